@@ -233,6 +233,9 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 
 	switch {
 	case req.Type == abci.CheckTxType_New:
+		if app.queryOnly {
+			return sdkerrors.ResponseCheckTx(sdkerrors.Wrap(sdkerrors.ErrQueryOnly, "transactions are not accepted"), 0, 0, app.trace)
+		}
 		mode = runTxModeCheck
 
 	case req.Type == abci.CheckTxType_Recheck:
@@ -244,6 +247,10 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 
 	gInfo, result, anteEvents, err := app.runTx(mode, req.Tx)
 	if err != nil {
+		if app.txPriority != nil {
+			// the tx is removed from the mempool, or was never added to it
+			app.txPriorities.delete(req.Tx)
+		}
 		return sdkerrors.ResponseCheckTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, anteEvents, app.trace)
 	}
 
@@ -274,19 +281,39 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
 	}()
 
+	if app.txPriority != nil {
+		app.txPriorities.delete(req.Tx)
+	}
+
+	var res abci.ResponseDeliverTx
 	gInfo, result, anteEvents, err := app.runTx(runTxModeDeliver, req.Tx)
 	if err != nil {
 		resultStr = "failed"
-		return sdkerrors.ResponseDeliverTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, anteEvents, app.trace)
+		res = sdkerrors.ResponseDeliverTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, anteEvents, app.trace)
+	} else {
+		res = abci.ResponseDeliverTx{
+			GasWanted: int64(gInfo.GasWanted), // TODO: Should type accept unsigned ints?
+			GasUsed:   int64(gInfo.GasUsed),   // TODO: Should type accept unsigned ints?
+			Log:       result.Log,
+			Data:      result.Data,
+			Events:    sdk.MarkEventsToIndex(result.Events, app.indexEvents),
+		}
 	}
 
-	return abci.ResponseDeliverTx{
-		GasWanted: int64(gInfo.GasWanted), // TODO: Should type accept unsigned ints?
-		GasUsed:   int64(gInfo.GasUsed),   // TODO: Should type accept unsigned ints?
-		Log:       result.Log,
-		Data:      result.Data,
-		Events:    sdk.MarkEventsToIndex(result.Events, app.indexEvents),
+	if app.emitTxEvents {
+		res.Events = append(res.Events, sdk.MarkEventsToIndex(app.txEvents(req.Tx, gInfo), app.indexEvents)...)
+	}
+
+	if app.deliverTxHook != nil {
+		// the hook is not charged any gas and its events are discarded, so that
+		// it cannot affect the result of the tx
+		ctx := app.deliverState.ctx.
+			WithGasMeter(sdk.NewInfiniteGasMeter()).
+			WithEventManager(sdk.NewEventManager())
+		app.deliverTxHook(ctx, req.Tx, res)
 	}
+
+	return res
 }
 
 // Commit implements the ABCI interface. It will commit all state that exists in
@@ -314,6 +341,7 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	// NOTE: This is safe because Tendermint holds a lock on the mempool for
 	// Commit. Use the header from this latest block.
 	app.setCheckState(header)
+	app.txPriorities.rotate()
 
 	// empty/reset the deliver state
 	app.deliverState = nil
@@ -337,7 +365,11 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	}
 
 	if app.snapshotInterval > 0 && uint64(header.Height)%app.snapshotInterval == 0 {
-		go app.snapshot(header.Height)
+		app.snapshotWG.Add(1)
+		go func() {
+			defer app.snapshotWG.Done()
+			app.snapshot(header.Height)
+		}()
 	}
 
 	return abci.ResponseCommit{
@@ -616,6 +648,13 @@ func checkNegativeHeight(height int64) error {
 	return nil
 }
 
+// CreateQueryContext creates a new sdk.Context for querying the state outside
+// of the ABCI queries, e.g. by the health checks, at the given height, or at
+// the latest height if 0.
+func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, error) {
+	return app.createQueryContext(height, prove)
+}
+
 // createQueryContext creates a new sdk.Context for a query, taking as args
 // the block height and whether the query needs a proof or not.
 func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, error) {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"sync"
 
 	"github.com/gogo/protobuf/proto"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -57,20 +58,27 @@ type BaseApp struct { // nolint: maligned
 	grpcQueryRouter   *GRPCQueryRouter     // router for redirecting gRPC query calls
 	msgServiceRouter  *MsgServiceRouter    // router for redirecting Msg service messages
 	interfaceRegistry types.InterfaceRegistry
-	txDecoder         sdk.TxDecoder // unmarshal []byte into sdk.Tx
-
-	anteHandler    sdk.AnteHandler  // ante handler for fee and auth
-	initChainer    sdk.InitChainer  // initialize state with validators and state blob
-	beginBlocker   sdk.BeginBlocker // logic to run before any txs
-	endBlocker     sdk.EndBlocker   // logic to run after all txs, and to determine valset changes
-	addrPeerFilter sdk.PeerFilter   // filter peers by address and port
-	idPeerFilter   sdk.PeerFilter   // filter peers by node ID
-	fauxMerkleMode bool             // if true, IAVL MountStores uses MountStoresDB for simulation speed.
+	txDecoder         sdk.TxDecoder    // unmarshal []byte into sdk.Tx
+	txDecoderRoutes   []txDecoderRoute // alternative tx decoders, tried before txDecoder
+
+	anteHandler     sdk.AnteHandler            // ante handler for fee and auth
+	initChainer     sdk.InitChainer            // initialize state with validators and state blob
+	beginBlocker    sdk.BeginBlocker           // logic to run before any txs
+	endBlocker      sdk.EndBlocker             // logic to run after all txs, and to determine valset changes
+	prepareProposal sdk.PrepareProposalHandler // logic to select the txs of a block proposed by this node
+	priorityLane    *PriorityLane              // txs moved to the top of the blocks proposed by this node
+	txPriority      sdk.TxPriorityHandler      // priority assigned by CheckTx to the txs, ordering the blocks proposed by this node
+	processProposal sdk.ProcessProposalHandler // logic to validate a block proposed by another node
+	deliverTxHook   sdk.DeliverTxHook          // logic run with the result of every delivered tx
+	addrPeerFilter  sdk.PeerFilter             // filter peers by address and port
+	idPeerFilter    sdk.PeerFilter             // filter peers by node ID
+	fauxMerkleMode  bool                       // if true, IAVL MountStores uses MountStoresDB for simulation speed.
 
 	// manages snapshots, i.e. dumps of app state at certain intervals
 	snapshotManager    *snapshots.Manager
-	snapshotInterval   uint64 // block interval between state sync snapshots
-	snapshotKeepRecent uint32 // recent state sync snapshots to keep
+	snapshotInterval   uint64         // block interval between state sync snapshots
+	snapshotKeepRecent uint32         // recent state sync snapshots to keep
+	snapshotWG         sync.WaitGroup // tracks the snapshots being taken
 
 	// volatile states:
 	//
@@ -79,6 +87,10 @@ type BaseApp struct { // nolint: maligned
 	checkState   *state // for CheckTx
 	deliverState *state // for DeliverTx
 
+	// txPriorities are the priorities assigned by CheckTx to the txs of the
+	// mempool, if there is a txPriority handler
+	txPriorities *txPriorities
+
 	// an inter-block write-through cache provided to the context during deliverState
 	interBlockCache sdk.MultiStorePersistentCache
 
@@ -117,6 +129,10 @@ type BaseApp struct { // nolint: maligned
 	// ResponseCommit.RetainHeight.
 	minRetainBlocks uint64
 
+	// queryOnly rejects the new transactions in CheckTx, for nodes dedicated to
+	// serving queries and state sync.
+	queryOnly bool
+
 	// application's version string
 	version string
 
@@ -133,6 +149,9 @@ type BaseApp struct { // nolint: maligned
 	// indexEvents defines the set of events in the form {eventType}.{attributeKey},
 	// which informs Tendermint what to index. If empty, all events will be indexed.
 	indexEvents map[string]struct{}
+
+	// emitTxEvents emits an EventTx for every delivered transaction.
+	emitTxEvents bool
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -154,6 +173,7 @@ func NewBaseApp(
 		grpcQueryRouter:  NewGRPCQueryRouter(),
 		msgServiceRouter: NewMsgServiceRouter(),
 		txDecoder:        txDecoder,
+		txPriorities:     newTxPriorities(maxTxPriorities),
 		fauxMerkleMode:   false,
 	}
 
@@ -283,6 +303,24 @@ func (app *BaseApp) CommitMultiStore() sdk.CommitMultiStore {
 	return app.cms
 }
 
+// Close waits for the snapshots being taken to complete and closes the
+// snapshot store and the application DB. It must be called once no more ABCI
+// requests are delivered to the application, i.e. after Tendermint stopped.
+func (app *BaseApp) Close() error {
+	app.snapshotWG.Wait()
+
+	var snapshotErr error
+	if app.snapshotManager != nil {
+		snapshotErr = app.snapshotManager.Close()
+	}
+
+	if err := app.db.Close(); err != nil {
+		return err
+	}
+
+	return snapshotErr
+}
+
 // SnapshotManager returns the snapshot manager.
 // application use this to register extra extension snapshotters.
 func (app *BaseApp) SnapshotManager() *snapshots.Manager {
@@ -352,6 +390,10 @@ func (app *BaseApp) setMinRetainBlocks(minRetainBlocks uint64) {
 	app.minRetainBlocks = minRetainBlocks
 }
 
+func (app *BaseApp) setQueryOnly(queryOnly bool) {
+	app.queryOnly = queryOnly
+}
+
 func (app *BaseApp) setInterBlockCache(cache sdk.MultiStorePersistentCache) {
 	app.interBlockCache = cache
 }
@@ -360,6 +402,10 @@ func (app *BaseApp) setTrace(trace bool) {
 	app.trace = trace
 }
 
+func (app *BaseApp) setEmitTxEvents(emit bool) {
+	app.emitTxEvents = emit
+}
+
 func (app *BaseApp) setIndexEvents(ie []string) {
 	app.indexEvents = make(map[string]struct{})
 
@@ -589,12 +635,20 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes)
+}
+
+// runTxWithContext is like runTx, but processes the transaction on top of the
+// given Context. In simulation mode, the state transitions of successfully
+// executed messages are written to the Context's (discarded) multistore
+// branch, so that transactions simulated on top of the same Context see the
+// effects of the previous ones.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -632,7 +686,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		defer consumeBlockGas()
 	}
 
-	tx, err := app.txDecoder(txBytes)
+	tx, err := app.decodeTx(txBytes)
 	if err != nil {
 		return sdk.GasInfo{}, nil, nil, err
 	}
@@ -673,6 +727,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 
 		// GasMeter expected to be set in AnteHandler
 		gasWanted = ctx.GasMeter().Limit()
+		sdk.RecordAnteGas(ctx, ctx.GasMeter().GasConsumed())
 
 		if err != nil {
 			return gInfo, nil, nil, err
@@ -682,6 +737,10 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		anteEvents = events.ToABCIEvents()
 	}
 
+	if app.txPriority != nil && (mode == runTxModeCheck || mode == runTxModeReCheck) {
+		app.txPriorities.set(txBytes, app.txPriority(ctx, tx))
+	}
+
 	// Create a new Context based off of the existing Context with a MultiStore branch
 	// in case message processing fails. At this point, the MultiStore
 	// is a branch of a branch.
@@ -701,11 +760,54 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 			// append the events in the order of occurrence
 			result.Events = append(anteEvents, result.Events...)
 		}
+	} else if err == nil && mode == runTxModeSimulate {
+		msCache.Write()
 	}
 
 	return gInfo, result, anteEvents, err
 }
 
+// txEvents returns the EventTx summarizing the economics of the given delivered
+// transaction, or no event if it cannot be decoded or its messages are invalid.
+func (app *BaseApp) txEvents(txBytes []byte, gInfo sdk.GasInfo) []abci.Event {
+	tx, err := app.decodeTx(txBytes)
+	if err != nil {
+		return nil
+	}
+
+	// the signers of invalid messages may not be retrievable
+	msgs := tx.GetMsgs()
+	if err := validateBasicTxMsgs(msgs); err != nil {
+		return nil
+	}
+
+	event := &sdk.EventTx{
+		GasWanted: gInfo.GasWanted,
+		GasUsed:   gInfo.GasUsed,
+		TxSize:    uint64(len(txBytes)),
+		MsgTypes:  make([]string, len(msgs)),
+	}
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		event.Fee = feeTx.GetFee()
+	}
+
+	signers := make(map[string]bool)
+	for i, msg := range msgs {
+		event.MsgTypes[i] = sdk.MsgTypeURL(msg)
+		for _, signer := range msg.GetSigners() {
+			signers[signer.String()] = true
+		}
+	}
+	event.SignerCount = uint32(len(signers))
+
+	ev, err := sdk.TypedEventToEvent(event)
+	if err != nil {
+		return nil
+	}
+
+	return []abci.Event{abci.Event(ev)}
+}
+
 // runMsgs iterates through a list of messages and executes them with the provided
 // Context and execution mode. Messages will only be executed during simulation
 // and DeliverTx. An error is returned if any single message fails or if a
@@ -731,9 +833,14 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 			err          error
 		)
 
+		// each Msg may be refunded up to the gas it consumes, see sdk.RefundGas,
+		// and exposes its type URL, see sdk.MsgTypeURLFromContext
+		msgCtx := sdk.WithMsgGasRefund(sdk.StartMsgTrace(sdk.WithMsgTypeURL(ctx, sdk.MsgTypeURL(msg))))
+		startGas := ctx.GasMeter().GasConsumed()
+
 		if handler := app.msgServiceRouter.Handler(msg); handler != nil {
 			// ADR 031 request type routing
-			msgResult, err = handler(ctx, msg)
+			msgResult, err = handler(msgCtx, msg)
 			eventMsgName = sdk.MsgTypeURL(msg)
 		} else if legacyMsg, ok := msg.(legacytx.LegacyMsg); ok {
 			// legacy sdk.Msg routing
@@ -748,7 +855,7 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 				return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msgRoute, i)
 			}
 
-			msgResult, err = handler(ctx, msg)
+			msgResult, err = handler(msgCtx, msg)
 		} else {
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "can't route message %+v", msg)
 		}
@@ -756,11 +863,13 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 		if err != nil {
 			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
+		sdk.RecordMsgGas(ctx, ctx.GasMeter().GasConsumed()-startGas)
 
 		msgEvents := sdk.Events{
 			sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAction, eventMsgName)),
 		}
 		msgEvents = msgEvents.AppendEvents(msgResult.GetEvents())
+		sdk.RecordMsgEvents(ctx, msgEvents)
 
 		// append message events, data and logs
 		//
@@ -527,6 +527,12 @@ func TestBaseAppOptionSeal(t *testing.T) {
 	require.Panics(t, func() {
 		app.SetEndBlocker(nil)
 	})
+	require.Panics(t, func() {
+		app.SetPrepareProposal(nil)
+	})
+	require.Panics(t, func() {
+		app.SetProcessProposal(nil)
+	})
 	require.Panics(t, func() {
 		app.SetAnteHandler(nil)
 	})
@@ -963,6 +969,40 @@ func TestCheckTx(t *testing.T) {
 	require.Nil(t, storedBytes)
 }
 
+func TestCheckTxQueryOnly(t *testing.T) {
+	counterKey := []byte("counter-key")
+
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, counterKey)) }
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			return &sdk.Result{}, nil
+		}))
+	}
+	app := setupBaseApp(t, anteOpt, routerOpt, SetQueryOnly(true))
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	txBytes, err := codec.Marshal(newTxCounter(0, 1))
+	require.NoError(t, err)
+
+	r := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.False(t, r.IsOK())
+	require.Equal(t, sdkerrors.ErrQueryOnly.ABCICode(), r.Code)
+	require.Equal(t, sdkerrors.ErrQueryOnly.Codespace(), r.Codespace)
+
+	// the ante handler did not run
+	checkStateStore := app.checkState.ctx.KVStore(capKey1)
+	require.Nil(t, checkStateStore.Get(counterKey))
+
+	// the blocks are still executed
+	header := tmproto.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+}
+
 // Test that successive DeliverTx can see each others' effects
 // on the store, both within and across blocks.
 func TestDeliverTx(t *testing.T) {
@@ -1011,6 +1051,104 @@ func TestDeliverTx(t *testing.T) {
 	}
 }
 
+func TestDeliverTxHook(t *testing.T) {
+	hookKey := []byte("hook-key")
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, []byte("deliver-key"))))
+	}
+
+	var results []abci.ResponseDeliverTx
+	hookOpt := func(bapp *BaseApp) {
+		bapp.SetDeliverTxHook(func(ctx sdk.Context, txBytes []byte, res abci.ResponseDeliverTx) {
+			results = append(results, res)
+			ctx.KVStore(capKey1).Set(hookKey, txBytes)
+		})
+	}
+
+	app := setupBaseApp(t, routerOpt, hookOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+
+	okTx, err := codec.Marshal(newTxCounter(0, 0))
+	require.NoError(t, err)
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: okTx})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	failTx := newTxCounter(1, 1)
+	failTx.setFailOnHandler(true)
+	failTxBytes, err := codec.Marshal(failTx)
+	require.NoError(t, err)
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: failTxBytes})
+	require.False(t, res.IsOK())
+
+	// the hook is called on both successful and failed txs, with the returned results
+	require.Len(t, results, 2)
+	require.True(t, results[0].IsOK())
+	require.Equal(t, res, results[1])
+
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	// the state changes of the hook are committed
+	ctx := app.getState(runTxModeCheck).ctx
+	require.Equal(t, failTxBytes, ctx.KVStore(capKey1).Get(hookKey))
+}
+
+func TestDeliverTxEmitTxEvents(t *testing.T) {
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, []byte("deliver-key"))))
+	}
+
+	app := setupBaseApp(t, routerOpt, SetEmitTxEvents(true))
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+
+	okTx, err := codec.Marshal(newTxCounter(0, 0, 1))
+	require.NoError(t, err)
+	failTx := newTxCounter(1, 2)
+	failTx.setFailOnHandler(true)
+	failTxBytes, err := codec.Marshal(failTx)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		txBytes []byte
+		msgs    int
+		ok      bool
+	}{
+		{okTx, 2, true},
+		{failTxBytes, 1, false},
+	} {
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: tc.txBytes})
+		require.Equal(t, tc.ok, res.IsOK(), fmt.Sprintf("%v", res))
+
+		// the tx event is the last event, on both successful and failed txs
+		events := res.GetEvents()
+		require.NotEmpty(t, events)
+		msg, err := sdk.ParseTypedEvent(events[len(events)-1])
+		require.NoError(t, err)
+
+		event, ok := msg.(*sdk.EventTx)
+		require.True(t, ok)
+		require.Equal(t, uint64(len(tc.txBytes)), event.TxSize)
+		require.Equal(t, uint64(res.GasUsed), event.GasUsed)
+		require.Equal(t, uint64(res.GasWanted), event.GasWanted)
+		require.Len(t, event.MsgTypes, tc.msgs)
+		require.Zero(t, event.SignerCount)
+		require.True(t, event.Fee.IsZero())
+	}
+
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+}
+
 // Number of messages doesn't matter to CheckTx.
 func TestMultiMsgCheckTx(t *testing.T) {
 	// TODO: ensure we get the same results
@@ -1163,6 +1301,189 @@ func TestSimulateTx(t *testing.T) {
 	}
 }
 
+func TestSimulateBundle(t *testing.T) {
+	counterKey := []byte("counter-key")
+
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			store := ctx.KVStore(capKey1)
+			counter := msg.(*msgCounter).Counter
+			if stored := getIntFromStore(store, counterKey); stored != counter {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "expected counter %d, got %d", stored, counter)
+			}
+
+			ctx.GasMeter().ConsumeGas(uint64(counter+1)*10, "test")
+			setIntOnStore(store, counterKey, counter+1)
+			return &sdk.Result{}, nil
+		})
+		bapp.Router().AddRoute(r)
+	}
+
+	app := setupBaseApp(t, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	txsBytes := make([][]byte, 3)
+	for i := range txsBytes {
+		txBytes, err := cdc.Marshal(newTxCounter(0, int64(i)))
+		require.NoError(t, err)
+		txsBytes[i] = txBytes
+	}
+
+	// every tx sees the effects of the previous ones
+	gasInfos, results, err := app.SimulateBundle(txsBytes)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	// gas is metered per tx, not accumulated over the bundle
+	require.Less(t, gasInfos[2].GasUsed, 2*gasInfos[0].GasUsed)
+
+	// the effects are discarded once the bundle is simulated
+	_, _, err = app.SimulateBundle(txsBytes)
+	require.NoError(t, err)
+	_, _, err = app.Simulate(txsBytes[0])
+	require.NoError(t, err)
+	_, _, err = app.Simulate(txsBytes[1])
+	require.Error(t, err)
+
+	// the first failing tx fails the bundle
+	_, _, err = app.SimulateBundle([][]byte{txsBytes[0], txsBytes[2]})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.Contains(t, err.Error(), "tx 1")
+}
+
+func TestEstimateGas(t *testing.T) {
+	anteGas, msgGas := uint64(7), uint64(10)
+
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			newCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+			newCtx.GasMeter().ConsumeGas(anteGas, "ante")
+			return newCtx, nil
+		})
+	}
+
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			counter := msg.(*msgCounter).Counter
+			ctx.GasMeter().ConsumeGas(uint64(counter+1)*msgGas, "test")
+			return &sdk.Result{}, nil
+		})
+		bapp.Router().AddRoute(r)
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	// the gas used is broken down between the AnteHandler and each message
+	txBytes, err := cdc.Marshal(newTxCounter(0, 0, 1, 2))
+	require.NoError(t, err)
+	gasInfo, result, breakdown, err := app.EstimateGas(txBytes)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Equal(t, anteGas, breakdown.AnteGas)
+	require.Equal(t, []uint64{msgGas, 2 * msgGas, 3 * msgGas}, breakdown.MsgsGas)
+	require.Equal(t, anteGas+6*msgGas, gasInfo.GasUsed)
+
+	simGasInfo, _, err := app.Simulate(txBytes)
+	require.NoError(t, err)
+	require.Equal(t, simGasInfo, gasInfo)
+
+	// an invalid tx has no breakdown
+	_, _, breakdown, err = app.EstimateGas([]byte("invalid"))
+	require.Error(t, err)
+	require.Empty(t, breakdown.MsgsGas)
+}
+
+func TestTraceTx(t *testing.T) {
+	anteKey := []byte("ante-key")
+	deliverKey := []byte("deliver-key")
+	anteOpt := func(bapp *BaseApp) {
+		anteHandler := anteHandlerTxTest(t, capKey1, anteKey)
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			// each tx has its own gas meter, as set by the auth AnteHandler
+			return anteHandler(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), tx, simulate)
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey)))
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	failTx := newTxCounter(3, 4)
+	failTx.setFailOnHandler(true)
+	blocks := [][]*txTest{
+		{newTxCounter(0, 0)},
+		{newTxCounter(1, 1), newTxCounter(2, 2, 3), failTx},
+	}
+
+	var (
+		headers   []tmproto.Header
+		blocksTxs [][][]byte
+		responses []abci.ResponseDeliverTx
+	)
+	for i, txs := range blocks {
+		header := tmproto.Header{Height: int64(i) + 1}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+		var txsBytes [][]byte
+		for _, tx := range txs {
+			txBytes, err := cdc.Marshal(tx)
+			require.NoError(t, err)
+			txsBytes = append(txsBytes, txBytes)
+			responses = append(responses, app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes}))
+		}
+
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+		headers = append(headers, header)
+		blocksTxs = append(blocksTxs, txsBytes)
+	}
+
+	// the tx is re-executed after the previous txs of its block
+	res, trace, err := app.TraceTx(headers[1], blocksTxs[1], 1)
+	require.NoError(t, err)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, responses[2].GasUsed, res.GasUsed)
+	require.Len(t, trace.Msgs, 2)
+
+	msgsGas := uint64(0)
+	for i, msgTrace := range trace.Msgs {
+		require.Equal(t, uint64(1), msgTrace.Reads)
+		require.Equal(t, uint64(1), msgTrace.Writes)
+		require.Equal(t, sdk.Events{
+			sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAction, "counter1")),
+		}.AppendEvents(counterEvent(sdk.EventTypeMessage, int64(i)+2)), msgTrace.Events)
+		msgsGas += msgTrace.GasUsed
+	}
+	require.Equal(t, uint64(res.GasUsed), trace.AnteGas+msgsGas)
+
+	// a failed tx is traced up to its failing message
+	res, trace, err = app.TraceTx(headers[1], blocksTxs[1], 2)
+	require.NoError(t, err)
+	require.False(t, res.IsOK())
+	require.Equal(t, responses[3].Code, res.Code)
+	require.Len(t, trace.Msgs, 1)
+	require.Empty(t, trace.Msgs[0].Events)
+
+	// the state before the first block is not available
+	_, _, err = app.TraceTx(headers[0], blocksTxs[0], 0)
+	require.Error(t, err)
+	_, _, err = app.TraceTx(tmproto.Header{Height: 3}, blocksTxs[1], 0)
+	require.Error(t, err)
+	_, _, err = app.TraceTx(headers[1], blocksTxs[1], 3)
+	require.Error(t, err)
+}
+
 func TestRunInvalidTransaction(t *testing.T) {
 	anteOpt := func(bapp *BaseApp) {
 		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
@@ -1354,6 +1675,65 @@ func TestTxGasLimits(t *testing.T) {
 	}
 }
 
+// Test that Msgs can only be refunded the gas they consumed
+func TestMsgGasRefund(t *testing.T) {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+			newCtx = ctx.WithGasMeter(sdk.NewGasMeter(100))
+			newCtx.GasMeter().ConsumeGas(uint64(tx.(txTest).Counter), "counter-ante")
+
+			// gas consumed outside of Msg execution is never refunded
+			require.Zero(t, sdk.RefundGas(newCtx, 1, "counter-ante"))
+
+			return newCtx, nil
+		})
+	}
+
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			count := msg.(*msgCounter).Counter
+			ctx.GasMeter().ConsumeGas(uint64(count), "counter-handler")
+
+			// try to refund more than the Msg consumed
+			refunded := sdk.RefundGas(ctx, uint64(count)+10, "counter-handler")
+			require.Equal(t, uint64(count), refunded)
+			require.Equal(t, refunded, sdk.GasRefunded(ctx))
+
+			return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+		})
+		bapp.Router().AddRoute(r)
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+
+	testCases := []struct {
+		tx      *txTest
+		gasUsed uint64
+		refunds int
+	}{
+		{newTxCounter(0, 0), 0, 0},
+		{newTxCounter(3, 4), 3, 1},
+		{newTxCounter(3, 4, 5, 6), 3, 3},
+		{newTxCounter(10, 50, 0, 50), 10, 2},
+	}
+
+	for i, tc := range testCases {
+		gInfo, result, err := app.Deliver(aminoTxEncoder(), tc.tx)
+		require.NoError(t, err, fmt.Sprintf("tc #%d", i))
+		require.Equal(t, tc.gasUsed, gInfo.GasUsed, fmt.Sprintf("tc #%d", i))
+
+		var refunds int
+		for _, ev := range result.Events {
+			if ev.Type == sdk.EventTypeGasRefund {
+				refunds++
+			}
+		}
+		require.Equal(t, tc.refunds, refunds, fmt.Sprintf("tc #%d", i))
+	}
+}
+
 // Test that transactions exceeding gas limits fail
 func TestMaxBlockGasLimits(t *testing.T) {
 	gasGranted := uint64(10)
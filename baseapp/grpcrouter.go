@@ -116,6 +116,17 @@ func (qrt *GRPCQueryRouter) RegisterService(sd *grpc.ServiceDesc, handler interf
 	})
 }
 
+// ServiceDescs returns the descriptions of the registered gRPC services, in
+// registration order.
+func (qrt *GRPCQueryRouter) ServiceDescs() []*grpc.ServiceDesc {
+	descs := make([]*grpc.ServiceDesc, len(qrt.serviceData))
+	for i, data := range qrt.serviceData {
+		descs[i] = data.serviceDesc
+	}
+
+	return descs
+}
+
 // SetInterfaceRegistry sets the interface registry for the router. This will
 // also register the interface reflection gRPC service.
 func (qrt *GRPCQueryRouter) SetInterfaceRegistry(interfaceRegistry codectypes.InterfaceRegistry) {
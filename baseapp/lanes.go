@@ -0,0 +1,103 @@
+package baseapp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PriorityLane marks the transactions of critical protocols, e.g. oracle
+// votes, evidence or upgrade related messages, which the blocks proposed by the
+// node always start with, so that these protocols stay live when blocks are
+// congested. A transaction is in the lane if all its messages are of the lane
+// types.
+//
+// The lane is bounded by a gas budget: the lane transactions are moved to the
+// top of the proposal in their mempool order as long as the sum of their gas
+// limits fits in the budget. The lane transactions exceeding it keep their
+// position among the other transactions, so that the lane cannot take over
+// whole blocks.
+type PriorityLane struct {
+	msgTypeURLs map[string]bool
+	gasBudget   uint64
+}
+
+// NewPriorityLane returns a PriorityLane of the messages with the given type
+// URLs, e.g. sdk.MsgTypeURL(&evidencetypes.MsgSubmitEvidence{}), with the given
+// gas budget per block.
+func NewPriorityLane(gasBudget uint64, msgTypeURLs ...string) *PriorityLane {
+	lane := &PriorityLane{
+		msgTypeURLs: make(map[string]bool, len(msgTypeURLs)),
+		gasBudget:   gasBudget,
+	}
+	for _, typeURL := range msgTypeURLs {
+		lane.msgTypeURLs[typeURL] = true
+	}
+
+	return lane
+}
+
+// GasBudget returns the gas budget of the lane per block.
+func (l *PriorityLane) GasBudget() uint64 {
+	return l.gasBudget
+}
+
+// Contains returns true if all the messages of the tx, which must have at least
+// one, are of the lane types.
+func (l *PriorityLane) Contains(tx sdk.Tx) bool {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return false
+	}
+
+	for _, msg := range msgs {
+		if !l.msgTypeURLs[sdk.MsgTypeURL(msg)] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// prioritizeTxs orders the given proposal transactions by priority, if there is
+// a tx priority handler, and then moves the transactions of the priority lane,
+// if any, to the top, within the gas budget of the lane. The transactions which
+// cannot be decoded or do not declare their gas limit are never in the lane.
+// The order of the transactions is otherwise kept.
+func (app *BaseApp) prioritizeTxs(txs [][]byte) [][]byte {
+	txs = app.sortTxsByPriority(txs)
+	if app.priorityLane == nil {
+		return txs
+	}
+
+	var (
+		laneGas uint64
+		lane    [][]byte
+		others  [][]byte
+	)
+	for _, txBytes := range txs {
+		if gas, ok := app.priorityLaneGas(txBytes); ok && gas <= app.priorityLane.gasBudget-laneGas {
+			laneGas += gas
+			lane = append(lane, txBytes)
+			continue
+		}
+
+		others = append(others, txBytes)
+	}
+
+	return append(lane, others...)
+}
+
+// priorityLaneGas returns the gas limit of the tx if it is in the priority
+// lane.
+func (app *BaseApp) priorityLaneGas(txBytes []byte) (uint64, bool) {
+	tx, err := app.decodeTx(txBytes)
+	if err != nil {
+		return 0, false
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || !app.priorityLane.Contains(tx) {
+		return 0, false
+	}
+
+	return feeTx.GetGas(), true
+}
@@ -0,0 +1,182 @@
+package baseapp
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+const (
+	// DefaultMaxMsgRouterDepth is the default maximum nesting of Msgs executed
+	// through the MsgRouterService, e.g. an authz MsgExec executing a group
+	// proposal executing a bank MsgSend is a depth of 2.
+	DefaultMaxMsgRouterDepth = 6
+
+	// MsgRouterDispatchGasCost is the flat gas cost charged for every Msg
+	// executed through the MsgRouterService, on top of the gas consumed by the
+	// Msg handler itself.
+	MsgRouterDispatchGasCost sdk.Gas = 1000
+)
+
+// msgRouterStackKey is the context key holding the names of the modules
+// currently executing Msgs through the MsgRouterService, outermost first.
+type msgRouterStackKey struct{}
+
+// MsgRouterService lets modules execute the Msgs of other modules without
+// depending on their keepers. Each module gets its own scoped router through
+// ForModule, which enforces that the executed Msgs are only signed by the
+// module account or by addresses the module vouches for.
+//
+// Reentrancy is guarded against: a module cannot execute Msgs while a Msg it
+// dispatched is still being handled, and the nesting of dispatched Msgs is
+// bounded. Every dispatch is charged a flat gas cost and may be bounded by a
+// per-dispatch gas limit.
+type MsgRouterService struct {
+	router         *MsgServiceRouter
+	maxDepth       int
+	maxGasPerMsg   sdk.Gas
+	dispatchGasFee sdk.Gas
+}
+
+// NewMsgRouterService returns a MsgRouterService routing Msgs through the
+// given MsgServiceRouter, with a max depth of DefaultMaxMsgRouterDepth and no
+// per-dispatch gas limit besides the gas left in the transaction.
+func NewMsgRouterService(router *MsgServiceRouter) *MsgRouterService {
+	return &MsgRouterService{
+		router:         router,
+		maxDepth:       DefaultMaxMsgRouterDepth,
+		dispatchGasFee: MsgRouterDispatchGasCost,
+	}
+}
+
+// WithMaxDepth returns a copy of the service allowing at most maxDepth nested
+// dispatches.
+func (s MsgRouterService) WithMaxDepth(maxDepth int) *MsgRouterService {
+	s.maxDepth = maxDepth
+	return &s
+}
+
+// WithMaxGasPerMsg returns a copy of the service limiting the gas a single
+// dispatched Msg may consume. Zero means no limit besides the gas left.
+func (s MsgRouterService) WithMaxGasPerMsg(maxGas sdk.Gas) *MsgRouterService {
+	s.maxGasPerMsg = maxGas
+	return &s
+}
+
+// ForModule returns a router executing Msgs on behalf of the given module.
+func (s *MsgRouterService) ForModule(moduleName string) msgservice.MsgRouter {
+	return moduleMsgRouter{
+		service:    s,
+		moduleName: moduleName,
+		moduleAddr: sdk.AccAddress(crypto.AddressHash([]byte(moduleName))),
+	}
+}
+
+// moduleMsgRouter is a MsgRouter scoped to a single module.
+type moduleMsgRouter struct {
+	service    *MsgRouterService
+	moduleName string
+	moduleAddr sdk.AccAddress
+}
+
+var _ msgservice.MsgRouter = moduleMsgRouter{}
+
+// Execute implements msgservice.MsgRouter.
+func (r moduleMsgRouter) Execute(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+	return r.ExecuteAuthorized(ctx, nil, msg)
+}
+
+// ExecuteAuthorized implements msgservice.MsgRouter.
+func (r moduleMsgRouter) ExecuteAuthorized(ctx sdk.Context, authorized []sdk.AccAddress, msg sdk.Msg) (*sdk.Result, error) {
+	for _, signer := range msg.GetSigners() {
+		if !signer.Equals(r.moduleAddr) && !containsAddress(authorized, signer) {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf(
+				"module %s cannot execute %s on behalf of %s", r.moduleName, sdk.MsgTypeURL(msg), signer,
+			)
+		}
+	}
+
+	return r.service.dispatch(ctx, r.moduleName, msg)
+}
+
+func (s *MsgRouterService) dispatch(ctx sdk.Context, moduleName string, msg sdk.Msg) (*sdk.Result, error) {
+	stack, _ := ctx.Value(msgRouterStackKey{}).([]string)
+	if len(stack) >= s.maxDepth {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("max msg router depth of %d reached", s.maxDepth)
+	}
+	for _, caller := range stack {
+		if caller == moduleName {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("module %s cannot reenter the msg router", moduleName)
+		}
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	handler := s.router.Handler(msg)
+	if handler == nil {
+		return nil, sdkerrors.ErrUnknownRequest.Wrapf("unrecognized message route: %s", sdk.MsgTypeURL(msg))
+	}
+
+	ctx.GasMeter().ConsumeGas(s.dispatchGasFee, "msg router dispatch")
+
+	// copy the stack so sibling dispatches don't observe each other
+	nested := make([]string, len(stack), len(stack)+1)
+	copy(nested, stack)
+	nested = append(nested, moduleName)
+
+	cacheCtx, write := ctx.WithValue(msgRouterStackKey{}, nested).CacheContext()
+	res, err := s.runWithGasLimit(cacheCtx, msg, handler)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to execute message %s", sdk.MsgTypeURL(msg))
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(res.GetEvents())
+
+	return res, nil
+}
+
+// runWithGasLimit runs the handler with a gas meter bounded by maxGasPerMsg,
+// if set, and charges the consumed gas to the parent gas meter.
+func (s *MsgRouterService) runWithGasLimit(ctx sdk.Context, msg sdk.Msg, handler MsgServiceHandler) (res *sdk.Result, err error) {
+	if s.maxGasPerMsg == 0 {
+		return handler(ctx, msg)
+	}
+
+	parent := ctx.GasMeter()
+	limit := s.maxGasPerMsg
+	if parent.Limit() > 0 && parent.Limit()-parent.GasConsumedToLimit() < limit {
+		limit = parent.Limit() - parent.GasConsumedToLimit()
+	}
+
+	meter := sdk.NewGasMeter(limit)
+	defer func() {
+		parent.ConsumeGas(meter.GasConsumedToLimit(), "msg router dispatch execution")
+
+		if r := recover(); r != nil {
+			oog, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			res, err = nil, sdkerrors.ErrOutOfGas.Wrapf(
+				"dispatched msg exceeded gas limit %d: %s", limit, oog.Descriptor,
+			)
+		}
+	}()
+
+	return handler(ctx.WithGasMeter(meter), msg)
+}
+
+func containsAddress(addrs []sdk.AccAddress, addr sdk.AccAddress) bool {
+	for _, a := range addrs {
+		if a.Equals(addr) {
+			return true
+		}
+	}
+
+	return false
+}
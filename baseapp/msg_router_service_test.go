@@ -0,0 +1,160 @@
+package baseapp_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var msgRouterTestKey = sdk.NewKVStoreKey("msgrouter")
+
+// hookMsgServer runs onCreateDog from within the CreateDog handler.
+type hookMsgServer struct {
+	testdata.MsgServerImpl
+	onCreateDog func(ctx sdk.Context) error
+}
+
+func (s hookMsgServer) CreateDog(goCtx context.Context, msg *testdata.MsgCreateDog) (*testdata.MsgCreateDogResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx.KVStore(msgRouterTestKey).Set([]byte(msg.Dog.Name), []byte(msg.Dog.Size_))
+	ctx.EventManager().EmitEvent(sdk.NewEvent("dog_created", sdk.NewAttribute("name", msg.Dog.Name)))
+
+	if s.onCreateDog != nil {
+		if err := s.onCreateDog(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return &testdata.MsgCreateDogResponse{Name: msg.Dog.Name}, nil
+}
+
+func setupMsgRouterService(t *testing.T, onCreateDog func(ctx sdk.Context) error) (*baseapp.BaseApp, sdk.Context) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	app := baseapp.NewBaseApp("test", log.NewTMLogger(log.NewSyncWriter(os.Stdout)), dbm.NewMemDB(), encCfg.TxConfig.TxDecoder())
+	app.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+	app.MountStores(msgRouterTestKey)
+	testdata.RegisterMsgServer(app.MsgServiceRouter(), hookMsgServer{onCreateDog: onCreateDog})
+	require.NoError(t, app.LoadLatestVersion())
+
+	ctx := app.NewUncachedContext(false, tmproto.Header{}).WithGasMeter(sdk.NewInfiniteGasMeter())
+	return app, ctx
+}
+
+func newDogMsg(name string) *testdata.MsgCreateDog {
+	return &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: name, Size_: "small"}}
+}
+
+func TestMsgRouterServiceExecute(t *testing.T) {
+	app, ctx := setupMsgRouterService(t, nil)
+	router := baseapp.NewMsgRouterService(app.MsgServiceRouter()).ForModule("foo")
+
+	res, err := router.Execute(ctx, newDogMsg("spot"))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, []byte("small"), ctx.KVStore(msgRouterTestKey).Get([]byte("spot")))
+	require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed(), baseapp.MsgRouterDispatchGasCost)
+
+	var found bool
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type == "dog_created" {
+			found = true
+		}
+	}
+	require.True(t, found, "events of the dispatched msg should be emitted")
+}
+
+func TestMsgRouterServiceSigners(t *testing.T) {
+	app, ctx := setupMsgRouterService(t, nil)
+	router := baseapp.NewMsgRouterService(app.MsgServiceRouter()).ForModule("foo")
+
+	moduleAddr := sdk.AccAddress(crypto.AddressHash([]byte("foo")))
+	_, _, other := testdata.KeyTestPubAddr()
+
+	_, err := router.Execute(ctx, testdata.NewTestMsg(other))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// authorized signers pass the signer check, but TestMsg has no Msg service
+	_, err = router.ExecuteAuthorized(ctx, []sdk.AccAddress{other}, testdata.NewTestMsg(other))
+	require.ErrorIs(t, err, sdkerrors.ErrUnknownRequest)
+
+	_, err = router.Execute(ctx, testdata.NewTestMsg(moduleAddr))
+	require.ErrorIs(t, err, sdkerrors.ErrUnknownRequest)
+}
+
+func TestMsgRouterServiceRollback(t *testing.T) {
+	app, ctx := setupMsgRouterService(t, func(sdk.Context) error {
+		return sdkerrors.ErrInvalidRequest
+	})
+	router := baseapp.NewMsgRouterService(app.MsgServiceRouter()).ForModule("foo")
+
+	_, err := router.Execute(ctx, newDogMsg("spot"))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.Nil(t, ctx.KVStore(msgRouterTestKey).Get([]byte("spot")))
+	require.Empty(t, ctx.EventManager().Events())
+}
+
+func TestMsgRouterServiceReentrancy(t *testing.T) {
+	var service *baseapp.MsgRouterService
+	app, ctx := setupMsgRouterService(t, func(ctx sdk.Context) error {
+		// the handler executes again on behalf of the calling module
+		_, err := service.ForModule("foo").Execute(ctx, newDogMsg("nested"))
+		return err
+	})
+	service = baseapp.NewMsgRouterService(app.MsgServiceRouter())
+
+	_, err := service.ForModule("foo").Execute(ctx, newDogMsg("spot"))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.Contains(t, err.Error(), "cannot reenter")
+}
+
+func TestMsgRouterServiceMaxDepth(t *testing.T) {
+	var (
+		service *baseapp.MsgRouterService
+		depth   int
+	)
+	app, ctx := setupMsgRouterService(t, func(ctx sdk.Context) error {
+		// every nested dispatch is made on behalf of a different module
+		depth++
+		_, err := service.ForModule(string(rune('a'+depth))).Execute(ctx, newDogMsg("nested"))
+		return err
+	})
+	service = baseapp.NewMsgRouterService(app.MsgServiceRouter()).WithMaxDepth(3)
+
+	_, err := service.ForModule("a").Execute(ctx, newDogMsg("spot"))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.Contains(t, err.Error(), "max msg router depth of 3 reached")
+	require.Equal(t, 3, depth)
+}
+
+func TestMsgRouterServiceMaxGasPerMsg(t *testing.T) {
+	app, ctx := setupMsgRouterService(t, func(ctx sdk.Context) error {
+		ctx.GasMeter().ConsumeGas(10000, "test")
+		return nil
+	})
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(100000))
+
+	service := baseapp.NewMsgRouterService(app.MsgServiceRouter())
+
+	_, err := service.WithMaxGasPerMsg(5000).ForModule("foo").Execute(ctx, newDogMsg("spot"))
+	require.ErrorIs(t, err, sdkerrors.ErrOutOfGas)
+	require.Equal(t, baseapp.MsgRouterDispatchGasCost+5000, ctx.GasMeter().GasConsumed())
+	require.Nil(t, ctx.KVStore(msgRouterTestKey).Get([]byte("spot")))
+
+	_, err = service.WithMaxGasPerMsg(50000).ForModule("foo").Execute(ctx, newDogMsg("spot"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("small"), ctx.KVStore(msgRouterTestKey).Get([]byte("spot")))
+}
@@ -30,6 +30,30 @@ func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.setMinGasPrices(gasPrices) }
 }
 
+// SetTxPriorityPolicy returns an option that sets the TxPriorityPolicy of the
+// given fee tiers, each a list of gas prices, and whitelisted message type URLs
+// as the tx priority handler of the app. No handler is set if both are empty.
+func SetTxPriorityPolicy(feeTiersStr []string, msgTypeURLs []string) func(*BaseApp) {
+	if len(feeTiersStr) == 0 && len(msgTypeURLs) == 0 {
+		return func(*BaseApp) {}
+	}
+
+	feeTiers := make([]sdk.DecCoins, len(feeTiersStr))
+	for i, tierStr := range feeTiersStr {
+		gasPrices, err := sdk.ParseDecCoins(tierStr)
+		if err != nil {
+			panic(fmt.Sprintf("invalid tx priority fee tier %q: %v", tierStr, err))
+		}
+		if gasPrices.Empty() {
+			panic(fmt.Sprintf("empty tx priority fee tier %d", i))
+		}
+		feeTiers[i] = gasPrices
+	}
+
+	policy := NewTxPriorityPolicy(feeTiers, msgTypeURLs...)
+	return func(bapp *BaseApp) { bapp.SetTxPriority(policy.TxPriority) }
+}
+
 // SetHaltHeight returns a BaseApp option function that sets the halt block height.
 func SetHaltHeight(blockHeight uint64) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.setHaltHeight(blockHeight) }
@@ -47,6 +71,12 @@ func SetMinRetainBlocks(minRetainBlocks uint64) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.setMinRetainBlocks(minRetainBlocks) }
 }
 
+// SetQueryOnly returns a BaseApp option function that rejects the new
+// transactions in CheckTx, for nodes serving queries and state sync only.
+func SetQueryOnly(queryOnly bool) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.setQueryOnly(queryOnly) }
+}
+
 // SetTrace will turn on or off trace flag
 func SetTrace(trace bool) func(*BaseApp) {
 	return func(app *BaseApp) { app.setTrace(trace) }
@@ -57,6 +87,13 @@ func SetIndexEvents(ie []string) func(*BaseApp) {
 	return func(app *BaseApp) { app.setIndexEvents(ie) }
 }
 
+// SetEmitTxEvents provides a BaseApp option function that emits an EventTx
+// summarizing the fee, gas, size, signers and messages of every delivered
+// transaction.
+func SetEmitTxEvents(emit bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.setEmitTxEvents(emit) }
+}
+
 // SetIAVLCacheSize provides a BaseApp option function that sets the size of IAVL cache.
 func SetIAVLCacheSize(size int) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetIAVLCacheSize(size) }
@@ -153,6 +190,56 @@ func (app *BaseApp) SetEndBlocker(endBlocker sdk.EndBlocker) {
 	app.endBlocker = endBlocker
 }
 
+// SetPrepareProposal sets the handler selecting and ordering the transactions
+// of the blocks proposed by this node.
+func (app *BaseApp) SetPrepareProposal(handler sdk.PrepareProposalHandler) {
+	if app.sealed {
+		panic("SetPrepareProposal() on sealed BaseApp")
+	}
+
+	app.prepareProposal = handler
+}
+
+// SetPriorityLane sets the priority lane of the transactions the blocks
+// proposed by this node start with.
+func (app *BaseApp) SetPriorityLane(lane *PriorityLane) {
+	if app.sealed {
+		panic("SetPriorityLane() on sealed BaseApp")
+	}
+
+	app.priorityLane = lane
+}
+
+// SetTxPriority sets the handler assigning the priorities of the transactions
+// accepted by CheckTx, which order the blocks proposed by this node.
+func (app *BaseApp) SetTxPriority(handler sdk.TxPriorityHandler) {
+	if app.sealed {
+		panic("SetTxPriority() on sealed BaseApp")
+	}
+
+	app.txPriority = handler
+}
+
+// SetProcessProposal sets the handler validating blocks proposed by other
+// validators.
+func (app *BaseApp) SetProcessProposal(handler sdk.ProcessProposalHandler) {
+	if app.sealed {
+		panic("SetProcessProposal() on sealed BaseApp")
+	}
+
+	app.processProposal = handler
+}
+
+// SetDeliverTxHook sets the hook called with the result of every transaction
+// delivered in a block.
+func (app *BaseApp) SetDeliverTxHook(hook sdk.DeliverTxHook) {
+	if app.sealed {
+		panic("SetDeliverTxHook() on sealed BaseApp")
+	}
+
+	app.deliverTxHook = hook
+}
+
 func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	if app.sealed {
 		panic("SetAnteHandler() on sealed BaseApp")
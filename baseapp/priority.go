@@ -0,0 +1,202 @@
+package baseapp
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TxPriorityPolicy assigns the priorities of the transactions accepted by
+// CheckTx from their fee per gas and their message types, so that the critical
+// messages and the transactions paying more are proposed first during
+// congestion.
+//
+// The fee tiers are gas prices listed from the lowest to the highest: a
+// transaction paying at least one of the prices of the i-th tier, as for the
+// minimum gas prices, has the priority i+1, and the priority 0 below the first
+// tier. A transaction whose messages are all of the whitelisted types, e.g.
+// governance votes or oracle prices, has a priority above all the tiers.
+type TxPriorityPolicy struct {
+	feeTiers    []sdk.DecCoins
+	msgTypeURLs map[string]bool
+}
+
+// NewTxPriorityPolicy returns a TxPriorityPolicy with the given fee tiers and
+// the whitelisted message type URLs, e.g. sdk.MsgTypeURL(&govtypes.MsgVote{}).
+func NewTxPriorityPolicy(feeTiers []sdk.DecCoins, msgTypeURLs ...string) *TxPriorityPolicy {
+	policy := &TxPriorityPolicy{
+		feeTiers:    feeTiers,
+		msgTypeURLs: make(map[string]bool, len(msgTypeURLs)),
+	}
+	for _, typeURL := range msgTypeURLs {
+		policy.msgTypeURLs[typeURL] = true
+	}
+
+	return policy
+}
+
+// TxPriority implements sdk.TxPriorityHandler.
+func (p *TxPriorityPolicy) TxPriority(_ sdk.Context, tx sdk.Tx) int64 {
+	if p.isWhitelisted(tx) {
+		return int64(len(p.feeTiers)) + 1
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.GetGas() == 0 {
+		return 0
+	}
+
+	fee := feeTx.GetFee()
+	gas := sdk.NewDec(int64(feeTx.GetGas()))
+	var priority int64
+	for i, gasPrices := range p.feeTiers {
+		requiredFees := make(sdk.Coins, len(gasPrices))
+		for j, gp := range gasPrices {
+			requiredFees[j] = sdk.NewCoin(gp.Denom, gp.Amount.Mul(gas).Ceil().RoundInt())
+		}
+		if fee.IsAnyGTE(requiredFees) {
+			priority = int64(i) + 1
+		}
+	}
+
+	return priority
+}
+
+// isWhitelisted returns true if all the messages of the tx, which must have at
+// least one, are of the whitelisted types.
+func (p *TxPriorityPolicy) isWhitelisted(tx sdk.Tx) bool {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return false
+	}
+
+	for _, msg := range msgs {
+		if !p.msgTypeURLs[sdk.MsgTypeURL(msg)] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// maxTxPriorities is the default maximum number of priorities recorded by
+// CheckTx, above the default size of the Tendermint mempool.
+const maxTxPriorities = 10000
+
+// txPriorities records the priorities assigned by CheckTx to the transactions
+// of the mempool, by hash. The transactions are forgotten once delivered or
+// rejected by a recheck, and the ones neither checked nor rechecked since the
+// last two commits, e.g. evicted from the mempool, are dropped.
+//
+// At most limit priorities are recorded: when full, the transactions not
+// checked since the last commit are dropped first, and then the new ones are
+// not recorded, their priority being computed again when they are proposed.
+type txPriorities struct {
+	mtx      sync.Mutex
+	limit    int
+	current  map[string]int64
+	previous map[string]int64
+}
+
+func newTxPriorities(limit int) *txPriorities {
+	return &txPriorities{limit: limit, current: map[string]int64{}, previous: map[string]int64{}}
+}
+
+func (p *txPriorities) set(txBytes []byte, priority int64) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	key := string(tmhash.Sum(txBytes))
+	if _, ok := p.current[key]; !ok && len(p.current)+len(p.previous) >= p.limit {
+		p.previous = map[string]int64{}
+		if len(p.current) >= p.limit {
+			return
+		}
+	}
+
+	p.current[key] = priority
+}
+
+func (p *txPriorities) get(txBytes []byte) (int64, bool) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	key := string(tmhash.Sum(txBytes))
+	if priority, ok := p.current[key]; ok {
+		return priority, true
+	}
+	priority, ok := p.previous[key]
+	return priority, ok
+}
+
+func (p *txPriorities) delete(txBytes []byte) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	key := string(tmhash.Sum(txBytes))
+	delete(p.current, key)
+	delete(p.previous, key)
+}
+
+// rotate is called on commit, before the mempool is rechecked.
+func (p *txPriorities) rotate() {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.previous = p.current
+	p.current = map[string]int64{}
+}
+
+// sortTxsByPriority orders the given proposal transactions by decreasing
+// priority, keeping the order of the transactions of the same priority. The
+// priority of a transaction which was not checked by this node is computed
+// against the check state, and is 0 if it cannot be decoded.
+func (app *BaseApp) sortTxsByPriority(txs [][]byte) [][]byte {
+	if app.txPriority == nil {
+		return txs
+	}
+
+	type prioritizedTx struct {
+		txBytes  []byte
+		priority int64
+	}
+	prioritized := make([]prioritizedTx, len(txs))
+	for i, txBytes := range txs {
+		prioritized[i] = prioritizedTx{txBytes: txBytes, priority: app.txPriorityOf(txBytes)}
+	}
+	sort.SliceStable(prioritized, func(i, j int) bool {
+		return prioritized[i].priority > prioritized[j].priority
+	})
+
+	sorted := make([][]byte, len(txs))
+	for i, tx := range prioritized {
+		sorted[i] = tx.txBytes
+	}
+
+	return sorted
+}
+
+// txPriorityOf returns the priority assigned to the tx by CheckTx, or else
+// computes it.
+func (app *BaseApp) txPriorityOf(txBytes []byte) (priority int64) {
+	if priority, ok := app.txPriorities.get(txBytes); ok {
+		return priority
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			app.logger.Error("panic recovered in the tx priority handler", "panic", r)
+			priority = 0
+		}
+	}()
+
+	tx, err := app.decodeTx(txBytes)
+	if err != nil || app.checkState == nil {
+		return 0
+	}
+
+	return app.txPriority(app.checkState.ctx, tx)
+}
@@ -0,0 +1,208 @@
+package baseapp
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// priorityTestTx is a tx of the priority tests, decoded from bytes holding a
+// lane test tx and its fee, e.g. "other:100:300stake".
+type priorityTestTx struct {
+	laneTestTx
+	fee sdk.Coins
+}
+
+func (tx priorityTestTx) GetFee() sdk.Coins { return tx.fee }
+
+func priorityTestTxDecoder(txBytes []byte) (sdk.Tx, error) {
+	i := strings.LastIndex(string(txBytes), ":")
+	if i < 0 {
+		return laneTestTxDecoder(txBytes)
+	}
+	tx, err := laneTestTxDecoder(txBytes[:i])
+	if err != nil {
+		return nil, err
+	}
+	fee, err := sdk.ParseCoinsNormalized(string(txBytes[i+1:]))
+	if err != nil {
+		return nil, err
+	}
+
+	return priorityTestTx{laneTestTx: tx.(laneTestTx), fee: fee}, nil
+}
+
+func newTestTxPriorityPolicy() *TxPriorityPolicy {
+	return NewTxPriorityPolicy([]sdk.DecCoins{
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(5, 1))),
+		sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 2), sdk.NewInt64DecCoin("atom", 1)),
+		sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 10)),
+	}, sdk.MsgTypeURL(testdata.NewTestMsg()))
+}
+
+func TestTxPriorityPolicy(t *testing.T) {
+	policy := newTestTxPriorityPolicy()
+
+	testCases := []struct {
+		tx       string
+		priority int64
+	}{
+		{"other:100:", 0},
+		{"other:100:49stake", 0},
+		{"other:0:100stake", 0},
+		{"other:100:50stake", 1},
+		// the fee of a fractional gas price is rounded up
+		{"other:101:50stake", 0},
+		{"other:101:51stake", 1},
+		{"other:100:199stake", 1},
+		{"other:100:200stake", 2},
+		{"other:100:100atom", 2},
+		{"other:100:1000stake", 3},
+		{"other:100:1000stake,1atom", 3},
+		{"mixed:100:200stake", 2},
+		// the whitelisted txs are above all the tiers, whatever their fee
+		{"lane:100:", 4},
+		{"lane:0:", 4},
+		{"empty:0:1000stake", 0},
+	}
+	for _, tc := range testCases {
+		tx, err := priorityTestTxDecoder([]byte(tc.tx))
+		require.NoError(t, err)
+		require.Equal(t, tc.priority, policy.TxPriority(sdk.Context{}, tx), tc.tx)
+	}
+}
+
+func TestPrepareProposalTxPriority(t *testing.T) {
+	txs := [][]byte{
+		[]byte("other:100:50stake"), []byte("invalid"), []byte("other:100:1000stake"),
+		[]byte("lane:40:"), []byte("other:100:200stake"), []byte("mixed:100:"),
+	}
+
+	// the txs are ordered by decreasing priority, keeping the order of the
+	// txs of the same priority, and the lane txs are moved to the top
+	app := setupBaseApp(t, func(app *BaseApp) {
+		app.txDecoder = priorityTestTxDecoder
+		app.SetTxPriority(newTestTxPriorityPolicy().TxPriority)
+	})
+	res := app.PrepareProposal(sdk.RequestPrepareProposal{Txs: txs})
+	require.Equal(t, [][]byte{
+		[]byte("lane:40:"), []byte("other:100:1000stake"), []byte("other:100:200stake"),
+		[]byte("other:100:50stake"), []byte("invalid"), []byte("mixed:100:"),
+	}, res.Txs)
+
+	app = setupBaseApp(t, func(app *BaseApp) {
+		app.txDecoder = priorityTestTxDecoder
+		app.SetTxPriority(newTestTxPriorityPolicy().TxPriority)
+		app.SetPriorityLane(NewPriorityLane(30, sdk.MsgTypeURL(&testdata.MsgCreateDog{})))
+	})
+	res = app.PrepareProposal(sdk.RequestPrepareProposal{Txs: append(txs, []byte("other:20:"))})
+	require.Equal(t, [][]byte{
+		[]byte("other:20:"), []byte("lane:40:"), []byte("other:100:1000stake"), []byte("other:100:200stake"),
+		[]byte("other:100:50stake"), []byte("invalid"), []byte("mixed:100:"),
+	}, res.Txs)
+}
+
+func TestCheckTxPriority(t *testing.T) {
+	var (
+		priority int64
+		reject   bool
+	)
+	app := setupBaseApp(t, func(app *BaseApp) {
+		app.txDecoder = priorityTestTxDecoder
+		app.SetAnteHandler(func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+			if reject {
+				return ctx, errors.New("rejected")
+			}
+			return ctx, nil
+		})
+		app.SetTxPriority(func(sdk.Context, sdk.Tx) int64 { return priority })
+	})
+	app.InitChain(abci.RequestInitChain{})
+
+	checkTx := func(tx string, typ abci.CheckTxType) abci.ResponseCheckTx {
+		return app.CheckTx(abci.RequestCheckTx{Tx: []byte(tx), Type: typ})
+	}
+	recorded := func(tx string) bool {
+		_, ok := app.txPriorities.get([]byte(tx))
+		return ok
+	}
+	commit := func() {
+		header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+		app.Commit()
+	}
+
+	priority = 2
+	require.True(t, checkTx("lane:10:", abci.CheckTxType_New).IsOK())
+	priority = 1
+	require.True(t, checkTx("other:20:", abci.CheckTxType_New).IsOK())
+	reject = true
+	require.False(t, checkTx("other:30:", abci.CheckTxType_New).IsOK())
+	require.False(t, recorded("other:30:"))
+	reject = false
+
+	// the priorities assigned by CheckTx are used over the ones computed at
+	// the time of the proposal
+	priority = 0
+	res := app.PrepareProposal(sdk.RequestPrepareProposal{
+		Txs: [][]byte{[]byte("other:20:"), []byte("other:30:"), []byte("lane:10:")},
+	})
+	require.Equal(t, [][]byte{[]byte("lane:10:"), []byte("other:20:"), []byte("other:30:")}, res.Txs)
+
+	// the delivered txs are forgotten
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	app.DeliverTx(abci.RequestDeliverTx{Tx: []byte("lane:10:")})
+	require.False(t, recorded("lane:10:"))
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	// the priorities of the txs left in the mempool survive a commit, but
+	// the txs neither checked nor rechecked since two commits are dropped
+	require.True(t, recorded("other:20:"))
+	commit()
+	require.False(t, recorded("other:20:"))
+
+	// the priorities are updated by the rechecks, and the txs rejected by a
+	// recheck are forgotten
+	priority = 3
+	require.True(t, checkTx("other:20:", abci.CheckTxType_Recheck).IsOK())
+	commit()
+	p, ok := app.txPriorities.get([]byte("other:20:"))
+	require.True(t, ok)
+	require.Equal(t, int64(3), p)
+	reject = true
+	require.False(t, checkTx("other:20:", abci.CheckTxType_Recheck).IsOK())
+	require.False(t, recorded("other:20:"))
+}
+
+func TestTxPrioritiesLimit(t *testing.T) {
+	p := newTxPriorities(2)
+	p.set([]byte("a"), 1)
+	p.rotate()
+	p.set([]byte("b"), 2)
+
+	// the txs not checked since the last commit are dropped first
+	p.set([]byte("c"), 3)
+	_, ok := p.get([]byte("a"))
+	require.False(t, ok)
+
+	// the recorded txs are still updated when full, but the new ones are not
+	// recorded
+	p.set([]byte("b"), 4)
+	p.set([]byte("d"), 5)
+	priority, ok := p.get([]byte("b"))
+	require.True(t, ok)
+	require.Equal(t, int64(4), priority)
+	_, ok = p.get([]byte("d"))
+	require.False(t, ok)
+	_, ok = p.get([]byte("c"))
+	require.True(t, ok)
+}
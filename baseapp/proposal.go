@@ -0,0 +1,105 @@
+package baseapp
+
+import (
+	"time"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PrepareProposal routes the candidate transactions of a block proposed by this
+// node to the PrepareProposalHandler, if any. The handler runs against a branch
+// of the last committed state which is discarded afterwards. The transactions
+// are then ordered by their priority, if there is a tx priority handler, the
+// ones of the priority lane, if any, are moved to the top of the proposal, and
+// the returned transactions are always capped to req.MaxTxBytes. If the handler
+// panics the candidate transactions are proposed unchanged, apart from their
+// priority order and the priority lane.
+//
+// NOTE: Tendermint v0.34 does not call the application while building a
+// proposal; PrepareProposal is meant to be invoked by the ABCI++ adapter of
+// newer consensus engines.
+func (app *BaseApp) PrepareProposal(req sdk.RequestPrepareProposal) (res sdk.ResponsePrepareProposal) {
+	defer telemetry.MeasureSince(time.Now(), "abci", "prepare_proposal")
+
+	if app.prepareProposal == nil {
+		return sdk.ResponsePrepareProposal{Txs: capTxBytes(app.prioritizeTxs(req.Txs), req.MaxTxBytes)}
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			app.logger.Error("panic recovered in PrepareProposal", "height", req.Height, "panic", r)
+			res = sdk.ResponsePrepareProposal{Txs: capTxBytes(app.prioritizeTxs(req.Txs), req.MaxTxBytes)}
+		}
+	}()
+
+	ctx := app.proposalContext(req.Height, req.Time, req.ProposerAddress, nil)
+	res = app.prepareProposal(ctx, req)
+	res.Txs = capTxBytes(app.prioritizeTxs(res.Txs), req.MaxTxBytes)
+
+	return res
+}
+
+// ProcessProposal routes a block proposed by another validator to the
+// ProcessProposalHandler, if any. The handler runs against a branch of the last
+// committed state which is discarded afterwards. A proposal is accepted if no
+// handler is set and rejected if the handler panics.
+//
+// NOTE: Tendermint v0.34 does not call the application to validate proposals;
+// ProcessProposal is meant to be invoked by the ABCI++ adapter of newer
+// consensus engines.
+func (app *BaseApp) ProcessProposal(req sdk.RequestProcessProposal) (res sdk.ResponseProcessProposal) {
+	defer telemetry.MeasureSince(time.Now(), "abci", "process_proposal")
+
+	if app.processProposal == nil {
+		return sdk.ResponseProcessProposal{Status: sdk.ProposalStatusAccept}
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			app.logger.Error("panic recovered in ProcessProposal", "height", req.Height, "panic", r)
+			res = sdk.ResponseProcessProposal{Status: sdk.ProposalStatusReject}
+		}
+	}()
+
+	ctx := app.proposalContext(req.Height, req.Time, req.ProposerAddress, req.Hash)
+	return app.processProposal(ctx, req)
+}
+
+// proposalContext returns a context for proposal handlers, backed by a branch
+// of the last committed state.
+func (app *BaseApp) proposalContext(height int64, t time.Time, proposer, hash []byte) sdk.Context {
+	header := tmproto.Header{
+		Height:          height,
+		Time:            t,
+		ProposerAddress: proposer,
+	}
+	if app.checkState != nil {
+		header.ChainID = app.checkState.ctx.ChainID()
+	}
+
+	ctx := sdk.NewContext(app.cms.CacheMultiStore(), header, false, app.logger).
+		WithHeaderHash(hash)
+
+	return ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+}
+
+// capTxBytes returns the longest prefix of txs whose total size does not exceed
+// maxTxBytes. A non-positive maxTxBytes places no limit.
+func capTxBytes(txs [][]byte, maxTxBytes int64) [][]byte {
+	if maxTxBytes <= 0 {
+		return txs
+	}
+
+	var total int64
+	for i, tx := range txs {
+		total += int64(len(tx))
+		if total > maxTxBytes {
+			return txs[:i]
+		}
+	}
+
+	return txs
+}
@@ -0,0 +1,168 @@
+package baseapp
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestPrepareProposal(t *testing.T) {
+	txs := [][]byte{[]byte("aa"), []byte("bbb"), []byte("c")}
+
+	// without a handler the candidate txs are capped to MaxTxBytes
+	app := setupBaseApp(t)
+	res := app.PrepareProposal(sdk.RequestPrepareProposal{Txs: txs, MaxTxBytes: 5})
+	require.Equal(t, txs[:2], res.Txs)
+
+	// the handler sees the committed state of the app
+	app = setupBaseApp(t, func(app *BaseApp) {
+		app.SetPrepareProposal(func(ctx sdk.Context, req sdk.RequestPrepareProposal) sdk.ResponsePrepareProposal {
+			require.Equal(t, int64(2), ctx.BlockHeight())
+			require.Equal(t, []byte("value"), ctx.KVStore(capKey1).Get([]byte("key")))
+			// writes must be discarded
+			ctx.KVStore(capKey1).Set([]byte("key"), []byte("overwritten"))
+
+			reversed := make([][]byte, len(req.Txs))
+			for i, tx := range req.Txs {
+				reversed[len(req.Txs)-1-i] = tx
+			}
+			return sdk.ResponsePrepareProposal{Txs: reversed}
+		})
+	})
+	app.InitChain(abci.RequestInitChain{})
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	app.deliverState.ctx.KVStore(capKey1).Set([]byte("key"), []byte("value"))
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	res = app.PrepareProposal(sdk.RequestPrepareProposal{Txs: txs, Height: 2, MaxTxBytes: 4})
+	require.Equal(t, [][]byte{[]byte("c"), []byte("bbb")}, res.Txs)
+	require.Equal(t, []byte("value"), app.checkState.ctx.KVStore(capKey1).Get([]byte("key")))
+
+	// a panicking handler falls back to the candidate txs
+	app = setupBaseApp(t, func(app *BaseApp) {
+		app.SetPrepareProposal(func(sdk.Context, sdk.RequestPrepareProposal) sdk.ResponsePrepareProposal {
+			panic("boom")
+		})
+	})
+	res = app.PrepareProposal(sdk.RequestPrepareProposal{Txs: txs})
+	require.Equal(t, txs, res.Txs)
+}
+
+func TestProcessProposal(t *testing.T) {
+	app := setupBaseApp(t)
+	require.True(t, app.ProcessProposal(sdk.RequestProcessProposal{}).IsAccepted())
+
+	app = setupBaseApp(t, func(app *BaseApp) {
+		app.SetProcessProposal(func(_ sdk.Context, req sdk.RequestProcessProposal) sdk.ResponseProcessProposal {
+			if len(req.Txs) > 1 {
+				return sdk.ResponseProcessProposal{Status: sdk.ProposalStatusReject}
+			}
+			return sdk.ResponseProcessProposal{Status: sdk.ProposalStatusAccept}
+		})
+	})
+	require.True(t, app.ProcessProposal(sdk.RequestProcessProposal{Txs: [][]byte{{1}}}).IsAccepted())
+	require.False(t, app.ProcessProposal(sdk.RequestProcessProposal{Txs: [][]byte{{1}, {2}}}).IsAccepted())
+
+	app = setupBaseApp(t, func(app *BaseApp) {
+		app.SetProcessProposal(func(sdk.Context, sdk.RequestProcessProposal) sdk.ResponseProcessProposal {
+			panic("boom")
+		})
+	})
+	require.Equal(t, sdk.ProposalStatusReject, app.ProcessProposal(sdk.RequestProcessProposal{}).Status)
+}
+
+// laneTestTx is a tx of the priority lane tests, decoded from bytes holding the
+// kind of its msgs and its gas limit, e.g. "lane:100".
+type laneTestTx struct {
+	msgs []sdk.Msg
+	gas  uint64
+}
+
+func (tx laneTestTx) GetMsgs() []sdk.Msg         { return tx.msgs }
+func (tx laneTestTx) ValidateBasic() error       { return nil }
+func (tx laneTestTx) GetGas() uint64             { return tx.gas }
+func (tx laneTestTx) GetFee() sdk.Coins          { return nil }
+func (tx laneTestTx) FeePayer() sdk.AccAddress   { return nil }
+func (tx laneTestTx) FeeGranter() sdk.AccAddress { return nil }
+
+func laneTestTxDecoder(txBytes []byte) (sdk.Tx, error) {
+	parts := strings.SplitN(string(txBytes), ":", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid test tx %s", txBytes)
+	}
+	gas, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	laneMsg, otherMsg := testdata.NewTestMsg(), &testdata.MsgCreateDog{}
+	var msgs []sdk.Msg
+	switch parts[0] {
+	case "lane":
+		msgs = []sdk.Msg{laneMsg, laneMsg}
+	case "other":
+		msgs = []sdk.Msg{otherMsg}
+	case "mixed":
+		msgs = []sdk.Msg{laneMsg, otherMsg}
+	case "empty":
+	default:
+		return nil, fmt.Errorf("invalid test tx kind %s", parts[0])
+	}
+
+	return laneTestTx{msgs: msgs, gas: gas}, nil
+}
+
+func TestPrepareProposalPriorityLane(t *testing.T) {
+	txs := [][]byte{
+		[]byte("other:10"), []byte("lane:40"), []byte("mixed:10"), []byte("invalid"),
+		[]byte("lane:70"), []byte("empty:0"), []byte("lane:60"), []byte("other:20"),
+	}
+
+	lane := NewPriorityLane(100, sdk.MsgTypeURL(testdata.NewTestMsg()))
+	require.True(t, lane.Contains(laneTestTx{msgs: []sdk.Msg{testdata.NewTestMsg()}}))
+	require.False(t, lane.Contains(laneTestTx{msgs: []sdk.Msg{testdata.NewTestMsg(), &testdata.MsgCreateDog{}}}))
+	require.False(t, lane.Contains(laneTestTx{}))
+
+	// the lane txs fitting in the budget are moved to the top, the others keep
+	// their order
+	app := setupBaseApp(t, func(app *BaseApp) {
+		app.txDecoder = laneTestTxDecoder
+		app.SetPriorityLane(lane)
+	})
+	res := app.PrepareProposal(sdk.RequestPrepareProposal{Txs: txs})
+	require.Equal(t, [][]byte{
+		[]byte("lane:40"), []byte("lane:60"),
+		[]byte("other:10"), []byte("mixed:10"), []byte("invalid"), []byte("lane:70"), []byte("empty:0"), []byte("other:20"),
+	}, res.Txs)
+
+	// the lane txs are kept when the proposal is capped
+	res = app.PrepareProposal(sdk.RequestPrepareProposal{Txs: txs, MaxTxBytes: 16})
+	require.Equal(t, [][]byte{[]byte("lane:40"), []byte("lane:60")}, res.Txs)
+
+	// the lane applies to the txs returned by the handler, and to the
+	// candidate txs if it panics
+	app = setupBaseApp(t, func(app *BaseApp) {
+		app.txDecoder = laneTestTxDecoder
+		app.SetPriorityLane(lane)
+		app.SetPrepareProposal(func(_ sdk.Context, req sdk.RequestPrepareProposal) sdk.ResponsePrepareProposal {
+			if len(req.Txs) == 4 {
+				panic("boom")
+			}
+			return sdk.ResponsePrepareProposal{Txs: req.Txs[4:]}
+		})
+	})
+	res = app.PrepareProposal(sdk.RequestPrepareProposal{Txs: txs})
+	require.Equal(t, [][]byte{[]byte("lane:70"), []byte("empty:0"), []byte("lane:60"), []byte("other:20")}, res.Txs)
+
+	res = app.PrepareProposal(sdk.RequestPrepareProposal{Txs: txs[:4]})
+	require.Equal(t, [][]byte{[]byte("lane:40"), []byte("other:10"), []byte("mixed:10"), []byte("invalid")}, res.Txs)
+}
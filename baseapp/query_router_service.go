@@ -0,0 +1,86 @@
+package baseapp
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// QueryRouterService lets modules query other modules during DeliverTx
+// through the GRPCQueryRouter without depending on their keepers.
+//
+// Since query handlers are not required to be deterministic in the gas they
+// consume, only query methods explicitly whitelisted with a fixed gas cost
+// may be called. Queries always execute against the calling context's state
+// on a discarded cache, so they can neither target another height nor write
+// state.
+type QueryRouterService struct {
+	router    *GRPCQueryRouter
+	whitelist map[string]sdk.Gas
+}
+
+var _ query.Router = &QueryRouterService{}
+
+// NewQueryRouterService returns a QueryRouterService routing queries through
+// the given GRPCQueryRouter, with no whitelisted query methods.
+func NewQueryRouterService(router *GRPCQueryRouter) *QueryRouterService {
+	return &QueryRouterService{
+		router:    router,
+		whitelist: map[string]sdk.Gas{},
+	}
+}
+
+// WhitelistQuery allows modules to call the query method with the given
+// fully-qualified name, charging gasCost for every call. The method must be
+// deterministic, i.e. its response only depends on the request and the state.
+//
+// This function PANICS if the method is already whitelisted.
+func (s *QueryRouterService) WhitelistQuery(method string, gasCost sdk.Gas) {
+	if _, found := s.whitelist[method]; found {
+		panic(fmt.Errorf("query method %s is already whitelisted", method))
+	}
+
+	s.whitelist[method] = gasCost
+}
+
+// IsWhitelisted returns whether the given query method may be called.
+func (s *QueryRouterService) IsWhitelisted(method string) bool {
+	_, found := s.whitelist[method]
+	return found
+}
+
+// Query implements query.Router.
+func (s *QueryRouterService) Query(ctx sdk.Context, method string, req, res proto.Message) error {
+	gasCost, found := s.whitelist[method]
+	if !found {
+		return sdkerrors.ErrUnauthorized.Wrapf("query method %s is not whitelisted", method)
+	}
+
+	handler := s.router.Route(method)
+	if handler == nil {
+		return sdkerrors.ErrUnknownRequest.Wrapf("unrecognized query route: %s", method)
+	}
+
+	// the fixed cost is charged upfront, so failing queries are charged as well
+	ctx.GasMeter().ConsumeGas(gasCost, "query router "+method)
+
+	reqBz, err := protoCodec.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	// run on a discarded cache with its own gas meter, so the query can neither
+	// write state nor charge a non-deterministic amount of gas
+	cacheCtx, _ := ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).CacheContext()
+	resp, err := handler(cacheCtx, abci.RequestQuery{Path: method, Data: reqBz})
+	if err != nil {
+		return err
+	}
+
+	return protoCodec.Unmarshal(resp.Value, res)
+}
@@ -0,0 +1,72 @@
+package baseapp_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var queryRouterTestKey = sdk.NewKVStoreKey("queryrouter")
+
+// gasWritingQueryServer consumes gas and writes state from within Echo.
+type gasWritingQueryServer struct {
+	testdata.QueryImpl
+}
+
+func (gasWritingQueryServer) Echo(goCtx context.Context, req *testdata.EchoRequest) (*testdata.EchoResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx.GasMeter().ConsumeGas(uint64(len(req.Message))*100, "echo")
+	ctx.KVStore(queryRouterTestKey).Set([]byte("echo"), []byte(req.Message))
+
+	return &testdata.EchoResponse{Message: req.Message}, nil
+}
+
+func TestQueryRouterService(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	app := baseapp.NewBaseApp("test", log.NewTMLogger(log.NewSyncWriter(os.Stdout)), dbm.NewMemDB(), encCfg.TxConfig.TxDecoder())
+	app.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+	app.MountStores(queryRouterTestKey)
+	testdata.RegisterQueryServer(app.GRPCQueryRouter(), gasWritingQueryServer{})
+	require.NoError(t, app.LoadLatestVersion())
+
+	service := baseapp.NewQueryRouterService(app.GRPCQueryRouter())
+	service.WhitelistQuery("/testdata.Query/Echo", 500)
+	service.WhitelistQuery("/testdata.Query/Unknown", 500)
+	require.Panics(t, func() { service.WhitelistQuery("/testdata.Query/Echo", 1000) })
+	require.True(t, service.IsWhitelisted("/testdata.Query/Echo"))
+	require.False(t, service.IsWhitelisted("/testdata.Query/SayHello"))
+
+	ctx := app.NewUncachedContext(false, tmproto.Header{}).WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	// the fixed gas cost is charged whatever the message length
+	for _, msg := range []string{"hi", "a much longer message"} {
+		var res testdata.EchoResponse
+		before := ctx.GasMeter().GasConsumed()
+		require.NoError(t, service.Query(ctx, "/testdata.Query/Echo", &testdata.EchoRequest{Message: msg}, &res))
+		require.Equal(t, msg, res.Message)
+		require.Equal(t, sdk.Gas(500), ctx.GasMeter().GasConsumed()-before)
+	}
+
+	// writes of the query handler are discarded
+	require.Nil(t, ctx.KVStore(queryRouterTestKey).Get([]byte("echo")))
+
+	var res testdata.SayHelloResponse
+	err := service.Query(ctx, "/testdata.Query/SayHello", &testdata.SayHelloRequest{Name: "foo"}, &res)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	err = service.Query(ctx, "/testdata.Query/Unknown", &testdata.SayHelloRequest{Name: "foo"}, &res)
+	require.ErrorIs(t, err, sdkerrors.ErrUnknownRequest)
+}
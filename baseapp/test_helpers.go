@@ -1,6 +1,7 @@
 package baseapp
 
 import (
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -24,6 +25,104 @@ func (app *BaseApp) Simulate(txBytes []byte) (sdk.GasInfo, *sdk.Result, error) {
 	return gasInfo, result, err
 }
 
+// SimulateBundle simulates executing the given transactions in order, each on
+// top of the state changes of the previous ones, against a branch of the
+// current check state which is discarded afterwards. It returns the gas info
+// and result of every transaction, or an error for the first failing one.
+func (app *BaseApp) SimulateBundle(txsBytes [][]byte) ([]sdk.GasInfo, []*sdk.Result, error) {
+	ctx := app.getContextForTx(runTxModeSimulate, nil)
+
+	gasInfos := make([]sdk.GasInfo, 0, len(txsBytes))
+	results := make([]*sdk.Result, 0, len(txsBytes))
+	for i, txBytes := range txsBytes {
+		txCtx := ctx.
+			WithTxBytes(txBytes).
+			WithGasMeter(sdk.NewInfiniteGasMeter()).
+			WithEventManager(sdk.NewEventManager())
+
+		gasInfo, result, _, err := app.runTxWithContext(txCtx, runTxModeSimulate, txBytes)
+		if err != nil {
+			return nil, nil, sdkerrors.Wrapf(err, "tx %d", i)
+		}
+
+		gasInfos = append(gasInfos, gasInfo)
+		results = append(results, result)
+	}
+
+	return gasInfos, results, nil
+}
+
+// EstimateGas simulates executing the given transaction like Simulate, and
+// returns the gas used by its AnteHandler and by each of its messages besides
+// its gas info and result.
+func (app *BaseApp) EstimateGas(txBytes []byte) (sdk.GasInfo, *sdk.Result, sdk.GasBreakdown, error) {
+	var breakdown sdk.GasBreakdown
+	ctx := sdk.WithGasBreakdown(app.getContextForTx(runTxModeSimulate, txBytes), &breakdown)
+
+	gasInfo, result, _, err := app.runTxWithContext(ctx, runTxModeSimulate, txBytes)
+	if err != nil {
+		return gasInfo, nil, sdk.GasBreakdown{}, err
+	}
+
+	return gasInfo, result, breakdown, nil
+}
+
+// TraceTx re-executes the transaction at the given index of the given
+// transactions of the block of the given header, against a branch of the state
+// committed at the previous height on which the transactions before it are
+// executed first, and returns its response and trace. The state changes of the
+// BeginBlock of the block are not replayed. A failing transaction is traced up
+// to its failure, reported by the code and log of its response.
+func (app *BaseApp) TraceTx(header tmproto.Header, txsBytes [][]byte, index int) (abci.ResponseDeliverTx, sdk.TxTrace, error) {
+	if header.Height <= 1 || header.Height > app.LastBlockHeight() {
+		return abci.ResponseDeliverTx{}, sdk.TxTrace{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidHeight, "cannot trace a tx at height %d", header.Height)
+	}
+	if index < 0 || index >= len(txsBytes) {
+		return abci.ResponseDeliverTx{}, sdk.TxTrace{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "tx index %d out of range", index)
+	}
+
+	cacheMS, err := app.cms.CacheMultiStoreWithVersion(header.Height - 1)
+	if err != nil {
+		return abci.ResponseDeliverTx{}, sdk.TxTrace{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to load state at height %d; %s", header.Height-1, err)
+	}
+
+	ctx := sdk.NewContext(cacheMS, header, false, app.logger).
+		WithBlockGasMeter(sdk.NewInfiniteGasMeter())
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+
+	newTxCtx := func(txBytes []byte) sdk.Context {
+		return ctx.
+			WithTxBytes(txBytes).
+			WithGasMeter(sdk.NewInfiniteGasMeter()).
+			WithEventManager(sdk.NewEventManager())
+	}
+
+	// the previous txs which failed did so in the block too
+	for _, txBytes := range txsBytes[:index] {
+		app.runTxWithContext(newTxCtx(txBytes), runTxModeDeliver, txBytes)
+	}
+
+	var (
+		breakdown sdk.GasBreakdown
+		trace     sdk.TxTrace
+	)
+	txCtx := sdk.WithTxTrace(sdk.WithGasBreakdown(newTxCtx(txsBytes[index]), &breakdown), &trace)
+
+	gInfo, result, anteEvents, err := app.runTxWithContext(txCtx, runTxModeDeliver, txsBytes[index])
+	trace.AnteGas = breakdown.AnteGas
+	if err != nil {
+		return sdkerrors.ResponseDeliverTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, anteEvents, false), trace, nil
+	}
+
+	return abci.ResponseDeliverTx{
+		GasWanted: int64(gInfo.GasWanted),
+		GasUsed:   int64(gInfo.GasUsed),
+		Log:       result.Log,
+		Data:      result.Data,
+		Events:    result.Events,
+	}, trace, nil
+}
+
 func (app *BaseApp) Deliver(txEncoder sdk.TxEncoder, tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error) {
 	// See comment for Check().
 	bz, err := txEncoder(tx)
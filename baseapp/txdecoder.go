@@ -0,0 +1,69 @@
+package baseapp
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TxFormatMatcher reports whether raw transaction bytes are encoded in the
+// format understood by a registered tx decoder. Matchers must be cheap and
+// deterministic as they run on every transaction.
+type TxFormatMatcher func(txBytes []byte) bool
+
+// PrefixMatcher returns a TxFormatMatcher matching transactions starting with
+// the given prefix, e.g. a magic byte sequence identifying the encoding.
+func PrefixMatcher(prefix []byte) TxFormatMatcher {
+	return func(txBytes []byte) bool {
+		return bytes.HasPrefix(txBytes, prefix)
+	}
+}
+
+// txDecoderRoute associates a transaction format with its decoder.
+type txDecoderRoute struct {
+	name    string
+	match   TxFormatMatcher
+	decoder sdk.TxDecoder
+}
+
+// RegisterTxDecoder registers an alternative decoder for transactions matched
+// by the given matcher (e.g. Ethereum RLP-wrapped or JSON encoded txs). Raw
+// transactions are offered to the matchers in registration order before the
+// ante handler runs, and fall back to the BaseApp's default decoder if none
+// matches. It panics if the BaseApp is sealed or the name is already taken.
+func (app *BaseApp) RegisterTxDecoder(name string, match TxFormatMatcher, decoder sdk.TxDecoder) {
+	if app.sealed {
+		panic("RegisterTxDecoder() on sealed BaseApp")
+	}
+
+	for _, route := range app.txDecoderRoutes {
+		if route.name == name {
+			panic(fmt.Sprintf("tx decoder %s already registered", name))
+		}
+	}
+
+	app.txDecoderRoutes = append(app.txDecoderRoutes, txDecoderRoute{
+		name:    name,
+		match:   match,
+		decoder: decoder,
+	})
+}
+
+// TxDecoder returns the decoder used by the BaseApp, dispatching to the
+// registered alternative decoders before falling back to the default one.
+func (app *BaseApp) TxDecoder() sdk.TxDecoder {
+	return app.decodeTx
+}
+
+// decodeTx decodes raw transaction bytes with the decoder of the first
+// registered format matching them, or the default decoder otherwise.
+func (app *BaseApp) decodeTx(txBytes []byte) (sdk.Tx, error) {
+	for _, route := range app.txDecoderRoutes {
+		if route.match(txBytes) {
+			return route.decoder(txBytes)
+		}
+	}
+
+	return app.txDecoder(txBytes)
+}
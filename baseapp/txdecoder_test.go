@@ -0,0 +1,66 @@
+package baseapp
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// jsonTxPrefix marks txs encoded as {"counter": N}.
+var jsonTxPrefix = []byte("json:")
+
+func jsonTxDecoder(txBytes []byte) (sdk.Tx, error) {
+	var raw struct {
+		Counter int64 `json:"counter"`
+	}
+	if err := json.Unmarshal(txBytes[len(jsonTxPrefix):], &raw); err != nil {
+		return nil, err
+	}
+
+	return *newTxCounter(raw.Counter, raw.Counter), nil
+}
+
+func TestRegisterTxDecoder(t *testing.T) {
+	deliverKey := []byte("deliver-key")
+	app := setupBaseApp(t, func(bapp *BaseApp) {
+		bapp.RegisterTxDecoder("json", PrefixMatcher(jsonTxPrefix), jsonTxDecoder)
+		require.Panics(t, func() {
+			bapp.RegisterTxDecoder("json", PrefixMatcher([]byte("other")), jsonTxDecoder)
+		})
+
+		bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, []byte("ante-key")))
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey)))
+	})
+	require.Panics(t, func() {
+		app.RegisterTxDecoder("late", PrefixMatcher([]byte("late")), jsonTxDecoder)
+	})
+
+	app.InitChain(abci.RequestInitChain{})
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+
+	// txs of the registered format are decoded by the registered decoder
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: append(jsonTxPrefix, []byte(`{"counter":0}`)...)})
+	require.True(t, res.IsOK(), res.Log)
+
+	// other txs fall back to the default decoder
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+	txBytes, err := cdc.Marshal(newTxCounter(1, 1))
+	require.NoError(t, err)
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), res.Log)
+
+	// decoding errors of the registered decoder are not retried with the default one
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: append(jsonTxPrefix, []byte(`not json`)...)})
+	require.False(t, res.IsOK())
+
+	tx, err := app.TxDecoder()(append(jsonTxPrefix, []byte(`{"counter":7}`)...))
+	require.NoError(t, err)
+	require.Equal(t, int64(7), tx.(txTest).Counter)
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+// CompletionCacheTTL defines how long the values queried from the node to
+// complete the command line are cached in the home directory.
+var CompletionCacheTTL = 5 * time.Minute
+
+// completionCache is the content of a completion cache file.
+type completionCache struct {
+	Time   time.Time `json:"time"`
+	Values []string  `json:"values"`
+}
+
+// GetCompletionContext returns the Context of a command being completed,
+// updated with the persistent flags, e.g. the home directory, keyring and node,
+// typed on the command line so far.
+func GetCompletionContext(cmd *cobra.Command) (Context, error) {
+	return ReadPersistentCommandFlags(GetClientContextFromCmd(cmd), cmd.Flags())
+}
+
+// FilterCompletions returns the values starting with the given prefix.
+func FilterCompletions(values []string, prefix string) []string {
+	completions := make([]string, 0, len(values))
+	for _, value := range values {
+		if strings.HasPrefix(value, prefix) {
+			completions = append(completions, value)
+		}
+	}
+
+	return completions
+}
+
+// CachedCompletions returns the completion values cached under the given name
+// for the chain of the Context, calling fetch and caching its result when the
+// cache is missing or older than CompletionCacheTTL. The values are returned
+// uncached when the cache cannot be written.
+func CachedCompletions(clientCtx Context, name string, fetch func() ([]string, error)) ([]string, error) {
+	file := name + ".json"
+	if clientCtx.ChainID != "" {
+		file = clientCtx.ChainID + "-" + file
+	}
+	path := filepath.Join(clientCtx.HomeDir, "completions", file)
+
+	var cache completionCache
+	if bz, err := os.ReadFile(path); err == nil && json.Unmarshal(bz, &cache) == nil &&
+		time.Since(cache.Time) < CompletionCacheTTL {
+		return cache.Values, nil
+	}
+
+	values, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := json.Marshal(completionCache{Time: time.Now(), Values: values})
+	if err == nil && os.MkdirAll(filepath.Dir(path), 0o700) == nil {
+		_ = os.WriteFile(path, bz, 0o600)
+	}
+
+	return values, nil
+}
+
+// CompleteKeyNames is a cobra completion function completing the names of the
+// keys of the keyring.
+func CompleteKeyNames(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	clientCtx, err := GetCompletionContext(cmd)
+	if err != nil || clientCtx.Keyring == nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	infos, err := clientCtx.Keyring.List()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	names := make([]string, len(infos))
+	for i, info := range infos {
+		names[i] = info.GetName()
+	}
+
+	return FilterCompletions(names, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// RegisterKeyNameCompletions registers the completion of the key names for the
+// --from flag of the given command and all its subcommands.
+func RegisterKeyNameCompletions(cmd *cobra.Command) {
+	if cmd.Flags().Lookup(flags.FlagFrom) != nil {
+		// the flag may already have a completion function
+		_ = cmd.RegisterFlagCompletionFunc(flags.FlagFrom, CompleteKeyNames)
+	}
+
+	for _, child := range cmd.Commands() {
+		RegisterKeyNameCompletions(child)
+	}
+}
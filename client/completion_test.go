@@ -0,0 +1,86 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFilterCompletions(t *testing.T) {
+	values := []string{"alice", "albert", "bob"}
+	require.Equal(t, []string{"alice", "albert"}, client.FilterCompletions(values, "al"))
+	require.Equal(t, values, client.FilterCompletions(values, ""))
+	require.Empty(t, client.FilterCompletions(values, "c"))
+}
+
+func TestCachedCompletions(t *testing.T) {
+	clientCtx := client.Context{}.WithHomeDir(t.TempDir()).WithChainID("test-chain")
+
+	calls := 0
+	fetch := func() ([]string, error) {
+		calls++
+		return []string{"a", "b"}, nil
+	}
+
+	values, err := client.CachedCompletions(clientCtx, "values", fetch)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, values)
+
+	values, err = client.CachedCompletions(clientCtx, "values", fetch)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, values)
+	require.Equal(t, 1, calls)
+
+	// the cache is per chain
+	_, err = client.CachedCompletions(clientCtx.WithChainID("other-chain"), "values", fetch)
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+
+	// the cache expires
+	ttl := client.CompletionCacheTTL
+	client.CompletionCacheTTL = 0
+	defer func() { client.CompletionCacheTTL = ttl }()
+
+	_, err = client.CachedCompletions(clientCtx, "values", fetch)
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+
+	_, err = client.CachedCompletions(clientCtx, "values", func() ([]string, error) {
+		return nil, errors.New("node unavailable")
+	})
+	require.Error(t, err)
+}
+
+func TestRegisterKeyNameCompletions(t *testing.T) {
+	kr := keyring.NewInMemory()
+	path := sdk.GetConfig().GetFullFundraiserPath()
+	for _, name := range []string{"alice", "albert", "bob"} {
+		_, _, err := kr.NewMnemonic(name, keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+	}
+
+	root := &cobra.Command{Use: "root"}
+	send := &cobra.Command{Use: "send", RunE: func(*cobra.Command, []string) error { return nil }}
+	send.Flags().String(flags.FlagFrom, "", "")
+	root.AddCommand(send)
+	client.RegisterKeyNameCompletions(root)
+
+	out := new(bytes.Buffer)
+	root.SetOut(out)
+	root.SetErr(new(bytes.Buffer))
+	root.SetArgs([]string{cobra.ShellCompRequestCmd, "send", "--" + flags.FlagFrom, "al"})
+
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &client.Context{Keyring: kr})
+	require.NoError(t, root.ExecuteContext(ctx))
+	require.Equal(t, "albert\nalice\n:4\n", out.String())
+}
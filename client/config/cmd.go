@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"strconv"
+	"time"
 
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 
@@ -11,6 +13,15 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/i18n"
+)
+
+// The configuration keys of the keyring signing log and TSS signer, which have
+// no flag.
+const (
+	keyKeyringSigningLog  = "keyring-signing-log"
+	keyKeyringTSSEndpoint = "keyring-tss-endpoint"
+	keyKeyringTSSTimeout  = "keyring-tss-timeout"
 )
 
 // Cmd returns a CLI command to interactively create an application CLI
@@ -58,6 +69,14 @@ func runConfigCmd(cmd *cobra.Command, args []string) error {
 			cmd.Println(conf.Node)
 		case flags.FlagBroadcastMode:
 			cmd.Println(conf.BroadcastMode)
+		case flags.FlagLang:
+			cmd.Println(conf.Lang)
+		case keyKeyringSigningLog:
+			cmd.Println(conf.KeyringSigningLog)
+		case keyKeyringTSSEndpoint:
+			cmd.Println(conf.KeyringTSSEndpoint)
+		case keyKeyringTSSTimeout:
+			cmd.Println(conf.KeyringTSSTimeout)
 		default:
 			err := errUnknownConfigKey(key)
 			return fmt.Errorf("couldn't get the value for the key: %v, error:  %v", key, err)
@@ -78,6 +97,24 @@ func runConfigCmd(cmd *cobra.Command, args []string) error {
 			conf.SetNode(value)
 		case flags.FlagBroadcastMode:
 			conf.SetBroadcastMode(value)
+		case flags.FlagLang:
+			if err := i18n.ValidateLanguage(value); err != nil {
+				return err
+			}
+			conf.SetLang(value)
+		case keyKeyringSigningLog:
+			signingLog, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("invalid value for %s: %v", key, err)
+			}
+			conf.SetKeyringSigningLog(signingLog)
+		case keyKeyringTSSEndpoint:
+			conf.SetKeyringTSSEndpoint(value)
+		case keyKeyringTSSTimeout:
+			if timeout, err := time.ParseDuration(value); err != nil || timeout <= 0 {
+				return fmt.Errorf("invalid value for %s: must be a positive duration", key)
+			}
+			conf.SetKeyringTSSTimeout(value)
 		default:
 			return errUnknownConfigKey(key)
 		}
@@ -4,8 +4,12 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/i18n"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/tss"
 )
 
 // Default constants
@@ -15,6 +19,10 @@ const (
 	output         = "text"
 	node           = "tcp://localhost:26657"
 	broadcastMode  = "sync"
+	lang           = i18n.English
+	signingLog     = false
+	tssEndpoint    = ""
+	tssTimeout     = "30s"
 )
 
 type ClientConfig struct {
@@ -23,11 +31,20 @@ type ClientConfig struct {
 	Output         string `mapstructure:"output" json:"output"`
 	Node           string `mapstructure:"node" json:"node"`
 	BroadcastMode  string `mapstructure:"broadcast-mode" json:"broadcast-mode"`
+	Lang           string `mapstructure:"lang" json:"lang"`
+	// KeyringSigningLog enables the signing log of the keyring, which records
+	// every signature of the keys in the home directory.
+	KeyringSigningLog bool `mapstructure:"keyring-signing-log" json:"keyring-signing-log"`
+	// KeyringTSSEndpoint is the gRPC endpoint of the threshold signature signer
+	// of the TSS keys of the keyring, none if empty.
+	KeyringTSSEndpoint string `mapstructure:"keyring-tss-endpoint" json:"keyring-tss-endpoint"`
+	// KeyringTSSTimeout is the timeout of the requests to the TSS signer.
+	KeyringTSSTimeout string `mapstructure:"keyring-tss-timeout" json:"keyring-tss-timeout"`
 }
 
 // defaultClientConfig returns the reference to ClientConfig with default values.
 func defaultClientConfig() *ClientConfig {
-	return &ClientConfig{chainID, keyringBackend, output, node, broadcastMode}
+	return &ClientConfig{chainID, keyringBackend, output, node, broadcastMode, lang, signingLog, tssEndpoint, tssTimeout}
 }
 
 func (c *ClientConfig) SetChainID(chainID string) {
@@ -50,6 +67,22 @@ func (c *ClientConfig) SetBroadcastMode(broadcastMode string) {
 	c.BroadcastMode = broadcastMode
 }
 
+func (c *ClientConfig) SetLang(lang string) {
+	c.Lang = lang
+}
+
+func (c *ClientConfig) SetKeyringSigningLog(signingLog bool) {
+	c.KeyringSigningLog = signingLog
+}
+
+func (c *ClientConfig) SetKeyringTSSEndpoint(endpoint string) {
+	c.KeyringTSSEndpoint = endpoint
+}
+
+func (c *ClientConfig) SetKeyringTSSTimeout(timeout string) {
+	c.KeyringTSSTimeout = timeout
+}
+
 // ReadFromClientConfig reads values from client.toml file and updates them in client Context
 func ReadFromClientConfig(ctx client.Context) (client.Context, error) {
 	configPath := filepath.Join(ctx.HomeDir, "config")
@@ -71,17 +104,38 @@ func ReadFromClientConfig(ctx client.Context) (client.Context, error) {
 	if err != nil {
 		return ctx, fmt.Errorf("couldn't get client config: %v", err)
 	}
+	if err := i18n.SetLanguage(conf.Lang); err != nil {
+		return ctx, fmt.Errorf("couldn't set the CLI language: %v", err)
+	}
+
 	// we need to update KeyringDir field on Client Context first cause it is used in NewKeyringFromBackend
 	ctx = ctx.WithOutputFormat(conf.Output).
 		WithChainID(conf.ChainID).
 		WithKeyringDir(ctx.HomeDir)
+	if conf.KeyringSigningLog {
+		ctx = ctx.WithKeyringOptions(append(ctx.KeyringOptions, keyring.WithSigningLog(filepath.Join(ctx.HomeDir, keyring.SigningLogFileName)))...)
+	}
+	if conf.KeyringTSSEndpoint != "" {
+		// the client configs written before the TSS signer have no timeout
+		var timeout time.Duration
+		if conf.KeyringTSSTimeout != "" {
+			if timeout, err = time.ParseDuration(conf.KeyringTSSTimeout); err != nil {
+				return ctx, fmt.Errorf("invalid keyring-tss-timeout: %v", err)
+			}
+		}
+		signer, err := tss.NewClient(conf.KeyringTSSEndpoint, timeout)
+		if err != nil {
+			return ctx, fmt.Errorf("couldn't get the TSS signer: %v", err)
+		}
+		ctx = ctx.WithKeyringOptions(append(ctx.KeyringOptions, keyring.WithTSSSigner(signer))...)
+	}
 
-	keyring, err := client.NewKeyringFromBackend(ctx, conf.KeyringBackend)
+	kr, err := client.NewKeyringFromBackend(ctx, conf.KeyringBackend)
 	if err != nil {
 		return ctx, fmt.Errorf("couldn't get key ring: %v", err)
 	}
 
-	ctx = ctx.WithKeyring(keyring)
+	ctx = ctx.WithKeyring(kr)
 
 	// https://github.com/cosmos/cosmos-sdk/issues/8986
 	client, err := client.NewClientFromNode(conf.Node)
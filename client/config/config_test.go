@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -12,7 +13,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/config"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/i18n"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/client/cli"
 )
 
@@ -105,3 +110,56 @@ func TestConfigCmdEnvFlag(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigCmdLang(t *testing.T) {
+	clientCtx, cleanup := initClientContext(t, "")
+	defer cleanup()
+
+	cmd := config.Cmd()
+	_, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{flags.FlagLang, "fr"})
+	require.Error(t, err)
+
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{flags.FlagLang, i18n.SimplifiedChinese})
+	require.NoError(t, err)
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{flags.FlagLang})
+	require.NoError(t, err)
+	require.Equal(t, i18n.SimplifiedChinese+"\n", out.String())
+
+	// the language is set when reading the config
+	defer func() { require.NoError(t, i18n.SetLanguage(i18n.English)) }()
+	_, err = config.ReadFromClientConfig(clientCtx)
+	require.NoError(t, err)
+	require.Equal(t, i18n.SimplifiedChinese, i18n.Language())
+}
+
+func TestConfigCmdKeyringSigningLog(t *testing.T) {
+	clientCtx, cleanup := initClientContext(t, "")
+	defer cleanup()
+
+	cmd := config.Cmd()
+	_, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{"keyring-signing-log", "maybe"})
+	require.Error(t, err)
+
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{"keyring-signing-log", "true"})
+	require.NoError(t, err)
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{flags.FlagKeyringBackend, keyring.BackendTest})
+	require.NoError(t, err)
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{"keyring-signing-log"})
+	require.NoError(t, err)
+	require.Equal(t, "true\n", out.String())
+
+	// the keyring records its signatures in the home directory
+	clientCtx, err = config.ReadFromClientConfig(clientCtx)
+	require.NoError(t, err)
+	_, _, err = clientCtx.Keyring.NewMnemonic("jack", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, _, err = clientCtx.Keyring.Sign("jack", []byte("message"))
+	require.NoError(t, err)
+
+	entries, err := keyring.ReadSigningLog(filepath.Join(clientCtx.HomeDir, keyring.SigningLogFileName))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "jack", entries[0].KeyName)
+}
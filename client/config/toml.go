@@ -18,7 +18,7 @@ const defaultConfigTemplate = `# This is a TOML config file.
 
 # The network chain ID
 chain-id = "{{ .ChainID }}"
-# The keyring's backend, where the keys are stored (os|file|kwallet|pass|test|memory)
+# The keyring's backend, where the keys are stored (os|file|kwallet|pass|test|memory|tss)
 keyring-backend = "{{ .KeyringBackend }}"
 # CLI output format (text|json)
 output = "{{ .Output }}"
@@ -26,6 +26,16 @@ output = "{{ .Output }}"
 node = "{{ .Node }}"
 # Transaction broadcasting mode (sync|async|block)
 broadcast-mode = "{{ .BroadcastMode }}"
+# The language of the CLI output (en|zh-CN), machine readable outputs are not translated
+lang = "{{ .Lang }}"
+# Record every signature of the keyring in the hash-chained keyring-signing.log
+# of the home directory, see the 'keys audit' command
+keyring-signing-log = {{ .KeyringSigningLog }}
+# <host>:<port> to the gRPC interface of the threshold signature (TSS) signer of the
+# keys added with 'keys add --tss-key-id', none if empty
+keyring-tss-endpoint = "{{ .KeyringTSSEndpoint }}"
+# Timeout of the requests to the TSS signer, e.g. of a signing round
+keyring-tss-timeout = "{{ .KeyringTSSTimeout }}"
 `
 
 // writeConfigToFile parses defaultConfigTemplate, renders config using the template and writes it to
@@ -340,11 +340,18 @@ func GetFromFields(kr keyring.Keyring, from string, genOnly bool) (sdk.AccAddres
 
 	if genOnly {
 		addr, err := sdk.AccAddressFromBech32(from)
-		if err != nil {
-			return nil, "", 0, errors.Wrap(err, "must provide a valid Bech32 address in generate-only mode")
+		if err == nil {
+			return addr, "", 0, nil
+		}
+
+		// keys saved in the keyring, e.g. watch-only keys, can be referred to by name
+		if kr != nil {
+			if info, keyErr := kr.Key(from); keyErr == nil {
+				return info.GetAddress(), info.GetName(), info.GetType(), nil
+			}
 		}
 
-		return addr, "", 0, nil
+		return nil, "", 0, errors.Wrap(err, "must provide a valid Bech32 address or a key name in generate-only mode")
 	}
 
 	var info keyring.Info
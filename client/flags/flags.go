@@ -37,6 +37,8 @@ const (
 	SignModeLegacyAminoJSON = "amino-json"
 	// SignModeEIP191 is the value of the --sign-mode flag for SIGN_MODE_EIP_191
 	SignModeEIP191 = "eip-191"
+	// SignModeTextual is the value of the --sign-mode flag for SIGN_MODE_TEXTUAL
+	SignModeTextual = "textual"
 )
 
 // List of CLI flags
@@ -71,9 +73,13 @@ const (
 	FlagOffset           = "offset"
 	FlagCountTotal       = "count-total"
 	FlagTimeoutHeight    = "timeout-height"
+	FlagTimeoutDuration  = "timeout-duration"
+	FlagUnordered        = "unordered"
+	FlagForce            = "force"
 	FlagKeyAlgorithm     = "algo"
 	FlagFeeAccount       = "fee-account"
 	FlagReverse          = "reverse"
+	FlagLang             = "lang"
 
 	// Tendermint logging flags
 	FlagLogLevel  = "log_level"
@@ -111,9 +117,12 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	cmd.Flags().Bool(FlagGenerateOnly, false, "Build an unsigned transaction and write it to STDOUT (when enabled, the local Keybase is not accessible)")
 	cmd.Flags().Bool(FlagOffline, false, "Offline mode (does not allow any online functionality")
 	cmd.Flags().BoolP(FlagSkipConfirmation, "y", false, "Skip tx broadcasting prompt confirmation")
-	cmd.Flags().String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory)")
-	cmd.Flags().String(FlagSignMode, "", "Choose sign mode (direct|amino-json), this is an advanced feature")
+	cmd.Flags().String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory|tss)")
+	cmd.Flags().String(FlagSignMode, "", "Choose sign mode (direct|amino-json|textual), this is an advanced feature")
 	cmd.Flags().Uint64(FlagTimeoutHeight, 0, "Set a block timeout height to prevent the tx from being committed past a certain height")
+	cmd.Flags().Duration(FlagTimeoutDuration, 0, "Set a duration from now after which the tx will not be committed, based on block time (e.g. 10m)")
+	cmd.Flags().Bool(FlagUnordered, false, "Build an unordered tx, which ignores the account sequence and requires --timeout-duration")
+	cmd.Flags().Bool(FlagForce, false, "Skip the client-side check of the fees above 10 times the fee required by the chain-wide minimum gas prices")
 	cmd.Flags().String(FlagFeeAccount, "", "Fee account pays fees for the transaction instead of deducting from the signer")
 
 	// --gas can accept integers and "auto"
@@ -0,0 +1,84 @@
+package typed
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Broadcaster broadcasts the transactions of the messages of the typed Msg
+// clients.
+type Broadcaster interface {
+	BroadcastMsgs(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error)
+}
+
+var _ Broadcaster = txBroadcaster{}
+
+type txBroadcaster struct {
+	clientCtx client.Context
+	txf       tx.Factory
+}
+
+// NewTxBroadcaster returns a Broadcaster signing the transactions with the key
+// of the from address of the client context, as configured by the factory,
+// and broadcasting them in the broadcast mode of the client context.
+func NewTxBroadcaster(clientCtx client.Context, txf tx.Factory) Broadcaster {
+	return txBroadcaster{
+		clientCtx: clientCtx,
+		txf:       txf,
+	}
+}
+
+// BroadcastMsgs implements the Broadcaster interface.
+func (b txBroadcaster) BroadcastMsgs(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+
+	return tx.SignAndBroadcastTx(b.clientCtx, b.txf, msgs...)
+}
+
+// BroadcastMsg broadcasts a transaction of the message, and unmarshals the
+// response of the message from the result of the transaction. The response is
+// only available if the transaction is broadcast in block mode, and is left
+// empty otherwise. It returns an error if the transaction failed.
+func BroadcastMsg(ctx context.Context, b Broadcaster, msg sdk.Msg, msgRes proto.Message) (*sdk.TxResponse, error) {
+	txRes, err := b.BroadcastMsgs(ctx, msg)
+	if err != nil {
+		return txRes, err
+	}
+	if txRes.Code != 0 {
+		return txRes, sdkerrors.ABCIError(txRes.Codespace, txRes.Code, txRes.RawLog)
+	}
+	if txRes.Data == "" {
+		return txRes, nil
+	}
+
+	bz, err := hex.DecodeString(txRes.Data)
+	if err != nil {
+		return txRes, err
+	}
+	var txMsgData sdk.TxMsgData
+	if err := proto.Unmarshal(bz, &txMsgData); err != nil {
+		return txRes, err
+	}
+	if len(txMsgData.Data) == 0 {
+		return txRes, nil
+	}
+	if len(txMsgData.Data) > 1 {
+		return txRes, sdkerrors.Wrapf(sdkerrors.ErrLogic, "expected the data of 1 message, got %d", len(txMsgData.Data))
+	}
+
+	return txRes, proto.Unmarshal(txMsgData.Data[0].Data, msgRes)
+}
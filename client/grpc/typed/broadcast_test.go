@@ -0,0 +1,75 @@
+package typed_test
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/typed"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// mockBroadcaster returns its tx response.
+type mockBroadcaster struct {
+	txRes *sdk.TxResponse
+}
+
+func (b mockBroadcaster) BroadcastMsgs(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) {
+	return b.txRes, nil
+}
+
+func TestBroadcastMsg(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	msg := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	msgData := func(data ...[]byte) string {
+		txMsgData := sdk.TxMsgData{}
+		for _, d := range data {
+			txMsgData.Data = append(txMsgData.Data, &sdk.MsgData{MsgType: sdk.MsgTypeURL(msg), Data: d})
+		}
+		bz, err := proto.Marshal(&txMsgData)
+		require.NoError(t, err)
+		return hex.EncodeToString(bz)
+	}
+	resBz, err := proto.Marshal(&govtypes.MsgSubmitProposalResponse{ProposalId: 5})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		txRes  *sdk.TxResponse
+		expRes uint64
+		expErr bool
+	}{
+		{"block mode", &sdk.TxResponse{Data: msgData(resBz)}, 5, false},
+		{"sync mode", &sdk.TxResponse{}, 0, false},
+		{"failed tx", &sdk.TxResponse{Code: sdkerrors.ErrInsufficientFunds.ABCICode(), Codespace: sdkerrors.RootCodespace}, 0, true},
+		{"several messages", &sdk.TxResponse{Data: msgData(resBz, resBz)}, 0, true},
+		{"invalid data", &sdk.TxResponse{Data: "invalid"}, 0, true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res := &govtypes.MsgSubmitProposalResponse{}
+			txRes, err := typed.BroadcastMsg(context.Background(), mockBroadcaster{tc.txRes}, msg, res)
+			require.Equal(t, tc.txRes, txRes)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expRes, res.ProposalId)
+			}
+		})
+	}
+
+	_, err = typed.BroadcastMsg(context.Background(), mockBroadcaster{&sdk.TxResponse{
+		Code: sdkerrors.ErrInsufficientFunds.ABCICode(), Codespace: sdkerrors.RootCodespace,
+	}}, msg, &govtypes.MsgSubmitProposalResponse{})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+}
@@ -0,0 +1,17 @@
+/*
+Package typed implements the runtime of the typed gRPC clients generated by
+typedgen for the SDK modules, in the grpcclient package of each module:
+
+- the typed Query clients retry the queries failing with a transient error
+according to a RetryConfig, and iterate over the pages of the paginated
+queries with Paginate,
+
+- the typed Msg clients broadcast a transaction of each message with a
+Broadcaster, and decode the response of the message from the transaction
+result.
+
+The clients are regenerated with:
+
+	go run ./client/grpc/typed/typedgen
+*/
+package typed
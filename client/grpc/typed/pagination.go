@@ -0,0 +1,41 @@
+package typed
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// PageFunc queries the page of a paginated query for the page request, and
+// returns its page response and whether the iteration should go on.
+type PageFunc func(ctx context.Context, pageReq *query.PageRequest) (pageRes *query.PageResponse, next bool, err error)
+
+// Paginate iterates over the pages of a paginated query starting at the page
+// request, which may be nil, following the next keys of the page responses
+// until the last page or until the PageFunc stops the iteration.
+func Paginate(ctx context.Context, pageReq *query.PageRequest, fetch PageFunc) error {
+	req := &query.PageRequest{}
+	if pageReq != nil {
+		*req = *pageReq
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		res, next, err := fetch(ctx, req)
+		if err != nil {
+			return err
+		}
+		if !next || res == nil || len(res.NextKey) == 0 {
+			return nil
+		}
+
+		req = &query.PageRequest{
+			Key:     res.NextKey,
+			Limit:   req.Limit,
+			Reverse: req.Reverse,
+		}
+	}
+}
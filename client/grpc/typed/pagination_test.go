@@ -0,0 +1,49 @@
+package typed_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/typed"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func TestPaginate(t *testing.T) {
+	pages := [][]byte{[]byte("key1"), []byte("key2"), nil}
+	fetch := func(reqs *[]*query.PageRequest, stopAt int) typed.PageFunc {
+		return func(_ context.Context, pageReq *query.PageRequest) (*query.PageResponse, bool, error) {
+			i := len(*reqs)
+			*reqs = append(*reqs, pageReq)
+			return &query.PageResponse{NextKey: pages[i]}, i != stopAt, nil
+		}
+	}
+
+	// all the pages are fetched, following the next keys
+	var reqs []*query.PageRequest
+	require.NoError(t, typed.Paginate(context.Background(), &query.PageRequest{Limit: 2, Reverse: true, CountTotal: true}, fetch(&reqs, -1)))
+	require.Equal(t, []*query.PageRequest{
+		{Limit: 2, Reverse: true, CountTotal: true},
+		{Key: []byte("key1"), Limit: 2, Reverse: true},
+		{Key: []byte("key2"), Limit: 2, Reverse: true},
+	}, reqs)
+
+	// the iteration is stopped by the page function
+	reqs = nil
+	require.NoError(t, typed.Paginate(context.Background(), nil, fetch(&reqs, 1)))
+	require.Len(t, reqs, 2)
+	require.Equal(t, &query.PageRequest{}, reqs[0])
+
+	// the errors are returned
+	err := errors.New("error")
+	require.Equal(t, err, typed.Paginate(context.Background(), nil, func(context.Context, *query.PageRequest) (*query.PageResponse, bool, error) {
+		return nil, false, err
+	}))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	reqs = nil
+	require.ErrorIs(t, typed.Paginate(ctx, nil, fetch(&reqs, -1)), context.Canceled)
+	require.Empty(t, reqs)
+}
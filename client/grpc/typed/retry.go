@@ -0,0 +1,70 @@
+package typed
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RetryConfig defines how the calls of the typed Query clients failing with a
+// transient error are retried, waiting for a backoff doubled after each retry.
+// The zero value never retries.
+type RetryConfig struct {
+	// MaxRetries is the maximum number of retries of a call.
+	MaxRetries uint
+	// InitialBackoff is the backoff before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the backoff between two retries, if positive.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryConfig returns the default RetryConfig, retrying a call up to 3
+// times, after 100ms, 200ms and 400ms.
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxRetries:     3,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     2 * time.Second,
+	}
+}
+
+// Do calls the function until it succeeds, it fails with an error which is not
+// transient, the retries are exhausted or the context is done, and returns its
+// last error.
+func (c RetryConfig) Do(ctx context.Context, call func(ctx context.Context) error) error {
+	backoff := c.InitialBackoff
+	for retries := uint(0); ; retries++ {
+		err := call(ctx)
+		if err == nil || retries >= c.MaxRetries || !IsTransient(err) {
+			return err
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+
+		backoff *= 2
+		if c.MaxBackoff > 0 && backoff > c.MaxBackoff {
+			backoff = c.MaxBackoff
+		}
+	}
+}
+
+// IsTransient returns true if the error is a gRPC error which may not happen
+// again if the call is retried, i.e. with the Unavailable, ResourceExhausted or
+// Aborted codes.
+func IsTransient(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted:
+		return true
+
+	default:
+		return false
+	}
+}
@@ -0,0 +1,67 @@
+package typed_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/typed"
+)
+
+func TestRetryConfigDo(t *testing.T) {
+	retry := typed.RetryConfig{MaxRetries: 2, InitialBackoff: time.Millisecond}
+	unavailable := status.Error(codes.Unavailable, "unavailable")
+
+	testCases := []struct {
+		name      string
+		retry     typed.RetryConfig
+		errs      []error
+		expCalls  int
+		expErr    error
+		cancelCtx bool
+	}{
+		{"success", retry, []error{nil}, 1, nil, false},
+		{"success after transient errors", retry, []error{unavailable, unavailable, nil}, 3, nil, false},
+		{"retries exhausted", retry, []error{unavailable, unavailable, unavailable, nil}, 3, unavailable, false},
+		{"error which is not transient", retry, []error{status.Error(codes.NotFound, "not found"), nil}, 1, status.Error(codes.NotFound, "not found"), false},
+		{"no retries", typed.RetryConfig{}, []error{unavailable, nil}, 1, unavailable, false},
+		{"context canceled", retry, []error{unavailable, nil}, 1, unavailable, true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tc.cancelCtx {
+				cancel()
+			}
+
+			calls := 0
+			err := tc.retry.Do(ctx, func(context.Context) error {
+				err := tc.errs[calls]
+				calls++
+				return err
+			})
+			require.Equal(t, tc.expCalls, calls)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.Equal(t, status.Code(tc.expErr), status.Code(err))
+			}
+		})
+	}
+}
+
+func TestIsTransient(t *testing.T) {
+	require.True(t, typed.IsTransient(status.Error(codes.Unavailable, "")))
+	require.True(t, typed.IsTransient(status.Error(codes.ResourceExhausted, "")))
+	require.True(t, typed.IsTransient(status.Error(codes.Aborted, "")))
+	require.False(t, typed.IsTransient(status.Error(codes.InvalidArgument, "")))
+	require.False(t, typed.IsTransient(errors.New("error")))
+	require.False(t, typed.IsTransient(nil))
+}
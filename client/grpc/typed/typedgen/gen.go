@@ -0,0 +1,234 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go/format"
+	"path"
+	"reflect"
+	"sort"
+	"text/template"
+
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var (
+	contextType      = reflect.TypeOf((*context.Context)(nil)).Elem()
+	callOptionsType  = reflect.TypeOf([]grpc.CallOption(nil))
+	errorType        = reflect.TypeOf((*error)(nil)).Elem()
+	pageRequestType  = reflect.TypeOf(&query.PageRequest{})
+	pageResponseType = reflect.TypeOf(&query.PageResponse{})
+)
+
+// service is a module whose typed clients are generated from the generated
+// gRPC client interfaces of its Query and Msg services.
+type service struct {
+	// Module is the name of the module in the doc comments.
+	Module string
+	// Dir is the directory of the generated package, relative to the root of
+	// the repository.
+	Dir string
+	// Query is the QueryClient interface of the module, if any.
+	Query reflect.Type
+	// Msg is the MsgClient interface of the module, if any.
+	Msg reflect.Type
+}
+
+// method is a unary method of a gRPC client interface.
+type method struct {
+	Name      string
+	Request   string
+	Response  string
+	Paginated bool
+}
+
+type file struct {
+	Module       string
+	QueryPkg     string
+	Imports      []string
+	QueryMethods []method
+	MsgMethods   []method
+	Paginated    bool
+}
+
+// generate returns the source of the typed clients of the service.
+func generate(svc service) ([]byte, error) {
+	imports := map[string]bool{}
+	f := file{Module: svc.Module}
+
+	var err error
+	if svc.Query != nil {
+		if f.QueryMethods, err = methods(svc.Query, imports); err != nil {
+			return nil, err
+		}
+		imports[svc.Query.PkgPath()] = true
+		f.QueryPkg = path.Base(svc.Query.PkgPath())
+	}
+	if svc.Msg != nil {
+		if f.MsgMethods, err = methods(svc.Msg, imports); err != nil {
+			return nil, err
+		}
+	}
+	for _, m := range f.QueryMethods {
+		f.Paginated = f.Paginated || m.Paginated
+	}
+	for imp := range imports {
+		f.Imports = append(f.Imports, imp)
+	}
+	sort.Strings(f.Imports)
+
+	var buf bytes.Buffer
+	if err := fileTemplate.Execute(&buf, f); err != nil {
+		return nil, err
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+// methods returns the unary methods of the gRPC client interface, adding the
+// import paths of their request and response types to the imports.
+func methods(iface reflect.Type, imports map[string]bool) ([]method, error) {
+	res := make([]method, iface.NumMethod())
+	for i := range res {
+		m := iface.Method(i)
+		t := m.Type
+		if t.NumIn() != 3 || t.In(0) != contextType || t.In(2) != callOptionsType || !t.IsVariadic() ||
+			t.NumOut() != 2 || t.Out(1) != errorType {
+			return nil, fmt.Errorf("%s.%s is not a unary gRPC method", iface, m.Name)
+		}
+
+		req, resp := t.In(1), t.Out(0)
+		if req.Kind() != reflect.Ptr || resp.Kind() != reflect.Ptr {
+			return nil, fmt.Errorf("%s.%s does not have message types", iface, m.Name)
+		}
+		imports[req.Elem().PkgPath()] = true
+		imports[resp.Elem().PkgPath()] = true
+
+		res[i] = method{
+			Name:      m.Name,
+			Request:   qualifiedName(req.Elem()),
+			Response:  qualifiedName(resp.Elem()),
+			Paginated: hasField(req.Elem(), "Pagination", pageRequestType) && hasField(resp.Elem(), "Pagination", pageResponseType),
+		}
+	}
+
+	return res, nil
+}
+
+// qualifiedName returns the name of the type qualified by its package name,
+// assumed to be the last element of its import path.
+func qualifiedName(t reflect.Type) string {
+	return path.Base(t.PkgPath()) + "." + t.Name()
+}
+
+func hasField(t reflect.Type, name string, typ reflect.Type) bool {
+	f, ok := t.FieldByName(name)
+	return ok && f.Type == typ
+}
+
+var fileTemplate = template.Must(template.New("file").Parse(`// Code generated by typedgen. DO NOT EDIT.
+
+// Package grpcclient implements the typed clients of the {{ .Module }} module.
+package grpcclient
+
+import (
+	"context"
+	{{- if .QueryMethods }}
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+	{{- end }}
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/typed"
+	{{- if .MsgMethods }}
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	{{- end }}
+	{{- if .Paginated }}
+	"github.com/cosmos/cosmos-sdk/types/query"
+	{{- end }}
+	{{- range .Imports }}
+	"{{ . }}"
+	{{- end }}
+)
+{{- $module := .Module }}
+{{- if .QueryMethods }}
+
+// QueryClient is the typed client of the {{ $module }} Query service, retrying
+// the queries failing with a transient error.
+type QueryClient struct {
+	client {{ .QueryPkg }}.QueryClient
+	retry  typed.RetryConfig
+}
+
+// NewQueryClient returns a QueryClient querying through the connection.
+func NewQueryClient(conn gogogrpc.ClientConn, retry typed.RetryConfig) *QueryClient {
+	return &QueryClient{
+		client: {{ .QueryPkg }}.NewQueryClient(conn),
+		retry:  retry,
+	}
+}
+{{- range .QueryMethods }}
+
+// {{ .Name }} calls the {{ $module }} Query/{{ .Name }} method.
+func (c *QueryClient) {{ .Name }}(ctx context.Context, req *{{ .Request }}, opts ...grpc.CallOption) (*{{ .Response }}, error) {
+	var res *{{ .Response }}
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.{{ .Name }}(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+{{- if .Paginated }}
+
+// Iterate{{ .Name }} calls the {{ $module }} Query/{{ .Name }} method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) Iterate{{ .Name }}(ctx context.Context, req *{{ .Request }}, fn func(*{{ .Response }}) bool, opts ...grpc.CallOption) error {
+	var pageReq {{ .Request }}
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.{{ .Name }}(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- if .MsgMethods }}
+
+// MsgClient is the typed client of the {{ $module }} Msg service, broadcasting a
+// transaction of each message.
+type MsgClient struct {
+	broadcaster typed.Broadcaster
+}
+
+// NewMsgClient returns a MsgClient broadcasting the transactions with the
+// broadcaster.
+func NewMsgClient(broadcaster typed.Broadcaster) *MsgClient {
+	return &MsgClient{broadcaster: broadcaster}
+}
+{{- range .MsgMethods }}
+
+// {{ .Name }} broadcasts a transaction of the {{ $module }} Msg/{{ .Name }} message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) {{ .Name }}(ctx context.Context, msg *{{ .Request }}) (*{{ .Response }}, *sdk.TxResponse, error) {
+	res := &{{ .Response }}{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
+{{- end }}
+{{- end }}
+`))
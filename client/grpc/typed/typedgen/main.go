@@ -0,0 +1,65 @@
+// typedgen generates the typed gRPC clients of the SDK modules, implemented
+// over the runtime of the typed package, in the grpcclient package of each
+// module. It must be run from the root of the repository:
+//
+//	go run ./client/grpc/typed/typedgen
+//
+// With the -check flag, it only checks that the generated clients are up to
+// date, and fails otherwise.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// fileName is the name of the generated file in the grpcclient package.
+const fileName = "client.go"
+
+func main() {
+	check := flag.Bool("check", false, "only check that the generated clients are up to date")
+	flag.Parse()
+
+	if err := run(".", *check); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// run generates the typed clients of the services in the repository at root,
+// or only checks that they are up to date.
+func run(root string, check bool) error {
+	for _, svc := range services {
+		src, err := generate(svc)
+		if err != nil {
+			return fmt.Errorf("%s: %w", svc.Module, err)
+		}
+
+		fileDir := filepath.Join(root, filepath.FromSlash(svc.Dir))
+		filePath := filepath.Join(fileDir, fileName)
+		if check {
+			existing, err := ioutil.ReadFile(filePath)
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(existing, src) {
+				return fmt.Errorf("%s is not up to date, run go run ./client/grpc/typed/typedgen", filepath.ToSlash(filepath.Join(svc.Dir, fileName)))
+			}
+
+			continue
+		}
+
+		if err := os.MkdirAll(fileDir, 0o755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(filePath, src, 0o644); err != nil { //nolint:gosec
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,11 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratedClientsUpToDate(t *testing.T) {
+	require.NoError(t, run("../../../..", true))
+}
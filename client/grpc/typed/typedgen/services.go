@@ -0,0 +1,61 @@
+package main
+
+import (
+	"reflect"
+
+	authsession "github.com/cosmos/cosmos-sdk/x/auth/session"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/batch"
+	blocktimetypes "github.com/cosmos/cosmos-sdk/x/blocktime/types"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	feeconversiontypes "github.com/cosmos/cosmos-sdk/x/feeconversion/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	genesishashtypes "github.com/cosmos/cosmos-sdk/x/genesishash/types"
+	globalfeetypes "github.com/cosmos/cosmos-sdk/x/globalfee/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/cosmos/cosmos-sdk/x/precompile"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	txresulttypes "github.com/cosmos/cosmos-sdk/x/txresult/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// services are the modules whose typed clients are generated.
+var services = []service{
+	{"auth", "x/auth/client/grpcclient", typeOf((*authtypes.QueryClient)(nil)), typeOf((*authtypes.MsgClient)(nil))},
+	{"session", "x/auth/session/client/grpcclient", typeOf((*authsession.QueryClient)(nil)), typeOf((*authsession.MsgClient)(nil))},
+	{"vesting", "x/auth/vesting/client/grpcclient", nil, typeOf((*vestingtypes.MsgClient)(nil))},
+	{"authz", "x/authz/client/grpcclient", typeOf((*authz.QueryClient)(nil)), typeOf((*authz.MsgClient)(nil))},
+	{"bank", "x/bank/client/grpcclient", typeOf((*banktypes.QueryClient)(nil)), typeOf((*banktypes.MsgClient)(nil))},
+	{"batch", "x/batch/client/grpcclient", nil, typeOf((*batch.MsgClient)(nil))},
+	{"blocktime", "x/blocktime/client/grpcclient", typeOf((*blocktimetypes.QueryClient)(nil)), nil},
+	{"crisis", "x/crisis/client/grpcclient", nil, typeOf((*crisistypes.MsgClient)(nil))},
+	{"distribution", "x/distribution/client/grpcclient", typeOf((*distrtypes.QueryClient)(nil)), typeOf((*distrtypes.MsgClient)(nil))},
+	{"evidence", "x/evidence/client/grpcclient", typeOf((*evidencetypes.QueryClient)(nil)), typeOf((*evidencetypes.MsgClient)(nil))},
+	{"feeconversion", "x/feeconversion/client/grpcclient", typeOf((*feeconversiontypes.QueryClient)(nil)), nil},
+	{"feegrant", "x/feegrant/client/grpcclient", typeOf((*feegrant.QueryClient)(nil)), typeOf((*feegrant.MsgClient)(nil))},
+	{"feemarket", "x/feemarket/client/grpcclient", typeOf((*feemarkettypes.QueryClient)(nil)), nil},
+	{"genesishash", "x/genesishash/client/grpcclient", typeOf((*genesishashtypes.QueryClient)(nil)), nil},
+	{"globalfee", "x/globalfee/client/grpcclient", typeOf((*globalfeetypes.QueryClient)(nil)), nil},
+	{"gov", "x/gov/client/grpcclient", typeOf((*govtypes.QueryClient)(nil)), typeOf((*govtypes.MsgClient)(nil))},
+	{"mint", "x/mint/client/grpcclient", typeOf((*minttypes.QueryClient)(nil)), nil},
+	{"params", "x/params/client/grpcclient", typeOf((*paramsproposal.QueryClient)(nil)), nil},
+	{"precompile", "x/precompile/client/grpcclient", typeOf((*precompile.QueryClient)(nil)), typeOf((*precompile.MsgClient)(nil))},
+	{"slashing", "x/slashing/client/grpcclient", typeOf((*slashingtypes.QueryClient)(nil)), typeOf((*slashingtypes.MsgClient)(nil))},
+	{"staking", "x/staking/client/grpcclient", typeOf((*stakingtypes.QueryClient)(nil)), typeOf((*stakingtypes.MsgClient)(nil))},
+	{"txresult", "x/txresult/client/grpcclient", typeOf((*txresulttypes.QueryClient)(nil)), nil},
+	{"upgrade", "x/upgrade/client/grpcclient", typeOf((*upgradetypes.QueryClient)(nil)), nil},
+}
+
+// typeOf returns the interface type of the given nil pointer to an interface.
+func typeOf(iface interface{}) reflect.Type {
+	return reflect.TypeOf(iface).Elem()
+}
@@ -0,0 +1,100 @@
+// Package i18n translates the human readable output and the common error
+// messages of the CLI. Machine readable outputs, e.g. JSON, are never
+// translated.
+package i18n
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Supported languages of the CLI.
+const (
+	English           = "en"
+	SimplifiedChinese = "zh-CN"
+)
+
+// catalogs holds the translations of the English messages per language.
+var catalogs = map[string]map[string]string{
+	SimplifiedChinese: zhCN,
+}
+
+var (
+	mtx      sync.RWMutex
+	language = English
+)
+
+// ValidateLanguage returns an error if a language is not supported, empty
+// meaning English.
+func ValidateLanguage(lang string) error {
+	if _, ok := catalogs[lang]; !ok && lang != English && lang != "" {
+		return fmt.Errorf("unsupported language %s, expected one of %s", lang, strings.Join(Languages(), "|"))
+	}
+
+	return nil
+}
+
+// SetLanguage sets the language of the CLI, English if empty.
+func SetLanguage(lang string) error {
+	if err := ValidateLanguage(lang); err != nil {
+		return err
+	}
+
+	if lang == "" {
+		lang = English
+	}
+
+	mtx.Lock()
+	defer mtx.Unlock()
+	language = lang
+
+	return nil
+}
+
+// Language returns the language of the CLI.
+func Language() string {
+	mtx.RLock()
+	defer mtx.RUnlock()
+	return language
+}
+
+// Languages returns the supported languages.
+func Languages() []string {
+	return []string{English, SimplifiedChinese}
+}
+
+// T returns the translation of an English message in the language of the CLI,
+// the message itself if it has no translation.
+func T(msg string) string {
+	mtx.RLock()
+	defer mtx.RUnlock()
+
+	if translation, ok := catalogs[language][msg]; ok {
+		return translation
+	}
+
+	return msg
+}
+
+// Sprintf formats according to the translation of an English format.
+func Sprintf(format string, args ...interface{}) string {
+	return fmt.Sprintf(T(format), args...)
+}
+
+// Error returns the message of an error with its common error descriptions,
+// e.g. the descriptions of the registered SDK errors, translated.
+func Error(err error) string {
+	msg := err.Error()
+
+	mtx.RLock()
+	defer mtx.RUnlock()
+
+	for _, desc := range errorDescriptions {
+		if translation, ok := catalogs[language][desc]; ok {
+			msg = strings.ReplaceAll(msg, desc, translation)
+		}
+	}
+
+	return msg
+}
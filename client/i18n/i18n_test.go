@@ -0,0 +1,33 @@
+package i18n_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/i18n"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestTranslate(t *testing.T) {
+	defer func() { require.NoError(t, i18n.SetLanguage(i18n.English)) }()
+
+	err := sdkerrors.ErrInsufficientFunds.Wrap("10stake is smaller than 20stake")
+	require.Equal(t, i18n.English, i18n.Language())
+	require.Equal(t, "cancelled transaction", i18n.T("cancelled transaction"))
+	require.Equal(t, err.Error(), i18n.Error(err))
+
+	require.Error(t, i18n.SetLanguage("fr"))
+	require.Equal(t, i18n.English, i18n.Language())
+
+	require.NoError(t, i18n.SetLanguage(i18n.SimplifiedChinese))
+	require.Equal(t, "交易已取消", i18n.T("cancelled transaction"))
+	require.Equal(t, "覆盖已存在的名称 alice", i18n.Sprintf("override the existing name %s", "alice"))
+	require.Equal(t, "10stake is smaller than 20stake: 余额不足", i18n.Error(err))
+
+	// messages without translation are kept
+	require.Equal(t, "untranslated", i18n.T("untranslated"))
+
+	require.NoError(t, i18n.SetLanguage(""))
+	require.Equal(t, i18n.English, i18n.Language())
+}
@@ -0,0 +1,94 @@
+package i18n
+
+import "sort"
+
+// errorDescriptions are the common error descriptions translated in the error
+// messages, longest first so that no description is replaced within another.
+var errorDescriptions = []string{
+	"tx parse error",
+	"invalid sequence",
+	"unauthorized",
+	"insufficient funds",
+	"unknown request",
+	"invalid address",
+	"invalid pubkey",
+	"unknown address",
+	"invalid coins",
+	"out of gas",
+	"memo too large",
+	"insufficient fee",
+	"maximum number of signatures exceeded",
+	"no signatures supplied",
+	"invalid request",
+	"tx already in mempool",
+	"mempool is full",
+	"tx too large",
+	"key not found",
+	"invalid account password",
+	"tx intended signer does not match the given signer",
+	"invalid gas adjustment",
+	"invalid height",
+	"invalid chain-id",
+	"tx timeout height",
+	"incorrect account sequence",
+	"feature not supported",
+	"tx timeout timestamp",
+	"empty address string is not allowed",
+	"node is query-only",
+}
+
+func init() {
+	sort.SliceStable(errorDescriptions, func(i, j int) bool {
+		return len(errorDescriptions[i]) > len(errorDescriptions[j])
+	})
+}
+
+// zhCN is the Simplified Chinese catalog.
+var zhCN = map[string]string{
+	// CLI output
+	"Error:": "错误：",
+	"confirm transaction before signing and broadcasting":                          "签名并广播交易前请确认",
+	"cancelled transaction":                                                        "交易已取消",
+	"override the existing name %s":                                                "覆盖已存在的名称 %s",
+	"**Important** write this mnemonic phrase in a safe place.":                    "**重要** 请将助记词抄写并保存在安全的地方。",
+	"It is the only way to recover your account if you ever forget your password.": "如果您忘记了密码，这是恢复账户的唯一方式。",
+	"Key reference will be deleted. Continue?":                                     "密钥引用将被删除。是否继续？",
+	"Public key reference deleted":                                                 "密钥引用已删除",
+	"Key deleted forever (uh oh!)":                                                 "密钥已永久删除（无法恢复！）",
+	"WARNING: The private key will be exported as an unarmored hexadecimal string. USE AT YOUR OWN RISK. Continue?": "警告：私钥将以未加密的十六进制字符串导出，风险自负。是否继续？",
+	"WARNING: The private key will be exported as %d mnemonic shares, any %d of which restore it. Continue?":        "警告：私钥将以 %d 份助记词分片导出，其中任意 %d 份即可恢复私钥。是否继续？",
+	"Share %d of %d:": "分片 %d / %d：",
+	"Enter share %d:": "请输入分片 %d：",
+
+	// common errors
+	"tx parse error":                        "交易解析错误",
+	"invalid sequence":                      "无效的序列号",
+	"unauthorized":                          "未授权",
+	"insufficient funds":                    "余额不足",
+	"unknown request":                       "未知请求",
+	"invalid address":                       "无效的地址",
+	"invalid pubkey":                        "无效的公钥",
+	"unknown address":                       "未知地址",
+	"invalid coins":                         "无效的代币",
+	"out of gas":                            "gas 不足",
+	"memo too large":                        "备注过长",
+	"insufficient fee":                      "手续费不足",
+	"maximum number of signatures exceeded": "超过最大签名数量",
+	"no signatures supplied":                "未提供签名",
+	"invalid request":                       "无效的请求",
+	"tx already in mempool":                 "交易已在内存池中",
+	"mempool is full":                       "内存池已满",
+	"tx too large":                          "交易过大",
+	"key not found":                         "未找到密钥",
+	"invalid account password":              "账户密码错误",
+	"tx intended signer does not match the given signer": "交易的签名者与给定的签名者不匹配",
+	"invalid gas adjustment":                             "无效的 gas 调整系数",
+	"invalid height":                                     "无效的高度",
+	"invalid chain-id":                                   "无效的链 ID",
+	"tx timeout height":                                  "交易超过超时高度",
+	"incorrect account sequence":                         "账户序列号不正确",
+	"feature not supported":                              "不支持该功能",
+	"tx timeout timestamp":                               "交易超过超时时间",
+	"empty address string is not allowed":                "地址不能为空",
+	"node is query-only":                                 "节点为只读查询节点",
+}
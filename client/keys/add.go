@@ -12,6 +12,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/i18n"
 	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
@@ -21,15 +22,19 @@ import (
 )
 
 const (
-	flagInteractive = "interactive"
-	flagRecover     = "recover"
-	flagNoBackup    = "no-backup"
-	flagCoinType    = "coin-type"
-	flagAccount     = "account"
-	flagIndex       = "index"
-	flagMultisig    = "multisig"
-	flagNoSort      = "nosort"
-	flagHDPath      = "hd-path"
+	flagInteractive     = "interactive"
+	flagRecover         = "recover"
+	flagNoBackup        = "no-backup"
+	flagCoinType        = "coin-type"
+	flagAccount         = "account"
+	flagIndex           = "index"
+	flagMultisig        = "multisig"
+	flagMultisigWeights = "multisig-weights"
+	flagNoSort          = "nosort"
+	flagHDPath          = "hd-path"
+	flagWatch           = "watch"
+	flagTags            = "tags"
+	flagTSSKeyID        = "tss-key-id"
 
 	// DefaultKeyPass contains the default key password for genesis transactions
 	DefaultKeyPass = "12345678"
@@ -51,13 +56,25 @@ If run with --dry-run, a key would be generated (or recovered) but not stored to
 local keystore.
 Use the --pubkey flag to add arbitrary public keys to the keystore for constructing
 multisig transactions.
+Use the --watch flag along with --address and/or --pubkey to add a watch-only key that
+can be used as --from to generate unsigned transactions for offline signers, optionally
+labeled with --tags.
+Use the --tss-key-id flag to add a reference to a key of the threshold signature (TSS)
+signer configured by 'config keyring-tss-endpoint', which signs without the private key
+ever being held by a single machine.
 
 You can create and store a multisig key by passing the list of key names stored in a keyring
 and the minimum number of signatures required through --multisig-threshold. The keys are
 sorted by address, unless the flag --nosort is set.
+A weighted multisig key is created by passing the weights of the keys, in the order of
+--multisig, through --multisig-weights: the threshold is then the minimum sum of the weights
+of the keys which must sign.
 Example:
 
     keys add mymultisig --multisig "keyname1,keyname2,keyname3" --multisig-threshold 2
+    keys add myweighted --multisig "keyname1,keyname2,keyname3" --multisig-weights 2,1,1 --multisig-threshold 3
+    keys add cold --watch --address cosmos1... --tags custody,treasury
+    keys add operator --tss-key-id validator-operator --algo secp256k1
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: runAddCmdPrepare,
@@ -65,8 +82,13 @@ Example:
 	f := cmd.Flags()
 	f.StringSlice(flagMultisig, nil, "List of key names stored in keyring to construct a public legacy multisig key")
 	f.Int(flagMultiSigThreshold, 1, "K out of N required signatures. For use in conjunction with --multisig")
+	f.UintSlice(flagMultisigWeights, nil, "Weights of the keys passed to --multisig, to construct a weighted multisig key")
 	f.Bool(flagNoSort, false, "Keys passed to --multisig are taken in the order they're supplied")
 	f.String(FlagPublicKey, "", "Parse a public key in JSON format and saves key info to <name> file.")
+	f.Bool(flagWatch, false, "Add a watch-only key from --address and/or --pubkey, which cannot sign")
+	f.String(FlagAddress, "", "Bech32 address of the watch-only key. For use in conjunction with --watch")
+	f.StringSlice(flagTags, nil, "Labels of the watch-only key. For use in conjunction with --watch")
+	f.String(flagTSSKeyID, "", "Add a reference to the key of this ID of the TSS signer")
 	f.BoolP(flagInteractive, "i", false, "Interactively prompt user for BIP39 passphrase and mnemonic")
 	f.Bool(flags.FlagUseLedger, false, "Store a local reference to a private key on a Ledger device")
 	f.Bool(flagRecover, false, "Provide seed phrase to recover existing key instead of creating")
@@ -93,12 +115,13 @@ func runAddCmdPrepare(cmd *cobra.Command, args []string) error {
 
 /*
 input
-	- bip39 mnemonic
-	- bip39 passphrase
-	- bip44 path
-	- local encryption password
+  - bip39 mnemonic
+  - bip39 passphrase
+  - bip44 path
+  - local encryption password
+
 output
-	- armor encrypted private key (saved to file)
+  - armor encrypted private key (saved to file)
 */
 func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *bufio.Reader) error {
 	var err error
@@ -124,7 +147,7 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		_, err = kb.Key(name)
 		if err == nil {
 			// account exists, ask for user confirmation
-			response, err2 := input.GetConfirmation(fmt.Sprintf("override the existing name %s", name), inBuf, cmd.ErrOrStderr())
+			response, err2 := input.GetConfirmation(i18n.Sprintf("override the existing name %s", name), inBuf, cmd.ErrOrStderr())
 			if err2 != nil {
 				return err2
 			}
@@ -143,7 +166,12 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		if len(multisigKeys) != 0 {
 			pks := make([]cryptotypes.PubKey, len(multisigKeys))
 			multisigThreshold, _ := cmd.Flags().GetInt(flagMultiSigThreshold)
-			if err := validateMultisigThreshold(multisigThreshold, len(multisigKeys)); err != nil {
+			weights, _ := cmd.Flags().GetUintSlice(flagMultisigWeights)
+			if len(weights) != 0 {
+				if err := validateWeightedMultisigThreshold(multisigThreshold, len(multisigKeys), weights); err != nil {
+					return err
+				}
+			} else if err := validateMultisigThreshold(multisigThreshold, len(multisigKeys)); err != nil {
 				return err
 			}
 
@@ -156,6 +184,16 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 				pks[i] = k.GetPubKey()
 			}
 
+			if len(weights) != 0 {
+				pk := newWeightedMultisigPubKey(cmd, multisigThreshold, pks, weights)
+				info, err := kb.SaveMultisig(name, pk)
+				if err != nil {
+					return err
+				}
+
+				return printCreate(cmd, info, false, "", outputFormat)
+			}
+
 			if noSort, _ := cmd.Flags().GetBool(flagNoSort); !noSort {
 				sort.Slice(pks, func(i, j int) bool {
 					return bytes.Compare(pks[i].Address(), pks[j].Address()) < 0
@@ -173,6 +211,45 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 	}
 
 	pubKey, _ := cmd.Flags().GetString(FlagPublicKey)
+	if watch, _ := cmd.Flags().GetBool(flagWatch); watch {
+		addrStr, _ := cmd.Flags().GetString(FlagAddress)
+		if addrStr == "" && pubKey == "" {
+			return fmt.Errorf("--%s requires --%s or --%s", flagWatch, FlagAddress, FlagPublicKey)
+		}
+
+		var addr sdk.AccAddress
+		if addrStr != "" {
+			addr, err = sdk.AccAddressFromBech32(addrStr)
+			if err != nil {
+				return err
+			}
+		}
+
+		var pk cryptotypes.PubKey
+		if pubKey != "" {
+			if err = ctx.Codec.UnmarshalInterfaceJSON([]byte(pubKey), &pk); err != nil {
+				return err
+			}
+		}
+
+		tags, _ := cmd.Flags().GetStringSlice(flagTags)
+		info, err := kb.SaveWatchKey(name, addr, pk, tags)
+		if err != nil {
+			return err
+		}
+
+		return printCreate(cmd, info, false, "", outputFormat)
+	}
+
+	if keyID, _ := cmd.Flags().GetString(flagTSSKeyID); keyID != "" {
+		info, err := kb.SaveTSSKey(name, keyID, algo)
+		if err != nil {
+			return err
+		}
+
+		return printCreate(cmd, info, false, "", outputFormat)
+	}
+
 	if pubKey != "" {
 		var pk cryptotypes.PubKey
 		err = ctx.Codec.UnmarshalInterfaceJSON([]byte(pubKey), &pk)
@@ -294,8 +371,8 @@ func printCreate(cmd *cobra.Command, info keyring.Info, showMnemonic bool, mnemo
 
 		// print mnemonic unless requested not to.
 		if showMnemonic {
-			fmt.Fprintln(cmd.ErrOrStderr(), "\n**Important** write this mnemonic phrase in a safe place.")
-			fmt.Fprintln(cmd.ErrOrStderr(), "It is the only way to recover your account if you ever forget your password.")
+			fmt.Fprintln(cmd.ErrOrStderr(), "\n"+i18n.T("**Important** write this mnemonic phrase in a safe place."))
+			fmt.Fprintln(cmd.ErrOrStderr(), i18n.T("It is the only way to recover your account if you ever forget your password."))
 			fmt.Fprintln(cmd.ErrOrStderr(), "")
 			fmt.Fprintln(cmd.ErrOrStderr(), mnemonic)
 		}
@@ -322,3 +399,27 @@ func printCreate(cmd *cobra.Command, info keyring.Info, showMnemonic bool, mnemo
 
 	return nil
 }
+
+// newWeightedMultisigPubKey returns the weighted multisig key of the keys with
+// the given weights, sorting the keys by address along with their weights,
+// unless the flag --nosort is set.
+func newWeightedMultisigPubKey(cmd *cobra.Command, threshold int, pks []cryptotypes.PubKey, weights []uint) *multisig.WeightedMultisigPubKey {
+	indexes := make([]int, len(pks))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	if noSort, _ := cmd.Flags().GetBool(flagNoSort); !noSort {
+		sort.Slice(indexes, func(i, j int) bool {
+			return bytes.Compare(pks[indexes[i]].Address(), pks[indexes[j]].Address()) < 0
+		})
+	}
+
+	sortedPks := make([]cryptotypes.PubKey, len(pks))
+	sortedWeights := make([]uint32, len(pks))
+	for i, index := range indexes {
+		sortedPks[i] = pks[index]
+		sortedWeights[i] = uint32(weights[index])
+	}
+
+	return multisig.NewWeightedMultisigPubKey(uint32(threshold), sortedPks, sortedWeights)
+}
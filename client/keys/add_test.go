@@ -163,6 +163,17 @@ func Test_runAddCmdDryRun(t *testing.T) {
 			},
 			added: false,
 		},
+		{
+			name: "weighted multisig account is added",
+			args: []string{
+				"testkey",
+				fmt.Sprintf("--%s=%s", flags.FlagDryRun, "false"),
+				fmt.Sprintf("--%s=%s", flagMultisig, "subkey"),
+				fmt.Sprintf("--%s=%s", flagMultisigWeights, "2"),
+				fmt.Sprintf("--%s=%s", flagMultiSigThreshold, "2"),
+			},
+			added: true,
+		},
 		{
 			name: "pubkey account is added",
 			args: []string{
@@ -273,3 +284,63 @@ func TestAddRecoverFileBackend(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "keyname1", info.GetName())
 }
+
+func Test_runAddCmdWatch(t *testing.T) {
+	cmd := AddKeyCommand()
+	cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+
+	mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+	kbHome := t.TempDir()
+
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockIn)
+	require.NoError(t, err)
+
+	encCfg := simapp.MakeTestEncodingConfig()
+	clientCtx := client.Context{}.
+		WithKeyringDir(kbHome).
+		WithInput(mockIn).
+		WithCodec(encCfg.Marshaler)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	baseArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagHome, kbHome),
+		fmt.Sprintf("--%s=%s", cli.OutputFlag, OutputFormatText),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		fmt.Sprintf("--%s=true", flagWatch),
+	}
+
+	// neither --address nor --pubkey
+	cmd.SetArgs(append([]string{"watch0"}, baseArgs...))
+	require.Error(t, cmd.ExecuteContext(ctx))
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	cmd.SetArgs(append([]string{
+		"watch1",
+		fmt.Sprintf("--%s=%s", FlagAddress, addr),
+		fmt.Sprintf("--%s=custody,cold", flagTags),
+	}, baseArgs...))
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	info, err := kb.Key("watch1")
+	require.NoError(t, err)
+	require.Equal(t, keyring.TypeWatch, info.GetType())
+	require.Equal(t, addr, info.GetAddress())
+	require.Nil(t, info.GetPubKey())
+	require.Equal(t, []string{"custody", "cold"}, keyring.InfoTags(info))
+
+	_, pk, _ := testdata.KeyTestPubAddr()
+	pkJSON, err := encCfg.Marshaler.MarshalInterfaceJSON(pk)
+	require.NoError(t, err)
+	cmd.SetArgs(append([]string{
+		"watch2",
+		fmt.Sprintf("--%s=%s", FlagAddress, sdk.AccAddress(pk.Address())),
+		fmt.Sprintf("--%s=%s", FlagPublicKey, pkJSON),
+	}, baseArgs...))
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	info, err = kb.Key("watch2")
+	require.NoError(t, err)
+	require.Equal(t, keyring.TypeWatch, info.GetType())
+	require.Equal(t, sdk.AccAddress(pk.Address()), info.GetAddress())
+	require.True(t, pk.Equals(info.GetPubKey()))
+}
@@ -0,0 +1,79 @@
+package keys
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+const flagLogFile = "log-file"
+
+// AuditCommand prints the signatures recorded in the signing log of the
+// keyring.
+func AuditCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit [name]",
+		Short: "Audit the signatures recorded in the keyring signing log",
+		Long: `Print the signatures recorded in the signing log of the keyring, optionally
+only the ones of the given key, after verifying the hash chain of the log. Each
+entry records the key, sign mode and chain ID of a signature with the SHA-256
+digest of the signed bytes.
+
+The signing log is enabled with 'config keyring-signing-log true' and written to
+the keyring-signing.log file of the home directory. The command fails if entries
+of the log were altered or removed, e.g. by another user of a shared machine.`,
+		Args:              cobra.RangeArgs(0, 1),
+		ValidArgsFunction: client.CompleteKeyNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			logFile, _ := cmd.Flags().GetString(flagLogFile)
+			if logFile == "" {
+				logFile = filepath.Join(clientCtx.HomeDir, keyring.SigningLogFileName)
+			}
+
+			entries, err := keyring.ReadSigningLog(logFile)
+			if err != nil {
+				return err
+			}
+			if err := keyring.VerifySigningLog(entries); err != nil {
+				return fmt.Errorf("the signing log %s is corrupted: %w", logFile, err)
+			}
+
+			if len(args) == 1 {
+				keyEntries := make([]keyring.SigningLogEntry, 0, len(entries))
+				for _, entry := range entries {
+					if entry.KeyName == args[0] {
+						keyEntries = append(keyEntries, entry)
+					}
+				}
+				entries = keyEntries
+			}
+
+			var out []byte
+			if clientCtx.OutputFormat == OutputFormatJSON {
+				out, err = json.Marshal(entries)
+			} else {
+				out, err = yaml.Marshal(entries)
+			}
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(out))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagLogFile, "", "The signing log file; if omitted, the keyring-signing.log file of the home directory")
+	return cmd
+}
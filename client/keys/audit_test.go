@@ -0,0 +1,79 @@
+package keys
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func Test_runAuditCmd(t *testing.T) {
+	home := t.TempDir()
+	logFile := filepath.Join(home, keyring.SigningLogFileName)
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, home, nil, keyring.WithSigningLog(logFile))
+	require.NoError(t, err)
+
+	path := sdk.GetConfig().GetFullBIP44Path()
+	for _, uid := range []string{"keyname1", "keyname2"} {
+		_, _, err = kb.NewMnemonic(uid, keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+	}
+	for _, uid := range []string{"keyname1", "keyname2", "keyname1"} {
+		_, _, err = kb.Sign(uid, []byte("message"))
+		require.NoError(t, err)
+	}
+
+	clientCtx := client.Context{}.
+		WithHomeDir(home).
+		WithKeyringDir(home).
+		WithKeyring(kb)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	audit := func(args ...string) ([]keyring.SigningLogEntry, error) {
+		cmd := AuditCommand()
+		cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+		mockIn, mockOut := testutil.ApplyMockIO(cmd)
+		mockIn.Reset("")
+		cmd.SetArgs(append(args, fmt.Sprintf("--%s=%s", cli.OutputFlag, OutputFormatJSON)))
+		if err := cmd.ExecuteContext(ctx); err != nil {
+			return nil, err
+		}
+
+		var entries []keyring.SigningLogEntry
+		require.NoError(t, json.Unmarshal(mockOut.Bytes(), &entries))
+		return entries, nil
+	}
+
+	entries, err := audit()
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	entries, err = audit("keyname1")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, uint64(0), entries[0].Sequence)
+	require.Equal(t, uint64(2), entries[1].Sequence)
+
+	// the command fails once the log is tampered with
+	bz, err := ioutil.ReadFile(logFile)
+	require.NoError(t, err)
+	lines := strings.SplitAfter(string(bz), "\n")
+	tampered := filepath.Join(t.TempDir(), keyring.SigningLogFileName)
+	require.NoError(t, ioutil.WriteFile(tampered, []byte(lines[0]+lines[2]), 0600))
+
+	_, err = audit(fmt.Sprintf("--%s=%s", flagLogFile, tampered))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "is corrupted")
+}
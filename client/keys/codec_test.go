@@ -20,10 +20,10 @@ func getTestCases() testCases {
 	return testCases{
 		// nolint:govet
 		[]keyring.KeyOutput{
-			{"A", "B", "C", "D", "E"},
-			{"A", "B", "C", "D", ""},
-			{"", "B", "C", "D", ""},
-			{"", "", "", "", ""},
+			{"A", "B", "C", "D", "E", nil},
+			{"A", "B", "C", "D", "", nil},
+			{"", "B", "C", "D", "", nil},
+			{"", "", "", "", "", nil},
 		},
 		make([]keyring.KeyOutput, 4),
 		[][]byte{
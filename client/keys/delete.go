@@ -4,6 +4,7 @@ import (
 	"bufio"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/i18n"
 	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 
@@ -26,7 +27,8 @@ Note that removing offline or ledger keys will remove
 only the public key references stored locally, i.e.
 private keys stored in a ledger device cannot be deleted with the CLI.
 `,
-		Args: cobra.MinimumNArgs(1),
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: client.CompleteKeyNames,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			buf := bufio.NewReader(cmd.InOrStdin())
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -42,7 +44,7 @@ private keys stored in a ledger device cannot be deleted with the CLI.
 
 				// confirm deletion, unless -y is passed
 				if skip, _ := cmd.Flags().GetBool(flagYes); !skip {
-					if yes, err := input.GetConfirmation("Key reference will be deleted. Continue?", buf, cmd.ErrOrStderr()); err != nil {
+					if yes, err := input.GetConfirmation(i18n.T("Key reference will be deleted. Continue?"), buf, cmd.ErrOrStderr()); err != nil {
 						return err
 					} else if !yes {
 						continue
@@ -54,10 +56,10 @@ private keys stored in a ledger device cannot be deleted with the CLI.
 				}
 
 				if info.GetType() == keyring.TypeLedger || info.GetType() == keyring.TypeOffline {
-					cmd.PrintErrln("Public key reference deleted")
+					cmd.PrintErrln(i18n.T("Public key reference deleted"))
 					continue
 				}
-				cmd.PrintErrln("Key deleted forever (uh oh!)")
+				cmd.PrintErrln(i18n.T("Key deleted forever (uh oh!)"))
 			}
 
 			return nil
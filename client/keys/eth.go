@@ -0,0 +1,313 @@
+package keys
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/cli"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+const flagBech32Prefix = "bech32-prefix"
+
+// ImportEthKeyCommand imports the secp256k1 private key of an Ethereum account.
+func ImportEthKeyCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import-eth <name> [keystore-file]",
+		Short: "Import an Ethereum private key into the local keybase",
+		Long: `Import the secp256k1 private key of an Ethereum, or any other EVM chain,
+account into the local keybase, either the hexadecimal private key read from
+the input or the keystore v3 JSON file of the account, e.g. exported by geth or
+MetaMask, decrypted with its passphrase.
+
+The imported key is a regular secp256k1 key: its address is derived from its
+public key as any account of this chain, and so differs from its Ethereum
+address. Both addresses are printed.
+`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+
+			var privKeyBz []byte
+			if len(args) == 2 {
+				bz, err := ioutil.ReadFile(args[1])
+				if err != nil {
+					return err
+				}
+
+				passphrase, err := input.GetPassword("Enter passphrase to decrypt your keystore:", buf)
+				if err != nil {
+					return err
+				}
+
+				if privKeyBz, err = decryptKeystoreV3(bz, passphrase); err != nil {
+					return err
+				}
+			} else {
+				hexKey, err := input.GetPassword("Enter your hex private key:", buf)
+				if err != nil {
+					return err
+				}
+
+				if privKeyBz, err = hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(hexKey), "0x")); err != nil {
+					return fmt.Errorf("invalid hex private key: %w", err)
+				}
+			}
+
+			if len(privKeyBz) != secp256k1.PrivKeySize {
+				return fmt.Errorf("invalid private key length %d, expected %d", len(privKeyBz), secp256k1.PrivKeySize)
+			}
+			privKey := &secp256k1.PrivKey{Key: privKeyBz}
+
+			info, err := importUnarmoredPrivKey(clientCtx.Keyring, args[0], privKey, hd.Secp256k1Type)
+			if err != nil {
+				return err
+			}
+
+			ethAddr, err := ethAddressFromPubKey(privKey.PubKey().(*secp256k1.PubKey))
+			if err != nil {
+				return err
+			}
+
+			cmd.PrintErrf("Ethereum address: %s\n", ethAddr)
+			printKeyInfo(cmd.OutOrStdout(), info, keyring.MkAccKeyOutput, clientCtx.OutputFormat)
+
+			return nil
+		},
+	}
+}
+
+type convertAddressOutput struct {
+	Hex    string `json:"hex"`
+	Bech32 string `json:"bech32"`
+}
+
+func (o convertAddressOutput) String() string {
+	return fmt.Sprintf("Hex: %s\nBech32: %s", o.Hex, o.Bech32)
+}
+
+// ConvertAddressCommand converts an address between its Ethereum hex and bech32
+// forms.
+func ConvertAddressCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-address <eth-hex-or-bech32-address>",
+		Short: "Convert an address between its Ethereum hex and bech32 forms",
+		Long: `Convert an address from its 0x prefixed, Ethereum style, hexadecimal form to
+its bech32 form and vice versa. The hex form is EIP-55 checksummed and the
+bech32 form uses the account prefix unless --bech32-prefix is given.
+
+The conversion maps the address bytes and does not derive addresses: the same
+secp256k1 key has different Ethereum and SDK addresses, see import-eth.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			prefix, _ := cmd.Flags().GetString(flagBech32Prefix)
+			if prefix == "" {
+				prefix = sdk.GetConfig().GetBech32AccountAddrPrefix()
+			}
+
+			bz, err := parseEthOrBech32Address(strings.TrimSpace(args[0]))
+			if err != nil {
+				return err
+			}
+
+			bech32Addr, err := bech32.ConvertAndEncode(prefix, bz)
+			if err != nil {
+				return err
+			}
+
+			output, _ := cmd.Flags().GetString(cli.OutputFlag)
+			displayParseKeyInfo(cmd.OutOrStdout(), convertAddressOutput{Hex: checksumHexAddress(bz), Bech32: bech32Addr}, output)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagBech32Prefix, "", "The bech32 prefix of the converted address, the account prefix by default")
+
+	return cmd
+}
+
+// parseEthOrBech32Address returns the bytes of an hex, with an optional 0x
+// prefix, or bech32 address.
+func parseEthOrBech32Address(addr string) ([]byte, error) {
+	if len(addr) == 0 {
+		return nil, errors.New("couldn't parse empty input")
+	}
+
+	if bz, err := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(addr, "0x"), "0X")); err == nil {
+		if err := sdk.VerifyAddressFormat(bz); err != nil {
+			return nil, err
+		}
+		return bz, nil
+	}
+
+	if _, bz, err := bech32.DecodeAndConvert(addr); err == nil {
+		return bz, nil
+	}
+
+	return nil, errors.New("couldn't find valid hex nor bech32 address")
+}
+
+// ethAddressFromPubKey returns the EIP-55 checksummed Ethereum address of a
+// secp256k1 public key, i.e. the last 20 bytes of the keccak256 hash of the
+// uncompressed public key.
+func ethAddressFromPubKey(pubKey *secp256k1.PubKey) (string, error) {
+	pub, err := btcec.ParsePubKey(pubKey.Key, btcec.S256())
+	if err != nil {
+		return "", err
+	}
+
+	return checksumHexAddress(keccak256(pub.SerializeUncompressed()[1:])[12:]), nil
+}
+
+// checksumHexAddress returns the 0x prefixed, EIP-55 checksummed, hex form of
+// an address.
+func checksumHexAddress(addr []byte) string {
+	lower := hex.EncodeToString(addr)
+	hash := keccak256([]byte(lower))
+
+	checksummed := []byte(lower)
+	for i, c := range checksummed {
+		// upper case the letters whose hash nibble is at least 8
+		nibble := hash[i/2] >> 4
+		if i%2 == 1 {
+			nibble = hash[i/2] & 0x0f
+		}
+		if c >= 'a' && c <= 'f' && nibble >= 8 {
+			checksummed[i] = c - 'a' + 'A'
+		}
+	}
+
+	return "0x" + string(checksummed)
+}
+
+func keccak256(data ...[]byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	for _, bz := range data {
+		hasher.Write(bz)
+	}
+	return hasher.Sum(nil)
+}
+
+// keystoreV3 is an Ethereum keystore v3, see
+// https://github.com/ethereum/wiki/wiki/Web3-Secret-Storage-Definition.
+type keystoreV3 struct {
+	Address string `json:"address"`
+	Version int    `json:"version"`
+	Crypto  struct {
+		Cipher       string `json:"cipher"`
+		CipherText   string `json:"ciphertext"`
+		CipherParams struct {
+			IV string `json:"iv"`
+		} `json:"cipherparams"`
+		KDF       string `json:"kdf"`
+		KDFParams struct {
+			DKLen int    `json:"dklen"`
+			Salt  string `json:"salt"`
+			// scrypt
+			N int `json:"n"`
+			R int `json:"r"`
+			P int `json:"p"`
+			// pbkdf2
+			C   int    `json:"c"`
+			PRF string `json:"prf"`
+		} `json:"kdfparams"`
+		MAC string `json:"mac"`
+	} `json:"crypto"`
+}
+
+// decryptKeystoreV3 returns the private key of an Ethereum keystore v3.
+func decryptKeystoreV3(bz []byte, passphrase string) ([]byte, error) {
+	var ks keystoreV3
+	if err := json.Unmarshal(bz, &ks); err != nil {
+		return nil, fmt.Errorf("invalid keystore: %w", err)
+	}
+
+	if ks.Version != 3 {
+		return nil, fmt.Errorf("unsupported keystore version %d, expected 3", ks.Version)
+	}
+	if ks.Crypto.Cipher != "aes-128-ctr" {
+		return nil, fmt.Errorf("unsupported keystore cipher %s", ks.Crypto.Cipher)
+	}
+
+	salt, err := hex.DecodeString(ks.Crypto.KDFParams.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid keystore salt: %w", err)
+	}
+	params := ks.Crypto.KDFParams
+	if params.DKLen < 32 {
+		return nil, fmt.Errorf("invalid keystore derived key length %d", params.DKLen)
+	}
+
+	var derivedKey []byte
+	switch ks.Crypto.KDF {
+	case "scrypt":
+		if derivedKey, err = scrypt.Key([]byte(passphrase), salt, params.N, params.R, params.P, params.DKLen); err != nil {
+			return nil, err
+		}
+
+	case "pbkdf2":
+		if params.PRF != "hmac-sha256" {
+			return nil, fmt.Errorf("unsupported keystore pseudorandom function %s", params.PRF)
+		}
+		derivedKey = pbkdf2.Key([]byte(passphrase), salt, params.C, params.DKLen, sha256.New)
+
+	default:
+		return nil, fmt.Errorf("unsupported keystore key derivation function %s", ks.Crypto.KDF)
+	}
+
+	cipherText, err := hex.DecodeString(ks.Crypto.CipherText)
+	if err != nil {
+		return nil, fmt.Errorf("invalid keystore ciphertext: %w", err)
+	}
+	mac, err := hex.DecodeString(ks.Crypto.MAC)
+	if err != nil {
+		return nil, fmt.Errorf("invalid keystore mac: %w", err)
+	}
+	if !bytes.Equal(keccak256(derivedKey[16:32], cipherText), mac) {
+		return nil, errors.New("invalid keystore passphrase")
+	}
+
+	iv, err := hex.DecodeString(ks.Crypto.CipherParams.IV)
+	if err != nil {
+		return nil, fmt.Errorf("invalid keystore iv: %w", err)
+	}
+	block, err := aes.NewCipher(derivedKey[:16])
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != block.BlockSize() {
+		return nil, fmt.Errorf("invalid keystore iv length %d", len(iv))
+	}
+
+	privKey := make([]byte, len(cipherText))
+	cipher.NewCTR(block, iv).XORKeyStream(privKey, cipherText)
+
+	return privKey, nil
+}
@@ -0,0 +1,144 @@
+package keys
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// keystore v3 test vectors of the Web3 Secret Storage Definition, with the
+// passphrase "testpassword".
+const (
+	keystorePrivKey = "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d"
+	keystorePBKDF2  = `{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"6087dab2f9fdbbfaddc31a909735c1e6"},"ciphertext":"5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46","kdf":"pbkdf2","kdfparams":{"c":262144,"dklen":32,"prf":"hmac-sha256","salt":"ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"},"mac":"517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"},"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`
+	keystoreScrypt  = `{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"83dbcc02d8ccb40e466191a123791e0e"},"ciphertext":"d172bf743a674da9cdad04534d56926ef8358534d458fffccd4e6ad2fbde479c","kdf":"scrypt","kdfparams":{"dklen":32,"n":262144,"r":1,"p":8,"salt":"ab0c7876052600dd703518d6fc3fe8984592145b591fc8fb5c6d43190334ba19"},"mac":"2103ac29920d71da29f15d75b4a16dbe95cfd7ff8faea1056c33131d846e3097"},"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`
+)
+
+func TestChecksumHexAddress(t *testing.T) {
+	// EIP-55 test vectors
+	for _, addr := range []string{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+		"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+	} {
+		bz, err := hex.DecodeString(strings.ToLower(addr[2:]))
+		require.NoError(t, err)
+		require.Equal(t, addr, checksumHexAddress(bz))
+	}
+}
+
+func TestEthAddressFromPubKey(t *testing.T) {
+	key := make([]byte, secp256k1.PrivKeySize)
+	key[len(key)-1] = 1
+	privKey := &secp256k1.PrivKey{Key: key}
+
+	addr, err := ethAddressFromPubKey(privKey.PubKey().(*secp256k1.PubKey))
+	require.NoError(t, err)
+	require.Equal(t, "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf", addr)
+}
+
+func TestDecryptKeystoreV3(t *testing.T) {
+	for _, keystore := range []string{keystorePBKDF2, keystoreScrypt} {
+		privKey, err := decryptKeystoreV3([]byte(keystore), "testpassword")
+		require.NoError(t, err)
+		require.Equal(t, keystorePrivKey, hex.EncodeToString(privKey))
+
+		_, err = decryptKeystoreV3([]byte(keystore), "wrongpassword")
+		require.EqualError(t, err, "invalid keystore passphrase")
+	}
+
+	_, err := decryptKeystoreV3([]byte(strings.Replace(keystorePBKDF2, `"version":3`, `"version":2`, 1)), "testpassword")
+	require.Error(t, err)
+}
+
+func Test_runImportEthCmd(t *testing.T) {
+	keyfile := filepath.Join(t.TempDir(), "keystore.json")
+	require.NoError(t, ioutil.WriteFile(keyfile, []byte(keystorePBKDF2), 0600))
+
+	privKeyBz, err := hex.DecodeString(keystorePrivKey)
+	require.NoError(t, err)
+	addr := sdk.AccAddress((&secp256k1.PrivKey{Key: privKeyBz}).PubKey().Address())
+
+	testCases := []struct {
+		name        string
+		args        []string
+		userInput   string
+		expectError bool
+	}{
+		{"hex key", []string{"keyname1"}, "0x" + keystorePrivKey + "\n", false},
+		{"keystore", []string{"keyname2", keyfile}, "testpassword\n", false},
+		{"invalid hex key", []string{"keyname3"}, "0x" + keystorePrivKey[2:] + "\n", true},
+		{"wrong keystore passphrase", []string{"keyname4", keyfile}, "wrongpassword\n", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// the test cases import the same key, each in its own keybase
+			kbHome := t.TempDir()
+			kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, nil)
+			require.NoError(t, err)
+
+			cmd := ImportEthKeyCommand()
+			cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+			mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+
+			clientCtx := client.Context{}.
+				WithKeyringDir(kbHome).
+				WithKeyring(kb).
+				WithInput(mockIn)
+			ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+			mockIn.Reset(tc.userInput)
+			cmd.SetArgs(append(tc.args, fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest)))
+
+			err = cmd.ExecuteContext(ctx)
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			info, err := kb.Key(tc.args[0])
+			require.NoError(t, err)
+			require.Equal(t, addr, info.GetAddress())
+		})
+	}
+}
+
+func TestConvertAddressCmd(t *testing.T) {
+	addr := sdk.AccAddress([]byte("convert-address-test"))
+	hexAddr := checksumHexAddress(addr)
+
+	for _, arg := range []string{hexAddr, strings.ToLower(hexAddr[2:]), addr.String()} {
+		cmd := ConvertAddressCommand()
+		cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+		testutil.ApplyMockIODiscardOutErr(cmd)
+
+		out := new(strings.Builder)
+		cmd.SetOut(out)
+		cmd.SetArgs([]string{arg, fmt.Sprintf("--%s=%s", cli.OutputFlag, OutputFormatJSON)})
+		require.NoError(t, cmd.Execute())
+		require.Equal(t, fmt.Sprintf(`{"hex":"%s","bech32":"%s"}`+"\n", hexAddr, addr.String()), out.String())
+	}
+
+	cmd := ConvertAddressCommand()
+	cmd.SetOut(new(strings.Builder))
+	cmd.SetErr(new(strings.Builder))
+	cmd.SetArgs([]string{"invalid"})
+	require.Error(t, cmd.Execute())
+}
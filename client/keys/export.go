@@ -7,13 +7,16 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/i18n"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 )
 
 const (
 	flagUnarmoredHex = "unarmored-hex"
 	flagUnsafe       = "unsafe"
+	flagCipher       = "cipher"
 )
 
 // ExportKeyCommand exports private keys from the key store.
@@ -28,8 +31,13 @@ private key material is exported in an INSECURE fashion that is designed to
 allow users to import their keys in hot wallets. This feature is for advanced
 users only that are confident about how to handle private keys work and are
 FULLY AWARE OF THE RISKS. If you are unsure, you may want to do some research
-and export your keys in ASCII-armored encrypted format.`,
-		Args: cobra.ExactArgs(1),
+and export your keys in ASCII-armored encrypted format.
+
+The key is encrypted with xsalsa20 by default. With --cipher sm4, it is
+encrypted with SM4 and keyed by PBKDF2 over SM3 instead, complying with the
+Chinese national cryptographic standards.`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: client.CompleteKeyNames,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -50,7 +58,8 @@ and export your keys in ASCII-armored encrypted format.`,
 				return err
 			}
 
-			armored, err := clientCtx.Keyring.ExportPrivKeyArmor(args[0], encryptPassword)
+			cipher, _ := cmd.Flags().GetString(flagCipher)
+			armored, err := clientCtx.Keyring.ExportPrivKeyArmorWithCipher(args[0], encryptPassword, cipher)
 			if err != nil {
 				return err
 			}
@@ -62,6 +71,7 @@ and export your keys in ASCII-armored encrypted format.`,
 	}
 
 	cmd.Flags().Bool(flagUnarmoredHex, false, "Export unarmored hex privkey. Requires --unsafe.")
+	cmd.Flags().String(flagCipher, crypto.CipherXsalsa20, fmt.Sprintf("The cipher encrypting the exported key (%s|%s)", crypto.CipherXsalsa20, crypto.CipherSm4))
 	cmd.Flags().Bool(flagUnsafe, false, "Enable unsafe operations. This flag must be switched on along with all unsafe operation-specific options.")
 
 	return cmd
@@ -69,7 +79,7 @@ and export your keys in ASCII-armored encrypted format.`,
 
 func exportUnsafeUnarmored(cmd *cobra.Command, uid string, buf *bufio.Reader, kr keyring.Keyring) error {
 	// confirm deletion, unless -y is passed
-	if yes, err := input.GetConfirmation("WARNING: The private key will be exported as an unarmored hexadecimal string. USE AT YOUR OWN RISK. Continue?", buf, cmd.ErrOrStderr()); err != nil {
+	if yes, err := input.GetConfirmation(i18n.T("WARNING: The private key will be exported as an unarmored hexadecimal string. USE AT YOUR OWN RISK. Continue?"), buf, cmd.ErrOrStderr()); err != nil {
 		return err
 	} else if !yes {
 		return nil
@@ -4,11 +4,14 @@ import (
 	"bufio"
 	"context"
 	"fmt"
+	"io/ioutil"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -111,3 +114,55 @@ func Test_runExportCmd(t *testing.T) {
 		})
 	}
 }
+
+func Test_runExportImportCmdSm4(t *testing.T) {
+	kbHome := t.TempDir()
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, nil)
+	require.NoError(t, err)
+	info, err := kb.NewAccount("keyname1", testdata.TestMnemonic, "", sdk.GetConfig().GetFullBIP44Path(), hd.Secp256k1)
+	require.NoError(t, err)
+
+	cmd := ExportKeyCommand()
+	cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+	cmd.SetArgs([]string{"keyname1", fmt.Sprintf("--%s=%s", flagCipher, crypto.CipherSm4)})
+	mockIn, mockOut := testutil.ApplyMockIO(cmd)
+	mockIn.Reset("12345678\n")
+	clientCtx := client.Context{}.WithKeyringDir(kbHome).WithKeyring(kb).WithInput(mockIn)
+	require.NoError(t, cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)))
+
+	armored := mockOut.String()
+	require.Contains(t, armored, "cipher: sm4")
+	require.Contains(t, armored, "kdf: pbkdf2-sm3")
+	keyfile := filepath.Join(kbHome, "key.asc")
+	require.NoError(t, ioutil.WriteFile(keyfile, []byte(armored), 0644))
+
+	importKey := func(cipher string) error {
+		kb := keyring.NewInMemory()
+		cmd := ImportKeyCommand()
+		cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+		cmd.SetArgs([]string{"keyname1", keyfile, fmt.Sprintf("--%s=%s", flagCipher, cipher)})
+		mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+		mockIn.Reset("12345678\n")
+		clientCtx := client.Context{}.WithKeyring(kb).WithInput(mockIn)
+		if err := cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)); err != nil {
+			return err
+		}
+
+		imported, err := kb.Key("keyname1")
+		require.NoError(t, err)
+		require.Equal(t, info.GetAddress(), imported.GetAddress())
+		return nil
+	}
+
+	require.NoError(t, importKey(crypto.CipherSm4))
+	require.NoError(t, importKey(""))
+	require.Error(t, importKey(crypto.CipherXsalsa20))
+
+	cmd = ExportKeyCommand()
+	cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+	cmd.SetArgs([]string{"keyname1", fmt.Sprintf("--%s=unknown", flagCipher)})
+	mockIn = testutil.ApplyMockIODiscardOutErr(cmd)
+	mockIn.Reset("12345678\n")
+	clientCtx = client.Context{}.WithKeyringDir(kbHome).WithKeyring(kb).WithInput(mockIn)
+	require.Error(t, cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)))
+}
@@ -2,21 +2,30 @@ package keys
 
 import (
 	"bufio"
+	"fmt"
 	"io/ioutil"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
 // ImportKeyCommand imports private keys from a keyfile.
 func ImportKeyCommand() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "import <name> <keyfile>",
 		Short: "Import private keys into the local keybase",
-		Long:  "Import a ASCII armored private key into the local keybase.",
-		Args:  cobra.ExactArgs(2),
+		Long: `Import a ASCII armored private key into the local keybase.
+
+The cipher of the key, xsalsa20 or sm4, is read from the armor. With --cipher,
+the key must be encrypted with the given cipher, e.g. sm4 for the deployments
+required to use the Chinese national cryptographic standards.`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -29,6 +38,16 @@ func ImportKeyCommand() *cobra.Command {
 				return err
 			}
 
+			if expected, _ := cmd.Flags().GetString(flagCipher); expected != "" {
+				cipher, err := crypto.ArmorCipher(string(bz))
+				if err != nil {
+					return err
+				}
+				if cipher != expected {
+					return fmt.Errorf("the key is encrypted with %s, expected %s", cipher, expected)
+				}
+			}
+
 			passphrase, err := input.GetPassword("Enter passphrase to decrypt your key:", buf)
 			if err != nil {
 				return err
@@ -37,4 +56,22 @@ func ImportKeyCommand() *cobra.Command {
 			return clientCtx.Keyring.ImportPrivKey(args[0], string(bz), passphrase)
 		},
 	}
+
+	cmd.Flags().String(flagCipher, "", fmt.Sprintf("Require the key to be encrypted with the given cipher (%s|%s)", crypto.CipherXsalsa20, crypto.CipherSm4))
+
+	return cmd
+}
+
+// importUnarmoredPrivKey imports a private key into the keyring and returns
+// the info of the imported key.
+func importUnarmoredPrivKey(kr keyring.Keyring, uid string, privKey cryptotypes.PrivKey, algo hd.PubKeyType) (keyring.Info, error) {
+	// the keyring only imports armored private keys, the passphrase only
+	// protects the armor in memory
+	const armorPassphrase = "import"
+	armor := crypto.EncryptArmorPrivKey(privKey, armorPassphrase, string(algo))
+	if err := kr.ImportPrivKey(uid, armor, armorPassphrase); err != nil {
+		return nil, err
+	}
+
+	return kr.Key(uid)
 }
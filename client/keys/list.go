@@ -4,6 +4,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 )
 
 const flagListNames = "list-names"
@@ -19,6 +20,7 @@ along with their associated name and address.`,
 	}
 
 	cmd.Flags().BoolP(flagListNames, "n", false, "List names only")
+	cmd.Flags().Bool(flagWatch, false, "List watch-only keys only")
 	return cmd
 }
 
@@ -33,6 +35,16 @@ func runListCmd(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
+	if watch, _ := cmd.Flags().GetBool(flagWatch); watch {
+		watchInfos := make([]keyring.Info, 0, len(infos))
+		for _, info := range infos {
+			if info.GetType() == keyring.TypeWatch {
+				watchInfos = append(watchInfos, info)
+			}
+		}
+		infos = watchInfos
+	}
+
 	if ok, _ := cmd.Flags().GetBool(flagListNames); !ok {
 		printInfos(cmd.OutOrStdout(), infos, clientCtx.OutputFormat)
 		return nil
@@ -35,8 +35,13 @@ func Test_runListCmd(t *testing.T) {
 	_, err = kb.NewAccount("something", testdata.TestMnemonic, "", path, hd.Secp256k1)
 	require.NoError(t, err)
 
+	_, _, watchAddr := testdata.KeyTestPubAddr()
+	_, err = kb.SaveWatchKey("watched", watchAddr, nil, []string{"custody"})
+	require.NoError(t, err)
+
 	t.Cleanup(func() {
 		kb.Delete("something") // nolint:errcheck
+		kb.Delete("watched")   // nolint:errcheck
 	})
 
 	type args struct {
@@ -72,6 +77,16 @@ func Test_runListCmd(t *testing.T) {
 			if err := cmd.ExecuteContext(ctx); (err != nil) != tt.wantErr {
 				t.Errorf("runListCmd() error = %v, wantErr %v", err, tt.wantErr)
 			}
+
+			cmd.SetArgs([]string{
+				fmt.Sprintf("--%s=%s", flags.FlagHome, tt.kbDir),
+				fmt.Sprintf("--%s=true", flagListNames),
+				fmt.Sprintf("--%s=true", flagWatch),
+			})
+
+			if err := cmd.ExecuteContext(ctx); (err != nil) != tt.wantErr {
+				t.Errorf("runListCmd() error = %v, wantErr %v", err, tt.wantErr)
+			}
 		})
 	}
 }
@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
@@ -19,11 +22,26 @@ import (
 // is not needed for importing into the Keyring keystore.
 const migratePassphrase = "NOOP_PASSPHRASE"
 
+const (
+	flagFromBackend = "from"
+	flagToBackend   = "to"
+)
+
+// Actions reported for each key migrated between keyring backends.
+const (
+	migrateActionMigrate   = "migrate"
+	migrateActionMigrated  = "migrated"
+	migrateActionPresent   = "already present"
+	migrateActionConflict  = "conflict"
+	migrateActionOverwrite = "overwritten"
+	migrateActionSkipped   = "skipped"
+)
+
 // MigrateCommand migrates key information from legacy keybase to OS secret store.
 func MigrateCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "migrate <old_home_dir>",
-		Short: "Migrate keys from the legacy (db-based) Keybase",
+		Use:   "migrate [old_home_dir]",
+		Short: "Migrate keys from the legacy (db-based) Keybase or between keyring backends",
 		Long: `Migrate key information from the legacy (db-based) Keybase to the new keyring-based Keyring.
 The legacy Keybase used to persist keys in a LevelDB database stored in a 'keys' sub-directory of
 the old client application's home directory, e.g. $HOME/.gaiacli/keys/.
@@ -31,13 +49,40 @@ For each key material entry, the command will prompt if the key should be skippe
 is not to be skipped, the passphrase must be entered. The key will only be migrated if the passphrase
 is correct. Otherwise, the command will exit and migration must be repeated.
 
+When the --from and --to flags are provided instead of the old home directory, all keys, including
+ledger, offline and multisig keys, are moved from one keyring backend to another one, e.g.:
+
+    $ keys migrate --from file --to os
+
+The address of every key is re-derived from its key material in both keyrings to verify the integrity
+of the migration. If a key with the same name but a different address already exists in the
+destination keyring, the command prompts whether it should be overwritten. Keys are left untouched in
+the source keyring, which can be cleared once the migration has been verified.
+
 It is recommended to run in 'dry-run' mode first to verify all key migration material.
 `,
-		Args: cobra.ExactArgs(1),
-		RunE: runMigrateCmd,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			from, _ := cmd.Flags().GetString(flagFromBackend)
+			if from != "" {
+				if len(args) != 0 {
+					return fmt.Errorf("the old home directory cannot be used with --%s", flagFromBackend)
+				}
+
+				return runMigrateBackendCmd(cmd)
+			}
+
+			if len(args) != 1 {
+				return fmt.Errorf("either the old home directory or --%s is required", flagFromBackend)
+			}
+
+			return runMigrateCmd(cmd, args)
+		},
 	}
 
 	cmd.Flags().Bool(flags.FlagDryRun, false, "Run migration without actually persisting any changes to the new Keybase")
+	cmd.Flags().String(flagFromBackend, "", "The keyring backend to migrate keys from (os|file|kwallet|pass|test)")
+	cmd.Flags().String(flagToBackend, "", "The keyring backend to migrate keys to (os|file|kwallet|pass|test)")
 	return cmd
 }
 
@@ -145,3 +190,173 @@ func runMigrateCmd(cmd *cobra.Command, args []string) error {
 
 	return err
 }
+
+func runMigrateBackendCmd(cmd *cobra.Command) error {
+	from, _ := cmd.Flags().GetString(flagFromBackend)
+	to, _ := cmd.Flags().GetString(flagToBackend)
+	dryRun, _ := cmd.Flags().GetBool(flags.FlagDryRun)
+
+	if to == "" {
+		return fmt.Errorf("--%s is required with --%s", flagToBackend, flagFromBackend)
+	}
+	if from == to {
+		return fmt.Errorf("cannot migrate keys from the %s backend to itself", from)
+	}
+
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	keyringDir, _ := cmd.Flags().GetString(flags.FlagKeyringDir)
+	if keyringDir == "" {
+		keyringDir = clientCtx.KeyringDir
+	}
+	if keyringDir == "" {
+		keyringDir, _ = cmd.Flags().GetString(flags.FlagHome)
+	}
+
+	buf := bufio.NewReader(cmd.InOrStdin())
+	keyringServiceName := sdk.KeyringServiceName()
+
+	src, err := keyring.New(keyringServiceName, from, keyringDir, buf, clientCtx.KeyringOptions...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to initialize %s keyring at directory %s", from, keyringDir)
+	}
+
+	dst, err := keyring.New(keyringServiceName, to, keyringDir, buf, clientCtx.KeyringOptions...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to initialize %s keyring at directory %s", to, keyringDir)
+	}
+
+	infos, err := src.List()
+	if err != nil {
+		return err
+	}
+
+	if len(infos) == 0 {
+		cmd.PrintErrln("Migration Aborted: no keys to migrate")
+		return nil
+	}
+
+	importer, ok := dst.(keyring.LegacyInfoImporter)
+	if !ok {
+		return fmt.Errorf("the %s keyring does not support import operations of Info types", to)
+	}
+
+	report := make([][]string, 0, len(infos))
+	for _, info := range infos {
+		// re-derive the address from the key material of the source keyring
+		if err := verifyKeyAddress(src, info); err != nil {
+			return errors.Wrapf(err, "%s keyring integrity check failed", from)
+		}
+
+		action, err := migrateKey(cmd, buf, dst, importer, info, dryRun)
+		if err != nil {
+			return errors.Wrapf(err, "failed to migrate key %s", info.GetName())
+		}
+
+		report = append(report, []string{info.GetName(), info.GetType().String(), info.GetAddress().String(), action})
+	}
+
+	w := tabwriter.NewWriter(cmd.ErrOrStderr(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tTYPE\tADDRESS\tACTION")
+	for _, row := range report {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if dryRun {
+		cmd.PrintErrln("Dry run complete, no key was migrated.")
+	} else {
+		cmd.PrintErrln("Migration complete.")
+	}
+
+	return nil
+}
+
+// migrateKey migrates a key to the destination keyring, prompting whether a
+// conflicting key of the destination keyring should be overwritten, and
+// returns the action taken.
+func migrateKey(
+	cmd *cobra.Command, buf *bufio.Reader, dst keyring.Keyring, importer keyring.LegacyInfoImporter, info keyring.Info, dryRun bool,
+) (string, error) {
+	action := migrateActionMigrated
+	if dryRun {
+		action = migrateActionMigrate
+	}
+
+	// a key is stored under a single name in a keyring
+	if existing, err := dst.KeyByAddress(info.GetAddress()); err == nil {
+		if existing.GetName() == info.GetName() {
+			return migrateActionPresent, nil
+		}
+
+		cmd.PrintErrf("Key %s is already stored as %s in the destination keyring, skipping.\n", info.GetName(), existing.GetName())
+		return migrateActionSkipped, nil
+	}
+
+	if existing, err := dst.Key(info.GetName()); err == nil {
+		if dryRun {
+			return migrateActionConflict, nil
+		}
+
+		overwrite, err := input.GetConfirmation(
+			fmt.Sprintf("Key %s already exists in the destination keyring with address %s. Overwrite with address %s?",
+				info.GetName(), existing.GetAddress(), info.GetAddress()),
+			buf, cmd.ErrOrStderr(),
+		)
+		if err != nil {
+			return "", err
+		}
+		if !overwrite {
+			return migrateActionSkipped, nil
+		}
+
+		if err := dst.Delete(info.GetName()); err != nil {
+			return "", err
+		}
+
+		action = migrateActionOverwrite
+	}
+
+	if dryRun {
+		return action, nil
+	}
+
+	if err := importer.ImportInfo(info); err != nil {
+		return "", err
+	}
+
+	// re-derive the address from the key material of the destination keyring
+	migrated, err := dst.Key(info.GetName())
+	if err != nil {
+		return "", err
+	}
+	if err := verifyKeyAddress(dst, migrated); err != nil {
+		return "", err
+	}
+	if !migrated.GetAddress().Equals(info.GetAddress()) {
+		return "", fmt.Errorf("migrated key address %s does not match %s", migrated.GetAddress(), info.GetAddress())
+	}
+
+	return action, nil
+}
+
+// verifyKeyAddress checks that the address of a key matches the address
+// derived from its private key, for local keys, or from its public key.
+func verifyKeyAddress(kr keyring.Keyring, info keyring.Info) error {
+	pubKey := info.GetPubKey()
+	if info.GetType() == keyring.TypeLocal {
+		priv, err := kr.ExportPrivateKeyObject(info.GetName())
+		if err != nil {
+			return err
+		}
+
+		pubKey = priv.PubKey()
+	}
+
+	if addr := sdk.AccAddressFromPubKey(pubKey); !addr.Equals(info.GetAddress()) {
+		return fmt.Errorf("key %s: address %s does not match the address %s derived from its key material", info.GetName(), info.GetAddress(), addr)
+	}
+
+	return nil
+}
@@ -1,8 +1,10 @@
 package keys
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -13,8 +15,13 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func Test_runMigrateCmd(t *testing.T) {
@@ -40,3 +47,84 @@ func Test_runMigrateCmd(t *testing.T) {
 	t.Log(mockOut.String())
 	assert.NoError(t, cmd.ExecuteContext(ctx))
 }
+
+func Test_runMigrateBackendCmd(t *testing.T) {
+	kbHome := t.TempDir()
+	clientCtx := client.Context{}.WithKeyringDir(kbHome)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	// populate the source keyring with local, offline and multisig keys
+	src, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, nil)
+	require.NoError(t, err)
+	local, _, err := src.NewMnemonic("local", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	_, err = src.SavePubKey("offline", pubKey, hd.Secp256k1Type)
+	require.NoError(t, err)
+	_, err = src.SaveMultisig("multi", multisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{pubKey}))
+	require.NoError(t, err)
+
+	// the destination keyring has a conflicting key
+	dstIn := bufio.NewReader(strings.NewReader("12345678\n12345678\n"))
+	dst, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendFile, kbHome, dstIn)
+	require.NoError(t, err)
+	conflict, _, err := dst.NewMnemonic("local", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	runCmd := func(mockInput string, args ...string) string {
+		cmd := MigrateCommand()
+		cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+		mockIn, mockOut := testutil.ApplyMockIO(cmd)
+		mockIn.Reset(mockInput)
+
+		cmd.SetArgs(append([]string{
+			fmt.Sprintf("--%s=%s", flagFromBackend, keyring.BackendTest),
+			fmt.Sprintf("--%s=%s", flagToBackend, keyring.BackendFile),
+		}, args...))
+		require.NoError(t, cmd.ExecuteContext(ctx))
+
+		return mockOut.String()
+	}
+
+	// a dry run reports the conflict and migrates nothing
+	out := runCmd("12345678\n", fmt.Sprintf("--%s=true", flags.FlagDryRun))
+	require.Contains(t, out, migrateActionConflict)
+	require.Contains(t, out, "Dry run complete")
+	dst, err = keyring.New(sdk.KeyringServiceName(), keyring.BackendFile, kbHome, bufio.NewReader(strings.NewReader("12345678\n")))
+	require.NoError(t, err)
+	infos, err := dst.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+
+	// the conflicting key is overwritten after confirmation
+	out = runCmd("12345678\ny\n")
+	require.Contains(t, out, migrateActionOverwrite)
+	require.Contains(t, out, "Migration complete.")
+
+	dst, err = keyring.New(sdk.KeyringServiceName(), keyring.BackendFile, kbHome, bufio.NewReader(strings.NewReader("12345678\n")))
+	require.NoError(t, err)
+	infos, err = dst.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 3)
+
+	migrated, err := dst.Key("local")
+	require.NoError(t, err)
+	require.Equal(t, local.GetAddress(), migrated.GetAddress())
+	require.NotEqual(t, conflict.GetAddress(), migrated.GetAddress())
+	_, err = dst.ExportPrivateKeyObject("local")
+	require.NoError(t, err)
+
+	// keys already present are skipped
+	out = runCmd("12345678\n")
+	require.Contains(t, out, migrateActionPresent)
+
+	// the source and destination backends must differ
+	cmd := MigrateCommand()
+	cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+	testutil.ApplyMockIODiscardOutErr(cmd)
+	cmd.SetArgs([]string{
+		fmt.Sprintf("--%s=%s", flagFromBackend, keyring.BackendTest),
+		fmt.Sprintf("--%s=%s", flagToBackend, keyring.BackendTest),
+	})
+	require.Error(t, cmd.ExecuteContext(ctx))
+}
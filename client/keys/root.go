@@ -41,12 +41,17 @@ The pass backend requires GnuPG: https://gnupg.org/
 		MnemonicKeyCommand(),
 		AddKeyCommand(),
 		ExportKeyCommand(),
+		BackupKeyCommand(),
+		RestoreKeyCommand(),
 		ImportKeyCommand(),
+		ImportEthKeyCommand(),
 		ListKeysCmd(),
 		ShowKeysCmd(),
 		DeleteKeyCommand(),
 		ParseKeyStringCommand(),
+		ConvertAddressCommand(),
 		MigrateCommand(),
+		AuditCommand(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
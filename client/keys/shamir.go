@@ -0,0 +1,172 @@
+package keys
+
+import (
+	"bufio"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/i18n"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/slip39"
+)
+
+const flagShamir = "shamir"
+
+// BackupKeyCommand backs up a private key as SLIP-39 mnemonic shares.
+func BackupKeyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backup <name> --shamir <threshold>-of-<count>",
+		Short: "Back up a private key as Shamir mnemonic shares",
+		Long: `Back up the private key of a local secp256k1 key as a set of SLIP-39 (Shamir's
+Secret-Sharing for Mnemonic Codes) mnemonic shares, any threshold of which
+restore the key with 'keys restore --shamir', while fewer shares reveal nothing
+about it. For instance, with --shamir 3-of-5 the 5 shares can be given to
+distinct custodians and any 3 of them restore the key.
+
+The shares encode the private key, not the BIP39 mnemonic of the key, and are
+not protected by a SLIP-39 passphrase: each share must be kept as safe as a
+mnemonic.`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: client.CompleteKeyNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+
+			shamir, _ := cmd.Flags().GetString(flagShamir)
+			threshold, count, err := parseShamirScheme(shamir)
+			if err != nil {
+				return err
+			}
+
+			info, err := clientCtx.Keyring.Key(args[0])
+			if err != nil {
+				return err
+			}
+			if info.GetAlgo() != hd.Secp256k1Type {
+				return fmt.Errorf("unsupported key algorithm %s, only %s keys can be backed up", info.GetAlgo(), hd.Secp256k1Type)
+			}
+
+			if yes, err := input.GetConfirmation(i18n.Sprintf("WARNING: The private key will be exported as %d mnemonic shares, any %d of which restore it. Continue?", count, threshold), buf, cmd.ErrOrStderr()); err != nil {
+				return err
+			} else if !yes {
+				return nil
+			}
+
+			hexPrivKey, err := keyring.NewUnsafe(clientCtx.Keyring).UnsafeExportPrivKeyHex(args[0])
+			if err != nil {
+				return err
+			}
+			privKey, err := hex.DecodeString(hexPrivKey)
+			if err != nil {
+				return err
+			}
+
+			mnemonics, err := slip39.SplitMnemonics(threshold, count, privKey, nil)
+			if err != nil {
+				return err
+			}
+
+			for i, mnemonic := range mnemonics {
+				cmd.Printf("%s\n%s\n\n", i18n.Sprintf("Share %d of %d:", i+1, count), mnemonic)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagShamir, "", "The Shamir scheme of the shares, <threshold>-of-<count>, e.g. 3-of-5")
+	_ = cmd.MarkFlagRequired(flagShamir)
+
+	return cmd
+}
+
+// RestoreKeyCommand restores a private key from its SLIP-39 mnemonic shares.
+func RestoreKeyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore <name> --shamir",
+		Short: "Restore a private key from its Shamir mnemonic shares",
+		Long: `Restore a secp256k1 private key backed up with 'keys backup --shamir' from its
+SLIP-39 mnemonic shares, read one per line from the input until the threshold
+of the shares is met, and store it in the local keybase under the given name.
+
+To restore a key from its BIP39 mnemonic, use 'keys add --recover' instead.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+
+			if shamir, _ := cmd.Flags().GetBool(flagShamir); !shamir {
+				return errors.New("only the restore of Shamir mnemonic shares is supported, use --shamir or 'keys add --recover' for a BIP39 mnemonic")
+			}
+
+			var (
+				mnemonics []string
+				privKey   []byte
+			)
+			for {
+				mnemonic, err := input.GetString(i18n.Sprintf("Enter share %d:", len(mnemonics)+1), buf)
+				if err != nil {
+					return err
+				}
+				mnemonics = append(mnemonics, mnemonic)
+
+				privKey, err = slip39.CombineMnemonics(mnemonics, nil)
+				if errors.Is(err, slip39.ErrInsufficientShares) {
+					continue
+				}
+				if err != nil {
+					return err
+				}
+				break
+			}
+
+			if len(privKey) != secp256k1.PrivKeySize {
+				return fmt.Errorf("invalid private key length %d, expected %d", len(privKey), secp256k1.PrivKeySize)
+			}
+
+			info, err := importUnarmoredPrivKey(clientCtx.Keyring, args[0], &secp256k1.PrivKey{Key: privKey}, hd.Secp256k1Type)
+			if err != nil {
+				return err
+			}
+
+			printKeyInfo(cmd.OutOrStdout(), info, keyring.MkAccKeyOutput, clientCtx.OutputFormat)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagShamir, false, "Restore the key from its Shamir mnemonic shares")
+
+	return cmd
+}
+
+// parseShamirScheme parses a <threshold>-of-<count> Shamir scheme.
+func parseShamirScheme(scheme string) (threshold, count int, err error) {
+	parts := strings.Split(scheme, "-of-")
+	if len(parts) == 2 {
+		threshold, err = strconv.Atoi(parts[0])
+		if err == nil {
+			count, err = strconv.Atoi(parts[1])
+		}
+	}
+	if len(parts) != 2 || err != nil || threshold < 1 || threshold > count {
+		return 0, 0, fmt.Errorf("invalid Shamir scheme %q, expected <threshold>-of-<count>, e.g. 3-of-5", scheme)
+	}
+
+	return threshold, count, nil
+}
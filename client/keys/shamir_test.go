@@ -0,0 +1,92 @@
+package keys
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func Test_runBackupRestoreCmd(t *testing.T) {
+	kbHome := t.TempDir()
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, nil)
+	require.NoError(t, err)
+
+	path := sdk.GetConfig().GetFullBIP44Path()
+	info, _, err := kb.NewMnemonic("keyname1", keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	// back up the key as 2-of-3 shares
+	cmd := BackupKeyCommand()
+	cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+	mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+
+	clientCtx := client.Context{}.
+		WithKeyringDir(kbHome).
+		WithKeyring(kb).
+		WithInput(mockIn)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	mockIn.Reset("y\n")
+	cmd.SetArgs([]string{"keyname1", fmt.Sprintf("--%s=2-of-3", flagShamir), fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest)})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	var shares []string
+	for _, line := range strings.Split(out.String(), "\n") {
+		if len(strings.Fields(line)) > 1 && !strings.HasPrefix(line, "Share") {
+			shares = append(shares, line)
+		}
+	}
+	require.Len(t, shares, 3)
+
+	// restore the key from 2 shares into another keybase
+	restoreHome := t.TempDir()
+	restoreKb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, restoreHome, nil)
+	require.NoError(t, err)
+
+	cmd = RestoreKeyCommand()
+	cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+	mockIn = testutil.ApplyMockIODiscardOutErr(cmd)
+
+	clientCtx = clientCtx.WithKeyringDir(restoreHome).WithKeyring(restoreKb).WithInput(mockIn)
+	ctx = context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	mockIn.Reset(shares[2] + "\n" + shares[0] + "\n")
+	cmd.SetArgs([]string{"keyname1", fmt.Sprintf("--%s", flagShamir), fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest)})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	restored, err := restoreKb.Key("keyname1")
+	require.NoError(t, err)
+	require.Equal(t, info.GetAddress(), restored.GetAddress())
+
+	// a single share does not restore the key
+	mockIn.Reset(shares[1] + "\n")
+	cmd.SetArgs([]string{"keyname2", fmt.Sprintf("--%s", flagShamir), fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest)})
+	require.Error(t, cmd.ExecuteContext(ctx))
+	_, err = restoreKb.Key("keyname2")
+	require.Error(t, err)
+}
+
+func TestParseShamirScheme(t *testing.T) {
+	threshold, count, err := parseShamirScheme("3-of-5")
+	require.NoError(t, err)
+	require.Equal(t, 3, threshold)
+	require.Equal(t, 5, count)
+
+	for _, scheme := range []string{"", "3", "3-5", "5-of-3", "0-of-3", "a-of-3", "3-of-5-of-7"} {
+		_, _, err := parseShamirScheme(scheme)
+		require.Error(t, err, scheme)
+	}
+}
@@ -3,6 +3,7 @@ package keys
 import (
 	"errors"
 	"fmt"
+	"math"
 
 	"github.com/spf13/cobra"
 	"github.com/tendermint/tendermint/libs/cli"
@@ -39,8 +40,9 @@ func ShowKeysCmd() *cobra.Command {
 		Long: `Display keys details. If multiple names or addresses are provided,
 then an ephemeral multisig key will be created under the name "multi"
 consisting of all the keys provided by name and multisig threshold.`,
-		Args: cobra.MinimumNArgs(1),
-		RunE: runShowCmd,
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: client.CompleteKeyNames,
+		RunE:              runShowCmd,
 	}
 	f := cmd.Flags()
 	f.String(FlagBechPrefix, sdk.PrefixAccount, "The Bech32 prefix encoding for a key (acc|val|cons)")
@@ -183,6 +185,27 @@ func validateMultisigThreshold(k, nKeys int) error {
 	return nil
 }
 
+func validateWeightedMultisigThreshold(k, nKeys int, weights []uint) error {
+	if k <= 0 {
+		return fmt.Errorf("threshold must be a positive integer")
+	}
+	if len(weights) != nKeys {
+		return fmt.Errorf("got %d weights for %d keys", len(weights), nKeys)
+	}
+	var total uint64
+	for _, weight := range weights {
+		if weight == 0 || weight > math.MaxUint32 {
+			return fmt.Errorf("weights must be positive 32-bit integers")
+		}
+		total += uint64(weight)
+	}
+	if total < uint64(k) {
+		return fmt.Errorf(
+			"weighted multisignature: sum of weights %d < threshold %d", total, k)
+	}
+	return nil
+}
+
 func getBechKeyOut(bechPrefix string) (bechKeyOutFn, error) {
 	switch bechPrefix {
 	case sdk.PrefixAccount:
@@ -185,6 +185,34 @@ func Test_validateMultisigThreshold(t *testing.T) {
 	}
 }
 
+func Test_validateWeightedMultisigThreshold(t *testing.T) {
+	type args struct {
+		k       int
+		nKeys   int
+		weights []uint
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{"zero threshold", args{0, 2, []uint{1, 1}}, true},
+		{"missing weight", args{1, 2, []uint{1}}, true},
+		{"zero weight", args{1, 2, []uint{1, 0}}, true},
+		{"threshold above weights", args{4, 2, []uint{2, 1}}, true},
+		{"threshold of weights", args{3, 2, []uint{2, 1}}, false},
+		{"threshold below weights", args{2, 2, []uint{2, 1}}, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateWeightedMultisigThreshold(tt.args.k, tt.args.nKeys, tt.args.weights); (err != nil) != tt.wantErr {
+				t.Errorf("validateWeightedMultisigThreshold() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func Test_getBechKeyOut(t *testing.T) {
 	type args struct {
 		bechPrefix string
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/spf13/pflag"
 
@@ -26,6 +27,7 @@ type Factory struct {
 	sequence           uint64
 	gas                uint64
 	timeoutHeight      uint64
+	timeoutTimestamp   time.Time
 	gasAdjustment      float64
 	chainID            string
 	memo               string
@@ -33,6 +35,8 @@ type Factory struct {
 	gasPrices          sdk.DecCoins
 	signMode           signing.SignMode
 	simulateAndExecute bool
+	unordered          bool
+	forceFee           bool
 }
 
 // NewFactoryCLI creates a new Factory.
@@ -47,6 +51,8 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 		signMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
 	case flags.SignModeEIP191:
 		signMode = signing.SignMode_SIGN_MODE_EIP_191
+	case flags.SignModeTextual:
+		signMode = signing.SignMode_SIGN_MODE_TEXTUAL
 	}
 
 	accNum, _ := flagSet.GetUint64(flags.FlagAccountNumber)
@@ -54,6 +60,13 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 	gasAdj, _ := flagSet.GetFloat64(flags.FlagGasAdjustment)
 	memo, _ := flagSet.GetString(flags.FlagNote)
 	timeoutHeight, _ := flagSet.GetUint64(flags.FlagTimeoutHeight)
+	unordered, _ := flagSet.GetBool(flags.FlagUnordered)
+	forceFee, _ := flagSet.GetBool(flags.FlagForce)
+
+	var timeoutTimestamp time.Time
+	if timeoutDuration, _ := flagSet.GetDuration(flags.FlagTimeoutDuration); timeoutDuration > 0 {
+		timeoutTimestamp = time.Now().Add(timeoutDuration)
+	}
 
 	gasStr, _ := flagSet.GetString(flags.FlagGas)
 	gasSetting, _ := flags.ParseGasSetting(gasStr)
@@ -68,6 +81,9 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 		accountNumber:      accNum,
 		sequence:           accSeq,
 		timeoutHeight:      timeoutHeight,
+		timeoutTimestamp:   timeoutTimestamp,
+		unordered:          unordered,
+		forceFee:           forceFee,
 		gasAdjustment:      gasAdj,
 		memo:               memo,
 		signMode:           signMode,
@@ -93,6 +109,9 @@ func (f Factory) Fees() sdk.Coins                           { return f.fees }
 func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices }
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
 func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHeight }
+func (f Factory) TimeoutTimestamp() time.Time               { return f.timeoutTimestamp }
+func (f Factory) Unordered() bool                           { return f.unordered }
+func (f Factory) ForceFee() bool                            { return f.forceFee }
 
 // SimulateAndExecute returns the option to simulate and then execute the transaction
 // using the gas from the simulation results
@@ -192,6 +211,29 @@ func (f Factory) WithSignMode(mode signing.SignMode) Factory {
 	return f
 }
 
+// WithTimeoutTimestamp returns a copy of the Factory with an updated timeout
+// timestamp. A zero time means no timeout.
+func (f Factory) WithTimeoutTimestamp(timestamp time.Time) Factory {
+	f.timeoutTimestamp = timestamp
+	return f
+}
+
+// WithUnordered returns a copy of the Factory with an updated unordered value.
+// The unordered txs are signed with a zero sequence, and require a timeout
+// timestamp.
+func (f Factory) WithUnordered(unordered bool) Factory {
+	f.unordered = unordered
+	return f
+}
+
+// WithForceFee returns a copy of the Factory with an updated force fee value.
+// The fees of the forced txs are broadcast without checking them against
+// DefaultMaxFeeMultiple.
+func (f Factory) WithForceFee(forceFee bool) Factory {
+	f.forceFee = forceFee
+	return f
+}
+
 // WithTimeoutHeight returns a copy of the Factory with an updated timeout height.
 func (f Factory) WithTimeoutHeight(height uint64) Factory {
 	f.timeoutHeight = height
@@ -205,6 +247,10 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 		return nil, fmt.Errorf("chain ID required but not specified")
 	}
 
+	if f.unordered && f.timeoutTimestamp.IsZero() {
+		return nil, errors.New("unordered transactions require a timeout timestamp")
+	}
+
 	fees := f.fees
 
 	if !f.gasPrices.IsZero() {
@@ -234,6 +280,8 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 	tx.SetFeeAmount(fees)
 	tx.SetGasLimit(f.gas)
 	tx.SetTimeoutHeight(f.TimeoutHeight())
+	tx.SetTimeoutTimestamp(f.TimeoutTimestamp())
+	tx.SetUnordered(f.Unordered())
 
 	return tx, nil
 }
@@ -312,7 +360,8 @@ func (f Factory) BuildSimTx(msgs ...sdk.Msg) ([]byte, error) {
 
 // Prepare ensures the account defined by ctx.GetFromAddress() exists and
 // if the account number and/or the account sequence number are zero (not set),
-// they will be queried for and set on the provided Factory. A new Factory with
+// they will be queried for and set on the provided Factory. The sequence of
+// the unordered txs is left to zero. A new Factory with
 // the updated fields will be returned.
 func (f Factory) Prepare(clientCtx client.Context) (Factory, error) {
 	fc := f
@@ -334,7 +383,7 @@ func (f Factory) Prepare(clientCtx client.Context) (Factory, error) {
 			fc = fc.WithAccountNumber(num)
 		}
 
-		if initSeq == 0 {
+		if initSeq == 0 && !fc.unordered {
 			fc = fc.WithSequence(seq)
 		}
 	}
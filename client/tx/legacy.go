@@ -63,6 +63,13 @@ func CopyTx(tx signing.Tx, builder client.TxBuilder, ignoreSignatureError bool)
 	builder.SetGasLimit(tx.GetGas())
 	builder.SetTimeoutHeight(tx.GetTimeoutHeight())
 
+	if timeoutTx, ok := tx.(sdk.TxWithTimeoutTimestamp); ok {
+		builder.SetTimeoutTimestamp(timeoutTx.GetTimeoutTimestamp())
+	}
+	if unorderedTx, ok := tx.(sdk.TxWithUnordered); ok {
+		builder.SetUnordered(unorderedTx.GetUnordered())
+	}
+
 	return nil
 }
 
@@ -13,7 +13,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/i18n"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -21,8 +23,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
+// DefaultMaxFeeMultiple is the maximum multiple of the fee required by the
+// chain-wide minimum gas prices that the broadcast txs can pay, unless they
+// force their fee.
+const DefaultMaxFeeMultiple = 10
+
 // GenerateOrBroadcastTxCLI will either generate and print and unsigned transaction
 // or sign it and broadcast it returning an error upon failure.
 func GenerateOrBroadcastTxCLI(clientCtx client.Context, flagSet *pflag.FlagSet, msgs ...sdk.Msg) error {
@@ -110,6 +118,10 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		return err
 	}
 
+	if err := CheckFeeMultiple(clientCtx, txf, tx.GetTx()); err != nil {
+		return err
+	}
+
 	if !clientCtx.SkipConfirm {
 		out, err := clientCtx.TxConfig.TxJSONEncoder()(tx.GetTx())
 		if err != nil {
@@ -119,10 +131,10 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		_, _ = fmt.Fprintf(os.Stderr, "%s\n\n", out)
 
 		buf := bufio.NewReader(os.Stdin)
-		ok, err := input.GetConfirmation("confirm transaction before signing and broadcasting", buf, os.Stderr)
+		ok, err := input.GetConfirmation(i18n.T("confirm transaction before signing and broadcasting"), buf, os.Stderr)
 
 		if err != nil || !ok {
-			_, _ = fmt.Fprintf(os.Stderr, "%s\n", "cancelled transaction")
+			_, _ = fmt.Fprintf(os.Stderr, "%s\n", i18n.T("cancelled transaction"))
 			return err
 		}
 	}
@@ -147,6 +159,47 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 	return clientCtx.PrintProto(res)
 }
 
+// SignAndBroadcastTx generates, signs and broadcasts a transaction with the
+// given set of messages, simulating its gas requirements if necessary, and
+// returns the broadcast response. Unlike BroadcastTx, it never prompts for a
+// confirmation nor prints anything, so that it can be used by programs.
+func SignAndBroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	txf, err := prepareFactory(clientCtx, txf)
+	if err != nil {
+		return nil, err
+	}
+
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := CalculateGas(clientCtx, txf, msgs...)
+		if err != nil {
+			return nil, err
+		}
+
+		txf = txf.WithGas(adjusted)
+	}
+
+	tx, err := BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckFeeMultiple(clientCtx, txf, tx.GetTx()); err != nil {
+		return nil, err
+	}
+
+	tx.SetFeeGranter(clientCtx.GetFeeGranterAddress())
+	if err := Sign(txf, clientCtx.GetFromName(), tx, true); err != nil {
+		return nil, err
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(tx.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	return clientCtx.BroadcastTx(txBytes)
+}
+
 // WriteGeneratedTxResponse writes a generated unsigned transaction to the
 // provided http.ResponseWriter. It will simulate gas costs if requested by the
 // BaseReq. Upon any error, the error will be written to the http.ResponseWriter.
@@ -273,7 +326,7 @@ func prepareFactory(clientCtx client.Context, txf Factory) (Factory, error) {
 			txf = txf.WithAccountNumber(num)
 		}
 
-		if initSeq == 0 {
+		if initSeq == 0 && !txf.unordered {
 			txf = txf.WithSequence(seq)
 		}
 	}
@@ -281,6 +334,41 @@ func prepareFactory(clientCtx client.Context, txf Factory) (Factory, error) {
 	return txf, nil
 }
 
+// CheckFeeMultiple protects the users from absurd fees: unless the Factory
+// forces the fee of the tx, it must not exceed DefaultMaxFeeMultiple times the
+// fee required by the chain-wide MinGasPrices param of x/auth, queried with the
+// given client connection.
+func CheckFeeMultiple(clientCtx gogogrpc.ClientConn, txf Factory, tx sdk.FeeTx) error {
+	if txf.ForceFee() {
+		return nil
+	}
+
+	res, err := authtypes.NewQueryClient(clientCtx).Params(context.Background(), &authtypes.QueryParamsRequest{})
+	if err != nil {
+		return err
+	}
+
+	return validateFeeMultiple(tx.GetFee(), tx.GetGas(), res.Params.MinGasPrices, DefaultMaxFeeMultiple)
+}
+
+// validateFeeMultiple returns ErrAbsurdFee if the fee exceeds maxFeeMultiple
+// times the fee required by the minimum gas prices in any of their denoms, where
+// the required fee is ceil(minGasPrice * gas).
+func validateFeeMultiple(fee sdk.Coins, gas uint64, minGasPrices sdk.DecCoins, maxFeeMultiple int64) error {
+	gasLimit := sdk.NewDec(int64(gas))
+	for _, gp := range minGasPrices {
+		required := gp.Amount.Mul(gasLimit).Ceil().RoundInt()
+		if amount := fee.AmountOf(gp.Denom); amount.GT(required.MulRaw(maxFeeMultiple)) {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrAbsurdFee, "fee %s%s exceeds %d times the required fee %s%s; use --force to pay it",
+				amount, gp.Denom, maxFeeMultiple, required, gp.Denom,
+			)
+		}
+	}
+
+	return nil
+}
+
 // SignWithPrivKey signs a given tx with the given private key, and returns the
 // corresponding SignatureV2 if the signing is successful.
 func SignWithPrivKey(
@@ -390,8 +478,17 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 		return err
 	}
 
-	// Sign those bytes
-	sigBytes, _, err := txf.keybase.Sign(name, bytesToSign)
+	// Sign those bytes, recording what they are signed for in the signing log
+	// of the keyring, if any
+	var sigBytes []byte
+	if signer, ok := txf.keybase.(keyring.MetadataSigner); ok {
+		sigBytes, _, err = signer.SignWithMetadata(name, bytesToSign, keyring.SignMetadata{
+			SignMode: signMode.String(),
+			ChainID:  txf.chainID,
+		})
+	} else {
+		sigBytes, _, err = txf.keybase.Sign(name, bytesToSign)
+	}
 	if err != nil {
 		return err
 	}
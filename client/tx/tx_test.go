@@ -3,6 +3,7 @@ package tx_test
 import (
 	gocontext "context"
 	"fmt"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -15,9 +16,11 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -95,6 +98,65 @@ func TestCalculateGas(t *testing.T) {
 	}
 }
 
+// mockParamsContext is a mock client.Context returning the given chain-wide
+// minimum gas prices in the x/auth params, used to unit test CheckFeeMultiple.
+type mockParamsContext struct {
+	minGasPrices sdk.DecCoins
+}
+
+func (m mockParamsContext) Invoke(_ gocontext.Context, _ string, _, reply interface{}, _ ...grpc.CallOption) error {
+	*(reply.(*authtypes.QueryParamsResponse)) = authtypes.QueryParamsResponse{
+		Params: authtypes.Params{MinGasPrices: m.minGasPrices},
+	}
+
+	return nil
+}
+
+func (mockParamsContext) NewStream(gocontext.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	panic("not implemented")
+}
+
+func TestCheckFeeMultiple(t *testing.T) {
+	// the required fee is 10stake, 100stake at most
+	clientCtx := mockParamsContext{minGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 4)))}
+	txf := tx.Factory{}.
+		WithTxConfig(NewTestTxConfig()).
+		WithGas(100000).
+		WithChainID("test-chain")
+	msg := banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), nil)
+
+	testCases := []struct {
+		name   string
+		fees   string
+		force  bool
+		expErr bool
+	}{
+		{"required fee", "10stake", false, false},
+		{"max fee multiple", "100stake", false, false},
+		{"other denom", "1000atom", false, false},
+		{"absurd fee", "101stake", false, true},
+		{"forced absurd fee", "101stake", true, false},
+	}
+	for _, tc := range testCases {
+		txf := txf.WithFees(tc.fees).WithForceFee(tc.force)
+		txb, err := tx.BuildUnsignedTx(txf, msg)
+		require.NoError(t, err)
+
+		err = tx.CheckFeeMultiple(clientCtx, txf, txb.GetTx())
+		if tc.expErr {
+			require.ErrorIs(t, err, sdkerrors.ErrAbsurdFee, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+
+	// the fees are not limited without chain-wide minimum gas prices
+	txf = txf.WithFees("1000000stake")
+	txb, err := tx.BuildUnsignedTx(txf, msg)
+	require.NoError(t, err)
+	require.NoError(t, tx.CheckFeeMultiple(mockParamsContext{}, txf, txb.GetTx()))
+}
+
 func TestBuildSimTx(t *testing.T) {
 	txCfg := NewTestTxConfig()
 
@@ -283,6 +345,33 @@ func TestSign(t *testing.T) {
 	}
 }
 
+func TestSignRecordsMetadata(t *testing.T) {
+	dir := t.TempDir()
+	logPath := filepath.Join(dir, keyring.SigningLogFileName)
+	kr, err := keyring.New(t.Name(), "test", dir, nil, keyring.WithSigningLog(logPath))
+	require.NoError(t, err)
+
+	from := "test_key1"
+	info, _, err := kr.NewMnemonic(from, keyring.English, hd.CreateHDPath(118, 0, 0).String(), keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	txf := tx.Factory{}.
+		WithTxConfig(NewTestTxConfig()).
+		WithKeybase(kr).
+		WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT).
+		WithChainID("test-chain")
+	txb, err := tx.BuildUnsignedTx(txf, banktypes.NewMsgSend(info.GetAddress(), sdk.AccAddress("to"), nil))
+	require.NoError(t, err)
+	require.NoError(t, tx.Sign(txf, from, txb, true))
+
+	entries, err := keyring.ReadSigningLog(logPath)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, from, entries[0].KeyName)
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_DIRECT.String(), entries[0].SignMode)
+	require.Equal(t, "test-chain", entries[0].ChainID)
+}
+
 func testSigners(require *require.Assertions, tr signing.Tx, pks ...cryptotypes.PubKey) []signingtypes.SignatureV2 {
 	sigs, err := tr.GetSignaturesV2()
 	require.Len(sigs, len(pks))
@@ -1,6 +1,8 @@
 package client
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
@@ -42,6 +44,8 @@ type (
 		SetFeeAmount(amount sdk.Coins)
 		SetGasLimit(limit uint64)
 		SetTimeoutHeight(height uint64)
+		SetTimeoutTimestamp(timestamp time.Time)
+		SetUnordered(unordered bool)
 		SetFeeGranter(feeGranter sdk.AccAddress)
 	}
 )
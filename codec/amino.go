@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"reflect"
 
 	amino "github.com/tendermint/go-amino"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -66,6 +67,13 @@ func (cdc *LegacyAmino) marshalAnys(o interface{}) error {
 }
 
 func (cdc *LegacyAmino) unmarshalAnys(o interface{}) error {
+	// when unmarshaling into a pointer to an interface, e.g. a
+	// *cryptotypes.PubKey, it is the unmarshaled value which holds the Anys
+	if v := reflect.ValueOf(o); v.Kind() == reflect.Ptr && !v.IsNil() &&
+		v.Elem().Kind() == reflect.Interface && !v.Elem().IsNil() {
+		o = v.Elem().Interface()
+	}
+
 	return types.UnpackInterfaces(o, types.AminoUnpacker{Cdc: cdc.Amino})
 }
 
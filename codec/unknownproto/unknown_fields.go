@@ -327,6 +327,18 @@ func (twt *errUnknownField) Error() string {
 
 var _ error = (*errUnknownField)(nil)
 
+// IsUnknownFieldError reports whether err, or an error it wraps, reports an
+// unknown field, and if so whether that field is critical, i.e. its field number
+// does not have bit 11 set.
+func IsUnknownFieldError(err error) (isUnknown, isCritical bool) {
+	var unknownErr *errUnknownField
+	if !errors.As(err, &unknownErr) {
+		return false, false
+	}
+
+	return true, unknownErr.TagNum&bit11NonCritical == 0
+}
+
 var (
 	protoFileToDesc   = make(map[string]*descriptor.FileDescriptorProto)
 	protoFileToDescMu sync.RWMutex
@@ -1,6 +1,7 @@
 package unknownproto
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -297,6 +298,19 @@ func TestRejectUnknownFields_allowUnknownNonCriticals(t *testing.T) {
 	}
 }
 
+func TestIsUnknownFieldError(t *testing.T) {
+	isUnknown, isCritical := IsUnknownFieldError(fmt.Errorf("wrapped: %w", &errUnknownField{TagNum: 6}))
+	require.True(t, isUnknown)
+	require.True(t, isCritical)
+
+	isUnknown, isCritical = IsUnknownFieldError(&errUnknownField{TagNum: 1047})
+	require.True(t, isUnknown)
+	require.False(t, isCritical)
+
+	isUnknown, _ = IsUnknownFieldError(&errMismatchedWireType{TagNum: 6})
+	require.False(t, isUnknown)
+}
+
 func TestRejectUnknownFieldsNested(t *testing.T) {
 	tests := []struct {
 		name    string
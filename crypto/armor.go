@@ -1,13 +1,18 @@
 package crypto
 
 import (
+	"crypto/cipher"
 	"encoding/hex"
 	"fmt"
+	"strconv"
 
 	"github.com/tendermint/crypto/bcrypt"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/armor"
 	"github.com/tendermint/tendermint/crypto/xsalsa20symmetric"
+	"github.com/tjfoc/gmsm/sm3"
+	"github.com/tjfoc/gmsm/sm4"
+	"golang.org/x/crypto/pbkdf2"
 
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -21,8 +26,24 @@ const (
 
 	defaultAlgo = "secp256k1"
 
-	headerVersion = "version"
-	headerType    = "type"
+	headerVersion    = "version"
+	headerType       = "type"
+	headerCipher     = "cipher"
+	headerIterations = "iterations"
+
+	kdfBcrypt    = "bcrypt"
+	kdfPbkdf2Sm3 = "pbkdf2-sm3"
+)
+
+// The ciphers of the private key armors.
+const (
+	// CipherXsalsa20 encrypts the private key with xsalsa20-poly1305, keyed
+	// by bcrypt and SHA-256 from the passphrase. It is the default cipher.
+	CipherXsalsa20 = "xsalsa20"
+	// CipherSm4 encrypts the private key with SM4-GCM, keyed by PBKDF2 over
+	// SM3 from the passphrase, complying with the Chinese national
+	// cryptographic standards.
+	CipherSm4 = "sm4"
 )
 
 // BcryptSecurityParameter is security parameter var, and it can be changed within the lcd test.
@@ -40,6 +61,11 @@ const (
 // For further notes on security parameter choice, see README.md
 var BcryptSecurityParameter = 12
 
+// Pbkdf2Iterations is the number of PBKDF2 iterations deriving the SM4 keys of
+// the private key armors from the passphrases, recorded in the armors. Like
+// BcryptSecurityParameter, it can be changed within tests.
+var Pbkdf2Iterations = 100000
+
 //-----------------------------------------------------------------
 // add armor
 
@@ -127,7 +153,7 @@ func unarmorBytes(armorStr, blockType string) (bz []byte, header map[string]stri
 func EncryptArmorPrivKey(privKey cryptotypes.PrivKey, passphrase string, algo string) string {
 	saltBytes, encBytes := encryptPrivKey(privKey, passphrase)
 	header := map[string]string{
-		"kdf":  "bcrypt",
+		"kdf":  kdfBcrypt,
 		"salt": fmt.Sprintf("%X", saltBytes),
 	}
 
@@ -140,6 +166,48 @@ func EncryptArmorPrivKey(privKey cryptotypes.PrivKey, passphrase string, algo st
 	return armorStr
 }
 
+// EncryptArmorPrivKeyWithCipher encrypts and armors the private key with the
+// given cipher, one of CipherXsalsa20 and CipherSm4.
+func EncryptArmorPrivKeyWithCipher(privKey cryptotypes.PrivKey, passphrase, algo, cipherName string) (string, error) {
+	switch cipherName {
+	case "", CipherXsalsa20:
+		return EncryptArmorPrivKey(privKey, passphrase, algo), nil
+
+	case CipherSm4:
+		saltBytes, encBytes, err := encryptPrivKeySm4(privKey, passphrase, Pbkdf2Iterations)
+		if err != nil {
+			return "", err
+		}
+		header := map[string]string{
+			"kdf":            kdfPbkdf2Sm3,
+			"salt":           fmt.Sprintf("%X", saltBytes),
+			headerIterations: strconv.Itoa(Pbkdf2Iterations),
+			headerCipher:     CipherSm4,
+		}
+		if algo != "" {
+			header[headerType] = algo
+		}
+
+		return armor.EncodeArmor(blockTypePrivKey, header, encBytes), nil
+
+	default:
+		return "", fmt.Errorf("unrecognized cipher %q, expected %s or %s", cipherName, CipherXsalsa20, CipherSm4)
+	}
+}
+
+// ArmorCipher returns the cipher of an encrypted private key armor.
+func ArmorCipher(armorStr string) (string, error) {
+	_, header, err := unarmorBytes(armorStr, blockTypePrivKey)
+	if err != nil {
+		return "", err
+	}
+	if header[headerCipher] == "" {
+		return CipherXsalsa20, nil
+	}
+
+	return header[headerCipher], nil
+}
+
 // encrypt the given privKey with the passphrase using a randomly
 // generated salt and the xsalsa20 cipher. returns the salt and the
 // encrypted priv key.
@@ -157,6 +225,35 @@ func encryptPrivKey(privKey cryptotypes.PrivKey, passphrase string) (saltBytes [
 	return saltBytes, xsalsa20symmetric.EncryptSymmetric(privKeyBytes, key)
 }
 
+// encrypt the given privKey with the passphrase using a randomly
+// generated salt and the SM4-GCM cipher, keyed by the given number of PBKDF2
+// over SM3 iterations. returns the salt and the nonce-prefixed encrypted priv
+// key.
+func encryptPrivKeySm4(privKey cryptotypes.PrivKey, passphrase string, iterations int) (saltBytes []byte, encBytes []byte, err error) {
+	saltBytes = crypto.CRandBytes(16)
+	aead, err := newSm4GCM(saltBytes, passphrase, iterations)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nonce := crypto.CRandBytes(aead.NonceSize())
+	privKeyBytes := legacy.Cdc.MustMarshal(privKey)
+
+	return saltBytes, aead.Seal(nonce, nonce, privKeyBytes, nil), nil
+}
+
+// newSm4GCM returns the SM4-GCM cipher keyed by PBKDF2 over SM3 from the
+// passphrase.
+func newSm4GCM(saltBytes []byte, passphrase string, iterations int) (cipher.AEAD, error) {
+	key := pbkdf2.Key([]byte(passphrase), saltBytes, iterations, sm4.BlockSize, sm3.New)
+	block, err := sm4.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
 // UnarmorDecryptPrivKey returns the privkey byte slice, a string of the algo type, and an error
 func UnarmorDecryptPrivKey(armorStr string, passphrase string) (privKey cryptotypes.PrivKey, algo string, err error) {
 	blockType, header, encBytes, err := armor.DecodeArmor(armorStr)
@@ -168,7 +265,17 @@ func UnarmorDecryptPrivKey(armorStr string, passphrase string) (privKey cryptoty
 		return privKey, "", fmt.Errorf("unrecognized armor type: %v", blockType)
 	}
 
-	if header["kdf"] != "bcrypt" {
+	cipherName := header[headerCipher]
+	kdf := kdfBcrypt
+	switch cipherName {
+	case "", CipherXsalsa20:
+	case CipherSm4:
+		kdf = kdfPbkdf2Sm3
+	default:
+		return privKey, "", fmt.Errorf("unrecognized cipher: %v", cipherName)
+	}
+
+	if header["kdf"] != kdf {
 		return privKey, "", fmt.Errorf("unrecognized KDF type: %v", header["kdf"])
 	}
 
@@ -181,7 +288,15 @@ func UnarmorDecryptPrivKey(armorStr string, passphrase string) (privKey cryptoty
 		return privKey, "", fmt.Errorf("error decoding salt: %v", err.Error())
 	}
 
-	privKey, err = decryptPrivKey(saltBytes, encBytes, passphrase)
+	if cipherName == CipherSm4 {
+		iterations, convErr := strconv.Atoi(header[headerIterations])
+		if convErr != nil || iterations <= 0 {
+			return privKey, "", fmt.Errorf("invalid PBKDF2 iterations: %q", header[headerIterations])
+		}
+		privKey, err = decryptPrivKeySm4(saltBytes, encBytes, passphrase, iterations)
+	} else {
+		privKey, err = decryptPrivKey(saltBytes, encBytes, passphrase)
+	}
 
 	if header[headerType] == "" {
 		header[headerType] = defaultAlgo
@@ -207,3 +322,21 @@ func decryptPrivKey(saltBytes []byte, encBytes []byte, passphrase string) (privK
 
 	return legacy.PrivKeyFromBytes(privKeyBytes)
 }
+
+func decryptPrivKeySm4(saltBytes []byte, encBytes []byte, passphrase string, iterations int) (privKey cryptotypes.PrivKey, err error) {
+	aead, err := newSm4GCM(saltBytes, passphrase, iterations)
+	if err != nil {
+		return privKey, err
+	}
+	if len(encBytes) < aead.NonceSize() {
+		return privKey, fmt.Errorf("ciphertext is too short")
+	}
+
+	nonce, ciphertext := encBytes[:aead.NonceSize()], encBytes[aead.NonceSize():]
+	privKeyBytes, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return privKey, sdkerrors.ErrWrongPassword
+	}
+
+	return legacy.PrivKeyFromBytes(privKeyBytes)
+}
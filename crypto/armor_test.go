@@ -18,8 +18,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/sm2"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func TestArmorUnarmorPrivKey(t *testing.T) {
@@ -68,6 +70,43 @@ func TestArmorUnarmorPrivKey(t *testing.T) {
 	require.Equal(t, "unrecognized KDF type: wrong", err.Error())
 }
 
+func TestArmorUnarmorPrivKeySm4(t *testing.T) {
+	privKey := sm2.GenPrivKey()
+	priv := &privKey
+	armored, err := crypto.EncryptArmorPrivKeyWithCipher(priv, "passphrase", string(hd.Sm2Type), crypto.CipherSm4)
+	require.NoError(t, err)
+	cipher, err := crypto.ArmorCipher(armored)
+	require.NoError(t, err)
+	require.Equal(t, crypto.CipherSm4, cipher)
+
+	_, _, err = crypto.UnarmorDecryptPrivKey(armored, "wrongpassphrase")
+	require.True(t, errors.Is(err, sdkerrors.ErrWrongPassword))
+	decrypted, algo, err := crypto.UnarmorDecryptPrivKey(armored, "passphrase")
+	require.NoError(t, err)
+	require.Equal(t, string(hd.Sm2Type), algo)
+	require.True(t, priv.Equals(decrypted))
+
+	// the default cipher is xsalsa20
+	armored, err = crypto.EncryptArmorPrivKeyWithCipher(priv, "passphrase", "", "")
+	require.NoError(t, err)
+	cipher, err = crypto.ArmorCipher(armored)
+	require.NoError(t, err)
+	require.Equal(t, crypto.CipherXsalsa20, cipher)
+
+	_, err = crypto.EncryptArmorPrivKeyWithCipher(priv, "passphrase", "", "aes")
+	require.Error(t, err)
+
+	// the KDF must be the one of the cipher
+	_, header, encBytes, err := armor.DecodeArmor(armored)
+	require.NoError(t, err)
+	header["cipher"] = crypto.CipherSm4
+	_, _, err = crypto.UnarmorDecryptPrivKey(armor.EncodeArmor("TENDERMINT PRIVATE KEY", header, encBytes), "passphrase")
+	require.EqualError(t, err, "unrecognized KDF type: bcrypt")
+	header["cipher"] = "aes"
+	_, _, err = crypto.UnarmorDecryptPrivKey(armor.EncodeArmor("TENDERMINT PRIVATE KEY", header, encBytes), "passphrase")
+	require.EqualError(t, err, "unrecognized cipher: aes")
+}
+
 func TestArmorUnarmorPubKey(t *testing.T) {
 	// Select the encryption and storage for your cryptostore
 	cstore := keyring.NewInMemory()
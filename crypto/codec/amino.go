@@ -25,6 +25,8 @@ func RegisterCrypto(cdc *codec.LegacyAmino) {
 		sm2.PubKeyName, nil)
 	cdc.RegisterConcrete(&kmultisig.LegacyAminoPubKey{},
 		kmultisig.PubKeyAminoRoute, nil)
+	cdc.RegisterConcrete(&kmultisig.WeightedMultisigPubKey{},
+		kmultisig.WeightedPubKeyAminoRoute, nil)
 
 	cdc.RegisterInterface((*cryptotypes.PrivKey)(nil), nil)
 	cdc.RegisterConcrete(sr25519.PrivKey{},
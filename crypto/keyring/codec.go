@@ -18,4 +18,6 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(ledgerInfo{}, "crypto/keys/ledgerInfo", nil)
 	cdc.RegisterConcrete(offlineInfo{}, "crypto/keys/offlineInfo", nil)
 	cdc.RegisterConcrete(multiInfo{}, "crypto/keys/multiInfo", nil)
+	cdc.RegisterConcrete(watchInfo{}, "crypto/keys/watchInfo", nil)
+	cdc.RegisterConcrete(tssInfo{}, "crypto/keys/tssInfo", nil)
 }
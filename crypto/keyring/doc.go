@@ -43,4 +43,7 @@
 // 			be unlocked and it should be use only for testing purposes.
 // 	memory	Same instance as returned by NewInMemory. This backend uses a transient storage. Keys
 // 			are discarded when the process terminates or the type instance is garbage collected.
+// 	tss		This backend stores references to the keys of a threshold signature signer, which
+// 			signs with key shares distributed among several parties, and public keys only. It
+// 			does not prompt for a password and rejects the keys holding a private key.
 package keyring
@@ -10,4 +10,12 @@ var (
 	// ErrUnsupportedLanguage is raised when the caller tries to use a
 	// different language than english for creating a mnemonic sentence.
 	ErrUnsupportedLanguage = errors.New("unsupported language: only english is supported")
+
+	// ErrLocalKeysNotAllowed is raised when the caller tries to store a key
+	// holding a private key in the tss backend.
+	ErrLocalKeysNotAllowed = errors.New("the tss keyring backend only stores TSS and public keys")
+
+	// ErrNoTSSSigner is raised when the caller tries to use a TSS key without a
+	// TSS signer in the keyring options.
+	ErrNoTSSSigner = errors.New("no TSS signer configured")
 )
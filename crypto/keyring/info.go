@@ -32,6 +32,8 @@ var (
 	_ Info = &ledgerInfo{}
 	_ Info = &offlineInfo{}
 	_ Info = &multiInfo{}
+	_ Info = &watchInfo{}
+	_ Info = &tssInfo{}
 )
 
 // localInfo is the public information about a locally stored key
@@ -69,7 +71,7 @@ func (i localInfo) GetPubKey() cryptotypes.PubKey {
 
 // GetType implements Info interface
 func (i localInfo) GetAddress() types.AccAddress {
-	return i.PubKey.Address().Bytes()
+	return types.AccAddressFromPubKey(i.PubKey)
 }
 
 // GetType implements Info interface
@@ -117,7 +119,7 @@ func (i ledgerInfo) GetPubKey() cryptotypes.PubKey {
 
 // GetAddress implements Info interface
 func (i ledgerInfo) GetAddress() types.AccAddress {
-	return i.PubKey.Address().Bytes()
+	return types.AccAddressFromPubKey(i.PubKey)
 }
 
 // GetPath implements Info interface
@@ -169,7 +171,7 @@ func (i offlineInfo) GetAlgo() hd.PubKeyType {
 
 // GetAddress implements Info interface
 func (i offlineInfo) GetAddress() types.AccAddress {
-	return i.PubKey.Address().Bytes()
+	return types.AccAddressFromPubKey(i.PubKey)
 }
 
 // GetPath implements Info interface
@@ -196,8 +198,10 @@ type multiInfo struct {
 
 // NewMultiInfo creates a new multiInfo instance
 func NewMultiInfo(name string, pub cryptotypes.PubKey) (Info, error) {
-	if _, ok := pub.(*multisig.LegacyAminoPubKey); !ok {
-		return nil, fmt.Errorf("MultiInfo supports only multisig.LegacyAminoPubKey, got  %T", pub)
+	switch pub.(type) {
+	case *multisig.LegacyAminoPubKey, *multisig.WeightedMultisigPubKey:
+	default:
+		return nil, fmt.Errorf("MultiInfo supports only multisig.LegacyAminoPubKey and multisig.WeightedMultisigPubKey, got  %T", pub)
 	}
 	return &multiInfo{
 		Name:   name,
@@ -222,7 +226,7 @@ func (i multiInfo) GetPubKey() cryptotypes.PubKey {
 
 // GetAddress implements Info interface
 func (i multiInfo) GetAddress() types.AccAddress {
-	return i.PubKey.Address().Bytes()
+	return types.AccAddressFromPubKey(i.PubKey)
 }
 
 // GetPath implements Info interface
@@ -237,9 +241,121 @@ func (i multiInfo) GetPath() (*hd.BIP44Params, error) {
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (i multiInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
-	multiPK := i.PubKey.(*multisig.LegacyAminoPubKey)
+	return codectypes.UnpackInterfaces(i.PubKey, unpacker)
+}
+
+// watchInfo is the public information about a watch-only key, i.e. an address
+// tracked in the keyring, optionally along with its public key, that cannot
+// sign. Watch-only keys can be labeled with tags.
+type watchInfo struct {
+	Name    string             `json:"name"`
+	Address types.AccAddress   `json:"address"`
+	PubKey  cryptotypes.PubKey `json:"pubkey"`
+	Tags    []string           `json:"tags"`
+}
+
+func newWatchInfo(name string, addr types.AccAddress, pub cryptotypes.PubKey, tags []string) Info {
+	return &watchInfo{
+		Name:    name,
+		Address: addr,
+		PubKey:  pub,
+		Tags:    tags,
+	}
+}
+
+// GetType implements Info interface
+func (i watchInfo) GetType() KeyType {
+	return TypeWatch
+}
+
+// GetName implements Info interface
+func (i watchInfo) GetName() string {
+	return i.Name
+}
+
+// GetPubKey implements Info interface. It returns nil if only the address of
+// the key is known.
+func (i watchInfo) GetPubKey() cryptotypes.PubKey {
+	return i.PubKey
+}
+
+// GetAlgo returns the signing algorithm for the key, if its public key is known
+func (i watchInfo) GetAlgo() hd.PubKeyType {
+	if i.PubKey == nil {
+		return ""
+	}
+
+	return hd.PubKeyType(i.PubKey.Type())
+}
+
+// GetAddress implements Info interface
+func (i watchInfo) GetAddress() types.AccAddress {
+	return i.Address
+}
+
+// GetPath implements Info interface
+func (i watchInfo) GetPath() (*hd.BIP44Params, error) {
+	return nil, fmt.Errorf("BIP44 Paths are not available for this type")
+}
+
+// tssInfo is the public information about a key of a threshold signature
+// (TSS) signer, whose private key is never held by the keyring.
+type tssInfo struct {
+	Name   string             `json:"name"`
+	PubKey cryptotypes.PubKey `json:"pubkey"`
+	Algo   hd.PubKeyType      `json:"algo"`
+	KeyID  string             `json:"key_id"`
+}
+
+func newTSSInfo(name string, pub cryptotypes.PubKey, algo hd.PubKeyType, keyID string) Info {
+	return &tssInfo{
+		Name:   name,
+		PubKey: pub,
+		Algo:   algo,
+		KeyID:  keyID,
+	}
+}
+
+// GetType implements Info interface
+func (i tssInfo) GetType() KeyType {
+	return TypeTSS
+}
+
+// GetName implements Info interface
+func (i tssInfo) GetName() string {
+	return i.Name
+}
 
-	return codectypes.UnpackInterfaces(multiPK, unpacker)
+// GetPubKey implements Info interface
+func (i tssInfo) GetPubKey() cryptotypes.PubKey {
+	return i.PubKey
+}
+
+// GetAddress implements Info interface
+func (i tssInfo) GetAddress() types.AccAddress {
+	return types.AccAddressFromPubKey(i.PubKey)
+}
+
+// GetAlgo implements Info interface
+func (i tssInfo) GetAlgo() hd.PubKeyType {
+	return i.Algo
+}
+
+// GetPath implements Info interface
+func (i tssInfo) GetPath() (*hd.BIP44Params, error) {
+	return nil, fmt.Errorf("BIP44 Paths are not available for this type")
+}
+
+// InfoTags returns the tags of a key. Only watch-only keys can be tagged.
+func InfoTags(info Info) []string {
+	switch wi := info.(type) {
+	case watchInfo:
+		return wi.Tags
+	case *watchInfo:
+		return wi.Tags
+	default:
+		return nil
+	}
 }
 
 // encoding info
@@ -35,11 +35,13 @@ const (
 	BackendPass    = "pass"
 	BackendTest    = "test"
 	BackendMemory  = "memory"
+	BackendTSS     = "tss"
 )
 
 const (
 	keyringFileDirName = "keyring-file"
 	keyringTestDirName = "keyring-test"
+	keyringTSSDirName  = "keyring-tss"
 	passKeyringPrefix  = "keyring-%s"
 )
 
@@ -85,6 +87,14 @@ type Keyring interface {
 	// SaveMultisig stores and returns a new multsig (offline) key reference.
 	SaveMultisig(uid string, pubkey types.PubKey) (Info, error)
 
+	// SaveWatchKey stores and returns a new watch-only key reference, tracking
+	// an address and optionally its public key, labeled with the given tags.
+	SaveWatchKey(uid string, address sdk.AccAddress, pubkey types.PubKey, tags []string) (Info, error)
+
+	// SaveTSSKey retrieves the public key of a key of the threshold signature
+	// signer of the keyring options and persists a reference to it.
+	SaveTSSKey(uid, keyID string, algo SignatureAlgo) (Info, error)
+
 	Signer
 
 	Importer
@@ -135,6 +145,10 @@ type Exporter interface {
 	// It returns an error if the key does not exist or a wrong encryption passphrase is supplied.
 	ExportPrivKeyArmor(uid, encryptPassphrase string) (armor string, err error)
 	ExportPrivKeyArmorByAddress(address sdk.Address, encryptPassphrase string) (armor string, err error)
+
+	// ExportPrivKeyArmorWithCipher returns a private key in ASCII armored
+	// format, encrypted with the given cipher, e.g. crypto.CipherSm4.
+	ExportPrivKeyArmorWithCipher(uid, encryptPassphrase, cipher string) (armor string, err error)
 }
 
 // UnsafeExporter is implemented by key stores that support unsafe export
@@ -153,6 +167,12 @@ type Options struct {
 	SupportedAlgos SigningAlgoList
 	// supported signing algorithms for Ledger
 	SupportedAlgosLedger SigningAlgoList
+	// path of the signing log, none if empty
+	SigningLogPath string
+	// signer of the TSS keys, none if nil
+	TSSSigner TSSSigner
+	// whether the local keys, holding a private key, are rejected
+	noLocalKeys bool
 }
 
 // NewInMemory creates a transient keyring useful for testing
@@ -164,7 +184,7 @@ func NewInMemory(opts ...Option) Keyring {
 
 // New creates a new instance of a keyring.
 // Keyring ptions can be applied when generating the new instance.
-// Available backends are "os", "file", "kwallet", "memory", "pass", "test".
+// Available backends are "os", "file", "kwallet", "memory", "pass", "test", "tss".
 func New(
 	appName, backend, rootDir string, userInput io.Reader, opts ...Option,
 ) (Keyring, error) {
@@ -186,6 +206,9 @@ func New(
 		db, err = keyring.Open(newKWalletBackendKeyringConfig(appName, rootDir, userInput))
 	case BackendPass:
 		db, err = keyring.Open(newPassBackendKeyringConfig(appName, rootDir, userInput))
+	case BackendTSS:
+		db, err = keyring.Open(newTSSBackendKeyringConfig(appName, rootDir))
+		opts = append(opts, withoutLocalKeys())
 	default:
 		return nil, fmt.Errorf("unknown keyring backend %v", backend)
 	}
@@ -226,6 +249,10 @@ func (ks keystore) ExportPubKeyArmor(uid string) (string, error) {
 		return "", fmt.Errorf("no key to export with name: %s", uid)
 	}
 
+	if bz.GetPubKey() == nil {
+		return "", fmt.Errorf("no public key to export with name: %s", uid)
+	}
+
 	return crypto.ArmorPubKeyBytes(legacy.Cdc.MustMarshal(bz.GetPubKey()), string(bz.GetAlgo())), nil
 }
 
@@ -239,6 +266,10 @@ func (ks keystore) ExportPubKeyArmorByAddress(address sdk.Address) (string, erro
 }
 
 func (ks keystore) ExportPrivKeyArmor(uid, encryptPassphrase string) (armor string, err error) {
+	return ks.ExportPrivKeyArmorWithCipher(uid, encryptPassphrase, crypto.CipherXsalsa20)
+}
+
+func (ks keystore) ExportPrivKeyArmorWithCipher(uid, encryptPassphrase, cipher string) (armor string, err error) {
 	priv, err := ks.ExportPrivateKeyObject(uid)
 	if err != nil {
 		return "", err
@@ -249,7 +280,7 @@ func (ks keystore) ExportPrivKeyArmor(uid, encryptPassphrase string) (armor stri
 		return "", err
 	}
 
-	return crypto.EncryptArmorPrivKey(priv, encryptPassphrase, string(info.GetAlgo())), nil
+	return crypto.EncryptArmorPrivKeyWithCipher(priv, encryptPassphrase, string(info.GetAlgo()), cipher)
 }
 
 // ExportPrivateKeyObject exports an armored private key object.
@@ -273,7 +304,7 @@ func (ks keystore) ExportPrivateKeyObject(uid string) (types.PrivKey, error) {
 			return nil, err
 		}
 
-	case ledgerInfo, offlineInfo, multiInfo:
+	case ledgerInfo, offlineInfo, multiInfo, watchInfo, tssInfo:
 		return nil, errors.New("only works on local private keys")
 	}
 
@@ -340,12 +371,35 @@ func (ks keystore) ImportInfo(oldInfo Info) error {
 }
 
 func (ks keystore) Sign(uid string, msg []byte) ([]byte, types.PubKey, error) {
+	return ks.SignWithMetadata(uid, msg, SignMetadata{})
+}
+
+// SignWithMetadata implements MetadataSigner.
+func (ks keystore) SignWithMetadata(uid string, msg []byte, metadata SignMetadata) ([]byte, types.PubKey, error) {
 	info, err := ks.Key(uid)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	var priv types.PrivKey
+	sig, pub, err := ks.sign(info, msg)
+	if err != nil {
+		return sig, pub, err
+	}
+
+	if ks.options.SigningLogPath != "" {
+		if err := AppendSigningLog(ks.options.SigningLogPath, info, msg, metadata); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return sig, pub, nil
+}
+
+func (ks keystore) sign(info Info, msg []byte) ([]byte, types.PubKey, error) {
+	var (
+		priv types.PrivKey
+		err  error
+	)
 
 	switch i := info.(type) {
 	case localInfo:
@@ -361,8 +415,14 @@ func (ks keystore) Sign(uid string, msg []byte) ([]byte, types.PubKey, error) {
 	case ledgerInfo:
 		return SignWithLedger(info, msg)
 
+	case tssInfo:
+		return ks.signWithTSS(i, msg)
+
 	case offlineInfo, multiInfo:
 		return nil, info.GetPubKey(), errors.New("cannot sign with offline keys")
+
+	case watchInfo:
+		return nil, info.GetPubKey(), errors.New("cannot sign with watch-only keys")
 	}
 
 	sig, err := priv.Sign(msg)
@@ -417,6 +477,27 @@ func (ks keystore) SavePubKey(uid string, pubkey types.PubKey, algo hd.PubKeyTyp
 	return ks.writeOfflineKey(uid, pubkey, algo)
 }
 
+func (ks keystore) SaveWatchKey(uid string, address sdk.AccAddress, pubkey types.PubKey, tags []string) (Info, error) {
+	if pubkey != nil {
+		if address.Empty() {
+			address = sdk.AccAddressFromPubKey(pubkey)
+		} else if !address.Equals(sdk.AccAddressFromPubKey(pubkey)) {
+			return nil, fmt.Errorf("address %s does not match public key address %s", address, sdk.AccAddressFromPubKey(pubkey))
+		}
+	}
+
+	if address.Empty() {
+		return nil, errors.New("watch-only keys require an address or a public key")
+	}
+
+	info := newWatchInfo(uid, address, pubkey, tags)
+	if err := ks.writeInfo(info); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
 func (ks keystore) DeleteByAddress(address sdk.Address) error {
 	info, err := ks.KeyByAddress(address)
 	if err != nil {
@@ -550,7 +631,7 @@ func (ks keystore) NewAccount(name string, mnemonic string, bip39Passphrase stri
 
 	// check if the a key already exists with the same address and return an error
 	// if found
-	address := sdk.AccAddress(privKey.PubKey().Address())
+	address := sdk.AccAddressFromPubKey(privKey.PubKey())
 	if _, err := ks.KeyByAddress(address); err == nil {
 		return nil, fmt.Errorf("account with address %s already exists in keyring, delete the key first if you want to recreate it", address)
 	}
@@ -631,6 +712,19 @@ func newTestBackendKeyringConfig(appName, dir string) keyring.Config {
 	}
 }
 
+// newTSSBackendKeyringConfig returns the config of the tss backend, which only
+// stores public information, like the test backend, and is not encrypted.
+func newTSSBackendKeyringConfig(appName, dir string) keyring.Config {
+	return keyring.Config{
+		AllowedBackends: []keyring.BackendType{keyring.FileBackend},
+		ServiceName:     appName,
+		FileDir:         filepath.Join(dir, keyringTSSDirName),
+		FilePasswordFunc: func(_ string) (string, error) {
+			return "tss", nil
+		},
+	}
+}
+
 func newKWalletBackendKeyringConfig(appName, _ string, _ io.Reader) keyring.Config {
 	return keyring.Config{
 		AllowedBackends: []keyring.BackendType{keyring.KWalletBackend},
@@ -757,6 +851,10 @@ func (ks keystore) writeLocalKey(name string, priv types.PrivKey, algo hd.PubKey
 }
 
 func (ks keystore) writeInfo(info Info) error {
+	if ks.options.noLocalKeys && info.GetType() == TypeLocal {
+		return ErrLocalKeysNotAllowed
+	}
+
 	key := infoKeyBz(info.GetName())
 	serializedInfo := marshalInfo(info)
 
@@ -2,6 +2,7 @@ package keyring
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
@@ -964,6 +965,120 @@ func TestAltKeyring_SaveMultisig(t *testing.T) {
 	require.Len(t, list, 3)
 }
 
+func TestAltKeyring_SaveWatchKey(t *testing.T) {
+	keyring, err := New(t.Name(), BackendTest, t.TempDir(), nil)
+	require.NoError(t, err)
+
+	priv := secp256k1.GenPrivKey()
+	pub := priv.PubKey()
+	addr := sdk.AccAddress(pub.Address())
+
+	// neither address nor pubkey
+	_, err = keyring.SaveWatchKey("empty", nil, nil, nil)
+	require.Error(t, err)
+
+	// mismatching address and pubkey
+	_, err = keyring.SaveWatchKey("mismatch", sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), pub, nil)
+	require.Error(t, err)
+
+	info, err := keyring.SaveWatchKey("withpub", nil, pub, []string{"custody"})
+	require.NoError(t, err)
+	require.Equal(t, TypeWatch, info.GetType())
+	require.Equal(t, addr, info.GetAddress())
+	require.Equal(t, pub, info.GetPubKey())
+
+	addrOnly := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	_, err = keyring.SaveWatchKey("addronly", addrOnly, nil, []string{"cold", "treasury"})
+	require.NoError(t, err)
+
+	info, err = keyring.KeyByAddress(addrOnly)
+	require.NoError(t, err)
+	require.Equal(t, "addronly", info.GetName())
+	require.Equal(t, TypeWatch, info.GetType())
+	require.Nil(t, info.GetPubKey())
+	require.Equal(t, []string{"cold", "treasury"}, InfoTags(info))
+
+	_, _, err = keyring.Sign("addronly", []byte("msg"))
+	require.EqualError(t, err, "cannot sign with watch-only keys")
+	_, err = keyring.ExportPubKeyArmor("addronly")
+	require.Error(t, err)
+	_, err = keyring.ExportPrivKeyArmor("withpub", "password")
+	require.Error(t, err)
+
+	list, err := keyring.List()
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+}
+
+// mockTSSSigner is a TSS signer holding the full private keys of its key IDs.
+type mockTSSSigner struct {
+	keys    map[string]types.PrivKey
+	healthy bool
+}
+
+func (s mockTSSSigner) CheckHealth() error {
+	if !s.healthy {
+		return errors.New("not enough parties")
+	}
+	return nil
+}
+
+func (s mockTSSSigner) PubKey(keyID string, _ hd.PubKeyType) (types.PubKey, error) {
+	priv, ok := s.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown key %s", keyID)
+	}
+	return priv.PubKey(), nil
+}
+
+func (s mockTSSSigner) Sign(keyID string, _ types.PubKey, msg []byte) ([]byte, error) {
+	return s.keys[keyID].Sign(msg)
+}
+
+func TestAltKeyring_SaveTSSKey(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	signer := &mockTSSSigner{keys: map[string]types.PrivKey{"operator": priv}, healthy: true}
+
+	noSigner, err := New(t.Name(), BackendTest, t.TempDir(), nil)
+	require.NoError(t, err)
+	_, err = noSigner.SaveTSSKey("operator", "operator", hd.Secp256k1)
+	require.ErrorIs(t, err, ErrNoTSSSigner)
+
+	keyring, err := New(t.Name(), BackendTSS, t.TempDir(), nil, WithTSSSigner(signer))
+	require.NoError(t, err)
+
+	_, err = keyring.SaveTSSKey("unknown", "unknown", hd.Secp256k1)
+	require.Error(t, err)
+
+	info, err := keyring.SaveTSSKey("operator", "operator", hd.Secp256k1)
+	require.NoError(t, err)
+	require.Equal(t, TypeTSS, info.GetType())
+	require.Equal(t, priv.PubKey(), info.GetPubKey())
+	require.Equal(t, sdk.AccAddress(priv.PubKey().Address()), info.GetAddress())
+
+	msg := []byte("msg")
+	sig, pub, err := keyring.Sign("operator", msg)
+	require.NoError(t, err)
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	signer.healthy = false
+	_, _, err = keyring.Sign("operator", msg)
+	require.EqualError(t, err, "not enough parties")
+
+	_, err = keyring.ExportPrivKeyArmor("operator", "password")
+	require.Error(t, err)
+
+	// the tss backend does not store private keys
+	_, _, err = keyring.NewMnemonic("local", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.ErrorIs(t, err, ErrLocalKeysNotAllowed)
+	_, err = keyring.SavePubKey("offline", secp256k1.GenPrivKey().PubKey(), hd.Secp256k1Type)
+	require.NoError(t, err)
+
+	list, err := keyring.List()
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+}
+
 func TestAltKeyring_Sign(t *testing.T) {
 	keyring, err := New(t.Name(), BackendTest, t.TempDir(), nil)
 	require.NoError(t, err)
@@ -13,11 +13,12 @@ import (
 // KeyOutput defines a structure wrapping around an Info object used for output
 // functionality.
 type KeyOutput struct {
-	Name     string `json:"name" yaml:"name"`
-	Type     string `json:"type" yaml:"type"`
-	Address  string `json:"address" yaml:"address"`
-	PubKey   string `json:"pubkey" yaml:"pubkey"`
-	Mnemonic string `json:"mnemonic,omitempty" yaml:"mnemonic"`
+	Name     string   `json:"name" yaml:"name"`
+	Type     string   `json:"type" yaml:"type"`
+	Address  string   `json:"address" yaml:"address"`
+	PubKey   string   `json:"pubkey" yaml:"pubkey"`
+	Mnemonic string   `json:"mnemonic,omitempty" yaml:"mnemonic"`
+	Tags     []string `json:"tags,omitempty" yaml:"tags,omitempty"`
 }
 
 // NewKeyOutput creates a default KeyOutput instance without Mnemonic, Threshold and PubKeys
@@ -40,25 +41,41 @@ func NewKeyOutput(name string, keyType KeyType, a sdk.Address, pk cryptotypes.Pu
 
 // MkConsKeyOutput create a KeyOutput in with "cons" Bech32 prefixes.
 func MkConsKeyOutput(keyInfo Info) (KeyOutput, error) {
-	pk := keyInfo.GetPubKey()
-	addr := sdk.ConsAddress(pk.Address())
-	return NewKeyOutput(keyInfo.GetName(), keyInfo.GetType(), addr, pk)
+	return mkKeyOutput(keyInfo, sdk.ConsAddress(keyInfo.GetAddress()))
 }
 
 // MkValKeyOutput create a KeyOutput in with "val" Bech32 prefixes.
 func MkValKeyOutput(keyInfo Info) (KeyOutput, error) {
-	pk := keyInfo.GetPubKey()
-	addr := sdk.ValAddress(pk.Address())
-	return NewKeyOutput(keyInfo.GetName(), keyInfo.GetType(), addr, pk)
+	return mkKeyOutput(keyInfo, sdk.ValAddress(keyInfo.GetAddress()))
 }
 
 // MkAccKeyOutput create a KeyOutput in with "acc" Bech32 prefixes. If the
 // public key is a multisig public key, then the threshold and constituent
 // public keys will be added.
 func MkAccKeyOutput(keyInfo Info) (KeyOutput, error) {
+	return mkKeyOutput(keyInfo, keyInfo.GetAddress())
+}
+
+// mkKeyOutput creates a KeyOutput of the key with the given address, leaving
+// the public key empty for watch-only keys tracking only an address.
+func mkKeyOutput(keyInfo Info, addr sdk.Address) (KeyOutput, error) {
 	pk := keyInfo.GetPubKey()
-	addr := sdk.AccAddress(pk.Address())
-	return NewKeyOutput(keyInfo.GetName(), keyInfo.GetType(), addr, pk)
+	if pk == nil {
+		return KeyOutput{
+			Name:    keyInfo.GetName(),
+			Type:    keyInfo.GetType().String(),
+			Address: addr.String(),
+			Tags:    InfoTags(keyInfo),
+		}, nil
+	}
+
+	ko, err := NewKeyOutput(keyInfo.GetName(), keyInfo.GetType(), addr, pk)
+	if err != nil {
+		return KeyOutput{}, err
+	}
+
+	ko.Tags = InfoTags(keyInfo)
+	return ko, nil
 }
 
 // MkAccKeysOutput returns a slice of KeyOutput objects, each with the "acc"
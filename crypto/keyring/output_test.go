@@ -26,5 +26,24 @@ func TestBech32KeysOutput(t *testing.T) {
 	out, err := MkAccKeyOutput(info)
 	require.NoError(t, err)
 	require.Equal(t, expectedOutput, out)
-	require.Equal(t, `{Name:multisig Type:multi Address:cosmos1nf8lf6n4wa43rzmdzwe6hkrnw5guekhqt595cw PubKey:{"@type":"/cosmos.crypto.multisig.LegacyAminoPubKey","threshold":1,"public_keys":[{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"AurroA7jvfPd1AadmmOvWM2rJSwipXfRf8yD6pLbA2DJ"}]} Mnemonic:}`, fmt.Sprintf("%+v", out))
+	require.Equal(t, `{Name:multisig Type:multi Address:cosmos1nf8lf6n4wa43rzmdzwe6hkrnw5guekhqt595cw PubKey:{"@type":"/cosmos.crypto.multisig.LegacyAminoPubKey","threshold":1,"public_keys":[{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"AurroA7jvfPd1AadmmOvWM2rJSwipXfRf8yD6pLbA2DJ"}]} Mnemonic: Tags:[]}`, fmt.Sprintf("%+v", out))
+}
+
+func TestWatchKeyOutput(t *testing.T) {
+	addr := sdk.AccAddress([]byte("watch_only_address__"))
+	info := newWatchInfo("watch", addr, nil, []string{"custody"})
+
+	out, err := MkAccKeyOutput(info)
+	require.NoError(t, err)
+	require.Equal(t, KeyOutput{
+		Name:    "watch",
+		Type:    TypeWatch.String(),
+		Address: addr.String(),
+		Tags:    []string{"custody"},
+	}, out)
+
+	out, err = MkValKeyOutput(info)
+	require.NoError(t, err)
+	require.Equal(t, sdk.ValAddress(addr).String(), out.Address)
+	require.Empty(t, out.PubKey)
 }
@@ -0,0 +1,183 @@
+package keyring
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// SigningLogFileName is the name of the signing log file in the home
+// directory of a client.
+const SigningLogFileName = "keyring-signing.log"
+
+// signingLogMtx serializes the appends to the signing logs of the process.
+var signingLogMtx sync.Mutex
+
+// SignMetadata describes what a message is signed for, e.g. a tx.
+type SignMetadata struct {
+	SignMode string
+	ChainID  string
+}
+
+// MetadataSigner is implemented by key stores that can record what the
+// messages they sign are signed for in their signing log.
+type MetadataSigner interface {
+	// SignWithMetadata signs the message with a user key like Sign, and records
+	// the metadata in the signing log of the key store, if any.
+	SignWithMetadata(uid string, msg []byte, metadata SignMetadata) ([]byte, types.PubKey, error)
+}
+
+var _ MetadataSigner = keystore{}
+
+// WithSigningLog enables the signing log of the keyring at the given path,
+// which records every signature of the keyring.
+func WithSigningLog(path string) Option {
+	return func(options *Options) {
+		options.SigningLogPath = path
+	}
+}
+
+// SigningLogEntry is a signature recorded in a signing log. The entries of a
+// log are hash-chained: each entry contains the hash of the previous one, so
+// that removing or altering entries breaks the chain.
+type SigningLogEntry struct {
+	Sequence uint64    `json:"sequence" yaml:"sequence"`
+	Time     time.Time `json:"time" yaml:"time"`
+	KeyName  string    `json:"key_name" yaml:"key_name"`
+	Address  string    `json:"address" yaml:"address"`
+	SignMode string    `json:"sign_mode,omitempty" yaml:"sign_mode"`
+	ChainID  string    `json:"chain_id,omitempty" yaml:"chain_id"`
+	// SignBytesDigest is the hex SHA-256 digest of the signed bytes.
+	SignBytesDigest string `json:"sign_bytes_digest" yaml:"sign_bytes_digest"`
+	// PrevHash is the hash of the previous entry, empty for the first one.
+	PrevHash string `json:"prev_hash" yaml:"prev_hash"`
+	// Hash is the hex SHA-256 hash of the entry with an empty hash.
+	Hash string `json:"hash" yaml:"hash"`
+}
+
+// ComputeHash returns the hash of the entry.
+func (e SigningLogEntry) ComputeHash() (string, error) {
+	e.Hash = ""
+	bz, err := json.Marshal(e)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(bz)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// AppendSigningLog appends an entry for the signature of the message by the
+// key to the signing log at the given path, creating the log if needed.
+func AppendSigningLog(path string, info Info, msg []byte, metadata SignMetadata) error {
+	signingLogMtx.Lock()
+	defer signingLogMtx.Unlock()
+
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open the signing log: %w", err)
+	}
+	defer f.Close()
+
+	entries, err := readSigningLog(f)
+	if err != nil {
+		return err
+	}
+
+	digest := sha256.Sum256(msg)
+	entry := SigningLogEntry{
+		Time:            time.Now().UTC(),
+		KeyName:         info.GetName(),
+		Address:         info.GetAddress().String(),
+		SignMode:        metadata.SignMode,
+		ChainID:         metadata.ChainID,
+		SignBytesDigest: hex.EncodeToString(digest[:]),
+	}
+	if n := len(entries); n > 0 {
+		entry.Sequence = entries[n-1].Sequence + 1
+		entry.PrevHash = entries[n-1].Hash
+	}
+	if entry.Hash, err = entry.ComputeHash(); err != nil {
+		return err
+	}
+
+	bz, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(bz, '\n')); err != nil {
+		return fmt.Errorf("failed to write the signing log: %w", err)
+	}
+
+	return f.Sync()
+}
+
+// ReadSigningLog returns the entries of the signing log at the given path.
+func ReadSigningLog(path string) ([]SigningLogEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the signing log: %w", err)
+	}
+	defer f.Close()
+
+	return readSigningLog(f)
+}
+
+func readSigningLog(r io.Reader) ([]SigningLogEntry, error) {
+	var entries []SigningLogEntry
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		var entry SigningLogEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf("invalid signing log entry at line %d: %w", line, err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read the signing log: %w", err)
+	}
+
+	return entries, nil
+}
+
+// VerifySigningLog verifies the hash chain of the entries of a signing log. It
+// returns an error on the first entry which was altered, or follows removed
+// entries.
+func VerifySigningLog(entries []SigningLogEntry) error {
+	prevHash := ""
+	for i, entry := range entries {
+		if entry.Sequence != uint64(i) {
+			return fmt.Errorf("signing log entry %d has sequence %d", i, entry.Sequence)
+		}
+		if entry.PrevHash != prevHash {
+			return fmt.Errorf("signing log entry %d does not follow the previous entry", i)
+		}
+
+		hash, err := entry.ComputeHash()
+		if err != nil {
+			return err
+		}
+		if entry.Hash != hash {
+			return fmt.Errorf("signing log entry %d was altered", i)
+		}
+
+		prevHash = entry.Hash
+	}
+
+	return nil
+}
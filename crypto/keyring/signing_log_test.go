@@ -0,0 +1,143 @@
+package keyring
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSigningLog(t *testing.T) {
+	dir := t.TempDir()
+	logPath := filepath.Join(dir, SigningLogFileName)
+	kr, err := New(t.Name(), BackendTest, dir, nil, WithSigningLog(logPath))
+	require.NoError(t, err)
+
+	info, _, err := kr.NewMnemonic("jack", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = kr.SavePubKey("offline", secp256k1.GenPrivKey().PubKey(), hd.Secp256k1Type)
+	require.NoError(t, err)
+
+	_, _, err = kr.Sign("jack", []byte("first"))
+	require.NoError(t, err)
+	_, _, err = kr.SignByAddress(info.GetAddress(), []byte("second"))
+	require.NoError(t, err)
+	signer, ok := kr.(MetadataSigner)
+	require.True(t, ok)
+	_, _, err = signer.SignWithMetadata("jack", []byte("third"), SignMetadata{SignMode: "SIGN_MODE_DIRECT", ChainID: "test-chain"})
+	require.NoError(t, err)
+
+	// failed signatures are not recorded
+	_, _, err = kr.Sign("offline", []byte("fourth"))
+	require.Error(t, err)
+
+	entries, err := ReadSigningLog(logPath)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	require.NoError(t, VerifySigningLog(entries))
+
+	for i, msg := range []string{"first", "second", "third"} {
+		digest := sha256.Sum256([]byte(msg))
+		require.Equal(t, uint64(i), entries[i].Sequence)
+		require.Equal(t, "jack", entries[i].KeyName)
+		require.Equal(t, info.GetAddress().String(), entries[i].Address)
+		require.Equal(t, hex.EncodeToString(digest[:]), entries[i].SignBytesDigest)
+	}
+	require.Empty(t, entries[0].PrevHash)
+	require.Equal(t, entries[0].Hash, entries[1].PrevHash)
+	require.Equal(t, "SIGN_MODE_DIRECT", entries[2].SignMode)
+	require.Equal(t, "test-chain", entries[2].ChainID)
+
+	// the keyrings without a signing log do not record signatures
+	kr, err = New(t.Name(), BackendTest, dir, nil)
+	require.NoError(t, err)
+	_, _, err = kr.Sign("jack", []byte("unlogged"))
+	require.NoError(t, err)
+	entries, err = ReadSigningLog(logPath)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+}
+
+func TestVerifySigningLog(t *testing.T) {
+	dir := t.TempDir()
+	logPath := filepath.Join(dir, SigningLogFileName)
+	kr, err := New(t.Name(), BackendTest, dir, nil, WithSigningLog(logPath))
+	require.NoError(t, err)
+	_, _, err = kr.NewMnemonic("jack", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	for _, msg := range []string{"first", "second", "third"} {
+		_, _, err = kr.Sign("jack", []byte(msg))
+		require.NoError(t, err)
+	}
+
+	entries, err := ReadSigningLog(logPath)
+	require.NoError(t, err)
+	require.NoError(t, VerifySigningLog(entries))
+	require.NoError(t, VerifySigningLog(nil))
+
+	testCases := []struct {
+		name     string
+		malleate func([]SigningLogEntry) []SigningLogEntry
+		expErr   string
+	}{
+		{
+			"altered entry",
+			func(entries []SigningLogEntry) []SigningLogEntry {
+				entries[1].ChainID = "other-chain"
+				return entries
+			},
+			"entry 1 was altered",
+		},
+		{
+			"rehashed altered entry",
+			func(entries []SigningLogEntry) []SigningLogEntry {
+				entries[1].SignBytesDigest = entries[0].SignBytesDigest
+				entries[1].Hash, _ = entries[1].ComputeHash()
+				return entries
+			},
+			"entry 2 does not follow the previous entry",
+		},
+		{
+			"removed entry",
+			func(entries []SigningLogEntry) []SigningLogEntry {
+				return append(entries[:1], entries[2:]...)
+			},
+			"entry 1 has sequence 2",
+		},
+		{
+			"removed first entry",
+			func(entries []SigningLogEntry) []SigningLogEntry {
+				return entries[1:]
+			},
+			"entry 0 has sequence 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tampered := append([]SigningLogEntry(nil), entries...)
+			err := VerifySigningLog(tc.malleate(tampered))
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.expErr)
+		})
+	}
+
+	// entries are read back from the file as written
+	bz, err := ioutil.ReadFile(logPath)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(bz)), "\n")
+	require.Len(t, lines, 3)
+	var entry SigningLogEntry
+	require.NoError(t, json.Unmarshal([]byte(lines[2]), &entry))
+	require.Equal(t, entries[2], entry)
+}
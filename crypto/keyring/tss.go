@@ -0,0 +1,82 @@
+package keyring
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// TSSSigner is a threshold signature (TSS) signer, which signs with keys whose
+// shares are distributed among several parties, so that no single machine
+// holds the full private key. It is implemented by the client of the tss
+// package.
+type TSSSigner interface {
+	// CheckHealth returns an error if the signer cannot sign.
+	CheckHealth() error
+	// PubKey returns the public key of the given key of the signer.
+	PubKey(keyID string, algo hd.PubKeyType) (types.PubKey, error)
+	// Sign signs the message with the given key of the signer, whose public
+	// key is given.
+	Sign(keyID string, pubKey types.PubKey, msg []byte) ([]byte, error)
+}
+
+// WithTSSSigner sets the signer of the TSS keys of the keyring.
+func WithTSSSigner(signer TSSSigner) Option {
+	return func(options *Options) {
+		options.TSSSigner = signer
+	}
+}
+
+// withoutLocalKeys rejects the keys holding a private key, for the tss backend.
+func withoutLocalKeys() Option {
+	return func(options *Options) {
+		options.noLocalKeys = true
+	}
+}
+
+func (ks keystore) SaveTSSKey(uid, keyID string, algo SignatureAlgo) (Info, error) {
+	if !ks.options.SupportedAlgos.Contains(algo) {
+		return nil, fmt.Errorf(
+			"%w: signature algo %s is not defined in the keyring options",
+			ErrUnsupportedSigningAlgo, algo.Name(),
+		)
+	}
+	if ks.options.TSSSigner == nil {
+		return nil, ErrNoTSSSigner
+	}
+	if keyID == "" {
+		return nil, fmt.Errorf("empty TSS key ID")
+	}
+
+	pub, err := ks.options.TSSSigner.PubKey(keyID, algo.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	info := newTSSInfo(uid, pub, algo.Name(), keyID)
+	if err := ks.writeInfo(info); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// signWithTSS signs the message with the TSS signer of the keyring, after
+// checking that it can sign, so that a signer missing parties fails fast
+// rather than at the timeout of the signing round.
+func (ks keystore) signWithTSS(info tssInfo, msg []byte) ([]byte, types.PubKey, error) {
+	if ks.options.TSSSigner == nil {
+		return nil, info.GetPubKey(), ErrNoTSSSigner
+	}
+	if err := ks.options.TSSSigner.CheckHealth(); err != nil {
+		return nil, info.GetPubKey(), err
+	}
+
+	sig, err := ks.options.TSSSigner.Sign(info.KeyID, info.PubKey, msg)
+	if err != nil {
+		return nil, info.GetPubKey(), err
+	}
+
+	return sig, info.GetPubKey(), nil
+}
@@ -49,6 +49,8 @@ const (
 	TypeLedger  KeyType = 1
 	TypeOffline KeyType = 2
 	TypeMulti   KeyType = 3
+	TypeWatch   KeyType = 4
+	TypeTSS     KeyType = 5
 )
 
 var keyTypes = map[KeyType]string{
@@ -56,6 +58,8 @@ var keyTypes = map[KeyType]string{
 	TypeLedger:  "ledger",
 	TypeOffline: "offline",
 	TypeMulti:   "multi",
+	TypeWatch:   "watch",
+	TypeTSS:     "tss",
 }
 
 // String implements the stringer interface for KeyType.
@@ -122,6 +122,9 @@ func (m *LegacyAminoPubKey) Equals(key cryptotypes.PubKey) bool {
 	if !ok {
 		return false
 	}
+	if _, weighted := key.(*WeightedMultisigPubKey); weighted {
+		return false
+	}
 	pubKeys := m.GetPubKeys()
 	otherPubKeys := otherKey.GetPubKeys()
 	if m.GetThreshold() != otherKey.GetThreshold() || len(pubKeys) != len(otherPubKeys) {
@@ -0,0 +1,183 @@
+package multisig
+
+import (
+	fmt "fmt"
+
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	multisigtypes "github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var _ multisigtypes.PubKey = &WeightedMultisigPubKey{}
+var _ types.UnpackInterfacesMessage = &WeightedMultisigPubKey{}
+
+// NewWeightedMultisigPubKey returns a new WeightedMultisigPubKey, where the
+// i-th key has the i-th weight, and the threshold is the sum of the weights of
+// the keys which must sign.
+// Panics if len(weights) != len(pubKeys), if a weight is 0, or if the threshold
+// is 0 or greater than the sum of the weights.
+func NewWeightedMultisigPubKey(threshold uint32, pubKeys []cryptotypes.PubKey, weights []uint32) *WeightedMultisigPubKey {
+	if threshold == 0 {
+		panic("weighted multisignature: threshold == 0")
+	}
+	if len(weights) != len(pubKeys) {
+		panic("weighted multisignature: len(weights) != len(pubKeys)")
+	}
+	var total uint64
+	for _, weight := range weights {
+		if weight == 0 {
+			panic("weighted multisignature: weight == 0")
+		}
+		total += uint64(weight)
+	}
+	if total < uint64(threshold) {
+		panic("weighted multisignature: sum of weights < threshold")
+	}
+	anyPubKeys, err := packPubKeys(pubKeys)
+	if err != nil {
+		panic(err)
+	}
+	return &WeightedMultisigPubKey{Threshold: threshold, PubKeys: anyPubKeys, Weights: weights}
+}
+
+// Address implements cryptotypes.PubKey Address method
+func (m *WeightedMultisigPubKey) Address() cryptotypes.Address {
+	return tmcrypto.AddressHash(m.Bytes())
+}
+
+// Bytes returns the amino encoded version of the WeightedMultisigPubKey
+func (m *WeightedMultisigPubKey) Bytes() []byte {
+	return AminoCdc.MustMarshal(m)
+}
+
+// VerifyMultisignature implements the multisigtypes.PubKey VerifyMultisignature method.
+// The signatures must be added in an order corresponding to the public keys order in
+// WeightedMultisigPubKey, and the sum of the weights of the keys which signed must
+// reach the threshold.
+func (m *WeightedMultisigPubKey) VerifyMultisignature(getSignBytes multisigtypes.GetSignBytesFunc, sig *signing.MultiSignatureData) error {
+	bitarray := sig.BitArray
+	sigs := sig.Signatures
+	size := bitarray.Count()
+	pubKeys := m.GetPubKeys()
+	// ensure bit array is the correct size
+	if len(pubKeys) != size || len(m.Weights) != size {
+		return fmt.Errorf("bit array size is incorrect, expecting: %d", len(pubKeys))
+	}
+	// ensure there is a signature for each set bit
+	if len(sigs) != bitarray.NumTrueBitsBefore(size) {
+		return fmt.Errorf("signature size is incorrect %d", len(sigs))
+	}
+	// ensure the weight of the set signatures reaches the threshold
+	var weight uint64
+	for i := 0; i < size; i++ {
+		if bitarray.GetIndex(i) {
+			weight += uint64(m.Weights[i])
+		}
+	}
+	if weight < uint64(m.Threshold) {
+		return fmt.Errorf("not enough signatures weight set, have %d, expected %d", weight, m.Threshold)
+	}
+	// index in the list of signatures which we are concerned with.
+	sigIndex := 0
+	for i := 0; i < size; i++ {
+		if bitarray.GetIndex(i) {
+			switch si := sigs[sigIndex].(type) {
+			case *signing.SingleSignatureData:
+				msg, err := getSignBytes(si.SignMode)
+				if err != nil {
+					return err
+				}
+				if !pubKeys[i].VerifySignature(msg, si.Signature) {
+					return fmt.Errorf("unable to verify signature at index %d", i)
+				}
+			case *signing.MultiSignatureData:
+				nestedMultisigPk, ok := pubKeys[i].(multisigtypes.PubKey)
+				if !ok {
+					return fmt.Errorf("unable to parse pubkey of index %d", i)
+				}
+				if err := nestedMultisigPk.VerifyMultisignature(getSignBytes, si); err != nil {
+					return err
+				}
+			default:
+				return fmt.Errorf("improper signature data type for index %d", sigIndex)
+			}
+			sigIndex++
+		}
+	}
+	return nil
+}
+
+// VerifySignature implements cryptotypes.PubKey VerifySignature method,
+// it panics because it can't handle MultiSignatureData
+func (m *WeightedMultisigPubKey) VerifySignature(msg []byte, sig []byte) bool {
+	panic("not implemented")
+}
+
+// GetPubKeys implements the PubKey.GetPubKeys method
+func (m *WeightedMultisigPubKey) GetPubKeys() []cryptotypes.PubKey {
+	if m != nil {
+		pubKeys := make([]cryptotypes.PubKey, len(m.PubKeys))
+		for i := 0; i < len(m.PubKeys); i++ {
+			pubKeys[i] = m.PubKeys[i].GetCachedValue().(cryptotypes.PubKey)
+		}
+		return pubKeys
+	}
+
+	return nil
+}
+
+// GetWeights returns the weights of the keys, in the order of the keys.
+func (m *WeightedMultisigPubKey) GetWeights() []uint32 {
+	if m != nil {
+		return m.Weights
+	}
+
+	return nil
+}
+
+// Equals returns true if key is a WeightedMultisigPubKey with the same
+// threshold, and the same keys with the same weights, in the same order.
+func (m *WeightedMultisigPubKey) Equals(key cryptotypes.PubKey) bool {
+	otherKey, ok := key.(*WeightedMultisigPubKey)
+	if !ok {
+		return false
+	}
+	pubKeys := m.GetPubKeys()
+	otherPubKeys := otherKey.GetPubKeys()
+	if m.Threshold != otherKey.Threshold || len(pubKeys) != len(otherPubKeys) || len(m.Weights) != len(otherKey.Weights) {
+		return false
+	}
+
+	for i := 0; i < len(pubKeys); i++ {
+		if !pubKeys[i].Equals(otherPubKeys[i]) || m.Weights[i] != otherKey.Weights[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// GetThreshold implements the PubKey.GetThreshold method. The threshold is the
+// sum of the weights of the keys which must sign.
+func (m *WeightedMultisigPubKey) GetThreshold() uint {
+	return uint(m.Threshold)
+}
+
+// Type returns weighted multisig type
+func (m *WeightedMultisigPubKey) Type() string {
+	return "PubKeyWeightedMultisig"
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *WeightedMultisigPubKey) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, any := range m.PubKeys {
+		var pk cryptotypes.PubKey
+		err := unpacker.UnpackAny(any, &pk)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
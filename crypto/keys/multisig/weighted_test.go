@@ -0,0 +1,116 @@
+package multisig_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestNewWeightedMultisig(t *testing.T) {
+	pubKeys := generatePubKeys(3)
+
+	require.Panics(t, func() { kmultisig.NewWeightedMultisigPubKey(0, pubKeys, []uint32{1, 1, 1}) })
+	require.Panics(t, func() { kmultisig.NewWeightedMultisigPubKey(1, pubKeys, []uint32{1, 1}) })
+	require.Panics(t, func() { kmultisig.NewWeightedMultisigPubKey(1, pubKeys, []uint32{1, 0, 1}) })
+	require.Panics(t, func() { kmultisig.NewWeightedMultisigPubKey(4, pubKeys, []uint32{1, 1, 1}) })
+
+	pk := kmultisig.NewWeightedMultisigPubKey(3, pubKeys, []uint32{2, 1, 1})
+	require.Len(t, pk.Address().Bytes(), 20)
+	require.Equal(t, uint(3), pk.GetThreshold())
+	require.Equal(t, []uint32{2, 1, 1}, pk.GetWeights())
+}
+
+func TestWeightedEquals(t *testing.T) {
+	pubKeys := generatePubKeys(2)
+	pk := kmultisig.NewWeightedMultisigPubKey(2, pubKeys, []uint32{1, 1})
+
+	require.True(t, pk.Equals(kmultisig.NewWeightedMultisigPubKey(2, pubKeys, []uint32{1, 1})))
+	require.False(t, pk.Equals(kmultisig.NewWeightedMultisigPubKey(2, pubKeys, []uint32{2, 1})))
+	require.False(t, pk.Equals(kmultisig.NewWeightedMultisigPubKey(1, pubKeys, []uint32{1, 1})))
+	require.False(t, pk.Equals(kmultisig.NewWeightedMultisigPubKey(2, []cryptotypes.PubKey{pubKeys[1], pubKeys[0]}, []uint32{1, 1})))
+
+	// a weighted multisig key is never equal to a legacy one with the same keys
+	legacyPk := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+	require.False(t, pk.Equals(legacyPk))
+	require.False(t, legacyPk.Equals(pk))
+	require.NotEqual(t, pk.Address(), legacyPk.Address())
+}
+
+func TestVerifyWeightedMultisignature(t *testing.T) {
+	msg := []byte{1, 2, 3, 4}
+	signBytesFn := func(mode signing.SignMode) ([]byte, error) { return msg, nil }
+	pubKeys, sigs := generatePubKeysAndSignatures(3, msg)
+	pk := kmultisig.NewWeightedMultisigPubKey(3, pubKeys, []uint32{2, 1, 1})
+
+	// the weight of the two light keys does not reach the threshold
+	sig := multisig.NewMultisig(len(pubKeys))
+	require.NoError(t, multisig.AddSignatureFromPubKey(sig, sigs[1], pubKeys[1], pubKeys))
+	require.NoError(t, multisig.AddSignatureFromPubKey(sig, sigs[2], pubKeys[2], pubKeys))
+	require.Error(t, pk.VerifyMultisignature(signBytesFn, sig))
+
+	// while the heavy key along with a light one does
+	sig = multisig.NewMultisig(len(pubKeys))
+	require.NoError(t, multisig.AddSignatureFromPubKey(sig, sigs[0], pubKeys[0], pubKeys))
+	require.Error(t, pk.VerifyMultisignature(signBytesFn, sig))
+	require.NoError(t, multisig.AddSignatureFromPubKey(sig, sigs[2], pubKeys[2], pubKeys))
+	require.NoError(t, pk.VerifyMultisignature(signBytesFn, sig))
+
+	// a wrong signature fails even if the threshold is reached
+	sig.Signatures[1] = sigs[1]
+	require.Error(t, pk.VerifyMultisignature(signBytesFn, sig))
+
+	// a wrong size of the bit array fails
+	require.Error(t, pk.VerifyMultisignature(signBytesFn, multisig.NewMultisig(2)))
+
+	// a weighted multisig key can nest other multisig keys
+	nestedPk, nestedSig := generateNestedMultiSignature(2, msg)
+	pk = kmultisig.NewWeightedMultisigPubKey(2, []cryptotypes.PubKey{nestedPk, pubKeys[0]}, []uint32{2, 1})
+	sig = multisig.NewMultisig(2)
+	require.NoError(t, multisig.AddSignatureFromPubKey(sig, nestedSig, nestedPk, pk.GetPubKeys()))
+	require.NoError(t, pk.VerifyMultisignature(signBytesFn, sig))
+}
+
+func TestWeightedMultisigEncoding(t *testing.T) {
+	require := require.New(t)
+	msig := kmultisig.NewWeightedMultisigPubKey(2, generatePubKeys(3), []uint32{1, 1, 2})
+
+	// amino binary
+	bz, err := legacy.Cdc.Marshal(msig)
+	require.NoError(err)
+	aminoPk := &kmultisig.WeightedMultisigPubKey{}
+	require.NoError(legacy.Cdc.Unmarshal(bz, aminoPk))
+	require.True(msig.Equals(aminoPk))
+	require.Equal(msig.Address(), aminoPk.Address())
+
+	// proto JSON
+	registry := types.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	bz, err = cdc.MarshalInterfaceJSON(msig)
+	require.NoError(err)
+	var protoPk cryptotypes.PubKey
+	require.NoError(cdc.UnmarshalInterfaceJSON(bz, &protoPk))
+	require.True(protoPk.Equals(msig))
+	require.Equal(msig.Address(), protoPk.Address())
+
+	// the keyring
+	kb := keyring.NewInMemory()
+	_, err = kb.SaveMultisig("my weighted multisig", msig)
+	require.NoError(err)
+	info, err := kb.Key("my weighted multisig")
+	require.NoError(err)
+	require.Equal(keyring.TypeMulti, info.GetType())
+	require.True(msig.Equals(info.GetPubKey()))
+	require.Equal(msig.Address().Bytes(), info.GetAddress().Bytes())
+}
@@ -0,0 +1,82 @@
+package sm2
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// BatchVerifier verifies a batch of SM2 signatures. SM2 signatures cannot be
+// combined into a single check, so the batch verifies its signatures
+// concurrently, spreading them over the available CPUs.
+type BatchVerifier struct {
+	entries []batchEntry
+}
+
+type batchEntry struct {
+	pubKey *PubKey
+	msg    []byte
+	sig    []byte
+}
+
+// NewSm2BatchVerifier returns an empty SM2 batch verifier.
+func NewSm2BatchVerifier() *BatchVerifier {
+	return &BatchVerifier{}
+}
+
+// Add adds the signature of the given message by the given key to the batch.
+// It fails if the key is not an SM2 key or the signature is malformed.
+func (b *BatchVerifier) Add(key cryptotypes.PubKey, msg, sig []byte) error {
+	pubKey, ok := key.(*PubKey)
+	if !ok {
+		return fmt.Errorf("expected %T, got %T", &PubKey{}, key)
+	}
+	if len(pubKey.Key) != PubKeySize {
+		return fmt.Errorf("invalid pubkey size")
+	}
+	if len(sig) != SignatureSize {
+		return fmt.Errorf("invalid signature size")
+	}
+
+	b.entries = append(b.entries, batchEntry{pubKey: pubKey, msg: msg, sig: sig})
+	return nil
+}
+
+// Len returns the number of signatures in the batch.
+func (b *BatchVerifier) Len() int {
+	return len(b.entries)
+}
+
+// Verify verifies all the signatures of the batch. It returns whether they are
+// all valid, and the validity of each signature in the order they were added.
+func (b *BatchVerifier) Verify() (bool, []bool) {
+	valid := make([]bool, len(b.entries))
+
+	workers := runtime.GOMAXPROCS(0)
+	if workers > len(b.entries) {
+		workers = len(b.entries)
+	}
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < len(b.entries); i += workers {
+				e := b.entries[i]
+				valid[i] = e.pubKey.VerifySignature(e.msg, e.sig)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	for _, ok := range valid {
+		if !ok {
+			return false, valid
+		}
+	}
+
+	return true, valid
+}
@@ -0,0 +1,103 @@
+package sm2
+
+import (
+	"crypto/ecdsa"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/tjfoc/gmsm/sm2"
+	"github.com/tjfoc/gmsm/x509"
+)
+
+// ParseCertificate parses a GM/T 0015 X.509 certificate, PEM or DER encoded,
+// which carries an SM2 public key.
+func ParseCertificate(bz []byte) (*x509.Certificate, error) {
+	if block, _ := pem.Decode(bz); block != nil {
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("invalid certificate PEM block type %s", block.Type)
+		}
+		bz = block.Bytes
+	}
+
+	cert, err := x509.ParseCertificate(bz)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := CertificatePubKey(cert); err != nil {
+		return nil, err
+	}
+
+	return cert, nil
+}
+
+// CertificatePubKey returns the SM2 public key of a certificate.
+func CertificatePubKey(cert *x509.Certificate) (*PubKey, error) {
+	pub, ok := cert.PublicKey.(*ecdsa.PublicKey)
+	if !ok || pub.Curve != sm2.P256Sm2() {
+		return nil, fmt.Errorf("certificate %s does not carry an SM2 public key", cert.Subject)
+	}
+
+	compPubkey := sm2.Compress(&sm2.PublicKey{Curve: pub.Curve, X: pub.X, Y: pub.Y})
+	pubkeyBytes := make([]byte, PubKeySize)
+	copy(pubkeyBytes, compPubkey)
+
+	return &PubKey{Key: pubkeyBytes}, nil
+}
+
+// VerifyCertChain verifies that the leaf certificate, the first one of chain,
+// is issued by one of the root certificates through the other certificates of
+// chain, all of them being valid at the given time, e.g. the block time, so
+// that the verification is deterministic. The certificates are PEM or DER
+// encoded, and must all carry SM2 public keys, the ones of chain being signed
+// with SM2 over SM3. The key usages of the certificates are not restricted.
+//
+// It returns the leaf certificate, whose public key, see CertificatePubKey,
+// links the accounts of this key to the identity of the certificate.
+func VerifyCertChain(chain, roots [][]byte, at time.Time) (*x509.Certificate, error) {
+	if len(chain) == 0 {
+		return nil, fmt.Errorf("empty certificate chain")
+	}
+	if len(roots) == 0 {
+		return nil, fmt.Errorf("no root certificate")
+	}
+	if at.IsZero() {
+		return nil, fmt.Errorf("no verification time")
+	}
+
+	rootPool := x509.NewCertPool()
+	for _, bz := range roots {
+		root, err := ParseCertificate(bz)
+		if err != nil {
+			return nil, fmt.Errorf("invalid root certificate: %w", err)
+		}
+		rootPool.AddCert(root)
+	}
+
+	certs := make([]*x509.Certificate, len(chain))
+	intermediatePool := x509.NewCertPool()
+	for i, bz := range chain {
+		cert, err := ParseCertificate(bz)
+		if err != nil {
+			return nil, fmt.Errorf("invalid certificate %d of the chain: %w", i, err)
+		}
+		if cert.SignatureAlgorithm != x509.SM2WithSM3 {
+			return nil, fmt.Errorf("certificate %s is signed with %s, expected %s", cert.Subject, cert.SignatureAlgorithm, x509.SM2WithSM3)
+		}
+		if i > 0 {
+			intermediatePool.AddCert(cert)
+		}
+		certs[i] = cert
+	}
+
+	if _, err := certs[0].Verify(x509.VerifyOptions{
+		Intermediates: intermediatePool,
+		Roots:         rootPool,
+		CurrentTime:   at,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}); err != nil {
+		return nil, err
+	}
+
+	return certs[0], nil
+}
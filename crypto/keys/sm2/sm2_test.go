@@ -1,14 +1,20 @@
 package sm2_test
 
 import (
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/tjfoc/gmsm/x509"
 
 	"github.com/tendermint/tendermint/crypto"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/sm2"
 )
 
@@ -65,3 +71,124 @@ func TestGenPrivKeySm2FromSecret(t *testing.T) {
 	require.Equal(t, a, b)
 	require.NotEqual(t, a, c)
 }
+
+func TestBatchVerifier(t *testing.T) {
+	bv := sm2.NewSm2BatchVerifier()
+	for i := 0; i < 20; i++ {
+		privKey := sm2.GenPrivKey()
+		msg := crypto.CRandBytes(128)
+		sig, err := privKey.Sign(msg)
+		require.NoError(t, err)
+		require.NoError(t, bv.Add(privKey.PubKey(), msg, sig))
+	}
+	require.Equal(t, 20, bv.Len())
+
+	ok, valid := bv.Verify()
+	require.True(t, ok)
+	require.Len(t, valid, 20)
+
+	// Add a signature of another message.
+	privKey := sm2.GenPrivKey()
+	sig, err := privKey.Sign([]byte("foo"))
+	require.NoError(t, err)
+	require.NoError(t, bv.Add(privKey.PubKey(), []byte("bar"), sig))
+
+	ok, valid = bv.Verify()
+	require.False(t, ok)
+	for i, v := range valid {
+		require.Equal(t, i != 20, v, i)
+	}
+
+	require.Error(t, bv.Add(privKey.PubKey(), []byte("foo"), sig[:32]))
+	require.Error(t, bv.Add(secp256k1.GenPrivKey().PubKey(), []byte("foo"), sig))
+
+	ok, valid = sm2.NewSm2BatchVerifier().Verify()
+	require.True(t, ok)
+	require.Empty(t, valid)
+}
+
+func BenchmarkBatchVerifier(b *testing.B) {
+	const size = 64
+	bv := sm2.NewSm2BatchVerifier()
+	for i := 0; i < size; i++ {
+		privKey := sm2.GenPrivKey()
+		msg := crypto.CRandBytes(128)
+		sig, err := privKey.Sign(msg)
+		require.NoError(b, err)
+		require.NoError(b, bv.Add(privKey.PubKey(), msg, sig))
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bv.Verify()
+	}
+}
+
+// newTestCert returns a DER certificate of the key, signed by the issuer, or
+// self-signed if the issuer is nil.
+func newTestCert(t *testing.T, name string, privKey sm2.PrivKey, isCA bool, issuer *x509.Certificate, issuerKey sm2.PrivKey) []byte {
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Unix(1_000_000, 0),
+		NotAfter:              time.Unix(2_000_000, 0),
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+		SignatureAlgorithm:    x509.SM2WithSM3,
+	}
+	if issuer == nil {
+		issuer, issuerKey = template, privKey
+	}
+
+	der, err := x509.CreateCertificate(template, issuer, &privKey.GetPrivateKey().PublicKey, issuerKey.GetPrivateKey())
+	require.NoError(t, err)
+	return der
+}
+
+func TestVerifyCertChain(t *testing.T) {
+	rootKey, interKey, leafKey := sm2.GenPrivKey(), sm2.GenPrivKey(), sm2.GenPrivKey()
+	root := newTestCert(t, "root", rootKey, true, nil, sm2.PrivKey{})
+	rootCert, err := sm2.ParseCertificate(root)
+	require.NoError(t, err)
+	inter := newTestCert(t, "intermediate", interKey, true, rootCert, rootKey)
+	interCert, err := sm2.ParseCertificate(inter)
+	require.NoError(t, err)
+	leaf := newTestCert(t, "leaf", leafKey, false, interCert, interKey)
+
+	// the certificates are PEM or DER encoded
+	leafPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leaf})
+	at := time.Unix(1_500_000, 0)
+	cert, err := sm2.VerifyCertChain([][]byte{leafPEM, inter}, [][]byte{root}, at)
+	require.NoError(t, err)
+	require.Equal(t, "leaf", cert.Subject.CommonName)
+
+	pubKey, err := sm2.CertificatePubKey(cert)
+	require.NoError(t, err)
+	require.True(t, leafKey.PubKey().Equals(pubKey))
+
+	otherRoot := newTestCert(t, "root", sm2.GenPrivKey(), true, nil, sm2.PrivKey{})
+	leafOfLeaf := newTestCert(t, "leaf of leaf", sm2.GenPrivKey(), false, cert, leafKey)
+	_, err = sm2.ParseCertificate(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: leaf}))
+	require.Error(t, err)
+
+	testCases := []struct {
+		name  string
+		chain [][]byte
+		roots [][]byte
+		at    time.Time
+	}{
+		{"missing intermediate", [][]byte{leaf}, [][]byte{root}, at},
+		{"other root", [][]byte{leaf, inter}, [][]byte{otherRoot}, at},
+		{"not yet valid", [][]byte{leaf, inter}, [][]byte{root}, time.Unix(999_999, 0)},
+		{"expired", [][]byte{leaf, inter}, [][]byte{root}, time.Unix(2_000_001, 0)},
+		{"no time", [][]byte{leaf, inter}, [][]byte{root}, time.Time{}},
+		{"issued by a leaf", [][]byte{leafOfLeaf, leaf, inter}, [][]byte{root}, at},
+		{"empty chain", nil, [][]byte{root}, at},
+		{"no root", [][]byte{leaf, inter}, nil, at},
+		{"invalid certificate", [][]byte{leaf[:len(leaf)-1], inter}, [][]byte{root}, at},
+	}
+	for _, tc := range testCases {
+		_, err := sm2.VerifyCertChain(tc.chain, tc.roots, tc.at)
+		require.Error(t, err, tc.name)
+	}
+}
@@ -0,0 +1,539 @@
+// Package slip39 implements SLIP-0039, Shamir's Secret-Sharing for Mnemonic
+// Codes: a master secret is encrypted with a passphrase and split into
+// mnemonic shares, any threshold of which recovers it, while fewer shares
+// reveal nothing about it.
+//
+// See https://github.com/satoshilabs/slips/blob/master/slip-0039.md.
+package slip39
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	// radixBits is the number of bits encoded by a word.
+	radixBits = 10
+	// checksumLengthWords is the number of words of the checksum.
+	checksumLengthWords = 3
+	// metadataLengthWords is the number of words of a mnemonic which do not
+	// encode its share value: the identifier, extendable backup flag and
+	// iteration exponent (2), the group and member parameters (2) and the
+	// checksum (3).
+	metadataLengthWords = 7
+	// minMnemonicLengthWords is the number of words of the shortest mnemonics,
+	// sharing a 128 bits secret.
+	minMnemonicLengthWords = 20
+	// minStrengthBits is the minimum length of a master secret.
+	minStrengthBits = 128
+	// maxShareCount is the maximum number of groups and of members of a group.
+	maxShareCount = 16
+	// digestLengthBytes is the length of the digest of the shared secret.
+	digestLengthBytes = 4
+	// digestIndex and secretIndex are the x coordinates of the digest and the
+	// shared secret.
+	digestIndex = 254
+	secretIndex = 255
+	// roundCount is the number of rounds of the Feistel encryption of the
+	// master secret.
+	roundCount = 4
+	// baseIterationCount is the number of PBKDF2 iterations of the encryption
+	// with an iteration exponent of 0.
+	baseIterationCount = 10000
+	// iterationExponent is the iteration exponent of the generated mnemonics.
+	iterationExponent = 1
+)
+
+var (
+	customizationString           = []byte("shamir")
+	customizationStringExtendable = []byte("shamir_extendable")
+)
+
+// ErrInsufficientShares is returned when combining fewer mnemonics than
+// required to recover the master secret.
+var ErrInsufficientShares = errors.New("insufficient mnemonic shares")
+
+// SplitMnemonics encrypts a master secret of at least 128 bits with a
+// passphrase and splits it into count mnemonic shares of a single group, any
+// threshold of which recover it.
+func SplitMnemonics(threshold, count int, masterSecret, passphrase []byte) ([]string, error) {
+	if len(masterSecret)*8 < minStrengthBits || len(masterSecret)%2 != 0 {
+		return nil, fmt.Errorf("invalid master secret length %d, expected an even number of bytes, at least %d", len(masterSecret), minStrengthBits/8)
+	}
+	if count < 1 || count > maxShareCount {
+		return nil, fmt.Errorf("invalid share count %d, expected between 1 and %d", count, maxShareCount)
+	}
+	if threshold < 1 || threshold > count {
+		return nil, fmt.Errorf("invalid threshold %d, expected between 1 and the share count %d", threshold, count)
+	}
+	if threshold == 1 && count > 1 {
+		return nil, errors.New("creating multiple shares with a threshold of 1 is not allowed, use 1-of-1 sharing instead")
+	}
+	if err := validatePassphrase(passphrase); err != nil {
+		return nil, err
+	}
+
+	var idBz [2]byte
+	if _, err := rand.Read(idBz[:]); err != nil {
+		return nil, err
+	}
+	identifier := binary.BigEndian.Uint16(idBz[:]) >> 1
+
+	encryptedSecret := encrypt(masterSecret, passphrase, iterationExponent, identifier, false)
+
+	// the single group secret is the encrypted master secret itself
+	points, err := splitSecret(threshold, count, encryptedSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	mnemonics := make([]string, len(points))
+	for i, p := range points {
+		mnemonics[i] = share{
+			identifier:        identifier,
+			iterationExponent: iterationExponent,
+			groupIndex:        0,
+			groupThreshold:    1,
+			groupCount:        1,
+			memberIndex:       p.x,
+			memberThreshold:   uint8(threshold),
+			value:             p.y,
+		}.mnemonic()
+	}
+
+	return mnemonics, nil
+}
+
+// CombineMnemonics recovers the master secret of mnemonic shares decrypted with
+// a passphrase. It returns ErrInsufficientShares if the mnemonics are valid but
+// do not meet the group and member thresholds.
+func CombineMnemonics(mnemonics []string, passphrase []byte) ([]byte, error) {
+	if len(mnemonics) == 0 {
+		return nil, ErrInsufficientShares
+	}
+	if err := validatePassphrase(passphrase); err != nil {
+		return nil, err
+	}
+
+	shares := make([]share, len(mnemonics))
+	for i, mnemonic := range mnemonics {
+		s, err := parseShare(mnemonic)
+		if err != nil {
+			return nil, err
+		}
+		shares[i] = s
+	}
+
+	first := shares[0]
+	groups := make(map[uint8][]share)
+	for _, s := range shares {
+		switch {
+		case s.identifier != first.identifier || s.extendable != first.extendable || s.iterationExponent != first.iterationExponent:
+			return nil, errors.New("invalid mnemonic set, all mnemonics must begin with the same 2 words")
+		case s.groupThreshold != first.groupThreshold || s.groupCount != first.groupCount:
+			return nil, errors.New("invalid mnemonic set, all mnemonics must have the same group threshold and group count")
+		case len(s.value) != len(first.value):
+			return nil, errors.New("invalid mnemonic set, all mnemonics must have the same length")
+		}
+
+		members, duplicate := groups[s.groupIndex], false
+		for _, m := range members {
+			if m.memberThreshold != s.memberThreshold {
+				return nil, fmt.Errorf("invalid mnemonic set, the mnemonics of group %d must have the same member threshold", s.groupIndex+1)
+			}
+			if m.memberIndex == s.memberIndex {
+				if !bytes.Equal(m.value, s.value) {
+					return nil, fmt.Errorf("invalid mnemonic set, conflicting mnemonics of member %d of group %d", s.memberIndex+1, s.groupIndex+1)
+				}
+				duplicate = true
+			}
+		}
+		if !duplicate {
+			groups[s.groupIndex] = append(members, s)
+		}
+	}
+
+	groupIndexes := make([]int, 0, len(groups))
+	for gi := range groups {
+		groupIndexes = append(groupIndexes, int(gi))
+	}
+	sort.Ints(groupIndexes)
+
+	var groupPoints []point
+	for _, gi := range groupIndexes {
+		members := groups[uint8(gi)]
+		threshold := int(members[0].memberThreshold)
+		if len(members) < threshold {
+			continue
+		}
+
+		memberPoints := make([]point, threshold)
+		for i, m := range members[:threshold] {
+			memberPoints[i] = point{x: m.memberIndex, y: m.value}
+		}
+
+		groupSecret, err := recoverSecret(threshold, memberPoints)
+		if err != nil {
+			return nil, err
+		}
+		groupPoints = append(groupPoints, point{x: uint8(gi), y: groupSecret})
+	}
+
+	groupThreshold := int(first.groupThreshold)
+	if len(groupPoints) < groupThreshold {
+		return nil, ErrInsufficientShares
+	}
+
+	encryptedSecret, err := recoverSecret(groupThreshold, groupPoints[:groupThreshold])
+	if err != nil {
+		return nil, err
+	}
+
+	return decrypt(encryptedSecret, passphrase, first.iterationExponent, first.identifier, first.extendable), nil
+}
+
+// validatePassphrase returns an error if a passphrase has other characters than
+// printable ASCII ones.
+func validatePassphrase(passphrase []byte) error {
+	for _, c := range passphrase {
+		if c < 32 || c > 126 {
+			return errors.New("invalid passphrase, expected printable ASCII characters only")
+		}
+	}
+
+	return nil
+}
+
+// share is a decoded mnemonic share.
+type share struct {
+	identifier        uint16
+	extendable        bool
+	iterationExponent uint8
+	groupIndex        uint8
+	groupThreshold    uint8
+	groupCount        uint8
+	memberIndex       uint8
+	memberThreshold   uint8
+	value             []byte
+}
+
+// mnemonic returns the words of the share.
+func (s share) mnemonic() string {
+	idExp := int(s.identifier)<<5 | int(s.iterationExponent)
+	if s.extendable {
+		idExp |= 1 << 4
+	}
+	params := int(s.groupIndex)<<16 | int(s.groupThreshold-1)<<12 | int(s.groupCount-1)<<8 |
+		int(s.memberIndex)<<4 | int(s.memberThreshold-1)
+
+	indices := []int{idExp >> radixBits, idExp & (1<<radixBits - 1), params >> radixBits, params & (1<<radixBits - 1)}
+	indices = append(indices, bytesToIndices(s.value)...)
+	indices = append(indices, createChecksum(customization(s.extendable), indices)...)
+
+	words := make([]string, len(indices))
+	for i, idx := range indices {
+		words[i] = wordlist[idx]
+	}
+
+	return strings.Join(words, " ")
+}
+
+// parseShare decodes a mnemonic share.
+func parseShare(mnemonic string) (share, error) {
+	words := strings.Fields(strings.ToLower(mnemonic))
+	if len(words) < minMnemonicLengthWords {
+		return share{}, fmt.Errorf("invalid mnemonic length %d, expected at least %d words", len(words), minMnemonicLengthWords)
+	}
+
+	paddingBits := (radixBits * (len(words) - metadataLengthWords)) % 16
+	if paddingBits > 8 {
+		return share{}, fmt.Errorf("invalid mnemonic length %d words", len(words))
+	}
+
+	indices := make([]int, len(words))
+	for i, word := range words {
+		idx, ok := wordIndex[word]
+		if !ok {
+			return share{}, fmt.Errorf("invalid mnemonic word %q", word)
+		}
+		indices[i] = idx
+	}
+
+	idExp := indices[0]<<radixBits | indices[1]
+	extendable := idExp>>4&1 == 1
+	if !verifyChecksum(customization(extendable), indices) {
+		return share{}, errors.New("invalid mnemonic checksum")
+	}
+
+	params := indices[2]<<radixBits | indices[3]
+	s := share{
+		identifier:        uint16(idExp >> 5),
+		extendable:        extendable,
+		iterationExponent: uint8(idExp & 0xf),
+		groupIndex:        uint8(params >> 16),
+		groupThreshold:    uint8(params>>12&0xf) + 1,
+		groupCount:        uint8(params>>8&0xf) + 1,
+		memberIndex:       uint8(params >> 4 & 0xf),
+		memberThreshold:   uint8(params&0xf) + 1,
+	}
+	if s.groupCount < s.groupThreshold {
+		return share{}, errors.New("invalid mnemonic, the group threshold exceeds the group count")
+	}
+
+	valueIndices := indices[4 : len(indices)-checksumLengthWords]
+	value := new(big.Int)
+	for _, idx := range valueIndices {
+		value.Lsh(value, radixBits).Or(value, big.NewInt(int64(idx)))
+	}
+
+	byteCount := (radixBits*len(valueIndices) - paddingBits) / 8
+	if value.BitLen() > byteCount*8 {
+		return share{}, errors.New("invalid mnemonic padding")
+	}
+	s.value = value.FillBytes(make([]byte, byteCount))
+
+	return s, nil
+}
+
+// bytesToIndices returns the word indices encoding a share value, left padded
+// with zero bits.
+func bytesToIndices(bz []byte) []int {
+	value := new(big.Int).SetBytes(bz)
+	mask := big.NewInt(1<<radixBits - 1)
+
+	indices := make([]int, (len(bz)*8+radixBits-1)/radixBits)
+	for i := len(indices) - 1; i >= 0; i-- {
+		indices[i] = int(new(big.Int).And(value, mask).Int64())
+		value.Rsh(value, radixBits)
+	}
+
+	return indices
+}
+
+func customization(extendable bool) []byte {
+	if extendable {
+		return customizationStringExtendable
+	}
+	return customizationString
+}
+
+// rs1024Polymod computes the RS1024 checksum polynomial of a sequence of
+// 10 bits values.
+func rs1024Polymod(values []int) int {
+	gen := [10]int{
+		0xe0e040, 0x1c1c080, 0x3838100, 0x7070200, 0xe0e0009,
+		0x1c0c2412, 0x38086c24, 0x3090fc48, 0x21b1f890, 0x3f3f120,
+	}
+
+	chk := 1
+	for _, v := range values {
+		b := chk >> 20
+		chk = (chk&0xfffff)<<10 ^ v
+		for i := 0; i < 10; i++ {
+			if (b>>i)&1 == 1 {
+				chk ^= gen[i]
+			}
+		}
+	}
+
+	return chk
+}
+
+func createChecksum(cs []byte, data []int) []int {
+	values := make([]int, 0, len(cs)+len(data)+checksumLengthWords)
+	for _, c := range cs {
+		values = append(values, int(c))
+	}
+	values = append(values, data...)
+	values = append(values, make([]int, checksumLengthWords)...)
+
+	polymod := rs1024Polymod(values) ^ 1
+	checksum := make([]int, checksumLengthWords)
+	for i := range checksum {
+		checksum[i] = polymod >> (radixBits * (checksumLengthWords - 1 - i)) & (1<<radixBits - 1)
+	}
+
+	return checksum
+}
+
+func verifyChecksum(cs []byte, data []int) bool {
+	values := make([]int, 0, len(cs)+len(data))
+	for _, c := range cs {
+		values = append(values, int(c))
+	}
+
+	return rs1024Polymod(append(values, data...)) == 1
+}
+
+// encrypt encrypts a master secret with the 4 rounds Feistel network of
+// SLIP-0039, PBKDF2-HMAC-SHA256 being the round function.
+func encrypt(masterSecret, passphrase []byte, e uint8, identifier uint16, extendable bool) []byte {
+	half := len(masterSecret) / 2
+	l, r := masterSecret[:half], masterSecret[half:]
+	salt := encryptionSalt(identifier, extendable)
+
+	for i := 0; i < roundCount; i++ {
+		l, r = r, xor(l, roundFunction(byte(i), passphrase, e, salt, r))
+	}
+
+	return append(append([]byte{}, r...), l...)
+}
+
+// decrypt decrypts an encrypted master secret, running the rounds of encrypt
+// in reverse order.
+func decrypt(encryptedSecret, passphrase []byte, e uint8, identifier uint16, extendable bool) []byte {
+	half := len(encryptedSecret) / 2
+	l, r := encryptedSecret[:half], encryptedSecret[half:]
+	salt := encryptionSalt(identifier, extendable)
+
+	for i := roundCount - 1; i >= 0; i-- {
+		l, r = r, xor(l, roundFunction(byte(i), passphrase, e, salt, r))
+	}
+
+	return append(append([]byte{}, r...), l...)
+}
+
+func encryptionSalt(identifier uint16, extendable bool) []byte {
+	if extendable {
+		return nil
+	}
+
+	salt := append([]byte{}, customizationString...)
+	return append(salt, byte(identifier>>8), byte(identifier))
+}
+
+func roundFunction(i byte, passphrase []byte, e uint8, salt, r []byte) []byte {
+	password := append([]byte{i}, passphrase...)
+	return pbkdf2.Key(password, append(append([]byte{}, salt...), r...), (baseIterationCount<<e)/roundCount, len(r), sha256.New)
+}
+
+func xor(a, b []byte) []byte {
+	out := make([]byte, len(a))
+	for i := range a {
+		out[i] = a[i] ^ b[i]
+	}
+	return out
+}
+
+// point is a point of a polynomial over GF(256), evaluated bytewise.
+type point struct {
+	x uint8
+	y []byte
+}
+
+// expTable and logTable are the exponentiation and logarithm tables of
+// GF(256) with the Rijndael polynomial x^8 + x^4 + x^3 + x + 1, the
+// generator being x + 1.
+var expTable, logTable [256]int
+
+func init() {
+	poly := 1
+	for i := 0; i < 255; i++ {
+		expTable[i] = poly
+		logTable[poly] = i
+		poly = poly<<1 ^ poly
+		if poly&0x100 != 0 {
+			poly ^= 0x11b
+		}
+	}
+}
+
+// interpolate evaluates at x the Lagrange interpolation polynomial of points
+// of distinct x coordinates.
+func interpolate(points []point, x uint8) []byte {
+	for _, p := range points {
+		if p.x == x {
+			return append([]byte{}, p.y...)
+		}
+	}
+
+	logProd := 0
+	for _, p := range points {
+		logProd += logTable[p.x^x]
+	}
+
+	result := make([]byte, len(points[0].y))
+	for _, p := range points {
+		logBasis := logProd - logTable[p.x^x]
+		for _, o := range points {
+			logBasis -= logTable[p.x^o.x]
+		}
+		logBasis = (logBasis%255 + 255) % 255
+
+		for i, y := range p.y {
+			if y != 0 {
+				result[i] ^= byte(expTable[(logTable[y]+logBasis)%255])
+			}
+		}
+	}
+
+	return result
+}
+
+// splitSecret splits a secret into count points, any threshold of which
+// recover it, the polynomial also encoding a digest of the secret.
+func splitSecret(threshold, count int, secret []byte) ([]point, error) {
+	points := make([]point, 0, count)
+	if threshold == 1 {
+		for i := 0; i < count; i++ {
+			points = append(points, point{x: uint8(i), y: append([]byte{}, secret...)})
+		}
+		return points, nil
+	}
+
+	randomShareCount := threshold - 2
+	for i := 0; i < randomShareCount; i++ {
+		y := make([]byte, len(secret))
+		if _, err := rand.Read(y); err != nil {
+			return nil, err
+		}
+		points = append(points, point{x: uint8(i), y: y})
+	}
+
+	randomPart := make([]byte, len(secret)-digestLengthBytes)
+	if _, err := rand.Read(randomPart); err != nil {
+		return nil, err
+	}
+
+	basePoints := append(append([]point{}, points...),
+		point{x: digestIndex, y: append(createDigest(randomPart, secret), randomPart...)},
+		point{x: secretIndex, y: secret},
+	)
+	for i := randomShareCount; i < count; i++ {
+		points = append(points, point{x: uint8(i), y: interpolate(basePoints, uint8(i))})
+	}
+
+	return points, nil
+}
+
+// recoverSecret recovers the secret of threshold points and verifies its
+// digest.
+func recoverSecret(threshold int, points []point) ([]byte, error) {
+	if threshold == 1 {
+		return points[0].y, nil
+	}
+
+	secret := interpolate(points, secretIndex)
+	digestShare := interpolate(points, digestIndex)
+	if !hmac.Equal(digestShare[:digestLengthBytes], createDigest(digestShare[digestLengthBytes:], secret)) {
+		return nil, errors.New("invalid digest of the shared secret")
+	}
+
+	return secret, nil
+}
+
+func createDigest(randomPart, secret []byte) []byte {
+	mac := hmac.New(sha256.New, randomPart)
+	mac.Write(secret)
+	return mac.Sum(nil)[:digestLengthBytes]
+}
@@ -0,0 +1,131 @@
+package slip39_test
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/slip39"
+)
+
+// test vectors of SLIP-0039, with the passphrase "TREZOR"
+func TestCombineMnemonicsVectors(t *testing.T) {
+	testCases := []struct {
+		name      string
+		mnemonics []string
+		secret    string
+		expErr    string
+	}{
+		{
+			"valid mnemonic without sharing (128 bits)",
+			[]string{"duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision keyboard"},
+			"bb54aac4b89dc868ba37d9cc21b2cece",
+			"",
+		},
+		{
+			"mnemonic with invalid checksum (128 bits)",
+			[]string{"duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision kidney"},
+			"",
+			"invalid mnemonic checksum",
+		},
+		{
+			"basic sharing 2-of-3 (128 bits)",
+			[]string{
+				"shadow pistol academic always adequate wildlife fancy gross oasis cylinder mustang wrist rescue view short owner flip making coding armed",
+				"shadow pistol academic acid actress prayer class unknown daughter sweater depict flip twice unkind craft early superior advocate guest smoking",
+			},
+			"b43ceb7e57a0ea8766221624d01b0864",
+			"",
+		},
+		{
+			"basic sharing 2-of-3 with one share (128 bits)",
+			[]string{"shadow pistol academic always adequate wildlife fancy gross oasis cylinder mustang wrist rescue view short owner flip making coding armed"},
+			"",
+			slip39.ErrInsufficientShares.Error(),
+		},
+		{
+			"valid mnemonic without sharing (256 bits)",
+			[]string{"theory painting academic academic armed sweater year military elder discuss acne wildlife boring employer fused large satoshi bundle carbon diagnose anatomy hamster leaves tracks paces beyond phantom capital marvel lips brave detect luck"},
+			"989baf9dcaad5b10ca33dfd8cc75e42477025dce88ae83e75a230086a0e00e92",
+			"",
+		},
+		{
+			"mnemonic with an unknown word",
+			[]string{"duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision cosmos"},
+			"",
+			`invalid mnemonic word "cosmos"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			secret, err := slip39.CombineMnemonics(tc.mnemonics, []byte("TREZOR"))
+			if tc.expErr != "" {
+				require.EqualError(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.secret, hex.EncodeToString(secret))
+		})
+	}
+}
+
+func TestSplitMnemonics(t *testing.T) {
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	require.NoError(t, err)
+
+	mnemonics, err := slip39.SplitMnemonics(3, 5, secret, []byte("TREZOR"))
+	require.NoError(t, err)
+	require.Len(t, mnemonics, 5)
+	for _, m := range mnemonics {
+		require.Len(t, strings.Fields(m), 33)
+	}
+
+	for _, shares := range [][]string{mnemonics[:3], mnemonics[2:], {mnemonics[4], mnemonics[0], mnemonics[2]}, mnemonics} {
+		recovered, err := slip39.CombineMnemonics(shares, []byte("TREZOR"))
+		require.NoError(t, err)
+		require.Equal(t, secret, recovered)
+	}
+
+	// the passphrase is part of the encryption, any passphrase decrypts a
+	// different secret
+	recovered, err := slip39.CombineMnemonics(mnemonics[:3], nil)
+	require.NoError(t, err)
+	require.NotEqual(t, secret, recovered)
+
+	_, err = slip39.CombineMnemonics(mnemonics[:2], []byte("TREZOR"))
+	require.ErrorIs(t, err, slip39.ErrInsufficientShares)
+
+	_, err = slip39.CombineMnemonics([]string{mnemonics[0], mnemonics[0]}, []byte("TREZOR"))
+	require.ErrorIs(t, err, slip39.ErrInsufficientShares)
+
+	other, err := slip39.SplitMnemonics(3, 5, secret, []byte("TREZOR"))
+	require.NoError(t, err)
+	_, err = slip39.CombineMnemonics([]string{mnemonics[0], mnemonics[1], other[2]}, []byte("TREZOR"))
+	require.Error(t, err)
+
+	single, err := slip39.SplitMnemonics(1, 1, secret, nil)
+	require.NoError(t, err)
+	recovered, err = slip39.CombineMnemonics(single, nil)
+	require.NoError(t, err)
+	require.Equal(t, secret, recovered)
+
+	for _, tc := range []struct {
+		threshold, count int
+		secret           []byte
+	}{
+		{2, 3, secret[:15]},
+		{2, 3, secret[:17]},
+		{4, 3, secret},
+		{0, 3, secret},
+		{2, 17, secret},
+		{1, 3, secret},
+	} {
+		_, err := slip39.SplitMnemonics(tc.threshold, tc.count, tc.secret, nil)
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,81 @@
+// Package sm3hash implements the SM3 counterpart of the tmhash package of
+// Tendermint, i.e. the SM3 hash of GB/T 32905-2016 and its truncation to the
+// length of the addresses.
+package sm3hash
+
+import (
+	"hash"
+
+	"github.com/tjfoc/gmsm/sm3"
+)
+
+const (
+	Size      = 32
+	BlockSize = 64
+
+	// TruncatedSize is the size of the truncated hashes, i.e. of the addresses.
+	TruncatedSize = 20
+)
+
+// sm3Hash wraps the SM3 implementation of gmsm, whose Sum method writes its
+// argument to the hash rather than appending the hash to it, to implement
+// hash.Hash.
+type sm3Hash struct {
+	sm3 hash.Hash
+}
+
+func (h sm3Hash) Write(p []byte) (n int, err error) {
+	return h.sm3.Write(p)
+}
+
+// Sum appends the hash of the data written so far to b. It does not change the
+// state of the hash.
+func (h sm3Hash) Sum(b []byte) []byte {
+	return append(b, h.sm3.Sum(nil)...)
+}
+
+func (h sm3Hash) Reset() {
+	h.sm3.Reset()
+}
+
+func (h sm3Hash) Size() int {
+	return Size
+}
+
+func (h sm3Hash) BlockSize() int {
+	return BlockSize
+}
+
+// New returns a new hash.Hash.
+func New() hash.Hash {
+	return sm3Hash{sm3: sm3.New()}
+}
+
+// Sum returns the SM3 of the bz.
+func Sum(bz []byte) []byte {
+	return sm3.Sm3Sum(bz)
+}
+
+//-------------------------------------------------------------
+
+type sm3trunc struct {
+	sm3Hash
+}
+
+func (h sm3trunc) Sum(b []byte) []byte {
+	return append(b, h.sm3.Sum(nil)[:TruncatedSize]...)
+}
+
+func (h sm3trunc) Size() int {
+	return TruncatedSize
+}
+
+// NewTruncated returns a new hash.Hash.
+func NewTruncated() hash.Hash {
+	return sm3trunc{sm3Hash{sm3: sm3.New()}}
+}
+
+// SumTruncated returns the first 20 bytes of SM3 of the bz.
+func SumTruncated(bz []byte) []byte {
+	return sm3.Sm3Sum(bz)[:TruncatedSize]
+}
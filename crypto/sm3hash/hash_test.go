@@ -0,0 +1,53 @@
+package sm3hash_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/sm3hash"
+)
+
+func TestHash(t *testing.T) {
+	// the examples of GB/T 32905-2016
+	testCases := []struct {
+		msg      string
+		expected string
+	}{
+		{"abc", "66c7f0f462eeedd9d1f2d46bdc10e4e24167c4875cf2f7a2297da02b8f4ba8e0"},
+		{strings.Repeat("abcd", 16), "debe9ff92275b8a138604889c18e5a4d6fdb70e5387e5765293dcba39c0c5732"},
+	}
+	for _, tc := range testCases {
+		expected, err := hex.DecodeString(tc.expected)
+		require.NoError(t, err)
+		require.Equal(t, expected, sm3hash.Sum([]byte(tc.msg)))
+		require.Equal(t, expected[:sm3hash.TruncatedSize], sm3hash.SumTruncated([]byte(tc.msg)))
+
+		// the data is written in several parts
+		hasher := sm3hash.New()
+		truncated := sm3hash.NewTruncated()
+		for _, part := range []string{tc.msg[:1], tc.msg[1:]} {
+			_, err = hasher.Write([]byte(part))
+			require.NoError(t, err)
+			_, err = truncated.Write([]byte(part))
+			require.NoError(t, err)
+		}
+		require.Equal(t, expected, hasher.Sum(nil))
+		require.Equal(t, expected[:sm3hash.TruncatedSize], truncated.Sum(nil))
+
+		// the hashes are appended, without changing the state
+		require.Equal(t, append([]byte("prefix"), expected...), hasher.Sum([]byte("prefix")))
+		require.Equal(t, expected, hasher.Sum(nil))
+
+		hasher.Reset()
+		_, err = hasher.Write([]byte(tc.msg))
+		require.NoError(t, err)
+		require.Equal(t, expected, hasher.Sum(nil))
+	}
+
+	require.Equal(t, sm3hash.Size, sm3hash.New().Size())
+	require.Equal(t, sm3hash.TruncatedSize, sm3hash.NewTruncated().Size())
+	require.Equal(t, sm3hash.BlockSize, sm3hash.New().BlockSize())
+}
@@ -0,0 +1,169 @@
+// Package tss implements the client of a threshold signature (TSS) signer,
+// which signs with keys whose shares are distributed among several parties,
+// so that no single machine holds the full private key.
+package tss
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/sm2"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+const (
+	// ServiceName is the name of the Signer service, under which the signers
+	// report their health.
+	ServiceName = "cosmos.crypto.tss.v1beta1.Signer"
+
+	// DefaultTimeout is the default timeout of the requests to a signer, which
+	// runs a signing round between its parties for each signature.
+	DefaultTimeout = 30 * time.Second
+)
+
+var _ keyring.TSSSigner = (*Client)(nil)
+
+// Client is a client of a TSS signer.
+type Client struct {
+	conn    *grpc.ClientConn
+	signer  SignerClient
+	health  healthpb.HealthClient
+	timeout time.Duration
+}
+
+// NewClient returns a client of the TSS signer at the given gRPC endpoint, the
+// requests to which time out after the given timeout, or DefaultTimeout if it
+// is zero. The connection is established lazily, and is not encrypted unless
+// TLS credentials are given in the dial options.
+func NewClient(endpoint string, timeout time.Duration, opts ...grpc.DialOption) (*Client, error) {
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	if timeout < 0 {
+		return nil, fmt.Errorf("invalid TSS signer timeout %s", timeout)
+	}
+	if len(opts) == 0 {
+		opts = []grpc.DialOption{grpc.WithInsecure()}
+	}
+
+	conn, err := grpc.Dial(endpoint, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial TSS signer %s: %w", endpoint, err)
+	}
+
+	return &Client{
+		conn:    conn,
+		signer:  NewSignerClient(conn),
+		health:  healthpb.NewHealthClient(conn),
+		timeout: timeout,
+	}, nil
+}
+
+// Close closes the connection to the signer.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// CheckHealth returns an error if the signer is unreachable or reports that it
+// cannot sign, e.g. because not enough of its parties are online.
+func (c *Client) CheckHealth() error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	res, err := c.health.Check(ctx, &healthpb.HealthCheckRequest{Service: ServiceName})
+	if err != nil {
+		return fmt.Errorf("TSS signer health check failed: %w", err)
+	}
+	if res.Status != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("TSS signer is not serving: %s", res.Status)
+	}
+
+	return nil
+}
+
+// PubKey returns the public key of the given key of the signer.
+func (c *Client) PubKey(keyID string, algo hd.PubKeyType) (cryptotypes.PubKey, error) {
+	scheme, err := SchemeFromAlgo(algo)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	res, err := c.signer.PubKey(ctx, &PubKeyRequest{KeyId: keyID, Scheme: scheme})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the public key of TSS key %s: %w", keyID, err)
+	}
+
+	return pubKeyFromBytes(res.PubKey, scheme)
+}
+
+// Sign signs the message with the given key of the signer, and verifies the
+// signature against the public key of the key.
+func (c *Client) Sign(keyID string, pubKey cryptotypes.PubKey, msg []byte) ([]byte, error) {
+	scheme, err := schemeFromPubKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	res, err := c.signer.Sign(ctx, &SignRequest{KeyId: keyID, Scheme: scheme, Msg: msg})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign with TSS key %s: %w", keyID, err)
+	}
+	if !pubKey.VerifySignature(msg, res.Signature) {
+		return nil, fmt.Errorf("invalid signature of TSS key %s", keyID)
+	}
+
+	return res.Signature, nil
+}
+
+// SchemeFromAlgo returns the signature scheme of the keys of the given algo.
+func SchemeFromAlgo(algo hd.PubKeyType) (Scheme, error) {
+	switch algo {
+	case hd.Secp256k1Type:
+		return Scheme_SCHEME_SECP256K1, nil
+	case hd.Sm2Type:
+		return Scheme_SCHEME_SM2, nil
+	default:
+		return Scheme_SCHEME_UNSPECIFIED, fmt.Errorf("unsupported TSS signature algo %s", algo)
+	}
+}
+
+func schemeFromPubKey(pubKey cryptotypes.PubKey) (Scheme, error) {
+	switch pubKey.(type) {
+	case *secp256k1.PubKey:
+		return Scheme_SCHEME_SECP256K1, nil
+	case *sm2.PubKey:
+		return Scheme_SCHEME_SM2, nil
+	default:
+		return Scheme_SCHEME_UNSPECIFIED, fmt.Errorf("unsupported TSS public key type %T", pubKey)
+	}
+}
+
+func pubKeyFromBytes(bz []byte, scheme Scheme) (cryptotypes.PubKey, error) {
+	switch scheme {
+	case Scheme_SCHEME_SECP256K1:
+		if len(bz) != secp256k1.PubKeySize {
+			return nil, fmt.Errorf("invalid secp256k1 public key length %d", len(bz))
+		}
+		return &secp256k1.PubKey{Key: bz}, nil
+	case Scheme_SCHEME_SM2:
+		if len(bz) != sm2.PubKeySize {
+			return nil, fmt.Errorf("invalid sm2 public key length %d", len(bz))
+		}
+		return &sm2.PubKey{Key: bz}, nil
+	default:
+		return nil, fmt.Errorf("unsupported TSS signature scheme %s", scheme)
+	}
+}
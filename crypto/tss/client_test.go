@@ -0,0 +1,132 @@
+package tss_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/sm2"
+	"github.com/cosmos/cosmos-sdk/crypto/tss"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// mockSigner is a signer holding the full private keys of its key IDs.
+type mockSigner struct {
+	tss.UnimplementedSignerServer
+
+	keys  map[string]cryptotypes.PrivKey
+	delay time.Duration
+}
+
+func (s mockSigner) PubKey(_ context.Context, req *tss.PubKeyRequest) (*tss.PubKeyResponse, error) {
+	priv, ok := s.keys[req.KeyId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown key %s", req.KeyId)
+	}
+	return &tss.PubKeyResponse{PubKey: priv.PubKey().Bytes()}, nil
+}
+
+func (s mockSigner) Sign(ctx context.Context, req *tss.SignRequest) (*tss.SignResponse, error) {
+	select {
+	case <-time.After(s.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	sig, err := s.keys[req.KeyId].Sign(req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return &tss.SignResponse{Signature: sig}, nil
+}
+
+func startSigner(t *testing.T, signer mockSigner, timeout time.Duration) (*tss.Client, *health.Server) {
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	tss.RegisterSignerServer(srv, signer)
+	healthSrv := health.NewServer()
+	healthSrv.SetServingStatus(tss.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(srv, healthSrv)
+	go srv.Serve(lis) //nolint:errcheck
+	t.Cleanup(srv.Stop)
+
+	client, err := tss.NewClient("bufnet", timeout,
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { client.Close() })
+
+	return client, healthSrv
+}
+
+func TestClient(t *testing.T) {
+	secpPriv := secp256k1.GenPrivKey()
+	sm2Priv := sm2.GenPrivKey()
+	signer := mockSigner{keys: map[string]cryptotypes.PrivKey{
+		"secp256k1": secpPriv,
+		"sm2":       &sm2Priv,
+		"wrong":     secp256k1.GenPrivKey(),
+	}}
+	client, healthSrv := startSigner(t, signer, 0)
+
+	require.NoError(t, client.CheckHealth())
+	healthSrv.SetServingStatus(tss.ServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
+	require.Error(t, client.CheckHealth())
+	healthSrv.SetServingStatus(tss.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	require.NoError(t, client.CheckHealth())
+
+	msg := []byte("msg")
+	for _, tc := range []struct {
+		keyID string
+		algo  hd.PubKeyType
+		pub   cryptotypes.PubKey
+	}{
+		{"secp256k1", hd.Secp256k1Type, secpPriv.PubKey()},
+		{"sm2", hd.Sm2Type, sm2Priv.PubKey()},
+	} {
+		pub, err := client.PubKey(tc.keyID, tc.algo)
+		require.NoError(t, err)
+		require.True(t, tc.pub.Equals(pub))
+
+		sig, err := client.Sign(tc.keyID, pub, msg)
+		require.NoError(t, err)
+		require.True(t, pub.VerifySignature(msg, sig))
+	}
+
+	_, err := client.PubKey("unknown", hd.Secp256k1Type)
+	require.Error(t, err)
+	_, err = client.PubKey("secp256k1", hd.Ed25519Type)
+	require.Error(t, err)
+	_, err = client.Sign("secp256k1", ed25519.GenPrivKey().PubKey(), msg)
+	require.Error(t, err)
+
+	// the signature of another key does not verify
+	_, err = client.Sign("wrong", secpPriv.PubKey(), msg)
+	require.Error(t, err)
+}
+
+func TestClientTimeout(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	signer := mockSigner{keys: map[string]cryptotypes.PrivKey{"slow": priv}, delay: time.Second}
+	client, _ := startSigner(t, signer, 50*time.Millisecond)
+
+	_, err := client.Sign("slow", priv.PubKey(), []byte("msg"))
+	require.Error(t, err)
+	require.Equal(t, codes.DeadlineExceeded, status.Code(errors.Unwrap(err)))
+
+	_, err = tss.NewClient("bufnet", -time.Second)
+	require.Error(t, err)
+}
@@ -0,0 +1,107 @@
+package fuzz
+
+import (
+	"fmt"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// ChainID is the chain ID of the app of FuzzAnte, which the seed txs are
+// signed for.
+const ChainID = ""
+
+// numAccounts is the number of single key accounts of the app of FuzzAnte.
+const numAccounts = 3
+
+var (
+	// PrivKeys are the keys of the single key accounts of the app of
+	// FuzzAnte, derived from fixed secrets so that the seed corpus is stable.
+	PrivKeys []cryptotypes.PrivKey
+
+	// MultisigPubKey is the key of the 2-of-3 multisig account of the app of
+	// FuzzAnte, made of PrivKeys.
+	MultisigPubKey cryptotypes.PubKey
+
+	// Balance is the genesis balance of every account of the app of FuzzAnte.
+	Balance = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000_000_000))
+)
+
+func init() {
+	pubKeys := make([]cryptotypes.PubKey, numAccounts)
+	for i := 0; i < numAccounts; i++ {
+		PrivKeys = append(PrivKeys, secp256k1.GenPrivKeyFromSecret([]byte(fmt.Sprintf("fuzz-account-%d", i))))
+		pubKeys[i] = PrivKeys[i].PubKey()
+	}
+	MultisigPubKey = kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+}
+
+// AccountNumber returns the account number of the account of the given
+// key in the app of FuzzAnte. The accounts of PrivKeys come first, in their
+// order, followed by the multisig account.
+func AccountNumber(pubKey cryptotypes.PubKey) uint64 {
+	for i, priv := range PrivKeys {
+		if priv.PubKey().Equals(pubKey) {
+			return uint64(i)
+		}
+	}
+	if MultisigPubKey.Equals(pubKey) {
+		return numAccounts
+	}
+
+	panic(fmt.Sprintf("no fuzz account for key %s", pubKey))
+}
+
+var (
+	anteApp     *simapp.SimApp
+	anteAppOnce sync.Once
+)
+
+// getAnteApp returns the app of FuzzAnte, which is set up on first use since
+// it is quite costly. Its genesis funds the accounts of PrivKeys and
+// MultisigPubKey, and the panics of its txs are not recovered into errors,
+// except for the out of gas ones, so that they reach the fuzzer.
+func getAnteApp() *simapp.SimApp {
+	anteAppOnce.Do(func() {
+		pubKeys := []cryptotypes.PubKey{}
+		for _, priv := range PrivKeys {
+			pubKeys = append(pubKeys, priv.PubKey())
+		}
+		pubKeys = append(pubKeys, MultisigPubKey)
+
+		genAccs := make([]authtypes.GenesisAccount, len(pubKeys))
+		balances := make([]banktypes.Balance, len(pubKeys))
+		for i, pubKey := range pubKeys {
+			addr := sdk.AccAddress(pubKey.Address())
+			genAccs[i] = authtypes.NewBaseAccount(addr, nil, uint64(i), 0)
+			balances[i] = banktypes.Balance{Address: addr.String(), Coins: Balance}
+		}
+
+		anteApp = simapp.SetupWithGenesisAccounts(genAccs, balances...)
+		// the check state is still at the genesis height, where signatures
+		// are verified with the account number 0
+		resetAnteApp(anteApp)
+		anteApp.AddRunTxRecoveryHandler(baseapp.RecoveryHandler(func(recoveryObj interface{}) error {
+			panic(recoveryObj)
+		}))
+	})
+
+	return anteApp
+}
+
+// resetAnteApp discards the changes of the accepted txs to the check state of
+// the app of FuzzAnte, so that every input runs against the same state.
+func resetAnteApp(app *simapp.SimApp) {
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{ChainID: ChainID, Height: app.LastBlockHeight() + 1}})
+}
@@ -0,0 +1,21 @@
+/*
+Package fuzz contains the go-fuzz and libFuzzer targets of the SDK, covering
+the surfaces parsing untrusted bytes before a transaction is accepted in the
+mempool:
+
+	FuzzTxDecode  the protobuf tx decoder, and the re-encoding of decoded txs
+	FuzzSignDoc   the sign bytes builders of every enabled sign mode
+	FuzzBech32    the bech32 address and public key parsers
+	FuzzAnte      the whole CheckTx path of the simapp: decoding, the
+	              ValidateBasic of the msgs and the ante handler chain
+
+A target returns 1 when the input was parsed, so that the fuzzer gives it more
+priority, and 0 otherwise. It panics when an invariant of the parsed input is
+broken, e.g. a decoded tx which does not survive a round trip, or when the code
+under test panics while it must return an error instead.
+
+The seed corpus of every target is in corpus/<target>, and is replayed by the
+tests of the package. The targets are built and run by `make fuzz`, see
+scripts/fuzz.sh.
+*/
+package fuzz
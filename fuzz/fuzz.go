@@ -0,0 +1,172 @@
+package fuzz
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/bech32/legacybech32" // nolint:staticcheck
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// encodingConfig is the encoding config of the simapp, which registers the
+// msgs and keys of all the modules.
+var encodingConfig = simapp.MakeTestEncodingConfig()
+
+// FuzzTxDecode decodes data as a tx, and checks that the decoded tx encodes
+// to bytes which decode to the same tx.
+func FuzzTxDecode(data []byte) int {
+	tx, bz, ok := decodeTx(data)
+	if !ok {
+		return 0
+	}
+
+	tx2, err := encodingConfig.TxConfig.TxDecoder()(bz)
+	if err != nil {
+		panic(fmt.Sprintf("re-encoded tx does not decode: %v", err))
+	}
+	bz2, err := encodingConfig.TxConfig.TxEncoder()(tx2)
+	if err != nil {
+		panic(fmt.Sprintf("re-decoded tx does not encode: %v", err))
+	}
+	if !bytes.Equal(bz, bz2) {
+		panic(fmt.Sprintf("tx round trip mismatch: %X != %X", bz, bz2))
+	}
+
+	if validateBasic(tx) {
+		// the signers of a valid tx can always be derived
+		tx.(authsigning.SigVerifiableTx).GetSigners()
+	}
+
+	return 1
+}
+
+// FuzzSignDoc decodes data as a tx, and builds its sign bytes for every sign
+// mode of the sign mode handler of the simapp. The sign bytes must be the same
+// for the re-encoded tx, otherwise signatures would not survive a relay.
+func FuzzSignDoc(data []byte) int {
+	tx, bz, ok := decodeTx(data)
+	if !ok || !validateBasic(tx) {
+		return 0
+	}
+
+	tx2, err := encodingConfig.TxConfig.TxDecoder()(bz)
+	if err != nil {
+		panic(fmt.Sprintf("re-encoded tx does not decode: %v", err))
+	}
+
+	handler := encodingConfig.TxConfig.SignModeHandler()
+	signerData := authsigning.SignerData{ChainID: ChainID, AccountNumber: 1, Sequence: 1}
+	for _, mode := range handler.Modes() {
+		signBytes, err := handler.GetSignBytes(mode, signerData, tx)
+		if err != nil {
+			continue
+		}
+		signBytes2, err := handler.GetSignBytes(mode, signerData, tx2)
+		if err != nil {
+			panic(fmt.Sprintf("no %s sign bytes for the re-encoded tx: %v", mode, err))
+		}
+		if !bytes.Equal(signBytes, signBytes2) {
+			panic(fmt.Sprintf("%s sign bytes mismatch: %X != %X", mode, signBytes, signBytes2))
+		}
+	}
+
+	return 1
+}
+
+// FuzzBech32 parses data as a bech32 string, as an address and as a public
+// key, and checks that what it parses to encodes back to the same string.
+func FuzzBech32(data []byte) int {
+	s := string(data)
+	hrp, bz, err := bech32.DecodeAndConvert(s)
+	if err != nil {
+		return 0
+	}
+
+	// bech32 strings are either all lowercase or all uppercase, and encode
+	// to lowercase
+	lower := strings.ToLower(s)
+	encoded, err := bech32.ConvertAndEncode(hrp, bz)
+	if err != nil {
+		panic(fmt.Sprintf("decoded bech32 string %q does not encode: %v", s, err))
+	}
+	if encoded != lower {
+		panic(fmt.Sprintf("bech32 round trip mismatch: %q != %q", encoded, lower))
+	}
+
+	var addr fmt.Stringer
+	if accAddr, err := sdk.AccAddressFromBech32(s); err == nil {
+		addr = accAddr
+	} else if valAddr, err := sdk.ValAddressFromBech32(s); err == nil {
+		addr = valAddr
+	} else if consAddr, err := sdk.ConsAddressFromBech32(s); err == nil {
+		addr = consAddr
+	}
+	if addr != nil && addr.String() != lower {
+		panic(fmt.Sprintf("address round trip mismatch: %q != %q", addr.String(), lower))
+	}
+
+	for _, pkt := range []legacybech32.Bech32PubKeyType{legacybech32.AccPK, legacybech32.ValPK, legacybech32.ConsPK} {
+		pk, err := legacybech32.UnmarshalPubKey(pkt, s)
+		if err != nil {
+			continue
+		}
+		encoded, err := legacybech32.MarshalPubKey(pkt, pk)
+		if err != nil {
+			panic(fmt.Sprintf("decoded public key %q does not encode: %v", s, err))
+		}
+		// the amino encoding of a public key is not unique, so it is its
+		// decoding which must round trip
+		pk2, err := legacybech32.UnmarshalPubKey(pkt, encoded)
+		if err != nil || !pk.Equals(pk2) {
+			panic(fmt.Sprintf("public key round trip mismatch for %q: %v", s, err))
+		}
+	}
+
+	return 1
+}
+
+// FuzzAnte runs data through the CheckTx of the simapp, which decodes it,
+// runs the ValidateBasic of its msgs and its ante handler chain. Any panic of
+// the ante handler fails, except for an out of gas one.
+func FuzzAnte(data []byte) int {
+	app := getAnteApp()
+	res := app.CheckTx(abci.RequestCheckTx{Tx: data, Type: abci.CheckTxType_New})
+	if !res.IsOK() {
+		return 0
+	}
+
+	resetAnteApp(app)
+	return 1
+}
+
+// decodeTx decodes data as a tx, and returns it along with its encoding.
+func decodeTx(data []byte) (sdk.Tx, []byte, bool) {
+	tx, err := encodingConfig.TxConfig.TxDecoder()(data)
+	if err != nil {
+		return nil, nil, false
+	}
+	bz, err := encodingConfig.TxConfig.TxEncoder()(tx)
+	if err != nil {
+		panic(fmt.Sprintf("decoded tx does not encode: %v", err))
+	}
+
+	return tx, bz, true
+}
+
+// validateBasic returns whether all the msgs of the tx and then the tx pass
+// their ValidateBasic, in the order of the baseapp and the ante handler.
+func validateBasic(tx sdk.Tx) bool {
+	for _, msg := range tx.GetMsgs() {
+		if msg.ValidateBasic() != nil {
+			return false
+		}
+	}
+
+	return tx.ValidateBasic() == nil
+}
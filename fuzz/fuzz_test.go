@@ -0,0 +1,216 @@
+package fuzz
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32/legacybech32" // nolint:staticcheck
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var regenerateCorpus = flag.Bool("regenerate-corpus", false, "regenerate the seed corpus of the fuzz targets")
+
+const (
+	txCorpusDir     = "corpus/tx"
+	bech32CorpusDir = "corpus/bech32"
+)
+
+// TestRegenerateCorpus writes the generated seed corpus when run with
+// -regenerate-corpus, and otherwise checks that it is up to date.
+func TestRegenerateCorpus(t *testing.T) {
+	for dir, seeds := range map[string]map[string][]byte{
+		txCorpusDir:     txSeeds(t),
+		bech32CorpusDir: bech32Seeds(),
+	} {
+		for name, seed := range seeds {
+			path := filepath.Join(dir, name)
+			if *regenerateCorpus {
+				require.NoError(t, os.MkdirAll(dir, 0o755))
+				require.NoError(t, ioutil.WriteFile(path, seed, 0o644))
+				continue
+			}
+
+			bz, err := ioutil.ReadFile(path)
+			require.NoError(t, err, "run go test ./fuzz -run TestRegenerateCorpus -regenerate-corpus")
+			require.Equal(t, seed, bz, "%s is outdated, run go test ./fuzz -run TestRegenerateCorpus -regenerate-corpus", path)
+		}
+	}
+}
+
+func TestFuzzTxDecode(t *testing.T) {
+	replayCorpus(t, txCorpusDir, FuzzTxDecode)
+}
+
+func TestFuzzSignDoc(t *testing.T) {
+	replayCorpus(t, txCorpusDir, FuzzSignDoc)
+}
+
+func TestFuzzBech32(t *testing.T) {
+	replayCorpus(t, bech32CorpusDir, FuzzBech32)
+}
+
+func TestFuzzAnte(t *testing.T) {
+	replayCorpus(t, txCorpusDir, FuzzAnte)
+
+	// the state changes of an accepted tx are discarded, so that it is
+	// accepted again
+	bz, err := ioutil.ReadFile(filepath.Join(txCorpusDir, "bank-send"))
+	require.NoError(t, err)
+	require.Equal(t, 1, FuzzAnte(bz))
+
+	// invalid txs are rejected without a panic
+	require.Equal(t, 0, FuzzAnte(nil))
+	require.Equal(t, 0, FuzzAnte(bz[:len(bz)/2]))
+}
+
+// replayCorpus checks that every input of the corpus in dir is accepted by
+// the fuzz target, the seed corpus being only made of valid inputs.
+func replayCorpus(t *testing.T, dir string, fuzzFn func([]byte) int) {
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	for _, file := range files {
+		bz, err := ioutil.ReadFile(filepath.Join(dir, file.Name()))
+		require.NoError(t, err)
+		require.Equal(t, 1, fuzzFn(bz), file.Name())
+	}
+}
+
+// txSeeds returns the seed txs, signed by the accounts of the app of
+// FuzzAnte so that they go through the whole ante handler chain.
+func txSeeds(t *testing.T) map[string][]byte {
+	addrs := make([]sdk.AccAddress, len(PrivKeys))
+	for i, priv := range PrivKeys {
+		addrs[i] = sdk.AccAddress(priv.PubKey().Address())
+	}
+	multisigAddr := sdk.AccAddress(MultisigPubKey.Address())
+	valAddr := sdk.ValAddress(addrs[1])
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+
+	return map[string][]byte{
+		"bank-send": signTx(t, signing.SignMode_SIGN_MODE_DIRECT,
+			[]sdk.Msg{banktypes.NewMsgSend(addrs[0], addrs[1], coins)}, PrivKeys[0]),
+		"bank-send-amino-json": signTx(t, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+			[]sdk.Msg{banktypes.NewMsgSend(addrs[1], addrs[2], coins)}, PrivKeys[1]),
+		"bank-multi-send": signTx(t, signing.SignMode_SIGN_MODE_DIRECT,
+			[]sdk.Msg{banktypes.NewMsgMultiSend(
+				[]banktypes.Input{banktypes.NewInput(addrs[2], coins.Add(coins...))},
+				[]banktypes.Output{banktypes.NewOutput(addrs[0], coins), banktypes.NewOutput(addrs[1], coins)},
+			)}, PrivKeys[2]),
+		"multi-signer": signTx(t, signing.SignMode_SIGN_MODE_DIRECT, []sdk.Msg{
+			banktypes.NewMsgSend(addrs[0], addrs[2], coins),
+			stakingtypes.NewMsgDelegate(addrs[1], valAddr, coins[0]),
+		}, PrivKeys[0], PrivKeys[1]),
+		"staking-delegate": signTx(t, signing.SignMode_SIGN_MODE_DIRECT,
+			[]sdk.Msg{stakingtypes.NewMsgDelegate(addrs[0], valAddr, coins[0])}, PrivKeys[0]),
+		"gov-vote": signTx(t, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+			[]sdk.Msg{govtypes.NewMsgVote(addrs[2], 1, govtypes.OptionYes)}, PrivKeys[2]),
+		"multisig": signMultisigTx(t,
+			[]sdk.Msg{banktypes.NewMsgSend(multisigAddr, addrs[0], coins)}, PrivKeys[0], PrivKeys[2]),
+	}
+}
+
+// bech32Seeds returns the seed addresses and public keys.
+func bech32Seeds() map[string][]byte {
+	pubKey := PrivKeys[0].PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+
+	return map[string][]byte{
+		"acc-address":         []byte(addr.String()),
+		"val-address":         []byte(sdk.ValAddress(addr).String()),
+		"cons-address":        []byte(sdk.ConsAddress(addr).String()),
+		"module-address":      []byte(authtypes.NewModuleAddress(govtypes.ModuleName).String()),
+		"acc-pubkey":          []byte(legacybech32.MustMarshalPubKey(legacybech32.AccPK, pubKey)),
+		"val-pubkey":          []byte(legacybech32.MustMarshalPubKey(legacybech32.ValPK, pubKey)),
+		"multisig-acc-pubkey": []byte(legacybech32.MustMarshalPubKey(legacybech32.AccPK, MultisigPubKey)),
+	}
+}
+
+// newTxBuilder returns a tx builder of the msgs, with the fee and the gas
+// limit of all the seed txs.
+func newTxBuilder(t *testing.T, msgs []sdk.Msg) client.TxBuilder {
+	txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(msgs...))
+	txBuilder.SetMemo("fuzz seed")
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20000)))
+	txBuilder.SetGasLimit(400000)
+
+	return txBuilder
+}
+
+// signTx returns the encoding of a tx of the msgs, signed in the sign mode by
+// the keys, in the order of the signers of the msgs.
+func signTx(t *testing.T, signMode signing.SignMode, msgs []sdk.Msg, privs ...cryptotypes.PrivKey) []byte {
+	txBuilder := newTxBuilder(t, msgs)
+
+	// the signer infos are part of the DIRECT sign bytes, so they must be set
+	// before signing
+	sigs := make([]signing.SignatureV2, len(privs))
+	for i, priv := range privs {
+		sigs[i] = signing.SignatureV2{
+			PubKey: priv.PubKey(),
+			Data:   &signing.SingleSignatureData{SignMode: signMode},
+		}
+	}
+	require.NoError(t, txBuilder.SetSignatures(sigs...))
+
+	for i, priv := range privs {
+		signBytes := getSignBytes(t, signMode, priv.PubKey(), txBuilder.GetTx())
+		sig, err := priv.Sign(signBytes)
+		require.NoError(t, err)
+		sigs[i].Data.(*signing.SingleSignatureData).Signature = sig
+	}
+	require.NoError(t, txBuilder.SetSignatures(sigs...))
+
+	bz, err := encodingConfig.TxConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+	return bz
+}
+
+// signMultisigTx returns the encoding of a tx of the msgs, signed by the
+// multisig account with the keys.
+func signMultisigTx(t *testing.T, msgs []sdk.Msg, privs ...cryptotypes.PrivKey) []byte {
+	txBuilder := newTxBuilder(t, msgs)
+
+	// the signatures of the keys of a legacy amino multisig key must be in
+	// LEGACY_AMINO_JSON, whose sign bytes do not depend on the signer infos
+	signMode := signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	signBytes := getSignBytes(t, signMode, MultisigPubKey, txBuilder.GetTx())
+
+	pubKeys := MultisigPubKey.(multisig.PubKey).GetPubKeys()
+	sigData := multisig.NewMultisig(len(pubKeys))
+	for _, priv := range privs {
+		sig, err := priv.Sign(signBytes)
+		require.NoError(t, err)
+		require.NoError(t, multisig.AddSignatureFromPubKey(
+			sigData, &signing.SingleSignatureData{SignMode: signMode, Signature: sig}, priv.PubKey(), pubKeys,
+		))
+	}
+	require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{PubKey: MultisigPubKey, Data: sigData}))
+
+	bz, err := encodingConfig.TxConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+	return bz
+}
+
+func getSignBytes(t *testing.T, signMode signing.SignMode, pubKey cryptotypes.PubKey, tx sdk.Tx) []byte {
+	signerData := authsigning.SignerData{ChainID: ChainID, AccountNumber: AccountNumber(pubKey)}
+	signBytes, err := encodingConfig.TxConfig.SignModeHandler().GetSignBytes(signMode, signerData, tx)
+	require.NoError(t, err)
+	return signBytes
+}
@@ -0,0 +1,103 @@
+#!/usr/bin/env bash
+
+# this script builds and runs the fuzz targets of the fuzz package, starting
+# from their seed corpus in fuzz/corpus.
+#
+# FUZZ_TARGETS  the targets to run, all of them by default
+# FUZZ_TIME     how long each target runs for, in seconds (default 60)
+# FUZZ_ENGINE   go-fuzz (default) or libfuzzer, which requires clang
+# FUZZ_WORKDIR  where the corpus, the crashers and the binaries are written
+#               (default build/fuzz)
+#
+# The script fails if a target crashes. go-fuzz minimizes its crashers itself
+# into $FUZZ_WORKDIR/<target>/crashers, along with their output, while with
+# libFuzzer each crash artifact is minimized into
+# $FUZZ_WORKDIR/<target>/crashers/<crash>.min once the target stopped.
+
+set -euo pipefail
+
+FUZZ_TARGETS=${FUZZ_TARGETS:-"FuzzTxDecode FuzzSignDoc FuzzBech32 FuzzAnte"}
+FUZZ_TIME=${FUZZ_TIME:-60}
+FUZZ_ENGINE=${FUZZ_ENGINE:-go-fuzz}
+FUZZ_WORKDIR=${FUZZ_WORKDIR:-build/fuzz}
+GO_FUZZ_VERSION=${GO_FUZZ_VERSION:-latest}
+
+root_dir=$(pwd)
+PKG=github.com/cosmos/cosmos-sdk/fuzz
+
+corpus_dir() {
+    case $1 in
+        FuzzBech32) echo "$root_dir/fuzz/corpus/bech32" ;;
+        *) echo "$root_dir/fuzz/corpus/tx" ;;
+    esac
+}
+
+install_go_fuzz() {
+    if ! command -v go-fuzz-build >/dev/null 2>&1; then
+        echo ">>> installing go-fuzz"
+        go install github.com/dvyukov/go-fuzz/go-fuzz@"$GO_FUZZ_VERSION" \
+            github.com/dvyukov/go-fuzz/go-fuzz-build@"$GO_FUZZ_VERSION"
+    fi
+
+    # the instrumented binaries import go-fuzz-dep, which must thus be a
+    # dependency of the module while they are built
+    cp go.mod "$FUZZ_WORKDIR/go.mod.bak"
+    cp go.sum "$FUZZ_WORKDIR/go.sum.bak"
+    trap 'mv "$FUZZ_WORKDIR/go.mod.bak" go.mod; mv "$FUZZ_WORKDIR/go.sum.bak" go.sum' EXIT
+    go get github.com/dvyukov/go-fuzz/go-fuzz-dep@"$GO_FUZZ_VERSION"
+}
+
+# run_go_fuzz runs the target with go-fuzz, and returns whether it found no crasher.
+run_go_fuzz() {
+    target=$1; workdir=$2
+
+    mkdir -p "$workdir/corpus"
+    cp -n "$(corpus_dir "$target")"/* "$workdir/corpus/" 2>/dev/null || true
+    go-fuzz-build -func "$target" -o "$workdir/$target.zip" "$PKG"
+    timeout --preserve-status --signal INT "$FUZZ_TIME" \
+        go-fuzz -bin "$workdir/$target.zip" -workdir "$workdir" || true
+
+    crashers=$(find "$workdir/crashers" -type f ! -name '*.*' 2>/dev/null | wc -l)
+    [ "$crashers" -eq 0 ]
+}
+
+# run_libfuzzer runs the target with libFuzzer, minimizes the crash artifacts,
+# and returns whether it found no crash.
+run_libfuzzer() {
+    target=$1; workdir=$2
+
+    mkdir -p "$workdir/corpus" "$workdir/crashers"
+    go-fuzz-build -libfuzzer -func "$target" -o "$workdir/$target.a" "$PKG"
+    clang -fsanitize=fuzzer "$workdir/$target.a" -o "$workdir/$target"
+    "$workdir/$target" -max_total_time="$FUZZ_TIME" -artifact_prefix="$workdir/crashers/" \
+        "$workdir/corpus" "$(corpus_dir "$target")" || true
+
+    ok=0
+    for crash in "$workdir"/crashers/*; do
+        [ -e "$crash" ] || continue
+        case $crash in *.min) continue ;; esac
+        ok=1
+        "$workdir/$target" -minimize_crash=1 -runs=10000 -exact_artifact_path="$crash.min" "$crash" || true
+    done
+    [ "$ok" -eq 0 ]
+}
+
+mkdir -p "$FUZZ_WORKDIR"
+install_go_fuzz
+
+failed=()
+for target in $FUZZ_TARGETS; do
+    workdir="$FUZZ_WORKDIR/$target"
+    echo ">>> fuzzing $target with $FUZZ_ENGINE for ${FUZZ_TIME}s in $workdir"
+
+    case $FUZZ_ENGINE in
+        go-fuzz) run_go_fuzz "$target" "$workdir" || failed+=("$target") ;;
+        libfuzzer) run_libfuzzer "$target" "$workdir" || failed+=("$target") ;;
+        *) echo "unknown FUZZ_ENGINE: $FUZZ_ENGINE" >&2; exit 1 ;;
+    esac
+done
+
+if [ ${#failed[@]} -ne 0 ]; then
+    echo ">>> crashes found for: ${failed[*]}, see their crashers in $FUZZ_WORKDIR" >&2
+    exit 1
+fi
@@ -0,0 +1,265 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// DisplayDenomsParam is the query parameter enabling the display mode of a
+// REST response, e.g. "/cosmos/bank/v1beta1/balances/{address}?display_denoms=true".
+//
+// In display mode, every coin of the JSON response whose denom has bank
+// metadata is augmented with its amount in the display denom:
+//
+//	{"denom": "uatom", "amount": "1500000", "display_denom": "atom", "display_amount": "1.5"}
+//
+// and every event attribute holding coins, e.g. the "amount" attribute of a
+// transfer event, is augmented with a "display_value" holding the same coins
+// in their display denoms.
+const DisplayDenomsParam = "display_denoms"
+
+// DenomMetadataResolver returns the bank metadata of the given base denom and
+// whether it exists.
+type DenomMetadataResolver func(ctx context.Context, denom string) (banktypes.Metadata, bool)
+
+// BankDenomMetadataResolver returns a DenomMetadataResolver querying the bank
+// module through the given client context.
+func BankDenomMetadataResolver(clientCtx client.Context) DenomMetadataResolver {
+	return func(ctx context.Context, denom string) (banktypes.Metadata, bool) {
+		res, err := banktypes.NewQueryClient(clientCtx).DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{Denom: denom})
+		if err != nil {
+			return banktypes.Metadata{}, false
+		}
+
+		return res.Metadata, true
+	}
+}
+
+// DisplayDenomsMiddleware returns an HTTP middleware transforming the JSON
+// responses of requests setting the DisplayDenomsParam query parameter into
+// their display mode. Metadata is resolved at most once per denom and request.
+func DisplayDenomsMiddleware(resolve DenomMetadataResolver) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if enabled, _ := strconv.ParseBool(r.URL.Query().Get(DisplayDenomsParam)); !enabled {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			bw := &bufferedResponseWriter{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(bw, r)
+
+			body := bw.body.Bytes()
+			if bw.status == http.StatusOK && strings.Contains(w.Header().Get("Content-Type"), "json") {
+				if transformed, ok := (&displayTransformer{ctx: r.Context(), resolve: resolve}).transform(body); ok {
+					body = transformed
+					w.Header().Del("Content-Length")
+				}
+			}
+
+			w.WriteHeader(bw.status)
+			_, _ = w.Write(body)
+		})
+	}
+}
+
+// bufferedResponseWriter holds the response of a handler back so that it can
+// be transformed before being written.
+type bufferedResponseWriter struct {
+	http.ResponseWriter
+
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedResponseWriter) WriteHeader(status int) { w.status = status }
+
+func (w *bufferedResponseWriter) Write(bz []byte) (int, error) { return w.body.Write(bz) }
+
+type displayTransformer struct {
+	ctx      context.Context
+	resolve  DenomMetadataResolver
+	metadata map[string]*banktypes.Metadata
+}
+
+// transform returns the display mode of the JSON body, or false if the body
+// is not valid JSON.
+func (t *displayTransformer) transform(body []byte) ([]byte, bool) {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, false
+	}
+
+	t.walk(v)
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return nil, false
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), true
+}
+
+func (t *displayTransformer) walk(v interface{}) {
+	switch v := v.(type) {
+	case []interface{}:
+		for _, e := range v {
+			t.walk(e)
+		}
+
+	case map[string]interface{}:
+		for _, e := range v {
+			t.walk(e)
+		}
+
+		denom, isCoin := v["denom"].(string)
+		amount, hasAmount := v["amount"].(string)
+		if isCoin && hasAmount {
+			if displayDenom, displayAmount, ok := t.toDisplay(denom, amount); ok {
+				v["display_denom"] = displayDenom
+				v["display_amount"] = displayAmount
+			}
+			return
+		}
+
+		_, isAttribute := v["key"].(string)
+		value, hasValue := v["value"].(string)
+		if isAttribute && hasValue {
+			if displayValue, ok := t.coinsToDisplay(value); ok {
+				v["display_value"] = displayValue
+			}
+		}
+	}
+}
+
+// coinsToDisplay converts a coins string, e.g. "1500000uatom,10stake", into
+// the display denoms of the coins having metadata.
+func (t *displayTransformer) coinsToDisplay(value string) (string, bool) {
+	// coins are parsed one by one to keep their order
+	var (
+		converted bool
+		parts     = strings.Split(value, ",")
+	)
+	for i, part := range parts {
+		coin, err := sdk.ParseDecCoin(strings.TrimSpace(part))
+		if err != nil {
+			return "", false
+		}
+
+		// amounts are rendered without the trailing zeros of sdk.Dec
+		amount := trimFraction(coin.Amount.String())
+		parts[i] = amount + coin.Denom
+
+		if displayDenom, displayAmount, ok := t.toDisplay(coin.Denom, amount); ok {
+			parts[i] = displayAmount + displayDenom
+			converted = true
+		}
+	}
+
+	return strings.Join(parts, ","), converted
+}
+
+// toDisplay converts an amount of the given base denom into its display denom.
+func (t *displayTransformer) toDisplay(denom, amount string) (string, string, bool) {
+	metadata := t.lookup(denom)
+	if metadata == nil || metadata.Display == "" || metadata.Display == denom {
+		return "", "", false
+	}
+
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom != metadata.Display {
+			continue
+		}
+
+		displayAmount, ok := shiftDecimal(amount, int(unit.Exponent))
+		return metadata.Display, displayAmount, ok
+	}
+
+	return "", "", false
+}
+
+func (t *displayTransformer) lookup(denom string) *banktypes.Metadata {
+	if t.metadata == nil {
+		t.metadata = make(map[string]*banktypes.Metadata)
+	}
+
+	if metadata, ok := t.metadata[denom]; ok {
+		return metadata
+	}
+
+	var metadata *banktypes.Metadata
+	if m, found := t.resolve(t.ctx, denom); found && m.Base == denom {
+		metadata = &m
+	}
+	t.metadata[denom] = metadata
+
+	return metadata
+}
+
+// shiftDecimal divides the decimal amount by 10^places, without loss of
+// precision, and trims the trailing zeros of the result.
+func shiftDecimal(amount string, places int) (string, bool) {
+	integer, fraction := amount, ""
+	if i := strings.IndexByte(amount, '.'); i >= 0 {
+		integer, fraction = amount[:i], amount[i+1:]
+	}
+
+	negative := strings.HasPrefix(integer, "-")
+	integer = strings.TrimPrefix(integer, "-")
+
+	if integer == "" || !isDigits(integer) || !isDigits(fraction) {
+		return "", false
+	}
+
+	if len(integer) <= places {
+		integer = strings.Repeat("0", places-len(integer)+1) + integer
+	}
+
+	split := len(integer) - places
+	integer, fraction = integer[:split], integer[split:]+fraction
+
+	integer = strings.TrimLeft(integer, "0")
+	if integer == "" {
+		integer = "0"
+	}
+
+	result := trimFraction(integer + "." + fraction)
+	if negative && result != "0" {
+		result = "-" + result
+	}
+
+	return result, true
+}
+
+// trimFraction trims the trailing zeros of the fractional part of a decimal.
+func trimFraction(amount string) string {
+	if !strings.Contains(amount, ".") {
+		return amount
+	}
+
+	return strings.TrimSuffix(strings.TrimRight(amount, "0"), ".")
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
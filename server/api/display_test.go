@@ -0,0 +1,109 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestDisplayDenomsMiddleware(t *testing.T) {
+	var resolved []string
+	resolve := func(_ context.Context, denom string) (banktypes.Metadata, bool) {
+		resolved = append(resolved, denom)
+		if denom != "uatom" {
+			return banktypes.Metadata{}, false
+		}
+
+		return banktypes.Metadata{
+			Base:    "uatom",
+			Display: "atom",
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: "uatom", Exponent: 0},
+				{Denom: "matom", Exponent: 3},
+				{Denom: "atom", Exponent: 6},
+			},
+		}, true
+	}
+
+	body := `{"balances":[{"denom":"uatom","amount":"1500000"},{"denom":"stake","amount":"10"}],` +
+		`"rewards":[{"denom":"uatom","amount":"12.500000000000000000"}],` +
+		`"logs":[{"events":[{"type":"transfer","attributes":[{"key":"amount","value":"1uatom,10stake"},{"key":"sender","value":"cosmos1"}]}]}],` +
+		`"height":12}`
+
+	handler := DisplayDenomsMiddleware(resolve)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/balances", nil))
+	require.Equal(t, body, rec.Body.String())
+	require.Empty(t, resolved)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/balances?"+DisplayDenomsParam+"=true", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{
+		"balances": [
+			{"denom": "uatom", "amount": "1500000", "display_denom": "atom", "display_amount": "1.5"},
+			{"denom": "stake", "amount": "10"}
+		],
+		"rewards": [{"denom": "uatom", "amount": "12.500000000000000000", "display_denom": "atom", "display_amount": "0.0000125"}],
+		"logs": [{"events": [{"type": "transfer", "attributes": [
+			{"key": "amount", "value": "1uatom,10stake", "display_value": "0.000001atom,10stake"},
+			{"key": "sender", "value": "cosmos1"}
+		]}]}],
+		"height": 12
+	}`, rec.Body.String())
+	require.ElementsMatch(t, []string{"uatom", "stake"}, resolved, "metadata is resolved once per denom")
+}
+
+func TestDisplayDenomsMiddlewareErrors(t *testing.T) {
+	resolve := func(context.Context, string) (banktypes.Metadata, bool) {
+		t.Fatal("metadata must not be resolved for error responses")
+		return banktypes.Metadata{}, false
+	}
+
+	body := `{"code":5,"message":"not found"}`
+	handler := DisplayDenomsMiddleware(resolve)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(body))
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/balances?"+DisplayDenomsParam+"=true", nil))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.Equal(t, body, rec.Body.String())
+}
+
+func TestShiftDecimal(t *testing.T) {
+	testCases := []struct {
+		amount string
+		places int
+		expect string
+		valid  bool
+	}{
+		{"1500000", 6, "1.5", true},
+		{"1", 6, "0.000001", true},
+		{"1000000", 6, "1", true},
+		{"123", 0, "123", true},
+		{"0", 6, "0", true},
+		{"12.5", 2, "0.125", true},
+		{"-2500", 3, "-2.5", true},
+		{"123456789012345678901234567890", 18, "123456789012.34567890123456789", true},
+		{"1e6", 6, "", false},
+		{"", 6, "", false},
+	}
+
+	for _, tc := range testCases {
+		res, ok := shiftDecimal(tc.amount, tc.places)
+		require.Equal(t, tc.valid, ok, tc.amount)
+		require.Equal(t, tc.expect, res, tc.amount)
+	}
+}
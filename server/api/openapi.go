@@ -0,0 +1,470 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	// nolint: staticcheck
+	"github.com/golang/protobuf/proto"
+	dpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/server/grpc/gogoreflection"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// OpenAPI routes serving the document generated by RegisterOpenAPI. The
+// swagger UI served under /swagger/ loads the document from swagger.yaml,
+// which, JSON being a subset of YAML, is served as JSON as well.
+const (
+	OpenAPIRoute       = "/swagger/openapi.json"
+	OpenAPISwaggerPath = "/swagger/swagger.yaml"
+)
+
+// openAPIErrorDefinition is the definition of the errors returned by the gRPC
+// gateway.
+const openAPIErrorDefinition = "grpc.gateway.runtime.Error"
+
+var pathParamPattern = regexp.MustCompile(`{([^}=]+)(=[^}]*)?}`)
+
+// RegisterOpenAPI generates an OpenAPI (swagger 2.0) document describing the
+// gRPC gateway routes of the given gRPC services and serves it under the
+// OpenAPIRoute and OpenAPISwaggerPath routes. The document is built from the
+// proto descriptors registered in the binary, so that it covers all the
+// services registered by the app, including custom modules. It must be called
+// before the swagger UI routes are registered.
+func (s *Server) RegisterOpenAPI(services []*grpc.ServiceDesc) error {
+	doc, err := GenerateOpenAPI(services)
+	if err != nil {
+		return err
+	}
+
+	bz, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	handler := func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(bz)
+	}
+
+	s.Router.HandleFunc(OpenAPIRoute, handler).Methods("GET")
+	s.Router.HandleFunc(OpenAPISwaggerPath, handler).Methods("GET")
+
+	return nil
+}
+
+type (
+	// OpenAPIDocument is an OpenAPI (swagger 2.0) document.
+	OpenAPIDocument struct {
+		Swagger     string                                  `json:"swagger"`
+		Info        OpenAPIInfo                             `json:"info"`
+		Consumes    []string                                `json:"consumes"`
+		Produces    []string                                `json:"produces"`
+		Paths       map[string]map[string]*OpenAPIOperation `json:"paths"`
+		Definitions map[string]*OpenAPISchema               `json:"definitions"`
+	}
+
+	// OpenAPIInfo is the metadata of an OpenAPI document.
+	OpenAPIInfo struct {
+		Title   string `json:"title"`
+		Version string `json:"version"`
+	}
+
+	// OpenAPIOperation is an operation available on a path.
+	OpenAPIOperation struct {
+		OperationID string                      `json:"operationId"`
+		Tags        []string                    `json:"tags,omitempty"`
+		Parameters  []*OpenAPIParameter         `json:"parameters,omitempty"`
+		Responses   map[string]*OpenAPIResponse `json:"responses"`
+	}
+
+	// OpenAPIParameter is a path, query or body parameter of an operation.
+	OpenAPIParameter struct {
+		Name             string         `json:"name"`
+		In               string         `json:"in"`
+		Required         bool           `json:"required,omitempty"`
+		Type             string         `json:"type,omitempty"`
+		Format           string         `json:"format,omitempty"`
+		Enum             []string       `json:"enum,omitempty"`
+		Items            *OpenAPISchema `json:"items,omitempty"`
+		CollectionFormat string         `json:"collectionFormat,omitempty"`
+		Schema           *OpenAPISchema `json:"schema,omitempty"`
+	}
+
+	// OpenAPIResponse is a response of an operation.
+	OpenAPIResponse struct {
+		Description string         `json:"description"`
+		Schema      *OpenAPISchema `json:"schema,omitempty"`
+	}
+
+	// OpenAPISchema is the schema of a JSON value.
+	OpenAPISchema struct {
+		Ref                  string                    `json:"$ref,omitempty"`
+		Type                 string                    `json:"type,omitempty"`
+		Format               string                    `json:"format,omitempty"`
+		Enum                 []string                  `json:"enum,omitempty"`
+		Items                *OpenAPISchema            `json:"items,omitempty"`
+		Properties           map[string]*OpenAPISchema `json:"properties,omitempty"`
+		AdditionalProperties *OpenAPISchema            `json:"additionalProperties,omitempty"`
+	}
+)
+
+// GenerateOpenAPI returns the OpenAPI document describing the gRPC gateway
+// routes, i.e. the methods annotated with google.api.http, of the given gRPC
+// services.
+func GenerateOpenAPI(services []*grpc.ServiceDesc) (*OpenAPIDocument, error) {
+	files := make([]string, 0, len(services))
+	for _, sd := range services {
+		if file, ok := sd.Metadata.(string); ok {
+			files = append(files, file)
+		}
+	}
+
+	set, err := gogoreflection.FileDescriptorSet(files...)
+	if err != nil {
+		return nil, err
+	}
+
+	g := newOpenAPIGenerator(set)
+	for _, sd := range services {
+		if err := g.addService(sd.ServiceName); err != nil {
+			return nil, err
+		}
+	}
+
+	return g.doc, nil
+}
+
+type openAPIGenerator struct {
+	doc      *OpenAPIDocument
+	services map[string]*dpb.ServiceDescriptorProto
+	packages map[string]string
+	messages map[string]*dpb.DescriptorProto
+	enums    map[string]*dpb.EnumDescriptorProto
+}
+
+func newOpenAPIGenerator(set *dpb.FileDescriptorSet) *openAPIGenerator {
+	title := "REST API"
+	if version.Name != "" {
+		title = fmt.Sprintf("%s REST API", version.Name)
+	}
+	appVersion := version.Version
+	if appVersion == "" {
+		appVersion = "unknown"
+	}
+
+	g := &openAPIGenerator{
+		doc: &OpenAPIDocument{
+			Swagger:  "2.0",
+			Info:     OpenAPIInfo{Title: title, Version: appVersion},
+			Consumes: []string{"application/json"},
+			Produces: []string{"application/json"},
+			Paths:    make(map[string]map[string]*OpenAPIOperation),
+			Definitions: map[string]*OpenAPISchema{
+				openAPIErrorDefinition: {
+					Type: "object",
+					Properties: map[string]*OpenAPISchema{
+						"error":   {Type: "string"},
+						"code":    {Type: "integer", Format: "int32"},
+						"message": {Type: "string"},
+						"details": {Type: "array", Items: anySchema()},
+					},
+				},
+			},
+		},
+		services: make(map[string]*dpb.ServiceDescriptorProto),
+		packages: make(map[string]string),
+		messages: make(map[string]*dpb.DescriptorProto),
+		enums:    make(map[string]*dpb.EnumDescriptorProto),
+	}
+
+	for _, fd := range set.File {
+		prefix := ""
+		if fd.GetPackage() != "" {
+			prefix = "." + fd.GetPackage()
+		}
+
+		for _, sd := range fd.Service {
+			name := strings.TrimPrefix(prefix+"."+sd.GetName(), ".")
+			g.services[name] = sd
+			g.packages[name] = fd.GetPackage()
+		}
+		for _, en := range fd.EnumType {
+			g.enums[prefix+"."+en.GetName()] = en
+		}
+		for _, msg := range fd.MessageType {
+			g.indexMessage(prefix, msg)
+		}
+	}
+
+	return g
+}
+
+func (g *openAPIGenerator) indexMessage(prefix string, msg *dpb.DescriptorProto) {
+	name := prefix + "." + msg.GetName()
+	g.messages[name] = msg
+
+	for _, en := range msg.EnumType {
+		g.enums[name+"."+en.GetName()] = en
+	}
+	for _, nested := range msg.NestedType {
+		g.indexMessage(name, nested)
+	}
+}
+
+func (g *openAPIGenerator) addService(name string) error {
+	sd, ok := g.services[name]
+	if !ok {
+		return fmt.Errorf("no descriptor found for service %s", name)
+	}
+
+	for _, md := range sd.Method {
+		if md.GetClientStreaming() || md.GetServerStreaming() || md.Options == nil {
+			continue
+		}
+
+		ext, err := proto.GetExtension(md.Options, annotations.E_Http)
+		if err != nil {
+			continue
+		}
+		rule, ok := ext.(*annotations.HttpRule)
+		if !ok {
+			continue
+		}
+
+		operationID := fmt.Sprintf("%s.%s", name, md.GetName())
+		for i, binding := range append([]*annotations.HttpRule{rule}, rule.AdditionalBindings...) {
+			id := operationID
+			if i > 0 {
+				id = fmt.Sprintf("%s%d", operationID, i)
+			}
+
+			if err := g.addOperation(g.packages[name], id, md, binding); err != nil {
+				return fmt.Errorf("%s: %w", operationID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (g *openAPIGenerator) addOperation(pkg, operationID string, md *dpb.MethodDescriptorProto, rule *annotations.HttpRule) error {
+	var method, template string
+	switch pattern := rule.Pattern.(type) {
+	case *annotations.HttpRule_Get:
+		method, template = "get", pattern.Get
+	case *annotations.HttpRule_Put:
+		method, template = "put", pattern.Put
+	case *annotations.HttpRule_Post:
+		method, template = "post", pattern.Post
+	case *annotations.HttpRule_Delete:
+		method, template = "delete", pattern.Delete
+	case *annotations.HttpRule_Patch:
+		method, template = "patch", pattern.Patch
+	default:
+		return nil
+	}
+
+	input, ok := g.messages[md.GetInputType()]
+	if !ok {
+		return fmt.Errorf("unknown message %s", md.GetInputType())
+	}
+
+	op := &OpenAPIOperation{
+		OperationID: operationID,
+		Tags:        []string{pkg},
+		Responses: map[string]*OpenAPIResponse{
+			"200":     {Description: "A successful response.", Schema: g.messageSchema(md.GetOutputType())},
+			"default": {Description: "An unexpected error response.", Schema: definitionRef(openAPIErrorDefinition)},
+		},
+	}
+
+	// path parameters, e.g. {address} or {name=**}
+	bound := make(map[string]bool)
+	for _, match := range pathParamPattern.FindAllStringSubmatch(template, -1) {
+		bound[match[1]] = true
+		op.Parameters = append(op.Parameters, &OpenAPIParameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Type:     "string",
+		})
+	}
+	path := pathParamPattern.ReplaceAllString(template, "{$1}")
+
+	switch rule.Body {
+	case "":
+		g.addQueryParams(op, "", input, bound, map[string]bool{md.GetInputType(): true})
+
+	case "*":
+		op.Parameters = append(op.Parameters, &OpenAPIParameter{
+			Name:     "body",
+			In:       "body",
+			Required: true,
+			Schema:   g.messageSchema(md.GetInputType()),
+		})
+
+	default:
+		for _, fd := range input.Field {
+			if fd.GetName() == rule.Body {
+				op.Parameters = append(op.Parameters, &OpenAPIParameter{
+					Name:     "body",
+					In:       "body",
+					Required: true,
+					Schema:   g.fieldSchema(fd),
+				})
+			}
+		}
+	}
+
+	if g.doc.Paths[path] == nil {
+		g.doc.Paths[path] = make(map[string]*OpenAPIOperation)
+	}
+	g.doc.Paths[path][method] = op
+
+	return nil
+}
+
+// addQueryParams adds the fields of the message which aren't bound to the path
+// as query parameters, flattening nested messages into dotted names, e.g.
+// "pagination.limit".
+func (g *openAPIGenerator) addQueryParams(op *OpenAPIOperation, prefix string, msg *dpb.DescriptorProto, bound, parents map[string]bool) {
+	for _, fd := range msg.Field {
+		name := prefix + fd.GetName()
+		if bound[name] {
+			continue
+		}
+
+		repeated := fd.GetLabel() == dpb.FieldDescriptorProto_LABEL_REPEATED
+		if fd.GetType() == dpb.FieldDescriptorProto_TYPE_MESSAGE {
+			nested, ok := g.messages[fd.GetTypeName()]
+			if !ok || repeated || parents[fd.GetTypeName()] || isWellKnownType(fd.GetTypeName()) {
+				continue
+			}
+
+			parents[fd.GetTypeName()] = true
+			g.addQueryParams(op, name+".", nested, bound, parents)
+			delete(parents, fd.GetTypeName())
+			continue
+		}
+
+		schema := g.fieldSchema(fd)
+		param := &OpenAPIParameter{Name: name, In: "query"}
+		if repeated {
+			param.Type, param.Items, param.CollectionFormat = "array", schema.Items, "multi"
+		} else {
+			param.Type, param.Format, param.Enum = schema.Type, schema.Format, schema.Enum
+		}
+		op.Parameters = append(op.Parameters, param)
+	}
+}
+
+// messageSchema returns a reference to the definition of the message, adding
+// the definitions of the message and of the messages it refers to.
+func (g *openAPIGenerator) messageSchema(typeName string) *OpenAPISchema {
+	switch typeName {
+	case ".google.protobuf.Any":
+		return anySchema()
+	case ".google.protobuf.Timestamp":
+		return &OpenAPISchema{Type: "string", Format: "date-time"}
+	case ".google.protobuf.Duration":
+		return &OpenAPISchema{Type: "string"}
+	}
+
+	name := strings.TrimPrefix(typeName, ".")
+	if _, ok := g.doc.Definitions[name]; ok {
+		return definitionRef(name)
+	}
+
+	msg, ok := g.messages[typeName]
+	if !ok {
+		return &OpenAPISchema{Type: "object"}
+	}
+
+	// the definition is added before its fields to terminate recursion
+	def := &OpenAPISchema{Type: "object", Properties: make(map[string]*OpenAPISchema)}
+	g.doc.Definitions[name] = def
+	for _, fd := range msg.Field {
+		def.Properties[fd.GetName()] = g.fieldSchema(fd)
+	}
+
+	return definitionRef(name)
+}
+
+func (g *openAPIGenerator) fieldSchema(fd *dpb.FieldDescriptorProto) *OpenAPISchema {
+	var schema *OpenAPISchema
+
+	switch fd.GetType() {
+	case dpb.FieldDescriptorProto_TYPE_MESSAGE:
+		msg, ok := g.messages[fd.GetTypeName()]
+		if ok && msg.GetOptions().GetMapEntry() {
+			// maps are objects keyed by strings
+			return &OpenAPISchema{Type: "object", AdditionalProperties: g.fieldSchema(msg.Field[1])}
+		}
+		schema = g.messageSchema(fd.GetTypeName())
+
+	case dpb.FieldDescriptorProto_TYPE_ENUM:
+		schema = &OpenAPISchema{Type: "string"}
+		if en, ok := g.enums[fd.GetTypeName()]; ok {
+			for _, v := range en.Value {
+				schema.Enum = append(schema.Enum, v.GetName())
+			}
+		}
+
+	case dpb.FieldDescriptorProto_TYPE_STRING:
+		schema = &OpenAPISchema{Type: "string"}
+
+	case dpb.FieldDescriptorProto_TYPE_BYTES:
+		schema = &OpenAPISchema{Type: "string", Format: "byte"}
+
+	case dpb.FieldDescriptorProto_TYPE_BOOL:
+		schema = &OpenAPISchema{Type: "boolean"}
+
+	case dpb.FieldDescriptorProto_TYPE_DOUBLE:
+		schema = &OpenAPISchema{Type: "number", Format: "double"}
+
+	case dpb.FieldDescriptorProto_TYPE_FLOAT:
+		schema = &OpenAPISchema{Type: "number", Format: "float"}
+
+	case dpb.FieldDescriptorProto_TYPE_INT32, dpb.FieldDescriptorProto_TYPE_SINT32, dpb.FieldDescriptorProto_TYPE_SFIXED32:
+		schema = &OpenAPISchema{Type: "integer", Format: "int32"}
+
+	case dpb.FieldDescriptorProto_TYPE_UINT32, dpb.FieldDescriptorProto_TYPE_FIXED32:
+		schema = &OpenAPISchema{Type: "integer", Format: "int64"}
+
+	// 64 bit integers are encoded as strings in proto3 JSON
+	case dpb.FieldDescriptorProto_TYPE_UINT64, dpb.FieldDescriptorProto_TYPE_FIXED64:
+		schema = &OpenAPISchema{Type: "string", Format: "uint64"}
+
+	default:
+		schema = &OpenAPISchema{Type: "string", Format: "int64"}
+	}
+
+	if fd.GetLabel() == dpb.FieldDescriptorProto_LABEL_REPEATED {
+		return &OpenAPISchema{Type: "array", Items: schema}
+	}
+
+	return schema
+}
+
+func isWellKnownType(typeName string) bool {
+	return strings.HasPrefix(typeName, ".google.protobuf.")
+}
+
+func anySchema() *OpenAPISchema {
+	return &OpenAPISchema{
+		Type:                 "object",
+		Properties:           map[string]*OpenAPISchema{"@type": {Type: "string"}},
+		AdditionalProperties: &OpenAPISchema{},
+	}
+}
+
+func definitionRef(name string) *OpenAPISchema {
+	return &OpenAPISchema{Ref: "#/definitions/" + name}
+}
@@ -0,0 +1,81 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server/api"
+	"github.com/cosmos/cosmos-sdk/simapp"
+)
+
+func TestGenerateOpenAPI(t *testing.T) {
+	app := simapp.Setup(false)
+	app.RegisterTxService(client.Context{})
+
+	doc, err := api.GenerateOpenAPI(app.GRPCQueryRouter().ServiceDescs())
+	require.NoError(t, err)
+	require.Equal(t, "2.0", doc.Swagger)
+
+	balances := doc.Paths["/cosmos/bank/v1beta1/balances/{address}"]["get"]
+	require.NotNil(t, balances)
+	require.Equal(t, "cosmos.bank.v1beta1.Query.AllBalances", balances.OperationID)
+	require.Equal(t, []string{"cosmos.bank.v1beta1"}, balances.Tags)
+	require.Equal(t, "#/definitions/cosmos.bank.v1beta1.QueryAllBalancesResponse", balances.Responses["200"].Schema.Ref)
+
+	params := make(map[string]*api.OpenAPIParameter)
+	for _, p := range balances.Parameters {
+		params[p.Name] = p
+	}
+	require.Equal(t, "path", params["address"].In)
+	require.True(t, params["address"].Required)
+	require.Equal(t, "query", params["pagination.key"].In)
+	require.Equal(t, "byte", params["pagination.key"].Format)
+	require.Equal(t, "boolean", params["pagination.count_total"].Type)
+
+	res := doc.Definitions["cosmos.bank.v1beta1.QueryAllBalancesResponse"]
+	require.NotNil(t, res)
+	require.Equal(t, "array", res.Properties["balances"].Type)
+	require.Equal(t, "#/definitions/cosmos.base.v1beta1.Coin", res.Properties["balances"].Items.Ref)
+	require.Equal(t, "string", doc.Definitions["cosmos.base.v1beta1.Coin"].Properties["amount"].Type)
+
+	// path templates with patterns are simplified
+	require.NotNil(t, doc.Paths["/cosmos/bank/v1beta1/denoms_metadata/{denom}"]["get"])
+
+	broadcast := doc.Paths["/cosmos/tx/v1beta1/txs"]["post"]
+	require.NotNil(t, broadcast)
+	require.Len(t, broadcast.Parameters, 1)
+	require.Equal(t, "body", broadcast.Parameters[0].In)
+	require.Equal(t, "#/definitions/cosmos.tx.v1beta1.BroadcastTxRequest", broadcast.Parameters[0].Schema.Ref)
+
+	mode := doc.Definitions["cosmos.tx.v1beta1.BroadcastTxRequest"].Properties["mode"]
+	require.Contains(t, mode.Enum, "BROADCAST_MODE_BLOCK")
+}
+
+func TestRegisterOpenAPI(t *testing.T) {
+	app := simapp.Setup(false)
+
+	srv := api.New(client.Context{}, log.NewNopLogger())
+	require.NoError(t, srv.RegisterOpenAPI(app.GRPCQueryRouter().ServiceDescs()))
+	simapp.RegisterSwaggerAPI(client.Context{}, srv.Router)
+
+	for _, route := range []string{api.OpenAPIRoute, api.OpenAPISwaggerPath} {
+		rec := httptest.NewRecorder()
+		srv.Router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, route, nil))
+		require.Equal(t, http.StatusOK, rec.Code, route)
+
+		var doc api.OpenAPIDocument
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &doc), route)
+		require.Contains(t, doc.Paths, "/cosmos/bank/v1beta1/balances/{address}", route)
+	}
+
+	// the swagger UI is still served
+	rec := httptest.NewRecorder()
+	srv.Router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/swagger/swagger-ui.css", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+}
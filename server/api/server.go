@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"net/http"
@@ -14,9 +15,12 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/tendermint/tendermint/libs/log"
 	tmrpcserver "github.com/tendermint/tendermint/rpc/jsonrpc/server"
+	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/filter"
+	"github.com/cosmos/cosmos-sdk/server/limit"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	"github.com/cosmos/cosmos-sdk/types/rest"
@@ -33,12 +37,16 @@ type Server struct {
 
 	logger  log.Logger
 	metrics *telemetry.Metrics
+
+	queryFilter *filter.QueryFilter
+	queryRoutes *filter.Routes
 	// Start() is blocking and generally called from a separate goroutine.
 	// Close() can be called asynchronously and access shared memory
 	// via the listener. Therefore, we sync access to Start and Close with
 	// this mutex to avoid data races.
-	mtx      sync.Mutex
-	listener net.Listener
+	mtx        sync.Mutex
+	listener   net.Listener
+	httpServer *http.Server
 }
 
 // CustomGRPCHeaderMatcher for mapping request headers to
@@ -116,17 +124,63 @@ func (s *Server) Start(cfg config.Config) error {
 	s.registerGRPCGatewayRoutes()
 
 	s.listener = listener
-	var h http.Handler = s.Router
+
+	queueTimeout := time.Duration(cfg.API.QueueTimeout) * time.Second
+	var h http.Handler = limit.Middleware(
+		limit.NewLimiter("api_query", cfg.API.MaxConcurrentQueries, cfg.API.MaxQueuedRequests, queueTimeout),
+		limit.NewLimiter("api_broadcast", cfg.API.MaxConcurrentBroadcasts, cfg.API.MaxQueuedRequests, queueTimeout),
+	)(DisplayDenomsMiddleware(BankDenomMetadataResolver(s.ClientCtx))(s.Router))
+	if cfg.QueryOnly {
+		h = limit.RejectBroadcastMiddleware(h)
+	}
+	h = filter.Middleware(s.queryFilter, s.queryRoutes)(h)
 
 	if cfg.API.EnableUnsafeCORS {
 		allowAllCORS := handlers.CORS(handlers.AllowedHeaders([]string{"Content-Type"}))
-		s.mtx.Unlock()
-		return tmrpcserver.Serve(s.listener, allowAllCORS(h), s.logger, tmCfg)
+		h = allowAllCORS(h)
+	} else {
+		s.logger.Info("starting API server...")
 	}
 
-	s.logger.Info("starting API server...")
+	// The HTTP server is created here rather than by Tendermint's JSON RPC
+	// server so that Shutdown can drain the in-flight requests.
+	s.httpServer = &http.Server{
+		Handler:        tmrpcserver.RecoverAndLogHandler(maxBytesHandler(h, tmCfg.MaxBodyBytes), s.logger),
+		ReadTimeout:    tmCfg.ReadTimeout,
+		WriteTimeout:   tmCfg.WriteTimeout,
+		MaxHeaderBytes: tmCfg.MaxHeaderBytes,
+	}
+	httpServer := s.httpServer
 	s.mtx.Unlock()
-	return tmrpcserver.Serve(s.listener, s.Router, s.logger, tmCfg)
+
+	s.logger.Info(fmt.Sprintf("Starting RPC HTTP server on %s", listener.Addr()))
+	err = httpServer.Serve(listener)
+	if err == http.ErrServerClosed {
+		return nil
+	}
+
+	return err
+}
+
+// SetQueryFilter sets the filter rejecting the gRPC gateway requests to the
+// methods it doesn't expose, the routes of which are read from the given gRPC
+// services. It must be called before Start.
+func (s *Server) SetQueryFilter(f *filter.QueryFilter, services []*grpc.ServiceDesc) error {
+	if f == nil {
+		return nil
+	}
+
+	routes, err := filter.NewRoutes(services)
+	if err != nil {
+		return err
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.queryFilter = f
+	s.queryRoutes = routes
+
+	return nil
 }
 
 // Close closes the API server.
@@ -136,6 +190,38 @@ func (s *Server) Close() error {
 	return s.listener.Close()
 }
 
+// Shutdown gracefully shuts down the API server: it stops accepting new
+// connections and waits for the in-flight requests to complete or for the
+// context to be done, in which case the remaining connections are closed.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.mtx.Lock()
+	httpServer := s.httpServer
+	s.mtx.Unlock()
+
+	if httpServer == nil {
+		return s.Close()
+	}
+
+	err := httpServer.Shutdown(ctx)
+	if err != nil {
+		_ = httpServer.Close()
+	}
+
+	if s.metrics != nil {
+		s.metrics.Close()
+	}
+
+	return err
+}
+
+// maxBytesHandler limits the size of the request bodies to n bytes.
+func maxBytesHandler(h http.Handler, n int64) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.Body = http.MaxBytesReader(w, r.Body, n)
+		h.ServeHTTP(w, r)
+	})
+}
+
 func (s *Server) registerGRPCGatewayRoutes() {
 	s.Router.PathPrefix("/").Handler(s.GRPCGatewayRouter)
 }
@@ -0,0 +1,75 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+func TestServerShutdownDrainsRequests(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	cfg := config.DefaultConfig()
+	cfg.API.Address = "tcp://" + addr
+
+	s := New(client.Context{}, log.NewNopLogger())
+	started := make(chan struct{})
+	s.Router.HandleFunc("/slow", func(w http.ResponseWriter, _ *http.Request) {
+		close(started)
+		time.Sleep(200 * time.Millisecond)
+		fmt.Fprint(w, "done")
+	})
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- s.Start(*cfg) }()
+	require.Eventually(t, func() bool {
+		conn, err := net.Dial("tcp", addr)
+		if err != nil {
+			return false
+		}
+		return conn.Close() == nil
+	}, time.Second, 10*time.Millisecond)
+
+	type result struct {
+		body string
+		err  error
+	}
+	resCh := make(chan result, 1)
+	go func() {
+		res, err := http.Get("http://" + addr + "/slow")
+		if err != nil {
+			resCh <- result{err: err}
+			return
+		}
+		defer res.Body.Close()
+		body, err := ioutil.ReadAll(res.Body)
+		resCh <- result{body: string(body), err: err}
+	}()
+
+	<-started
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, s.Shutdown(ctx))
+
+	// the in-flight request completed and the server refuses new ones
+	res := <-resCh
+	require.NoError(t, res.err)
+	require.Equal(t, "done", res.body)
+	require.NoError(t, <-errCh)
+
+	_, err = http.Get("http://" + addr + "/slow")
+	require.Error(t, err)
+}
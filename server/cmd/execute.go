@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
@@ -10,6 +12,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/i18n"
 	"github.com/cosmos/cosmos-sdk/server"
 )
 
@@ -31,7 +34,24 @@ func Execute(rootCmd *cobra.Command, defaultHome string) error {
 
 	rootCmd.PersistentFlags().String(flags.FlagLogLevel, zerolog.InfoLevel.String(), "The logging level (trace|debug|info|warn|error|fatal|panic)")
 	rootCmd.PersistentFlags().String(flags.FlagLogFormat, tmcfg.LogFormatPlain, "The logging format (json|plain)")
+	rootCmd.PersistentFlags().String(flags.FlagLang, "", fmt.Sprintf("The language of the CLI output (%s)", strings.Join(i18n.Languages(), "|")))
+
+	// print the errors with their common descriptions translated, in the
+	// same order as cobra
+	silenceErrors, silenceUsage := rootCmd.SilenceErrors, rootCmd.SilenceUsage
+	rootCmd.SilenceErrors, rootCmd.SilenceUsage = true, true
 
 	executor := tmcli.PrepareBaseCmd(rootCmd, "", defaultHome)
-	return executor.ExecuteContext(ctx)
+	cmd, err := executor.ExecuteContextC(ctx)
+	rootCmd.SilenceErrors, rootCmd.SilenceUsage = silenceErrors, silenceUsage
+	if err != nil {
+		if !rootCmd.SilenceErrors && !cmd.SilenceErrors {
+			cmd.PrintErrln(i18n.T("Error:"), i18n.Error(err))
+		}
+		if !rootCmd.SilenceUsage && !cmd.SilenceUsage {
+			cmd.Println(cmd.UsageString())
+		}
+	}
+
+	return err
 }
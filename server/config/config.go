@@ -20,6 +20,18 @@ const (
 
 	// DefaultGRPCWebAddress defines the default address to bind the gRPC-web server to.
 	DefaultGRPCWebAddress = "0.0.0.0:9091"
+
+	// DefaultMaxQueuedRequests defines the default number of gRPC and REST
+	// requests allowed to wait for a free slot once a concurrency limit is hit.
+	DefaultMaxQueuedRequests = 1000
+
+	// DefaultQueueTimeout defines the default time (in seconds) a queued gRPC or
+	// REST request waits for a free slot.
+	DefaultQueueTimeout = 10
+
+	// DefaultShutdownTimeout defines the default time (in seconds) given to the
+	// in-flight gRPC and REST requests to complete on shutdown.
+	DefaultShutdownTimeout = 10
 )
 
 // BaseConfig defines the server's basic configuration
@@ -69,8 +81,51 @@ type BaseConfig struct {
 	// IndexEvents defines the set of events in the form {eventType}.{attributeKey},
 	// which informs Tendermint what to index. If empty, all events will be indexed.
 	IndexEvents []string `mapstructure:"index-events"`
+
+	// EmitTxEvents emits a typed EventTx summarizing the fee, gas, size,
+	// signers and messages of every delivered transaction.
+	EmitTxEvents bool `mapstructure:"emit-tx-events"`
+
+	// MaxGasWanted is the maximum gas a transaction can want to be accepted in
+	// the mempool. A value of 0 disables the limit.
+	MaxGasWanted uint64 `mapstructure:"max-gas-wanted"`
+
+	// MaxFeeMultiple is the maximum multiple of the fee required by the minimum
+	// gas prices a transaction can pay to be accepted in the mempool, protecting
+	// the users from absurd fees. A value of 0 disables the limit.
+	MaxFeeMultiple uint64 `mapstructure:"max-fee-multiple"`
+
+	// TxPriorityFeeTiers are the gas prices, from the lowest to the highest, of
+	// the fee tiers of the priority assigned by CheckTx to the transactions.
+	// Each tier is a list of gas prices, e.g. "0.1stake,0.001atom".
+	TxPriorityFeeTiers []string `mapstructure:"tx-priority-fee-tiers"`
+
+	// TxPriorityMsgs are the type URLs of the messages whose transactions get a
+	// priority above all the fee tiers.
+	TxPriorityMsgs []string `mapstructure:"tx-priority-msgs"`
+
 	// IavlCacheSize set the size of the iavl tree cache.
 	IAVLCacheSize uint64 `mapstructure:"iavl-cache-size"`
+
+	// QueryOnly runs the node in query-only mode: transactions are rejected in
+	// CheckTx and on the gRPC and REST broadcast endpoints, while queries and
+	// state sync are served.
+	QueryOnly bool `mapstructure:"query-only"`
+
+	// ShutdownTimeout defines the time (in seconds) given to the in-flight gRPC
+	// and REST requests to complete on shutdown, after which the remaining
+	// connections are closed.
+	ShutdownTimeout uint64 `mapstructure:"shutdown-timeout"`
+
+	// Bech32Prefix defines the bech32 account address prefix of the network,
+	// from which the validator and consensus prefixes are derived. If empty,
+	// the prefixes of the binary are used.
+	Bech32Prefix string `mapstructure:"bech32-prefix"`
+
+	// NodeRole defines the role of the node, one of the presets written by
+	// init --preset, against which config doctor checks the configuration.
+	// If empty, only the checks common to all the roles are made.
+	NodeRole string `mapstructure:"node-role"`
 }
 
 // APIConfig defines the API listener configuration.
@@ -99,6 +154,23 @@ type APIConfig struct {
 	// RPCMaxBodyBytes defines the Tendermint maximum response body (in bytes)
 	RPCMaxBodyBytes uint `mapstructure:"rpc-max-body-bytes"`
 
+	// MaxConcurrentQueries defines the maximum number of query requests handled
+	// concurrently. 0 disables the limit.
+	MaxConcurrentQueries uint `mapstructure:"max-concurrent-queries"`
+
+	// MaxConcurrentBroadcasts defines the maximum number of transaction
+	// broadcast requests handled concurrently. 0 disables the limit.
+	MaxConcurrentBroadcasts uint `mapstructure:"max-concurrent-broadcasts"`
+
+	// MaxQueuedRequests defines the maximum number of requests, per limit,
+	// waiting for a free slot once the concurrency limit is reached. Requests
+	// beyond this are rejected immediately.
+	MaxQueuedRequests uint `mapstructure:"max-queued-requests"`
+
+	// QueueTimeout defines the maximum time (in seconds) a request waits for a
+	// free slot before being rejected. 0 waits for as long as the client does.
+	QueueTimeout uint `mapstructure:"queue-timeout"`
+
 	// TODO: TLS/Proxy configuration.
 	//
 	// Ref: https://github.com/cosmos/cosmos-sdk/issues/6420
@@ -134,6 +206,23 @@ type GRPCConfig struct {
 
 	// Address defines the API server to listen on
 	Address string `mapstructure:"address"`
+
+	// MaxConcurrentQueries defines the maximum number of query requests handled
+	// concurrently. 0 disables the limit.
+	MaxConcurrentQueries uint `mapstructure:"max-concurrent-queries"`
+
+	// MaxConcurrentBroadcasts defines the maximum number of transaction
+	// broadcast requests handled concurrently. 0 disables the limit.
+	MaxConcurrentBroadcasts uint `mapstructure:"max-concurrent-broadcasts"`
+
+	// MaxQueuedRequests defines the maximum number of requests, per limit,
+	// waiting for a free slot once the concurrency limit is reached. Requests
+	// beyond this are rejected immediately.
+	MaxQueuedRequests uint `mapstructure:"max-queued-requests"`
+
+	// QueueTimeout defines the maximum time (in seconds) a request waits for a
+	// free slot before being rejected. 0 waits for as long as the client does.
+	QueueTimeout uint `mapstructure:"queue-timeout"`
 }
 
 // GRPCWebConfig defines configuration for the gRPC-web server.
@@ -159,6 +248,17 @@ type StateSyncConfig struct {
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
 }
 
+// QueryFilterConfig defines the gRPC services and methods exposed by the
+// gRPC, gRPC-web and REST servers.
+type QueryFilterConfig struct {
+	// Allowed defines the services and methods exposed, all of them if empty.
+	Allowed []string `mapstructure:"allowed"`
+
+	// Denied defines the services and methods not exposed, taking precedence
+	// over Allowed.
+	Denied []string `mapstructure:"denied"`
+}
+
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
@@ -170,6 +270,8 @@ type Config struct {
 	Rosetta   RosettaConfig    `mapstructure:"rosetta"`
 	GRPCWeb   GRPCWebConfig    `mapstructure:"grpc-web"`
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
+
+	QueryFilter QueryFilterConfig `mapstructure:"query-filter"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -203,15 +305,18 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 func DefaultConfig() *Config {
 	return &Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:      defaultMinGasPrices,
-			InterBlockCache:   true,
-			Pruning:           storetypes.PruningOptionDefault,
-			PruningKeepRecent: "0",
-			PruningKeepEvery:  "0",
-			PruningInterval:   "0",
-			MinRetainBlocks:   0,
-			IndexEvents:       make([]string, 0),
-			IAVLCacheSize:     781250, // 50 MB
+			MinGasPrices:       defaultMinGasPrices,
+			InterBlockCache:    true,
+			Pruning:            storetypes.PruningOptionDefault,
+			PruningKeepRecent:  "0",
+			PruningKeepEvery:   "0",
+			PruningInterval:    "0",
+			MinRetainBlocks:    0,
+			IndexEvents:        make([]string, 0),
+			TxPriorityFeeTiers: make([]string, 0),
+			TxPriorityMsgs:     make([]string, 0),
+			IAVLCacheSize:      781250, // 50 MB
+			ShutdownTimeout:    DefaultShutdownTimeout,
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -224,10 +329,14 @@ func DefaultConfig() *Config {
 			MaxOpenConnections: 1000,
 			RPCReadTimeout:     10,
 			RPCMaxBodyBytes:    1000000,
+			MaxQueuedRequests:  DefaultMaxQueuedRequests,
+			QueueTimeout:       DefaultQueueTimeout,
 		},
 		GRPC: GRPCConfig{
-			Enable:  true,
-			Address: DefaultGRPCAddress,
+			Enable:            true,
+			Address:           DefaultGRPCAddress,
+			MaxQueuedRequests: DefaultMaxQueuedRequests,
+			QueueTimeout:      DefaultQueueTimeout,
 		},
 		Rosetta: RosettaConfig{
 			Enable:     false,
@@ -245,6 +354,10 @@ func DefaultConfig() *Config {
 			SnapshotInterval:   0,
 			SnapshotKeepRecent: 2,
 		},
+		QueryFilter: QueryFilterConfig{
+			Allowed: []string{},
+			Denied:  []string{},
+		},
 	}
 }
 
@@ -261,17 +374,26 @@ func GetConfig(v *viper.Viper) Config {
 
 	return Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:      v.GetString("minimum-gas-prices"),
-			InterBlockCache:   v.GetBool("inter-block-cache"),
-			Pruning:           v.GetString("pruning"),
-			PruningKeepRecent: v.GetString("pruning-keep-recent"),
-			PruningKeepEvery:  v.GetString("pruning-keep-every"),
-			PruningInterval:   v.GetString("pruning-interval"),
-			HaltHeight:        v.GetUint64("halt-height"),
-			HaltTime:          v.GetUint64("halt-time"),
-			IndexEvents:       v.GetStringSlice("index-events"),
-			MinRetainBlocks:   v.GetUint64("min-retain-blocks"),
-			IAVLCacheSize:     v.GetUint64("iavl-cache-size"),
+			MinGasPrices:       v.GetString("minimum-gas-prices"),
+			InterBlockCache:    v.GetBool("inter-block-cache"),
+			Pruning:            v.GetString("pruning"),
+			PruningKeepRecent:  v.GetString("pruning-keep-recent"),
+			PruningKeepEvery:   v.GetString("pruning-keep-every"),
+			PruningInterval:    v.GetString("pruning-interval"),
+			HaltHeight:         v.GetUint64("halt-height"),
+			HaltTime:           v.GetUint64("halt-time"),
+			IndexEvents:        v.GetStringSlice("index-events"),
+			EmitTxEvents:       v.GetBool("emit-tx-events"),
+			MaxGasWanted:       v.GetUint64("max-gas-wanted"),
+			MaxFeeMultiple:     v.GetUint64("max-fee-multiple"),
+			TxPriorityFeeTiers: v.GetStringSlice("tx-priority-fee-tiers"),
+			TxPriorityMsgs:     v.GetStringSlice("tx-priority-msgs"),
+			MinRetainBlocks:    v.GetUint64("min-retain-blocks"),
+			IAVLCacheSize:      v.GetUint64("iavl-cache-size"),
+			QueryOnly:          v.GetBool("query-only"),
+			ShutdownTimeout:    v.GetUint64("shutdown-timeout"),
+			Bech32Prefix:       v.GetString("bech32-prefix"),
+			NodeRole:           v.GetString("node-role"),
 		},
 		Telemetry: telemetry.Config{
 			ServiceName:             v.GetString("telemetry.service-name"),
@@ -291,6 +413,11 @@ func GetConfig(v *viper.Viper) Config {
 			RPCWriteTimeout:    v.GetUint("api.rpc-write-timeout"),
 			RPCMaxBodyBytes:    v.GetUint("api.rpc-max-body-bytes"),
 			EnableUnsafeCORS:   v.GetBool("api.enabled-unsafe-cors"),
+
+			MaxConcurrentQueries:    v.GetUint("api.max-concurrent-queries"),
+			MaxConcurrentBroadcasts: v.GetUint("api.max-concurrent-broadcasts"),
+			MaxQueuedRequests:       v.GetUint("api.max-queued-requests"),
+			QueueTimeout:            v.GetUint("api.queue-timeout"),
 		},
 		Rosetta: RosettaConfig{
 			Enable:     v.GetBool("rosetta.enable"),
@@ -301,8 +428,12 @@ func GetConfig(v *viper.Viper) Config {
 			Offline:    v.GetBool("rosetta.offline"),
 		},
 		GRPC: GRPCConfig{
-			Enable:  v.GetBool("grpc.enable"),
-			Address: v.GetString("grpc.address"),
+			Enable:                  v.GetBool("grpc.enable"),
+			Address:                 v.GetString("grpc.address"),
+			MaxConcurrentQueries:    v.GetUint("grpc.max-concurrent-queries"),
+			MaxConcurrentBroadcasts: v.GetUint("grpc.max-concurrent-broadcasts"),
+			MaxQueuedRequests:       v.GetUint("grpc.max-queued-requests"),
+			QueueTimeout:            v.GetUint("grpc.queue-timeout"),
 		},
 		GRPCWeb: GRPCWebConfig{
 			Enable:           v.GetBool("grpc-web.enable"),
@@ -313,6 +444,10 @@ func GetConfig(v *viper.Viper) Config {
 			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
 			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
 		},
+		QueryFilter: QueryFilterConfig{
+			Allowed: v.GetStringSlice("query-filter.allowed"),
+			Denied:  v.GetStringSlice("query-filter.denied"),
+		},
 	}
 }
 
@@ -326,6 +461,21 @@ func (c Config) ValidateBasic() error {
 			"cannot enable state sync snapshots with '%s' pruning setting", storetypes.PruningOptionEverything,
 		)
 	}
+	if c.Bech32Prefix != "" {
+		if err := sdk.ValidateBech32Prefix(c.Bech32Prefix); err != nil {
+			return sdkerrors.ErrAppConfig.Wrap(err.Error())
+		}
+	}
+	if c.NodeRole != "" {
+		if _, err := GetPreset(c.NodeRole); err != nil {
+			return sdkerrors.ErrAppConfig.Wrap(err.Error())
+		}
+	}
+	for _, tier := range c.TxPriorityFeeTiers {
+		if gasPrices, err := sdk.ParseDecCoins(tier); err != nil || gasPrices.Empty() {
+			return sdkerrors.ErrAppConfig.Wrapf("invalid tx priority fee tier %q", tier)
+		}
+	}
 
 	return nil
 }
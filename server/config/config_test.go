@@ -1,8 +1,10 @@
 package config
 
 import (
+	"path/filepath"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,3 +20,55 @@ func TestSetMinimumFees(t *testing.T) {
 	cfg.SetMinGasPrices(sdk.DecCoins{sdk.NewInt64DecCoin("foo", 5)})
 	require.Equal(t, "5.000000000000000000foo", cfg.MinGasPrices)
 }
+
+func TestValidateBech32Prefix(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.SetMinGasPrices(sdk.DecCoins{sdk.NewInt64DecCoin("foo", 5)})
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.Bech32Prefix = "cosmos"
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.Bech32Prefix = "Cosmos"
+	require.Error(t, cfg.ValidateBasic())
+}
+
+func TestQueryFilterConfigRoundTrip(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.QueryFilter.Allowed = []string{"cosmos.bank.*", "/cosmos.tx.v1beta1.Service/GetTx"}
+	cfg.QueryFilter.Denied = []string{"cosmos.bank.v1beta1.Query/DenomsMetadata"}
+
+	path := filepath.Join(t.TempDir(), "app.toml")
+	WriteConfigFile(path, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	require.NoError(t, v.ReadInConfig())
+
+	parsed := GetConfig(v)
+	require.Equal(t, cfg.QueryFilter, parsed.QueryFilter)
+}
+
+func TestTxPriorityConfig(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.SetMinGasPrices(sdk.DecCoins{sdk.NewInt64DecCoin("foo", 5)})
+	cfg.TxPriorityFeeTiers = []string{"0.1stake", "1stake,0.01atom"}
+	cfg.TxPriorityMsgs = []string{"/cosmos.gov.v1beta1.MsgVote"}
+	require.NoError(t, cfg.ValidateBasic())
+
+	path := filepath.Join(t.TempDir(), "app.toml")
+	WriteConfigFile(path, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	require.NoError(t, v.ReadInConfig())
+
+	parsed := GetConfig(v)
+	require.Equal(t, cfg.TxPriorityFeeTiers, parsed.TxPriorityFeeTiers)
+	require.Equal(t, cfg.TxPriorityMsgs, parsed.TxPriorityMsgs)
+
+	for _, tier := range []string{"", "stake", "1stake,1stake"} {
+		cfg.TxPriorityFeeTiers = []string{tier}
+		require.Error(t, cfg.ValidateBasic(), tier)
+	}
+}
@@ -0,0 +1,173 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	tmcfg "github.com/tendermint/tendermint/config"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// The severities of the findings of Diagnose.
+const (
+	// SeverityDanger flags a setting which compromises the node, its keys or
+	// its data, or which prevents it from fulfilling its role.
+	SeverityDanger = "danger"
+	// SeverityWarning flags a setting which is unusual for the role of the
+	// node.
+	SeverityWarning = "warning"
+)
+
+// Finding is a dangerous or unusual setting found by Diagnose.
+type Finding struct {
+	Severity string
+	Message  string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s", f.Severity, f.Message)
+}
+
+// Diagnose checks the Tendermint and application configurations of a node for
+// dangerous combinations of settings, e.g. a validator with a public RPC, the
+// role of the node being the one of the node-role setting.
+func Diagnose(tmConfig *tmcfg.Config, config Config) []Finding {
+	var findings []Finding
+	danger := func(format string, args ...interface{}) {
+		findings = append(findings, Finding{SeverityDanger, fmt.Sprintf(format, args...)})
+	}
+	warning := func(format string, args ...interface{}) {
+		findings = append(findings, Finding{SeverityWarning, fmt.Sprintf(format, args...)})
+	}
+
+	if err := tmConfig.ValidateBasic(); err != nil {
+		danger("invalid config.toml: %s", err)
+	}
+	if err := config.ValidateBasic(); err != nil {
+		danger("invalid app.toml: %s", err)
+	}
+
+	rpcPublic := isPublicAddress(tmConfig.RPC.ListenAddress)
+	apiPublic := config.API.Enable && isPublicAddress(config.API.Address)
+	grpcPublic := config.GRPC.Enable && isPublicAddress(config.GRPC.Address)
+	grpcWebPublic := config.GRPC.Enable && config.GRPCWeb.Enable && isPublicAddress(config.GRPCWeb.Address)
+
+	if rpcPublic && tmConfig.RPC.Unsafe {
+		danger("the unsafe RPC commands are exposed on the public address %s", tmConfig.RPC.ListenAddress)
+	}
+	if isPublicAddress(tmConfig.RPC.PprofListenAddress) {
+		danger("the pprof profiles are exposed on the public address %s", tmConfig.RPC.PprofListenAddress)
+	}
+	if rpcPublic && containsString(tmConfig.RPC.CORSAllowedOrigins, "*") {
+		warning("the RPC allows the requests of any origin")
+	}
+	if apiPublic && config.API.EnableUnsafeCORS {
+		warning("the API allows the requests of any origin")
+	}
+	if grpcWebPublic && config.GRPCWeb.EnableUnsafeCORS {
+		warning("the gRPC-web server allows the requests of any origin")
+	}
+	if tmConfig.P2P.SeedMode && !tmConfig.P2P.PexReactor {
+		danger("seed mode requires the peer exchange")
+	}
+
+	switch config.NodeRole {
+	case RoleValidator:
+		if rpcPublic {
+			danger("the validator exposes its RPC on the public address %s", tmConfig.RPC.ListenAddress)
+		}
+		if apiPublic {
+			danger("the validator exposes its API on the public address %s", config.API.Address)
+		}
+		if grpcPublic {
+			danger("the validator exposes its gRPC server on the public address %s", config.GRPC.Address)
+		}
+		if grpcWebPublic {
+			danger("the validator exposes its gRPC-web server on the public address %s", config.GRPCWeb.Address)
+		}
+		if config.Rosetta.Enable {
+			warning("the validator runs the Rosetta API")
+		}
+		if tmConfig.P2P.SeedMode {
+			danger("the validator runs in seed mode")
+		}
+		if tmConfig.P2P.PexReactor {
+			warning("the validator discovers and advertises peers through the peer exchange, revealing its address")
+		}
+		if tmConfig.P2P.PersistentPeers == "" {
+			warning("the validator has no persistent peers, e.g. its sentries")
+		}
+
+	case RoleSentry:
+		if tmConfig.P2P.SeedMode {
+			danger("the sentry runs in seed mode")
+		}
+		if !tmConfig.P2P.PexReactor {
+			warning("the sentry does not discover peers through the peer exchange")
+		}
+
+	case RoleArchive:
+		if config.Pruning != storetypes.PruningOptionNothing {
+			danger("the archive prunes the application state with the %q pruning strategy", config.Pruning)
+		}
+		if config.MinRetainBlocks > 0 {
+			danger("the archive prunes the blocks older than %d blocks", config.MinRetainBlocks)
+		}
+		if tmConfig.TxIndex.Indexer == "null" {
+			warning("the archive does not index the transactions")
+		}
+
+	case RoleSeed:
+		if !tmConfig.P2P.SeedMode {
+			danger("the seed does not run in seed mode")
+		}
+		if !tmConfig.P2P.PexReactor {
+			danger("the seed does not run the peer exchange")
+		}
+		if config.API.Enable || config.GRPC.Enable {
+			warning("the seed serves queries while it keeps no state")
+		}
+	}
+
+	return findings
+}
+
+// isPublicAddress returns true if the given listen address, e.g.
+// "tcp://0.0.0.0:26657" or "localhost:9090", is reachable from other hosts,
+// which is the case of the addresses that are neither loopback nor unix
+// sockets.
+func isPublicAddress(addr string) bool {
+	if addr == "" || strings.HasPrefix(addr, "unix://") {
+		return false
+	}
+	if i := strings.Index(addr, "://"); i >= 0 {
+		addr = addr[i+len("://"):]
+	}
+
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+	if host == "localhost" {
+		return false
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return !ip.IsLoopback()
+	}
+
+	// an empty host binds all the interfaces, and the other host names are
+	// resolved to any address
+	return true
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,224 @@
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	tmcfg "github.com/tendermint/tendermint/config"
+)
+
+// The roles of the nodes for which a configuration preset is defined.
+const (
+	RoleValidator = "validator"
+	RoleSentry    = "sentry"
+	RoleArchive   = "archive"
+	RoleSeed      = "seed"
+)
+
+// localRPCAddress is the Tendermint RPC address of the presets whose RPC is
+// only reachable from the host.
+const localRPCAddress = "tcp://127.0.0.1:26657"
+
+// Preset defines the opinionated settings of config.toml and app.toml for a
+// role of node.
+type Preset struct {
+	Name string
+
+	// Tendermint applies the Tendermint settings of the preset.
+	Tendermint func(*tmcfg.Config)
+
+	// App are the app.toml settings of the preset, keyed by the keys of the
+	// settings prefixed with their section if any, e.g. "api.enable", with
+	// their TOML encoded values.
+	App map[string]string
+}
+
+var presets = []Preset{
+	{
+		// A validator only connects to its sentries, which shield it from the
+		// network, and signs blocks: it neither indexes nor serves queries.
+		Name: RoleValidator,
+		Tendermint: func(c *tmcfg.Config) {
+			c.P2P.PexReactor = false
+			c.P2P.SeedMode = false
+			c.RPC.ListenAddress = localRPCAddress
+			c.RPC.Unsafe = false
+			c.TxIndex.Indexer = "null"
+		},
+		App: map[string]string{
+			"pruning":                      `"default"`,
+			"node-role":                    `"validator"`,
+			"api.enable":                   "false",
+			"rosetta.enable":               "false",
+			"grpc.address":                 `"127.0.0.1:9090"`,
+			"grpc-web.enable":              "false",
+			"state-sync.snapshot-interval": "0",
+		},
+	},
+	{
+		// A sentry relays the blocks and transactions between its validator and
+		// the network, discovering the peers of the network.
+		Name: RoleSentry,
+		Tendermint: func(c *tmcfg.Config) {
+			c.P2P.PexReactor = true
+			c.P2P.SeedMode = false
+			c.RPC.ListenAddress = localRPCAddress
+			c.RPC.Unsafe = false
+			c.TxIndex.Indexer = "null"
+		},
+		App: map[string]string{
+			"pruning":         `"default"`,
+			"node-role":       `"sentry"`,
+			"api.enable":      "false",
+			"rosetta.enable":  "false",
+			"grpc.address":    `"127.0.0.1:9090"`,
+			"grpc-web.enable": "false",
+		},
+	},
+	{
+		// An archive keeps the whole history and serves it to the clients,
+		// along with state sync snapshots.
+		Name: RoleArchive,
+		Tendermint: func(c *tmcfg.Config) {
+			c.P2P.PexReactor = true
+			c.P2P.SeedMode = false
+			c.RPC.ListenAddress = "tcp://0.0.0.0:26657"
+			c.RPC.Unsafe = false
+			c.TxIndex.Indexer = "kv"
+		},
+		App: map[string]string{
+			"pruning":                         `"nothing"`,
+			"min-retain-blocks":               "0",
+			"index-events":                    "[]",
+			"node-role":                       `"archive"`,
+			"api.enable":                      "true",
+			"api.address":                     `"tcp://0.0.0.0:1317"`,
+			"grpc.enable":                     "true",
+			"grpc.address":                    `"0.0.0.0:9090"`,
+			"state-sync.snapshot-interval":    "1000",
+			"state-sync.snapshot-keep-recent": "2",
+		},
+	},
+	{
+		// A seed only crawls the network to hand out the addresses of its
+		// peers, keeping no state worth serving.
+		Name: RoleSeed,
+		Tendermint: func(c *tmcfg.Config) {
+			c.P2P.PexReactor = true
+			c.P2P.SeedMode = true
+			c.RPC.ListenAddress = localRPCAddress
+			c.RPC.Unsafe = false
+			c.TxIndex.Indexer = "null"
+		},
+		App: map[string]string{
+			"pruning":                      `"everything"`,
+			"node-role":                    `"seed"`,
+			"api.enable":                   "false",
+			"rosetta.enable":               "false",
+			"grpc.enable":                  "false",
+			"grpc-web.enable":              "false",
+			"state-sync.snapshot-interval": "0",
+		},
+	},
+}
+
+// PresetNames returns the names of the presets.
+func PresetNames() []string {
+	names := make([]string, len(presets))
+	for i, p := range presets {
+		names[i] = p.Name
+	}
+
+	return names
+}
+
+// GetPreset returns the preset of the given name.
+func GetPreset(name string) (Preset, error) {
+	for _, p := range presets {
+		if p.Name == name {
+			return p, nil
+		}
+	}
+
+	return Preset{}, fmt.Errorf("unknown node preset %q, expected one of %s", name, strings.Join(PresetNames(), ", "))
+}
+
+// Apply applies the preset to the Tendermint configuration, to be written by
+// the caller, and to the app.toml file of the given path. The settings of the
+// file are replaced in place, keeping its comments and the sections of the
+// application, e.g. the ones of a custom template.
+func (p Preset) Apply(tmConfig *tmcfg.Config, appConfigPath string) error {
+	bz, err := os.ReadFile(appConfigPath)
+	if err != nil {
+		return fmt.Errorf("failed to read the application config: %w", err)
+	}
+	bz, err = setTOMLValues(bz, p.App)
+	if err != nil {
+		return fmt.Errorf("failed to apply the %s preset to %s: %w", p.Name, appConfigPath, err)
+	}
+	if err := os.WriteFile(appConfigPath, bz, 0644); err != nil {
+		return err
+	}
+
+	p.Tendermint(tmConfig)
+
+	return nil
+}
+
+// tomlTableRegexp matches the table headers of a TOML file, unlike the lines
+// of the multi-line arrays, e.g. the ones of telemetry.global-labels.
+var tomlTableRegexp = regexp.MustCompile(`^\[([A-Za-z0-9_.-]+)\]\s*(#.*)?$`)
+
+// setTOMLValues replaces the values of the given settings of a TOML file,
+// keyed by their keys prefixed with their section, by the given TOML encoded
+// values. The settings must be on a single line.
+func setTOMLValues(bz []byte, values map[string]string) ([]byte, error) {
+	var (
+		out     bytes.Buffer
+		section string
+		found   = make(map[string]bool, len(values))
+	)
+
+	scanner := bufio.NewScanner(bytes.NewReader(bz))
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+
+		if m := tomlTableRegexp.FindStringSubmatch(trimmed); m != nil {
+			section = m[1]
+		} else if i := strings.Index(trimmed, "="); i > 0 && !strings.HasPrefix(trimmed, "#") {
+			key := strings.TrimSpace(trimmed[:i])
+			if section != "" {
+				key = section + "." + key
+			}
+			if value, ok := values[key]; ok {
+				line = fmt.Sprintf("%s = %s", strings.TrimSpace(trimmed[:i]), value)
+				found[key] = true
+			}
+		}
+
+		out.WriteString(line)
+		out.WriteByte('\n')
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	var missing []string
+	for key := range values {
+		if !found[key] {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("settings %s not found", strings.Join(missing, ", "))
+	}
+
+	return out.Bytes(), nil
+}
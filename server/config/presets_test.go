@@ -0,0 +1,153 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	tmcfg "github.com/tendermint/tendermint/config"
+)
+
+// writeTestAppConfig writes the default app.toml, with global labels and a
+// custom section, and returns its path.
+func writeTestAppConfig(t *testing.T) string {
+	cfg := DefaultConfig()
+	cfg.MinGasPrices = "1stake"
+	cfg.Telemetry.GlobalLabels = [][]string{{"chain_id", "test"}}
+
+	path := filepath.Join(t.TempDir(), "app.toml")
+	WriteConfigFile(path, cfg)
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	require.NoError(t, err)
+	_, err = f.WriteString("\n[wasm]\n# The number of cached contracts\nlru_size = 0\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	return path
+}
+
+func readTestAppConfig(t *testing.T, path string) (*viper.Viper, Config) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	require.NoError(t, v.ReadInConfig())
+
+	return v, GetConfig(v)
+}
+
+func dangers(findings []Finding) []string {
+	var messages []string
+	for _, f := range findings {
+		if f.Severity == SeverityDanger {
+			messages = append(messages, f.Message)
+		}
+	}
+
+	return messages
+}
+
+func TestPresets(t *testing.T) {
+	for _, name := range PresetNames() {
+		t.Run(name, func(t *testing.T) {
+			preset, err := GetPreset(name)
+			require.NoError(t, err)
+
+			path := writeTestAppConfig(t)
+			tmConfig := tmcfg.DefaultConfig()
+			require.NoError(t, preset.Apply(tmConfig, path))
+
+			v, cfg := readTestAppConfig(t, path)
+			require.Equal(t, name, cfg.NodeRole)
+			require.NoError(t, cfg.ValidateBasic())
+			// the other settings, and the custom sections, are kept
+			require.Equal(t, "1stake", cfg.MinGasPrices)
+			require.Equal(t, [][]string{{"chain_id", "test"}}, cfg.Telemetry.GlobalLabels)
+			require.Equal(t, int64(0), v.GetInt64("wasm.lru_size"))
+
+			// a node configured by its preset is not dangerous
+			require.Empty(t, dangers(Diagnose(tmConfig, cfg)))
+		})
+	}
+
+	path := writeTestAppConfig(t)
+	tmConfig := tmcfg.DefaultConfig()
+	preset, err := GetPreset(RoleArchive)
+	require.NoError(t, err)
+	require.NoError(t, preset.Apply(tmConfig, path))
+	_, cfg := readTestAppConfig(t, path)
+	require.Equal(t, "nothing", cfg.Pruning)
+	require.True(t, cfg.API.Enable)
+	require.Equal(t, uint64(1000), cfg.StateSync.SnapshotInterval)
+	require.Equal(t, "kv", tmConfig.TxIndex.Indexer)
+
+	_, err = GetPreset("unknown")
+	require.Error(t, err)
+
+	// the settings of the preset must all be found
+	require.NoError(t, os.WriteFile(path, []byte("pruning = \"default\"\n"), 0644))
+	require.Error(t, preset.Apply(tmcfg.DefaultConfig(), path))
+}
+
+func TestDiagnose(t *testing.T) {
+	newConfigs := func(role string) (*tmcfg.Config, Config) {
+		preset, err := GetPreset(role)
+		require.NoError(t, err)
+		path := writeTestAppConfig(t)
+		tmConfig := tmcfg.DefaultConfig()
+		require.NoError(t, preset.Apply(tmConfig, path))
+		_, cfg := readTestAppConfig(t, path)
+		return tmConfig, cfg
+	}
+
+	testCases := []struct {
+		name     string
+		role     string
+		malleate func(*tmcfg.Config, *Config)
+		dangers  int
+	}{
+		{"validator", RoleValidator, func(*tmcfg.Config, *Config) {}, 0},
+		{"validator with public RPC", RoleValidator, func(c *tmcfg.Config, _ *Config) { c.RPC.ListenAddress = "tcp://0.0.0.0:26657" }, 1},
+		{"validator with unsafe public RPC", RoleValidator, func(c *tmcfg.Config, _ *Config) {
+			c.RPC.ListenAddress = "tcp://10.0.0.1:26657"
+			c.RPC.Unsafe = true
+		}, 2},
+		{"validator with public API", RoleValidator, func(_ *tmcfg.Config, c *Config) { c.API.Enable = true }, 1},
+		{"validator with disabled public API", RoleValidator, func(_ *tmcfg.Config, c *Config) { c.API.Address = "tcp://0.0.0.0:1317" }, 0},
+		{"validator with public gRPC", RoleValidator, func(_ *tmcfg.Config, c *Config) { c.GRPC.Address = ":9090" }, 1},
+		{"validator in seed mode", RoleValidator, func(c *tmcfg.Config, _ *Config) { c.P2P.SeedMode = true }, 2},
+		{"validator with unix socket RPC", RoleValidator, func(c *tmcfg.Config, _ *Config) { c.RPC.ListenAddress = "unix:///tmp/rpc.sock" }, 0},
+		{"sentry with public pprof", RoleSentry, func(c *tmcfg.Config, _ *Config) { c.RPC.PprofListenAddress = "0.0.0.0:6060" }, 1},
+		{"sentry with local pprof", RoleSentry, func(c *tmcfg.Config, _ *Config) { c.RPC.PprofListenAddress = "localhost:6060" }, 0},
+		{"archive", RoleArchive, func(*tmcfg.Config, *Config) {}, 0},
+		{"pruning archive", RoleArchive, func(_ *tmcfg.Config, c *Config) {
+			c.Pruning = "default"
+			c.MinRetainBlocks = 100
+		}, 2},
+		{"seed without seed mode", RoleSeed, func(c *tmcfg.Config, _ *Config) { c.P2P.SeedMode = false }, 1},
+		{"seed without pex", RoleSeed, func(c *tmcfg.Config, _ *Config) { c.P2P.PexReactor = false }, 2},
+		{"no min gas prices", RoleSeed, func(_ *tmcfg.Config, c *Config) { c.MinGasPrices = "" }, 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tmConfig, cfg := newConfigs(tc.role)
+			tc.malleate(tmConfig, &cfg)
+			require.Len(t, dangers(Diagnose(tmConfig, cfg)), tc.dangers)
+		})
+	}
+
+	// without a role, only the common checks are made
+	tmConfig, cfg := newConfigs(RoleValidator)
+	cfg.NodeRole = ""
+	tmConfig.RPC.ListenAddress = "tcp://0.0.0.0:26657"
+	require.Empty(t, Diagnose(tmConfig, cfg))
+	tmConfig.RPC.Unsafe = true
+	require.Len(t, dangers(Diagnose(tmConfig, cfg)), 1)
+
+	// the warnings flag the unusual settings
+	tmConfig, cfg = newConfigs(RoleValidator)
+	require.Equal(t, []Finding{{SeverityWarning, "the validator has no persistent peers, e.g. its sentries"}}, Diagnose(tmConfig, cfg))
+	tmConfig.P2P.PersistentPeers = "id@10.0.0.2:26656"
+	require.Empty(t, Diagnose(tmConfig, cfg))
+}
@@ -70,10 +70,69 @@ inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 # ["message.sender", "message.recipient"]
 index-events = {{ .BaseConfig.IndexEvents }}
 
+# EmitTxEvents emits a "cosmos.base.abci.v1beta1.EventTx" event for every
+# delivered transaction, with its fee, gas wanted and used, size in bytes,
+# number of signers and message types.
+emit-tx-events = {{ .BaseConfig.EmitTxEvents }}
+
+# MaxGasWanted is the maximum gas a transaction can want to be accepted in the
+# mempool. A value of 0 disables the limit.
+max-gas-wanted = {{ .BaseConfig.MaxGasWanted }}
+
+# MaxFeeMultiple is the maximum multiple of the fee required by the minimum gas
+# prices a transaction can pay to be accepted in the mempool, protecting the
+# users from absurd fees. A value of 0 disables the limit.
+max-fee-multiple = {{ .BaseConfig.MaxFeeMultiple }}
+
+# TxPriorityFeeTiers are the fee tiers of the priority assigned by CheckTx to
+# the transactions, which orders the blocks proposed by the node: each tier is a
+# list of gas prices, and a transaction paying at least one of the prices of the
+# i-th tier, listed from the lowest to the highest, has the priority i+1.
+# Tendermint v0.34 does not call the application while building blocks, so the
+# priority takes effect with an ABCI++ consensus engine only.
+#
+# Example:
+# ["0.01stake", "0.1stake,0.001atom"]
+tx-priority-fee-tiers = [{{ range $i, $t := .BaseConfig.TxPriorityFeeTiers }}{{ if $i }}, {{ end }}"{{ $t }}"{{ end }}]
+
+# TxPriorityMsgs are the type URLs of the messages, e.g. governance votes or
+# oracle prices, whose transactions have a priority above all the fee tiers, so
+# that they are proposed first during congestion.
+#
+# Example:
+# ["/cosmos.gov.v1beta1.MsgVote"]
+tx-priority-msgs = [{{ range $i, $m := .BaseConfig.TxPriorityMsgs }}{{ if $i }}, {{ end }}"{{ $m }}"{{ end }}]
+
 # IavlCacheSize set the size of the iavl tree cache. 
 # Default cache size is 50mb.
 iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 
+# QueryOnly runs the node in query-only mode, e.g. for dedicated query nodes
+# behind a load balancer: transactions are rejected in CheckTx, so neither
+# accepted in the mempool nor relayed, and on the gRPC and REST broadcast
+# endpoints, while queries and state sync snapshots are served.
+query-only = {{ .BaseConfig.QueryOnly }}
+
+# ShutdownTimeout defines the time (in seconds) given to the in-flight gRPC and
+# REST requests to complete on SIGINT or SIGTERM. New requests are refused as
+# soon as the shutdown starts; the remaining connections are closed after the
+# timeout, before Tendermint is stopped and the databases are closed. A value
+# of 0 closes the connections immediately.
+shutdown-timeout = {{ .BaseConfig.ShutdownTimeout }}
+
+# Bech32Prefix defines the bech32 account address prefix of the network, from
+# which the validator and consensus prefixes are derived (e.g. cosmos,
+# cosmosvaloper, cosmosvalcons), letting a single binary serve several
+# networks. If empty, the prefixes of the binary are used. The addresses stored
+# on-chain are checked against the prefix at startup.
+bech32-prefix = "{{ .BaseConfig.Bech32Prefix }}"
+
+# NodeRole defines the role of the node, one of validator, sentry, archive or
+# seed, as written by "init --preset". The "config doctor" command checks the
+# configuration against the role, e.g. that a validator does not expose its
+# RPC. If empty, only the checks common to all the roles are made.
+node-role = "{{ .BaseConfig.NodeRole }}"
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
@@ -139,6 +198,22 @@ rpc-max-body-bytes = {{ .API.RPCMaxBodyBytes }}
 # EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk).
 enabled-unsafe-cors = {{ .API.EnableUnsafeCORS }}
 
+# MaxConcurrentQueries defines the maximum number of query requests handled
+# concurrently (0 disables the limit).
+max-concurrent-queries = {{ .API.MaxConcurrentQueries }}
+
+# MaxConcurrentBroadcasts defines the maximum number of transaction broadcast
+# requests handled concurrently (0 disables the limit).
+max-concurrent-broadcasts = {{ .API.MaxConcurrentBroadcasts }}
+
+# MaxQueuedRequests defines the maximum number of requests waiting for a free
+# slot once a concurrency limit is reached. Further requests are rejected.
+max-queued-requests = {{ .API.MaxQueuedRequests }}
+
+# QueueTimeout defines the maximum time (in seconds) a request waits for a free
+# slot before being rejected (0 waits for as long as the client does).
+queue-timeout = {{ .API.QueueTimeout }}
+
 ###############################################################################
 ###                           Rosetta Configuration                         ###
 ###############################################################################
@@ -175,6 +250,22 @@ enable = {{ .GRPC.Enable }}
 # Address defines the gRPC server address to bind to.
 address = "{{ .GRPC.Address }}"
 
+# MaxConcurrentQueries defines the maximum number of query requests handled
+# concurrently (0 disables the limit).
+max-concurrent-queries = {{ .GRPC.MaxConcurrentQueries }}
+
+# MaxConcurrentBroadcasts defines the maximum number of transaction broadcast
+# requests handled concurrently (0 disables the limit).
+max-concurrent-broadcasts = {{ .GRPC.MaxConcurrentBroadcasts }}
+
+# MaxQueuedRequests defines the maximum number of requests waiting for a free
+# slot once a concurrency limit is reached. Further requests are rejected.
+max-queued-requests = {{ .GRPC.MaxQueuedRequests }}
+
+# QueueTimeout defines the maximum time (in seconds) a request waits for a free
+# slot before being rejected (0 waits for as long as the client does).
+queue-timeout = {{ .GRPC.QueueTimeout }}
+
 ###############################################################################
 ###                        gRPC Web Configuration                           ###
 ###############################################################################
@@ -205,6 +296,26 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
+###############################################################################
+###                        Query Filter Configuration                       ###
+###############################################################################
+
+# The query filter restricts the gRPC services and methods exposed by the gRPC,
+# gRPC-web and REST servers. Requests to the methods not exposed are rejected
+# with a PermissionDenied gRPC status, or a 403 Forbidden HTTP status. Patterns
+# are full methods, e.g. "/cosmos.bank.v1beta1.Query/Balance", services, e.g.
+# "cosmos.bank.v1beta1.Query", or prefixes ending with a wildcard, e.g.
+# "cosmos.bank.*". The legacy REST routes are not filtered.
+[query-filter]
+
+# allowed defines the services and methods exposed, all of them if empty. Note
+# that the transaction service, e.g. "cosmos.tx.v1beta1.Service", and the
+# reflection services must be allowed explicitly to remain available.
+allowed = [{{ range $i, $p := .QueryFilter.Allowed }}{{ if $i }}, {{ end }}"{{ $p }}"{{ end }}]
+
+# denied defines the services and methods not exposed, taking precedence over allowed.
+denied = [{{ range $i, $p := .QueryFilter.Denied }}{{ if $i }}, {{ end }}"{{ $p }}"{{ end }}]
 `
 
 var configTemplate *template.Template
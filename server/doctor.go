@@ -0,0 +1,49 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+// ConfigDoctorCmd returns a command checking the config.toml and app.toml
+// files of the node home for dangerous combinations of settings.
+func ConfigDoctorCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "doctor",
+		Args:  cobra.NoArgs,
+		Short: "Check the node configuration for dangerous combinations of settings",
+		Long: `Check the config.toml and app.toml files of the node home for dangerous
+combinations of settings, e.g. unsafe RPC commands on a public address, taking
+into account the role of the node set by the node-role setting of app.toml, as
+written by init --preset: a validator exposing its RPC, API or gRPC server to
+other hosts, an archive pruning its state or blocks, a seed not running in seed
+mode, etc.
+
+The findings are either dangers or warnings. The command exits with an error if
+any danger is found.
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			findings := config.Diagnose(serverCtx.Config, config.GetConfig(serverCtx.Viper))
+
+			dangers := 0
+			for _, f := range findings {
+				fmt.Fprintln(cmd.OutOrStdout(), f)
+				if f.Severity == config.SeverityDanger {
+					dangers++
+				}
+			}
+			if len(findings) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "no issue found")
+			}
+			if dangers > 0 {
+				return fmt.Errorf("%d dangerous settings found", dangers)
+			}
+
+			return nil
+		},
+	}
+}
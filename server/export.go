@@ -3,9 +3,13 @@ package server
 // DONTCOVER
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"sort"
 
 	"github.com/spf13/cobra"
 	tmjson "github.com/tendermint/tendermint/libs/json"
@@ -13,6 +17,7 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/parquet"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -21,17 +26,41 @@ const (
 	FlagHeight           = "height"
 	FlagForZeroHeight    = "for-zero-height"
 	FlagJailAllowedAddrs = "jail-allowed-addrs"
+	FlagFormat           = "format"
+	FlagModules          = "modules"
+	FlagOutputDir        = "output-dir"
 )
 
-// ExportCmd dumps app state to JSON.
-func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Command {
+// Export formats.
+const (
+	ExportFormatJSON    = "json"
+	ExportFormatParquet = "parquet"
+)
+
+// ExportCmd dumps app state to JSON, or the state of the modules exporting
+// tables to Parquet files.
+func ExportCmd(appExporter types.AppExporter, tableExporter types.TableExporter, defaultNodeHome string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "export",
-		Short: "Export state to JSON",
+		Short: "Export state to JSON or Parquet",
+		Long: `Export state to JSON, or to Parquet with --format parquet.
+
+The Parquet export writes a columnar dump of the decoded state of the modules
+exporting tables, e.g. the bank balances or the staking delegations, for the
+ingestion of the state by analytics warehouses. Each table is written to the
+<module>_<table>.parquet file of the output directory.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			serverCtx := GetServerContextFromCmd(cmd)
 			config := serverCtx.Config
 
+			format, _ := cmd.Flags().GetString(FlagFormat)
+			if format != ExportFormatJSON && format != ExportFormatParquet {
+				return fmt.Errorf("unknown export format %s", format)
+			}
+			if format == ExportFormatParquet && tableExporter == nil {
+				return errors.New("the app does not export tables")
+			}
+
 			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
 			config.SetRoot(homeDir)
 
@@ -73,6 +102,12 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 				return fmt.Errorf("error exporting state: %v", err)
 			}
 
+			if format == ExportFormatParquet {
+				modules, _ := cmd.Flags().GetStringSlice(FlagModules)
+				outputDir, _ := cmd.Flags().GetString(FlagOutputDir)
+				return exportParquet(cmd, tableExporter, exported.AppState, modules, outputDir)
+			}
+
 			doc, err := tmtypes.GenesisDocFromFile(serverCtx.Config.GenesisFile())
 			if err != nil {
 				return err
@@ -114,6 +149,55 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 	cmd.Flags().Int64(FlagHeight, -1, "Export state from a particular height (-1 means latest height)")
 	cmd.Flags().Bool(FlagForZeroHeight, false, "Export state to start at height zero (perform preproccessing)")
 	cmd.Flags().StringSlice(FlagJailAllowedAddrs, []string{}, "Comma-separated list of operator addresses of jailed validators to unjail")
+	cmd.Flags().String(FlagFormat, ExportFormatJSON, "Export format (json|parquet)")
+	cmd.Flags().StringSlice(FlagModules, []string{}, "Comma-separated list of the modules to export to Parquet (default all the modules exporting tables)")
+	cmd.Flags().String(FlagOutputDir, ".", "Directory of the Parquet files")
 
 	return cmd
 }
+
+// exportParquet writes the tables of the given modules of the exported app
+// state to Parquet files in outputDir.
+func exportParquet(cmd *cobra.Command, tableExporter types.TableExporter, appState json.RawMessage, modules []string, outputDir string) error {
+	tables, err := tableExporter(appState, modules)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(outputDir, 0o755); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(tables))
+	for name := range tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, table := range tables[name] {
+			path := filepath.Join(outputDir, fmt.Sprintf("%s_%s.parquet", name, table.Name))
+			if err := writeParquetFile(path, table); err != nil {
+				return err
+			}
+
+			cmd.Printf("wrote %d rows to %s\n", len(table.Rows), path)
+		}
+	}
+
+	return nil
+}
+
+func writeParquetFile(path string, table *sdk.Table) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := parquet.Write(f, table); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 )
@@ -56,6 +57,38 @@ func TestExportCmd_ConsensusParams(t *testing.T) {
 	require.Equal(t, simapp.DefaultConsensusParams.Validator.PubKeyTypes, exportedGenDoc.ConsensusParams.Validator.PubKeyTypes)
 }
 
+func TestExportCmd_Parquet(t *testing.T) {
+	tempDir := t.TempDir()
+	outputDir := path.Join(tempDir, "parquet")
+
+	_, ctx, _, cmd := setupApp(t, tempDir)
+
+	output := &bytes.Buffer{}
+	cmd.SetOut(output)
+	cmd.SetArgs([]string{
+		fmt.Sprintf("--%s=%s", flags.FlagHome, tempDir),
+		fmt.Sprintf("--%s=%s", server.FlagFormat, server.ExportFormatParquet),
+		fmt.Sprintf("--%s=bank,staking", server.FlagModules),
+		fmt.Sprintf("--%s=%s", server.FlagOutputDir, outputDir),
+	})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	for _, name := range []string{"bank_balances", "bank_supply", "staking_validators", "staking_delegations", "staking_unbonding_delegations"} {
+		bz, err := os.ReadFile(path.Join(outputDir, name+".parquet"))
+		require.NoError(t, err, name)
+		require.Equal(t, "PAR1", string(bz[:4]), name)
+		require.Contains(t, output.String(), name+".parquet")
+	}
+
+	_, ctx, _, cmd = setupApp(t, t.TempDir())
+	cmd.SetArgs([]string{fmt.Sprintf("--%s=%s", server.FlagFormat, server.ExportFormatParquet), fmt.Sprintf("--%s=auth", server.FlagModules)})
+	require.EqualError(t, cmd.ExecuteContext(ctx), "module auth does not export tables")
+
+	_, ctx, _, cmd = setupApp(t, t.TempDir())
+	cmd.SetArgs([]string{fmt.Sprintf("--%s=csv", server.FlagFormat)})
+	require.EqualError(t, cmd.ExecuteContext(ctx), "unknown export format csv")
+}
+
 func TestExportCmd_HomeDir(t *testing.T) {
 	_, ctx, _, cmd := setupApp(t, t.TempDir())
 
@@ -164,6 +197,13 @@ func setupApp(t *testing.T, tempDir string) (*simapp.SimApp, context.Context, *t
 			}
 
 			return simApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs)
+		}, func(appState json.RawMessage, modules []string) (map[string][]*sdk.Table, error) {
+			var genesis map[string]json.RawMessage
+			if err := json.Unmarshal(appState, &genesis); err != nil {
+				return nil, err
+			}
+
+			return simapp.ModuleBasics.ExportTables(simapp.MakeTestEncodingConfig().Marshaler, genesis, modules)
 		}, tempDir)
 
 	ctx := context.Background()
@@ -0,0 +1,100 @@
+// Package filter implements the allow and deny lists restricting the gRPC
+// services and methods exposed by the node's public gRPC, gRPC-web and REST
+// endpoints, e.g. to hide expensive or operational queries from public nodes.
+package filter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Metric keys emitted when a request is denied by a QueryFilter. The counter is
+// labeled with the full gRPC method and the transport of the request.
+var MetricKeysDenied = []string{"query_filter", "denied"}
+
+// QueryFilter decides which gRPC methods are exposed. A method is exposed if it
+// matches one of the allowed patterns, or if there are none, and matches none
+// of the denied patterns. A nil *QueryFilter exposes all methods, which allows
+// callers to unconditionally wrap handlers.
+//
+// A pattern is either a full method, e.g. "/cosmos.bank.v1beta1.Query/Balance",
+// a service, e.g. "cosmos.bank.v1beta1.Query", or a prefix ending with a
+// wildcard, e.g. "cosmos.bank.*". The leading slash of methods is optional.
+type QueryFilter struct {
+	allowed []string
+	denied  []string
+}
+
+// NewQueryFilter returns a QueryFilter from the given allowed and denied
+// patterns. If both are empty, nil is returned, i.e. all methods are exposed.
+func NewQueryFilter(allowed, denied []string) (*QueryFilter, error) {
+	if len(allowed) == 0 && len(denied) == 0 {
+		return nil, nil
+	}
+
+	f := &QueryFilter{}
+	for _, p := range allowed {
+		if err := validatePattern(p); err != nil {
+			return nil, fmt.Errorf("invalid allowed query pattern: %w", err)
+		}
+		f.allowed = append(f.allowed, strings.TrimPrefix(p, "/"))
+	}
+	for _, p := range denied {
+		if err := validatePattern(p); err != nil {
+			return nil, fmt.Errorf("invalid denied query pattern: %w", err)
+		}
+		f.denied = append(f.denied, strings.TrimPrefix(p, "/"))
+	}
+
+	return f, nil
+}
+
+// Allows returns true if the given full gRPC method, e.g.
+// "/cosmos.bank.v1beta1.Query/Balance", is exposed.
+func (f *QueryFilter) Allows(fullMethod string) bool {
+	if f == nil {
+		return true
+	}
+
+	method := strings.TrimPrefix(fullMethod, "/")
+	for _, p := range f.denied {
+		if matchPattern(p, method) {
+			return false
+		}
+	}
+	if len(f.allowed) == 0 {
+		return true
+	}
+	for _, p := range f.allowed {
+		if matchPattern(p, method) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validatePattern(p string) error {
+	switch {
+	case strings.TrimPrefix(p, "/") == "":
+		return fmt.Errorf("empty pattern %q", p)
+	case strings.ContainsAny(p, " \t\n"):
+		return fmt.Errorf("pattern %q contains whitespace", p)
+	case strings.Contains(strings.TrimSuffix(p, "*"), "*"):
+		return fmt.Errorf("pattern %q may only end with a wildcard", p)
+	case strings.Count(p, "/") > 2 || (strings.Count(p, "/") == 2 && !strings.HasPrefix(p, "/")):
+		return fmt.Errorf("pattern %q is neither a service nor a method", p)
+	}
+
+	return nil
+}
+
+// matchPattern reports whether the method, stripped of its leading slash,
+// matches the pattern.
+func matchPattern(pattern, method string) bool {
+	if prefix := strings.TrimSuffix(pattern, "*"); prefix != pattern {
+		return strings.HasPrefix(method, prefix)
+	}
+
+	return method == pattern || strings.HasPrefix(method, pattern+"/")
+}
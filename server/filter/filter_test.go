@@ -0,0 +1,135 @@
+package filter_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/server/filter"
+	_ "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var bankQueryService = &grpc.ServiceDesc{
+	ServiceName: "cosmos.bank.v1beta1.Query",
+	Metadata:    "cosmos/bank/v1beta1/query.proto",
+}
+
+func TestNilQueryFilterAllowsAll(t *testing.T) {
+	f, err := filter.NewQueryFilter(nil, []string{})
+	require.NoError(t, err)
+	require.Nil(t, f)
+	require.True(t, f.Allows("/cosmos.bank.v1beta1.Query/Balance"))
+}
+
+func TestNewQueryFilterInvalidPatterns(t *testing.T) {
+	for _, p := range []string{"", "/", "cosmos.*.Query", "cosmos bank", "/cosmos.bank.v1beta1.Query/Balance/x", "a/b/c"} {
+		_, err := filter.NewQueryFilter([]string{p}, nil)
+		require.Error(t, err, p)
+		_, err = filter.NewQueryFilter(nil, []string{p})
+		require.Error(t, err, p)
+	}
+}
+
+func TestQueryFilterAllows(t *testing.T) {
+	f, err := filter.NewQueryFilter(
+		[]string{"cosmos.bank.*", "/cosmos.tx.v1beta1.Service/GetTx", "cosmos.auth.v1beta1.Query"},
+		[]string{"/cosmos.bank.v1beta1.Query/DenomsMetadata", "cosmos.auth.v1beta1.Query/Accounts"},
+	)
+	require.NoError(t, err)
+
+	testCases := map[string]bool{
+		"/cosmos.bank.v1beta1.Query/Balance":        true,
+		"/cosmos.bank.v1beta1.Query/DenomsMetadata": false,
+		"/cosmos.bank.v1beta1.Query/DenomMetadata":  true,
+		"/cosmos.tx.v1beta1.Service/GetTx":          true,
+		"/cosmos.tx.v1beta1.Service/GetTxsEvent":    false,
+		"/cosmos.auth.v1beta1.Query/Account":        true,
+		"/cosmos.auth.v1beta1.Query/Accounts":       false,
+		"/cosmos.auth.v1beta1.QueryExtra/Account":   false,
+		"/cosmos.staking.v1beta1.Query/Validators":  false,
+	}
+	for method, allowed := range testCases {
+		require.Equal(t, allowed, f.Allows(method), method)
+	}
+
+	f, err = filter.NewQueryFilter(nil, []string{"cosmos.staking.v1beta1.Query"})
+	require.NoError(t, err)
+	require.True(t, f.Allows("/cosmos.bank.v1beta1.Query/Balance"))
+	require.False(t, f.Allows("/cosmos.staking.v1beta1.Query/Validators"))
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	f, err := filter.NewQueryFilter(nil, []string{"cosmos.bank.v1beta1.Query/Balance"})
+	require.NoError(t, err)
+	interceptor := filter.UnaryServerInterceptor(f)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+	_, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/cosmos.bank.v1beta1.Query/Balance"}, handler)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	res, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/cosmos.bank.v1beta1.Query/AllBalances"}, handler)
+	require.NoError(t, err)
+	require.Equal(t, "ok", res)
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	f, err := filter.NewQueryFilter([]string{"cosmos.bank.v1beta1.Query"}, nil)
+	require.NoError(t, err)
+	interceptor := filter.StreamServerInterceptor(f)
+	handler := func(srv interface{}, stream grpc.ServerStream) error { return nil }
+
+	err = interceptor(nil, nil, &grpc.StreamServerInfo{FullMethod: "/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo"}, handler)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestRoutesMethod(t *testing.T) {
+	routes, err := filter.NewRoutes([]*grpc.ServiceDesc{bankQueryService})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		httpMethod string
+		path       string
+		method     string
+	}{
+		{http.MethodGet, "/cosmos/bank/v1beta1/balances/cosmos1addr", "/cosmos.bank.v1beta1.Query/AllBalances"},
+		{http.MethodGet, "/cosmos/bank/v1beta1/balances/cosmos1addr/by_denom", "/cosmos.bank.v1beta1.Query/Balance"},
+		{http.MethodGet, "/cosmos/bank/v1beta1/denoms_metadata", "/cosmos.bank.v1beta1.Query/DenomsMetadata"},
+		{http.MethodGet, "/cosmos/bank/v1beta1/denoms_metadata/stake", "/cosmos.bank.v1beta1.Query/DenomMetadata"},
+		{http.MethodGet, "/cosmos/bank/v1beta1/balances", ""},
+		{http.MethodPost, "/cosmos/bank/v1beta1/balances/cosmos1addr", ""},
+		{http.MethodGet, "/cosmos/staking/v1beta1/validators", ""},
+	}
+	for _, tc := range testCases {
+		method, ok := routes.Method(httptest.NewRequest(tc.httpMethod, tc.path, nil))
+		require.Equal(t, tc.method != "", ok, tc.path)
+		require.Equal(t, tc.method, method, tc.path)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	routes, err := filter.NewRoutes([]*grpc.ServiceDesc{bankQueryService})
+	require.NoError(t, err)
+	f, err := filter.NewQueryFilter(nil, []string{"/cosmos.bank.v1beta1.Query/DenomsMetadata"})
+	require.NoError(t, err)
+
+	h := filter.Middleware(f, routes)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/cosmos/bank/v1beta1/denoms_metadata", nil))
+	require.Equal(t, http.StatusForbidden, rec.Code)
+	require.Contains(t, rec.Body.String(), "DenomsMetadata")
+
+	for _, path := range []string{"/cosmos/bank/v1beta1/denoms_metadata/stake", "/bank/balances/cosmos1addr"} {
+		rec = httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		require.Equal(t, http.StatusOK, rec.Code, path)
+	}
+}
@@ -0,0 +1,83 @@
+package filter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	metrics "github.com/armon/go-metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+// Transports labeling the denied requests metric.
+const (
+	TransportGRPC = "grpc"
+	TransportREST = "rest"
+)
+
+// UnaryServerInterceptor returns a gRPC interceptor rejecting the requests to
+// the methods not exposed by the filter with a PermissionDenied status.
+func UnaryServerInterceptor(f *QueryFilter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !f.Allows(info.FullMethod) {
+			return nil, deniedStatus(info.FullMethod)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns a gRPC interceptor rejecting the streams of
+// the methods not exposed by the filter with a PermissionDenied status, e.g.
+// the reflection services.
+func StreamServerInterceptor(f *QueryFilter) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !f.Allows(info.FullMethod) {
+			return deniedStatus(info.FullMethod)
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+// Middleware returns an HTTP middleware rejecting the REST requests to the
+// gRPC gateway routes of the methods not exposed by the filter with a 403
+// Forbidden status. Requests matching none of the routes are passed through.
+func Middleware(f *QueryFilter, routes *Routes) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if f == nil {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if method, ok := routes.Method(r); ok && !f.Allows(method) {
+				incrDenied(method, TransportREST)
+				rest.WriteErrorResponse(w, http.StatusForbidden, deniedMessage(method))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func deniedStatus(method string) error {
+	incrDenied(method, TransportGRPC)
+	return status.Error(codes.PermissionDenied, deniedMessage(method))
+}
+
+func deniedMessage(method string) string {
+	return fmt.Sprintf("query %s is not exposed by this node", method)
+}
+
+func incrDenied(method, transport string) {
+	telemetry.IncrCounterWithLabels(MetricKeysDenied, 1, []metrics.Label{
+		telemetry.NewLabel("method", method),
+		telemetry.NewLabel("transport", transport),
+	})
+}
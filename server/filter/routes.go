@@ -0,0 +1,188 @@
+package filter
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	// nolint: staticcheck
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/server/grpc/gogoreflection"
+)
+
+var pathParamPattern = regexp.MustCompile(`{([^}=]+)(=([^}]*))?}`)
+
+// Routes maps the REST requests of the gRPC gateway to the gRPC methods they
+// are served by, from the google.api.http annotations of the methods. A nil
+// *Routes maps no request.
+type Routes struct {
+	routes []route
+}
+
+type route struct {
+	httpMethod string
+	path       *regexp.Regexp
+	// literals is the number of literal characters of the path template, used
+	// to pick the most specific of the routes matching a request.
+	literals   int
+	fullMethod string
+}
+
+// NewRoutes returns the gRPC gateway routes of the given gRPC services, as
+// registered by the app.
+func NewRoutes(services []*grpc.ServiceDesc) (*Routes, error) {
+	files := make([]string, 0, len(services))
+	names := make(map[string]bool, len(services))
+	for _, sd := range services {
+		if file, ok := sd.Metadata.(string); ok {
+			files = append(files, file)
+		}
+		names[sd.ServiceName] = true
+	}
+
+	set, err := gogoreflection.FileDescriptorSet(files...)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Routes{}
+	for _, fd := range set.File {
+		for _, sd := range fd.Service {
+			name := sd.GetName()
+			if fd.GetPackage() != "" {
+				name = fd.GetPackage() + "." + name
+			}
+			if !names[name] {
+				continue
+			}
+
+			for _, md := range sd.Method {
+				if md.Options == nil {
+					continue
+				}
+
+				ext, err := proto.GetExtension(md.Options, annotations.E_Http)
+				if err != nil {
+					continue
+				}
+				rule, ok := ext.(*annotations.HttpRule)
+				if !ok {
+					continue
+				}
+
+				fullMethod := fmt.Sprintf("/%s/%s", name, md.GetName())
+				for _, binding := range append([]*annotations.HttpRule{rule}, rule.AdditionalBindings...) {
+					if err := r.add(fullMethod, binding); err != nil {
+						return nil, fmt.Errorf("%s: %w", fullMethod, err)
+					}
+				}
+			}
+		}
+	}
+
+	return r, nil
+}
+
+// Method returns the full gRPC method serving the given REST request, if any.
+func (r *Routes) Method(req *http.Request) (string, bool) {
+	if r == nil {
+		return "", false
+	}
+
+	var match *route
+	for i, rt := range r.routes {
+		if rt.httpMethod != req.Method || !rt.path.MatchString(req.URL.Path) {
+			continue
+		}
+		if match == nil || rt.literals > match.literals {
+			match = &r.routes[i]
+		}
+	}
+	if match == nil {
+		return "", false
+	}
+
+	return match.fullMethod, true
+}
+
+func (r *Routes) add(fullMethod string, rule *annotations.HttpRule) error {
+	var method, template string
+	switch pattern := rule.Pattern.(type) {
+	case *annotations.HttpRule_Get:
+		method, template = http.MethodGet, pattern.Get
+	case *annotations.HttpRule_Put:
+		method, template = http.MethodPut, pattern.Put
+	case *annotations.HttpRule_Post:
+		method, template = http.MethodPost, pattern.Post
+	case *annotations.HttpRule_Delete:
+		method, template = http.MethodDelete, pattern.Delete
+	case *annotations.HttpRule_Patch:
+		method, template = http.MethodPatch, pattern.Patch
+	default:
+		return nil
+	}
+
+	path, literals := templateRegexp(template)
+	re, err := regexp.Compile(path)
+	if err != nil {
+		return fmt.Errorf("invalid path template %q: %w", template, err)
+	}
+
+	r.routes = append(r.routes, route{
+		httpMethod: method,
+		path:       re,
+		literals:   literals,
+		fullMethod: fullMethod,
+	})
+
+	return nil
+}
+
+// templateRegexp converts a path template, e.g. "/blocks/{height}" or
+// "/params/{name=**}", to a regular expression matching the request paths, and
+// returns the number of literal characters of the template.
+func templateRegexp(template string) (string, int) {
+	var (
+		b        strings.Builder
+		literals int
+		last     int
+	)
+
+	b.WriteString("^")
+	for _, loc := range pathParamPattern.FindAllStringSubmatchIndex(template, -1) {
+		literal := template[last:loc[0]]
+		b.WriteString(regexp.QuoteMeta(literal))
+		literals += len(literal)
+
+		segments := "*"
+		if loc[6] >= 0 {
+			segments = template[loc[6]:loc[7]]
+		}
+		for i, segment := range strings.Split(segments, "/") {
+			if i > 0 {
+				b.WriteString("/")
+			}
+
+			switch segment {
+			case "*":
+				b.WriteString("[^/]+")
+			case "**":
+				b.WriteString(".+")
+			default:
+				b.WriteString(regexp.QuoteMeta(segment))
+				literals += len(segment)
+			}
+		}
+
+		last = loc[1]
+	}
+	b.WriteString(regexp.QuoteMeta(template[last:]))
+	literals += len(template) - last
+	b.WriteString("$")
+
+	return b.String(), literals
+}
@@ -0,0 +1,72 @@
+package gogoreflection
+
+import (
+	"fmt"
+
+	gogoproto "github.com/gogo/protobuf/proto"
+	dpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// FileDescriptorSet returns the descriptors of the given proto files, as
+// registered in either the gogoproto or the golang proto registry, along with
+// the descriptors of all their transitive dependencies. Every file follows its
+// dependencies in the set, so that it can be built in order. Dependencies which
+// aren't registered are left out.
+func FileDescriptorSet(files ...string) (*dpb.FileDescriptorSet, error) {
+	set := &dpb.FileDescriptorSet{}
+	visited := make(map[string]bool)
+
+	var visit func(name string, required bool) error
+	visit = func(name string, required bool) error {
+		if visited[name] {
+			return nil
+		}
+		visited[name] = true
+
+		enc := getFileDescriptor(name)
+		if len(enc) == 0 {
+			if required {
+				return fmt.Errorf("unknown file: %v", name)
+			}
+			return nil
+		}
+
+		fd, err := decodeFileDesc(enc)
+		if err != nil {
+			return err
+		}
+
+		for _, dep := range fd.Dependency {
+			if err := visit(dep, false); err != nil {
+				return err
+			}
+		}
+
+		set.File = append(set.File, fd)
+		return nil
+	}
+
+	for _, file := range files {
+		if err := visit(file, true); err != nil {
+			return nil, err
+		}
+	}
+
+	return set, nil
+}
+
+// MessageFile returns the name of the proto file defining the given message.
+func MessageFile(msg gogoproto.Message) (string, error) {
+	m, ok := msg.(protoMessage)
+	if !ok {
+		return "", fmt.Errorf("no descriptor found for %T", msg)
+	}
+
+	enc, _ := m.Descriptor()
+	fd, err := decodeFileDesc(enc)
+	if err != nil {
+		return "", err
+	}
+
+	return fd.GetName(), nil
+}
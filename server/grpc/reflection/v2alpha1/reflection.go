@@ -3,11 +3,17 @@ package v2alpha1
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/gogo/protobuf/proto"
+	// nolint: staticcheck
+	protov1 "github.com/golang/protobuf/proto"
+	dpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
 	"google.golang.org/grpc"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server/grpc/gogoreflection"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 )
@@ -31,7 +37,8 @@ func Register(srv *grpc.Server, conf Config) error {
 }
 
 type reflectionServiceServer struct {
-	desc *AppDescriptor
+	desc            *AppDescriptor
+	fileDescriptors [][]byte
 }
 
 func (r reflectionServiceServer) GetAuthnDescriptor(_ context.Context, _ *GetAuthnDescriptorRequest) (*GetAuthnDescriptorResponse, error) {
@@ -58,6 +65,14 @@ func (r reflectionServiceServer) GetTxDescriptor(_ context.Context, _ *GetTxDesc
 	return &GetTxDescriptorResponse{Tx: r.desc.Tx}, nil
 }
 
+func (r reflectionServiceServer) GetMsgServicesDescriptor(_ context.Context, _ *GetMsgServicesDescriptorRequest) (*GetMsgServicesDescriptorResponse, error) {
+	return &GetMsgServicesDescriptorResponse{MsgServices: r.desc.MsgServices}, nil
+}
+
+func (r reflectionServiceServer) GetFileDescriptorSet(_ context.Context, _ *GetFileDescriptorSetRequest) (*GetFileDescriptorSetResponse, error) {
+	return &GetFileDescriptorSetResponse{FileDescriptors: r.fileDescriptors}, nil
+}
+
 func newReflectionServiceServer(grpcSrv *grpc.Server, conf Config) (reflectionServiceServer, error) {
 	// set chain descriptor
 	chainDescriptor := &ChainDescriptor{Id: conf.ChainID}
@@ -78,6 +93,19 @@ func newReflectionServiceServer(grpcSrv *grpc.Server, conf Config) (reflectionSe
 		return reflectionServiceServer{}, fmt.Errorf("unable to create deliver descriptor: %w", err)
 	}
 	authnDescriptor := newAuthnDescriptor(conf.SigningModes)
+	// set the file descriptors and msg services descriptor
+	fileDescriptorSet, err := newFileDescriptorSet(grpcSrv, conf.InterfaceRegistry)
+	if err != nil {
+		return reflectionServiceServer{}, fmt.Errorf("unable to create file descriptor set: %w", err)
+	}
+	fileDescriptors := make([][]byte, len(fileDescriptorSet.File))
+	for i, fd := range fileDescriptorSet.File {
+		fileDescriptors[i], err = protov1.Marshal(fd)
+		if err != nil {
+			return reflectionServiceServer{}, fmt.Errorf("unable to marshal file descriptor %s: %w", fd.GetName(), err)
+		}
+	}
+	msgServicesDescriptor := newMsgServicesDescriptor(fileDescriptorSet, conf.InterfaceRegistry)
 	desc := &AppDescriptor{
 		Authn:         authnDescriptor,
 		Chain:         chainDescriptor,
@@ -85,6 +113,7 @@ func newReflectionServiceServer(grpcSrv *grpc.Server, conf Config) (reflectionSe
 		Configuration: configurationDescriptor,
 		QueryServices: queryServiceDescriptor,
 		Tx:            txDescriptor,
+		MsgServices:   msgServicesDescriptor,
 	}
 
 	ifaceList := make([]string, len(desc.Codec.Interfaces))
@@ -98,7 +127,8 @@ func newReflectionServiceServer(grpcSrv *grpc.Server, conf Config) (reflectionSe
 		ifaceImplementers[iface.Fullname] = impls
 	}
 	return reflectionServiceServer{
-		desc: desc,
+		desc:            desc,
+		fileDescriptors: fileDescriptors,
 	}, nil
 }
 
@@ -162,6 +192,86 @@ func newQueryServiceDescriptor(srv *grpc.Server) *QueryServicesDescriptor {
 	return &QueryServicesDescriptor{QueryServices: queryServices}
 }
 
+// newFileDescriptorSet returns the descriptors of the files defining the
+// services of the gRPC server, the transaction type and the implementations of
+// the registered interfaces, along with all their dependencies.
+func newFileDescriptorSet(srv *grpc.Server, ir codectypes.InterfaceRegistry) (*dpb.FileDescriptorSet, error) {
+	files := make(map[string]struct{})
+	for _, info := range srv.GetServiceInfo() {
+		if file, ok := info.Metadata.(string); ok {
+			files[file] = struct{}{}
+		}
+	}
+
+	msgs := []proto.Message{&tx.Tx{}}
+	for _, iface := range ir.ListAllInterfaces() {
+		for _, typeURL := range ir.ListImplementations(iface) {
+			msg, err := ir.Resolve(typeURL)
+			if err != nil {
+				return nil, err
+			}
+			msgs = append(msgs, msg)
+		}
+	}
+	for _, msg := range msgs {
+		file, err := gogoreflection.MessageFile(msg)
+		if err != nil {
+			return nil, err
+		}
+		files[file] = struct{}{}
+	}
+
+	names := make([]string, 0, len(files))
+	for file := range files {
+		names = append(names, file)
+	}
+	sort.Strings(names)
+
+	return gogoreflection.FileDescriptorSet(names...)
+}
+
+// newMsgServicesDescriptor describes the services of the file descriptor set
+// of which every method handles a registered sdk.Msg.
+func newMsgServicesDescriptor(set *dpb.FileDescriptorSet, ir codectypes.InterfaceRegistry) *MsgServicesDescriptor {
+	msgTypeURLs := make(map[string]bool)
+	for _, typeURL := range ir.ListImplementations(sdk.MsgInterfaceProtoName) {
+		msgTypeURLs[typeURL] = true
+	}
+
+	msgServices := make([]*MsgServiceDescriptor, 0)
+	for _, fd := range set.File {
+		for _, sd := range fd.Service {
+			methods := make([]*MsgMethodDescriptor, 0, len(sd.Method))
+			for _, md := range sd.Method {
+				msgTypeURL := "/" + strings.TrimPrefix(md.GetInputType(), ".")
+				if !msgTypeURLs[msgTypeURL] {
+					break
+				}
+				methods = append(methods, &MsgMethodDescriptor{
+					Name:             md.GetName(),
+					MsgTypeUrl:       msgTypeURL,
+					ResponseFullname: strings.TrimPrefix(md.GetOutputType(), "."),
+				})
+			}
+
+			if len(methods) == 0 || len(methods) != len(sd.Method) {
+				continue
+			}
+
+			fullname := sd.GetName()
+			if fd.GetPackage() != "" {
+				fullname = fd.GetPackage() + "." + fullname
+			}
+			msgServices = append(msgServices, &MsgServiceDescriptor{
+				Fullname: fullname,
+				Methods:  methods,
+			})
+		}
+	}
+
+	return &MsgServicesDescriptor{MsgServices: msgServices}
+}
+
 func newTxDescriptor(ir codectypes.InterfaceRegistry) (*TxDescriptor, error) {
 	// get base tx type name
 	txPbName := proto.MessageName(&tx.Tx{})
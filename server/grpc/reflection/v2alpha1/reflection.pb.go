@@ -43,6 +43,8 @@ type AppDescriptor struct {
 	QueryServices *QueryServicesDescriptor `protobuf:"bytes,5,opt,name=query_services,json=queryServices,proto3" json:"query_services,omitempty"`
 	// tx provides metadata information regarding how to send transactions to the given application
 	Tx *TxDescriptor `protobuf:"bytes,6,opt,name=tx,proto3" json:"tx,omitempty"`
+	// msg_services provides metadata information regarding the available Msg services
+	MsgServices *MsgServicesDescriptor `protobuf:"bytes,7,opt,name=msg_services,json=msgServices,proto3" json:"msg_services,omitempty"`
 }
 
 func (m *AppDescriptor) Reset()         { *m = AppDescriptor{} }
@@ -120,6 +122,13 @@ func (m *AppDescriptor) GetTx() *TxDescriptor {
 	return nil
 }
 
+func (m *AppDescriptor) GetMsgServices() *MsgServicesDescriptor {
+	if m != nil {
+		return m.MsgServices
+	}
+	return nil
+}
+
 // TxDescriptor describes the accepted transaction type
 type TxDescriptor struct {
 	// fullname is the protobuf fullname of the raw transaction type (for instance the tx.Tx type)
@@ -1156,6 +1165,173 @@ func (m *GetTxDescriptorResponse) GetTx() *TxDescriptor {
 	return nil
 }
 
+// GetMsgServicesDescriptorRequest is the request used for the GetMsgServicesDescriptor RPC
+type GetMsgServicesDescriptorRequest struct {
+}
+
+func (m *GetMsgServicesDescriptorRequest) Reset()         { *m = GetMsgServicesDescriptorRequest{} }
+func (m *GetMsgServicesDescriptorRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMsgServicesDescriptorRequest) ProtoMessage()    {}
+func (*GetMsgServicesDescriptorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{23}
+}
+func (m *GetMsgServicesDescriptorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetMsgServicesDescriptorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetMsgServicesDescriptorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetMsgServicesDescriptorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMsgServicesDescriptorRequest.Merge(m, src)
+}
+func (m *GetMsgServicesDescriptorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetMsgServicesDescriptorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMsgServicesDescriptorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMsgServicesDescriptorRequest proto.InternalMessageInfo
+
+// GetMsgServicesDescriptorResponse is the response returned by the GetMsgServicesDescriptor RPC
+type GetMsgServicesDescriptorResponse struct {
+	// msg_services provides the list of Msg services of the application
+	MsgServices *MsgServicesDescriptor `protobuf:"bytes,1,opt,name=msg_services,json=msgServices,proto3" json:"msg_services,omitempty"`
+}
+
+func (m *GetMsgServicesDescriptorResponse) Reset()         { *m = GetMsgServicesDescriptorResponse{} }
+func (m *GetMsgServicesDescriptorResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMsgServicesDescriptorResponse) ProtoMessage()    {}
+func (*GetMsgServicesDescriptorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{24}
+}
+func (m *GetMsgServicesDescriptorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetMsgServicesDescriptorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetMsgServicesDescriptorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetMsgServicesDescriptorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMsgServicesDescriptorResponse.Merge(m, src)
+}
+func (m *GetMsgServicesDescriptorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetMsgServicesDescriptorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMsgServicesDescriptorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMsgServicesDescriptorResponse proto.InternalMessageInfo
+
+func (m *GetMsgServicesDescriptorResponse) GetMsgServices() *MsgServicesDescriptor {
+	if m != nil {
+		return m.MsgServices
+	}
+	return nil
+}
+
+// GetFileDescriptorSetRequest is the request used for the GetFileDescriptorSet RPC
+type GetFileDescriptorSetRequest struct {
+}
+
+func (m *GetFileDescriptorSetRequest) Reset()         { *m = GetFileDescriptorSetRequest{} }
+func (m *GetFileDescriptorSetRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFileDescriptorSetRequest) ProtoMessage()    {}
+func (*GetFileDescriptorSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{25}
+}
+func (m *GetFileDescriptorSetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetFileDescriptorSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetFileDescriptorSetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetFileDescriptorSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFileDescriptorSetRequest.Merge(m, src)
+}
+func (m *GetFileDescriptorSetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetFileDescriptorSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFileDescriptorSetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFileDescriptorSetRequest proto.InternalMessageInfo
+
+// GetFileDescriptorSetResponse is the response returned by the GetFileDescriptorSet RPC
+type GetFileDescriptorSetResponse struct {
+	// file_descriptors holds the serialized google.protobuf.FileDescriptorProto of
+	// every proto file of the application, each file following its dependencies.
+	FileDescriptors [][]byte `protobuf:"bytes,1,rep,name=file_descriptors,json=fileDescriptors,proto3" json:"file_descriptors,omitempty"`
+}
+
+func (m *GetFileDescriptorSetResponse) Reset()         { *m = GetFileDescriptorSetResponse{} }
+func (m *GetFileDescriptorSetResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFileDescriptorSetResponse) ProtoMessage()    {}
+func (*GetFileDescriptorSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{26}
+}
+func (m *GetFileDescriptorSetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetFileDescriptorSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetFileDescriptorSetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetFileDescriptorSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFileDescriptorSetResponse.Merge(m, src)
+}
+func (m *GetFileDescriptorSetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetFileDescriptorSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFileDescriptorSetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFileDescriptorSetResponse proto.InternalMessageInfo
+
+func (m *GetFileDescriptorSetResponse) GetFileDescriptors() [][]byte {
+	if m != nil {
+		return m.FileDescriptors
+	}
+	return nil
+}
+
 // QueryServicesDescriptor contains the list of cosmos-sdk queriable services
 type QueryServicesDescriptor struct {
 	// query_services is a list of cosmos-sdk QueryServiceDescriptor
@@ -1166,7 +1342,7 @@ func (m *QueryServicesDescriptor) Reset()         { *m = QueryServicesDescriptor
 func (m *QueryServicesDescriptor) String() string { return proto.CompactTextString(m) }
 func (*QueryServicesDescriptor) ProtoMessage()    {}
 func (*QueryServicesDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15c91f0b8d6bf3d0, []int{23}
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{27}
 }
 func (m *QueryServicesDescriptor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1216,7 +1392,7 @@ func (m *QueryServiceDescriptor) Reset()         { *m = QueryServiceDescriptor{}
 func (m *QueryServiceDescriptor) String() string { return proto.CompactTextString(m) }
 func (*QueryServiceDescriptor) ProtoMessage()    {}
 func (*QueryServiceDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15c91f0b8d6bf3d0, []int{24}
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{28}
 }
 func (m *QueryServiceDescriptor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1281,7 +1457,7 @@ func (m *QueryMethodDescriptor) Reset()         { *m = QueryMethodDescriptor{} }
 func (m *QueryMethodDescriptor) String() string { return proto.CompactTextString(m) }
 func (*QueryMethodDescriptor) ProtoMessage()    {}
 func (*QueryMethodDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15c91f0b8d6bf3d0, []int{25}
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{29}
 }
 func (m *QueryMethodDescriptor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1324,6 +1500,171 @@ func (m *QueryMethodDescriptor) GetFullQueryPath() string {
 	return ""
 }
 
+// MsgServicesDescriptor contains the list of cosmos-sdk Msg services
+type MsgServicesDescriptor struct {
+	// msg_services is a list of cosmos-sdk MsgServiceDescriptor
+	MsgServices []*MsgServiceDescriptor `protobuf:"bytes,1,rep,name=msg_services,json=msgServices,proto3" json:"msg_services,omitempty"`
+}
+
+func (m *MsgServicesDescriptor) Reset()         { *m = MsgServicesDescriptor{} }
+func (m *MsgServicesDescriptor) String() string { return proto.CompactTextString(m) }
+func (*MsgServicesDescriptor) ProtoMessage()    {}
+func (*MsgServicesDescriptor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{30}
+}
+func (m *MsgServicesDescriptor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgServicesDescriptor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgServicesDescriptor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgServicesDescriptor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgServicesDescriptor.Merge(m, src)
+}
+func (m *MsgServicesDescriptor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgServicesDescriptor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgServicesDescriptor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgServicesDescriptor proto.InternalMessageInfo
+
+func (m *MsgServicesDescriptor) GetMsgServices() []*MsgServiceDescriptor {
+	if m != nil {
+		return m.MsgServices
+	}
+	return nil
+}
+
+// MsgServiceDescriptor describes a cosmos-sdk Msg service
+type MsgServiceDescriptor struct {
+	// fullname is the protobuf fullname of the service descriptor
+	Fullname string `protobuf:"bytes,1,opt,name=fullname,proto3" json:"fullname,omitempty"`
+	// methods provides a list of Msg service methods
+	Methods []*MsgMethodDescriptor `protobuf:"bytes,2,rep,name=methods,proto3" json:"methods,omitempty"`
+}
+
+func (m *MsgServiceDescriptor) Reset()         { *m = MsgServiceDescriptor{} }
+func (m *MsgServiceDescriptor) String() string { return proto.CompactTextString(m) }
+func (*MsgServiceDescriptor) ProtoMessage()    {}
+func (*MsgServiceDescriptor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{31}
+}
+func (m *MsgServiceDescriptor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgServiceDescriptor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgServiceDescriptor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgServiceDescriptor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgServiceDescriptor.Merge(m, src)
+}
+func (m *MsgServiceDescriptor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgServiceDescriptor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgServiceDescriptor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgServiceDescriptor proto.InternalMessageInfo
+
+func (m *MsgServiceDescriptor) GetFullname() string {
+	if m != nil {
+		return m.Fullname
+	}
+	return ""
+}
+
+func (m *MsgServiceDescriptor) GetMethods() []*MsgMethodDescriptor {
+	if m != nil {
+		return m.Methods
+	}
+	return nil
+}
+
+// MsgMethodDescriptor describes a method of a Msg service
+type MsgMethodDescriptor struct {
+	// name is the protobuf name (not fullname) of the method
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// msg_type_url is the TypeURL of the sdk.Msg handled by the method
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// response_fullname is the protobuf fullname of the response of the method
+	ResponseFullname string `protobuf:"bytes,3,opt,name=response_fullname,json=responseFullname,proto3" json:"response_fullname,omitempty"`
+}
+
+func (m *MsgMethodDescriptor) Reset()         { *m = MsgMethodDescriptor{} }
+func (m *MsgMethodDescriptor) String() string { return proto.CompactTextString(m) }
+func (*MsgMethodDescriptor) ProtoMessage()    {}
+func (*MsgMethodDescriptor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{32}
+}
+func (m *MsgMethodDescriptor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMethodDescriptor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMethodDescriptor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMethodDescriptor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMethodDescriptor.Merge(m, src)
+}
+func (m *MsgMethodDescriptor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMethodDescriptor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMethodDescriptor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMethodDescriptor proto.InternalMessageInfo
+
+func (m *MsgMethodDescriptor) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgMethodDescriptor) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgMethodDescriptor) GetResponseFullname() string {
+	if m != nil {
+		return m.ResponseFullname
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*AppDescriptor)(nil), "cosmos.base.reflection.v2alpha1.AppDescriptor")
 	proto.RegisterType((*TxDescriptor)(nil), "cosmos.base.reflection.v2alpha1.TxDescriptor")
@@ -1348,9 +1689,16 @@ func init() {
 	proto.RegisterType((*GetQueryServicesDescriptorResponse)(nil), "cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse")
 	proto.RegisterType((*GetTxDescriptorRequest)(nil), "cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest")
 	proto.RegisterType((*GetTxDescriptorResponse)(nil), "cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse")
+	proto.RegisterType((*GetMsgServicesDescriptorRequest)(nil), "cosmos.base.reflection.v2alpha1.GetMsgServicesDescriptorRequest")
+	proto.RegisterType((*GetMsgServicesDescriptorResponse)(nil), "cosmos.base.reflection.v2alpha1.GetMsgServicesDescriptorResponse")
+	proto.RegisterType((*GetFileDescriptorSetRequest)(nil), "cosmos.base.reflection.v2alpha1.GetFileDescriptorSetRequest")
+	proto.RegisterType((*GetFileDescriptorSetResponse)(nil), "cosmos.base.reflection.v2alpha1.GetFileDescriptorSetResponse")
 	proto.RegisterType((*QueryServicesDescriptor)(nil), "cosmos.base.reflection.v2alpha1.QueryServicesDescriptor")
 	proto.RegisterType((*QueryServiceDescriptor)(nil), "cosmos.base.reflection.v2alpha1.QueryServiceDescriptor")
 	proto.RegisterType((*QueryMethodDescriptor)(nil), "cosmos.base.reflection.v2alpha1.QueryMethodDescriptor")
+	proto.RegisterType((*MsgServicesDescriptor)(nil), "cosmos.base.reflection.v2alpha1.MsgServicesDescriptor")
+	proto.RegisterType((*MsgServiceDescriptor)(nil), "cosmos.base.reflection.v2alpha1.MsgServiceDescriptor")
+	proto.RegisterType((*MsgMethodDescriptor)(nil), "cosmos.base.reflection.v2alpha1.MsgMethodDescriptor")
 }
 
 func init() {
@@ -1358,80 +1706,93 @@ func init() {
 }
 
 var fileDescriptor_15c91f0b8d6bf3d0 = []byte{
-	// 1155 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0x3a, 0xbf, 0x5f, 0x9b, 0x46, 0x0c, 0x24, 0x71, 0xdd, 0xe2, 0xa6, 0x1b, 0x09, 0xf5,
-	0x52, 0xbb, 0x49, 0xa3, 0xb4, 0x82, 0x94, 0xca, 0x69, 0x68, 0x15, 0x89, 0xa0, 0xe0, 0xa4, 0x80,
-	0x10, 0xea, 0x6a, 0xbd, 0x3b, 0x5e, 0x8f, 0xf0, 0xee, 0x6c, 0x76, 0xc6, 0xc1, 0xb9, 0x72, 0xe0,
-	0x0c, 0xe2, 0x4f, 0xe0, 0xc0, 0x9d, 0xbf, 0x02, 0xc1, 0xa5, 0x12, 0x17, 0x8e, 0x28, 0x41, 0xe2,
-	0x00, 0x7f, 0x04, 0x9a, 0x1f, 0x76, 0xc6, 0xce, 0xda, 0xde, 0x24, 0x3d, 0x25, 0xb3, 0xef, 0xbd,
-	0x6f, 0xbe, 0xef, 0xed, 0xe8, 0x7d, 0xb3, 0x86, 0x07, 0x1e, 0x65, 0x21, 0x65, 0xe5, 0x9a, 0xcb,
-	0x70, 0x39, 0xc1, 0xf5, 0x26, 0xf6, 0x38, 0xa1, 0x51, 0xf9, 0x68, 0xcd, 0x6d, 0xc6, 0x0d, 0x77,
-	0xd5, 0x78, 0x56, 0x8a, 0x13, 0xca, 0x29, 0xba, 0xa3, 0x2a, 0x4a, 0xa2, 0xa2, 0x64, 0x44, 0x3b,
-	0x15, 0x85, 0xdb, 0x01, 0xa5, 0x41, 0x13, 0x97, 0xdd, 0x98, 0x94, 0xdd, 0x28, 0xa2, 0xdc, 0x15,
-	0x71, 0xa6, 0xca, 0xed, 0x7f, 0xc6, 0x61, 0xae, 0x12, 0xc7, 0xdb, 0x98, 0x79, 0x09, 0x89, 0x39,
-	0x4d, 0xd0, 0x73, 0x98, 0x74, 0x5b, 0xbc, 0x11, 0xe5, 0xad, 0x65, 0xeb, 0xde, 0xb5, 0xb5, 0x07,
-	0xa5, 0x11, 0x1b, 0x94, 0x2a, 0x22, 0xfb, 0x0c, 0xa0, 0xaa, 0xca, 0x05, 0x8e, 0xd7, 0x70, 0x49,
-	0x94, 0xcf, 0x65, 0xc4, 0x79, 0x26, 0xb2, 0x4d, 0x1c, 0x59, 0x2e, 0x71, 0xa8, 0x8f, 0xbd, 0xfc,
-	0x78, 0x56, 0x1c, 0x91, 0xdd, 0x83, 0x23, 0x1e, 0xa0, 0x57, 0x30, 0xe7, 0xd1, 0xa8, 0x4e, 0x82,
-	0x56, 0x22, 0x3b, 0x90, 0x9f, 0x90, 0x78, 0x8f, 0x33, 0xe0, 0x19, 0x55, 0x06, 0x6e, 0x2f, 0x1c,
-	0x72, 0xe0, 0xc6, 0x61, 0x0b, 0x27, 0xc7, 0x0e, 0xc3, 0xc9, 0x11, 0xf1, 0x30, 0xcb, 0x4f, 0x66,
-	0xdc, 0xe0, 0x53, 0x51, 0xb6, 0xaf, 0xab, 0xcc, 0x0d, 0x0e, 0xcd, 0x00, 0x7a, 0x02, 0x39, 0xde,
-	0xce, 0x4f, 0x49, 0xd0, 0xfb, 0x23, 0x41, 0x0f, 0xda, 0x06, 0x52, 0x8e, 0xb7, 0xed, 0x08, 0xae,
-	0x9b, 0xcf, 0x50, 0x01, 0x66, 0xea, 0xad, 0x66, 0x33, 0x72, 0x43, 0x2c, 0x5f, 0xf5, 0x6c, 0xb5,
-	0xbb, 0x46, 0x5b, 0x30, 0x11, 0xb2, 0x80, 0xe5, 0x73, 0xcb, 0xe3, 0xf7, 0xae, 0xad, 0x95, 0x46,
-	0x6e, 0xb6, 0xcb, 0x02, 0x63, 0x37, 0x59, 0x6b, 0x37, 0x60, 0xbe, 0xef, 0x64, 0xa0, 0x97, 0x00,
-	0x8c, 0x04, 0x91, 0x13, 0x52, 0x1f, 0xb3, 0xbc, 0x25, 0xc1, 0x37, 0x46, 0x82, 0xef, 0x93, 0x20,
-	0x22, 0x51, 0xb0, 0x4b, 0x7d, 0x6c, 0x6c, 0x32, 0x2b, 0x90, 0xc4, 0x33, 0x66, 0xff, 0x60, 0xc1,
-	0x42, 0x6a, 0x12, 0x42, 0x30, 0x61, 0xe8, 0x93, 0xff, 0xa3, 0x45, 0x98, 0x8a, 0x5a, 0x61, 0x0d,
-	0x27, 0xf2, 0x60, 0x4e, 0x56, 0xf5, 0x0a, 0x7d, 0x0c, 0x2b, 0xf2, 0xe0, 0x3a, 0x24, 0xaa, 0x53,
-	0x27, 0x4e, 0xe8, 0x11, 0xf1, 0x71, 0xe2, 0x84, 0x98, 0x37, 0xa8, 0xef, 0x74, 0x5b, 0x35, 0x2e,
-	0xa1, 0xee, 0xc8, 0xd4, 0x9d, 0xa8, 0x4e, 0xf7, 0x74, 0xe2, 0xae, 0xcc, 0x7b, 0xae, 0xd3, 0xec,
-	0xbb, 0x30, 0xdf, 0x77, 0x9e, 0xd1, 0x0d, 0xc8, 0x11, 0x5f, 0x53, 0xc9, 0x11, 0xdf, 0x0e, 0x60,
-	0xbe, 0xef, 0xa8, 0xa2, 0x03, 0x00, 0x12, 0x71, 0x9c, 0xd4, 0x5d, 0xaf, 0xdb, 0xa0, 0xf5, 0x91,
-	0x0d, 0xda, 0xe9, 0x94, 0x18, 0xed, 0x31, 0x70, 0xec, 0x5f, 0x72, 0xf0, 0x76, 0x4a, 0xce, 0xd0,
-	0x13, 0xf0, 0x9d, 0x05, 0xb7, 0xbb, 0x10, 0x8e, 0xeb, 0x79, 0x38, 0xe6, 0x24, 0x0a, 0x9c, 0x10,
-	0x33, 0xe6, 0x06, 0xb8, 0x73, 0x34, 0xb6, 0xb3, 0x93, 0xab, 0x74, 0x30, 0x76, 0x15, 0x84, 0x41,
-	0xb6, 0x40, 0x06, 0x25, 0x31, 0x74, 0x04, 0x8b, 0x67, 0x3c, 0x48, 0x18, 0x37, 0x71, 0x88, 0xc5,
-	0x9a, 0xe5, 0xc7, 0x25, 0x83, 0xa7, 0xd9, 0x19, 0xec, 0x9c, 0x55, 0x1b, 0x9b, 0x2f, 0x90, 0x94,
-	0x38, 0xb3, 0x3f, 0x87, 0xe2, 0xf0, 0xc2, 0xa1, 0xed, 0xbb, 0x09, 0x33, 0xfc, 0x38, 0xc6, 0x4e,
-	0x2b, 0x69, 0xca, 0x63, 0x36, 0x5b, 0x9d, 0x16, 0xeb, 0x97, 0x49, 0xd3, 0xfe, 0x06, 0x56, 0x32,
-	0xf4, 0x64, 0x28, 0xfa, 0x3a, 0x2c, 0xd6, 0x09, 0x6e, 0xfa, 0x8e, 0xdf, 0xcd, 0x77, 0x44, 0x40,
-	0xbd, 0x95, 0xd9, 0xea, 0x3b, 0x32, 0x7a, 0x06, 0xf6, 0x89, 0x88, 0xd9, 0x5f, 0xc1, 0xd2, 0x80,
-	0x51, 0x86, 0x2a, 0xf0, 0x6e, 0x0d, 0x7b, 0x8d, 0x87, 0x6b, 0xe2, 0x4d, 0xd3, 0x56, 0xc4, 0x1d,
-	0xd7, 0xf7, 0x13, 0xcc, 0x98, 0x13, 0x27, 0xb8, 0x4e, 0xda, 0x9a, 0x41, 0x41, 0x25, 0x55, 0x54,
-	0x4e, 0x45, 0xa5, 0xec, 0xc9, 0x0c, 0x7b, 0x15, 0xe6, 0x7a, 0xa6, 0x00, 0x5a, 0x86, 0xeb, 0x21,
-	0x0b, 0x9c, 0x6e, 0x1b, 0x14, 0x04, 0x84, 0x2c, 0x38, 0xd0, 0x9d, 0xb8, 0x05, 0x37, 0x5f, 0x60,
-	0xde, 0x6f, 0x1f, 0xf8, 0xb0, 0x85, 0x19, 0xb7, 0x7d, 0x28, 0xa4, 0x05, 0x59, 0x4c, 0x23, 0x86,
-	0xdf, 0x94, 0x49, 0x69, 0x0a, 0xfd, 0xce, 0xd3, 0x43, 0xe1, 0x5c, 0xf0, 0x8c, 0x82, 0xf2, 0x37,
-	0xeb, 0x4a, 0xfe, 0xd6, 0xa1, 0xd0, 0x67, 0x5a, 0xbd, 0x14, 0xfa, 0x83, 0x06, 0x05, 0x69, 0x8d,
-	0xd6, 0x95, 0xac, 0xd1, 0x5e, 0x81, 0xbb, 0x72, 0x97, 0x74, 0x9f, 0xd3, 0x54, 0x8e, 0xc0, 0x1e,
-	0x96, 0xa4, 0x29, 0xed, 0xc1, 0x94, 0xb2, 0x45, 0xcd, 0xe9, 0xf2, 0xf6, 0xaa, 0x71, 0x34, 0xb9,
-	0x41, 0x1e, 0xa9, 0xc9, 0xb5, 0x25, 0xb9, 0x81, 0x49, 0x9a, 0x5c, 0x15, 0xa6, 0x85, 0xa5, 0x12,
-	0x39, 0x5b, 0xaf, 0xe6, 0xcd, 0x1d, 0x20, 0x3b, 0x0f, 0x8b, 0x2f, 0x30, 0xef, 0x71, 0x5b, 0xcd,
-	0xe9, 0x0b, 0x58, 0x3a, 0x17, 0xd1, 0x44, 0x94, 0x95, 0x5b, 0x97, 0xb5, 0xf2, 0x63, 0x58, 0x1a,
-	0xc0, 0x0b, 0xbd, 0x3a, 0x77, 0x0b, 0x51, 0x2e, 0xf2, 0xe8, 0x42, 0x4a, 0x07, 0x5e, 0x42, 0xec,
-	0x9f, 0x2c, 0x58, 0x4c, 0xcf, 0x1c, 0x3a, 0xb1, 0x6e, 0xc1, 0x2c, 0x61, 0xc2, 0xf7, 0x5b, 0x4d,
-	0x2c, 0x07, 0xe2, 0x4c, 0x75, 0x86, 0xb0, 0x5d, 0xb9, 0x46, 0x7b, 0x30, 0xad, 0x5c, 0xb6, 0x33,
-	0xd3, 0x37, 0xb2, 0x91, 0x55, 0x96, 0x6b, 0xbe, 0x14, 0x0d, 0x63, 0xef, 0xc3, 0x42, 0x6a, 0x46,
-	0xea, 0x85, 0xe0, 0x3d, 0x98, 0x17, 0x3c, 0x1d, 0xd5, 0xb7, 0xd8, 0xe5, 0x0d, 0x3d, 0xb2, 0xe7,
-	0xc4, 0x63, 0x89, 0xb3, 0xe7, 0xf2, 0xc6, 0xda, 0xcf, 0x00, 0x6f, 0x55, 0xbb, 0x5c, 0xb4, 0x7e,
-	0xf4, 0xbb, 0x05, 0xe8, 0xfc, 0xa0, 0x42, 0xef, 0x8f, 0x94, 0x30, 0x70, 0xf4, 0x15, 0x3e, 0xb8,
-	0x54, 0xad, 0x3a, 0x5a, 0xf6, 0xe6, 0xb7, 0x7f, 0xfc, 0xfd, 0x63, 0x6e, 0x03, 0xad, 0x97, 0x07,
-	0x7d, 0x4a, 0xac, 0xd6, 0x30, 0x77, 0x57, 0xcb, 0x6e, 0x1c, 0x1b, 0xfe, 0x51, 0x56, 0x97, 0x76,
-	0xad, 0xa6, 0xff, 0xea, 0x92, 0x49, 0x4d, 0xfa, 0x14, 0xcd, 0xa6, 0x66, 0xc0, 0x90, 0xbd, 0xb4,
-	0x1a, 0xf5, 0xe9, 0xd0, 0x51, 0xd3, 0x77, 0xcb, 0xca, 0xa6, 0x26, 0x75, 0x20, 0x67, 0x54, 0x93,
-	0x3e, 0xaf, 0x2f, 0xaf, 0x46, 0x7e, 0xc0, 0xfc, 0x6b, 0x69, 0x33, 0x48, 0xf7, 0xf0, 0xad, 0x6c,
-	0xcc, 0x86, 0xcd, 0xf8, 0xc2, 0xb3, 0x2b, 0x61, 0x68, 0x95, 0xdb, 0x52, 0xe5, 0x87, 0x68, 0xf3,
-	0xc2, 0x2a, 0xcd, 0xcf, 0xa9, 0xff, 0x94, 0xda, 0x41, 0x73, 0x2e, 0x93, 0xda, 0xe1, 0xa6, 0x91,
-	0x4d, 0xed, 0x08, 0x4f, 0xb1, 0x3f, 0x92, 0x6a, 0x9f, 0xa2, 0x27, 0x17, 0x54, 0xdb, 0x3b, 0xa5,
-	0xd1, 0x6f, 0x16, 0xcc, 0xf7, 0xb9, 0x05, 0x7a, 0x94, 0x85, 0x5f, 0x8a, 0xf3, 0x14, 0x1e, 0x5f,
-	0xbc, 0xf0, 0x8a, 0xef, 0x8e, 0xb7, 0x8d, 0xd5, 0xd6, 0x67, 0xbf, 0x9e, 0x14, 0xad, 0xd7, 0x27,
-	0x45, 0xeb, 0xaf, 0x93, 0xa2, 0xf5, 0xfd, 0x69, 0x71, 0xec, 0xf5, 0x69, 0x71, 0xec, 0xcf, 0xd3,
-	0xe2, 0xd8, 0x97, 0x9b, 0x01, 0xe1, 0x8d, 0x56, 0xad, 0xe4, 0xd1, 0xb0, 0xb3, 0x83, 0xfa, 0x73,
-	0x9f, 0xf9, 0x5f, 0x97, 0x45, 0x37, 0x70, 0x52, 0x0e, 0x92, 0xd8, 0x4b, 0xfb, 0xf1, 0xa3, 0x36,
-	0x25, 0x7f, 0xb3, 0x78, 0xf8, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xdb, 0xec, 0xac, 0x26,
-	0x11, 0x00, 0x00,
+	// 1371 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x73, 0xd3, 0xc6,
+	0x1b, 0x46, 0x4e, 0xc8, 0x9f, 0x17, 0x42, 0x60, 0x21, 0xc1, 0x08, 0x30, 0x41, 0xcc, 0xfc, 0x86,
+	0xdf, 0x74, 0xb0, 0x49, 0xa0, 0xc0, 0x34, 0x49, 0xa9, 0x93, 0x94, 0x4c, 0x3a, 0x0d, 0x93, 0x3a,
+	0xa1, 0xa5, 0x9d, 0x0e, 0x1a, 0x45, 0x5a, 0xcb, 0x3b, 0xb5, 0xfe, 0x44, 0xbb, 0x4e, 0xcd, 0xa1,
+	0x17, 0x0e, 0x3d, 0xb7, 0xd3, 0x8f, 0xd0, 0x6f, 0xd0, 0x8f, 0xd0, 0x53, 0xa7, 0xbd, 0x30, 0xd3,
+	0x4b, 0x6f, 0xed, 0x00, 0xb7, 0xf6, 0xd0, 0x8f, 0xd0, 0xd1, 0x6a, 0x65, 0xaf, 0x65, 0xc9, 0x91,
+	0x63, 0x4e, 0x89, 0xf4, 0xbe, 0xef, 0xb3, 0xcf, 0xfb, 0x6a, 0xb5, 0xcf, 0x23, 0xc3, 0x6d, 0xd3,
+	0xa3, 0x8e, 0x47, 0x2b, 0xfb, 0x06, 0xc5, 0x95, 0x00, 0xd7, 0x9b, 0xd8, 0x64, 0xc4, 0x73, 0x2b,
+	0x87, 0x4b, 0x46, 0xd3, 0x6f, 0x18, 0x8b, 0xd2, 0xbd, 0xb2, 0x1f, 0x78, 0xcc, 0x43, 0xd7, 0xa2,
+	0x8a, 0x72, 0x58, 0x51, 0x96, 0xa2, 0x71, 0x85, 0x7a, 0xc5, 0xf6, 0x3c, 0xbb, 0x89, 0x2b, 0x86,
+	0x4f, 0x2a, 0x86, 0xeb, 0x7a, 0xcc, 0x08, 0xe3, 0x34, 0x2a, 0xd7, 0x7e, 0x1e, 0x87, 0x99, 0xaa,
+	0xef, 0x6f, 0x60, 0x6a, 0x06, 0xc4, 0x67, 0x5e, 0x80, 0x1e, 0xc1, 0x49, 0xa3, 0xc5, 0x1a, 0x6e,
+	0x51, 0x59, 0x50, 0x6e, 0x9e, 0x5a, 0xba, 0x5d, 0x3e, 0x62, 0x81, 0x72, 0x35, 0xcc, 0xee, 0x02,
+	0xd4, 0xa2, 0xf2, 0x10, 0xc7, 0x6c, 0x18, 0xc4, 0x2d, 0x16, 0x72, 0xe2, 0xac, 0x87, 0xd9, 0x32,
+	0x0e, 0x2f, 0xe7, 0x38, 0x9e, 0x85, 0xcd, 0xe2, 0x58, 0x5e, 0x9c, 0x30, 0xbb, 0x07, 0x27, 0xbc,
+	0x81, 0x9e, 0xc1, 0x8c, 0xe9, 0xb9, 0x75, 0x62, 0xb7, 0x02, 0x3e, 0x81, 0xe2, 0x38, 0xc7, 0x7b,
+	0x90, 0x03, 0x4f, 0xaa, 0x92, 0x70, 0x7b, 0xe1, 0x90, 0x0e, 0x67, 0x0e, 0x5a, 0x38, 0x78, 0xae,
+	0x53, 0x1c, 0x1c, 0x12, 0x13, 0xd3, 0xe2, 0xc9, 0x9c, 0x0b, 0x7c, 0x12, 0x96, 0xed, 0x8a, 0x2a,
+	0x79, 0x81, 0x03, 0x39, 0x80, 0x56, 0xa1, 0xc0, 0xda, 0xc5, 0x09, 0x0e, 0x7a, 0xeb, 0x48, 0xd0,
+	0xbd, 0xb6, 0x84, 0x54, 0x60, 0x6d, 0xf4, 0x39, 0x9c, 0x76, 0xa8, 0xdd, 0x65, 0x37, 0xc9, 0x81,
+	0xee, 0x1d, 0x09, 0xb4, 0x4d, 0xed, 0x14, 0x6e, 0xa7, 0x9c, 0xee, 0x6d, 0xcd, 0x85, 0xd3, 0xf2,
+	0x72, 0x48, 0x85, 0xa9, 0x7a, 0xab, 0xd9, 0x74, 0x0d, 0x07, 0xf3, 0x5d, 0x34, 0x5d, 0xeb, 0x5c,
+	0xa3, 0x35, 0x18, 0x77, 0xa8, 0x4d, 0x8b, 0x85, 0x85, 0xb1, 0x9b, 0xa7, 0x96, 0xca, 0x79, 0x96,
+	0x97, 0x96, 0xe5, 0xb5, 0x5a, 0x03, 0x66, 0x13, 0x9b, 0x0e, 0x3d, 0x01, 0xa0, 0xc4, 0x76, 0x75,
+	0xc7, 0xb3, 0x30, 0x2d, 0x2a, 0x0b, 0x63, 0xb9, 0x7a, 0xdb, 0x25, 0xb6, 0x4b, 0x5c, 0x7b, 0xdb,
+	0xb3, 0xb0, 0xb4, 0xc8, 0x74, 0x88, 0x14, 0xde, 0xa3, 0xda, 0xf7, 0x0a, 0xcc, 0xa5, 0x26, 0x21,
+	0x04, 0xe3, 0x52, 0x7f, 0xfc, 0x7f, 0x34, 0x0f, 0x13, 0x6e, 0xcb, 0xd9, 0xc7, 0x01, 0xdf, 0xf3,
+	0x27, 0x6b, 0xe2, 0x0a, 0x7d, 0x0c, 0x37, 0xf8, 0x3b, 0xa1, 0x13, 0xb7, 0xee, 0xe9, 0x7e, 0xe0,
+	0x1d, 0x12, 0x0b, 0x07, 0xba, 0x83, 0x59, 0xc3, 0xb3, 0xf4, 0xce, 0xa8, 0xc6, 0x38, 0xd4, 0x35,
+	0x9e, 0xba, 0xe5, 0xd6, 0xbd, 0x1d, 0x91, 0xb8, 0xcd, 0xf3, 0x1e, 0x89, 0x34, 0xed, 0x3a, 0xcc,
+	0x26, 0x5e, 0x15, 0x74, 0x06, 0x0a, 0xc4, 0x12, 0x54, 0x0a, 0xc4, 0xd2, 0x6c, 0x98, 0x4d, 0xbc,
+	0x05, 0x68, 0x0f, 0x80, 0xb8, 0x0c, 0x07, 0x75, 0xc3, 0xec, 0x0c, 0xe8, 0xee, 0x91, 0x03, 0xda,
+	0x8a, 0x4b, 0xa4, 0xf1, 0x48, 0x38, 0xda, 0x4f, 0x05, 0x38, 0x9f, 0x92, 0x33, 0x70, 0x07, 0x7c,
+	0xab, 0xc0, 0x95, 0x0e, 0x84, 0x6e, 0x98, 0x26, 0xf6, 0x19, 0x71, 0x6d, 0xdd, 0xc1, 0x94, 0x1a,
+	0x36, 0x8e, 0xb7, 0xc6, 0x46, 0x7e, 0x72, 0xd5, 0x18, 0x63, 0x3b, 0x82, 0x90, 0xc8, 0xaa, 0x24,
+	0x2b, 0x89, 0xa2, 0x43, 0x98, 0xef, 0xf2, 0x20, 0x8e, 0xdf, 0xc4, 0x0e, 0x0e, 0xaf, 0x69, 0x71,
+	0x8c, 0x33, 0x78, 0x98, 0x9f, 0xc1, 0x56, 0xb7, 0x5a, 0x5a, 0x7c, 0x8e, 0xa4, 0xc4, 0xa9, 0xf6,
+	0x19, 0x94, 0x06, 0x17, 0x0e, 0x1c, 0xdf, 0x25, 0x98, 0x62, 0xcf, 0x7d, 0xac, 0xb7, 0x82, 0x26,
+	0xdf, 0x66, 0xd3, 0xb5, 0xc9, 0xf0, 0xfa, 0x49, 0xd0, 0xd4, 0xbe, 0x86, 0x1b, 0x39, 0x66, 0x32,
+	0x10, 0xfd, 0x2e, 0xcc, 0xd7, 0x09, 0x6e, 0x5a, 0xba, 0xd5, 0xc9, 0xd7, 0xc3, 0x40, 0xf4, 0x54,
+	0xa6, 0x6b, 0x17, 0x78, 0xb4, 0x0b, 0xf6, 0x38, 0x8c, 0x69, 0x5f, 0xc2, 0xc5, 0x8c, 0x53, 0x12,
+	0x55, 0xe1, 0xea, 0x3e, 0x36, 0x1b, 0x77, 0x96, 0xc2, 0x27, 0xed, 0xb5, 0x5c, 0xa6, 0x1b, 0x96,
+	0x15, 0x60, 0x4a, 0x75, 0x3f, 0xc0, 0x75, 0xd2, 0x16, 0x0c, 0xd4, 0x28, 0xa9, 0x1a, 0xe5, 0x54,
+	0xa3, 0x94, 0x1d, 0x9e, 0xa1, 0x2d, 0xc2, 0x4c, 0xcf, 0x29, 0x80, 0x16, 0xa2, 0xa3, 0xac, 0x33,
+	0x86, 0x08, 0x02, 0x1c, 0x6a, 0xef, 0x89, 0x49, 0x5c, 0x86, 0x4b, 0x9b, 0x98, 0x25, 0x95, 0x09,
+	0x1f, 0xb4, 0x30, 0x65, 0x9a, 0x05, 0x6a, 0x5a, 0x90, 0xfa, 0x9e, 0x4b, 0xf1, 0xdb, 0xd2, 0x3f,
+	0x41, 0x21, 0x29, 0x6a, 0x3d, 0x14, 0xfa, 0x82, 0x5d, 0x0a, 0x91, 0x74, 0x2a, 0x23, 0x49, 0x67,
+	0x4c, 0x21, 0xa1, 0x87, 0xbd, 0x14, 0x92, 0x41, 0x89, 0x02, 0x57, 0x5d, 0x65, 0x24, 0xd5, 0xd5,
+	0x6e, 0xc0, 0x75, 0xbe, 0x4a, 0xba, 0x84, 0x0a, 0x2a, 0x87, 0xa0, 0x0d, 0x4a, 0x12, 0x94, 0x76,
+	0x60, 0x22, 0x52, 0xdc, 0xa2, 0x92, 0x53, 0x58, 0xb3, 0x10, 0x05, 0x8e, 0x20, 0x97, 0x25, 0xbf,
+	0x82, 0x5c, 0x1b, 0xb4, 0x41, 0x49, 0x82, 0x5c, 0x0d, 0x26, 0x43, 0xb5, 0x26, 0x98, 0xe6, 0x66,
+	0x97, 0x05, 0x19, 0x03, 0x69, 0x45, 0x98, 0xdf, 0xc4, 0x6c, 0xaf, 0xdd, 0xcf, 0xe9, 0x29, 0x5c,
+	0xec, 0x8b, 0x08, 0x22, 0x91, 0x4b, 0x50, 0x8e, 0xe9, 0x12, 0xb4, 0xeb, 0x70, 0x6d, 0x13, 0xb3,
+	0x74, 0xcd, 0x17, 0x8b, 0x7f, 0x03, 0x0b, 0xd9, 0x29, 0x82, 0x45, 0xd2, 0x6c, 0x28, 0x6f, 0xcf,
+	0x6c, 0x5c, 0x85, 0xcb, 0x9b, 0x98, 0x3d, 0x22, 0x4d, 0xe9, 0x48, 0xdb, 0xc5, 0x2c, 0x66, 0xb7,
+	0x05, 0x57, 0xd2, 0xc3, 0x82, 0xd9, 0xff, 0xe1, 0x6c, 0x9d, 0x34, 0xb1, 0x74, 0xbe, 0x45, 0x6a,
+	0x78, 0xba, 0x36, 0x5b, 0xef, 0x29, 0xa2, 0xda, 0x73, 0xb8, 0x98, 0xf1, 0x8c, 0xd0, 0xb3, 0x3e,
+	0xb3, 0x17, 0x29, 0xea, 0xfd, 0xa1, 0x9e, 0x7a, 0xa6, 0xd7, 0xd3, 0x7e, 0x54, 0x60, 0x3e, 0x3d,
+	0x73, 0xe0, 0xe9, 0x7d, 0x19, 0xa6, 0x09, 0x0d, 0x3d, 0x50, 0xab, 0x89, 0xb9, 0x38, 0x4c, 0xd5,
+	0xa6, 0x08, 0xdd, 0xe6, 0xd7, 0x68, 0x07, 0x26, 0x23, 0xc7, 0x11, 0xeb, 0xdb, 0xbd, 0x7c, 0x64,
+	0x23, 0xfb, 0x21, 0x6f, 0x50, 0x01, 0xa3, 0xed, 0xc2, 0x5c, 0x6a, 0x46, 0xaa, 0x39, 0xfa, 0x1f,
+	0xcc, 0x86, 0x3c, 0xf5, 0x68, 0x6e, 0xbe, 0xc1, 0x1a, 0x42, 0xbe, 0x66, 0xc2, 0xdb, 0x1c, 0x67,
+	0xc7, 0x60, 0x0d, 0xed, 0x00, 0xe6, 0x52, 0x77, 0x01, 0x7a, 0xda, 0xb7, 0xa7, 0xc2, 0x26, 0xde,
+	0x1d, 0x62, 0x4f, 0x65, 0x6d, 0xa9, 0x17, 0x0a, 0x5c, 0x48, 0xcb, 0x1a, 0x38, 0xeb, 0xc7, 0xdd,
+	0x71, 0x16, 0x72, 0xba, 0xa9, 0x6d, 0x6a, 0x67, 0x0f, 0xb3, 0x0d, 0xe7, 0x53, 0xe2, 0xa9, 0xa3,
+	0x4c, 0xea, 0x5f, 0x21, 0xa9, 0x7f, 0xe8, 0x1d, 0x38, 0x17, 0x88, 0x1d, 0x9f, 0xf4, 0x97, 0x67,
+	0xe3, 0x40, 0x6c, 0x28, 0x97, 0xfe, 0x9d, 0x81, 0x73, 0xb5, 0x0e, 0x5d, 0x31, 0x05, 0xf4, 0x9b,
+	0x02, 0xa8, 0x5f, 0x26, 0xd1, 0x7b, 0x47, 0x76, 0x99, 0x29, 0xbc, 0xea, 0xf2, 0xb1, 0x6a, 0x23,
+	0xb6, 0xda, 0xca, 0x8b, 0xdf, 0xdf, 0xfc, 0x50, 0xb8, 0x87, 0xee, 0x56, 0xb2, 0xbe, 0x91, 0x17,
+	0xf7, 0x31, 0x33, 0x16, 0x2b, 0x86, 0xef, 0x4b, 0x6f, 0x77, 0x25, 0xfa, 0x1a, 0x15, 0xdd, 0x24,
+	0x8d, 0x73, 0xae, 0x6e, 0xd2, 0x35, 0x5c, 0x5d, 0x3e, 0x56, 0xed, 0x88, 0xdd, 0x44, 0xdf, 0xc4,
+	0x71, 0x37, 0x09, 0x8f, 0x9f, 0xaf, 0x9b, 0x54, 0x3b, 0xa0, 0x2e, 0x1f, 0xab, 0x76, 0xd4, 0x6e,
+	0xf8, 0x97, 0xf9, 0xdf, 0x8a, 0xb0, 0x22, 0xe9, 0x0e, 0x72, 0x2d, 0x1f, 0xb3, 0x41, 0x0e, 0x43,
+	0x5d, 0x1f, 0x09, 0x43, 0x74, 0xb9, 0xc1, 0xbb, 0x7c, 0x1f, 0xad, 0x0c, 0xdd, 0xa5, 0x84, 0x8b,
+	0xfe, 0x89, 0xba, 0xcd, 0x52, 0x96, 0x5c, 0xdd, 0x0e, 0xb6, 0x2c, 0xea, 0xfa, 0x48, 0x18, 0xa2,
+	0xdb, 0x0f, 0x79, 0xb7, 0x0f, 0xd1, 0xea, 0x90, 0xdd, 0xf6, 0xea, 0x22, 0xfa, 0x55, 0x81, 0xd9,
+	0x84, 0x57, 0x41, 0xf7, 0xf3, 0xf0, 0x4b, 0xf1, 0x3d, 0xea, 0x83, 0xe1, 0x0b, 0x47, 0x7c, 0x76,
+	0xac, 0x2d, 0x5d, 0xa1, 0x37, 0x0a, 0x14, 0xb3, 0xbc, 0x0f, 0xfa, 0x20, 0x0f, 0xb9, 0x41, 0xce,
+	0x4a, 0xad, 0x8e, 0x80, 0x20, 0xfa, 0x5c, 0xe7, 0x7d, 0xae, 0xa2, 0xe5, 0x21, 0xfb, 0x94, 0x95,
+	0x15, 0xfd, 0xa9, 0xc0, 0x85, 0x34, 0x13, 0x85, 0x56, 0xf2, 0x10, 0xcc, 0xb2, 0x66, 0xea, 0xea,
+	0x31, 0xab, 0x45, 0x6b, 0x1f, 0xf1, 0xd6, 0x36, 0xd0, 0xda, 0x90, 0xad, 0x25, 0xec, 0x9e, 0x4e,
+	0x31, 0x5b, 0xfb, 0xf4, 0x97, 0x57, 0x25, 0xe5, 0xe5, 0xab, 0x92, 0xf2, 0xd7, 0xab, 0x92, 0xf2,
+	0xdd, 0xeb, 0xd2, 0x89, 0x97, 0xaf, 0x4b, 0x27, 0xfe, 0x78, 0x5d, 0x3a, 0xf1, 0xc5, 0x8a, 0x4d,
+	0x58, 0xa3, 0xb5, 0x5f, 0x36, 0x3d, 0x27, 0x5e, 0x27, 0xfa, 0x73, 0x8b, 0x5a, 0x5f, 0x55, 0xc2,
+	0x11, 0xe1, 0xa0, 0x62, 0x07, 0xbe, 0x99, 0xf6, 0xf3, 0xec, 0xfe, 0x04, 0xff, 0x55, 0xf5, 0xce,
+	0x7f, 0x03, 0x00, 0xd7, 0x01, 0xa2, 0x9f, 0xc8, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1460,6 +1821,12 @@ type ReflectionServiceClient interface {
 	GetQueryServicesDescriptor(ctx context.Context, in *GetQueryServicesDescriptorRequest, opts ...grpc.CallOption) (*GetQueryServicesDescriptorResponse, error)
 	// GetTxDescriptor returns information on the used transaction object and available msgs that can be used
 	GetTxDescriptor(ctx context.Context, in *GetTxDescriptorRequest, opts ...grpc.CallOption) (*GetTxDescriptorResponse, error)
+	// GetMsgServicesDescriptor returns the Msg services of the application
+	GetMsgServicesDescriptor(ctx context.Context, in *GetMsgServicesDescriptorRequest, opts ...grpc.CallOption) (*GetMsgServicesDescriptorResponse, error)
+	// GetFileDescriptorSet returns the descriptors of the proto files defining the
+	// services, messages and interface implementations of the application, so that
+	// dynamic clients can encode and decode them without compiled protos.
+	GetFileDescriptorSet(ctx context.Context, in *GetFileDescriptorSetRequest, opts ...grpc.CallOption) (*GetFileDescriptorSetResponse, error)
 }
 
 type reflectionServiceClient struct {
@@ -1524,6 +1891,24 @@ func (c *reflectionServiceClient) GetTxDescriptor(ctx context.Context, in *GetTx
 	return out, nil
 }
 
+func (c *reflectionServiceClient) GetMsgServicesDescriptor(ctx context.Context, in *GetMsgServicesDescriptorRequest, opts ...grpc.CallOption) (*GetMsgServicesDescriptorResponse, error) {
+	out := new(GetMsgServicesDescriptorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.reflection.v2alpha1.ReflectionService/GetMsgServicesDescriptor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reflectionServiceClient) GetFileDescriptorSet(ctx context.Context, in *GetFileDescriptorSetRequest, opts ...grpc.CallOption) (*GetFileDescriptorSetResponse, error) {
+	out := new(GetFileDescriptorSetResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.reflection.v2alpha1.ReflectionService/GetFileDescriptorSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReflectionServiceServer is the server API for ReflectionService service.
 type ReflectionServiceServer interface {
 	// GetAuthnDescriptor returns information on how to authenticate transactions in the application
@@ -1540,6 +1925,12 @@ type ReflectionServiceServer interface {
 	GetQueryServicesDescriptor(context.Context, *GetQueryServicesDescriptorRequest) (*GetQueryServicesDescriptorResponse, error)
 	// GetTxDescriptor returns information on the used transaction object and available msgs that can be used
 	GetTxDescriptor(context.Context, *GetTxDescriptorRequest) (*GetTxDescriptorResponse, error)
+	// GetMsgServicesDescriptor returns the Msg services of the application
+	GetMsgServicesDescriptor(context.Context, *GetMsgServicesDescriptorRequest) (*GetMsgServicesDescriptorResponse, error)
+	// GetFileDescriptorSet returns the descriptors of the proto files defining the
+	// services, messages and interface implementations of the application, so that
+	// dynamic clients can encode and decode them without compiled protos.
+	GetFileDescriptorSet(context.Context, *GetFileDescriptorSetRequest) (*GetFileDescriptorSetResponse, error)
 }
 
 // UnimplementedReflectionServiceServer can be embedded to have forward compatible implementations.
@@ -1564,6 +1955,12 @@ func (*UnimplementedReflectionServiceServer) GetQueryServicesDescriptor(ctx cont
 func (*UnimplementedReflectionServiceServer) GetTxDescriptor(ctx context.Context, req *GetTxDescriptorRequest) (*GetTxDescriptorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTxDescriptor not implemented")
 }
+func (*UnimplementedReflectionServiceServer) GetMsgServicesDescriptor(ctx context.Context, req *GetMsgServicesDescriptorRequest) (*GetMsgServicesDescriptorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMsgServicesDescriptor not implemented")
+}
+func (*UnimplementedReflectionServiceServer) GetFileDescriptorSet(ctx context.Context, req *GetFileDescriptorSetRequest) (*GetFileDescriptorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileDescriptorSet not implemented")
+}
 
 func RegisterReflectionServiceServer(s grpc1.Server, srv ReflectionServiceServer) {
 	s.RegisterService(&_ReflectionService_serviceDesc, srv)
@@ -1677,6 +2074,42 @@ func _ReflectionService_GetTxDescriptor_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReflectionService_GetMsgServicesDescriptor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMsgServicesDescriptorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReflectionServiceServer).GetMsgServicesDescriptor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.reflection.v2alpha1.ReflectionService/GetMsgServicesDescriptor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReflectionServiceServer).GetMsgServicesDescriptor(ctx, req.(*GetMsgServicesDescriptorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReflectionService_GetFileDescriptorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileDescriptorSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReflectionServiceServer).GetFileDescriptorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.reflection.v2alpha1.ReflectionService/GetFileDescriptorSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReflectionServiceServer).GetFileDescriptorSet(ctx, req.(*GetFileDescriptorSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ReflectionService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.base.reflection.v2alpha1.ReflectionService",
 	HandlerType: (*ReflectionServiceServer)(nil),
@@ -1705,6 +2138,14 @@ var _ReflectionService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTxDescriptor",
 			Handler:    _ReflectionService_GetTxDescriptor_Handler,
 		},
+		{
+			MethodName: "GetMsgServicesDescriptor",
+			Handler:    _ReflectionService_GetMsgServicesDescriptor_Handler,
+		},
+		{
+			MethodName: "GetFileDescriptorSet",
+			Handler:    _ReflectionService_GetFileDescriptorSet_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/base/reflection/v2alpha1/reflection.proto",
@@ -1730,9 +2171,9 @@ func (m *AppDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Tx != nil {
+	if m.MsgServices != nil {
 		{
-			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MsgServices.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1740,11 +2181,23 @@ func (m *AppDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintReflection(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x3a
 	}
-	if m.QueryServices != nil {
+	if m.Tx != nil {
 		{
-			size, err := m.QueryServices.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReflection(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.QueryServices != nil {
+		{
+			size, err := m.QueryServices.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2537,6 +2990,119 @@ func (m *GetTxDescriptorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *GetMsgServicesDescriptorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMsgServicesDescriptorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetMsgServicesDescriptorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetMsgServicesDescriptorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMsgServicesDescriptorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetMsgServicesDescriptorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MsgServices != nil {
+		{
+			size, err := m.MsgServices.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReflection(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetFileDescriptorSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFileDescriptorSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFileDescriptorSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetFileDescriptorSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFileDescriptorSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFileDescriptorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FileDescriptors) > 0 {
+		for iNdEx := len(m.FileDescriptors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FileDescriptors[iNdEx])
+			copy(dAtA[i:], m.FileDescriptors[iNdEx])
+			i = encodeVarintReflection(dAtA, i, uint64(len(m.FileDescriptors[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryServicesDescriptor) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2665,6 +3231,131 @@ func (m *QueryMethodDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgServicesDescriptor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgServicesDescriptor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgServicesDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgServices) > 0 {
+		for iNdEx := len(m.MsgServices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgServices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReflection(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgServiceDescriptor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgServiceDescriptor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgServiceDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Methods) > 0 {
+		for iNdEx := len(m.Methods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Methods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReflection(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Fullname) > 0 {
+		i -= len(m.Fullname)
+		copy(dAtA[i:], m.Fullname)
+		i = encodeVarintReflection(dAtA, i, uint64(len(m.Fullname)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMethodDescriptor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMethodDescriptor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMethodDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResponseFullname) > 0 {
+		i -= len(m.ResponseFullname)
+		copy(dAtA[i:], m.ResponseFullname)
+		i = encodeVarintReflection(dAtA, i, uint64(len(m.ResponseFullname)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintReflection(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintReflection(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintReflection(dAtA []byte, offset int, v uint64) int {
 	offset -= sovReflection(v)
 	base := offset
@@ -2706,6 +3397,10 @@ func (m *AppDescriptor) Size() (n int) {
 		l = m.Tx.Size()
 		n += 1 + l + sovReflection(uint64(l))
 	}
+	if m.MsgServices != nil {
+		l = m.MsgServices.Size()
+		n += 1 + l + sovReflection(uint64(l))
+	}
 	return n
 }
 
@@ -3010,6 +3705,52 @@ func (m *GetTxDescriptorResponse) Size() (n int) {
 	return n
 }
 
+func (m *GetMsgServicesDescriptorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetMsgServicesDescriptorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgServices != nil {
+		l = m.MsgServices.Size()
+		n += 1 + l + sovReflection(uint64(l))
+	}
+	return n
+}
+
+func (m *GetFileDescriptorSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetFileDescriptorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FileDescriptors) > 0 {
+		for _, b := range m.FileDescriptors {
+			l = len(b)
+			n += 1 + l + sovReflection(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryServicesDescriptor) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3064,7 +3805,62 @@ func (m *QueryMethodDescriptor) Size() (n int) {
 	return n
 }
 
-func sovReflection(x uint64) (n int) {
+func (m *MsgServicesDescriptor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgServices) > 0 {
+		for _, e := range m.MsgServices {
+			l = e.Size()
+			n += 1 + l + sovReflection(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgServiceDescriptor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fullname)
+	if l > 0 {
+		n += 1 + l + sovReflection(uint64(l))
+	}
+	if len(m.Methods) > 0 {
+		for _, e := range m.Methods {
+			l = e.Size()
+			n += 1 + l + sovReflection(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMethodDescriptor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovReflection(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovReflection(uint64(l))
+	}
+	l = len(m.ResponseFullname)
+	if l > 0 {
+		n += 1 + l + sovReflection(uint64(l))
+	}
+	return n
+}
+
+func sovReflection(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozReflection(x uint64) (n int) {
@@ -3315,6 +4111,42 @@ func (m *AppDescriptor) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgServices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MsgServices == nil {
+				m.MsgServices = &MsgServicesDescriptor{}
+			}
+			if err := m.MsgServices.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReflection(dAtA[iNdEx:])
@@ -5193,7 +6025,7 @@ func (m *GetTxDescriptorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServicesDescriptor) Unmarshal(dAtA []byte) error {
+func (m *GetMsgServicesDescriptorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5216,15 +6048,65 @@ func (m *QueryServicesDescriptor) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServicesDescriptor: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetMsgServicesDescriptorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServicesDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetMsgServicesDescriptorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReflection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMsgServicesDescriptorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReflection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMsgServicesDescriptorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMsgServicesDescriptorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryServices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgServices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5251,8 +6133,10 @@ func (m *QueryServicesDescriptor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueryServices = append(m.QueryServices, &QueryServiceDescriptor{})
-			if err := m.QueryServices[len(m.QueryServices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MsgServices == nil {
+				m.MsgServices = &MsgServicesDescriptor{}
+			}
+			if err := m.MsgServices.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5277,7 +6161,7 @@ func (m *QueryServicesDescriptor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServiceDescriptor) Unmarshal(dAtA []byte) error {
+func (m *GetFileDescriptorSetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5300,69 +6184,67 @@ func (m *QueryServiceDescriptor) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceDescriptor: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetFileDescriptorSetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetFileDescriptorSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fullname", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowReflection
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthReflection
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReflection(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthReflection
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fullname = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsModule", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetFileDescriptorSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReflection
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowReflection
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.IsModule = bool(v != 0)
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetFileDescriptorSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetFileDescriptorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Methods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FileDescriptors", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReflection
@@ -5372,25 +6254,23 @@ func (m *QueryServiceDescriptor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthReflection
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthReflection
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Methods = append(m.Methods, &QueryMethodDescriptor{})
-			if err := m.Methods[len(m.Methods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FileDescriptors = append(m.FileDescriptors, make([]byte, postIndex-iNdEx))
+			copy(m.FileDescriptors[len(m.FileDescriptors)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5413,7 +6293,7 @@ func (m *QueryServiceDescriptor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMethodDescriptor) Unmarshal(dAtA []byte) error {
+func (m *QueryServicesDescriptor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5436,17 +6316,17 @@ func (m *QueryMethodDescriptor) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMethodDescriptor: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryServicesDescriptor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMethodDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryServicesDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryServices", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReflection
@@ -5456,23 +6336,243 @@ func (m *QueryMethodDescriptor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthReflection
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthReflection
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.QueryServices = append(m.QueryServices, &QueryServiceDescriptor{})
+			if err := m.QueryServices[len(m.QueryServices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReflection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServiceDescriptor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReflection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fullname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fullname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsModule", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsModule = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Methods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Methods = append(m.Methods, &QueryMethodDescriptor{})
+			if err := m.Methods[len(m.Methods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReflection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMethodDescriptor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReflection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMethodDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMethodDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -5527,6 +6627,352 @@ func (m *QueryMethodDescriptor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgServicesDescriptor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReflection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgServicesDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgServicesDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgServices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgServices = append(m.MsgServices, &MsgServiceDescriptor{})
+			if err := m.MsgServices[len(m.MsgServices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReflection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgServiceDescriptor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReflection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgServiceDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgServiceDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fullname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fullname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Methods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Methods = append(m.Methods, &MsgMethodDescriptor{})
+			if err := m.Methods[len(m.Methods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReflection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMethodDescriptor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReflection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMethodDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMethodDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseFullname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResponseFullname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReflection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipReflection(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -8,15 +8,37 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/filter"
 	"github.com/cosmos/cosmos-sdk/server/grpc/gogoreflection"
 	reflection "github.com/cosmos/cosmos-sdk/server/grpc/reflection/v2alpha1"
+	"github.com/cosmos/cosmos-sdk/server/limit"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// StartGRPCServer starts a gRPC server on the given address.
-func StartGRPCServer(clientCtx client.Context, app types.Application, address string) (*grpc.Server, error) {
-	grpcSrv := grpc.NewServer()
+// StartGRPCServer starts a gRPC server on the configured address. Query and
+// broadcast requests are subject to the concurrency limits of the given config.
+// The broadcast requests are rejected if queryOnly is true, and the requests to
+// the methods not exposed by the query filter, which may be nil, are rejected.
+func StartGRPCServer(
+	clientCtx client.Context, app types.Application, cfg config.GRPCConfig, queryOnly bool, queryFilter *filter.QueryFilter,
+) (*grpc.Server, error) {
+	queueTimeout := time.Duration(cfg.QueueTimeout) * time.Second
+	interceptors := []grpc.UnaryServerInterceptor{
+		filter.UnaryServerInterceptor(queryFilter),
+		limit.UnaryServerInterceptor(
+			limit.NewLimiter("grpc_query", cfg.MaxConcurrentQueries, cfg.MaxQueuedRequests, queueTimeout),
+			limit.NewLimiter("grpc_broadcast", cfg.MaxConcurrentBroadcasts, cfg.MaxQueuedRequests, queueTimeout),
+		),
+	}
+	if queryOnly {
+		interceptors = append([]grpc.UnaryServerInterceptor{limit.RejectBroadcastUnaryServerInterceptor()}, interceptors...)
+	}
+	grpcSrv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.ChainStreamInterceptor(filter.StreamServerInterceptor(queryFilter)),
+	)
 	app.RegisterGRPCServer(grpcSrv)
 	// reflection allows consumers to build dynamic clients that can write
 	// to any cosmos-sdk application without relying on application packages at compile time
@@ -38,7 +60,7 @@ func StartGRPCServer(clientCtx client.Context, app types.Application, address st
 	// Reflection allows external clients to see what services and methods
 	// the gRPC server exposes.
 	gogoreflection.Register(grpcSrv)
-	listener, err := net.Listen("tcp", address)
+	listener, err := net.Listen("tcp", cfg.Address)
 	if err != nil {
 		return nil, err
 	}
@@ -58,3 +80,38 @@ func StartGRPCServer(clientCtx client.Context, app types.Application, address st
 		return grpcSrv, nil
 	}
 }
+
+// ServiceDescs returns the descriptions of the gRPC services the app registers
+// on the gRPC server.
+func ServiceDescs(app types.Application) []*grpc.ServiceDesc {
+	c := &serviceCollector{}
+	app.RegisterGRPCServer(c)
+	return c.services
+}
+
+// serviceCollector is a gRPC server recording the services registered on it.
+type serviceCollector struct {
+	services []*grpc.ServiceDesc
+}
+
+func (c *serviceCollector) RegisterService(sd *grpc.ServiceDesc, _ interface{}) {
+	c.services = append(c.services, sd)
+}
+
+// StopGRPCServer gracefully stops the gRPC server: it stops accepting new
+// connections and waits for the in-flight requests to complete for at most the
+// given timeout, after which the remaining connections are closed.
+func StopGRPCServer(grpcSrv *grpc.Server, timeout time.Duration) {
+	stopped := make(chan struct{})
+	go func() {
+		grpcSrv.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(timeout):
+		grpcSrv.Stop()
+		<-stopped
+	}
+}
@@ -16,6 +16,8 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	reflectionv1 "github.com/cosmos/cosmos-sdk/client/grpc/reflection"
@@ -153,6 +155,49 @@ func (s *IntegrationTestSuite) TestGRPCServer_InterfaceReflection() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGRPCServer_DescriptorReflection() {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	client := reflectionv2.NewReflectionServiceClient(s.conn)
+
+	fdsRes, err := client.GetFileDescriptorSet(ctx, &reflectionv2.GetFileDescriptorSetRequest{})
+	s.Require().NoError(err)
+
+	// every file follows its dependencies, so that dynamic clients can build the
+	// descriptors without compiled protos
+	built := make(map[string]bool)
+	for _, bz := range fdsRes.FileDescriptors {
+		fd := &descriptorpb.FileDescriptorProto{}
+		s.Require().NoError(protov2.Unmarshal(bz, fd))
+		for _, dep := range fd.Dependency {
+			s.Require().True(built[dep], "dependency %s of %s is missing", dep, fd.GetName())
+		}
+		built[fd.GetName()] = true
+	}
+	s.Require().True(built["cosmos/bank/v1beta1/tx.proto"])
+	s.Require().True(built["cosmos/bank/v1beta1/query.proto"])
+	s.Require().True(built["cosmos/tx/v1beta1/tx.proto"])
+	s.Require().True(built["cosmos/crypto/secp256k1/keys.proto"])
+
+	msgRes, err := client.GetMsgServicesDescriptor(ctx, &reflectionv2.GetMsgServicesDescriptorRequest{})
+	s.Require().NoError(err)
+
+	var bankMsg *reflectionv2.MsgServiceDescriptor
+	for _, svc := range msgRes.MsgServices.MsgServices {
+		s.Require().NotEqual("cosmos.bank.v1beta1.Query", svc.Fullname)
+		if svc.Fullname == "cosmos.bank.v1beta1.Msg" {
+			bankMsg = svc
+		}
+	}
+	s.Require().NotNil(bankMsg)
+	s.Require().Contains(bankMsg.Methods, &reflectionv2.MsgMethodDescriptor{
+		Name:             "Send",
+		MsgTypeUrl:       sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		ResponseFullname: "cosmos.bank.v1beta1.MsgSendResponse",
+	})
+}
+
 func (s *IntegrationTestSuite) TestGRPCServer_GetTxsEvent() {
 	// Query the tx via gRPC without pagination. This used to panic, see
 	// https://github.com/cosmos/cosmos-sdk/issues/8038.
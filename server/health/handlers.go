@@ -0,0 +1,48 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StateCheckName is the name of the check, part of every report of the
+// endpoints, failing when the node's state cannot be loaded.
+const StateCheckName = "state"
+
+// RegisterRoutes registers the node health endpoints on the router:
+// "/health/live", reporting the liveness checks, and "/health/ready", reporting
+// the liveness and readiness checks. They respond with the report as JSON, with
+// a 200 status if it is healthy or else 503. ctxFn returns the context of the
+// node's latest state the checks run against.
+func RegisterRoutes(r *mux.Router, registry *Registry, ctxFn func() (sdk.Context, error)) {
+	r.HandleFunc("/health/live", handler(registry.Liveness, ctxFn)).Methods("GET")
+	r.HandleFunc("/health/ready", handler(registry.Readiness, ctxFn)).Methods("GET")
+}
+
+func handler(run func(sdk.Context) Report, ctxFn func() (sdk.Context, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		var report Report
+		if ctx, err := ctxFn(); err != nil {
+			report = Report{
+				Status: StatusFailing,
+				Checks: []CheckResult{{Name: StateCheckName, Status: StatusFailing, Error: err.Error()}},
+			}
+		} else {
+			report = run(ctx)
+			report.Checks = append([]CheckResult{{Name: StateCheckName, Status: StatusOK}}, report.Checks...)
+		}
+
+		status := http.StatusOK
+		if !report.Healthy() {
+			status = http.StatusServiceUnavailable
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(report)
+	}
+}
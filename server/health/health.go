@@ -0,0 +1,122 @@
+package health
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Statuses of the health checks and reports.
+const (
+	StatusOK      = "ok"
+	StatusFailing = "failing"
+)
+
+// Registry holds the liveness and readiness checks registered by the modules.
+// It implements sdk.HealthCheckRegistry.
+type Registry struct {
+	liveness  map[string]sdk.HealthCheck
+	readiness map[string]sdk.HealthCheck
+}
+
+var _ sdk.HealthCheckRegistry = (*Registry)(nil)
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		liveness:  make(map[string]sdk.HealthCheck),
+		readiness: make(map[string]sdk.HealthCheck),
+	}
+}
+
+// RegisterLivenessCheck implements sdk.HealthCheckRegistry. It panics if a
+// liveness check is already registered with the same name.
+func (r *Registry) RegisterLivenessCheck(name string, check sdk.HealthCheck) {
+	register(r.liveness, "liveness", name, check)
+}
+
+// RegisterReadinessCheck implements sdk.HealthCheckRegistry. It panics if a
+// readiness check is already registered with the same name.
+func (r *Registry) RegisterReadinessCheck(name string, check sdk.HealthCheck) {
+	register(r.readiness, "readiness", name, check)
+}
+
+func register(checks map[string]sdk.HealthCheck, kind, name string, check sdk.HealthCheck) {
+	if _, ok := checks[name]; ok {
+		panic(fmt.Sprintf("%s check %s already registered", kind, name))
+	}
+
+	checks[name] = check
+}
+
+// CheckResult is the result of a health check.
+type CheckResult struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Report is the result of a set of health checks, failing if any of them does.
+type Report struct {
+	Status string        `json:"status"`
+	Checks []CheckResult `json:"checks"`
+}
+
+// Healthy returns whether all the checks of the report passed.
+func (r Report) Healthy() bool {
+	return r.Status == StatusOK
+}
+
+// Liveness runs the liveness checks against the given context.
+func (r *Registry) Liveness(ctx sdk.Context) Report {
+	return run(ctx, r.liveness)
+}
+
+// Readiness runs the liveness and readiness checks against the given context,
+// as a node which is not live is not ready either.
+func (r *Registry) Readiness(ctx sdk.Context) Report {
+	checks := make(map[string]sdk.HealthCheck, len(r.liveness)+len(r.readiness))
+	for name, check := range r.liveness {
+		checks[name] = check
+	}
+	for name, check := range r.readiness {
+		checks[name] = check
+	}
+
+	return run(ctx, checks)
+}
+
+// run runs the given checks, in order of name, against a branch of the context
+// so that they cannot modify it.
+func run(ctx sdk.Context, checks map[string]sdk.HealthCheck) Report {
+	names := make([]string, 0, len(checks))
+	for name := range checks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	report := Report{Status: StatusOK, Checks: make([]CheckResult, 0, len(names))}
+	for _, name := range names {
+		result := CheckResult{Name: name, Status: StatusOK}
+		if err := runCheck(ctx, checks[name]); err != nil {
+			result.Status = StatusFailing
+			result.Error = err.Error()
+			report.Status = StatusFailing
+		}
+		report.Checks = append(report.Checks, result)
+	}
+
+	return report
+}
+
+func runCheck(ctx sdk.Context, check sdk.HealthCheck) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	cacheCtx, _ := ctx.CacheContext()
+	return check(cacheCtx)
+}
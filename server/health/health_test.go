@@ -0,0 +1,82 @@
+package health_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/server/health"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func newContext() sdk.Context {
+	return sdk.NewContext(store.NewCommitMultiStore(dbm.NewMemDB()), tmproto.Header{Height: 10}, false, log.NewNopLogger())
+}
+
+func TestRegistry(t *testing.T) {
+	registry := health.NewRegistry()
+	registry.RegisterLivenessCheck("db", func(sdk.Context) error { return nil })
+	registry.RegisterReadinessCheck("oracle", func(ctx sdk.Context) error {
+		if ctx.BlockHeight() > 5 {
+			return errors.New("stale prices")
+		}
+		return nil
+	})
+	registry.RegisterReadinessCheck("panics", func(sdk.Context) error { panic("boom") })
+	require.Panics(t, func() { registry.RegisterLivenessCheck("db", func(sdk.Context) error { return nil }) })
+
+	ctx := newContext()
+	require.Equal(t, health.Report{
+		Status: health.StatusOK,
+		Checks: []health.CheckResult{{Name: "db", Status: health.StatusOK}},
+	}, registry.Liveness(ctx))
+
+	require.Equal(t, health.Report{
+		Status: health.StatusFailing,
+		Checks: []health.CheckResult{
+			{Name: "db", Status: health.StatusOK},
+			{Name: "oracle", Status: health.StatusFailing, Error: "stale prices"},
+			{Name: "panics", Status: health.StatusFailing, Error: "panic: boom"},
+		},
+	}, registry.Readiness(ctx))
+}
+
+func TestRegisterRoutes(t *testing.T) {
+	registry := health.NewRegistry()
+	registry.RegisterReadinessCheck("oracle", func(sdk.Context) error { return errors.New("stale prices") })
+
+	var ctxErr error
+	router := mux.NewRouter()
+	health.RegisterRoutes(router, registry, func() (sdk.Context, error) { return newContext(), ctxErr })
+
+	get := func(path string) (int, health.Report) {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		var report health.Report
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+		return rec.Code, report
+	}
+
+	code, report := get("/health/live")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, []health.CheckResult{{Name: health.StateCheckName, Status: health.StatusOK}}, report.Checks)
+
+	code, report = get("/health/ready")
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.Equal(t, health.StatusFailing, report.Status)
+	require.Len(t, report.Checks, 2)
+
+	ctxErr = errors.New("no state")
+	code, report = get("/health/live")
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.Equal(t, []health.CheckResult{{Name: health.StateCheckName, Status: health.StatusFailing, Error: "no state"}}, report.Checks)
+}
@@ -22,7 +22,7 @@ func GenerateCoinKey(algo keyring.SignatureAlgo) (sdk.AccAddress, string, error)
 		return sdk.AccAddress{}, "", err
 	}
 
-	return sdk.AccAddress(info.GetPubKey().Address()), secret, nil
+	return sdk.AccAddressFromPubKey(info.GetPubKey()), secret, nil
 }
 
 // Deprecated: GenerateSaveCoinKey generates a new key mnemonic with its addrress.
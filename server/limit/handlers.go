@@ -0,0 +1,122 @@
+package limit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+// BroadcastTxMethod is the full gRPC method name of the tx service broadcast
+// endpoint, which is governed by the broadcast limiter rather than the query
+// limiter.
+const BroadcastTxMethod = "/cosmos.tx.v1beta1.Service/BroadcastTx"
+
+// broadcastPaths are the REST routes broadcasting transactions.
+var broadcastPaths = map[string]bool{
+	"/cosmos/tx/v1beta1/txs": true,
+	"/txs":                   true,
+}
+
+// UnaryServerInterceptor returns a gRPC interceptor limiting the concurrency
+// of broadcast requests with the broadcast limiter and of all other requests
+// with the query limiter. Either limiter may be nil.
+func UnaryServerInterceptor(query, broadcast *Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		l := query
+		if info.FullMethod == BroadcastTxMethod {
+			l = broadcast
+		}
+
+		release, err := l.Acquire(ctx)
+		if err != nil {
+			return nil, status.Error(grpcCode(err), err.Error())
+		}
+		defer release()
+
+		return handler(ctx, req)
+	}
+}
+
+// Middleware returns an HTTP middleware limiting the concurrency of REST
+// broadcast requests with the broadcast limiter and of all other requests with
+// the query limiter. Either limiter may be nil.
+func Middleware(query, broadcast *Limiter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			l := query
+			if IsBroadcastRequest(r) {
+				l = broadcast
+			}
+
+			release, err := l.Acquire(r.Context())
+			if err != nil {
+				rest.WriteErrorResponse(w, httpStatus(err), err.Error())
+				return
+			}
+			defer release()
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// errQueryOnly is the error of the broadcast requests of a query-only node.
+var errQueryOnly = sdkerrors.Wrap(sdkerrors.ErrQueryOnly, "transactions are not accepted")
+
+// RejectBroadcastUnaryServerInterceptor returns a gRPC interceptor rejecting
+// the broadcast requests of a query-only node with an Unimplemented status.
+func RejectBroadcastUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if info.FullMethod == BroadcastTxMethod {
+			return nil, status.Error(codes.Unimplemented, errQueryOnly.Error())
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// RejectBroadcastMiddleware is an HTTP middleware rejecting the REST broadcast
+// requests of a query-only node with a 501 Not Implemented status.
+func RejectBroadcastMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if IsBroadcastRequest(r) {
+			rest.WriteErrorResponse(w, http.StatusNotImplemented, errQueryOnly.Error())
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// IsBroadcastRequest returns true if the HTTP request targets one of the REST
+// transaction broadcast routes.
+func IsBroadcastRequest(r *http.Request) bool {
+	return r.Method == http.MethodPost && broadcastPaths[strings.TrimSuffix(r.URL.Path, "/")]
+}
+
+func grpcCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, ErrQueueFull):
+		return codes.ResourceExhausted
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	default:
+		return codes.DeadlineExceeded
+	}
+}
+
+func httpStatus(err error) int {
+	if errors.Is(err, ErrQueueFull) {
+		return http.StatusTooManyRequests
+	}
+
+	return http.StatusServiceUnavailable
+}
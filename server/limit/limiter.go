@@ -0,0 +1,150 @@
+// Package limit implements bounded concurrency for the node's gRPC and REST
+// query and broadcast handlers. Requests beyond the configured concurrency wait
+// in a bounded queue, and are rejected once the queue is full or their wait
+// exceeds the configured timeout, instead of spawning an unbounded number of
+// handler goroutines under load.
+package limit
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+var (
+	// ErrQueueFull is returned by Acquire when both all execution slots and
+	// the wait queue are occupied.
+	ErrQueueFull = errors.New("request queue is full")
+
+	// ErrQueueTimeout is returned by Acquire when a request waited in the queue
+	// for longer than the configured timeout.
+	ErrQueueTimeout = errors.New("timed out waiting for an available request slot")
+)
+
+// Metric keys emitted by a Limiter. All keys are prefixed with the limiter's
+// name, e.g. "grpc_query_in_flight".
+const (
+	MetricKeyInFlight = "in_flight"
+	MetricKeyQueued   = "queued"
+	MetricKeyWaitTime = "wait_time"
+	MetricKeyRejected = "rejected"
+	MetricKeyTimedOut = "timed_out"
+)
+
+// Limiter bounds the number of requests that may be handled concurrently.
+// Requests exceeding the limit wait in a bounded queue until a slot frees up
+// or the queue timeout elapses. A nil *Limiter places no bound on concurrency,
+// which allows callers to unconditionally wrap handlers.
+type Limiter struct {
+	name    string
+	slots   chan struct{}
+	queue   chan struct{}
+	timeout time.Duration
+
+	inFlight int64
+	queued   int64
+}
+
+// NewLimiter returns a Limiter allowing up to maxConcurrent requests to be
+// handled at once, with up to maxQueued requests waiting for at most timeout
+// (zero means waiting until the caller's context is done). If maxConcurrent is
+// zero, nil is returned, i.e. concurrency is unbounded.
+func NewLimiter(name string, maxConcurrent, maxQueued uint, timeout time.Duration) *Limiter {
+	if maxConcurrent == 0 {
+		return nil
+	}
+
+	return &Limiter{
+		name:    name,
+		slots:   make(chan struct{}, maxConcurrent),
+		queue:   make(chan struct{}, maxQueued),
+		timeout: timeout,
+	}
+}
+
+// Acquire reserves an execution slot, blocking while the limiter is saturated.
+// On success, the returned release function must be called exactly once when
+// the request has been handled.
+func (l *Limiter) Acquire(ctx context.Context) (release func(), err error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	// fast path: a slot is immediately available
+	select {
+	case l.slots <- struct{}{}:
+		return l.acquired(), nil
+	default:
+	}
+
+	// all slots are taken, so try to wait in the queue
+	select {
+	case l.queue <- struct{}{}:
+	default:
+		telemetry.IncrCounter(1, l.name, MetricKeyRejected)
+		return nil, ErrQueueFull
+	}
+
+	telemetry.SetGauge(float32(atomic.AddInt64(&l.queued, 1)), l.name, MetricKeyQueued)
+	start := time.Now()
+	defer func() {
+		<-l.queue
+		telemetry.SetGauge(float32(atomic.AddInt64(&l.queued, -1)), l.name, MetricKeyQueued)
+		telemetry.MeasureSince(start, l.name, MetricKeyWaitTime)
+	}()
+
+	var timeoutCh <-chan time.Time
+	if l.timeout > 0 {
+		timer := time.NewTimer(l.timeout)
+		defer timer.Stop()
+		timeoutCh = timer.C
+	}
+
+	select {
+	case l.slots <- struct{}{}:
+		return l.acquired(), nil
+
+	case <-timeoutCh:
+		telemetry.IncrCounter(1, l.name, MetricKeyTimedOut)
+		return nil, ErrQueueTimeout
+
+	case <-ctx.Done():
+		telemetry.IncrCounter(1, l.name, MetricKeyTimedOut)
+		return nil, ctx.Err()
+	}
+}
+
+// InFlight returns the number of requests currently holding a slot.
+func (l *Limiter) InFlight() int {
+	if l == nil {
+		return 0
+	}
+
+	return int(atomic.LoadInt64(&l.inFlight))
+}
+
+// Queued returns the number of requests currently waiting for a slot.
+func (l *Limiter) Queued() int {
+	if l == nil {
+		return 0
+	}
+
+	return int(atomic.LoadInt64(&l.queued))
+}
+
+func (l *Limiter) acquired() func() {
+	telemetry.SetGauge(float32(atomic.AddInt64(&l.inFlight, 1)), l.name, MetricKeyInFlight)
+
+	var released int32
+	return func() {
+		if !atomic.CompareAndSwapInt32(&released, 0, 1) {
+			return
+		}
+
+		telemetry.SetGauge(float32(atomic.AddInt64(&l.inFlight, -1)), l.name, MetricKeyInFlight)
+		<-l.slots
+	}
+}
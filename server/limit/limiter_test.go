@@ -0,0 +1,164 @@
+package limit_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/server/limit"
+)
+
+func TestNilLimiterIsUnbounded(t *testing.T) {
+	l := limit.NewLimiter("test", 0, 0, 0)
+	require.Nil(t, l)
+
+	for i := 0; i < 10; i++ {
+		_, err := l.Acquire(context.Background())
+		require.NoError(t, err)
+	}
+	require.Zero(t, l.InFlight())
+	require.Zero(t, l.Queued())
+}
+
+func TestLimiterQueueFull(t *testing.T) {
+	l := limit.NewLimiter("test", 1, 0, 0)
+
+	release, err := l.Acquire(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, l.InFlight())
+
+	_, err = l.Acquire(context.Background())
+	require.ErrorIs(t, err, limit.ErrQueueFull)
+
+	release()
+	// releasing twice must not free an additional slot
+	release()
+	require.Zero(t, l.InFlight())
+
+	release, err = l.Acquire(context.Background())
+	require.NoError(t, err)
+	release()
+}
+
+func TestLimiterQueueTimeout(t *testing.T) {
+	l := limit.NewLimiter("test", 1, 1, 10*time.Millisecond)
+
+	release, err := l.Acquire(context.Background())
+	require.NoError(t, err)
+	defer release()
+
+	_, err = l.Acquire(context.Background())
+	require.ErrorIs(t, err, limit.ErrQueueTimeout)
+	require.Zero(t, l.Queued())
+}
+
+func TestLimiterQueueContextDone(t *testing.T) {
+	l := limit.NewLimiter("test", 1, 1, 0)
+
+	release, err := l.Acquire(context.Background())
+	require.NoError(t, err)
+	defer release()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = l.Acquire(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestLimiterQueuedRequestAcquiresReleasedSlot(t *testing.T) {
+	l := limit.NewLimiter("test", 1, 1, time.Second)
+
+	release, err := l.Acquire(context.Background())
+	require.NoError(t, err)
+
+	done := make(chan error)
+	go func() {
+		r, err := l.Acquire(context.Background())
+		if err == nil {
+			r()
+		}
+		done <- err
+	}()
+
+	require.Eventually(t, func() bool { return l.Queued() == 1 }, time.Second, time.Millisecond)
+	release()
+	require.NoError(t, <-done)
+	require.Zero(t, l.InFlight())
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	query := limit.NewLimiter("query", 1, 0, 0)
+	broadcast := limit.NewLimiter("broadcast", 1, 0, 0)
+	interceptor := limit.UnaryServerInterceptor(query, broadcast)
+
+	release, err := query.Acquire(context.Background())
+	require.NoError(t, err)
+	defer release()
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+	_, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/cosmos.bank.v1beta1.Query/Balance"}, handler)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	res, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: limit.BroadcastTxMethod}, handler)
+	require.NoError(t, err)
+	require.Equal(t, "ok", res)
+	require.Zero(t, broadcast.InFlight())
+}
+
+func TestMiddleware(t *testing.T) {
+	query := limit.NewLimiter("query", 1, 0, 0)
+	broadcast := limit.NewLimiter("broadcast", 1, 0, 0)
+	h := limit.Middleware(query, broadcast)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	release, err := broadcast.Acquire(context.Background())
+	require.NoError(t, err)
+	defer release()
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/cosmos/tx/v1beta1/txs", nil))
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/cosmos/tx/v1beta1/txs", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestRejectBroadcastUnaryServerInterceptor(t *testing.T) {
+	interceptor := limit.RejectBroadcastUnaryServerInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+	res, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/cosmos.bank.v1beta1.Query/Balance"}, handler)
+	require.NoError(t, err)
+	require.Equal(t, "ok", res)
+
+	_, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: limit.BroadcastTxMethod}, handler)
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+	require.Contains(t, err.Error(), "node is query-only")
+}
+
+func TestRejectBroadcastMiddleware(t *testing.T) {
+	h := limit.RejectBroadcastMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	for _, path := range []string{"/cosmos/tx/v1beta1/txs", "/txs"} {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, nil))
+		require.Equal(t, http.StatusNotImplemented, rec.Code)
+		require.Contains(t, rec.Body.String(), "node is query-only")
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/cosmos/tx/v1beta1/txs", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+}
@@ -0,0 +1,100 @@
+package parquet
+
+import "encoding/binary"
+
+// Thrift compact protocol types.
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// thriftWriter encodes the Parquet metadata structs with the Thrift compact
+// protocol. Only the types used by the metadata are supported.
+type thriftWriter struct {
+	buf []byte
+	// lastIDs is the stack of the last field ids of the structs being written,
+	// as field ids are encoded as a delta to the previous one.
+	lastIDs []int16
+}
+
+func (w *thriftWriter) beginStruct() {
+	w.lastIDs = append(w.lastIDs, 0)
+}
+
+func (w *thriftWriter) endStruct() {
+	w.buf = append(w.buf, 0) // stop field
+	w.lastIDs = w.lastIDs[:len(w.lastIDs)-1]
+}
+
+func (w *thriftWriter) fieldHeader(id int16, typ byte) {
+	last := &w.lastIDs[len(w.lastIDs)-1]
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		w.buf = append(w.buf, byte(delta)<<4|typ)
+	} else {
+		w.buf = append(w.buf, typ)
+		w.varint(int64(id))
+	}
+	*last = id
+}
+
+// varint writes a zigzag encoded signed integer.
+func (w *thriftWriter) varint(v int64) {
+	w.uvarint(uint64((v << 1) ^ (v >> 63)))
+}
+
+func (w *thriftWriter) uvarint(v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], v)
+	w.buf = append(w.buf, b[:n]...)
+}
+
+func (w *thriftWriter) i32Field(id int16, v int32) {
+	w.fieldHeader(id, thriftI32)
+	w.varint(int64(v))
+}
+
+func (w *thriftWriter) i64Field(id int16, v int64) {
+	w.fieldHeader(id, thriftI64)
+	w.varint(v)
+}
+
+func (w *thriftWriter) stringField(id int16, v string) {
+	w.fieldHeader(id, thriftBinary)
+	w.binary(v)
+}
+
+func (w *thriftWriter) binary(v string) {
+	w.uvarint(uint64(len(v)))
+	w.buf = append(w.buf, v...)
+}
+
+// structField writes the header of a struct field, whose fields are written by
+// fn.
+func (w *thriftWriter) structField(id int16, fn func()) {
+	w.fieldHeader(id, thriftStruct)
+	w.beginStruct()
+	fn()
+	w.endStruct()
+}
+
+// listField writes the header of a list field of n elements of the given type,
+// which are written by the caller.
+func (w *thriftWriter) listField(id int16, elemType byte, n int) {
+	w.fieldHeader(id, thriftList)
+	if n < 15 {
+		w.buf = append(w.buf, byte(n)<<4|elemType)
+	} else {
+		w.buf = append(w.buf, 0xf0|elemType)
+		w.uvarint(uint64(n))
+	}
+}
+
+// structElem writes a struct element of a list, whose fields are written by fn.
+func (w *thriftWriter) structElem(fn func()) {
+	w.beginStruct()
+	fn()
+	w.endStruct()
+}
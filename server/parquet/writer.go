@@ -0,0 +1,234 @@
+// Package parquet writes tables as Apache Parquet files, the columnar format
+// ingested by most analytics warehouses.
+//
+// The writer supports what the state tables need: required string, int64 and
+// bool columns, all stored in a single row group of uncompressed, plain
+// encoded pages.
+package parquet
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	magic     = "PAR1"
+	createdBy = "cosmos-sdk"
+)
+
+// Parquet physical types.
+const (
+	typeBoolean   = 0
+	typeInt64     = 2
+	typeByteArray = 6
+)
+
+// Parquet enum values of the metadata.
+const (
+	repetitionRequired = 0
+	convertedTypeUTF8  = 0
+	encodingPlain      = 0
+	encodingRLE        = 3
+	codecUncompressed  = 0
+	pageTypeData       = 0
+)
+
+// columnChunk is a column of the row group, as written to the file.
+type columnChunk struct {
+	physicalType int32
+	name         string
+	offset       int64
+	size         int64
+}
+
+// Write writes the table as a Parquet file to w.
+func Write(w io.Writer, table *sdk.Table) error {
+	cw := &countingWriter{w: w}
+	if _, err := io.WriteString(cw, magic); err != nil {
+		return err
+	}
+
+	chunks := make([]columnChunk, len(table.Columns))
+	for i, col := range table.Columns {
+		physicalType, values, err := encodeColumn(table, i)
+		if err != nil {
+			return err
+		}
+
+		header := &thriftWriter{}
+		header.beginStruct()
+		header.i32Field(1, pageTypeData)
+		header.i32Field(2, int32(len(values)))
+		header.i32Field(3, int32(len(values)))
+		header.structField(5, func() {
+			header.i32Field(1, int32(len(table.Rows)))
+			header.i32Field(2, encodingPlain)
+			header.i32Field(3, encodingRLE)
+			header.i32Field(4, encodingRLE)
+		})
+		header.endStruct()
+
+		chunks[i] = columnChunk{
+			physicalType: physicalType,
+			name:         col.Name,
+			offset:       cw.n,
+			size:         int64(len(header.buf) + len(values)),
+		}
+		if _, err := cw.Write(header.buf); err != nil {
+			return err
+		}
+		if _, err := cw.Write(values); err != nil {
+			return err
+		}
+	}
+
+	footer := fileMetadata(table, chunks)
+	if _, err := cw.Write(footer); err != nil {
+		return err
+	}
+
+	var size [4]byte
+	binary.LittleEndian.PutUint32(size[:], uint32(len(footer)))
+	if _, err := cw.Write(size[:]); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(cw, magic)
+	return err
+}
+
+// encodeColumn returns the physical type and plain encoded values of the i-th
+// column of the table.
+func encodeColumn(table *sdk.Table, i int) (int32, []byte, error) {
+	col := table.Columns[i]
+
+	var values []byte
+	switch col.Type {
+	case sdk.ColumnTypeString:
+		for _, row := range table.Rows {
+			v, ok := row[i].(string)
+			if !ok {
+				return 0, nil, invalidValueErr(table, i, row[i])
+			}
+
+			var size [4]byte
+			binary.LittleEndian.PutUint32(size[:], uint32(len(v)))
+			values = append(values, size[:]...)
+			values = append(values, v...)
+		}
+		return typeByteArray, values, nil
+
+	case sdk.ColumnTypeInt64:
+		for _, row := range table.Rows {
+			v, ok := row[i].(int64)
+			if !ok {
+				return 0, nil, invalidValueErr(table, i, row[i])
+			}
+
+			var b [8]byte
+			binary.LittleEndian.PutUint64(b[:], uint64(v))
+			values = append(values, b[:]...)
+		}
+		return typeInt64, values, nil
+
+	case sdk.ColumnTypeBool:
+		// Booleans are bit packed, least significant bit first.
+		values = make([]byte, (len(table.Rows)+7)/8)
+		for j, row := range table.Rows {
+			v, ok := row[i].(bool)
+			if !ok {
+				return 0, nil, invalidValueErr(table, i, row[i])
+			}
+			if v {
+				values[j/8] |= 1 << (j % 8)
+			}
+		}
+		return typeBoolean, values, nil
+
+	default:
+		return 0, nil, fmt.Errorf("table %s: unsupported type %d of column %s", table.Name, col.Type, col.Name)
+	}
+}
+
+func invalidValueErr(table *sdk.Table, i int, v interface{}) error {
+	return fmt.Errorf("table %s: invalid value %T for column %s", table.Name, v, table.Columns[i].Name)
+}
+
+// fileMetadata returns the encoded FileMetaData of the file footer.
+func fileMetadata(table *sdk.Table, chunks []columnChunk) []byte {
+	numRows := int64(len(table.Rows))
+
+	w := &thriftWriter{}
+	w.beginStruct()
+	w.i32Field(1, 1) // version
+
+	w.listField(2, thriftStruct, len(table.Columns)+1)
+	w.structElem(func() {
+		w.stringField(4, "schema")
+		w.i32Field(5, int32(len(table.Columns)))
+	})
+	for i, col := range table.Columns {
+		w.structElem(func() {
+			w.i32Field(1, chunks[i].physicalType)
+			w.i32Field(3, repetitionRequired)
+			w.stringField(4, col.Name)
+			if col.Type == sdk.ColumnTypeString {
+				w.i32Field(6, convertedTypeUTF8)
+			}
+		})
+	}
+
+	w.i64Field(3, numRows)
+
+	var totalSize int64
+	for _, c := range chunks {
+		totalSize += c.size
+	}
+
+	w.listField(4, thriftStruct, 1)
+	w.structElem(func() {
+		w.listField(1, thriftStruct, len(chunks))
+		for _, c := range chunks {
+			c := c
+			w.structElem(func() {
+				w.i64Field(2, c.offset)
+				w.structField(3, func() {
+					w.i32Field(1, c.physicalType)
+					w.listField(2, thriftI32, 2)
+					w.varint(encodingPlain)
+					w.varint(encodingRLE)
+					w.listField(3, thriftBinary, 1)
+					w.binary(c.name)
+					w.i32Field(4, codecUncompressed)
+					w.i64Field(5, numRows)
+					w.i64Field(6, c.size)
+					w.i64Field(7, c.size)
+					w.i64Field(9, c.offset)
+				})
+			})
+		}
+		w.i64Field(2, totalSize)
+		w.i64Field(3, numRows)
+	})
+
+	w.stringField(6, createdBy)
+	w.endStruct()
+
+	return w.buf
+}
+
+// countingWriter counts the bytes written, to record the offsets of the
+// column chunks.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,138 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// thriftReader decodes the Thrift compact protocol structs written by
+// thriftWriter, as maps of field ids to values.
+type thriftReader struct {
+	buf []byte
+	pos int
+}
+
+func (r *thriftReader) uvarint() uint64 {
+	v, n := binary.Uvarint(r.buf[r.pos:])
+	r.pos += n
+	return v
+}
+
+func (r *thriftReader) varint() int64 {
+	v := r.uvarint()
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+func (r *thriftReader) value(typ byte) interface{} {
+	switch typ {
+	case thriftI32, thriftI64:
+		return r.varint()
+	case thriftBinary:
+		n := int(r.uvarint())
+		r.pos += n
+		return string(r.buf[r.pos-n : r.pos])
+	case thriftList:
+		header := r.buf[r.pos]
+		r.pos++
+		n := int(header >> 4)
+		if n == 15 {
+			n = int(r.uvarint())
+		}
+		list := make([]interface{}, n)
+		for i := range list {
+			list[i] = r.value(header & 0x0f)
+		}
+		return list
+	case thriftStruct:
+		return r.readStruct()
+	default:
+		panic("unexpected thrift type")
+	}
+}
+
+func (r *thriftReader) readStruct() map[int64]interface{} {
+	fields := make(map[int64]interface{})
+	var id int64
+	for {
+		header := r.buf[r.pos]
+		r.pos++
+		if header == 0 {
+			return fields
+		}
+		if delta := int64(header >> 4); delta != 0 {
+			id += delta
+		} else {
+			id = r.varint()
+		}
+		fields[id] = r.value(header & 0x0f)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	table := sdk.NewTable("balances",
+		sdk.TableColumn{Name: "address", Type: sdk.ColumnTypeString},
+		sdk.TableColumn{Name: "amount", Type: sdk.ColumnTypeInt64},
+		sdk.TableColumn{Name: "frozen", Type: sdk.ColumnTypeBool},
+	)
+	for i := 0; i < 20; i++ {
+		table.AddRow(string(rune('a'+i)), int64(i*1000), i%3 == 0)
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, table))
+	file := buf.Bytes()
+	require.Equal(t, magic, string(file[:4]))
+	require.Equal(t, magic, string(file[len(file)-4:]))
+
+	footerSize := int(binary.LittleEndian.Uint32(file[len(file)-8:]))
+	r := &thriftReader{buf: file, pos: len(file) - 8 - footerSize}
+	metadata := r.readStruct()
+	require.Equal(t, len(file)-8, r.pos)
+	require.Equal(t, int64(20), metadata[3])
+
+	schema := metadata[2].([]interface{})
+	require.Len(t, schema, 4)
+	require.Equal(t, int64(3), schema[0].(map[int64]interface{})[5])
+	require.Equal(t, "address", schema[1].(map[int64]interface{})[4])
+	require.Equal(t, int64(typeByteArray), schema[1].(map[int64]interface{})[1])
+
+	rowGroups := metadata[4].([]interface{})
+	require.Len(t, rowGroups, 1)
+	chunks := rowGroups[0].(map[int64]interface{})[1].([]interface{})
+	require.Len(t, chunks, 3)
+
+	// Read back the values of each column from its data page.
+	var columns [][]byte
+	for _, chunk := range chunks {
+		meta := chunk.(map[int64]interface{})[3].(map[int64]interface{})
+		require.Equal(t, int64(20), meta[5])
+
+		r := &thriftReader{buf: file, pos: int(meta[9].(int64))}
+		page := r.readStruct()
+		size := int(page[3].(int64))
+		require.Equal(t, meta[7], int64(r.pos+size)-meta[9].(int64))
+		require.Equal(t, int64(20), page[5].(map[int64]interface{})[1])
+		columns = append(columns, file[r.pos:r.pos+size])
+	}
+
+	require.Equal(t, []byte{1, 0, 0, 0, 'a', 1, 0, 0, 0, 'b'}, columns[0][:10])
+	require.Equal(t, uint64(19000), binary.LittleEndian.Uint64(columns[1][19*8:]))
+	require.Equal(t, []byte{0x49, 0x92, 0x04}, columns[2])
+}
+
+func TestWriteEmptyTable(t *testing.T) {
+	table := sdk.NewTable("empty", sdk.TableColumn{Name: "address", Type: sdk.ColumnTypeString})
+
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, table))
+
+	file := buf.Bytes()
+	footerSize := int(binary.LittleEndian.Uint32(file[len(file)-8:]))
+	r := &thriftReader{buf: file, pos: len(file) - 8 - footerSize}
+	require.Equal(t, int64(0), r.readStruct()[3])
+}
@@ -0,0 +1,298 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/proxy"
+	"github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	flagFromHeight = "from-height"
+	flagToHeight   = "to-height"
+	flagVerbose    = "verbose"
+	flagWriteSet   = "write-set"
+)
+
+// replayReport is the outcome of a block replay.
+type replayReport struct {
+	FromHeight int64 `json:"from_height"`
+	ToHeight   int64 `json:"to_height"`
+	Txs        int   `json:"txs"`
+	// ResultMismatches lists the transactions whose result differs from the
+	// result stored by Tendermint when the block was first executed.
+	ResultMismatches []string `json:"result_mismatches,omitempty"`
+	// AppHash is the app hash of the last replayed block.
+	AppHash string `json:"app_hash"`
+	// AppHashMismatch is set if the app hash of a replayed block differs from
+	// the app hash agreed upon in the header of the following block.
+	AppHashMismatch string `json:"app_hash_mismatch,omitempty"`
+}
+
+// NewReplayCmd creates a command replaying blocks of the Tendermint block store
+// through the application, against a throwaway copy of the application database.
+func NewReplayCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Replay blocks through the application against a copy of its state",
+		Long: `Replay the blocks of the node block store from --from-height to --to-height through
+the application, against a throwaway copy of the application database rolled back
+to the height preceding --from-height, e.g. to reproduce a consensus failure
+locally. The node home is left untouched. The node should be stopped while the
+databases are read, and the state at the height preceding --from-height must not
+be pruned.
+
+The result of every transaction is compared to the result stored by Tendermint
+when the block was first executed, and the app hash of every block to the app
+hash of the header of the following block. The replay stops at the first app hash
+mismatch, in which case the command exits with an error.
+
+With --verbose, the result and events of every message are logged. With
+--write-set, the store writes and deletes of the replayed blocks are captured to
+the given file, as JSON lines in the format of --trace-store.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			fromHeight, _ := cmd.Flags().GetInt64(flagFromHeight)
+			toHeight, _ := cmd.Flags().GetInt64(flagToHeight)
+			verbose, _ := cmd.Flags().GetBool(flagVerbose)
+			writeSetFile, _ := cmd.Flags().GetString(flagWriteSet)
+
+			blockStoreDB, stateDB, err := openTendermintDBs(config)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = blockStoreDB.Close()
+				_ = stateDB.Close()
+			}()
+
+			blockStore := store.NewBlockStore(blockStoreDB)
+			stateStore := state.NewStore(stateDB)
+			tmState, err := stateStore.Load()
+			if err != nil {
+				return err
+			}
+
+			if toHeight == 0 {
+				toHeight = blockStore.Height()
+			}
+			switch {
+			case fromHeight <= tmState.InitialHeight:
+				return fmt.Errorf("--%s must be above the initial height %d", flagFromHeight, tmState.InitialHeight)
+			case fromHeight < blockStore.Base():
+				return fmt.Errorf("block %d is pruned, the block store starts at height %d", fromHeight, blockStore.Base())
+			case toHeight < fromHeight || toHeight > blockStore.Height():
+				return fmt.Errorf("--%s must be between %d and the block store height %d", flagToHeight, fromHeight, blockStore.Height())
+			}
+
+			tmpDir, err := ioutil.TempDir("", "replay")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(tmpDir)
+
+			if err := CopyDir(filepath.Join(config.RootDir, "data", "application.db"), filepath.Join(tmpDir, "application.db")); err != nil {
+				return fmt.Errorf("failed to copy the application database: %w", err)
+			}
+
+			db, err := sdk.NewLevelDB("application", tmpDir)
+			if err != nil {
+				return err
+			}
+
+			if latest := rootmulti.GetLatestVersion(db); latest < fromHeight-1 {
+				_ = db.Close()
+				return fmt.Errorf("the application state at height %d is behind --%s", latest, flagFromHeight)
+			}
+			rootmulti.NewStore(db).RollbackToVersion(fromHeight - 1)
+
+			var traceWriter io.Writer
+			if writeSetFile != "" {
+				f, err := os.Create(writeSetFile)
+				if err != nil {
+					_ = db.Close()
+					return err
+				}
+				defer f.Close()
+
+				traceWriter = newWriteSetWriter(f)
+			}
+
+			app := &replayApp{Application: appCreator(serverCtx.Logger, db, traceWriter, serverCtx.Viper)}
+			defer app.Close()
+
+			if height := app.Info(abci.RequestInfo{}).LastBlockHeight; height != fromHeight-1 {
+				return fmt.Errorf("failed to load the application state at height %d, got height %d", fromHeight-1, height)
+			}
+
+			client, err := proxy.NewLocalClientCreator(app).NewABCIClient()
+			if err != nil {
+				return err
+			}
+			conn := proxy.NewAppConnConsensus(client)
+
+			report := replayReport{FromHeight: fromHeight, ToHeight: toHeight}
+			for height := fromHeight; height <= toHeight; height++ {
+				block := blockStore.LoadBlock(height)
+				if block == nil {
+					return fmt.Errorf("block %d not found", height)
+				}
+
+				appHash, err := state.ExecCommitBlock(conn, block, serverCtx.Logger, stateStore, tmState.InitialHeight)
+				if err != nil {
+					return fmt.Errorf("failed to replay block %d: %w", height, err)
+				}
+
+				report.Txs += len(app.responses)
+				report.AppHash = fmt.Sprintf("%X", appHash)
+				report.ResultMismatches = append(report.ResultMismatches, compareTxResults(stateStore, height, app.responses)...)
+				if verbose {
+					logTxResults(serverCtx.Logger, height, app.responses)
+				}
+
+				if next := blockStore.LoadBlockMeta(height + 1); next != nil && !bytes.Equal(next.Header.AppHash, appHash) {
+					report.ToHeight = height
+					report.AppHashMismatch = fmt.Sprintf("block %d: replayed app hash %X, agreed app hash %X", height, appHash, next.Header.AppHash)
+					break
+				}
+			}
+
+			bz, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+
+			if report.AppHashMismatch != "" {
+				return fmt.Errorf("app hash mismatch at %s", report.AppHashMismatch)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(flagFromHeight, 0, "The first height to replay")
+	cmd.Flags().Int64(flagToHeight, 0, "The last height to replay, the block store height if 0")
+	cmd.Flags().Bool(flagVerbose, false, "Log the result and events of every message")
+	cmd.Flags().String(flagWriteSet, "", "Capture the store writes of the replayed blocks to the given file")
+	_ = cmd.MarkFlagRequired(flagFromHeight)
+
+	return cmd
+}
+
+// replayApp wraps an application to record the DeliverTx responses of the
+// block being replayed.
+type replayApp struct {
+	types.Application
+
+	responses []abci.ResponseDeliverTx
+}
+
+func (app *replayApp) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	app.responses = app.responses[:0]
+	return app.Application.BeginBlock(req)
+}
+
+func (app *replayApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	res := app.Application.DeliverTx(req)
+	app.responses = append(app.responses, res)
+	return res
+}
+
+// compareTxResults compares the replayed transaction results of a block to the
+// results stored by Tendermint, if any.
+func compareTxResults(stateStore state.Store, height int64, responses []abci.ResponseDeliverTx) []string {
+	stored, err := stateStore.LoadABCIResponses(height)
+	if err != nil {
+		return nil
+	}
+
+	var mismatches []string
+	for i, res := range responses {
+		if i >= len(stored.DeliverTxs) {
+			break
+		}
+
+		if want := stored.DeliverTxs[i]; res.Code != want.Code || res.GasUsed != want.GasUsed || !bytes.Equal(res.Data, want.Data) {
+			mismatches = append(mismatches, fmt.Sprintf(
+				"block %d tx %d: replayed code %d and gas used %d, stored code %d and gas used %d",
+				height, i, res.Code, res.GasUsed, want.Code, want.GasUsed,
+			))
+		}
+	}
+
+	return mismatches
+}
+
+// logTxResults logs the result of every transaction of a block and the events
+// of every message.
+func logTxResults(logger log.Logger, height int64, responses []abci.ResponseDeliverTx) {
+	for i, res := range responses {
+		logger.Info("replayed tx", "height", height, "tx", i, "code", res.Code, "gas_wanted", res.GasWanted, "gas_used", res.GasUsed)
+		if res.Code != 0 {
+			logger.Info("replayed tx failed", "height", height, "tx", i, "codespace", res.Codespace, "log", res.Log)
+			continue
+		}
+
+		msgLogs, err := sdk.ParseABCILogs(res.Log)
+		if err != nil {
+			continue
+		}
+		for _, msgLog := range msgLogs {
+			logger.Info("replayed msg", "height", height, "tx", i, "msg", msgLog.MsgIndex, "events", msgLog.Events.String())
+		}
+	}
+}
+
+// writeSetWriter filters the store trace written to it, keeping the write and
+// delete operations only.
+type writeSetWriter struct {
+	w   io.Writer
+	buf []byte
+}
+
+func newWriteSetWriter(w io.Writer) *writeSetWriter {
+	return &writeSetWriter{w: w}
+}
+
+// Write buffers the trace until the end of each traced operation, written as a
+// JSON line.
+func (ws *writeSetWriter) Write(p []byte) (int, error) {
+	ws.buf = append(ws.buf, p...)
+	for {
+		i := bytes.IndexByte(ws.buf, '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+
+		line := ws.buf[:i+1]
+		if bytes.HasPrefix(line, []byte(`{"operation":"write"`)) || bytes.HasPrefix(line, []byte(`{"operation":"delete"`)) {
+			if _, err := ws.w.Write(line); err != nil {
+				return 0, err
+			}
+		}
+		ws.buf = ws.buf[i+1:]
+	}
+}
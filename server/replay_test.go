@@ -0,0 +1,32 @@
+package server
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteSetWriter(t *testing.T) {
+	var out bytes.Buffer
+	w := newWriteSetWriter(&out)
+
+	// the trace store writes each operation and its line break separately
+	for _, p := range []string{
+		`{"operation":"read","key":"a2V5","value":"","metadata":null}`, "\n",
+		`{"operation":"write","key":"a2V5","value":"dmFsdWU=","metadata":{"blockHeight":2}}`, "\n",
+		`{"operation":"iterKey","key":"a2V5","value":"","metadata":null}`, "\n",
+		`{"operation":"delete","key":"a2V5","value":"","metadata":{"blockHeight":2}}`, "\n",
+		`{"operation":"write",`,
+	} {
+		n, err := w.Write([]byte(p))
+		require.NoError(t, err)
+		require.Equal(t, len(p), n)
+	}
+
+	require.Equal(t,
+		`{"operation":"write","key":"a2V5","value":"dmFsdWU=","metadata":{"blockHeight":2}}`+"\n"+
+			`{"operation":"delete","key":"a2V5","value":"","metadata":{"blockHeight":2}}`+"\n",
+		out.String(),
+	)
+}
@@ -1,19 +1,31 @@
 package server
 
 import (
+	"bytes"
 	"fmt"
 
+	"github.com/spf13/cobra"
+	tmcfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/node"
+	tmstore "github.com/tendermint/tendermint/proto/tendermint/store"
+	"github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	dbm "github.com/tendermint/tm-db"
+
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
-	"github.com/spf13/cobra"
-	tmcmd "github.com/tendermint/tendermint/cmd/tendermint/commands"
+)
+
+const (
+	flagHard    = "hard"
+	flagHeights = "heights"
 )
 
 // NewRollbackCmd creates a command to rollback tendermint and multistore state by one height.
 func NewRollbackCmd(defaultNodeHome string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "rollback",
-		Short: "rollback cosmos-sdk and tendermint state by one height",
+		Short: "rollback cosmos-sdk and tendermint state by one or more heights",
 		Long: `
 A state rollback is performed to recover from an incorrect application state transition,
 when Tendermint has persisted an incorrect app hash and is thus unable to make
@@ -21,17 +33,33 @@ progress. Rollback overwrites a state at height n with the state at height n - 1
 The application also roll back to height n - 1. No blocks are removed, so upon
 restarting Tendermint the transactions in block n will be re-executed against the
 application.
+
+With --hard, the rolled back blocks are also removed from the block store and are
+fetched again from the peers upon restarting. This allows rolling back more than
+one height with --heights.
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := GetServerContextFromCmd(cmd)
 			cfg := ctx.Config
 			home := cfg.RootDir
+
+			hard, _ := cmd.Flags().GetBool(flagHard)
+			heights, _ := cmd.Flags().GetInt64(flagHeights)
+			if heights < 1 {
+				return fmt.Errorf("the number of heights to roll back must be positive, got %d", heights)
+			}
+			if heights > 1 && !hard {
+				return fmt.Errorf("rolling back more than one height requires --%s", flagHard)
+			}
+
 			db, err := openDB(home)
 			if err != nil {
 				return err
 			}
+			defer db.Close()
+
 			// rollback tendermint state
-			height, hash, err := tmcmd.RollbackState(ctx.Config)
+			height, hash, err := rollbackTendermintState(cfg, heights, hard)
 			if err != nil {
 				return fmt.Errorf("failed to rollback tendermint state: %w", err)
 			}
@@ -39,11 +67,171 @@ application.
 			cms := rootmulti.NewStore(db)
 			cms.RollbackToVersion(height)
 
-			fmt.Printf("Rolled back state to height %d and hash %X", height, hash)
+			fmt.Printf("Rolled back state to height %d and hash %X\n", height, hash)
 			return nil
 		},
 	}
 
 	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Bool(flagHard, false, "Also remove the rolled back blocks from the block store")
+	cmd.Flags().Int64(flagHeights, 1, "The number of heights to roll back, more than one requires --hard")
 	return cmd
 }
+
+// rollbackTendermintState rolls the Tendermint state back by the given number
+// of heights, removing the rolled back blocks from the block store if hard is
+// true. It returns the height and app hash of the rolled back state.
+func rollbackTendermintState(cfg *tmcfg.Config, heights int64, hard bool) (int64, []byte, error) {
+	blockStoreDB, stateDB, err := openTendermintDBs(cfg)
+	if err != nil {
+		return -1, nil, err
+	}
+	defer func() {
+		_ = blockStoreDB.Close()
+		_ = stateDB.Close()
+	}()
+
+	var (
+		stateStore = state.NewStore(stateDB)
+		height     int64
+		hash       []byte
+	)
+	for i := int64(0); i < heights; i++ {
+		// the block store is reloaded as deleting the latest block bypasses it
+		blockStore := store.NewBlockStore(blockStoreDB)
+
+		height, hash, err = state.Rollback(blockStore, stateStore)
+		if err != nil {
+			return -1, nil, err
+		}
+
+		if hard {
+			if err := deleteLatestBlock(blockStoreDB, blockStore); err != nil {
+				return -1, nil, fmt.Errorf("failed to remove block %d: %w", blockStore.Height(), err)
+			}
+		}
+	}
+
+	return height, hash, nil
+}
+
+// deleteLatestBlock removes the latest block of the block store, along with its
+// parts and commits, and lowers the height of the block store.
+func deleteLatestBlock(db dbm.DB, bs *store.BlockStore) error {
+	height := bs.Height()
+	if height <= bs.Base() {
+		return fmt.Errorf("cannot remove the base block %d of the block store", height)
+	}
+
+	meta := bs.LoadBlockMeta(height)
+	if meta == nil {
+		return fmt.Errorf("block %d not found", height)
+	}
+
+	batch := db.NewBatch()
+	defer batch.Close()
+
+	keys := [][]byte{
+		[]byte(fmt.Sprintf("H:%v", height)),
+		[]byte(fmt.Sprintf("BH:%x", meta.BlockID.Hash)),
+		[]byte(fmt.Sprintf("C:%v", height)),
+		[]byte(fmt.Sprintf("SC:%v", height)),
+	}
+	for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
+		keys = append(keys, []byte(fmt.Sprintf("P:%v:%v", height, p)))
+	}
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	// lower the height first so that the block store never references a
+	// missing block
+	store.SaveBlockStoreState(&tmstore.BlockStoreState{Base: bs.Base(), Height: height - 1}, db)
+	return batch.WriteSync()
+}
+
+// openTendermintDBs opens the block store and state databases of Tendermint.
+func openTendermintDBs(cfg *tmcfg.Config) (blockStoreDB, stateDB dbm.DB, err error) {
+	blockStoreDB, err = node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stateDB, err = node.DefaultDBProvider(&node.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		_ = blockStoreDB.Close()
+		return nil, nil, err
+	}
+
+	return blockStoreDB, stateDB, nil
+}
+
+// inconsistentStateError is returned by checkStateConsistency when the
+// multistore is inconsistent with the Tendermint state.
+type inconsistentStateError struct {
+	// rollbackHeight is the height the multistore should be rolled back to for
+	// Tendermint to replay the following blocks.
+	rollbackHeight int64
+	reason         string
+}
+
+func (e inconsistentStateError) Error() string {
+	return e.reason
+}
+
+// checkStateConsistency checks that the multistore committed to db is
+// consistent with the Tendermint state, i.e. that Tendermint is able to
+// handshake with the application. It returns an inconsistentStateError on an
+// inconsistency.
+func checkStateConsistency(cfg *tmcfg.Config, db dbm.DB) error {
+	appHeight := rootmulti.GetLatestVersion(db)
+	if appHeight == 0 {
+		return nil
+	}
+
+	blockStoreDB, stateDB, err := openTendermintDBs(cfg)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = blockStoreDB.Close()
+		_ = stateDB.Close()
+	}()
+
+	storeHeight := store.LoadBlockStoreState(blockStoreDB).Height
+	tmState, err := state.NewStore(stateDB).Load()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case tmState.IsEmpty():
+		return nil
+
+	case appHeight > storeHeight:
+		return inconsistentStateError{
+			rollbackHeight: tmState.LastBlockHeight,
+			reason:         fmt.Sprintf("application height %d is ahead of the Tendermint block store height %d", appHeight, storeHeight),
+		}
+
+	case appHeight == tmState.LastBlockHeight:
+		commitInfo, err := rootmulti.GetCommitInfo(db, appHeight)
+		if err != nil {
+			return err
+		}
+
+		if appHash := commitInfo.Hash(); !bytes.Equal(appHash, tmState.AppHash) {
+			return inconsistentStateError{
+				rollbackHeight: appHeight - 1,
+				reason: fmt.Sprintf(
+					"application hash %X at height %d does not match the Tendermint state app hash %X",
+					appHash, appHeight, tmState.AppHash,
+				),
+			}
+		}
+	}
+
+	return nil
+}
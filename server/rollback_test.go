@@ -0,0 +1,47 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestDeleteLatestBlock(t *testing.T) {
+	db := dbm.NewMemDB()
+	bs := store.NewBlockStore(db)
+
+	lastCommit := &tmtypes.Commit{}
+	for height := int64(1); height <= 3; height++ {
+		block := tmtypes.MakeBlock(height, []tmtypes.Tx{[]byte{byte(height)}}, lastCommit, nil)
+		block.ProposerAddress = make([]byte, 20)
+		parts := block.MakePartSet(tmtypes.BlockPartSizeBytes)
+		blockID := tmtypes.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+		lastCommit = tmtypes.NewCommit(height, 0, blockID, []tmtypes.CommitSig{{
+			BlockIDFlag:      tmtypes.BlockIDFlagCommit,
+			ValidatorAddress: make([]byte, 20),
+			Timestamp:        time.Now(),
+			Signature:        []byte("signature"),
+		}})
+		bs.SaveBlock(block, parts, lastCommit)
+	}
+	hash := bs.LoadBlockMeta(3).BlockID.Hash
+
+	require.NoError(t, deleteLatestBlock(db, bs))
+
+	bs = store.NewBlockStore(db)
+	require.Equal(t, int64(2), bs.Height())
+	require.Nil(t, bs.LoadBlockMeta(3))
+	require.Nil(t, bs.LoadBlockByHash(hash))
+	require.Nil(t, bs.LoadBlockPart(3, 0))
+	require.Nil(t, bs.LoadSeenCommit(3))
+	require.NotNil(t, bs.LoadBlock(2))
+	require.NotNil(t, bs.LoadSeenCommit(2))
+
+	require.NoError(t, deleteLatestBlock(db, bs))
+	bs = store.NewBlockStore(db)
+	require.Error(t, deleteLatestBlock(db, bs), "the base block cannot be removed")
+}
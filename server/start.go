@@ -3,6 +3,8 @@ package server
 // DONTCOVER
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
@@ -25,10 +27,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/filter"
 	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
 	"github.com/cosmos/cosmos-sdk/server/rosetta"
 	crgserver "github.com/cosmos/cosmos-sdk/server/rosetta/lib/server"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 )
 
@@ -46,13 +50,20 @@ const (
 	FlagUnsafeSkipUpgrades = "unsafe-skip-upgrades"
 	FlagTrace              = "trace"
 	FlagInvCheckPeriod     = "inv-check-period"
-
-	FlagPruning           = "pruning"
-	FlagPruningKeepRecent = "pruning-keep-recent"
-	FlagPruningKeepEvery  = "pruning-keep-every"
-	FlagPruningInterval   = "pruning-interval"
-	FlagIndexEvents       = "index-events"
-	FlagMinRetainBlocks   = "min-retain-blocks"
+	FlagQueryOnly          = "query-only"
+	FlagAutoRollback       = "auto-rollback"
+
+	FlagPruning            = "pruning"
+	FlagPruningKeepRecent  = "pruning-keep-recent"
+	FlagPruningKeepEvery   = "pruning-keep-every"
+	FlagPruningInterval    = "pruning-interval"
+	FlagIndexEvents        = "index-events"
+	FlagEmitTxEvents       = "emit-tx-events"
+	FlagMaxGasWanted       = "max-gas-wanted"
+	FlagMaxFeeMultiple     = "max-fee-multiple"
+	FlagTxPriorityFeeTiers = "tx-priority-fee-tiers"
+	FlagTxPriorityMsgs     = "tx-priority-msgs"
+	FlagMinRetainBlocks    = "min-retain-blocks"
 
 	// state sync-related flags
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
@@ -152,6 +163,13 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune Tendermint blocks")
+	cmd.Flags().Bool(FlagEmitTxEvents, false, "Emit an event summarizing the fee, gas, size, signers and messages of every delivered transaction")
+	cmd.Flags().Uint64(FlagMaxGasWanted, 0, "Maximum gas a transaction can want to be accepted in the mempool (0 disables the limit)")
+	cmd.Flags().Uint64(FlagMaxFeeMultiple, 0, "Maximum multiple of the fee required by the minimum gas prices a transaction can pay to be accepted in the mempool (0 disables the limit)")
+	cmd.Flags().StringSlice(FlagTxPriorityFeeTiers, nil, "Gas prices of the fee tiers of the tx priority, from the lowest to the highest (tiers of several gas prices can only be set in app.toml)")
+	cmd.Flags().StringSlice(FlagTxPriorityMsgs, nil, "Type URLs of the messages whose txs have a priority above all the fee tiers, e.g. /cosmos.gov.v1beta1.MsgVote")
+	cmd.Flags().Bool(FlagQueryOnly, false, "Run a query-only node, rejecting transactions in CheckTx and on the broadcast endpoints")
+	cmd.Flags().Bool(FlagAutoRollback, false, "Roll the application state back on startup if it is inconsistent with the Tendermint state, e.g. after a crash")
 
 	cmd.Flags().Bool(flagGRPCOnly, false, "Start the node in gRPC query only mode (no Tendermint process is started)")
 	cmd.Flags().Bool(flagGRPCEnable, true, "Define if the gRPC server should be enabled")
@@ -202,6 +220,10 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 		if err = svr.Stop(); err != nil {
 			tmos.Exit(err.Error())
 		}
+
+		if err = app.Close(); err != nil {
+			ctx.Logger.Error("failed to close the application", "err", err)
+		}
 	}()
 
 	// Wait for SIGINT or SIGTERM signal
@@ -242,6 +264,28 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		return err
 	}
 
+	// Check that Tendermint is able to handshake with the application before
+	// loading it, so that the multistore can still be rolled back.
+	if !ctx.Viper.GetBool(flagGRPCOnly) {
+		var stateErr inconsistentStateError
+		switch err := checkStateConsistency(cfg, db); {
+		case err == nil:
+
+		case !errors.As(err, &stateErr):
+			return fmt.Errorf("failed to check the application state: %w", err)
+
+		case ctx.Viper.GetBool(FlagAutoRollback):
+			ctx.Logger.Error("inconsistent application state; rolling back", "err", err, "height", stateErr.rollbackHeight)
+			rootmulti.NewStore(db).RollbackToVersion(stateErr.rollbackHeight)
+
+		default:
+			return fmt.Errorf(
+				"%w; roll the application state back to height %d with --%s, or with the rollback command",
+				err, stateErr.rollbackHeight, FlagAutoRollback,
+			)
+		}
+	}
+
 	config := config.GetConfig(ctx.Viper)
 	if err := config.ValidateBasic(); err != nil {
 		ctx.Logger.Error("WARNING: The minimum-gas-prices config in app.toml is set to the empty string. " +
@@ -251,6 +295,58 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 
 	app := appCreator(ctx.Logger, db, traceWriter, ctx.Viper)
 
+	var (
+		tmNode     *node.Node
+		apiSrv     *api.Server
+		grpcSrv    *grpc.Server
+		grpcWebSrv *http.Server
+	)
+
+	// On shutdown, the API and gRPC servers first refuse the new requests and
+	// drain the in-flight ones, then Tendermint is stopped so that no more ABCI
+	// requests are delivered, and finally the application waits for the
+	// snapshot being taken, if any, and closes its databases.
+	defer func() {
+		shutdownTimeout := time.Duration(config.ShutdownTimeout) * time.Second
+		ctx.Logger.Info("shutting down...", "timeout", shutdownTimeout)
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		if apiSrv != nil {
+			if err := apiSrv.Shutdown(shutdownCtx); err != nil {
+				ctx.Logger.Error("failed to drain the API server", "err", err)
+			}
+		}
+
+		if grpcWebSrv != nil {
+			if err := grpcWebSrv.Shutdown(shutdownCtx); err != nil {
+				ctx.Logger.Error("failed to drain the gRPC-web server", "err", err)
+				_ = grpcWebSrv.Close()
+			}
+		}
+
+		if grpcSrv != nil {
+			deadline, _ := shutdownCtx.Deadline()
+			servergrpc.StopGRPCServer(grpcSrv, time.Until(deadline))
+		}
+
+		if tmNode != nil && tmNode.IsRunning() {
+			_ = tmNode.Stop()
+			tmNode.Wait()
+		}
+
+		if err := app.Close(); err != nil {
+			ctx.Logger.Error("failed to close the application", "err", err)
+		}
+
+		if cpuProfileCleanup != nil {
+			cpuProfileCleanup()
+		}
+
+		ctx.Logger.Info("exiting...")
+	}()
+
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
 	if err != nil {
 		return err
@@ -258,10 +354,7 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 
 	genDocProvider := node.DefaultGenesisDocProviderFunc(cfg)
 
-	var (
-		tmNode   *node.Node
-		gRPCOnly = ctx.Viper.GetBool(flagGRPCOnly)
-	)
+	gRPCOnly := ctx.Viper.GetBool(flagGRPCOnly)
 
 	if gRPCOnly {
 		ctx.Logger.Info("starting node in gRPC only mode; Tendermint is disabled")
@@ -297,7 +390,11 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		app.RegisterTendermintService(clientCtx)
 	}
 
-	var apiSrv *api.Server
+	queryFilter, err := filter.NewQueryFilter(config.QueryFilter.Allowed, config.QueryFilter.Denied)
+	if err != nil {
+		return err
+	}
+
 	if config.API.Enable {
 		genDoc, err := genDocProvider()
 		if err != nil {
@@ -308,6 +405,9 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 
 		apiSrv = api.New(clientCtx, ctx.Logger.With("module", "api-server"))
 		app.RegisterAPIRoutes(apiSrv, config.API)
+		if err := apiSrv.SetQueryFilter(queryFilter, servergrpc.ServiceDescs(app)); err != nil {
+			return err
+		}
 		errCh := make(chan error)
 
 		go func() {
@@ -324,13 +424,8 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		}
 	}
 
-	var (
-		grpcSrv    *grpc.Server
-		grpcWebSrv *http.Server
-	)
-
 	if config.GRPC.Enable {
-		grpcSrv, err = servergrpc.StartGRPCServer(clientCtx, app, config.GRPC.Address)
+		grpcSrv, err = servergrpc.StartGRPCServer(clientCtx, app, config.GRPC, config.QueryOnly, queryFilter)
 		if err != nil {
 			return err
 		}
@@ -393,29 +488,6 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		}
 	}
 
-	defer func() {
-		if tmNode.IsRunning() {
-			_ = tmNode.Stop()
-		}
-
-		if cpuProfileCleanup != nil {
-			cpuProfileCleanup()
-		}
-
-		if apiSrv != nil {
-			_ = apiSrv.Close()
-		}
-
-		if grpcSrv != nil {
-			grpcSrv.Stop()
-			if grpcWebSrv != nil {
-				grpcWebSrv.Close()
-			}
-		}
-
-		ctx.Logger.Info("exiting...")
-	}()
-
 	// wait for signal capture and gracefully return
 	return WaitForQuitSignals()
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // ServerStartTime defines the time duration that the server need to stay running after startup
@@ -51,6 +52,10 @@ type (
 
 		// RegisterTendermintService registers the gRPC Query service for tendermint queries.
 		RegisterTendermintService(clientCtx client.Context)
+
+		// Close is called once the node stopped, to release the resources of the
+		// application, e.g. its databases.
+		Close() error
 	}
 
 	// AppCreator is a function that allows us to lazily initialize an
@@ -76,4 +81,9 @@ type (
 	// AppExporter is a function that dumps all app state to
 	// JSON-serializable structure and returns the current validator set.
 	AppExporter func(log.Logger, dbm.DB, io.Writer, int64, bool, []string, AppOptions) (ExportedApp, error)
+
+	// TableExporter is a function that converts the exported app state of the
+	// given modules, or of all the modules exporting tables if none is given,
+	// to tables by module name.
+	TableExporter func(appState json.RawMessage, modules []string) (map[string][]*sdk.Table, error)
 )
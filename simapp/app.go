@@ -13,6 +13,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -21,21 +22,28 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/health"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/vm"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authrest "github.com/cosmos/cosmos-sdk/x/auth/client/rest"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/session"
+	sessionkeeper "github.com/cosmos/cosmos-sdk/x/auth/session/keeper"
+	sessionmodule "github.com/cosmos/cosmos-sdk/x/auth/session/module"
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authproposal "github.com/cosmos/cosmos-sdk/x/auth/types/proposal"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
@@ -44,6 +52,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/batch"
+	batchkeeper "github.com/cosmos/cosmos-sdk/x/batch/keeper"
+	batchmodule "github.com/cosmos/cosmos-sdk/x/batch/module"
+	"github.com/cosmos/cosmos-sdk/x/blocktime"
+	blocktimekeeper "github.com/cosmos/cosmos-sdk/x/blocktime/keeper"
+	blocktimetypes "github.com/cosmos/cosmos-sdk/x/blocktime/types"
 	"github.com/cosmos/cosmos-sdk/x/capability"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
@@ -57,11 +71,23 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	evidencekeeper "github.com/cosmos/cosmos-sdk/x/evidence/keeper"
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/x/feeconversion"
+	feeconversionkeeper "github.com/cosmos/cosmos-sdk/x/feeconversion/keeper"
+	feeconversiontypes "github.com/cosmos/cosmos-sdk/x/feeconversion/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	feemarketkeeper "github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	"github.com/cosmos/cosmos-sdk/x/genesishash"
+	genesishashkeeper "github.com/cosmos/cosmos-sdk/x/genesishash/keeper"
+	genesishashtypes "github.com/cosmos/cosmos-sdk/x/genesishash/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/cosmos/cosmos-sdk/x/globalfee"
+	globalfeekeeper "github.com/cosmos/cosmos-sdk/x/globalfee/keeper"
+	globalfeetypes "github.com/cosmos/cosmos-sdk/x/globalfee/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -73,12 +99,18 @@ import (
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/cosmos/cosmos-sdk/x/precompile"
+	precompilekeeper "github.com/cosmos/cosmos-sdk/x/precompile/keeper"
+	precompilemodule "github.com/cosmos/cosmos-sdk/x/precompile/module"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/x/txresult"
+	txresultkeeper "github.com/cosmos/cosmos-sdk/x/txresult/keeper"
+	txresulttypes "github.com/cosmos/cosmos-sdk/x/txresult/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
 	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
@@ -107,6 +139,7 @@ var (
 		distr.AppModuleBasic{},
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distrclient.ProposalHandler, upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
+			auth.ProposalHandler, auth.BlocklistProposalHandler, vesting.ProposalHandler, crisis.ProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -115,17 +148,28 @@ var (
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
 		authzmodule.AppModuleBasic{},
+		batchmodule.AppModuleBasic{},
+		txresult.AppModuleBasic{},
+		globalfee.AppModuleBasic{},
+		feemarket.AppModuleBasic{},
+		feeconversion.AppModuleBasic{},
+		blocktime.AppModuleBasic{},
+		genesishash.AppModuleBasic{},
+		sessionmodule.AppModuleBasic{},
+		precompilemodule.AppModuleBasic{},
 		vesting.AppModuleBasic{},
 	)
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:     nil,
-		distrtypes.ModuleName:          nil,
-		minttypes.ModuleName:           {authtypes.Minter},
-		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:            {authtypes.Burner},
+		authtypes.FeeCollectorName:        nil,
+		distrtypes.ModuleName:             nil,
+		minttypes.ModuleName:              {authtypes.Minter},
+		stakingtypes.BondedPoolName:       {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:    {authtypes.Burner, authtypes.Staking},
+		stakingtypes.BondDenomReserveName: nil,
+		govtypes.ModuleName:               {authtypes.Burner},
+		feemarkettypes.FeeReserveName:     nil,
 	}
 )
 
@@ -143,28 +187,47 @@ type SimApp struct {
 	appCodec          codec.Codec
 	interfaceRegistry types.InterfaceRegistry
 
-	invCheckPeriod uint
+	invCheckPeriod      uint
+	unknownFieldsPolicy authtx.UnknownFieldsPolicy
 
 	// keys to access the substores
 	keys    map[string]*sdk.KVStoreKey
 	tkeys   map[string]*sdk.TransientStoreKey
 	memKeys map[string]*sdk.MemoryStoreKey
 
+	// module account permissions, including the ones of the extensions
+	maccPerms map[string][]string
+
+	// extensions plugged into the app
+	extensions []AppExtension
+
 	// keepers
-	AccountKeeper    authkeeper.AccountKeeper
-	BankKeeper       bankkeeper.Keeper
-	CapabilityKeeper *capabilitykeeper.Keeper
-	StakingKeeper    stakingkeeper.Keeper
-	SlashingKeeper   slashingkeeper.Keeper
-	MintKeeper       mintkeeper.Keeper
-	DistrKeeper      distrkeeper.Keeper
-	GovKeeper        govkeeper.Keeper
-	CrisisKeeper     crisiskeeper.Keeper
-	UpgradeKeeper    upgradekeeper.Keeper
-	ParamsKeeper     paramskeeper.Keeper
-	AuthzKeeper      authzkeeper.Keeper
-	EvidenceKeeper   evidencekeeper.Keeper
-	FeeGrantKeeper   feegrantkeeper.Keeper
+	AccountKeeper       authkeeper.AccountKeeper
+	BankKeeper          bankkeeper.Keeper
+	CapabilityKeeper    *capabilitykeeper.Keeper
+	StakingKeeper       stakingkeeper.Keeper
+	SlashingKeeper      slashingkeeper.Keeper
+	MintKeeper          mintkeeper.Keeper
+	DistrKeeper         distrkeeper.Keeper
+	GovKeeper           govkeeper.Keeper
+	CrisisKeeper        crisiskeeper.Keeper
+	UpgradeKeeper       upgradekeeper.Keeper
+	ParamsKeeper        paramskeeper.Keeper
+	AuthzKeeper         authzkeeper.Keeper
+	BatchKeeper         batchkeeper.Keeper
+	TxResultKeeper      txresultkeeper.Keeper
+	GlobalFeeKeeper     globalfeekeeper.Keeper
+	FeeMarketKeeper     feemarketkeeper.Keeper
+	FeeConversionKeeper feeconversionkeeper.Keeper
+	BlockTimeKeeper     blocktimekeeper.Keeper
+	GenesisHashKeeper   genesishashkeeper.Keeper
+	SessionKeeper       sessionkeeper.Keeper
+	PrecompileKeeper    precompilekeeper.Keeper
+	EvidenceKeeper      evidencekeeper.Keeper
+	FeeGrantKeeper      feegrantkeeper.Keeper
+
+	// QueryPlugins are the queries contracts can make into the app modules
+	QueryPlugins *vm.QueryPlugins
 
 	// the module manager
 	mm *module.Manager
@@ -174,6 +237,12 @@ type SimApp struct {
 
 	// module configurator
 	configurator module.Configurator
+
+	// the health checks of the modules, served on the API server
+	healthChecks *health.Registry
+
+	// the names of the decorators of the ante handler, served on the API server
+	anteDecorators []string
 }
 
 func init() {
@@ -191,12 +260,34 @@ func NewSimApp(
 	homePath string, invCheckPeriod uint, encodingConfig simappparams.EncodingConfig,
 	appOpts servertypes.AppOptions, baseAppOptions ...func(*baseapp.BaseApp),
 ) *SimApp {
+	return NewSimAppWithExtensions(
+		logger, db, traceStore, loadLatest, skipUpgradeHeights, homePath, invCheckPeriod, encodingConfig,
+		appOpts, nil, baseAppOptions...,
+	)
+}
+
+// NewSimAppWithExtensions returns a reference to an initialized SimApp with the
+// given extension modules. The amino codec of the encoding config must have
+// been built with ModuleBasicsWithExtensions.
+func NewSimAppWithExtensions(
+	logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool, skipUpgradeHeights map[int64]bool,
+	homePath string, invCheckPeriod uint, encodingConfig simappparams.EncodingConfig,
+	appOpts servertypes.AppOptions, extensions []AppExtension, baseAppOptions ...func(*baseapp.BaseApp),
+) *SimApp {
 
 	appCodec := encodingConfig.Marshaler
 	legacyAmino := encodingConfig.Amino
 	interfaceRegistry := encodingConfig.InterfaceRegistry
 
-	bApp := baseapp.NewBaseApp(appName, logger, db, encodingConfig.TxConfig.TxDecoder(), baseAppOptions...)
+	unknownFieldsPolicy := authtx.UnknownFieldsPolicy{
+		RejectNonCritical: cast.ToBool(appOpts.Get(auth.FlagRejectUnknownNonCriticalFields)),
+	}
+	txDecoder := encodingConfig.TxConfig.TxDecoder()
+	if protoCodec, ok := appCodec.(codec.ProtoCodecMarshaler); ok {
+		txDecoder = authtx.DefaultTxDecoderWithPolicy(protoCodec, unknownFieldsPolicy)
+	}
+
+	bApp := baseapp.NewBaseApp(appName, logger, db, txDecoder, baseAppOptions...)
 	bApp.SetCommitMultiStoreTracer(traceStore)
 	bApp.SetVersion(version.Version)
 	bApp.SetInterfaceRegistry(interfaceRegistry)
@@ -206,22 +297,32 @@ func NewSimApp(
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey,
 		evidencetypes.StoreKey, capabilitytypes.StoreKey,
-		authzkeeper.StoreKey,
+		authzkeeper.StoreKey, txresulttypes.StoreKey, session.StoreKey,
+		precompile.StoreKey, feemarkettypes.StoreKey, blocktimetypes.StoreKey, genesishashtypes.StoreKey,
 	)
+	for _, ext := range extensions {
+		ext.RegisterInterfaces(interfaceRegistry)
+		for _, name := range ext.StoreKeys() {
+			keys[name] = sdk.NewKVStoreKey(name)
+		}
+	}
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	// NOTE: The testingkey is just mounted for testing purposes. Actual applications should
 	// not include this key.
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey, "testingkey")
 
 	app := &SimApp{
-		BaseApp:           bApp,
-		legacyAmino:       legacyAmino,
-		appCodec:          appCodec,
-		interfaceRegistry: interfaceRegistry,
-		invCheckPeriod:    invCheckPeriod,
-		keys:              keys,
-		tkeys:             tkeys,
-		memKeys:           memKeys,
+		BaseApp:             bApp,
+		legacyAmino:         legacyAmino,
+		appCodec:            appCodec,
+		interfaceRegistry:   interfaceRegistry,
+		invCheckPeriod:      invCheckPeriod,
+		unknownFieldsPolicy: unknownFieldsPolicy,
+		keys:                keys,
+		tkeys:               tkeys,
+		memKeys:             memKeys,
+		maccPerms:           ModuleAccountPermissions(extensions...),
+		extensions:          extensions,
 	}
 
 	app.ParamsKeeper = initParamsKeeper(appCodec, legacyAmino, keys[paramstypes.StoreKey], tkeys[paramstypes.TStoreKey])
@@ -230,20 +331,33 @@ func NewSimApp(
 	bApp.SetParamStore(app.ParamsKeeper.Subspace(baseapp.Paramspace).WithKeyTable(paramskeeper.ConsensusParamsKeyTable()))
 
 	app.CapabilityKeeper = capabilitykeeper.NewKeeper(appCodec, keys[capabilitytypes.StoreKey], memKeys[capabilitytypes.MemStoreKey])
+	scopedKeepers := make(map[string]capabilitykeeper.ScopedKeeper, len(extensions))
+	for _, ext := range extensions {
+		scopedKeepers[ext.Name()] = app.CapabilityKeeper.ScopeToModule(ext.Name())
+	}
 	// Applications that wish to enforce statically created ScopedKeepers should call `Seal` after creating
 	// their scoped modules in `NewApp` with `ScopeToModule`
 	app.CapabilityKeeper.Seal()
 
 	// add keepers
 	app.AccountKeeper = authkeeper.NewAccountKeeper(
-		appCodec, keys[authtypes.StoreKey], app.GetSubspace(authtypes.ModuleName), authtypes.ProtoBaseAccount, maccPerms,
+		appCodec, keys[authtypes.StoreKey], app.GetSubspace(authtypes.ModuleName), authtypes.ProtoBaseAccount, app.maccPerms,
+		sdk.NewBech32AddressCodec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
 	)
-	app.BankKeeper = bankkeeper.NewBaseKeeper(
+	bankKeeper := bankkeeper.NewBaseKeeper(
 		appCodec, keys[banktypes.StoreKey], app.AccountKeeper, app.GetSubspace(banktypes.ModuleName), app.ModuleAccountAddrs(),
 	)
+	// the addresses of the governance blocklist of auth can neither send nor
+	// receive funds
+	app.BankKeeper = *bankKeeper.SetBlocklistKeeper(app.AccountKeeper)
+	// report the balances of the module accounts in the auth module account queries
+	app.AccountKeeper.SetBalanceKeeper(app.BankKeeper)
 	stakingKeeper := stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName),
 	)
+	// accept the denoms weighted by governance for delegations, converted with
+	// the bond denom reserve
+	stakingKeeper.SetBondDenomProvider(stakingkeeper.NewReserveBondDenomProvider(stakingKeeper, stakingtypes.BondDenomReserveName))
 	app.MintKeeper = mintkeeper.NewKeeper(
 		appCodec, keys[minttypes.StoreKey], app.GetSubspace(minttypes.ModuleName), &stakingKeeper,
 		app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName,
@@ -252,9 +366,17 @@ func NewSimApp(
 		appCodec, keys[distrtypes.StoreKey], app.GetSubspace(distrtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, authtypes.FeeCollectorName, app.ModuleAccountAddrs(),
 	)
+	var livenessMetrics []sdk.ConsAddress
+	for _, addr := range cast.ToStringSlice(appOpts.Get(slashing.FlagLivenessMetrics)) {
+		consAddr, err := sdk.ConsAddressFromBech32(addr)
+		if err != nil {
+			panic(err)
+		}
+		livenessMetrics = append(livenessMetrics, consAddr)
+	}
 	app.SlashingKeeper = slashingkeeper.NewKeeper(
 		appCodec, keys[slashingtypes.StoreKey], &stakingKeeper, app.GetSubspace(slashingtypes.ModuleName),
-	)
+	).WithLivenessMetrics(livenessMetrics...)
 	app.CrisisKeeper = crisiskeeper.NewKeeper(
 		app.GetSubspace(crisistypes.ModuleName), invCheckPeriod, app.BankKeeper, authtypes.FeeCollectorName,
 	)
@@ -270,12 +392,46 @@ func NewSimApp(
 
 	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], appCodec, app.BaseApp.MsgServiceRouter())
 
+	app.BatchKeeper = batchkeeper.NewKeeper(app.BaseApp.MsgServiceRouter())
+
+	app.TxResultKeeper = txresultkeeper.NewKeeper(
+		appCodec, keys[txresulttypes.StoreKey], app.GetSubspace(txresulttypes.ModuleName),
+	)
+
+	app.GlobalFeeKeeper = globalfeekeeper.NewKeeper(app.GetSubspace(globalfeetypes.ModuleName))
+
+	app.FeeConversionKeeper = feeconversionkeeper.NewKeeper(app.GetSubspace(feeconversiontypes.ModuleName))
+
+	app.FeeMarketKeeper = feemarketkeeper.NewKeeper(
+		appCodec, keys[feemarkettypes.StoreKey], app.GetSubspace(feemarkettypes.ModuleName),
+	)
+	// the fees can be paid in the denoms accepted by x/feeconversion too
+	app.FeeMarketKeeper.SetFeeConversionKeeper(app.FeeConversionKeeper)
+
+	app.BlockTimeKeeper = blocktimekeeper.NewKeeper(
+		appCodec, keys[blocktimetypes.StoreKey], app.GetSubspace(blocktimetypes.ModuleName),
+	)
+
+	app.GenesisHashKeeper = genesishashkeeper.NewKeeper(appCodec, keys[genesishashtypes.StoreKey])
+
+	app.SessionKeeper = sessionkeeper.NewKeeper(appCodec, keys[session.StoreKey])
+
+	app.PrecompileKeeper = precompilekeeper.NewKeeper(appCodec, keys[precompile.StoreKey], app.BankKeeper)
+
+	extModules, extProposalRoutes := app.buildExtensions(extensions, scopedKeepers, appOpts)
+
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
-		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper))
+		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
+		AddRoute(authproposal.RouterKey, auth.NewModuleAccountPermissionsProposalHandler(app.AccountKeeper)).
+		AddRoute(vestingtypes.RouterKey, vesting.NewUpdateVestingScheduleProposalHandler(app.AccountKeeper)).
+		AddRoute(crisistypes.RouterKey, crisis.NewInvariantRemediationProposalHandler(&app.CrisisKeeper))
+	for route, handler := range extProposalRoutes {
+		govRouter.AddRoute(route, handler)
+	}
 	govKeeper := govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, govRouter,
@@ -283,7 +439,8 @@ func NewSimApp(
 
 	app.GovKeeper = *govKeeper.SetHooks(
 		govtypes.NewMultiGovHooks(
-		// register the governance hooks
+			// register the governance hooks
+			app.SlashingKeeper.Hooks(),
 		),
 	)
 
@@ -302,13 +459,13 @@ func NewSimApp(
 
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
-	app.mm = module.NewManager(
+	modules := []module.AppModule{
 		genutil.NewAppModule(
 			app.AccountKeeper, app.StakingKeeper, app.BaseApp.DeliverTx,
 			encodingConfig.TxConfig,
 		),
 		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
-		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
+		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
@@ -322,41 +479,60 @@ func NewSimApp(
 		evidence.NewAppModule(app.EvidenceKeeper),
 		params.NewAppModule(app.ParamsKeeper),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
-	)
+		batchmodule.NewAppModule(app.BatchKeeper),
+		txresult.NewAppModule(app.TxResultKeeper),
+		globalfee.NewAppModule(app.GlobalFeeKeeper),
+		feemarket.NewAppModule(app.FeeMarketKeeper),
+		feeconversion.NewAppModule(app.FeeConversionKeeper),
+		blocktime.NewAppModule(app.BlockTimeKeeper),
+		genesishash.NewAppModule(app.GenesisHashKeeper),
+		sessionmodule.NewAppModule(app.SessionKeeper),
+		precompilemodule.NewAppModule(app.PrecompileKeeper),
+	}
+	app.mm = module.NewManager(append(modules, extModules...)...)
+
+	// the extensions run after the SimApp modules
+	extNames := make([]string, len(extensions))
+	for i, ext := range extensions {
+		extNames[i] = ext.Name()
+	}
 
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
 	// NOTE: staking module is required if HistoricalEntries param > 0
 	// NOTE: capability module's beginblocker must come before any modules using capabilities (e.g. IBC)
-	app.mm.SetOrderBeginBlockers(
+	app.mm.SetOrderBeginBlockers(append([]string{
 		upgradetypes.ModuleName, capabilitytypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
 		evidencetypes.ModuleName, stakingtypes.ModuleName,
 		authtypes.ModuleName, banktypes.ModuleName, govtypes.ModuleName, crisistypes.ModuleName, genutiltypes.ModuleName,
-		authz.ModuleName, feegrant.ModuleName,
+		authz.ModuleName, feegrant.ModuleName, batch.ModuleName, txresulttypes.ModuleName, globalfeetypes.ModuleName, feemarkettypes.ModuleName, feeconversiontypes.ModuleName, blocktimetypes.ModuleName, genesishashtypes.ModuleName, session.ModuleName, precompile.ModuleName,
 		paramstypes.ModuleName, vestingtypes.ModuleName,
-	)
-	app.mm.SetOrderEndBlockers(
-		crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName,
+	}, extNames...)...)
+	// NOTE: slashing auto unjails validators before staking updates the validator set
+	app.mm.SetOrderEndBlockers(append([]string{
+		crisistypes.ModuleName, govtypes.ModuleName, slashingtypes.ModuleName, stakingtypes.ModuleName,
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName,
-		slashingtypes.ModuleName, minttypes.ModuleName,
+		minttypes.ModuleName,
 		genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
-		feegrant.ModuleName,
+		feegrant.ModuleName, batch.ModuleName, txresulttypes.ModuleName, globalfeetypes.ModuleName, feemarkettypes.ModuleName, feeconversiontypes.ModuleName, blocktimetypes.ModuleName, genesishashtypes.ModuleName, session.ModuleName, precompile.ModuleName,
 		paramstypes.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName,
-	)
+	}, extNames...)...)
+	// the blocker orders can be changed by param change proposals
+	app.mm.SetParamStore(app.ParamsKeeper.Subspace(module.OrderParamspace).WithKeyTable(paramskeeper.BlockerOrderParamsKeyTable(app.mm)))
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
 	// NOTE: Capability module must occur first so that it can initialize any capabilities
 	// so that other modules that want to create or claim capabilities afterwards in InitChain
 	// can do so safely.
-	app.mm.SetOrderInitGenesis(
+	app.mm.SetOrderInitGenesis(append([]string{
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, stakingtypes.ModuleName,
 		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName, crisistypes.ModuleName,
 		genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
-		feegrant.ModuleName,
+		feegrant.ModuleName, batch.ModuleName, txresulttypes.ModuleName, globalfeetypes.ModuleName, feemarkettypes.ModuleName, feeconversiontypes.ModuleName, blocktimetypes.ModuleName, genesishashtypes.ModuleName, session.ModuleName, precompile.ModuleName,
 		paramstypes.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName,
-	)
+	}, extNames...)...)
 
 	// Uncomment if you want to set a custom migration order here.
 	// app.mm.SetOrderMigrations(custom order)
@@ -365,6 +541,8 @@ func NewSimApp(
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 	app.mm.RegisterServices(app.configurator)
+	app.healthChecks = health.NewRegistry()
+	app.mm.RegisterHealthChecks(app.healthChecks)
 
 	// add test gRPC service for testing gRPC queries in isolation
 	testdata.RegisterQueryServer(app.GRPCQueryRouter(), testdata.QueryImpl{})
@@ -387,6 +565,11 @@ func NewSimApp(
 		evidence.NewAppModule(app.EvidenceKeeper),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 	)
+	for _, m := range extModules {
+		if simModule, ok := m.(module.AppModuleSimulation); ok {
+			app.sm.Modules = append(app.sm.Modules, simModule)
+		}
+	}
 
 	app.sm.RegisterStoreDecoders()
 
@@ -399,13 +582,27 @@ func NewSimApp(
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 
-	anteHandler, err := ante.NewAnteHandler(
+	anteBuilder, err := ante.NewAnteHandlerBuilder(
 		ante.HandlerOptions{
 			AccountKeeper:   app.AccountKeeper,
 			BankKeeper:      app.BankKeeper,
 			SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
 			FeegrantKeeper:  app.FeeGrantKeeper,
+			SessionKeeper:   app.SessionKeeper,
+			TxHashKeeper:    app.TxResultKeeper,
+			GlobalFeeKeeper: app.GlobalFeeKeeper,
+			FeeMarketKeeper: app.FeeMarketKeeper,
 			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+
+			AuthenticationKeeper: app.AccountKeeper,
+			BlocklistKeeper:      app.AccountKeeper,
+			FeeConverter: feemarketkeeper.NewReserveFeeConverter(
+				app.FeeMarketKeeper, app.AccountKeeper, app.BankKeeper, feemarkettypes.FeeReserveName,
+			),
+
+			ExtensionOptions: extensionOptionRegistry(extensions),
+			MaxGasWanted:     cast.ToUint64(appOpts.Get(server.FlagMaxGasWanted)),
+			MaxFeeMultiple:   cast.ToUint64(appOpts.Get(server.FlagMaxFeeMultiple)),
 		},
 	)
 
@@ -413,13 +610,36 @@ func NewSimApp(
 		panic(err)
 	}
 
-	app.SetAnteHandler(anteHandler)
+	app.anteDecorators = anteBuilder.Names()
+	app.SetAnteHandler(anteBuilder.Build())
 	app.SetEndBlocker(app.EndBlocker)
+	app.SetDeliverTxHook(app.TxResultKeeper.RecordTxResult)
+	app.SetPrepareProposal(app.mm.PrepareProposal)
+	app.SetProcessProposal(app.mm.ProcessProposal)
+
+	if manager := app.SnapshotManager(); manager != nil {
+		for _, ext := range extensions {
+			if snapshotExt, ok := ext.(SnapshotExtension); ok {
+				if err := manager.RegisterExtensions(snapshotExt.SnapshotExtensions()...); err != nil {
+					panic(err)
+				}
+			}
+		}
+	}
 
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
 		}
+
+		// make sure the bech32 prefixes configured are the ones of the network
+		ctx := app.NewContext(true, tmproto.Header{})
+		if err := app.AccountKeeper.ValidateAddressPrefix(ctx); err != nil {
+			tmos.Exit(err.Error())
+		}
+		if err := app.StakingKeeper.ValidateAddressPrefix(ctx); err != nil {
+			tmos.Exit(err.Error())
+		}
 	}
 
 	return app
@@ -445,6 +665,7 @@ func (app *SimApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.
 		panic(err)
 	}
 	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
+	app.GenesisHashKeeper.RecordGenesisHash(ctx, req)
 	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
@@ -456,7 +677,7 @@ func (app *SimApp) LoadHeight(height int64) error {
 // ModuleAccountAddrs returns all the app's module account addresses.
 func (app *SimApp) ModuleAccountAddrs() map[string]bool {
 	modAccAddrs := make(map[string]bool)
-	for acc := range maccPerms {
+	for acc := range app.maccPerms {
 		modAccAddrs[authtypes.NewModuleAddress(acc).String()] = true
 	}
 
@@ -533,16 +754,33 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 	// Register legacy and grpc-gateway routes for all modules.
 	ModuleBasics.RegisterRESTRoutes(clientCtx, apiSvr.Router)
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	for _, ext := range app.extensions {
+		ext.RegisterRESTRoutes(clientCtx, apiSvr.Router)
+		ext.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	}
+
+	// Register the health endpoints.
+	health.RegisterRoutes(apiSvr.Router, app.healthChecks, func() (sdk.Context, error) {
+		return app.CreateQueryContext(0, false)
+	})
+
+	// Register the ante decorators debug endpoint.
+	authrest.RegisterAnteDecoratorsRoute(apiSvr.Router, app.anteDecorators)
 
 	// register swagger API from root so that other applications can override easily
 	if apiConfig.Swagger {
+		// serve the OpenAPI document generated from the registered services in
+		// place of the static one bundled with the swagger UI
+		if err := apiSvr.RegisterOpenAPI(app.GRPCQueryRouter().ServiceDescs()); err != nil {
+			panic(err)
+		}
 		RegisterSwaggerAPI(clientCtx, apiSvr.Router)
 	}
 }
 
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *SimApp) RegisterTxService(clientCtx client.Context) {
-	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
+	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.BaseApp.SimulateBundle, app.BaseApp.EstimateGas, app.BaseApp.TraceTx, app.interfaceRegistry, app.unknownFieldsPolicy)
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
@@ -582,6 +820,11 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(slashingtypes.ModuleName)
 	paramsKeeper.Subspace(govtypes.ModuleName).WithKeyTable(govtypes.ParamKeyTable())
 	paramsKeeper.Subspace(crisistypes.ModuleName)
+	paramsKeeper.Subspace(txresulttypes.ModuleName)
+	paramsKeeper.Subspace(globalfeetypes.ModuleName)
+	paramsKeeper.Subspace(feemarkettypes.ModuleName)
+	paramsKeeper.Subspace(feeconversiontypes.ModuleName)
+	paramsKeeper.Subspace(blocktimetypes.ModuleName)
 
 	return paramsKeeper
 }
@@ -17,21 +17,30 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	sessionmodule "github.com/cosmos/cosmos-sdk/x/auth/session/module"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	batchmodule "github.com/cosmos/cosmos-sdk/x/batch/module"
+	"github.com/cosmos/cosmos-sdk/x/blocktime"
 	"github.com/cosmos/cosmos-sdk/x/capability"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
+	"github.com/cosmos/cosmos-sdk/x/feeconversion"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/genesishash"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/globalfee"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	precompilemodule "github.com/cosmos/cosmos-sdk/x/precompile/module"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/txresult"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 )
 
@@ -123,8 +132,23 @@ func TestRunMigrations(t *testing.T) {
 			false, "", true, "no migrations found for module bank: not found", 0,
 		},
 		{
-			"can register and run migration handler for x/bank",
+			"can register 1->2 migration handler for x/bank, cannot run migration",
 			"bank", 1,
+			false, "", true, "no migration found for module bank from version 2 to version 3: not found", 0,
+		},
+		{
+			"can register 2->3 migration handler for x/bank, cannot run migration",
+			"bank", 2,
+			false, "", true, "no migration found for module bank from version 3 to version 4: not found", 0,
+		},
+		{
+			"can register 3->4 migration handler for x/bank, cannot run migration",
+			"bank", 3,
+			false, "", true, "no migration found for module bank from version 4 to version 5: not found", 0,
+		},
+		{
+			"can register 4->5 migration handler for x/bank, can run migration",
+			"bank", 4,
 			false, "", false, "", 1,
 		},
 		{
@@ -166,22 +190,31 @@ func TestRunMigrations(t *testing.T) {
 			_, err = app.mm.RunMigrations(
 				app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()}), app.configurator,
 				module.VersionMap{
-					"bank":         1,
-					"auth":         auth.AppModule{}.ConsensusVersion(),
-					"authz":        authzmodule.AppModule{}.ConsensusVersion(),
-					"staking":      staking.AppModule{}.ConsensusVersion(),
-					"mint":         mint.AppModule{}.ConsensusVersion(),
-					"distribution": distribution.AppModule{}.ConsensusVersion(),
-					"slashing":     slashing.AppModule{}.ConsensusVersion(),
-					"gov":          gov.AppModule{}.ConsensusVersion(),
-					"params":       params.AppModule{}.ConsensusVersion(),
-					"upgrade":      upgrade.AppModule{}.ConsensusVersion(),
-					"vesting":      vesting.AppModule{}.ConsensusVersion(),
-					"feegrant":     feegrantmodule.AppModule{}.ConsensusVersion(),
-					"evidence":     evidence.AppModule{}.ConsensusVersion(),
-					"crisis":       crisis.AppModule{}.ConsensusVersion(),
-					"genutil":      genutil.AppModule{}.ConsensusVersion(),
-					"capability":   capability.AppModule{}.ConsensusVersion(),
+					"bank":          1,
+					"auth":          auth.AppModule{}.ConsensusVersion(),
+					"authz":         authzmodule.AppModule{}.ConsensusVersion(),
+					"batch":         batchmodule.AppModule{}.ConsensusVersion(),
+					"staking":       staking.AppModule{}.ConsensusVersion(),
+					"mint":          mint.AppModule{}.ConsensusVersion(),
+					"distribution":  distribution.AppModule{}.ConsensusVersion(),
+					"slashing":      slashing.AppModule{}.ConsensusVersion(),
+					"gov":           gov.AppModule{}.ConsensusVersion(),
+					"params":        params.AppModule{}.ConsensusVersion(),
+					"upgrade":       upgrade.AppModule{}.ConsensusVersion(),
+					"vesting":       vesting.AppModule{}.ConsensusVersion(),
+					"feegrant":      feegrantmodule.AppModule{}.ConsensusVersion(),
+					"evidence":      evidence.AppModule{}.ConsensusVersion(),
+					"crisis":        crisis.AppModule{}.ConsensusVersion(),
+					"genutil":       genutil.AppModule{}.ConsensusVersion(),
+					"capability":    capability.AppModule{}.ConsensusVersion(),
+					"txresult":      txresult.AppModule{}.ConsensusVersion(),
+					"globalfee":     globalfee.AppModule{}.ConsensusVersion(),
+					"feemarket":     feemarket.AppModule{}.ConsensusVersion(),
+					"feeconversion": feeconversion.AppModule{}.ConsensusVersion(),
+					"blocktime":     blocktime.AppModule{}.ConsensusVersion(),
+					"genesishash":   genesishash.AppModule{}.ConsensusVersion(),
+					"session":       sessionmodule.AppModule{}.ConsensusVersion(),
+					"precompile":    precompilemodule.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
@@ -1,6 +1,7 @@
 package simapp
 
 import (
+	"bytes"
 	"encoding/json"
 	"log"
 
@@ -29,15 +30,20 @@ func (app *SimApp) ExportAppStateAndValidators(
 		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
 	}
 
-	genState := app.mm.ExportGenesis(ctx, app.appCodec)
-	appState, err := json.MarshalIndent(genState, "", "  ")
-	if err != nil {
+	// stream the modules supporting it instead of marshaling their state at once
+	var genState bytes.Buffer
+	if err := app.mm.ExportGenesisToWriter(ctx, app.appCodec, &genState); err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	var appState bytes.Buffer
+	if err := json.Indent(&appState, genState.Bytes(), "", "  "); err != nil {
 		return servertypes.ExportedApp{}, err
 	}
 
 	validators, err := staking.WriteValidators(ctx, app.StakingKeeper)
 	return servertypes.ExportedApp{
-		AppState:        appState,
+		AppState:        appState.Bytes(),
 		Validators:      validators,
 		Height:          height,
 		ConsensusParams: app.BaseApp.GetConsensusParams(ctx),
@@ -0,0 +1,209 @@
+package simapp
+
+import (
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/types/vm"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// app.toml keys of the gas config of the contract modules, in the [wasm]
+// section read by x/wasm.
+const (
+	FlagWasmGasMultiplier      = "wasm.gas_multiplier"
+	FlagWasmQueryGasLimit      = "wasm.query_gas_limit"
+	FlagWasmSimulationGasLimit = "wasm.simulation_gas_limit"
+)
+
+// AppExtension is a module plugged into SimApp by NewSimAppWithExtensions along
+// with its keeper wiring, such as x/wasm, so that it can be added without
+// modifying NewSimApp. The extension module begins and ends blocks after the
+// SimApp modules, and initializes its genesis after all of them, so that it can
+// rely on their state, e.g. to run genesis messages.
+//
+// An extension can additionally implement ProposalExtension,
+// QueryPluginExtension, SnapshotExtension, ModuleAccountExtension and
+// ExtensionOptionsExtension. Its
+// module is added to the simulation manager if it implements
+// module.AppModuleSimulation.
+type AppExtension interface {
+	module.AppModuleBasic
+
+	// StoreKeys returns the names of the KV stores mounted for the extension.
+	StoreKeys() []string
+
+	// Build builds the module of the extension once the keepers of SimApp are
+	// created.
+	Build(app *SimApp, deps ExtensionDeps) module.AppModule
+}
+
+// ExtensionDeps are the dependencies SimApp builds an AppExtension with.
+type ExtensionDeps struct {
+	// Keys are the store keys of the extension, by name.
+	Keys map[string]*sdk.KVStoreKey
+	// ScopedKeeper is the capability keeper scoped to the extension module.
+	ScopedKeeper capabilitykeeper.ScopedKeeper
+	// MsgRouter executes Msgs on behalf of the extension module.
+	MsgRouter msgservice.MsgRouter
+	// QueryPlugins are the queries contracts can make into the other modules.
+	// They are complete once all the extensions are built.
+	QueryPlugins *vm.QueryPlugins
+	// GasConfig is the VM gas config read from app.toml.
+	GasConfig vm.GasConfig
+	// AppOpts are the options SimApp is built with.
+	AppOpts servertypes.AppOptions
+}
+
+// ProposalExtension is implemented by extensions handling governance proposals.
+type ProposalExtension interface {
+	// ProposalRoutes returns the governance proposal handlers of the extension
+	// by route, once the extension is built.
+	ProposalRoutes() map[string]govtypes.Handler
+}
+
+// QueryPluginExtension is implemented by extensions exposing queries to
+// contracts.
+type QueryPluginExtension interface {
+	// RegisterQueryPlugins registers the queries of the extension once all the
+	// extensions are built.
+	RegisterQueryPlugins(plugins *vm.QueryPlugins)
+}
+
+// SnapshotExtension is implemented by extensions with state outside of the
+// multistore, e.g. contract code, to include in state sync snapshots.
+type SnapshotExtension interface {
+	// SnapshotExtensions returns the snapshotters of the extension, once the
+	// extension is built.
+	SnapshotExtensions() []snapshottypes.ExtensionSnapshotter
+}
+
+// ModuleAccountExtension is implemented by extensions owning a module account.
+type ModuleAccountExtension interface {
+	// ModuleAccountPermissions returns the permissions of the module account of
+	// the extension.
+	ModuleAccountPermissions() []string
+}
+
+// ExtensionOptionsExtension is implemented by extensions handling tx extension
+// options, e.g. EVM-compatible transaction data.
+type ExtensionOptionsExtension interface {
+	// RegisterExtensionOptions registers the handlers of the tx extension
+	// options of the extension, once the extension is built.
+	RegisterExtensionOptions(registry *ante.ExtensionOptionRegistry)
+}
+
+// ModuleBasicsWithExtensions returns the SimApp module basics with the basics
+// of the extensions, to build the encoding config and the genesis of an app
+// with extensions.
+func ModuleBasicsWithExtensions(extensions ...AppExtension) module.BasicManager {
+	basics := make(module.BasicManager, len(ModuleBasics)+len(extensions))
+	for name, basic := range ModuleBasics {
+		basics[name] = basic
+	}
+	for _, ext := range extensions {
+		basics[ext.Name()] = ext
+	}
+
+	return basics
+}
+
+// ModuleAccountPermissions returns the permissions of the SimApp module
+// accounts and of the module accounts of the extensions.
+func ModuleAccountPermissions(extensions ...AppExtension) map[string][]string {
+	perms := GetMaccPerms()
+	for _, ext := range extensions {
+		if macc, ok := ext.(ModuleAccountExtension); ok {
+			perms[ext.Name()] = macc.ModuleAccountPermissions()
+		}
+	}
+
+	return perms
+}
+
+// extensionOptionRegistry returns the registry of the tx extension options
+// handled by the extensions, or nil if none of them handles any, in which case
+// the txs with critical extension options are rejected.
+func extensionOptionRegistry(extensions []AppExtension) *ante.ExtensionOptionRegistry {
+	var registry *ante.ExtensionOptionRegistry
+	for _, ext := range extensions {
+		if e, ok := ext.(ExtensionOptionsExtension); ok {
+			if registry == nil {
+				registry = ante.NewExtensionOptionRegistry()
+			}
+			e.RegisterExtensionOptions(registry)
+		}
+	}
+
+	return registry
+}
+
+// GasConfigFromAppOptions returns the VM gas config set in app.toml, the
+// default config for unset values.
+func GasConfigFromAppOptions(appOpts servertypes.AppOptions) vm.GasConfig {
+	cfg := vm.DefaultGasConfig()
+	if v := cast.ToUint64(appOpts.Get(FlagWasmGasMultiplier)); v != 0 {
+		cfg.GasMultiplier = v
+	}
+	if v := cast.ToUint64(appOpts.Get(FlagWasmQueryGasLimit)); v != 0 {
+		cfg.QueryGasLimit = v
+	}
+	cfg.SimulationGasLimit = cast.ToUint64(appOpts.Get(FlagWasmSimulationGasLimit))
+
+	return cfg
+}
+
+// buildExtensions builds the modules of the extensions and collects the
+// proposal routes they handle.
+func (app *SimApp) buildExtensions(
+	extensions []AppExtension, scopedKeepers map[string]capabilitykeeper.ScopedKeeper, appOpts servertypes.AppOptions,
+) ([]module.AppModule, map[string]govtypes.Handler) {
+	var (
+		modules        = make([]module.AppModule, len(extensions))
+		proposalRoutes = make(map[string]govtypes.Handler)
+		msgRouter      = baseapp.NewMsgRouterService(app.MsgServiceRouter())
+		gasConfig      = GasConfigFromAppOptions(appOpts)
+	)
+	if err := gasConfig.Validate(); err != nil {
+		panic(err)
+	}
+
+	app.QueryPlugins = vm.NewQueryPlugins(baseapp.NewQueryRouterService(app.GRPCQueryRouter()))
+
+	for i, ext := range extensions {
+		keys := make(map[string]*sdk.KVStoreKey)
+		for _, name := range ext.StoreKeys() {
+			keys[name] = app.keys[name]
+		}
+
+		modules[i] = ext.Build(app, ExtensionDeps{
+			Keys:         keys,
+			ScopedKeeper: scopedKeepers[ext.Name()],
+			MsgRouter:    msgRouter.ForModule(ext.Name()),
+			QueryPlugins: app.QueryPlugins,
+			GasConfig:    gasConfig,
+			AppOpts:      appOpts,
+		})
+
+		if p, ok := ext.(ProposalExtension); ok {
+			for route, handler := range p.ProposalRoutes() {
+				proposalRoutes[route] = handler
+			}
+		}
+	}
+
+	for _, ext := range extensions {
+		if q, ok := ext.(QueryPluginExtension); ok {
+			q.RegisterQueryPlugins(app.QueryPlugins)
+		}
+	}
+
+	return modules, proposalRoutes
+}
@@ -0,0 +1,166 @@
+package simapp
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/vm"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const testExtensionName = "testext"
+
+// testExtension is a minimal AppExtension recording how it is wired.
+type testExtension struct {
+	deps ExtensionDeps
+
+	// genesisSupply is the bank supply seen by the module when initializing
+	// its genesis
+	genesisSupply sdk.Coin
+}
+
+var (
+	_ AppExtension           = &testExtension{}
+	_ ProposalExtension      = &testExtension{}
+	_ QueryPluginExtension   = &testExtension{}
+	_ ModuleAccountExtension = &testExtension{}
+)
+
+func (*testExtension) Name() string                                                { return testExtensionName }
+func (*testExtension) RegisterLegacyAminoCodec(*codec.LegacyAmino)                 {}
+func (*testExtension) RegisterInterfaces(codectypes.InterfaceRegistry)             {}
+func (*testExtension) DefaultGenesis(codec.JSONCodec) json.RawMessage              { return json.RawMessage(`{}`) }
+func (*testExtension) RegisterRESTRoutes(client.Context, *mux.Router)              {}
+func (*testExtension) RegisterGRPCGatewayRoutes(client.Context, *runtime.ServeMux) {}
+func (*testExtension) GetTxCmd() *cobra.Command                                    { return nil }
+func (*testExtension) GetQueryCmd() *cobra.Command                                 { return nil }
+func (*testExtension) StoreKeys() []string                                         { return []string{testExtensionName} }
+func (*testExtension) ModuleAccountPermissions() []string                          { return []string{authtypes.Burner} }
+
+func (*testExtension) ValidateGenesis(codec.JSONCodec, client.TxEncodingConfig, json.RawMessage) error {
+	return nil
+}
+
+func (ext *testExtension) Build(app *SimApp, deps ExtensionDeps) module.AppModule {
+	ext.deps = deps
+	return testExtensionModule{testExtension: ext, app: app}
+}
+
+func (*testExtension) ProposalRoutes() map[string]govtypes.Handler {
+	return map[string]govtypes.Handler{
+		testExtensionName: func(sdk.Context, govtypes.Content) error { return nil },
+	}
+}
+
+func (*testExtension) RegisterQueryPlugins(plugins *vm.QueryPlugins) {
+	plugins.RegisterCustomQuerier(testExtensionName, func(_ sdk.Context, request json.RawMessage) ([]byte, error) {
+		return request, nil
+	})
+}
+
+type testExtensionModule struct {
+	*testExtension
+	app *SimApp
+}
+
+func (m testExtensionModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, _ json.RawMessage) []abci.ValidatorUpdate {
+	m.genesisSupply = m.app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+	return nil
+}
+
+func (testExtensionModule) ExportGenesis(sdk.Context, codec.JSONCodec) json.RawMessage {
+	return json.RawMessage(`{}`)
+}
+
+func (testExtensionModule) RegisterInvariants(sdk.InvariantRegistry)            {}
+func (testExtensionModule) Route() sdk.Route                                    { return sdk.Route{} }
+func (testExtensionModule) QuerierRoute() string                                { return "" }
+func (testExtensionModule) LegacyQuerierHandler(*codec.LegacyAmino) sdk.Querier { return nil }
+func (testExtensionModule) RegisterServices(module.Configurator)                {}
+func (testExtensionModule) ConsensusVersion() uint64                            { return 1 }
+func (testExtensionModule) BeginBlock(sdk.Context, abci.RequestBeginBlock)      {}
+
+func (testExtensionModule) EndBlock(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return nil
+}
+
+func TestNewSimAppWithExtensions(t *testing.T) {
+	ext := &testExtension{}
+	encCfg := MakeTestEncodingConfig()
+	app := NewSimAppWithExtensions(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, encCfg,
+		EmptyAppOptions{}, []AppExtension{ext},
+	)
+
+	genesisState := NewDefaultGenesisState(encCfg.Marshaler)
+	genesisState[testExtensionName] = ext.DefaultGenesis(encCfg.Marshaler)
+	bankGenesis := banktypes.DefaultGenesisState()
+	bankGenesis.Balances = []banktypes.Balance{{
+		Address: sdk.AccAddress("addr1_______________").String(),
+		Coins:   sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+	}}
+	genesisState[banktypes.ModuleName] = encCfg.Marshaler.MustMarshalJSON(bankGenesis)
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+
+	app.InitChain(abci.RequestInitChain{ConsensusParams: DefaultConsensusParams, AppStateBytes: stateBytes})
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	// the extension initializes its genesis after the SimApp modules
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), ext.genesisSupply)
+	require.Equal(t, testExtensionName, app.mm.OrderInitGenesis[len(app.mm.OrderInitGenesis)-1])
+	require.Equal(t, testExtensionName, app.mm.OrderBeginBlockers[len(app.mm.OrderBeginBlockers)-1])
+
+	// the store of the extension is mounted
+	key := ext.deps.Keys[testExtensionName]
+	require.NotNil(t, key)
+	require.Equal(t, key, app.GetKey(testExtensionName))
+	ctx.KVStore(key).Set([]byte("key"), []byte("value"))
+
+	// the extension has a scoped capability keeper
+	_, err = ext.deps.ScopedKeeper.NewCapability(ctx, "port")
+	require.NoError(t, err)
+
+	// the extension has a module account, and handles its proposals
+	require.True(t, app.ModuleAccountAddrs()[authtypes.NewModuleAddress(testExtensionName).String()])
+	require.True(t, app.GovKeeper.Router().HasRoute(testExtensionName))
+
+	// the queries of the extension are registered
+	require.Equal(t, vm.DefaultGasConfig(), ext.deps.GasConfig)
+	require.Equal(t, []string{testExtensionName}, app.QueryPlugins.CustomRoutes())
+	require.Same(t, app.QueryPlugins, ext.deps.QueryPlugins)
+}
+
+func TestGasConfigFromAppOptions(t *testing.T) {
+	require.Equal(t, vm.DefaultGasConfig(), GasConfigFromAppOptions(EmptyAppOptions{}))
+
+	appOpts := testAppOptions{
+		FlagWasmGasMultiplier:      uint64(100),
+		FlagWasmSimulationGasLimit: "50000000",
+	}
+	require.Equal(t, vm.GasConfig{
+		GasMultiplier:      100,
+		QueryGasLimit:      vm.DefaultQueryGasLimit,
+		SimulationGasLimit: 50000000,
+	}, GasConfigFromAppOptions(appOpts))
+}
+
+type testAppOptions map[string]interface{}
+
+func (o testAppOptions) Get(key string) interface{} { return o[key] }
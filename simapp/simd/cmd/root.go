@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"encoding/json"
 	"errors"
 	"io"
 	"os"
@@ -27,11 +28,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/vm"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
+	genesishashcli "github.com/cosmos/cosmos-sdk/x/genesishash/client/cli"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	upgradecli "github.com/cosmos/cosmos-sdk/x/upgrade/client/cli"
+	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 )
 
 // NewRootCmd creates a new root command for simd. It is called once in the
@@ -93,6 +101,12 @@ func initAppConfig() (string, interface{}) {
 
 		// Address defines the gRPC-web server to listen on
 		LruSize uint64 `mapstructure:"lru_size"`
+
+		// GasMultiplier is the number of wasm gas units per sdk gas unit
+		GasMultiplier uint64 `mapstructure:"gas_multiplier"`
+
+		// SimulationGasLimit is the maximum sdk gas of simulated transactions, unbounded if 0
+		SimulationGasLimit uint64 `mapstructure:"simulation_gas_limit"`
 	}
 
 	type CustomAppConfig struct {
@@ -123,6 +137,7 @@ func initAppConfig() (string, interface{}) {
 		WASM: WASMConfig{
 			LruSize:       1,
 			QueryGasLimit: 300000,
+			GasMultiplier: vm.DefaultGasMultiplier,
 		},
 	}
 
@@ -132,7 +147,11 @@ func initAppConfig() (string, interface{}) {
 query_gas_limit = 300000
 # This is the number of wasm vm instances we keep cached in memory for speed-up
 # Warning: this is currently unstable and may lead to crashes, best to keep for 0 unless testing locally
-lru_size = 0`
+lru_size = 0
+# This is the number of wasm gas units charged per sdk gas unit
+gas_multiplier = 140000000
+# This is the maximum sdk gas of simulated transactions executing contracts, unbounded if 0
+simulation_gas_limit = 0`
 
 	return customAppTemplate, customAppConfig
 }
@@ -141,21 +160,32 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 	cfg := sdk.GetConfig()
 	cfg.Seal()
 
+	a := appCreator{encodingConfig}
+	debugCmd := debug.Cmd()
+	debugCmd.AddCommand(
+		server.NewReplayCmd(a.newApp, simapp.DefaultNodeHome),
+		authcmd.NewSm2PubKeysReportCmd(a.newApp, accountKeeper, simapp.DefaultNodeHome),
+	)
+
+	configCmd := config.Cmd()
+	configCmd.AddCommand(server.ConfigDoctorCmd())
+
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(simapp.ModuleBasics, simapp.DefaultNodeHome),
 		genutilcli.CollectGenTxsCmd(banktypes.GenesisBalancesIterator{}, simapp.DefaultNodeHome),
 		genutilcli.MigrateGenesisCmd(),
 		genutilcli.GenTxCmd(simapp.ModuleBasics, encodingConfig.TxConfig, banktypes.GenesisBalancesIterator{}, simapp.DefaultNodeHome),
 		genutilcli.ValidateGenesisCmd(simapp.ModuleBasics),
+		genesishashcli.NewGenesisCmd(),
 		AddGenesisAccountCmd(simapp.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		testnetCmd(simapp.ModuleBasics, banktypes.GenesisBalancesIterator{}),
-		debug.Cmd(),
-		config.Cmd(),
+		debugCmd,
+		configCmd,
 	)
 
-	a := appCreator{encodingConfig}
-	server.AddCommands(rootCmd, simapp.DefaultNodeHome, a.newApp, a.appExport, addModuleInitFlags)
+	server.AddCommands(rootCmd, simapp.DefaultNodeHome, a.newApp, a.appExport, a.appExportTables, addModuleInitFlags)
+	rootCmd.AddCommand(upgradecli.NewUpgradeCmd(a.newApp, upgradeKeeper, simapp.DefaultNodeHome))
 
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
@@ -167,10 +197,15 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 
 	// add rosetta
 	rootCmd.AddCommand(server.RosettaCommand(encodingConfig.InterfaceRegistry, encodingConfig.Marshaler))
+
+	// complete the --from flags with the names of the keys
+	client.RegisterKeyNameCompletions(rootCmd)
 }
 
 func addModuleInitFlags(startCmd *cobra.Command) {
+	auth.AddModuleInitFlags(startCmd)
 	crisis.AddModuleInitFlags(startCmd)
+	slashing.AddModuleInitFlags(startCmd)
 }
 
 func queryCommand() *cobra.Command {
@@ -213,8 +248,10 @@ func txCommand() *cobra.Command {
 		authcmd.GetMultiSignBatchCmd(),
 		authcmd.GetValidateSignaturesCommand(),
 		authcmd.GetBroadcastCommand(),
+		authcmd.GetSubmitCommand(),
 		authcmd.GetEncodeCommand(),
 		authcmd.GetDecodeCommand(),
+		authcmd.GetTraceCommand(),
 	)
 
 	simapp.ModuleBasics.AddTxCommands(cmd)
@@ -266,15 +303,31 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(server.FlagHaltHeight))),
 		baseapp.SetHaltTime(cast.ToUint64(appOpts.Get(server.FlagHaltTime))),
 		baseapp.SetMinRetainBlocks(cast.ToUint64(appOpts.Get(server.FlagMinRetainBlocks))),
+		baseapp.SetQueryOnly(cast.ToBool(appOpts.Get(server.FlagQueryOnly))),
 		baseapp.SetInterBlockCache(cache),
 		baseapp.SetTrace(cast.ToBool(appOpts.Get(server.FlagTrace))),
 		baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(server.FlagIndexEvents))),
+		baseapp.SetEmitTxEvents(cast.ToBool(appOpts.Get(server.FlagEmitTxEvents))),
+		baseapp.SetTxPriorityPolicy(
+			cast.ToStringSlice(appOpts.Get(server.FlagTxPriorityFeeTiers)),
+			cast.ToStringSlice(appOpts.Get(server.FlagTxPriorityMsgs)),
+		),
 		baseapp.SetSnapshotStore(snapshotStore),
 		baseapp.SetSnapshotInterval(cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval))),
 		baseapp.SetSnapshotKeepRecent(cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent))),
 	)
 }
 
+// accountKeeper returns the account keeper of a simapp created by newApp.
+func accountKeeper(app servertypes.Application) authkeeper.AccountKeeper {
+	return app.(*simapp.SimApp).AccountKeeper
+}
+
+// upgradeKeeper returns the upgrade keeper of a simapp created by newApp.
+func upgradeKeeper(app servertypes.Application) upgradekeeper.Keeper {
+	return app.(*simapp.SimApp).UpgradeKeeper
+}
+
 // appExport creates a new simapp (optionally at a given height)
 // and exports state.
 func (a appCreator) appExport(
@@ -299,3 +352,13 @@ func (a appCreator) appExport(
 
 	return simApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs)
 }
+
+// appExportTables converts the exported state of the given modules to tables.
+func (a appCreator) appExportTables(appState json.RawMessage, modules []string) (map[string][]*sdk.Table, error) {
+	var genesis map[string]json.RawMessage
+	if err := json.Unmarshal(appState, &genesis); err != nil {
+		return nil, err
+	}
+
+	return simapp.ModuleBasics.ExportTables(a.encCfg.Marshaler, genesis, modules)
+}
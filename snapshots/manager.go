@@ -131,6 +131,16 @@ func (m *Manager) endLocked() {
 	m.restoreChunkIndex = 0
 }
 
+// Close closes the snapshot store. It errors if an operation is in progress.
+func (m *Manager) Close() error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if m.operation != opNone {
+		return sdkerrors.Wrapf(sdkerrors.ErrConflict, "a %v operation is in progress", m.operation)
+	}
+	return m.store.Close()
+}
+
 // sortedExtensionNames sort extension names for deterministic iteration.
 func (m *Manager) sortedExtensionNames() []string {
 	names := make([]string, 0, len(m.extensions))
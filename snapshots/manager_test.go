@@ -114,6 +114,15 @@ func TestManager_Prune(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestManager_Close(t *testing.T) {
+	manager := snapshots.NewManager(setupStore(t), nil)
+	require.NoError(t, manager.Close())
+
+	// Close should error while a snapshot is being taken
+	manager = setupBusyManager(t)
+	require.Error(t, manager.Close())
+}
+
 func TestManager_Restore(t *testing.T) {
 	store := setupStore(t)
 	target := &mockSnapshotter{}
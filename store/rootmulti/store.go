@@ -924,6 +924,18 @@ type storeParams struct {
 	initialVersion uint64
 }
 
+// GetLatestVersion returns the latest version committed to the multistore DB,
+// without loading the stores.
+func GetLatestVersion(db dbm.DB) int64 {
+	return getLatestVersion(db)
+}
+
+// GetCommitInfo returns the commit info of the given version of the multistore
+// DB, without loading the stores.
+func GetCommitInfo(db dbm.DB, ver int64) (*types.CommitInfo, error) {
+	return getCommitInfo(db, ver)
+}
+
 func getLatestVersion(db dbm.DB) int64 {
 	bz, err := db.Get([]byte(latestVersionKey))
 	if err != nil {
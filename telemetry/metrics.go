@@ -61,6 +61,7 @@ type Config struct {
 // dump of formatted recent metrics will be sent to STDERR.
 type Metrics struct {
 	memSink           *metrics.InmemSink
+	memSignal         *metrics.InmemSignal
 	prometheusEnabled bool
 }
 
@@ -90,9 +91,9 @@ func New(cfg Config) (*Metrics, error) {
 	metricsConf.EnableHostnameLabel = cfg.EnableHostnameLabel
 
 	memSink := metrics.NewInmemSink(10*time.Second, time.Minute)
-	metrics.DefaultInmemSignal(memSink)
+	memSignal := metrics.DefaultInmemSignal(memSink)
 
-	m := &Metrics{memSink: memSink}
+	m := &Metrics{memSink: memSink, memSignal: memSignal}
 	fanout := metrics.FanoutSink{memSink}
 
 	if cfg.PrometheusRetentionTime > 0 {
@@ -135,6 +136,14 @@ func (m *Metrics) Gather(format string) (GatherResponse, error) {
 	}
 }
 
+// Close stops the SIGUSR1 dump of the recent metrics and detaches the sinks
+// from the global metrics: the metrics emitted afterwards, e.g. during the
+// shutdown of the process, are discarded.
+func (m *Metrics) Close() {
+	m.memSignal.Stop()
+	_, _ = metrics.NewGlobal(metrics.DefaultConfig(""), &metrics.BlackholeSink{})
+}
+
 func (m *Metrics) gatherPrometheus() (GatherResponse, error) {
 	if !m.prometheusEnabled {
 		return GatherResponse{}, fmt.Errorf("prometheus metrics are not enabled")
@@ -15,6 +15,7 @@ import (
 	tmtime "github.com/tendermint/tendermint/types/time"
 
 	"github.com/cosmos/cosmos-sdk/server/api"
+	"github.com/cosmos/cosmos-sdk/server/filter"
 	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
 	srvtypes "github.com/cosmos/cosmos-sdk/server/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -76,9 +77,17 @@ func startInProcess(cfg Config, val *Validator) error {
 		app.RegisterTendermintService(val.ClientCtx)
 	}
 
+	queryFilter, err := filter.NewQueryFilter(val.AppConfig.QueryFilter.Allowed, val.AppConfig.QueryFilter.Denied)
+	if err != nil {
+		return err
+	}
+
 	if val.APIAddress != "" {
 		apiSrv := api.New(val.ClientCtx, logger.With("module", "api-server"))
 		app.RegisterAPIRoutes(apiSrv, val.AppConfig.API)
+		if err := apiSrv.SetQueryFilter(queryFilter, servergrpc.ServiceDescs(app)); err != nil {
+			return err
+		}
 
 		errCh := make(chan error)
 
@@ -98,7 +107,7 @@ func startInProcess(cfg Config, val *Validator) error {
 	}
 
 	if val.AppConfig.GRPC.Enable {
-		grpcSrv, err := servergrpc.StartGRPCServer(val.ClientCtx, app, val.AppConfig.GRPC.Address)
+		grpcSrv, err := servergrpc.StartGRPCServer(val.ClientCtx, app, val.AppConfig.GRPC, val.AppConfig.QueryOnly, queryFilter)
 		if err != nil {
 			return err
 		}
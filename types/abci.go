@@ -1,6 +1,10 @@
 package types
 
-import abci "github.com/tendermint/tendermint/abci/types"
+import (
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
 
 // InitChainer initializes application state at genesis
 type InitChainer func(ctx Context, req abci.RequestInitChain) abci.ResponseInitChain
@@ -19,3 +23,68 @@ type EndBlocker func(ctx Context, req abci.RequestEndBlock) abci.ResponseEndBloc
 
 // PeerFilter responds to p2p filtering queries from Tendermint
 type PeerFilter func(ctx Context, info string) abci.ResponseQuery
+
+// RequestPrepareProposal is passed to a PrepareProposalHandler when the node is
+// the proposer of the next block. It mirrors the ABCI++ request of the same name
+// so applications can be written against it ahead of a consensus engine upgrade.
+type RequestPrepareProposal struct {
+	// Txs are the candidate transactions, in mempool order.
+	Txs [][]byte
+	// MaxTxBytes is the maximum total size of the returned transactions.
+	MaxTxBytes int64
+	Height     int64
+	Time       time.Time
+	// ProposerAddress is the consensus address of the block proposer.
+	ProposerAddress []byte
+}
+
+// ResponsePrepareProposal holds the transactions, possibly filtered and
+// reordered, to be included in the proposed block.
+type ResponsePrepareProposal struct {
+	Txs [][]byte
+}
+
+// RequestProcessProposal is passed to a ProcessProposalHandler when a block
+// proposed by another validator has to be validated before voting on it.
+type RequestProcessProposal struct {
+	Txs             [][]byte
+	Hash            []byte
+	Height          int64
+	Time            time.Time
+	ProposerAddress []byte
+}
+
+// ProposalStatus is the verdict of a ProcessProposalHandler.
+type ProposalStatus int32
+
+const (
+	ProposalStatusUnknown ProposalStatus = iota
+	ProposalStatusAccept
+	ProposalStatusReject
+)
+
+// ResponseProcessProposal holds the verdict on a proposed block.
+type ResponseProcessProposal struct {
+	Status ProposalStatus
+}
+
+// IsAccepted returns true if the proposal was accepted.
+func (r ResponseProcessProposal) IsAccepted() bool {
+	return r.Status == ProposalStatusAccept
+}
+
+// PrepareProposalHandler selects, orders and possibly filters the transactions
+// of a block the node is about to propose.
+type PrepareProposalHandler func(ctx Context, req RequestPrepareProposal) ResponsePrepareProposal
+
+// ProcessProposalHandler validates a block proposed by another validator.
+type ProcessProposalHandler func(ctx Context, req RequestProcessProposal) ResponseProcessProposal
+
+// DeliverTxHook is called with the result of every transaction delivered in a
+// block. Its state changes are committed along with the block.
+type DeliverTxHook func(ctx Context, txBytes []byte, res abci.ResponseDeliverTx)
+
+// TxPriorityHandler returns the priority of a transaction accepted by CheckTx.
+// The transactions of higher priority come first in the blocks proposed by the
+// node.
+type TxPriorityHandler func(ctx Context, tx Tx) int64
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/golang-lru/simplelru"
 	yaml "gopkg.in/yaml.v2"
 
+	"github.com/cosmos/cosmos-sdk/crypto/sm3hash"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/internal/conv"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -134,6 +135,24 @@ func AccAddressFromHex(address string) (addr AccAddress, err error) {
 	return AccAddress(bz), err
 }
 
+// AccAddressFromPubKey derives the account address of a public key, by the
+// address derivation set by GetConfig().SetAddressDerivation() if any, by its
+// Address method otherwise.
+func AccAddressFromPubKey(pubKey cryptotypes.PubKey) AccAddress {
+	if derivation := GetConfig().GetAddressDerivation(); derivation != nil {
+		return AccAddress(derivation(pubKey))
+	}
+
+	return AccAddress(pubKey.Address())
+}
+
+// Sm3AddressDerivation derives the address of a public key from the first 20
+// bytes of the SM3 hash of its bytes, to be set by
+// GetConfig().SetAddressDerivation().
+func Sm3AddressDerivation(pubKey cryptotypes.PubKey) []byte {
+	return sm3hash.SumTruncated(pubKey.Bytes())
+}
+
 // VerifyAddressFormat verifies that the provided bytes form a valid address
 // according to the default address rules or a custom address verifier set by
 // GetConfig().SetAddressVerifier()
@@ -156,23 +175,7 @@ func VerifyAddressFormat(bz []byte) error {
 
 // AccAddressFromBech32 creates an AccAddress from a Bech32 string.
 func AccAddressFromBech32(address string) (addr AccAddress, err error) {
-	if len(strings.TrimSpace(address)) == 0 {
-		return AccAddress{}, errors.New("empty address string is not allowed")
-	}
-
-	bech32PrefixAccAddr := GetConfig().GetBech32AccountAddrPrefix()
-
-	bz, err := GetFromBech32(address, bech32PrefixAccAddr)
-	if err != nil {
-		return nil, err
-	}
-
-	err = VerifyAddressFormat(bz)
-	if err != nil {
-		return nil, err
-	}
-
-	return AccAddress(bz), nil
+	return NewBech32AddressCodec(GetConfig().GetBech32AccountAddrPrefix()).StringToBytes(address)
 }
 
 // Returns boolean for whether two AccAddresses are Equal
@@ -659,3 +662,18 @@ func cacheBech32Addr(prefix string, addr []byte, cache *simplelru.LRU, cacheKey
 	cache.Add(cacheKey, bech32Addr)
 	return bech32Addr
 }
+
+// purgeAddrCaches clears the caches of the Bech32 encoded addresses.
+func purgeAddrCaches() {
+	accAddrMu.Lock()
+	accAddrCache.Purge()
+	accAddrMu.Unlock()
+
+	consAddrMu.Lock()
+	consAddrCache.Purge()
+	consAddrMu.Unlock()
+
+	valAddrMu.Lock()
+	valAddrCache.Purge()
+	valAddrMu.Unlock()
+}
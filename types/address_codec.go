@@ -0,0 +1,59 @@
+package types
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// AddressCodec defines the interface to convert account addresses from and to
+// their string representation. It is injected in the keepers so that each app
+// chooses the encoding of its addresses instead of relying on the global
+// Config.
+type AddressCodec interface {
+	// StringToBytes decodes the string representation of an address.
+	StringToBytes(text string) (AccAddress, error)
+	// BytesToString encodes an address to its string representation.
+	BytesToString(addr AccAddress) (string, error)
+}
+
+// Bech32AddressCodec is the AddressCodec encoding addresses with bech32 and a
+// given human readable part.
+type Bech32AddressCodec struct {
+	Bech32Prefix string
+}
+
+var _ AddressCodec = Bech32AddressCodec{}
+
+// NewBech32AddressCodec creates a new Bech32AddressCodec with the given prefix.
+func NewBech32AddressCodec(prefix string) Bech32AddressCodec {
+	return Bech32AddressCodec{Bech32Prefix: prefix}
+}
+
+// StringToBytes implements AddressCodec.
+func (bc Bech32AddressCodec) StringToBytes(text string) (AccAddress, error) {
+	if len(strings.TrimSpace(text)) == 0 {
+		return AccAddress{}, errors.New("empty address string is not allowed")
+	}
+
+	bz, err := GetFromBech32(text, bc.Bech32Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := VerifyAddressFormat(bz); err != nil {
+		return nil, err
+	}
+
+	return AccAddress(bz), nil
+}
+
+// BytesToString implements AddressCodec.
+func (bc Bech32AddressCodec) BytesToString(addr AccAddress) (string, error) {
+	if addr.Empty() {
+		return "", nil
+	}
+
+	return bech32.ConvertAndEncode(bc.Bech32Prefix, addr)
+}
@@ -14,6 +14,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/sm2"
+	"github.com/cosmos/cosmos-sdk/crypto/sm3hash"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32/legacybech32"
@@ -125,6 +127,32 @@ func (s *addressTestSuite) TestRandBech32AccAddrConsistency() {
 	s.Require().Equal("decoding Bech32 address failed: must provide an address", err.Error())
 }
 
+func (s *addressTestSuite) TestBech32AddressCodec() {
+	codec := types.NewBech32AddressCodec("other")
+	addr := types.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	str, err := codec.BytesToString(addr)
+	s.Require().NoError(err)
+	s.Require().True(strings.HasPrefix(str, "other1"))
+
+	res, err := codec.StringToBytes(str)
+	s.Require().NoError(err)
+	s.Require().Equal(addr, res)
+
+	// the global prefix is not accepted by the codec
+	_, err = codec.StringToBytes(addr.String())
+	s.Require().Error(err)
+	_, err = types.AccAddressFromBech32(str)
+	s.Require().Error(err)
+
+	_, err = codec.StringToBytes(" ")
+	s.Require().Equal("empty address string is not allowed", err.Error())
+
+	str, err = codec.BytesToString(types.AccAddress{})
+	s.Require().NoError(err)
+	s.Require().Empty(str)
+}
+
 func (s *addressTestSuite) TestValAddr() {
 	pubBz := make([]byte, ed25519.PubKeySize)
 	pub := &ed25519.PubKey{Key: pubBz}
@@ -361,6 +389,45 @@ func (s *addressTestSuite) TestCustomAddressVerifier() {
 	types.GetConfig().SetAddressVerifier(nil)
 }
 
+func (s *addressTestSuite) TestAddressDerivation() {
+	pubKeys := []cryptotypes.PubKey{
+		secp256k1.GenPrivKeyFromSecret([]byte("secp256k1")).PubKey(),
+		sm2.GenPrivKeyFromSecret([]byte("sm2")).PubKey(),
+	}
+
+	// the addresses are derived by the Address method of the public keys by
+	// default
+	for _, pk := range pubKeys {
+		s.Require().Equal(types.AccAddress(pk.Address()), types.AccAddressFromPubKey(pk))
+	}
+
+	types.GetConfig().SetAddressDerivation(types.Sm3AddressDerivation)
+	for _, pk := range pubKeys {
+		addr := types.AccAddressFromPubKey(pk)
+		s.Require().Equal(types.AccAddress(sm3hash.SumTruncated(pk.Bytes())), addr)
+		s.Require().NotEqual(types.AccAddress(pk.Address()), addr)
+		s.Require().NoError(types.VerifyAddressFormat(addr))
+
+		// the SM3 addresses round trip through their bech32 and hex forms
+		res, err := types.AccAddressFromBech32(addr.String())
+		s.Require().NoError(err)
+		s.Require().Equal(addr, res)
+		res, err = types.AccAddressFromHex(hex.EncodeToString(addr))
+		s.Require().NoError(err)
+		s.Require().Equal(addr, res)
+		valAddr, err := types.ValAddressFromBech32(types.ValAddress(addr).String())
+		s.Require().NoError(err)
+		s.Require().Equal(addr.Bytes(), valAddr.Bytes())
+
+		// the consensus addresses are still the ones of Tendermint
+		s.Require().Equal(types.ConsAddress(pk.Address()), types.GetConsAddress(pk))
+	}
+
+	// Reinitialize the global config to the default address derivation (nil)
+	types.GetConfig().SetAddressDerivation(nil)
+	s.Require().Equal(types.AccAddress(pubKeys[0].Address()), types.AccAddressFromPubKey(pubKeys[0]))
+}
+
 func (s *addressTestSuite) TestBech32ifyAddressBytes() {
 	addr10byte := []byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
 	addr20byte := []byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19}
@@ -6,7 +6,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/ledger"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -23,3 +26,21 @@ func TestBeach32ifPbKey(t *testing.T) {
 	require.Equal("cosmospub1addwnpepqd87l8xhcnrrtzxnkql7k55ph8fr9jarf4hn6udwukfprlalu8lgw0urza0",
 		pubKeyAddr, "Is your device using test mnemonic: %s ?", testdata.TestMnemonic)
 }
+
+func TestMultisigPubKeyRoundTrip(t *testing.T) {
+	require := require.New(t)
+	pubKeys := []cryptotypes.PubKey{secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()}
+	pk := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+
+	pubKeyAddr, err := MarshalPubKey(AccPK, pk)
+	require.NoError(err)
+
+	// the keys of the unmarshaled multisig key are unpacked, so that it can
+	// be marshaled back
+	pk2, err := UnmarshalPubKey(AccPK, pubKeyAddr)
+	require.NoError(err)
+	require.True(pk.Equals(pk2))
+	pubKeyAddr2, err := MarshalPubKey(AccPK, pk2)
+	require.NoError(err)
+	require.Equal(pubKeyAddr, pubKeyAddr2)
+}
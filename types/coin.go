@@ -778,13 +778,51 @@ func DefaultCoinDenomRegex() string {
 // coinDenomRegex returns the current regex string and can be overwritten for custom validation
 var coinDenomRegex = DefaultCoinDenomRegex
 
+// extraDenomRegexes are the additional regex strings registered with
+// RegisterDenomRegex.
+var extraDenomRegexes []string
+
 // SetCoinDenomRegex allows for coin's custom validation by overriding the regular
-// expression string used for denom validation.
+// expression string used for denom validation. The regexes registered with
+// RegisterDenomRegex are kept.
 func SetCoinDenomRegex(reFn func() string) {
 	coinDenomRegex = reFn
+	compileDenomRegexes()
+}
+
+// RegisterDenomRegex extends the denom validation with additional regular
+// expression strings, e.g. `factory/[a-z0-9]+/[a-zA-Z0-9]{1,44}`: a denom is
+// valid if it matches the regex set by SetCoinDenomRegex or any of the
+// registered ones. The regexes apply both to ValidateDenom and to the parsing
+// of coins, so that the client and the keepers accept the same denoms.
+//
+// RegisterDenomRegex is not safe for concurrent use and is meant to be called
+// at init, before any denom is validated. It panics if a regex is invalid.
+func RegisterDenomRegex(regexes ...string) {
+	for _, re := range regexes {
+		regexp.MustCompile(re)
+	}
+
+	extraDenomRegexes = append(extraDenomRegexes, regexes...)
+	compileDenomRegexes()
+}
+
+// DenomRegexes returns the regex strings a denom is validated against: the one
+// set by SetCoinDenomRegex, followed by the ones registered with
+// RegisterDenomRegex.
+func DenomRegexes() []string {
+	return append([]string{coinDenomRegex()}, extraDenomRegexes...)
+}
+
+func compileDenomRegexes() {
+	regexes := DenomRegexes()
+	for i, re := range regexes {
+		regexes[i] = fmt.Sprintf("(?:%s)", re)
+	}
+	denomRegex := strings.Join(regexes, "|")
 
-	reDnm = regexp.MustCompile(fmt.Sprintf(`^%s$`, coinDenomRegex()))
-	reDecCoin = regexp.MustCompile(fmt.Sprintf(`^(%s)%s(%s)$`, reDecAmt, reSpc, coinDenomRegex()))
+	reDnm = regexp.MustCompile(fmt.Sprintf(`^(?:%s)$`, denomRegex))
+	reDecCoin = regexp.MustCompile(fmt.Sprintf(`^(%s)%s(%s)$`, reDecAmt, reSpc, denomRegex))
 }
 
 // ValidateDenom is the default validation function for Coin.Denom.
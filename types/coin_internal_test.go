@@ -35,3 +35,38 @@ func (s *coinInternalSuite) TestIsSorted() {
 		assert.Equal(tc.expected, tc.coins.isSorted(), "testcase %d failed", i)
 	}
 }
+
+func (s *coinInternalSuite) TestRegisterDenomRegex() {
+	defer func() {
+		extraDenomRegexes = nil
+		compileDenomRegexes()
+	}()
+
+	s.Require().Error(ValidateDenom("1factory"))
+	s.Require().Panics(func() { RegisterDenomRegex(`[a-z`) })
+
+	RegisterDenomRegex(`[0-9][a-z]+`, `cny\.[a-z]{2}`)
+	s.Require().Equal([]string{DefaultCoinDenomRegex(), `[0-9][a-z]+`, `cny\.[a-z]{2}`}, DenomRegexes())
+
+	for denom, valid := range map[string]bool{
+		"atom":      true,
+		"1factory":  true,
+		"cny.ab":    true,
+		"cny.abc":   false,
+		"1factory1": false,
+		"1":         false,
+	} {
+		s.Require().Equal(valid, ValidateDenom(denom) == nil, denom)
+	}
+
+	coins, err := ParseCoinsNormalized("10cny.ab,5atom,2.5 1factory")
+	s.Require().NoError(err)
+	s.Require().Equal(NewCoins(NewInt64Coin("1factory", 2), NewInt64Coin("atom", 5), NewInt64Coin("cny.ab", 10)), coins)
+
+	// overriding the default regex keeps the registered ones
+	SetCoinDenomRegex(func() string { return `[A-Z]{3}` })
+	defer SetCoinDenomRegex(DefaultCoinDenomRegex)
+	s.Require().NoError(ValidateDenom("ATO"))
+	s.Require().NoError(ValidateDenom("1factory"))
+	s.Require().Error(ValidateDenom("atom"))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sync"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/version"
 )
 
@@ -18,6 +19,7 @@ type Config struct {
 	bech32AddressPrefix map[string]string
 	txEncoder           TxEncoder
 	addressVerifier     func([]byte) error
+	addressDerivation   func(cryptotypes.PubKey) []byte
 	mtx                 sync.RWMutex
 
 	// SLIP-44 related
@@ -106,6 +108,65 @@ func (config *Config) SetBech32PrefixForConsensusNode(addressPrefix, pubKeyPrefi
 	config.bech32AddressPrefix["consensus_pub"] = pubKeyPrefix
 }
 
+// SetBech32Prefixes builds the Config with the Bech32 prefixes of all the
+// address and public key types derived from the account address prefix, e.g.
+// cosmos, cosmospub, cosmosvaloper, cosmosvalcons. Unlike the other setters, it
+// can be called at runtime, returning an error rather than panicking if the
+// prefix is invalid or the config is sealed with different prefixes.
+func (config *Config) SetBech32Prefixes(accountAddrPrefix string) error {
+	if err := ValidateBech32Prefix(accountAddrPrefix); err != nil {
+		return err
+	}
+
+	prefixes := map[string]string{
+		"account_addr":   accountAddrPrefix,
+		"validator_addr": accountAddrPrefix + PrefixValidator + PrefixOperator,
+		"consensus_addr": accountAddrPrefix + PrefixValidator + PrefixConsensus,
+		"account_pub":    accountAddrPrefix + PrefixPublic,
+		"validator_pub":  accountAddrPrefix + PrefixValidator + PrefixOperator + PrefixPublic,
+		"consensus_pub":  accountAddrPrefix + PrefixValidator + PrefixConsensus + PrefixPublic,
+	}
+
+	config.mtx.Lock()
+	defer config.mtx.Unlock()
+
+	changed := false
+	for key, prefix := range prefixes {
+		if config.bech32AddressPrefix[key] != prefix {
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	if config.sealed {
+		return fmt.Errorf("config is sealed with the bech32 account prefix %s, cannot set %s",
+			config.bech32AddressPrefix["account_addr"], accountAddrPrefix)
+	}
+
+	config.bech32AddressPrefix = prefixes
+	// the cached addresses are encoded with the previous prefixes
+	purgeAddrCaches()
+
+	return nil
+}
+
+// ValidateBech32Prefix checks that a string is a valid Bech32 human-readable
+// part, i.e. is made of 1 to 83 lowercase US-ASCII characters.
+func ValidateBech32Prefix(prefix string) error {
+	if len(prefix) == 0 || len(prefix) > 83 {
+		return fmt.Errorf("invalid bech32 prefix length %d", len(prefix))
+	}
+
+	for _, c := range prefix {
+		if c < 33 || c > 126 || (c >= 'A' && c <= 'Z') {
+			return fmt.Errorf("invalid character %q in bech32 prefix %s", c, prefix)
+		}
+	}
+
+	return nil
+}
+
 // SetTxEncoder builds the Config with TxEncoder used to marshal StdTx to bytes
 func (config *Config) SetTxEncoder(encoder TxEncoder) {
 	config.assertNotSealed()
@@ -119,6 +180,15 @@ func (config *Config) SetAddressVerifier(addressVerifier func([]byte) error) {
 	config.addressVerifier = addressVerifier
 }
 
+// SetAddressDerivation builds the Config with the provided function deriving the
+// account addresses from the public keys, e.g. Sm3AddressDerivation, instead of
+// their Address method. The consensus addresses are still derived by the
+// Address method, as by Tendermint.
+func (config *Config) SetAddressDerivation(addressDerivation func(cryptotypes.PubKey) []byte) {
+	config.assertNotSealed()
+	config.addressDerivation = addressDerivation
+}
+
 // Set the FullFundraiserPath (BIP44Prefix) on the config.
 //
 // Deprecated: This method is supported for backward compatibility only and will be removed in a future release. Use SetPurpose and SetCoinType instead.
@@ -196,6 +266,12 @@ func (config *Config) GetAddressVerifier() func([]byte) error {
 	return config.addressVerifier
 }
 
+// GetAddressDerivation returns the function deriving the account addresses from
+// the public keys, nil if they are derived by their Address method
+func (config *Config) GetAddressDerivation() func(cryptotypes.PubKey) []byte {
+	return config.addressDerivation
+}
+
 // GetPurpose returns the BIP-0044 Purpose code on the config.
 func (config *Config) GetPurpose() uint32 {
 	return config.purpose
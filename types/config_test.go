@@ -53,6 +53,16 @@ func (s *configTestSuite) TestConfig_SetTxEncoder() {
 	s.Require().Panics(func() { config.SetTxEncoder(encFunc) })
 }
 
+func (s *configTestSuite) TestConfig_SetAddressDerivation() {
+	config := sdk.NewConfig()
+	s.Require().Nil(config.GetAddressDerivation())
+	config.SetAddressDerivation(sdk.Sm3AddressDerivation)
+	s.Require().NotNil(config.GetAddressDerivation())
+
+	config.Seal()
+	s.Require().Panics(func() { config.SetAddressDerivation(nil) })
+}
+
 func (s *configTestSuite) TestConfig_SetFullFundraiserPath() {
 	config := sdk.NewConfig()
 	config.SetFullFundraiserPath("test/path")
@@ -65,6 +75,26 @@ func (s *configTestSuite) TestConfig_SetFullFundraiserPath() {
 	s.Require().Panics(func() { config.SetFullFundraiserPath("x/test/path") })
 }
 
+func (s *configTestSuite) TestConfig_SetBech32Prefixes() {
+	config := sdk.NewConfig()
+	s.Require().NoError(config.SetBech32Prefixes("fork"))
+	s.Require().Equal("fork", config.GetBech32AccountAddrPrefix())
+	s.Require().Equal("forkpub", config.GetBech32AccountPubPrefix())
+	s.Require().Equal("forkvaloper", config.GetBech32ValidatorAddrPrefix())
+	s.Require().Equal("forkvaloperpub", config.GetBech32ValidatorPubPrefix())
+	s.Require().Equal("forkvalcons", config.GetBech32ConsensusAddrPrefix())
+	s.Require().Equal("forkvalconspub", config.GetBech32ConsensusPubPrefix())
+
+	s.Require().Error(config.SetBech32Prefixes(""))
+	s.Require().Error(config.SetBech32Prefixes("Fork"))
+	s.Require().Error(config.SetBech32Prefixes("fo rk"))
+
+	config.Seal()
+	s.Require().NoError(config.SetBech32Prefixes("fork"))
+	s.Require().Error(config.SetBech32Prefixes("cosmos"))
+	s.Require().Equal("fork", config.GetBech32AccountAddrPrefix())
+}
+
 func (s *configTestSuite) TestKeyringServiceName() {
 	s.Require().Equal(sdk.DefaultKeyringServiceName, sdk.KeyringServiceName())
 }
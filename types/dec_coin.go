@@ -182,8 +182,8 @@ func sanitizeDecCoins(decCoins []DecCoin) DecCoins {
 // NewDecCoinsFromCoins constructs a new coin set with decimal values
 // from regular Coins.
 func NewDecCoinsFromCoins(coins ...Coin) DecCoins {
-	decCoins := make(DecCoins, len(coins))
 	newCoins := NewCoins(coins...)
+	decCoins := make(DecCoins, len(newCoins))
 	for i, coin := range newCoins {
 		decCoins[i] = NewDecCoinFromCoin(coin)
 	}
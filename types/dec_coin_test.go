@@ -62,6 +62,34 @@ func (s *decCoinTestSuite) TestNewDecCoinFromCoin() {
 	})
 }
 
+func (s *decCoinTestSuite) TestNewDecCoinsFromCoins() {
+	testCases := []struct {
+		name     string
+		coins    sdk.Coins
+		expected sdk.DecCoins
+	}{
+		{"empty coins", sdk.Coins{}, sdk.DecCoins{}},
+		{
+			"valid coins",
+			sdk.Coins{sdk.NewInt64Coin(testDenom2, 5), sdk.NewInt64Coin(testDenom1, 10)},
+			sdk.DecCoins{sdk.NewInt64DecCoin(testDenom1, 10), sdk.NewInt64DecCoin(testDenom2, 5)},
+		},
+		{
+			"zero coins are removed",
+			sdk.Coins{sdk.NewInt64Coin(testDenom1, 10), sdk.NewInt64Coin(testDenom2, 0)},
+			sdk.DecCoins{sdk.NewInt64DecCoin(testDenom1, 10)},
+		},
+		{"only zero coins", sdk.Coins{sdk.NewInt64Coin(testDenom1, 0)}, sdk.DecCoins{}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.Require().Equal(tc.expected, sdk.NewDecCoinsFromCoins(tc.coins...))
+		})
+	}
+}
+
 func (s *decCoinTestSuite) TestDecCoinIsPositive() {
 	dc := sdk.NewInt64DecCoin(testDenom1, 5)
 	s.Require().True(dc.IsPositive())
@@ -144,6 +144,30 @@ var (
 	// ErrAppConfig defines an error occurred if min-gas-prices field in BaseConfig is empty.
 	ErrAppConfig = Register(RootCodespace, 40, "error in app.toml")
 
+	// ErrTxTimeoutTimestamp defines an error for when a tx is rejected out due to
+	// an explicitly set timeout timestamp.
+	ErrTxTimeoutTimestamp = Register(RootCodespace, 41, "tx timeout timestamp")
+
+	// ErrQueryOnly defines an error for when a transaction is rejected by a
+	// query-only node.
+	ErrQueryOnly = Register(RootCodespace, 42, "node is query-only")
+
+	// ErrGasWantedTooHigh defines an error for when a transaction wants more gas
+	// than the limit of the node's mempool.
+	ErrGasWantedTooHigh = Register(RootCodespace, 43, "gas wanted too high")
+
+	// ErrAbsurdFee defines an error for when a transaction pays a fee exceeding
+	// the node's sanity limit, likely by mistake.
+	ErrAbsurdFee = Register(RootCodespace, 44, "absurd fee")
+
+	// ErrTxReplayed defines an error for when a transaction with the same bytes
+	// was already processed within the replay protection window.
+	ErrTxReplayed = Register(RootCodespace, 45, "tx already processed")
+
+	// ErrInvalidMemo defines an error for when a transaction memo is rejected
+	// by the memo validator of the app.
+	ErrInvalidMemo = Register(RootCodespace, 46, "invalid memo")
+
 	// ErrPanic is only set when we recover from a panic, so we know to
 	// redact potentially sensitive system info
 	ErrPanic = Register(UndefinedCodespace, 111222, "panic")
@@ -0,0 +1,542 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/abci/v1beta1/event.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventTx is emitted by baseapp for every delivered transaction, successful or
+// not, summarizing its economics.
+type EventTx struct {
+	// fee is the fee of the transaction.
+	Fee Coins `protobuf:"bytes,1,rep,name=fee,proto3,castrepeated=Coins" json:"fee"`
+	// gas_wanted is the gas limit of the transaction.
+	GasWanted uint64 `protobuf:"varint,2,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
+	// gas_used is the gas consumed by the transaction.
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// tx_size is the size in bytes of the raw transaction.
+	TxSize uint64 `protobuf:"varint,4,opt,name=tx_size,json=txSize,proto3" json:"tx_size,omitempty"`
+	// signer_count is the number of distinct signers of the messages.
+	SignerCount uint32 `protobuf:"varint,5,opt,name=signer_count,json=signerCount,proto3" json:"signer_count,omitempty"`
+	// msg_types are the type URLs of the messages, in order.
+	MsgTypes []string `protobuf:"bytes,6,rep,name=msg_types,json=msgTypes,proto3" json:"msg_types,omitempty"`
+}
+
+func (m *EventTx) Reset()         { *m = EventTx{} }
+func (m *EventTx) String() string { return proto.CompactTextString(m) }
+func (*EventTx) ProtoMessage()    {}
+func (*EventTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b261e7b6ec49fd1, []int{0}
+}
+func (m *EventTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTx.Merge(m, src)
+}
+func (m *EventTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTx proto.InternalMessageInfo
+
+func (m *EventTx) GetFee() Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func (m *EventTx) GetGasWanted() uint64 {
+	if m != nil {
+		return m.GasWanted
+	}
+	return 0
+}
+
+func (m *EventTx) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *EventTx) GetTxSize() uint64 {
+	if m != nil {
+		return m.TxSize
+	}
+	return 0
+}
+
+func (m *EventTx) GetSignerCount() uint32 {
+	if m != nil {
+		return m.SignerCount
+	}
+	return 0
+}
+
+func (m *EventTx) GetMsgTypes() []string {
+	if m != nil {
+		return m.MsgTypes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EventTx)(nil), "cosmos.base.abci.v1beta1.EventTx")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/abci/v1beta1/event.proto", fileDescriptor_7b261e7b6ec49fd1)
+}
+
+var fileDescriptor_7b261e7b6ec49fd1 = []byte{
+	// 321 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xcd, 0x4e, 0xc2, 0x40,
+	0x14, 0x85, 0x5b, 0xcb, 0xef, 0x20, 0x9b, 0xc6, 0xc4, 0x82, 0x71, 0xa8, 0xc4, 0x45, 0x37, 0xce,
+	0x04, 0xdd, 0x19, 0x57, 0x10, 0x5f, 0xa0, 0x62, 0x4c, 0xdc, 0x34, 0xd3, 0x76, 0x1c, 0x27, 0xa6,
+	0x1d, 0xc2, 0x1d, 0x10, 0x79, 0x0a, 0x9f, 0xc3, 0x27, 0x61, 0xc9, 0x92, 0x95, 0x1a, 0x78, 0x11,
+	0x33, 0x2d, 0x26, 0xac, 0x66, 0xee, 0x39, 0xdf, 0x3d, 0x37, 0x39, 0xe8, 0x32, 0x51, 0x90, 0x29,
+	0xa0, 0x31, 0x03, 0x4e, 0x59, 0x9c, 0x48, 0x3a, 0x1f, 0xc4, 0x5c, 0xb3, 0x01, 0xe5, 0x73, 0x9e,
+	0x6b, 0x32, 0x99, 0x2a, 0xad, 0x5c, 0xaf, 0xa4, 0x88, 0xa1, 0x88, 0xa1, 0xc8, 0x9e, 0xea, 0x9e,
+	0x08, 0x25, 0x54, 0x01, 0x51, 0xf3, 0x2b, 0xf9, 0x2e, 0x3e, 0x4c, 0xfd, 0x0f, 0x4c, 0x94, 0xcc,
+	0x4b, 0xbf, 0xbf, 0xb1, 0x51, 0xfd, 0xde, 0xe4, 0x8f, 0x17, 0xee, 0x2d, 0x72, 0x5e, 0x38, 0xf7,
+	0x6c, 0xdf, 0x09, 0x5a, 0xd7, 0x1d, 0x72, 0x78, 0x69, 0xbf, 0x49, 0x46, 0x4a, 0xe6, 0xc3, 0xf6,
+	0xea, 0xbb, 0x67, 0x7d, 0xfd, 0xf4, 0xaa, 0x66, 0x82, 0xd0, 0x2c, 0xb9, 0xe7, 0x08, 0x09, 0x06,
+	0xd1, 0x3b, 0xcb, 0x35, 0x4f, 0xbd, 0x23, 0xdf, 0x0e, 0x2a, 0x61, 0x53, 0x30, 0x78, 0x2a, 0x04,
+	0xb7, 0x83, 0x1a, 0xc6, 0x9e, 0x01, 0x4f, 0x3d, 0xa7, 0x30, 0xeb, 0x82, 0xc1, 0x23, 0xf0, 0xd4,
+	0x3d, 0x45, 0x75, 0xbd, 0x88, 0x40, 0x2e, 0xb9, 0x57, 0x29, 0x9c, 0x9a, 0x5e, 0x3c, 0xc8, 0x25,
+	0x77, 0x2f, 0xd0, 0x31, 0x48, 0x91, 0xf3, 0x69, 0x94, 0xa8, 0x59, 0xae, 0xbd, 0xaa, 0x6f, 0x07,
+	0xed, 0xb0, 0x55, 0x6a, 0x23, 0x23, 0xb9, 0x67, 0xa8, 0x99, 0x81, 0x88, 0xf4, 0xc7, 0x84, 0x83,
+	0x57, 0xf3, 0x9d, 0xa0, 0x19, 0x36, 0x32, 0x10, 0x63, 0x33, 0x0f, 0xef, 0x56, 0x5b, 0x6c, 0xaf,
+	0xb7, 0xd8, 0xfe, 0xdd, 0x62, 0xfb, 0x73, 0x87, 0xad, 0xf5, 0x0e, 0x5b, 0x9b, 0x1d, 0xb6, 0x9e,
+	0xfb, 0x42, 0xea, 0xd7, 0x59, 0x4c, 0x12, 0x95, 0xd1, 0x7d, 0x3f, 0xe5, 0x73, 0x05, 0xe9, 0x1b,
+	0x2d, 0xd2, 0xe2, 0x5a, 0xd1, 0xcf, 0xcd, 0xdf, 0x00, 0x6f, 0x71, 0x20, 0x30, 0x97, 0x01, 0x00,
+	0x00,
+}
+
+func (m *EventTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypes) > 0 {
+		for iNdEx := len(m.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypes[iNdEx])
+			copy(dAtA[i:], m.MsgTypes[iNdEx])
+			i = encodeVarintEvent(dAtA, i, uint64(len(m.MsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.SignerCount != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SignerCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TxSize != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.TxSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GasWanted != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.GasWanted))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if m.GasWanted != 0 {
+		n += 1 + sovEvent(uint64(m.GasWanted))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovEvent(uint64(m.GasUsed))
+	}
+	if m.TxSize != 0 {
+		n += 1 + sovEvent(uint64(m.TxSize))
+	}
+	if m.SignerCount != 0 {
+		n += 1 + sovEvent(uint64(m.SignerCount))
+	}
+	if len(m.MsgTypes) > 0 {
+		for _, s := range m.MsgTypes {
+			l = len(s)
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
+			}
+			m.GasWanted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasWanted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSize", wireType)
+			}
+			m.TxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerCount", wireType)
+			}
+			m.SignerCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypes = append(m.MsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvent(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvent
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvent
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvent
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvent        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvent          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvent = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,35 @@
+package types
+
+// GasBreakdown is the gas used by a transaction, split between its AnteHandler
+// and each of its Msgs, in their order. It is recorded by the BaseApp when
+// estimating the gas of a transaction.
+type GasBreakdown struct {
+	AnteGas Gas
+	MsgsGas []Gas
+}
+
+// gasBreakdownKey is the context key of the GasBreakdown recorded for the
+// transaction being executed.
+type gasBreakdownKey struct{}
+
+// WithGasBreakdown returns a context recording the gas breakdown of the
+// transaction executed with it into breakdown.
+func WithGasBreakdown(ctx Context, breakdown *GasBreakdown) Context {
+	return ctx.WithValue(gasBreakdownKey{}, breakdown)
+}
+
+// RecordAnteGas records the gas consumed by the AnteHandler, if the gas
+// breakdown of the transaction is recorded.
+func RecordAnteGas(ctx Context, gas Gas) {
+	if breakdown, ok := ctx.Value(gasBreakdownKey{}).(*GasBreakdown); ok {
+		breakdown.AnteGas = gas
+	}
+}
+
+// RecordMsgGas records the gas consumed by the next Msg, net of its refunds,
+// if the gas breakdown of the transaction is recorded.
+func RecordMsgGas(ctx Context, gas Gas) {
+	if breakdown, ok := ctx.Value(gasBreakdownKey{}).(*GasBreakdown); ok {
+		breakdown.MsgsGas = append(breakdown.MsgsGas, gas)
+	}
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// EventTypeGasRefund is the type of the event emitted when gas is refunded
+	// to the transaction executing a Msg.
+	EventTypeGasRefund = "gas_refund"
+
+	AttributeKeyGasRefunded  = "refunded"
+	AttributeKeyGasRefundFor = "descriptor"
+)
+
+// msgGasRefundKey is the context key of the gas refund tracker of the Msg
+// being executed.
+type msgGasRefundKey struct{}
+
+// msgGasRefund tracks the gas refunded during the execution of a Msg.
+type msgGasRefund struct {
+	meter    GasMeter
+	startGas Gas
+	refunded Gas
+}
+
+// WithMsgGasRefund returns a context allowing the Msg executed with it to be
+// refunded, through RefundGas, up to the gas it consumes from the gas meter of
+// the context. It is called by the BaseApp before executing each Msg.
+func WithMsgGasRefund(ctx Context) Context {
+	return ctx.WithValue(msgGasRefundKey{}, &msgGasRefund{
+		meter:    ctx.GasMeter(),
+		startGas: ctx.GasMeter().GasConsumed(),
+	})
+}
+
+// RefundGas credits back up to amount gas to the transaction executing the
+// current Msg, e.g. to discount operations clearing state. The refund is
+// bounded by the gas the Msg consumed so far net of its previous refunds, and
+// emits a gas refund event. It returns the refunded amount, which is zero
+// outside of Msg execution.
+func RefundGas(ctx Context, amount Gas, descriptor string) Gas {
+	tracker, ok := ctx.Value(msgGasRefundKey{}).(*msgGasRefund)
+	if !ok || amount == 0 {
+		return 0
+	}
+
+	consumed := tracker.meter.GasConsumed()
+	if consumed <= tracker.startGas {
+		return 0
+	}
+
+	if refundable := consumed - tracker.startGas; amount > refundable {
+		amount = refundable
+	}
+
+	tracker.meter.RefundGas(amount, descriptor)
+	tracker.refunded += amount
+
+	ctx.EventManager().EmitEvent(NewEvent(
+		EventTypeGasRefund,
+		NewAttribute(AttributeKeyGasRefunded, fmt.Sprintf("%d", amount)),
+		NewAttribute(AttributeKeyGasRefundFor, descriptor),
+	))
+
+	return amount
+}
+
+// GasRefunded returns the gas refunded so far to the transaction executing the
+// current Msg.
+func GasRefunded(ctx Context) Gas {
+	tracker, ok := ctx.Value(msgGasRefundKey{}).(*msgGasRefund)
+	if !ok {
+		return 0
+	}
+
+	return tracker.refunded
+}
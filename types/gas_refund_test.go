@@ -0,0 +1,40 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestRefundGas(t *testing.T) {
+	ctx := types.NewContext(nil, tmproto.Header{}, false, nil).WithGasMeter(types.NewGasMeter(1000))
+	ctx.GasMeter().ConsumeGas(100, "ante")
+
+	// no refund outside of Msg execution
+	require.Zero(t, types.RefundGas(ctx, 50, "test"))
+	require.Equal(t, types.Gas(100), ctx.GasMeter().GasConsumed())
+
+	msgCtx := types.WithMsgGasRefund(ctx)
+
+	// the gas consumed before the Msg cannot be refunded
+	require.Zero(t, types.RefundGas(msgCtx, 50, "test"))
+
+	msgCtx.GasMeter().ConsumeGas(300, "msg")
+	require.Equal(t, types.Gas(200), types.RefundGas(msgCtx, 200, "test"))
+	require.Equal(t, types.Gas(200), msgCtx.GasMeter().GasConsumed())
+
+	// refunds are bounded by the gas consumed by the Msg net of refunds
+	require.Equal(t, types.Gas(100), types.RefundGas(msgCtx, 500, "test"))
+	require.Equal(t, types.Gas(100), msgCtx.GasMeter().GasConsumed())
+	require.Zero(t, types.RefundGas(msgCtx, 1, "test"))
+	require.Equal(t, types.Gas(300), types.GasRefunded(msgCtx))
+
+	events := msgCtx.EventManager().Events()
+	require.Len(t, events, 2)
+	require.Equal(t, types.EventTypeGasRefund, events[0].Type)
+	require.Equal(t, "200", string(events[0].Attributes[0].Value))
+	require.Equal(t, "test", string(events[0].Attributes[1].Value))
+}
@@ -0,0 +1,16 @@
+package types
+
+// A HealthCheck checks a condition of the node's state at the latest height.
+// It returns an error describing the problem if the condition is not met.
+type HealthCheck func(ctx Context) error
+
+// HealthCheckRegistry is the expected interface for registering the health
+// checks of the modules, reported by the node health endpoints.
+type HealthCheckRegistry interface {
+	// RegisterLivenessCheck registers a check failing when the node is not
+	// working and needs to be restarted.
+	RegisterLivenessCheck(name string, check HealthCheck)
+	// RegisterReadinessCheck registers a check failing when the node works but
+	// should not be served traffic, e.g. because its data is stale.
+	RegisterReadinessCheck(name string, check HealthCheck)
+}
@@ -11,11 +11,58 @@ type Invariant func(ctx Context) (string, bool)
 // Invariants defines a group of invariants
 type Invariants []Invariant
 
+// InvariantSeverity defines how a broken invariant is handled by the crisis
+// module.
+type InvariantSeverity int32
+
+const (
+	// InvariantSeverityHalt halts the chain when the invariant is broken. It
+	// is the severity of the invariants registered without one.
+	InvariantSeverityHalt InvariantSeverity = iota
+	// InvariantSeverityAlert only reports the broken invariant, in the logs,
+	// events and telemetry.
+	InvariantSeverityAlert
+	// InvariantSeverityAutoFix reports the broken invariant like
+	// InvariantSeverityAlert, and it can be fixed by its InvariantRemediation
+	// once approved by governance.
+	InvariantSeverityAutoFix
+)
+
+// String implements the Stringer interface.
+func (s InvariantSeverity) String() string {
+	switch s {
+	case InvariantSeverityHalt:
+		return "halt"
+	case InvariantSeverityAlert:
+		return "alert"
+	case InvariantSeverityAutoFix:
+		return "auto-fix"
+	default:
+		return fmt.Sprintf("InvariantSeverity(%d)", int32(s))
+	}
+}
+
+// An InvariantRemediation is a function which fixes the state breaking an
+// invariant, e.g. a minor accounting drift. It is only executed when approved
+// by governance.
+type InvariantRemediation func(ctx Context) error
+
 // expected interface for registering invariants
 type InvariantRegistry interface {
 	RegisterRoute(moduleName, route string, invar Invariant)
 }
 
+// SeverityInvariantRegistry is an optional extension of InvariantRegistry
+// for registering invariants with a severity other than halting the chain,
+// and the remediation of the InvariantSeverityAutoFix ones.
+type SeverityInvariantRegistry interface {
+	InvariantRegistry
+
+	RegisterRouteWithSeverity(
+		moduleName, route string, invar Invariant, severity InvariantSeverity, remediation InvariantRemediation,
+	)
+}
+
 // FormatInvariant returns a standardized invariant message.
 func FormatInvariant(module, name, msg string) string {
 	return fmt.Sprintf("%s: %s invariant\n%s\n", module, name, msg)
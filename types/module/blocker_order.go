@@ -0,0 +1,149 @@
+package module
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OrderParamspace defines the parameter subspace of the blocker orders of the
+// module manager.
+const OrderParamspace = "module"
+
+// Parameter store keys of the begin and end blocker orders, overriding the
+// OrderBeginBlockers and OrderEndBlockers of the module manager when set, so
+// that the orders can be changed by governance without a binary upgrade.
+var (
+	ParamStoreKeyOrderBeginBlockers = []byte("OrderBeginBlockers")
+	ParamStoreKeyOrderEndBlockers   = []byte("OrderEndBlockers")
+)
+
+// ParamStore defines the interface the parameter store of the blocker orders
+// used by the module manager must fulfill.
+type ParamStore interface {
+	Get(ctx sdk.Context, key []byte, ptr interface{})
+	Has(ctx sdk.Context, key []byte) bool
+}
+
+// BlockerOrderConstraints are the constraints on the begin and end blocker
+// orders declared by a module. They name the modules whose blockers must run
+// after (resp. before) the one of the module; the modules missing from the
+// module manager are ignored.
+type BlockerOrderConstraints struct {
+	BeginBlockAfter  []string
+	BeginBlockBefore []string
+	EndBlockAfter    []string
+	EndBlockBefore   []string
+}
+
+// BlockerOrderAppModule is an optional extension of AppModule for modules whose
+// begin or end blockers depend on the ones of other modules, e.g. distribution
+// allocating the tokens minted by the mint begin blocker. The blocker orders of
+// the module manager, including the ones set through its params, must respect
+// the constraints of all its modules.
+type BlockerOrderAppModule interface {
+	AppModule
+
+	// BlockerOrderConstraints returns the constraints of the module on the
+	// begin and end blocker orders.
+	BlockerOrderConstraints() BlockerOrderConstraints
+}
+
+// SetParamStore sets the parameter store of the blocker orders. The orders set
+// in it override OrderBeginBlockers and OrderEndBlockers, unless they are empty.
+func (m *Manager) SetParamStore(paramStore ParamStore) {
+	m.paramStore = paramStore
+}
+
+// ValidateOrderBeginBlockers defines a validation on the begin blocker order
+// param: it must be empty, or contain all the modules once in an order
+// respecting their BlockerOrderConstraints.
+func (m *Manager) ValidateOrderBeginBlockers(i interface{}) error {
+	return m.validateOrderParam(i, func(c BlockerOrderConstraints) ([]string, []string) {
+		return c.BeginBlockAfter, c.BeginBlockBefore
+	})
+}
+
+// ValidateOrderEndBlockers defines a validation on the end blocker order param:
+// it must be empty, or contain all the modules once in an order respecting
+// their BlockerOrderConstraints.
+func (m *Manager) ValidateOrderEndBlockers(i interface{}) error {
+	return m.validateOrderParam(i, func(c BlockerOrderConstraints) ([]string, []string) {
+		return c.EndBlockAfter, c.EndBlockBefore
+	})
+}
+
+func (m *Manager) validateOrderParam(i interface{}, constraints func(BlockerOrderConstraints) ([]string, []string)) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if len(v) == 0 {
+		return nil
+	}
+
+	return m.validateOrder(v, constraints)
+}
+
+// validateOrder checks that the order contains all the modules once, and that
+// it respects the constraints of the modules.
+func (m *Manager) validateOrder(order []string, constraints func(BlockerOrderConstraints) ([]string, []string)) error {
+	positions := make(map[string]int, len(order))
+	for i, name := range order {
+		if _, ok := m.Modules[name]; !ok {
+			return fmt.Errorf("unknown module %s", name)
+		}
+		if _, ok := positions[name]; ok {
+			return fmt.Errorf("duplicate module %s", name)
+		}
+		positions[name] = i
+	}
+	if len(positions) != len(m.Modules) {
+		return fmt.Errorf("all %d modules must be ordered, got %d", len(m.Modules), len(positions))
+	}
+
+	// the constraints are checked in the order to report the first violated one
+	for _, name := range order {
+		module, ok := m.Modules[name].(BlockerOrderAppModule)
+		if !ok {
+			continue
+		}
+
+		after, before := constraints(module.BlockerOrderConstraints())
+		for _, other := range after {
+			if pos, ok := positions[other]; ok && pos > positions[name] {
+				return fmt.Errorf("module %s must run after %s", name, other)
+			}
+		}
+		for _, other := range before {
+			if pos, ok := positions[other]; ok && pos < positions[name] {
+				return fmt.Errorf("module %s must run before %s", name, other)
+			}
+		}
+	}
+
+	return nil
+}
+
+// blockersOrder returns the blocker order set in the param store under the key,
+// or the given default order if it is not set. An order which became invalid,
+// e.g. as modules were added by a binary upgrade, is ignored until it is reset.
+func (m *Manager) blockersOrder(ctx sdk.Context, key []byte, defaultOrder []string, validate func(interface{}) error) []string {
+	if m.paramStore == nil || !m.paramStore.Has(ctx, key) {
+		return defaultOrder
+	}
+
+	var order []string
+	m.paramStore.Get(ctx, key, &order)
+	if len(order) == 0 {
+		return defaultOrder
+	}
+
+	if err := validate(order); err != nil {
+		ctx.Logger().Error("ignoring invalid blocker order param", "key", string(key), "err", err)
+		return defaultOrder
+	}
+
+	return order
+}
@@ -115,3 +115,24 @@ func (c configurator) runModuleMigrations(ctx sdk.Context, moduleName string, fr
 
 	return nil
 }
+
+// MigrationObserver is notified around the migrations of every module run by
+// Manager.RunMigrations, e.g. to report on the migrations of an upgrade.
+type MigrationObserver interface {
+	// BeforeModuleMigration is called before running the migrations, or the
+	// InitGenesis, of a module. fromVersion is 0 for modules absent from the
+	// version map.
+	BeforeModuleMigration(moduleName string, fromVersion, toVersion uint64)
+
+	// AfterModuleMigration is called with the error of the module migrations,
+	// if any. It is not called if the migrations panic.
+	AfterModuleMigration(moduleName string, err error)
+}
+
+type migrationObserverKey struct{}
+
+// WithMigrationObserver returns a context notifying the given observer of the
+// module migrations run by Manager.RunMigrations with it.
+func WithMigrationObserver(ctx sdk.Context, observer MigrationObserver) sdk.Context {
+	return ctx.WithValue(migrationObserverKey{}, observer)
+}
@@ -0,0 +1,257 @@
+package module
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StreamingGenesisAppModule is an optional extension of AppModule for modules
+// with a large genesis state, e.g. the balances of bank, which they read and
+// write as a stream of JSON instead of marshaling it as a single blob in
+// memory. The JSON is the same as the one of InitGenesis and ExportGenesis.
+type StreamingGenesisAppModule interface {
+	AppModule
+
+	// InitGenesisFromReader initializes the state of the module from its JSON
+	// genesis state read from the reader, like InitGenesis.
+	InitGenesisFromReader(sdk.Context, codec.JSONCodec, io.Reader) ([]abci.ValidatorUpdate, error)
+
+	// ExportGenesisToWriter writes the JSON genesis state of the module to the
+	// writer, like ExportGenesis.
+	ExportGenesisToWriter(sdk.Context, codec.JSONCodec, io.Writer) error
+}
+
+// ExportGenesisToWriter writes the exported genesis state of all the modules
+// to the writer, as a JSON object by sorted module name like the one marshaled
+// from the result of ExportGenesis. The modules implementing
+// StreamingGenesisAppModule are streamed.
+func (m *Manager) ExportGenesisToWriter(ctx sdk.Context, cdc codec.JSONCodec, w io.Writer) error {
+	// the module names are sorted as in the JSON of a map
+	moduleNames := append([]string{}, m.OrderExportGenesis...)
+	sort.Strings(moduleNames)
+
+	if _, err := io.WriteString(w, "{"); err != nil {
+		return err
+	}
+	for i, moduleName := range moduleNames {
+		key, err := json.Marshal(moduleName)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			key = append([]byte(","), key...)
+		}
+		if _, err := w.Write(append(key, ':')); err != nil {
+			return err
+		}
+
+		if module, ok := m.Modules[moduleName].(StreamingGenesisAppModule); ok {
+			if err := module.ExportGenesisToWriter(ctx, cdc, w); err != nil {
+				return fmt.Errorf("failed to export %s genesis state: %w", moduleName, err)
+			}
+			continue
+		}
+
+		// an empty genesis state is marshaled as null, as a nil json.RawMessage
+		genState := m.Modules[moduleName].ExportGenesis(ctx, cdc)
+		if len(genState) == 0 {
+			genState = json.RawMessage("null")
+		}
+		if _, err := w.Write(genState); err != nil {
+			return err
+		}
+	}
+	_, err := io.WriteString(w, "}")
+
+	return err
+}
+
+// GenesisWriter writes the JSON genesis state of a module field by field, so
+// that the items of its repeated fields can be written as they are iterated
+// from the store. Written in the proto order, the fields are marshaled as by
+// the JSONCodec. The first error is returned by Close.
+type GenesisWriter struct {
+	cdc    codec.JSONCodec
+	w      io.Writer
+	fields int
+	items  int
+	err    error
+}
+
+// NewGenesisWriter returns a GenesisWriter writing to w.
+func NewGenesisWriter(cdc codec.JSONCodec, w io.Writer) *GenesisWriter {
+	gw := &GenesisWriter{cdc: cdc, w: w}
+	gw.write([]byte("{"))
+
+	return gw
+}
+
+func (gw *GenesisWriter) write(bz []byte) {
+	if gw.err == nil {
+		_, gw.err = gw.w.Write(bz)
+	}
+}
+
+func (gw *GenesisWriter) writeKey(name string) {
+	key, err := json.Marshal(name)
+	if err != nil && gw.err == nil {
+		gw.err = err
+	}
+	if gw.fields > 0 {
+		gw.write([]byte(","))
+	}
+	gw.write(append(key, ':'))
+	gw.fields++
+}
+
+// WriteField writes a field with the JSON of the given value, e.g. a bool.
+func (gw *GenesisWriter) WriteField(name string, value interface{}) {
+	bz, err := json.Marshal(value)
+	if err != nil && gw.err == nil {
+		gw.err = err
+	}
+
+	gw.writeKey(name)
+	gw.write(bz)
+}
+
+// WriteMessageField writes a field with the JSON of the given message.
+func (gw *GenesisWriter) WriteMessageField(name string, msg proto.Message) {
+	bz, err := gw.cdc.MarshalJSON(msg)
+	if err != nil && gw.err == nil {
+		gw.err = err
+	}
+
+	gw.writeKey(name)
+	gw.write(bz)
+}
+
+// BeginArray begins a repeated field, whose items are written by WriteItem
+// until EndArray.
+func (gw *GenesisWriter) BeginArray(name string) {
+	gw.writeKey(name)
+	gw.write([]byte("["))
+	gw.items = 0
+}
+
+// WriteItem writes an item of the current repeated field.
+func (gw *GenesisWriter) WriteItem(msg proto.Message) {
+	bz, err := gw.cdc.MarshalJSON(msg)
+	if err != nil && gw.err == nil {
+		gw.err = err
+	}
+
+	if gw.items > 0 {
+		gw.write([]byte(","))
+	}
+	gw.write(bz)
+	gw.items++
+}
+
+// EndArray ends the current repeated field.
+func (gw *GenesisWriter) EndArray() {
+	gw.write([]byte("]"))
+}
+
+// Close ends the JSON object and returns the first error, if any.
+func (gw *GenesisWriter) Close() error {
+	gw.write([]byte("}"))
+	return gw.err
+}
+
+// ReadGenesis reads the JSON genesis state of a module from the reader. The
+// items of the repeated fields with a handler in streamed are passed to their
+// handler as they are read, in the order of the JSON, instead of being held in
+// memory. The other fields are unmarshaled into genState once the whole JSON
+// is read, so the handlers must not depend on them.
+func ReadGenesis(
+	cdc codec.JSONCodec, r io.Reader, genState proto.Message, streamed map[string]func(json.RawMessage) error,
+) error {
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+
+	fields := make(map[string]json.RawMessage)
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		name, ok := token.(string)
+		if !ok {
+			return fmt.Errorf("invalid genesis field name %v", token)
+		}
+
+		handler, ok := streamed[name]
+		if !ok {
+			var value json.RawMessage
+			if err := dec.Decode(&value); err != nil {
+				return err
+			}
+			fields[name] = value
+			continue
+		}
+
+		if err := readArray(dec, handler); err != nil {
+			return fmt.Errorf("invalid genesis field %s: %w", name, err)
+		}
+	}
+	if err := expectDelim(dec, '}'); err != nil {
+		return err
+	}
+
+	bz, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+
+	return cdc.UnmarshalJSON(bz, genState)
+}
+
+// readArray passes the items of the JSON array, or null, read from the decoder
+// to the handler.
+func readArray(dec *json.Decoder, handler func(json.RawMessage) error) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if token == nil {
+		return nil
+	}
+	if token != json.Delim('[') {
+		return fmt.Errorf("expected an array, got %v", token)
+	}
+
+	for dec.More() {
+		var item json.RawMessage
+		if err := dec.Decode(&item); err != nil {
+			return err
+		}
+		if err := handler(item); err != nil {
+			return err
+		}
+	}
+
+	return expectDelim(dec, ']')
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("expected %s, got %v", delim, token)
+	}
+
+	return nil
+}
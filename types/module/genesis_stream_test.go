@@ -0,0 +1,105 @@
+package module_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/tests/mocks"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// streamingAppModule is an AppModule streaming its genesis state, which it
+// records when initialized.
+type streamingAppModule struct {
+	module.AppModule
+
+	name    string
+	genesis []byte
+	read    *[]byte
+}
+
+func (m streamingAppModule) Name() string { return m.name }
+
+func (m streamingAppModule) InitGenesisFromReader(_ sdk.Context, _ codec.JSONCodec, r io.Reader) ([]abci.ValidatorUpdate, error) {
+	bz, err := ioutil.ReadAll(r)
+	*m.read = bz
+	return nil, err
+}
+
+func (m streamingAppModule) ExportGenesisToWriter(_ sdk.Context, _ codec.JSONCodec, w io.Writer) error {
+	_, err := w.Write(m.genesis)
+	return err
+}
+
+func TestManager_StreamingGenesis(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	var read []byte
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mm := module.NewManager(mockAppModule1, streamingAppModule{name: "module2", genesis: []byte(`{"key2":"value2"}`), read: &read})
+
+	ctx := sdk.Context{}
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+	mockAppModule1.EXPECT().ExportGenesis(gomock.Eq(ctx), gomock.Eq(cdc)).Times(1).Return(json.RawMessage(`{"key1":"value1"}`))
+
+	// the streamed genesis state is the JSON of the exported one
+	var buf bytes.Buffer
+	require.NoError(t, mm.ExportGenesisToWriter(ctx, cdc, &buf))
+	require.Equal(t, `{"module1":{"key1":"value1"},"module2":{"key2":"value2"}}`, buf.String())
+
+	genesisData := map[string]json.RawMessage{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &genesisData))
+
+	mockAppModule1.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(genesisData["module1"])).Times(1).Return(nil)
+	mm.InitGenesis(ctx, cdc, genesisData)
+	require.Equal(t, `{"key2":"value2"}`, string(read))
+}
+
+func TestReadGenesis(t *testing.T) {
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+
+	var addresses []string
+	streamed := map[string]func(json.RawMessage) error{
+		"balances": func(bz json.RawMessage) error {
+			var balance banktypes.Balance
+			if err := cdc.UnmarshalJSON(bz, &balance); err != nil {
+				return err
+			}
+
+			addresses = append(addresses, balance.Address)
+			return nil
+		},
+	}
+
+	var genState banktypes.GenesisState
+	err := module.ReadGenesis(cdc, strings.NewReader(
+		`{"supply":[{"denom":"stake","amount":"3"}],"balances":[{"address":"addr1","coins":[]},{"address":"addr2","coins":[]}]}`,
+	), &genState, streamed)
+	require.NoError(t, err)
+	require.Equal(t, []string{"addr1", "addr2"}, addresses)
+	require.Empty(t, genState.Balances)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 3)), genState.Supply)
+
+	// a null streamed field has no items
+	addresses = nil
+	require.NoError(t, module.ReadGenesis(cdc, strings.NewReader(`{"balances":null}`), &genState, streamed))
+	require.Empty(t, addresses)
+
+	err = module.ReadGenesis(cdc, strings.NewReader(`{"balances":{}}`), &genState, streamed)
+	require.EqualError(t, err, "invalid genesis field balances: expected an array, got {")
+	require.Error(t, module.ReadGenesis(cdc, strings.NewReader(`[]`), &genState, streamed))
+}
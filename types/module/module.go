@@ -29,6 +29,7 @@ needlessly defining many placeholder functions
 package module
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"sort"
@@ -146,6 +147,50 @@ func (bm BasicManager) AddQueryCommands(rootQueryCmd *cobra.Command) {
 	}
 }
 
+// TableExportAppModuleBasic is an optional extension of AppModuleBasic for
+// modules exporting their state as tables, e.g. for the ingestion of the
+// state by analytics warehouses.
+type TableExportAppModuleBasic interface {
+	AppModuleBasic
+
+	// ExportTables converts the exported genesis state of the module to tables.
+	ExportTables(codec.JSONCodec, json.RawMessage) ([]*sdk.Table, error)
+}
+
+// ExportTables converts the exported genesis state of the given modules to
+// tables, by module name. All the modules implementing
+// TableExportAppModuleBasic are exported if none is given.
+func (bm BasicManager) ExportTables(cdc codec.JSONCodec, genesis map[string]json.RawMessage, modules []string) (map[string][]*sdk.Table, error) {
+	if len(modules) == 0 {
+		for name, b := range bm {
+			if _, ok := b.(TableExportAppModuleBasic); ok {
+				modules = append(modules, name)
+			}
+		}
+		sort.Strings(modules)
+	}
+
+	tables := make(map[string][]*sdk.Table, len(modules))
+	for _, name := range modules {
+		b, ok := bm[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown module %s", name)
+		}
+		exporter, ok := b.(TableExportAppModuleBasic)
+		if !ok {
+			return nil, fmt.Errorf("module %s does not export tables", name)
+		}
+
+		moduleTables, err := exporter.ExportTables(cdc, genesis[name])
+		if err != nil {
+			return nil, fmt.Errorf("failed to export %s tables: %w", name, err)
+		}
+		tables[name] = moduleTables
+	}
+
+	return tables, nil
+}
+
 // AppModuleGenesis is the standard form for an application module genesis functions
 type AppModuleGenesis interface {
 	AppModuleBasic
@@ -184,6 +229,37 @@ type AppModule interface {
 	EndBlock(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate
 }
 
+// PrepareProposalAppModule is an optional extension of AppModule for modules
+// taking part in selecting and ordering the transactions of blocks proposed by
+// the node (e.g. moving oracle price updates to the top of the block).
+type PrepareProposalAppModule interface {
+	AppModule
+
+	// PrepareProposal receives, in req.Txs, the transactions returned by the
+	// modules preceding it in the PrepareProposal order and returns the
+	// transactions to pass on to the next module.
+	PrepareProposal(sdk.Context, sdk.RequestPrepareProposal) [][]byte
+}
+
+// ProcessProposalAppModule is an optional extension of AppModule for modules
+// validating blocks proposed by other validators.
+type ProcessProposalAppModule interface {
+	AppModule
+
+	// ProcessProposal returns an error if the proposed block must be rejected.
+	ProcessProposal(sdk.Context, sdk.RequestProcessProposal) error
+}
+
+// HealthCheckAppModule is an optional extension of AppModule for modules
+// reporting their health, e.g. the freshness of an oracle feed, through the node
+// health endpoints.
+type HealthCheckAppModule interface {
+	AppModule
+
+	// RegisterHealthChecks registers the health checks of the module.
+	RegisterHealthChecks(sdk.HealthCheckRegistry)
+}
+
 // GenesisOnlyAppModule is an AppModule that only has import/export functionality
 type GenesisOnlyAppModule struct {
 	AppModuleGenesis
@@ -231,6 +307,16 @@ type Manager struct {
 	OrderBeginBlockers []string
 	OrderEndBlockers   []string
 	OrderMigrations    []string
+
+	// OrderPrepareProposal and OrderProcessProposal only contain the modules
+	// implementing PrepareProposalAppModule and ProcessProposalAppModule
+	// respectively.
+	OrderPrepareProposal []string
+	OrderProcessProposal []string
+
+	// paramStore holds the blocker orders overriding OrderBeginBlockers and
+	// OrderEndBlockers, if any.
+	paramStore ParamStore
 }
 
 // NewManager creates a new Manager object
@@ -238,17 +324,27 @@ func NewManager(modules ...AppModule) *Manager {
 
 	moduleMap := make(map[string]AppModule)
 	modulesStr := make([]string, 0, len(modules))
+	var prepareProposalStr, processProposalStr []string
 	for _, module := range modules {
 		moduleMap[module.Name()] = module
 		modulesStr = append(modulesStr, module.Name())
+
+		if _, ok := module.(PrepareProposalAppModule); ok {
+			prepareProposalStr = append(prepareProposalStr, module.Name())
+		}
+		if _, ok := module.(ProcessProposalAppModule); ok {
+			processProposalStr = append(processProposalStr, module.Name())
+		}
 	}
 
 	return &Manager{
-		Modules:            moduleMap,
-		OrderInitGenesis:   modulesStr,
-		OrderExportGenesis: modulesStr,
-		OrderBeginBlockers: modulesStr,
-		OrderEndBlockers:   modulesStr,
+		Modules:              moduleMap,
+		OrderInitGenesis:     modulesStr,
+		OrderExportGenesis:   modulesStr,
+		OrderBeginBlockers:   modulesStr,
+		OrderEndBlockers:     modulesStr,
+		OrderPrepareProposal: prepareProposalStr,
+		OrderProcessProposal: processProposalStr,
 	}
 }
 
@@ -264,15 +360,23 @@ func (m *Manager) SetOrderExportGenesis(moduleNames ...string) {
 	m.OrderExportGenesis = moduleNames
 }
 
-// SetOrderBeginBlockers sets the order of set begin-blocker calls
+// SetOrderBeginBlockers sets the order of set begin-blocker calls, which must
+// respect the BlockerOrderConstraints of the modules
 func (m *Manager) SetOrderBeginBlockers(moduleNames ...string) {
 	m.assertNoForgottenModules("SetOrderBeginBlockers", moduleNames)
+	if err := m.ValidateOrderBeginBlockers(moduleNames); err != nil {
+		panic(fmt.Sprintf("SetOrderBeginBlockers: %s", err))
+	}
 	m.OrderBeginBlockers = moduleNames
 }
 
-// SetOrderEndBlockers sets the order of set end-blocker calls
+// SetOrderEndBlockers sets the order of set end-blocker calls, which must
+// respect the BlockerOrderConstraints of the modules
 func (m *Manager) SetOrderEndBlockers(moduleNames ...string) {
 	m.assertNoForgottenModules("SetOrderEndBlockers", moduleNames)
+	if err := m.ValidateOrderEndBlockers(moduleNames); err != nil {
+		panic(fmt.Sprintf("SetOrderEndBlockers: %s", err))
+	}
 	m.OrderEndBlockers = moduleNames
 }
 
@@ -283,6 +387,26 @@ func (m *Manager) SetOrderMigrations(moduleNames ...string) {
 	m.OrderMigrations = moduleNames
 }
 
+// SetOrderPrepareProposal sets the order in which modules implementing
+// PrepareProposalAppModule process the transactions of a block proposal.
+func (m *Manager) SetOrderPrepareProposal(moduleNames ...string) {
+	m.assertProposalOrder("SetOrderPrepareProposal", moduleNames, func(module AppModule) bool {
+		_, ok := module.(PrepareProposalAppModule)
+		return ok
+	})
+	m.OrderPrepareProposal = moduleNames
+}
+
+// SetOrderProcessProposal sets the order in which modules implementing
+// ProcessProposalAppModule validate a block proposal.
+func (m *Manager) SetOrderProcessProposal(moduleNames ...string) {
+	m.assertProposalOrder("SetOrderProcessProposal", moduleNames, func(module AppModule) bool {
+		_, ok := module.(ProcessProposalAppModule)
+		return ok
+	})
+	m.OrderProcessProposal = moduleNames
+}
+
 // RegisterInvariants registers all module invariants
 func (m *Manager) RegisterInvariants(ir sdk.InvariantRegistry) {
 	for _, module := range m.Modules {
@@ -290,6 +414,16 @@ func (m *Manager) RegisterInvariants(ir sdk.InvariantRegistry) {
 	}
 }
 
+// RegisterHealthChecks registers the health checks of the modules implementing
+// HealthCheckAppModule.
+func (m *Manager) RegisterHealthChecks(registry sdk.HealthCheckRegistry) {
+	for _, module := range m.Modules {
+		if module, ok := module.(HealthCheckAppModule); ok {
+			module.RegisterHealthChecks(registry)
+		}
+	}
+}
+
 // RegisterRoutes registers all module routes and module querier routes
 func (m *Manager) RegisterRoutes(router sdk.Router, queryRouter sdk.QueryRouter, legacyQuerierCdc *codec.LegacyAmino) {
 	for _, module := range m.Modules {
@@ -317,7 +451,16 @@ func (m *Manager) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, genesisData
 			continue
 		}
 
-		moduleValUpdates := m.Modules[moduleName].InitGenesis(ctx, cdc, genesisData[moduleName])
+		var moduleValUpdates []abci.ValidatorUpdate
+		if module, ok := m.Modules[moduleName].(StreamingGenesisAppModule); ok {
+			var err error
+			moduleValUpdates, err = module.InitGenesisFromReader(ctx, cdc, bytes.NewReader(genesisData[moduleName]))
+			if err != nil {
+				panic(fmt.Sprintf("failed to initialize %s genesis state: %s", moduleName, err))
+			}
+		} else {
+			moduleValUpdates = m.Modules[moduleName].InitGenesis(ctx, cdc, genesisData[moduleName])
+		}
 
 		// use these validator updates if provided, the module manager assumes
 		// only one module will update the validator set
@@ -363,6 +506,30 @@ func (m *Manager) assertNoForgottenModules(setOrderFnName string, moduleNames []
 	}
 }
 
+// assertProposalOrder checks that the given order contains exactly the modules
+// for which implements returns true.
+func (m *Manager) assertProposalOrder(setOrderFnName string, moduleNames []string, implements func(AppModule) bool) {
+	ms := make(map[string]bool)
+	for _, name := range moduleNames {
+		module, ok := m.Modules[name]
+		if !ok || !implements(module) {
+			panic(fmt.Sprintf("%s: module %s does not exist or does not implement the required interface", setOrderFnName, name))
+		}
+		ms[name] = true
+	}
+	var missing []string
+	for name, module := range m.Modules {
+		if implements(module) && !ms[name] {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) != 0 {
+		sort.Strings(missing)
+		panic(fmt.Sprintf(
+			"%s: all modules implementing the interface must be defined when setting %s, missing: %v", setOrderFnName, setOrderFnName, missing))
+	}
+}
+
 // MigrationHandler is the migration function that each module registers.
 type MigrationHandler func(sdk.Context) error
 
@@ -427,40 +594,24 @@ func (m Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM Version
 		modules = DefaultMigrationsOrder(m.ModuleNames())
 	}
 
+	observer, _ := ctx.Value(migrationObserverKey{}).(MigrationObserver)
+
 	updatedVM := VersionMap{}
 	for _, moduleName := range modules {
-		module := m.Modules[moduleName]
 		fromVersion, exists := fromVM[moduleName]
-		toVersion := module.ConsensusVersion()
-
-		// Only run migrations when the module exists in the fromVM.
-		// Run InitGenesis otherwise.
-		//
-		// the module won't exist in the fromVM in two cases:
-		// 1. A new module is added. In this case we run InitGenesis with an
-		// empty genesis state.
-		// 2. An existing chain is upgrading to v043 for the first time. In this case,
-		// all modules have yet to be added to x/upgrade's VersionMap store.
-		if exists {
-			err := c.runModuleMigrations(ctx, moduleName, fromVersion, toVersion)
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			cfgtor, ok := cfg.(configurator)
-			if !ok {
-				// Currently, the only implementator of Configurator (the interface)
-				// is configurator (the struct).
-				return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", configurator{}, cfg)
-			}
+		toVersion := m.Modules[moduleName].ConsensusVersion()
 
-			moduleValUpdates := module.InitGenesis(ctx, cfgtor.cdc, module.DefaultGenesis(cfgtor.cdc))
-			ctx.Logger().Info(fmt.Sprintf("adding a new module: %s", moduleName))
-			// The module manager assumes only one module will update the
-			// validator set, and that it will not be by a new module.
-			if len(moduleValUpdates) > 0 {
-				return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "validator InitGenesis updates already set by a previous module")
-			}
+		if observer != nil {
+			observer.BeforeModuleMigration(moduleName, fromVersion, toVersion)
+		}
+
+		err := m.migrateModule(ctx, c, moduleName, fromVersion, toVersion, exists)
+
+		if observer != nil {
+			observer.AfterModuleMigration(moduleName, err)
+		}
+		if err != nil {
+			return nil, err
 		}
 
 		updatedVM[moduleName] = toVersion
@@ -469,13 +620,42 @@ func (m Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM Version
 	return updatedVM, nil
 }
 
-// BeginBlock performs begin block functionality for all modules. It creates a
-// child context with an event manager to aggregate events emitted from all
-// modules.
+// migrateModule runs the in-place migrations of a module from fromVersion to
+// toVersion, or its InitGenesis with the default genesis state if the module
+// didn't exist in the version map.
+func (m Manager) migrateModule(ctx sdk.Context, c configurator, moduleName string, fromVersion, toVersion uint64, exists bool) error {
+	// Only run migrations when the module exists in the fromVM.
+	// Run InitGenesis otherwise.
+	//
+	// the module won't exist in the fromVM in two cases:
+	// 1. A new module is added. In this case we run InitGenesis with an
+	// empty genesis state.
+	// 2. An existing chain is upgrading to v043 for the first time. In this case,
+	// all modules have yet to be added to x/upgrade's VersionMap store.
+	if exists {
+		return c.runModuleMigrations(ctx, moduleName, fromVersion, toVersion)
+	}
+
+	module := m.Modules[moduleName]
+	moduleValUpdates := module.InitGenesis(ctx, c.cdc, module.DefaultGenesis(c.cdc))
+	ctx.Logger().Info(fmt.Sprintf("adding a new module: %s", moduleName))
+	// The module manager assumes only one module will update the
+	// validator set, and that it will not be by a new module.
+	if len(moduleValUpdates) > 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "validator InitGenesis updates already set by a previous module")
+	}
+
+	return nil
+}
+
+// BeginBlock performs begin block functionality for all modules, in the order
+// set in the param store or else OrderBeginBlockers. It creates a child context
+// with an event manager to aggregate events emitted from all modules.
 func (m *Manager) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 
-	for _, moduleName := range m.OrderBeginBlockers {
+	order := m.blockersOrder(ctx, ParamStoreKeyOrderBeginBlockers, m.OrderBeginBlockers, m.ValidateOrderBeginBlockers)
+	for _, moduleName := range order {
 		m.Modules[moduleName].BeginBlock(ctx, req)
 	}
 
@@ -484,14 +664,15 @@ func (m *Manager) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) abci.R
 	}
 }
 
-// EndBlock performs end block functionality for all modules. It creates a
-// child context with an event manager to aggregate events emitted from all
-// modules.
+// EndBlock performs end block functionality for all modules, in the order set
+// in the param store or else OrderEndBlockers. It creates a child context with
+// an event manager to aggregate events emitted from all modules.
 func (m *Manager) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 	validatorUpdates := []abci.ValidatorUpdate{}
 
-	for _, moduleName := range m.OrderEndBlockers {
+	order := m.blockersOrder(ctx, ParamStoreKeyOrderEndBlockers, m.OrderEndBlockers, m.ValidateOrderEndBlockers)
+	for _, moduleName := range order {
 		moduleValUpdates := m.Modules[moduleName].EndBlock(ctx, req)
 
 		// use these validator updates if provided, the module manager assumes
@@ -511,6 +692,33 @@ func (m *Manager) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) abci.Respo
 	}
 }
 
+// PrepareProposal passes the candidate transactions of a block proposal through
+// all modules implementing PrepareProposalAppModule, in OrderPrepareProposal.
+// Each module receives the transactions returned by the previous one.
+// It can be set as the BaseApp PrepareProposalHandler.
+func (m *Manager) PrepareProposal(ctx sdk.Context, req sdk.RequestPrepareProposal) sdk.ResponsePrepareProposal {
+	for _, moduleName := range m.OrderPrepareProposal {
+		req.Txs = m.Modules[moduleName].(PrepareProposalAppModule).PrepareProposal(ctx, req)
+	}
+
+	return sdk.ResponsePrepareProposal{Txs: req.Txs}
+}
+
+// ProcessProposal validates a block proposal with all modules implementing
+// ProcessProposalAppModule, in OrderProcessProposal. The proposal is rejected
+// as soon as one module returns an error. It can be set as the BaseApp
+// ProcessProposalHandler.
+func (m *Manager) ProcessProposal(ctx sdk.Context, req sdk.RequestProcessProposal) sdk.ResponseProcessProposal {
+	for _, moduleName := range m.OrderProcessProposal {
+		if err := m.Modules[moduleName].(ProcessProposalAppModule).ProcessProposal(ctx, req); err != nil {
+			ctx.Logger().Error("block proposal rejected", "module", moduleName, "height", req.Height, "err", err)
+			return sdk.ResponseProcessProposal{Status: sdk.ProposalStatusReject}
+		}
+	}
+
+	return sdk.ResponseProcessProposal{Status: sdk.ProposalStatusAccept}
+}
+
 // GetVersionMap gets consensus version from all modules
 func (m *Manager) GetVersionMap() VersionMap {
 	vermap := make(VersionMap)
@@ -3,6 +3,7 @@ package module_test
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
@@ -12,6 +13,8 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -217,6 +220,49 @@ func TestManager_InitGenesis(t *testing.T) {
 	require.Panics(t, func() { mm.InitGenesis(ctx, cdc, genesisData) })
 }
 
+type recordingObserver struct{ events []string }
+
+func (o *recordingObserver) BeforeModuleMigration(moduleName string, fromVersion, toVersion uint64) {
+	o.events = append(o.events, fmt.Sprintf("before %s %d->%d", moduleName, fromVersion, toVersion))
+}
+
+func (o *recordingObserver) AfterModuleMigration(moduleName string, err error) {
+	o.events = append(o.events, fmt.Sprintf("after %s %v", moduleName, err != nil))
+}
+
+func TestManager_RunMigrationsObserver(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
+	mm.SetOrderMigrations("module1", "module2")
+
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+	cfg := module.NewConfigurator(cdc, mocks.NewMockServer(mockCtrl), mocks.NewMockServer(mockCtrl))
+
+	observer := &recordingObserver{}
+	ctx := module.WithMigrationObserver(sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger()), observer)
+
+	// module1 is up to date and module2 is new but fails its InitGenesis
+	mockAppModule1.EXPECT().ConsensusVersion().Times(1).Return(uint64(1))
+	mockAppModule2.EXPECT().ConsensusVersion().Times(1).Return(uint64(2))
+	mockAppModule2.EXPECT().DefaultGenesis(gomock.Eq(cdc)).Times(1).Return(json.RawMessage(`{}`))
+	mockAppModule2.EXPECT().InitGenesis(gomock.Any(), gomock.Eq(cdc), gomock.Any()).Times(1).Return([]abci.ValidatorUpdate{{}})
+
+	_, err := mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 1})
+	require.Error(t, err)
+	require.Equal(t, []string{
+		"before module1 1->1",
+		"after module1 false",
+		"before module2 0->2",
+		"after module2 true",
+	}, observer.events)
+}
+
 func TestManager_ExportGenesis(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
@@ -284,3 +330,181 @@ func TestManager_EndBlock(t *testing.T) {
 	mockAppModule2.EXPECT().EndBlock(gomock.Any(), gomock.Eq(req)).Times(1).Return([]abci.ValidatorUpdate{{}})
 	require.Panics(t, func() { mm.EndBlock(sdk.Context{}, req) })
 }
+
+// proposalAppModule is an AppModule taking part in block proposals.
+type proposalAppModule struct {
+	module.AppModule
+
+	name       string
+	prepare    func([][]byte) [][]byte
+	processErr error
+}
+
+func (m proposalAppModule) Name() string { return m.name }
+
+func (m proposalAppModule) PrepareProposal(_ sdk.Context, req sdk.RequestPrepareProposal) [][]byte {
+	return m.prepare(req.Txs)
+}
+
+func (m proposalAppModule) ProcessProposal(sdk.Context, sdk.RequestProcessProposal) error {
+	return m.processErr
+}
+
+func TestManager_PrepareProposal(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	plainModule := mocks.NewMockAppModule(mockCtrl)
+	plainModule.EXPECT().Name().Times(2).Return("plain")
+
+	// "oracle" moves its txs to the front, "filter" drops txs of the "spam" kind
+	oracle := proposalAppModule{name: "oracle", prepare: func(txs [][]byte) [][]byte {
+		var oracleTxs, others [][]byte
+		for _, tx := range txs {
+			if string(tx) == "oracle" {
+				oracleTxs = append(oracleTxs, tx)
+			} else {
+				others = append(others, tx)
+			}
+		}
+		return append(oracleTxs, others...)
+	}}
+	filter := proposalAppModule{name: "filter", prepare: func(txs [][]byte) [][]byte {
+		var res [][]byte
+		for _, tx := range txs {
+			if string(tx) != "spam" {
+				res = append(res, tx)
+			}
+		}
+		return res
+	}}
+
+	mm := module.NewManager(plainModule, filter, oracle)
+	require.Equal(t, []string{"filter", "oracle"}, mm.OrderPrepareProposal)
+	require.Equal(t, []string{"filter", "oracle"}, mm.OrderProcessProposal)
+
+	require.Panics(t, func() { mm.SetOrderPrepareProposal("oracle") })
+	require.Panics(t, func() { mm.SetOrderPrepareProposal("oracle", "filter", "plain") })
+	mm.SetOrderPrepareProposal("oracle", "filter")
+
+	req := sdk.RequestPrepareProposal{Txs: [][]byte{[]byte("send"), []byte("spam"), []byte("oracle")}}
+	res := mm.PrepareProposal(sdk.Context{}, req)
+	require.Equal(t, [][]byte{[]byte("oracle"), []byte("send")}, res.Txs)
+}
+
+func TestManager_ProcessProposal(t *testing.T) {
+	ctx := sdk.Context{}.WithLogger(log.NewNopLogger())
+
+	accept := proposalAppModule{name: "accept"}
+	mm := module.NewManager(accept)
+	require.True(t, mm.ProcessProposal(ctx, sdk.RequestProcessProposal{}).IsAccepted())
+
+	reject := proposalAppModule{name: "reject", processErr: errFoo}
+	mm = module.NewManager(accept, reject)
+	mm.SetOrderProcessProposal("reject", "accept")
+	require.Equal(t, sdk.ProposalStatusReject, mm.ProcessProposal(ctx, sdk.RequestProcessProposal{}).Status)
+}
+
+// orderedAppModule is an AppModule recording its blocker calls, with blocker
+// order constraints.
+type orderedAppModule struct {
+	module.AppModule
+
+	name        string
+	constraints module.BlockerOrderConstraints
+	calls       *[]string
+}
+
+func (m orderedAppModule) Name() string { return m.name }
+
+func (m orderedAppModule) BlockerOrderConstraints() module.BlockerOrderConstraints {
+	return m.constraints
+}
+
+func (m orderedAppModule) BeginBlock(sdk.Context, abci.RequestBeginBlock) {
+	*m.calls = append(*m.calls, m.name)
+}
+
+func (m orderedAppModule) EndBlock(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate {
+	*m.calls = append(*m.calls, m.name)
+	return nil
+}
+
+// orderParamStore is a module.ParamStore holding blocker orders.
+type orderParamStore map[string][]string
+
+func (ps orderParamStore) Get(_ sdk.Context, key []byte, ptr interface{}) {
+	*ptr.(*[]string) = ps[string(key)]
+}
+
+func (ps orderParamStore) Has(_ sdk.Context, key []byte) bool {
+	_, ok := ps[string(key)]
+	return ok
+}
+
+func TestManager_BlockerOrder(t *testing.T) {
+	ctx := sdk.Context{}.WithLogger(log.NewNopLogger())
+
+	// "oracle" can run anywhere, "distr" begins after "mint" and ends before "staking"
+	var calls []string
+	mm := module.NewManager(
+		orderedAppModule{name: "mint", calls: &calls},
+		orderedAppModule{name: "distr", calls: &calls, constraints: module.BlockerOrderConstraints{
+			BeginBlockAfter: []string{"mint", "missing"},
+			EndBlockBefore:  []string{"staking"},
+		}},
+		orderedAppModule{name: "staking", calls: &calls},
+		orderedAppModule{name: "oracle", calls: &calls},
+	)
+
+	require.Panics(t, func() { mm.SetOrderBeginBlockers("distr", "mint", "staking", "oracle") })
+	require.Panics(t, func() { mm.SetOrderEndBlockers("staking", "distr", "mint", "oracle") })
+	mm.SetOrderBeginBlockers("mint", "distr", "staking", "oracle")
+	mm.SetOrderEndBlockers("mint", "distr", "staking", "oracle")
+
+	testCases := []struct {
+		name   string
+		order  interface{}
+		expErr string
+	}{
+		{"default order", []string{}, ""},
+		{"valid order", []string{"oracle", "mint", "distr", "staking"}, ""},
+		{"invalid type", "mint", "invalid parameter type"},
+		{"unknown module", []string{"oracle", "mint", "distr", "staking", "bank"}, "unknown module bank"},
+		{"duplicate module", []string{"oracle", "mint", "oracle", "distr", "staking"}, "duplicate module oracle"},
+		{"missing module", []string{"mint", "distr", "staking"}, "all 4 modules must be ordered"},
+		{"violated constraint", []string{"distr", "oracle", "mint", "staking"}, "module distr must run after mint"},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := mm.ValidateOrderBeginBlockers(tc.order)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErr)
+			}
+		})
+	}
+	require.EqualError(t, mm.ValidateOrderEndBlockers([]string{"staking", "distr", "mint", "oracle"}), "module distr must run before staking")
+
+	// the orders of the param store override the ones of the manager
+	paramStore := orderParamStore{}
+	mm.SetParamStore(paramStore)
+	mm.BeginBlock(ctx, abci.RequestBeginBlock{})
+	require.Equal(t, []string{"mint", "distr", "staking", "oracle"}, calls)
+
+	paramStore[string(module.ParamStoreKeyOrderBeginBlockers)] = []string{"oracle", "mint", "distr", "staking"}
+	paramStore[string(module.ParamStoreKeyOrderEndBlockers)] = []string{"oracle", "distr", "mint", "staking"}
+	calls = nil
+	mm.BeginBlock(ctx, abci.RequestBeginBlock{})
+	mm.EndBlock(ctx, abci.RequestEndBlock{})
+	require.Equal(t, []string{"oracle", "mint", "distr", "staking", "oracle", "distr", "mint", "staking"}, calls)
+
+	// the invalid orders are ignored
+	paramStore[string(module.ParamStoreKeyOrderBeginBlockers)] = []string{"oracle", "mint", "distr"}
+	calls = nil
+	mm.BeginBlock(ctx, abci.RequestBeginBlock{})
+	require.Equal(t, []string{"mint", "distr", "staking", "oracle"}, calls)
+}
@@ -0,0 +1,17 @@
+package types
+
+// msgTypeURLKey is the context key of the type URL of the Msg being executed.
+type msgTypeURLKey struct{}
+
+// WithMsgTypeURL returns a context executing the Msg of the given type URL. It
+// is called by the BaseApp before executing each Msg.
+func WithMsgTypeURL(ctx Context, typeURL string) Context {
+	return ctx.WithValue(msgTypeURLKey{}, typeURL)
+}
+
+// MsgTypeURLFromContext returns the type URL of the Msg being executed, which
+// is empty outside of Msg execution.
+func MsgTypeURLFromContext(ctx Context) string {
+	typeURL, _ := ctx.Value(msgTypeURLKey{}).(string)
+	return typeURL
+}
@@ -0,0 +1,21 @@
+package msgservice
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgRouter executes Msgs handled by the Msg services of other modules on
+// behalf of a module, so the module does not need to depend on the keepers
+// owning those Msgs. It is implemented by baseapp.MsgRouterService.
+type MsgRouter interface {
+	// Execute executes a Msg whose signers must all be the module account of the
+	// calling module. State changes are only persisted if the Msg succeeds and
+	// its events are emitted on the given context.
+	Execute(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error)
+
+	// ExecuteAuthorized is like Execute, but additionally accepts Msgs signed by
+	// the given addresses. The calling module is responsible for having verified
+	// that those addresses authorized the execution, e.g. via authz grants or a
+	// passed group proposal.
+	ExecuteAuthorized(ctx sdk.Context, authorized []sdk.AccAddress, msg sdk.Msg) (*sdk.Result, error)
+}
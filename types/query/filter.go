@@ -0,0 +1,69 @@
+package query
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// Filter reports whether the KV pair of a result matches a filter of a
+// paginated query. Query endpoints declare their filters from the fields of
+// their requests, so that clients only receive the matching results instead of
+// fetching whole collections to filter them locally.
+type Filter func(key []byte, value []byte) (bool, error)
+
+// AllFilters returns a Filter matching the KV pairs matched by all the given
+// filters, which are applied in order. Nil filters are skipped, so endpoints
+// can pass the filters of their optional fields unconditionally. If all the
+// filters are nil, AllFilters returns nil.
+func AllFilters(filters ...Filter) Filter {
+	var nonNil []Filter
+	for _, f := range filters {
+		if f != nil {
+			nonNil = append(nonNil, f)
+		}
+	}
+	if len(nonNil) == 0 {
+		return nil
+	}
+
+	return func(key []byte, value []byte) (bool, error) {
+		for _, f := range nonNil {
+			match, err := f(key, value)
+			if err != nil || !match {
+				return false, err
+			}
+		}
+
+		return true, nil
+	}
+}
+
+// PaginateWithFilter does pagination of the results in the PrefixStore
+// matching the given filter, based on the provided PageRequest. The offset,
+// limit and total of the page apply to the matching results only, and
+// onResult is called for each matching result of the page. If the filter is
+// nil, all the results match.
+func PaginateWithFilter(
+	prefixStore types.KVStore,
+	pageRequest *PageRequest,
+	filter Filter,
+	onResult func(key []byte, value []byte) error,
+) (*PageResponse, error) {
+	if filter == nil {
+		return Paginate(prefixStore, pageRequest, onResult)
+	}
+
+	return FilteredPaginate(prefixStore, pageRequest, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		match, err := filter(key, value)
+		if err != nil || !match {
+			return false, err
+		}
+
+		if accumulate {
+			if err := onResult(key, value); err != nil {
+				return false, err
+			}
+		}
+
+		return true, nil
+	})
+}
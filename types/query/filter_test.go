@@ -0,0 +1,97 @@
+package query_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (s *paginationTestSuite) TestPaginateWithFilter() {
+	app, ctx, appCodec := setupTest()
+
+	var balances sdk.Coins
+	for i := 0; i < 10; i++ {
+		balances = append(balances, sdk.NewInt64Coin(fmt.Sprintf("foo%ddenom", i), int64((i+1)*100)))
+	}
+	balances = balances.Sort()
+
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	app.AccountKeeper.SetAccount(ctx, acc1)
+	s.Require().NoError(simapp.FundAccount(app.BankKeeper, ctx, addr1, balances))
+
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	accountStore := prefix.NewStore(prefix.NewStore(store, types.BalancesPrefix), address.MustLengthPrefix(addr1))
+
+	minAmountFilter := func(min int64) query.Filter {
+		return func(_ []byte, value []byte) (bool, error) {
+			var bal sdk.Coin
+			if err := appCodec.Unmarshal(value, &bal); err != nil {
+				return false, err
+			}
+			return bal.Amount.Int64() >= min, nil
+		}
+	}
+	maxAmountFilter := func(max int64) query.Filter {
+		return func(_ []byte, value []byte) (bool, error) {
+			var bal sdk.Coin
+			if err := appCodec.Unmarshal(value, &bal); err != nil {
+				return false, err
+			}
+			return bal.Amount.Int64() <= max, nil
+		}
+	}
+
+	paginate := func(pageReq *query.PageRequest, filter query.Filter) (sdk.Coins, *query.PageResponse) {
+		var result sdk.Coins
+		res, err := query.PaginateWithFilter(accountStore, pageReq, filter, func(_ []byte, value []byte) error {
+			var bal sdk.Coin
+			if err := appCodec.Unmarshal(value, &bal); err != nil {
+				return err
+			}
+			result = append(result, bal)
+			return nil
+		})
+		s.Require().NoError(err)
+		return result, res
+	}
+
+	s.T().Log("verify nil filter returns all the results")
+	result, res := paginate(&query.PageRequest{CountTotal: true}, query.AllFilters(nil, nil))
+	s.Require().Equal(10, len(result))
+	s.Require().Equal(uint64(10), res.Total)
+
+	s.T().Log("verify filter returns the matching results only")
+	result, res = paginate(&query.PageRequest{CountTotal: true}, minAmountFilter(600))
+	s.Require().Equal(balances[5:].String(), result.String())
+	s.Require().Equal(uint64(5), res.Total)
+	s.Require().Nil(res.NextKey)
+
+	s.T().Log("verify all the filters must match")
+	result, res = paginate(&query.PageRequest{CountTotal: true}, query.AllFilters(minAmountFilter(400), nil, maxAmountFilter(700)))
+	s.Require().Equal(balances[3:7].String(), result.String())
+	s.Require().Equal(uint64(4), res.Total)
+
+	s.T().Log("verify the pages hold the matching results")
+	result, res = paginate(&query.PageRequest{Limit: 2, CountTotal: true}, minAmountFilter(600))
+	s.Require().Equal(balances[5:7].String(), result.String())
+	s.Require().Equal(uint64(5), res.Total)
+	s.Require().Equal("foo7denom", string(res.NextKey))
+
+	result, res = paginate(&query.PageRequest{Key: res.NextKey, Limit: 2}, minAmountFilter(600))
+	s.Require().Equal(balances[7:9].String(), result.String())
+	s.Require().Equal("foo9denom", string(res.NextKey))
+
+	result, _ = paginate(&query.PageRequest{Offset: 4, Limit: 2}, minAmountFilter(600))
+	s.Require().Equal(balances[9:].String(), result.String())
+
+	s.T().Log("verify filter errors are returned")
+	_, err := query.PaginateWithFilter(accountStore, nil, func(_ []byte, _ []byte) (bool, error) {
+		return false, fmt.Errorf("filter error")
+	}, func(_ []byte, _ []byte) error { return nil })
+	s.Require().EqualError(err, "filter error")
+}
@@ -0,0 +1,23 @@
+package query
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Router performs gRPC queries against the query services of other modules
+// from within state machine execution, so a module does not need to depend on
+// the keepers serving those queries. It is implemented by
+// baseapp.QueryRouterService.
+//
+// Only whitelisted query methods may be called. Every call is charged the
+// fixed gas cost the method was whitelisted with, independently of the gas
+// the query handler actually consumes, and always executes against the state
+// of the given context, so results and gas usage are deterministic.
+type Router interface {
+	// Query calls the query method with the given fully-qualified name, e.g.
+	// "/cosmos.bank.v1beta1.Query/Balance", and unmarshals its response into
+	// res.
+	Query(ctx sdk.Context, method string, req, res proto.Message) error
+}
@@ -43,7 +43,7 @@ func RandomAccounts(r *rand.Rand, n int) []Account {
 
 		accs[i].PrivKey = secp256k1.GenPrivKeyFromSecret(privkeySeed)
 		accs[i].PubKey = accs[i].PrivKey.PubKey()
-		accs[i].Address = sdk.AccAddress(accs[i].PubKey.Address())
+		accs[i].Address = sdk.AccAddressFromPubKey(accs[i].PubKey)
 
 		accs[i].ConsKey = ed25519.GenPrivKeyFromSecret(privkeySeed)
 	}
@@ -0,0 +1,58 @@
+package types
+
+import "fmt"
+
+// ColumnType is the type of the values of a table column.
+type ColumnType int
+
+// Column types of the tables exported by the modules.
+const (
+	ColumnTypeString ColumnType = iota
+	ColumnTypeInt64
+	ColumnTypeBool
+)
+
+// TableColumn is a named and typed column of a table.
+type TableColumn struct {
+	Name string
+	Type ColumnType
+}
+
+// Table is a flat view of a part of a module's state, e.g. the balances of the
+// bank module, for the ingestion of the state by analytics tools. Each row
+// holds a value per column: a string, int64 or bool following the column type.
+type Table struct {
+	Name    string
+	Columns []TableColumn
+	Rows    [][]interface{}
+}
+
+// NewTable returns an empty table with the given columns.
+func NewTable(name string, columns ...TableColumn) *Table {
+	return &Table{Name: name, Columns: columns}
+}
+
+// AddRow adds a row to the table. It panics if the values do not match the
+// columns, which is a programming error.
+func (t *Table) AddRow(values ...interface{}) {
+	if len(values) != len(t.Columns) {
+		panic(fmt.Sprintf("table %s: expected %d values, got %d", t.Name, len(t.Columns), len(values)))
+	}
+
+	for i, v := range values {
+		var ok bool
+		switch t.Columns[i].Type {
+		case ColumnTypeString:
+			_, ok = v.(string)
+		case ColumnTypeInt64:
+			_, ok = v.(int64)
+		case ColumnTypeBool:
+			_, ok = v.(bool)
+		}
+		if !ok {
+			panic(fmt.Sprintf("table %s: invalid value %T for column %s", t.Name, v, t.Columns[i].Name))
+		}
+	}
+
+	t.Rows = append(t.Rows, values)
+}
@@ -0,0 +1,23 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTableAddRow(t *testing.T) {
+	table := sdk.NewTable("balances",
+		sdk.TableColumn{Name: "address", Type: sdk.ColumnTypeString},
+		sdk.TableColumn{Name: "height", Type: sdk.ColumnTypeInt64},
+		sdk.TableColumn{Name: "jailed", Type: sdk.ColumnTypeBool},
+	)
+
+	table.AddRow("cosmos1", int64(1), true)
+	require.Equal(t, [][]interface{}{{"cosmos1", int64(1), true}}, table.Rows)
+
+	require.Panics(t, func() { table.AddRow("cosmos1", int64(1)) })
+	require.Panics(t, func() { table.AddRow("cosmos1", 1, true) })
+}
@@ -6,13 +6,15 @@ package tx
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	golang_proto "github.com/golang/protobuf/proto"
-	types1 "github.com/tendermint/tendermint/proto/tendermint/types"
+	types1 "github.com/tendermint/tendermint/abci/types"
+	types2 "github.com/tendermint/tendermint/proto/tendermint/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -452,25 +454,25 @@ func (m *SimulateResponse) GetResult() *types.Result {
 	return nil
 }
 
-// GetTxRequest is the request type for the Service.GetTx
+// SimulateBundleRequest is the request type for the Service.SimulateBundle
 // RPC method.
-type GetTxRequest struct {
-	// hash is the tx hash to query, encoded as a hex string.
-	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+type SimulateBundleRequest struct {
+	// txs_bytes are the raw transactions to simulate, in execution order.
+	TxsBytes [][]byte `protobuf:"bytes,1,rep,name=txs_bytes,json=txsBytes,proto3" json:"txs_bytes,omitempty"`
 }
 
-func (m *GetTxRequest) Reset()         { *m = GetTxRequest{} }
-func (m *GetTxRequest) String() string { return proto.CompactTextString(m) }
-func (*GetTxRequest) ProtoMessage()    {}
-func (*GetTxRequest) Descriptor() ([]byte, []int) {
+func (m *SimulateBundleRequest) Reset()         { *m = SimulateBundleRequest{} }
+func (m *SimulateBundleRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateBundleRequest) ProtoMessage()    {}
+func (*SimulateBundleRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e0b00a618705eca7, []int{6}
 }
-func (m *GetTxRequest) XXX_Unmarshal(b []byte) error {
+func (m *SimulateBundleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SimulateBundleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetTxRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SimulateBundleRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -480,45 +482,44 @@ func (m *GetTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *GetTxRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTxRequest.Merge(m, src)
+func (m *SimulateBundleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateBundleRequest.Merge(m, src)
 }
-func (m *GetTxRequest) XXX_Size() int {
+func (m *SimulateBundleRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetTxRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTxRequest.DiscardUnknown(m)
+func (m *SimulateBundleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateBundleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTxRequest proto.InternalMessageInfo
+var xxx_messageInfo_SimulateBundleRequest proto.InternalMessageInfo
 
-func (m *GetTxRequest) GetHash() string {
+func (m *SimulateBundleRequest) GetTxsBytes() [][]byte {
 	if m != nil {
-		return m.Hash
+		return m.TxsBytes
 	}
-	return ""
+	return nil
 }
 
-// GetTxResponse is the response type for the Service.GetTx method.
-type GetTxResponse struct {
-	// tx is the queried transaction.
-	Tx *Tx `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
-	// tx_response is the queried TxResponses.
-	TxResponse *types.TxResponse `protobuf:"bytes,2,opt,name=tx_response,json=txResponse,proto3" json:"tx_response,omitempty"`
+// SimulateBundleResponse is the response type for the Service.SimulateBundle
+// RPC method.
+type SimulateBundleResponse struct {
+	// results are the simulation results of the transactions, in execution order.
+	Results []*SimulateResponse `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (m *GetTxResponse) Reset()         { *m = GetTxResponse{} }
-func (m *GetTxResponse) String() string { return proto.CompactTextString(m) }
-func (*GetTxResponse) ProtoMessage()    {}
-func (*GetTxResponse) Descriptor() ([]byte, []int) {
+func (m *SimulateBundleResponse) Reset()         { *m = SimulateBundleResponse{} }
+func (m *SimulateBundleResponse) String() string { return proto.CompactTextString(m) }
+func (*SimulateBundleResponse) ProtoMessage()    {}
+func (*SimulateBundleResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e0b00a618705eca7, []int{7}
 }
-func (m *GetTxResponse) XXX_Unmarshal(b []byte) error {
+func (m *SimulateBundleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SimulateBundleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetTxResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SimulateBundleResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -528,55 +529,44 @@ func (m *GetTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *GetTxResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTxResponse.Merge(m, src)
+func (m *SimulateBundleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateBundleResponse.Merge(m, src)
 }
-func (m *GetTxResponse) XXX_Size() int {
+func (m *SimulateBundleResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetTxResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTxResponse.DiscardUnknown(m)
+func (m *SimulateBundleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateBundleResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTxResponse proto.InternalMessageInfo
-
-func (m *GetTxResponse) GetTx() *Tx {
-	if m != nil {
-		return m.Tx
-	}
-	return nil
-}
+var xxx_messageInfo_SimulateBundleResponse proto.InternalMessageInfo
 
-func (m *GetTxResponse) GetTxResponse() *types.TxResponse {
+func (m *SimulateBundleResponse) GetResults() []*SimulateResponse {
 	if m != nil {
-		return m.TxResponse
+		return m.Results
 	}
 	return nil
 }
 
-// GetBlockWithTxsRequest is the request type for the Service.GetBlockWithTxs
+// EstimateGasRequest is the request type for the Service.EstimateGas
 // RPC method.
-//
-// Since: cosmos-sdk 0.45.2
-type GetBlockWithTxsRequest struct {
-	// height is the height of the block to query.
-	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
-	// pagination defines a pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type EstimateGasRequest struct {
+	// tx_bytes is the raw transaction.
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
 }
 
-func (m *GetBlockWithTxsRequest) Reset()         { *m = GetBlockWithTxsRequest{} }
-func (m *GetBlockWithTxsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetBlockWithTxsRequest) ProtoMessage()    {}
-func (*GetBlockWithTxsRequest) Descriptor() ([]byte, []int) {
+func (m *EstimateGasRequest) Reset()         { *m = EstimateGasRequest{} }
+func (m *EstimateGasRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateGasRequest) ProtoMessage()    {}
+func (*EstimateGasRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e0b00a618705eca7, []int{8}
 }
-func (m *GetBlockWithTxsRequest) XXX_Unmarshal(b []byte) error {
+func (m *EstimateGasRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetBlockWithTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EstimateGasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetBlockWithTxsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EstimateGasRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -586,56 +576,52 @@ func (m *GetBlockWithTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *GetBlockWithTxsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetBlockWithTxsRequest.Merge(m, src)
+func (m *EstimateGasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateGasRequest.Merge(m, src)
 }
-func (m *GetBlockWithTxsRequest) XXX_Size() int {
+func (m *EstimateGasRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetBlockWithTxsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetBlockWithTxsRequest.DiscardUnknown(m)
+func (m *EstimateGasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateGasRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetBlockWithTxsRequest proto.InternalMessageInfo
-
-func (m *GetBlockWithTxsRequest) GetHeight() int64 {
-	if m != nil {
-		return m.Height
-	}
-	return 0
-}
+var xxx_messageInfo_EstimateGasRequest proto.InternalMessageInfo
 
-func (m *GetBlockWithTxsRequest) GetPagination() *query.PageRequest {
+func (m *EstimateGasRequest) GetTxBytes() []byte {
 	if m != nil {
-		return m.Pagination
+		return m.TxBytes
 	}
 	return nil
 }
 
-// GetBlockWithTxsResponse is the response type for the Service.GetBlockWithTxs method.
-//
-// Since: cosmos-sdk 0.45.2
-type GetBlockWithTxsResponse struct {
-	// txs are the transactions in the block.
-	Txs     []*Tx           `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
-	BlockId *types1.BlockID `protobuf:"bytes,2,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
-	Block   *types1.Block   `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
-	// pagination defines a pagination for the response.
-	Pagination *query.PageResponse `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
-}
-
-func (m *GetBlockWithTxsResponse) Reset()         { *m = GetBlockWithTxsResponse{} }
-func (m *GetBlockWithTxsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetBlockWithTxsResponse) ProtoMessage()    {}
-func (*GetBlockWithTxsResponse) Descriptor() ([]byte, []int) {
+// EstimateGasResponse is the response type for the Service.EstimateGas
+// RPC method.
+type EstimateGasResponse struct {
+	// gas_info is the information about gas used in the simulation.
+	GasInfo *types.GasInfo `protobuf:"bytes,1,opt,name=gas_info,json=gasInfo,proto3" json:"gas_info,omitempty"`
+	// result is the result of the simulation.
+	Result *types.Result `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	// ante_gas_used is the gas used by the AnteHandler, e.g. for the signature
+	// verification and the tx size.
+	AnteGasUsed uint64 `protobuf:"varint,3,opt,name=ante_gas_used,json=anteGasUsed,proto3" json:"ante_gas_used,omitempty"`
+	// msgs_gas_used are the gas used by the messages, in their order. The gas
+	// used by the tx is the sum of ante_gas_used and of theirs.
+	MsgsGasUsed []*MsgGasUsed `protobuf:"bytes,4,rep,name=msgs_gas_used,json=msgsGasUsed,proto3" json:"msgs_gas_used,omitempty"`
+}
+
+func (m *EstimateGasResponse) Reset()         { *m = EstimateGasResponse{} }
+func (m *EstimateGasResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateGasResponse) ProtoMessage()    {}
+func (*EstimateGasResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e0b00a618705eca7, []int{9}
 }
-func (m *GetBlockWithTxsResponse) XXX_Unmarshal(b []byte) error {
+func (m *EstimateGasResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetBlockWithTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EstimateGasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetBlockWithTxsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EstimateGasResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -645,1043 +631,4092 @@ func (m *GetBlockWithTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *GetBlockWithTxsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetBlockWithTxsResponse.Merge(m, src)
+func (m *EstimateGasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateGasResponse.Merge(m, src)
 }
-func (m *GetBlockWithTxsResponse) XXX_Size() int {
+func (m *EstimateGasResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetBlockWithTxsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetBlockWithTxsResponse.DiscardUnknown(m)
+func (m *EstimateGasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateGasResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetBlockWithTxsResponse proto.InternalMessageInfo
+var xxx_messageInfo_EstimateGasResponse proto.InternalMessageInfo
 
-func (m *GetBlockWithTxsResponse) GetTxs() []*Tx {
+func (m *EstimateGasResponse) GetGasInfo() *types.GasInfo {
 	if m != nil {
-		return m.Txs
+		return m.GasInfo
 	}
 	return nil
 }
 
-func (m *GetBlockWithTxsResponse) GetBlockId() *types1.BlockID {
+func (m *EstimateGasResponse) GetResult() *types.Result {
 	if m != nil {
-		return m.BlockId
+		return m.Result
 	}
 	return nil
 }
 
-func (m *GetBlockWithTxsResponse) GetBlock() *types1.Block {
+func (m *EstimateGasResponse) GetAnteGasUsed() uint64 {
 	if m != nil {
-		return m.Block
+		return m.AnteGasUsed
 	}
-	return nil
+	return 0
 }
 
-func (m *GetBlockWithTxsResponse) GetPagination() *query.PageResponse {
+func (m *EstimateGasResponse) GetMsgsGasUsed() []*MsgGasUsed {
 	if m != nil {
-		return m.Pagination
+		return m.MsgsGasUsed
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("cosmos.tx.v1beta1.OrderBy", OrderBy_name, OrderBy_value)
-	golang_proto.RegisterEnum("cosmos.tx.v1beta1.OrderBy", OrderBy_name, OrderBy_value)
-	proto.RegisterEnum("cosmos.tx.v1beta1.BroadcastMode", BroadcastMode_name, BroadcastMode_value)
-	golang_proto.RegisterEnum("cosmos.tx.v1beta1.BroadcastMode", BroadcastMode_name, BroadcastMode_value)
-	proto.RegisterType((*GetTxsEventRequest)(nil), "cosmos.tx.v1beta1.GetTxsEventRequest")
-	golang_proto.RegisterType((*GetTxsEventRequest)(nil), "cosmos.tx.v1beta1.GetTxsEventRequest")
-	proto.RegisterType((*GetTxsEventResponse)(nil), "cosmos.tx.v1beta1.GetTxsEventResponse")
-	golang_proto.RegisterType((*GetTxsEventResponse)(nil), "cosmos.tx.v1beta1.GetTxsEventResponse")
-	proto.RegisterType((*BroadcastTxRequest)(nil), "cosmos.tx.v1beta1.BroadcastTxRequest")
-	golang_proto.RegisterType((*BroadcastTxRequest)(nil), "cosmos.tx.v1beta1.BroadcastTxRequest")
-	proto.RegisterType((*BroadcastTxResponse)(nil), "cosmos.tx.v1beta1.BroadcastTxResponse")
-	golang_proto.RegisterType((*BroadcastTxResponse)(nil), "cosmos.tx.v1beta1.BroadcastTxResponse")
-	proto.RegisterType((*SimulateRequest)(nil), "cosmos.tx.v1beta1.SimulateRequest")
-	golang_proto.RegisterType((*SimulateRequest)(nil), "cosmos.tx.v1beta1.SimulateRequest")
-	proto.RegisterType((*SimulateResponse)(nil), "cosmos.tx.v1beta1.SimulateResponse")
-	golang_proto.RegisterType((*SimulateResponse)(nil), "cosmos.tx.v1beta1.SimulateResponse")
-	proto.RegisterType((*GetTxRequest)(nil), "cosmos.tx.v1beta1.GetTxRequest")
-	golang_proto.RegisterType((*GetTxRequest)(nil), "cosmos.tx.v1beta1.GetTxRequest")
-	proto.RegisterType((*GetTxResponse)(nil), "cosmos.tx.v1beta1.GetTxResponse")
-	golang_proto.RegisterType((*GetTxResponse)(nil), "cosmos.tx.v1beta1.GetTxResponse")
-	proto.RegisterType((*GetBlockWithTxsRequest)(nil), "cosmos.tx.v1beta1.GetBlockWithTxsRequest")
-	golang_proto.RegisterType((*GetBlockWithTxsRequest)(nil), "cosmos.tx.v1beta1.GetBlockWithTxsRequest")
-	proto.RegisterType((*GetBlockWithTxsResponse)(nil), "cosmos.tx.v1beta1.GetBlockWithTxsResponse")
-	golang_proto.RegisterType((*GetBlockWithTxsResponse)(nil), "cosmos.tx.v1beta1.GetBlockWithTxsResponse")
+// MsgGasUsed is the gas used by a message of a transaction.
+type MsgGasUsed struct {
+	// msg_type_url is the type URL of the message.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	GasUsed    uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
 }
 
-func init() { proto.RegisterFile("cosmos/tx/v1beta1/service.proto", fileDescriptor_e0b00a618705eca7) }
-func init() {
-	golang_proto.RegisterFile("cosmos/tx/v1beta1/service.proto", fileDescriptor_e0b00a618705eca7)
+func (m *MsgGasUsed) Reset()         { *m = MsgGasUsed{} }
+func (m *MsgGasUsed) String() string { return proto.CompactTextString(m) }
+func (*MsgGasUsed) ProtoMessage()    {}
+func (*MsgGasUsed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{10}
 }
-
-var fileDescriptor_e0b00a618705eca7 = []byte{
-	// 976 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1a, 0x47,
-	0x14, 0xf7, 0x2e, 0xb6, 0x21, 0x0f, 0x3b, 0x21, 0x63, 0xd7, 0x26, 0x24, 0xc5, 0x64, 0x53, 0x6c,
-	0x07, 0xc9, 0xbb, 0x0a, 0x4d, 0xa5, 0xaa, 0xea, 0xc5, 0xfc, 0x89, 0x8b, 0xda, 0x84, 0x68, 0x70,
-	0x15, 0xa5, 0xaa, 0x84, 0x16, 0x98, 0x2c, 0xab, 0x98, 0x1d, 0xbc, 0x33, 0x58, 0x8b, 0x1c, 0xab,
-	0x52, 0x8f, 0x3d, 0x55, 0xed, 0xa1, 0x1f, 0xa2, 0x5f, 0xa2, 0xc7, 0x1e, 0x2d, 0xf5, 0xd2, 0x63,
-	0x65, 0xf7, 0x03, 0xf4, 0x23, 0x54, 0x3b, 0x3b, 0xc0, 0x82, 0x97, 0x38, 0x8d, 0x7a, 0x81, 0x99,
-	0x9d, 0xdf, 0x7b, 0xef, 0x37, 0xbf, 0xd9, 0xdf, 0x9b, 0x85, 0xad, 0x36, 0x65, 0x3d, 0xca, 0x0c,
-	0xee, 0x19, 0x27, 0x8f, 0x5a, 0x84, 0x9b, 0x8f, 0x0c, 0x46, 0xdc, 0x13, 0xbb, 0x4d, 0xf4, 0xbe,
-	0x4b, 0x39, 0x45, 0xb7, 0x03, 0x80, 0xce, 0x3d, 0x5d, 0x02, 0x32, 0xf7, 0x2c, 0x4a, 0xad, 0x23,
-	0x62, 0x98, 0x7d, 0xdb, 0x30, 0x1d, 0x87, 0x72, 0x93, 0xdb, 0xd4, 0x61, 0x41, 0x40, 0xe6, 0x81,
-	0xcc, 0xd8, 0x32, 0x19, 0x31, 0xcc, 0x56, 0xdb, 0x1e, 0x27, 0xf6, 0x27, 0x12, 0x94, 0xb9, 0x5a,
-	0x96, 0x7b, 0x72, 0x6d, 0xdd, 0xa2, 0x16, 0x15, 0x43, 0xc3, 0x1f, 0xc9, 0xa7, 0x85, 0x70, 0xda,
-	0xe3, 0x01, 0x71, 0x87, 0xe3, 0xc8, 0xbe, 0x69, 0xd9, 0x8e, 0xe0, 0x20, 0xb1, 0xf7, 0x38, 0x71,
-	0x3a, 0xc4, 0xed, 0xd9, 0x0e, 0x37, 0xf8, 0xb0, 0x4f, 0x98, 0xd1, 0x3a, 0xa2, 0xed, 0xd7, 0x73,
-	0x57, 0xc5, 0x6f, 0xb0, 0xaa, 0xfd, 0xaa, 0x00, 0x3a, 0x20, 0xfc, 0xd0, 0x63, 0xd5, 0x13, 0xe2,
-	0x70, 0x4c, 0x8e, 0x07, 0x84, 0x71, 0xb4, 0x01, 0xcb, 0xc4, 0x9f, 0xb3, 0xb4, 0x92, 0x8b, 0xed,
-	0xde, 0xc0, 0x72, 0x86, 0x9e, 0x00, 0x4c, 0xca, 0xa7, 0xd5, 0x9c, 0xb2, 0x9b, 0x2c, 0x6e, 0xeb,
-	0x52, 0x33, 0x9f, 0xab, 0x2e, 0xb8, 0x8e, 0xb4, 0xd3, 0x9f, 0x9b, 0x16, 0x91, 0x39, 0x71, 0x28,
-	0x12, 0x7d, 0x02, 0x09, 0xea, 0x76, 0x88, 0xdb, 0x6c, 0x0d, 0xd3, 0xb1, 0x9c, 0xb2, 0x7b, 0xb3,
-	0x98, 0xd1, 0xaf, 0x28, 0xaf, 0xd7, 0x7d, 0x48, 0x69, 0x88, 0xe3, 0x34, 0x18, 0x68, 0xe7, 0x0a,
-	0xac, 0x4d, 0xb1, 0x65, 0x7d, 0xea, 0x30, 0x82, 0x76, 0x20, 0xc6, 0xbd, 0x80, 0x6b, 0xb2, 0xf8,
-	0x41, 0x44, 0xa6, 0x43, 0x0f, 0xfb, 0x08, 0x74, 0x00, 0x2b, 0xdc, 0x6b, 0xba, 0x32, 0x8e, 0xa5,
-	0x55, 0x11, 0xf1, 0xd1, 0xd4, 0x0e, 0xc4, 0xb9, 0x85, 0x02, 0x25, 0x18, 0x27, 0xf9, 0x78, 0xec,
-	0x27, 0x0a, 0x0b, 0x11, 0x13, 0x42, 0xec, 0x5c, 0x2b, 0x84, 0xcc, 0x14, 0x0a, 0xd5, 0x08, 0xa0,
-	0x92, 0x4b, 0xcd, 0x4e, 0xdb, 0x64, 0xdc, 0x2f, 0x16, 0xe8, 0x7f, 0x07, 0x12, 0xdc, 0x6b, 0xb6,
-	0x86, 0x9c, 0xf8, 0xbb, 0x52, 0x76, 0x57, 0x70, 0x9c, 0x7b, 0x25, 0x7f, 0x8a, 0x1e, 0xc3, 0x62,
-	0x8f, 0x76, 0x88, 0x10, 0xff, 0x66, 0x31, 0x17, 0xb1, 0xd9, 0x71, 0xbe, 0xa7, 0xb4, 0x43, 0xb0,
-	0x40, 0x6b, 0xdf, 0xc2, 0xda, 0x54, 0x19, 0x29, 0x5c, 0x15, 0x92, 0x21, 0x3d, 0x44, 0xa9, 0x77,
-	0x95, 0x03, 0x26, 0x72, 0x68, 0x2f, 0xe0, 0x56, 0xc3, 0xee, 0x0d, 0x8e, 0x4c, 0x3e, 0x3a, 0x6d,
-	0xf4, 0x10, 0x54, 0xee, 0xc9, 0x84, 0xd1, 0x27, 0x52, 0x52, 0xd3, 0x0a, 0x56, 0xb9, 0x37, 0xb5,
-	0x59, 0x75, 0x6a, 0xb3, 0xda, 0x0f, 0x0a, 0xa4, 0x26, 0x99, 0x25, 0xe9, 0xcf, 0x21, 0x61, 0x99,
-	0xac, 0x69, 0x3b, 0xaf, 0xa8, 0x2c, 0x70, 0x7f, 0x3e, 0xe3, 0x03, 0x93, 0xd5, 0x9c, 0x57, 0x14,
-	0xc7, 0xad, 0x60, 0x80, 0x3e, 0x85, 0x65, 0x97, 0xb0, 0xc1, 0x11, 0x97, 0xaf, 0x6f, 0x6e, 0x7e,
-	0x2c, 0x16, 0x38, 0x2c, 0xf1, 0x9a, 0x06, 0x2b, 0xe2, 0xe5, 0x1b, 0x6d, 0x11, 0xc1, 0x62, 0xd7,
-	0x64, 0x5d, 0xc1, 0xe1, 0x06, 0x16, 0x63, 0xed, 0x0c, 0x56, 0x25, 0x46, 0x92, 0xcd, 0x5f, 0xab,
-	0x83, 0xd0, 0x60, 0xe6, 0x20, 0xd4, 0xf7, 0x3c, 0x08, 0x0f, 0x36, 0x0e, 0x08, 0x2f, 0xf9, 0xf6,
-	0x7f, 0x61, 0xf3, 0xee, 0xa1, 0xc7, 0x42, 0x8e, 0xee, 0x12, 0xdb, 0xea, 0x72, 0xc1, 0x25, 0x86,
-	0xe5, 0xec, 0xff, 0x72, 0xb4, 0xf6, 0x8f, 0x02, 0x9b, 0x57, 0x4a, 0xff, 0x57, 0x7b, 0x3e, 0x86,
-	0x84, 0x68, 0x5d, 0x4d, 0xbb, 0x23, 0xa9, 0xdc, 0xd1, 0x27, 0xed, 0x4b, 0x0f, 0x1a, 0x97, 0x28,
-	0x51, 0xab, 0xe0, 0xb8, 0x80, 0xd6, 0x3a, 0x68, 0x0f, 0x96, 0xc4, 0x50, 0xda, 0x70, 0x73, 0x4e,
-	0x08, 0x0e, 0x50, 0x33, 0xd6, 0x5d, 0x7c, 0x6f, 0xeb, 0x16, 0xbe, 0x80, 0xb8, 0xec, 0x50, 0x28,
-	0x0d, 0xeb, 0x75, 0x5c, 0xa9, 0xe2, 0x66, 0xe9, 0x65, 0xf3, 0xeb, 0x67, 0x8d, 0xe7, 0xd5, 0x72,
-	0xed, 0x49, 0xad, 0x5a, 0x49, 0x2d, 0xa0, 0x14, 0xac, 0x8c, 0x57, 0xf6, 0x1b, 0xe5, 0x94, 0x82,
-	0x6e, 0xc3, 0xea, 0xf8, 0x49, 0xa5, 0xda, 0x28, 0xa7, 0xd4, 0xc2, 0x1b, 0x58, 0x9d, 0x32, 0x2d,
-	0xca, 0x42, 0xa6, 0x84, 0xeb, 0xfb, 0x95, 0xf2, 0x7e, 0xe3, 0xb0, 0xf9, 0xb4, 0x5e, 0xa9, 0xce,
-	0x64, 0x4d, 0xc3, 0xfa, 0xcc, 0x7a, 0xe9, 0xab, 0x7a, 0xf9, 0xcb, 0x94, 0x82, 0x36, 0x61, 0x6d,
-	0x66, 0xa5, 0xf1, 0xf2, 0x59, 0x39, 0xa5, 0x46, 0x84, 0xec, 0x8b, 0x95, 0x58, 0xf1, 0xa7, 0x25,
-	0x88, 0x37, 0x82, 0x5b, 0x10, 0x9d, 0x42, 0x62, 0xe4, 0x37, 0xa4, 0x45, 0x9c, 0xd4, 0x8c, 0xcd,
-	0x33, 0x0f, 0xde, 0x8a, 0x91, 0x6f, 0xe5, 0xf6, 0xf7, 0x7f, 0xfc, 0xfd, 0xb3, 0x9a, 0xd3, 0xee,
-	0x1a, 0x11, 0xd7, 0xaf, 0x04, 0x7f, 0xa6, 0x14, 0xd0, 0x31, 0x2c, 0x09, 0xf3, 0xa0, 0xad, 0x88,
-	0xac, 0x61, 0xeb, 0x65, 0x72, 0xf3, 0x01, 0xb2, 0x66, 0x5e, 0xd4, 0xdc, 0x42, 0x1f, 0x1a, 0x51,
-	0x77, 0x2f, 0x33, 0x4e, 0x7d, 0xbb, 0x9e, 0xa1, 0xef, 0x20, 0x19, 0xea, 0x8b, 0x28, 0xff, 0xb6,
-	0x76, 0x3a, 0x29, 0xbf, 0x7d, 0x1d, 0x4c, 0x92, 0xb8, 0x2f, 0x48, 0xdc, 0xd5, 0x36, 0xa2, 0x49,
-	0xf8, 0x7b, 0x7e, 0x03, 0xc9, 0xd0, 0x8d, 0x16, 0x49, 0xe0, 0xea, 0xfd, 0x1c, 0x49, 0x20, 0xe2,
-	0x62, 0xd4, 0xb2, 0x82, 0x40, 0x1a, 0xcd, 0x21, 0x80, 0x7e, 0x51, 0xe0, 0xd6, 0x8c, 0x6b, 0xd1,
-	0xc3, 0xe8, 0xdc, 0x11, 0x4d, 0x25, 0x53, 0x78, 0x17, 0xa8, 0xa4, 0xb2, 0x27, 0xa8, 0xec, 0xa0,
-	0xfc, 0x9c, 0x03, 0x11, 0xe6, 0x34, 0x4e, 0x83, 0xb6, 0x74, 0x56, 0x2a, 0xff, 0x7e, 0x91, 0x55,
-	0xce, 0x2f, 0xb2, 0xca, 0x5f, 0x17, 0x59, 0xe5, 0xc7, 0xcb, 0xec, 0xc2, 0x6f, 0x97, 0x59, 0xe5,
-	0xfc, 0x32, 0xbb, 0xf0, 0xe7, 0x65, 0x76, 0xe1, 0x9b, 0xbc, 0x65, 0xf3, 0xee, 0xa0, 0xa5, 0xb7,
-	0x69, 0x6f, 0x94, 0x2e, 0xf8, 0xdb, 0x63, 0x9d, 0xd7, 0xa3, 0xcf, 0x1c, 0xaf, 0xb5, 0x2c, 0x3e,
-	0x72, 0x3e, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0xb5, 0xaa, 0xba, 0x0d, 0xf7, 0x09, 0x00, 0x00,
+func (m *MsgGasUsed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// ServiceClient is the client API for Service service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ServiceClient interface {
-	// Simulate simulates executing a transaction for estimating gas usage.
-	Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error)
-	// GetTx fetches a tx by hash.
-	GetTx(ctx context.Context, in *GetTxRequest, opts ...grpc.CallOption) (*GetTxResponse, error)
-	// BroadcastTx broadcast transaction.
-	BroadcastTx(ctx context.Context, in *BroadcastTxRequest, opts ...grpc.CallOption) (*BroadcastTxResponse, error)
-	// GetTxsEvent fetches txs by event.
-	GetTxsEvent(ctx context.Context, in *GetTxsEventRequest, opts ...grpc.CallOption) (*GetTxsEventResponse, error)
-	// GetBlockWithTxs fetches a block with decoded txs.
-	//
-	// Since: cosmos-sdk 0.45.2
-	GetBlockWithTxs(ctx context.Context, in *GetBlockWithTxsRequest, opts ...grpc.CallOption) (*GetBlockWithTxsResponse, error)
+func (m *MsgGasUsed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGasUsed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type serviceClient struct {
-	cc grpc1.ClientConn
+func (m *MsgGasUsed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGasUsed.Merge(m, src)
 }
-
-func NewServiceClient(cc grpc1.ClientConn) ServiceClient {
-	return &serviceClient{cc}
+func (m *MsgGasUsed) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGasUsed) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGasUsed.DiscardUnknown(m)
 }
 
-func (c *serviceClient) Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error) {
-	out := new(SimulateResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/Simulate", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgGasUsed proto.InternalMessageInfo
+
+func (m *MsgGasUsed) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
 	}
-	return out, nil
+	return ""
 }
 
-func (c *serviceClient) GetTx(ctx context.Context, in *GetTxRequest, opts ...grpc.CallOption) (*GetTxResponse, error) {
-	out := new(GetTxResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/GetTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgGasUsed) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
 	}
-	return out, nil
+	return 0
 }
 
-func (c *serviceClient) BroadcastTx(ctx context.Context, in *BroadcastTxRequest, opts ...grpc.CallOption) (*BroadcastTxResponse, error) {
-	out := new(BroadcastTxResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/BroadcastTx", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// TraceTxRequest is the request type for the Service.TraceTx RPC method.
+type TraceTxRequest struct {
+	// hash is the tx hash to trace encoded as a hex string.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (c *serviceClient) GetTxsEvent(ctx context.Context, in *GetTxsEventRequest, opts ...grpc.CallOption) (*GetTxsEventResponse, error) {
-	out := new(GetTxsEventResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/GetTxsEvent", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TraceTxRequest) Reset()         { *m = TraceTxRequest{} }
+func (m *TraceTxRequest) String() string { return proto.CompactTextString(m) }
+func (*TraceTxRequest) ProtoMessage()    {}
+func (*TraceTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{11}
+}
+func (m *TraceTxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TraceTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TraceTxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *TraceTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceTxRequest.Merge(m, src)
+}
+func (m *TraceTxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TraceTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceTxRequest.DiscardUnknown(m)
 }
 
-func (c *serviceClient) GetBlockWithTxs(ctx context.Context, in *GetBlockWithTxsRequest, opts ...grpc.CallOption) (*GetBlockWithTxsResponse, error) {
-	out := new(GetBlockWithTxsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/GetBlockWithTxs", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TraceTxRequest proto.InternalMessageInfo
+
+func (m *TraceTxRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	return out, nil
+	return ""
 }
 
-// ServiceServer is the server API for Service service.
-type ServiceServer interface {
-	// Simulate simulates executing a transaction for estimating gas usage.
-	Simulate(context.Context, *SimulateRequest) (*SimulateResponse, error)
-	// GetTx fetches a tx by hash.
-	GetTx(context.Context, *GetTxRequest) (*GetTxResponse, error)
-	// BroadcastTx broadcast transaction.
-	BroadcastTx(context.Context, *BroadcastTxRequest) (*BroadcastTxResponse, error)
-	// GetTxsEvent fetches txs by event.
-	GetTxsEvent(context.Context, *GetTxsEventRequest) (*GetTxsEventResponse, error)
-	// GetBlockWithTxs fetches a block with decoded txs.
-	//
-	// Since: cosmos-sdk 0.45.2
-	GetBlockWithTxs(context.Context, *GetBlockWithTxsRequest) (*GetBlockWithTxsResponse, error)
-}
-
-// UnimplementedServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedServiceServer struct {
-}
-
-func (*UnimplementedServiceServer) Simulate(ctx context.Context, req *SimulateRequest) (*SimulateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Simulate not implemented")
+// TraceTxResponse is the response type for the Service.TraceTx RPC method.
+type TraceTxResponse struct {
+	// height is the height of the block of the transaction.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// gas_info is the information about gas used in the re-execution.
+	GasInfo *types.GasInfo `protobuf:"bytes,2,opt,name=gas_info,json=gasInfo,proto3" json:"gas_info,omitempty"`
+	// code is the error code of the re-execution, 0 if it succeeded.
+	Code uint32 `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	// codespace is the namespace of the error code.
+	Codespace string `protobuf:"bytes,4,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	// log is the log of the re-execution, the error log if it failed.
+	Log string `protobuf:"bytes,5,opt,name=log,proto3" json:"log,omitempty"`
+	// ante_gas_used is the gas used by the AnteHandler.
+	AnteGasUsed uint64 `protobuf:"varint,6,opt,name=ante_gas_used,json=anteGasUsed,proto3" json:"ante_gas_used,omitempty"`
+	// msgs are the traces of the messages executed, in their order. The last one
+	// is the failing message if the transaction failed in its messages, e.g. by
+	// running out of gas.
+	Msgs []*MsgTrace `protobuf:"bytes,7,rep,name=msgs,proto3" json:"msgs,omitempty"`
+}
+
+func (m *TraceTxResponse) Reset()         { *m = TraceTxResponse{} }
+func (m *TraceTxResponse) String() string { return proto.CompactTextString(m) }
+func (*TraceTxResponse) ProtoMessage()    {}
+func (*TraceTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{12}
+}
+func (m *TraceTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedServiceServer) GetTx(ctx context.Context, req *GetTxRequest) (*GetTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTx not implemented")
+func (m *TraceTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TraceTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedServiceServer) BroadcastTx(ctx context.Context, req *BroadcastTxRequest) (*BroadcastTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BroadcastTx not implemented")
+func (m *TraceTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceTxResponse.Merge(m, src)
 }
-func (*UnimplementedServiceServer) GetTxsEvent(ctx context.Context, req *GetTxsEventRequest) (*GetTxsEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTxsEvent not implemented")
+func (m *TraceTxResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedServiceServer) GetBlockWithTxs(ctx context.Context, req *GetBlockWithTxsRequest) (*GetBlockWithTxsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBlockWithTxs not implemented")
+func (m *TraceTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceTxResponse.DiscardUnknown(m)
 }
 
-func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
-	s.RegisterService(&_Service_serviceDesc, srv)
-}
+var xxx_messageInfo_TraceTxResponse proto.InternalMessageInfo
 
-func _Service_Simulate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SimulateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ServiceServer).Simulate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.tx.v1beta1.Service/Simulate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).Simulate(ctx, req.(*SimulateRequest))
+func (m *TraceTxResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Service_GetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ServiceServer).GetTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.tx.v1beta1.Service/GetTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).GetTx(ctx, req.(*GetTxRequest))
+func (m *TraceTxResponse) GetGasInfo() *types.GasInfo {
+	if m != nil {
+		return m.GasInfo
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Service_BroadcastTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BroadcastTxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ServiceServer).BroadcastTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.tx.v1beta1.Service/BroadcastTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).BroadcastTx(ctx, req.(*BroadcastTxRequest))
+func (m *TraceTxResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Service_GetTxsEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTxsEventRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *TraceTxResponse) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
 	}
-	if interceptor == nil {
-		return srv.(ServiceServer).GetTxsEvent(ctx, in)
+	return ""
+}
+
+func (m *TraceTxResponse) GetLog() string {
+	if m != nil {
+		return m.Log
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.tx.v1beta1.Service/GetTxsEvent",
+	return ""
+}
+
+func (m *TraceTxResponse) GetAnteGasUsed() uint64 {
+	if m != nil {
+		return m.AnteGasUsed
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).GetTxsEvent(ctx, req.(*GetTxsEventRequest))
+	return 0
+}
+
+func (m *TraceTxResponse) GetMsgs() []*MsgTrace {
+	if m != nil {
+		return m.Msgs
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Service_GetBlockWithTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBlockWithTxsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// MsgTrace is the trace of the execution of a message of a transaction.
+type MsgTrace struct {
+	// msg_type_url is the type URL of the message.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// gas_used is the gas used by the message, net of its refunds.
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// events are the events emitted by the message, none if it failed.
+	Events []types1.Event `protobuf:"bytes,3,rep,name=events,proto3" json:"events"`
+	// reads is the number of store reads, i.e. gets, has checks and iterator
+	// steps, of the message.
+	Reads uint64 `protobuf:"varint,4,opt,name=reads,proto3" json:"reads,omitempty"`
+	// writes is the number of store writes, i.e. sets and deletes, of the
+	// message.
+	Writes uint64 `protobuf:"varint,5,opt,name=writes,proto3" json:"writes,omitempty"`
+}
+
+func (m *MsgTrace) Reset()         { *m = MsgTrace{} }
+func (m *MsgTrace) String() string { return proto.CompactTextString(m) }
+func (*MsgTrace) ProtoMessage()    {}
+func (*MsgTrace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{13}
+}
+func (m *MsgTrace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTrace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTrace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(ServiceServer).GetBlockWithTxs(ctx, in)
+}
+func (m *MsgTrace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTrace.Merge(m, src)
+}
+func (m *MsgTrace) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTrace) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTrace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTrace proto.InternalMessageInfo
+
+func (m *MsgTrace) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.tx.v1beta1.Service/GetBlockWithTxs",
+	return ""
+}
+
+func (m *MsgTrace) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).GetBlockWithTxs(ctx, req.(*GetBlockWithTxsRequest))
+	return 0
+}
+
+func (m *MsgTrace) GetEvents() []types1.Event {
+	if m != nil {
+		return m.Events
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Service_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.tx.v1beta1.Service",
-	HandlerType: (*ServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Simulate",
-			Handler:    _Service_Simulate_Handler,
-		},
-		{
-			MethodName: "GetTx",
-			Handler:    _Service_GetTx_Handler,
-		},
-		{
-			MethodName: "BroadcastTx",
-			Handler:    _Service_BroadcastTx_Handler,
-		},
-		{
-			MethodName: "GetTxsEvent",
-			Handler:    _Service_GetTxsEvent_Handler,
-		},
-		{
-			MethodName: "GetBlockWithTxs",
-			Handler:    _Service_GetBlockWithTxs_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/tx/v1beta1/service.proto",
+func (m *MsgTrace) GetReads() uint64 {
+	if m != nil {
+		return m.Reads
+	}
+	return 0
 }
 
-func (m *GetTxsEventRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTrace) GetWrites() uint64 {
+	if m != nil {
+		return m.Writes
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GetTxsEventRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TxSignersRequest is the request type for the Service.TxSigners
+// RPC method.
+type TxSignersRequest struct {
+	// tx_bytes is the raw transaction.
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
 }
 
-func (m *GetTxsEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OrderBy != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.OrderBy))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Events) > 0 {
-		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Events[iNdEx])
-			copy(dAtA[i:], m.Events[iNdEx])
-			i = encodeVarintService(dAtA, i, uint64(len(m.Events[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *TxSignersRequest) Reset()         { *m = TxSignersRequest{} }
+func (m *TxSignersRequest) String() string { return proto.CompactTextString(m) }
+func (*TxSignersRequest) ProtoMessage()    {}
+func (*TxSignersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{14}
+}
+func (m *TxSignersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxSignersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxSignersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *TxSignersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxSignersRequest.Merge(m, src)
+}
+func (m *TxSignersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxSignersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxSignersRequest.DiscardUnknown(m)
 }
 
-func (m *GetTxsEventResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TxSignersRequest proto.InternalMessageInfo
+
+func (m *TxSignersRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetTxsEventResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TxSignersResponse is the response type for the Service.TxSigners
+// RPC method.
+type TxSignersResponse struct {
+	// signers are the distinct signers of the messages, in order of appearance.
+	Signers []string `protobuf:"bytes,1,rep,name=signers,proto3" json:"signers,omitempty"`
+	// fee_payer is the account paying the fee.
+	FeePayer string `protobuf:"bytes,2,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// fee_granter is the account granting the fee allowance, if any.
+	FeeGranter string `protobuf:"bytes,3,opt,name=fee_granter,json=feeGranter,proto3" json:"fee_granter,omitempty"`
+	// fee is the fee of the transaction.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// gas_limit is the gas limit of the transaction.
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// msg_types are the type URLs of the messages, in order.
+	MsgTypes []string `protobuf:"bytes,6,rep,name=msg_types,json=msgTypes,proto3" json:"msg_types,omitempty"`
+}
+
+func (m *TxSignersResponse) Reset()         { *m = TxSignersResponse{} }
+func (m *TxSignersResponse) String() string { return proto.CompactTextString(m) }
+func (*TxSignersResponse) ProtoMessage()    {}
+func (*TxSignersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{15}
+}
+func (m *TxSignersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *GetTxsEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.TxResponses) > 0 {
-		for iNdEx := len(m.TxResponses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TxResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Txs) > 0 {
-		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *TxSignersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxSignersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *BroadcastTxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *TxSignersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxSignersResponse.Merge(m, src)
 }
-
-func (m *BroadcastTxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TxSignersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxSignersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxSignersResponse.DiscardUnknown(m)
 }
 
-func (m *BroadcastTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Mode != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Mode))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_TxSignersResponse proto.InternalMessageInfo
+
+func (m *TxSignersResponse) GetSigners() []string {
+	if m != nil {
+		return m.Signers
 	}
-	if len(m.TxBytes) > 0 {
-		i -= len(m.TxBytes)
-		copy(dAtA[i:], m.TxBytes)
-		i = encodeVarintService(dAtA, i, uint64(len(m.TxBytes)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *TxSignersResponse) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *BroadcastTxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TxSignersResponse) GetFeeGranter() string {
+	if m != nil {
+		return m.FeeGranter
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *BroadcastTxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TxSignersResponse) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
 }
 
-func (m *BroadcastTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TxResponse != nil {
-		{
-			size, err := m.TxResponse.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *TxSignersResponse) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *SimulateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TxSignersResponse) GetMsgTypes() []string {
+	if m != nil {
+		return m.MsgTypes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SimulateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UnknownFieldsPolicyRequest is the request type for the
+// Service.UnknownFieldsPolicy RPC method.
+type UnknownFieldsPolicyRequest struct {
 }
 
-func (m *SimulateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxBytes) > 0 {
-		i -= len(m.TxBytes)
-		copy(dAtA[i:], m.TxBytes)
-		i = encodeVarintService(dAtA, i, uint64(len(m.TxBytes)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Tx != nil {
-		{
-			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+func (m *UnknownFieldsPolicyRequest) Reset()         { *m = UnknownFieldsPolicyRequest{} }
+func (m *UnknownFieldsPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*UnknownFieldsPolicyRequest) ProtoMessage()    {}
+func (*UnknownFieldsPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{16}
+}
+func (m *UnknownFieldsPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnknownFieldsPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnknownFieldsPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *SimulateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *UnknownFieldsPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnknownFieldsPolicyRequest.Merge(m, src)
+}
+func (m *UnknownFieldsPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnknownFieldsPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnknownFieldsPolicyRequest.DiscardUnknown(m)
 }
 
-func (m *SimulateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_UnknownFieldsPolicyRequest proto.InternalMessageInfo
+
+// UnknownFieldsPolicyResponse is the response type for the
+// Service.UnknownFieldsPolicy RPC method.
+type UnknownFieldsPolicyResponse struct {
+	// reject_critical is whether unknown critical fields, i.e. whose field number
+	// does not have bit 11 set, are rejected. It is always true.
+	RejectCritical bool `protobuf:"varint,1,opt,name=reject_critical,json=rejectCritical,proto3" json:"reject_critical,omitempty"`
+	// reject_non_critical is whether unknown non-critical fields of the tx body
+	// are rejected. If false, they are accepted and ignored.
+	RejectNonCritical bool `protobuf:"varint,2,opt,name=reject_non_critical,json=rejectNonCritical,proto3" json:"reject_non_critical,omitempty"`
 }
 
-func (m *SimulateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Result != nil {
-		{
-			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+func (m *UnknownFieldsPolicyResponse) Reset()         { *m = UnknownFieldsPolicyResponse{} }
+func (m *UnknownFieldsPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*UnknownFieldsPolicyResponse) ProtoMessage()    {}
+func (*UnknownFieldsPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{17}
+}
+func (m *UnknownFieldsPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnknownFieldsPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnknownFieldsPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.GasInfo != nil {
-		{
-			size, err := m.GasInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *UnknownFieldsPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnknownFieldsPolicyResponse.Merge(m, src)
+}
+func (m *UnknownFieldsPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnknownFieldsPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnknownFieldsPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnknownFieldsPolicyResponse proto.InternalMessageInfo
+
+func (m *UnknownFieldsPolicyResponse) GetRejectCritical() bool {
+	if m != nil {
+		return m.RejectCritical
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *GetTxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UnknownFieldsPolicyResponse) GetRejectNonCritical() bool {
+	if m != nil {
+		return m.RejectNonCritical
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *GetTxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// GetTxRequest is the request type for the Service.GetTx
+// RPC method.
+type GetTxRequest struct {
+	// hash is the tx hash to query, encoded as a hex string.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (m *GetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintService(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+func (m *GetTxRequest) Reset()         { *m = GetTxRequest{} }
+func (m *GetTxRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTxRequest) ProtoMessage()    {}
+func (*GetTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{18}
+}
+func (m *GetTxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxRequest.Merge(m, src)
+}
+func (m *GetTxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxRequest.DiscardUnknown(m)
 }
 
-func (m *GetTxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetTxRequest proto.InternalMessageInfo
+
+func (m *GetTxRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetTxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// GetTxResponse is the response type for the Service.GetTx method.
+type GetTxResponse struct {
+	// tx is the queried transaction.
+	Tx *Tx `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	// tx_response is the queried TxResponses.
+	TxResponse *types.TxResponse `protobuf:"bytes,2,opt,name=tx_response,json=txResponse,proto3" json:"tx_response,omitempty"`
 }
 
-func (m *GetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TxResponse != nil {
-		{
-			size, err := m.TxResponse.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+func (m *GetTxResponse) Reset()         { *m = GetTxResponse{} }
+func (m *GetTxResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTxResponse) ProtoMessage()    {}
+func (*GetTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{19}
+}
+func (m *GetTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Tx != nil {
-		{
-			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *GetTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxResponse.Merge(m, src)
+}
+func (m *GetTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTxResponse proto.InternalMessageInfo
+
+func (m *GetTxResponse) GetTx() *Tx {
+	if m != nil {
+		return m.Tx
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *GetBlockWithTxsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetTxResponse) GetTxResponse() *types.TxResponse {
+	if m != nil {
+		return m.TxResponse
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetBlockWithTxsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// GetBlockWithTxsRequest is the request type for the Service.GetBlockWithTxs
+// RPC method.
+//
+// Since: cosmos-sdk 0.45.2
+type GetBlockWithTxsRequest struct {
+	// height is the height of the block to query.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// pagination defines a pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *GetBlockWithTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+func (m *GetBlockWithTxsRequest) Reset()         { *m = GetBlockWithTxsRequest{} }
+func (m *GetBlockWithTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockWithTxsRequest) ProtoMessage()    {}
+func (*GetBlockWithTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{20}
+}
+func (m *GetBlockWithTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockWithTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockWithTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Height != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *GetBlockWithTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockWithTxsRequest.Merge(m, src)
+}
+func (m *GetBlockWithTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockWithTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockWithTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockWithTxsRequest proto.InternalMessageInfo
+
+func (m *GetBlockWithTxsRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GetBlockWithTxsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetBlockWithTxsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetBlockWithTxsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// GetBlockWithTxsResponse is the response type for the Service.GetBlockWithTxs method.
+//
+// Since: cosmos-sdk 0.45.2
+type GetBlockWithTxsResponse struct {
+	// txs are the transactions in the block.
+	Txs     []*Tx           `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+	BlockId *types2.BlockID `protobuf:"bytes,2,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	Block   *types2.Block   `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
+	// pagination defines a pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *GetBlockWithTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+func (m *GetBlockWithTxsResponse) Reset()         { *m = GetBlockWithTxsResponse{} }
+func (m *GetBlockWithTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBlockWithTxsResponse) ProtoMessage()    {}
+func (*GetBlockWithTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{21}
+}
+func (m *GetBlockWithTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockWithTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockWithTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if m.Block != nil {
-		{
-			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.BlockId != nil {
-		{
-			size, err := m.BlockId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Txs) > 0 {
-		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+}
+func (m *GetBlockWithTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockWithTxsResponse.Merge(m, src)
+}
+func (m *GetBlockWithTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockWithTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockWithTxsResponse.DiscardUnknown(m)
 }
 
-func encodeVarintService(dAtA []byte, offset int, v uint64) int {
-	offset -= sovService(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_GetBlockWithTxsResponse proto.InternalMessageInfo
+
+func (m *GetBlockWithTxsResponse) GetTxs() []*Tx {
+	if m != nil {
+		return m.Txs
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *GetTxsEventRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Events) > 0 {
-		for _, s := range m.Events {
-			l = len(s)
-			n += 1 + l + sovService(uint64(l))
-		}
+
+func (m *GetBlockWithTxsResponse) GetBlockId() *types2.BlockID {
+	if m != nil {
+		return m.BlockId
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovService(uint64(l))
+	return nil
+}
+
+func (m *GetBlockWithTxsResponse) GetBlock() *types2.Block {
+	if m != nil {
+		return m.Block
 	}
-	if m.OrderBy != 0 {
-		n += 1 + sovService(uint64(m.OrderBy))
+	return nil
+}
+
+func (m *GetBlockWithTxsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *GetTxsEventResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterEnum("cosmos.tx.v1beta1.OrderBy", OrderBy_name, OrderBy_value)
+	golang_proto.RegisterEnum("cosmos.tx.v1beta1.OrderBy", OrderBy_name, OrderBy_value)
+	proto.RegisterEnum("cosmos.tx.v1beta1.BroadcastMode", BroadcastMode_name, BroadcastMode_value)
+	golang_proto.RegisterEnum("cosmos.tx.v1beta1.BroadcastMode", BroadcastMode_name, BroadcastMode_value)
+	proto.RegisterType((*GetTxsEventRequest)(nil), "cosmos.tx.v1beta1.GetTxsEventRequest")
+	golang_proto.RegisterType((*GetTxsEventRequest)(nil), "cosmos.tx.v1beta1.GetTxsEventRequest")
+	proto.RegisterType((*GetTxsEventResponse)(nil), "cosmos.tx.v1beta1.GetTxsEventResponse")
+	golang_proto.RegisterType((*GetTxsEventResponse)(nil), "cosmos.tx.v1beta1.GetTxsEventResponse")
+	proto.RegisterType((*BroadcastTxRequest)(nil), "cosmos.tx.v1beta1.BroadcastTxRequest")
+	golang_proto.RegisterType((*BroadcastTxRequest)(nil), "cosmos.tx.v1beta1.BroadcastTxRequest")
+	proto.RegisterType((*BroadcastTxResponse)(nil), "cosmos.tx.v1beta1.BroadcastTxResponse")
+	golang_proto.RegisterType((*BroadcastTxResponse)(nil), "cosmos.tx.v1beta1.BroadcastTxResponse")
+	proto.RegisterType((*SimulateRequest)(nil), "cosmos.tx.v1beta1.SimulateRequest")
+	golang_proto.RegisterType((*SimulateRequest)(nil), "cosmos.tx.v1beta1.SimulateRequest")
+	proto.RegisterType((*SimulateResponse)(nil), "cosmos.tx.v1beta1.SimulateResponse")
+	golang_proto.RegisterType((*SimulateResponse)(nil), "cosmos.tx.v1beta1.SimulateResponse")
+	proto.RegisterType((*SimulateBundleRequest)(nil), "cosmos.tx.v1beta1.SimulateBundleRequest")
+	golang_proto.RegisterType((*SimulateBundleRequest)(nil), "cosmos.tx.v1beta1.SimulateBundleRequest")
+	proto.RegisterType((*SimulateBundleResponse)(nil), "cosmos.tx.v1beta1.SimulateBundleResponse")
+	golang_proto.RegisterType((*SimulateBundleResponse)(nil), "cosmos.tx.v1beta1.SimulateBundleResponse")
+	proto.RegisterType((*EstimateGasRequest)(nil), "cosmos.tx.v1beta1.EstimateGasRequest")
+	golang_proto.RegisterType((*EstimateGasRequest)(nil), "cosmos.tx.v1beta1.EstimateGasRequest")
+	proto.RegisterType((*EstimateGasResponse)(nil), "cosmos.tx.v1beta1.EstimateGasResponse")
+	golang_proto.RegisterType((*EstimateGasResponse)(nil), "cosmos.tx.v1beta1.EstimateGasResponse")
+	proto.RegisterType((*MsgGasUsed)(nil), "cosmos.tx.v1beta1.MsgGasUsed")
+	golang_proto.RegisterType((*MsgGasUsed)(nil), "cosmos.tx.v1beta1.MsgGasUsed")
+	proto.RegisterType((*TraceTxRequest)(nil), "cosmos.tx.v1beta1.TraceTxRequest")
+	golang_proto.RegisterType((*TraceTxRequest)(nil), "cosmos.tx.v1beta1.TraceTxRequest")
+	proto.RegisterType((*TraceTxResponse)(nil), "cosmos.tx.v1beta1.TraceTxResponse")
+	golang_proto.RegisterType((*TraceTxResponse)(nil), "cosmos.tx.v1beta1.TraceTxResponse")
+	proto.RegisterType((*MsgTrace)(nil), "cosmos.tx.v1beta1.MsgTrace")
+	golang_proto.RegisterType((*MsgTrace)(nil), "cosmos.tx.v1beta1.MsgTrace")
+	proto.RegisterType((*TxSignersRequest)(nil), "cosmos.tx.v1beta1.TxSignersRequest")
+	golang_proto.RegisterType((*TxSignersRequest)(nil), "cosmos.tx.v1beta1.TxSignersRequest")
+	proto.RegisterType((*TxSignersResponse)(nil), "cosmos.tx.v1beta1.TxSignersResponse")
+	golang_proto.RegisterType((*TxSignersResponse)(nil), "cosmos.tx.v1beta1.TxSignersResponse")
+	proto.RegisterType((*UnknownFieldsPolicyRequest)(nil), "cosmos.tx.v1beta1.UnknownFieldsPolicyRequest")
+	golang_proto.RegisterType((*UnknownFieldsPolicyRequest)(nil), "cosmos.tx.v1beta1.UnknownFieldsPolicyRequest")
+	proto.RegisterType((*UnknownFieldsPolicyResponse)(nil), "cosmos.tx.v1beta1.UnknownFieldsPolicyResponse")
+	golang_proto.RegisterType((*UnknownFieldsPolicyResponse)(nil), "cosmos.tx.v1beta1.UnknownFieldsPolicyResponse")
+	proto.RegisterType((*GetTxRequest)(nil), "cosmos.tx.v1beta1.GetTxRequest")
+	golang_proto.RegisterType((*GetTxRequest)(nil), "cosmos.tx.v1beta1.GetTxRequest")
+	proto.RegisterType((*GetTxResponse)(nil), "cosmos.tx.v1beta1.GetTxResponse")
+	golang_proto.RegisterType((*GetTxResponse)(nil), "cosmos.tx.v1beta1.GetTxResponse")
+	proto.RegisterType((*GetBlockWithTxsRequest)(nil), "cosmos.tx.v1beta1.GetBlockWithTxsRequest")
+	golang_proto.RegisterType((*GetBlockWithTxsRequest)(nil), "cosmos.tx.v1beta1.GetBlockWithTxsRequest")
+	proto.RegisterType((*GetBlockWithTxsResponse)(nil), "cosmos.tx.v1beta1.GetBlockWithTxsResponse")
+	golang_proto.RegisterType((*GetBlockWithTxsResponse)(nil), "cosmos.tx.v1beta1.GetBlockWithTxsResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/tx/v1beta1/service.proto", fileDescriptor_e0b00a618705eca7) }
+func init() {
+	golang_proto.RegisterFile("cosmos/tx/v1beta1/service.proto", fileDescriptor_e0b00a618705eca7)
+}
+
+var fileDescriptor_e0b00a618705eca7 = []byte{
+	// 1649 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xdf, 0x6e, 0x1b, 0x4d,
+	0x15, 0xcf, 0xda, 0x4e, 0xec, 0x1c, 0xe7, 0x8f, 0x33, 0x49, 0x53, 0x77, 0x93, 0x3a, 0xee, 0xe6,
+	0x4f, 0xdd, 0xa0, 0x78, 0xdb, 0x10, 0x24, 0x84, 0xe0, 0x22, 0x76, 0xdc, 0x10, 0xd1, 0x36, 0xd1,
+	0xda, 0x51, 0x55, 0x04, 0x5a, 0xad, 0xed, 0xc9, 0x66, 0xa9, 0xbd, 0xeb, 0xee, 0x8c, 0xd3, 0xb5,
+	0xda, 0x08, 0x84, 0xb8, 0x40, 0x5c, 0x21, 0x81, 0xc4, 0x1b, 0x70, 0x01, 0x2f, 0xc1, 0x65, 0x2f,
+	0x2b, 0x71, 0x83, 0x84, 0x04, 0xa8, 0xe1, 0x01, 0xb8, 0xe0, 0x01, 0x3e, 0xcd, 0xec, 0xd8, 0x5e,
+	0x3b, 0x6b, 0x27, 0xed, 0xf7, 0x49, 0xdf, 0x4d, 0x3c, 0x33, 0xe7, 0x77, 0xce, 0x9c, 0xf3, 0x3b,
+	0x33, 0x73, 0xce, 0x06, 0xd6, 0x6a, 0x0e, 0x69, 0x3a, 0x44, 0xa5, 0x9e, 0x7a, 0xf1, 0xa4, 0x8a,
+	0xa9, 0xf1, 0x44, 0x25, 0xd8, 0xbd, 0xb0, 0x6a, 0x38, 0xdf, 0x72, 0x1d, 0xea, 0xa0, 0x05, 0x1f,
+	0x90, 0xa7, 0x5e, 0x5e, 0x00, 0xe4, 0x55, 0xd3, 0x71, 0xcc, 0x06, 0x56, 0x8d, 0x96, 0xa5, 0x1a,
+	0xb6, 0xed, 0x50, 0x83, 0x5a, 0x8e, 0x4d, 0x7c, 0x05, 0x79, 0x5d, 0x58, 0xac, 0x1a, 0x04, 0xab,
+	0x46, 0xb5, 0x66, 0xf5, 0x0c, 0xb3, 0x89, 0x00, 0x65, 0x82, 0xa0, 0xae, 0xbc, 0xe6, 0x58, 0xb6,
+	0x90, 0xcb, 0xd7, 0xdd, 0xa2, 0x9e, 0x90, 0x2d, 0x99, 0x8e, 0xe9, 0xf0, 0xa1, 0xca, 0x46, 0x62,
+	0x75, 0x3b, 0x68, 0xf1, 0x4d, 0x1b, 0xbb, 0x9d, 0x9e, 0x66, 0xcb, 0x30, 0x2d, 0x9b, 0xfb, 0x28,
+	0xb0, 0xab, 0x14, 0xdb, 0x75, 0xec, 0x36, 0x2d, 0x9b, 0xaa, 0xb4, 0xd3, 0xc2, 0x44, 0xad, 0x36,
+	0x9c, 0xda, 0xeb, 0x91, 0x52, 0xfe, 0x57, 0x48, 0x57, 0x02, 0x52, 0x1e, 0x5d, 0x40, 0xa8, 0xfc,
+	0x55, 0x02, 0x74, 0x88, 0x69, 0xc5, 0x23, 0xa5, 0x0b, 0x6c, 0x53, 0x0d, 0xbf, 0x69, 0x63, 0x42,
+	0xd1, 0x32, 0x4c, 0x61, 0x36, 0x27, 0x69, 0x29, 0x1b, 0xcd, 0x4d, 0x6b, 0x62, 0x86, 0x9e, 0x02,
+	0xf4, 0x7d, 0x4b, 0x47, 0xb2, 0x52, 0x2e, 0xb9, 0xbb, 0x95, 0x17, 0x84, 0xb3, 0x40, 0xf2, 0x3c,
+	0x90, 0x2e, 0xf1, 0xf9, 0x13, 0xc3, 0xc4, 0xc2, 0xa6, 0x16, 0xd0, 0x44, 0xdf, 0x83, 0x84, 0xe3,
+	0xd6, 0xb1, 0xab, 0x57, 0x3b, 0xe9, 0x68, 0x56, 0xca, 0xcd, 0xed, 0xca, 0xf9, 0x6b, 0x69, 0xcb,
+	0x1f, 0x33, 0x48, 0xa1, 0xa3, 0xc5, 0x1d, 0x7f, 0xa0, 0x7c, 0x94, 0x60, 0x71, 0xc0, 0x5b, 0xd2,
+	0x72, 0x6c, 0x82, 0xd1, 0x43, 0x88, 0x52, 0xcf, 0xf7, 0x35, 0xb9, 0x7b, 0x27, 0xc4, 0x52, 0xc5,
+	0xd3, 0x18, 0x02, 0x1d, 0xc2, 0x0c, 0xf5, 0x74, 0x57, 0xe8, 0x91, 0x74, 0x84, 0x6b, 0x6c, 0x0c,
+	0x44, 0xc0, 0x93, 0x1e, 0x50, 0x14, 0x60, 0x2d, 0x49, 0x7b, 0x63, 0x66, 0x28, 0x48, 0x44, 0x94,
+	0x13, 0xf1, 0xf0, 0x46, 0x22, 0x84, 0xa5, 0x80, 0xaa, 0x82, 0x01, 0x15, 0x5c, 0xc7, 0xa8, 0xd7,
+	0x0c, 0x42, 0x2b, 0x9e, 0xe0, 0x0a, 0xdd, 0x83, 0x04, 0xf5, 0xf4, 0x6a, 0x87, 0x62, 0x16, 0x95,
+	0x94, 0x9b, 0xd1, 0xe2, 0xd4, 0x2b, 0xb0, 0x29, 0xda, 0x83, 0x58, 0xd3, 0xa9, 0x63, 0x4e, 0xfe,
+	0xdc, 0x6e, 0x36, 0x24, 0xd8, 0x9e, 0xbd, 0xe7, 0x4e, 0x1d, 0x6b, 0x1c, 0xad, 0xfc, 0x0c, 0x16,
+	0x07, 0xb6, 0x11, 0xc4, 0x95, 0x20, 0x19, 0xe0, 0x83, 0x6f, 0x75, 0x5b, 0x3a, 0xa0, 0x4f, 0x87,
+	0xf2, 0x12, 0xe6, 0xcb, 0x56, 0xb3, 0xdd, 0x30, 0x68, 0x37, 0xdb, 0xe8, 0x11, 0x44, 0xa8, 0x27,
+	0x0c, 0x86, 0x67, 0xa4, 0x10, 0x49, 0x4b, 0x5a, 0x84, 0x7a, 0x03, 0xc1, 0x46, 0x06, 0x82, 0x55,
+	0x7e, 0x27, 0x41, 0xaa, 0x6f, 0x59, 0x38, 0xfd, 0x43, 0x48, 0x98, 0x06, 0xd1, 0x2d, 0xfb, 0xcc,
+	0x11, 0x1b, 0x3c, 0x18, 0xed, 0xf1, 0xa1, 0x41, 0x8e, 0xec, 0x33, 0x47, 0x8b, 0x9b, 0xfe, 0x00,
+	0x7d, 0x1f, 0xa6, 0x5c, 0x4c, 0xda, 0x0d, 0x2a, 0x8e, 0x6f, 0x76, 0xb4, 0xae, 0xc6, 0x71, 0x9a,
+	0xc0, 0x2b, 0x7b, 0x70, 0xa7, 0xeb, 0x4b, 0xa1, 0x6d, 0xd7, 0x1b, 0xbd, 0x58, 0x57, 0x60, 0x9a,
+	0x7a, 0xa4, 0x97, 0xae, 0x68, 0x6e, 0x46, 0x4b, 0x50, 0x8f, 0xf8, 0x21, 0xbc, 0x84, 0xe5, 0x61,
+	0x2d, 0x11, 0xc7, 0x8f, 0x20, 0xee, 0x5b, 0xee, 0x9e, 0xdc, 0xf5, 0x10, 0x9e, 0x86, 0xa3, 0xd7,
+	0xba, 0x3a, 0x8a, 0x0a, 0xa8, 0x44, 0xa8, 0xd5, 0x34, 0x28, 0x3e, 0x34, 0xc8, 0xcd, 0x27, 0x47,
+	0xf9, 0xbf, 0x04, 0x8b, 0x03, 0x1a, 0xdf, 0x2e, 0x9f, 0x48, 0x81, 0x59, 0xc3, 0xa6, 0x58, 0x67,
+	0x9b, 0xb7, 0x09, 0xae, 0xf3, 0x6b, 0x14, 0xd3, 0x92, 0x6c, 0xf1, 0xd0, 0x20, 0xa7, 0x04, 0xd7,
+	0xd1, 0x3e, 0xcc, 0x36, 0x89, 0x49, 0xfa, 0x98, 0x18, 0x67, 0xea, 0x7e, 0x08, 0x53, 0xcf, 0x89,
+	0x29, 0xb4, 0xb4, 0x24, 0xd3, 0x11, 0x13, 0xe5, 0x08, 0xa0, 0x2f, 0x42, 0x59, 0x98, 0x69, 0x12,
+	0x53, 0x67, 0x6f, 0xa0, 0xde, 0x76, 0x1b, 0x3c, 0xe0, 0x69, 0x0d, 0x9a, 0xc4, 0xac, 0x74, 0x5a,
+	0xf8, 0xd4, 0x6d, 0x30, 0x06, 0x7b, 0xbb, 0x45, 0xb8, 0x47, 0x71, 0xd3, 0x57, 0x56, 0x36, 0x60,
+	0xae, 0xe2, 0x1a, 0x35, 0xdc, 0xbf, 0xa8, 0x08, 0x62, 0xe7, 0x06, 0x39, 0x17, 0x66, 0xf8, 0x58,
+	0xf9, 0x55, 0x04, 0xe6, 0x7b, 0x30, 0xc1, 0xf1, 0x32, 0x4c, 0x9d, 0x63, 0xcb, 0x3c, 0xa7, 0x1c,
+	0x19, 0xd5, 0xc4, 0x6c, 0x80, 0xfb, 0xc8, 0x67, 0x73, 0x8f, 0x20, 0x56, 0x63, 0x6f, 0x01, 0x23,
+	0x6e, 0x56, 0xe3, 0x63, 0xb4, 0x0a, 0xd3, 0xec, 0x97, 0xb4, 0x8c, 0x1a, 0x4e, 0xc7, 0xb8, 0x5b,
+	0xfd, 0x05, 0x94, 0x82, 0x68, 0xc3, 0x31, 0xd3, 0x93, 0x7c, 0x9d, 0x0d, 0xaf, 0x67, 0x61, 0xea,
+	0x7a, 0x16, 0x54, 0x88, 0x31, 0x46, 0xd3, 0x71, 0x4e, 0xfe, 0x4a, 0x38, 0xf9, 0x3c, 0x64, 0x8d,
+	0x03, 0x59, 0x59, 0x49, 0x74, 0x97, 0xbe, 0x16, 0xe5, 0x68, 0xaf, 0x57, 0x89, 0xa2, 0x7c, 0xf3,
+	0xe5, 0x7c, 0xbf, 0x9c, 0xf9, 0xec, 0xf0, 0x52, 0x50, 0x88, 0x7d, 0xf8, 0xd7, 0xda, 0x44, 0xaf,
+	0x4e, 0x2d, 0xc1, 0xa4, 0x8b, 0x8d, 0x3a, 0xe1, 0x04, 0xc4, 0x34, 0x7f, 0xc2, 0x92, 0xf0, 0xd6,
+	0xb5, 0xd8, 0xcd, 0x98, 0xe4, 0xcb, 0x62, 0xa6, 0xec, 0x40, 0xaa, 0xe2, 0x95, 0x2d, 0xd3, 0xc6,
+	0xee, 0x6d, 0xee, 0xd1, 0x6f, 0x22, 0xb0, 0x10, 0xc0, 0x8b, 0x0c, 0xa7, 0x21, 0x4e, 0xfc, 0x25,
+	0x51, 0x33, 0xbb, 0x53, 0xf6, 0x3c, 0x9c, 0x61, 0xac, 0xb7, 0x8c, 0x0e, 0x76, 0x79, 0x78, 0xd3,
+	0x5a, 0xe2, 0x0c, 0xe3, 0x13, 0x36, 0x47, 0x6b, 0x90, 0x64, 0x42, 0xd3, 0x65, 0x7c, 0xbb, 0x3c,
+	0x93, 0xd3, 0x1a, 0x9c, 0x61, 0x7c, 0xe8, 0xaf, 0xa0, 0x9f, 0x43, 0xf4, 0x0c, 0x63, 0x71, 0xee,
+	0xef, 0x0d, 0x1c, 0x8e, 0x2e, 0xf9, 0x45, 0xc7, 0xb2, 0x0b, 0x8f, 0x19, 0x01, 0x7f, 0xf9, 0xf7,
+	0x5a, 0xce, 0xb4, 0xe8, 0x79, 0xbb, 0x9a, 0xaf, 0x39, 0x4d, 0xd5, 0x07, 0x8b, 0x9f, 0x1d, 0x52,
+	0x7f, 0x2d, 0x6a, 0x3f, 0x53, 0x20, 0x1a, 0xb3, 0xcb, 0x9c, 0x63, 0xd4, 0x37, 0xac, 0xa6, 0x45,
+	0x05, 0x2d, 0x2c, 0x17, 0xcf, 0xd8, 0x9c, 0x09, 0xbb, 0x99, 0x23, 0xe9, 0x29, 0x1e, 0x55, 0x42,
+	0xa4, 0x8d, 0x28, 0xab, 0x20, 0x9f, 0xda, 0xaf, 0x6d, 0xe7, 0xad, 0xfd, 0xd4, 0xc2, 0x8d, 0x3a,
+	0x39, 0x71, 0x1a, 0x56, 0xad, 0x23, 0xf8, 0x53, 0x2e, 0x60, 0x25, 0x54, 0xda, 0xab, 0xd8, 0xf3,
+	0x2e, 0xfe, 0x05, 0xae, 0x51, 0xbd, 0xe6, 0x5a, 0xd4, 0xaa, 0x19, 0xfe, 0xb1, 0x48, 0x68, 0x73,
+	0xfe, 0x72, 0x51, 0xac, 0xa2, 0x3c, 0x2c, 0x0a, 0xa0, 0xed, 0xd8, 0x7d, 0x70, 0x84, 0x83, 0x17,
+	0x7c, 0xd1, 0x0b, 0xc7, 0xee, 0xe2, 0x15, 0x05, 0x66, 0x78, 0x87, 0x30, 0xee, 0x82, 0x5e, 0xc2,
+	0xac, 0xc0, 0x08, 0x6f, 0x36, 0x6f, 0x2c, 0x56, 0xbc, 0x50, 0x0d, 0x55, 0xcb, 0xc8, 0x17, 0x56,
+	0x4b, 0x0f, 0x96, 0x0f, 0x31, 0x2d, 0xb0, 0x06, 0xee, 0xa5, 0x45, 0xcf, 0x2b, 0x1e, 0x09, 0xb4,
+	0x5d, 0xa1, 0xaf, 0xc4, 0x37, 0xd4, 0x76, 0x29, 0xff, 0x93, 0xe0, 0xee, 0xb5, 0xad, 0x3f, 0xb7,
+	0x87, 0xda, 0x83, 0x04, 0x6f, 0x3e, 0x75, 0xab, 0x2e, 0x5c, 0xb9, 0x17, 0xbc, 0x93, 0xfe, 0x09,
+	0xe3, 0x5b, 0x1c, 0x1d, 0x68, 0x71, 0x0e, 0x3d, 0xaa, 0xa3, 0x1d, 0x98, 0xe4, 0x43, 0xd1, 0x2b,
+	0xdd, 0x1d, 0xa1, 0xa2, 0xf9, 0xa8, 0xa1, 0xfe, 0x2a, 0xf6, 0xc5, 0xfd, 0xd5, 0xf6, 0x8f, 0x21,
+	0x2e, 0xda, 0x48, 0x94, 0x86, 0xa5, 0x63, 0xed, 0xa0, 0xa4, 0xe9, 0x85, 0x57, 0xfa, 0xe9, 0x8b,
+	0xf2, 0x49, 0xa9, 0x78, 0xf4, 0xf4, 0xa8, 0x74, 0x90, 0x9a, 0x40, 0x29, 0x98, 0xe9, 0x49, 0xf6,
+	0xcb, 0xc5, 0x94, 0x84, 0x16, 0x60, 0xb6, 0xb7, 0x72, 0x50, 0x2a, 0x17, 0x53, 0x91, 0xed, 0xf7,
+	0x30, 0x3b, 0xd0, 0x59, 0xa1, 0x0c, 0xc8, 0x05, 0xed, 0x78, 0xff, 0xa0, 0xb8, 0x5f, 0xae, 0xe8,
+	0xcf, 0x8f, 0x0f, 0x4a, 0x43, 0x56, 0xd3, 0xb0, 0x34, 0x24, 0x2f, 0x3c, 0x3b, 0x2e, 0xfe, 0x24,
+	0x25, 0xa1, 0xbb, 0xb0, 0x38, 0x24, 0x29, 0xbf, 0x7a, 0x51, 0x4c, 0x45, 0x42, 0x54, 0xf6, 0xb9,
+	0x24, 0xba, 0xfb, 0x4f, 0x80, 0x78, 0xd9, 0xff, 0xce, 0x41, 0xef, 0x20, 0xd1, 0x6d, 0x0b, 0x90,
+	0x32, 0xb6, 0x67, 0xe0, 0x47, 0x40, 0xbe, 0x4d, 0x5f, 0xa1, 0x6c, 0xfd, 0xfa, 0xef, 0xff, 0xfd,
+	0x43, 0x24, 0xab, 0xac, 0xa8, 0x21, 0x1f, 0x58, 0x02, 0xfc, 0x03, 0x69, 0x1b, 0xbd, 0x81, 0x49,
+	0x7e, 0x79, 0xd0, 0x5a, 0x88, 0xd5, 0xe0, 0xd5, 0x93, 0xb3, 0xa3, 0x01, 0x62, 0xcf, 0x4d, 0xbe,
+	0xe7, 0x1a, 0xba, 0xaf, 0x86, 0x7d, 0x3d, 0x11, 0xf5, 0x1d, 0xbb, 0xae, 0x97, 0xe8, 0x97, 0x90,
+	0x0c, 0x34, 0xaf, 0x68, 0x73, 0x5c, 0xcf, 0xdb, 0xdf, 0x7e, 0xeb, 0x26, 0x98, 0x70, 0xe2, 0x01,
+	0x77, 0x62, 0x45, 0x59, 0x0e, 0x77, 0x82, 0xc5, 0xfc, 0x1e, 0x92, 0x81, 0xcf, 0x8e, 0x50, 0x07,
+	0xae, 0x7f, 0x44, 0xc9, 0x5b, 0x37, 0xc1, 0x84, 0x03, 0x19, 0xee, 0x40, 0x1a, 0x8d, 0x70, 0x00,
+	0xfd, 0x49, 0x82, 0xf9, 0xa1, 0x5b, 0x8b, 0x1e, 0x85, 0xdb, 0x0e, 0x79, 0x54, 0xe4, 0xed, 0xdb,
+	0x40, 0x85, 0x2b, 0x3b, 0xdc, 0x95, 0x87, 0x68, 0x73, 0x44, 0x42, 0xf8, 0xe5, 0x54, 0xdf, 0xf9,
+	0xcf, 0xd2, 0x25, 0xfa, 0xa3, 0x04, 0x73, 0x83, 0xcd, 0x2d, 0xca, 0x8d, 0x39, 0x6b, 0x03, 0x5d,
+	0xb3, 0xfc, 0xe8, 0x16, 0xc8, 0x41, 0xb7, 0x14, 0x65, 0xcc, 0xd9, 0xd4, 0xab, 0x5c, 0x87, 0xa5,
+	0xeb, 0x12, 0xa6, 0x7b, 0xf5, 0x19, 0xad, 0x87, 0x3e, 0x65, 0x83, 0xd5, 0x5e, 0xde, 0x18, 0x0f,
+	0x1a, 0x3c, 0xae, 0x8a, 0x1c, 0xea, 0x06, 0xc7, 0xb2, 0xed, 0xff, 0x2c, 0xc1, 0x62, 0x48, 0xed,
+	0x43, 0x3b, 0x21, 0x9b, 0x8c, 0xae, 0xa0, 0x72, 0xfe, 0xb6, 0x70, 0xe1, 0xdd, 0x63, 0xee, 0xdd,
+	0x36, 0xca, 0x85, 0x78, 0xd7, 0xf6, 0xf5, 0xf4, 0x33, 0xae, 0xa8, 0xb7, 0x7c, 0x87, 0x7e, 0x2b,
+	0x41, 0x32, 0xf0, 0x41, 0x10, 0x7a, 0xae, 0xaf, 0x7f, 0x62, 0xc8, 0x5b, 0x37, 0xc1, 0x84, 0x43,
+	0xdb, 0xdc, 0xa1, 0x0d, 0x65, 0x2d, 0xc4, 0x21, 0x2c, 0xf0, 0xac, 0xed, 0xf4, 0x53, 0x16, 0x17,
+	0x2d, 0x33, 0x7a, 0x10, 0x96, 0x8b, 0x81, 0xae, 0x5b, 0x56, 0xc6, 0x41, 0xc4, 0xee, 0xdf, 0xe1,
+	0xbb, 0x6f, 0xa2, 0xf5, 0xb1, 0x6f, 0x8b, 0x4a, 0x99, 0x5a, 0xa1, 0xf8, 0xe1, 0x53, 0x46, 0xfa,
+	0xf8, 0x29, 0x23, 0xfd, 0xe7, 0x53, 0x46, 0xfa, 0xfd, 0x55, 0x66, 0xe2, 0x6f, 0x57, 0x19, 0xe9,
+	0xe3, 0x55, 0x66, 0xe2, 0x1f, 0x57, 0x99, 0x89, 0x9f, 0x6e, 0xde, 0xdc, 0x52, 0xa9, 0xd4, 0xab,
+	0x4e, 0xf1, 0x7f, 0xa9, 0x7c, 0xf7, 0xab, 0x01, 0x00, 0x23, 0xf8, 0x95, 0xd5, 0xa2, 0x12, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ServiceClient interface {
+	// Simulate simulates executing a transaction for estimating gas usage.
+	Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error)
+	// GetTx fetches a tx by hash.
+	GetTx(ctx context.Context, in *GetTxRequest, opts ...grpc.CallOption) (*GetTxResponse, error)
+	// BroadcastTx broadcast transaction.
+	BroadcastTx(ctx context.Context, in *BroadcastTxRequest, opts ...grpc.CallOption) (*BroadcastTxResponse, error)
+	// GetTxsEvent fetches txs by event.
+	GetTxsEvent(ctx context.Context, in *GetTxsEventRequest, opts ...grpc.CallOption) (*GetTxsEventResponse, error)
+	// GetBlockWithTxs fetches a block with decoded txs.
+	//
+	// Since: cosmos-sdk 0.45.2
+	GetBlockWithTxs(ctx context.Context, in *GetBlockWithTxsRequest, opts ...grpc.CallOption) (*GetBlockWithTxsResponse, error)
+	// SimulateBundle simulates executing an ordered list of transactions, each
+	// seeing the state changes of the previous ones, for previewing multi-step
+	// workflows. No state changes are persisted.
+	SimulateBundle(ctx context.Context, in *SimulateBundleRequest, opts ...grpc.CallOption) (*SimulateBundleResponse, error)
+	// TxSigners decodes a raw transaction and returns its signers, fee payer,
+	// fee and message types, without executing it.
+	TxSigners(ctx context.Context, in *TxSignersRequest, opts ...grpc.CallOption) (*TxSignersResponse, error)
+	// UnknownFieldsPolicy returns how the node handles the unknown proto fields
+	// of the transactions it decodes.
+	UnknownFieldsPolicy(ctx context.Context, in *UnknownFieldsPolicyRequest, opts ...grpc.CallOption) (*UnknownFieldsPolicyResponse, error)
+	// EstimateGas simulates executing a transaction like Simulate, and returns
+	// the gas used by the AnteHandler and by each of its messages besides the
+	// aggregate gas info, for setting tighter gas limits.
+	EstimateGas(ctx context.Context, in *EstimateGasRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// TraceTx re-executes a committed transaction against the state of its
+	// block, and returns the gas used, events emitted and store accesses of each
+	// of its messages, for debugging out of gas transactions and tuning fees.
+	TraceTx(ctx context.Context, in *TraceTxRequest, opts ...grpc.CallOption) (*TraceTxResponse, error)
+}
+
+type serviceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewServiceClient(cc grpc1.ClientConn) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error) {
+	out := new(SimulateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/Simulate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Txs) > 0 {
-		for _, e := range m.Txs {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *serviceClient) GetTx(ctx context.Context, in *GetTxRequest, opts ...grpc.CallOption) (*GetTxResponse, error) {
+	out := new(GetTxResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/GetTx", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.TxResponses) > 0 {
-		for _, e := range m.TxResponses {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *serviceClient) BroadcastTx(ctx context.Context, in *BroadcastTxRequest, opts ...grpc.CallOption) (*BroadcastTxResponse, error) {
+	out := new(BroadcastTxResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/BroadcastTx", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovService(uint64(l))
+	return out, nil
+}
+
+func (c *serviceClient) GetTxsEvent(ctx context.Context, in *GetTxsEventRequest, opts ...grpc.CallOption) (*GetTxsEventResponse, error) {
+	out := new(GetTxsEventResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/GetTxsEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *BroadcastTxRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *serviceClient) GetBlockWithTxs(ctx context.Context, in *GetBlockWithTxsRequest, opts ...grpc.CallOption) (*GetBlockWithTxsResponse, error) {
+	out := new(GetBlockWithTxsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/GetBlockWithTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.TxBytes)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	return out, nil
+}
+
+func (c *serviceClient) SimulateBundle(ctx context.Context, in *SimulateBundleRequest, opts ...grpc.CallOption) (*SimulateBundleResponse, error) {
+	out := new(SimulateBundleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/SimulateBundle", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Mode != 0 {
-		n += 1 + sovService(uint64(m.Mode))
+	return out, nil
+}
+
+func (c *serviceClient) TxSigners(ctx context.Context, in *TxSignersRequest, opts ...grpc.CallOption) (*TxSignersResponse, error) {
+	out := new(TxSignersResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/TxSigners", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *BroadcastTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *serviceClient) UnknownFieldsPolicy(ctx context.Context, in *UnknownFieldsPolicyRequest, opts ...grpc.CallOption) (*UnknownFieldsPolicyResponse, error) {
+	out := new(UnknownFieldsPolicyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/UnknownFieldsPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.TxResponse != nil {
-		l = m.TxResponse.Size()
-		n += 1 + l + sovService(uint64(l))
+	return out, nil
+}
+
+func (c *serviceClient) EstimateGas(ctx context.Context, in *EstimateGasRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error) {
+	out := new(EstimateGasResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/EstimateGas", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *SimulateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *serviceClient) TraceTx(ctx context.Context, in *TraceTxRequest, opts ...grpc.CallOption) (*TraceTxResponse, error) {
+	out := new(TraceTxResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/TraceTx", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Tx != nil {
-		l = m.Tx.Size()
-		n += 1 + l + sovService(uint64(l))
+	return out, nil
+}
+
+// ServiceServer is the server API for Service service.
+type ServiceServer interface {
+	// Simulate simulates executing a transaction for estimating gas usage.
+	Simulate(context.Context, *SimulateRequest) (*SimulateResponse, error)
+	// GetTx fetches a tx by hash.
+	GetTx(context.Context, *GetTxRequest) (*GetTxResponse, error)
+	// BroadcastTx broadcast transaction.
+	BroadcastTx(context.Context, *BroadcastTxRequest) (*BroadcastTxResponse, error)
+	// GetTxsEvent fetches txs by event.
+	GetTxsEvent(context.Context, *GetTxsEventRequest) (*GetTxsEventResponse, error)
+	// GetBlockWithTxs fetches a block with decoded txs.
+	//
+	// Since: cosmos-sdk 0.45.2
+	GetBlockWithTxs(context.Context, *GetBlockWithTxsRequest) (*GetBlockWithTxsResponse, error)
+	// SimulateBundle simulates executing an ordered list of transactions, each
+	// seeing the state changes of the previous ones, for previewing multi-step
+	// workflows. No state changes are persisted.
+	SimulateBundle(context.Context, *SimulateBundleRequest) (*SimulateBundleResponse, error)
+	// TxSigners decodes a raw transaction and returns its signers, fee payer,
+	// fee and message types, without executing it.
+	TxSigners(context.Context, *TxSignersRequest) (*TxSignersResponse, error)
+	// UnknownFieldsPolicy returns how the node handles the unknown proto fields
+	// of the transactions it decodes.
+	UnknownFieldsPolicy(context.Context, *UnknownFieldsPolicyRequest) (*UnknownFieldsPolicyResponse, error)
+	// EstimateGas simulates executing a transaction like Simulate, and returns
+	// the gas used by the AnteHandler and by each of its messages besides the
+	// aggregate gas info, for setting tighter gas limits.
+	EstimateGas(context.Context, *EstimateGasRequest) (*EstimateGasResponse, error)
+	// TraceTx re-executes a committed transaction against the state of its
+	// block, and returns the gas used, events emitted and store accesses of each
+	// of its messages, for debugging out of gas transactions and tuning fees.
+	TraceTx(context.Context, *TraceTxRequest) (*TraceTxResponse, error)
+}
+
+// UnimplementedServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (*UnimplementedServiceServer) Simulate(ctx context.Context, req *SimulateRequest) (*SimulateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Simulate not implemented")
+}
+func (*UnimplementedServiceServer) GetTx(ctx context.Context, req *GetTxRequest) (*GetTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTx not implemented")
+}
+func (*UnimplementedServiceServer) BroadcastTx(ctx context.Context, req *BroadcastTxRequest) (*BroadcastTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BroadcastTx not implemented")
+}
+func (*UnimplementedServiceServer) GetTxsEvent(ctx context.Context, req *GetTxsEventRequest) (*GetTxsEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxsEvent not implemented")
+}
+func (*UnimplementedServiceServer) GetBlockWithTxs(ctx context.Context, req *GetBlockWithTxsRequest) (*GetBlockWithTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockWithTxs not implemented")
+}
+func (*UnimplementedServiceServer) SimulateBundle(ctx context.Context, req *SimulateBundleRequest) (*SimulateBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateBundle not implemented")
+}
+func (*UnimplementedServiceServer) TxSigners(ctx context.Context, req *TxSignersRequest) (*TxSignersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxSigners not implemented")
+}
+func (*UnimplementedServiceServer) UnknownFieldsPolicy(ctx context.Context, req *UnknownFieldsPolicyRequest) (*UnknownFieldsPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnknownFieldsPolicy not implemented")
+}
+func (*UnimplementedServiceServer) EstimateGas(ctx context.Context, req *EstimateGasRequest) (*EstimateGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
+}
+func (*UnimplementedServiceServer) TraceTx(ctx context.Context, req *TraceTxRequest) (*TraceTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceTx not implemented")
+}
+
+func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
+	s.RegisterService(&_Service_serviceDesc, srv)
+}
+
+func _Service_Simulate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.TxBytes)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if interceptor == nil {
+		return srv.(ServiceServer).Simulate(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.Service/Simulate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Simulate(ctx, req.(*SimulateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SimulateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Service_GetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.GasInfo != nil {
-		l = m.GasInfo.Size()
-		n += 1 + l + sovService(uint64(l))
+	if interceptor == nil {
+		return srv.(ServiceServer).GetTx(ctx, in)
 	}
-	if m.Result != nil {
-		l = m.Result.Size()
-		n += 1 + l + sovService(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.Service/GetTx",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetTx(ctx, req.(*GetTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetTxRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Service_BroadcastTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if interceptor == nil {
+		return srv.(ServiceServer).BroadcastTx(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.Service/BroadcastTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).BroadcastTx(ctx, req.(*BroadcastTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Service_GetTxsEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTxsEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Tx != nil {
-		l = m.Tx.Size()
-		n += 1 + l + sovService(uint64(l))
+	if interceptor == nil {
+		return srv.(ServiceServer).GetTxsEvent(ctx, in)
 	}
-	if m.TxResponse != nil {
-		l = m.TxResponse.Size()
-		n += 1 + l + sovService(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.Service/GetTxsEvent",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetTxsEvent(ctx, req.(*GetTxsEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetBlockWithTxsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Service_GetBlockWithTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockWithTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovService(uint64(m.Height))
+	if interceptor == nil {
+		return srv.(ServiceServer).GetBlockWithTxs(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovService(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.Service/GetBlockWithTxs",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetBlockWithTxs(ctx, req.(*GetBlockWithTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetBlockWithTxsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Service_SimulateBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Txs) > 0 {
-		for _, e := range m.Txs {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(ServiceServer).SimulateBundle(ctx, in)
 	}
-	if m.BlockId != nil {
-		l = m.BlockId.Size()
-		n += 1 + l + sovService(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.Service/SimulateBundle",
 	}
-	if m.Block != nil {
-		l = m.Block.Size()
-		n += 1 + l + sovService(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SimulateBundle(ctx, req.(*SimulateBundleRequest))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovService(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_TxSigners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxSignersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(ServiceServer).TxSigners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.Service/TxSigners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).TxSigners(ctx, req.(*TxSignersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_UnknownFieldsPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnknownFieldsPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).UnknownFieldsPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.Service/UnknownFieldsPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).UnknownFieldsPolicy(ctx, req.(*UnknownFieldsPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateGasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).EstimateGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.Service/EstimateGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).EstimateGas(ctx, req.(*EstimateGasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TraceTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).TraceTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.Service/TraceTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).TraceTx(ctx, req.(*TraceTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func sovService(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozService(x uint64) (n int) {
-	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+var _Service_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.tx.v1beta1.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Simulate",
+			Handler:    _Service_Simulate_Handler,
+		},
+		{
+			MethodName: "GetTx",
+			Handler:    _Service_GetTx_Handler,
+		},
+		{
+			MethodName: "BroadcastTx",
+			Handler:    _Service_BroadcastTx_Handler,
+		},
+		{
+			MethodName: "GetTxsEvent",
+			Handler:    _Service_GetTxsEvent_Handler,
+		},
+		{
+			MethodName: "GetBlockWithTxs",
+			Handler:    _Service_GetBlockWithTxs_Handler,
+		},
+		{
+			MethodName: "SimulateBundle",
+			Handler:    _Service_SimulateBundle_Handler,
+		},
+		{
+			MethodName: "TxSigners",
+			Handler:    _Service_TxSigners_Handler,
+		},
+		{
+			MethodName: "UnknownFieldsPolicy",
+			Handler:    _Service_UnknownFieldsPolicy_Handler,
+		},
+		{
+			MethodName: "EstimateGas",
+			Handler:    _Service_EstimateGas_Handler,
+		},
+		{
+			MethodName: "TraceTx",
+			Handler:    _Service_TraceTx_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/tx/v1beta1/service.proto",
+}
+
+func (m *GetTxsEventRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxsEventRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxsEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderBy != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.OrderBy))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Events[iNdEx])
+			copy(dAtA[i:], m.Events[iNdEx])
+			i = encodeVarintService(dAtA, i, uint64(len(m.Events[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTxsEventResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxsEventResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxsEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TxResponses) > 0 {
+		for iNdEx := len(m.TxResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BroadcastTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BroadcastTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BroadcastTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintService(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BroadcastTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BroadcastTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BroadcastTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxResponse != nil {
+		{
+			size, err := m.TxResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintService(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Tx != nil {
+		{
+			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GasInfo != nil {
+		{
+			size, err := m.GasInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateBundleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateBundleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateBundleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxsBytes) > 0 {
+		for iNdEx := len(m.TxsBytes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxsBytes[iNdEx])
+			copy(dAtA[i:], m.TxsBytes[iNdEx])
+			i = encodeVarintService(dAtA, i, uint64(len(m.TxsBytes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateBundleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateBundleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateBundleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateGasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateGasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateGasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintService(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateGasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateGasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgsGasUsed) > 0 {
+		for iNdEx := len(m.MsgsGasUsed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgsGasUsed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.AnteGasUsed != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.AnteGasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GasInfo != nil {
+		{
+			size, err := m.GasInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGasUsed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGasUsed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGasUsed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintService(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TraceTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TraceTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TraceTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TraceTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TraceTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TraceTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.AnteGasUsed != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.AnteGasUsed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Log) > 0 {
+		i -= len(m.Log)
+		copy(dAtA[i:], m.Log)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Log)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Code != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GasInfo != nil {
+		{
+			size, err := m.GasInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTrace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTrace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTrace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Writes != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Writes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Reads != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Reads))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintService(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxSignersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxSignersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxSignersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintService(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxSignersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxSignersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxSignersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypes) > 0 {
+		for iNdEx := len(m.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypes[iNdEx])
+			copy(dAtA[i:], m.MsgTypes[iNdEx])
+			i = encodeVarintService(dAtA, i, uint64(len(m.MsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FeeGranter) > 0 {
+		i -= len(m.FeeGranter)
+		copy(dAtA[i:], m.FeeGranter)
+		i = encodeVarintService(dAtA, i, uint64(len(m.FeeGranter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintService(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintService(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnknownFieldsPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnknownFieldsPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnknownFieldsPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *UnknownFieldsPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnknownFieldsPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnknownFieldsPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RejectNonCritical {
+		i--
+		if m.RejectNonCritical {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RejectCritical {
+		i--
+		if m.RejectCritical {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxResponse != nil {
+		{
+			size, err := m.TxResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Tx != nil {
+		{
+			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockWithTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockWithTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockWithTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockWithTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockWithTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockWithTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockId != nil {
+		{
+			size, err := m.BlockId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintService(dAtA []byte, offset int, v uint64) int {
+	offset -= sovService(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetTxsEventRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, s := range m.Events {
+			l = len(s)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.OrderBy != 0 {
+		n += 1 + sovService(uint64(m.OrderBy))
+	}
+	return n
+}
+
+func (m *GetTxsEventResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.TxResponses) > 0 {
+		for _, e := range m.TxResponses {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *BroadcastTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovService(uint64(m.Mode))
+	}
+	return n
+}
+
+func (m *BroadcastTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxResponse != nil {
+		l = m.TxResponse.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *SimulateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *SimulateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasInfo != nil {
+		l = m.GasInfo.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *SimulateBundleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TxsBytes) > 0 {
+		for _, b := range m.TxsBytes {
+			l = len(b)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SimulateBundleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EstimateGasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *EstimateGasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasInfo != nil {
+		l = m.GasInfo.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.AnteGasUsed != 0 {
+		n += 1 + sovService(uint64(m.AnteGasUsed))
+	}
+	if len(m.MsgsGasUsed) > 0 {
+		for _, e := range m.MsgsGasUsed {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGasUsed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovService(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func (m *TraceTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *TraceTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovService(uint64(m.Height))
+	}
+	if m.GasInfo != nil {
+		l = m.GasInfo.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovService(uint64(m.Code))
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Log)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.AnteGasUsed != 0 {
+		n += 1 + sovService(uint64(m.AnteGasUsed))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTrace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovService(uint64(m.GasUsed))
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.Reads != 0 {
+		n += 1 + sovService(uint64(m.Reads))
+	}
+	if m.Writes != 0 {
+		n += 1 + sovService(uint64(m.Writes))
+	}
+	return n
+}
+
+func (m *TxSignersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *TxSignersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.FeeGranter)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovService(uint64(m.GasLimit))
+	}
+	if len(m.MsgTypes) > 0 {
+		for _, s := range m.MsgTypes {
+			l = len(s)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnknownFieldsPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UnknownFieldsPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RejectCritical {
+		n += 2
+	}
+	if m.RejectNonCritical {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *GetTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TxResponse != nil {
+		l = m.TxResponse.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *GetBlockWithTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovService(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *GetBlockWithTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.BlockId != nil {
+		l = m.BlockId.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func sovService(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozService(x uint64) (n int) {
+	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetTxsEventRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTxsEventRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTxsEventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+			}
+			m.OrderBy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderBy |= OrderBy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTxsEventResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTxsEventResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTxsEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &Tx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxResponses = append(m.TxResponses, &types.TxResponse{})
+			if err := m.TxResponses[len(m.TxResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BroadcastTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BroadcastTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BroadcastTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= BroadcastMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BroadcastTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BroadcastTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BroadcastTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxResponse == nil {
+				m.TxResponse = &types.TxResponse{}
+			}
+			if err := m.TxResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &Tx{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasInfo == nil {
+				m.GasInfo = &types.GasInfo{}
+			}
+			if err := m.GasInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &types.Result{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateBundleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateBundleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateBundleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxsBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxsBytes = append(m.TxsBytes, make([]byte, postIndex-iNdEx))
+			copy(m.TxsBytes[len(m.TxsBytes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateBundleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateBundleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &SimulateResponse{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateGasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateGasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateGasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasInfo == nil {
+				m.GasInfo = &types.GasInfo{}
+			}
+			if err := m.GasInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &types.Result{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnteGasUsed", wireType)
+			}
+			m.AnteGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AnteGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgsGasUsed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgsGasUsed = append(m.MsgsGasUsed, &MsgGasUsed{})
+			if err := m.MsgsGasUsed[len(m.MsgsGasUsed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGasUsed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGasUsed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGasUsed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GetTxsEventRequest) Unmarshal(dAtA []byte) error {
+func (m *TraceTxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1704,15 +4739,15 @@ func (m *GetTxsEventRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTxsEventRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TraceTxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTxsEventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1740,63 +4775,8 @@ func (m *GetTxsEventRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
-			}
-			m.OrderBy = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OrderBy |= OrderBy(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -1818,7 +4798,7 @@ func (m *GetTxsEventRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTxsEventResponse) Unmarshal(dAtA []byte) error {
+func (m *TraceTxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1841,15 +4821,34 @@ func (m *GetTxsEventResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTxsEventResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TraceTxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTxsEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1876,16 +4875,37 @@ func (m *GetTxsEventResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txs = append(m.Txs, &Tx{})
-			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.GasInfo == nil {
+				m.GasInfo = &types.GasInfo{}
+			}
+			if err := m.GasInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxResponses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -1895,29 +4915,78 @@ func (m *GetTxsEventResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxResponses = append(m.TxResponses, &types.TxResponse{})
-			if err := m.TxResponses[len(m.TxResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Log = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnteGasUsed", wireType)
+			}
+			m.AnteGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AnteGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1944,10 +5013,8 @@ func (m *GetTxsEventResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Msgs = append(m.Msgs, &MsgTrace{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1972,7 +5039,7 @@ func (m *GetTxsEventResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BroadcastTxRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgTrace) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1995,17 +5062,17 @@ func (m *BroadcastTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BroadcastTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTrace: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BroadcastTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTrace: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -2015,31 +5082,101 @@ func (m *BroadcastTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.TxBytes == nil {
-				m.TxBytes = []byte{}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, types1.Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reads", wireType)
+			}
+			m.Reads = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reads |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Writes", wireType)
 			}
-			m.Mode = 0
+			m.Writes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -2049,7 +5186,7 @@ func (m *BroadcastTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Mode |= BroadcastMode(b&0x7F) << shift
+				m.Writes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2075,7 +5212,7 @@ func (m *BroadcastTxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BroadcastTxResponse) Unmarshal(dAtA []byte) error {
+func (m *TxSignersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2098,17 +5235,17 @@ func (m *BroadcastTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BroadcastTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxSignersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BroadcastTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxSignersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxResponse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -2118,26 +5255,24 @@ func (m *BroadcastTxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TxResponse == nil {
-				m.TxResponse = &types.TxResponse{}
-			}
-			if err := m.TxResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2161,7 +5296,7 @@ func (m *BroadcastTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
+func (m *TxSignersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2184,15 +5319,111 @@ func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SimulateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxSignersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SimulateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxSignersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGranter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeGranter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2219,18 +5450,35 @@ func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tx == nil {
-				m.Tx = &Tx{}
-			}
-			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -2240,25 +5488,23 @@ func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.TxBytes == nil {
-				m.TxBytes = []byte{}
-			}
+			m.MsgTypes = append(m.MsgTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2281,7 +5527,7 @@ func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
+func (m *UnknownFieldsPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2304,17 +5550,67 @@ func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SimulateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnknownFieldsPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SimulateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnknownFieldsPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnknownFieldsPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnknownFieldsPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnknownFieldsPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectCritical", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -2324,33 +5620,17 @@ func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.GasInfo == nil {
-				m.GasInfo = &types.GasInfo{}
-			}
-			if err := m.GasInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.RejectCritical = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectNonCritical", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -2360,28 +5640,12 @@ func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Result == nil {
-				m.Result = &types.Result{}
-			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.RejectNonCritical = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -2805,7 +6069,7 @@ func (m *GetBlockWithTxsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.BlockId == nil {
-				m.BlockId = &types1.BlockID{}
+				m.BlockId = &types2.BlockID{}
 			}
 			if err := m.BlockId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2841,7 +6105,7 @@ func (m *GetBlockWithTxsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Block == nil {
-				m.Block = &types1.Block{}
+				m.Block = &types2.Block{}
 			}
 			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
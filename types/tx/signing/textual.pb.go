@@ -0,0 +1,641 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/tx/signing/v1beta1/textual.proto
+
+package signing
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TextualSignDoc is the document signed in SIGN_MODE_TEXTUAL: the
+// human-readable screens a signing device, e.g. a hardware wallet, shows to
+// the signer of a transaction.
+type TextualSignDoc struct {
+	// screens are the screens of the transaction, in their order.
+	Screens []*TextualScreen `protobuf:"bytes,1,rep,name=screens,proto3" json:"screens,omitempty"`
+}
+
+func (m *TextualSignDoc) Reset()         { *m = TextualSignDoc{} }
+func (m *TextualSignDoc) String() string { return proto.CompactTextString(m) }
+func (*TextualSignDoc) ProtoMessage()    {}
+func (*TextualSignDoc) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6df0a8c931f9f8, []int{0}
+}
+func (m *TextualSignDoc) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TextualSignDoc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TextualSignDoc.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TextualSignDoc) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TextualSignDoc.Merge(m, src)
+}
+func (m *TextualSignDoc) XXX_Size() int {
+	return m.Size()
+}
+func (m *TextualSignDoc) XXX_DiscardUnknown() {
+	xxx_messageInfo_TextualSignDoc.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TextualSignDoc proto.InternalMessageInfo
+
+func (m *TextualSignDoc) GetScreens() []*TextualScreen {
+	if m != nil {
+		return m.Screens
+	}
+	return nil
+}
+
+// TextualScreen is a line of the textual representation of a transaction,
+// rendered as "title: content", or only its title or content if the other is
+// empty.
+type TextualScreen struct {
+	Title   string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// indent is the nesting level of the screen, e.g. 1 for the fields of a
+	// message.
+	Indent uint32 `protobuf:"varint,3,opt,name=indent,proto3" json:"indent,omitempty"`
+	// expert marks the screens that signing devices may only show in their
+	// expert mode, e.g. the gas limit.
+	Expert bool `protobuf:"varint,4,opt,name=expert,proto3" json:"expert,omitempty"`
+}
+
+func (m *TextualScreen) Reset()         { *m = TextualScreen{} }
+func (m *TextualScreen) String() string { return proto.CompactTextString(m) }
+func (*TextualScreen) ProtoMessage()    {}
+func (*TextualScreen) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6df0a8c931f9f8, []int{1}
+}
+func (m *TextualScreen) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TextualScreen) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TextualScreen.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TextualScreen) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TextualScreen.Merge(m, src)
+}
+func (m *TextualScreen) XXX_Size() int {
+	return m.Size()
+}
+func (m *TextualScreen) XXX_DiscardUnknown() {
+	xxx_messageInfo_TextualScreen.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TextualScreen proto.InternalMessageInfo
+
+func (m *TextualScreen) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *TextualScreen) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+func (m *TextualScreen) GetIndent() uint32 {
+	if m != nil {
+		return m.Indent
+	}
+	return 0
+}
+
+func (m *TextualScreen) GetExpert() bool {
+	if m != nil {
+		return m.Expert
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*TextualSignDoc)(nil), "cosmos.tx.signing.v1beta1.TextualSignDoc")
+	proto.RegisterType((*TextualScreen)(nil), "cosmos.tx.signing.v1beta1.TextualScreen")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/tx/signing/v1beta1/textual.proto", fileDescriptor_5c6df0a8c931f9f8)
+}
+
+var fileDescriptor_5c6df0a8c931f9f8 = []byte{
+	// 253 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xb1, 0x4e, 0xf3, 0x30,
+	0x14, 0x85, 0xe3, 0xbf, 0x3f, 0x2d, 0x18, 0x95, 0xc1, 0x42, 0xc8, 0x2c, 0x56, 0xd4, 0x05, 0x2f,
+	0xb5, 0x55, 0x78, 0x83, 0x0a, 0x89, 0x3d, 0x74, 0x62, 0x6b, 0xdc, 0xab, 0x60, 0xd1, 0xda, 0x51,
+	0x7c, 0x8b, 0xc2, 0x5b, 0xf0, 0x58, 0x8c, 0x1d, 0x19, 0x51, 0xf2, 0x22, 0xa8, 0x89, 0x23, 0xc1,
+	0xc0, 0x74, 0x75, 0xee, 0xf9, 0xbe, 0xe5, 0xd0, 0x1b, 0xe3, 0xc3, 0xce, 0x07, 0x8d, 0xb5, 0x0e,
+	0xb6, 0x70, 0xd6, 0x15, 0xfa, 0x75, 0x91, 0x03, 0xae, 0x17, 0x1a, 0xa1, 0xc6, 0xfd, 0x7a, 0xab,
+	0xca, 0xca, 0xa3, 0x67, 0xd7, 0x3d, 0xa8, 0xb0, 0x56, 0x11, 0x54, 0x11, 0x9c, 0xad, 0xe8, 0xc5,
+	0xaa, 0x67, 0x1f, 0x6d, 0xe1, 0xee, 0xbd, 0x61, 0x4b, 0x3a, 0x09, 0xa6, 0x02, 0x70, 0x81, 0x93,
+	0x74, 0x24, 0xcf, 0x6f, 0xa5, 0xfa, 0x53, 0x57, 0x83, 0xdb, 0x09, 0xd9, 0x20, 0xce, 0x3c, 0x9d,
+	0xfe, 0x6a, 0xd8, 0x25, 0x3d, 0x41, 0x8b, 0x5b, 0xe0, 0x24, 0x25, 0xf2, 0x2c, 0xeb, 0x03, 0xe3,
+	0x74, 0x62, 0xbc, 0x43, 0x70, 0xc8, 0xff, 0x75, 0xff, 0x21, 0xb2, 0x2b, 0x3a, 0xb6, 0x6e, 0x73,
+	0x2c, 0x46, 0x29, 0x91, 0xd3, 0x2c, 0xa6, 0xe3, 0x1f, 0xea, 0x12, 0x2a, 0xe4, 0xff, 0x53, 0x22,
+	0x4f, 0xb3, 0x98, 0x96, 0x0f, 0x1f, 0x8d, 0x20, 0x87, 0x46, 0x90, 0xaf, 0x46, 0x90, 0xf7, 0x56,
+	0x24, 0x87, 0x56, 0x24, 0x9f, 0xad, 0x48, 0x9e, 0xe6, 0x85, 0xc5, 0xe7, 0x7d, 0xae, 0x8c, 0xdf,
+	0xe9, 0xb8, 0x57, 0x7f, 0xe6, 0x61, 0xf3, 0xa2, 0xf1, 0xad, 0x84, 0x9f, 0x03, 0xe6, 0xe3, 0x6e,
+	0xb1, 0xbb, 0xef, 0x01, 0x00, 0xbf, 0xfd, 0xeb, 0x32, 0x5c, 0x01, 0x00, 0x00,
+}
+
+func (m *TextualSignDoc) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TextualSignDoc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TextualSignDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Screens) > 0 {
+		for iNdEx := len(m.Screens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Screens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTextual(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TextualScreen) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TextualScreen) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TextualScreen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expert {
+		i--
+		if m.Expert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Indent != 0 {
+		i = encodeVarintTextual(dAtA, i, uint64(m.Indent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintTextual(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTextual(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTextual(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTextual(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TextualSignDoc) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Screens) > 0 {
+		for _, e := range m.Screens {
+			l = e.Size()
+			n += 1 + l + sovTextual(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TextualScreen) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTextual(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovTextual(uint64(l))
+	}
+	if m.Indent != 0 {
+		n += 1 + sovTextual(uint64(m.Indent))
+	}
+	if m.Expert {
+		n += 2
+	}
+	return n
+}
+
+func sovTextual(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTextual(x uint64) (n int) {
+	return sovTextual(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TextualSignDoc) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTextual
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TextualSignDoc: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TextualSignDoc: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Screens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTextual
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTextual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Screens = append(m.Screens, &TextualScreen{})
+			if err := m.Screens[len(m.Screens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTextual(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTextual
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TextualScreen) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTextual
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TextualScreen: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TextualScreen: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTextual
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTextual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTextual
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTextual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indent", wireType)
+			}
+			m.Indent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Indent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expert = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTextual(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTextual
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTextual(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTextual
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTextual
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTextual
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTextual
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTextual        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTextual          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTextual = fmt.Errorf("proto: unexpected end of group")
+)
@@ -12,15 +12,19 @@ import (
 	signing "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -261,6 +265,14 @@ type TxBody struct {
 	// timeout is the block height after which this transaction will not
 	// be processed by the chain
 	TimeoutHeight uint64 `protobuf:"varint,3,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	// timeout_timestamp is the block time after which this transaction will not
+	// be processed by the chain. It is ignored if unset.
+	TimeoutTimestamp *time.Time `protobuf:"bytes,4,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3,stdtime" json:"timeout_timestamp,omitempty"`
+	// unordered, when set, indicates that the transaction is not ordered by the
+	// sequences of its signers, which are neither checked nor incremented. It is
+	// instead protected against replays by its timeout_timestamp, which must then
+	// be set: the hash of the transaction bytes is recorded until it times out.
+	Unordered bool `protobuf:"varint,5,opt,name=unordered,proto3" json:"unordered,omitempty"`
 	// extension_options are arbitrary options that can be added by chains
 	// when the default options are not sufficient. If any of these are present
 	// and can't be handled, the transaction will be rejected
@@ -325,6 +337,20 @@ func (m *TxBody) GetTimeoutHeight() uint64 {
 	return 0
 }
 
+func (m *TxBody) GetTimeoutTimestamp() *time.Time {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return nil
+}
+
+func (m *TxBody) GetUnordered() bool {
+	if m != nil {
+		return m.Unordered
+	}
+	return false
+}
+
 func (m *TxBody) GetExtensionOptions() []*types.Any {
 	if m != nil {
 		return m.ExtensionOptions
@@ -760,60 +786,64 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/tx.proto", fileDescriptor_96d1575ffde80842) }
 
 var fileDescriptor_96d1575ffde80842 = []byte{
-	// 843 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xdd, 0x6e, 0xdc, 0x44,
-	0x14, 0x5e, 0xef, 0x5f, 0xd6, 0x27, 0x49, 0x4b, 0x47, 0x11, 0xda, 0x6c, 0x54, 0x37, 0x18, 0x15,
-	0xf6, 0x26, 0x76, 0x9b, 0x5e, 0xf0, 0x23, 0x24, 0xc8, 0x16, 0xaa, 0x54, 0xa5, 0x20, 0x4d, 0x72,
-	0xd5, 0x1b, 0x6b, 0xec, 0x9d, 0x78, 0x47, 0x5d, 0xcf, 0x2c, 0x9e, 0x71, 0xb1, 0x1f, 0x02, 0xa9,
-	0x42, 0x42, 0xbc, 0x03, 0x2f, 0xc0, 0x2b, 0xf4, 0xb2, 0x97, 0x5c, 0x41, 0x95, 0x3c, 0x08, 0x68,
-	0xc6, 0x63, 0x27, 0x82, 0x55, 0x72, 0xc3, 0x95, 0xe7, 0x9c, 0xf9, 0xce, 0x37, 0x9f, 0xcf, 0x1f,
-	0x4c, 0x12, 0x21, 0x33, 0x21, 0x43, 0x55, 0x86, 0xaf, 0x1e, 0xc6, 0x54, 0x91, 0x87, 0xa1, 0x2a,
-	0x83, 0x55, 0x2e, 0x94, 0x40, 0x77, 0xea, 0xbb, 0x40, 0x95, 0x81, 0xbd, 0x9b, 0xec, 0xa4, 0x22,
-	0x15, 0xe6, 0x36, 0xd4, 0xa7, 0x1a, 0x38, 0x39, 0xb0, 0x24, 0x49, 0x5e, 0xad, 0x94, 0x08, 0xb3,
-	0x62, 0xa9, 0x98, 0x64, 0x69, 0xcb, 0xd8, 0x38, 0x2c, 0xdc, 0xb3, 0xf0, 0x98, 0x48, 0xda, 0x62,
-	0x12, 0xc1, 0xb8, 0xbd, 0xff, 0xf8, 0x52, 0x93, 0x64, 0x29, 0x67, 0xfc, 0x92, 0xc9, 0xda, 0x16,
-	0xb8, 0x9b, 0x0a, 0x91, 0x2e, 0x69, 0x68, 0xac, 0xb8, 0x38, 0x0b, 0x09, 0xaf, 0xea, 0x2b, 0xff,
-	0x27, 0x07, 0xba, 0xa7, 0x25, 0x3a, 0x80, 0x7e, 0x2c, 0xe6, 0xd5, 0xd8, 0xd9, 0x77, 0xa6, 0x9b,
-	0x87, 0xbb, 0xc1, 0x7f, 0xfe, 0x28, 0x38, 0x2d, 0x67, 0x62, 0x5e, 0x61, 0x03, 0x43, 0x9f, 0x82,
-	0x4b, 0x0a, 0xb5, 0x88, 0x18, 0x3f, 0x13, 0xe3, 0xae, 0x89, 0xd9, 0x5b, 0x13, 0x73, 0x54, 0xa8,
-	0xc5, 0x53, 0x7e, 0x26, 0xf0, 0x88, 0xd8, 0x13, 0xf2, 0x00, 0xb4, 0x36, 0xa2, 0x8a, 0x9c, 0xca,
-	0x71, 0x6f, 0xbf, 0x37, 0xdd, 0xc2, 0x57, 0x3c, 0x3e, 0x87, 0xc1, 0x69, 0x89, 0xc9, 0x8f, 0xe8,
-	0x2e, 0x80, 0x7e, 0x2a, 0x8a, 0x2b, 0x45, 0xa5, 0xd1, 0xb5, 0x85, 0x5d, 0xed, 0x99, 0x69, 0x07,
-	0xfa, 0x08, 0x6e, 0xb7, 0x0a, 0x2c, 0xa6, 0x6b, 0x30, 0xdb, 0xcd, 0x53, 0x35, 0xee, 0xa6, 0xf7,
-	0x7e, 0x76, 0x60, 0xe3, 0x84, 0xa5, 0xfc, 0x6b, 0x91, 0xfc, 0x5f, 0x4f, 0xee, 0xc2, 0x28, 0x59,
-	0x10, 0xc6, 0x23, 0x36, 0x1f, 0xf7, 0xf6, 0x9d, 0xa9, 0x8b, 0x37, 0x8c, 0xfd, 0x74, 0x8e, 0xee,
-	0xc3, 0x2d, 0x92, 0x24, 0xa2, 0xe0, 0x2a, 0xe2, 0x45, 0x16, 0xd3, 0x7c, 0xdc, 0xdf, 0x77, 0xa6,
-	0x7d, 0xbc, 0x6d, 0xbd, 0xdf, 0x19, 0xa7, 0xff, 0x4b, 0x17, 0x86, 0x75, 0xbe, 0xd1, 0x03, 0x18,
-	0x65, 0x54, 0x4a, 0x92, 0x1a, 0x45, 0xbd, 0xe9, 0xe6, 0xe1, 0x4e, 0x50, 0x57, 0x33, 0x68, 0xaa,
-	0x19, 0x1c, 0xf1, 0x0a, 0xb7, 0x28, 0x84, 0xa0, 0x9f, 0xd1, 0xac, 0x2e, 0x8b, 0x8b, 0xcd, 0x59,
-	0xbf, 0xab, 0x58, 0x46, 0x45, 0xa1, 0xa2, 0x05, 0x65, 0xe9, 0x42, 0x19, 0x61, 0x7d, 0xbc, 0x6d,
-	0xbd, 0xc7, 0xc6, 0x89, 0x66, 0x70, 0x87, 0x96, 0x8a, 0x72, 0xc9, 0x04, 0x8f, 0xc4, 0x4a, 0x31,
-	0xc1, 0xe5, 0xf8, 0xef, 0x8d, 0x6b, 0x9e, 0x7d, 0xaf, 0xc5, 0x7f, 0x5f, 0xc3, 0xd1, 0x0b, 0xf0,
-	0xb8, 0xe0, 0x51, 0x92, 0x33, 0xc5, 0x12, 0xb2, 0x8c, 0xd6, 0x10, 0xde, 0xbe, 0x86, 0x70, 0x8f,
-	0x0b, 0xfe, 0xd8, 0xc6, 0x7e, 0xf3, 0x2f, 0x6e, 0xff, 0x15, 0x8c, 0x9a, 0x96, 0x42, 0x5f, 0xc1,
-	0x96, 0x2e, 0x23, 0xcd, 0x4d, 0x3d, 0x9a, 0xe4, 0xdc, 0x5d, 0xd3, 0x85, 0x27, 0x06, 0x66, 0xfa,
-	0x70, 0x53, 0xb6, 0x67, 0x89, 0xa6, 0xd0, 0x3b, 0xa3, 0xd4, 0xb6, 0xef, 0xfb, 0x6b, 0x02, 0x9f,
-	0x50, 0x8a, 0x35, 0xc4, 0xff, 0xd5, 0x01, 0xb8, 0x64, 0x41, 0x8f, 0x00, 0x56, 0x45, 0xbc, 0x64,
-	0x49, 0xf4, 0x92, 0x36, 0x23, 0xb3, 0xfe, 0x6f, 0xdc, 0x1a, 0xf7, 0x8c, 0x9a, 0x91, 0xc9, 0xc4,
-	0x9c, 0xde, 0x34, 0x32, 0xcf, 0xc5, 0x9c, 0xd6, 0x23, 0x93, 0xd9, 0x13, 0x9a, 0xc0, 0x48, 0xd2,
-	0x1f, 0x0a, 0xca, 0x13, 0x6a, 0xcb, 0xd6, 0xda, 0xfe, 0xbb, 0x2e, 0x8c, 0x9a, 0x10, 0xf4, 0x05,
-	0x0c, 0x25, 0xe3, 0xe9, 0x92, 0x5a, 0x4d, 0xfe, 0x35, 0xfc, 0xc1, 0x89, 0x41, 0x1e, 0x77, 0xb0,
-	0x8d, 0x41, 0x9f, 0xc1, 0xc0, 0xec, 0x1f, 0x2b, 0xee, 0x83, 0xeb, 0x82, 0x9f, 0x6b, 0xe0, 0x71,
-	0x07, 0xd7, 0x11, 0x93, 0x23, 0x18, 0xd6, 0x74, 0xe8, 0x13, 0xe8, 0x6b, 0xdd, 0x46, 0xc0, 0xad,
-	0xc3, 0x0f, 0xaf, 0x70, 0x34, 0x1b, 0xe9, 0x6a, 0x55, 0x34, 0x1f, 0x36, 0x01, 0x93, 0xd7, 0x0e,
-	0x0c, 0x0c, 0x2b, 0x7a, 0x06, 0xa3, 0x98, 0x29, 0x92, 0xe7, 0xa4, 0xc9, 0x6d, 0xd8, 0xd0, 0xd4,
-	0x7b, 0x33, 0x68, 0xd7, 0x64, 0xc3, 0xf5, 0x58, 0x64, 0x2b, 0x92, 0xa8, 0x19, 0x53, 0x47, 0x3a,
-	0x0c, 0xb7, 0x04, 0xe8, 0x73, 0x80, 0x36, 0xeb, 0x7a, 0x5c, 0x7b, 0x37, 0xa5, 0xdd, 0x6d, 0xd2,
-	0x2e, 0x67, 0x03, 0xe8, 0xc9, 0x22, 0xf3, 0x7f, 0x77, 0xa0, 0xf7, 0x84, 0x52, 0x94, 0xc0, 0x90,
-	0x64, 0x7a, 0x48, 0x6d, 0xab, 0xb5, 0x4b, 0x52, 0xaf, 0xe7, 0x2b, 0x52, 0x18, 0x9f, 0x3d, 0x78,
-	0xf3, 0xe7, 0xbd, 0xce, 0x6f, 0x7f, 0xdd, 0x9b, 0xa6, 0x4c, 0x2d, 0x8a, 0x38, 0x48, 0x44, 0x16,
-	0x36, 0xab, 0xdf, 0x7c, 0x0e, 0xe4, 0xfc, 0x65, 0xa8, 0xaa, 0x15, 0x95, 0x26, 0x40, 0x62, 0x4b,
-	0x8d, 0xf6, 0xc0, 0x4d, 0x89, 0x8c, 0x96, 0x2c, 0x63, 0xca, 0x14, 0xa2, 0x8f, 0x47, 0x29, 0x91,
-	0xdf, 0x6a, 0x1b, 0xed, 0xc0, 0x60, 0x45, 0x2a, 0x9a, 0xdb, 0xad, 0x52, 0x1b, 0x68, 0x0c, 0x1b,
-	0x69, 0x4e, 0xb8, 0xb2, 0xcb, 0xc4, 0xc5, 0x8d, 0x39, 0xfb, 0xf2, 0xcd, 0xb9, 0xe7, 0xbc, 0x3d,
-	0xf7, 0x9c, 0x77, 0xe7, 0x9e, 0xf3, 0xfa, 0xc2, 0xeb, 0xbc, 0xbd, 0xf0, 0x3a, 0x7f, 0x5c, 0x78,
-	0x9d, 0x17, 0xf7, 0x6f, 0x16, 0x16, 0xaa, 0x32, 0x1e, 0x9a, 0x66, 0x7e, 0xf4, 0x4f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xd4, 0xb7, 0x75, 0x7d, 0xfd, 0x06, 0x00, 0x00,
+	// 898 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0xdb, 0x36,
+	0x14, 0xb7, 0x22, 0xdb, 0x91, 0x5e, 0x92, 0xb6, 0x21, 0x82, 0xc1, 0x71, 0x56, 0xc5, 0xf3, 0xd0,
+	0xcd, 0x97, 0x48, 0x6d, 0x7a, 0xd8, 0x1f, 0x0c, 0xd8, 0xe2, 0x6e, 0x45, 0x8a, 0x2e, 0x1b, 0xc0,
+	0xe4, 0xd4, 0x8b, 0x40, 0x49, 0x8c, 0x4c, 0xd4, 0x22, 0x3d, 0x91, 0xea, 0xec, 0xeb, 0xee, 0x03,
+	0x82, 0x5d, 0xf6, 0x1d, 0xf6, 0x05, 0xf6, 0x15, 0x7a, 0xec, 0x71, 0xa7, 0xb5, 0x48, 0x3e, 0xc8,
+	0x06, 0x51, 0x94, 0x12, 0x34, 0x46, 0x72, 0xe9, 0xc9, 0x7c, 0x8f, 0xbf, 0xdf, 0x8f, 0x3f, 0xf1,
+	0x3d, 0x3e, 0x43, 0x3f, 0x16, 0x32, 0x13, 0x32, 0x50, 0xf3, 0xe0, 0xd5, 0xa3, 0x88, 0x2a, 0xf2,
+	0x28, 0x50, 0x73, 0x7f, 0x96, 0x0b, 0x25, 0xd0, 0x66, 0xb5, 0xe7, 0xab, 0xb9, 0x6f, 0xf6, 0xfa,
+	0x5b, 0xa9, 0x48, 0x85, 0xde, 0x0d, 0xca, 0x55, 0x05, 0xec, 0xef, 0x19, 0x91, 0x38, 0x5f, 0xcc,
+	0x94, 0x08, 0xb2, 0x62, 0xaa, 0x98, 0x64, 0x69, 0xa3, 0x58, 0x27, 0x0c, 0xdc, 0x33, 0xf0, 0x88,
+	0x48, 0xda, 0x60, 0x62, 0xc1, 0xb8, 0xd9, 0xff, 0xfc, 0xd2, 0x93, 0x64, 0x29, 0x67, 0xfc, 0x52,
+	0xc9, 0xc4, 0x06, 0xb8, 0x9d, 0x0a, 0x91, 0x4e, 0x69, 0xa0, 0xa3, 0xa8, 0x38, 0x0d, 0x08, 0x5f,
+	0x98, 0xad, 0xdd, 0xf7, 0xb7, 0x14, 0xcb, 0xa8, 0x54, 0x24, 0x9b, 0x55, 0x80, 0xe1, 0xef, 0x16,
+	0xac, 0x9c, 0xcc, 0xd1, 0x1e, 0xb4, 0x23, 0x91, 0x2c, 0x7a, 0xd6, 0xc0, 0x1a, 0xad, 0xed, 0x6f,
+	0xfb, 0xd7, 0x3e, 0xd9, 0x3f, 0x99, 0x8f, 0x45, 0xb2, 0xc0, 0x1a, 0x86, 0xbe, 0x04, 0x97, 0x14,
+	0x6a, 0x12, 0x32, 0x7e, 0x2a, 0x7a, 0x2b, 0x9a, 0xb3, 0xb3, 0x84, 0x73, 0x50, 0xa8, 0xc9, 0x33,
+	0x7e, 0x2a, 0xb0, 0x43, 0xcc, 0x0a, 0x79, 0x00, 0xa5, 0x79, 0xa2, 0x8a, 0x9c, 0xca, 0x9e, 0x3d,
+	0xb0, 0x47, 0xeb, 0xf8, 0x4a, 0x66, 0xc8, 0xa1, 0x73, 0x32, 0xc7, 0xe4, 0x57, 0x74, 0x1f, 0xa0,
+	0x3c, 0x2a, 0x8c, 0x16, 0x8a, 0x4a, 0xed, 0x6b, 0x1d, 0xbb, 0x65, 0x66, 0x5c, 0x26, 0xd0, 0x67,
+	0x70, 0xb7, 0x71, 0x60, 0x30, 0x2b, 0x1a, 0xb3, 0x51, 0x1f, 0x55, 0xe1, 0x6e, 0x3b, 0xef, 0x0f,
+	0x0b, 0x56, 0x8f, 0x59, 0xca, 0xbf, 0x17, 0xf1, 0x87, 0x3a, 0x72, 0x1b, 0x9c, 0x78, 0x42, 0x18,
+	0x0f, 0x59, 0xd2, 0xb3, 0x07, 0xd6, 0xc8, 0xc5, 0xab, 0x3a, 0x7e, 0x96, 0xa0, 0x07, 0x70, 0x87,
+	0xc4, 0xb1, 0x28, 0xb8, 0x0a, 0x79, 0x91, 0x45, 0x34, 0xef, 0xb5, 0x07, 0xd6, 0xa8, 0x8d, 0x37,
+	0x4c, 0xf6, 0x27, 0x9d, 0x1c, 0xfe, 0x66, 0x43, 0xb7, 0xba, 0x6f, 0xf4, 0x10, 0x9c, 0x8c, 0x4a,
+	0x49, 0x52, 0xed, 0xc8, 0x1e, 0xad, 0xed, 0x6f, 0xf9, 0x55, 0x4d, 0xfd, 0xba, 0xa6, 0xfe, 0x01,
+	0x5f, 0xe0, 0x06, 0x85, 0x10, 0xb4, 0x33, 0x9a, 0x55, 0x65, 0x71, 0xb1, 0x5e, 0x97, 0xe7, 0x96,
+	0x85, 0x17, 0x85, 0x0a, 0x27, 0x94, 0xa5, 0x13, 0xa5, 0x8d, 0xb5, 0xf1, 0x86, 0xc9, 0x1e, 0xea,
+	0x24, 0x3a, 0x82, 0xcd, 0x1a, 0xd6, 0xf4, 0x89, 0x76, 0xb8, 0xb6, 0xdf, 0xbf, 0x76, 0xea, 0x49,
+	0x8d, 0x18, 0xb7, 0xcf, 0xde, 0xee, 0x5a, 0xf8, 0x9e, 0xa1, 0x36, 0x79, 0xf4, 0x31, 0xb8, 0x05,
+	0x17, 0x79, 0x42, 0x73, 0x9a, 0xf4, 0x3a, 0x03, 0x6b, 0xe4, 0xe0, 0xcb, 0x04, 0x1a, 0xc3, 0x26,
+	0x9d, 0x2b, 0xca, 0x25, 0x13, 0x3c, 0x14, 0x33, 0xc5, 0x04, 0x97, 0xbd, 0xff, 0x56, 0x6f, 0xf8,
+	0xc6, 0x7b, 0x0d, 0xfe, 0xe7, 0x0a, 0x8e, 0x5e, 0x80, 0xc7, 0x05, 0x0f, 0xe3, 0x9c, 0x29, 0x16,
+	0x93, 0x69, 0xb8, 0x44, 0xf0, 0xee, 0x0d, 0x82, 0x3b, 0x5c, 0xf0, 0x27, 0x86, 0xfb, 0xc3, 0x7b,
+	0xda, 0xc3, 0x57, 0xe0, 0xd4, 0xfd, 0x8b, 0xbe, 0x83, 0xf5, 0xb2, 0x67, 0x68, 0xae, 0x8b, 0x5f,
+	0x57, 0xe2, 0xfe, 0x92, 0x96, 0x3f, 0xd6, 0x30, 0xdd, 0xf4, 0x6b, 0xb2, 0x59, 0x4b, 0x34, 0x02,
+	0xfb, 0x94, 0x52, 0xf3, 0x56, 0x3e, 0x5a, 0x42, 0x7c, 0x4a, 0x29, 0x2e, 0x21, 0xc3, 0x3f, 0x2d,
+	0x80, 0x4b, 0x15, 0xf4, 0x18, 0x60, 0x56, 0x44, 0x53, 0x16, 0x87, 0x2f, 0x69, 0xfd, 0x3e, 0x97,
+	0x7f, 0x8d, 0x5b, 0xe1, 0x9e, 0x53, 0xfd, 0x3e, 0x33, 0x91, 0xd0, 0xdb, 0xde, 0xe7, 0x91, 0x48,
+	0x68, 0xf5, 0x3e, 0x33, 0xb3, 0x42, 0x7d, 0x70, 0x24, 0xfd, 0xa5, 0xa0, 0x3c, 0xa6, 0xa6, 0x47,
+	0x9a, 0x78, 0xf8, 0x6e, 0x05, 0x9c, 0x9a, 0x82, 0xbe, 0x81, 0xae, 0x64, 0x3c, 0x9d, 0x52, 0xe3,
+	0x69, 0x78, 0x83, 0xbe, 0x7f, 0xac, 0x91, 0x87, 0x2d, 0x6c, 0x38, 0xe8, 0x2b, 0xe8, 0xe8, 0x69,
+	0x68, 0xcc, 0x7d, 0x72, 0x13, 0xf9, 0xa8, 0x04, 0x1e, 0xb6, 0x70, 0xc5, 0xe8, 0x1f, 0x40, 0xb7,
+	0x92, 0x43, 0x5f, 0x40, 0xbb, 0xf4, 0xad, 0x0d, 0xdc, 0xd9, 0xff, 0xf4, 0x8a, 0x46, 0x3d, 0x1f,
+	0xaf, 0x56, 0xa5, 0xd4, 0xc3, 0x9a, 0xd0, 0x3f, 0xb3, 0xa0, 0xa3, 0x55, 0xd1, 0x73, 0x70, 0x22,
+	0xa6, 0x48, 0x9e, 0x93, 0xfa, 0x6e, 0x83, 0x5a, 0xa6, 0x9a, 0xe2, 0x7e, 0x33, 0xb4, 0x6b, 0xad,
+	0x27, 0x22, 0x9b, 0x91, 0x58, 0x8d, 0x99, 0x3a, 0x28, 0x69, 0xb8, 0x11, 0x40, 0x5f, 0x03, 0x34,
+	0xb7, 0x5e, 0xce, 0x06, 0xfb, 0xb6, 0x6b, 0x77, 0xeb, 0x6b, 0x97, 0xe3, 0x0e, 0xd8, 0xb2, 0xc8,
+	0x86, 0x7f, 0x5b, 0x60, 0x3f, 0xa5, 0x14, 0xc5, 0xd0, 0x25, 0x59, 0x39, 0x11, 0x4c, 0xab, 0x35,
+	0x13, 0xb9, 0xfc, 0xb3, 0xb8, 0x62, 0x85, 0xf1, 0xf1, 0xc3, 0xd7, 0xff, 0xee, 0xb6, 0xfe, 0x7a,
+	0xbb, 0x3b, 0x4a, 0x99, 0x9a, 0x14, 0x91, 0x1f, 0x8b, 0x2c, 0xa8, 0xff, 0x88, 0xf4, 0xcf, 0x9e,
+	0x4c, 0x5e, 0x06, 0x6a, 0x31, 0xa3, 0x52, 0x13, 0x24, 0x36, 0xd2, 0x68, 0x07, 0xdc, 0x94, 0xc8,
+	0x70, 0xca, 0x32, 0xa6, 0x74, 0x21, 0xda, 0xd8, 0x49, 0x89, 0xfc, 0xb1, 0x8c, 0xd1, 0x16, 0x74,
+	0x66, 0x64, 0x41, 0x73, 0x33, 0xc2, 0xaa, 0x00, 0xf5, 0x60, 0x35, 0xcd, 0x09, 0x57, 0x66, 0x72,
+	0xb9, 0xb8, 0x0e, 0xc7, 0xdf, 0xbe, 0x3e, 0xf7, 0xac, 0x37, 0xe7, 0x9e, 0xf5, 0xee, 0xdc, 0xb3,
+	0xce, 0x2e, 0xbc, 0xd6, 0x9b, 0x0b, 0xaf, 0xf5, 0xcf, 0x85, 0xd7, 0x7a, 0xf1, 0xe0, 0x76, 0x63,
+	0x81, 0x9a, 0x47, 0x5d, 0xdd, 0xcc, 0x8f, 0xff, 0x1f, 0x00, 0x16, 0x0c, 0x14, 0x34, 0x8b, 0x07,
+	0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -1019,6 +1049,26 @@ func (m *TxBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xfa
 		}
 	}
+	if m.Unordered {
+		i--
+		if m.Unordered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TimeoutTimestamp != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TimeoutTimestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimeoutTimestamp):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTx(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.TimeoutHeight != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutHeight))
 		i--
@@ -1456,6 +1506,13 @@ func (m *TxBody) Size() (n int) {
 	if m.TimeoutHeight != 0 {
 		n += 1 + sovTx(uint64(m.TimeoutHeight))
 	}
+	if m.TimeoutTimestamp != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimeoutTimestamp)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Unordered {
+		n += 2
+	}
 	if len(m.ExtensionOptions) > 0 {
 		for _, e := range m.ExtensionOptions {
 			l = e.Size()
@@ -2196,6 +2253,62 @@ func (m *TxBody) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimeoutTimestamp == nil {
+				m.TimeoutTimestamp = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.TimeoutTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unordered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unordered = bool(v != 0)
 		case 1023:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionOptions", wireType)
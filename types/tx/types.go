@@ -83,6 +83,13 @@ func (t *Tx) ValidateBasic() error {
 		}
 	}
 
+	if fee.Granter != "" {
+		_, err := sdk.AccAddressFromBech32(fee.Granter)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid fee granter address (%s)", err)
+		}
+	}
+
 	sigs := t.Signatures
 
 	if len(sigs) == 0 {
@@ -116,7 +123,7 @@ func (t *Tx) GetSigners() []sdk.AccAddress {
 	}
 
 	// ensure any specified fee payer is included in the required signers (at the end)
-	feePayer := t.AuthInfo.Fee.Payer
+	feePayer := t.AuthInfo.GetFee().GetPayer()
 	if feePayer != "" && !seen[feePayer] {
 		payerAddr, err := sdk.AccAddressFromBech32(feePayer)
 		if err != nil {
@@ -130,13 +137,13 @@ func (t *Tx) GetSigners() []sdk.AccAddress {
 }
 
 func (t *Tx) GetGas() uint64 {
-	return t.AuthInfo.Fee.GasLimit
+	return t.AuthInfo.GetFee().GetGasLimit()
 }
 func (t *Tx) GetFee() sdk.Coins {
-	return t.AuthInfo.Fee.Amount
+	return t.AuthInfo.GetFee().GetAmount()
 }
 func (t *Tx) FeePayer() sdk.AccAddress {
-	feePayer := t.AuthInfo.Fee.Payer
+	feePayer := t.AuthInfo.GetFee().GetPayer()
 	if feePayer != "" {
 		payerAddr, err := sdk.AccAddressFromBech32(feePayer)
 		if err != nil {
@@ -149,7 +156,7 @@ func (t *Tx) FeePayer() sdk.AccAddress {
 }
 
 func (t *Tx) FeeGranter() sdk.AccAddress {
-	feePayer := t.AuthInfo.Fee.Granter
+	feePayer := t.AuthInfo.GetFee().GetGranter()
 	if feePayer != "" {
 		granterAddr, err := sdk.AccAddressFromBech32(feePayer)
 		if err != nil {
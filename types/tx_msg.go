@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	"github.com/gogo/protobuf/proto"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -67,6 +69,22 @@ type (
 
 		GetTimeoutHeight() uint64
 	}
+
+	// TxWithTimeoutTimestamp extends the Tx interface by allowing a transaction
+	// to set a block time timeout. A zero time means no timeout.
+	TxWithTimeoutTimestamp interface {
+		Tx
+
+		GetTimeoutTimestamp() time.Time
+	}
+
+	// TxWithUnordered extends the Tx interface by allowing a transaction to
+	// opt out of the sequence ordering of its signers.
+	TxWithUnordered interface {
+		TxWithTimeoutTimestamp
+
+		GetUnordered() bool
+	}
 )
 
 // TxDecoder unmarshals transaction bytes
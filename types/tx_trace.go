@@ -0,0 +1,87 @@
+package types
+
+import (
+	stypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// TxTrace is the trace of the execution of a transaction, recorded by the
+// BaseApp when tracing it: the gas used by its AnteHandler and the traces of
+// its Msgs executed, in their order.
+type TxTrace struct {
+	AnteGas Gas
+	Msgs    []MsgTrace
+}
+
+// MsgTrace is the trace of the execution of a Msg: the gas it used net of its
+// refunds, the events it emitted, and the number of reads and writes of the
+// KVStores it made, as metered by its gas meter.
+type MsgTrace struct {
+	GasUsed Gas
+	Events  Events
+	Reads   uint64
+	Writes  uint64
+}
+
+// txTraceKey is the context key of the TxTrace recorded for the transaction
+// being executed.
+type txTraceKey struct{}
+
+// WithTxTrace returns a context recording the trace of the Msgs executed with
+// it into trace.
+func WithTxTrace(ctx Context, trace *TxTrace) Context {
+	return ctx.WithValue(txTraceKey{}, trace)
+}
+
+// StartMsgTrace starts the trace of the next Msg if the transaction is traced,
+// returning a context whose gas meter records the gas and store accesses of
+// the Msg into it. It returns the context unchanged otherwise.
+func StartMsgTrace(ctx Context) Context {
+	trace, ok := ctx.Value(txTraceKey{}).(*TxTrace)
+	if !ok {
+		return ctx
+	}
+
+	trace.Msgs = append(trace.Msgs, MsgTrace{})
+	return ctx.WithGasMeter(&tracingGasMeter{
+		GasMeter: ctx.GasMeter(),
+		trace:    trace,
+		index:    len(trace.Msgs) - 1,
+	})
+}
+
+// RecordMsgEvents records the events emitted by the Msg whose trace was last
+// started, if the transaction is traced.
+func RecordMsgEvents(ctx Context, events Events) {
+	if trace, ok := ctx.Value(txTraceKey{}).(*TxTrace); ok && len(trace.Msgs) > 0 {
+		trace.Msgs[len(trace.Msgs)-1].Events = events
+	}
+}
+
+// tracingGasMeter is a GasMeter recording the gas consumed through it, and the
+// store accesses from their gas descriptors, into the trace of a Msg. The gas
+// is recorded before being consumed, so that the gas consumed by a Msg running
+// out of gas is recorded too.
+type tracingGasMeter struct {
+	GasMeter
+
+	trace *TxTrace
+	index int
+}
+
+func (m *tracingGasMeter) ConsumeGas(amount Gas, descriptor string) {
+	msg := &m.trace.Msgs[m.index]
+	msg.GasUsed += amount
+	switch descriptor {
+	case stypes.GasReadCostFlatDesc, stypes.GasHasDesc, stypes.GasIterNextCostFlatDesc:
+		msg.Reads++
+	case stypes.GasWriteCostFlatDesc, stypes.GasDeleteDesc:
+		msg.Writes++
+	}
+
+	m.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+func (m *tracingGasMeter) RefundGas(amount Gas, descriptor string) {
+	m.GasMeter.RefundGas(amount, descriptor)
+	m.trace.Msgs[m.index].GasUsed -= amount
+}
@@ -0,0 +1,12 @@
+/*
+Package vm defines the integration points between the SDK and the modules
+running smart contracts in a virtual machine, such as x/wasm, so that such a
+module can be added to an application without patching the SDK.
+
+GasConfig converts between SDK gas and the gas units of the virtual machine,
+and bounds the gas of contract queries and simulations. QueryPlugins lets the
+application register the queries contracts can make into the other modules:
+custom queries handled by Go functions, and whitelisted gRPC queries routed
+through a query.Router such as baseapp.QueryRouterService.
+*/
+package vm
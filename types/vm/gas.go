@@ -0,0 +1,69 @@
+package vm
+
+import (
+	"errors"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultGasMultiplier is the default number of VM gas units per SDK gas
+	// unit, the one used by CosmWasm.
+	DefaultGasMultiplier uint64 = 140_000_000
+
+	// DefaultQueryGasLimit is the default maximum SDK gas of a contract query.
+	DefaultQueryGasLimit sdk.Gas = 3_000_000
+)
+
+// GasConfig converts between SDK gas and VM gas, and bounds the gas of the
+// contract executions not metered by a transaction.
+type GasConfig struct {
+	// GasMultiplier is the number of VM gas units per SDK gas unit.
+	GasMultiplier uint64
+	// QueryGasLimit is the maximum SDK gas of a contract query.
+	QueryGasLimit sdk.Gas
+	// SimulationGasLimit is the maximum SDK gas of a simulated transaction
+	// executing contracts, unbounded if zero.
+	SimulationGasLimit sdk.Gas
+}
+
+// DefaultGasConfig returns the default GasConfig.
+func DefaultGasConfig() GasConfig {
+	return GasConfig{
+		GasMultiplier: DefaultGasMultiplier,
+		QueryGasLimit: DefaultQueryGasLimit,
+	}
+}
+
+// Validate returns an error if the GasConfig is invalid.
+func (c GasConfig) Validate() error {
+	if c.GasMultiplier == 0 {
+		return errors.New("gas multiplier must be positive")
+	}
+	if c.QueryGasLimit == 0 {
+		return errors.New("query gas limit must be positive")
+	}
+
+	return nil
+}
+
+// ToVMGas converts SDK gas to VM gas, saturating at the maximum uint64.
+func (c GasConfig) ToVMGas(gas sdk.Gas) uint64 {
+	if gas > math.MaxUint64/c.GasMultiplier {
+		return math.MaxUint64
+	}
+
+	return gas * c.GasMultiplier
+}
+
+// FromVMGas converts VM gas to SDK gas, rounding up so that any VM gas used is
+// charged.
+func (c GasConfig) FromVMGas(vmGas uint64) sdk.Gas {
+	gas := vmGas / c.GasMultiplier
+	if vmGas%c.GasMultiplier != 0 {
+		gas++
+	}
+
+	return gas
+}
@@ -0,0 +1,29 @@
+package vm_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/vm"
+)
+
+func TestGasConfig(t *testing.T) {
+	cfg := vm.DefaultGasConfig()
+	require.NoError(t, cfg.Validate())
+
+	require.Equal(t, uint64(3*vm.DefaultGasMultiplier), cfg.ToVMGas(3))
+	require.Equal(t, uint64(math.MaxUint64), cfg.ToVMGas(math.MaxUint64/2))
+
+	require.Equal(t, uint64(3), cfg.FromVMGas(3*vm.DefaultGasMultiplier))
+	require.Equal(t, uint64(4), cfg.FromVMGas(3*vm.DefaultGasMultiplier+1), "partial gas units are charged")
+	require.Equal(t, uint64(0), cfg.FromVMGas(0))
+
+	cfg.GasMultiplier = 0
+	require.Error(t, cfg.Validate())
+
+	cfg = vm.DefaultGasConfig()
+	cfg.QueryGasLimit = 0
+	require.Error(t, cfg.Validate())
+}
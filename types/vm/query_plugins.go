@@ -0,0 +1,86 @@
+package vm
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// CustomQuerier handles a custom contract query, given and answered in JSON.
+type CustomQuerier func(ctx sdk.Context, request json.RawMessage) ([]byte, error)
+
+// QueryWhitelister whitelists gRPC query methods with a fixed gas cost. It is
+// implemented by baseapp.QueryRouterService.
+type QueryWhitelister interface {
+	query.Router
+
+	WhitelistQuery(method string, gasCost sdk.Gas)
+	IsWhitelisted(method string) bool
+}
+
+// QueryPlugins holds the queries contracts can make into the other modules of
+// the application: custom queries registered by route, and gRPC queries
+// whitelisted on the query router.
+type QueryPlugins struct {
+	router  QueryWhitelister
+	customs map[string]CustomQuerier
+}
+
+// NewQueryPlugins returns QueryPlugins routing gRPC queries through the given
+// router, with no custom queries.
+func NewQueryPlugins(router QueryWhitelister) *QueryPlugins {
+	return &QueryPlugins{
+		router:  router,
+		customs: make(map[string]CustomQuerier),
+	}
+}
+
+// RegisterCustomQuerier registers the handler of the custom queries with the
+// given route.
+//
+// This function PANICS if the route is already registered.
+func (p *QueryPlugins) RegisterCustomQuerier(route string, querier CustomQuerier) {
+	if _, found := p.customs[route]; found {
+		panic(fmt.Errorf("custom query route %s is already registered", route))
+	}
+
+	p.customs[route] = querier
+}
+
+// WhitelistGRPCQuery lets contracts call the gRPC query method with the given
+// fully-qualified name, charging gasCost for every call.
+func (p *QueryPlugins) WhitelistGRPCQuery(method string, gasCost sdk.Gas) {
+	p.router.WhitelistQuery(method, gasCost)
+}
+
+// CustomRoutes returns the registered custom query routes, sorted.
+func (p *QueryPlugins) CustomRoutes() []string {
+	routes := make([]string, 0, len(p.customs))
+	for route := range p.customs {
+		routes = append(routes, route)
+	}
+	sort.Strings(routes)
+
+	return routes
+}
+
+// HandleCustomQuery runs the custom query of the given route.
+func (p *QueryPlugins) HandleCustomQuery(ctx sdk.Context, route string, request json.RawMessage) ([]byte, error) {
+	querier, found := p.customs[route]
+	if !found {
+		return nil, sdkerrors.ErrUnknownRequest.Wrapf("unknown custom query route: %s", route)
+	}
+
+	return querier(ctx, request)
+}
+
+// HandleGRPCQuery runs a whitelisted gRPC query.
+func (p *QueryPlugins) HandleGRPCQuery(ctx sdk.Context, method string, req, res proto.Message) error {
+	return p.router.Query(ctx, method, req, res)
+}
@@ -0,0 +1,56 @@
+package vm_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/vm"
+)
+
+func TestQueryPlugins(t *testing.T) {
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	require.NoError(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+
+	grpcRouter := baseapp.NewGRPCQueryRouter()
+	grpcRouter.SetInterfaceRegistry(types.NewInterfaceRegistry())
+	testdata.RegisterQueryServer(grpcRouter, testdata.QueryImpl{})
+
+	plugins := vm.NewQueryPlugins(baseapp.NewQueryRouterService(grpcRouter))
+
+	// custom queries
+	plugins.RegisterCustomQuerier("echo", func(_ sdk.Context, request json.RawMessage) ([]byte, error) {
+		return request, nil
+	})
+	require.Panics(t, func() {
+		plugins.RegisterCustomQuerier("echo", func(sdk.Context, json.RawMessage) ([]byte, error) { return nil, nil })
+	})
+	require.Equal(t, []string{"echo"}, plugins.CustomRoutes())
+
+	res, err := plugins.HandleCustomQuery(ctx, "echo", json.RawMessage(`{"foo":"bar"}`))
+	require.NoError(t, err)
+	require.Equal(t, `{"foo":"bar"}`, string(res))
+
+	_, err = plugins.HandleCustomQuery(ctx, "unknown", nil)
+	require.ErrorIs(t, err, sdkerrors.ErrUnknownRequest)
+
+	// gRPC queries must be whitelisted
+	var hello testdata.SayHelloResponse
+	err = plugins.HandleGRPCQuery(ctx, "/testdata.Query/SayHello", &testdata.SayHelloRequest{Name: "foo"}, &hello)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	plugins.WhitelistGRPCQuery("/testdata.Query/SayHello", 100)
+	require.NoError(t, plugins.HandleGRPCQuery(ctx, "/testdata.Query/SayHello", &testdata.SayHelloRequest{Name: "foo"}, &hello))
+	require.Equal(t, "Hello foo!", hello.Greeting)
+}
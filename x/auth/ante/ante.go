@@ -1,8 +1,9 @@
 package ante
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -10,49 +11,56 @@ import (
 
 // HandlerOptions are the options required for constructing a default SDK AnteHandler.
 type HandlerOptions struct {
-	AccountKeeper   AccountKeeper
-	BankKeeper      types.BankKeeper
-	FeegrantKeeper  FeegrantKeeper
+	AccountKeeper  AccountKeeper
+	BankKeeper     types.BankKeeper
+	FeegrantKeeper FeegrantKeeper
+	SessionKeeper  SessionKeeper
+	// AuthenticationKeeper provides the custom authenticators of the accounts,
+	// consulted before their pubkeys. If nil, all the accounts are authenticated
+	// by their pubkeys.
+	AuthenticationKeeper AuthenticationKeeper
+	// TxHashKeeper indexes the processed txs for replay protection. If nil, only
+	// the sequences protect against replays, and the unordered txs are rejected.
+	TxHashKeeper TxHashKeeper
+	// BlocklistKeeper provides the addresses blocked from signing txs. If nil,
+	// any address can sign txs.
+	BlocklistKeeper BlocklistKeeper
+	// MaxUnorderedTxTimeout is the maximum duration from the block time to the
+	// timeout timestamps of the unordered txs, DefaultMaxUnorderedTxTimeout if
+	// zero.
+	MaxUnorderedTxTimeout time.Duration
+	// GlobalFeeKeeper provides the chain-wide minimum gas prices, enforced in
+	// DeliverTx too. If nil, only the MinGasPrices param applies.
+	GlobalFeeKeeper GlobalFeeKeeper
+	// FeeMarketKeeper provides the base fee gas price the fees of the txs must
+	// pay, enforced in DeliverTx too. If nil, there is no base fee.
+	FeeMarketKeeper FeeMarketKeeper
+	// FeeConverter converts the fees paid in alternative denoms to the base fee
+	// denom. If nil, the fees are deducted in the denoms they are paid in.
+	FeeConverter    FeeConverter
 	SignModeHandler authsigning.SignModeHandler
 	SigGasConsumer  func(meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error
+	// MemoValidator validates the memos of the txs. If nil, the memos are
+	// validated against the MemoRegex param.
+	MemoValidator MemoValidator
+	// ExtensionOptions handles the extension options of the txs. If nil, the txs
+	// with critical extension options are rejected.
+	ExtensionOptions *ExtensionOptionRegistry
+	// MaxGasWanted is the maximum gas wanted by the txs accepted in CheckTx, and
+	// MaxFeeMultiple the maximum multiple of the fees required by the minimum
+	// gas prices they can pay. Zero disables the limit.
+	MaxGasWanted   uint64
+	MaxFeeMultiple uint64
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
-// signer.
+// signer. Use NewAnteHandlerBuilder to change its decorators.
 func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
-	if options.AccountKeeper == nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
-	}
-
-	if options.BankKeeper == nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "bank keeper is required for ante builder")
-	}
-
-	if options.SignModeHandler == nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
-	}
-
-	var sigGasConsumer = options.SigGasConsumer
-	if sigGasConsumer == nil {
-		sigGasConsumer = DefaultSigVerificationGasConsumer
-	}
-
-	anteDecorators := []sdk.AnteDecorator{
-		NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
-		NewRejectExtensionOptionsDecorator(),
-		NewMempoolFeeDecorator(),
-		NewValidateBasicDecorator(),
-		NewTxTimeoutHeightDecorator(),
-		NewValidateMemoDecorator(options.AccountKeeper),
-		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
-		NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
-		NewValidateSigCountDecorator(options.AccountKeeper),
-		NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
-		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
-		NewIncrementSequenceDecorator(options.AccountKeeper),
+	builder, err := NewAnteHandlerBuilder(options)
+	if err != nil {
+		return nil, err
 	}
 
-	return sdk.ChainAnteDecorators(anteDecorators...), nil
+	return builder.Build(), nil
 }
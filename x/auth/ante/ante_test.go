@@ -926,9 +926,9 @@ func expectedGasCostByKeys(pubkeys []cryptotypes.PubKey) uint64 {
 		pubkeyType := strings.ToLower(fmt.Sprintf("%T", pubkey))
 		switch {
 		case strings.Contains(pubkeyType, "ed25519"):
-			cost += types.DefaultParams().SigVerifyCostED25519
+			cost += types.DefaultSigVerifyCostED25519
 		case strings.Contains(pubkeyType, "secp256k1"):
-			cost += types.DefaultParams().SigVerifyCostSecp256k1
+			cost += types.DefaultSigVerifyCostSecp256k1
 		default:
 			panic("unexpected key type")
 		}
@@ -950,6 +950,8 @@ func TestCountSubkeys(t *testing.T) {
 	multiLevelSubKey2 := kmultisig.NewLegacyAminoPubKey(4, genPubKeys(5))
 	multiLevelMultiKey := kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{
 		multiLevelSubKey1, multiLevelSubKey2, secp256k1.GenPrivKey().PubKey()})
+	weightedMultiKey := kmultisig.NewWeightedMultisigPubKey(3, []cryptotypes.PubKey{
+		singleLevelMultiKey, secp256k1.GenPrivKey().PubKey()}, []uint32{2, 1})
 	type args struct {
 		pub cryptotypes.PubKey
 	}
@@ -961,6 +963,7 @@ func TestCountSubkeys(t *testing.T) {
 		{"single key", args{singleKey}, 1},
 		{"single level multikey", args{singleLevelMultiKey}, 5},
 		{"multi level multikey", args{multiLevelMultiKey}, 11},
+		{"weighted multikey", args{weightedMultiKey}, 6},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(T *testing.T) {
@@ -1019,7 +1022,8 @@ func (suite *AnteTestSuite) TestCustomSignatureVerificationGasConsumer() {
 			SigGasConsumer: func(meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error {
 				switch pubkey := sig.PubKey.(type) {
 				case *ed25519.PubKey:
-					meter.ConsumeGas(params.SigVerifyCostED25519, "ante verify: ed25519")
+					cost, _ := params.SigVerifyCost(types.PubKeyTypeURLEd25519)
+					meter.ConsumeGas(cost, "ante verify: ed25519")
 					return nil
 				default:
 					return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "unrecognized public key type: %T", pubkey)
@@ -1112,9 +1116,14 @@ func (suite *AnteTestSuite) TestAnteHandlerReCheck() {
 		name   string
 		params types.Params
 	}{
-		{"memo size check", types.NewParams(1, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultSigVerifyCostSm2)},
-		{"txsize check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 10000000, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultSigVerifyCostSm2)},
-		{"sig verify cost check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, 100000000, types.DefaultSigVerifyCostSm2)},
+		{"memo size check", types.NewParams(1, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCosts(), types.DefaultMemoRegex, types.DefaultPubKeyChangeCost, types.DefaultMinGasPrices())},
+		{"txsize check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 10000000, types.DefaultSigVerifyCosts(), types.DefaultMemoRegex, types.DefaultPubKeyChangeCost, types.DefaultMinGasPrices())},
+		{"sig verify cost check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, []types.SigVerifyCost{
+			types.NewSigVerifyCost(types.PubKeyTypeURLEd25519, types.DefaultSigVerifyCostED25519),
+			types.NewSigVerifyCost(types.PubKeyTypeURLSecp256k1, 100000000),
+			types.NewSigVerifyCost(types.PubKeyTypeURLSecp256r1, types.DefaultSigVerifyCostSecp256r1),
+			types.NewSigVerifyCost(types.PubKeyTypeURLSm2, types.DefaultSigVerifyCostSm2),
+		}, types.DefaultMemoRegex, types.DefaultPubKeyChangeCost, types.DefaultMinGasPrices())},
 	}
 	for _, tc := range testCases {
 		// set testcase parameters
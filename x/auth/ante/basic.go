@@ -2,8 +2,8 @@ package ante
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
-	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -35,15 +35,25 @@ func (vbd ValidateBasicDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulat
 }
 
 // ValidateMemoDecorator will validate memo given the parameters passed in
-// If memo is too large decorator returns with error, otherwise call next AnteHandler
+// If memo is too large or rejected by the memo validator decorator returns with
+// error, otherwise call next AnteHandler
 // CONTRACT: Tx must implement TxWithMemo interface
 type ValidateMemoDecorator struct {
-	ak AccountKeeper
+	ak        AccountKeeper
+	validator MemoValidator
 }
 
-func NewValidateMemoDecorator(ak AccountKeeper) ValidateMemoDecorator {
+// NewValidateMemoDecorator returns a ValidateMemoDecorator validating the memos
+// with the given validator, after checking their length. If the validator is
+// nil, the memos are validated against the MemoRegex param.
+func NewValidateMemoDecorator(ak AccountKeeper, validator MemoValidator) ValidateMemoDecorator {
+	if validator == nil {
+		validator = NewRegexMemoValidator()
+	}
+
 	return ValidateMemoDecorator{
-		ak: ak,
+		ak:        ak,
+		validator: validator,
 	}
 }
 
@@ -55,7 +65,8 @@ func (vmd ValidateMemoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 
 	params := vmd.ak.GetParams(ctx)
 
-	memoLength := len(memoTx.GetMemo())
+	memo := memoTx.GetMemo()
+	memoLength := len(memo)
 	if uint64(memoLength) > params.MaxMemoCharacters {
 		return ctx, sdkerrors.Wrapf(sdkerrors.ErrMemoTooLarge,
 			"maximum number of characters is %d but received %d characters",
@@ -63,6 +74,10 @@ func (vmd ValidateMemoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 		)
 	}
 
+	if err := vmd.validator.ValidateMemo(ctx, memo, params); err != nil {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidMemo, err.Error())
+	}
+
 	return next(ctx, tx, simulate)
 }
 
@@ -131,7 +146,7 @@ func (cgts ConsumeTxSizeGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 
 			// If the pubkey is a multi-signature pubkey, then we estimate for the maximum
 			// number of signers.
-			if _, ok := pubkey.(*multisig.LegacyAminoPubKey); ok {
+			if _, ok := pubkey.(multisig.PubKey); ok {
 				cost *= params.TxSigLimit
 			}
 
@@ -188,7 +203,9 @@ func NewTxTimeoutHeightDecorator() TxTimeoutHeightDecorator {
 // AnteHandle implements an AnteHandler decorator for the TxHeightTimeoutDecorator
 // type where the current block height is checked against the tx's height timeout.
 // If a height timeout is provided (non-zero) and is less than the current block
-// height, then an error is returned.
+// height, then an error is returned. Likewise, if the tx implements
+// sdk.TxWithTimeoutTimestamp and provides a timeout timestamp (non-zero) which is
+// before the current block time, then an error is returned.
 func (txh TxTimeoutHeightDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	timeoutTx, ok := tx.(TxWithTimeoutHeight)
 	if !ok {
@@ -202,5 +219,14 @@ func (txh TxTimeoutHeightDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		)
 	}
 
+	if timestampTx, ok := tx.(sdk.TxWithTimeoutTimestamp); ok {
+		timeoutTimestamp := timestampTx.GetTimeoutTimestamp()
+		if !timeoutTimestamp.IsZero() && ctx.BlockTime().After(timeoutTimestamp) {
+			return ctx, sdkerrors.Wrapf(
+				sdkerrors.ErrTxTimeoutTimestamp, "block time: %s, timeout timestamp: %s", ctx.BlockTime(), timeoutTimestamp,
+			)
+		}
+	}
+
 	return next(ctx, tx, simulate)
 }
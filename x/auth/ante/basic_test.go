@@ -2,11 +2,13 @@ package ante_test
 
 import (
 	"strings"
+	"time"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 )
@@ -73,7 +75,7 @@ func (suite *AnteTestSuite) TestValidateMemo() {
 	suite.Require().NoError(err)
 
 	// require that long memos get rejected
-	vmd := ante.NewValidateMemoDecorator(suite.app.AccountKeeper)
+	vmd := ante.NewValidateMemoDecorator(suite.app.AccountKeeper, nil)
 	antehandler := sdk.ChainAnteDecorators(vmd)
 	_, err = antehandler(suite.ctx, invalidTx, false)
 
@@ -222,3 +224,55 @@ func (suite *AnteTestSuite) TestTxHeightTimeoutDecorator() {
 		})
 	}
 }
+
+func (suite *AnteTestSuite) TestTxTimestampTimeoutDecorator() {
+	suite.SetupTest(true)
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewTxTimeoutHeightDecorator())
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(addr1)
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+
+	blockTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		timeout   time.Time
+		expectErr bool
+	}{
+		{"default value", time.Time{}, false},
+		{"no timeout (later time)", blockTime.Add(time.Second), false},
+		{"no timeout (same time)", blockTime, false},
+		{"timeout (earlier time)", blockTime.Add(-time.Second), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+			suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+
+			suite.txBuilder.SetFeeAmount(feeAmount)
+			suite.txBuilder.SetGasLimit(gasLimit)
+			suite.txBuilder.SetTimeoutTimestamp(tc.timeout)
+
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+			tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+			suite.Require().NoError(err)
+
+			ctx := suite.ctx.WithBlockTime(blockTime)
+			_, err = antehandler(ctx, tx, true)
+			suite.Require().Equal(tc.expectErr, err != nil, err)
+			if tc.expectErr {
+				suite.Require().ErrorIs(err, sdkerrors.ErrTxTimeoutTimestamp)
+			}
+		})
+	}
+}
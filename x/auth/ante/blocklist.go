@@ -0,0 +1,35 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// BlocklistDecorator rejects the txs signed by an address of the blocklist of
+// the BlocklistKeeper, before their fees are deducted.
+type BlocklistDecorator struct {
+	blocklistKeeper BlocklistKeeper
+}
+
+func NewBlocklistDecorator(blocklistKeeper BlocklistKeeper) BlocklistDecorator {
+	return BlocklistDecorator{
+		blocklistKeeper: blocklistKeeper,
+	}
+}
+
+func (bd BlocklistDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	for _, signer := range sigTx.GetSigners() {
+		if bd.blocklistKeeper.IsBlocked(ctx, signer) {
+			return ctx, sdkerrors.Wrapf(types.ErrBlockedAddress, "signer %s is blocked", signer)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,37 @@
+package ante_test
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *AnteTestSuite) TestBlocklistDecorator() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	antehandler := sdk.ChainAnteDecorators(ante.NewBlocklistDecorator(suite.app.AccountKeeper))
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	priv2, _, addr2 := testdata.KeyTestPubAddr()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1, addr2)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1, priv2}, []uint64{0, 1}, []uint64{0, 0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+
+	// a tx is rejected if any of its signers is blocked
+	suite.app.AccountKeeper.BlockAddress(suite.ctx, addr2)
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().ErrorIs(err, types.ErrBlockedAddress)
+
+	suite.app.AccountKeeper.UnblockAddress(suite.ctx, addr2)
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+}
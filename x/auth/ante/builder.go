@@ -0,0 +1,192 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Names of the decorators of the default AnteHandler, in their order.
+const (
+	DecoratorSetUpContext     = "setup-context"
+	DecoratorExtensionOptions = "extension-options"
+	DecoratorMinGasPrice      = "min-gas-price"
+	DecoratorGlobalFee        = "global-fee"
+	DecoratorMempoolLimits    = "mempool-limits"
+	DecoratorValidateBasic    = "validate-basic"
+	DecoratorBlocklist        = "blocklist"
+	DecoratorTxReplay         = "tx-replay"
+	DecoratorTimeoutHeight    = "timeout-height"
+	DecoratorUnorderedTx      = "unordered-tx"
+	DecoratorValidateMemo     = "validate-memo"
+	DecoratorConsumeTxSizeGas = "consume-tx-size-gas"
+	DecoratorDeductFee        = "deduct-fee"
+	DecoratorSetPubKey        = "set-pubkey"
+	DecoratorValidateSigCount = "validate-sig-count"
+	DecoratorSigGasConsume    = "sig-gas-consume"
+	DecoratorSigVerification  = "sigverify"
+	DecoratorIncrementSeq     = "increment-sequence"
+)
+
+// NamedDecorator is an AnteDecorator of an AnteHandlerBuilder, identified by
+// its name.
+type NamedDecorator struct {
+	Name      string
+	Decorator sdk.AnteDecorator
+}
+
+// AnteHandlerBuilder builds an AnteHandler from a chain of named decorators,
+// starting from the ones of the default AnteHandler, so that apps can insert,
+// replace or remove some of them without constructing the whole chain.
+type AnteHandlerBuilder struct {
+	decorators []NamedDecorator
+}
+
+// NewAnteHandlerBuilder returns an AnteHandlerBuilder with the decorators of
+// the default AnteHandler for the given options.
+func NewAnteHandlerBuilder(options HandlerOptions) (*AnteHandlerBuilder, error) {
+	if options.AccountKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
+	}
+
+	if options.BankKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "bank keeper is required for ante builder")
+	}
+
+	if options.SignModeHandler == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
+	}
+
+	var sigGasConsumer = options.SigGasConsumer
+	if sigGasConsumer == nil {
+		sigGasConsumer = DefaultSigVerificationGasConsumer
+	}
+
+	var extensionOptionsDecorator sdk.AnteDecorator = NewRejectExtensionOptionsDecorator()
+	if options.ExtensionOptions != nil {
+		extensionOptionsDecorator = NewExtensionOptionsDecorator(options.ExtensionOptions)
+	}
+
+	b := &AnteHandlerBuilder{}
+	b.add(DecoratorSetUpContext, NewSetUpContextDecorator()) // outermost AnteDecorator. SetUpContext must be called first
+	b.add(DecoratorExtensionOptions, extensionOptionsDecorator)
+	b.add(DecoratorMinGasPrice, NewMinGasPriceDecorator(options.AccountKeeper))
+	if options.GlobalFeeKeeper != nil {
+		b.add(DecoratorGlobalFee, NewGlobalFeeDecorator(options.GlobalFeeKeeper))
+	}
+	b.add(DecoratorMempoolLimits, NewMempoolLimitsDecorator(options.AccountKeeper, options.MaxGasWanted, options.MaxFeeMultiple))
+	b.add(DecoratorValidateBasic, NewValidateBasicDecorator())
+	if options.BlocklistKeeper != nil {
+		b.add(DecoratorBlocklist, NewBlocklistDecorator(options.BlocklistKeeper))
+	}
+	if options.TxHashKeeper != nil {
+		b.add(DecoratorTxReplay, NewTxReplayDecorator(options.TxHashKeeper))
+	}
+	b.add(DecoratorTimeoutHeight, NewTxTimeoutHeightDecorator())
+	b.add(DecoratorUnorderedTx, NewUnorderedTxDecorator(options.TxHashKeeper, options.MaxUnorderedTxTimeout))
+	b.add(DecoratorValidateMemo, NewValidateMemoDecorator(options.AccountKeeper, options.MemoValidator))
+	b.add(DecoratorConsumeTxSizeGas, NewConsumeGasForTxSizeDecorator(options.AccountKeeper))
+	b.add(DecoratorDeductFee, NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.FeeMarketKeeper, options.FeeConverter))
+	b.add(DecoratorSetPubKey, NewSetPubKeyDecorator(options.AccountKeeper, options.SessionKeeper, options.AuthenticationKeeper)) // SetPubKeyDecorator must be called before all signature verification decorators
+	b.add(DecoratorValidateSigCount, NewValidateSigCountDecorator(options.AccountKeeper))
+	b.add(DecoratorSigGasConsume, NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer, options.AuthenticationKeeper))
+	b.add(DecoratorSigVerification, NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SessionKeeper, options.AuthenticationKeeper))
+	b.add(DecoratorIncrementSeq, NewIncrementSequenceDecorator(options.AccountKeeper))
+
+	return b, nil
+}
+
+func (b *AnteHandlerBuilder) add(name string, decorator sdk.AnteDecorator) {
+	b.decorators = append(b.decorators, NamedDecorator{Name: name, Decorator: decorator})
+}
+
+// index returns the position of the decorator with the given name, or an error
+// if there is none.
+func (b *AnteHandlerBuilder) index(name string) (int, error) {
+	for i, d := range b.decorators {
+		if d.Name == name {
+			return i, nil
+		}
+	}
+
+	return -1, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "ante decorator %s", name)
+}
+
+func (b *AnteHandlerBuilder) insert(i int, decorator NamedDecorator) error {
+	if decorator.Name == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty ante decorator name")
+	}
+	if _, err := b.index(decorator.Name); err == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrConflict, "ante decorator %s already exists", decorator.Name)
+	}
+
+	b.decorators = append(b.decorators[:i], append([]NamedDecorator{decorator}, b.decorators[i:]...)...)
+	return nil
+}
+
+// Append adds the decorator at the end of the chain, as the innermost one.
+func (b *AnteHandlerBuilder) Append(decorator NamedDecorator) error {
+	return b.insert(len(b.decorators), decorator)
+}
+
+// InsertBefore inserts the decorator right before the one with the given name.
+func (b *AnteHandlerBuilder) InsertBefore(name string, decorator NamedDecorator) error {
+	i, err := b.index(name)
+	if err != nil {
+		return err
+	}
+
+	return b.insert(i, decorator)
+}
+
+// InsertAfter inserts the decorator right after the one with the given name.
+func (b *AnteHandlerBuilder) InsertAfter(name string, decorator NamedDecorator) error {
+	i, err := b.index(name)
+	if err != nil {
+		return err
+	}
+
+	return b.insert(i+1, decorator)
+}
+
+// Replace replaces the decorator with the given name, keeping its name and
+// position.
+func (b *AnteHandlerBuilder) Replace(name string, decorator sdk.AnteDecorator) error {
+	i, err := b.index(name)
+	if err != nil {
+		return err
+	}
+
+	b.decorators[i].Decorator = decorator
+	return nil
+}
+
+// Remove removes the decorator with the given name.
+func (b *AnteHandlerBuilder) Remove(name string) error {
+	i, err := b.index(name)
+	if err != nil {
+		return err
+	}
+
+	b.decorators = append(b.decorators[:i], b.decorators[i+1:]...)
+	return nil
+}
+
+// Names returns the names of the decorators of the chain, in their order.
+func (b *AnteHandlerBuilder) Names() []string {
+	names := make([]string, len(b.decorators))
+	for i, d := range b.decorators {
+		names[i] = d.Name
+	}
+
+	return names
+}
+
+// Build returns the AnteHandler chaining the decorators.
+func (b *AnteHandlerBuilder) Build() sdk.AnteHandler {
+	decorators := make([]sdk.AnteDecorator, len(b.decorators))
+	for i, d := range b.decorators {
+		decorators[i] = d.Decorator
+	}
+
+	return sdk.ChainAnteDecorators(decorators...)
+}
@@ -0,0 +1,81 @@
+package ante_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// recordingDecorator records its name when it runs.
+type recordingDecorator struct {
+	name  string
+	calls *[]string
+}
+
+func (d recordingDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*d.calls = append(*d.calls, d.name)
+	return next(ctx, tx, simulate)
+}
+
+func (suite *AnteTestSuite) TestAnteHandlerBuilder() {
+	suite.SetupTest(true) // setup
+
+	_, err := ante.NewAnteHandlerBuilder(ante.HandlerOptions{})
+	suite.Require().Error(err)
+
+	builder, err := ante.NewAnteHandlerBuilder(ante.HandlerOptions{
+		AccountKeeper:   suite.app.AccountKeeper,
+		BankKeeper:      suite.app.BankKeeper,
+		SignModeHandler: simapp.MakeTestEncodingConfig().TxConfig.SignModeHandler(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{
+		ante.DecoratorSetUpContext, ante.DecoratorExtensionOptions, ante.DecoratorMinGasPrice,
+		ante.DecoratorMempoolLimits, ante.DecoratorValidateBasic, ante.DecoratorTimeoutHeight, ante.DecoratorUnorderedTx,
+		ante.DecoratorValidateMemo, ante.DecoratorConsumeTxSizeGas, ante.DecoratorDeductFee, ante.DecoratorSetPubKey,
+		ante.DecoratorValidateSigCount, ante.DecoratorSigGasConsume, ante.DecoratorSigVerification, ante.DecoratorIncrementSeq,
+	}, builder.Names())
+
+	// the optional decorators are added when their keepers are set
+	withGlobalFee, err := ante.NewAnteHandlerBuilder(ante.HandlerOptions{
+		AccountKeeper:   suite.app.AccountKeeper,
+		BankKeeper:      suite.app.BankKeeper,
+		GlobalFeeKeeper: suite.app.GlobalFeeKeeper,
+		SignModeHandler: simapp.MakeTestEncodingConfig().TxConfig.SignModeHandler(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{ante.DecoratorMinGasPrice, ante.DecoratorGlobalFee, ante.DecoratorMempoolLimits}, withGlobalFee.Names()[2:5])
+
+	var calls []string
+	decorator := func(name string) ante.NamedDecorator {
+		return ante.NamedDecorator{Name: name, Decorator: recordingDecorator{name: name, calls: &calls}}
+	}
+
+	suite.Require().NoError(builder.Remove(ante.DecoratorSigVerification))
+	suite.Require().NoError(builder.InsertBefore(ante.DecoratorSetUpContext, decorator("first")))
+	suite.Require().NoError(builder.InsertAfter(ante.DecoratorSetUpContext, decorator("second")))
+	suite.Require().NoError(builder.Append(decorator("last")))
+	suite.Require().NoError(builder.Replace(ante.DecoratorExtensionOptions, recordingDecorator{name: "extensions", calls: &calls}))
+
+	names := builder.Names()
+	suite.Require().Equal([]string{"first", ante.DecoratorSetUpContext, "second"}, names[:3])
+	suite.Require().Equal(ante.DecoratorExtensionOptions, names[3])
+	suite.Require().Equal([]string{ante.DecoratorSigGasConsume, ante.DecoratorIncrementSeq, "last"}, names[len(names)-3:])
+	suite.Require().NotContains(names, ante.DecoratorSigVerification)
+
+	err = builder.Remove(ante.DecoratorSigVerification)
+	suite.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+	err = builder.InsertAfter(ante.DecoratorSigVerification, decorator("other"))
+	suite.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+	err = builder.Append(decorator("first"))
+	suite.Require().ErrorIs(err, sdkerrors.ErrConflict)
+	err = builder.Append(ante.NamedDecorator{Decorator: recordingDecorator{calls: &calls}})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// the decorators run in their order until the first failing one
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	_, err = builder.Build()(suite.ctx, suite.txBuilder.GetTx(), false)
+	suite.Require().Error(err)
+	suite.Require().Equal([]string{"first", "second", "extensions"}, calls)
+}
@@ -1,6 +1,9 @@
 package ante
 
 import (
+	"time"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -9,6 +12,7 @@ import (
 // Interface provides support to use non-sdk AccountKeeper for AnteHandler's decorators.
 type AccountKeeper interface {
 	GetParams(ctx sdk.Context) (params types.Params)
+	GetMinGasPrices(ctx sdk.Context) sdk.DecCoins
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) types.AccountI
 	SetAccount(ctx sdk.Context, acc types.AccountI)
 	GetModuleAddress(moduleName string) sdk.AccAddress
@@ -18,3 +22,54 @@ type AccountKeeper interface {
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
+
+// SessionKeeper defines the expected session keeper.
+type SessionKeeper interface {
+	ValidateSessionKey(ctx sdk.Context, granter sdk.AccAddress, pubKey cryptotypes.PubKey, msgs []sdk.Msg) error
+}
+
+// AuthenticationKeeper defines the expected keeper of the custom authenticators
+// of the accounts. GetAuthenticator returns nil if the account is authenticated
+// by its pubkey.
+type AuthenticationKeeper interface {
+	GetAuthenticator(ctx sdk.Context, addr sdk.AccAddress) (types.Authenticator, error)
+}
+
+// TxHashKeeper defines the expected keeper of the index of the processed txs.
+// MarkTxSeen records a tx for the retention window of the keeper, and
+// MarkTxSeenUntil until the given timeout.
+type TxHashKeeper interface {
+	HasSeenTx(ctx sdk.Context, txHash []byte) bool
+	MarkTxSeen(ctx sdk.Context, txHash []byte)
+	MarkTxSeenUntil(ctx sdk.Context, txHash []byte, timeout time.Time)
+}
+
+// BlocklistKeeper defines the expected keeper of the addresses blocked from
+// signing txs.
+type BlocklistKeeper interface {
+	IsBlocked(ctx sdk.Context, addr sdk.AccAddress) bool
+}
+
+// GlobalFeeKeeper defines the expected keeper of the chain-wide minimum gas
+// prices.
+type GlobalFeeKeeper interface {
+	GetMinimumGasPrices(ctx sdk.Context) sdk.DecCoins
+}
+
+// FeeMarketKeeper defines the expected keeper of the base fee gas price of the
+// current block.
+type FeeMarketKeeper interface {
+	GetBaseFee(ctx sdk.Context) sdk.DecCoin
+}
+
+// FeeConverter defines the expected converter of the fees paid in alternative
+// denoms, e.g. IBC tokens, to the base fee denom the fees are collected in.
+type FeeConverter interface {
+	// ConvertedFee returns the base fee denom coin the fee coin converts to,
+	// and false if the fees cannot be paid in its denom or it is the base one.
+	ConvertedFee(ctx sdk.Context, fee sdk.Coin) (sdk.Coin, bool)
+
+	// ConvertFee converts the fee coin of the payer, in an alternative fee
+	// denom, to base fee denom coins of the payer, and returns them.
+	ConvertFee(ctx sdk.Context, payer sdk.AccAddress, fee sdk.Coin) (sdk.Coin, error)
+}
@@ -1,6 +1,11 @@
 package ante
 
 import (
+	"fmt"
+	"reflect"
+
+	"github.com/gogo/protobuf/proto"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -34,3 +39,102 @@ func (r RejectExtensionOptionsDecorator) AnteHandle(ctx types.Context, tx types.
 
 	return next(ctx, tx, simulate)
 }
+
+// ExtensionOptionHandler handles an extension option of a tx, unpacked from its
+// Any. It validates the option and may return a modified Context, e.g. carrying
+// a priority hint, to the following decorators.
+type ExtensionOptionHandler func(ctx types.Context, tx types.Tx, option proto.Message, simulate bool) (types.Context, error)
+
+// ExtensionOptionRegistry registers the handlers of the extension options a
+// chain supports, by type URL.
+type ExtensionOptionRegistry struct {
+	options map[string]extensionOption
+}
+
+type extensionOption struct {
+	typ     reflect.Type
+	handler ExtensionOptionHandler
+}
+
+// NewExtensionOptionRegistry returns an empty ExtensionOptionRegistry.
+func NewExtensionOptionRegistry() *ExtensionOptionRegistry {
+	return &ExtensionOptionRegistry{options: make(map[string]extensionOption)}
+}
+
+// Register registers the handler of the extension options of the type of the
+// given message. It panics if a handler is already registered for the type.
+func (r *ExtensionOptionRegistry) Register(option proto.Message, handler ExtensionOptionHandler) {
+	typeURL := "/" + proto.MessageName(option)
+	if _, ok := r.options[typeURL]; ok {
+		panic(fmt.Sprintf("extension option %s is already registered", typeURL))
+	}
+
+	r.options[typeURL] = extensionOption{typ: reflect.TypeOf(option).Elem(), handler: handler}
+}
+
+// IsRegistered returns true if a handler is registered for the extension
+// options of the given type URL.
+func (r *ExtensionOptionRegistry) IsRegistered(typeURL string) bool {
+	_, ok := r.options[typeURL]
+	return ok
+}
+
+// handle runs the handler of the given extension option, if any, and reports
+// whether one is registered.
+func (r *ExtensionOptionRegistry) handle(ctx types.Context, tx types.Tx, any *codectypes.Any, simulate bool) (types.Context, bool, error) {
+	opt, ok := r.options[any.TypeUrl]
+	if !ok {
+		return ctx, false, nil
+	}
+
+	option := reflect.New(opt.typ).Interface().(proto.Message)
+	if err := proto.Unmarshal(any.Value, option); err != nil {
+		return ctx, true, sdkerrors.Wrapf(sdkerrors.ErrTxDecode, "invalid extension option %s: %s", any.TypeUrl, err)
+	}
+
+	newCtx, err := opt.handler(ctx, tx, option, simulate)
+	return newCtx, true, err
+}
+
+// ExtensionOptionsDecorator is an AnteDecorator that handles the extension
+// options of protobuf transactions with the handlers of a registry, in order.
+// The critical extension options without a registered handler are rejected,
+// while the non-critical ones are ignored.
+type ExtensionOptionsDecorator struct {
+	registry *ExtensionOptionRegistry
+}
+
+// NewExtensionOptionsDecorator creates a new ExtensionOptionsDecorator
+func NewExtensionOptionsDecorator(registry *ExtensionOptionRegistry) ExtensionOptionsDecorator {
+	return ExtensionOptionsDecorator{registry: registry}
+}
+
+var _ types.AnteDecorator = ExtensionOptionsDecorator{}
+
+// AnteHandle implements the AnteDecorator.AnteHandle method
+func (d ExtensionOptionsDecorator) AnteHandle(ctx types.Context, tx types.Tx, simulate bool, next types.AnteHandler) (newCtx types.Context, err error) {
+	hasExtOptsTx, ok := tx.(HasExtensionOptionsTx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, any := range hasExtOptsTx.GetExtensionOptions() {
+		var handled bool
+		ctx, handled, err = d.registry.handle(ctx, tx, any, simulate)
+		if err != nil {
+			return ctx, err
+		}
+		if !handled {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrUnknownExtensionOptions, any.TypeUrl)
+		}
+	}
+
+	for _, any := range hasExtOptsTx.GetNonCriticalExtensionOptions() {
+		ctx, _, err = d.registry.handle(ctx, tx, any, simulate)
+		if err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
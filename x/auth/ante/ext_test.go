@@ -1,9 +1,14 @@
 package ante_test
 
 import (
+	"errors"
+
+	"github.com/gogo/protobuf/proto"
+
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
 )
@@ -34,3 +39,69 @@ func (suite *AnteTestSuite) TestRejectExtensionOptionsDecorator() {
 	_, err = antehandler(suite.ctx, theTx, false)
 	suite.Require().EqualError(err, "unknown extension options")
 }
+
+type extensionOptionKey struct{}
+
+func (suite *AnteTestSuite) TestExtensionOptionsDecorator() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	registry := ante.NewExtensionOptionRegistry()
+	registry.Register(&testdata.TestMsg{}, func(ctx sdk.Context, _ sdk.Tx, option proto.Message, _ bool) (sdk.Context, error) {
+		signers := option.(*testdata.TestMsg).Signers
+		if len(signers) == 0 {
+			return ctx, errors.New("no signers")
+		}
+		return ctx.WithValue(extensionOptionKey{}, signers[0]), nil
+	})
+	suite.Require().True(registry.IsRegistered(sdk.MsgTypeURL(&testdata.TestMsg{})))
+	suite.Require().Panics(func() { registry.Register(&testdata.TestMsg{}, nil) })
+
+	var handledCtx sdk.Context
+	antehandler := sdk.ChainAnteDecorators(ante.NewExtensionOptionsDecorator(registry), terminatorDecorator{&handledCtx})
+
+	extOptsTxBldr, ok := suite.txBuilder.(tx.ExtensionOptionsTxBuilder)
+	suite.Require().True(ok)
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	known, err := types.NewAnyWithValue(testdata.NewTestMsg(addr))
+	suite.Require().NoError(err)
+	invalid, err := types.NewAnyWithValue(testdata.NewTestMsg())
+	suite.Require().NoError(err)
+	unknown, err := types.NewAnyWithValue(&testdata.Dog{Name: "spot"})
+	suite.Require().NoError(err)
+
+	// the handler of a known option modifies the context of the next decorators
+	extOptsTxBldr.SetExtensionOptions(known)
+	_, err = antehandler(suite.ctx, suite.txBuilder.GetTx(), false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(addr.String(), handledCtx.Value(extensionOptionKey{}))
+
+	// the errors of the handlers are returned
+	extOptsTxBldr.SetExtensionOptions(invalid)
+	_, err = antehandler(suite.ctx, suite.txBuilder.GetTx(), false)
+	suite.Require().EqualError(err, "no signers")
+
+	// unknown critical options are rejected
+	extOptsTxBldr.SetExtensionOptions(known, unknown)
+	_, err = antehandler(suite.ctx, suite.txBuilder.GetTx(), false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnknownExtensionOptions)
+
+	// unknown non-critical options are ignored, the known ones are handled
+	extOptsTxBldr.SetExtensionOptions()
+	extOptsTxBldr.SetNonCriticalExtensionOptions(unknown, known)
+	handledCtx = sdk.Context{}
+	_, err = antehandler(suite.ctx, suite.txBuilder.GetTx(), false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(addr.String(), handledCtx.Value(extensionOptionKey{}))
+}
+
+// terminatorDecorator records the context it is called with.
+type terminatorDecorator struct {
+	ctx *sdk.Context
+}
+
+func (d terminatorDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*d.ctx = ctx
+	return next(ctx, tx, simulate)
+}
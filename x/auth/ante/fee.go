@@ -35,16 +35,7 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	if ctx.IsCheckTx() && !simulate {
 		minGasPrices := ctx.MinGasPrices()
 		if !minGasPrices.IsZero() {
-			requiredFees := make(sdk.Coins, len(minGasPrices))
-
-			// Determine the required fees by multiplying each required minimum gas
-			// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
-			glDec := sdk.NewDec(int64(gas))
-			for i, gp := range minGasPrices {
-				fee := gp.Amount.Mul(glDec)
-				requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
-			}
-
+			requiredFees := requiredFees(minGasPrices, gas)
 			if !feeCoins.IsAnyGTE(requiredFees) {
 				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
 			}
@@ -54,21 +45,206 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	return next(ctx, tx, simulate)
 }
 
+// GlobalFeeDecorator checks that the fees of the txs meet the chain-wide minimum
+// gas prices of the GlobalFeeKeeper in one of their denoms. Unlike the local
+// minimum gas prices of the validators, they are part of the consensus and
+// checked in DeliverTx too, so that validators cannot include txs paying lower
+// fees in their blocks. The genesis txs are not checked.
+// CONTRACT: Tx must implement FeeTx to use GlobalFeeDecorator
+type GlobalFeeDecorator struct {
+	gfk GlobalFeeKeeper
+}
+
+func NewGlobalFeeDecorator(gfk GlobalFeeKeeper) GlobalFeeDecorator {
+	return GlobalFeeDecorator{
+		gfk: gfk,
+	}
+}
+
+func (gfd GlobalFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if simulate || ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := checkMinGasPrices(feeTx, gfd.gfk.GetMinimumGasPrices(ctx)); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// MinGasPriceDecorator checks that the fees of the txs meet the chain-wide
+// MinGasPrices param of x/auth in one of their denoms. The param is part of
+// the consensus and checked in DeliverTx too, so that validators cannot include
+// txs paying lower fees in their blocks nor undercut each other. It replaces
+// the local minimum gas prices of the validator, which only apply in CheckTx
+// while the param is not set, like in MempoolFeeDecorator. The genesis txs are
+// not checked against the param.
+// CONTRACT: Tx must implement FeeTx to use MinGasPriceDecorator
+type MinGasPriceDecorator struct {
+	ak AccountKeeper
+}
+
+func NewMinGasPriceDecorator(ak AccountKeeper) MinGasPriceDecorator {
+	return MinGasPriceDecorator{
+		ak: ak,
+	}
+}
+
+func (mgpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := checkMinGasPrices(feeTx, minGasPrices(ctx, mgpd.ak)); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// minGasPrices returns the minimum gas prices the fees must meet: the
+// chain-wide MinGasPrices param if it is set, except for the genesis txs, and
+// otherwise the local minimum gas prices in CheckTx.
+func minGasPrices(ctx sdk.Context, ak AccountKeeper) sdk.DecCoins {
+	minGasPrices := ak.GetMinGasPrices(ctx)
+	switch {
+	case !minGasPrices.IsZero() && ctx.BlockHeight() > 0:
+		// the chain-wide minimum gas prices take precedence over the local ones
+		return minGasPrices
+	case ctx.IsCheckTx():
+		return ctx.MinGasPrices()
+	default:
+		return nil
+	}
+}
+
+// checkMinGasPrices checks that the fees of the tx meet the minimum gas prices
+// in one of their denoms, if any.
+func checkMinGasPrices(feeTx sdk.FeeTx, minGasPrices sdk.DecCoins) error {
+	if minGasPrices.IsZero() {
+		return nil
+	}
+
+	feeCoins := feeTx.GetFee()
+	requiredFees := requiredFees(minGasPrices, feeTx.GetGas())
+	if !feeCoins.IsAnyGTE(requiredFees) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+	}
+
+	return nil
+}
+
+// MempoolLimitsDecorator protects the mempool and the users from costly
+// mistakes: it rejects the txs wanting more gas than maxGasWanted, and the txs
+// paying more than maxFeeMultiple times the fees required by the minimum gas
+// prices in any of their denoms. The required fees
+// are those of MinGasPriceDecorator: the chain-wide MinGasPrices param if it is
+// set, and otherwise the local validator's minimum gas prices. A zero limit
+// disables the corresponding check, and the fees are not checked if there are
+// no minimum gas prices. Like MempoolFeeDecorator, the limits are node
+// configuration and only apply when ctx.CheckTx = true, so that they do not
+// affect consensus.
+// CONTRACT: Tx must implement FeeTx to use MempoolLimitsDecorator
+type MempoolLimitsDecorator struct {
+	ak             AccountKeeper
+	maxGasWanted   uint64
+	maxFeeMultiple uint64
+}
+
+func NewMempoolLimitsDecorator(ak AccountKeeper, maxGasWanted, maxFeeMultiple uint64) MempoolLimitsDecorator {
+	return MempoolLimitsDecorator{
+		ak:             ak,
+		maxGasWanted:   maxGasWanted,
+		maxFeeMultiple: maxFeeMultiple,
+	}
+}
+
+func (mld MempoolLimitsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if !ctx.IsCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	gas := feeTx.GetGas()
+	if mld.maxGasWanted > 0 && gas > mld.maxGasWanted {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrGasWantedTooHigh, "gas wanted %d exceeds the node limit %d", gas, mld.maxGasWanted)
+	}
+
+	if mld.maxFeeMultiple == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	if minGasPrices := minGasPrices(ctx, mld.ak); !minGasPrices.IsZero() {
+		multiple := sdk.NewIntFromUint64(mld.maxFeeMultiple)
+		for _, required := range requiredFees(minGasPrices, gas) {
+			fee := feeTx.GetFee().AmountOf(required.Denom)
+			if fee.GT(required.Amount.Mul(multiple)) {
+				return ctx, sdkerrors.Wrapf(
+					sdkerrors.ErrAbsurdFee, "fee %s%s exceeds %d times the required fee %s",
+					fee, required.Denom, mld.maxFeeMultiple, required,
+				)
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// requiredFees determines the required fees by multiplying each required
+// minimum gas price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
+func requiredFees(minGasPrices sdk.DecCoins, gas uint64) sdk.Coins {
+	requiredFees := make(sdk.Coins, len(minGasPrices))
+
+	glDec := sdk.NewDec(int64(gas))
+	for i, gp := range minGasPrices {
+		fee := gp.Amount.Mul(glDec)
+		requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+	}
+
+	return requiredFees
+}
+
 // DeductFeeDecorator deducts fees from the first signer of the tx
 // If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error
 // Call next AnteHandler if fees successfully deducted
+// If a FeeMarketKeeper is set, the fees must also pay at least its base fee gas
+// price for the gas limit of the tx, in CheckTx and DeliverTx. The genesis txs
+// are not checked.
+// If a FeeConverter is set, the fees paid in its alternative denoms are
+// converted to the base fee denom before they are deducted, so that the fee
+// collector is credited in the base denom, and they pay the base fee at their
+// converted value.
 // CONTRACT: Tx must implement FeeTx interface to use DeductFeeDecorator
 type DeductFeeDecorator struct {
-	ak             AccountKeeper
-	bankKeeper     types.BankKeeper
-	feegrantKeeper FeegrantKeeper
+	ak              AccountKeeper
+	bankKeeper      types.BankKeeper
+	feegrantKeeper  FeegrantKeeper
+	feeMarketKeeper FeeMarketKeeper
+	feeConverter    FeeConverter
 }
 
-func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper) DeductFeeDecorator {
+func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper, fmk FeeMarketKeeper, fc FeeConverter) DeductFeeDecorator {
 	return DeductFeeDecorator{
-		ak:             ak,
-		bankKeeper:     bk,
-		feegrantKeeper: fk,
+		ak:              ak,
+		bankKeeper:      bk,
+		feegrantKeeper:  fk,
+		feeMarketKeeper: fmk,
+		feeConverter:    fc,
 	}
 }
 
@@ -86,6 +262,12 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	feePayer := feeTx.FeePayer()
 	feeGranter := feeTx.FeeGranter()
 
+	if dfd.feeMarketKeeper != nil && !simulate && ctx.BlockHeight() > 0 {
+		if err := checkBaseFee(dfd.convertedFees(ctx, fee), feeTx.GetGas(), dfd.feeMarketKeeper.GetBaseFee(ctx)); err != nil {
+			return ctx, err
+		}
+	}
+
 	deductFeesFrom := feePayer
 
 	// if feegranter set deduct fee from feegranter account.
@@ -109,9 +291,14 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", deductFeesFrom)
 	}
 
-	// deduct the fees
-	if !feeTx.GetFee().IsZero() {
-		err = DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, feeTx.GetFee())
+	// deduct the fees, converted to the base fee denom
+	if !fee.IsZero() {
+		deducted, err := dfd.convertFees(ctx, deductFeesFrom, fee)
+		if err != nil {
+			return ctx, err
+		}
+
+		err = DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, deducted)
 		if err != nil {
 			return ctx, err
 		}
@@ -122,9 +309,91 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	)}
 	ctx.EventManager().EmitEvents(events)
 
+	if err := ctx.EventManager().EmitTypedEvent(newEventFeeDeducted(feeTx, feePayer, feeGranter)); err != nil {
+		return ctx, err
+	}
+
 	return next(ctx, tx, simulate)
 }
 
+// convertedFees returns the fees with the coins in the alternative fee denoms
+// of the FeeConverter, if any, replaced by the base fee denom coins they convert
+// to.
+func (dfd DeductFeeDecorator) convertedFees(ctx sdk.Context, fee sdk.Coins) sdk.Coins {
+	if dfd.feeConverter == nil {
+		return fee
+	}
+
+	converted := sdk.NewCoins()
+	for _, coin := range fee {
+		if c, ok := dfd.feeConverter.ConvertedFee(ctx, coin); ok {
+			coin = c
+		}
+
+		converted = converted.Add(coin)
+	}
+
+	return converted
+}
+
+// convertFees converts the fees of the payer in the alternative fee denoms of
+// the FeeConverter, if any, to base fee denom coins, and returns the fees to
+// deduct.
+func (dfd DeductFeeDecorator) convertFees(ctx sdk.Context, payer sdk.AccAddress, fee sdk.Coins) (sdk.Coins, error) {
+	if dfd.feeConverter == nil {
+		return fee, nil
+	}
+
+	converted := sdk.NewCoins()
+	for _, coin := range fee {
+		if _, ok := dfd.feeConverter.ConvertedFee(ctx, coin); ok {
+			c, err := dfd.feeConverter.ConvertFee(ctx, payer, coin)
+			if err != nil {
+				return nil, sdkerrors.Wrapf(err, "failed to convert fee %s", coin)
+			}
+
+			coin = c
+		}
+
+		converted = converted.Add(coin)
+	}
+
+	return converted, nil
+}
+
+// checkBaseFee checks that the fees pay at least the base fee gas price for the
+// gas limit, if it is positive.
+func checkBaseFee(fee sdk.Coins, gas uint64, baseFee sdk.DecCoin) error {
+	if baseFee.Amount.IsNil() || !baseFee.IsPositive() {
+		return nil
+	}
+
+	required := requiredFees(sdk.DecCoins{baseFee}, gas)[0]
+	if fee.AmountOf(required.Denom).LT(required.Amount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees for the base fee; got: %s required: %s", fee, required)
+	}
+
+	return nil
+}
+
+// newEventFeeDeducted returns the EventFeeDeducted of the fees of the tx, with
+// the gas prices they pay for its gas limit.
+func newEventFeeDeducted(feeTx sdk.FeeTx, feePayer, feeGranter sdk.AccAddress) *types.EventFeeDeducted {
+	event := &types.EventFeeDeducted{
+		FeePayer:  feePayer.String(),
+		Fee:       feeTx.GetFee(),
+		GasWanted: feeTx.GetGas(),
+	}
+	if feeGranter != nil {
+		event.FeeGranter = feeGranter.String()
+	}
+	if gas := feeTx.GetGas(); gas > 0 {
+		event.GasPrices = sdk.NewDecCoinsFromCoins(feeTx.GetFee()...).QuoDec(sdk.NewDecFromInt(sdk.NewIntFromUint64(gas)))
+	}
+
+	return event
+}
+
 // DeductFees deducts fees from the given account.
 func DeductFees(bankKeeper types.BankKeeper, ctx sdk.Context, acc types.AccountI, fees sdk.Coins) error {
 	if !fees.IsValid() {
@@ -5,7 +5,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	feemarketkeeper "github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
 )
 
 func (suite *AnteTestSuite) TestEnsureMempoolFees() {
@@ -60,6 +64,183 @@ func (suite *AnteTestSuite) TestEnsureMempoolFees() {
 	suite.Require().Nil(err, "Decorator should not have errored on fee higher than local gasPrice")
 }
 
+func (suite *AnteTestSuite) TestMempoolLimits() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// 150atom of fee for 400000 gas
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	// a required fee of 40atom
+	suite.ctx = suite.ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 4))))
+
+	testCases := []struct {
+		name           string
+		maxGasWanted   uint64
+		maxFeeMultiple uint64
+		expErr         error
+	}{
+		{"no limits", 0, 0, nil},
+		{"gas wanted within limit", 400000, 0, nil},
+		{"gas wanted above limit", 399999, 0, sdkerrors.ErrGasWantedTooHigh},
+		{"fee within multiple", 0, 4, nil},
+		{"fee above multiple", 0, 3, sdkerrors.ErrAbsurdFee},
+	}
+	for _, tc := range testCases {
+		antehandler := sdk.ChainAnteDecorators(ante.NewMempoolLimitsDecorator(suite.app.AccountKeeper, tc.maxGasWanted, tc.maxFeeMultiple))
+
+		_, err = antehandler(suite.ctx, tx, false)
+		suite.Require().ErrorIs(err, tc.expErr, tc.name)
+
+		// the limits do not apply to simulations and DeliverTx
+		_, err = antehandler(suite.ctx, tx, true)
+		suite.Require().NoError(err, tc.name)
+		_, err = antehandler(suite.ctx.WithIsCheckTx(false), tx, false)
+		suite.Require().NoError(err, tc.name)
+	}
+
+	// the fees are not limited without minimum gas prices
+	antehandler := sdk.ChainAnteDecorators(ante.NewMempoolLimitsDecorator(suite.app.AccountKeeper, 0, 1))
+	_, err = antehandler(suite.ctx.WithMinGasPrices(nil), tx, false)
+	suite.Require().NoError(err)
+
+	// the chain-wide minimum gas prices apply without local ones, with a
+	// required fee of 40atom
+	ctx := suite.ctx.WithMinGasPrices(nil).WithBlockHeight(1)
+	params := suite.app.AccountKeeper.GetParams(ctx)
+	params.MinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 4)))
+	suite.app.AccountKeeper.SetParams(ctx, params)
+
+	antehandler = sdk.ChainAnteDecorators(ante.NewMempoolLimitsDecorator(suite.app.AccountKeeper, 0, 3))
+	_, err = antehandler(ctx, tx, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrAbsurdFee)
+}
+
+func (suite *AnteTestSuite) TestGlobalFees() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// 150atom of fee for 400000 gas
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewGlobalFeeDecorator(suite.app.GlobalFeeKeeper))
+	ctx := suite.ctx.WithBlockHeight(1)
+
+	testCases := []struct {
+		name         string
+		minGasPrices sdk.DecCoins
+		expErr       error
+	}{
+		{"no global minimum", sdk.NewDecCoins(), nil},
+		{"fee above the minimum", sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 4))), nil},
+		{"fee equal to the minimum", sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(375, 6))), nil},
+		{"fee below the minimum", sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 3))), sdkerrors.ErrInsufficientFee},
+		{"fee in another denom", sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 4))), sdkerrors.ErrInsufficientFee},
+		{
+			"fee above the minimum in one of the denoms",
+			sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 4)), sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 4))),
+			nil,
+		},
+	}
+	for _, tc := range testCases {
+		globalFeeParams := suite.app.GlobalFeeKeeper.GetParams(ctx)
+		globalFeeParams.MinimumGasPrices = tc.minGasPrices
+		suite.app.GlobalFeeKeeper.SetParams(ctx, globalFeeParams)
+
+		// the minimum applies to both CheckTx and DeliverTx
+		_, err = antehandler(ctx, tx, false)
+		suite.Require().ErrorIs(err, tc.expErr, tc.name)
+		_, err = antehandler(ctx.WithIsCheckTx(true), tx, false)
+		suite.Require().ErrorIs(err, tc.expErr, tc.name)
+
+		// but not to simulations and genesis txs
+		_, err = antehandler(ctx, tx, true)
+		suite.Require().NoError(err, tc.name)
+		_, err = antehandler(ctx.WithBlockHeight(0), tx, false)
+		suite.Require().NoError(err, tc.name)
+	}
+}
+
+func (suite *AnteTestSuite) TestMinGasPrices() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// 150atom of fee for 400000 gas
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewMinGasPriceDecorator(suite.app.AccountKeeper))
+	ctx := suite.ctx.WithBlockHeight(1)
+
+	testCases := []struct {
+		name         string
+		minGasPrices sdk.DecCoins
+		expErr       error
+	}{
+		{"no min gas prices", nil, nil},
+		{"fee equal to the minimum", sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(375, 6))), nil},
+		{"fee below the minimum", sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 3))), sdkerrors.ErrInsufficientFee},
+		{"fee in another denom", sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 4))), sdkerrors.ErrInsufficientFee},
+	}
+	for _, tc := range testCases {
+		params := suite.app.AccountKeeper.GetParams(ctx)
+		params.MinGasPrices = tc.minGasPrices
+		suite.app.AccountKeeper.SetParams(ctx, params)
+
+		// the minimum applies to both CheckTx and DeliverTx
+		_, err = antehandler(ctx, tx, false)
+		suite.Require().ErrorIs(err, tc.expErr, tc.name)
+		_, err = antehandler(ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins()), tx, false)
+		suite.Require().ErrorIs(err, tc.expErr, tc.name)
+
+		// but not to simulations and genesis txs
+		_, err = antehandler(ctx, tx, true)
+		suite.Require().NoError(err, tc.name)
+		_, err = antehandler(ctx.WithBlockHeight(0), tx, false)
+		suite.Require().NoError(err, tc.name)
+	}
+
+	// the local minimum gas prices only apply in CheckTx while the param is not
+	// set, and give way to the param once it is
+	localMinGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 3)))
+	checkCtx := ctx.WithIsCheckTx(true).WithMinGasPrices(localMinGasPrices)
+	params := suite.app.AccountKeeper.GetParams(ctx)
+	params.MinGasPrices = nil
+	suite.app.AccountKeeper.SetParams(ctx, params)
+	_, err = antehandler(checkCtx, tx, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+	_, err = antehandler(checkCtx.WithIsCheckTx(false), tx, false)
+	suite.Require().NoError(err)
+
+	params.MinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(375, 6)))
+	suite.app.AccountKeeper.SetParams(ctx, params)
+	_, err = antehandler(checkCtx, tx, false)
+	suite.Require().NoError(err)
+}
+
 func (suite *AnteTestSuite) TestDeductFees() {
 	suite.SetupTest(false) // setup
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
@@ -86,7 +267,7 @@ func (suite *AnteTestSuite) TestDeductFees() {
 	err = simapp.FundAccount(suite.app.BankKeeper, suite.ctx, addr1, coins)
 	suite.Require().NoError(err)
 
-	dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil)
+	dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil, nil, nil)
 	antehandler := sdk.ChainAnteDecorators(dfd)
 
 	_, err = antehandler(suite.ctx, tx, false)
@@ -98,7 +279,144 @@ func (suite *AnteTestSuite) TestDeductFees() {
 	err = simapp.FundAccount(suite.app.BankKeeper, suite.ctx, addr1, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(200))))
 	suite.Require().NoError(err)
 
-	_, err = antehandler(suite.ctx, tx, false)
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = antehandler(ctx, tx, false)
 
 	suite.Require().Nil(err, "Tx errored after account has been set with sufficient funds")
+
+	// the deduction is reported by an EventFeeDeducted
+	var event *types.EventFeeDeducted
+	for _, abciEvent := range ctx.EventManager().ABCIEvents() {
+		if msg, err := sdk.ParseTypedEvent(abciEvent); err == nil {
+			event, _ = msg.(*types.EventFeeDeducted)
+		}
+	}
+	suite.Require().NotNil(event)
+	suite.Require().Equal(addr1.String(), event.FeePayer)
+	suite.Require().Empty(event.FeeGranter)
+	suite.Require().Equal(feeAmount, event.Fee)
+	suite.Require().Equal(gasLimit, event.GasWanted)
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(375, 6))), event.GasPrices)
+}
+
+func (suite *AnteTestSuite) TestDeductFeesBaseFee() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// 150atom of fee for 400000 gas
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	ctx := suite.ctx.WithBlockHeight(1)
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(ctx, acc)
+	suite.Require().NoError(simapp.FundAccount(suite.app.BankKeeper, ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))))
+
+	dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil, suite.app.FeeMarketKeeper, nil)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+	// the fees are not deducted from ctx
+	run := func(ctx sdk.Context, simulate bool) error {
+		cacheCtx, _ := ctx.CacheContext()
+		_, err := antehandler(cacheCtx, tx, simulate)
+		return err
+	}
+
+	params := feemarkettypes.DefaultParams()
+	params.FeeDenom = "atom"
+	suite.app.FeeMarketKeeper.SetParams(ctx, params)
+
+	testCases := []struct {
+		name    string
+		baseFee sdk.Dec
+		expErr  error
+	}{
+		{"no base fee", sdk.ZeroDec(), nil},
+		{"fee equal to the base fee", sdk.NewDecWithPrec(375, 6), nil},
+		{"fee below the base fee", sdk.NewDecWithPrec(1, 3), sdkerrors.ErrInsufficientFee},
+	}
+	for _, tc := range testCases {
+		suite.app.FeeMarketKeeper.SetBaseFeeAmount(ctx, tc.baseFee)
+
+		// the base fee applies to both CheckTx and DeliverTx
+		suite.Require().ErrorIs(run(ctx, false), tc.expErr, tc.name)
+		suite.Require().ErrorIs(run(ctx.WithIsCheckTx(true), false), tc.expErr, tc.name)
+
+		// but not to simulations and genesis txs
+		suite.Require().NoError(run(ctx, true), tc.name)
+		suite.Require().NoError(run(ctx.WithBlockHeight(0), false), tc.name)
+	}
+
+	// the fees must pay the base fee in its denom
+	params.FeeDenom = "stake"
+	suite.app.FeeMarketKeeper.SetParams(ctx, params)
+	suite.app.FeeMarketKeeper.SetBaseFeeAmount(ctx, sdk.NewDecWithPrec(1, 6))
+	suite.Require().ErrorIs(run(ctx, false), sdkerrors.ErrInsufficientFee)
+}
+
+func (suite *AnteTestSuite) TestDeductFeesAlternativeDenom() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// 150atom of fee for 400000 gas
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	ctx := suite.ctx.WithBlockHeight(1)
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(ctx, acc)
+	suite.Require().NoError(simapp.FundAccount(suite.app.BankKeeper, ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))))
+
+	// one atom converts to two stake
+	params := feemarkettypes.DefaultParams()
+	params.FeeDenom = "stake"
+	params.AlternativeFeeDenoms = []feemarkettypes.FeeDenomRate{feemarkettypes.NewFeeDenomRate("atom", sdk.NewDec(2))}
+	suite.app.FeeMarketKeeper.SetParams(ctx, params)
+
+	converter := feemarketkeeper.NewReserveFeeConverter(
+		suite.app.FeeMarketKeeper, suite.app.AccountKeeper, suite.app.BankKeeper, feemarkettypes.FeeReserveName,
+	)
+	dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil, suite.app.FeeMarketKeeper, converter)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+	run := func(ctx sdk.Context) error {
+		cacheCtx, _ := ctx.CacheContext()
+		_, err := antehandler(cacheCtx, tx, false)
+		return err
+	}
+
+	// the reserve has no stake to exchange the fees for
+	suite.Require().ErrorIs(run(ctx), sdkerrors.ErrInsufficientFunds)
+
+	reserve := suite.app.AccountKeeper.GetModuleAddress(feemarkettypes.FeeReserveName)
+	suite.Require().NoError(simapp.FundModuleAccount(suite.app.BankKeeper, ctx, feemarkettypes.FeeReserveName, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))))
+
+	// the fees pay the base fee at their converted value, 300stake
+	suite.app.FeeMarketKeeper.SetBaseFeeAmount(ctx, sdk.NewDecWithPrec(1, 3))
+	suite.Require().ErrorIs(run(ctx), sdkerrors.ErrInsufficientFee)
+	suite.app.FeeMarketKeeper.SetBaseFeeAmount(ctx, sdk.NewDecWithPrec(75, 5))
+	suite.Require().NoError(run(ctx))
+
+	// the fee collector is credited in the fee denom
+	_, err = antehandler(ctx, tx, false)
+	suite.Require().NoError(err)
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 300)), suite.app.BankKeeper.GetAllBalances(ctx, feeCollector))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 850)), suite.app.BankKeeper.GetAllBalances(ctx, addr1))
+	suite.Require().Equal(
+		sdk.NewCoins(sdk.NewInt64Coin("atom", 150), sdk.NewInt64Coin("stake", 700)),
+		suite.app.BankKeeper.GetAllBalances(ctx, reserve),
+	)
 }
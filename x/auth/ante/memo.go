@@ -0,0 +1,126 @@
+package ante
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// MemoValidator validates the memos of the txs, in addition to the
+// MaxMemoCharacters limit checked by the ValidateMemoDecorator. It lets chains
+// restrict the content of the memos, e.g. to UTF-8 or structured JSON memos.
+type MemoValidator interface {
+	ValidateMemo(ctx sdk.Context, memo string, params types.Params) error
+}
+
+// MemoValidatorFunc is a function implementing MemoValidator.
+type MemoValidatorFunc func(ctx sdk.Context, memo string, params types.Params) error
+
+// ValidateMemo implements MemoValidator.
+func (f MemoValidatorFunc) ValidateMemo(ctx sdk.Context, memo string, params types.Params) error {
+	return f(ctx, memo, params)
+}
+
+// NewMemoValidators returns a MemoValidator accepting the memos accepted by all
+// the given validators, which are run in order.
+func NewMemoValidators(validators ...MemoValidator) MemoValidator {
+	return MemoValidatorFunc(func(ctx sdk.Context, memo string, params types.Params) error {
+		for _, v := range validators {
+			if err := v.ValidateMemo(ctx, memo, params); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+var (
+	// UTF8MemoValidator rejects the memos which are not valid UTF-8.
+	UTF8MemoValidator MemoValidator = MemoValidatorFunc(func(_ sdk.Context, memo string, _ types.Params) error {
+		if !utf8.ValidString(memo) {
+			return fmt.Errorf("memo is not valid UTF-8")
+		}
+
+		return nil
+	})
+
+	// NoControlCharsMemoValidator rejects the memos containing control
+	// characters, including new lines.
+	NoControlCharsMemoValidator MemoValidator = MemoValidatorFunc(func(_ sdk.Context, memo string, _ types.Params) error {
+		for i, r := range memo {
+			if unicode.IsControl(r) {
+				return fmt.Errorf("memo contains control character %U at position %d", r, i)
+			}
+		}
+
+		return nil
+	})
+
+	// JSONMemoValidator rejects the non-empty memos which are not valid JSON.
+	JSONMemoValidator MemoValidator = MemoValidatorFunc(func(_ sdk.Context, memo string, _ types.Params) error {
+		if memo != "" && !json.Valid([]byte(memo)) {
+			return fmt.Errorf("memo is not valid JSON")
+		}
+
+		return nil
+	})
+)
+
+// RegexMemoValidator is the default MemoValidator. It rejects the memos not
+// matching the MemoRegex param, if set. All memos, including empty ones, must
+// contain a match of the regex: anchor it with ^ and $ to match whole memos.
+type RegexMemoValidator struct {
+	mtx     sync.Mutex
+	pattern string
+	regex   *regexp.Regexp
+}
+
+var _ MemoValidator = (*RegexMemoValidator)(nil)
+
+// NewRegexMemoValidator returns a new RegexMemoValidator.
+func NewRegexMemoValidator() *RegexMemoValidator {
+	return &RegexMemoValidator{}
+}
+
+// ValidateMemo implements MemoValidator.
+func (v *RegexMemoValidator) ValidateMemo(_ sdk.Context, memo string, params types.Params) error {
+	if params.MemoRegex == "" {
+		return nil
+	}
+
+	regex, err := v.compile(params.MemoRegex)
+	if err != nil {
+		return err
+	}
+	if !regex.MatchString(memo) {
+		return fmt.Errorf("memo does not match %s", params.MemoRegex)
+	}
+
+	return nil
+}
+
+// compile returns the compiled regex of the pattern, caching the last one as
+// the param rarely changes.
+func (v *RegexMemoValidator) compile(pattern string) (*regexp.Regexp, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	if v.regex != nil && v.pattern == pattern {
+		return v.regex, nil
+	}
+
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid memo regex: %w", err)
+	}
+
+	v.pattern, v.regex = pattern, regex
+	return regex, nil
+}
@@ -0,0 +1,64 @@
+package ante_test
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *AnteTestSuite) TestValidateMemoValidators() {
+	suite.SetupTest(true) // setup
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+
+	testCases := []struct {
+		name      string
+		memoRegex string
+		validator ante.MemoValidator
+		memo      string
+		expPass   bool
+	}{
+		{"default params accept any memo", "", nil, "any\x00memo", true},
+		{"memo matching the regex", `^[a-z]*$`, nil, "memo", true},
+		{"empty memo matching the regex", `^[a-z]*$`, nil, "", true},
+		{"memo not matching the regex", `^[a-z]*$`, nil, "Memo", false},
+		{"valid utf-8 memo", "", ante.UTF8MemoValidator, "mémo", true},
+		{"invalid utf-8 memo", "", ante.UTF8MemoValidator, "m\xffmo", false},
+		{"memo without control characters", "", ante.NoControlCharsMemoValidator, "a memo", true},
+		{"memo with a control character", "", ante.NoControlCharsMemoValidator, "a\nmemo", false},
+		{"json memo", "", ante.JSONMemoValidator, `{"ref":1}`, true},
+		{"empty memo is not checked as json", "", ante.JSONMemoValidator, "", true},
+		{"invalid json memo", "", ante.JSONMemoValidator, `{"ref":`, false},
+		{"memo accepted by all validators", `^\{`, ante.NewMemoValidators(ante.NewRegexMemoValidator(), ante.JSONMemoValidator), `{"ref":1}`, true},
+		{"memo rejected by one of the validators", `^\{`, ante.NewMemoValidators(ante.NewRegexMemoValidator(), ante.JSONMemoValidator), `{"ref":`, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			params := types.DefaultParams()
+			params.MemoRegex = tc.memoRegex
+			suite.app.AccountKeeper.SetParams(suite.ctx, params)
+
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+			suite.txBuilder.SetMemo(tc.memo)
+			tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+			suite.Require().NoError(err)
+
+			antehandler := sdk.ChainAnteDecorators(ante.NewValidateMemoDecorator(suite.app.AccountKeeper, tc.validator))
+			_, err = antehandler(suite.ctx, tx, false)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, sdkerrors.ErrInvalidMemo)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package ante
+
+import (
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TxReplayDecorator rejects the txs whose exact bytes were already processed
+// within the retention window of the TxHashKeeper, and records the hash of the
+// others. As the index is in state, replays are rejected deterministically by
+// all nodes, complementing the sequence checks. The hash is recorded only if
+// the whole AnteHandler succeeds, so that a tx rejected by the AnteHandler can
+// be submitted again. The unordered txs are left to the UnorderedTxDecorator,
+// which records them until they time out.
+type TxReplayDecorator struct {
+	txHashKeeper TxHashKeeper
+}
+
+func NewTxReplayDecorator(txHashKeeper TxHashKeeper) TxReplayDecorator {
+	return TxReplayDecorator{
+		txHashKeeper: txHashKeeper,
+	}
+}
+
+func (trd TxReplayDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	// simulated txs are not processed, and their bytes may not be final
+	if simulate || len(ctx.TxBytes()) == 0 || isUnordered(tx) {
+		return next(ctx, tx, simulate)
+	}
+
+	hash := tmhash.Sum(ctx.TxBytes())
+	if trd.txHashKeeper.HasSeenTx(ctx, hash) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrTxReplayed, "tx %X", hash)
+	}
+	trd.txHashKeeper.MarkTxSeen(ctx, hash)
+
+	return next(ctx, tx, simulate)
+}
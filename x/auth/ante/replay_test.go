@@ -0,0 +1,29 @@
+package ante_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	txresulttypes "github.com/cosmos/cosmos-sdk/x/txresult/types"
+)
+
+func (suite *AnteTestSuite) TestTxReplayDecorator() {
+	suite.SetupTest(true) // setup
+	suite.app.TxResultKeeper.SetParams(suite.ctx, txresulttypes.NewParams(10))
+	antehandler := sdk.ChainAnteDecorators(ante.NewTxReplayDecorator(suite.app.TxResultKeeper))
+
+	ctx := suite.ctx.WithTxBytes([]byte("tx"))
+
+	// simulations do not record the tx
+	_, err := antehandler(ctx, nil, true)
+	suite.Require().NoError(err)
+
+	_, err = antehandler(ctx, nil, false)
+	suite.Require().NoError(err)
+
+	_, err = antehandler(ctx, nil, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrTxReplayed)
+
+	_, err = antehandler(ctx.WithTxBytes([]byte("other tx")), nil, false)
+	suite.Require().NoError(err)
+}
@@ -6,10 +6,9 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/gogo/protobuf/proto"
+
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
-	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
-	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
-	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/sm2"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
@@ -42,14 +41,21 @@ type SignatureVerificationGasConsumer = func(meter sdk.GasMeter, sig signing.Sig
 
 // SetPubKeyDecorator sets PubKeys in context for any signer which does not already have pubkey set
 // PubKeys must be set in context for all signers before any other sigverify decorators run
+// Session keys of the signers, if a SessionKeeper is given, are accepted but never set.
+// The pubkeys of the signers with a custom authenticator, if an AuthenticationKeeper
+// is given, are left to their authenticator and never set either.
 // CONTRACT: Tx must implement SigVerifiableTx interface
 type SetPubKeyDecorator struct {
-	ak AccountKeeper
+	ak    AccountKeeper
+	sk    SessionKeeper
+	authk AuthenticationKeeper
 }
 
-func NewSetPubKeyDecorator(ak AccountKeeper) SetPubKeyDecorator {
+func NewSetPubKeyDecorator(ak AccountKeeper, sk SessionKeeper, authk AuthenticationKeeper) SetPubKeyDecorator {
 	return SetPubKeyDecorator{
-		ak: ak,
+		ak:    ak,
+		sk:    sk,
+		authk: authk,
 	}
 }
 
@@ -73,8 +79,23 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 			}
 			pk = simSm2Pubkey
 		}
+		authenticator, err := getAuthenticator(ctx, spkd.authk, signers[i])
+		if err != nil {
+			return ctx, err
+		}
+		if authenticator != nil {
+			continue
+		}
 		// Only make check if simulate=false
-		if !simulate && !bytes.Equal(pk.Address(), signers[i]) {
+		if !simulate && !bytes.Equal(sdk.AccAddressFromPubKey(pk), signers[i]) {
+			// session keys sign on behalf of the signer, and must not be set as its pubkey
+			if spkd.sk != nil && spkd.sk.ValidateSessionKey(ctx, signers[i], pk, tx.GetMsgs()) == nil {
+				continue
+			}
+			// rotated public keys, see MsgChangePubKey, no longer match the address
+			if acc := spkd.ak.GetAccount(ctx, signers[i]); acc != nil && acc.GetPubKey() != nil && acc.GetPubKey().Equals(pk) {
+				continue
+			}
 			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
 				"pubKey does not match signer address %s with signer index: %d", signers[i], i)
 		}
@@ -127,17 +148,21 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 
 // Consume parameter-defined amount of gas for each signature according to the passed-in SignatureVerificationGasConsumer function
 // before calling the next AnteHandler
+// The signatures of the signers with a custom authenticator, if an AuthenticationKeeper
+// is given, are skipped, as their authenticator consumes the gas of their verification.
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
 // CONTRACT: Tx must implement SigVerifiableTx interface
 type SigGasConsumeDecorator struct {
 	ak             AccountKeeper
 	sigGasConsumer SignatureVerificationGasConsumer
+	authk          AuthenticationKeeper
 }
 
-func NewSigGasConsumeDecorator(ak AccountKeeper, sigGasConsumer SignatureVerificationGasConsumer) SigGasConsumeDecorator {
+func NewSigGasConsumeDecorator(ak AccountKeeper, sigGasConsumer SignatureVerificationGasConsumer, authk AuthenticationKeeper) SigGasConsumeDecorator {
 	return SigGasConsumeDecorator{
 		ak:             ak,
 		sigGasConsumer: sigGasConsumer,
+		authk:          authk,
 	}
 }
 
@@ -163,8 +188,22 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 			return ctx, err
 		}
 
+		authenticator, err := getAuthenticator(ctx, sgcd.authk, signerAddrs[i])
+		if err != nil {
+			return ctx, err
+		}
+		if authenticator != nil {
+			continue
+		}
+
 		pubKey := signerAcc.GetPubKey()
 
+		// The signature of a session key is verified against the session key
+		// rather than the account pubkey, see SigVerificationDecorator.
+		if sig.PubKey != nil && !bytes.Equal(sdk.AccAddressFromPubKey(sig.PubKey), signerAddrs[i]) {
+			pubKey = sig.PubKey
+		}
+
 		// In simulate mode the transaction comes with no signatures, thus if the
 		// account's pubkey is nil, both signature verification and gasKVStore.Set()
 		// shall consume the largest amount, i.e. it takes more gas to verify
@@ -191,18 +230,29 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 
 // Verify all signatures for a tx and return an error if any are invalid. Note,
 // the SigVerificationDecorator decorator will not get executed on ReCheck.
+// If a SessionKeeper is given, a signature made with a session key of the
+// signer is verified against it, provided the session key allows the tx msgs.
+// If an AuthenticationKeeper is given, the signature of a signer with a custom
+// authenticator is verified by its authenticator, in place of the pubkey and
+// session keys of the signer.
+// The single SM2 signatures of the tx are verified in batch with an SM2 batch
+// verifier, after the other signatures.
 //
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
 // CONTRACT: Tx must implement SigVerifiableTx interface
 type SigVerificationDecorator struct {
 	ak              AccountKeeper
 	signModeHandler authsigning.SignModeHandler
+	sk              SessionKeeper
+	authk           AuthenticationKeeper
 }
 
-func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler authsigning.SignModeHandler) SigVerificationDecorator {
+func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler authsigning.SignModeHandler, sk SessionKeeper, authk AuthenticationKeeper) SigVerificationDecorator {
 	return SigVerificationDecorator{
 		ak:              ak,
 		signModeHandler: signModeHandler,
+		sk:              sk,
+		authk:           authk,
 	}
 }
 
@@ -251,14 +301,40 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signerAddrs), len(sigs))
 	}
 
+	// The single SM2 signatures are verified together once all the signers are
+	// checked; sm2ErrMsgs holds the error message of each of them.
+	sm2Batch := sm2.NewSm2BatchVerifier()
+	var sm2ErrMsgs []string
+
 	for i, sig := range sigs {
 		acc, err := GetSignerAcc(ctx, svd.ak, signerAddrs[i])
 		if err != nil {
 			return ctx, err
 		}
 
-		// retrieve pubkey
+		authenticator, err := getAuthenticator(ctx, svd.authk, signerAddrs[i])
+		if err != nil {
+			return ctx, err
+		}
+		if authenticator != nil {
+			if !simulate {
+				if err := svd.authenticate(ctx, authenticator, acc, sig, tx); err != nil {
+					return ctx, err
+				}
+			}
+			continue
+		}
+
+		// retrieve pubkey; the keys which do not match the address of the signer
+		// are session keys, unless they are its rotated pubkey, see MsgChangePubKey
 		pubKey := acc.GetPubKey()
+		isRotated := pubKey != nil && sig.PubKey != nil && pubKey.Equals(sig.PubKey)
+		if svd.sk != nil && sig.PubKey != nil && !isRotated && !bytes.Equal(sdk.AccAddressFromPubKey(sig.PubKey), signerAddrs[i]) {
+			if err := svd.sk.ValidateSessionKey(ctx, signerAddrs[i], sig.PubKey, tx.GetMsgs()); err != nil {
+				return ctx, sdkerrors.Wrapf(err, "invalid session key signature for signer %s", signerAddrs[i])
+			}
+			pubKey = sig.PubKey
+		}
 		if !simulate && pubKey == nil {
 			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
 		}
@@ -270,7 +346,7 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		// verification (in the VerifySignature call below).
 		onlyAminoSigners := OnlyLegacyAminoSigners(sig.Data)
 		if !onlyAminoSigners {
-			if sig.Sequence != acc.GetSequence() {
+			if sig.Sequence != signerSequence(tx, acc) {
 				//return ctx, sdkerrors.Wrapf(
 				//	sdkerrors.ErrWrongSequence,
 				//	"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
@@ -288,22 +364,44 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		signerData := authsigning.SignerData{
 			ChainID:       chainID,
 			AccountNumber: accNum,
-			Sequence:      acc.GetSequence(),
+			Sequence:      signerSequence(tx, acc),
 		}
 
 		if !simulate {
+			var errMsg string
+			if OnlyLegacyAminoSigners(sig.Data) {
+				// If all signers are using SIGN_MODE_LEGACY_AMINO, we rely on VerifySignature to check account sequence number,
+				// and therefore communicate sequence number as a potential cause of error.
+				errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, signerData.Sequence, chainID)
+			} else {
+				errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d) and chain-id (%s)", accNum, chainID)
+			}
+
+			if data, ok := sig.Data.(*signing.SingleSignatureData); ok {
+				if _, ok := pubKey.(*sm2.PubKey); ok {
+					signBytes, err := svd.signModeHandler.GetSignBytes(data.SignMode, signerData, tx)
+					if err != nil {
+						return ctx, err
+					}
+					if err := sm2Batch.Add(pubKey, signBytes, data.Signature); err != nil {
+						return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, errMsg)
+					}
+					sm2ErrMsgs = append(sm2ErrMsgs, errMsg)
+					continue
+				}
+			}
+
 			err := authsigning.VerifySignature(pubKey, signerData, sig.Data, svd.signModeHandler, tx)
 			if err != nil {
-				var errMsg string
-				if OnlyLegacyAminoSigners(sig.Data) {
-					// If all signers are using SIGN_MODE_LEGACY_AMINO, we rely on VerifySignature to check account sequence number,
-					// and therefore communicate sequence number as a potential cause of error.
-					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, acc.GetSequence(), chainID)
-				} else {
-					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d) and chain-id (%s)", accNum, chainID)
-				}
 				return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, errMsg)
+			}
+		}
+	}
 
+	if ok, valid := sm2Batch.Verify(); !ok {
+		for i, v := range valid {
+			if !v {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, sm2ErrMsgs[i])
 			}
 		}
 	}
@@ -311,6 +409,39 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 	return next(ctx, tx, simulate)
 }
 
+// authenticate verifies the signature of the account with its custom
+// authenticator, over the bytes the account signed in the requested sign mode.
+func (svd SigVerificationDecorator) authenticate(ctx sdk.Context, authenticator types.Authenticator, acc types.AccountI, sig signing.SignatureV2, tx sdk.Tx) error {
+	var accNum uint64
+	if ctx.BlockHeight() != 0 {
+		accNum = acc.GetAccountNumber()
+	}
+	signerData := authsigning.SignerData{
+		ChainID:       ctx.ChainID(),
+		AccountNumber: accNum,
+		Sequence:      signerSequence(tx, acc),
+	}
+	signBytes := func(mode signing.SignMode) ([]byte, error) {
+		return svd.signModeHandler.GetSignBytes(mode, signerData, tx)
+	}
+
+	if err := authenticator.Authenticate(ctx, acc, sig, signBytes, tx); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "authentication of account %s failed: %s", acc.GetAddress(), err)
+	}
+
+	return nil
+}
+
+// signerSequence returns the sequence the account signed the tx with, which is
+// zero for the unordered txs.
+func signerSequence(tx sdk.Tx, acc types.AccountI) uint64 {
+	if isUnordered(tx) {
+		return 0
+	}
+
+	return acc.GetSequence()
+}
+
 // IncrementSequenceDecorator handles incrementing sequences of all signers.
 // Use the IncrementSequenceDecorator decorator to prevent replay attacks. Note,
 // there is no need to execute IncrementSequenceDecorator on RecheckTX since
@@ -319,7 +450,8 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 // NOTE: Since CheckTx and DeliverTx state are managed separately, subsequent and
 // sequential txs orginating from the same account cannot be handled correctly in
 // a reliable way unless sequence numbers are managed and tracked manually by a
-// client. It is recommended to instead use multiple messages in a tx.
+// client. It is recommended to instead use multiple messages in a tx, or
+// unordered txs, whose sequences are not incremented.
 type IncrementSequenceDecorator struct {
 	ak AccountKeeper
 }
@@ -336,6 +468,11 @@ func (isd IncrementSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
 	}
 
+	// the unordered txs are protected against replays by the UnorderedTxDecorator
+	if isUnordered(tx) {
+		return next(ctx, tx, simulate)
+	}
+
 	// increment sequence of all signers
 	for _, addr := range sigTx.GetSigners() {
 		acc := isd.ak.GetAccount(ctx, addr)
@@ -388,28 +525,18 @@ func (vscd ValidateSigCountDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 }
 
 // DefaultSigVerificationGasConsumer is the default implementation of SignatureVerificationGasConsumer. It consumes gas
-// for signature verification based upon the public key type. The cost is fetched from the given params and is matched
-// by the concrete type.
+// for signature verification based upon the public key type. The cost is fetched from the SigVerifyCosts of the given
+// params by the type URL of the public key.
 func DefaultSigVerificationGasConsumer(
 	meter sdk.GasMeter, sig signing.SignatureV2, params types.Params,
 ) error {
 	pubkey := sig.PubKey
 	switch pubkey := pubkey.(type) {
-	case *sm2.PubKey:
-		meter.ConsumeGas(params.SigVerifyCostSm2, "ante verify: sm2")
-		return nil
 	case *ed25519.PubKey:
-		meter.ConsumeGas(params.SigVerifyCostED25519, "ante verify: ed25519")
+		cost, _ := params.SigVerifyCost("/" + proto.MessageName(pubkey))
+		meter.ConsumeGas(cost, "ante verify: ed25519")
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "ED25519 public keys are unsupported")
 
-	case *secp256k1.PubKey:
-		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
-		return nil
-
-	case *secp256r1.PubKey:
-		meter.ConsumeGas(params.SigVerifyCostSecp256r1(), "ante verify: secp256r1")
-		return nil
-
 	case multisig.PubKey:
 		multisignature, ok := sig.Data.(*signing.MultiSignatureData)
 		if !ok {
@@ -422,7 +549,13 @@ func DefaultSigVerificationGasConsumer(
 		return nil
 
 	default:
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "unrecognized public key type: %T", pubkey)
+		typeURL := "/" + proto.MessageName(pubkey)
+		cost, ok := params.SigVerifyCost(typeURL)
+		if !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "unrecognized public key type: %T", pubkey)
+		}
+		meter.ConsumeGas(cost, "ante verify: "+typeURL)
+		return nil
 	}
 }
 
@@ -454,6 +587,16 @@ func ConsumeMultisignatureVerificationGas(
 	return nil
 }
 
+// getAuthenticator returns the custom authenticator of the signer, or nil if the
+// signer is authenticated by its pubkey or no AuthenticationKeeper is given.
+func getAuthenticator(ctx sdk.Context, authk AuthenticationKeeper, addr sdk.AccAddress) (types.Authenticator, error) {
+	if authk == nil {
+		return nil, nil
+	}
+
+	return authk.GetAuthenticator(ctx, addr)
+}
+
 // GetSignerAcc returns an account for a given address that is expected to sign
 // a transaction.
 func GetSignerAcc(ctx sdk.Context, ak AccountKeeper, addr sdk.AccAddress) (types.AccountI, error) {
@@ -466,7 +609,7 @@ func GetSignerAcc(ctx sdk.Context, ak AccountKeeper, addr sdk.AccAddress) (types
 
 // CountSubKeys counts the total number of keys for a multi-sig public key.
 func CountSubKeys(pub cryptotypes.PubKey) int {
-	v, ok := pub.(*kmultisig.LegacyAminoPubKey)
+	v, ok := pub.(multisig.PubKey)
 	if !ok {
 		return 1
 	}
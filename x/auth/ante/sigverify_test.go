@@ -2,6 +2,7 @@ package ante_test
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -15,10 +16,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	xauthsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func (suite *AnteTestSuite) TestSetPubKey() {
@@ -50,7 +54,7 @@ func (suite *AnteTestSuite) TestSetPubKey() {
 	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
 	require.NoError(err)
 
-	spkd := ante.NewSetPubKeyDecorator(suite.app.AccountKeeper)
+	spkd := ante.NewSetPubKeyDecorator(suite.app.AccountKeeper, nil, nil)
 	antehandler := sdk.ChainAnteDecorators(spkd)
 
 	ctx, err := antehandler(suite.ctx, tx, false)
@@ -65,17 +69,62 @@ func (suite *AnteTestSuite) TestSetPubKey() {
 	}
 }
 
+func (suite *AnteTestSuite) TestSetPubKeyRotated() {
+	suite.SetupTest(true) // setup
+	require := suite.Require()
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	// the public key of the account is rotated to a key of another address
+	_, _, addr := testdata.KeyTestPubAddr()
+	newPriv, newPub, _ := testdata.KeyTestPubAddr()
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	require.NoError(suite.app.AccountKeeper.ChangePubKey(suite.ctx, addr, newPub))
+
+	require.NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	acc = suite.app.AccountKeeper.GetAccount(suite.ctx, addr)
+	otherPriv, _, _ := testdata.KeyTestPubAddr()
+
+	// the rotated key is not taken for a session key when there is a session
+	// keeper, as in SimApp
+	for _, sk := range []ante.SessionKeeper{nil, suite.app.SessionKeeper} {
+		spkd := ante.NewSetPubKeyDecorator(suite.app.AccountKeeper, sk, nil)
+		svd := ante.NewSigVerificationDecorator(suite.app.AccountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), sk, nil)
+		antehandler := sdk.ChainAnteDecorators(spkd, svd)
+
+		tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{newPriv}, []uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, suite.ctx.ChainID())
+		require.NoError(err)
+		ctx, err := antehandler(suite.ctx, tx, false)
+		require.NoError(err)
+
+		pk, err := suite.app.AccountKeeper.GetPubKey(ctx, addr)
+		require.NoError(err)
+		require.True(newPub.Equals(pk))
+
+		// the keys of other addresses are still rejected
+		tx, err = suite.CreateTestTx([]cryptotypes.PrivKey{otherPriv}, []uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, suite.ctx.ChainID())
+		require.NoError(err)
+		_, err = antehandler(suite.ctx, tx, false)
+		require.Error(err)
+	}
+}
+
 func (suite *AnteTestSuite) TestConsumeSignatureVerificationGas() {
 	params := types.DefaultParams()
 	msg := []byte{1, 2, 3, 4}
 	cdc := simapp.MakeTestEncodingConfig().Amino
 
-	p := types.DefaultParams()
+	noSm2Params := types.DefaultParams()
+	noSm2Params.SigVerifyCosts = noSm2Params.SigVerifyCosts[:3]
 	skR1, _ := secp256r1.GenPrivKey()
 	pkSet1, sigSet1 := generatePubKeysAndSignatures(5, msg, false)
 	multisigKey1 := kmultisig.NewLegacyAminoPubKey(2, pkSet1)
 	multisignature1 := multisig.NewMultisig(len(pkSet1))
 	expectedCost1 := expectedGasCostByKeys(pkSet1)
+	weightedMultisigKey1 := kmultisig.NewWeightedMultisigPubKey(2, pkSet1, []uint32{1, 1, 1, 1, 1})
 	for i := 0; i < len(pkSet1); i++ {
 		stdSig := legacytx.StdSignature{PubKey: pkSet1[i], Signature: sigSet1[i]}
 		sigV2, err := legacytx.StdSignatureToSignatureV2(cdc, stdSig)
@@ -96,11 +145,13 @@ func (suite *AnteTestSuite) TestConsumeSignatureVerificationGas() {
 		gasConsumed uint64
 		shouldErr   bool
 	}{
-		{"PubKeyEd25519", args{sdk.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, p.SigVerifyCostED25519, true},
+		{"PubKeyEd25519", args{sdk.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostED25519, true},
 		{"PubKeySm2", args{sdk.NewInfiniteGasMeter(), nil, sm2.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostSm2, false},
-		{"PubKeySecp256k1", args{sdk.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, p.SigVerifyCostSecp256k1, false},
-		{"PubKeySecp256r1", args{sdk.NewInfiniteGasMeter(), nil, skR1.PubKey(), params}, p.SigVerifyCostSecp256r1(), false},
+		{"PubKeySm2 without cost", args{sdk.NewInfiniteGasMeter(), nil, sm2.GenPrivKey().PubKey(), noSm2Params}, 0, true},
+		{"PubKeySecp256k1", args{sdk.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostSecp256k1, false},
+		{"PubKeySecp256r1", args{sdk.NewInfiniteGasMeter(), nil, skR1.PubKey(), params}, types.DefaultSigVerifyCostSecp256r1, false},
 		{"Multisig", args{sdk.NewInfiniteGasMeter(), multisignature1, multisigKey1, params}, expectedCost1, false},
+		{"WeightedMultisig", args{sdk.NewInfiniteGasMeter(), multisignature1, weightedMultisigKey1, params}, expectedCost1, false},
 		{"unknown key", args{sdk.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
 	}
 	for _, tt := range tests {
@@ -146,8 +197,8 @@ func (suite *AnteTestSuite) TestSigVerification() {
 	feeAmount := testdata.NewTestFeeAmount()
 	gasLimit := testdata.NewTestGasLimit()
 
-	spkd := ante.NewSetPubKeyDecorator(suite.app.AccountKeeper)
-	svd := ante.NewSigVerificationDecorator(suite.app.AccountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+	spkd := ante.NewSetPubKeyDecorator(suite.app.AccountKeeper, nil, nil)
+	svd := ante.NewSigVerificationDecorator(suite.app.AccountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), nil, nil)
 	antehandler := sdk.ChainAnteDecorators(spkd, svd)
 
 	type testCase struct {
@@ -187,6 +238,59 @@ func (suite *AnteTestSuite) TestSigVerification() {
 	}
 }
 
+func (suite *AnteTestSuite) TestSigVerificationSm2Batch() {
+	suite.SetupTest(true) // setup
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	privs := []cryptotypes.PrivKey{secp256k1.GenPrivKey()}
+	for i := 0; i < 3; i++ {
+		priv := sm2.GenPrivKey()
+		privs = append(privs, &priv)
+	}
+
+	msgs := make([]sdk.Msg, len(privs))
+	for i, priv := range privs {
+		addr := sdk.AccAddress(priv.PubKey().Address())
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+		suite.Require().NoError(acc.SetAccountNumber(uint64(i)))
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+		msgs[i] = testdata.NewTestMsg(addr)
+	}
+
+	spkd := ante.NewSetPubKeyDecorator(suite.app.AccountKeeper, nil, nil)
+	svd := ante.NewSigVerificationDecorator(suite.app.AccountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), nil, nil)
+	antehandler := sdk.ChainAnteDecorators(spkd, svd)
+
+	testCases := []struct {
+		name    string
+		accNums []uint64
+		expErr  string
+	}{
+		{"valid tx", []uint64{0, 1, 2, 3}, ""},
+		{"invalid sm2 signature", []uint64{0, 1, 9, 3}, "please verify account number (2)"},
+		{"invalid secp256k1 signature", []uint64{9, 1, 2, 3}, "please verify account number (0)"},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			suite.Require().NoError(suite.txBuilder.SetMsgs(msgs...))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			tx, err := suite.CreateTestTx(privs, tc.accNums, []uint64{0, 0, 0, 0}, suite.ctx.ChainID())
+			suite.Require().NoError(err)
+
+			_, err = antehandler(suite.ctx, tx, false)
+			if tc.expErr == "" {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+				suite.Require().Contains(err.Error(), tc.expErr)
+			}
+		})
+	}
+}
+
 // This test is exactly like the one above, but we set the codec explicitly to
 // Amino.
 // Once https://github.com/cosmos/cosmos-sdk/issues/6190 is in, we can remove
@@ -242,8 +346,8 @@ func (suite *AnteTestSuite) TestSigVerification_ExplicitAmino() {
 	feeAmount := testdata.NewTestFeeAmount()
 	gasLimit := testdata.NewTestGasLimit()
 
-	spkd := ante.NewSetPubKeyDecorator(suite.app.AccountKeeper)
-	svd := ante.NewSigVerificationDecorator(suite.app.AccountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+	spkd := ante.NewSetPubKeyDecorator(suite.app.AccountKeeper, nil, nil)
+	svd := ante.NewSigVerificationDecorator(suite.app.AccountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), nil, nil)
 	antehandler := sdk.ChainAnteDecorators(spkd, svd)
 
 	type testCase struct {
@@ -292,11 +396,11 @@ func (suite *AnteTestSuite) TestSigIntegration() {
 	}
 
 	params := types.DefaultParams()
-	initialSigCost := params.SigVerifyCostSecp256k1
+	initialSigCost, _ := params.SigVerifyCost(types.PubKeyTypeURLSecp256k1)
 	initialCost, err := suite.runSigDecorators(params, false, privs...)
 	suite.Require().Nil(err)
 
-	params.SigVerifyCostSecp256k1 *= 2
+	params.SigVerifyCosts[1].Cost *= 2
 	doubleCost, err := suite.runSigDecorators(params, false, privs...)
 	suite.Require().Nil(err)
 
@@ -334,9 +438,9 @@ func (suite *AnteTestSuite) runSigDecorators(params types.Params, _ bool, privs
 	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
 	suite.Require().NoError(err)
 
-	spkd := ante.NewSetPubKeyDecorator(suite.app.AccountKeeper)
-	svgc := ante.NewSigGasConsumeDecorator(suite.app.AccountKeeper, ante.DefaultSigVerificationGasConsumer)
-	svd := ante.NewSigVerificationDecorator(suite.app.AccountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+	spkd := ante.NewSetPubKeyDecorator(suite.app.AccountKeeper, nil, nil)
+	svgc := ante.NewSigGasConsumeDecorator(suite.app.AccountKeeper, ante.DefaultSigVerificationGasConsumer, nil)
+	svd := ante.NewSigVerificationDecorator(suite.app.AccountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), nil, nil)
 	antehandler := sdk.ChainAnteDecorators(spkd, svgc, svd)
 
 	// Determine gas consumption of antehandler with default params
@@ -347,6 +451,206 @@ func (suite *AnteTestSuite) runSigDecorators(params types.Params, _ bool, privs
 	return after - before, err
 }
 
+func (suite *AnteTestSuite) TestSigVerificationSessionKey() {
+	suite.SetupTest(false) // setup
+	suite.ctx = suite.ctx.WithBlockTime(time.Now().UTC())
+
+	_, _, granter := testdata.KeyTestPubAddr()
+	sessionPriv, _, _ := testdata.KeyTestPubAddr()
+	otherPriv, _, _ := testdata.KeyTestPubAddr()
+
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, granter)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	expiration := suite.ctx.BlockTime().Add(time.Hour)
+	msgs := []sdk.Msg{testdata.NewTestMsg(granter)}
+	suite.Require().NoError(suite.app.SessionKeeper.AddSessionKey(
+		suite.ctx, granter, sessionPriv.PubKey(), expiration, []string{sdk.MsgTypeURL(msgs[0])},
+	))
+	suite.Require().NoError(suite.app.SessionKeeper.AddSessionKey(
+		suite.ctx, granter, otherPriv.PubKey(), expiration, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+	))
+
+	spkd := ante.NewSetPubKeyDecorator(suite.app.AccountKeeper, suite.app.SessionKeeper, nil)
+	svgc := ante.NewSigGasConsumeDecorator(suite.app.AccountKeeper, ante.DefaultSigVerificationGasConsumer, nil)
+	svd := ante.NewSigVerificationDecorator(suite.app.AccountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), suite.app.SessionKeeper, nil)
+	antehandler := sdk.ChainAnteDecorators(spkd, svgc, svd)
+
+	testCases := []struct {
+		name      string
+		priv      cryptotypes.PrivKey
+		ctx       sdk.Context
+		shouldErr bool
+	}{
+		{"valid session key", sessionPriv, suite.ctx, false},
+		{"msg not allowed", otherPriv, suite.ctx, true},
+		{"expired session key", sessionPriv, suite.ctx.WithBlockTime(expiration), true},
+		{"unknown session key", secp256k1.GenPrivKey(), suite.ctx, true},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			suite.Require().NoError(suite.txBuilder.SetMsgs(msgs...))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{tc.priv}, []uint64{acc.GetAccountNumber()}, []uint64{0}, suite.ctx.ChainID())
+			suite.Require().NoError(err)
+
+			_, err = antehandler(tc.ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), tx, false)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			// session keys are never set as the account pubkey
+			suite.Require().Nil(suite.app.AccountKeeper.GetAccount(suite.ctx, granter).GetPubKey())
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestSigVerificationAuthenticator() {
+	suite.SetupTest(false) // setup
+
+	priv, _, addr := testdata.KeyTestPubAddr()
+	rotatedPriv, _, _ := testdata.KeyTestPubAddr()
+	otherPriv, _, otherAddr := testdata.KeyTestPubAddr()
+
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	otherAcc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, otherAddr)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, otherAcc)
+
+	// the account signs with the key it rotated to, instead of its own key
+	suite.app.AccountKeeper.RegisterAuthenticator("rotated", types.AuthenticatorFunc(
+		func(_ sdk.Context, _ types.AccountI, sig signing.SignatureV2, signBytes types.SignBytesFunc, _ sdk.Tx) error {
+			data, ok := sig.Data.(*signing.SingleSignatureData)
+			if !ok {
+				return fmt.Errorf("expected a single signature")
+			}
+			bz, err := signBytes(data.SignMode)
+			if err != nil {
+				return err
+			}
+			if !rotatedPriv.PubKey().VerifySignature(bz, data.Signature) {
+				return fmt.Errorf("not signed by the rotated key")
+			}
+
+			return nil
+		},
+	))
+	suite.Require().NoError(suite.app.AccountKeeper.SetAccountAuthenticator(suite.ctx, addr, "rotated"))
+
+	spkd := ante.NewSetPubKeyDecorator(suite.app.AccountKeeper, nil, suite.app.AccountKeeper)
+	svgc := ante.NewSigGasConsumeDecorator(suite.app.AccountKeeper, ante.DefaultSigVerificationGasConsumer, suite.app.AccountKeeper)
+	svd := ante.NewSigVerificationDecorator(suite.app.AccountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), nil, suite.app.AccountKeeper)
+	antehandler := sdk.ChainAnteDecorators(spkd, svgc, svd)
+
+	testCases := []struct {
+		name      string
+		signer    sdk.AccAddress
+		priv      cryptotypes.PrivKey
+		accNum    uint64
+		shouldErr bool
+	}{
+		{"signed by the rotated key", addr, rotatedPriv, acc.GetAccountNumber(), false},
+		{"signed by the account key", addr, priv, acc.GetAccountNumber(), true},
+		{"wrong account number", addr, rotatedPriv, acc.GetAccountNumber() + 1, true},
+		{"account without authenticator", otherAddr, otherPriv, otherAcc.GetAccountNumber(), false},
+		{"account without authenticator signed by the rotated key", otherAddr, rotatedPriv, otherAcc.GetAccountNumber(), true},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(tc.signer)))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{tc.priv}, []uint64{tc.accNum}, []uint64{0}, suite.ctx.ChainID())
+			suite.Require().NoError(err)
+
+			_, err = antehandler(suite.ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), tx, false)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+
+	// the pubkeys of the accounts with an authenticator are never set
+	suite.Require().Nil(suite.app.AccountKeeper.GetAccount(suite.ctx, addr).GetPubKey())
+}
+
+func (suite *AnteTestSuite) TestSigVerificationWeightedMultisig() {
+	suite.SetupTest(false) // setup
+
+	priv1, _, _ := testdata.KeyTestPubAddr()
+	priv2, _, _ := testdata.KeyTestPubAddr()
+	priv3, _, _ := testdata.KeyTestPubAddr()
+	pubKeys := []cryptotypes.PubKey{priv1.PubKey(), priv2.PubKey(), priv3.PubKey()}
+	multisigKey := kmultisig.NewWeightedMultisigPubKey(3, pubKeys, []uint32{2, 1, 1})
+	addr := sdk.AccAddress(multisigKey.Address())
+
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	spkd := ante.NewSetPubKeyDecorator(suite.app.AccountKeeper, nil, nil)
+	svgc := ante.NewSigGasConsumeDecorator(suite.app.AccountKeeper, ante.DefaultSigVerificationGasConsumer, nil)
+	svd := ante.NewSigVerificationDecorator(suite.app.AccountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), nil, nil)
+	antehandler := sdk.ChainAnteDecorators(spkd, svgc, svd)
+
+	testCases := []struct {
+		name      string
+		privs     []cryptotypes.PrivKey
+		shouldErr bool
+	}{
+		{"heavy and light keys reach the threshold", []cryptotypes.PrivKey{priv1, priv3}, false},
+		{"all keys", []cryptotypes.PrivKey{priv1, priv2, priv3}, false},
+		{"light keys do not reach the threshold", []cryptotypes.PrivKey{priv2, priv3}, true},
+		{"heavy key does not reach the threshold", []cryptotypes.PrivKey{priv1}, true},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			signerData := xauthsigning.SignerData{
+				ChainID:       suite.ctx.ChainID(),
+				AccountNumber: acc.GetAccountNumber(),
+				Sequence:      acc.GetSequence(),
+			}
+			signBytes, err := suite.clientCtx.TxConfig.SignModeHandler().GetSignBytes(
+				signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, suite.txBuilder.GetTx(),
+			)
+			suite.Require().NoError(err)
+
+			multisignature := multisig.NewMultisig(len(pubKeys))
+			for _, priv := range tc.privs {
+				sig, err := priv.Sign(signBytes)
+				suite.Require().NoError(err)
+				sigData := &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, Signature: sig}
+				suite.Require().NoError(multisig.AddSignatureFromPubKey(multisignature, sigData, priv.PubKey(), pubKeys))
+			}
+			suite.Require().NoError(suite.txBuilder.SetSignatures(signing.SignatureV2{
+				PubKey:   multisigKey,
+				Data:     multisignature,
+				Sequence: acc.GetSequence(),
+			}))
+
+			_, err = antehandler(suite.ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), suite.txBuilder.GetTx(), false)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
 func (suite *AnteTestSuite) TestIncrementSequenceDecorator() {
 	suite.SetupTest(true) // setup
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
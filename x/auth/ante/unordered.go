@@ -0,0 +1,79 @@
+package ante
+
+import (
+	"time"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultMaxUnorderedTxTimeout is the default maximum duration from the block
+// time to the timeout timestamp of the unordered txs.
+const DefaultMaxUnorderedTxTimeout = 10 * time.Minute
+
+// UnorderedTxDecorator protects the unordered txs against replays. As their
+// sequences are neither checked nor incremented, an unordered tx must instead
+// set a timeout timestamp, at most the max timeout after the block time, and is
+// rejected if its hash was already processed. The hashes are recorded in the
+// index of the TxHashKeeper until they time out, so that the retained hashes
+// are bounded by the max timeout. If the keeper is nil, the unordered txs are
+// rejected.
+type UnorderedTxDecorator struct {
+	txHashKeeper TxHashKeeper
+	maxTimeout   time.Duration
+}
+
+// NewUnorderedTxDecorator returns an UnorderedTxDecorator with the given max
+// timeout, or DefaultMaxUnorderedTxTimeout if zero.
+func NewUnorderedTxDecorator(txHashKeeper TxHashKeeper, maxTimeout time.Duration) UnorderedTxDecorator {
+	if maxTimeout == 0 {
+		maxTimeout = DefaultMaxUnorderedTxTimeout
+	}
+
+	return UnorderedTxDecorator{
+		txHashKeeper: txHashKeeper,
+		maxTimeout:   maxTimeout,
+	}
+}
+
+func (utd UnorderedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	unorderedTx, ok := tx.(sdk.TxWithUnordered)
+	if !ok || !unorderedTx.GetUnordered() {
+		return next(ctx, tx, simulate)
+	}
+
+	if utd.txHashKeeper == nil {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrNotSupported, "unordered txs are not supported")
+	}
+
+	timeout := unorderedTx.GetTimeoutTimestamp()
+	if timeout.IsZero() {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unordered tx must set a timeout timestamp")
+	}
+	if maxTimeout := ctx.BlockTime().Add(utd.maxTimeout); timeout.After(maxTimeout) {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "unordered tx timeout timestamp %s is after the max timeout %s", timeout, maxTimeout,
+		)
+	}
+
+	// simulated txs are not processed, and their bytes may not be final
+	if simulate || len(ctx.TxBytes()) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	hash := tmhash.Sum(ctx.TxBytes())
+	if utd.txHashKeeper.HasSeenTx(ctx, hash) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrTxReplayed, "unordered tx %X", hash)
+	}
+	utd.txHashKeeper.MarkTxSeenUntil(ctx, hash, timeout)
+
+	return next(ctx, tx, simulate)
+}
+
+// isUnordered returns true if the tx is unordered.
+func isUnordered(tx sdk.Tx) bool {
+	unorderedTx, ok := tx.(sdk.TxWithUnordered)
+	return ok && unorderedTx.GetUnordered()
+}
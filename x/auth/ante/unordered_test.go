@@ -0,0 +1,82 @@
+package ante_test
+
+import (
+	"time"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+func (suite *AnteTestSuite) TestUnorderedTxDecorator() {
+	suite.SetupTest(false) // setup
+	blockTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.ctx = suite.ctx.WithBlockTime(blockTime)
+
+	anteHandler, err := ante.NewAnteHandler(
+		ante.HandlerOptions{
+			AccountKeeper:   suite.app.AccountKeeper,
+			BankKeeper:      suite.app.BankKeeper,
+			SignModeHandler: suite.clientCtx.TxConfig.SignModeHandler(),
+			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			TxHashKeeper:    suite.app.TxResultKeeper,
+		},
+	)
+	suite.Require().NoError(err)
+
+	accs := suite.CreateTestAccounts(1)
+	addr := accs[0].acc.GetAddress()
+	privs, accNums := []cryptotypes.PrivKey{accs[0].priv}, []uint64{accs[0].acc.GetAccountNumber()}
+
+	encodeTx := func(timeout time.Time) []byte {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+		suite.txBuilder.SetTimeoutTimestamp(timeout)
+		suite.txBuilder.SetUnordered(true)
+
+		tx, err := suite.CreateTestTx(privs, accNums, []uint64{0}, suite.ctx.ChainID())
+		suite.Require().NoError(err)
+		bz, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+		suite.Require().NoError(err)
+
+		return bz
+	}
+	runTx := func(anteHandler sdk.AnteHandler, bz []byte) error {
+		tx, err := suite.clientCtx.TxConfig.TxDecoder()(bz)
+		suite.Require().NoError(err)
+
+		_, err = anteHandler(suite.ctx.WithTxBytes(bz), tx, false)
+		return err
+	}
+
+	timeout := blockTime.Add(5 * time.Minute)
+	bz := encodeTx(timeout)
+	suite.Require().NoError(runTx(anteHandler, bz))
+
+	// the sequence is not incremented, and the tx cannot be replayed
+	suite.Require().Equal(uint64(0), suite.app.AccountKeeper.GetAccount(suite.ctx, addr).GetSequence())
+	suite.Require().True(suite.app.TxResultKeeper.HasSeenTx(suite.ctx, tmhash.Sum(bz)))
+	suite.Require().ErrorIs(runTx(anteHandler, bz), sdkerrors.ErrTxReplayed)
+
+	// other unordered txs of the signer are accepted
+	later := timeout.Add(time.Minute)
+	suite.Require().NoError(runTx(anteHandler, encodeTx(later)))
+
+	// the timeout timestamp is required, and bounded by the max timeout
+	suite.Require().ErrorIs(runTx(anteHandler, encodeTx(time.Time{})), sdkerrors.ErrInvalidRequest)
+	suite.Require().ErrorIs(runTx(anteHandler, encodeTx(blockTime.Add(20*time.Minute))), sdkerrors.ErrInvalidRequest)
+
+	// the unordered txs are rejected without a TxHashKeeper
+	suite.Require().ErrorIs(runTx(suite.anteHandler, encodeTx(later.Add(time.Minute))), sdkerrors.ErrNotSupported)
+
+	// the timed out txs are pruned
+	suite.app.TxResultKeeper.PruneSeenTxs(suite.ctx.WithBlockTime(timeout.Add(time.Second)))
+	suite.Require().False(suite.app.TxResultKeeper.HasSeenTx(suite.ctx, tmhash.Sum(bz)))
+	suite.Require().True(suite.app.TxResultKeeper.HasSeenTx(suite.ctx, tmhash.Sum(encodeTx(later))))
+}
@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types/proposal"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	FlagGrant   = "grant"
+	FlagRevoke  = "revoke"
+	FlagBlock   = "block"
+	FlagUnblock = "unblock"
+)
+
+// NewCmdSubmitModuleAccountPermissionsProposal implements a command handler for submitting a
+// module account permissions proposal transaction.
+func NewCmdSubmitModuleAccountPermissionsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-account-permissions [module-name] (--grant [permissions]) (--revoke [permissions]) [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to grant or revoke permissions of a module account",
+		Long: "Submit a proposal to grant permissions to and revoke permissions from a registered module account,\n" +
+			"along with an initial deposit. Only the minter and burner permissions can be changed by governance.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			grant, err := cmd.Flags().GetStringSlice(FlagGrant)
+			if err != nil {
+				return err
+			}
+
+			revoke, err := cmd.Flags().GetStringSlice(FlagRevoke)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := proposal.NewModuleAccountPermissionsProposal(title, description, args[0], grant, revoke)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().StringSlice(FlagGrant, nil, "Comma-separated permissions to grant to the module account")
+	cmd.Flags().StringSlice(FlagRevoke, nil, "Comma-separated permissions to revoke from the module account")
+	cmd.MarkFlagRequired(govcli.FlagTitle)
+	cmd.MarkFlagRequired(govcli.FlagDescription)
+
+	return cmd
+}
+
+// NewCmdSubmitBlocklistProposal implements a command handler for submitting a
+// blocklist proposal transaction.
+func NewCmdSubmitBlocklistProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blocklist (--block [addresses]) (--unblock [addresses]) [flags]",
+		Args:  cobra.NoArgs,
+		Short: "Submit a proposal to block or unblock addresses",
+		Long: "Submit a proposal to add addresses to and remove addresses from the blocklist, along with an initial\n" +
+			"deposit. The blocked addresses cannot sign txs nor send or receive funds.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			block, err := cmd.Flags().GetStringSlice(FlagBlock)
+			if err != nil {
+				return err
+			}
+
+			unblock, err := cmd.Flags().GetStringSlice(FlagUnblock)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := proposal.NewBlocklistProposal(title, description, block, unblock)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().StringSlice(FlagBlock, nil, "Comma-separated addresses to add to the blocklist")
+	cmd.Flags().StringSlice(FlagUnblock, nil, "Comma-separated addresses to remove from the blocklist")
+	cmd.MarkFlagRequired(govcli.FlagTitle)
+	cmd.MarkFlagRequired(govcli.FlagDescription)
+
+	return cmd
+}
@@ -9,6 +9,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -41,13 +43,114 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetAccountCmd(),
+		GetAccountByPubKeyCmd(),
 		GetAccountsCmd(),
 		QueryParamsCmd(),
+		QueryModuleAccountPermissionsCmd(),
+		QueryModuleAccountsCmd(),
+		QueryModuleAccountByNameCmd(),
+		QueryBlockedAddressesCmd(),
 	)
 
 	return cmd
 }
 
+// QueryModuleAccountPermissionsCmd returns the command handler for module account
+// permissions querying.
+func QueryModuleAccountPermissionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-account-permissions",
+		Short: "Query the permissions currently granted to the registered module accounts",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(`Query the permissions currently granted to the registered module accounts:
+
+$ <appd> query auth module-account-permissions
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ModuleAccountPermissions(cmd.Context(), &types.QueryModuleAccountPermissionsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryModuleAccountsCmd returns the command handler for module accounts
+// querying.
+func QueryModuleAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-accounts",
+		Short: "Query the address, permissions and balance of all registered module accounts",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(`Query the address, permissions and balance of all registered module accounts:
+
+$ <appd> query auth module-accounts
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ModuleAccounts(cmd.Context(), &types.QueryModuleAccountsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryModuleAccountByNameCmd returns the command handler for module account
+// querying by name.
+func QueryModuleAccountByNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-account [module-name]",
+		Short: "Query the address, permissions and balance of a registered module account",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(`Query the address, permissions and balance of a registered module account:
+
+$ <appd> query auth module-account mint
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ModuleAccountByName(cmd.Context(), &types.QueryModuleAccountByNameRequest{Name: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Account)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // QueryParamsCmd returns the command handler for evidence parameter querying.
 func QueryParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -111,6 +214,89 @@ func GetAccountCmd() *cobra.Command {
 	return cmd
 }
 
+// GetAccountByPubKeyCmd returns a query account that will display the state
+// of the account owning a given public key.
+func GetAccountByPubKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-by-pubkey [pubkey]",
+		Short: "Query for account by public key",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the account owning a public key, given in JSON.
+
+Example:
+$ %s query auth account-by-pubkey '{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A8ahAhiBD8Ge3+Ll7qt3CsMiWQMEgdRJYV0BakqdEwLb"}'
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pubKey cryptotypes.PubKey
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pubKey); err != nil {
+				return err
+			}
+			pubKeyAny, err := codectypes.NewAnyWithValue(pubKey)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AccountByPubKey(cmd.Context(), &types.QueryAccountByPubKeyRequest{PubKey: pubKeyAny})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Account)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryBlockedAddressesCmd returns the command handler for blocklist querying.
+func QueryBlockedAddressesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blocked-addresses",
+		Short: "Query the addresses of the blocklist",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(`Query the addresses of the governance blocklist, which cannot sign txs nor send or receive funds:
+
+$ <appd> query auth blocked-addresses
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BlockedAddresses(cmd.Context(), &types.QueryBlockedAddressesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "blocked addresses")
+
+	return cmd
+}
+
 // GetAccountsCmd returns a query command that will display a list of accounts
 func GetAccountsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+)
+
+// stateApp defines the BaseApp methods needed to read the application state
+// outside of a block.
+type stateApp interface {
+	LastBlockHeight() int64
+	NewUncachedContext(isCheckTx bool, header tmproto.Header) sdk.Context
+}
+
+// NewSm2PubKeysReportCmd returns a command reporting the accounts of the
+// application database of the node home whose SM2 public keys are in a legacy
+// amino encoding, which the migration of the auth module re-encodes.
+func NewSm2PubKeysReportCmd(appCreator servertypes.AppCreator, accountKeeper func(servertypes.Application) keeper.AccountKeeper, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sm2-pubkeys",
+		Args:  cobra.NoArgs,
+		Short: "Report the accounts whose SM2 public keys are in a legacy encoding",
+		Long: `Report the accounts of the application database of the node home whose SM2
+public keys are in a legacy amino encoding, written by the older versions of the
+fork. The migration of the auth module re-encodes them into their proto form
+when the chain upgrades, provided that every re-encoded public key derives the
+address of its account.
+
+The state is only read, from the last committed height. The node should be
+stopped while the command runs.
+
+The report lists the address and the legacy encodings of every account, and the
+reason the accounts which cannot be migrated fail, in which case the command
+exits with an error.
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			db, err := sdk.NewLevelDB("application", filepath.Join(config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			app := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+			baseApp, ok := app.(stateApp)
+			if !ok {
+				return fmt.Errorf("application %T does not support reading its state", app)
+			}
+
+			ctx := baseApp.NewUncachedContext(false, tmproto.Header{Height: baseApp.LastBlockHeight()})
+			ctx, _ = ctx.CacheContext()
+			report, err := accountKeeper(app).MigrateSm2PubKeys(ctx, true)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+
+			for _, acc := range report.Accounts {
+				if acc.Error != "" {
+					return fmt.Errorf("the SM2 public key of account %s cannot be migrated: %s", acc.Address, acc.Error)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
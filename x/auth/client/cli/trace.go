@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// GetTraceCommand returns the command re-executing a committed transaction to
+// trace the gas used, events emitted and store accesses of its messages.
+func GetTraceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace [hash]",
+		Short: "Trace the gas used by each message of a committed transaction",
+		Long: strings.TrimSpace(fmt.Sprintf(`Re-execute a committed transaction against the state of its block, on the
+queried node, and print the gas used by its AnteHandler, and the gas used,
+events emitted and store reads and writes of each of its messages. A failed
+transaction, e.g. out of gas, is traced up to the message which failed.
+
+The node must have the state of the height before the block of the
+transaction, i.e. not be pruning it.
+
+Example:
+$ %s tx trace <hash>
+`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			serviceClient := txtypes.NewServiceClient(clientCtx)
+			res, err := serviceClient.TraceTx(cmd.Context(), &txtypes.TraceTxRequest{Hash: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
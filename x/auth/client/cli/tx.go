@@ -0,0 +1,148 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/auth transaction
+// commands.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Auth transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewChangePubKeyCmd(),
+		NewSetAuthenticatorCmd(),
+		NewClearAuthenticatorCmd(),
+	)
+
+	return txCmd
+}
+
+// NewChangePubKeyCmd returns a CLI command handler for rotating the public
+// key of an account.
+func NewChangePubKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-pubkey [pubkey]",
+		Short: "Rotate the public key of an account to a new one",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Rotate the public key of the --from account to a new public key, given in
+JSON, e.g. after the key of the account was compromised. The address and the
+sequence of the account are kept, and the transactions of the account must be
+signed with the new key from then on.
+
+Example:
+$ %s tx auth change-pubkey '{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A8ahAhiBD8Ge3+Ll7qt3CsMiWQMEgdRJYV0BakqdEwLb"}' --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pubKey cryptotypes.PubKey
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pubKey); err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgChangePubKey(clientCtx.GetFromAddress(), pubKey)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetAuthenticatorCmd returns a CLI command handler for setting the custom
+// authenticator of an account.
+func NewSetAuthenticatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-authenticator [name]",
+		Short: "Authenticate an account by a registered authenticator instead of its public key",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the authenticator registered by the chain under the given name as the
+custom authenticator of the --from account. The signatures of the transactions
+of the account are verified by the authenticator from then on, in place of its
+public key.
+
+Example:
+$ %s tx auth set-authenticator smart-account --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAuthenticator(clientCtx.GetFromAddress(), args[0])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewClearAuthenticatorCmd returns a CLI command handler for clearing the
+// custom authenticator of an account.
+func NewClearAuthenticatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clear-authenticator",
+		Short: "Authenticate an account by its public key again",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Clear the custom authenticator of the --from account, whose transactions are
+then authenticated by its public key again. The transaction is signed in
+the way the authenticator of the account verifies.
+
+Example:
+$ %s tx auth clear-authenticator --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAuthenticator(clientCtx.GetFromAddress(), "")
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -13,7 +13,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
-	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
@@ -91,8 +90,8 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) (err error) {
 			return err
 		}
 
-		multisigPub := multisigInfo.GetPubKey().(*kmultisig.LegacyAminoPubKey)
-		multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
+		multisigPub := multisigInfo.GetPubKey().(multisig.PubKey)
+		multisigSig := multisig.NewMultisig(len(multisigPub.GetPubKeys()))
 		if !clientCtx.Offline {
 			accnum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, multisigInfo.GetAddress())
 			if err != nil {
@@ -122,7 +121,7 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) (err error) {
 			for _, sig := range sigs {
 				err = signing.VerifySignature(sig.PubKey, signingData, sig.Data, txCfg.SignModeHandler(), txBuilder.GetTx())
 				if err != nil {
-					addr, _ := sdk.AccAddressFromHex(sig.PubKey.Address().String())
+					addr := sdk.AccAddressFromPubKey(sig.PubKey)
 					return fmt.Errorf("couldn't verify signature for address %s", addr)
 				}
 
@@ -297,8 +296,8 @@ func makeBatchMultisignCmd() func(cmd *cobra.Command, args []string) error {
 				return err
 			}
 
-			multisigPub := multisigInfo.GetPubKey().(*kmultisig.LegacyAminoPubKey)
-			multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
+			multisigPub := multisigInfo.GetPubKey().(multisig.PubKey)
+			multisigSig := multisig.NewMultisig(len(multisigPub.GetPubKeys()))
 			signingData := signing.SignerData{
 				ChainID:       txFactory.ChainID(),
 				AccountNumber: txFactory.AccountNumber(),
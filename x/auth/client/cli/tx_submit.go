@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+)
+
+const flagFile = "file"
+
+// GetSubmitCommand returns the tx submit command.
+func GetSubmitCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit --file [file_path] --from [key]",
+		Short: "Build, sign and broadcast a transaction of messages read from a file",
+		Long: strings.TrimSpace(`Read one or more messages from a YAML or JSON file, wrap them into a
+transaction and sign and broadcast it, or print it with --generate-only. If you
+supply a dash (-) in place of the file path, the command reads from standard
+input.
+
+Each message is the proto-JSON of a Msg along with its type URL. The messages
+are validated against the registered Msg types, reporting every invalid field
+along with its path, before the transaction is built:
+
+messages:
+- '@type': /cosmos.bank.v1beta1.MsgSend
+  from_address: cosmos1...
+  to_address: cosmos1...
+  amount:
+  - denom: stake
+    amount: "10"
+
+$ <appd> tx submit --file ./msgs.yaml --from mykey
+`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			filename, _ := cmd.Flags().GetString(flagFile)
+			if filename == "" {
+				return errors.New("the messages file must be set with --file")
+			}
+
+			msgs, err := authclient.ReadMsgsFromFile(clientCtx, filename)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	cmd.Flags().String(flagFile, "", "The YAML or JSON file holding the messages")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
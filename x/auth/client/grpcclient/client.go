@@ -0,0 +1,189 @@
+// Code generated by typedgen. DO NOT EDIT.
+
+// Package grpcclient implements the typed clients of the auth module.
+package grpcclient
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/typed"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// QueryClient is the typed client of the auth Query service, retrying
+// the queries failing with a transient error.
+type QueryClient struct {
+	client types.QueryClient
+	retry  typed.RetryConfig
+}
+
+// NewQueryClient returns a QueryClient querying through the connection.
+func NewQueryClient(conn gogogrpc.ClientConn, retry typed.RetryConfig) *QueryClient {
+	return &QueryClient{
+		client: types.NewQueryClient(conn),
+		retry:  retry,
+	}
+}
+
+// Account calls the auth Query/Account method.
+func (c *QueryClient) Account(ctx context.Context, req *types.QueryAccountRequest, opts ...grpc.CallOption) (*types.QueryAccountResponse, error) {
+	var res *types.QueryAccountResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Account(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// AccountByPubKey calls the auth Query/AccountByPubKey method.
+func (c *QueryClient) AccountByPubKey(ctx context.Context, req *types.QueryAccountByPubKeyRequest, opts ...grpc.CallOption) (*types.QueryAccountByPubKeyResponse, error) {
+	var res *types.QueryAccountByPubKeyResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.AccountByPubKey(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// Accounts calls the auth Query/Accounts method.
+func (c *QueryClient) Accounts(ctx context.Context, req *types.QueryAccountsRequest, opts ...grpc.CallOption) (*types.QueryAccountsResponse, error) {
+	var res *types.QueryAccountsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Accounts(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateAccounts calls the auth Query/Accounts method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateAccounts(ctx context.Context, req *types.QueryAccountsRequest, fn func(*types.QueryAccountsResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq types.QueryAccountsRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.Accounts(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// BlockedAddresses calls the auth Query/BlockedAddresses method.
+func (c *QueryClient) BlockedAddresses(ctx context.Context, req *types.QueryBlockedAddressesRequest, opts ...grpc.CallOption) (*types.QueryBlockedAddressesResponse, error) {
+	var res *types.QueryBlockedAddressesResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.BlockedAddresses(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateBlockedAddresses calls the auth Query/BlockedAddresses method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateBlockedAddresses(ctx context.Context, req *types.QueryBlockedAddressesRequest, fn func(*types.QueryBlockedAddressesResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq types.QueryBlockedAddressesRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.BlockedAddresses(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// ModuleAccountByName calls the auth Query/ModuleAccountByName method.
+func (c *QueryClient) ModuleAccountByName(ctx context.Context, req *types.QueryModuleAccountByNameRequest, opts ...grpc.CallOption) (*types.QueryModuleAccountByNameResponse, error) {
+	var res *types.QueryModuleAccountByNameResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.ModuleAccountByName(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// ModuleAccountPermissions calls the auth Query/ModuleAccountPermissions method.
+func (c *QueryClient) ModuleAccountPermissions(ctx context.Context, req *types.QueryModuleAccountPermissionsRequest, opts ...grpc.CallOption) (*types.QueryModuleAccountPermissionsResponse, error) {
+	var res *types.QueryModuleAccountPermissionsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.ModuleAccountPermissions(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// ModuleAccounts calls the auth Query/ModuleAccounts method.
+func (c *QueryClient) ModuleAccounts(ctx context.Context, req *types.QueryModuleAccountsRequest, opts ...grpc.CallOption) (*types.QueryModuleAccountsResponse, error) {
+	var res *types.QueryModuleAccountsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.ModuleAccounts(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// Params calls the auth Query/Params method.
+func (c *QueryClient) Params(ctx context.Context, req *types.QueryParamsRequest, opts ...grpc.CallOption) (*types.QueryParamsResponse, error) {
+	var res *types.QueryParamsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Params(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// MsgClient is the typed client of the auth Msg service, broadcasting a
+// transaction of each message.
+type MsgClient struct {
+	broadcaster typed.Broadcaster
+}
+
+// NewMsgClient returns a MsgClient broadcasting the transactions with the
+// broadcaster.
+func NewMsgClient(broadcaster typed.Broadcaster) *MsgClient {
+	return &MsgClient{broadcaster: broadcaster}
+}
+
+// ChangePubKey broadcasts a transaction of the auth Msg/ChangePubKey message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) ChangePubKey(ctx context.Context, msg *types.MsgChangePubKey) (*types.MsgChangePubKeyResponse, *sdk.TxResponse, error) {
+	res := &types.MsgChangePubKeyResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
+
+// SetAuthenticator broadcasts a transaction of the auth Msg/SetAuthenticator message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) SetAuthenticator(ctx context.Context, msg *types.MsgSetAuthenticator) (*types.MsgSetAuthenticatorResponse, *sdk.TxResponse, error) {
+	res := &types.MsgSetAuthenticatorResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
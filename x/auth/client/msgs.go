@@ -0,0 +1,358 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"gopkg.in/yaml.v2"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	anyType = reflect.TypeOf(codectypes.Any{})
+
+	// well known types encoded as JSON strings
+	stringTypes = map[reflect.Type]bool{
+		reflect.TypeOf(time.Time{}):           true,
+		reflect.TypeOf(time.Duration(0)):      true,
+		reflect.TypeOf(gogotypes.Timestamp{}): true,
+		reflect.TypeOf(gogotypes.Duration{}):  true,
+	}
+)
+
+// ReadMsgsFromFile reads Msgs from a YAML or JSON file, using "-" for standard
+// input. The file either holds a list of messages or an object with a
+// "messages" list, each message being the proto-JSON of a Msg along with its
+// "@type", e.g.
+//
+//	messages:
+//	- '@type': /cosmos.bank.v1beta1.MsgSend
+//	  from_address: cosmos1...
+//	  to_address: cosmos1...
+//	  amount: [{denom: stake, amount: "10"}]
+//
+// The messages are validated against the registered Msg types before being
+// decoded, reporting every invalid field along with its path, e.g.
+// "messages[0].amount[0].amount: expected a string, got a list".
+func ReadMsgsFromFile(clientCtx client.Context, filename string) ([]sdk.Msg, error) {
+	var (
+		bz  []byte
+		err error
+	)
+	if filename == "-" {
+		bz, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		bz, err = ioutil.ReadFile(filename)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseMsgs(clientCtx, bz)
+}
+
+// ParseMsgs parses and validates Msgs from the YAML or JSON contents of a
+// messages file, see ReadMsgsFromFile.
+func ParseMsgs(clientCtx client.Context, bz []byte) ([]sdk.Msg, error) {
+	// JSON is a subset of YAML, so both are decoded by the YAML decoder
+	var raw interface{}
+	if err := yaml.Unmarshal(bz, &raw); err != nil {
+		return nil, fmt.Errorf("failed to decode messages file: %w", err)
+	}
+
+	doc, err := normalizeYAML(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	path := "messages"
+	if obj, ok := doc.(map[string]interface{}); ok {
+		for key := range obj {
+			if key != "messages" {
+				return nil, fmt.Errorf("%s: unknown field", key)
+			}
+		}
+		doc = obj["messages"]
+	} else {
+		path = ""
+	}
+
+	list, ok := doc.([]interface{})
+	if !ok || len(list) == 0 {
+		return nil, fmt.Errorf("expected a non-empty list of messages")
+	}
+
+	v := &msgValidator{clientCtx: clientCtx}
+	for i, m := range list {
+		v.validateMsg(fmt.Sprintf("%s[%d]", path, i), m)
+	}
+	if len(v.errs) > 0 {
+		return nil, fmt.Errorf("invalid messages:\n%s", strings.Join(v.errs, "\n"))
+	}
+
+	msgs := make([]sdk.Msg, len(list))
+	for i, m := range list {
+		bz, err := json.Marshal(m)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := clientCtx.Codec.UnmarshalInterfaceJSON(bz, &msgs[i]); err != nil {
+			return nil, fmt.Errorf("%s[%d]: %w", path, i, err)
+		}
+
+		if err := msgs[i].ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("%s[%d]: %w", path, i, err)
+		}
+	}
+
+	return msgs, nil
+}
+
+// normalizeYAML converts the maps decoded by YAML into JSON objects.
+func normalizeYAML(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		obj := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			k, ok := key.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid non-string key %v", key)
+			}
+
+			n, err := normalizeYAML(value)
+			if err != nil {
+				return nil, err
+			}
+			obj[k] = n
+		}
+		return obj, nil
+
+	case []interface{}:
+		for i, value := range v {
+			n, err := normalizeYAML(value)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = n
+		}
+		return v, nil
+
+	default:
+		return v, nil
+	}
+}
+
+// msgValidator validates decoded proto-JSON against the Go types generated for
+// the registered proto messages, collecting an error for every invalid field.
+type msgValidator struct {
+	clientCtx client.Context
+	errs      []string
+}
+
+func (v *msgValidator) errorf(path string, format string, args ...interface{}) {
+	v.errs = append(v.errs, fmt.Sprintf("%s: %s", path, fmt.Sprintf(format, args...)))
+}
+
+func (v *msgValidator) validateMsg(path string, value interface{}) {
+	typ, ok := v.validateAny(path, value)
+	if !ok {
+		return
+	}
+
+	if !typ.Implements(reflect.TypeOf((*sdk.Msg)(nil)).Elem()) {
+		v.errorf(joinPath(path, "@type"), "%s is not a Msg", value.(map[string]interface{})["@type"])
+	}
+}
+
+// validateAny validates an Any and returns the type of the packed message.
+func (v *msgValidator) validateAny(path string, value interface{}) (reflect.Type, bool) {
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		v.errorf(path, "expected an object with a @type, got %s", jsonKind(value))
+		return nil, false
+	}
+
+	typeURL, ok := obj["@type"].(string)
+	if !ok {
+		v.errorf(joinPath(path, "@type"), "missing type URL")
+		return nil, false
+	}
+
+	msg, err := v.clientCtx.InterfaceRegistry.Resolve(typeURL)
+	if err != nil {
+		v.errorf(joinPath(path, "@type"), "unknown type URL %s", typeURL)
+		return nil, false
+	}
+
+	typ := reflect.TypeOf(msg)
+	fields := make(map[string]interface{}, len(obj))
+	for key, field := range obj {
+		if key != "@type" {
+			fields[key] = field
+		}
+	}
+	v.validateStruct(path, typ.Elem(), fields)
+
+	return typ, true
+}
+
+func (v *msgValidator) validateStruct(path string, typ reflect.Type, obj map[string]interface{}) {
+	fields := make(map[string]reflect.StructField)
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		// oneofs hold their fields in wrapper types, so unknown fields can't be
+		// told apart from the fields of the oneof
+		if _, isOneof := field.Tag.Lookup("protobuf_oneof"); isOneof {
+			return
+		}
+
+		tag, ok := field.Tag.Lookup("protobuf")
+		if !ok {
+			continue
+		}
+
+		for _, opt := range strings.Split(tag, ",") {
+			if strings.HasPrefix(opt, "name=") || strings.HasPrefix(opt, "json=") {
+				fields[opt[strings.IndexByte(opt, '=')+1:]] = field
+			}
+		}
+	}
+
+	for key, value := range obj {
+		field, ok := fields[key]
+		if !ok {
+			v.errorf(joinPath(path, key), "unknown field of %s", typ.Name())
+			continue
+		}
+
+		v.validateField(joinPath(path, key), field, value)
+	}
+}
+
+func (v *msgValidator) validateField(path string, field reflect.StructField, value interface{}) {
+	if value == nil {
+		return
+	}
+
+	// custom types, e.g. sdk.Int or sdk.Dec, are encoded as strings
+	if strings.Contains(field.Tag.Get("protobuf"), "customtype=") {
+		v.expectString(path, value)
+		return
+	}
+
+	v.validateValue(path, field.Type, value)
+}
+
+func (v *msgValidator) validateValue(path string, typ reflect.Type, value interface{}) {
+	if value == nil {
+		return
+	}
+
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	switch {
+	case typ == anyType:
+		v.validateAny(path, value)
+		return
+
+	case stringTypes[typ]:
+		v.expectString(path, value)
+		return
+	}
+
+	switch typ.Kind() {
+	case reflect.String:
+		v.expectString(path, value)
+
+	case reflect.Bool:
+		if _, ok := value.(bool); !ok {
+			v.errorf(path, "expected a boolean, got %s", jsonKind(value))
+		}
+
+	case reflect.Int, reflect.Int32, reflect.Int64, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		// numbers may be quoted, and enums may be given by name
+		switch value.(type) {
+		case string, int, int64, uint64, float64, json.Number:
+		default:
+			v.errorf(path, "expected a number, got %s", jsonKind(value))
+		}
+
+	case reflect.Slice:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			// bytes are base64 encoded, except addresses which are bech32
+			v.expectString(path, value)
+			return
+		}
+
+		list, ok := value.([]interface{})
+		if !ok {
+			v.errorf(path, "expected a list, got %s", jsonKind(value))
+			return
+		}
+		for i, e := range list {
+			v.validateValue(fmt.Sprintf("%s[%d]", path, i), typ.Elem(), e)
+		}
+
+	case reflect.Map:
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			v.errorf(path, "expected an object, got %s", jsonKind(value))
+			return
+		}
+		for key, e := range obj {
+			v.validateValue(joinPath(path, key), typ.Elem(), e)
+		}
+
+	case reflect.Struct:
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			v.errorf(path, "expected an object, got %s", jsonKind(value))
+			return
+		}
+		v.validateStruct(path, typ, obj)
+	}
+}
+
+func (v *msgValidator) expectString(path string, value interface{}) {
+	if _, ok := value.(string); !ok {
+		v.errorf(path, "expected a string, got %s", jsonKind(value))
+	}
+}
+
+func jsonKind(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "a string"
+	case bool:
+		return "a boolean"
+	case []interface{}:
+		return "a list"
+	case map[string]interface{}:
+		return "an object"
+	default:
+		return "a number"
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
@@ -0,0 +1,113 @@
+package client_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestParseMsgs(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	clientCtx := client.Context{}.
+		WithCodec(encCfg.Marshaler).
+		WithInterfaceRegistry(encCfg.InterfaceRegistry)
+
+	to := sdk.AccAddress([]byte("to__________________"))
+	send := func(amount string) string {
+		return fmt.Sprintf(`
+- '@type': /cosmos.bank.v1beta1.MsgSend
+  from_address: %s
+  to_address: %s
+  amount:
+  - denom: stake
+    amount: %s
+`, addr, to, amount)
+	}
+
+	testCases := []struct {
+		name   string
+		file   string
+		expErr string
+		expLen int
+	}{
+		{"yaml list", send(`"10"`), "", 1},
+		{"yaml messages", "messages:\n" + send(`"10"`) + send(`"20"`), "", 2},
+		{
+			"json",
+			fmt.Sprintf(`{"messages": [{"@type": "/cosmos.bank.v1beta1.MsgSend", "from_address": "%s", "to_address": "%s", "amount": [{"denom": "stake", "amount": "1"}]}]}`, addr, to),
+			"", 1,
+		},
+		{
+			"nested any",
+			fmt.Sprintf(`
+- '@type': /cosmos.gov.v1beta1.MsgSubmitProposal
+  proposer: %s
+  initialDeposit: [{denom: stake, amount: "10"}]
+  content:
+    '@type': /cosmos.gov.v1beta1.TextProposal
+    title: title
+    description: description
+`, addr),
+			"", 1,
+		},
+		{"unquoted integer", send("10"), `[0].amount[0].amount: expected a string, got a number`, 0},
+		{"unknown field", send(`"10"`) + "  foo: bar\n", `[0].foo: unknown field of MsgSend`, 0},
+		{"unknown type", "- '@type': /cosmos.bank.v1beta1.MsgFoo\n", `[0].@type: unknown type URL /cosmos.bank.v1beta1.MsgFoo`, 0},
+		{"not a msg", "- '@type': /cosmos.gov.v1beta1.TextProposal\n", `[0].@type: /cosmos.gov.v1beta1.TextProposal is not a Msg`, 0},
+		{"missing type", "messages:\n- from_address: foo\n", `messages[0].@type: missing type URL`, 0},
+		{
+			"nested unknown field",
+			fmt.Sprintf(`
+messages:
+- '@type': /cosmos.gov.v1beta1.MsgSubmitProposal
+  proposer: %s
+  content:
+    '@type': /cosmos.gov.v1beta1.TextProposal
+    titel: title
+`, addr),
+			`messages[0].content.titel: unknown field of TextProposal`, 0,
+		},
+		{"invalid msg", fmt.Sprintf("- {'@type': /cosmos.bank.v1beta1.MsgSend, from_address: %s, to_address: foo}\n", addr), `[0]: Invalid recipient address`, 0},
+		{"empty", "messages: []\n", "expected a non-empty list of messages", 0},
+		{"unknown top level field", "messages: []\nmemo: foo\n", "memo: unknown field", 0},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			msgs, err := authclient.ParseMsgs(clientCtx, []byte(tc.file))
+			if tc.expErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, msgs, tc.expLen)
+		})
+	}
+
+	msgs, err := authclient.ParseMsgs(clientCtx, []byte(send(`"10"`)))
+	require.NoError(t, err)
+	require.Equal(t, &banktypes.MsgSend{
+		FromAddress: addr.String(),
+		ToAddress:   to.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	}, msgs[0])
+
+	msgs, err = authclient.ParseMsgs(clientCtx, []byte(fmt.Sprintf(`
+- '@type': /cosmos.gov.v1beta1.MsgSubmitProposal
+  proposer: %s
+  content: {'@type': /cosmos.gov.v1beta1.TextProposal, title: title, description: description}
+`, addr)))
+	require.NoError(t, err)
+	require.Equal(t, "title", msgs[0].(*govtypes.MsgSubmitProposal).GetContent().GetTitle())
+}
@@ -0,0 +1,23 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// AnteDecoratorsResponse is the response of the ante decorators endpoint.
+type AnteDecoratorsResponse struct {
+	Decorators []string `json:"decorators"`
+}
+
+// RegisterAnteDecoratorsRoute registers the "/debug/ante/decorators" endpoint
+// on the router, reporting as JSON the names of the decorators of the app's
+// AnteHandler in their order, e.g. from AnteHandlerBuilder.Names.
+func RegisterAnteDecoratorsRoute(rtr *mux.Router, decorators []string) {
+	rtr.HandleFunc("/debug/ante/decorators", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(AnteDecoratorsResponse{Decorators: decorators})
+	}).Methods(MethodGet)
+}
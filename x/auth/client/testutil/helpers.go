@@ -37,6 +37,16 @@ func TxBroadcastExec(clientCtx client.Context, filename string, extraArgs ...str
 	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetBroadcastCommand(), append(args, extraArgs...))
 }
 
+func TxSubmitExec(clientCtx client.Context, from fmt.Stringer, filename string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		fmt.Sprintf("--from=%s", from.String()),
+		fmt.Sprintf("--file=%s", filename),
+	}
+
+	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetSubmitCommand(), append(args, extraArgs...))
+}
+
 func TxEncodeExec(clientCtx client.Context, filename string, extraArgs ...string) (testutil.BufferWriter, error) {
 	args := []string{
 		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
@@ -19,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil"
@@ -33,6 +34,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankcli "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type IntegrationTestSuite struct {
@@ -725,6 +727,50 @@ func (s *IntegrationTestSuite) TestCLIEncode() {
 	s.Require().Equal("deadbeef", txBuilder.GetTx().GetMemo())
 }
 
+func (s *IntegrationTestSuite) TestCLISubmit() {
+	val1 := s.network.Validators[0]
+
+	send := func(amount string) string {
+		return fmt.Sprintf(`
+- '@type': /cosmos.bank.v1beta1.MsgSend
+  from_address: %s
+  to_address: %s
+  amount:
+  - denom: %s
+    amount: %s
+`, val1.Address, val1.Address, s.cfg.BondDenom, amount)
+	}
+
+	msgsFile := testutil.WriteToNewTempFile(s.T(), "messages:\n"+send(`"10"`)+send(`"20"`))
+
+	// generate only
+	out, err := TxSubmitExec(val1.ClientCtx, val1.Address, msgsFile.Name(), fmt.Sprintf("--%s=true", flags.FlagGenerateOnly))
+	s.Require().NoError(err)
+
+	generatedTx, err := val1.ClientCtx.TxConfig.TxJSONDecoder()(out.Bytes())
+	s.Require().NoError(err)
+	s.Require().Len(generatedTx.GetMsgs(), 2)
+
+	// sign and broadcast
+	out, err = TxSubmitExec(
+		val1.ClientCtx, val1.Address, msgsFile.Name(),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	)
+	s.Require().NoError(err)
+
+	var txRes sdk.TxResponse
+	s.Require().NoError(val1.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &txRes))
+	s.Require().Equal(uint32(0), txRes.Code, txRes.RawLog)
+
+	// invalid messages are reported along with their field path
+	invalidFile := testutil.WriteToNewTempFile(s.T(), send("20"))
+	_, err = TxSubmitExec(val1.ClientCtx, val1.Address, invalidFile.Name(), fmt.Sprintf("--%s=true", flags.FlagGenerateOnly))
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "[0].amount[0].amount: expected a string, got a number")
+}
+
 func (s *IntegrationTestSuite) TestCLIMultisignSortSignatures() {
 	val1 := s.network.Validators[0]
 
@@ -1119,6 +1165,154 @@ func (s *IntegrationTestSuite) TestGetAccountCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetAccountByPubKeyCmd() {
+	val := s.network.Validators[0]
+	info, err := val.ClientCtx.Keyring.KeyByAddress(val.Address)
+	s.Require().NoError(err)
+	_, pubKey, _ := testdata.KeyTestPubAddr()
+
+	testCases := []struct {
+		name      string
+		pubKey    cryptotypes.PubKey
+		expectErr bool
+	}{
+		{
+			"unknown public key",
+			pubKey,
+			true,
+		},
+		{
+			"public key of the validator",
+			info.GetPubKey(),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			clientCtx := val.ClientCtx
+
+			bz, err := clientCtx.Codec.MarshalInterfaceJSON(tc.pubKey)
+			s.Require().NoError(err)
+			args := []string{string(bz), fmt.Sprintf("--%s=json", tmcli.OutputFlag)}
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, authcli.GetAccountByPubKeyCmd(), args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var acc authtypes.AccountI
+				s.Require().NoError(val.ClientCtx.Codec.UnmarshalInterfaceJSON(out.Bytes(), &acc))
+				s.Require().Equal(val.Address, acc.GetAddress())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestChangePubKeyCmd() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	info, _, err := clientCtx.Keyring.NewMnemonic("rotatedAccount", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	s.Require().NoError(err)
+	_, err = bankcli.MsgSendExec(
+		clientCtx,
+		val.Address,
+		info.GetAddress(),
+		sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100))),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	)
+	s.Require().NoError(err)
+
+	newPubKey := secp256k1.GenPrivKey().PubKey()
+	bz, err := clientCtx.Codec.MarshalInterfaceJSON(newPubKey)
+	s.Require().NoError(err)
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, authcli.NewChangePubKeyCmd(), []string{
+		string(bz),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, info.GetName()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	})
+	s.Require().NoError(err)
+	var txRes sdk.TxResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txRes))
+	s.Require().Equal(uint32(0), txRes.Code, txRes.RawLog)
+
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, authcli.GetAccountCmd(), []string{
+		info.GetAddress().String(),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+	var acc authtypes.AccountI
+	s.Require().NoError(clientCtx.Codec.UnmarshalInterfaceJSON(out.Bytes(), &acc))
+	s.Require().Equal(info.GetAddress(), acc.GetAddress())
+	s.Require().True(newPubKey.Equals(acc.GetPubKey()))
+	s.Require().Equal(uint64(1), acc.GetSequence())
+}
+
+func (s *IntegrationTestSuite) TestQueryModuleAccountsCmd() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, authcli.QueryModuleAccountsCmd(), []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+
+	var res authtypes.QueryModuleAccountsResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+	s.Require().NotEmpty(res.Accounts)
+	for _, acc := range res.Accounts {
+		s.Require().Equal(authtypes.NewModuleAddress(acc.Name).String(), acc.Address)
+	}
+}
+
+func (s *IntegrationTestSuite) TestQueryModuleAccountByNameCmd() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name       string
+		moduleName string
+		expectErr  bool
+	}{
+		{
+			"unknown module account",
+			"unknown",
+			true,
+		},
+		{
+			"bonded pool",
+			stakingtypes.BondedPoolName,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			clientCtx := val.ClientCtx
+
+			args := []string{tc.moduleName, fmt.Sprintf("--%s=json", tmcli.OutputFlag)}
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, authcli.QueryModuleAccountByNameCmd(), args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var acc authtypes.ModuleAccountInfo
+				s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &acc))
+				s.Require().Equal(authtypes.NewModuleAddress(tc.moduleName).String(), acc.Address)
+				s.Require().ElementsMatch([]string{authtypes.Burner, authtypes.Staking}, acc.Permissions)
+				s.Require().True(acc.Balance.AmountOf(s.cfg.BondDenom).IsPositive())
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetAccountsCmd() {
 	val := s.network.Validators[0]
 	clientCtx := val.ClientCtx
@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -11,6 +12,11 @@ import (
 // CONTRACT: old coins from the FeeCollectionKeeper need to be transferred through
 // a genesis port script to the new fee collector account
 func InitGenesis(ctx sdk.Context, ak keeper.AccountKeeper, data types.GenesisState) {
+	if pc, ok := ak.GetCodec().(codec.ProtoCodecMarshaler); ok {
+		if err := data.Params.ValidatePubKeyTypes(types.SigVerifiablePubKeyTypeURLs(pc.InterfaceRegistry())); err != nil {
+			panic(err)
+		}
+	}
 	ak.SetParams(ctx, data.Params)
 
 	accounts, err := types.UnpackAccounts(data.Accounts)
@@ -24,6 +30,24 @@ func InitGenesis(ctx sdk.Context, ak keeper.AccountKeeper, data types.GenesisSta
 		ak.SetAccount(ctx, acc)
 	}
 
+	for _, a := range data.AccountAuthenticators {
+		addr, err := sdk.AccAddressFromBech32(a.Address)
+		if err != nil {
+			panic(err)
+		}
+		if err := ak.SetAccountAuthenticator(ctx, addr, a.Authenticator); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, a := range data.BlockedAddresses {
+		addr, err := sdk.AccAddressFromBech32(a)
+		if err != nil {
+			panic(err)
+		}
+		ak.BlockAddress(ctx, addr)
+	}
+
 	ak.GetModuleAccount(ctx, types.FeeCollectorName)
 }
 
@@ -38,5 +62,15 @@ func ExportGenesis(ctx sdk.Context, ak keeper.AccountKeeper) *types.GenesisState
 		return false
 	})
 
-	return types.NewGenesisState(params, genAccounts)
+	genState := types.NewGenesisState(params, genAccounts)
+	ak.IterateAccountAuthenticators(ctx, func(addr sdk.AccAddress, name string) bool {
+		genState.AccountAuthenticators = append(genState.AccountAuthenticators, types.NewAccountAuthenticator(addr, name))
+		return false
+	})
+	ak.IterateBlockedAddresses(ctx, func(addr sdk.AccAddress) bool {
+		genState.BlockedAddresses = append(genState.BlockedAddresses, addr.String())
+		return false
+	})
+
+	return genState
 }
@@ -1,7 +1,11 @@
 package keeper
 
 import (
+	"bytes"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -63,6 +67,15 @@ func (ak AccountKeeper) SetAccount(ctx sdk.Context, acc types.AccountI) {
 	}
 
 	store.Set(types.AddressStoreKey(addr), bz)
+
+	// index the public key of the account for the AccountByPubKey query; the
+	// entry of a previous public key is removed when it is rotated
+	if pubKey := acc.GetPubKey(); pubKey != nil {
+		pubKeyKey := types.PubKeyStoreKey(pubKey)
+		if !store.Has(pubKeyKey) {
+			store.Set(pubKeyKey, addr)
+		}
+	}
 }
 
 // RemoveAccount removes an account for the account mapper store.
@@ -71,6 +84,57 @@ func (ak AccountKeeper) RemoveAccount(ctx sdk.Context, acc types.AccountI) {
 	addr := acc.GetAddress()
 	store := ctx.KVStore(ak.key)
 	store.Delete(types.AddressStoreKey(addr))
+
+	if pubKey := acc.GetPubKey(); pubKey != nil && bytes.Equal(store.Get(types.PubKeyStoreKey(pubKey)), addr) {
+		store.Delete(types.PubKeyStoreKey(pubKey))
+	}
+}
+
+// GetAccountByPubKey returns the account the public key is set on, if any.
+func (ak AccountKeeper) GetAccountByPubKey(ctx sdk.Context, pubKey cryptotypes.PubKey) types.AccountI {
+	store := ctx.KVStore(ak.key)
+	addr := store.Get(types.PubKeyStoreKey(pubKey))
+	if addr == nil {
+		return nil
+	}
+
+	// the index is keyed by the address of the public key, thus check that the
+	// account still has the very public key
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil || acc.GetPubKey() == nil || !acc.GetPubKey().Equals(pubKey) {
+		return nil
+	}
+
+	return acc
+}
+
+// ChangePubKey rotates the public key of an account to the given one, keeping
+// its address and sequence. The public key must not be set on another account.
+func (ak AccountKeeper) ChangePubKey(ctx sdk.Context, addr sdk.AccAddress, pubKey cryptotypes.PubKey) error {
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+	}
+
+	oldPubKey := acc.GetPubKey()
+	if oldPubKey != nil && oldPubKey.Equals(pubKey) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "public key is already set on account %s", addr)
+	}
+	if other := ak.GetAccountByPubKey(ctx, pubKey); other != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "public key is set on account %s", other.GetAddress())
+	}
+
+	if err := acc.SetPubKey(pubKey); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+
+	store := ctx.KVStore(ak.key)
+	if oldPubKey != nil && bytes.Equal(store.Get(types.PubKeyStoreKey(oldPubKey)), addr) {
+		store.Delete(types.PubKeyStoreKey(oldPubKey))
+	}
+	ak.SetAccount(ctx, acc)
+
+	return nil
 }
 
 // IterateAccounts iterates over all the stored accounts and performs a callback function.
@@ -88,3 +152,32 @@ func (ak AccountKeeper) IterateAccounts(ctx sdk.Context, cb func(account types.A
 		}
 	}
 }
+
+// ValidateAddressPrefix checks that the stored accounts are encoded with the
+// configured bech32 account prefix, i.e. that the node is configured for the
+// network of its state. Only the first account is checked.
+func (ak AccountKeeper) ValidateAddressPrefix(ctx sdk.Context) error {
+	store := ctx.KVStore(ak.key)
+	iterator := sdk.KVStorePrefixIterator(store, types.AddressStoreKeyPrefix)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return nil
+	}
+
+	addr := sdk.AccAddress(iterator.Key()[len(types.AddressStoreKeyPrefix):])
+	account, err := ak.UnmarshalAccount(iterator.Value())
+	if err != nil {
+		return err
+	}
+
+	// the address of an account fails to decode with another prefix
+	if !addr.Equals(account.GetAddress()) {
+		return sdkerrors.ErrInvalidAddress.Wrapf(
+			"stored account %X is not encoded with the bech32 account prefix %s",
+			addr.Bytes(), sdk.GetConfig().GetBech32AccountAddrPrefix(),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// RegisterAuthenticator registers a custom authenticator under the given name,
+// so that accounts can be authenticated by it. It must be called when the app
+// is constructed, as the registered authenticators are not persisted, and
+// panics if the name is already registered.
+func (ak AccountKeeper) RegisterAuthenticator(name string, authenticator types.Authenticator) {
+	if name == "" {
+		panic("authenticator name cannot be empty")
+	}
+	if _, ok := ak.authenticators[name]; ok {
+		panic(fmt.Sprintf("authenticator %s has already been registered", name))
+	}
+
+	ak.authenticators[name] = authenticator
+}
+
+// HasAuthenticator returns true if an authenticator is registered under the
+// given name.
+func (ak AccountKeeper) HasAuthenticator(name string) bool {
+	_, ok := ak.authenticators[name]
+	return ok
+}
+
+// GetAuthenticatorNames returns the sorted names of the registered
+// authenticators.
+func (ak AccountKeeper) GetAuthenticatorNames() []string {
+	names := make([]string, 0, len(ak.authenticators))
+	for name := range ak.authenticators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// SetAccountAuthenticator sets the registered authenticator with the given name
+// as the custom authenticator of the account, in place of its pubkey.
+func (ak AccountKeeper) SetAccountAuthenticator(ctx sdk.Context, addr sdk.AccAddress, name string) error {
+	if !ak.HasAuthenticator(name) {
+		return sdkerrors.Wrapf(types.ErrUnknownAuthenticator, "authenticator %s is not registered", name)
+	}
+	if !ak.HasAccount(ctx, addr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+	}
+
+	ctx.KVStore(ak.key).Set(types.AuthenticatorStoreKey(addr), []byte(name))
+	return nil
+}
+
+// GetAccountAuthenticator returns the name of the custom authenticator of the
+// account, if any.
+func (ak AccountKeeper) GetAccountAuthenticator(ctx sdk.Context, addr sdk.AccAddress) (string, bool) {
+	bz := ctx.KVStore(ak.key).Get(types.AuthenticatorStoreKey(addr))
+	if bz == nil {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// RemoveAccountAuthenticator removes the custom authenticator of the account,
+// which is then authenticated by its pubkey again.
+func (ak AccountKeeper) RemoveAccountAuthenticator(ctx sdk.Context, addr sdk.AccAddress) {
+	ctx.KVStore(ak.key).Delete(types.AuthenticatorStoreKey(addr))
+}
+
+// IterateAccountAuthenticators iterates over the custom authenticators of the
+// accounts. Stop iteration when the callback returns true.
+func (ak AccountKeeper) IterateAccountAuthenticators(ctx sdk.Context, cb func(addr sdk.AccAddress, name string) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(ak.key), types.AuthenticatorStoreKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		addr := sdk.AccAddress(iter.Key()[len(types.AuthenticatorStoreKeyPrefix):])
+		if cb(addr, string(iter.Value())) {
+			break
+		}
+	}
+}
+
+// GetAuthenticator returns the custom authenticator of the account, or nil if
+// the account is authenticated by its pubkey. It returns an error if the
+// authenticator of the account is no longer registered, so that the account
+// cannot be authenticated by its pubkey instead.
+func (ak AccountKeeper) GetAuthenticator(ctx sdk.Context, addr sdk.AccAddress) (types.Authenticator, error) {
+	name, found := ak.GetAccountAuthenticator(ctx, addr)
+	if !found {
+		return nil, nil
+	}
+
+	authenticator, ok := ak.authenticators[name]
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownAuthenticator, "authenticator %s of account %s is not registered", name, addr)
+	}
+
+	return authenticator, nil
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var _ ante.AuthenticationKeeper = keeper.AccountKeeper{}
+
+func (suite *KeeperTestSuite) TestAccountAuthenticators() {
+	app, ctx := suite.app, suite.ctx
+	addr := sdk.AccAddress([]byte("authenticated-------"))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+
+	authenticator := types.AuthenticatorFunc(func(sdk.Context, types.AccountI, signing.SignatureV2, types.SignBytesFunc, sdk.Tx) error {
+		return nil
+	})
+	app.AccountKeeper.RegisterAuthenticator("smart-account", authenticator)
+	suite.Require().True(app.AccountKeeper.HasAuthenticator("smart-account"))
+	suite.Require().Equal([]string{"smart-account"}, app.AccountKeeper.GetAuthenticatorNames())
+	suite.Require().Panics(func() { app.AccountKeeper.RegisterAuthenticator("smart-account", authenticator) })
+
+	// accounts without authenticator are authenticated by their pubkey
+	res, err := app.AccountKeeper.GetAuthenticator(ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().Nil(res)
+
+	// only registered authenticators of existing accounts can be set
+	err = app.AccountKeeper.SetAccountAuthenticator(ctx, addr, "unknown")
+	suite.Require().ErrorIs(err, types.ErrUnknownAuthenticator)
+	err = app.AccountKeeper.SetAccountAuthenticator(ctx, sdk.AccAddress([]byte("unknown-------------")), "smart-account")
+	suite.Require().Error(err)
+
+	suite.Require().NoError(app.AccountKeeper.SetAccountAuthenticator(ctx, addr, "smart-account"))
+	name, found := app.AccountKeeper.GetAccountAuthenticator(ctx, addr)
+	suite.Require().True(found)
+	suite.Require().Equal("smart-account", name)
+	res, err = app.AccountKeeper.GetAuthenticator(ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	// the authenticators are exported and imported with the genesis state
+	genState := auth.ExportGenesis(ctx, app.AccountKeeper)
+	suite.Require().Equal([]types.AccountAuthenticator{types.NewAccountAuthenticator(addr, "smart-account")}, genState.AccountAuthenticators)
+	suite.Require().NoError(types.ValidateGenesis(*genState))
+
+	app.AccountKeeper.RemoveAccountAuthenticator(ctx, addr)
+	_, found = app.AccountKeeper.GetAccountAuthenticator(ctx, addr)
+	suite.Require().False(found)
+
+	auth.InitGenesis(ctx, app.AccountKeeper, *genState)
+	name, found = app.AccountKeeper.GetAccountAuthenticator(ctx, addr)
+	suite.Require().True(found)
+	suite.Require().Equal("smart-account", name)
+
+	// an account whose authenticator is no longer registered cannot be
+	// authenticated by its pubkey instead
+	ctx.KVStore(app.GetKey(types.StoreKey)).Set(types.AuthenticatorStoreKey(addr), []byte("removed"))
+	_, err = app.AccountKeeper.GetAuthenticator(ctx, addr)
+	suite.Require().ErrorIs(err, types.ErrUnknownAuthenticator)
+}
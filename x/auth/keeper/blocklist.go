@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// IsBlocked returns true if the address is in the governance blocklist, so that
+// it cannot sign txs nor receive funds.
+func (ak AccountKeeper) IsBlocked(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return ctx.KVStore(ak.key).Has(types.BlocklistStoreKey(addr))
+}
+
+// BlockAddress adds the address to the blocklist.
+func (ak AccountKeeper) BlockAddress(ctx sdk.Context, addr sdk.AccAddress) {
+	ctx.KVStore(ak.key).Set(types.BlocklistStoreKey(addr), []byte{})
+}
+
+// UnblockAddress removes the address from the blocklist.
+func (ak AccountKeeper) UnblockAddress(ctx sdk.Context, addr sdk.AccAddress) {
+	ctx.KVStore(ak.key).Delete(types.BlocklistStoreKey(addr))
+}
+
+// IterateBlockedAddresses iterates over the addresses of the blocklist. Stop
+// iteration when the callback returns true.
+func (ak AccountKeeper) IterateBlockedAddresses(ctx sdk.Context, cb func(addr sdk.AccAddress) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(ak.key), types.BlocklistStoreKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(sdk.AccAddress(iter.Key()[len(types.BlocklistStoreKeyPrefix):])) {
+			break
+		}
+	}
+}
+
+// UpdateBlocklist adds the given addresses to and removes the given addresses
+// from the blocklist, as decided by governance.
+func (ak AccountKeeper) UpdateBlocklist(ctx sdk.Context, block, unblock []string) error {
+	blockAddrs, err := parseAddresses(block)
+	if err != nil {
+		return err
+	}
+	unblockAddrs, err := parseAddresses(unblock)
+	if err != nil {
+		return err
+	}
+
+	for _, addr := range unblockAddrs {
+		ak.UnblockAddress(ctx, addr)
+	}
+	for _, addr := range blockAddrs {
+		ak.BlockAddress(ctx, addr)
+	}
+
+	ak.Logger(ctx).Info("updated blocklist", "blocked", block, "unblocked", unblock)
+
+	return nil
+}
+
+func parseAddresses(addrs []string) ([]sdk.AccAddress, error) {
+	res := make([]sdk.AccAddress, len(addrs))
+	for i, addr := range addrs {
+		accAddr, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = accAddr
+	}
+
+	return res, nil
+}
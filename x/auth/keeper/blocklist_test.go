@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var _ ante.BlocklistKeeper = keeper.AccountKeeper{}
+
+func (suite *KeeperTestSuite) TestUpdateBlocklist() {
+	app, ctx := suite.app, suite.ctx
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+
+	suite.Require().False(app.AccountKeeper.IsBlocked(ctx, addr1))
+	suite.Require().NoError(app.AccountKeeper.UpdateBlocklist(ctx, []string{addr1.String(), addr2.String()}, nil))
+	suite.Require().True(app.AccountKeeper.IsBlocked(ctx, addr1))
+	suite.Require().True(app.AccountKeeper.IsBlocked(ctx, addr2))
+
+	// unblock and block at once
+	suite.Require().NoError(app.AccountKeeper.UpdateBlocklist(ctx, []string{addr2.String()}, []string{addr1.String()}))
+	suite.Require().False(app.AccountKeeper.IsBlocked(ctx, addr1))
+	suite.Require().True(app.AccountKeeper.IsBlocked(ctx, addr2))
+
+	// an invalid address changes nothing
+	suite.Require().Error(app.AccountKeeper.UpdateBlocklist(ctx, []string{addr1.String()}, []string{"invalid"}))
+	suite.Require().False(app.AccountKeeper.IsBlocked(ctx, addr1))
+
+	res, err := suite.queryClient.BlockedAddresses(sdk.WrapSDKContext(ctx), &types.QueryBlockedAddressesRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{addr2.String()}, res.Addresses)
+	suite.Require().Equal(uint64(1), res.Pagination.Total)
+
+	// the blocklist is exported and imported with the genesis state
+	genState := auth.ExportGenesis(ctx, app.AccountKeeper)
+	suite.Require().Equal([]string{addr2.String()}, genState.BlockedAddresses)
+	app.AccountKeeper.UnblockAddress(ctx, addr2)
+	auth.InitGenesis(ctx, app.AccountKeeper, *genState)
+	suite.Require().True(app.AccountKeeper.IsBlocked(ctx, addr2))
+}
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -55,7 +56,7 @@ func (ak AccountKeeper) Account(c context.Context, req *types.QueryAccountReques
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	addr, err := sdk.AccAddressFromBech32(req.Address)
+	addr, err := ak.AddressCodec().StringToBytes(req.Address)
 
 	if err != nil {
 		return nil, err
@@ -83,3 +84,94 @@ func (ak AccountKeeper) Params(c context.Context, req *types.QueryParamsRequest)
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// ModuleAccountPermissions returns the permissions of all registered module accounts
+func (ak AccountKeeper) ModuleAccountPermissions(c context.Context, req *types.QueryModuleAccountPermissionsRequest) (*types.QueryModuleAccountPermissionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryModuleAccountPermissionsResponse{Permissions: ak.GetAllModuleAccountPermissions(ctx)}, nil
+}
+
+// ModuleAccounts returns the address, permissions and balance of all registered
+// module accounts
+func (ak AccountKeeper) ModuleAccounts(c context.Context, req *types.QueryModuleAccountsRequest) (*types.QueryModuleAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryModuleAccountsResponse{Accounts: ak.GetAllModuleAccountInfos(ctx)}, nil
+}
+
+// ModuleAccountByName returns the address, permissions and balance of a
+// registered module account
+func (ak AccountKeeper) ModuleAccountByName(c context.Context, req *types.QueryModuleAccountByNameRequest) (*types.QueryModuleAccountByNameResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "module account name cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	info, ok := ak.GetModuleAccountInfo(ctx, req.Name)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "module account %s not found", req.Name)
+	}
+
+	return &types.QueryModuleAccountByNameResponse{Account: info}, nil
+}
+
+// AccountByPubKey returns the account owning a public key
+func (ak AccountKeeper) AccountByPubKey(c context.Context, req *types.QueryAccountByPubKeyRequest) (*types.QueryAccountByPubKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PubKey == nil {
+		return nil, status.Error(codes.InvalidArgument, "public key cannot be empty")
+	}
+
+	pubKey, ok := req.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid public key")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	account := ak.GetAccountByPubKey(ctx, pubKey)
+	if account == nil {
+		return nil, status.Errorf(codes.NotFound, "account of public key %s not found", pubKey)
+	}
+
+	any, err := codectypes.NewAnyWithValue(account)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccountByPubKeyResponse{Account: any}, nil
+}
+
+// BlockedAddresses returns the addresses of the blocklist
+func (ak AccountKeeper) BlockedAddresses(c context.Context, req *types.QueryBlockedAddressesRequest) (*types.QueryBlockedAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	blocklistStore := prefix.NewStore(ctx.KVStore(ak.key), types.BlocklistStoreKeyPrefix)
+
+	var addresses []string
+	pageRes, err := query.Paginate(blocklistStore, req.Pagination, func(key, _ []byte) error {
+		addresses = append(addresses, sdk.AccAddress(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "paginate: %v", err)
+	}
+
+	return &types.QueryBlockedAddressesResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -150,6 +151,100 @@ func (suite *KeeperTestSuite) TestGRPCQueryAccount() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryAccountByPubKey() {
+	var (
+		req *types.QueryAccountByPubKeyRequest
+	)
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	pubKeyAny, err := codectypes.NewAnyWithValue(pubKey)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expPass   bool
+		posttests func(res *types.QueryAccountByPubKeyResponse)
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryAccountByPubKeyRequest{}
+			},
+			false,
+			func(res *types.QueryAccountByPubKeyResponse) {},
+		},
+		{
+			"account not found",
+			func() {
+				req = &types.QueryAccountByPubKeyRequest{PubKey: pubKeyAny}
+			},
+			false,
+			func(res *types.QueryAccountByPubKeyResponse) {},
+		},
+		{
+			"account without public key",
+			func() {
+				suite.app.AccountKeeper.SetAccount(suite.ctx,
+					suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr))
+				req = &types.QueryAccountByPubKeyRequest{PubKey: pubKeyAny}
+			},
+			false,
+			func(res *types.QueryAccountByPubKeyResponse) {},
+		},
+		{
+			"success",
+			func() {
+				acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+				suite.Require().NoError(acc.SetPubKey(pubKey))
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+				req = &types.QueryAccountByPubKeyRequest{PubKey: pubKeyAny}
+			},
+			true,
+			func(res *types.QueryAccountByPubKeyResponse) {
+				var newAccount types.AccountI
+				err := suite.app.InterfaceRegistry().UnpackAny(res.Account, &newAccount)
+				suite.Require().NoError(err)
+				suite.Require().NotNil(newAccount)
+				suite.Require().True(addr.Equals(newAccount.GetAddress()))
+				suite.Require().True(pubKey.Equals(newAccount.GetPubKey()))
+			},
+		},
+		{
+			"removed account",
+			func() {
+				acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+				suite.Require().NoError(acc.SetPubKey(pubKey))
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+				suite.app.AccountKeeper.RemoveAccount(suite.ctx, acc)
+				req = &types.QueryAccountByPubKeyRequest{PubKey: pubKeyAny}
+			},
+			false,
+			func(res *types.QueryAccountByPubKeyResponse) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.ctx)
+
+			res, err := suite.queryClient.AccountByPubKey(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+
+			tc.posttests(res)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestGRPCQueryParameters() {
 	var (
 		req       *types.QueryParamsRequest
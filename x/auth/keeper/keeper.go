@@ -45,6 +45,9 @@ type AccountKeeperI interface {
 
 	// Fetch the next account number, and increment the internal counter.
 	GetNextAccountNumber(sdk.Context) uint64
+
+	// Return the codec of the account addresses.
+	AddressCodec() sdk.AddressCodec
 }
 
 // AccountKeeper encodes/decodes accounts using the go-amino (binary)
@@ -54,6 +57,13 @@ type AccountKeeper struct {
 	cdc           codec.BinaryCodec
 	paramSubspace paramtypes.Subspace
 	permAddrs     map[string]types.PermissionsForAddress
+	addressCodec  sdk.AddressCodec
+
+	// The custom authenticators of the accounts, by name.
+	authenticators map[string]types.Authenticator
+
+	// The keeper reporting the balances of the module accounts, if any.
+	balanceKeeper types.BalanceKeeper
 
 	// The prototypical AccountI constructor.
 	proto func() types.AccountI
@@ -67,9 +77,10 @@ var _ AccountKeeperI = &AccountKeeper{}
 // types.PermissionsForAddress and is used in keeper.ValidatePermissions. Permissions are plain strings,
 // and don't have to fit into any predefined structure. This auth module does not use account permissions internally, though other modules
 // may use auth.Keeper to access the accounts permissions map.
+// `addressCodec` converts the account addresses from and to their string representation.
 func NewAccountKeeper(
 	cdc codec.BinaryCodec, key sdk.StoreKey, paramstore paramtypes.Subspace, proto func() types.AccountI,
-	maccPerms map[string][]string,
+	maccPerms map[string][]string, addressCodec sdk.AddressCodec,
 ) AccountKeeper {
 
 	// set KeyTable if it has not already been set
@@ -83,19 +94,33 @@ func NewAccountKeeper(
 	}
 
 	return AccountKeeper{
-		key:           key,
-		proto:         proto,
-		cdc:           cdc,
-		paramSubspace: paramstore,
-		permAddrs:     permAddrs,
+		key:            key,
+		proto:          proto,
+		cdc:            cdc,
+		paramSubspace:  paramstore,
+		permAddrs:      permAddrs,
+		addressCodec:   addressCodec,
+		authenticators: make(map[string]types.Authenticator),
 	}
 }
 
+// SetBalanceKeeper sets the keeper reporting the balances of the module
+// accounts in the module account queries. The bank keeper depends on the
+// account keeper, thus it is set once both keepers are created.
+func (ak *AccountKeeper) SetBalanceKeeper(bk types.BalanceKeeper) {
+	ak.balanceKeeper = bk
+}
+
 // Logger returns a module-specific logger.
 func (ak AccountKeeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
 }
 
+// AddressCodec returns the codec of the account addresses.
+func (ak AccountKeeper) AddressCodec() sdk.AddressCodec {
+	return ak.addressCodec
+}
+
 // GetPubKey Returns the PubKey of the account at address
 func (ak AccountKeeper) GetPubKey(ctx sdk.Context, addr sdk.AccAddress) (cryptotypes.PubKey, error) {
 	acc := ak.GetAccount(ctx, addr)
@@ -144,11 +169,12 @@ func (ak AccountKeeper) GetNextAccountNumber(ctx sdk.Context) uint64 {
 }
 
 // ValidatePermissions validates that the module account has been granted
-// permissions within its set of allowed permissions.
+// permissions within its set of allowed permissions, i.e. its registered
+// permissions, or governable permissions if the module account is registered.
 func (ak AccountKeeper) ValidatePermissions(macc types.ModuleAccountI) error {
-	permAddr := ak.permAddrs[macc.GetName()]
+	permAddr, registered := ak.permAddrs[macc.GetName()]
 	for _, perm := range macc.GetPermissions() {
-		if !permAddr.HasPermission(perm) {
+		if !permAddr.HasPermission(perm) && !(registered && types.IsGovernablePermission(perm)) {
 			return fmt.Errorf("invalid module permission %s", perm)
 		}
 	}
@@ -166,7 +192,9 @@ func (ak AccountKeeper) GetModuleAddress(moduleName string) sdk.AccAddress {
 	return permAddr.GetAddress()
 }
 
-// GetModuleAddressAndPermissions returns an address and permissions based on the module name
+// GetModuleAddressAndPermissions returns an address and the permissions the module
+// account was registered with based on the module name. The permissions currently
+// granted to the module account may differ, see GetModuleAccountAndPermissions.
 func (ak AccountKeeper) GetModuleAddressAndPermissions(moduleName string) (addr sdk.AccAddress, permissions []string) {
 	permAddr, ok := ak.permAddrs[moduleName]
 	if !ok {
@@ -176,8 +204,9 @@ func (ak AccountKeeper) GetModuleAddressAndPermissions(moduleName string) (addr
 	return permAddr.GetAddress(), permAddr.GetPermissions()
 }
 
-// GetModuleAccountAndPermissions gets the module account from the auth account store and its
-// registered permissions
+// GetModuleAccountAndPermissions gets the module account from the auth account store and the
+// permissions currently granted to it. If the module account does not exist yet, it is created
+// with its registered permissions.
 func (ak AccountKeeper) GetModuleAccountAndPermissions(ctx sdk.Context, moduleName string) (types.ModuleAccountI, []string) {
 	addr, perms := ak.GetModuleAddressAndPermissions(moduleName)
 	if addr == nil {
@@ -190,7 +219,7 @@ func (ak AccountKeeper) GetModuleAccountAndPermissions(ctx sdk.Context, moduleNa
 		if !ok {
 			panic("account is not a module account")
 		}
-		return macc, perms
+		return macc, macc.GetPermissions()
 	}
 
 	// create a new module account
@@ -7,9 +7,14 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/sm2"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	v047 "github.com/cosmos/cosmos-sdk/x/auth/legacy/v047"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -108,6 +113,113 @@ func TestAccountMapperRemoveAccount(t *testing.T) {
 	require.Equal(t, accSeq2, acc2.GetSequence())
 }
 
+func TestValidateAddressPrefix(t *testing.T) {
+	app, ctx := createTestApp(true)
+	require.NoError(t, app.AccountKeeper.ValidateAddressPrefix(ctx))
+
+	// the zero address is iterated first
+	addr := sdk.AccAddress(make([]byte, 20))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+	require.NoError(t, app.AccountKeeper.ValidateAddressPrefix(ctx))
+
+	// store an account encoded with another prefix
+	other, err := bech32.ConvertAndEncode("other", addr)
+	require.NoError(t, err)
+	bz, err := app.AccountKeeper.MarshalAccount(&types.BaseAccount{Address: other})
+	require.NoError(t, err)
+	ctx.KVStore(app.GetKey(types.StoreKey)).Set(types.AddressStoreKey(addr), bz)
+	require.Error(t, app.AccountKeeper.ValidateAddressPrefix(ctx))
+}
+
+func TestMigrate4to5(t *testing.T) {
+	app, ctx := createTestApp(true)
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+
+	// store an account with a public key without indexing it
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	require.NoError(t, acc.SetPubKey(pubKey))
+	bz, err := app.AccountKeeper.MarshalAccount(acc)
+	require.NoError(t, err)
+	ctx.KVStore(app.GetKey(types.StoreKey)).Set(types.AddressStoreKey(addr), bz)
+	require.Nil(t, app.AccountKeeper.GetAccountByPubKey(ctx, pubKey))
+
+	m := keeper.NewMigrator(app.AccountKeeper, app.GRPCQueryRouter())
+	require.NoError(t, m.Migrate4to5(ctx))
+
+	acc = app.AccountKeeper.GetAccountByPubKey(ctx, pubKey)
+	require.NotNil(t, acc)
+	require.Equal(t, addr, acc.GetAddress())
+}
+
+func TestMigrate5to6(t *testing.T) {
+	app, ctx := createTestApp(true)
+
+	// store the params without the PubKeyChangeCost param
+	app.GetSubspace(types.ModuleName).Set(ctx, types.KeyPubKeyChangeCost, uint64(0))
+
+	m := keeper.NewMigrator(app.AccountKeeper, app.GRPCQueryRouter())
+	require.NoError(t, m.Migrate5to6(ctx))
+	require.Equal(t, types.DefaultPubKeyChangeCost, app.AccountKeeper.GetParams(ctx).PubKeyChangeCost)
+}
+
+func TestMigrate6to7(t *testing.T) {
+	app, ctx := createTestApp(true)
+
+	// store the params with other MinGasPrices than the default ones
+	app.GetSubspace(types.ModuleName).Set(ctx, types.KeyMinGasPrices, sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 1)))
+
+	m := keeper.NewMigrator(app.AccountKeeper, app.GRPCQueryRouter())
+	require.NoError(t, m.Migrate6to7(ctx))
+	require.Equal(t, types.DefaultMinGasPrices(), app.AccountKeeper.GetParams(ctx).MinGasPrices)
+}
+
+func TestMigrate7to8(t *testing.T) {
+	app, ctx := createTestApp(true)
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	pubKey := sm2.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+	otherAddr := sdk.AccAddress("other address")
+
+	// store accounts with a raw SM2 public key, which the codec rejects, one of
+	// them with a public key not deriving its address
+	for _, accAddr := range []sdk.AccAddress{addr, otherAddr} {
+		acc := app.AccountKeeper.NewAccountWithAddress(ctx, accAddr).(*types.BaseAccount)
+		acc.PubKey = &codectypes.Any{TypeUrl: types.PubKeyTypeURLSm2, Value: pubKey.Bytes()}
+		bz, err := app.AccountKeeper.MarshalAccount(acc)
+		require.NoError(t, err)
+		store.Set(types.AddressStoreKey(accAddr), bz)
+		_, err = app.AccountKeeper.UnmarshalAccount(bz)
+		require.Error(t, err)
+	}
+	legacyBz := store.Get(types.AddressStoreKey(addr))
+
+	// the dry run reports both accounts without writing them
+	report, err := app.AccountKeeper.MigrateSm2PubKeys(ctx, true)
+	require.NoError(t, err)
+	require.Len(t, report.Accounts, 2)
+	for _, acc := range report.Accounts {
+		require.Equal(t, []string{v047.EncodingRaw}, acc.Encodings)
+		require.Equal(t, acc.Address == otherAddr.String(), acc.Error != "", acc.Address)
+	}
+	require.Equal(t, legacyBz, store.Get(types.AddressStoreKey(addr)))
+
+	m := keeper.NewMigrator(app.AccountKeeper, app.GRPCQueryRouter())
+	require.Error(t, m.Migrate7to8(ctx))
+	require.Equal(t, legacyBz, store.Get(types.AddressStoreKey(addr)))
+
+	store.Delete(types.AddressStoreKey(otherAddr))
+	require.NoError(t, m.Migrate7to8(ctx))
+	acc := app.AccountKeeper.GetAccountByPubKey(ctx, pubKey)
+	require.NotNil(t, acc)
+	require.Equal(t, addr, acc.GetAddress())
+	require.True(t, pubKey.Equals(acc.GetPubKey()))
+
+	// the migration is idempotent
+	report, err = app.AccountKeeper.MigrateSm2PubKeys(ctx, false)
+	require.NoError(t, err)
+	require.Empty(t, report.Accounts)
+}
+
 func TestGetSetParams(t *testing.T) {
 	app, ctx := createTestApp(true)
 	params := types.DefaultParams()
@@ -116,6 +228,12 @@ func TestGetSetParams(t *testing.T) {
 
 	actualParams := app.AccountKeeper.GetParams(ctx)
 	require.Equal(t, params, actualParams)
+
+	// the MinGasPrices param is part of the param set
+	params.MinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 2)))
+	app.AccountKeeper.SetParams(ctx, params)
+	require.Equal(t, params, app.AccountKeeper.GetParams(ctx))
+	require.Equal(t, params.MinGasPrices, app.AccountKeeper.GetMinGasPrices(ctx))
 }
 
 func TestSupply_ValidatePermissions(t *testing.T) {
@@ -132,7 +250,7 @@ func TestSupply_ValidatePermissions(t *testing.T) {
 	cdc := simapp.MakeTestEncodingConfig().Marshaler
 	keeper := keeper.NewAccountKeeper(
 		cdc, app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
-		types.ProtoBaseAccount, maccPerms,
+		types.ProtoBaseAccount, maccPerms, sdk.NewBech32AddressCodec(sdk.Bech32MainPrefix),
 	)
 
 	err := keeper.ValidatePermissions(multiPermAcc)
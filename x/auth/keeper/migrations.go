@@ -1,9 +1,14 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/gogo/protobuf/grpc"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	v043 "github.com/cosmos/cosmos-sdk/x/auth/legacy/v043"
+	v046 "github.com/cosmos/cosmos-sdk/x/auth/legacy/v046"
+	v047 "github.com/cosmos/cosmos-sdk/x/auth/legacy/v047"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -22,6 +27,12 @@ func NewMigrator(keeper AccountKeeper, queryServer grpc.Server) Migrator {
 
 // Migrate1to2 migrates from version 1 to 2.
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	// the accounts cannot be decoded before their SM2 public keys are
+	// re-encoded
+	if _, err := m.keeper.MigrateSm2PubKeys(ctx, false); err != nil {
+		return err
+	}
+
 	var iterErr error
 
 	m.keeper.IterateAccounts(ctx, func(account types.AccountI) (stop bool) {
@@ -41,3 +52,116 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 
 	return iterErr
 }
+
+// Migrate2to3 migrates from version 2 to 3, replacing the per algorithm
+// signature verification cost params by the SigVerifyCosts table.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v046.MigrateParams(ctx, m.keeper.paramSubspace)
+}
+
+// Migrate3to4 migrates from version 3 to 4, setting the MemoRegex param to its
+// default, which accepts any memo.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.keeper.paramSubspace.Set(ctx, types.KeyMemoRegex, types.DefaultMemoRegex)
+	return nil
+}
+
+// Migrate4to5 migrates from version 4 to 5, indexing the public keys set on
+// the accounts for the AccountByPubKey query.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	if _, err := m.keeper.MigrateSm2PubKeys(ctx, false); err != nil {
+		return err
+	}
+
+	m.keeper.IterateAccounts(ctx, func(account types.AccountI) (stop bool) {
+		if account.GetPubKey() != nil {
+			m.keeper.SetAccount(ctx, account)
+		}
+		return false
+	})
+
+	return nil
+}
+
+// Migrate5to6 migrates from version 5 to 6, setting the PubKeyChangeCost param
+// to its default.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	m.keeper.paramSubspace.Set(ctx, types.KeyPubKeyChangeCost, types.DefaultPubKeyChangeCost)
+	return nil
+}
+
+// Migrate6to7 migrates from version 6 to 7, setting the MinGasPrices param to
+// its default, without chain-wide minimum gas prices.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	m.keeper.paramSubspace.Set(ctx, types.KeyMinGasPrices, types.DefaultMinGasPrices())
+	return nil
+}
+
+// Migrate7to8 migrates from version 7 to 8, re-encoding the SM2 public keys of
+// the accounts stored in a legacy amino encoding by the older versions of the
+// fork. The migrations from the versions before 5 have already done so, since
+// they decode the accounts.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	_, err := m.keeper.MigrateSm2PubKeys(ctx, false)
+	return err
+}
+
+// MigrateSm2PubKeys re-encodes the SM2 public keys of the accounts stored in a
+// legacy amino encoding into their proto Any form, see v047.MigrateAccount, and
+// reports the accounts with such keys. It fails on the first account which
+// cannot be migrated, e.g. whose re-encoded public key does not derive its
+// address, before any account is written.
+//
+// A dry run leaves the store untouched and reports every account, along with
+// the reason the ones which cannot be migrated fail.
+func (ak AccountKeeper) MigrateSm2PubKeys(ctx sdk.Context, dryRun bool) (v047.Report, error) {
+	pc, ok := ak.cdc.(codec.ProtoCodecMarshaler)
+	if !ok {
+		return v047.Report{}, fmt.Errorf("the account codec %T has no interface registry", ak.cdc)
+	}
+
+	report := v047.Report{Accounts: []v047.MigratedAccount{}}
+	var migrated []types.AccountI
+
+	// the accounts are written once the iteration is over
+	err := func() error {
+		iterator := sdk.KVStorePrefixIterator(ctx.KVStore(ak.key), types.AddressStoreKeyPrefix)
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			acc, encodings, err := v047.MigrateAccount(pc.InterfaceRegistry(), iterator.Value())
+			if acc == nil && err == nil {
+				continue
+			}
+
+			addr := sdk.AccAddress(iterator.Key()[len(types.AddressStoreKeyPrefix):])
+			entry := v047.MigratedAccount{Address: addr.String(), Encodings: encodings}
+			if err != nil {
+				if !dryRun {
+					return fmt.Errorf("failed to migrate the SM2 public key of account %s: %w", addr, err)
+				}
+				entry.Error = err.Error()
+			} else {
+				migrated = append(migrated, acc)
+			}
+			report.Accounts = append(report.Accounts, entry)
+		}
+
+		return nil
+	}()
+	if err != nil {
+		return report, err
+	}
+
+	if dryRun {
+		return report, nil
+	}
+	for _, acc := range migrated {
+		ak.SetAccount(ctx, acc)
+	}
+	if len(migrated) > 0 {
+		ak.Logger(ctx).Info("re-encoded the legacy SM2 public keys of the accounts", "accounts", len(migrated))
+	}
+
+	return report, nil
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type msgServer struct {
+	AccountKeeper
+}
+
+// NewMsgServerImpl returns an implementation of the auth MsgServer interface
+// for the provided AccountKeeper.
+func NewMsgServerImpl(keeper AccountKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// ChangePubKey rotates the public key of an account, consuming the
+// PubKeyChangeCost gas surcharge.
+func (s msgServer) ChangePubKey(goCtx context.Context, msg *types.MsgChangePubKey) (*types.MsgChangePubKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := msg.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.GasMeter().ConsumeGas(s.GetParams(ctx).PubKeyChangeCost, "public key change")
+
+	if err := s.AccountKeeper.ChangePubKey(ctx, addr, pubKey); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeChangePubKey,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+
+	return &types.MsgChangePubKeyResponse{}, nil
+}
+
+// SetAuthenticator sets the custom authenticator of an account, or clears it
+// if the authenticator name is empty.
+func (s msgServer) SetAuthenticator(goCtx context.Context, msg *types.MsgSetAuthenticator) (*types.MsgSetAuthenticatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Authenticator == "" {
+		s.RemoveAccountAuthenticator(ctx, addr)
+	} else if err := s.SetAccountAuthenticator(ctx, addr, msg.Authenticator); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetAuthenticator,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyAuthenticator, msg.Authenticator),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+
+	return &types.MsgSetAuthenticatorResponse{}, nil
+}
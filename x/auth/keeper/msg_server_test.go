@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestMsgChangePubKey() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.AccountKeeper)
+
+	_, oldPubKey, addr := testdata.KeyTestPubAddr()
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	suite.Require().NoError(acc.SetPubKey(oldPubKey))
+	suite.Require().NoError(acc.SetSequence(7))
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	_, otherPubKey, otherAddr := testdata.KeyTestPubAddr()
+	other := app.AccountKeeper.NewAccountWithAddress(ctx, otherAddr)
+	suite.Require().NoError(other.SetPubKey(otherPubKey))
+	app.AccountKeeper.SetAccount(ctx, other)
+
+	newPubKey := secp256k1.GenPrivKey().PubKey()
+
+	testCases := []struct {
+		name   string
+		addr   sdk.AccAddress
+		pubKey cryptotypes.PubKey
+		expErr bool
+	}{
+		{"unknown account", sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), newPubKey, true},
+		{"module account", types.NewModuleAddress(minttypes.ModuleName), newPubKey, true},
+		{"current public key", addr, oldPubKey, true},
+		{"public key of another account", addr, otherPubKey, true},
+		{"new public key", addr, newPubKey, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg, err := types.NewMsgChangePubKey(tc.addr, tc.pubKey)
+			suite.Require().NoError(err)
+
+			_, err = msgServer.ChangePubKey(sdk.WrapSDKContext(ctx), msg)
+			if tc.expErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+
+	// the address and the sequence are kept
+	acc = app.AccountKeeper.GetAccount(ctx, addr)
+	suite.Require().True(newPubKey.Equals(acc.GetPubKey()))
+	suite.Require().Equal(uint64(7), acc.GetSequence())
+
+	// the account is indexed by its new public key only
+	suite.Require().Nil(app.AccountKeeper.GetAccountByPubKey(ctx, oldPubKey))
+	suite.Require().Equal(addr, app.AccountKeeper.GetAccountByPubKey(ctx, newPubKey).GetAddress())
+	suite.Require().Equal(otherAddr, app.AccountKeeper.GetAccountByPubKey(ctx, otherPubKey).GetAddress())
+}
+
+func (suite *KeeperTestSuite) TestMsgChangePubKeyGas() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.AccountKeeper)
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	suite.Require().NoError(acc.SetPubKey(pubKey))
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	params := app.AccountKeeper.GetParams(ctx)
+	params.PubKeyChangeCost = 20000
+	app.AccountKeeper.SetParams(ctx, params)
+
+	msg, err := types.NewMsgChangePubKey(addr, secp256k1.GenPrivKey().PubKey())
+	suite.Require().NoError(err)
+
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, err = msgServer.ChangePubKey(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), params.PubKeyChangeCost)
+}
+
+func (suite *KeeperTestSuite) TestMsgSetAuthenticator() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.AccountKeeper)
+
+	addr := sdk.AccAddress([]byte("smart-account-------"))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+	app.AccountKeeper.RegisterAuthenticator("msg-smart-account", types.AuthenticatorFunc(
+		func(sdk.Context, types.AccountI, signing.SignatureV2, types.SignBytesFunc, sdk.Tx) error {
+			return nil
+		},
+	))
+
+	testCases := []struct {
+		name          string
+		addr          sdk.AccAddress
+		authenticator string
+		expErr        bool
+	}{
+		{"unknown account", sdk.AccAddress([]byte("unknown-------------")), "msg-smart-account", true},
+		{"unregistered authenticator", addr, "unknown", true},
+		{"registered authenticator", addr, "msg-smart-account", false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := types.NewMsgSetAuthenticator(tc.addr, tc.authenticator)
+			_, err := msgServer.SetAuthenticator(sdk.WrapSDKContext(ctx), msg)
+			if tc.expErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+
+	name, found := app.AccountKeeper.GetAccountAuthenticator(ctx, addr)
+	suite.Require().True(found)
+	suite.Require().Equal("msg-smart-account", name)
+
+	// an empty authenticator clears the authenticator of the account
+	_, err := msgServer.SetAuthenticator(sdk.WrapSDKContext(ctx), types.NewMsgSetAuthenticator(addr, ""))
+	suite.Require().NoError(err)
+	_, found = app.AccountKeeper.GetAccountAuthenticator(ctx, addr)
+	suite.Require().False(found)
+}
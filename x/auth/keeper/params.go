@@ -15,3 +15,11 @@ func (ak AccountKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 	ak.paramSubspace.GetParamSet(ctx, &params)
 	return
 }
+
+// GetMinGasPrices returns the chain-wide minimum gas prices of the MinGasPrices
+// param, reading only this param rather than the whole param set, since it is
+// read by every tx. It returns no gas prices before the param is set.
+func (ak AccountKeeper) GetMinGasPrices(ctx sdk.Context) (minGasPrices sdk.DecCoins) {
+	ak.paramSubspace.GetIfExists(ctx, types.KeyMinGasPrices, &minGasPrices)
+	return minGasPrices
+}
@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// UpdateModuleAccountPermissions grants the given permissions to and revokes
+// the given permissions from a registered module account, creating the module
+// account if it doesn't exist yet. Only governable permissions may be granted
+// or revoked, see types.IsGovernablePermission.
+func (ak AccountKeeper) UpdateModuleAccountPermissions(ctx sdk.Context, moduleName string, grant, revoke []string) error {
+	if _, ok := ak.permAddrs[moduleName]; !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownModuleAccount, "module account %s is not registered", moduleName)
+	}
+
+	for _, perm := range append(append([]string{}, grant...), revoke...) {
+		if !types.IsGovernablePermission(perm) {
+			return sdkerrors.Wrapf(types.ErrInvalidModulePermission, "permission %q cannot be changed by governance", perm)
+		}
+	}
+
+	macc, ok := ak.GetModuleAccount(ctx, moduleName).(*types.ModuleAccount)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownModuleAccount, "module account %s has an unsupported type", moduleName)
+	}
+
+	var perms []string
+	for _, perm := range macc.Permissions {
+		if !containsPermission(revoke, perm) {
+			perms = append(perms, perm)
+		}
+	}
+	for _, perm := range grant {
+		if !containsPermission(perms, perm) {
+			perms = append(perms, perm)
+		}
+	}
+
+	macc.Permissions = perms
+	ak.SetModuleAccount(ctx, macc)
+
+	ak.Logger(ctx).Info("updated module account permissions", "module", moduleName, "permissions", perms)
+
+	return nil
+}
+
+// GetAllModuleAccountPermissions returns the permissions currently granted to
+// every registered module account, sorted by module account name. Module
+// accounts not created yet are reported with their registered permissions.
+func (ak AccountKeeper) GetAllModuleAccountPermissions(ctx sdk.Context) []types.ModuleAccountPermissions {
+	names := ak.moduleAccountNames()
+	res := make([]types.ModuleAccountPermissions, 0, len(names))
+	for _, name := range names {
+		addr, perms := ak.moduleAccountPermissions(ctx, name)
+		res = append(res, types.ModuleAccountPermissions{
+			Name:        name,
+			Address:     addr.String(),
+			Permissions: perms,
+		})
+	}
+
+	return res
+}
+
+// GetModuleAccountInfo returns the address, permissions and balance of a
+// registered module account, and false if no module account is registered
+// under the name. The balance is empty unless a balance keeper is set.
+func (ak AccountKeeper) GetModuleAccountInfo(ctx sdk.Context, name string) (types.ModuleAccountInfo, bool) {
+	if _, ok := ak.permAddrs[name]; !ok {
+		return types.ModuleAccountInfo{}, false
+	}
+
+	addr, perms := ak.moduleAccountPermissions(ctx, name)
+	info := types.ModuleAccountInfo{
+		Name:        name,
+		Address:     addr.String(),
+		Permissions: perms,
+	}
+	if ak.balanceKeeper != nil {
+		info.Balance = ak.balanceKeeper.GetAllBalances(ctx, addr)
+	}
+
+	return info, true
+}
+
+// GetAllModuleAccountInfos returns the address, permissions and balance of
+// every registered module account, sorted by module account name.
+func (ak AccountKeeper) GetAllModuleAccountInfos(ctx sdk.Context) []types.ModuleAccountInfo {
+	names := ak.moduleAccountNames()
+	res := make([]types.ModuleAccountInfo, 0, len(names))
+	for _, name := range names {
+		info, _ := ak.GetModuleAccountInfo(ctx, name)
+		res = append(res, info)
+	}
+
+	return res
+}
+
+// moduleAccountNames returns the sorted names of the registered module
+// accounts.
+func (ak AccountKeeper) moduleAccountNames() []string {
+	names := make([]string, 0, len(ak.permAddrs))
+	for name := range ak.permAddrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// moduleAccountPermissions returns the address of a registered module account
+// and the permissions currently granted to it, which are its registered
+// permissions until it is created.
+func (ak AccountKeeper) moduleAccountPermissions(ctx sdk.Context, name string) (sdk.AccAddress, []string) {
+	permAddr := ak.permAddrs[name]
+	perms := permAddr.GetPermissions()
+
+	if acc := ak.GetAccount(ctx, permAddr.GetAddress()); acc != nil {
+		if macc, ok := acc.(types.ModuleAccountI); ok {
+			perms = macc.GetPermissions()
+		}
+	}
+
+	return permAddr.GetAddress(), perms
+}
+
+func containsPermission(perms []string, perm string) bool {
+	for _, p := range perms {
+		if p == perm {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (suite *KeeperTestSuite) TestUpdateModuleAccountPermissions() {
+	app, ctx := suite.app, suite.ctx
+
+	// grant to a module account without permissions
+	suite.Require().NoError(app.AccountKeeper.UpdateModuleAccountPermissions(ctx, distrtypes.ModuleName, []string{types.Minter}, nil))
+	macc := app.AccountKeeper.GetModuleAccount(ctx, distrtypes.ModuleName)
+	suite.Require().True(macc.HasPermission(types.Minter))
+
+	// granting twice is a no-op
+	suite.Require().NoError(app.AccountKeeper.UpdateModuleAccountPermissions(ctx, distrtypes.ModuleName, []string{types.Minter}, nil))
+	_, perms := app.AccountKeeper.GetModuleAccountAndPermissions(ctx, distrtypes.ModuleName)
+	suite.Require().Equal([]string{types.Minter}, perms)
+
+	// revoke and grant at once
+	suite.Require().NoError(app.AccountKeeper.UpdateModuleAccountPermissions(ctx, distrtypes.ModuleName, []string{types.Burner}, []string{types.Minter}))
+	_, perms = app.AccountKeeper.GetModuleAccountAndPermissions(ctx, distrtypes.ModuleName)
+	suite.Require().Equal([]string{types.Burner}, perms)
+	suite.Require().NoError(app.AccountKeeper.ValidatePermissions(app.AccountKeeper.GetModuleAccount(ctx, distrtypes.ModuleName)))
+
+	// the staking permission cannot be changed
+	err := app.AccountKeeper.UpdateModuleAccountPermissions(ctx, stakingtypes.BondedPoolName, nil, []string{types.Staking})
+	suite.Require().ErrorIs(err, types.ErrInvalidModulePermission)
+	suite.Require().True(app.AccountKeeper.GetModuleAccount(ctx, stakingtypes.BondedPoolName).HasPermission(types.Staking))
+
+	// unregistered module accounts cannot be changed
+	err = app.AccountKeeper.UpdateModuleAccountPermissions(ctx, "unknown", []string{types.Minter}, nil)
+	suite.Require().ErrorIs(err, types.ErrUnknownModuleAccount)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryModuleAccountPermissions() {
+	app, ctx := suite.app, suite.ctx
+
+	suite.Require().NoError(app.AccountKeeper.UpdateModuleAccountPermissions(ctx, govtypes.ModuleName, nil, []string{types.Burner}))
+
+	res, err := suite.queryClient.ModuleAccountPermissions(ctx.Context(), &types.QueryModuleAccountPermissionsRequest{})
+	suite.Require().NoError(err)
+
+	perms := make(map[string][]string)
+	for i, p := range res.Permissions {
+		if i > 0 {
+			suite.Require().Less(res.Permissions[i-1].Name, p.Name)
+		}
+		suite.Require().Equal(types.NewModuleAddress(p.Name).String(), p.Address)
+		perms[p.Name] = p.Permissions
+	}
+
+	suite.Require().Equal([]string{types.Minter}, perms[minttypes.ModuleName])
+	suite.Require().Empty(perms[govtypes.ModuleName])
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryModuleAccounts() {
+	app, ctx := suite.app, suite.ctx
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	suite.Require().NoError(simapp.FundModuleAccount(app.BankKeeper, ctx, minttypes.ModuleName, coins))
+
+	res, err := suite.queryClient.ModuleAccounts(ctx.Context(), &types.QueryModuleAccountsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Accounts, len(app.ModuleAccountAddrs()))
+
+	accounts := make(map[string]types.ModuleAccountInfo)
+	for i, acc := range res.Accounts {
+		if i > 0 {
+			suite.Require().Less(res.Accounts[i-1].Name, acc.Name)
+		}
+		suite.Require().Equal(types.NewModuleAddress(acc.Name).String(), acc.Address)
+		accounts[acc.Name] = acc
+	}
+
+	suite.Require().Equal([]string{types.Minter}, accounts[minttypes.ModuleName].Permissions)
+	suite.Require().Equal(coins, accounts[minttypes.ModuleName].Balance)
+	suite.Require().ElementsMatch([]string{types.Burner, types.Staking}, accounts[stakingtypes.BondedPoolName].Permissions)
+	suite.Require().True(accounts[govtypes.ModuleName].Balance.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryModuleAccountByName() {
+	app, ctx := suite.app, suite.ctx
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	suite.Require().NoError(simapp.FundModuleAccount(app.BankKeeper, ctx, minttypes.ModuleName, coins))
+
+	testCases := []struct {
+		name   string
+		req    *types.QueryModuleAccountByNameRequest
+		expErr bool
+	}{
+		{"empty name", &types.QueryModuleAccountByNameRequest{}, true},
+		{"unknown module account", &types.QueryModuleAccountByNameRequest{Name: "unknown"}, true},
+		{"registered module account", &types.QueryModuleAccountByNameRequest{Name: minttypes.ModuleName}, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := suite.queryClient.ModuleAccountByName(ctx.Context(), tc.req)
+			if tc.expErr {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(minttypes.ModuleName, res.Account.Name)
+			suite.Require().Equal(types.NewModuleAddress(minttypes.ModuleName).String(), res.Account.Address)
+			suite.Require().Equal([]string{types.Minter}, res.Account.Permissions)
+			suite.Require().Equal(coins, res.Account.Balance)
+		})
+	}
+}
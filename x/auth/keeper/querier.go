@@ -31,7 +31,7 @@ func queryAccount(ctx sdk.Context, req abci.RequestQuery, k AccountKeeper, legac
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	addr, err := sdk.AccAddressFromBech32(params.Address)
+	addr, err := k.AddressCodec().StringToBytes(params.Address)
 	if err != nil {
 		return nil, err
 	}
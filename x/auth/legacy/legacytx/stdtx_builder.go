@@ -2,6 +2,7 @@ package legacytx
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -66,6 +67,12 @@ func (s *StdTxBuilder) SetTimeoutHeight(height uint64) {
 	s.TimeoutHeight = height
 }
 
+// SetTimeoutTimestamp does nothing for stdtx
+func (s *StdTxBuilder) SetTimeoutTimestamp(_ time.Time) {}
+
+// SetUnordered does nothing for stdtx
+func (s *StdTxBuilder) SetUnordered(_ bool) {}
+
 // SetFeeGranter does nothing for stdtx
 func (s *StdTxBuilder) SetFeeGranter(_ sdk.AccAddress) {}
 
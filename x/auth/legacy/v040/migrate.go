@@ -114,11 +114,13 @@ func Migrate(authGenState v039auth.GenesisState) *v040auth.GenesisState {
 
 	return &v040auth.GenesisState{
 		Params: v040auth.Params{
-			MaxMemoCharacters:      authGenState.Params.MaxMemoCharacters,
-			TxSigLimit:             authGenState.Params.TxSigLimit,
-			TxSizeCostPerByte:      authGenState.Params.TxSizeCostPerByte,
-			SigVerifyCostED25519:   authGenState.Params.SigVerifyCostED25519,
-			SigVerifyCostSecp256k1: authGenState.Params.SigVerifyCostSecp256k1,
+			MaxMemoCharacters: authGenState.Params.MaxMemoCharacters,
+			TxSigLimit:        authGenState.Params.TxSigLimit,
+			TxSizeCostPerByte: authGenState.Params.TxSizeCostPerByte,
+			SigVerifyCosts: []v040auth.SigVerifyCost{
+				v040auth.NewSigVerifyCost(v040auth.PubKeyTypeURLEd25519, authGenState.Params.SigVerifyCostED25519),
+				v040auth.NewSigVerifyCost(v040auth.PubKeyTypeURLSecp256k1, authGenState.Params.SigVerifyCostSecp256k1),
+			},
 		},
 		Accounts: anys,
 	}
@@ -84,6 +84,7 @@ func TestMigrate(t *testing.T) {
 
 	migrated := v040auth.Migrate(gs)
 	expected := `{
+  "account_authenticators": [],
   "accounts": [
     {
       "@type": "/cosmos.auth.v1beta1.BaseAccount",
@@ -232,11 +233,22 @@ func TestMigrate(t *testing.T) {
       "sequence": "0"
     }
   ],
+  "blocked_addresses": [],
   "params": {
     "max_memo_characters": "10",
-    "sig_verify_cost_ed25519": "40",
-    "sig_verify_cost_secp256k1": "50",
-    "sig_verify_cost_sm2": "0",
+    "memo_regex": "",
+    "min_gas_prices": [],
+    "pub_key_change_cost": "0",
+    "sig_verify_costs": [
+      {
+        "cost": "40",
+        "pub_key_type_url": "/cosmos.crypto.ed25519.PubKey"
+      },
+      {
+        "cost": "50",
+        "pub_key_type_url": "/cosmos.crypto.secp256k1.PubKey"
+      }
+    ],
     "tx_sig_limit": "20",
     "tx_size_cost_per_byte": "30"
   }
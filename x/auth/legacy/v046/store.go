@@ -0,0 +1,66 @@
+// Package v046 creates in-place store migrations for replacing the per
+// algorithm signature verification cost params by the SigVerifyCosts table.
+package v046
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Legacy parameter keys of the signature verification costs.
+var (
+	KeySigVerifyCostED25519   = []byte("SigVerifyCostED25519")
+	KeySigVerifyCostSecp256k1 = []byte("SigVerifyCostSecp256k1")
+	KeySigVerifyCostSm2       = []byte("SigVerifyCostSm2")
+)
+
+// MigrateParams sets the SigVerifyCosts param from the legacy ed25519,
+// secp256k1 and sm2 signature verification cost params. The secp256r1 cost,
+// formerly derived from the secp256k1 one, is set to half of it. Legacy params
+// missing from the store keep their default cost. The legacy params are then
+// deleted from the store.
+func MigrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	cdc := codec.NewLegacyAmino()
+
+	legacyCost := func(key []byte, defaultCost uint64) (uint64, error) {
+		bz := paramSpace.GetRaw(ctx, key)
+		if bz == nil {
+			return defaultCost, nil
+		}
+
+		var cost uint64
+		if err := cdc.UnmarshalJSON(bz, &cost); err != nil {
+			return 0, err
+		}
+
+		return cost, nil
+	}
+
+	ed25519Cost, err := legacyCost(KeySigVerifyCostED25519, types.DefaultSigVerifyCostED25519)
+	if err != nil {
+		return err
+	}
+	secp256k1Cost, err := legacyCost(KeySigVerifyCostSecp256k1, types.DefaultSigVerifyCostSecp256k1)
+	if err != nil {
+		return err
+	}
+	sm2Cost, err := legacyCost(KeySigVerifyCostSm2, types.DefaultSigVerifyCostSm2)
+	if err != nil {
+		return err
+	}
+
+	paramSpace.Set(ctx, types.KeySigVerifyCosts, []types.SigVerifyCost{
+		types.NewSigVerifyCost(types.PubKeyTypeURLEd25519, ed25519Cost),
+		types.NewSigVerifyCost(types.PubKeyTypeURLSecp256k1, secp256k1Cost),
+		types.NewSigVerifyCost(types.PubKeyTypeURLSecp256r1, secp256k1Cost/2),
+		types.NewSigVerifyCost(types.PubKeyTypeURLSm2, sm2Cost),
+	})
+
+	for _, key := range [][]byte{KeySigVerifyCostED25519, KeySigVerifyCostSecp256k1, KeySigVerifyCostSm2} {
+		paramSpace.DeleteRaw(ctx, key)
+	}
+
+	return nil
+}
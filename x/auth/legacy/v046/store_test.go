@@ -0,0 +1,44 @@
+package v046_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v046"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(paramsKey, paramsTKey)
+	paramSpace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// Store the legacy params, leaving the sm2 cost unset.
+	store := ctx.KVStore(paramsKey)
+	store.Set(append([]byte(types.ModuleName+"/"), v046auth.KeySigVerifyCostED25519...), []byte(`"600"`))
+	store.Set(append([]byte(types.ModuleName+"/"), v046auth.KeySigVerifyCostSecp256k1...), []byte(`"1200"`))
+
+	require.NoError(t, v046auth.MigrateParams(ctx, paramSpace))
+
+	var costs []types.SigVerifyCost
+	paramSpace.Get(ctx, types.KeySigVerifyCosts, &costs)
+	require.Equal(t, []types.SigVerifyCost{
+		types.NewSigVerifyCost(types.PubKeyTypeURLEd25519, 600),
+		types.NewSigVerifyCost(types.PubKeyTypeURLSecp256k1, 1200),
+		types.NewSigVerifyCost(types.PubKeyTypeURLSecp256r1, 600),
+		types.NewSigVerifyCost(types.PubKeyTypeURLSm2, types.DefaultSigVerifyCostSm2),
+	}, costs)
+
+	// the legacy params are deleted
+	for _, key := range [][]byte{v046auth.KeySigVerifyCostED25519, v046auth.KeySigVerifyCostSecp256k1, v046auth.KeySigVerifyCostSm2} {
+		require.Nil(t, paramSpace.GetRaw(ctx, key))
+	}
+}
@@ -0,0 +1,209 @@
+// Package v047 creates in-place store migrations for re-encoding the legacy
+// amino representations of the SM2 public keys of the accounts into their
+// proto Any form.
+package v047
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/gogo/protobuf/proto"
+	amino "github.com/tendermint/go-amino"
+	tmsm2 "github.com/tendermint/tendermint/crypto/sm2"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/sm2"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// The legacy encodings of the SM2 public keys found in the Any values of the
+// accounts by the older versions of the fork.
+const (
+	// EncodingRaw is the amino binary of the key without its prefix, i.e.
+	// the compressed key bytes.
+	EncodingRaw = "raw"
+	// EncodingAminoBinary is the prefixed amino binary of the key.
+	EncodingAminoBinary = "amino-binary"
+	// EncodingAminoJSON is the amino JSON of the key.
+	EncodingAminoJSON = "amino-json"
+)
+
+// legacySm2PubKeyNames are the amino names of the SM2 public keys, which the
+// legacy Any values may also have as type URL.
+var legacySm2PubKeyNames = []string{sm2.PubKeyName, tmsm2.PubKeyName}
+
+// MigratedAccount is an account with SM2 public keys in a legacy encoding.
+type MigratedAccount struct {
+	Address string `json:"address"`
+	// Encodings are the legacy encodings of its SM2 public keys, several for
+	// a multisig public key.
+	Encodings []string `json:"encodings"`
+	// Error is the reason the account cannot be migrated, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// Report lists the accounts with SM2 public keys in a legacy encoding.
+type Report struct {
+	Accounts []MigratedAccount `json:"accounts"`
+}
+
+// MigrateAccount decodes the account of the given store bytes, re-encoding its
+// SM2 public keys in a legacy encoding into their proto Any form. It returns
+// the account along with the legacy encodings found, or a nil account if the
+// keys of the account are all in their proto form.
+//
+// The legacy keys predate the rotation of public keys, thus the address
+// derived from the re-encoded public key must be the one of the account.
+func MigrateAccount(registry codectypes.InterfaceRegistry, bz []byte) (types.AccountI, []string, error) {
+	var accAny codectypes.Any
+	if err := accAny.Unmarshal(bz); err != nil {
+		return nil, nil, err
+	}
+	msg, err := registry.Resolve(accAny.TypeUrl)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := proto.Unmarshal(accAny.Value, msg); err != nil {
+		return nil, nil, err
+	}
+
+	unpacker := &sm2PubKeyUnpacker{registry: registry}
+	if err := codectypes.UnpackInterfaces(msg, unpacker); err != nil {
+		return nil, unpacker.encodings, err
+	}
+	if len(unpacker.encodings) == 0 {
+		return nil, nil, nil
+	}
+
+	acc, ok := msg.(types.AccountI)
+	if !ok {
+		return nil, unpacker.encodings, sdkerrors.ErrInvalidType.Wrapf("%T is not an account", msg)
+	}
+	if pubKey := acc.GetPubKey(); pubKey == nil || !bytes.Equal(sdk.AccAddressFromPubKey(pubKey), acc.GetAddress()) {
+		return nil, unpacker.encodings, sdkerrors.ErrInvalidPubKey.Wrapf(
+			"re-encoded public key %s does not derive the address of account %s", pubKey, acc.GetAddress(),
+		)
+	}
+
+	return acc, unpacker.encodings, nil
+}
+
+// sm2PubKeyUnpacker unpacks the Any values of an account, re-encoding the SM2
+// public keys in a legacy encoding, including the ones of a multisig public
+// key, whose Any value is then re-encoded as well.
+type sm2PubKeyUnpacker struct {
+	registry  codectypes.InterfaceRegistry
+	encodings []string
+}
+
+var _ codectypes.AnyUnpacker = &sm2PubKeyUnpacker{}
+
+func (u *sm2PubKeyUnpacker) UnpackAny(any *codectypes.Any, iface interface{}) error {
+	if _, ok := iface.(*cryptotypes.PubKey); !ok || any == nil || any.TypeUrl == "" {
+		return u.registry.UnpackAny(any, iface)
+	}
+
+	if pubKey, encoding, ok := decodeLegacySm2PubKey(any); ok {
+		if err := u.repack(any, pubKey); err != nil {
+			return err
+		}
+		u.encodings = append(u.encodings, encoding)
+		return u.registry.UnpackAny(any, iface)
+	}
+
+	msg, err := u.registry.Resolve(any.TypeUrl)
+	if err != nil {
+		return u.registry.UnpackAny(any, iface)
+	}
+	if err := proto.Unmarshal(any.Value, msg); err != nil {
+		return err
+	}
+	n := len(u.encodings)
+	if err := codectypes.UnpackInterfaces(msg, u); err != nil {
+		return err
+	}
+	if len(u.encodings) > n {
+		if err := u.repack(any, msg); err != nil {
+			return err
+		}
+	}
+
+	return u.registry.UnpackAny(any, iface)
+}
+
+// repack replaces the Any value by the one of msg.
+func (u *sm2PubKeyUnpacker) repack(any *codectypes.Any, msg proto.Message) error {
+	repacked, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return err
+	}
+	*any = *repacked
+
+	return nil
+}
+
+// decodeLegacySm2PubKey returns the SM2 public key of an Any value in one of
+// the legacy encodings, along with the encoding.
+func decodeLegacySm2PubKey(any *codectypes.Any) (*sm2.PubKey, string, bool) {
+	if any.TypeUrl == types.PubKeyTypeURLSm2 {
+		var pubKey sm2.PubKey
+		if err := pubKey.Unmarshal(any.Value); err == nil && isSm2PubKey(pubKey.Key) {
+			return nil, "", false
+		}
+	} else if !isLegacySm2PubKeyName(any.TypeUrl) {
+		return nil, "", false
+	}
+
+	bz := any.Value
+	if isSm2PubKey(bz) {
+		return newSm2PubKey(bz), EncodingRaw, true
+	}
+
+	for _, name := range legacySm2PubKeyNames {
+		_, prefix := amino.NameToDisfix(name)
+		if !bytes.HasPrefix(bz, prefix[:]) {
+			continue
+		}
+		rest := bz[len(prefix):]
+		if size, n := binary.Uvarint(rest); n > 0 && size == uint64(len(rest)-n) && isSm2PubKey(rest[n:]) {
+			return newSm2PubKey(rest[n:]), EncodingAminoBinary, true
+		}
+	}
+
+	var aminoJSON struct {
+		Type  string `json:"type"`
+		Value []byte `json:"value"`
+	}
+	if err := json.Unmarshal(bz, &aminoJSON); err == nil && isLegacySm2PubKeyName(aminoJSON.Type) && isSm2PubKey(aminoJSON.Value) {
+		return newSm2PubKey(aminoJSON.Value), EncodingAminoJSON, true
+	}
+
+	return nil, "", false
+}
+
+func isLegacySm2PubKeyName(name string) bool {
+	for _, legacyName := range legacySm2PubKeyNames {
+		if name == legacyName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isSm2PubKey returns true if bz has the size and the prefix of a compressed
+// SM2 public key.
+func isSm2PubKey(bz []byte) bool {
+	return len(bz) == sm2.PubKeySize && (bz[0] == 0x02 || bz[0] == 0x03)
+}
+
+func newSm2PubKey(bz []byte) *sm2.PubKey {
+	key := make([]byte, len(bz))
+	copy(key, bz)
+
+	return &sm2.PubKey{Key: key}
+}
@@ -0,0 +1,146 @@
+package v047_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	amino "github.com/tendermint/go-amino"
+	tmsm2 "github.com/tendermint/tendermint/crypto/sm2"
+
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/sm2"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v047"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func TestMigrateAccount(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	pubKey := sm2.GenPrivKey().PubKey().(*sm2.PubKey)
+	addr := sdk.AccAddress(pubKey.Address())
+	_, tmPrefix := amino.NameToDisfix(tmsm2.PubKeyName)
+
+	protoAny, err := codectypes.NewAnyWithValue(pubKey)
+	require.NoError(t, err)
+	secp256k1Any, err := codectypes.NewAnyWithValue(secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		pubKey   *codectypes.Any
+		addr     sdk.AccAddress
+		encoding string
+		expErr   bool
+	}{
+		{"proto", protoAny, addr, "", false},
+		{"other key type", secp256k1Any, sdk.AccAddress(secp256k1Any.GetCachedValue().(cryptotypes.PubKey).Address()), "", false},
+		{"raw", &codectypes.Any{TypeUrl: types.PubKeyTypeURLSm2, Value: pubKey.Key}, addr, v047auth.EncodingRaw, false},
+		{"raw with amino name", &codectypes.Any{TypeUrl: sm2.PubKeyName, Value: pubKey.Key}, addr, v047auth.EncodingRaw, false},
+		{
+			"amino binary", &codectypes.Any{TypeUrl: types.PubKeyTypeURLSm2, Value: legacy.Cdc.MustMarshal(pubKey)},
+			addr, v047auth.EncodingAminoBinary, false,
+		},
+		{
+			"tendermint amino binary", &codectypes.Any{TypeUrl: tmsm2.PubKeyName, Value: append(append(tmPrefix[:], sm2.PubKeySize), pubKey.Key...)},
+			addr, v047auth.EncodingAminoBinary, false,
+		},
+		{
+			"amino json", &codectypes.Any{TypeUrl: sm2.PubKeyName, Value: legacy.Cdc.MustMarshalJSON(pubKey)},
+			addr, v047auth.EncodingAminoJSON, false,
+		},
+		{
+			"tendermint amino json", &codectypes.Any{TypeUrl: types.PubKeyTypeURLSm2, Value: []byte(`{"type":"tendermint/PubKeySm2","value":"` + base64.StdEncoding.EncodeToString(pubKey.Key) + `"}`)},
+			addr, v047auth.EncodingAminoJSON, false,
+		},
+		{"other address", &codectypes.Any{TypeUrl: types.PubKeyTypeURLSm2, Value: pubKey.Key}, sdk.AccAddress("other address"), v047auth.EncodingRaw, true},
+		{"unknown encoding", &codectypes.Any{TypeUrl: sm2.PubKeyName, Value: []byte("unknown")}, addr, "", true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := encCfg.Marshaler.MarshalInterface(&types.BaseAccount{Address: tc.addr.String(), PubKey: tc.pubKey, AccountNumber: 3})
+			require.NoError(t, err)
+
+			acc, encodings, err := v047auth.MigrateAccount(encCfg.InterfaceRegistry, bz)
+			if tc.expErr {
+				require.Error(t, err)
+				require.Nil(t, acc)
+				return
+			}
+			require.NoError(t, err)
+			if tc.encoding == "" {
+				require.Nil(t, acc)
+				require.Empty(t, encodings)
+				return
+			}
+
+			require.Equal(t, []string{tc.encoding}, encodings)
+			require.Equal(t, uint64(3), acc.GetAccountNumber())
+			require.True(t, pubKey.Equals(acc.GetPubKey()))
+
+			// the re-encoded account is decoded by the codec
+			bz, err = encCfg.Marshaler.MarshalInterface(acc)
+			require.NoError(t, err)
+			var decoded types.AccountI
+			require.NoError(t, encCfg.Marshaler.UnmarshalInterface(bz, &decoded))
+			require.True(t, pubKey.Equals(decoded.GetPubKey()))
+		})
+	}
+}
+
+func TestMigrateVestingAccount(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	pubKey := sm2.GenPrivKey().PubKey().(*sm2.PubKey)
+	baseAcc := &types.BaseAccount{
+		Address: sdk.AccAddress(pubKey.Address()).String(),
+		PubKey:  &codectypes.Any{TypeUrl: types.PubKeyTypeURLSm2, Value: pubKey.Key},
+	}
+	vestingAcc := vestingtypes.NewContinuousVestingAccount(baseAcc, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), 1, 2)
+	bz, err := encCfg.Marshaler.MarshalInterface(vestingAcc)
+	require.NoError(t, err)
+
+	acc, encodings, err := v047auth.MigrateAccount(encCfg.InterfaceRegistry, bz)
+	require.NoError(t, err)
+	require.Equal(t, []string{v047auth.EncodingRaw}, encodings)
+	require.IsType(t, &vestingtypes.ContinuousVestingAccount{}, acc)
+	require.True(t, pubKey.Equals(acc.GetPubKey()))
+	require.Equal(t, int64(2), acc.(*vestingtypes.ContinuousVestingAccount).EndTime)
+}
+
+func TestMigrateMultisigAccount(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	pubKeys := []cryptotypes.PubKey{sm2.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey(), sm2.GenPrivKey().PubKey()}
+	multisigPubKey := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+
+	// the SM2 keys of the multisig key are in legacy encodings
+	legacyPubKey := *multisigPubKey
+	legacyPubKey.PubKeys = []*codectypes.Any{
+		{TypeUrl: types.PubKeyTypeURLSm2, Value: pubKeys[0].Bytes()},
+		multisigPubKey.PubKeys[1],
+		{TypeUrl: sm2.PubKeyName, Value: legacy.Cdc.MustMarshalJSON(pubKeys[2])},
+	}
+	legacyAny, err := codectypes.NewAnyWithValue(&legacyPubKey)
+	require.NoError(t, err)
+	bz, err := encCfg.Marshaler.MarshalInterface(&types.BaseAccount{
+		Address: sdk.AccAddress(multisigPubKey.Address()).String(),
+		PubKey:  legacyAny,
+	})
+	require.NoError(t, err)
+
+	acc, encodings, err := v047auth.MigrateAccount(encCfg.InterfaceRegistry, bz)
+	require.NoError(t, err)
+	require.Equal(t, []string{v047auth.EncodingRaw, v047auth.EncodingAminoJSON}, encodings)
+	require.True(t, multisigPubKey.Equals(acc.GetPubKey()))
+
+	bz, err = encCfg.Marshaler.MarshalInterface(acc)
+	require.NoError(t, err)
+	var decoded types.AccountI
+	require.NoError(t, encCfg.Marshaler.UnmarshalInterface(bz, &decoded))
+	require.True(t, multisigPubKey.Equals(decoded.GetPubKey()))
+}
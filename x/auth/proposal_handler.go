@@ -0,0 +1,35 @@
+package auth
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types/proposal"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// ProposalHandler is the module account permissions proposal client handler.
+var ProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitModuleAccountPermissionsProposal, rest.ProposalRESTHandler)
+
+// BlocklistProposalHandler is the blocklist proposal client handler.
+var BlocklistProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitBlocklistProposal, rest.BlocklistProposalRESTHandler)
+
+// NewModuleAccountPermissionsProposalHandler creates a new governance Handler for a
+// ModuleAccountPermissionsProposal or a BlocklistProposal
+func NewModuleAccountPermissionsProposalHandler(ak keeper.AccountKeeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *proposal.ModuleAccountPermissionsProposal:
+			return ak.UpdateModuleAccountPermissions(ctx, c.ModuleName, c.Grant, c.Revoke)
+
+		case *proposal.BlocklistProposal:
+			return ak.UpdateBlocklist(ctx, c.Block, c.Unblock)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized auth proposal content type: %T", c)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/session"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	sessionQueryCmd := &cobra.Command{
+		Use:                        session.ModuleName,
+		Short:                      "Querying commands for the session module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	sessionQueryCmd.AddCommand(
+		GetCmdQuerySessionKey(),
+		GetCmdQuerySessionKeys(),
+	)
+
+	return sessionQueryCmd
+}
+
+// GetCmdQuerySessionKey returns cmd to query a session key of a granter.
+func GetCmdQuerySessionKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "key [granter] [session_address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query a session key of an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the session key with the given address authorized by the granter.
+
+Example:
+$ %s query %s key [granter] [session_address]
+`, version.AppName, session.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := session.NewQueryClient(clientCtx)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			sessionAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SessionKey(cmd.Context(), &session.QuerySessionKeyRequest{
+				Granter:        granter.String(),
+				SessionAddress: sessionAddr.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.SessionKey)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQuerySessionKeys returns cmd to query all the session keys of a granter.
+func GetCmdQuerySessionKeys() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "keys [granter]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query all the session keys of an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the session keys authorized by the granter.
+
+Example:
+$ %s query %s keys [granter]
+`, version.AppName, session.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := session.NewQueryClient(clientCtx)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SessionKeys(cmd.Context(), &session.QuerySessionKeysRequest{
+				Granter:    granter.String(),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "session keys")
+	return cmd
+}
@@ -0,0 +1,151 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/session"
+)
+
+// flags for the session module
+const (
+	FlagExpiration  = "expiration"
+	FlagDuration    = "duration"
+	FlagAllowedMsgs = "allowed-messages"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	sessionTxCmd := &cobra.Command{
+		Use:                        session.ModuleName,
+		Short:                      "Session key transactions subcommands",
+		Long:                       "Authorize and revoke session keys signing on behalf of an account",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	sessionTxCmd.AddCommand(
+		NewCmdAddSessionKey(),
+		NewCmdRevokeSessionKey(),
+	)
+
+	return sessionTxCmd
+}
+
+// NewCmdAddSessionKey returns a CLI command handler for creating a MsgAddSessionKey transaction.
+func NewCmdAddSessionKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add [session_pubkey]",
+		Short: "Authorize a session key to sign on behalf of your account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Authorize a session key, given as a public key in JSON format, to sign
+transactions on behalf of the --from account until its expiration, restricted to the allowed
+message types. The expiration is either an RFC3339 time or a duration from now.
+
+Examples:
+%s tx %s add '{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A..."}' --duration 1h
+	--allowed-messages "/cosmos.bank.v1beta1.MsgSend" --from mykey
+%s tx %s add '{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A..."}' --expiration 2022-01-30T15:04:05Z
+	--allowed-messages "/cosmos.gov.v1beta1.MsgVote,/cosmos.staking.v1beta1.MsgDelegate" --from mykey
+`, version.AppName, session.ModuleName, version.AppName, session.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pk cryptotypes.PubKey
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pk); err != nil {
+				return err
+			}
+
+			expiration, err := getExpiration(cmd)
+			if err != nil {
+				return err
+			}
+
+			allowedMsgs, err := cmd.Flags().GetStringSlice(FlagAllowedMsgs)
+			if err != nil {
+				return err
+			}
+
+			msg, err := session.NewMsgAddSessionKey(clientCtx.GetFromAddress(), pk, expiration, allowedMsgs)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagExpiration, "", "The RFC3339 time at which the session key expires")
+	cmd.Flags().Duration(FlagDuration, 24*time.Hour, "The duration from now after which the session key expires, if --expiration is not set")
+	cmd.Flags().StringSlice(FlagAllowedMsgs, []string{}, "The type URLs of the messages the session key can sign")
+
+	return cmd
+}
+
+// NewCmdRevokeSessionKey returns a CLI command handler for creating a MsgRevokeSessionKey transaction.
+func NewCmdRevokeSessionKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [session_address]",
+		Short: "Revoke a session key of your account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the session key with the given address of the --from account.
+
+Example:
+ $ %s tx %s revoke cosmos1skj.. --from mykey
+`, version.AppName, session.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sessionAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := session.NewMsgRevokeSessionKey(clientCtx.GetFromAddress(), sessionAddr)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func getExpiration(cmd *cobra.Command) (time.Time, error) {
+	exp, err := cmd.Flags().GetString(FlagExpiration)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if exp != "" {
+		return time.Parse(time.RFC3339, exp)
+	}
+
+	duration, err := cmd.Flags().GetDuration(FlagDuration)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Now().Add(duration).UTC(), nil
+}
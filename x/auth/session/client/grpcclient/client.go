@@ -0,0 +1,103 @@
+// Code generated by typedgen. DO NOT EDIT.
+
+// Package grpcclient implements the typed clients of the session module.
+package grpcclient
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/typed"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/auth/session"
+)
+
+// QueryClient is the typed client of the session Query service, retrying
+// the queries failing with a transient error.
+type QueryClient struct {
+	client session.QueryClient
+	retry  typed.RetryConfig
+}
+
+// NewQueryClient returns a QueryClient querying through the connection.
+func NewQueryClient(conn gogogrpc.ClientConn, retry typed.RetryConfig) *QueryClient {
+	return &QueryClient{
+		client: session.NewQueryClient(conn),
+		retry:  retry,
+	}
+}
+
+// SessionKey calls the session Query/SessionKey method.
+func (c *QueryClient) SessionKey(ctx context.Context, req *session.QuerySessionKeyRequest, opts ...grpc.CallOption) (*session.QuerySessionKeyResponse, error) {
+	var res *session.QuerySessionKeyResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.SessionKey(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// SessionKeys calls the session Query/SessionKeys method.
+func (c *QueryClient) SessionKeys(ctx context.Context, req *session.QuerySessionKeysRequest, opts ...grpc.CallOption) (*session.QuerySessionKeysResponse, error) {
+	var res *session.QuerySessionKeysResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.SessionKeys(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateSessionKeys calls the session Query/SessionKeys method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateSessionKeys(ctx context.Context, req *session.QuerySessionKeysRequest, fn func(*session.QuerySessionKeysResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq session.QuerySessionKeysRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.SessionKeys(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// MsgClient is the typed client of the session Msg service, broadcasting a
+// transaction of each message.
+type MsgClient struct {
+	broadcaster typed.Broadcaster
+}
+
+// NewMsgClient returns a MsgClient broadcasting the transactions with the
+// broadcaster.
+func NewMsgClient(broadcaster typed.Broadcaster) *MsgClient {
+	return &MsgClient{broadcaster: broadcaster}
+}
+
+// AddSessionKey broadcasts a transaction of the session Msg/AddSessionKey message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) AddSessionKey(ctx context.Context, msg *session.MsgAddSessionKey) (*session.MsgAddSessionKeyResponse, *sdk.TxResponse, error) {
+	res := &session.MsgAddSessionKeyResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
+
+// RevokeSessionKey broadcasts a transaction of the session Msg/RevokeSessionKey message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) RevokeSessionKey(ctx context.Context, msg *session.MsgRevokeSessionKey) (*session.MsgRevokeSessionKeyResponse, *sdk.TxResponse, error) {
+	res := &session.MsgRevokeSessionKeyResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
@@ -0,0 +1,15 @@
+/*
+Package session implements session keys, an extension of x/auth letting an
+account authorize short-lived secondary public keys to sign transactions on
+its behalf.
+
+A session key is authorized by its granter with MsgAddSessionKey, for a
+limited time and restricted to a set of message types. The auth ante handler,
+when given a SessionKeeper, accepts signatures of a session key in place of
+the signatures of its granter, as long as the session key has not expired and
+all the messages of the transaction are allowed. Session keys never become the
+public key of the granter account, and cannot sign session key messages
+themselves. They can be revoked before their expiration with
+MsgRevokeSessionKey.
+*/
+package session
@@ -0,0 +1,19 @@
+package session
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/session module sentinel errors
+var (
+	// ErrNoSessionKey error if there is no session key for that granter and address
+	ErrNoSessionKey = sdkerrors.Register(ModuleName, 2, "session key not found")
+	// ErrSessionKeyExpired error if the session key has expired
+	ErrSessionKeyExpired = sdkerrors.Register(ModuleName, 3, "session key expired")
+	// ErrNoMessages error if there is no allowed message
+	ErrNoMessages = sdkerrors.Register(ModuleName, 4, "allowed messages are empty")
+	// ErrMessageNotAllowed error if a message is not allowed by the session key
+	ErrMessageNotAllowed = sdkerrors.Register(ModuleName, 5, "message not allowed")
+	// ErrSessionKeyExists error if the session key is already authorized
+	ErrSessionKeyExists = sdkerrors.Register(ModuleName, 6, "session key already exists")
+)
@@ -0,0 +1,13 @@
+package session
+
+// session module events
+const (
+	EventTypeAddSessionKey    = "add_session_key"
+	EventTypeRevokeSessionKey = "revoke_session_key"
+
+	AttributeKeyGranter        = "granter"
+	AttributeKeySessionAddress = "session_address"
+	AttributeKeyExpiration     = "expiration"
+
+	AttributeValueCategory = ModuleName
+)
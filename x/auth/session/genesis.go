@@ -0,0 +1,57 @@
+package session
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ types.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates new GenesisState object
+func NewGenesisState(sessionKeys []SessionKey) *GenesisState {
+	return &GenesisState{
+		SessionKeys: sessionKeys,
+	}
+}
+
+// DefaultGenesisState returns default state for session module.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// ValidateGenesis ensures all session keys in the genesis state are valid and
+// unique.
+func ValidateGenesis(data GenesisState) error {
+	seen := make(map[string]bool, len(data.SessionKeys))
+	for _, s := range data.SessionKeys {
+		if err := s.ValidateBasic(); err != nil {
+			return err
+		}
+
+		pk, err := s.GetPubKey()
+		if err != nil {
+			return err
+		}
+
+		id := s.Granter + "/" + sdk.AccAddressFromPubKey(pk).String()
+		if seen[id] {
+			return fmt.Errorf("duplicate session key %s", id)
+		}
+		seen[id] = true
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, s := range data.SessionKeys {
+		if err := s.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
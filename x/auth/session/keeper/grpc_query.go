@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/auth/session"
+)
+
+var _ session.QueryServer = Keeper{}
+
+// SessionKey returns a session key authorized by the granter.
+func (k Keeper) SessionKey(c context.Context, req *session.QuerySessionKeyRequest) (*session.QuerySessionKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granter, err := sdk.AccAddressFromBech32(req.Granter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sessionAddr, err := sdk.AccAddressFromBech32(req.SessionAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	sessionKey, found := k.GetSessionKey(ctx, granter, sessionAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "session key %s of %s not found", sessionAddr, granter)
+	}
+
+	return &session.QuerySessionKeyResponse{SessionKey: sessionKey}, nil
+}
+
+// SessionKeys returns all the session keys authorized by the granter.
+func (k Keeper) SessionKeys(c context.Context, req *session.QuerySessionKeysRequest) (*session.QuerySessionKeysResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granter, err := sdk.AccAddressFromBech32(req.Granter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), session.SessionKeyPrefixByGranter(granter))
+
+	var sessionKeys []session.SessionKey
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var sessionKey session.SessionKey
+		if err := k.cdc.Unmarshal(value, &sessionKey); err != nil {
+			return err
+		}
+
+		sessionKeys = append(sessionKeys, sessionKey)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &session.QuerySessionKeysResponse{SessionKeys: sessionKeys, Pagination: pageRes}, nil
+}
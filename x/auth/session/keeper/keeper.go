@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/session"
+)
+
+// Keeper manages the session keys authorized by accounts.
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey sdk.StoreKey
+}
+
+var _ ante.SessionKeeper = Keeper{}
+
+// NewKeeper creates a session Keeper
+func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", session.ModuleName))
+}
+
+// AddSessionKey authorizes a session key of the granter, replacing any session
+// key with the same public key.
+func (k Keeper) AddSessionKey(ctx sdk.Context, granter sdk.AccAddress, pubKey cryptotypes.PubKey, expiration time.Time, allowedMsgs []string) error {
+	sessionKey, err := session.NewSessionKey(granter, pubKey, expiration, allowedMsgs)
+	if err != nil {
+		return err
+	}
+
+	if err := k.setSessionKey(ctx, granter, sdk.AccAddressFromPubKey(pubKey), sessionKey); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			session.EventTypeAddSessionKey,
+			sdk.NewAttribute(session.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(session.AttributeKeySessionAddress, sdk.AccAddressFromPubKey(pubKey).String()),
+			sdk.NewAttribute(session.AttributeKeyExpiration, expiration.Format(time.RFC3339)),
+		),
+	)
+
+	return nil
+}
+
+// RevokeSessionKey removes an existing session key of the granter.
+func (k Keeper) RevokeSessionKey(ctx sdk.Context, granter, sessionAddr sdk.AccAddress) error {
+	if _, found := k.GetSessionKey(ctx, granter, sessionAddr); !found {
+		return sdkerrors.Wrapf(session.ErrNoSessionKey, "granter %s, session address %s", granter, sessionAddr)
+	}
+
+	ctx.KVStore(k.storeKey).Delete(session.SessionKeyKey(granter, sessionAddr))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			session.EventTypeRevokeSessionKey,
+			sdk.NewAttribute(session.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(session.AttributeKeySessionAddress, sessionAddr.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetSessionKey returns the session key with the given address authorized by
+// the granter, if any.
+func (k Keeper) GetSessionKey(ctx sdk.Context, granter, sessionAddr sdk.AccAddress) (sessionKey session.SessionKey, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(session.SessionKeyKey(granter, sessionAddr))
+	if bz == nil {
+		return sessionKey, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &sessionKey)
+	return sessionKey, true
+}
+
+// IterateSessionKeys iterates over all the session keys in the store.
+// Callback to get all data, returns true to stop, false to keep reading
+func (k Keeper) IterateSessionKeys(ctx sdk.Context, cb func(sessionKey session.SessionKey) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), session.SessionKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var sessionKey session.SessionKey
+		k.cdc.MustUnmarshal(iter.Value(), &sessionKey)
+
+		if cb(sessionKey) {
+			break
+		}
+	}
+}
+
+// ValidateSessionKey implements ante.SessionKeeper. It returns an error unless
+// the public key is an unexpired session key of the granter allowing all the
+// msgs.
+func (k Keeper) ValidateSessionKey(ctx sdk.Context, granter sdk.AccAddress, pubKey cryptotypes.PubKey, msgs []sdk.Msg) error {
+	sessionAddr := sdk.AccAddressFromPubKey(pubKey)
+	sessionKey, found := k.GetSessionKey(ctx, granter, sessionAddr)
+	if !found {
+		return sdkerrors.Wrapf(session.ErrNoSessionKey, "granter %s, session address %s", granter, sessionAddr)
+	}
+
+	pk, err := sessionKey.GetPubKey()
+	if err != nil {
+		return err
+	}
+	if !pk.Equals(pubKey) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "session key public key mismatch for %s", sessionAddr)
+	}
+
+	if sessionKey.IsExpired(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(session.ErrSessionKeyExpired, "session key %s expired at %s", sessionAddr, sessionKey.Expiration)
+	}
+
+	return sessionKey.Accept(msgs)
+}
+
+// InitGenesis will initialize the keeper from a *previously validated* GenesisState
+func (k Keeper) InitGenesis(ctx sdk.Context, data *session.GenesisState) error {
+	for _, sessionKey := range data.SessionKeys {
+		granter, err := sdk.AccAddressFromBech32(sessionKey.Granter)
+		if err != nil {
+			return err
+		}
+
+		pk, err := sessionKey.GetPubKey()
+		if err != nil {
+			return err
+		}
+
+		if err := k.setSessionKey(ctx, granter, sdk.AccAddressFromPubKey(pk), sessionKey); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis will dump the contents of the keeper into a serializable GenesisState.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *session.GenesisState {
+	var sessionKeys []session.SessionKey
+	k.IterateSessionKeys(ctx, func(sessionKey session.SessionKey) bool {
+		sessionKeys = append(sessionKeys, sessionKey)
+		return false
+	})
+
+	return session.NewGenesisState(sessionKeys)
+}
+
+func (k Keeper) setSessionKey(ctx sdk.Context, granter, sessionAddr sdk.AccAddress, sessionKey session.SessionKey) error {
+	bz, err := k.cdc.Marshal(&sessionKey)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(session.SessionKeyKey(granter, sessionAddr), bz)
+	return nil
+}
@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/auth/session"
+	"github.com/cosmos/cosmos-sdk/x/auth/session/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var (
+	msgSendTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+	msgVoteTypeURL = sdk.MsgTypeURL(&govtypes.MsgVote{})
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	sdkCtx      sdk.Context
+	ctx         context.Context
+	addrs       []sdk.AccAddress
+	keeper      keeper.Keeper
+	msgSrvr     session.MsgServer
+	queryClient session.QueryClient
+	sessionPk   cryptotypes.PubKey
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	suite.app = app
+	suite.sdkCtx = ctx
+	suite.ctx = sdk.WrapSDKContext(ctx)
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(30000000))
+	suite.keeper = app.SessionKeeper
+	suite.msgSrvr = keeper.NewMsgServerImpl(suite.keeper)
+	suite.sessionPk = secp256k1.GenPrivKey().PubKey()
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	session.RegisterQueryServer(queryHelper, app.SessionKeeper)
+	suite.queryClient = session.NewQueryClient(queryHelper)
+}
+
+func (suite *KeeperTestSuite) TestAddRevokeSessionKey() {
+	granter := suite.addrs[0]
+	sessionAddr := sdk.AccAddress(suite.sessionPk.Address())
+	expiration := suite.sdkCtx.BlockTime().Add(time.Hour)
+
+	// expiration must be in the future
+	msg, err := session.NewMsgAddSessionKey(granter, suite.sessionPk, suite.sdkCtx.BlockTime(), []string{msgSendTypeURL})
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.AddSessionKey(suite.ctx, msg)
+	suite.Require().Error(err)
+
+	msg, err = session.NewMsgAddSessionKey(granter, suite.sessionPk, expiration, []string{msgSendTypeURL})
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.AddSessionKey(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	// duplicate session key
+	_, err = suite.msgSrvr.AddSessionKey(suite.ctx, msg)
+	suite.Require().ErrorIs(err, session.ErrSessionKeyExists)
+
+	sessionKey, found := suite.keeper.GetSessionKey(suite.sdkCtx, granter, sessionAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(granter.String(), sessionKey.Granter)
+	suite.Require().Equal(expiration, sessionKey.Expiration)
+	pk, err := sessionKey.GetPubKey()
+	suite.Require().NoError(err)
+	suite.Require().True(suite.sessionPk.Equals(pk))
+
+	// the session key is not authorized by other accounts
+	_, found = suite.keeper.GetSessionKey(suite.sdkCtx, suite.addrs[1], sessionAddr)
+	suite.Require().False(found)
+
+	revoke := session.NewMsgRevokeSessionKey(suite.addrs[1], sessionAddr)
+	_, err = suite.msgSrvr.RevokeSessionKey(suite.ctx, &revoke)
+	suite.Require().ErrorIs(err, session.ErrNoSessionKey)
+
+	revoke = session.NewMsgRevokeSessionKey(granter, sessionAddr)
+	_, err = suite.msgSrvr.RevokeSessionKey(suite.ctx, &revoke)
+	suite.Require().NoError(err)
+
+	_, found = suite.keeper.GetSessionKey(suite.sdkCtx, granter, sessionAddr)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestValidateSessionKey() {
+	granter := suite.addrs[0]
+	expiration := suite.sdkCtx.BlockTime().Add(time.Hour)
+	send := banktypes.NewMsgSend(granter, suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	vote := govtypes.NewMsgVote(granter, 1, govtypes.OptionYes)
+
+	err := suite.keeper.ValidateSessionKey(suite.sdkCtx, granter, suite.sessionPk, []sdk.Msg{send})
+	suite.Require().ErrorIs(err, session.ErrNoSessionKey)
+
+	suite.Require().NoError(suite.keeper.AddSessionKey(suite.sdkCtx, granter, suite.sessionPk, expiration, []string{msgSendTypeURL}))
+
+	suite.Require().NoError(suite.keeper.ValidateSessionKey(suite.sdkCtx, granter, suite.sessionPk, []sdk.Msg{send, send}))
+
+	err = suite.keeper.ValidateSessionKey(suite.sdkCtx, granter, suite.sessionPk, []sdk.Msg{send, vote})
+	suite.Require().ErrorIs(err, session.ErrMessageNotAllowed)
+
+	err = suite.keeper.ValidateSessionKey(suite.sdkCtx, suite.addrs[1], suite.sessionPk, []sdk.Msg{send})
+	suite.Require().ErrorIs(err, session.ErrNoSessionKey)
+
+	err = suite.keeper.ValidateSessionKey(suite.sdkCtx.WithBlockTime(expiration), granter, suite.sessionPk, []sdk.Msg{send})
+	suite.Require().ErrorIs(err, session.ErrSessionKeyExpired)
+}
+
+func (suite *KeeperTestSuite) TestQuerySessionKeys() {
+	granter := suite.addrs[0]
+	expiration := suite.sdkCtx.BlockTime().Add(time.Hour)
+	otherPk := secp256k1.GenPrivKey().PubKey()
+
+	_, err := suite.queryClient.SessionKey(suite.ctx, &session.QuerySessionKeyRequest{
+		Granter:        granter.String(),
+		SessionAddress: sdk.AccAddress(suite.sessionPk.Address()).String(),
+	})
+	suite.Require().Error(err)
+
+	suite.Require().NoError(suite.keeper.AddSessionKey(suite.sdkCtx, granter, suite.sessionPk, expiration, []string{msgSendTypeURL}))
+	suite.Require().NoError(suite.keeper.AddSessionKey(suite.sdkCtx, granter, otherPk, expiration, []string{msgVoteTypeURL}))
+	suite.Require().NoError(suite.keeper.AddSessionKey(suite.sdkCtx, suite.addrs[1], otherPk, expiration, []string{msgVoteTypeURL}))
+
+	res, err := suite.queryClient.SessionKey(suite.ctx, &session.QuerySessionKeyRequest{
+		Granter:        granter.String(),
+		SessionAddress: sdk.AccAddress(suite.sessionPk.Address()).String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{msgSendTypeURL}, res.SessionKey.AllowedMessages)
+
+	keysRes, err := suite.queryClient.SessionKeys(suite.ctx, &session.QuerySessionKeysRequest{
+		Granter:    granter.String(),
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(keysRes.SessionKeys, 2)
+	suite.Require().Equal(uint64(2), keysRes.Pagination.Total)
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	expiration := suite.sdkCtx.BlockTime().Add(time.Hour)
+	suite.Require().NoError(suite.keeper.AddSessionKey(suite.sdkCtx, suite.addrs[0], suite.sessionPk, expiration, []string{msgSendTypeURL}))
+	suite.Require().NoError(suite.keeper.AddSessionKey(suite.sdkCtx, suite.addrs[1], suite.sessionPk, expiration, []string{msgVoteTypeURL}))
+
+	genState := suite.keeper.ExportGenesis(suite.sdkCtx)
+	suite.Require().Len(genState.SessionKeys, 2)
+	suite.Require().NoError(session.ValidateGenesis(*genState))
+
+	suite.SetupTest()
+	suite.Require().NoError(suite.keeper.InitGenesis(suite.sdkCtx, genState))
+	suite.Require().Equal(genState, suite.keeper.ExportGenesis(suite.sdkCtx))
+
+	// duplicate session keys are invalid
+	genState.SessionKeys = append(genState.SessionKeys, genState.SessionKeys[0])
+	suite.Require().Error(session.ValidateGenesis(*genState))
+}
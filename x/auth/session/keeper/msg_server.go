@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/session"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the session MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) session.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ session.MsgServer = msgServer{}
+
+// AddSessionKey authorizes a session key to sign on behalf of the granter.
+func (k msgServer) AddSessionKey(goCtx context.Context, msg *session.MsgAddSessionKey) (*session.MsgAddSessionKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	pk, err := msg.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	if !ctx.BlockTime().Before(msg.Expiration) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "session key expiration %s must be after the block time", msg.Expiration)
+	}
+
+	// Checking for duplicate entry
+	if _, found := k.GetSessionKey(ctx, granter, sdk.AccAddressFromPubKey(pk)); found {
+		return nil, sdkerrors.Wrapf(session.ErrSessionKeyExists, "session address %s", sdk.AccAddressFromPubKey(pk))
+	}
+
+	if err := k.Keeper.AddSessionKey(ctx, granter, pk, msg.Expiration, msg.AllowedMessages); err != nil {
+		return nil, err
+	}
+
+	return &session.MsgAddSessionKeyResponse{}, nil
+}
+
+// RevokeSessionKey revokes a session key of the granter.
+func (k msgServer) RevokeSessionKey(goCtx context.Context, msg *session.MsgRevokeSessionKey) (*session.MsgRevokeSessionKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionAddr, err := sdk.AccAddressFromBech32(msg.SessionAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RevokeSessionKey(ctx, granter, sessionAddr); err != nil {
+		return nil, err
+	}
+
+	return &session.MsgRevokeSessionKeyResponse{}, nil
+}
@@ -0,0 +1,38 @@
+package session
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "session"
+
+	// StoreKey is the store key string for session
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for session
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for session
+	QuerierRoute = ModuleName
+)
+
+var (
+	// SessionKeyPrefix is the prefix of the session keys in the kvstore
+	SessionKeyPrefix = []byte{0x01}
+)
+
+// SessionKeyKey is the key of the session key with the given address authorized
+// by the granter. Session keys are stored by granter first to allow iterating
+// over all the session keys of an account.
+func SessionKeyKey(granter, sessionAddr sdk.AccAddress) []byte {
+	return append(SessionKeyPrefixByGranter(granter), address.MustLengthPrefix(sessionAddr.Bytes())...)
+}
+
+// SessionKeyPrefixByGranter returns the prefix of all the session keys authorized
+// by the granter.
+func SessionKeyPrefixByGranter(granter sdk.AccAddress) []byte {
+	return append(SessionKeyPrefix, address.MustLengthPrefix(granter.Bytes())...)
+}
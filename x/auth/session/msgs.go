@@ -0,0 +1,121 @@
+package session
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+)
+
+var (
+	_, _ sdk.Msg            = &MsgAddSessionKey{}, &MsgRevokeSessionKey{}
+	_, _ legacytx.LegacyMsg = &MsgAddSessionKey{}, &MsgRevokeSessionKey{} // For amino support.
+
+	_ types.UnpackInterfacesMessage = &MsgAddSessionKey{}
+)
+
+// NewMsgAddSessionKey creates a new MsgAddSessionKey.
+//nolint:interfacer
+func NewMsgAddSessionKey(granter sdk.AccAddress, pubKey cryptotypes.PubKey, expiration time.Time, allowedMsgs []string) (*MsgAddSessionKey, error) {
+	any, err := types.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgAddSessionKey{
+		Granter:         granter.String(),
+		PubKey:          any,
+		Expiration:      expiration,
+		AllowedMessages: allowedMsgs,
+	}, nil
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgAddSessionKey) ValidateBasic() error {
+	return validateSessionKey(msg.Granter, msg.PubKey, msg.Expiration, msg.AllowedMessages)
+}
+
+// GetSigners returns the granter authorizing the session key.
+func (msg MsgAddSessionKey) GetSigners() []sdk.AccAddress {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{granter}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgAddSessionKey) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgAddSessionKey) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgAddSessionKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetPubKey returns the unpacked public key of the session key.
+func (msg MsgAddSessionKey) GetPubKey() (cryptotypes.PubKey, error) {
+	pk, ok := msg.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", msg.PubKey.GetCachedValue())
+	}
+
+	return pk, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgAddSessionKey) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.PubKey, &pk)
+}
+
+// NewMsgRevokeSessionKey returns a message to revoke a session key of the granter.
+//nolint:interfacer
+func NewMsgRevokeSessionKey(granter, sessionAddr sdk.AccAddress) MsgRevokeSessionKey {
+	return MsgRevokeSessionKey{Granter: granter.String(), SessionAddress: sessionAddr.String()}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRevokeSessionKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid granter address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.SessionAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid session address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSigners returns the granter revoking the session key.
+func (msg MsgRevokeSessionKey) GetSigners() []sdk.AccAddress {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{granter}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRevokeSessionKey) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRevokeSessionKey) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgRevokeSessionKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
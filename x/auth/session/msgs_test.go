@@ -0,0 +1,71 @@
+package session_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/session"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMsgAddSessionKey(t *testing.T) {
+	granterPk := secp256k1.GenPrivKey().PubKey()
+	granter := sdk.AccAddress(granterPk.Address())
+	sessionPk := secp256k1.GenPrivKey().PubKey()
+	expiration := time.Now().Add(time.Hour)
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	cases := map[string]struct {
+		granter     sdk.AccAddress
+		expiration  time.Time
+		allowedMsgs []string
+		valid       bool
+	}{
+		"valid":              {granter, expiration, []string{sendURL}, true},
+		"empty granter":      {nil, expiration, []string{sendURL}, false},
+		"no expiration":      {granter, time.Time{}, []string{sendURL}, false},
+		"no allowed msgs":    {granter, expiration, nil, false},
+		"add session key":    {granter, expiration, []string{sdk.MsgTypeURL(&session.MsgAddSessionKey{})}, false},
+		"revoke session key": {granter, expiration, []string{sendURL, sdk.MsgTypeURL(&session.MsgRevokeSessionKey{})}, false},
+		"change pubkey":      {granter, expiration, []string{sdk.MsgTypeURL(&authtypes.MsgChangePubKey{})}, false},
+		"set authenticator":  {granter, expiration, []string{sdk.MsgTypeURL(&authtypes.MsgSetAuthenticator{})}, false},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			msg, err := session.NewMsgAddSessionKey(tc.granter, sessionPk, tc.expiration, tc.allowedMsgs)
+			require.NoError(t, err)
+
+			if tc.valid {
+				require.NoError(t, msg.ValidateBasic())
+				require.Equal(t, []sdk.AccAddress{tc.granter}, msg.GetSigners())
+				require.NotPanics(t, func() { msg.GetSignBytes() })
+			} else {
+				require.Error(t, msg.ValidateBasic())
+			}
+		})
+	}
+
+	// the granter key cannot be its own session key
+	msg, err := session.NewMsgAddSessionKey(granter, granterPk, expiration, []string{sendURL})
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMsgRevokeSessionKey(t *testing.T) {
+	granter := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	sessionAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	msg := session.NewMsgRevokeSessionKey(granter, sessionAddr)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{granter}, msg.GetSigners())
+
+	msg = session.NewMsgRevokeSessionKey(granter, nil)
+	require.Error(t, msg.ValidateBasic())
+}
@@ -0,0 +1,1166 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/session/v1beta1/query.proto
+
+package session
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QuerySessionKeyRequest is the request type for the Query/SessionKey RPC method.
+type QuerySessionKeyRequest struct {
+	// granter is the address of the account which authorized the session key.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// session_address is the address of the session key public key.
+	SessionAddress string `protobuf:"bytes,2,opt,name=session_address,json=sessionAddress,proto3" json:"session_address,omitempty"`
+}
+
+func (m *QuerySessionKeyRequest) Reset()         { *m = QuerySessionKeyRequest{} }
+func (m *QuerySessionKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySessionKeyRequest) ProtoMessage()    {}
+func (*QuerySessionKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fd0aa3a7f81b578, []int{0}
+}
+func (m *QuerySessionKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySessionKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySessionKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySessionKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySessionKeyRequest.Merge(m, src)
+}
+func (m *QuerySessionKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySessionKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySessionKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySessionKeyRequest proto.InternalMessageInfo
+
+func (m *QuerySessionKeyRequest) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *QuerySessionKeyRequest) GetSessionAddress() string {
+	if m != nil {
+		return m.SessionAddress
+	}
+	return ""
+}
+
+// QuerySessionKeyResponse is the response type for the Query/SessionKey RPC method.
+type QuerySessionKeyResponse struct {
+	SessionKey SessionKey `protobuf:"bytes,1,opt,name=session_key,json=sessionKey,proto3" json:"session_key"`
+}
+
+func (m *QuerySessionKeyResponse) Reset()         { *m = QuerySessionKeyResponse{} }
+func (m *QuerySessionKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySessionKeyResponse) ProtoMessage()    {}
+func (*QuerySessionKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fd0aa3a7f81b578, []int{1}
+}
+func (m *QuerySessionKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySessionKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySessionKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySessionKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySessionKeyResponse.Merge(m, src)
+}
+func (m *QuerySessionKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySessionKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySessionKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySessionKeyResponse proto.InternalMessageInfo
+
+func (m *QuerySessionKeyResponse) GetSessionKey() SessionKey {
+	if m != nil {
+		return m.SessionKey
+	}
+	return SessionKey{}
+}
+
+// QuerySessionKeysRequest is the request type for the Query/SessionKeys RPC method.
+type QuerySessionKeysRequest struct {
+	// granter is the address of the account which authorized the session keys.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySessionKeysRequest) Reset()         { *m = QuerySessionKeysRequest{} }
+func (m *QuerySessionKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySessionKeysRequest) ProtoMessage()    {}
+func (*QuerySessionKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fd0aa3a7f81b578, []int{2}
+}
+func (m *QuerySessionKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySessionKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySessionKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySessionKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySessionKeysRequest.Merge(m, src)
+}
+func (m *QuerySessionKeysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySessionKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySessionKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySessionKeysRequest proto.InternalMessageInfo
+
+func (m *QuerySessionKeysRequest) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *QuerySessionKeysRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySessionKeysResponse is the response type for the Query/SessionKeys RPC method.
+type QuerySessionKeysResponse struct {
+	SessionKeys []SessionKey `protobuf:"bytes,1,rep,name=session_keys,json=sessionKeys,proto3" json:"session_keys"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySessionKeysResponse) Reset()         { *m = QuerySessionKeysResponse{} }
+func (m *QuerySessionKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySessionKeysResponse) ProtoMessage()    {}
+func (*QuerySessionKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fd0aa3a7f81b578, []int{3}
+}
+func (m *QuerySessionKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySessionKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySessionKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySessionKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySessionKeysResponse.Merge(m, src)
+}
+func (m *QuerySessionKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySessionKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySessionKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySessionKeysResponse proto.InternalMessageInfo
+
+func (m *QuerySessionKeysResponse) GetSessionKeys() []SessionKey {
+	if m != nil {
+		return m.SessionKeys
+	}
+	return nil
+}
+
+func (m *QuerySessionKeysResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QuerySessionKeyRequest)(nil), "cosmos.session.v1beta1.QuerySessionKeyRequest")
+	proto.RegisterType((*QuerySessionKeyResponse)(nil), "cosmos.session.v1beta1.QuerySessionKeyResponse")
+	proto.RegisterType((*QuerySessionKeysRequest)(nil), "cosmos.session.v1beta1.QuerySessionKeysRequest")
+	proto.RegisterType((*QuerySessionKeysResponse)(nil), "cosmos.session.v1beta1.QuerySessionKeysResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/session/v1beta1/query.proto", fileDescriptor_8fd0aa3a7f81b578)
+}
+
+var fileDescriptor_8fd0aa3a7f81b578 = []byte{
+	// 459 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcf, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x77, 0xd6, 0x5f, 0x38, 0x11, 0x85, 0x41, 0x6a, 0x58, 0x24, 0x96, 0x20, 0x56, 0x14,
+	0x67, 0xda, 0x15, 0x3c, 0xeb, 0x82, 0xbf, 0xe8, 0x45, 0xd3, 0x9b, 0x1e, 0x64, 0xd2, 0x0c, 0xd3,
+	0x50, 0x9b, 0x49, 0xf3, 0x26, 0xe2, 0xb2, 0xf4, 0xe2, 0x5f, 0x20, 0xf8, 0x4f, 0x78, 0xf4, 0xe6,
+	0xbf, 0xd0, 0x63, 0xc1, 0x8b, 0x27, 0x91, 0x5d, 0x8f, 0xfe, 0x11, 0xb2, 0xf3, 0xa3, 0x09, 0xee,
+	0xb6, 0xdd, 0x3d, 0x25, 0x79, 0xf3, 0x7d, 0xdf, 0xf7, 0x79, 0x6f, 0x5e, 0x70, 0xbc, 0xad, 0x60,
+	0x4f, 0x01, 0x03, 0x01, 0x90, 0xab, 0x82, 0x7d, 0xd8, 0x48, 0x85, 0xe6, 0x1b, 0x6c, 0xbf, 0x16,
+	0xd5, 0x90, 0x96, 0x95, 0xd2, 0x8a, 0xac, 0x58, 0x0d, 0x75, 0x1a, 0xea, 0x34, 0xbd, 0xeb, 0x52,
+	0x49, 0x65, 0x24, 0x6c, 0xfa, 0x66, 0xd5, 0xbd, 0xdb, 0x27, 0x38, 0xfa, 0x6c, 0xab, 0xba, 0xe7,
+	0x54, 0x29, 0x07, 0x61, 0x8b, 0x1d, 0x0b, 0x4b, 0x2e, 0xf3, 0x82, 0xeb, 0x46, 0x7b, 0x53, 0x2a,
+	0x25, 0xdf, 0x0b, 0xc6, 0xcb, 0x9c, 0xf1, 0xa2, 0x50, 0xda, 0x1c, 0x82, 0x3d, 0x8d, 0xdf, 0xe2,
+	0x95, 0xd7, 0xd3, 0xfc, 0x2d, 0xeb, 0xbf, 0x29, 0x86, 0x89, 0xd8, 0xaf, 0x05, 0x68, 0x12, 0xe2,
+	0x4b, 0xb2, 0xe2, 0x85, 0x16, 0x55, 0x88, 0x56, 0xd1, 0xdd, 0xcb, 0x89, 0xff, 0x24, 0x6b, 0xf8,
+	0x9a, 0xc3, 0x79, 0xc7, 0xb3, 0xac, 0x12, 0x00, 0x61, 0xd7, 0x28, 0xae, 0xba, 0xf0, 0x13, 0x1b,
+	0x8d, 0x33, 0x7c, 0x63, 0xc6, 0x1c, 0x4a, 0x55, 0x80, 0x20, 0x2f, 0x71, 0xe0, 0x3d, 0x76, 0xc5,
+	0xd0, 0x54, 0x08, 0xfa, 0x31, 0x9d, 0x3f, 0x2b, 0xda, 0x18, 0x0c, 0xce, 0x1f, 0xfe, 0xba, 0xd5,
+	0x49, 0x30, 0x1c, 0x47, 0xe2, 0xd1, 0x4c, 0x15, 0x38, 0xbb, 0x87, 0x67, 0x18, 0x37, 0x93, 0x32,
+	0xf8, 0x41, 0xff, 0x8e, 0x2f, 0x3f, 0x1d, 0x2b, 0xb5, 0x77, 0xe8, 0x09, 0x5e, 0x71, 0x29, 0x9c,
+	0x6b, 0xd2, 0xca, 0x8c, 0xbf, 0x21, 0x1c, 0xce, 0x56, 0x77, 0x4d, 0x6e, 0xe2, 0x2b, 0xad, 0x26,
+	0x21, 0x44, 0xab, 0xe7, 0x96, 0xea, 0x32, 0x68, 0xba, 0x04, 0xf2, 0x7c, 0x0e, 0xf1, 0xda, 0x99,
+	0xc4, 0x96, 0xa4, 0x8d, 0xdc, 0xff, 0xdb, 0xc5, 0x17, 0x0c, 0x32, 0xf9, 0x8e, 0x30, 0x6e, 0x8a,
+	0x12, 0x7a, 0x12, 0xd8, 0xfc, 0x0d, 0xe9, 0xb1, 0x85, 0xf5, 0x96, 0x22, 0x7e, 0xf1, 0xe9, 0xc7,
+	0x9f, 0x2f, 0xdd, 0x01, 0x79, 0xcc, 0x4e, 0xdf, 0x72, 0x33, 0x2d, 0x36, 0x72, 0x77, 0x75, 0xc0,
+	0x46, 0xff, 0xad, 0xdb, 0x01, 0xf9, 0x8a, 0x70, 0xb0, 0xd5, 0x1a, 0xce, 0xa2, 0x28, 0x7e, 0x33,
+	0x7a, 0xeb, 0x8b, 0x27, 0x38, 0xf8, 0x47, 0x06, 0x7e, 0x9d, 0xd0, 0xe5, 0xe0, 0x07, 0x4f, 0x0f,
+	0xc7, 0x11, 0x3a, 0x1a, 0x47, 0xe8, 0xf7, 0x38, 0x42, 0x9f, 0x27, 0x51, 0xe7, 0x68, 0x12, 0x75,
+	0x7e, 0x4e, 0xa2, 0xce, 0x9b, 0xfb, 0x32, 0xd7, 0x3b, 0x75, 0x4a, 0xb7, 0xd5, 0x9e, 0xf7, 0xb4,
+	0x8f, 0x07, 0x90, 0xed, 0xb2, 0x8f, 0x8c, 0xd7, 0x7a, 0xc7, 0xbb, 0xa6, 0x17, 0xcd, 0xff, 0xfa,
+	0xf0, 0xdf, 0x00, 0x29, 0x00, 0x2f, 0x62, 0x73, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// SessionKey returns a session key authorized by the granter.
+	SessionKey(ctx context.Context, in *QuerySessionKeyRequest, opts ...grpc.CallOption) (*QuerySessionKeyResponse, error)
+	// SessionKeys returns all the session keys authorized by the granter.
+	SessionKeys(ctx context.Context, in *QuerySessionKeysRequest, opts ...grpc.CallOption) (*QuerySessionKeysResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) SessionKey(ctx context.Context, in *QuerySessionKeyRequest, opts ...grpc.CallOption) (*QuerySessionKeyResponse, error) {
+	out := new(QuerySessionKeyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.session.v1beta1.Query/SessionKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SessionKeys(ctx context.Context, in *QuerySessionKeysRequest, opts ...grpc.CallOption) (*QuerySessionKeysResponse, error) {
+	out := new(QuerySessionKeysResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.session.v1beta1.Query/SessionKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// SessionKey returns a session key authorized by the granter.
+	SessionKey(context.Context, *QuerySessionKeyRequest) (*QuerySessionKeyResponse, error)
+	// SessionKeys returns all the session keys authorized by the granter.
+	SessionKeys(context.Context, *QuerySessionKeysRequest) (*QuerySessionKeysResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) SessionKey(ctx context.Context, req *QuerySessionKeyRequest) (*QuerySessionKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SessionKey not implemented")
+}
+func (*UnimplementedQueryServer) SessionKeys(ctx context.Context, req *QuerySessionKeysRequest) (*QuerySessionKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SessionKeys not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_SessionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySessionKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SessionKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.session.v1beta1.Query/SessionKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SessionKey(ctx, req.(*QuerySessionKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SessionKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySessionKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SessionKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.session.v1beta1.Query/SessionKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SessionKeys(ctx, req.(*QuerySessionKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.session.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SessionKey",
+			Handler:    _Query_SessionKey_Handler,
+		},
+		{
+			MethodName: "SessionKeys",
+			Handler:    _Query_SessionKeys_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/session/v1beta1/query.proto",
+}
+
+func (m *QuerySessionKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySessionKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySessionKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SessionAddress) > 0 {
+		i -= len(m.SessionAddress)
+		copy(dAtA[i:], m.SessionAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SessionAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySessionKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySessionKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySessionKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SessionKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySessionKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySessionKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySessionKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySessionKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySessionKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySessionKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SessionKeys) > 0 {
+		for iNdEx := len(m.SessionKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SessionKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySessionKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SessionAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySessionKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SessionKey.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySessionKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySessionKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SessionKeys) > 0 {
+		for _, e := range m.SessionKeys {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuerySessionKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySessionKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySessionKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySessionKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySessionKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySessionKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SessionKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySessionKeysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySessionKeysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySessionKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySessionKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySessionKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySessionKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionKeys = append(m.SessionKeys, SessionKey{})
+			if err := m.SessionKeys[len(m.SessionKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
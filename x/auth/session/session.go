@@ -0,0 +1,106 @@
+package session
+
+import (
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = &SessionKey{}
+
+// NewSessionKey creates a new SessionKey authorized by the granter.
+//nolint:interfacer
+func NewSessionKey(granter sdk.AccAddress, pubKey cryptotypes.PubKey, expiration time.Time, allowedMsgs []string) (SessionKey, error) {
+	any, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return SessionKey{}, err
+	}
+
+	return SessionKey{
+		Granter:         granter.String(),
+		PubKey:          any,
+		Expiration:      expiration,
+		AllowedMessages: allowedMsgs,
+	}, nil
+}
+
+// GetPubKey returns the unpacked public key of the session key.
+func (s SessionKey) GetPubKey() (cryptotypes.PubKey, error) {
+	pk, ok := s.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", s.PubKey.GetCachedValue())
+	}
+
+	return pk, nil
+}
+
+// ValidateBasic performs a stateless validation of the session key.
+func (s SessionKey) ValidateBasic() error {
+	return validateSessionKey(s.Granter, s.PubKey, s.Expiration, s.AllowedMessages)
+}
+
+// IsExpired returns true if the session key can no longer sign at the given
+// block time.
+func (s SessionKey) IsExpired(blockTime time.Time) bool {
+	return !blockTime.Before(s.Expiration)
+}
+
+// Accept returns an error if any of the msgs is not allowed by the session key.
+func (s SessionKey) Accept(msgs []sdk.Msg) error {
+	allowed := make(map[string]bool, len(s.AllowedMessages))
+	for _, msg := range s.AllowedMessages {
+		allowed[msg] = true
+	}
+
+	for _, msg := range msgs {
+		if !allowed[sdk.MsgTypeURL(msg)] {
+			return sdkerrors.Wrapf(ErrMessageNotAllowed, "session key cannot sign %s", sdk.MsgTypeURL(msg))
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (s SessionKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+	return unpacker.UnpackAny(s.PubKey, &pk)
+}
+
+func validateSessionKey(granter string, pubKey *codectypes.Any, expiration time.Time, allowedMsgs []string) error {
+	granterAddr, err := sdk.AccAddressFromBech32(granter)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid granter address: %s", err)
+	}
+
+	pk, ok := pubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "missing session public key")
+	}
+	if granterAddr.Equals(sdk.AccAddressFromPubKey(pk)) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "session key cannot be the granter key")
+	}
+
+	if expiration.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing session key expiration")
+	}
+
+	if len(allowedMsgs) == 0 {
+		return ErrNoMessages
+	}
+
+	// session keys must not be able to authorize further session keys, nor to
+	// take over the account by rotating its public key or authenticator
+	for _, msg := range allowedMsgs {
+		if msg == sdk.MsgTypeURL(&MsgAddSessionKey{}) || msg == sdk.MsgTypeURL(&MsgRevokeSessionKey{}) ||
+			msg == sdk.MsgTypeURL(&authtypes.MsgChangePubKey{}) || msg == sdk.MsgTypeURL(&authtypes.MsgSetAuthenticator{}) {
+			return sdkerrors.Wrapf(ErrMessageNotAllowed, "session keys cannot sign %s", msg)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,1076 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/session/v1beta1/tx.proto
+
+package session
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/regen-network/cosmos-proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgAddSessionKey authorizes a session key of the granter.
+type MsgAddSessionKey struct {
+	// granter is the address of the account authorizing the session key.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// pub_key is the public key of the session key.
+	PubKey *types.Any `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// expiration is the time from which the session key can no longer sign.
+	Expiration time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration"`
+	// allowed_messages are the type URLs of the messages the session key can
+	// sign.
+	AllowedMessages []string `protobuf:"bytes,4,rep,name=allowed_messages,json=allowedMessages,proto3" json:"allowed_messages,omitempty"`
+}
+
+func (m *MsgAddSessionKey) Reset()         { *m = MsgAddSessionKey{} }
+func (m *MsgAddSessionKey) String() string { return proto.CompactTextString(m) }
+func (*MsgAddSessionKey) ProtoMessage()    {}
+func (*MsgAddSessionKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31fc454f7e5e4443, []int{0}
+}
+func (m *MsgAddSessionKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddSessionKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddSessionKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddSessionKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddSessionKey.Merge(m, src)
+}
+func (m *MsgAddSessionKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddSessionKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddSessionKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddSessionKey proto.InternalMessageInfo
+
+// MsgAddSessionKeyResponse defines the Msg/AddSessionKey response type.
+type MsgAddSessionKeyResponse struct {
+}
+
+func (m *MsgAddSessionKeyResponse) Reset()         { *m = MsgAddSessionKeyResponse{} }
+func (m *MsgAddSessionKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddSessionKeyResponse) ProtoMessage()    {}
+func (*MsgAddSessionKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31fc454f7e5e4443, []int{1}
+}
+func (m *MsgAddSessionKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddSessionKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddSessionKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddSessionKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddSessionKeyResponse.Merge(m, src)
+}
+func (m *MsgAddSessionKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddSessionKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddSessionKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddSessionKeyResponse proto.InternalMessageInfo
+
+// MsgRevokeSessionKey revokes a session key of the granter.
+type MsgRevokeSessionKey struct {
+	// granter is the address of the account which authorized the session key.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// session_address is the address of the session key public key.
+	SessionAddress string `protobuf:"bytes,2,opt,name=session_address,json=sessionAddress,proto3" json:"session_address,omitempty"`
+}
+
+func (m *MsgRevokeSessionKey) Reset()         { *m = MsgRevokeSessionKey{} }
+func (m *MsgRevokeSessionKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeSessionKey) ProtoMessage()    {}
+func (*MsgRevokeSessionKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31fc454f7e5e4443, []int{2}
+}
+func (m *MsgRevokeSessionKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeSessionKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeSessionKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeSessionKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeSessionKey.Merge(m, src)
+}
+func (m *MsgRevokeSessionKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeSessionKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeSessionKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeSessionKey proto.InternalMessageInfo
+
+func (m *MsgRevokeSessionKey) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *MsgRevokeSessionKey) GetSessionAddress() string {
+	if m != nil {
+		return m.SessionAddress
+	}
+	return ""
+}
+
+// MsgRevokeSessionKeyResponse defines the Msg/RevokeSessionKey response type.
+type MsgRevokeSessionKeyResponse struct {
+}
+
+func (m *MsgRevokeSessionKeyResponse) Reset()         { *m = MsgRevokeSessionKeyResponse{} }
+func (m *MsgRevokeSessionKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeSessionKeyResponse) ProtoMessage()    {}
+func (*MsgRevokeSessionKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31fc454f7e5e4443, []int{3}
+}
+func (m *MsgRevokeSessionKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeSessionKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeSessionKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeSessionKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeSessionKeyResponse.Merge(m, src)
+}
+func (m *MsgRevokeSessionKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeSessionKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeSessionKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeSessionKeyResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgAddSessionKey)(nil), "cosmos.session.v1beta1.MsgAddSessionKey")
+	proto.RegisterType((*MsgAddSessionKeyResponse)(nil), "cosmos.session.v1beta1.MsgAddSessionKeyResponse")
+	proto.RegisterType((*MsgRevokeSessionKey)(nil), "cosmos.session.v1beta1.MsgRevokeSessionKey")
+	proto.RegisterType((*MsgRevokeSessionKeyResponse)(nil), "cosmos.session.v1beta1.MsgRevokeSessionKeyResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/session/v1beta1/tx.proto", fileDescriptor_31fc454f7e5e4443) }
+
+var fileDescriptor_31fc454f7e5e4443 = []byte{
+	// 443 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xbd, 0xa4, 0x4a, 0xc9, 0x22, 0x68, 0x64, 0x2a, 0x64, 0x8c, 0xb0, 0xa3, 0x5c, 0x08,
+	0xaa, 0xba, 0xa6, 0xed, 0x09, 0x6e, 0x89, 0xe0, 0x54, 0x45, 0x42, 0x86, 0x03, 0xe2, 0x12, 0xad,
+	0xeb, 0x61, 0x6b, 0x25, 0xf6, 0x5a, 0x9e, 0x75, 0x89, 0xdf, 0x80, 0x63, 0x1f, 0x81, 0x87, 0xe0,
+	0x21, 0x2a, 0x4e, 0x3d, 0x72, 0x2a, 0x28, 0xb9, 0xf0, 0x18, 0x08, 0x7b, 0x57, 0x02, 0xb7, 0x42,
+	0xe1, 0xb4, 0x9e, 0xd9, 0x6f, 0xe6, 0xdf, 0x7f, 0x3d, 0x4b, 0xfd, 0x13, 0x89, 0xa9, 0xc4, 0x00,
+	0x01, 0x31, 0x91, 0x59, 0x70, 0x76, 0x10, 0x81, 0xe2, 0x07, 0x81, 0x5a, 0xb2, 0xbc, 0x90, 0x4a,
+	0xda, 0x0f, 0x1a, 0x80, 0x69, 0x80, 0x69, 0xc0, 0xdd, 0x15, 0x52, 0xc8, 0x1a, 0x09, 0x7e, 0x7f,
+	0x35, 0xb4, 0xfb, 0x50, 0x48, 0x29, 0x16, 0x10, 0xd4, 0x51, 0x54, 0x7e, 0x08, 0x78, 0x56, 0xe9,
+	0x2d, 0xbf, 0xbd, 0xa5, 0x92, 0x14, 0x50, 0xf1, 0x34, 0x37, 0xb5, 0x8d, 0xd2, 0xac, 0x69, 0xaa,
+	0x65, 0xeb, 0x60, 0x78, 0x45, 0x68, 0x7f, 0x8a, 0x62, 0x1c, 0xc7, 0x6f, 0x9a, 0x63, 0x1c, 0x43,
+	0x65, 0x3b, 0x74, 0x5b, 0x14, 0x3c, 0x53, 0x50, 0x38, 0x64, 0x40, 0x46, 0xbd, 0xd0, 0x84, 0xf6,
+	0x73, 0xba, 0x9d, 0x97, 0xd1, 0x6c, 0x0e, 0x95, 0x73, 0x6b, 0x40, 0x46, 0x77, 0x0e, 0x77, 0x59,
+	0x23, 0xce, 0x8c, 0x38, 0x1b, 0x67, 0xd5, 0x84, 0x7e, 0xfd, 0xb2, 0xdf, 0x7d, 0x5d, 0x46, 0xc7,
+	0x50, 0x85, 0xdd, 0xbc, 0x5e, 0xed, 0x97, 0x94, 0xc2, 0x32, 0x4f, 0x0a, 0xae, 0x12, 0x99, 0x39,
+	0x9d, 0xba, 0xda, 0xbd, 0x56, 0xfd, 0xd6, 0x1c, 0x7d, 0x72, 0xfb, 0xe2, 0xca, 0xb7, 0xce, 0xbf,
+	0xfb, 0x24, 0xfc, 0xa3, 0xce, 0x7e, 0x4a, 0xfb, 0x7c, 0xb1, 0x90, 0x1f, 0x21, 0x9e, 0xa5, 0x80,
+	0xc8, 0x05, 0xa0, 0xb3, 0x35, 0xe8, 0x8c, 0x7a, 0xe1, 0x8e, 0xce, 0x4f, 0x75, 0xfa, 0xc5, 0xd6,
+	0xa7, 0xcf, 0xbe, 0x35, 0x74, 0xa9, 0xd3, 0xf6, 0x17, 0x02, 0xe6, 0x32, 0x43, 0x18, 0xbe, 0xa3,
+	0xf7, 0xa7, 0x28, 0x42, 0x38, 0x93, 0x73, 0xd8, 0xc8, 0xfe, 0x13, 0xba, 0xa3, 0xff, 0xd6, 0x8c,
+	0xc7, 0x71, 0x01, 0x88, 0xf5, 0x35, 0xf4, 0xc2, 0x7b, 0x3a, 0x3d, 0x6e, 0xb2, 0xc3, 0xc7, 0xf4,
+	0xd1, 0x0d, 0x9d, 0x8d, 0xf0, 0xe1, 0x4f, 0x42, 0x3b, 0x53, 0x14, 0xf6, 0x9c, 0xde, 0xfd, 0xfb,
+	0xe6, 0x47, 0xec, 0xe6, 0xa1, 0x60, 0x6d, 0x0f, 0xee, 0xb3, 0x4d, 0x49, 0x23, 0x6a, 0x2b, 0xda,
+	0xbf, 0x66, 0x75, 0xef, 0x1f, 0x5d, 0xda, 0xb0, 0x7b, 0xf4, 0x1f, 0xb0, 0x51, 0x9d, 0xbc, 0xba,
+	0x58, 0x79, 0xe4, 0x72, 0xe5, 0x91, 0x1f, 0x2b, 0x8f, 0x9c, 0xaf, 0x3d, 0xeb, 0x72, 0xed, 0x59,
+	0xdf, 0xd6, 0x9e, 0xf5, 0x7e, 0x4f, 0x24, 0xea, 0xb4, 0x8c, 0xd8, 0x89, 0x4c, 0xf5, 0x4c, 0xea,
+	0x65, 0x1f, 0xe3, 0x79, 0xb0, 0x0c, 0x78, 0xa9, 0x4e, 0xcd, 0xeb, 0x89, 0xba, 0xf5, 0x84, 0x1c,
+	0xfd, 0x1a, 0x00, 0xd7, 0x59, 0x60, 0x96, 0x56, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// AddSessionKey authorizes a session key to sign transactions on behalf of
+	// the granter until its expiration time.
+	AddSessionKey(ctx context.Context, in *MsgAddSessionKey, opts ...grpc.CallOption) (*MsgAddSessionKeyResponse, error)
+	// RevokeSessionKey revokes a session key of the granter before its
+	// expiration time.
+	RevokeSessionKey(ctx context.Context, in *MsgRevokeSessionKey, opts ...grpc.CallOption) (*MsgRevokeSessionKeyResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) AddSessionKey(ctx context.Context, in *MsgAddSessionKey, opts ...grpc.CallOption) (*MsgAddSessionKeyResponse, error) {
+	out := new(MsgAddSessionKeyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.session.v1beta1.Msg/AddSessionKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeSessionKey(ctx context.Context, in *MsgRevokeSessionKey, opts ...grpc.CallOption) (*MsgRevokeSessionKeyResponse, error) {
+	out := new(MsgRevokeSessionKeyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.session.v1beta1.Msg/RevokeSessionKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// AddSessionKey authorizes a session key to sign transactions on behalf of
+	// the granter until its expiration time.
+	AddSessionKey(context.Context, *MsgAddSessionKey) (*MsgAddSessionKeyResponse, error)
+	// RevokeSessionKey revokes a session key of the granter before its
+	// expiration time.
+	RevokeSessionKey(context.Context, *MsgRevokeSessionKey) (*MsgRevokeSessionKeyResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) AddSessionKey(ctx context.Context, req *MsgAddSessionKey) (*MsgAddSessionKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSessionKey not implemented")
+}
+func (*UnimplementedMsgServer) RevokeSessionKey(ctx context.Context, req *MsgRevokeSessionKey) (*MsgRevokeSessionKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSessionKey not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_AddSessionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddSessionKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddSessionKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.session.v1beta1.Msg/AddSessionKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddSessionKey(ctx, req.(*MsgAddSessionKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeSessionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeSessionKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeSessionKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.session.v1beta1.Msg/RevokeSessionKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeSessionKey(ctx, req.(*MsgRevokeSessionKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.session.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddSessionKey",
+			Handler:    _Msg_AddSessionKey_Handler,
+		},
+		{
+			MethodName: "RevokeSessionKey",
+			Handler:    _Msg_RevokeSessionKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/session/v1beta1/tx.proto",
+}
+
+func (m *MsgAddSessionKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddSessionKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddSessionKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedMessages) > 0 {
+		for iNdEx := len(m.AllowedMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMessages[iNdEx])
+			copy(dAtA[i:], m.AllowedMessages[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AllowedMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddSessionKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddSessionKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddSessionKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeSessionKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeSessionKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeSessionKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SessionAddress) > 0 {
+		i -= len(m.SessionAddress)
+		copy(dAtA[i:], m.SessionAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeSessionKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeSessionKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeSessionKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddSessionKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.AllowedMessages) > 0 {
+		for _, s := range m.AllowedMessages {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddSessionKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeSessionKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SessionAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeSessionKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddSessionKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddSessionKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddSessionKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &types.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMessages = append(m.AllowedMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddSessionKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddSessionKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddSessionKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeSessionKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeSessionKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeSessionKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeSessionKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeSessionKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeSessionKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
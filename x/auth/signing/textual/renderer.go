@@ -0,0 +1,217 @@
+package textual
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// Renderer renders messages as the human-readable screens of
+// SIGN_MODE_TEXTUAL, one screen per field. The fields are rendered by the
+// value renderer of their type if any, e.g. for coins and timestamps, and
+// otherwise by their kind: the nested messages, including the ones packed in an
+// Any, as their own fields, indented, and the repeated fields as their items.
+// The fields with a zero value are not rendered.
+type Renderer struct {
+	valueRenderers map[reflect.Type]ValueRenderer
+}
+
+// NewRenderer returns a Renderer with the value renderers of the common types
+// of the SDK: integers, decimals, coins, addresses, timestamps and durations.
+func NewRenderer() *Renderer {
+	return &Renderer{valueRenderers: defaultValueRenderers()}
+}
+
+// RegisterValueRenderer registers the value renderer of the type of the given
+// value, e.g. of a custom type of an app, replacing the existing one if any.
+func (r *Renderer) RegisterValueRenderer(v interface{}, renderer ValueRenderer) {
+	r.valueRenderers[reflect.TypeOf(v)] = renderer
+}
+
+// RenderMessage returns the screens of the fields of the message, at the given
+// indent.
+func (r *Renderer) RenderMessage(msg proto.Message, indent uint32) ([]*signingtypes.TextualScreen, error) {
+	v := reflect.ValueOf(msg)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot render message %T", msg)
+	}
+
+	return r.renderFields(v.Elem(), indent)
+}
+
+// renderFields renders the proto fields of the struct of a message.
+func (r *Renderer) renderFields(v reflect.Value, indent uint32) ([]*signingtypes.TextualScreen, error) {
+	var screens []*signingtypes.TextualScreen
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		value := v.Field(i)
+
+		if _, ok := field.Tag.Lookup("protobuf_oneof"); ok {
+			// the oneof interface holds a pointer to a struct wrapping its field
+			if value.IsNil() {
+				continue
+			}
+			wrapper := value.Elem().Elem()
+			field, value = wrapper.Type().Field(0), wrapper.Field(0)
+		}
+
+		name, ok := fieldName(field)
+		if !ok || value.IsZero() {
+			continue
+		}
+
+		fieldScreens, err := r.renderValue(name, value, indent)
+		if err != nil {
+			return nil, fmt.Errorf("cannot render field %s: %w", field.Name, err)
+		}
+		screens = append(screens, fieldScreens...)
+	}
+
+	return screens, nil
+}
+
+// renderValue renders a value with the given title.
+func (r *Renderer) renderValue(title string, v reflect.Value, indent uint32) ([]*signingtypes.TextualScreen, error) {
+	if renderer, ok := r.valueRenderers[v.Type()]; ok {
+		content, err := renderer(v.Interface())
+		if err != nil {
+			return nil, err
+		}
+
+		return []*signingtypes.TextualScreen{{Title: title, Content: content, Indent: indent}}, nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil, nil
+		}
+		if any, ok := v.Interface().(*codectypes.Any); ok {
+			return r.renderAny(title, any, indent)
+		}
+
+		return r.renderValue(title, v.Elem(), indent)
+
+	case reflect.Struct:
+		fields, err := r.renderFields(v, indent+1)
+		if err != nil {
+			return nil, err
+		}
+
+		return append([]*signingtypes.TextualScreen{{Title: title, Indent: indent}}, fields...), nil
+
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return []*signingtypes.TextualScreen{{Title: title, Content: strings.ToUpper(hex.EncodeToString(v.Bytes())), Indent: indent}}, nil
+		}
+
+		var screens []*signingtypes.TextualScreen
+		for i := 0; i < v.Len(); i++ {
+			itemScreens, err := r.renderValue(fmt.Sprintf("%s (%d/%d)", title, i+1, v.Len()), v.Index(i), indent)
+			if err != nil {
+				return nil, err
+			}
+			screens = append(screens, itemScreens...)
+		}
+
+		return screens, nil
+	}
+
+	content, err := formatScalar(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*signingtypes.TextualScreen{{Title: title, Content: content, Indent: indent}}, nil
+}
+
+// renderAny renders the message packed in an Any as its type URL and its
+// fields, indented.
+func (r *Renderer) renderAny(title string, any *codectypes.Any, indent uint32) ([]*signingtypes.TextualScreen, error) {
+	msg, ok := any.GetCachedValue().(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("cannot render unpacked Any %s", any.TypeUrl)
+	}
+
+	fields, err := r.RenderMessage(msg, indent+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]*signingtypes.TextualScreen{{Title: title, Content: any.TypeUrl, Indent: indent}}, fields...), nil
+}
+
+// formatScalar formats a value of a proto scalar or enum type.
+func formatScalar(v reflect.Value) (string, error) {
+	// enums are rendered by their name
+	if stringer, ok := v.Interface().(fmt.Stringer); ok && v.Kind() == reflect.Int32 {
+		return stringer.String(), nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		if v.Bool() {
+			return "True", nil
+		}
+		return "False", nil
+	case reflect.Int32, reflect.Int64:
+		return FormatInteger(strconv.FormatInt(v.Int(), 10))
+	case reflect.Uint32, reflect.Uint64:
+		return FormatInteger(strconv.FormatUint(v.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64), nil
+	default:
+		return "", fmt.Errorf("unsupported type %s", v.Type())
+	}
+}
+
+// fieldName returns the title of a proto field from its name, e.g. "From
+// address" for from_address, and false if the field is not a proto field.
+func fieldName(field reflect.StructField) (string, bool) {
+	tag, ok := field.Tag.Lookup("protobuf")
+	if !ok {
+		return "", false
+	}
+
+	for _, part := range strings.Split(tag, ",") {
+		if name := strings.TrimPrefix(part, "name="); name != part && name != "" {
+			words := strings.ReplaceAll(name, "_", " ")
+			return strings.ToUpper(words[:1]) + words[1:], true
+		}
+	}
+
+	return "", false
+}
+
+// FormatScreens formats the screens as text, one line per screen indented by
+// two spaces per level, with the expert screens marked by a leading "*".
+func FormatScreens(screens []*signingtypes.TextualScreen) string {
+	var b strings.Builder
+	for _, screen := range screens {
+		if screen.Expert {
+			b.WriteString("*")
+		}
+		b.WriteString(strings.Repeat("  ", int(screen.Indent)))
+
+		switch {
+		case screen.Title == "":
+			b.WriteString(screen.Content)
+		case screen.Content == "":
+			b.WriteString(screen.Title + ":")
+		default:
+			b.WriteString(screen.Title + ": " + screen.Content)
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
@@ -0,0 +1,47 @@
+package textual_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing/textual"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestRenderMessage(t *testing.T) {
+	renderer := textual.NewRenderer()
+	voter := sdk.AccAddress("voter_______________")
+
+	// the enums are rendered by their name, and the zero fields are omitted
+	vote := govtypes.NewMsgVoteWeighted(voter, 1000, govtypes.WeightedVoteOptions{
+		{Option: govtypes.OptionYes, Weight: sdk.NewDecWithPrec(7, 1)},
+		{Option: govtypes.OptionAbstain, Weight: sdk.NewDecWithPrec(3, 1)},
+	})
+	screens, err := renderer.RenderMessage(vote, 1)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf(`  Proposal id: 1'000
+  Voter: %s
+  Options (1/2):
+    Option: VOTE_OPTION_YES
+    Weight: 0.7
+  Options (2/2):
+    Option: VOTE_OPTION_ABSTAIN
+    Weight: 0.3
+`, voter), textual.FormatScreens(screens))
+
+	// a registered value renderer replaces the default one of its type
+	renderer.RegisterValueRenderer(sdk.Dec{}, func(v interface{}) (string, error) {
+		return v.(sdk.Dec).MulInt64(100).TruncateInt().String() + "%", nil
+	})
+	screens, err = renderer.RenderMessage(vote, 0)
+	require.NoError(t, err)
+	require.Equal(t, "Weight", screens[4].Title)
+	require.Equal(t, "70%", screens[4].Content)
+	require.Equal(t, uint32(1), screens[4].Indent)
+
+	_, err = renderer.RenderMessage((*govtypes.MsgVote)(nil), 0)
+	require.Error(t, err)
+}
@@ -0,0 +1,260 @@
+package textual
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValueRenderer renders a value of a given type as the content of a screen.
+type ValueRenderer func(v interface{}) (string, error)
+
+// FormatInteger formats an integer with a ' separator every 3 digits, e.g.
+// 1'000'000.
+func FormatInteger(s string) (string, error) {
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	if s == "" || strings.TrimLeft(s, "0123456789") != "" {
+		return "", fmt.Errorf("invalid integer %q", s)
+	}
+
+	s = strings.TrimLeft(s, "0")
+	if s == "" {
+		return "0", nil
+	}
+
+	var b strings.Builder
+	b.WriteString(sign)
+	for i, c := range s {
+		if i > 0 && (len(s)-i)%3 == 0 {
+			b.WriteByte('\'')
+		}
+		b.WriteRune(c)
+	}
+
+	return b.String(), nil
+}
+
+// FormatDecimal formats a decimal as FormatInteger, without the trailing zeros
+// of its fractional part, e.g. 1'000.5.
+func FormatDecimal(s string) (string, error) {
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) > 2 || strings.HasPrefix(parts[0], "-") {
+		return "", fmt.Errorf("invalid decimal %q", sign+s)
+	}
+
+	integer, err := FormatInteger(parts[0])
+	if err != nil {
+		return "", fmt.Errorf("invalid decimal %q", sign+s)
+	}
+
+	fraction := ""
+	if len(parts) == 2 {
+		fraction = strings.TrimRight(parts[1], "0")
+		if strings.TrimLeft(fraction, "0123456789") != "" {
+			return "", fmt.Errorf("invalid decimal %q", sign+s)
+		}
+	}
+	if fraction == "" {
+		if integer == "0" {
+			return integer, nil
+		}
+		return sign + integer, nil
+	}
+
+	return sign + integer + "." + fraction, nil
+}
+
+// FormatCoin formats a coin as its amount, formatted as FormatInteger, and its
+// denom, e.g. 1'000 stake.
+func FormatCoin(coin sdk.Coin) (string, error) {
+	if coin.Amount.IsNil() {
+		return "", fmt.Errorf("nil amount of coin %s", coin.Denom)
+	}
+
+	amount, err := FormatInteger(coin.Amount.String())
+	if err != nil {
+		return "", err
+	}
+
+	return amount + " " + coin.Denom, nil
+}
+
+// FormatCoins formats the coins as FormatCoin, sorted by denom and separated by
+// ", ", or "zero" if there are none.
+func FormatCoins(coins sdk.Coins) (string, error) {
+	if len(coins) == 0 {
+		return "zero", nil
+	}
+
+	sorted := append(sdk.Coins{}, coins...).Sort()
+	formatted := make([]string, len(sorted))
+	for i, coin := range sorted {
+		s, err := FormatCoin(coin)
+		if err != nil {
+			return "", err
+		}
+		formatted[i] = s
+	}
+
+	return strings.Join(formatted, ", "), nil
+}
+
+// FormatDecCoin formats a decimal coin as its amount, formatted as
+// FormatDecimal, and its denom, e.g. 0.25 stake.
+func FormatDecCoin(coin sdk.DecCoin) (string, error) {
+	if coin.Amount.IsNil() {
+		return "", fmt.Errorf("nil amount of coin %s", coin.Denom)
+	}
+
+	amount, err := FormatDecimal(coin.Amount.String())
+	if err != nil {
+		return "", err
+	}
+
+	return amount + " " + coin.Denom, nil
+}
+
+// FormatDecCoins formats the decimal coins as FormatDecCoin, sorted by denom
+// and separated by ", ", or "zero" if there are none.
+func FormatDecCoins(coins sdk.DecCoins) (string, error) {
+	if len(coins) == 0 {
+		return "zero", nil
+	}
+
+	sorted := append(sdk.DecCoins{}, coins...).Sort()
+	formatted := make([]string, len(sorted))
+	for i, coin := range sorted {
+		s, err := FormatDecCoin(coin)
+		if err != nil {
+			return "", err
+		}
+		formatted[i] = s
+	}
+
+	return strings.Join(formatted, ", "), nil
+}
+
+// FormatTimestamp formats a time in UTC as RFC 3339, with the nanoseconds if
+// any, e.g. 2022-01-02T15:04:05Z.
+func FormatTimestamp(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// FormatDuration formats a duration in days, hours, minutes and seconds,
+// omitting the zero ones, e.g. 1 day, 2 hours, 0.5 seconds.
+func FormatDuration(d time.Duration) string {
+	if d == 0 {
+		return "0 seconds"
+	}
+
+	sign := ""
+	if d < 0 {
+		sign, d = "-", -d
+	}
+
+	var parts []string
+	for _, unit := range []struct {
+		name     string
+		duration time.Duration
+	}{
+		{"day", 24 * time.Hour},
+		{"hour", time.Hour},
+		{"minute", time.Minute},
+	} {
+		if n := d / unit.duration; n > 0 {
+			parts = append(parts, pluralize(fmt.Sprint(int64(n)), unit.name))
+			d -= n * unit.duration
+		}
+	}
+	if d > 0 {
+		seconds, _ := FormatDecimal(sdk.NewDecWithPrec(int64(d), 9).String())
+		parts = append(parts, pluralize(seconds, "second"))
+	}
+
+	return sign + strings.Join(parts, ", ")
+}
+
+func pluralize(n, unit string) string {
+	if n == "1" {
+		return n + " " + unit
+	}
+
+	return n + " " + unit + "s"
+}
+
+// defaultValueRenderers returns the value renderers of the types the messages
+// commonly have fields of, by type.
+func defaultValueRenderers() map[reflect.Type]ValueRenderer {
+	return map[reflect.Type]ValueRenderer{
+		reflect.TypeOf(sdk.Int{}): func(v interface{}) (string, error) {
+			i := v.(sdk.Int)
+			if i.IsNil() {
+				return "", fmt.Errorf("nil integer")
+			}
+			return FormatInteger(i.String())
+		},
+		reflect.TypeOf(sdk.Dec{}): func(v interface{}) (string, error) {
+			d := v.(sdk.Dec)
+			if d.IsNil() {
+				return "", fmt.Errorf("nil decimal")
+			}
+			return FormatDecimal(d.String())
+		},
+		reflect.TypeOf(sdk.Coin{}): func(v interface{}) (string, error) {
+			return FormatCoin(v.(sdk.Coin))
+		},
+		reflect.TypeOf(sdk.Coins{}): func(v interface{}) (string, error) {
+			return FormatCoins(v.(sdk.Coins))
+		},
+		reflect.TypeOf(sdk.DecCoin{}): func(v interface{}) (string, error) {
+			return FormatDecCoin(v.(sdk.DecCoin))
+		},
+		reflect.TypeOf(sdk.DecCoins{}): func(v interface{}) (string, error) {
+			return FormatDecCoins(v.(sdk.DecCoins))
+		},
+		reflect.TypeOf(sdk.AccAddress{}): func(v interface{}) (string, error) {
+			return v.(sdk.AccAddress).String(), nil
+		},
+		reflect.TypeOf(sdk.ValAddress{}): func(v interface{}) (string, error) {
+			return v.(sdk.ValAddress).String(), nil
+		},
+		reflect.TypeOf(sdk.ConsAddress{}): func(v interface{}) (string, error) {
+			return v.(sdk.ConsAddress).String(), nil
+		},
+		reflect.TypeOf(time.Time{}): func(v interface{}) (string, error) {
+			return FormatTimestamp(v.(time.Time)), nil
+		},
+		reflect.TypeOf(time.Duration(0)): func(v interface{}) (string, error) {
+			return FormatDuration(v.(time.Duration)), nil
+		},
+		reflect.TypeOf(gogotypes.Timestamp{}): func(v interface{}) (string, error) {
+			ts := v.(gogotypes.Timestamp)
+			t, err := gogotypes.TimestampFromProto(&ts)
+			if err != nil {
+				return "", err
+			}
+			return FormatTimestamp(t), nil
+		},
+		reflect.TypeOf(gogotypes.Duration{}): func(v interface{}) (string, error) {
+			pd := v.(gogotypes.Duration)
+			d, err := gogotypes.DurationFromProto(&pd)
+			if err != nil {
+				return "", err
+			}
+			return FormatDuration(d), nil
+		},
+	}
+}
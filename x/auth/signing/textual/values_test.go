@@ -0,0 +1,94 @@
+package textual_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing/textual"
+)
+
+func TestFormatInteger(t *testing.T) {
+	testCases := []struct {
+		in     string
+		exp    string
+		expErr bool
+	}{
+		{"0", "0", false},
+		{"1", "1", false},
+		{"999", "999", false},
+		{"1000", "1'000", false},
+		{"1234567", "1'234'567", false},
+		{"-1234567", "-1'234'567", false},
+		{"007", "7", false},
+		{"-0", "0", false},
+		{"", "", true},
+		{"12a", "", true},
+		{"1.5", "", true},
+	}
+	for _, tc := range testCases {
+		out, err := textual.FormatInteger(tc.in)
+		if tc.expErr {
+			require.Error(t, err, tc.in)
+			continue
+		}
+		require.NoError(t, err, tc.in)
+		require.Equal(t, tc.exp, out, tc.in)
+	}
+}
+
+func TestFormatDecimal(t *testing.T) {
+	testCases := []struct {
+		in     string
+		exp    string
+		expErr bool
+	}{
+		{"0.000000000000000000", "0", false},
+		{"1000.500000000000000000", "1'000.5", false},
+		{"-0.250000000000000000", "-0.25", false},
+		{"-0.000000000000000000", "0", false},
+		{"1234567", "1'234'567", false},
+		{"1.2.3", "", true},
+		{"1.-5", "", true},
+		{"--1", "", true},
+	}
+	for _, tc := range testCases {
+		out, err := textual.FormatDecimal(tc.in)
+		if tc.expErr {
+			require.Error(t, err, tc.in)
+			continue
+		}
+		require.NoError(t, err, tc.in)
+		require.Equal(t, tc.exp, out, tc.in)
+	}
+}
+
+func TestFormatCoins(t *testing.T) {
+	out, err := textual.FormatCoins(sdk.Coins{sdk.NewInt64Coin("uatom", 1000000), sdk.NewInt64Coin("stake", 5)})
+	require.NoError(t, err)
+	require.Equal(t, "5 stake, 1'000'000 uatom", out)
+
+	out, err = textual.FormatCoins(nil)
+	require.NoError(t, err)
+	require.Equal(t, "zero", out)
+
+	out, err = textual.FormatDecCoins(sdk.NewDecCoinsFromCoins(sdk.NewInt64Coin("stake", 2)).MulDec(sdk.NewDecWithPrec(125, 2)))
+	require.NoError(t, err)
+	require.Equal(t, "2.5 stake", out)
+
+	_, err = textual.FormatCoin(sdk.Coin{Denom: "stake"})
+	require.Error(t, err)
+}
+
+func TestFormatTime(t *testing.T) {
+	require.Equal(t, "2022-01-02T15:04:05Z", textual.FormatTimestamp(time.Date(2022, 1, 2, 15, 4, 5, 0, time.UTC)))
+	require.Equal(t, "2022-01-02T14:04:05.5Z", textual.FormatTimestamp(time.Date(2022, 1, 2, 15, 4, 5, 5e8, time.FixedZone("CET", 3600))))
+
+	require.Equal(t, "0 seconds", textual.FormatDuration(0))
+	require.Equal(t, "1 second", textual.FormatDuration(time.Second))
+	require.Equal(t, "1 day, 2 hours, 0.5 seconds", textual.FormatDuration(26*time.Hour+500*time.Millisecond))
+	require.Equal(t, "-3 minutes", textual.FormatDuration(-3*time.Minute))
+	require.Equal(t, "21 days", textual.FormatDuration(21*24*time.Hour))
+}
@@ -14,12 +14,10 @@ import (
 
 // Simulation parameter constants
 const (
-	MaxMemoChars           = "max_memo_characters"
-	TxSigLimit             = "tx_sig_limit"
-	TxSizeCostPerByte      = "tx_size_cost_per_byte"
-	SigVerifyCostED25519   = "sig_verify_cost_ed25519"
-	SigVerifyCostSECP256K1 = "sig_verify_cost_secp256k1"
-	SigVerifyCostSm2       = "sig_verify_cost_sm2"
+	MaxMemoChars      = "max_memo_characters"
+	TxSigLimit        = "tx_sig_limit"
+	TxSizeCostPerByte = "tx_size_cost_per_byte"
+	SigVerifyCosts    = "sig_verify_costs"
 )
 
 // RandomGenesisAccounts defines the default RandomGenesisAccountsFn used on the SDK.
@@ -78,19 +76,14 @@ func GenTxSizeCostPerByte(r *rand.Rand) uint64 {
 	return uint64(simulation.RandIntBetween(r, 5, 15))
 }
 
-// GenSigVerifyCostED25519 randomized SigVerifyCostED25519
-func GenSigVerifyCostED25519(r *rand.Rand) uint64 {
-	return uint64(simulation.RandIntBetween(r, 500, 1000))
-}
-
-// GenSigVerifyCostSECP256K1 randomized SigVerifyCostSECP256K1
-func GenSigVerifyCostSECP256K1(r *rand.Rand) uint64 {
-	return uint64(simulation.RandIntBetween(r, 500, 1000))
-}
+// GenSigVerifyCosts randomized SigVerifyCosts
+func GenSigVerifyCosts(r *rand.Rand) []types.SigVerifyCost {
+	costs := types.DefaultSigVerifyCosts()
+	for i := range costs {
+		costs[i].Cost = uint64(simulation.RandIntBetween(r, 500, 1000))
+	}
 
-// GenSigVerifyCostSM2 randomized SigVerifyCostSM2
-func GenSigVerifyCostSM2(r *rand.Rand) uint64 {
-	return uint64(simulation.RandIntBetween(r, 500, 1000))
+	return costs
 }
 
 // RandomizedGenState generates a random GenesisState for auth
@@ -113,31 +106,20 @@ func RandomizedGenState(simState *module.SimulationState, randGenAccountsFn type
 		func(r *rand.Rand) { txSizeCostPerByte = GenTxSizeCostPerByte(r) },
 	)
 
-	var sigVerifyCostED25519 uint64
-	simState.AppParams.GetOrGenerate(
-		simState.Cdc, SigVerifyCostED25519, &sigVerifyCostED25519, simState.Rand,
-		func(r *rand.Rand) { sigVerifyCostED25519 = GenSigVerifyCostED25519(r) },
-	)
-
-	var sigVerifyCostSECP256K1 uint64
-	simState.AppParams.GetOrGenerate(
-		simState.Cdc, SigVerifyCostSECP256K1, &sigVerifyCostSECP256K1, simState.Rand,
-		func(r *rand.Rand) { sigVerifyCostSECP256K1 = GenSigVerifyCostSECP256K1(r) },
-	)
-
-	var sigVerifyCostSm2 uint64
+	var sigVerifyCosts []types.SigVerifyCost
 	simState.AppParams.GetOrGenerate(
-		simState.Cdc, SigVerifyCostSm2, &sigVerifyCostSECP256K1, simState.Rand,
-		func(r *rand.Rand) { sigVerifyCostSm2 = GenSigVerifyCostSM2(r) },
+		simState.Cdc, SigVerifyCosts, &sigVerifyCosts, simState.Rand,
+		func(r *rand.Rand) { sigVerifyCosts = GenSigVerifyCosts(r) },
 	)
 
 	params := types.NewParams(
 		maxMemoChars,
 		txSigLimit,
 		txSizeCostPerByte,
-		sigVerifyCostED25519,
-		sigVerifyCostSECP256K1,
-		sigVerifyCostSm2,
+		sigVerifyCosts,
+		types.DefaultMemoRegex,
+		types.DefaultPubKeyChangeCost,
+		types.DefaultMinGasPrices(),
 	)
 	genesisAccs := randGenAccountsFn(simState)
 
@@ -41,8 +41,12 @@ func TestRandomizedGenState(t *testing.T) {
 	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &authGenesis)
 
 	require.Equal(t, uint64(0x8c), authGenesis.Params.GetMaxMemoCharacters())
-	require.Equal(t, uint64(0x2b6), authGenesis.Params.GetSigVerifyCostED25519())
-	require.Equal(t, uint64(0x1ff), authGenesis.Params.GetSigVerifyCostSecp256k1())
+	require.Equal(t, []types.SigVerifyCost{
+		types.NewSigVerifyCost(types.PubKeyTypeURLEd25519, 0x2b6),
+		types.NewSigVerifyCost(types.PubKeyTypeURLSecp256k1, 0x1ff),
+		types.NewSigVerifyCost(types.PubKeyTypeURLSecp256r1, 0x296),
+		types.NewSigVerifyCost(types.PubKeyTypeURLSm2, 0x24d),
+	}, authGenesis.Params.GetSigVerifyCosts())
 	require.Equal(t, uint64(9), authGenesis.Params.GetTxSigLimit())
 	require.Equal(t, uint64(5), authGenesis.Params.GetTxSizeCostPerByte())
 
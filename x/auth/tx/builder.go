@@ -1,6 +1,8 @@
 package tx
 
 import (
+	"time"
+
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -124,15 +126,15 @@ func (w *wrapper) GetPubKeys() ([]cryptotypes.PubKey, error) {
 }
 
 func (w *wrapper) GetGas() uint64 {
-	return w.tx.AuthInfo.Fee.GasLimit
+	return w.tx.AuthInfo.GetFee().GetGasLimit()
 }
 
 func (w *wrapper) GetFee() sdk.Coins {
-	return w.tx.AuthInfo.Fee.Amount
+	return w.tx.AuthInfo.GetFee().GetAmount()
 }
 
 func (w *wrapper) FeePayer() sdk.AccAddress {
-	feePayer := w.tx.AuthInfo.Fee.Payer
+	feePayer := w.tx.AuthInfo.GetFee().GetPayer()
 	if feePayer != "" {
 		payerAddr, err := sdk.AccAddressFromBech32(feePayer)
 		if err != nil {
@@ -145,7 +147,7 @@ func (w *wrapper) FeePayer() sdk.AccAddress {
 }
 
 func (w *wrapper) FeeGranter() sdk.AccAddress {
-	feePayer := w.tx.AuthInfo.Fee.Granter
+	feePayer := w.tx.AuthInfo.GetFee().GetGranter()
 	if feePayer != "" {
 		granterAddr, err := sdk.AccAddressFromBech32(feePayer)
 		if err != nil {
@@ -165,6 +167,20 @@ func (w *wrapper) GetTimeoutHeight() uint64 {
 	return w.tx.Body.TimeoutHeight
 }
 
+// GetTimeoutTimestamp returns the transaction's timeout timestamp (if set).
+func (w *wrapper) GetTimeoutTimestamp() time.Time {
+	if w.tx.Body.TimeoutTimestamp == nil {
+		return time.Time{}
+	}
+
+	return *w.tx.Body.TimeoutTimestamp
+}
+
+// GetUnordered returns true if the transaction is unordered.
+func (w *wrapper) GetUnordered() bool {
+	return w.tx.Body.Unordered
+}
+
 func (w *wrapper) GetSignaturesV2() ([]signing.SignatureV2, error) {
 	signerInfos := w.tx.AuthInfo.SignerInfos
 	sigs := w.tx.Signatures
@@ -226,6 +242,28 @@ func (w *wrapper) SetTimeoutHeight(height uint64) {
 	w.bodyBz = nil
 }
 
+// SetTimeoutTimestamp sets the transaction's block time timeout. A zero time
+// unsets the timeout.
+func (w *wrapper) SetTimeoutTimestamp(timestamp time.Time) {
+	if timestamp.IsZero() {
+		w.tx.Body.TimeoutTimestamp = nil
+	} else {
+		timestamp = timestamp.UTC()
+		w.tx.Body.TimeoutTimestamp = &timestamp
+	}
+
+	// set bodyBz to nil because the cached bodyBz no longer matches tx.Body
+	w.bodyBz = nil
+}
+
+// SetUnordered sets whether the transaction is unordered.
+func (w *wrapper) SetUnordered(unordered bool) {
+	w.tx.Body.Unordered = unordered
+
+	// set bodyBz to nil because the cached bodyBz no longer matches tx.Body
+	w.bodyBz = nil
+}
+
 func (w *wrapper) SetMemo(memo string) {
 	w.tx.Body.Memo = memo
 
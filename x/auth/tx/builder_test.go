@@ -218,11 +218,28 @@ func TestBuilderValidateBasic(t *testing.T) {
 	err = txBuilder.ValidateBasic()
 	require.NoError(t, err)
 
+	// invalid fee payer or granter
+	txBuilder.tx.AuthInfo.Fee.Payer = "invalid"
+	err = txBuilder.ValidateBasic()
+	require.Error(t, err)
+	txBuilder.tx.AuthInfo.Fee.Payer = ""
+	txBuilder.tx.AuthInfo.Fee.Granter = "invalid"
+	err = txBuilder.ValidateBasic()
+	require.Error(t, err)
+	txBuilder.tx.AuthInfo.Fee.Granter = ""
+	err = txBuilder.ValidateBasic()
+	require.NoError(t, err)
+
 	// missing fee
 	f := txBuilder.tx.AuthInfo.Fee
 	txBuilder.tx.AuthInfo.Fee = nil
 	err = txBuilder.ValidateBasic()
 	require.Error(t, err)
+	// which the fee getters of a decoded tx handle, since they are called
+	// before its validation
+	require.Zero(t, txBuilder.GetGas())
+	require.Nil(t, txBuilder.GetFee())
+	require.Nil(t, txBuilder.FeeGranter())
 	txBuilder.tx.AuthInfo.Fee = f
 	err = txBuilder.ValidateBasic()
 	require.NoError(t, err)
@@ -3,17 +3,53 @@ package tx
 import (
 	"fmt"
 
+	metrics "github.com/armon/go-metrics"
 	"google.golang.org/protobuf/encoding/protowire"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
+// UnknownFieldsPolicy defines how the tx decoder handles the unknown proto
+// fields of a tx, e.g. the fields added by clients newer than the node.
+//
+// Unknown critical fields, i.e. whose field number does not have bit 11 set,
+// are always rejected, as ignoring them could change the meaning of the tx.
+// Unknown non-critical fields are only allowed in the TxBody, where they are
+// accepted unless RejectNonCritical is set. Txs with accepted non-critical
+// fields cannot be signed with SIGN_MODE_LEGACY_AMINO_JSON.
+//
+// The unknown fields encountered are counted by the tx_unknown_fields metric,
+// labeled by criticality and action.
+type UnknownFieldsPolicy struct {
+	RejectNonCritical bool
+}
+
+// Labels of the unknown fields metric.
+const (
+	UnknownFieldCritical    = "critical"
+	UnknownFieldNonCritical = "non_critical"
+	UnknownFieldRejected    = "rejected"
+	UnknownFieldAccepted    = "accepted"
+)
+
+// MetricKeysUnknownFields is the key of the counter of the unknown fields
+// encountered while decoding txs.
+var MetricKeysUnknownFields = []string{"tx", "unknown_fields"}
+
 // DefaultTxDecoder returns a default protobuf TxDecoder using the provided Marshaler.
+// It accepts the unknown non-critical fields of the TxBody.
 func DefaultTxDecoder(cdc codec.ProtoCodecMarshaler) sdk.TxDecoder {
+	return DefaultTxDecoderWithPolicy(cdc, UnknownFieldsPolicy{})
+}
+
+// DefaultTxDecoderWithPolicy returns a protobuf TxDecoder using the provided
+// Marshaler and handling the unknown fields of the txs according to policy.
+func DefaultTxDecoderWithPolicy(cdc codec.ProtoCodecMarshaler, policy UnknownFieldsPolicy) sdk.TxDecoder {
 	return func(txBytes []byte) (sdk.Tx, error) {
 		// Make sure txBytes follow ADR-027.
 		err := rejectNonADR027TxRaw(txBytes)
@@ -26,6 +62,7 @@ func DefaultTxDecoder(cdc codec.ProtoCodecMarshaler) sdk.TxDecoder {
 		// reject all unknown proto fields in the root TxRaw
 		err = unknownproto.RejectUnknownFieldsStrict(txBytes, &raw, cdc.InterfaceRegistry())
 		if err != nil {
+			incrRejectedUnknownField(err)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
 		}
 
@@ -36,11 +73,15 @@ func DefaultTxDecoder(cdc codec.ProtoCodecMarshaler) sdk.TxDecoder {
 
 		var body tx.TxBody
 
-		// allow non-critical unknown fields in TxBody
-		txBodyHasUnknownNonCriticals, err := unknownproto.RejectUnknownFields(raw.BodyBytes, &body, true, cdc.InterfaceRegistry())
+		// allow non-critical unknown fields in TxBody, unless rejected by the policy
+		txBodyHasUnknownNonCriticals, err := unknownproto.RejectUnknownFields(raw.BodyBytes, &body, !policy.RejectNonCritical, cdc.InterfaceRegistry())
 		if err != nil {
+			incrRejectedUnknownField(err)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
 		}
+		if txBodyHasUnknownNonCriticals {
+			incrUnknownField(UnknownFieldNonCritical, UnknownFieldAccepted)
+		}
 
 		err = cdc.Unmarshal(raw.BodyBytes, &body)
 		if err != nil {
@@ -52,6 +93,7 @@ func DefaultTxDecoder(cdc codec.ProtoCodecMarshaler) sdk.TxDecoder {
 		// reject all unknown proto fields in AuthInfo
 		err = unknownproto.RejectUnknownFieldsStrict(raw.AuthInfoBytes, &authInfo, cdc.InterfaceRegistry())
 		if err != nil {
+			incrRejectedUnknownField(err)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
 		}
 
@@ -90,6 +132,27 @@ func DefaultJSONTxDecoder(cdc codec.ProtoCodecMarshaler) sdk.TxDecoder {
 	}
 }
 
+// incrRejectedUnknownField counts the unknown field reported by err, if any.
+func incrRejectedUnknownField(err error) {
+	isUnknown, isCritical := unknownproto.IsUnknownFieldError(err)
+	if !isUnknown {
+		return
+	}
+
+	criticality := UnknownFieldNonCritical
+	if isCritical {
+		criticality = UnknownFieldCritical
+	}
+	incrUnknownField(criticality, UnknownFieldRejected)
+}
+
+func incrUnknownField(criticality, action string) {
+	telemetry.IncrCounterWithLabels(MetricKeysUnknownFields, 1, []metrics.Label{
+		telemetry.NewLabel("criticality", criticality),
+		telemetry.NewLabel("action", action),
+	})
+}
+
 // rejectNonADR027TxRaw rejects txBytes that do not follow ADR-027. This is NOT
 // a generic ADR-027 checker, it only applies decoding TxRaw. Specifically, it
 // only checks that:
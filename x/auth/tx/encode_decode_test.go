@@ -161,6 +161,28 @@ func TestUnknownFields(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestUnknownFieldsPolicy(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	decoder := DefaultTxDecoderWithPolicy(cdc, UnknownFieldsPolicy{RejectNonCritical: true})
+
+	marshalTx := func(body *testdata.TestUpdatedTxBody) []byte {
+		bodyBz, err := body.Marshal()
+		require.NoError(t, err)
+		txBz, err := (&tx.TxRaw{BodyBytes: bodyBz}).Marshal()
+		require.NoError(t, err)
+		return txBz
+	}
+
+	_, err := decoder(marshalTx(&testdata.TestUpdatedTxBody{Memo: "foo"}))
+	require.NoError(t, err)
+
+	_, err = decoder(marshalTx(&testdata.TestUpdatedTxBody{Memo: "foo", SomeNewFieldNonCriticalField: "blah"}))
+	require.ErrorIs(t, err, sdkerrors.ErrTxDecode)
+
+	_, err = decoder(marshalTx(&testdata.TestUpdatedTxBody{Memo: "foo", SomeNewField: 10}))
+	require.ErrorIs(t, err, sdkerrors.ErrTxDecode)
+}
+
 func TestRejectNonADR027(t *testing.T) {
 	registry := codectypes.NewInterfaceRegistry()
 	cdc := codec.NewProtoCodec(registry)
@@ -2,6 +2,7 @@ package tx
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -80,6 +81,22 @@ func TestLegacyAminoJSONHandler_GetSignBytes(t *testing.T) {
 	tx = bldr.GetTx()
 	signBz, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signingData, tx)
 	require.Error(t, err)
+
+	// expect error with timeout timestamp
+	bldr = newBuilder()
+	buildTx(t, bldr)
+	bldr.SetTimeoutTimestamp(time.Now())
+	tx = bldr.GetTx()
+	signBz, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signingData, tx)
+	require.Error(t, err)
+
+	// expect error with unordered txs
+	bldr = newBuilder()
+	buildTx(t, bldr)
+	bldr.SetUnordered(true)
+	tx = bldr.GetTx()
+	signBz, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signingData, tx)
+	require.Error(t, err)
 }
 
 func TestLegacyAminoJSONHandler_DefaultMode(t *testing.T) {
@@ -2,6 +2,7 @@ package tx
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -10,6 +11,8 @@ import (
 	gogogrpc "github.com/gogo/protobuf/grpc"
 	"github.com/golang/protobuf/proto" // nolint: staticcheck
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -23,19 +26,41 @@ import (
 // baseAppSimulateFn is the signature of the Baseapp#Simulate function.
 type baseAppSimulateFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
 
+// baseAppSimulateBundleFn is the signature of the Baseapp#SimulateBundle function.
+type baseAppSimulateBundleFn func(txsBytes [][]byte) ([]sdk.GasInfo, []*sdk.Result, error)
+
+// baseAppEstimateGasFn is the signature of the Baseapp#EstimateGas function.
+type baseAppEstimateGasFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, sdk.GasBreakdown, error)
+
+// baseAppTraceTxFn is the signature of the Baseapp#TraceTx function.
+type baseAppTraceTxFn func(header tmproto.Header, txsBytes [][]byte, index int) (abci.ResponseDeliverTx, sdk.TxTrace, error)
+
 // txServer is the server for the protobuf Tx service.
 type txServer struct {
 	clientCtx         client.Context
 	simulate          baseAppSimulateFn
+	simulateBundle    baseAppSimulateBundleFn
+	estimateGas       baseAppEstimateGasFn
+	traceTx           baseAppTraceTxFn
 	interfaceRegistry codectypes.InterfaceRegistry
+	unknownFields     UnknownFieldsPolicy
 }
 
-// NewTxServer creates a new Tx service server.
-func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, interfaceRegistry codectypes.InterfaceRegistry) txtypes.ServiceServer {
+// NewTxServer creates a new Tx service server. unknownFields is the policy of
+// the app's tx decoder, reported by the UnknownFieldsPolicy method.
+func NewTxServer(
+	clientCtx client.Context, simulate baseAppSimulateFn, simulateBundle baseAppSimulateBundleFn,
+	estimateGas baseAppEstimateGasFn, traceTx baseAppTraceTxFn, interfaceRegistry codectypes.InterfaceRegistry,
+	unknownFields UnknownFieldsPolicy,
+) txtypes.ServiceServer {
 	return txServer{
 		clientCtx:         clientCtx,
 		simulate:          simulate,
+		simulateBundle:    simulateBundle,
+		estimateGas:       estimateGas,
+		traceTx:           traceTx,
 		interfaceRegistry: interfaceRegistry,
+		unknownFields:     unknownFields,
 	}
 }
 
@@ -127,6 +152,182 @@ func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*
 	}, nil
 }
 
+// SimulateBundle implements the ServiceServer.SimulateBundle RPC method.
+func (s txServer) SimulateBundle(ctx context.Context, req *txtypes.SimulateBundleRequest) (*txtypes.SimulateBundleResponse, error) {
+	if req == nil || len(req.TxsBytes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty txs bundle is not allowed")
+	}
+
+	for i, txBytes := range req.TxsBytes {
+		if len(txBytes) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "empty txBytes is not allowed; tx %d", i)
+		}
+	}
+
+	gasInfos, results, err := s.simulateBundle(req.TxsBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*txtypes.SimulateResponse, len(results))
+	for i := range results {
+		res[i] = &txtypes.SimulateResponse{
+			GasInfo: &gasInfos[i],
+			Result:  results[i],
+		}
+	}
+
+	return &txtypes.SimulateBundleResponse{Results: res}, nil
+}
+
+// EstimateGas implements the ServiceServer.EstimateGas RPC method.
+func (s txServer) EstimateGas(ctx context.Context, req *txtypes.EstimateGasRequest) (*txtypes.EstimateGasResponse, error) {
+	if req == nil || len(req.TxBytes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty txBytes is not allowed")
+	}
+
+	tx, err := s.clientCtx.TxConfig.TxDecoder()(req.TxBytes)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx; %v", err)
+	}
+
+	gasInfo, result, breakdown, err := s.estimateGas(req.TxBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := tx.GetMsgs()
+	res := &txtypes.EstimateGasResponse{
+		GasInfo:     &gasInfo,
+		Result:      result,
+		AnteGasUsed: breakdown.AnteGas,
+		MsgsGasUsed: make([]*txtypes.MsgGasUsed, len(breakdown.MsgsGas)),
+	}
+	for i, gas := range breakdown.MsgsGas {
+		res.MsgsGasUsed[i] = &txtypes.MsgGasUsed{MsgTypeUrl: sdk.MsgTypeURL(msgs[i]), GasUsed: gas}
+	}
+
+	return res, nil
+}
+
+// TraceTx implements the ServiceServer.TraceTx RPC method.
+func (s txServer) TraceTx(ctx context.Context, req *txtypes.TraceTxRequest) (*txtypes.TraceTxResponse, error) {
+	if req == nil || len(req.Hash) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "tx hash cannot be empty")
+	}
+
+	hash, err := hex.DecodeString(req.Hash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx hash; %v", err)
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	resTx, err := node.Tx(ctx, hash, false)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, status.Errorf(codes.NotFound, "tx not found: %s", req.Hash)
+		}
+
+		return nil, err
+	}
+
+	_, block, err := tmservice.GetProtoBlock(ctx, s.clientCtx, &resTx.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.clientCtx.TxConfig.TxDecoder()(resTx.Tx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid tx; %v", err)
+	}
+
+	res, trace, err := s.traceTx(block.Header, block.Data.Txs, int(resTx.Index))
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := tx.GetMsgs()
+	traceRes := &txtypes.TraceTxResponse{
+		Height:      resTx.Height,
+		GasInfo:     &sdk.GasInfo{GasWanted: uint64(res.GasWanted), GasUsed: uint64(res.GasUsed)},
+		Code:        res.Code,
+		Codespace:   res.Codespace,
+		Log:         res.Log,
+		AnteGasUsed: trace.AnteGas,
+		Msgs:        make([]*txtypes.MsgTrace, len(trace.Msgs)),
+	}
+	for i, msgTrace := range trace.Msgs {
+		traceRes.Msgs[i] = &txtypes.MsgTrace{
+			MsgTypeUrl: sdk.MsgTypeURL(msgs[i]),
+			GasUsed:    msgTrace.GasUsed,
+			Events:     msgTrace.Events.ToABCIEvents(),
+			Reads:      msgTrace.Reads,
+			Writes:     msgTrace.Writes,
+		}
+	}
+
+	return traceRes, nil
+}
+
+// TxSigners implements the ServiceServer.TxSigners RPC method.
+func (s txServer) TxSigners(ctx context.Context, req *txtypes.TxSignersRequest) (*txtypes.TxSignersResponse, error) {
+	if req == nil || len(req.TxBytes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty txBytes is not allowed")
+	}
+
+	tx, err := s.clientCtx.TxConfig.TxDecoder()(req.TxBytes)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx; %v", err)
+	}
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx; %T is not a FeeTx", tx)
+	}
+
+	signers, err := GetSigners(tx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx; %v", err)
+	}
+	payer, err := GetFeePayer(tx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx; %v", err)
+	}
+	granter, err := feeGranter(feeTx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx; %v", err)
+	}
+
+	res := &txtypes.TxSignersResponse{
+		Signers:  make([]string, len(signers)),
+		FeePayer: payer.String(),
+		Fee:      feeTx.GetFee(),
+		GasLimit: feeTx.GetGas(),
+	}
+	for i, signer := range signers {
+		res.Signers[i] = signer.String()
+	}
+	if granter != nil {
+		res.FeeGranter = granter.String()
+	}
+	for _, msg := range tx.GetMsgs() {
+		res.MsgTypes = append(res.MsgTypes, sdk.MsgTypeURL(msg))
+	}
+
+	return res, nil
+}
+
+// UnknownFieldsPolicy implements the ServiceServer.UnknownFieldsPolicy RPC method.
+func (s txServer) UnknownFieldsPolicy(ctx context.Context, _ *txtypes.UnknownFieldsPolicyRequest) (*txtypes.UnknownFieldsPolicyResponse, error) {
+	return &txtypes.UnknownFieldsPolicyResponse{
+		RejectCritical:    true,
+		RejectNonCritical: s.unknownFields.RejectNonCritical,
+	}, nil
+}
+
 // GetTx implements the ServiceServer.GetTx RPC method.
 func (s txServer) GetTx(ctx context.Context, req *txtypes.GetTxRequest) (*txtypes.GetTxResponse, error) {
 	if req == nil {
@@ -247,11 +448,15 @@ func RegisterTxService(
 	qrt gogogrpc.Server,
 	clientCtx client.Context,
 	simulateFn baseAppSimulateFn,
+	simulateBundleFn baseAppSimulateBundleFn,
+	estimateGasFn baseAppEstimateGasFn,
+	traceTxFn baseAppTraceTxFn,
 	interfaceRegistry codectypes.InterfaceRegistry,
+	unknownFields UnknownFieldsPolicy,
 ) {
 	txtypes.RegisterServiceServer(
 		qrt,
-		NewTxServer(clientCtx, simulateFn, interfaceRegistry),
+		NewTxServer(clientCtx, simulateFn, simulateBundleFn, estimateGasFn, traceTxFn, interfaceRegistry, unknownFields),
 	)
 }
 
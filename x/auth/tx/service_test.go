@@ -151,6 +151,185 @@ func (s IntegrationTestSuite) TestSimulateTx_GRPC() {
 	}
 }
 
+func (s IntegrationTestSuite) TestSimulateBundle_GRPC() {
+	val := s.network.Validators[0]
+	txBuilder := s.mkTxBuilder()
+	// Encode the txBuilder to txBytes.
+	txBytes, err := val.ClientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		req       *tx.SimulateBundleRequest
+		expErr    bool
+		expErrMsg string
+	}{
+		{"nil request", nil, true, "request cannot be nil"},
+		{"empty request", &tx.SimulateBundleRequest{}, true, "empty txs bundle is not allowed"},
+		{"empty tx", &tx.SimulateBundleRequest{TxsBytes: [][]byte{txBytes, {}}}, true, "empty txBytes is not allowed; tx 1"},
+		{"valid request", &tx.SimulateBundleRequest{TxsBytes: [][]byte{txBytes}}, false, ""},
+		{"valid request with several txs", &tx.SimulateBundleRequest{TxsBytes: [][]byte{txBytes, txBytes}}, false, ""},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			res, err := s.queryClient.SimulateBundle(context.Background(), tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Len(res.Results, len(tc.req.TxsBytes))
+				s.Require().True(res.Results[0].GetGasInfo().GetGasUsed() > 0)
+			}
+		})
+	}
+}
+
+func (s IntegrationTestSuite) TestEstimateGas_GRPC() {
+	val := s.network.Validators[0]
+	txBuilder := s.mkTxBuilder()
+	txBytes, err := val.ClientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		req       *tx.EstimateGasRequest
+		expErr    bool
+		expErrMsg string
+	}{
+		{"empty request", &tx.EstimateGasRequest{}, true, "empty txBytes is not allowed"},
+		{"invalid tx", &tx.EstimateGasRequest{TxBytes: []byte("invalid")}, true, "invalid tx"},
+		{"valid request", &tx.EstimateGasRequest{TxBytes: txBytes}, false, ""},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			res, err := s.queryClient.EstimateGas(context.Background(), tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().True(res.AnteGasUsed > 0)
+				s.Require().Len(res.MsgsGasUsed, 1)
+				s.Require().Equal(sdk.MsgTypeURL(&banktypes.MsgSend{}), res.MsgsGasUsed[0].MsgTypeUrl)
+				s.Require().True(res.MsgsGasUsed[0].GasUsed > 0)
+				s.Require().Equal(res.GetGasInfo().GetGasUsed(), res.AnteGasUsed+res.MsgsGasUsed[0].GasUsed)
+			}
+		})
+	}
+}
+
+func (s IntegrationTestSuite) TestTraceTx_GRPC() {
+	testCases := []struct {
+		name      string
+		req       *tx.TraceTxRequest
+		expErr    bool
+		expErrMsg string
+	}{
+		{"empty request", &tx.TraceTxRequest{}, true, "tx hash cannot be empty"},
+		{"invalid hash", &tx.TraceTxRequest{Hash: "foo"}, true, "invalid tx hash"},
+		{"unknown hash", &tx.TraceTxRequest{Hash: "deadbeef"}, true, "tx not found: deadbeef"},
+		{"valid request", &tx.TraceTxRequest{Hash: s.txRes.TxHash}, false, ""},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			res, err := s.queryClient.TraceTx(context.Background(), tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(s.txRes.Height, res.Height)
+				s.Require().Equal(uint32(0), res.Code, res.Log)
+				s.Require().Equal(uint64(s.txRes.GasUsed), res.GasInfo.GasUsed)
+				s.Require().True(res.AnteGasUsed > 0)
+				s.Require().Len(res.Msgs, 1)
+				s.Require().Equal(sdk.MsgTypeURL(&banktypes.MsgSend{}), res.Msgs[0].MsgTypeUrl)
+				s.Require().Equal(res.GasInfo.GasUsed, res.AnteGasUsed+res.Msgs[0].GasUsed)
+				s.Require().NotEmpty(res.Msgs[0].Events)
+				s.Require().True(res.Msgs[0].Reads > 0)
+				s.Require().True(res.Msgs[0].Writes > 0)
+			}
+		})
+	}
+}
+
+func (s IntegrationTestSuite) TestTxSigners_GRPC() {
+	val := s.network.Validators[0]
+	txBuilder := s.mkTxBuilder()
+	txBytes, err := val.ClientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		req       *tx.TxSignersRequest
+		expErr    bool
+		expErrMsg string
+	}{
+		{"nil request", nil, true, "request cannot be nil"},
+		{"empty request", &tx.TxSignersRequest{}, true, "empty txBytes is not allowed"},
+		{"invalid tx", &tx.TxSignersRequest{TxBytes: []byte("invalid")}, true, "invalid tx"},
+		{"valid request", &tx.TxSignersRequest{TxBytes: txBytes}, false, ""},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			res, err := s.queryClient.TxSigners(context.Background(), tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal([]string{val.Address.String()}, res.Signers)
+				s.Require().Equal(val.Address.String(), res.FeePayer)
+				s.Require().Empty(res.FeeGranter)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)), res.Fee)
+				s.Require().Equal(testdata.NewTestGasLimit(), res.GasLimit)
+				s.Require().Equal([]string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, res.MsgTypes)
+			}
+		})
+	}
+}
+
+func (s IntegrationTestSuite) TestTxSigners_GRPCGateway() {
+	val := s.network.Validators[0]
+	txBuilder := s.mkTxBuilder()
+	txBytes, err := val.ClientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	req, err := val.ClientCtx.Codec.MarshalJSON(&tx.TxSignersRequest{TxBytes: txBytes})
+	s.Require().NoError(err)
+	res, err := rest.PostRequest(fmt.Sprintf("%s/cosmos/tx/v1beta1/signers", val.APIAddress), "application/json", req)
+	s.Require().NoError(err)
+
+	var result tx.TxSignersResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(res, &result))
+	s.Require().Equal([]string{val.Address.String()}, result.Signers)
+	s.Require().Equal(val.Address.String(), result.FeePayer)
+}
+
+func (s IntegrationTestSuite) TestUnknownFieldsPolicy() {
+	val := s.network.Validators[0]
+
+	res, err := s.queryClient.UnknownFieldsPolicy(context.Background(), &tx.UnknownFieldsPolicyRequest{})
+	s.Require().NoError(err)
+	s.Require().True(res.RejectCritical)
+	s.Require().False(res.RejectNonCritical)
+
+	bz, err := rest.GetRequest(fmt.Sprintf("%s/cosmos/tx/v1beta1/unknown_fields_policy", val.APIAddress))
+	s.Require().NoError(err)
+	var result tx.UnknownFieldsPolicyResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(bz, &result))
+	s.Require().Equal(*res, result)
+}
+
 func (s IntegrationTestSuite) TestSimulateTx_GRPCGateway() {
 	val := s.network.Validators[0]
 	txBuilder := s.mkTxBuilder()
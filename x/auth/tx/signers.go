@@ -0,0 +1,80 @@
+package tx
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// GetSigners returns the signers required by the given tx, without executing
+// it: the distinct signers of its messages, in order of appearance, followed by
+// the fee payer if it is set and not already included. The messages must pass
+// ValidateBasic, as the signers of invalid messages may not be retrievable.
+func GetSigners(tx sdk.Tx) (signers []sdk.AccAddress, err error) {
+	if err := validateMsgs(tx); err != nil {
+		return nil, err
+	}
+
+	defer recoverInvalidAddress(&err)
+
+	if sigTx, ok := tx.(signing.SigVerifiableTx); ok {
+		return sigTx.GetSigners(), nil
+	}
+
+	seen := make(map[string]bool)
+	for _, msg := range tx.GetMsgs() {
+		for _, signer := range msg.GetSigners() {
+			if !seen[signer.String()] {
+				seen[signer.String()] = true
+				signers = append(signers, signer)
+			}
+		}
+	}
+
+	return signers, nil
+}
+
+// GetFeePayer returns the account paying the fee of the given tx, without
+// executing it: the fee payer set in the tx, or else its first signer.
+func GetFeePayer(tx sdk.Tx) (payer sdk.AccAddress, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "tx must be a FeeTx")
+	}
+
+	signers, err := GetSigners(tx)
+	if err != nil {
+		return nil, err
+	}
+	if len(signers) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrNoSignatures, "tx has no signers")
+	}
+
+	defer recoverInvalidAddress(&err)
+	return feeTx.FeePayer(), nil
+}
+
+// feeGranter returns the account granting the fee allowance of the given tx, if
+// any.
+func feeGranter(feeTx sdk.FeeTx) (granter sdk.AccAddress, err error) {
+	defer recoverInvalidAddress(&err)
+	return feeTx.FeeGranter(), nil
+}
+
+func validateMsgs(tx sdk.Tx) error {
+	for i, msg := range tx.GetMsgs() {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid message; message index: %d", i)
+		}
+	}
+
+	return nil
+}
+
+// recoverInvalidAddress turns the panics of the txs' signer getters, which
+// panic on invalid bech32 addresses, into an error.
+func recoverInvalidAddress(err *error) {
+	if r := recover(); r != nil {
+		*err = sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%v", r)
+	}
+}
@@ -0,0 +1,72 @@
+package tx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestGetSignersAndFeePayer(t *testing.T) {
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	_, _, addr3 := testdata.KeyTestPubAddr()
+
+	msgs := []sdk.Msg{testdata.NewTestMsg(addr1, addr2), testdata.NewTestMsg(addr2)}
+
+	cases := map[string]struct {
+		txFeePayer      sdk.AccAddress
+		expectedSigners []sdk.AccAddress
+		expectedPayer   sdk.AccAddress
+	}{
+		"no fee payer specified": {
+			expectedSigners: []sdk.AccAddress{addr1, addr2},
+			expectedPayer:   addr1,
+		},
+		"secondary signer set as fee payer": {
+			txFeePayer:      addr2,
+			expectedSigners: []sdk.AccAddress{addr1, addr2},
+			expectedPayer:   addr2,
+		},
+		"outside signer set as fee payer": {
+			txFeePayer:      addr3,
+			expectedSigners: []sdk.AccAddress{addr1, addr2, addr3},
+			expectedPayer:   addr3,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			txBuilder := newBuilder()
+			require.NoError(t, txBuilder.SetMsgs(msgs...))
+			txBuilder.SetFeePayer(tc.txFeePayer)
+
+			signers, err := GetSigners(txBuilder.GetTx())
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedSigners, signers)
+
+			payer, err := GetFeePayer(txBuilder.GetTx())
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPayer, payer)
+		})
+	}
+}
+
+func TestGetSignersInvalidTx(t *testing.T) {
+	// the signers of the message panic on their invalid address
+	txBuilder := newBuilder()
+	require.NoError(t, txBuilder.SetMsgs(&testdata.TestMsg{Signers: []string{"invalid"}}))
+
+	_, err := GetSigners(txBuilder.GetTx())
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+	_, err = GetFeePayer(txBuilder.GetTx())
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+
+	// a tx without signers has no fee payer
+	txBuilder = newBuilder()
+	_, err = GetFeePayer(txBuilder.GetTx())
+	require.ErrorIs(t, err, sdkerrors.ErrNoSignatures)
+}
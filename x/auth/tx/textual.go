@@ -0,0 +1,151 @@
+package tx
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing/textual"
+)
+
+// signModeTextualHandler defines the SIGN_MODE_TEXTUAL SignModeHandler, whose
+// sign bytes are the TextualSignDoc of the screens of the tx.
+type signModeTextualHandler struct {
+	renderer *textual.Renderer
+}
+
+var _ signing.SignModeHandler = signModeTextualHandler{}
+
+// NewSignModeTextualHandler returns the SIGN_MODE_TEXTUAL SignModeHandler
+// rendering the messages with the given renderer, e.g. with the value
+// renderers of the custom types of an app.
+func NewSignModeTextualHandler(renderer *textual.Renderer) signing.SignModeHandler {
+	return signModeTextualHandler{renderer: renderer}
+}
+
+// DefaultMode implements SignModeHandler.DefaultMode
+func (signModeTextualHandler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_TEXTUAL
+}
+
+// Modes implements SignModeHandler.Modes
+func (signModeTextualHandler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes
+func (h signModeTextualHandler) GetSignBytes(mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_TEXTUAL {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_TEXTUAL, mode)
+	}
+
+	screens, err := TextualScreens(h.renderer, data, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	signDoc := signingtypes.TextualSignDoc{Screens: screens}
+	return signDoc.Marshal()
+}
+
+// TextualScreens returns the SIGN_MODE_TEXTUAL screens of the tx for the
+// signer, with its messages rendered by the renderer. The expert screens end
+// with the hash of the body and auth info bytes of the tx, so that the
+// signature commits to the exact tx even if the other screens omit some of
+// its fields.
+func TextualScreens(renderer *textual.Renderer, data signing.SignerData, tx sdk.Tx) ([]*signingtypes.TextualScreen, error) {
+	protoTx, ok := tx.(*wrapper)
+	if !ok {
+		return nil, fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	}
+
+	screens := []*signingtypes.TextualScreen{
+		{Title: "Chain id", Content: data.ChainID},
+		{Title: "Account number", Content: formatUint(data.AccountNumber)},
+		{Title: "Sequence", Content: formatUint(data.Sequence)},
+	}
+
+	for i, signer := range protoTx.GetSigners() {
+		screens = append(screens, &signingtypes.TextualScreen{
+			Title: fmt.Sprintf("Signer (%d/%d)", i+1, len(protoTx.GetSigners())), Content: signer.String(), Expert: true,
+		})
+	}
+
+	body := protoTx.tx.Body
+	screens = append(screens, &signingtypes.TextualScreen{Content: messagesContent(len(body.Messages))})
+	for i, msg := range body.Messages {
+		msgScreens, err := renderer.RenderMessage(protoTx.GetMsgs()[i], 1)
+		if err != nil {
+			return nil, fmt.Errorf("cannot render message %s: %w", msg.TypeUrl, err)
+		}
+
+		screens = append(screens, &signingtypes.TextualScreen{
+			Title: fmt.Sprintf("Message (%d/%d)", i+1, len(body.Messages)), Content: msg.TypeUrl,
+		})
+		screens = append(screens, msgScreens...)
+	}
+	screens = append(screens, &signingtypes.TextualScreen{Content: "End of Messages"})
+
+	if memo := protoTx.GetMemo(); memo != "" {
+		screens = append(screens, &signingtypes.TextualScreen{Title: "Memo", Content: memo})
+	}
+
+	fees, err := textual.FormatCoins(protoTx.GetFee())
+	if err != nil {
+		return nil, err
+	}
+	screens = append(screens, &signingtypes.TextualScreen{Title: "Fees", Content: fees})
+	if payer := protoTx.tx.AuthInfo.Fee.Payer; payer != "" {
+		screens = append(screens, &signingtypes.TextualScreen{Title: "Fee payer", Content: payer, Expert: true})
+	}
+	if granter := protoTx.tx.AuthInfo.Fee.Granter; granter != "" {
+		screens = append(screens, &signingtypes.TextualScreen{Title: "Fee granter", Content: granter, Expert: true})
+	}
+	screens = append(screens, &signingtypes.TextualScreen{Title: "Gas limit", Content: formatUint(protoTx.GetGas()), Expert: true})
+
+	if height := protoTx.GetTimeoutHeight(); height > 0 {
+		screens = append(screens, &signingtypes.TextualScreen{Title: "Timeout height", Content: formatUint(height), Expert: true})
+	}
+	if body.TimeoutTimestamp != nil {
+		screens = append(screens, &signingtypes.TextualScreen{
+			Title: "Timeout timestamp", Content: textual.FormatTimestamp(*body.TimeoutTimestamp), Expert: true,
+		})
+	}
+	if body.Unordered {
+		screens = append(screens, &signingtypes.TextualScreen{Title: "Unordered", Content: "True", Expert: true})
+	}
+
+	for i, opt := range body.ExtensionOptions {
+		screens = append(screens, &signingtypes.TextualScreen{
+			Title: fmt.Sprintf("Extension option (%d/%d)", i+1, len(body.ExtensionOptions)), Content: opt.TypeUrl, Expert: true,
+		})
+	}
+	for i, opt := range body.NonCriticalExtensionOptions {
+		screens = append(screens, &signingtypes.TextualScreen{
+			Title: fmt.Sprintf("Non-critical extension option (%d/%d)", i+1, len(body.NonCriticalExtensionOptions)), Content: opt.TypeUrl, Expert: true,
+		})
+	}
+
+	hash := sha256.Sum256(append(append([]byte{}, protoTx.getBodyBytes()...), protoTx.getAuthInfoBytes()...))
+	screens = append(screens, &signingtypes.TextualScreen{
+		Title: "Hash of raw bytes", Content: strings.ToUpper(fmt.Sprintf("%x", hash)), Expert: true,
+	})
+
+	return screens, nil
+}
+
+func formatUint(n uint64) string {
+	s, _ := textual.FormatInteger(fmt.Sprint(n))
+	return s
+}
+
+func messagesContent(n int) string {
+	if n == 1 {
+		return "This transaction has 1 Message"
+	}
+
+	return fmt.Sprintf("This transaction has %d Messages", n)
+}
@@ -0,0 +1,79 @@
+package tx
+
+import (
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing/textual"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestTextualModeHandler(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	authz.RegisterInterfaces(interfaceRegistry)
+	txConfig := NewTxConfig(codec.NewProtoCodec(interfaceRegistry), []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL})
+
+	// fixed keys, so that the golden screens are deterministic
+	privKey := secp256k1.GenPrivKeyFromSecret([]byte("textual"))
+	granter := sdk.AccAddress(privKey.PubKey().Address())
+	grantee := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("grantee")).PubKey().Address())
+
+	grant, err := authz.NewMsgGrant(
+		granter, grantee,
+		banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 1500000))),
+		time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC),
+	)
+	require.NoError(t, err)
+	send := banktypes.NewMsgSend(granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("atom", 25), sdk.NewInt64Coin("stake", 1000)))
+
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(grant, send))
+	txBuilder.SetMemo("textual memo")
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 150)))
+	txBuilder.SetGasLimit(200000)
+	txBuilder.SetTimeoutHeight(1000)
+	require.NoError(t, txBuilder.SetSignatures(signingtypes.SignatureV2{
+		PubKey:   privKey.PubKey(),
+		Data:     &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_TEXTUAL},
+		Sequence: 3,
+	}))
+
+	modeHandler := txConfig.SignModeHandler()
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_TEXTUAL, modeHandler.DefaultMode())
+	require.Len(t, modeHandler.Modes(), 1)
+
+	signerData := signing.SignerData{ChainID: "test-chain", AccountNumber: 12345, Sequence: 3}
+	screens, err := TextualScreens(textual.NewRenderer(), signerData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	golden, err := ioutil.ReadFile("testdata/textual.golden")
+	require.NoError(t, err)
+	require.Equal(t, string(golden), textual.FormatScreens(screens))
+
+	// the sign bytes are the sign doc of the screens
+	signBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signerData, txBuilder.GetTx())
+	require.NoError(t, err)
+	var signDoc signingtypes.TextualSignDoc
+	require.NoError(t, signDoc.Unmarshal(signBytes))
+	require.Equal(t, screens, signDoc.Screens)
+
+	// the sign bytes change with any field of the tx, e.g. the fee granter
+	txBuilder.SetFeeGranter(grantee)
+	otherSignBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signerData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.NotEqual(t, signBytes, otherSignBytes)
+
+	_, err = modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT, signerData, txBuilder.GetTx())
+	require.Error(t, err)
+}
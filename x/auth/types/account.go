@@ -129,7 +129,7 @@ func (acc BaseAccount) Validate() error {
 		return err
 	}
 
-	if !bytes.Equal(acc.GetPubKey().Address().Bytes(), accAddr.Bytes()) {
+	if !bytes.Equal(sdk.AccAddressFromPubKey(acc.GetPubKey()).Bytes(), accAddr.Bytes()) {
 		return errors.New("account address and pubkey address do not match")
 	}
 
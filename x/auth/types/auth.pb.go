@@ -6,6 +6,8 @@ package types
 import (
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/regen-network/cosmos-proto"
@@ -108,12 +110,22 @@ var xxx_messageInfo_ModuleAccount proto.InternalMessageInfo
 
 // Params defines the parameters for the auth module.
 type Params struct {
-	MaxMemoCharacters      uint64 `protobuf:"varint,1,opt,name=max_memo_characters,json=maxMemoCharacters,proto3" json:"max_memo_characters,omitempty" yaml:"max_memo_characters"`
-	TxSigLimit             uint64 `protobuf:"varint,2,opt,name=tx_sig_limit,json=txSigLimit,proto3" json:"tx_sig_limit,omitempty" yaml:"tx_sig_limit"`
-	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty" yaml:"tx_size_cost_per_byte"`
-	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty" yaml:"sig_verify_cost_ed25519"`
-	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty" yaml:"sig_verify_cost_secp256k1"`
-	SigVerifyCostSm2       uint64 `protobuf:"varint,6,opt,name=sig_verify_cost_sm2,json=sigVerifyCostSm2,proto3" json:"sig_verify_cost_sm2,omitempty" yaml:"sig_verify_cost_sm2"`
+	MaxMemoCharacters uint64 `protobuf:"varint,1,opt,name=max_memo_characters,json=maxMemoCharacters,proto3" json:"max_memo_characters,omitempty" yaml:"max_memo_characters"`
+	TxSigLimit        uint64 `protobuf:"varint,2,opt,name=tx_sig_limit,json=txSigLimit,proto3" json:"tx_sig_limit,omitempty" yaml:"tx_sig_limit"`
+	TxSizeCostPerByte uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty" yaml:"tx_size_cost_per_byte"`
+	// sig_verify_costs are the gas costs of verifying a signature, per type URL
+	// of the public key verifying it, sorted by type URL.
+	SigVerifyCosts []SigVerifyCost `protobuf:"bytes,7,rep,name=sig_verify_costs,json=sigVerifyCosts,proto3" json:"sig_verify_costs" yaml:"sig_verify_costs"`
+	// memo_regex is the regular expression the memos of the txs must match, in
+	// addition to the max_memo_characters limit. Empty allows any memo.
+	MemoRegex string `protobuf:"bytes,8,opt,name=memo_regex,json=memoRegex,proto3" json:"memo_regex,omitempty" yaml:"memo_regex"`
+	// pub_key_change_cost is the gas surcharge of rotating the public key of an
+	// account with MsgChangePubKey.
+	PubKeyChangeCost uint64 `protobuf:"varint,9,opt,name=pub_key_change_cost,json=pubKeyChangeCost,proto3" json:"pub_key_change_cost,omitempty" yaml:"pub_key_change_cost"`
+	// min_gas_prices are the chain-wide minimum gas prices, enforced in both
+	// CheckTx and DeliverTx: the fees of the txs must meet them in one of their
+	// denoms. Empty disables them.
+	MinGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,10,rep,name=min_gas_prices,json=minGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"min_gas_prices" yaml:"min_gas_prices"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -169,23 +181,84 @@ func (m *Params) GetTxSizeCostPerByte() uint64 {
 	return 0
 }
 
-func (m *Params) GetSigVerifyCostED25519() uint64 {
+func (m *Params) GetSigVerifyCosts() []SigVerifyCost {
 	if m != nil {
-		return m.SigVerifyCostED25519
+		return m.SigVerifyCosts
 	}
-	return 0
+	return nil
 }
 
-func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
+func (m *Params) GetMemoRegex() string {
 	if m != nil {
-		return m.SigVerifyCostSecp256k1
+		return m.MemoRegex
+	}
+	return ""
+}
+
+func (m *Params) GetPubKeyChangeCost() uint64 {
+	if m != nil {
+		return m.PubKeyChangeCost
 	}
 	return 0
 }
 
-func (m *Params) GetSigVerifyCostSm2() uint64 {
+func (m *Params) GetMinGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinGasPrices
+	}
+	return nil
+}
+
+// SigVerifyCost defines the gas cost of verifying a signature of a public key
+// type.
+type SigVerifyCost struct {
+	PubKeyTypeURL string `protobuf:"bytes,1,opt,name=pub_key_type_url,json=pubKeyTypeUrl,proto3" json:"pub_key_type_url,omitempty" yaml:"pub_key_type_url"`
+	Cost          uint64 `protobuf:"varint,2,opt,name=cost,proto3" json:"cost,omitempty"`
+}
+
+func (m *SigVerifyCost) Reset()         { *m = SigVerifyCost{} }
+func (m *SigVerifyCost) String() string { return proto.CompactTextString(m) }
+func (*SigVerifyCost) ProtoMessage()    {}
+func (*SigVerifyCost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{3}
+}
+func (m *SigVerifyCost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigVerifyCost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigVerifyCost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigVerifyCost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigVerifyCost.Merge(m, src)
+}
+func (m *SigVerifyCost) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigVerifyCost) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigVerifyCost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigVerifyCost proto.InternalMessageInfo
+
+func (m *SigVerifyCost) GetPubKeyTypeURL() string {
+	if m != nil {
+		return m.PubKeyTypeURL
+	}
+	return ""
+}
+
+func (m *SigVerifyCost) GetCost() uint64 {
 	if m != nil {
-		return m.SigVerifyCostSm2
+		return m.Cost
 	}
 	return 0
 }
@@ -194,56 +267,65 @@ func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
 	proto.RegisterType((*Params)(nil), "cosmos.auth.v1beta1.Params")
+	proto.RegisterType((*SigVerifyCost)(nil), "cosmos.auth.v1beta1.SigVerifyCost")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 701 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x4f, 0x4f, 0xdb, 0x48,
-	0x14, 0x8f, 0x97, 0x6c, 0x80, 0x09, 0x20, 0x70, 0x02, 0x38, 0xd9, 0x95, 0x6d, 0xf9, 0x94, 0x95,
-	0x36, 0x8e, 0x92, 0x15, 0x2b, 0x91, 0x43, 0x55, 0x4c, 0x7b, 0x40, 0x2d, 0x08, 0x19, 0xa9, 0x87,
-	0xaa, 0x92, 0x3b, 0x76, 0x06, 0x63, 0x91, 0xc9, 0x18, 0xcf, 0x18, 0xc5, 0x7c, 0x82, 0x1e, 0x7b,
-	0xec, 0x91, 0x0f, 0xc1, 0x27, 0x68, 0x2f, 0x3d, 0x22, 0x4e, 0x3d, 0x59, 0x55, 0xb8, 0x54, 0x3d,
-	0xe6, 0x5e, 0xa9, 0xca, 0x8c, 0x13, 0x12, 0x9a, 0x9e, 0x3c, 0xef, 0xfd, 0xfe, 0xbc, 0x37, 0xef,
-	0xc9, 0x03, 0x54, 0x8f, 0x50, 0x4c, 0x68, 0x03, 0xc6, 0xec, 0xac, 0x71, 0xd9, 0x74, 0x11, 0x83,
-	0x4d, 0x1e, 0x98, 0x61, 0x44, 0x18, 0x91, 0x4b, 0x02, 0x37, 0x79, 0x2a, 0xc3, 0xab, 0x15, 0x91,
-	0x74, 0x38, 0xa5, 0x91, 0x31, 0x78, 0x50, 0x2d, 0xfb, 0xc4, 0x27, 0x22, 0x3f, 0x3a, 0x65, 0xd9,
-	0x8a, 0x4f, 0x88, 0xdf, 0x45, 0x0d, 0x1e, 0xb9, 0xf1, 0x69, 0x03, 0xf6, 0x12, 0x01, 0x19, 0x3f,
-	0x24, 0x50, 0xb4, 0x20, 0x45, 0x7b, 0x9e, 0x47, 0xe2, 0x1e, 0x93, 0x15, 0xb0, 0x08, 0x3b, 0x9d,
-	0x08, 0x51, 0xaa, 0x48, 0xba, 0x54, 0x5b, 0xb6, 0xc7, 0xa1, 0xfc, 0x06, 0x2c, 0x86, 0xb1, 0xeb,
-	0x9c, 0xa3, 0x44, 0xf9, 0x43, 0x97, 0x6a, 0xc5, 0x56, 0xd9, 0x14, 0xb6, 0xe6, 0xd8, 0xd6, 0xdc,
-	0xeb, 0x25, 0x56, 0xfd, 0x7b, 0xaa, 0x95, 0xc3, 0xd8, 0xed, 0x06, 0xde, 0x88, 0xfb, 0x2f, 0xc1,
-	0x01, 0x43, 0x38, 0x64, 0xc9, 0x30, 0xd5, 0x36, 0x12, 0x88, 0xbb, 0x6d, 0xe3, 0x01, 0x35, 0xec,
-	0x42, 0x18, 0xbb, 0x2f, 0x50, 0x22, 0x3f, 0x05, 0x6b, 0x50, 0xb4, 0xe0, 0xf4, 0x62, 0xec, 0xa2,
-	0x48, 0x59, 0xd0, 0xa5, 0x5a, 0xde, 0xaa, 0x0c, 0x53, 0x6d, 0x53, 0xc8, 0x66, 0x71, 0xc3, 0x5e,
-	0xcd, 0x12, 0x47, 0x3c, 0x96, 0xab, 0x60, 0x89, 0xa2, 0x8b, 0x18, 0xf5, 0x3c, 0xa4, 0xe4, 0x47,
-	0x5a, 0x7b, 0x12, 0xb7, 0x95, 0x77, 0xd7, 0x5a, 0xee, 0xc3, 0xb5, 0x96, 0xfb, 0x76, 0xad, 0xe5,
-	0xee, 0x6e, 0xea, 0x4b, 0xd9, 0x75, 0x0f, 0x8c, 0x4f, 0x12, 0x58, 0x3d, 0x24, 0x9d, 0xb8, 0x3b,
-	0x99, 0xc0, 0x5b, 0xb0, 0xe2, 0x42, 0x8a, 0x9c, 0xcc, 0x9d, 0x8f, 0xa1, 0xd8, 0xd2, 0xcd, 0x39,
-	0x9b, 0x30, 0xa7, 0x26, 0x67, 0xfd, 0x75, 0x9b, 0x6a, 0xd2, 0x30, 0xd5, 0x4a, 0xa2, 0xdb, 0x69,
-	0x0f, 0xc3, 0x2e, 0xba, 0x53, 0x33, 0x96, 0x41, 0xbe, 0x07, 0x31, 0xe2, 0x63, 0x5c, 0xb6, 0xf9,
-	0x59, 0xd6, 0x41, 0x31, 0x44, 0x11, 0x0e, 0x28, 0x0d, 0x48, 0x8f, 0x2a, 0x0b, 0xfa, 0x42, 0x6d,
-	0xd9, 0x9e, 0x4e, 0xb5, 0xab, 0xe3, 0x3b, 0xdc, 0xdd, 0xd4, 0xd7, 0x66, 0x5a, 0x3e, 0x30, 0x3e,
-	0xe6, 0x41, 0xe1, 0x18, 0x46, 0x10, 0x53, 0xf9, 0x08, 0x94, 0x30, 0xec, 0x3b, 0x18, 0x61, 0xe2,
-	0x78, 0x67, 0x30, 0x82, 0x1e, 0x43, 0x91, 0x58, 0x66, 0xde, 0x52, 0x87, 0xa9, 0x56, 0x15, 0xfd,
-	0xcd, 0x21, 0x19, 0xf6, 0x06, 0x86, 0xfd, 0x43, 0x84, 0xc9, 0xfe, 0x24, 0x27, 0xef, 0x82, 0x15,
-	0xd6, 0x77, 0x68, 0xe0, 0x3b, 0xdd, 0x00, 0x07, 0x8c, 0x37, 0x9d, 0xb7, 0xb6, 0x1f, 0x2e, 0x3a,
-	0x8d, 0x1a, 0x36, 0x60, 0xfd, 0x93, 0xc0, 0x7f, 0x39, 0x0a, 0x64, 0x1b, 0x6c, 0x72, 0xf0, 0x0a,
-	0x39, 0x1e, 0xa1, 0xcc, 0x09, 0x51, 0xe4, 0xb8, 0x09, 0x43, 0xd9, 0x6a, 0xf5, 0x61, 0xaa, 0xfd,
-	0x3d, 0xe5, 0xf1, 0x98, 0x66, 0xd8, 0x1b, 0x23, 0xb3, 0x2b, 0xb4, 0x4f, 0x28, 0x3b, 0x46, 0x91,
-	0x95, 0x30, 0x24, 0x5f, 0x80, 0xed, 0x51, 0xb5, 0x4b, 0x14, 0x05, 0xa7, 0x89, 0xe0, 0xa3, 0x4e,
-	0x6b, 0x67, 0xa7, 0xb9, 0x2b, 0x96, 0x6e, 0xb5, 0x07, 0xa9, 0x56, 0x3e, 0x09, 0xfc, 0x57, 0x9c,
-	0x31, 0x92, 0x3e, 0x7f, 0xc6, 0xf1, 0x61, 0xaa, 0xa9, 0xa2, 0xda, 0x6f, 0x0c, 0x0c, 0xbb, 0x4c,
-	0x67, 0x74, 0x22, 0x2d, 0x27, 0xa0, 0xf2, 0x58, 0x41, 0x91, 0x17, 0xb6, 0x76, 0xfe, 0x3f, 0x6f,
-	0x2a, 0x7f, 0xf2, 0xa2, 0x4f, 0x06, 0xa9, 0xb6, 0x35, 0x53, 0xf4, 0x64, 0xcc, 0x18, 0xa6, 0x9a,
-	0x3e, 0xbf, 0xec, 0xc4, 0xc4, 0xb0, 0xb7, 0xe8, 0x5c, 0xad, 0x0c, 0x41, 0xe9, 0x17, 0x15, 0x6e,
-	0x29, 0x05, 0x5e, 0xb4, 0x35, 0x48, 0xb5, 0xf5, 0xd9, 0xa2, 0xb8, 0xf5, 0xb0, 0xe0, 0x39, 0x42,
-	0xc3, 0x5e, 0xa7, 0x8f, 0xf8, 0xed, 0xa5, 0xec, 0xb7, 0x90, 0xac, 0xfd, 0xcf, 0x03, 0x55, 0xba,
-	0x1d, 0xa8, 0xd2, 0xd7, 0x81, 0x2a, 0xbd, 0xbf, 0x57, 0x73, 0xb7, 0xf7, 0x6a, 0xee, 0xcb, 0xbd,
-	0x9a, 0x7b, 0xfd, 0x8f, 0x1f, 0xb0, 0xb3, 0xd8, 0x35, 0x3d, 0x82, 0xb3, 0xe7, 0x26, 0xfb, 0xd4,
-	0x69, 0xe7, 0xbc, 0xd1, 0x17, 0xaf, 0x17, 0x4b, 0x42, 0x44, 0xdd, 0x02, 0x7f, 0x0c, 0xfe, 0xfb,
-	0x19, 0x00, 0x00, 0xff, 0xff, 0x67, 0x49, 0x4f, 0xd2, 0xd9, 0x04, 0x00, 0x00,
+	// 828 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xbf, 0x6f, 0xdb, 0x46,
+	0x14, 0x16, 0x6b, 0x5a, 0x96, 0x4e, 0x96, 0x21, 0xd3, 0x36, 0x42, 0xab, 0x81, 0x28, 0xdc, 0xa4,
+	0xa2, 0x31, 0x85, 0xb8, 0x5d, 0xaa, 0xa9, 0xa1, 0x0a, 0x14, 0x71, 0xed, 0xc0, 0xb8, 0x34, 0x1d,
+	0x8a, 0x02, 0xec, 0x91, 0xbe, 0xd0, 0x87, 0xe8, 0x78, 0x2c, 0xef, 0x18, 0x88, 0x99, 0x3a, 0x16,
+	0x9d, 0x32, 0x76, 0xf4, 0xdc, 0x39, 0xff, 0x41, 0x97, 0x8c, 0x86, 0xa7, 0x4e, 0x6c, 0x61, 0x2f,
+	0x45, 0x47, 0xed, 0x05, 0x8a, 0x3b, 0x52, 0x3f, 0x6c, 0x18, 0x99, 0x78, 0xef, 0xbd, 0xef, 0xde,
+	0xbd, 0xef, 0x7b, 0x7c, 0x0f, 0xf4, 0x42, 0x2e, 0x18, 0x17, 0x43, 0x9c, 0xc9, 0xf3, 0xe1, 0xeb,
+	0xc7, 0x01, 0x91, 0xf8, 0xb1, 0x36, 0xdc, 0x24, 0xe5, 0x92, 0x5b, 0x3b, 0x65, 0xdc, 0xd5, 0xae,
+	0x2a, 0xde, 0xdd, 0x2f, 0x9d, 0xbe, 0x86, 0x0c, 0x2b, 0x84, 0x36, 0xba, 0xbb, 0x11, 0x8f, 0x78,
+	0xe9, 0x57, 0xa7, 0xca, 0xbb, 0x1f, 0x71, 0x1e, 0x4d, 0xc8, 0x50, 0x5b, 0x41, 0xf6, 0x72, 0x88,
+	0xe3, 0xbc, 0x0a, 0xcd, 0x0b, 0x08, 0xb0, 0x20, 0x8b, 0x02, 0x42, 0x4e, 0xe3, 0x32, 0x0e, 0xff,
+	0x33, 0x40, 0xcb, 0xc3, 0x82, 0x3c, 0x09, 0x43, 0x9e, 0xc5, 0xd2, 0xb2, 0xc1, 0x06, 0x3e, 0x3b,
+	0x4b, 0x89, 0x10, 0xb6, 0xd1, 0x37, 0x06, 0x4d, 0x34, 0x37, 0xad, 0x1f, 0xc0, 0x46, 0x92, 0x05,
+	0xfe, 0x2b, 0x92, 0xdb, 0x1f, 0xf5, 0x8d, 0x41, 0xeb, 0x70, 0xd7, 0x2d, 0x9f, 0x75, 0xe7, 0xcf,
+	0xba, 0x4f, 0xe2, 0xdc, 0x3b, 0xf8, 0xb7, 0x70, 0x76, 0x93, 0x2c, 0x98, 0xd0, 0x50, 0x61, 0x1f,
+	0x71, 0x46, 0x25, 0x61, 0x89, 0xcc, 0x67, 0x85, 0xb3, 0x9d, 0x63, 0x36, 0x19, 0xc1, 0x65, 0x14,
+	0xa2, 0x7a, 0x92, 0x05, 0xdf, 0x90, 0xdc, 0xfa, 0x12, 0x6c, 0xe1, 0xb2, 0x04, 0x3f, 0xce, 0x58,
+	0x40, 0x52, 0x7b, 0xad, 0x6f, 0x0c, 0x4c, 0x6f, 0x7f, 0x56, 0x38, 0x7b, 0xe5, 0xb5, 0xdb, 0x71,
+	0x88, 0xda, 0x95, 0xe3, 0x99, 0xb6, 0xad, 0x2e, 0x68, 0x08, 0xf2, 0x53, 0x46, 0xe2, 0x90, 0xd8,
+	0xa6, 0xba, 0x8b, 0x16, 0xf6, 0xc8, 0xfe, 0xe5, 0xc2, 0xa9, 0xfd, 0x76, 0xe1, 0xd4, 0xfe, 0xb9,
+	0x70, 0x6a, 0x57, 0xef, 0x0e, 0x1a, 0x15, 0xdd, 0xa7, 0xf0, 0x0f, 0x03, 0xb4, 0x4f, 0xf8, 0x59,
+	0x36, 0x59, 0x28, 0xf0, 0x23, 0xd8, 0x54, 0x62, 0xf9, 0x55, 0x76, 0x2d, 0x43, 0xeb, 0xb0, 0xef,
+	0xde, 0xd3, 0x29, 0x77, 0x45, 0x39, 0xef, 0xe3, 0xcb, 0xc2, 0x31, 0x66, 0x85, 0xb3, 0x53, 0x56,
+	0xbb, 0x9a, 0x03, 0xa2, 0x56, 0xb0, 0xa2, 0xb1, 0x05, 0xcc, 0x18, 0x33, 0xa2, 0x65, 0x6c, 0x22,
+	0x7d, 0xb6, 0xfa, 0xa0, 0x95, 0x90, 0x94, 0x51, 0x21, 0x28, 0x8f, 0x85, 0xbd, 0xd6, 0x5f, 0x1b,
+	0x34, 0xd1, 0xaa, 0x6b, 0xd4, 0x9d, 0x73, 0xb8, 0x7a, 0x77, 0xb0, 0x75, 0xab, 0xe4, 0xa7, 0xf0,
+	0xd7, 0x75, 0x50, 0x3f, 0xc5, 0x29, 0x66, 0xc2, 0x7a, 0x06, 0x76, 0x18, 0x9e, 0xfa, 0x8c, 0x30,
+	0xee, 0x87, 0xe7, 0x38, 0xc5, 0xa1, 0x24, 0x69, 0xd9, 0x4c, 0xd3, 0xeb, 0xcd, 0x0a, 0xa7, 0x5b,
+	0xd6, 0x77, 0x0f, 0x08, 0xa2, 0x6d, 0x86, 0xa7, 0x27, 0x84, 0xf1, 0xf1, 0xc2, 0x67, 0x7d, 0x01,
+	0x36, 0xe5, 0xd4, 0x17, 0x34, 0xf2, 0x27, 0x94, 0x51, 0xa9, 0x8b, 0x36, 0xbd, 0x07, 0x4b, 0xa2,
+	0xab, 0x51, 0x88, 0x80, 0x9c, 0x3e, 0xa7, 0xd1, 0xb1, 0x32, 0x2c, 0x04, 0xf6, 0x74, 0xf0, 0x0d,
+	0xf1, 0x43, 0x2e, 0xa4, 0x9f, 0x90, 0xd4, 0x0f, 0x72, 0x49, 0xaa, 0xd6, 0xf6, 0x67, 0x85, 0xf3,
+	0x70, 0x25, 0xc7, 0x5d, 0x18, 0x44, 0xdb, 0x2a, 0xd9, 0x1b, 0x32, 0xe6, 0x42, 0x9e, 0x92, 0xd4,
+	0xcb, 0x25, 0xb1, 0x18, 0xe8, 0xa8, 0xd7, 0x5e, 0x93, 0x94, 0xbe, 0xcc, 0x35, 0x5e, 0xd8, 0x1b,
+	0xfd, 0xb5, 0x41, 0xeb, 0x10, 0xde, 0xdb, 0xa1, 0xe7, 0x34, 0xfa, 0x4e, 0x63, 0x55, 0x12, 0xcf,
+	0x79, 0x5f, 0x38, 0xb5, 0x59, 0xe1, 0x3c, 0x28, 0x9f, 0xbd, 0x9b, 0x09, 0xa2, 0x2d, 0xb1, 0x8a,
+	0x17, 0xd6, 0xe7, 0x00, 0x68, 0x91, 0x52, 0x12, 0x91, 0xa9, 0xdd, 0x50, 0x0d, 0xf3, 0xf6, 0x96,
+	0x7f, 0xf2, 0x32, 0x06, 0x51, 0x53, 0x19, 0x48, 0x9d, 0xad, 0x13, 0xb0, 0x53, 0x8d, 0x8a, 0x52,
+	0x37, 0x8e, 0x4a, 0x62, 0x76, 0xf3, 0x6e, 0x0f, 0xee, 0x01, 0x41, 0xd4, 0x29, 0x27, 0x62, 0xac,
+	0x7d, 0xaa, 0x0a, 0xeb, 0xad, 0x01, 0xb6, 0x18, 0x8d, 0xfd, 0x08, 0xab, 0x9d, 0x40, 0x43, 0x22,
+	0x6c, 0xa0, 0x29, 0x3f, 0x9c, 0x53, 0x56, 0x7f, 0xd7, 0x82, 0xf2, 0x57, 0x24, 0x1c, 0x73, 0x1a,
+	0x7b, 0xc7, 0x15, 0xd9, 0x6a, 0x7c, 0x6e, 0x67, 0x80, 0xbf, 0xff, 0xe5, 0x7c, 0x1a, 0x51, 0x79,
+	0x9e, 0x05, 0x6e, 0xc8, 0x59, 0xb5, 0x65, 0xaa, 0xcf, 0x81, 0x38, 0x7b, 0x35, 0x94, 0x79, 0x42,
+	0xc4, 0x3c, 0x99, 0x40, 0x9b, 0x8c, 0xc6, 0x5f, 0x63, 0x71, 0xaa, 0x6f, 0x8f, 0x1a, 0xd5, 0x30,
+	0x19, 0x47, 0x66, 0xc3, 0xec, 0xac, 0x1f, 0x99, 0x8d, 0xf5, 0x4e, 0xfd, 0xc8, 0x6c, 0xd4, 0x3b,
+	0x1b, 0xf0, 0x67, 0x03, 0xb4, 0x6f, 0xc9, 0x6e, 0xbd, 0x00, 0x9d, 0x39, 0x55, 0x95, 0xd5, 0xcf,
+	0xd2, 0x49, 0xb9, 0x5d, 0xbc, 0x47, 0xd7, 0x85, 0xd3, 0x3e, 0xd5, 0x84, 0xbf, 0xcd, 0x13, 0xf2,
+	0x02, 0x1d, 0x2f, 0xbb, 0x73, 0xf7, 0x0a, 0x44, 0xed, 0x64, 0x89, 0x4c, 0x27, 0x6a, 0x8e, 0xb4,
+	0xae, 0xfa, 0x97, 0x44, 0xfa, 0x3c, 0x32, 0x55, 0x51, 0xde, 0xf8, 0xfd, 0x75, 0xcf, 0xb8, 0xbc,
+	0xee, 0x19, 0x7f, 0x5f, 0xf7, 0x8c, 0xb7, 0x37, 0xbd, 0xda, 0xe5, 0x4d, 0xaf, 0xf6, 0xe7, 0x4d,
+	0xaf, 0xf6, 0xfd, 0x27, 0x1f, 0xe4, 0x3c, 0x2d, 0x17, 0xb5, 0xa6, 0x1e, 0xd4, 0xf5, 0x5e, 0xfb,
+	0xec, 0xff, 0x01, 0x00, 0x23, 0xf4, 0x89, 0xd2, 0xc4, 0x05, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -274,13 +356,53 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.TxSizeCostPerByte != that1.TxSizeCostPerByte {
 		return false
 	}
-	if this.SigVerifyCostED25519 != that1.SigVerifyCostED25519 {
+	if len(this.SigVerifyCosts) != len(that1.SigVerifyCosts) {
 		return false
 	}
-	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
+	for i := range this.SigVerifyCosts {
+		if !this.SigVerifyCosts[i].Equal(&that1.SigVerifyCosts[i]) {
+			return false
+		}
+	}
+	if this.MemoRegex != that1.MemoRegex {
 		return false
 	}
-	if this.SigVerifyCostSm2 != that1.SigVerifyCostSm2 {
+	if this.PubKeyChangeCost != that1.PubKeyChangeCost {
+		return false
+	}
+	if len(this.MinGasPrices) != len(that1.MinGasPrices) {
+		return false
+	}
+	for i := range this.MinGasPrices {
+		if !this.MinGasPrices[i].Equal(&that1.MinGasPrices[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SigVerifyCost) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SigVerifyCost)
+	if !ok {
+		that2, ok := that.(SigVerifyCost)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PubKeyTypeURL != that1.PubKeyTypeURL {
+		return false
+	}
+	if this.Cost != that1.Cost {
 		return false
 	}
 	return true
@@ -408,20 +530,45 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.SigVerifyCostSm2 != 0 {
-		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSm2))
-		i--
-		dAtA[i] = 0x30
+	if len(m.MinGasPrices) > 0 {
+		for iNdEx := len(m.MinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	if m.SigVerifyCostSecp256k1 != 0 {
-		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
+	if m.PubKeyChangeCost != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.PubKeyChangeCost))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x48
 	}
-	if m.SigVerifyCostED25519 != 0 {
-		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostED25519))
+	if len(m.MemoRegex) > 0 {
+		i -= len(m.MemoRegex)
+		copy(dAtA[i:], m.MemoRegex)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.MemoRegex)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x42
+	}
+	if len(m.SigVerifyCosts) > 0 {
+		for iNdEx := len(m.SigVerifyCosts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigVerifyCosts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
 	if m.TxSizeCostPerByte != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.TxSizeCostPerByte))
@@ -441,6 +588,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SigVerifyCost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigVerifyCost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigVerifyCost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cost != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Cost))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PubKeyTypeURL) > 0 {
+		i -= len(m.PubKeyTypeURL)
+		copy(dAtA[i:], m.PubKeyTypeURL)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.PubKeyTypeURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuth(v)
 	base := offset
@@ -513,14 +695,40 @@ func (m *Params) Size() (n int) {
 	if m.TxSizeCostPerByte != 0 {
 		n += 1 + sovAuth(uint64(m.TxSizeCostPerByte))
 	}
-	if m.SigVerifyCostED25519 != 0 {
-		n += 1 + sovAuth(uint64(m.SigVerifyCostED25519))
+	if len(m.SigVerifyCosts) > 0 {
+		for _, e := range m.SigVerifyCosts {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
 	}
-	if m.SigVerifyCostSecp256k1 != 0 {
-		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
+	l = len(m.MemoRegex)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.PubKeyChangeCost != 0 {
+		n += 1 + sovAuth(uint64(m.PubKeyChangeCost))
+	}
+	if len(m.MinGasPrices) > 0 {
+		for _, e := range m.MinGasPrices {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SigVerifyCost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKeyTypeURL)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
 	}
-	if m.SigVerifyCostSm2 != 0 {
-		n += 1 + sovAuth(uint64(m.SigVerifyCostSm2))
+	if m.Cost != 0 {
+		n += 1 + sovAuth(uint64(m.Cost))
 	}
 	return n
 }
@@ -923,11 +1131,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostED25519", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCosts", wireType)
 			}
-			m.SigVerifyCostED25519 = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -937,16 +1145,82 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SigVerifyCostED25519 |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigVerifyCosts = append(m.SigVerifyCosts, SigVerifyCost{})
+			if err := m.SigVerifyCosts[len(m.SigVerifyCosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoRegex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemoRegex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSecp256k1", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyChangeCost", wireType)
+			}
+			m.PubKeyChangeCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PubKeyChangeCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.SigVerifyCostSecp256k1 = 0
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrices", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -956,16 +1230,113 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SigVerifyCostSecp256k1 |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGasPrices = append(m.MinGasPrices, types1.DecCoin{})
+			if err := m.MinGasPrices[len(m.MinGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigVerifyCost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigVerifyCost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigVerifyCost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyTypeURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeyTypeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSm2", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
 			}
-			m.SigVerifyCostSm2 = 0
+			m.Cost = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -975,7 +1346,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SigVerifyCostSm2 |= uint64(b&0x7F) << shift
+				m.Cost |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// SignBytesFunc returns the bytes signed by a signer of a tx in the given sign
+// mode.
+type SignBytesFunc func(mode signing.SignMode) ([]byte, error)
+
+// Authenticator is a custom verification routine of the signatures of the
+// accounts, e.g. a multisig with rotating keys or a smart-account module. The
+// routines are registered by name in the AccountKeeper, and the accounts using
+// one of them are authenticated by it in place of their pubkey.
+//
+// Authenticate returns an error unless the signature of the account, which
+// signed the given sign bytes, is valid. It consumes the gas of the
+// verification from the gas meter of the context. Like pubkey verification, it
+// is not run in simulation mode, where the txs come with no signatures.
+type Authenticator interface {
+	Authenticate(ctx sdk.Context, acc AccountI, sig signing.SignatureV2, signBytes SignBytesFunc, tx sdk.Tx) error
+}
+
+// AuthenticatorFunc is a function implementing Authenticator.
+type AuthenticatorFunc func(ctx sdk.Context, acc AccountI, sig signing.SignatureV2, signBytes SignBytesFunc, tx sdk.Tx) error
+
+// Authenticate implements Authenticator.
+func (f AuthenticatorFunc) Authenticate(ctx sdk.Context, acc AccountI, sig signing.SignatureV2, signBytes SignBytesFunc, tx sdk.Tx) error {
+	return f(ctx, acc, sig, signBytes, tx)
+}
+
+// NewAccountAuthenticator returns the AccountAuthenticator of the account.
+//nolint:interfacer
+func NewAccountAuthenticator(addr sdk.AccAddress, authenticator string) AccountAuthenticator {
+	return AccountAuthenticator{
+		Address:       addr.String(),
+		Authenticator: authenticator,
+	}
+}
+
+// Validate performs a stateless validation of the AccountAuthenticator.
+func (a AccountAuthenticator) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Address); err != nil {
+		return err
+	}
+	if a.Authenticator == "" {
+		return ErrUnknownAuthenticator.Wrapf("empty authenticator name for account %s", a.Address)
+	}
+
+	return nil
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/auth module sentinel errors
+var (
+	ErrUnknownModuleAccount    = sdkerrors.Register(ModuleName, 2, "unknown module account")
+	ErrInvalidModulePermission = sdkerrors.Register(ModuleName, 3, "invalid module account permission")
+	ErrUnknownAuthenticator    = sdkerrors.Register(ModuleName, 4, "unknown authenticator")
+	ErrBlockedAddress          = sdkerrors.Register(ModuleName, 5, "blocked address")
+)
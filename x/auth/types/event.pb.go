@@ -0,0 +1,550 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/auth/v1beta1/event.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventFeeDeducted is emitted when the fees of a tx are deducted.
+type EventFeeDeducted struct {
+	// fee_payer is the address of the fee payer of the tx, i.e. its first signer
+	// unless it sets a payer.
+	FeePayer string `protobuf:"bytes,1,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty" yaml:"fee_payer"`
+	// fee_granter is the address of the account which granted the fees to the
+	// fee payer, and which they are deducted from, if any.
+	FeeGranter string `protobuf:"bytes,2,opt,name=fee_granter,json=feeGranter,proto3" json:"fee_granter,omitempty" yaml:"fee_granter"`
+	// fee is the fee amount of the tx.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// gas_wanted is the gas limit of the tx.
+	GasWanted uint64 `protobuf:"varint,4,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty" yaml:"gas_wanted"`
+	// gas_prices are the gas prices the tx pays, i.e. its fees divided by its gas
+	// limit.
+	GasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,5,rep,name=gas_prices,json=gasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"gas_prices" yaml:"gas_prices"`
+}
+
+func (m *EventFeeDeducted) Reset()         { *m = EventFeeDeducted{} }
+func (m *EventFeeDeducted) String() string { return proto.CompactTextString(m) }
+func (*EventFeeDeducted) ProtoMessage()    {}
+func (*EventFeeDeducted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_def33b6de17ecbc8, []int{0}
+}
+func (m *EventFeeDeducted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFeeDeducted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeeDeducted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFeeDeducted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeeDeducted.Merge(m, src)
+}
+func (m *EventFeeDeducted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFeeDeducted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeeDeducted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeeDeducted proto.InternalMessageInfo
+
+func (m *EventFeeDeducted) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
+	}
+	return ""
+}
+
+func (m *EventFeeDeducted) GetFeeGranter() string {
+	if m != nil {
+		return m.FeeGranter
+	}
+	return ""
+}
+
+func (m *EventFeeDeducted) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func (m *EventFeeDeducted) GetGasWanted() uint64 {
+	if m != nil {
+		return m.GasWanted
+	}
+	return 0
+}
+
+func (m *EventFeeDeducted) GetGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.GasPrices
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EventFeeDeducted)(nil), "cosmos.auth.v1beta1.EventFeeDeducted")
+}
+
+func init() { proto.RegisterFile("cosmos/auth/v1beta1/event.proto", fileDescriptor_def33b6de17ecbc8) }
+
+var fileDescriptor_def33b6de17ecbc8 = []byte{
+	// 383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x8a, 0x9b, 0x40,
+	0x1c, 0xc7, 0xb5, 0xa6, 0xa5, 0x99, 0x5c, 0x52, 0x9b, 0x16, 0x1b, 0x8a, 0x06, 0x4f, 0x96, 0x52,
+	0x6d, 0xda, 0x42, 0xa1, 0x47, 0x93, 0xfe, 0x39, 0x06, 0x2f, 0x85, 0x42, 0x09, 0xa3, 0xfe, 0x34,
+	0xb2, 0x1b, 0x47, 0x9c, 0x49, 0x76, 0xf3, 0x00, 0x7b, 0xdf, 0xfb, 0xbe, 0x41, 0x9e, 0x24, 0xc7,
+	0x1c, 0xf7, 0x64, 0x96, 0xe4, 0x0d, 0xf2, 0x04, 0xcb, 0xcc, 0xb8, 0x09, 0x0b, 0xcb, 0xb2, 0x27,
+	0x7f, 0xfa, 0xfd, 0xe3, 0xe7, 0xc7, 0x0c, 0xb2, 0x22, 0x42, 0xa7, 0x84, 0x7a, 0x78, 0xc6, 0x26,
+	0xde, 0xbc, 0x1f, 0x02, 0xc3, 0x7d, 0x0f, 0xe6, 0x90, 0x33, 0xb7, 0x28, 0x09, 0x23, 0xfa, 0x6b,
+	0x69, 0x70, 0xb9, 0xc1, 0xad, 0x0d, 0xdd, 0x4e, 0x4a, 0x52, 0x22, 0x74, 0x8f, 0x4f, 0xd2, 0xda,
+	0x35, 0xeb, 0xae, 0x10, 0x53, 0x38, 0x74, 0x45, 0x24, 0xcb, 0xa5, 0x6e, 0x5f, 0x69, 0xa8, 0xfd,
+	0x93, 0x57, 0xff, 0x02, 0x18, 0x42, 0x3c, 0x8b, 0x18, 0xc4, 0x7a, 0x1f, 0x35, 0x13, 0x80, 0x71,
+	0x81, 0x17, 0x50, 0x1a, 0x6a, 0x4f, 0x75, 0x9a, 0x7e, 0x67, 0x5f, 0x59, 0xed, 0x05, 0x9e, 0x9e,
+	0xfe, 0xb0, 0x0f, 0x92, 0x1d, 0xbc, 0x4c, 0x00, 0x46, 0x7c, 0xd4, 0xbf, 0xa3, 0x16, 0xff, 0x9e,
+	0x96, 0x38, 0x67, 0x50, 0x1a, 0xcf, 0x44, 0xe8, 0xed, 0xbe, 0xb2, 0xf4, 0x63, 0xa8, 0x16, 0xed,
+	0x00, 0x25, 0x00, 0xbf, 0xe5, 0x8b, 0xfe, 0x1f, 0x69, 0x09, 0x80, 0xa1, 0xf5, 0x34, 0xa7, 0xf5,
+	0xe5, 0x9d, 0x5b, 0x6f, 0xc6, 0x71, 0xef, 0x36, 0x73, 0x07, 0x24, 0xcb, 0xfd, 0xcf, 0xab, 0xca,
+	0x52, 0x96, 0x1b, 0xcb, 0x49, 0x33, 0x36, 0x99, 0x85, 0x6e, 0x44, 0xa6, 0x5e, 0xbd, 0x9b, 0x7c,
+	0x7c, 0xa2, 0xf1, 0x89, 0xc7, 0x16, 0x05, 0x50, 0x11, 0xa0, 0x01, 0xef, 0xd5, 0xbf, 0x21, 0x94,
+	0x62, 0x3a, 0x3e, 0xe3, 0x3f, 0x8b, 0x8d, 0x46, 0x4f, 0x75, 0x1a, 0xfe, 0x9b, 0x7d, 0x65, 0xbd,
+	0x92, 0x58, 0x47, 0xcd, 0x0e, 0x9a, 0x29, 0xa6, 0x7f, 0xc5, 0xac, 0x5f, 0xa8, 0x32, 0x56, 0x94,
+	0x59, 0x04, 0xd4, 0x78, 0x2e, 0xe0, 0xde, 0x3f, 0x08, 0x37, 0x84, 0x48, 0xf0, 0xfd, 0xe1, 0x7c,
+	0xf7, 0x8b, 0x65, 0xda, 0x5e, 0x6e, 0xac, 0x8f, 0x4f, 0x80, 0xae, 0x8b, 0xa8, 0xe0, 0x18, 0x89,
+	0xa8, 0x3f, 0x58, 0x6d, 0x4d, 0x75, 0xbd, 0x35, 0xd5, 0x9b, 0xad, 0xa9, 0x5e, 0xee, 0x4c, 0x65,
+	0xbd, 0x33, 0x95, 0xeb, 0x9d, 0xa9, 0xfc, 0xfb, 0xf0, 0x68, 0xe3, 0xb9, 0xbc, 0x3b, 0xa2, 0x38,
+	0x7c, 0x21, 0x4e, 0xfa, 0xeb, 0xed, 0x00, 0x4f, 0xfa, 0x31, 0xcc, 0x57, 0x02, 0x00, 0x00,
+}
+
+func (m *EventFeeDeducted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeeDeducted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeeDeducted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GasPrices) > 0 {
+		for iNdEx := len(m.GasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.GasWanted != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.GasWanted))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FeeGranter) > 0 {
+		i -= len(m.FeeGranter)
+		copy(dAtA[i:], m.FeeGranter)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeeGranter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventFeeDeducted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.FeeGranter)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if m.GasWanted != 0 {
+		n += 1 + sovEvent(uint64(m.GasWanted))
+	}
+	if len(m.GasPrices) > 0 {
+		for _, e := range m.GasPrices {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventFeeDeducted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFeeDeducted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFeeDeducted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGranter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeGranter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
+			}
+			m.GasWanted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasWanted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPrices = append(m.GasPrices, types.DecCoin{})
+			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvent(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvent
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvent
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvent
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvent        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvent          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvent = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,10 @@
+package types
+
+// auth module events
+const (
+	EventTypeChangePubKey     = "change_pub_key"
+	EventTypeSetAuthenticator = "set_authenticator"
+
+	AttributeKeyAddress       = "address"
+	AttributeKeyAuthenticator = "authenticator"
+)
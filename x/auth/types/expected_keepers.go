@@ -8,3 +8,9 @@ import (
 type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 }
+
+// BalanceKeeper defines the contract needed to report the balances of the
+// module accounts (noalias)
+type BalanceKeeper interface {
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
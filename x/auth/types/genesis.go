@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
@@ -70,6 +71,28 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	seenAuthenticators := make(map[string]bool)
+	for _, a := range data.AccountAuthenticators {
+		if err := a.Validate(); err != nil {
+			return err
+		}
+		if seenAuthenticators[a.Address] {
+			return fmt.Errorf("duplicate authenticator found in genesis state; address: %s", a.Address)
+		}
+		seenAuthenticators[a.Address] = true
+	}
+
+	seenBlocked := make(map[string]bool)
+	for _, a := range data.BlockedAddresses {
+		if _, err := sdk.AccAddressFromBech32(a); err != nil {
+			return fmt.Errorf("invalid blocked address %s: %w", a, err)
+		}
+		if seenBlocked[a] {
+			return fmt.Errorf("duplicate blocked address found in genesis state; address: %s", a)
+		}
+		seenBlocked[a] = true
+	}
+
 	return ValidateGenAccounts(genAccs)
 }
 
@@ -30,6 +30,12 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// accounts are the accounts present at genesis.
 	Accounts []*types.Any `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// account_authenticators are the custom authenticators of the accounts
+	// present at genesis.
+	AccountAuthenticators []AccountAuthenticator `protobuf:"bytes,3,rep,name=account_authenticators,json=accountAuthenticators,proto3" json:"account_authenticators" yaml:"account_authenticators"`
+	// blocked_addresses are the addresses of the governance blocklist at
+	// genesis.
+	BlockedAddresses []string `protobuf:"bytes,4,rep,name=blocked_addresses,json=blockedAddresses,proto3" json:"blocked_addresses,omitempty" yaml:"blocked_addresses"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,30 +85,107 @@ func (m *GenesisState) GetAccounts() []*types.Any {
 	return nil
 }
 
+func (m *GenesisState) GetAccountAuthenticators() []AccountAuthenticator {
+	if m != nil {
+		return m.AccountAuthenticators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlockedAddresses() []string {
+	if m != nil {
+		return m.BlockedAddresses
+	}
+	return nil
+}
+
+// AccountAuthenticator defines the name of the custom authenticator verifying
+// the signatures of an account in place of its pubkey.
+type AccountAuthenticator struct {
+	Address       string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Authenticator string `protobuf:"bytes,2,opt,name=authenticator,proto3" json:"authenticator,omitempty"`
+}
+
+func (m *AccountAuthenticator) Reset()         { *m = AccountAuthenticator{} }
+func (m *AccountAuthenticator) String() string { return proto.CompactTextString(m) }
+func (*AccountAuthenticator) ProtoMessage()    {}
+func (*AccountAuthenticator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d897ccbce9822332, []int{1}
+}
+func (m *AccountAuthenticator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountAuthenticator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountAuthenticator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountAuthenticator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountAuthenticator.Merge(m, src)
+}
+func (m *AccountAuthenticator) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountAuthenticator) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountAuthenticator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountAuthenticator proto.InternalMessageInfo
+
+func (m *AccountAuthenticator) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountAuthenticator) GetAuthenticator() string {
+	if m != nil {
+		return m.Authenticator
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.auth.v1beta1.GenesisState")
+	proto.RegisterType((*AccountAuthenticator)(nil), "cosmos.auth.v1beta1.AccountAuthenticator")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/genesis.proto", fileDescriptor_d897ccbce9822332) }
 
 var fileDescriptor_d897ccbce9822332 = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5,
-	0x07, 0x2b, 0x49, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xa8, 0x97, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8, 0x1c, 0x36, 0x8b, 0xc0, 0x46, 0x82, 0xe5, 0x95,
-	0xaa, 0xb9, 0x78, 0xdc, 0x21, 0xd6, 0x06, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x59, 0x72, 0xb1, 0x15,
-	0x24, 0x16, 0x25, 0xe6, 0x16, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x49, 0xeb, 0x61, 0x71,
-	0x86, 0x5e, 0x00, 0x58, 0x89, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0x0d, 0x42, 0x06,
-	0x5c, 0x1c, 0x89, 0xc9, 0xc9, 0xf9, 0xa5, 0x79, 0x25, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc,
-	0x46, 0x22, 0x7a, 0x10, 0xe7, 0xea, 0xc1, 0x9c, 0xab, 0xe7, 0x98, 0x57, 0x19, 0x04, 0x57, 0xe5,
-	0xe4, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78,
-	0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x9a, 0xe9, 0x99, 0x25,
-	0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0x1f, 0x40, 0x28, 0xdd, 0xe2, 0x94, 0x6c,
-	0xfd, 0x0a, 0x88, 0x77, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x86, 0x1b, 0x03, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0xac, 0xe2, 0xe7, 0xb6, 0x53, 0x01, 0x00, 0x00,
+	// 378 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xc1, 0x4e, 0xe2, 0x40,
+	0x1c, 0xc6, 0x5b, 0x20, 0xec, 0x32, 0xec, 0x26, 0xbb, 0x5d, 0xd6, 0x54, 0xd4, 0x82, 0x8d, 0x26,
+	0x70, 0x70, 0x46, 0xf0, 0xa4, 0xb7, 0xd6, 0x83, 0xf1, 0x66, 0x6a, 0xe2, 0xc1, 0x0b, 0x99, 0xb6,
+	0x63, 0x21, 0xd0, 0x0e, 0xe9, 0x4c, 0x8d, 0x7d, 0x02, 0xaf, 0x3e, 0x16, 0x47, 0x8e, 0x9e, 0x88,
+	0x81, 0x37, 0xe0, 0x09, 0x4c, 0x67, 0x0a, 0x91, 0xd8, 0x53, 0x67, 0xbe, 0xf9, 0x7d, 0xdf, 0xff,
+	0x4b, 0xff, 0xe0, 0xd8, 0xa3, 0x2c, 0xa4, 0x0c, 0xe1, 0x84, 0x0f, 0xd1, 0x73, 0xcf, 0x25, 0x1c,
+	0xf7, 0x50, 0x40, 0x22, 0xc2, 0x46, 0x0c, 0x4e, 0x63, 0xca, 0xa9, 0xf6, 0x4f, 0x22, 0x30, 0x43,
+	0x60, 0x8e, 0x34, 0xf7, 0x03, 0x4a, 0x83, 0x09, 0x41, 0x02, 0x71, 0x93, 0x27, 0x84, 0xa3, 0x54,
+	0xf2, 0xcd, 0x46, 0x40, 0x03, 0x2a, 0x8e, 0x28, 0x3b, 0xe5, 0xaa, 0x51, 0x34, 0x48, 0x44, 0x8a,
+	0x77, 0x73, 0x5e, 0x02, 0xbf, 0x6e, 0xe4, 0xdc, 0x7b, 0x8e, 0x39, 0xd1, 0x2e, 0x41, 0x75, 0x8a,
+	0x63, 0x1c, 0x32, 0x5d, 0x6d, 0xab, 0x9d, 0x7a, 0xff, 0x00, 0x16, 0xf4, 0x80, 0x77, 0x02, 0xb1,
+	0x2b, 0xb3, 0x45, 0x4b, 0x71, 0x72, 0x83, 0x76, 0x0e, 0x7e, 0x62, 0xcf, 0xa3, 0x49, 0xc4, 0x99,
+	0x5e, 0x6a, 0x97, 0x3b, 0xf5, 0x7e, 0x03, 0xca, 0xbe, 0x70, 0xd3, 0x17, 0x5a, 0x51, 0xea, 0x6c,
+	0x29, 0xed, 0x55, 0x05, 0x7b, 0xf9, 0x65, 0x90, 0xe5, 0x93, 0x88, 0x8f, 0x3c, 0xcc, 0x69, 0xcc,
+	0xf4, 0xb2, 0x08, 0xe8, 0x16, 0x4e, 0xb7, 0xa4, 0xc5, 0xfa, 0xea, 0xb0, 0x4f, 0xb3, 0x2e, 0xeb,
+	0x45, 0xeb, 0x28, 0xc5, 0xe1, 0xe4, 0xca, 0x2c, 0x8e, 0x35, 0x9d, 0xff, 0xb8, 0xc0, 0xcc, 0xb4,
+	0x5b, 0xf0, 0xd7, 0x9d, 0x50, 0x6f, 0x4c, 0xfc, 0x01, 0xf6, 0xfd, 0x98, 0x30, 0x46, 0x98, 0x5e,
+	0x69, 0x97, 0x3b, 0x35, 0xfb, 0x70, 0xbd, 0x68, 0xe9, 0x32, 0xf4, 0x1b, 0x62, 0x3a, 0x7f, 0x72,
+	0xcd, 0xda, 0x4a, 0x0f, 0xa0, 0x51, 0x54, 0x50, 0xd3, 0xc1, 0x8f, 0xdc, 0x27, 0x7e, 0x6d, 0xcd,
+	0xd9, 0x5c, 0xb5, 0x13, 0xf0, 0x7b, 0xa7, 0xa6, 0x5e, 0x12, 0xef, 0xbb, 0xa2, 0x7d, 0x3d, 0x5b,
+	0x1a, 0xea, 0x7c, 0x69, 0xa8, 0x1f, 0x4b, 0x43, 0x7d, 0x5b, 0x19, 0xca, 0x7c, 0x65, 0x28, 0xef,
+	0x2b, 0x43, 0x79, 0xec, 0x06, 0x23, 0x3e, 0x4c, 0x5c, 0xe8, 0xd1, 0x10, 0xe5, 0xfb, 0x96, 0x9f,
+	0x33, 0xe6, 0x8f, 0xd1, 0x8b, 0x5c, 0x3e, 0x4f, 0xa7, 0x84, 0xb9, 0x55, 0xb1, 0x89, 0x8b, 0xcf,
+	0x01, 0x00, 0x0b, 0xb8, 0x63, 0x21, 0x81, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +208,29 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockedAddresses) > 0 {
+		for iNdEx := len(m.BlockedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedAddresses[iNdEx])
+			copy(dAtA[i:], m.BlockedAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BlockedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AccountAuthenticators) > 0 {
+		for iNdEx := len(m.AccountAuthenticators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountAuthenticators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -152,6 +258,43 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AccountAuthenticator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountAuthenticator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountAuthenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authenticator) > 0 {
+		i -= len(m.Authenticator)
+		copy(dAtA[i:], m.Authenticator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Authenticator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -177,6 +320,35 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AccountAuthenticators) > 0 {
+		for _, e := range m.AccountAuthenticators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BlockedAddresses) > 0 {
+		for _, s := range m.BlockedAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccountAuthenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Authenticator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -282,6 +454,186 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAuthenticators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAuthenticators = append(m.AccountAuthenticators, AccountAuthenticator{})
+			if err := m.AccountAuthenticators[len(m.AccountAuthenticators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedAddresses = append(m.BlockedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountAuthenticator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountAuthenticator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountAuthenticator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authenticator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,7 @@
 package types
 
 import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -22,6 +23,18 @@ var (
 	// AddressStoreKeyPrefix prefix for account-by-address store
 	AddressStoreKeyPrefix = []byte{0x01}
 
+	// AuthenticatorStoreKeyPrefix prefix for the custom authenticators of the
+	// accounts, by address
+	AuthenticatorStoreKeyPrefix = []byte{0x02}
+
+	// PubKeyStoreKeyPrefix prefix for the reverse index of the public keys set
+	// on the accounts, by public key address
+	PubKeyStoreKeyPrefix = []byte{0x03}
+
+	// BlocklistStoreKeyPrefix prefix for the addresses of the governance
+	// blocklist
+	BlocklistStoreKeyPrefix = []byte{0x05}
+
 	// param key for global account number
 	GlobalAccountNumberKey = []byte("globalAccountNumber")
 )
@@ -30,3 +43,21 @@ var (
 func AddressStoreKey(addr sdk.AccAddress) []byte {
 	return append(AddressStoreKeyPrefix, addr.Bytes()...)
 }
+
+// AuthenticatorStoreKey turn an address to key used to get the name of the
+// custom authenticator of the account from the store
+func AuthenticatorStoreKey(addr sdk.AccAddress) []byte {
+	return append(AuthenticatorStoreKeyPrefix, addr.Bytes()...)
+}
+
+// PubKeyStoreKey turn a public key to key used to get the address of the
+// account it is set on from the store
+func PubKeyStoreKey(pubKey cryptotypes.PubKey) []byte {
+	return append(PubKeyStoreKeyPrefix, pubKey.Address().Bytes()...)
+}
+
+// BlocklistStoreKey turn an address to key used to record it as blocked in the
+// store
+func BlocklistStoreKey(addr sdk.AccAddress) []byte {
+	return append(BlocklistStoreKeyPrefix, addr.Bytes()...)
+}
@@ -0,0 +1,131 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+)
+
+var (
+	_ sdk.Msg                            = &MsgChangePubKey{}
+	_ legacytx.LegacyMsg                 = &MsgChangePubKey{} // For amino support.
+	_ codectypes.UnpackInterfacesMessage = &MsgChangePubKey{}
+	_ sdk.Msg                            = &MsgSetAuthenticator{}
+	_ legacytx.LegacyMsg                 = &MsgSetAuthenticator{} // For amino support.
+)
+
+// NewMsgChangePubKey creates a new MsgChangePubKey.
+//nolint:interfacer
+func NewMsgChangePubKey(addr sdk.AccAddress, pubKey cryptotypes.PubKey) (*MsgChangePubKey, error) {
+	any, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgChangePubKey{
+		Address: addr.String(),
+		PubKey:  any,
+	}, nil
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgChangePubKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address: %s", err)
+	}
+
+	if _, err := msg.GetPubKey(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "missing public key")
+	}
+
+	return nil
+}
+
+// GetSigners returns the account whose public key is rotated.
+func (msg MsgChangePubKey) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgChangePubKey) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgChangePubKey) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgChangePubKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetPubKey returns the unpacked new public key of the account.
+func (msg MsgChangePubKey) GetPubKey() (cryptotypes.PubKey, error) {
+	if msg.PubKey == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "missing public key")
+	}
+
+	pk, ok := msg.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", msg.PubKey.GetCachedValue())
+	}
+
+	return pk, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgChangePubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.PubKey, &pk)
+}
+
+// NewMsgSetAuthenticator creates a new MsgSetAuthenticator. An empty
+// authenticator name clears the custom authenticator of the account.
+//nolint:interfacer
+func NewMsgSetAuthenticator(addr sdk.AccAddress, authenticator string) *MsgSetAuthenticator {
+	return &MsgSetAuthenticator{
+		Address:       addr.String(),
+		Authenticator: authenticator,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSetAuthenticator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSigners returns the account whose authenticator is set.
+func (msg MsgSetAuthenticator) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgSetAuthenticator) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgSetAuthenticator) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgSetAuthenticator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
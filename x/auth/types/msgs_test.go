@@ -0,0 +1,47 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestMsgChangePubKey(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	pubKey := secp256k1.GenPrivKey().PubKey()
+
+	msg, err := types.NewMsgChangePubKey(addr, pubKey)
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+
+	pk, err := msg.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, pubKey.Equals(pk))
+
+	msg, err = types.NewMsgChangePubKey(nil, pubKey)
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+
+	msg = &types.MsgChangePubKey{Address: addr.String()}
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMsgSetAuthenticator(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	msg := types.NewMsgSetAuthenticator(addr, "smart-account")
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+
+	// an empty authenticator clears the authenticator of the account
+	require.NoError(t, types.NewMsgSetAuthenticator(addr, "").ValidateBasic())
+
+	require.Error(t, types.NewMsgSetAuthenticator(nil, "smart-account").ValidateBasic())
+}
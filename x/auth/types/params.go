@@ -2,9 +2,14 @@ package types
 
 import (
 	"fmt"
+	"regexp"
+	"sort"
 
 	yaml "gopkg.in/yaml.v2"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -16,31 +21,61 @@ const (
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
 	DefaultSigVerifyCostSm2       uint64 = 7850
+	DefaultMemoRegex                     = ""
+	DefaultPubKeyChangeCost       uint64 = 10000
+
+	// DefaultSigVerifyCostSecp256r1 is set by benchmarking the current
+	// implementation:
+	//     BenchmarkSig/secp256k1     4334   277167 ns/op   4128 B/op   79 allocs/op
+	//     BenchmarkSig/secp256r1    10000   108769 ns/op   1672 B/op   33 allocs/op
+	// Based on the results above secp256k1 is 2.7x slower. However we propose to
+	// discount it because we don't compare the cgo implementation of secp256k1,
+	// which is faster.
+	DefaultSigVerifyCostSecp256r1 = DefaultSigVerifyCostSecp256k1 / 2
+)
+
+// Type URLs of the public keys with a default signature verification cost.
+const (
+	PubKeyTypeURLEd25519   = "/cosmos.crypto.ed25519.PubKey"
+	PubKeyTypeURLSecp256k1 = "/cosmos.crypto.secp256k1.PubKey"
+	PubKeyTypeURLSecp256r1 = "/cosmos.crypto.secp256r1.PubKey"
+	PubKeyTypeURLSm2       = "/cosmos.crypto.sm2.PubKey"
 )
 
 // Parameter keys
 var (
-	KeyMaxMemoCharacters      = []byte("MaxMemoCharacters")
-	KeyTxSigLimit             = []byte("TxSigLimit")
-	KeyTxSizeCostPerByte      = []byte("TxSizeCostPerByte")
-	KeySigVerifyCostED25519   = []byte("SigVerifyCostED25519")
-	KeySigVerifyCostSecp256k1 = []byte("SigVerifyCostSecp256k1")
-	KeySigVerifyCostSm2       = []byte("SigVerifyCostSm2")
+	KeyMaxMemoCharacters = []byte("MaxMemoCharacters")
+	KeyTxSigLimit        = []byte("TxSigLimit")
+	KeyTxSizeCostPerByte = []byte("TxSizeCostPerByte")
+	KeySigVerifyCosts    = []byte("SigVerifyCosts")
+	KeyMemoRegex         = []byte("MemoRegex")
+	KeyPubKeyChangeCost  = []byte("PubKeyChangeCost")
+	KeyMinGasPrices      = []byte("MinGasPrices")
 )
 
 var _ paramtypes.ParamSet = &Params{}
 
 // NewParams creates a new Params object
 func NewParams(
-	maxMemoCharacters, txSigLimit, txSizeCostPerByte, sigVerifyCostED25519, sigVerifyCostSecp256k1, sigVerifyCostSm2 uint64,
+	maxMemoCharacters, txSigLimit, txSizeCostPerByte uint64, sigVerifyCosts []SigVerifyCost, memoRegex string,
+	pubKeyChangeCost uint64, minGasPrices sdk.DecCoins,
 ) Params {
 	return Params{
-		MaxMemoCharacters:      maxMemoCharacters,
-		TxSigLimit:             txSigLimit,
-		TxSizeCostPerByte:      txSizeCostPerByte,
-		SigVerifyCostED25519:   sigVerifyCostED25519,
-		SigVerifyCostSecp256k1: sigVerifyCostSecp256k1,
-		SigVerifyCostSm2:       sigVerifyCostSm2,
+		MaxMemoCharacters: maxMemoCharacters,
+		TxSigLimit:        txSigLimit,
+		TxSizeCostPerByte: txSizeCostPerByte,
+		SigVerifyCosts:    sigVerifyCosts,
+		MemoRegex:         memoRegex,
+		PubKeyChangeCost:  pubKeyChangeCost,
+		MinGasPrices:      minGasPrices,
+	}
+}
+
+// NewSigVerifyCost creates a new SigVerifyCost object
+func NewSigVerifyCost(pubKeyTypeURL string, cost uint64) SigVerifyCost {
+	return SigVerifyCost{
+		PubKeyTypeURL: pubKeyTypeURL,
+		Cost:          cost,
 	}
 }
 
@@ -56,32 +91,88 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyMaxMemoCharacters, &p.MaxMemoCharacters, validateMaxMemoCharacters),
 		paramtypes.NewParamSetPair(KeyTxSigLimit, &p.TxSigLimit, validateTxSigLimit),
 		paramtypes.NewParamSetPair(KeyTxSizeCostPerByte, &p.TxSizeCostPerByte, validateTxSizeCostPerByte),
-		paramtypes.NewParamSetPair(KeySigVerifyCostED25519, &p.SigVerifyCostED25519, validateSigVerifyCostED25519),
-		paramtypes.NewParamSetPair(KeySigVerifyCostSecp256k1, &p.SigVerifyCostSecp256k1, validateSigVerifyCostSecp256k1),
-		paramtypes.NewParamSetPair(KeySigVerifyCostSm2, &p.SigVerifyCostSm2, validateSigVerifyCostSm2),
+		paramtypes.NewParamSetPair(KeySigVerifyCosts, &p.SigVerifyCosts, validateSigVerifyCosts),
+		paramtypes.NewParamSetPair(KeyMemoRegex, &p.MemoRegex, validateMemoRegex),
+		paramtypes.NewParamSetPair(KeyPubKeyChangeCost, &p.PubKeyChangeCost, validatePubKeyChangeCost),
+		paramtypes.NewParamSetPair(KeyMinGasPrices, &p.MinGasPrices, validateMinGasPrices),
 	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
 	return Params{
-		MaxMemoCharacters:      DefaultMaxMemoCharacters,
-		TxSigLimit:             DefaultTxSigLimit,
-		TxSizeCostPerByte:      DefaultTxSizeCostPerByte,
-		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
-		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
-		SigVerifyCostSm2:       DefaultSigVerifyCostSm2,
+		MaxMemoCharacters: DefaultMaxMemoCharacters,
+		TxSigLimit:        DefaultTxSigLimit,
+		TxSizeCostPerByte: DefaultTxSizeCostPerByte,
+		SigVerifyCosts:    DefaultSigVerifyCosts(),
+		MemoRegex:         DefaultMemoRegex,
+		PubKeyChangeCost:  DefaultPubKeyChangeCost,
+		MinGasPrices:      DefaultMinGasPrices(),
+	}
+}
+
+// DefaultMinGasPrices returns the default chain-wide minimum gas prices, which
+// are empty: only the local minimum gas prices of the validators apply.
+func DefaultMinGasPrices() sdk.DecCoins {
+	return nil
+}
+
+// DefaultSigVerifyCosts returns the default signature verification costs of
+// the public key types supported by the SDK.
+func DefaultSigVerifyCosts() []SigVerifyCost {
+	return []SigVerifyCost{
+		NewSigVerifyCost(PubKeyTypeURLEd25519, DefaultSigVerifyCostED25519),
+		NewSigVerifyCost(PubKeyTypeURLSecp256k1, DefaultSigVerifyCostSecp256k1),
+		NewSigVerifyCost(PubKeyTypeURLSecp256r1, DefaultSigVerifyCostSecp256r1),
+		NewSigVerifyCost(PubKeyTypeURLSm2, DefaultSigVerifyCostSm2),
 	}
 }
 
-// SigVerifyCostSecp256r1 returns gas fee of secp256r1 signature verification.
-// Set by benchmarking current implementation:
-//     BenchmarkSig/secp256k1     4334   277167 ns/op   4128 B/op   79 allocs/op
-//     BenchmarkSig/secp256r1    10000   108769 ns/op   1672 B/op   33 allocs/op
-// Based on the results above secp256k1 is 2.7x is slwer. However we propose to discount it
-// because we are we don't compare the cgo implementation of secp256k1, which is faster.
-func (p Params) SigVerifyCostSecp256r1() uint64 {
-	return p.SigVerifyCostSecp256k1 / 2
+// SigVerifyCost returns the gas cost of verifying a signature of the public
+// key type with the given type URL, if it has one.
+func (p Params) SigVerifyCost(pubKeyTypeURL string) (uint64, bool) {
+	i := sort.Search(len(p.SigVerifyCosts), func(i int) bool {
+		return p.SigVerifyCosts[i].PubKeyTypeURL >= pubKeyTypeURL
+	})
+	if i == len(p.SigVerifyCosts) || p.SigVerifyCosts[i].PubKeyTypeURL != pubKeyTypeURL {
+		return 0, false
+	}
+
+	return p.SigVerifyCosts[i].Cost, true
+}
+
+// ValidatePubKeyTypes checks that every given public key type URL has a
+// signature verification cost.
+func (p Params) ValidatePubKeyTypes(pubKeyTypeURLs []string) error {
+	for _, typeURL := range pubKeyTypeURLs {
+		if _, ok := p.SigVerifyCost(typeURL); !ok {
+			return fmt.Errorf("missing signature verification cost for public key type %s", typeURL)
+		}
+	}
+
+	return nil
+}
+
+// SigVerifiablePubKeyTypeURLs returns the sorted type URLs of the public key
+// types registered in the given registry whose signatures are verified
+// directly, and thus need a signature verification cost. Multisig public keys
+// are excluded, as verifying them costs the verification of their keys.
+func SigVerifiablePubKeyTypeURLs(registry codectypes.InterfaceRegistry) []string {
+	var typeURLs []string
+	for _, typeURL := range registry.ListImplementations("cosmos.crypto.PubKey") {
+		msg, err := registry.Resolve(typeURL)
+		if err != nil {
+			continue
+		}
+		if _, ok := msg.(multisig.PubKey); ok {
+			continue
+		}
+
+		typeURLs = append(typeURLs, typeURL)
+	}
+
+	sort.Strings(typeURLs)
+	return typeURLs
 }
 
 // String implements the stringer interface.
@@ -103,53 +194,69 @@ func validateTxSigLimit(i interface{}) error {
 	return nil
 }
 
-func validateSigVerifyCostED25519(i interface{}) error {
-	v, ok := i.(uint64)
+func validateSigVerifyCosts(i interface{}) error {
+	v, ok := i.([]SigVerifyCost)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	if v == 0 {
-		return fmt.Errorf("invalid ED25519 signature verification cost: %d", v)
+	for i, c := range v {
+		if c.PubKeyTypeURL == "" {
+			return fmt.Errorf("empty signature verification cost public key type URL")
+		}
+		if i > 0 && c.PubKeyTypeURL <= v[i-1].PubKeyTypeURL {
+			return fmt.Errorf("signature verification costs must be sorted by unique public key type URL: %s", c.PubKeyTypeURL)
+		}
+		if c.Cost == 0 {
+			return fmt.Errorf("invalid %s signature verification cost: %d", c.PubKeyTypeURL, c.Cost)
+		}
 	}
 
 	return nil
 }
 
-func validateSigVerifyCostSecp256k1(i interface{}) error {
+func validateMaxMemoCharacters(i interface{}) error {
 	v, ok := i.(uint64)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
 	if v == 0 {
-		return fmt.Errorf("invalid SECK256k1 signature verification cost: %d", v)
+		return fmt.Errorf("invalid max memo characters: %d", v)
 	}
 
 	return nil
 }
 
-func validateSigVerifyCostSm2(i interface{}) error {
-	v, ok := i.(uint64)
+func validateMemoRegex(i interface{}) error {
+	v, ok := i.(string)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	if v == 0 {
-		return fmt.Errorf("invalid Sm2 signature verification cost: %d", v)
+	if _, err := regexp.Compile(v); err != nil {
+		return fmt.Errorf("invalid memo regex: %s", err)
 	}
 
 	return nil
 }
 
-func validateMaxMemoCharacters(i interface{}) error {
-	v, ok := i.(uint64)
+func validatePubKeyChangeCost(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMinGasPrices(i interface{}) error {
+	v, ok := i.(sdk.DecCoins)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	if v == 0 {
-		return fmt.Errorf("invalid max memo characters: %d", v)
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid min gas prices: %w", err)
 	}
 
 	return nil
@@ -173,19 +280,19 @@ func (p Params) Validate() error {
 	if err := validateTxSigLimit(p.TxSigLimit); err != nil {
 		return err
 	}
-	if err := validateSigVerifyCostED25519(p.SigVerifyCostED25519); err != nil {
+	if err := validateSigVerifyCosts(p.SigVerifyCosts); err != nil {
 		return err
 	}
-	if err := validateSigVerifyCostSecp256k1(p.SigVerifyCostSecp256k1); err != nil {
+	if err := validateMaxMemoCharacters(p.MaxMemoCharacters); err != nil {
 		return err
 	}
-	if err := validateSigVerifyCostSm2(p.SigVerifyCostSm2); err != nil {
+	if err := validateTxSizeCostPerByte(p.TxSizeCostPerByte); err != nil {
 		return err
 	}
-	if err := validateMaxMemoCharacters(p.MaxMemoCharacters); err != nil {
+	if err := validateMemoRegex(p.MemoRegex); err != nil {
 		return err
 	}
-	if err := validateTxSizeCostPerByte(p.TxSizeCostPerByte); err != nil {
+	if err := validateMinGasPrices(p.MinGasPrices); err != nil {
 		return err
 	}
 
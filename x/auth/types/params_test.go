@@ -6,6 +6,9 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -26,15 +29,26 @@ func TestParams_Validate(t *testing.T) {
 	}{
 		{"default params", types.DefaultParams(), nil},
 		{"invalid tx signature limit", types.NewParams(types.DefaultMaxMemoCharacters, 0, types.DefaultTxSizeCostPerByte,
-			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultSigVerifyCostSm2), fmt.Errorf("invalid tx signature limit: 0")},
+			types.DefaultSigVerifyCosts(), types.DefaultMemoRegex, types.DefaultPubKeyChangeCost, types.DefaultMinGasPrices()), fmt.Errorf("invalid tx signature limit: 0")},
 		{"invalid ED25519 signature verification cost", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
-			0, types.DefaultSigVerifyCostSecp256k1, types.DefaultSigVerifyCostSm2), fmt.Errorf("invalid ED25519 signature verification cost: 0")},
-		{"invalid SECK256k1 signature verification cost", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
-			types.DefaultSigVerifyCostED25519, 0, types.DefaultSigVerifyCostSm2), fmt.Errorf("invalid SECK256k1 signature verification cost: 0")},
+			[]types.SigVerifyCost{types.NewSigVerifyCost(types.PubKeyTypeURLEd25519, 0)}, types.DefaultMemoRegex, types.DefaultPubKeyChangeCost, types.DefaultMinGasPrices()), fmt.Errorf("invalid /cosmos.crypto.ed25519.PubKey signature verification cost: 0")},
+		{"empty signature verification cost type URL", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
+			[]types.SigVerifyCost{types.NewSigVerifyCost("", 1)}, types.DefaultMemoRegex, types.DefaultPubKeyChangeCost, types.DefaultMinGasPrices()), fmt.Errorf("empty signature verification cost public key type URL")},
+		{"unsorted signature verification costs", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
+			[]types.SigVerifyCost{types.NewSigVerifyCost(types.PubKeyTypeURLSm2, 1), types.NewSigVerifyCost(types.PubKeyTypeURLSecp256k1, 1)}, types.DefaultMemoRegex, types.DefaultPubKeyChangeCost, types.DefaultMinGasPrices()),
+			fmt.Errorf("signature verification costs must be sorted by unique public key type URL: /cosmos.crypto.secp256k1.PubKey")},
+		{"duplicate signature verification costs", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
+			[]types.SigVerifyCost{types.NewSigVerifyCost(types.PubKeyTypeURLSm2, 1), types.NewSigVerifyCost(types.PubKeyTypeURLSm2, 2)}, types.DefaultMemoRegex, types.DefaultPubKeyChangeCost, types.DefaultMinGasPrices()),
+			fmt.Errorf("signature verification costs must be sorted by unique public key type URL: /cosmos.crypto.sm2.PubKey")},
 		{"invalid max memo characters", types.NewParams(0, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
-			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultSigVerifyCostSm2), fmt.Errorf("invalid max memo characters: 0")},
+			types.DefaultSigVerifyCosts(), types.DefaultMemoRegex, types.DefaultPubKeyChangeCost, types.DefaultMinGasPrices()), fmt.Errorf("invalid max memo characters: 0")},
 		{"invalid tx size cost per byte", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 0,
-			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultSigVerifyCostSm2), fmt.Errorf("invalid tx size cost per byte: 0")},
+			types.DefaultSigVerifyCosts(), types.DefaultMemoRegex, types.DefaultPubKeyChangeCost, types.DefaultMinGasPrices()), fmt.Errorf("invalid tx size cost per byte: 0")},
+		{"invalid memo regex", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
+			types.DefaultSigVerifyCosts(), "(", types.DefaultPubKeyChangeCost, types.DefaultMinGasPrices()), fmt.Errorf("invalid memo regex: error parsing regexp: missing closing ): `(`")},
+		{"invalid min gas prices", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
+			types.DefaultSigVerifyCosts(), types.DefaultMemoRegex, types.DefaultPubKeyChangeCost, sdk.DecCoins{sdk.NewInt64DecCoin("stake", 1), sdk.NewInt64DecCoin("atom", 1)}),
+			fmt.Errorf("invalid min gas prices: %w", fmt.Errorf("denomination atom is not sorted"))},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -48,3 +62,28 @@ func TestParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestParamsSigVerifyCost(t *testing.T) {
+	params := types.DefaultParams()
+
+	cost, ok := params.SigVerifyCost(types.PubKeyTypeURLSecp256k1)
+	require.True(t, ok)
+	require.Equal(t, types.DefaultSigVerifyCostSecp256k1, cost)
+	cost, ok = params.SigVerifyCost(types.PubKeyTypeURLSecp256r1)
+	require.True(t, ok)
+	require.Equal(t, types.DefaultSigVerifyCostSecp256k1/2, cost)
+	_, ok = params.SigVerifyCost("/cosmos.crypto.bls.PubKey")
+	require.False(t, ok)
+
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	typeURLs := types.SigVerifiablePubKeyTypeURLs(registry)
+	require.Equal(t, []string{
+		types.PubKeyTypeURLEd25519, types.PubKeyTypeURLSecp256k1, types.PubKeyTypeURLSecp256r1, types.PubKeyTypeURLSm2,
+	}, typeURLs)
+	require.NoError(t, params.ValidatePubKeyTypes(typeURLs))
+
+	params.SigVerifyCosts = params.SigVerifyCosts[:3]
+	require.EqualError(t, params.ValidatePubKeyTypes(typeURLs),
+		"missing signature verification cost for public key type /cosmos.crypto.sm2.PubKey")
+}
@@ -57,3 +57,11 @@ func validatePermissions(permissions ...string) error {
 	}
 	return nil
 }
+
+// IsGovernablePermission returns whether governance may grant the permission
+// to or revoke it from a registered module account at runtime. The Staking
+// permission is excluded, since the bonded and not bonded pools of the staking
+// module must always hold it.
+func IsGovernablePermission(permission string) bool {
+	return permission == Minter || permission == Burner
+}
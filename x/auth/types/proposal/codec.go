@@ -0,0 +1,21 @@
+package proposal
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// RegisterLegacyAminoCodec registers all necessary auth proposal types with a given LegacyAmino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&ModuleAccountPermissionsProposal{}, "cosmos-sdk/ModuleAccountPermissionsProposal", nil)
+	cdc.RegisterConcrete(&BlocklistProposal{}, "cosmos-sdk/BlocklistProposal", nil)
+}
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&ModuleAccountPermissionsProposal{},
+		&BlocklistProposal{},
+	)
+}
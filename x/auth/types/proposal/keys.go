@@ -0,0 +1,6 @@
+package proposal
+
+const (
+	// RouterKey defines the routing key for the auth proposals
+	RouterKey = "auth"
+)
@@ -0,0 +1,139 @@
+package proposal
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeModuleAccountPermissions defines the type for a ModuleAccountPermissionsProposal
+	ProposalTypeModuleAccountPermissions = "ModuleAccountPermissions"
+	// ProposalTypeBlocklist defines the type for a BlocklistProposal
+	ProposalTypeBlocklist = "Blocklist"
+)
+
+// Assert ModuleAccountPermissionsProposal and BlocklistProposal implement govtypes.Content at compile-time
+var _ govtypes.Content = &ModuleAccountPermissionsProposal{}
+var _ govtypes.Content = &BlocklistProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeModuleAccountPermissions)
+	govtypes.RegisterProposalTypeCodec(&ModuleAccountPermissionsProposal{}, "cosmos-sdk/ModuleAccountPermissionsProposal")
+	govtypes.RegisterProposalType(ProposalTypeBlocklist)
+	govtypes.RegisterProposalTypeCodec(&BlocklistProposal{}, "cosmos-sdk/BlocklistProposal")
+}
+
+func NewModuleAccountPermissionsProposal(title, description, moduleName string, grant, revoke []string) *ModuleAccountPermissionsProposal {
+	return &ModuleAccountPermissionsProposal{title, description, moduleName, grant, revoke}
+}
+
+// GetTitle returns the title of a module account permissions proposal.
+func (p *ModuleAccountPermissionsProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a module account permissions proposal.
+func (p *ModuleAccountPermissionsProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a module account permissions proposal.
+func (p *ModuleAccountPermissionsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a module account permissions proposal.
+func (p *ModuleAccountPermissionsProposal) ProposalType() string {
+	return ProposalTypeModuleAccountPermissions
+}
+
+// ValidateBasic validates the module account permissions proposal. Only
+// governable permissions may be granted or revoked, and a permission cannot
+// be both granted and revoked.
+func (p *ModuleAccountPermissionsProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(p.ModuleName) == "" {
+		return sdkerrors.Wrap(types.ErrUnknownModuleAccount, "module name cannot be blank")
+	}
+
+	if len(p.Grant) == 0 && len(p.Revoke) == 0 {
+		return sdkerrors.Wrap(types.ErrInvalidModulePermission, "no permissions to grant or revoke")
+	}
+
+	seen := make(map[string]bool, len(p.Grant)+len(p.Revoke))
+	for _, perm := range append(append([]string{}, p.Grant...), p.Revoke...) {
+		if !types.IsGovernablePermission(perm) {
+			return sdkerrors.Wrapf(types.ErrInvalidModulePermission, "permission %q cannot be changed by governance", perm)
+		}
+		if seen[perm] {
+			return sdkerrors.Wrapf(types.ErrInvalidModulePermission, "duplicate permission %q", perm)
+		}
+		seen[perm] = true
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p ModuleAccountPermissionsProposal) String() string {
+	return fmt.Sprintf(`Module Account Permissions Proposal:
+  Title:       %s
+  Description: %s
+  Module Name: %s
+  Grant:       %s
+  Revoke:      %s
+`, p.Title, p.Description, p.ModuleName, strings.Join(p.Grant, ", "), strings.Join(p.Revoke, ", "))
+}
+
+func NewBlocklistProposal(title, description string, block, unblock []string) *BlocklistProposal {
+	return &BlocklistProposal{title, description, block, unblock}
+}
+
+// GetTitle returns the title of a blocklist proposal.
+func (p *BlocklistProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a blocklist proposal.
+func (p *BlocklistProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a blocklist proposal.
+func (p *BlocklistProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a blocklist proposal.
+func (p *BlocklistProposal) ProposalType() string { return ProposalTypeBlocklist }
+
+// ValidateBasic validates the blocklist proposal. An address cannot be both
+// blocked and unblocked.
+func (p *BlocklistProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if len(p.Block) == 0 && len(p.Unblock) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no addresses to block or unblock")
+	}
+
+	seen := make(map[string]bool, len(p.Block)+len(p.Unblock))
+	for _, addr := range append(append([]string{}, p.Block...), p.Unblock...) {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address %q: %s", addr, err)
+		}
+		if seen[addr] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate address %s", addr)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p BlocklistProposal) String() string {
+	return fmt.Sprintf(`Blocklist Proposal:
+  Title:       %s
+  Description: %s
+  Block:       %s
+  Unblock:     %s
+`, p.Title, p.Description, strings.Join(p.Block, ", "), strings.Join(p.Unblock, ", "))
+}
@@ -0,0 +1,899 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/auth/v1beta1/proposal.proto
+
+package proposal
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ModuleAccountPermissionsProposal defines a proposal to grant permissions to
+// and revoke permissions from a registered module account.
+type ModuleAccountPermissionsProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ModuleName  string   `protobuf:"bytes,3,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty" yaml:"module_name"`
+	Grant       []string `protobuf:"bytes,4,rep,name=grant,proto3" json:"grant,omitempty"`
+	Revoke      []string `protobuf:"bytes,5,rep,name=revoke,proto3" json:"revoke,omitempty"`
+}
+
+func (m *ModuleAccountPermissionsProposal) Reset()      { *m = ModuleAccountPermissionsProposal{} }
+func (*ModuleAccountPermissionsProposal) ProtoMessage() {}
+func (*ModuleAccountPermissionsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196f795894d42308, []int{0}
+}
+func (m *ModuleAccountPermissionsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleAccountPermissionsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleAccountPermissionsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleAccountPermissionsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleAccountPermissionsProposal.Merge(m, src)
+}
+func (m *ModuleAccountPermissionsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleAccountPermissionsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleAccountPermissionsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleAccountPermissionsProposal proto.InternalMessageInfo
+
+// BlocklistProposal defines a proposal to add addresses to and remove addresses
+// from the blocklist. The blocked addresses cannot sign txs nor receive funds.
+type BlocklistProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Block       []string `protobuf:"bytes,3,rep,name=block,proto3" json:"block,omitempty"`
+	Unblock     []string `protobuf:"bytes,4,rep,name=unblock,proto3" json:"unblock,omitempty"`
+}
+
+func (m *BlocklistProposal) Reset()      { *m = BlocklistProposal{} }
+func (*BlocklistProposal) ProtoMessage() {}
+func (*BlocklistProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196f795894d42308, []int{1}
+}
+func (m *BlocklistProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlocklistProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlocklistProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlocklistProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlocklistProposal.Merge(m, src)
+}
+func (m *BlocklistProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlocklistProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlocklistProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlocklistProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ModuleAccountPermissionsProposal)(nil), "cosmos.auth.v1beta1.ModuleAccountPermissionsProposal")
+	proto.RegisterType((*BlocklistProposal)(nil), "cosmos.auth.v1beta1.BlocklistProposal")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/auth/v1beta1/proposal.proto", fileDescriptor_196f795894d42308)
+}
+
+var fileDescriptor_196f795894d42308 = []byte{
+	// 336 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x91, 0xb1, 0x4e, 0xf3, 0x30,
+	0x14, 0x85, 0xe3, 0xbf, 0x7f, 0x0b, 0xb8, 0x13, 0xa1, 0xaa, 0x2c, 0x06, 0x37, 0xca, 0xc4, 0x42,
+	0xa2, 0xc2, 0x80, 0xd4, 0x8d, 0xee, 0xa0, 0xaa, 0x23, 0x0b, 0x72, 0x52, 0x2b, 0xb5, 0x1a, 0xe7,
+	0x46, 0xb1, 0x53, 0xd1, 0x07, 0x40, 0x62, 0x64, 0x64, 0xec, 0xc8, 0xa3, 0x20, 0xb1, 0x74, 0x64,
+	0x42, 0x28, 0x7d, 0x03, 0x9e, 0x00, 0xc5, 0x0e, 0xd0, 0x9d, 0xc9, 0xfe, 0xee, 0x3d, 0x3a, 0xf7,
+	0x48, 0x07, 0xfb, 0x31, 0x28, 0x09, 0x2a, 0x64, 0xa5, 0x9e, 0x87, 0xcb, 0x61, 0xc4, 0x35, 0x1b,
+	0x86, 0x79, 0x01, 0x39, 0x28, 0x96, 0x06, 0x79, 0x01, 0x1a, 0xdc, 0x23, 0xab, 0x09, 0x6a, 0x4d,
+	0xd0, 0x68, 0x8e, 0x7b, 0x09, 0x24, 0x60, 0xf6, 0x61, 0xfd, 0xb3, 0x52, 0xff, 0x15, 0x61, 0xef,
+	0x0a, 0x66, 0x65, 0xca, 0x2f, 0xe3, 0x18, 0xca, 0x4c, 0x4f, 0x78, 0x21, 0x85, 0x52, 0x02, 0x32,
+	0x35, 0x69, 0x5c, 0xdd, 0x1e, 0x6e, 0x6b, 0xa1, 0x53, 0x4e, 0x90, 0x87, 0x4e, 0x0e, 0xa6, 0x16,
+	0x5c, 0x0f, 0x77, 0x67, 0x5c, 0xc5, 0x85, 0xc8, 0xb5, 0x80, 0x8c, 0xfc, 0x33, 0xbb, 0xdd, 0x91,
+	0x7b, 0x81, 0xbb, 0xd2, 0x78, 0xdf, 0x66, 0x4c, 0x72, 0xd2, 0xaa, 0x15, 0xe3, 0xfe, 0xe7, 0xfb,
+	0xc0, 0x5d, 0x31, 0x99, 0x8e, 0xfc, 0x9d, 0xa5, 0x3f, 0xc5, 0x96, 0xae, 0x99, 0xe4, 0xf5, 0xc1,
+	0xa4, 0x60, 0x99, 0x26, 0xff, 0xbd, 0x56, 0x7d, 0xd0, 0x80, 0xdb, 0xc7, 0x9d, 0x82, 0x2f, 0x61,
+	0xc1, 0x49, 0xdb, 0x8c, 0x1b, 0x1a, 0xed, 0x3f, 0xac, 0x07, 0xce, 0xd3, 0x7a, 0xe0, 0xf8, 0xf7,
+	0x08, 0x1f, 0x8e, 0x53, 0x88, 0x17, 0xa9, 0x50, 0xfa, 0xcf, 0xf1, 0x7b, 0xb8, 0x1d, 0xd5, 0x66,
+	0xa4, 0x65, 0x53, 0x18, 0x70, 0x09, 0xde, 0x2b, 0x33, 0x3b, 0xb7, 0xe9, 0xbe, 0xf1, 0x37, 0xc7,
+	0x78, 0xf2, 0x5c, 0x51, 0xf4, 0x52, 0x51, 0xb4, 0xa9, 0x28, 0xfa, 0xa8, 0x28, 0x7a, 0xdc, 0x52,
+	0x67, 0xb3, 0xa5, 0xce, 0xdb, 0x96, 0x3a, 0x37, 0x67, 0x89, 0xd0, 0xf3, 0x32, 0x0a, 0x62, 0x90,
+	0x61, 0xd3, 0xa6, 0x7d, 0x4e, 0xd5, 0x6c, 0x11, 0xde, 0xd9, 0x6a, 0xf5, 0x2a, 0xe7, 0xea, 0xa7,
+	0xd8, 0xa8, 0x63, 0xea, 0x3a, 0xff, 0x1a, 0x00, 0x2b, 0xf8, 0x9c, 0x7f, 0xff, 0x01, 0x00, 0x00,
+}
+
+func (this *ModuleAccountPermissionsProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ModuleAccountPermissionsProposal)
+	if !ok {
+		that2, ok := that.(ModuleAccountPermissionsProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.ModuleName != that1.ModuleName {
+		return false
+	}
+	if len(this.Grant) != len(that1.Grant) {
+		return false
+	}
+	for i := range this.Grant {
+		if this.Grant[i] != that1.Grant[i] {
+			return false
+		}
+	}
+	if len(this.Revoke) != len(that1.Revoke) {
+		return false
+	}
+	for i := range this.Revoke {
+		if this.Revoke[i] != that1.Revoke[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *BlocklistProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlocklistProposal)
+	if !ok {
+		that2, ok := that.(BlocklistProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.Block) != len(that1.Block) {
+		return false
+	}
+	for i := range this.Block {
+		if this.Block[i] != that1.Block[i] {
+			return false
+		}
+	}
+	if len(this.Unblock) != len(that1.Unblock) {
+		return false
+	}
+	for i := range this.Unblock {
+		if this.Unblock[i] != that1.Unblock[i] {
+			return false
+		}
+	}
+	return true
+}
+func (m *ModuleAccountPermissionsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleAccountPermissionsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleAccountPermissionsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Revoke) > 0 {
+		for iNdEx := len(m.Revoke) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Revoke[iNdEx])
+			copy(dAtA[i:], m.Revoke[iNdEx])
+			i = encodeVarintProposal(dAtA, i, uint64(len(m.Revoke[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Grant) > 0 {
+		for iNdEx := len(m.Grant) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Grant[iNdEx])
+			copy(dAtA[i:], m.Grant[iNdEx])
+			i = encodeVarintProposal(dAtA, i, uint64(len(m.Grant[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlocklistProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlocklistProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlocklistProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unblock) > 0 {
+		for iNdEx := len(m.Unblock) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Unblock[iNdEx])
+			copy(dAtA[i:], m.Unblock[iNdEx])
+			i = encodeVarintProposal(dAtA, i, uint64(len(m.Unblock[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Block) > 0 {
+		for iNdEx := len(m.Block) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Block[iNdEx])
+			copy(dAtA[i:], m.Block[iNdEx])
+			i = encodeVarintProposal(dAtA, i, uint64(len(m.Block[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ModuleAccountPermissionsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Grant) > 0 {
+		for _, s := range m.Grant {
+			l = len(s)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	if len(m.Revoke) > 0 {
+		for _, s := range m.Revoke {
+			l = len(s)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlocklistProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Block) > 0 {
+		for _, s := range m.Block {
+			l = len(s)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	if len(m.Unblock) > 0 {
+		for _, s := range m.Unblock {
+			l = len(s)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposal(x uint64) (n int) {
+	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ModuleAccountPermissionsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleAccountPermissionsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleAccountPermissionsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grant = append(m.Grant, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoke", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revoke = append(m.Revoke, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlocklistProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlocklistProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlocklistProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Block = append(m.Block, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unblock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unblock = append(m.Unblock, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProposal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProposal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProposal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProposal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProposal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProposal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProposal = fmt.Errorf("proto: unexpected end of group")
+)
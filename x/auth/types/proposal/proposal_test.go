@@ -0,0 +1,74 @@
+package proposal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestModuleAccountPermissionsProposal(t *testing.T) {
+	testCases := []struct {
+		name       string
+		moduleName string
+		grant      []string
+		revoke     []string
+		expErr     bool
+	}{
+		{"valid grant", "distribution", []string{types.Minter}, nil, false},
+		{"valid grant and revoke", "distribution", []string{types.Minter}, []string{types.Burner}, false},
+		{"blank module name", " ", []string{types.Minter}, nil, true},
+		{"no permissions", "distribution", nil, nil, true},
+		{"staking permission", "bonded_tokens_pool", nil, []string{types.Staking}, true},
+		{"unknown permission", "distribution", []string{"random"}, nil, true},
+		{"granted and revoked", "distribution", []string{types.Minter}, []string{types.Minter}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := NewModuleAccountPermissionsProposal("title", "description", tc.moduleName, tc.grant, tc.revoke)
+			require.Equal(t, RouterKey, p.ProposalRoute())
+			require.Equal(t, ProposalTypeModuleAccountPermissions, p.ProposalType())
+
+			if tc.expErr {
+				require.Error(t, p.ValidateBasic())
+			} else {
+				require.NoError(t, p.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestBlocklistProposal(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+
+	testCases := []struct {
+		name    string
+		block   []string
+		unblock []string
+		expErr  bool
+	}{
+		{"valid block", []string{addr1}, nil, false},
+		{"valid block and unblock", []string{addr1}, []string{addr2}, false},
+		{"no addresses", nil, nil, true},
+		{"invalid address", []string{"invalid"}, nil, true},
+		{"blocked and unblocked", []string{addr1}, []string{addr1}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := NewBlocklistProposal("title", "description", tc.block, tc.unblock)
+			require.Equal(t, RouterKey, p.ProposalRoute())
+			require.Equal(t, ProposalTypeBlocklist, p.ProposalType())
+
+			if tc.expErr {
+				require.Error(t, p.ValidateBasic())
+			} else {
+				require.NoError(t, p.ValidateBasic())
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package types
 
-import codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
 
 func (m *QueryAccountResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	var account AccountI
@@ -8,3 +11,18 @@ func (m *QueryAccountResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker)
 }
 
 var _ codectypes.UnpackInterfacesMessage = &QueryAccountResponse{}
+
+func (m *QueryAccountByPubKeyRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(m.PubKey, &pubKey)
+}
+
+func (m *QueryAccountByPubKeyResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var account AccountI
+	return unpacker.UnpackAny(m.Account, &account)
+}
+
+var (
+	_ codectypes.UnpackInterfacesMessage = &QueryAccountByPubKeyRequest{}
+	_ codectypes.UnpackInterfacesMessage = &QueryAccountByPubKeyResponse{}
+)
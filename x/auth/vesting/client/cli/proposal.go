@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewCmdSubmitUpdateVestingScheduleProposal implements a command handler for
+// submitting an update vesting schedule proposal transaction.
+func NewCmdSubmitUpdateVestingScheduleProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-vesting-schedule [address] [periods_json_file] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to replace the remaining periods of a periodic vesting account",
+		Long: `Submit a proposal to replace the periods of a periodic vesting account which are
+not over, along with an initial deposit. The new periods of the JSON file start at
+the end of the last completed period, and their coins must sum to the coins still
+vesting. For instance:
+{
+  "periods": [
+    {"coins": "10stake", "length": 2592000},
+    {"coins": "10stake", "length": 2592000}
+  ]
+}`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			_, periods, err := readVestingData(args[1])
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewUpdateVestingScheduleProposal(title, description, addr, periods)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.MarkFlagRequired(govcli.FlagTitle)
+	cmd.MarkFlagRequired(govcli.FlagDescription)
+
+	return cmd
+}
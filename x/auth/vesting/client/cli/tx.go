@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"strconv"
 
 	"github.com/spf13/cobra"
@@ -15,6 +18,7 @@ import (
 // Transaction command flags
 const (
 	FlagDelayed = "delayed"
+	FlagDest    = "dest"
 )
 
 // GetTxCmd returns vesting module's transaction commands.
@@ -29,6 +33,8 @@ func GetTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewMsgCreateVestingAccountCmd(),
+		NewMsgCreateClawbackVestingAccountCmd(),
+		NewMsgClawbackCmd(),
 	)
 
 	return txCmd
@@ -79,3 +85,127 @@ timestamp.`,
 
 	return cmd
 }
+
+// VestingData defines the vesting schedule of a clawback vesting account, as
+// read from a JSON file.
+type VestingData struct {
+	StartTime int64         `json:"start_time"`
+	Periods   []InputPeriod `json:"periods"`
+}
+
+// InputPeriod defines a vesting period of a VestingData.
+type InputPeriod struct {
+	Coins  string `json:"coins"`
+	Length int64  `json:"length"`
+}
+
+// readVestingData reads the VestingData of the given JSON file, and returns it
+// with its parsed periods.
+func readVestingData(path string) (VestingData, []types.Period, error) {
+	var data VestingData
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return data, nil, err
+	}
+
+	if err := json.Unmarshal(contents, &data); err != nil {
+		return data, nil, err
+	}
+
+	periods := make([]types.Period, len(data.Periods))
+	for i, p := range data.Periods {
+		amount, err := sdk.ParseCoinsNormalized(p.Coins)
+		if err != nil {
+			return data, nil, fmt.Errorf("invalid coins of period %d: %w", i, err)
+		}
+
+		periods[i] = types.Period{Length: p.Length, Amount: amount}
+	}
+
+	return data, periods, nil
+}
+
+// NewMsgCreateClawbackVestingAccountCmd returns a CLI command handler for
+// creating a MsgCreateClawbackVestingAccount transaction.
+func NewMsgCreateClawbackVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-vesting-account [to_address] [periods_json_file]",
+		Short: "Create a new clawback vesting account funded with an allocation of tokens.",
+		Long: `Create a new clawback vesting account funded with an allocation of tokens,
+vesting according to the periods of the given JSON file. The sender is the funder
+of the account, who can claw back the coins which are still vesting. The start
+time must be provided as a UNIX epoch timestamp and the lengths of the periods
+in seconds. For instance:
+{
+  "start_time": 1625204910,
+  "periods": [
+    {"coins": "10stake", "length": 2592000},
+    {"coins": "10stake", "length": 2592000}
+  ]
+}`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			data, periods, err := readVestingData(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateClawbackVestingAccount(clientCtx.GetFromAddress(), toAddr, data.StartTime, periods)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgClawbackCmd returns a CLI command handler for creating a MsgClawback
+// transaction.
+func NewMsgClawbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [address]",
+		Short: "Claw back the unvested coins of a clawback vesting account.",
+		Long: `Claw back the coins which are still vesting in a clawback vesting account
+funded by the sender. The coins are sent to the sender, or to the address of the
+'--dest' flag if set. The delegations of the account are transferred if its
+balance does not cover the clawback.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var dest sdk.AccAddress
+			if destStr, _ := cmd.Flags().GetString(FlagDest); destStr != "" {
+				if dest, err = sdk.AccAddressFromBech32(destStr); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgClawback(clientCtx.GetFromAddress(), addr, dest)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDest, "", "Address receiving the clawed back coins, the sender if empty")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
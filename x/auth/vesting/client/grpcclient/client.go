@@ -0,0 +1,60 @@
+// Code generated by typedgen. DO NOT EDIT.
+
+// Package grpcclient implements the typed clients of the vesting module.
+package grpcclient
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/typed"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// MsgClient is the typed client of the vesting Msg service, broadcasting a
+// transaction of each message.
+type MsgClient struct {
+	broadcaster typed.Broadcaster
+}
+
+// NewMsgClient returns a MsgClient broadcasting the transactions with the
+// broadcaster.
+func NewMsgClient(broadcaster typed.Broadcaster) *MsgClient {
+	return &MsgClient{broadcaster: broadcaster}
+}
+
+// Clawback broadcasts a transaction of the vesting Msg/Clawback message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) Clawback(ctx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, *sdk.TxResponse, error) {
+	res := &types.MsgClawbackResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
+
+// CreateClawbackVestingAccount broadcasts a transaction of the vesting Msg/CreateClawbackVestingAccount message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) CreateClawbackVestingAccount(ctx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, *sdk.TxResponse, error) {
+	res := &types.MsgCreateClawbackVestingAccountResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
+
+// CreateVestingAccount broadcasts a transaction of the vesting Msg/CreateVestingAccount message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) CreateVestingAccount(ctx context.Context, msg *types.MsgCreateVestingAccount) (*types.MsgCreateVestingAccountResponse, *sdk.TxResponse, error) {
+	res := &types.MsgCreateVestingAccountResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
+
+// UpdateVestingSchedule broadcasts a transaction of the vesting Msg/UpdateVestingSchedule message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) UpdateVestingSchedule(ctx context.Context, msg *types.MsgUpdateVestingSchedule) (*types.MsgUpdateVestingScheduleResponse, *sdk.TxResponse, error) {
+	res := &types.MsgUpdateVestingScheduleResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
@@ -0,0 +1,61 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// UpdateVestingScheduleProposalReq defines an update vesting schedule proposal
+// request body.
+type UpdateVestingScheduleProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title          string         `json:"title" yaml:"title"`
+	Description    string         `json:"description" yaml:"description"`
+	Address        sdk.AccAddress `json:"address" yaml:"address"`
+	VestingPeriods []types.Period `json:"vesting_periods" yaml:"vesting_periods"`
+	Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit        sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the update
+// vesting schedule REST handler with a given sub-route.
+func ProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update_vesting_schedule",
+		Handler:  postProposalHandlerFn(clientCtx),
+	}
+}
+
+func postProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateVestingScheduleProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewUpdateVestingScheduleProposal(req.Title, req.Description, req.Address, req.VestingPeriods)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
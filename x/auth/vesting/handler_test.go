@@ -2,14 +2,19 @@ package vesting_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 )
 
 type HandlerTestSuite struct {
@@ -23,7 +28,7 @@ func (suite *HandlerTestSuite) SetupTest() {
 	checkTx := false
 	app := simapp.Setup(checkTx)
 
-	suite.handler = vesting.NewHandler(app.AccountKeeper, app.BankKeeper)
+	suite.handler = vesting.NewHandler(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
 	suite.app = app
 }
 
@@ -91,6 +96,140 @@ func (suite *HandlerTestSuite) TestMsgCreateVestingAccount() {
 	}
 }
 
+func (suite *HandlerTestSuite) TestMsgClawback() {
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1, Time: time.Now()})
+	bondDenom := suite.app.StakingKeeper.BondDenom(ctx)
+	stake := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amt)) }
+
+	funder := sdk.AccAddress([]byte("funder______________"))
+	addr := sdk.AccAddress([]byte("addr________________"))
+	operator := sdk.AccAddress([]byte("operator____________"))
+	suite.Require().NoError(simapp.FundAccount(suite.app.BankKeeper, ctx, funder, stake(2000)))
+	suite.Require().NoError(simapp.FundAccount(suite.app.BankKeeper, ctx, operator, stake(1000)))
+
+	// 300 coins are vested and 700 are still vesting
+	periods := []types.Period{{Length: 100, Amount: stake(300)}, {Length: 10000, Amount: stake(700)}}
+	msg := types.NewMsgCreateClawbackVestingAccount(funder, addr, ctx.BlockTime().Unix()-150, periods)
+	_, err := suite.handler(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(stake(1000), suite.app.BankKeeper.GetAllBalances(ctx, addr))
+
+	// stake all the coins of the account, half of which are unbonding
+	valAddr := sdk.ValAddress(operator)
+	sh := teststaking.NewHelper(suite.T(), ctx, suite.app.StakingKeeper)
+	sh.Denom = bondDenom
+	sh.CreateValidator(valAddr, ed25519.GenPrivKey().PubKey(), sdk.NewInt(1000), true)
+	sh.Delegate(addr, valAddr, sdk.NewInt(1000))
+	sh.Undelegate(addr, valAddr, sdk.NewInt(500), true)
+
+	// require only the funder to claw back the account
+	_, err = suite.handler(ctx, types.NewMsgClawback(operator, addr, nil))
+	suite.Require().Error(err)
+	_, err = suite.handler(ctx, types.NewMsgClawback(funder, operator, nil))
+	suite.Require().Error(err)
+
+	res, err := suite.handler(ctx, types.NewMsgClawback(funder, addr, nil))
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	// require the delegation and part of the unbonding delegation to be
+	// transferred to the funder
+	delegation, found := suite.app.StakingKeeper.GetDelegation(ctx, funder, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(500), delegation.Shares)
+	_, found = suite.app.StakingKeeper.GetDelegation(ctx, addr, valAddr)
+	suite.Require().False(found)
+
+	ubd, found := suite.app.StakingKeeper.GetUnbondingDelegation(ctx, funder, valAddr)
+	suite.Require().True(found)
+	suite.Require().Len(ubd.Entries, 1)
+	suite.Require().Equal(sdk.NewInt(200), ubd.Entries[0].Balance)
+	ubd, found = suite.app.StakingKeeper.GetUnbondingDelegation(ctx, addr, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(300), ubd.Entries[0].Balance)
+
+	// require the vested coins which are still unbonding to be tracked as free
+	acc, ok := suite.app.AccountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(stake(300), acc.OriginalVesting)
+	suite.Require().Equal(stake(300), acc.DelegatedFree)
+	suite.Require().Empty(acc.DelegatedVesting)
+
+	// require a new clawback to claw back nothing
+	res, err = suite.handler(ctx, types.NewMsgClawback(funder, addr, nil))
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+	suite.Require().Equal(stake(1000), suite.app.BankKeeper.GetAllBalances(ctx, funder))
+}
+
+func (suite *HandlerTestSuite) TestMsgUpdateVestingSchedule() {
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1, Time: time.Now()})
+	stake := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("stake", amt)) }
+
+	addr := sdk.AccAddress([]byte("addr________________"))
+	startTime := ctx.BlockTime().Unix() - 150
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	periods := types.Periods{{Length: 100, Amount: stake(300)}, {Length: 100, Amount: stake(700)}}
+	suite.app.AccountKeeper.SetAccount(ctx, types.NewPeriodicVestingAccount(bacc, stake(1000), startTime, periods))
+
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName)
+	newPeriods := []types.Period{{Length: 1000, Amount: stake(200)}, {Length: 1000, Amount: stake(500)}}
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgUpdateVestingSchedule
+		expectErr bool
+	}{
+		{"not executed by governance", types.NewMsgUpdateVestingSchedule(addr, addr, newPeriods), true},
+		{"not a periodic vesting account", types.NewMsgUpdateVestingSchedule(gov, gov, newPeriods), true},
+		{"periods not matching the vesting coins", types.NewMsgUpdateVestingSchedule(gov, addr, newPeriods[:1]), true},
+		{"extend the vesting schedule", types.NewMsgUpdateVestingSchedule(gov, addr, newPeriods), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			res, err := suite.handler(ctx, tc.msg)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().NotNil(res)
+
+			acc, ok := suite.app.AccountKeeper.GetAccount(ctx, addr).(*types.PeriodicVestingAccount)
+			suite.Require().True(ok)
+			suite.Require().Equal(append(periods[:1], newPeriods...), acc.GetVestingPeriods())
+			suite.Require().Equal(startTime+2100, acc.GetEndTime())
+		})
+	}
+}
+
+func (suite *HandlerTestSuite) TestUpdateVestingScheduleProposal() {
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1, Time: time.Now()})
+	stake := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("stake", amt)) }
+
+	addr := sdk.AccAddress([]byte("addr________________"))
+	startTime := ctx.BlockTime().Unix() + 100
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	periods := types.Periods{{Length: 100, Amount: stake(1000)}}
+	suite.app.AccountKeeper.SetAccount(ctx, types.NewPeriodicVestingAccount(bacc, stake(1000), startTime, periods))
+
+	// re-slice the schedule, which has not started yet
+	newPeriods := []types.Period{{Length: 50, Amount: stake(500)}, {Length: 50, Amount: stake(500)}}
+	proposal := types.NewUpdateVestingScheduleProposal("title", "description", addr, newPeriods)
+	suite.Require().NoError(proposal.ValidateBasic())
+
+	handler := vesting.NewUpdateVestingScheduleProposalHandler(suite.app.AccountKeeper)
+	suite.Require().NoError(handler(ctx, proposal))
+
+	acc, ok := suite.app.AccountKeeper.GetAccount(ctx, addr).(*types.PeriodicVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(types.Periods(newPeriods), acc.GetVestingPeriods())
+	suite.Require().Equal(startTime+100, acc.GetEndTime())
+}
+
 func TestHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(HandlerTestSuite))
 }
@@ -2,6 +2,8 @@ package vesting
 
 import (
 	"context"
+	"fmt"
+	"math"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -12,17 +14,20 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type msgServer struct {
 	keeper.AccountKeeper
 	types.BankKeeper
+	types.StakingKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
-// wrapping the corresponding AccountKeeper and BankKeeper.
-func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper) types.MsgServer {
-	return &msgServer{AccountKeeper: k, BankKeeper: bk}
+// wrapping the corresponding AccountKeeper, BankKeeper and StakingKeeper.
+func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, StakingKeeper: sk}
 }
 
 var _ types.MsgServer = msgServer{}
@@ -98,3 +103,291 @@ func (s msgServer) CreateVestingAccount(goCtx context.Context, msg *types.MsgCre
 
 	return &types.MsgCreateVestingAccountResponse{}, nil
 }
+
+func (s msgServer) CreateClawbackVestingAccount(goCtx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	periods := types.Periods(msg.VestingPeriods)
+	amount := periods.TotalAmount()
+	if err := bk.IsSendEnabledCoins(ctx, amount...); err != nil {
+		return nil, err
+	}
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if bk.BlockedAddr(to) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	if acc := ak.GetAccount(ctx, to); acc != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	}
+
+	baseAccount := ak.NewAccountWithAddress(ctx, to)
+	if _, ok := baseAccount.(*authtypes.BaseAccount); !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type; expected: BaseAccount, got: %T", baseAccount)
+	}
+
+	acc := types.NewClawbackVestingAccount(baseAccount.(*authtypes.BaseAccount), from, amount, msg.StartTime, periods)
+	ak.SetAccount(ctx, acc)
+
+	defer func() {
+		telemetry.IncrCounter(1, "new", "account")
+
+		for _, a := range amount {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_clawback_vesting_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	err = bk.SendCoins(ctx, from, to, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	dest, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		return nil, err
+	}
+	if msg.DestAddress != "" {
+		if dest, err = sdk.AccAddressFromBech32(msg.DestAddress); err != nil {
+			return nil, err
+		}
+	}
+
+	if bk.BlockedAddr(dest) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", dest)
+	}
+
+	acc, ok := ak.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", msg.Address)
+	}
+
+	if acc.FunderAddress != msg.FunderAddress {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the funder of account %s", msg.FunderAddress, msg.Address)
+	}
+
+	unvested := acc.Clawback(ctx.BlockTime())
+	ak.SetAccount(ctx, acc)
+
+	// The clawback is taken from the balance of the account first, then from
+	// its delegations and unbonding delegations, which are transferred to the
+	// destination, as all its coins are now vested.
+	spendable := bk.SpendableCoins(ctx, addr)
+	fromBalance := sdk.NewCoins()
+	for _, coin := range unvested {
+		if amt := sdk.MinInt(coin.Amount, spendable.AmountOf(coin.Denom)); amt.IsPositive() {
+			fromBalance = fromBalance.Add(sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+
+	if err := bk.SendCoins(ctx, addr, dest, fromBalance); err != nil {
+		return nil, err
+	}
+
+	bondDenom := s.StakingKeeper.BondDenom(ctx)
+	remaining := unvested.Sub(fromBalance).AmountOf(bondDenom)
+	transferred, err := s.transferDelegations(ctx, addr, dest, remaining)
+	if err != nil {
+		return nil, err
+	}
+	transferred = transferred.Add(s.transferUnbondingDelegations(ctx, addr, dest, remaining.Sub(transferred)))
+
+	if transferred.IsPositive() {
+		// the transferred delegations are not tracked by the account anymore
+		acc = ak.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+		acc.TrackUndelegation(sdk.NewCoins(sdk.NewCoin(bondDenom, transferred)))
+		ak.SetAccount(ctx, acc)
+	}
+
+	clawedBack := fromBalance.Add(sdk.NewCoin(bondDenom, transferred))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClawback,
+			sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyDestination, dest.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, clawedBack.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgClawbackResponse{Amount: clawedBack}, nil
+}
+
+func (s msgServer) UpdateVestingSchedule(goCtx context.Context, msg *types.MsgUpdateVestingSchedule) (*types.MsgUpdateVestingScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if authority := authtypes.NewModuleAddress(govtypes.ModuleName).String(); msg.Authority != authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", authority, msg.Authority)
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := updateVestingSchedule(ctx, s.AccountKeeper, addr, msg.VestingPeriods); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateVestingScheduleResponse{}, nil
+}
+
+// updateVestingSchedule replaces the remaining periods of the periodic vesting
+// account at addr.
+func updateVestingSchedule(ctx sdk.Context, ak keeper.AccountKeeper, addr sdk.AccAddress, periods []types.Period) error {
+	acc, ok := ak.GetAccount(ctx, addr).(*types.PeriodicVestingAccount)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a periodic vesting account", addr)
+	}
+
+	if err := acc.UpdateRemainingPeriods(ctx.BlockTime(), periods); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ak.SetAccount(ctx, acc)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateVestingSchedule,
+			sdk.NewAttribute(types.AttributeKeyAccount, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", acc.EndTime)),
+		),
+	)
+
+	return nil
+}
+
+// transferDelegations transfers up to amount bonded tokens of the delegations of
+// addr to dest, keeping their validators, and returns the transferred amount.
+func (s msgServer) transferDelegations(ctx sdk.Context, addr, dest sdk.AccAddress, amount sdk.Int) (sdk.Int, error) {
+	transferred := sdk.ZeroInt()
+	for _, delegation := range s.StakingKeeper.GetDelegatorDelegations(ctx, addr, math.MaxUint16) {
+		want := amount.Sub(transferred)
+		if !want.IsPositive() {
+			break
+		}
+
+		valAddr := delegation.GetValidatorAddr()
+		validator, found := s.StakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			continue
+		}
+
+		var err error
+		shares := delegation.Shares
+		if validator.TokensFromShares(shares).GT(want.ToDec()) {
+			if shares, err = validator.SharesFromTokens(want); err != nil {
+				continue
+			}
+		}
+
+		unbonded, err := s.StakingKeeper.Unbond(ctx, addr, valAddr, shares)
+		if err != nil {
+			return transferred, err
+		}
+		if !unbonded.IsPositive() {
+			continue
+		}
+
+		// Unbond removes the unbonded validators left without delegations, in
+		// which case the tokens are directly returned out of the staking pool.
+		if validator, found = s.StakingKeeper.GetValidator(ctx, valAddr); found {
+			if _, err := s.StakingKeeper.Delegate(ctx, dest, unbonded, validator.GetStatus(), validator, false); err != nil {
+				return transferred, err
+			}
+		} else {
+			coins := sdk.NewCoins(sdk.NewCoin(s.StakingKeeper.BondDenom(ctx), unbonded))
+			if err := s.BankKeeper.SendCoinsFromModuleToAccount(ctx, stakingtypes.NotBondedPoolName, dest, coins); err != nil {
+				return transferred, err
+			}
+		}
+
+		transferred = transferred.Add(unbonded)
+	}
+
+	return transferred, nil
+}
+
+// transferUnbondingDelegations transfers up to amount tokens of the unbonding
+// delegations of addr to dest, keeping their completion times, and returns the
+// transferred amount.
+func (s msgServer) transferUnbondingDelegations(ctx sdk.Context, addr, dest sdk.AccAddress, amount sdk.Int) sdk.Int {
+	transferred := sdk.ZeroInt()
+	for _, ubd := range s.StakingKeeper.GetUnbondingDelegations(ctx, addr, math.MaxUint16) {
+		if !amount.Sub(transferred).IsPositive() {
+			break
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(ubd.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		for i := 0; i < len(ubd.Entries); i++ {
+			entry := &ubd.Entries[i]
+			amt := sdk.MinInt(entry.Balance, amount.Sub(transferred))
+			if !amt.IsPositive() {
+				continue
+			}
+
+			entry.Balance = entry.Balance.Sub(amt)
+			entry.InitialBalance = sdk.MaxInt(entry.InitialBalance.Sub(amt), sdk.ZeroInt())
+			destUbd := s.StakingKeeper.SetUnbondingDelegationEntry(ctx, dest, valAddr, entry.CreationHeight, entry.CompletionTime, amt)
+			s.StakingKeeper.InsertUBDQueue(ctx, destUbd, entry.CompletionTime)
+			transferred = transferred.Add(amt)
+
+			if entry.Balance.IsZero() {
+				ubd.RemoveEntry(int64(i))
+				i--
+			}
+		}
+
+		if len(ubd.Entries) == 0 {
+			s.StakingKeeper.RemoveUnbondingDelegation(ctx, ubd)
+		} else {
+			s.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
+		}
+	}
+
+	return transferred
+}
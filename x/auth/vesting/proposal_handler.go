@@ -0,0 +1,35 @@
+package vesting
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/client/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// ProposalHandler is the update vesting schedule proposal client handler.
+var ProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitUpdateVestingScheduleProposal, rest.ProposalRESTHandler)
+
+// NewUpdateVestingScheduleProposalHandler creates a new governance Handler for
+// an UpdateVestingScheduleProposal. It has the effect of a
+// MsgUpdateVestingSchedule executed by governance.
+func NewUpdateVestingScheduleProposalHandler(ak keeper.AccountKeeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.UpdateVestingScheduleProposal:
+			addr, err := sdk.AccAddressFromBech32(c.Address)
+			if err != nil {
+				return err
+			}
+
+			return updateVestingSchedule(ctx, ak, addr, c.VestingPeriods)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized vesting proposal content type: %T", c)
+		}
+	}
+}
@@ -10,3 +10,14 @@ const (
 	// RouterKey defines the module's message routing key
 	RouterKey = ModuleName
 )
+
+// vesting module event types and attributes
+const (
+	EventTypeClawback              = "clawback"
+	EventTypeUpdateVestingSchedule = "update_vesting_schedule"
+
+	AttributeKeyFunder      = "funder"
+	AttributeKeyAccount     = "account"
+	AttributeKeyDestination = "destination"
+	AttributeKeyEndTime     = "end_time"
+)
@@ -75,3 +75,216 @@ func (msg MsgCreateVestingAccount) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{from}
 }
+
+// TypeMsgCreateClawbackVestingAccount defines the type value for a
+// MsgCreateClawbackVestingAccount.
+const TypeMsgCreateClawbackVestingAccount = "msg_create_clawback_vesting_account"
+
+var _ sdk.Msg = &MsgCreateClawbackVestingAccount{}
+
+// NewMsgCreateClawbackVestingAccount returns a reference to a new
+// MsgCreateClawbackVestingAccount.
+//nolint:interfacer
+func NewMsgCreateClawbackVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64, periods []Period) *MsgCreateClawbackVestingAccount {
+	return &MsgCreateClawbackVestingAccount{
+		FromAddress:    fromAddr.String(),
+		ToAddress:      toAddr.String(),
+		StartTime:      startTime,
+		VestingPeriods: periods,
+	}
+}
+
+// Route returns the message route for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) Type() string {
+	return TypeMsgCreateClawbackVestingAccount
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateClawbackVestingAccount) ValidateBasic() error {
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return err
+	}
+	if err := sdk.VerifyAddressFormat(from); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+
+	if err := sdk.VerifyAddressFormat(to); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+
+	if msg.StartTime <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid start time")
+	}
+
+	return validatePeriods(msg.VestingPeriods)
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// TypeMsgClawback defines the type value for a MsgClawback.
+const TypeMsgClawback = "msg_clawback"
+
+var _ sdk.Msg = &MsgClawback{}
+
+// NewMsgClawback returns a reference to a new MsgClawback. The clawed back
+// coins are sent to the funder if destAddr is empty.
+//nolint:interfacer
+func NewMsgClawback(funderAddr, addr, destAddr sdk.AccAddress) *MsgClawback {
+	msg := &MsgClawback{
+		FunderAddress: funderAddr.String(),
+		Address:       addr.String(),
+	}
+	if !destAddr.Empty() {
+		msg.DestAddress = destAddr.String()
+	}
+
+	return msg
+}
+
+// Route returns the message route for a MsgClawback.
+func (msg MsgClawback) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgClawback.
+func (msg MsgClawback) Type() string { return TypeMsgClawback }
+
+// ValidateBasic Implements Msg.
+func (msg MsgClawback) ValidateBasic() error {
+	funder, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		return err
+	}
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return err
+	}
+	if err := sdk.VerifyAddressFormat(funder); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid funder address: %s", err)
+	}
+
+	if err := sdk.VerifyAddressFormat(addr); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid account address: %s", err)
+	}
+
+	if msg.DestAddress != "" {
+		dest, err := sdk.AccAddressFromBech32(msg.DestAddress)
+		if err != nil {
+			return err
+		}
+		if err := sdk.VerifyAddressFormat(dest); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid destination address: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgClawback.
+func (msg MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgClawback.
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	funder, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{funder}
+}
+
+// TypeMsgUpdateVestingSchedule defines the type value for a
+// MsgUpdateVestingSchedule.
+const TypeMsgUpdateVestingSchedule = "msg_update_vesting_schedule"
+
+var _ sdk.Msg = &MsgUpdateVestingSchedule{}
+
+// NewMsgUpdateVestingSchedule returns a reference to a new
+// MsgUpdateVestingSchedule.
+//nolint:interfacer
+func NewMsgUpdateVestingSchedule(authority, addr sdk.AccAddress, periods []Period) *MsgUpdateVestingSchedule {
+	return &MsgUpdateVestingSchedule{
+		Authority:      authority.String(),
+		Address:        addr.String(),
+		VestingPeriods: periods,
+	}
+}
+
+// Route returns the message route for a MsgUpdateVestingSchedule.
+func (msg MsgUpdateVestingSchedule) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgUpdateVestingSchedule.
+func (msg MsgUpdateVestingSchedule) Type() string { return TypeMsgUpdateVestingSchedule }
+
+// ValidateBasic Implements Msg.
+func (msg MsgUpdateVestingSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return err
+	}
+	if err := sdk.VerifyAddressFormat(addr); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid account address: %s", err)
+	}
+
+	return validatePeriods(msg.VestingPeriods)
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgUpdateVestingSchedule.
+func (msg MsgUpdateVestingSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateVestingSchedule.
+func (msg MsgUpdateVestingSchedule) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// validatePeriods checks that there is at least one vesting period, and that
+// the periods have non-negative lengths and positive amounts.
+func validatePeriods(periods []Period) error {
+	if len(periods) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no vesting periods")
+	}
+
+	for i, period := range periods {
+		if period.Length < 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid length of period %d", i)
+		}
+
+		if !period.Amount.IsValid() || !period.Amount.IsAllPositive() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount of period %d: %s", i, period.Amount)
+		}
+	}
+
+	return nil
+}
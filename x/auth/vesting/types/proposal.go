@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeUpdateVestingSchedule defines the type for an UpdateVestingScheduleProposal
+	ProposalTypeUpdateVestingSchedule = "UpdateVestingSchedule"
+)
+
+// Assert UpdateVestingScheduleProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = &UpdateVestingScheduleProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUpdateVestingSchedule)
+	govtypes.RegisterProposalTypeCodec(&UpdateVestingScheduleProposal{}, "cosmos-sdk/UpdateVestingScheduleProposal")
+}
+
+// NewUpdateVestingScheduleProposal creates a new update vesting schedule proposal.
+//nolint:interfacer
+func NewUpdateVestingScheduleProposal(title, description string, addr sdk.AccAddress, periods []Period) *UpdateVestingScheduleProposal {
+	return &UpdateVestingScheduleProposal{title, description, addr.String(), periods}
+}
+
+// GetTitle returns the title of an update vesting schedule proposal.
+func (p *UpdateVestingScheduleProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an update vesting schedule proposal.
+func (p *UpdateVestingScheduleProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an update vesting schedule proposal.
+func (p *UpdateVestingScheduleProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an update vesting schedule proposal.
+func (p *UpdateVestingScheduleProposal) ProposalType() string {
+	return ProposalTypeUpdateVestingSchedule
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *UpdateVestingScheduleProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(p.Address)
+	if err != nil {
+		return err
+	}
+	if err := sdk.VerifyAddressFormat(addr); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid account address: %s", err)
+	}
+
+	return validatePeriods(p.VestingPeriods)
+}
+
+// String implements the Stringer interface.
+func (p UpdateVestingScheduleProposal) String() string {
+	return fmt.Sprintf(`Update Vesting Schedule Proposal:
+  Title:       %s
+  Description: %s
+  Address:     %s
+  Periods:     %s
+`, p.Title, p.Description, p.Address, Periods(p.VestingPeriods))
+}
@@ -0,0 +1,465 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/vesting/v1beta1/proposal.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// UpdateVestingScheduleProposal defines a proposal to replace the periods of a
+// periodic vesting account which are not over, executed as a
+// MsgUpdateVestingSchedule by governance.
+type UpdateVestingScheduleProposal struct {
+	Title          string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Address        string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	VestingPeriods []Period `protobuf:"bytes,4,rep,name=vesting_periods,json=vestingPeriods,proto3" json:"vesting_periods" yaml:"vesting_periods"`
+}
+
+func (m *UpdateVestingScheduleProposal) Reset()      { *m = UpdateVestingScheduleProposal{} }
+func (*UpdateVestingScheduleProposal) ProtoMessage() {}
+func (*UpdateVestingScheduleProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca96b02591926438, []int{0}
+}
+func (m *UpdateVestingScheduleProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateVestingScheduleProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateVestingScheduleProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateVestingScheduleProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateVestingScheduleProposal.Merge(m, src)
+}
+func (m *UpdateVestingScheduleProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateVestingScheduleProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateVestingScheduleProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateVestingScheduleProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*UpdateVestingScheduleProposal)(nil), "cosmos.vesting.v1beta1.UpdateVestingScheduleProposal")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/vesting/v1beta1/proposal.proto", fileDescriptor_ca96b02591926438)
+}
+
+var fileDescriptor_ca96b02591926438 = []byte{
+	// 308 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0x3f, 0x4b, 0x3b, 0x31,
+	0x1c, 0xc6, 0x93, 0x5f, 0x7f, 0xfe, 0x4b, 0x41, 0xe1, 0x28, 0xe5, 0x28, 0x98, 0x2b, 0x45, 0xa1,
+	0x8b, 0x09, 0xd5, 0xad, 0x63, 0x57, 0x97, 0x52, 0xd1, 0xc1, 0x45, 0xd2, 0x4b, 0xb8, 0x06, 0xaf,
+	0x4d, 0xb8, 0xa4, 0xc5, 0xbe, 0x03, 0x47, 0x47, 0xc7, 0xbe, 0x9c, 0x8e, 0x1d, 0x9d, 0x8a, 0xf4,
+	0x7c, 0x05, 0xbe, 0x02, 0x31, 0x49, 0x45, 0x44, 0xa7, 0xe4, 0xfb, 0x3c, 0x9f, 0x3c, 0x49, 0x1e,
+	0x74, 0x9a, 0x2a, 0x33, 0x56, 0x86, 0xce, 0x84, 0xb1, 0x72, 0x92, 0xd1, 0x59, 0x67, 0x28, 0x2c,
+	0xeb, 0x50, 0x5d, 0x28, 0xad, 0x0c, 0xcb, 0x89, 0x2e, 0x94, 0x55, 0x51, 0xdd, 0x63, 0x24, 0x60,
+	0x24, 0x60, 0x8d, 0x5a, 0xa6, 0x32, 0xe5, 0x10, 0xfa, 0xb9, 0xf3, 0x74, 0xe3, 0xe4, 0x8f, 0xd0,
+	0xed, 0x69, 0x47, 0xb5, 0xde, 0x20, 0x3a, 0xbe, 0xd6, 0x9c, 0x59, 0x71, 0xe3, 0xf5, 0xab, 0x74,
+	0x24, 0xf8, 0x34, 0x17, 0xfd, 0x70, 0x77, 0x54, 0x43, 0x3b, 0x56, 0xda, 0x5c, 0xc4, 0xb0, 0x09,
+	0xdb, 0x07, 0x03, 0x3f, 0x44, 0x4d, 0x54, 0xe5, 0xc2, 0xa4, 0x85, 0xd4, 0x56, 0xaa, 0x49, 0xfc,
+	0xcf, 0x79, 0xdf, 0xa5, 0x28, 0x46, 0x7b, 0x8c, 0xf3, 0x42, 0x18, 0x13, 0x57, 0x9c, 0xbb, 0x1d,
+	0xa3, 0x0c, 0x1d, 0x85, 0x47, 0xdc, 0x69, 0x51, 0x48, 0xc5, 0x4d, 0xfc, 0xbf, 0x59, 0x69, 0x57,
+	0xcf, 0x31, 0xf9, 0xfd, 0x87, 0xa4, 0xef, 0xb0, 0x1e, 0x5e, 0xae, 0x13, 0xf0, 0xbe, 0x4e, 0xea,
+	0x73, 0x36, 0xce, 0xbb, 0xad, 0x1f, 0x21, 0xad, 0xc1, 0x61, 0x50, 0x3c, 0x6e, 0xba, 0xfb, 0x8f,
+	0x8b, 0x04, 0x3c, 0x2f, 0x12, 0xd0, 0xbb, 0x5c, 0x6e, 0x30, 0x5c, 0x6d, 0x30, 0x7c, 0xdd, 0x60,
+	0xf8, 0x54, 0x62, 0xb0, 0x2a, 0x31, 0x78, 0x29, 0x31, 0xb8, 0xed, 0x64, 0xd2, 0x8e, 0xa6, 0x43,
+	0x92, 0xaa, 0x31, 0x0d, 0x8d, 0xf9, 0xe5, 0xcc, 0xf0, 0x7b, 0xfa, 0x40, 0xd9, 0xd4, 0x8e, 0xbe,
+	0x3a, 0xb4, 0x73, 0x2d, 0xcc, 0x70, 0xd7, 0x55, 0x77, 0xf1, 0x31, 0x00, 0xc0, 0xd5, 0x38, 0x92,
+	0xb7, 0x01, 0x00, 0x00,
+}
+
+func (m *UpdateVestingScheduleProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateVestingScheduleProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateVestingScheduleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UpdateVestingScheduleProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposal(x uint64) (n int) {
+	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UpdateVestingScheduleProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateVestingScheduleProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateVestingScheduleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProposal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProposal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProposal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProposal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProposal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProposal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProposal = fmt.Errorf("proto: unexpected end of group")
+)
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	yaml "gopkg.in/yaml.v2"
@@ -18,6 +19,7 @@ var (
 	_ vestexported.VestingAccount = (*ContinuousVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*PeriodicVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*DelayedVestingAccount)(nil)
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
 )
 
 // Base Vesting Account
@@ -164,6 +166,7 @@ type vestingAccountYAML struct {
 	// custom fields based on concrete vesting type which can be omitted
 	StartTime      int64   `json:"start_time,omitempty" yaml:"start_time,omitempty"`
 	VestingPeriods Periods `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"`
+	FunderAddress  string  `json:"funder_address,omitempty" yaml:"funder_address,omitempty"`
 }
 
 func (bva BaseVestingAccount) String() string {
@@ -401,6 +404,38 @@ func (pva PeriodicVestingAccount) GetVestingPeriods() Periods {
 	return pva.VestingPeriods
 }
 
+// UpdateRemainingPeriods replaces the periods of the account which are not over
+// at the given block time with the given periods, which start at the end of
+// the last completed period. Their coins must sum to the coins still vesting,
+// so that the original vesting coins are unchanged, and the end time of the
+// account becomes the end of the new periods.
+func (pva *PeriodicVestingAccount) UpdateRemainingPeriods(blockTime time.Time, periods Periods) error {
+	endTime := pva.StartTime
+	var vestedPeriods Periods
+	for _, period := range pva.VestingPeriods {
+		if blockTime.Unix() < endTime+period.Length {
+			break
+		}
+
+		endTime += period.Length
+		vestedPeriods = append(vestedPeriods, period)
+	}
+
+	if len(vestedPeriods) == len(pva.VestingPeriods) {
+		return errors.New("vesting schedule is over")
+	}
+
+	vesting := pva.GetVestingCoins(blockTime)
+	if total := periods.TotalAmount(); !total.IsEqual(vesting) {
+		return fmt.Errorf("coins of the periods %s do not match the vesting coins %s", total, vesting)
+	}
+
+	pva.VestingPeriods = append(vestedPeriods, periods...)
+	pva.EndTime = endTime + periods.TotalLength()
+
+	return nil
+}
+
 // Validate checks for errors on the account fields
 func (pva PeriodicVestingAccount) Validate() error {
 	if pva.GetStartTime() >= pva.GetEndTime() {
@@ -583,6 +618,165 @@ func (plva PermanentLockedAccount) String() string {
 	return out.(string)
 }
 
+//-----------------------------------------------------------------------------
+// Clawback Vesting Account
+
+var _ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+var _ authtypes.GenesisAccount = (*ClawbackVestingAccount)(nil)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount funded by the
+// given funder.
+func NewClawbackVestingAccount(baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, originalVesting sdk.Coins, startTime int64, periods Periods) *ClawbackVestingAccount {
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:      baseAcc,
+		OriginalVesting:  originalVesting,
+		DelegatedFree:    sdk.NewCoins(),
+		DelegatedVesting: sdk.NewCoins(),
+		EndTime:          startTime + periods.TotalLength(),
+	}
+
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder.String(),
+		StartTime:          startTime,
+		VestingPeriods:     periods,
+	}
+}
+
+// periodic returns the PeriodicVestingAccount following the same vesting
+// schedule as the account.
+func (cva ClawbackVestingAccount) periodic() PeriodicVestingAccount {
+	return PeriodicVestingAccount{
+		BaseVestingAccount: cva.BaseVestingAccount,
+		StartTime:          cva.StartTime,
+		VestingPeriods:     cva.VestingPeriods,
+	}
+}
+
+// GetVestedCoins returns the total number of vested coins. If no coins are vested,
+// nil is returned.
+func (cva ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	return cva.periodic().GetVestedCoins(blockTime)
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (cva ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Sub(cva.GetVestedCoins(blockTime))
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (cva ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return cva.BaseVestingAccount.LockedCoinsFromVesting(cva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (cva *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	cva.BaseVestingAccount.TrackDelegation(balance, cva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a clawback vesting
+// account.
+func (cva ClawbackVestingAccount) GetStartTime() int64 {
+	return cva.StartTime
+}
+
+// GetVestingPeriods returns vesting periods associated with clawback vesting account.
+func (cva ClawbackVestingAccount) GetVestingPeriods() Periods {
+	return cva.VestingPeriods
+}
+
+// GetFunder returns the address of the funder of the account.
+func (cva ClawbackVestingAccount) GetFunder() sdk.AccAddress {
+	funder, _ := sdk.AccAddressFromBech32(cva.FunderAddress)
+	return funder
+}
+
+// Clawback ends the vesting schedule of the account at the given block time,
+// dropping the periods which are not over, and returns the coins which were
+// still vesting. They are removed from the original vesting coins, and the
+// delegated vesting coins become delegated free coins, as no coins are vesting
+// anymore. It is the caller's responsibility to transfer the returned coins
+// and track the transferred delegations.
+func (cva *ClawbackVestingAccount) Clawback(blockTime time.Time) sdk.Coins {
+	unvested := cva.GetVestingCoins(blockTime)
+
+	endTime := cva.StartTime
+	var vestedPeriods Periods
+	for _, period := range cva.VestingPeriods {
+		if blockTime.Unix() < endTime+period.Length {
+			break
+		}
+
+		endTime += period.Length
+		vestedPeriods = append(vestedPeriods, period)
+	}
+
+	cva.OriginalVesting = cva.OriginalVesting.Sub(unvested)
+	cva.VestingPeriods = vestedPeriods
+	cva.EndTime = endTime
+	cva.DelegatedFree = cva.DelegatedFree.Add(cva.DelegatedVesting...)
+	cva.DelegatedVesting = sdk.NewCoins()
+
+	return unvested
+}
+
+// Validate checks for errors on the account fields
+func (cva ClawbackVestingAccount) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(cva.FunderAddress); err != nil {
+		return fmt.Errorf("invalid funder address: %w", err)
+	}
+	// the vesting schedule of a clawed back account may end at its start
+	if cva.GetStartTime() > cva.GetEndTime() {
+		return errors.New("vesting start-time cannot be after end-time")
+	}
+	endTime := cva.StartTime
+	originalVesting := sdk.NewCoins()
+	for _, p := range cva.VestingPeriods {
+		endTime += p.Length
+		originalVesting = originalVesting.Add(p.Amount...)
+	}
+	if endTime != cva.EndTime {
+		return errors.New("vesting end time does not match length of all vesting periods")
+	}
+	if !originalVesting.IsEqual(cva.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
+	}
+
+	return cva.BaseVestingAccount.Validate()
+}
+
+func (cva ClawbackVestingAccount) String() string {
+	out, _ := cva.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ClawbackVestingAccount.
+func (cva ClawbackVestingAccount) MarshalYAML() (interface{}, error) {
+	accAddr, err := sdk.AccAddressFromBech32(cva.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	out := vestingAccountYAML{
+		Address:          accAddr,
+		AccountNumber:    cva.AccountNumber,
+		PubKey:           getPKString(cva),
+		Sequence:         cva.Sequence,
+		OriginalVesting:  cva.OriginalVesting,
+		DelegatedFree:    cva.DelegatedFree,
+		DelegatedVesting: cva.DelegatedVesting,
+		EndTime:          cva.EndTime,
+		StartTime:        cva.StartTime,
+		VestingPeriods:   cva.VestingPeriods,
+		FunderAddress:    cva.FunderAddress,
+	}
+	return marshalYaml(out)
+}
+
 type getPK interface {
 	GetPubKey() cryptotypes.PubKey
 }
@@ -803,6 +803,108 @@ func TestPermanentLockedAccountMarshal(t *testing.T) {
 	require.NotNil(t, err)
 }
 
+func TestUpdateRemainingPeriodsPeriodicVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	pva := types.NewPeriodicVestingAccount(bacc, origCoins, now.Unix(), periods)
+
+	// require the new periods to match the vesting coins
+	blockTime := now.Add(15 * time.Hour)
+	require.Error(t, pva.UpdateRemainingPeriods(blockTime, types.Periods{periods[1]}))
+
+	// re-slice the remaining half in four periods of a day
+	quarter := sdk.Coins{sdk.NewInt64Coin(feeDenom, 125), sdk.NewInt64Coin(stakeDenom, 12)}
+	last := sdk.Coins{sdk.NewInt64Coin(feeDenom, 125), sdk.NewInt64Coin(stakeDenom, 14)}
+	day := int64(24 * 60 * 60)
+	newPeriods := types.Periods{{day, quarter}, {day, quarter}, {day, quarter}, {day, last}}
+	require.NoError(t, pva.UpdateRemainingPeriods(blockTime, newPeriods))
+	require.NoError(t, pva.Validate())
+	require.Equal(t, origCoins, pva.OriginalVesting)
+	require.Equal(t, append(types.Periods{periods[0]}, newPeriods...), pva.GetVestingPeriods())
+	require.Equal(t, now.Add(12*time.Hour+4*24*time.Hour).Unix(), pva.GetEndTime())
+
+	// require the completed period to stay vested, and the new periods to vest
+	// from its end
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, pva.GetVestedCoins(blockTime))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 625), sdk.NewInt64Coin(stakeDenom, 62)}, pva.GetVestedCoins(now.Add(36*time.Hour)))
+	require.Equal(t, origCoins, pva.GetVestedCoins(now.Add(12*time.Hour+4*24*time.Hour)))
+
+	// require a schedule which is over not to be updated
+	require.Error(t, pva.UpdateRemainingPeriods(now.Add(30*24*time.Hour), newPeriods))
+}
+
+func TestClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+	_, _, funder := testdata.KeyTestPubAddr()
+
+	bacc, origCoins := initBaseAccount()
+	cva := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods)
+	require.NoError(t, cva.Validate())
+	require.Equal(t, funder, cva.GetFunder())
+	require.Equal(t, now.Add(24*time.Hour).Unix(), cva.GetEndTime())
+
+	// require the coins to vest as those of a periodic vesting account
+	require.Nil(t, cva.GetVestedCoins(now))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, cva.GetVestedCoins(now.Add(15*time.Hour)))
+	require.Equal(t, origCoins, cva.GetVestedCoins(now.Add(24*time.Hour)))
+
+	// delegate all the stake, which is vesting for the most part
+	cva.TrackDelegation(now.Add(15*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedFree)
+
+	// require the clawback to return the coins of the periods which are not over
+	unvested := cva.Clawback(now.Add(15 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, unvested)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, cva.OriginalVesting)
+	require.Equal(t, types.Periods{periods[0]}, cva.GetVestingPeriods())
+	require.Equal(t, now.Add(12*time.Hour).Unix(), cva.GetEndTime())
+	require.NoError(t, cva.Validate())
+
+	// require the delegated vesting coins to be free
+	require.Empty(t, cva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, cva.DelegatedFree)
+	require.Empty(t, cva.GetVestingCoins(now.Add(15*time.Hour)))
+	require.Empty(t, cva.LockedCoins(now.Add(15*time.Hour)))
+
+	// require a clawback before the start to return all the coins
+	bacc, origCoins = initBaseAccount()
+	cva = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods)
+	require.Equal(t, origCoins, cva.Clawback(now.Add(-time.Hour)))
+	require.Empty(t, cva.OriginalVesting)
+	require.Equal(t, cva.GetStartTime(), cva.GetEndTime())
+	require.NoError(t, cva.Validate())
+
+	// require an invalid funder to fail validation
+	cva.FunderAddress = ""
+	require.Error(t, cva.Validate())
+}
+
+func TestClawbackVestingAccountMarshal(t *testing.T) {
+	baseAcc, coins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	acc := types.NewClawbackVestingAccount(baseAcc, funder, coins, time.Now().Unix(), types.Periods{types.Period{3600, coins}})
+
+	bz, err := app.AccountKeeper.MarshalAccount(acc)
+	require.Nil(t, err)
+
+	acc2, err := app.AccountKeeper.UnmarshalAccount(bz)
+	require.Nil(t, err)
+	require.IsType(t, &types.ClawbackVestingAccount{}, acc2)
+	require.Equal(t, acc.String(), acc2.String())
+}
+
 func initBaseAccount() (*authtypes.BaseAccount, sdk.Coins) {
 	_, _, addr := testdata.KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
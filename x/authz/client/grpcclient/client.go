@@ -0,0 +1,163 @@
+// Code generated by typedgen. DO NOT EDIT.
+
+// Package grpcclient implements the typed clients of the authz module.
+package grpcclient
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/typed"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// QueryClient is the typed client of the authz Query service, retrying
+// the queries failing with a transient error.
+type QueryClient struct {
+	client authz.QueryClient
+	retry  typed.RetryConfig
+}
+
+// NewQueryClient returns a QueryClient querying through the connection.
+func NewQueryClient(conn gogogrpc.ClientConn, retry typed.RetryConfig) *QueryClient {
+	return &QueryClient{
+		client: authz.NewQueryClient(conn),
+		retry:  retry,
+	}
+}
+
+// GranteeGrants calls the authz Query/GranteeGrants method.
+func (c *QueryClient) GranteeGrants(ctx context.Context, req *authz.QueryGranteeGrantsRequest, opts ...grpc.CallOption) (*authz.QueryGranteeGrantsResponse, error) {
+	var res *authz.QueryGranteeGrantsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.GranteeGrants(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateGranteeGrants calls the authz Query/GranteeGrants method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateGranteeGrants(ctx context.Context, req *authz.QueryGranteeGrantsRequest, fn func(*authz.QueryGranteeGrantsResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq authz.QueryGranteeGrantsRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.GranteeGrants(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// GranterGrants calls the authz Query/GranterGrants method.
+func (c *QueryClient) GranterGrants(ctx context.Context, req *authz.QueryGranterGrantsRequest, opts ...grpc.CallOption) (*authz.QueryGranterGrantsResponse, error) {
+	var res *authz.QueryGranterGrantsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.GranterGrants(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateGranterGrants calls the authz Query/GranterGrants method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateGranterGrants(ctx context.Context, req *authz.QueryGranterGrantsRequest, fn func(*authz.QueryGranterGrantsResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq authz.QueryGranterGrantsRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.GranterGrants(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// Grants calls the authz Query/Grants method.
+func (c *QueryClient) Grants(ctx context.Context, req *authz.QueryGrantsRequest, opts ...grpc.CallOption) (*authz.QueryGrantsResponse, error) {
+	var res *authz.QueryGrantsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Grants(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateGrants calls the authz Query/Grants method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateGrants(ctx context.Context, req *authz.QueryGrantsRequest, fn func(*authz.QueryGrantsResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq authz.QueryGrantsRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.Grants(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// MsgClient is the typed client of the authz Msg service, broadcasting a
+// transaction of each message.
+type MsgClient struct {
+	broadcaster typed.Broadcaster
+}
+
+// NewMsgClient returns a MsgClient broadcasting the transactions with the
+// broadcaster.
+func NewMsgClient(broadcaster typed.Broadcaster) *MsgClient {
+	return &MsgClient{broadcaster: broadcaster}
+}
+
+// Exec broadcasts a transaction of the authz Msg/Exec message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) Exec(ctx context.Context, msg *authz.MsgExec) (*authz.MsgExecResponse, *sdk.TxResponse, error) {
+	res := &authz.MsgExecResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
+
+// Grant broadcasts a transaction of the authz Msg/Grant message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) Grant(ctx context.Context, msg *authz.MsgGrant) (*authz.MsgGrantResponse, *sdk.TxResponse, error) {
+	res := &authz.MsgGrantResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
+
+// Revoke broadcasts a transaction of the authz Msg/Revoke message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) Revoke(ctx context.Context, msg *authz.MsgRevoke) (*authz.MsgRevokeResponse, *sdk.TxResponse, error) {
+	res := &authz.MsgRevokeResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// CompleteDenoms is a cobra completion function completing the denominations
+// of the bank metadata, i.e. their base and display denominations, queried
+// from the node and cached for client.CompletionCacheTTL.
+func CompleteDenoms(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	clientCtx, err := client.GetCompletionContext(cmd)
+	if err != nil || clientCtx.Client == nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	denoms, err := client.CachedCompletions(clientCtx, "denoms", func() ([]string, error) {
+		queryClient := types.NewQueryClient(clientCtx)
+
+		var (
+			denoms  []string
+			seen    = make(map[string]bool)
+			pageReq = &query.PageRequest{}
+		)
+		for {
+			res, err := queryClient.DenomsMetadata(cmd.Context(), &types.QueryDenomsMetadataRequest{Pagination: pageReq})
+			if err != nil {
+				return nil, err
+			}
+
+			for _, metadata := range res.Metadatas {
+				for _, denom := range []string{metadata.Base, metadata.Display} {
+					if denom != "" && !seen[denom] {
+						seen[denom] = true
+						denoms = append(denoms, denom)
+					}
+				}
+			}
+
+			if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+				return denoms, nil
+			}
+			pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+		}
+	})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return client.FilterCompletions(denoms, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
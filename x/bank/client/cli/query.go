@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -14,7 +15,10 @@ import (
 )
 
 const (
-	FlagDenom = "denom"
+	FlagDenom     = "denom"
+	FlagMinAmount = "min-amount"
+	FlagStartTime = "start-time"
+	FlagEndTime   = "end-time"
 )
 
 // GetQueryCmd returns the parent command for all x/bank CLi query commands. The
@@ -32,6 +36,9 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetBalancesCmd(),
 		GetCmdQueryTotalSupply(),
+		GetCmdQuerySupplyHistory(),
+		GetCmdQueryAccountStatement(),
+		GetCmdQueryTotalBurned(),
 		GetCmdDenomsMetadata(),
 	)
 
@@ -48,8 +55,9 @@ func GetBalancesCmd() *cobra.Command {
 Example:
   $ %s query %s balances [address]
   $ %s query %s balances [address] --denom=[denom]
+  $ %s query %s balances [address] --min-amount=[amount]
 `,
-				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName, version.AppName, types.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(1),
@@ -62,6 +70,10 @@ Example:
 			if err != nil {
 				return err
 			}
+			minAmount, err := cmd.Flags().GetString(FlagMinAmount)
+			if err != nil {
+				return err
+			}
 
 			queryClient := types.NewQueryClient(clientCtx)
 
@@ -77,6 +89,7 @@ Example:
 			ctx := cmd.Context()
 			if denom == "" {
 				params := types.NewQueryAllBalancesRequest(addr, pageReq)
+				params.MinAmount = minAmount
 				res, err := queryClient.AllBalances(ctx, params)
 				if err != nil {
 					return err
@@ -95,6 +108,8 @@ Example:
 	}
 
 	cmd.Flags().String(FlagDenom, "", "The specific balance denomination to query for")
+	cmd.RegisterFlagCompletionFunc(FlagDenom, CompleteDenoms)
+	cmd.Flags().String(FlagMinAmount, "", "Only query the balances whose amount is greater than or equal to the given amount")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "all balances")
 
@@ -150,6 +165,7 @@ To query for the client metadata of a specific coin denomination use:
 	}
 
 	cmd.Flags().String(FlagDenom, "", "The specific denomination to query client metadata for")
+	cmd.RegisterFlagCompletionFunc(FlagDenom, CompleteDenoms)
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -207,8 +223,194 @@ To query for the total supply of a specific coin denomination use:
 	}
 
 	cmd.Flags().String(FlagDenom, "", "The specific balance denomination to query for")
+	cmd.RegisterFlagCompletionFunc(FlagDenom, CompleteDenoms)
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "all supply totals")
 
 	return cmd
 }
+
+// GetCmdQueryTotalBurned returns a CLI command handler for querying the
+// cumulative amount of coins burned.
+func GetCmdQueryTotalBurned() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-burned",
+		Short: "Query the cumulative amount of coins burned on the chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the cumulative amount of coins burned on the chain.
+
+Example:
+  $ %s query %s total-burned
+
+To query for the amount burned of a specific coin denomination use:
+  $ %s query %s total-burned --denom=[denom]
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			ctx := cmd.Context()
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if denom == "" {
+				res, err := queryClient.TotalBurned(ctx, &types.QueryTotalBurnedRequest{Pagination: pageReq})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			res, err := queryClient.BurnedOf(ctx, &types.QueryBurnedOfRequest{Denom: denom})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Amount)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "The specific coin denomination to query for")
+	cmd.RegisterFlagCompletionFunc(FlagDenom, CompleteDenoms)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all burned totals")
+
+	return cmd
+}
+
+// GetCmdQuerySupplyHistory returns a CLI command handler for querying the
+// recorded history of the total supply of a denom.
+func GetCmdQuerySupplyHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-history [denom]",
+		Short: "Query the recorded history of the total supply of a coin denomination",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total supply of a coin denomination recorded every
+supply_history_interval blocks, oldest first.
+
+Example:
+  $ %s query %s supply-history stake
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: CompleteDenoms,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SupplyHistory(cmd.Context(), &types.QuerySupplyHistoryRequest{Denom: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "supply history")
+
+	return cmd
+}
+
+// GetCmdQueryAccountStatement returns a CLI command handler for querying the
+// recorded balance changes of an account.
+func GetCmdQueryAccountStatement() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-statement [address]",
+		Short: "Query the recorded balance changes of an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the balance changes of an account recorded in the last
+statement_retention_blocks blocks, oldest first, optionally within RFC 3339
+start and end times.
+
+Example:
+  $ %s query %s account-statement [address]
+  $ %s query %s account-statement [address] --start-time=2030-01-01T00:00:00Z --end-time=2030-02-01T00:00:00Z
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAccountStatementRequest{Address: addr.String()}
+			if req.StartTime, err = readTimeFlag(cmd, FlagStartTime); err != nil {
+				return err
+			}
+			if req.EndTime, err = readTimeFlag(cmd, FlagEndTime); err != nil {
+				return err
+			}
+
+			req.Pagination, err = client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AccountStatement(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagStartTime, "", "Only query the balance changes at or after the given RFC 3339 time")
+	cmd.Flags().String(FlagEndTime, "", "Only query the balance changes before the given RFC 3339 time")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "account statement")
+
+	return cmd
+}
+
+// readTimeFlag returns the RFC 3339 time of the given flag, or the zero time if
+// it is not set.
+func readTimeFlag(cmd *cobra.Command, flag string) (time.Time, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil || value == "" {
+		return time.Time{}, err
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s %s: %w", flag, value, err)
+	}
+
+	return t, nil
+}
@@ -0,0 +1,342 @@
+// Code generated by typedgen. DO NOT EDIT.
+
+// Package grpcclient implements the typed clients of the bank module.
+package grpcclient
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/typed"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// QueryClient is the typed client of the bank Query service, retrying
+// the queries failing with a transient error.
+type QueryClient struct {
+	client types.QueryClient
+	retry  typed.RetryConfig
+}
+
+// NewQueryClient returns a QueryClient querying through the connection.
+func NewQueryClient(conn gogogrpc.ClientConn, retry typed.RetryConfig) *QueryClient {
+	return &QueryClient{
+		client: types.NewQueryClient(conn),
+		retry:  retry,
+	}
+}
+
+// AccountStatement calls the bank Query/AccountStatement method.
+func (c *QueryClient) AccountStatement(ctx context.Context, req *types.QueryAccountStatementRequest, opts ...grpc.CallOption) (*types.QueryAccountStatementResponse, error) {
+	var res *types.QueryAccountStatementResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.AccountStatement(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateAccountStatement calls the bank Query/AccountStatement method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateAccountStatement(ctx context.Context, req *types.QueryAccountStatementRequest, fn func(*types.QueryAccountStatementResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq types.QueryAccountStatementRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.AccountStatement(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// AllBalances calls the bank Query/AllBalances method.
+func (c *QueryClient) AllBalances(ctx context.Context, req *types.QueryAllBalancesRequest, opts ...grpc.CallOption) (*types.QueryAllBalancesResponse, error) {
+	var res *types.QueryAllBalancesResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.AllBalances(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateAllBalances calls the bank Query/AllBalances method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateAllBalances(ctx context.Context, req *types.QueryAllBalancesRequest, fn func(*types.QueryAllBalancesResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq types.QueryAllBalancesRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.AllBalances(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// Balance calls the bank Query/Balance method.
+func (c *QueryClient) Balance(ctx context.Context, req *types.QueryBalanceRequest, opts ...grpc.CallOption) (*types.QueryBalanceResponse, error) {
+	var res *types.QueryBalanceResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Balance(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// BurnedOf calls the bank Query/BurnedOf method.
+func (c *QueryClient) BurnedOf(ctx context.Context, req *types.QueryBurnedOfRequest, opts ...grpc.CallOption) (*types.QueryBurnedOfResponse, error) {
+	var res *types.QueryBurnedOfResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.BurnedOf(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// DenomMetadata calls the bank Query/DenomMetadata method.
+func (c *QueryClient) DenomMetadata(ctx context.Context, req *types.QueryDenomMetadataRequest, opts ...grpc.CallOption) (*types.QueryDenomMetadataResponse, error) {
+	var res *types.QueryDenomMetadataResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.DenomMetadata(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// DenomsMetadata calls the bank Query/DenomsMetadata method.
+func (c *QueryClient) DenomsMetadata(ctx context.Context, req *types.QueryDenomsMetadataRequest, opts ...grpc.CallOption) (*types.QueryDenomsMetadataResponse, error) {
+	var res *types.QueryDenomsMetadataResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.DenomsMetadata(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateDenomsMetadata calls the bank Query/DenomsMetadata method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateDenomsMetadata(ctx context.Context, req *types.QueryDenomsMetadataRequest, fn func(*types.QueryDenomsMetadataResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq types.QueryDenomsMetadataRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.DenomsMetadata(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// Params calls the bank Query/Params method.
+func (c *QueryClient) Params(ctx context.Context, req *types.QueryParamsRequest, opts ...grpc.CallOption) (*types.QueryParamsResponse, error) {
+	var res *types.QueryParamsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Params(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// SpendableBalances calls the bank Query/SpendableBalances method.
+func (c *QueryClient) SpendableBalances(ctx context.Context, req *types.QuerySpendableBalancesRequest, opts ...grpc.CallOption) (*types.QuerySpendableBalancesResponse, error) {
+	var res *types.QuerySpendableBalancesResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.SpendableBalances(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateSpendableBalances calls the bank Query/SpendableBalances method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateSpendableBalances(ctx context.Context, req *types.QuerySpendableBalancesRequest, fn func(*types.QuerySpendableBalancesResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq types.QuerySpendableBalancesRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.SpendableBalances(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// SupplyHistory calls the bank Query/SupplyHistory method.
+func (c *QueryClient) SupplyHistory(ctx context.Context, req *types.QuerySupplyHistoryRequest, opts ...grpc.CallOption) (*types.QuerySupplyHistoryResponse, error) {
+	var res *types.QuerySupplyHistoryResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.SupplyHistory(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateSupplyHistory calls the bank Query/SupplyHistory method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateSupplyHistory(ctx context.Context, req *types.QuerySupplyHistoryRequest, fn func(*types.QuerySupplyHistoryResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq types.QuerySupplyHistoryRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.SupplyHistory(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// SupplyOf calls the bank Query/SupplyOf method.
+func (c *QueryClient) SupplyOf(ctx context.Context, req *types.QuerySupplyOfRequest, opts ...grpc.CallOption) (*types.QuerySupplyOfResponse, error) {
+	var res *types.QuerySupplyOfResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.SupplyOf(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// TotalBurned calls the bank Query/TotalBurned method.
+func (c *QueryClient) TotalBurned(ctx context.Context, req *types.QueryTotalBurnedRequest, opts ...grpc.CallOption) (*types.QueryTotalBurnedResponse, error) {
+	var res *types.QueryTotalBurnedResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.TotalBurned(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateTotalBurned calls the bank Query/TotalBurned method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateTotalBurned(ctx context.Context, req *types.QueryTotalBurnedRequest, fn func(*types.QueryTotalBurnedResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq types.QueryTotalBurnedRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.TotalBurned(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// TotalSupply calls the bank Query/TotalSupply method.
+func (c *QueryClient) TotalSupply(ctx context.Context, req *types.QueryTotalSupplyRequest, opts ...grpc.CallOption) (*types.QueryTotalSupplyResponse, error) {
+	var res *types.QueryTotalSupplyResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.TotalSupply(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateTotalSupply calls the bank Query/TotalSupply method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateTotalSupply(ctx context.Context, req *types.QueryTotalSupplyRequest, fn func(*types.QueryTotalSupplyResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq types.QueryTotalSupplyRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.TotalSupply(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// MsgClient is the typed client of the bank Msg service, broadcasting a
+// transaction of each message.
+type MsgClient struct {
+	broadcaster typed.Broadcaster
+}
+
+// NewMsgClient returns a MsgClient broadcasting the transactions with the
+// broadcaster.
+func NewMsgClient(broadcaster typed.Broadcaster) *MsgClient {
+	return &MsgClient{broadcaster: broadcaster}
+}
+
+// Burn broadcasts a transaction of the bank Msg/Burn message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) Burn(ctx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, *sdk.TxResponse, error) {
+	res := &types.MsgBurnResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
+
+// MultiSend broadcasts a transaction of the bank Msg/MultiSend message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) MultiSend(ctx context.Context, msg *types.MsgMultiSend) (*types.MsgMultiSendResponse, *sdk.TxResponse, error) {
+	res := &types.MsgMultiSendResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
+
+// Send broadcasts a transaction of the bank Msg/Send message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) Send(ctx context.Context, msg *types.MsgSend) (*types.MsgSendResponse, *sdk.TxResponse, error) {
+	res := &types.MsgSendResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
@@ -0,0 +1,62 @@
+package grpcclient_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/grpc/typed"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/bank/client/grpcclient"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestQueryClient(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := sdk.AccAddress([]byte("addr________________"))
+	balances := sdk.NewCoins(sdk.NewInt64Coin("denom1", 1), sdk.NewInt64Coin("denom2", 2), sdk.NewInt64Coin("denom3", 3))
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, addr, balances))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.BankKeeper)
+	client := grpcclient.NewQueryClient(queryHelper, typed.DefaultRetryConfig())
+
+	res, err := client.Balance(context.Background(), &types.QueryBalanceRequest{Address: addr.String(), Denom: "denom2"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("denom2", 2), *res.Balance)
+
+	// the balances are iterated one page at a time
+	var pages []sdk.Coins
+	err = client.IterateAllBalances(context.Background(), &types.QueryAllBalancesRequest{
+		Address:    addr.String(),
+		Pagination: &query.PageRequest{Limit: 1},
+	}, func(res *types.QueryAllBalancesResponse) bool {
+		pages = append(pages, res.Balances)
+		return true
+	})
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Coins{balances[:1], balances[1:2], balances[2:]}, pages)
+
+	// until the callback stops the iteration
+	pages = nil
+	err = client.IterateAllBalances(context.Background(), &types.QueryAllBalancesRequest{
+		Address:    addr.String(),
+		Pagination: &query.PageRequest{Limit: 2},
+	}, func(res *types.QueryAllBalancesResponse) bool {
+		pages = append(pages, res.Balances)
+		return false
+	})
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Coins{balances[:2]}, pages)
+
+	// the errors which are not transient are not retried
+	_, err = client.Balance(context.Background(), &types.QueryBalanceRequest{Address: "invalid", Denom: "denom2"})
+	require.Error(t, err)
+}
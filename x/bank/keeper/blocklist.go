@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// SetBlocklistKeeper sets the keeper of the blocklist, whose addresses can
+// neither send nor receive funds. It must be called before the keeper is
+// passed to other modules, as they hold copies of the keeper.
+func (k *BaseKeeper) SetBlocklistKeeper(bk types.BlocklistKeeper) *BaseKeeper {
+	k.blocklistKeeper = bk
+
+	return k
+}
+
+// checkBlocklist returns an error if one of the addresses is in the blocklist.
+func (k BaseSendKeeper) checkBlocklist(ctx sdk.Context, addrs ...sdk.AccAddress) error {
+	if k.blocklistKeeper == nil {
+		return nil
+	}
+
+	for _, addr := range addrs {
+		if k.blocklistKeeper.IsBlocked(ctx, addr) {
+			return sdkerrors.Wrapf(authtypes.ErrBlockedAddress, "%s cannot send or receive funds", addr)
+		}
+	}
+
+	return nil
+}
+
+// checkMultiSendBlocklist returns an error if the address of one of the inputs
+// or outputs is in the blocklist.
+func (k BaseSendKeeper) checkMultiSendBlocklist(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
+	if k.blocklistKeeper == nil {
+		return nil
+	}
+
+	addrs := make([]sdk.AccAddress, 0, len(inputs)+len(outputs))
+	for _, in := range inputs {
+		addr, err := k.AddressCodec().StringToBytes(in.Address)
+		if err != nil {
+			return err
+		}
+		addrs = append(addrs, addr)
+	}
+	for _, out := range outputs {
+		addr, err := k.AddressCodec().StringToBytes(out.Address)
+		if err != nil {
+			return err
+		}
+		addrs = append(addrs, addr)
+	}
+
+	return k.checkBlocklist(ctx, addrs...)
+}
@@ -1,35 +1,83 @@
 package keeper
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // InitGenesis initializes the bank module's state from a given genesis state.
 func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
-	k.SetParams(ctx, genState.Params)
-
 	totalSupply := sdk.Coins{}
 
 	genState.Balances = types.SanitizeGenesisBalances(genState.Balances)
 	for _, balance := range genState.Balances {
-		addr, err := sdk.AccAddressFromBech32(balance.Address)
-		if err != nil {
+		if err := k.initGenesisBalance(ctx, balance); err != nil {
 			panic(err)
 		}
 
-		if err := k.initBalances(ctx, addr, balance.Coins); err != nil {
-			panic(fmt.Errorf("error on setting balances %w", err))
-		}
-
 		totalSupply = totalSupply.Add(balance.Coins...)
 	}
 
+	if err := k.initGenesis(ctx, genState, totalSupply); err != nil {
+		panic(err)
+	}
+}
+
+// InitGenesisFromReader initializes the bank module's state from a JSON
+// genesis state read from r, without holding its balances in memory.
+func (k BaseKeeper) InitGenesisFromReader(ctx sdk.Context, cdc codec.JSONCodec, r io.Reader) error {
+	totalSupply := sdk.Coins{}
+
+	var genState types.GenesisState
+	err := module.ReadGenesis(cdc, r, &genState, map[string]func(json.RawMessage) error{
+		"balances": func(bz json.RawMessage) error {
+			var balance types.Balance
+			if err := cdc.UnmarshalJSON(bz, &balance); err != nil {
+				return err
+			}
+			if err := k.initGenesisBalance(ctx, balance); err != nil {
+				return err
+			}
+
+			totalSupply = totalSupply.Add(balance.Coins...)
+			return nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return k.initGenesis(ctx, &genState, totalSupply)
+}
+
+// initGenesisBalance sets a balance of the genesis state.
+func (k BaseKeeper) initGenesisBalance(ctx sdk.Context, balance types.Balance) error {
+	addr, err := k.AddressCodec().StringToBytes(balance.Address)
+	if err != nil {
+		return err
+	}
+
+	if err := k.initBalances(ctx, addr, balance.Coins); err != nil {
+		return fmt.Errorf("error on setting balances %w", err)
+	}
+
+	return nil
+}
+
+// initGenesis initializes the state of the genesis state but its balances,
+// whose total supply is given.
+func (k BaseKeeper) initGenesis(ctx sdk.Context, genState *types.GenesisState, totalSupply sdk.Coins) error {
+	k.SetParams(ctx, genState.Params)
+
 	if !genState.Supply.Empty() && !genState.Supply.IsEqual(totalSupply) {
-		panic(fmt.Errorf("genesis supply is incorrect, expected %v, got %v", genState.Supply, totalSupply))
+		return fmt.Errorf("genesis supply is incorrect, expected %v, got %v", genState.Supply, totalSupply)
 	}
 
 	for _, supply := range totalSupply {
@@ -39,19 +87,91 @@ func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	for _, burned := range genState.Burned {
+		k.setBurned(ctx, burned)
+	}
+
+	return nil
 }
 
 // ExportGenesis returns the bank module's genesis state.
 func (k BaseKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
-	totalSupply, _, err := k.GetPaginatedTotalSupply(ctx, &query.PageRequest{Limit: query.MaxLimit})
-	if err != nil {
-		panic(fmt.Errorf("unable to fetch total supply %v", err))
-	}
+	totalSupply, totalBurned := k.exportTotals(ctx)
 
-	return types.NewGenesisState(
+	genState := types.NewGenesisState(
 		k.GetParams(ctx),
 		k.GetAccountsBalances(ctx),
 		totalSupply,
 		k.GetAllDenomMetaData(ctx),
 	)
+	genState.Burned = totalBurned
+
+	return genState
+}
+
+// ExportGenesisToWriter writes the bank module's JSON genesis state to w, as
+// marshaled from ExportGenesis, without holding its balances in memory.
+func (k BaseKeeper) ExportGenesisToWriter(ctx sdk.Context, cdc codec.JSONCodec, w io.Writer) error {
+	totalSupply, totalBurned := k.exportTotals(ctx)
+
+	gw := module.NewGenesisWriter(cdc, w)
+	params := k.GetParams(ctx)
+	gw.WriteMessageField("params", &params)
+
+	// the balances of an account are contiguous in the store
+	gw.BeginArray("balances")
+	var balance *types.Balance
+	k.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) bool {
+		if balance != nil && balance.Address != addr.String() {
+			gw.WriteItem(balance)
+			balance = nil
+		}
+		if balance == nil {
+			balance = &types.Balance{Address: addr.String()}
+		}
+
+		balance.Coins = balance.Coins.Add(coin)
+		return false
+	})
+	if balance != nil {
+		gw.WriteItem(balance)
+	}
+	gw.EndArray()
+
+	gw.BeginArray("supply")
+	for i := range totalSupply {
+		gw.WriteItem(&totalSupply[i])
+	}
+	gw.EndArray()
+
+	gw.BeginArray("denom_metadata")
+	k.IterateAllDenomMetaData(ctx, func(meta types.Metadata) bool {
+		gw.WriteItem(&meta)
+		return false
+	})
+	gw.EndArray()
+
+	gw.BeginArray("burned")
+	for i := range totalBurned {
+		gw.WriteItem(&totalBurned[i])
+	}
+	gw.EndArray()
+
+	return gw.Close()
+}
+
+// exportTotals returns the total supply and the total burned coins.
+func (k BaseKeeper) exportTotals(ctx sdk.Context) (totalSupply, totalBurned sdk.Coins) {
+	totalSupply, _, err := k.GetPaginatedTotalSupply(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	if err != nil {
+		panic(fmt.Errorf("unable to fetch total supply %v", err))
+	}
+
+	totalBurned, _, err = k.GetPaginatedTotalBurned(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	if err != nil {
+		panic(fmt.Errorf("unable to fetch total burned %v", err))
+	}
+
+	return totalSupply, totalBurned
 }
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -37,6 +39,36 @@ func (suite *IntegrationTestSuite) TestExportGenesis() {
 	suite.Require().Equal(expectedMetadata, exportGenesis.DenomMetadata)
 }
 
+func (suite *IntegrationTestSuite) TestExportGenesisToWriter() {
+	app, ctx := suite.app, suite.ctx
+	cdc := app.AppCodec()
+
+	expectedMetadata := suite.getTestMetadata()
+	expectedBalances, _ := suite.getTestBalancesAndSupply()
+	for i := range []int{1, 2} {
+		app.BankKeeper.SetDenomMetaData(ctx, expectedMetadata[i])
+		accAddr, err := sdk.AccAddressFromBech32(expectedBalances[i].Address)
+		suite.Require().NoError(err)
+		suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, expectedBalances[i].Coins))
+		suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, accAddr, expectedBalances[i].Coins))
+	}
+
+	var buf bytes.Buffer
+	suite.Require().NoError(app.BankKeeper.ExportGenesisToWriter(ctx, cdc, &buf))
+	expected := cdc.MustMarshalJSON(app.BankKeeper.ExportGenesis(ctx))
+	suite.Require().Equal(string(expected), buf.String())
+
+	// the streamed genesis state initializes the same state
+	cacheCtx, _ := ctx.CacheContext()
+	suite.Require().NoError(app.BankKeeper.InitGenesisFromReader(cacheCtx, cdc, bytes.NewReader(expected)))
+	suite.Require().Equal(app.BankKeeper.ExportGenesis(ctx), app.BankKeeper.ExportGenesis(cacheCtx))
+
+	genState := app.BankKeeper.ExportGenesis(ctx)
+	genState.Supply = sdk.NewCoins(sdk.NewInt64Coin("wrongcoin", 1))
+	err := app.BankKeeper.InitGenesisFromReader(cacheCtx, cdc, bytes.NewReader(cdc.MustMarshalJSON(genState)))
+	suite.Require().ErrorContains(err, "genesis supply is incorrect")
+}
+
 func (suite *IntegrationTestSuite) getTestBalancesAndSupply() ([]types.Balance, sdk.Coins) {
 	addr2, _ := sdk.AccAddressFromBech32("cosmos1f9xjhxm0plzrh9cskf4qee4pc2xwp0n0556gh0")
 	addr1, _ := sdk.AccAddressFromBech32("cosmos1t5u0jfg3ljsjrh2m9e47d4ny2hea7eehxrzdgd")
@@ -29,7 +29,7 @@ func (k BaseKeeper) Balance(ctx context.Context, req *types.QueryBalanceRequest)
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	address, err := sdk.AccAddressFromBech32(req.Address)
+	address, err := k.AddressCodec().StringToBytes(req.Address)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
 	}
@@ -49,17 +49,34 @@ func (k BaseKeeper) AllBalances(ctx context.Context, req *types.QueryAllBalances
 		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
 	}
 
-	addr, err := sdk.AccAddressFromBech32(req.Address)
+	addr, err := k.AddressCodec().StringToBytes(req.Address)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
 	}
 
+	var filter query.Filter
+	if req.MinAmount != "" {
+		minAmount, ok := sdk.NewIntFromString(req.MinAmount)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid min amount: %s", req.MinAmount)
+		}
+
+		filter = func(_, value []byte) (bool, error) {
+			var balance sdk.Coin
+			if err := k.cdc.Unmarshal(value, &balance); err != nil {
+				return false, err
+			}
+
+			return balance.Amount.GTE(minAmount), nil
+		}
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	balances := sdk.NewCoins()
 	accountStore := k.getAccountStore(sdkCtx, addr)
 
-	pageRes, err := query.Paginate(accountStore, req.Pagination, func(_, value []byte) error {
+	pageRes, err := query.PaginateWithFilter(accountStore, req.Pagination, filter, func(_, value []byte) error {
 		var result sdk.Coin
 		err := k.cdc.Unmarshal(value, &result)
 		if err != nil {
@@ -83,7 +100,7 @@ func (k BaseKeeper) SpendableBalances(ctx context.Context, req *types.QuerySpend
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	addr, err := sdk.AccAddressFromBech32(req.Address)
+	addr, err := k.AddressCodec().StringToBytes(req.Address)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
 	}
@@ -139,6 +156,84 @@ func (k BaseKeeper) SupplyOf(c context.Context, req *types.QuerySupplyOfRequest)
 	return &types.QuerySupplyOfResponse{Amount: sdk.NewCoin(req.Denom, supply.Amount)}, nil
 }
 
+// SupplyHistory implements the Query/SupplyHistory gRPC method
+func (k BaseKeeper) SupplyHistory(c context.Context, req *types.QuerySupplyHistoryRequest) (*types.QuerySupplyHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	entries, pageRes, err := k.GetPaginatedSupplyHistory(ctx, req.Denom, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySupplyHistoryResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// AccountStatement implements the Query/AccountStatement gRPC method
+func (k BaseKeeper) AccountStatement(c context.Context, req *types.QueryAccountStatementRequest) (*types.QueryAccountStatementResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	addr, err := k.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	if !req.StartTime.IsZero() && !req.EndTime.IsZero() && !req.StartTime.Before(req.EndTime) {
+		return nil, status.Error(codes.InvalidArgument, "start time must be before end time")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	entries, pageRes, err := k.GetPaginatedAccountStatement(ctx, addr, req.StartTime, req.EndTime, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccountStatementResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// TotalBurned implements the Query/TotalBurned gRPC method
+func (k BaseKeeper) TotalBurned(c context.Context, req *types.QueryTotalBurnedRequest) (*types.QueryTotalBurnedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	totalBurned, pageRes, err := k.GetPaginatedTotalBurned(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTotalBurnedResponse{Burned: totalBurned, Pagination: pageRes}, nil
+}
+
+// BurnedOf implements the Query/BurnedOf gRPC method
+func (k BaseKeeper) BurnedOf(c context.Context, req *types.QueryBurnedOfRequest) (*types.QueryBurnedOfResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	burned := k.GetBurned(ctx, req.Denom)
+
+	return &types.QueryBurnedOfResponse{Amount: burned}, nil
+}
+
 // Params implements the gRPC service handler for querying x/bank parameters.
 func (k BaseKeeper) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	if req == nil {
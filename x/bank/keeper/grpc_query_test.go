@@ -88,6 +88,37 @@ func (suite *IntegrationTestSuite) TestQueryAllBalances() {
 	suite.Nil(res.Pagination.NextKey)
 }
 
+func (suite *IntegrationTestSuite) TestQueryAllBalancesMinAmount() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	app.AccountKeeper.SetAccount(ctx, acc)
+	suite.Require().NoError(simapp.FundAccount(app.BankKeeper, ctx, addr, sdk.NewCoins(newFooCoin(50), newBarCoin(30))))
+
+	req := types.NewQueryAllBalancesRequest(addr, &query.PageRequest{CountTotal: true})
+	req.MinAmount = "40"
+	res, err := queryClient.AllBalances(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(50)), res.Balances)
+	suite.Require().Equal(uint64(1), res.Pagination.Total)
+
+	req.MinAmount = "30"
+	res, err = queryClient.AllBalances(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(50), newBarCoin(30)), res.Balances)
+
+	req.MinAmount = "51"
+	res, err = queryClient.AllBalances(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().True(res.Balances.IsZero())
+	suite.Require().Equal(uint64(0), res.Pagination.Total)
+
+	req.MinAmount = "abc"
+	_, err = queryClient.AllBalances(gocontext.Background(), req)
+	suite.Require().Error(err)
+}
+
 func (suite *IntegrationTestSuite) TestSpendableBalances() {
 	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
 	_, _, addr := testdata.KeyTestPubAddr()
@@ -172,6 +203,167 @@ func (suite *IntegrationTestSuite) TestQueryTotalSupplyOf() {
 	suite.Require().Equal(test1Supply, res.Amount)
 }
 
+func (suite *IntegrationTestSuite) TestQueryTotalBurned() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 100))
+	suite.Require().NoError(simapp.FundAccount(app.BankKeeper, ctx, addr, coins))
+	suite.Require().NoError(app.BankKeeper.BurnCoinsFromAccount(ctx, addr, coins))
+
+	res, err := queryClient.TotalBurned(gocontext.Background(), &types.QueryTotalBurnedRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins, res.Burned)
+
+	_, err = queryClient.BurnedOf(gocontext.Background(), &types.QueryBurnedOfRequest{})
+	suite.Require().Error(err)
+
+	burnedRes, err := queryClient.BurnedOf(gocontext.Background(), &types.QueryBurnedOfRequest{Denom: "test"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins[0], burnedRes.Amount)
+
+	burnedRes, err = queryClient.BurnedOf(gocontext.Background(), &types.QueryBurnedOfRequest{Denom: "unburned"})
+	suite.Require().NoError(err)
+	suite.Require().True(burnedRes.Amount.IsZero())
+}
+
+func (suite *IntegrationTestSuite) TestQuerySupplyHistory() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	params := app.BankKeeper.GetParams(ctx)
+	params.SupplyHistoryInterval = 10
+	params.SupplyHistoryMaxEntries = 2
+	app.BankKeeper.SetParams(ctx, params)
+
+	coin := sdk.NewInt64Coin("test", 100)
+	for height := int64(10); height <= 30; height += 5 {
+		suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(coin)))
+		app.BankKeeper.RecordSupplyHistory(ctx.WithBlockHeight(height))
+	}
+
+	_, err := queryClient.SupplyHistory(gocontext.Background(), &types.QuerySupplyHistoryRequest{})
+	suite.Require().Error(err)
+
+	// only heights multiple of the interval are recorded, and only the two most
+	// recent records are kept
+	res, err := queryClient.SupplyHistory(gocontext.Background(), &types.QuerySupplyHistoryRequest{Denom: coin.Denom})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Entries, 2)
+	suite.Require().Equal(int64(20), res.Entries[0].Height)
+	suite.Require().Equal(sdk.NewInt(300), res.Entries[0].Amount)
+	suite.Require().Equal(int64(30), res.Entries[1].Height)
+	suite.Require().Equal(sdk.NewInt(500), res.Entries[1].Amount)
+
+	res, err = queryClient.SupplyHistory(gocontext.Background(), &types.QuerySupplyHistoryRequest{
+		Denom:      coin.Denom,
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Entries, 1)
+	suite.Require().Equal(int64(30), res.Entries[0].Height)
+
+	// disabling the supply history stops recording
+	params.SupplyHistoryInterval = 0
+	app.BankKeeper.SetParams(ctx, params)
+	app.BankKeeper.RecordSupplyHistory(ctx.WithBlockHeight(40))
+
+	res, err = queryClient.SupplyHistory(gocontext.Background(), &types.QuerySupplyHistoryRequest{Denom: coin.Denom})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Entries, 2)
+}
+
+func (suite *IntegrationTestSuite) TestQueryAccountStatement() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	start := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	blockCtx := func(height int64) sdk.Context {
+		return ctx.WithBlockHeight(height).WithBlockTime(start.Add(time.Duration(height) * time.Minute))
+	}
+
+	// the statements are disabled by default
+	suite.Require().NoError(simapp.FundAccount(app.BankKeeper, blockCtx(1), addr1, sdk.NewCoins(newFooCoin(100))))
+
+	params := app.BankKeeper.GetParams(ctx)
+	params.StatementRetentionBlocks = 20
+	app.BankKeeper.SetParams(ctx, params)
+
+	msgCtx := sdk.WithMsgTypeURL(blockCtx(10), sdk.MsgTypeURL(&types.MsgSend{}))
+	suite.Require().NoError(app.BankKeeper.SendCoins(msgCtx, addr1, addr2, sdk.NewCoins(newFooCoin(30))))
+	inputs := []types.Input{{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(20))}}
+	outputs := []types.Output{
+		{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: addr3.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+	}
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(blockCtx(10).WithTxBytes([]byte("tx")), inputs, outputs))
+	suite.Require().NoError(app.BankKeeper.SendCoins(blockCtx(20), addr2, addr1, sdk.NewCoins(newFooCoin(5))))
+
+	_, err := queryClient.AccountStatement(gocontext.Background(), &types.QueryAccountStatementRequest{})
+	suite.Require().Error(err)
+	_, err = queryClient.AccountStatement(gocontext.Background(), &types.QueryAccountStatementRequest{
+		Address:   addr1.String(),
+		StartTime: start.Add(time.Hour),
+		EndTime:   start,
+	})
+	suite.Require().Error(err)
+
+	res, err := queryClient.AccountStatement(gocontext.Background(), &types.QueryAccountStatementRequest{Address: addr1.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Entries, 3)
+	suite.Require().Equal(types.StatementEntry{
+		Height:       10,
+		Time:         start.Add(10 * time.Minute),
+		Spent:        sdk.NewCoins(newFooCoin(30)),
+		Reason:       sdk.MsgTypeURL(&types.MsgSend{}),
+		Counterparty: addr2.String(),
+	}, res.Entries[0])
+	// the counterparty of a multi-send with several outputs is unknown
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(20)), res.Entries[1].Spent)
+	suite.Require().Equal(types.StatementReasonTx, res.Entries[1].Reason)
+	suite.Require().Empty(res.Entries[1].Counterparty)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(5)), res.Entries[2].Received)
+	suite.Require().Equal(types.StatementReasonBlock, res.Entries[2].Reason)
+	suite.Require().Equal(addr2.String(), res.Entries[2].Counterparty)
+
+	res, err = queryClient.AccountStatement(gocontext.Background(), &types.QueryAccountStatementRequest{Address: addr2.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Entries, 3)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10)), res.Entries[1].Received)
+	suite.Require().Equal(addr1.String(), res.Entries[1].Counterparty)
+
+	// the entries are filtered by time and paginated
+	res, err = queryClient.AccountStatement(gocontext.Background(), &types.QueryAccountStatementRequest{
+		Address:    addr1.String(),
+		StartTime:  start.Add(10 * time.Minute),
+		EndTime:    start.Add(20 * time.Minute),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Entries, 1)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(30)), res.Entries[0].Spent)
+
+	res, err = queryClient.AccountStatement(gocontext.Background(), &types.QueryAccountStatementRequest{
+		Address:   addr1.String(),
+		StartTime: start.Add(15 * time.Minute),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Entries, 1)
+	suite.Require().Equal(int64(20), res.Entries[0].Height)
+
+	// the entries older than the retention are pruned
+	app.BankKeeper.PruneAccountStatements(blockCtx(30))
+	res, err = queryClient.AccountStatement(gocontext.Background(), &types.QueryAccountStatementRequest{Address: addr1.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Entries, 1)
+	suite.Require().Equal(int64(20), res.Entries[0].Height)
+	res, err = queryClient.AccountStatement(gocontext.Background(), &types.QueryAccountStatementRequest{Address: addr3.String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Entries)
+}
+
 func (suite *IntegrationTestSuite) TestQueryParams() {
 	res, err := suite.queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
 	suite.Require().NoError(err)
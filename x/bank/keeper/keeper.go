@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"fmt"
+	"io"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -22,7 +24,9 @@ type Keeper interface {
 	SendKeeper
 
 	InitGenesis(sdk.Context, *types.GenesisState)
+	InitGenesisFromReader(sdk.Context, codec.JSONCodec, io.Reader) error
 	ExportGenesis(sdk.Context) *types.GenesisState
+	ExportGenesisToWriter(sdk.Context, codec.JSONCodec, io.Writer) error
 
 	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 	HasSupply(ctx sdk.Context, denom string) bool
@@ -31,6 +35,10 @@ type Keeper interface {
 	GetDenomMetaData(ctx sdk.Context, denom string) (types.Metadata, bool)
 	SetDenomMetaData(ctx sdk.Context, denomMetaData types.Metadata)
 	IterateAllDenomMetaData(ctx sdk.Context, cb func(types.Metadata) bool)
+	RecordSupplyHistory(ctx sdk.Context)
+	GetPaginatedSupplyHistory(ctx sdk.Context, denom string, pagination *query.PageRequest) ([]types.SupplyHistoryEntry, *query.PageResponse, error)
+	PruneAccountStatements(ctx sdk.Context)
+	GetPaginatedAccountStatement(ctx sdk.Context, addr sdk.AccAddress, start, end time.Time, pagination *query.PageRequest) ([]types.StatementEntry, *query.PageResponse, error)
 
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
@@ -39,6 +47,9 @@ type Keeper interface {
 	UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	BurnCoinsFromAccount(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error
+	GetBurned(ctx sdk.Context, denom string) sdk.Coin
+	GetPaginatedTotalBurned(ctx sdk.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
 
 	DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
@@ -55,6 +66,7 @@ type BaseKeeper struct {
 	storeKey               sdk.StoreKey
 	paramSpace             paramtypes.Subspace
 	mintCoinsRestrictionFn MintingRestrictionFn
+	hooks                  types.BankHooks
 }
 
 type MintingRestrictionFn func(ctx sdk.Context, coins sdk.Coins) error
@@ -134,6 +146,18 @@ func (k BaseKeeper) WithMintCoinsRestriction(check MintingRestrictionFn) BaseKee
 	return k
 }
 
+// SetHooks sets the bank hooks. It must be called before the keeper is passed
+// to other modules, as they hold copies of the keeper.
+func (k *BaseKeeper) SetHooks(bh types.BankHooks) *BaseKeeper {
+	if k.hooks != nil {
+		panic("cannot set bank hooks twice")
+	}
+
+	k.hooks = bh
+
+	return k
+}
+
 // DelegateCoins performs delegation by deducting amt coins from an account with
 // address addr. For vesting accounts, delegations amounts are tracked for both
 // vesting and vested coins. The coins are then transferred from the delegator
@@ -179,6 +203,9 @@ func (k BaseKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr
 		return err
 	}
 
+	k.recordStatement(ctx, delegatorAddr, moduleAccAddr.String(), nil, amt)
+	k.recordStatement(ctx, moduleAccAddr, delegatorAddr.String(), amt, nil)
+
 	return nil
 }
 
@@ -211,6 +238,9 @@ func (k BaseKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAdd
 		return err
 	}
 
+	k.recordStatement(ctx, moduleAccAddr, delegatorAddr.String(), nil, amt)
+	k.recordStatement(ctx, delegatorAddr, moduleAccAddr.String(), amt, nil)
+
 	return nil
 }
 
@@ -414,6 +444,7 @@ func (k BaseKeeper) MintCoins(ctx sdk.Context, moduleName string, amounts sdk.Co
 	if err != nil {
 		return err
 	}
+	k.recordStatement(ctx, acc.GetAddress(), "", amounts, nil)
 
 	for _, amount := range amounts {
 		supply := k.GetSupply(ctx, amount.GetDenom())
@@ -444,28 +475,110 @@ func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Co
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "module account %s does not have permissions to burn tokens", moduleName))
 	}
 
-	err := k.subUnlockedCoins(ctx, acc.GetAddress(), amounts)
+	err := k.burnCoins(ctx, acc.GetAddress(), amounts)
+	if err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info("burned tokens from module account", "amount", amounts.String(), "from", moduleName)
+
+	return nil
+}
+
+// BurnCoinsFromAccount burns coins from the spendable balance of an account.
+func (k BaseKeeper) BurnCoinsFromAccount(ctx sdk.Context, addr sdk.AccAddress, amounts sdk.Coins) error {
+	return k.burnCoins(ctx, addr, amounts)
+}
+
+// burnCoins deletes coins from the balance of the burner, decreasing the
+// supply and increasing the burned amount of their denoms.
+func (k BaseKeeper) burnCoins(ctx sdk.Context, burner sdk.AccAddress, amounts sdk.Coins) error {
+	err := k.subUnlockedCoins(ctx, burner, amounts)
 	if err != nil {
 		return err
 	}
+	k.recordStatement(ctx, burner, "", nil, amounts)
 
 	for _, amount := range amounts {
 		supply := k.GetSupply(ctx, amount.GetDenom())
 		supply = supply.Sub(amount)
 		k.setSupply(ctx, supply)
-	}
 
-	logger := k.Logger(ctx)
-	logger.Info("burned tokens from module account", "amount", amounts.String(), "from", moduleName)
+		burned := k.GetBurned(ctx, amount.GetDenom())
+		burned = burned.Add(amount)
+		k.setBurned(ctx, burned)
+	}
 
 	// emit burn event
 	ctx.EventManager().EmitEvent(
-		types.NewCoinBurnEvent(acc.GetAddress(), amounts),
+		types.NewCoinBurnEvent(burner, amounts),
 	)
 
+	if k.hooks != nil {
+		k.hooks.AfterCoinsBurned(ctx, burner, amounts)
+	}
+
 	return nil
 }
 
+// GetBurned retrieves the cumulative amount burned of a given denom.
+func (k BaseKeeper) GetBurned(ctx sdk.Context, denom string) sdk.Coin {
+	store := ctx.KVStore(k.storeKey)
+	burnedStore := prefix.NewStore(store, types.BurnedPrefix)
+
+	bz := burnedStore.Get([]byte(denom))
+	if bz == nil {
+		return sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+
+	var amount sdk.Int
+	err := amount.Unmarshal(bz)
+	if err != nil {
+		panic(fmt.Errorf("unable to unmarshal burned value %v", err))
+	}
+
+	return sdk.NewCoin(denom, amount)
+}
+
+// GetPaginatedTotalBurned queries for the cumulative burned amounts, with a
+// given pagination.
+func (k BaseKeeper) GetPaginatedTotalBurned(ctx sdk.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+	burnedStore := prefix.NewStore(store, types.BurnedPrefix)
+
+	burned := sdk.NewCoins()
+
+	pageRes, err := query.Paginate(burnedStore, pagination, func(key, value []byte) error {
+		var amount sdk.Int
+		err := amount.Unmarshal(value)
+		if err != nil {
+			return fmt.Errorf("unable to convert amount string to Int %v", err)
+		}
+
+		burned = burned.Add(sdk.NewCoin(string(key), amount))
+		return nil
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return burned, pageRes, nil
+}
+
+// setBurned sets the cumulative burned amount for the given coin.
+func (k BaseKeeper) setBurned(ctx sdk.Context, coin sdk.Coin) {
+	intBytes, err := coin.Amount.Marshal()
+	if err != nil {
+		panic(fmt.Errorf("unable to marshal amount value %v", err))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	burnedStore := prefix.NewStore(store, types.BurnedPrefix)
+	burnedStore.Set([]byte(coin.GetDenom()), intBytes)
+}
+
 // setSupply sets the supply for the given coin
 func (k BaseKeeper) setSupply(ctx sdk.Context, coin sdk.Coin) {
 	intBytes, err := coin.Amount.Marshal()
@@ -83,7 +83,7 @@ func (suite *IntegrationTestSuite) initKeepersWithmAccPerms(blockedAddrs map[str
 	maccPerms[randomPerm] = []string{"random"}
 	authKeeper := authkeeper.NewAccountKeeper(
 		appCodec, app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
-		authtypes.ProtoBaseAccount, maccPerms,
+		authtypes.ProtoBaseAccount, maccPerms, sdk.NewBech32AddressCodec(sdk.Bech32MainPrefix),
 	)
 	keeper := keeper.NewBaseKeeper(
 		appCodec, app.GetKey(types.StoreKey), authKeeper,
@@ -302,6 +302,52 @@ func (suite *IntegrationTestSuite) TestSupply_BurnCoins() {
 	suite.Require().Equal(supplyAfterInflation.Sub(initCoins), supplyAfterBurn)
 }
 
+// burnHooks records the coins burned through the bank hooks.
+type burnHooks struct {
+	burned map[string]sdk.Coins
+}
+
+func (h burnHooks) AfterCoinsBurned(_ sdk.Context, burner sdk.AccAddress, amount sdk.Coins) {
+	h.burned[burner.String()] = h.burned[burner.String()].Add(amount...)
+}
+
+func (suite *IntegrationTestSuite) TestBurnCoinsFromAccount() {
+	ctx := suite.ctx
+	authKeeper, keeper := suite.initKeepersWithmAccPerms(make(map[string]bool))
+
+	hooks := burnHooks{burned: make(map[string]sdk.Coins)}
+	keeper.SetHooks(hooks)
+	suite.Require().Panics(func() { keeper.SetHooks(hooks) }, "hooks set twice")
+
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	authKeeper.SetAccount(ctx, authKeeper.NewAccountWithAddress(ctx, addr))
+	suite.Require().NoError(keeper.MintCoins(ctx, authtypes.Minter, initCoins))
+	suite.Require().NoError(keeper.SendCoinsFromModuleToAccount(ctx, authtypes.Minter, addr, initCoins))
+
+	burnCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens.QuoRaw(4)))
+	suite.Require().Error(keeper.BurnCoinsFromAccount(ctx, addr, initCoins.Add(initCoins...)), "insufficient coins")
+	suite.Require().NoError(keeper.BurnCoinsFromAccount(ctx, addr, burnCoins))
+	suite.Require().NoError(keeper.BurnCoinsFromAccount(ctx, addr, burnCoins))
+
+	totalBurned := burnCoins.Add(burnCoins...)
+	suite.Require().Equal(initCoins.Sub(totalBurned), keeper.GetAllBalances(ctx, addr))
+	suite.Require().Equal(initCoins.Sub(totalBurned)[0], keeper.GetSupply(ctx, sdk.DefaultBondDenom))
+	suite.Require().Equal(totalBurned[0], keeper.GetBurned(ctx, sdk.DefaultBondDenom))
+	suite.Require().Equal(totalBurned, hooks.burned[addr.String()])
+
+	// module account burns are tracked as well
+	authKeeper.SetModuleAccount(ctx, burnerAcc)
+	suite.Require().NoError(keeper.SendCoins(ctx, addr, burnerAcc.GetAddress(), burnCoins))
+	suite.Require().NoError(keeper.BurnCoins(ctx, authtypes.Burner, burnCoins))
+
+	totalBurned = totalBurned.Add(burnCoins...)
+	burned, _, err := keeper.GetPaginatedTotalBurned(ctx, &query.PageRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(totalBurned, burned)
+	suite.Require().Equal(burnCoins, hooks.burned[burnerAcc.GetAddress().String()])
+	suite.Require().True(keeper.GetBurned(ctx, fooDenom).IsZero())
+}
+
 func (suite *IntegrationTestSuite) TestSendCoinsNewAccount() {
 	app, ctx := suite.app, suite.ctx
 	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
@@ -453,6 +499,113 @@ func (suite *IntegrationTestSuite) TestSendCoins() {
 	suite.Require().Equal(newBarCoin(25), coins[0], "expected only bar coins in the account balance, got: %v", coins)
 }
 
+func (suite *IntegrationTestSuite) TestMultiSendLimits() {
+	app, ctx := suite.app, suite.ctx
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	suite.Require().NoError(simapp.FundAccount(app.BankKeeper, ctx, addr1, sdk.NewCoins(newFooCoin(90))))
+
+	params := types.DefaultParams()
+	params.MaxMultiSendInputs = 1
+	params.MaxMultiSendOutputs = 2
+	app.BankKeeper.SetParams(ctx, params)
+
+	inputs := []types.Input{{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(60))}}
+	outputs := []types.Output{
+		{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(30))},
+		{Address: addr3.String(), Coins: sdk.NewCoins(newFooCoin(30))},
+	}
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+
+	inputs = []types.Input{
+		{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+	}
+	outputs = []types.Output{{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(20))}}
+	err := app.BankKeeper.InputOutputCoins(ctx, inputs, outputs)
+	suite.Require().ErrorIs(err, types.ErrTooManyInputs)
+
+	inputs = []types.Input{{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(30))}}
+	outputs = []types.Output{
+		{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: addr3.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: addr3.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+	}
+	err = app.BankKeeper.InputOutputCoins(ctx, inputs, outputs)
+	suite.Require().ErrorIs(err, types.ErrTooManyOutputs)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(30)), app.BankKeeper.GetAllBalances(ctx, addr1))
+}
+
+func (suite *IntegrationTestSuite) TestTransferCaps() {
+	app, ctx := suite.app, suite.ctx
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	suite.Require().NoError(simapp.FundAccount(app.BankKeeper, ctx, addr1, sdk.NewCoins(newFooCoin(100), newBarCoin(100))))
+
+	params := types.DefaultParams()
+	params.TransferCaps = sdk.NewCoins(newFooCoin(50))
+	app.BankKeeper.SetParams(ctx, params)
+
+	// the transfers of a capped denom count toward its cap in the block
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(30), newBarCoin(60))))
+	suite.Require().Equal(sdk.NewInt(30), app.BankKeeper.GetBlockTransferVolume(ctx, fooDenom))
+	suite.Require().True(app.BankKeeper.GetBlockTransferVolume(ctx, barDenom).IsZero())
+
+	inputs := []types.Input{{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(20))}}
+	outputs := []types.Output{{Address: addr3.String(), Coins: sdk.NewCoins(newFooCoin(20))}}
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal(sdk.NewInt(50), app.BankKeeper.GetBlockTransferVolume(ctx, fooDenom))
+
+	err := app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(1)))
+	suite.Require().ErrorIs(err, types.ErrTransferCapExceeded)
+	err = app.BankKeeper.InputOutputCoins(ctx, inputs, outputs)
+	suite.Require().ErrorIs(err, types.ErrTransferCapExceeded)
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newBarCoin(40))))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(50)), app.BankKeeper.GetAllBalances(ctx, addr1))
+
+	// the volumes are reset in the next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	suite.Require().True(app.BankKeeper.GetBlockTransferVolume(ctx, fooDenom).IsZero())
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(50))))
+}
+
+func (suite *IntegrationTestSuite) TestBlocklist() {
+	app, ctx := suite.app, suite.ctx
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	suite.Require().NoError(simapp.FundAccount(app.BankKeeper, ctx, addr1, sdk.NewCoins(newFooCoin(100))))
+	suite.Require().NoError(simapp.FundAccount(app.BankKeeper, ctx, addr2, sdk.NewCoins(newFooCoin(100))))
+
+	// a blocked address can neither receive nor send funds
+	app.AccountKeeper.BlockAddress(ctx, addr2)
+	err := app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(10)))
+	suite.Require().ErrorIs(err, authtypes.ErrBlockedAddress)
+	err = app.BankKeeper.SendCoins(ctx, addr2, addr1, sdk.NewCoins(newFooCoin(10)))
+	suite.Require().ErrorIs(err, authtypes.ErrBlockedAddress)
+	err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr2, sdk.NewCoins(newFooCoin(10)))
+	suite.Require().ErrorIs(err, authtypes.ErrBlockedAddress)
+
+	inputs := []types.Input{{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(20))}}
+	outputs := []types.Output{
+		{Address: addr3.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+	}
+	err = app.BankKeeper.InputOutputCoins(ctx, inputs, outputs)
+	suite.Require().ErrorIs(err, authtypes.ErrBlockedAddress)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(100)), app.BankKeeper.GetAllBalances(ctx, addr1))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(100)), app.BankKeeper.GetAllBalances(ctx, addr2))
+
+	app.AccountKeeper.UnblockAddress(ctx, addr2)
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr2, addr1, sdk.NewCoins(newFooCoin(10))))
+}
+
 func (suite *IntegrationTestSuite) TestValidateBalance() {
 	app, ctx := suite.app, suite.ctx
 	now := tmtime.Now()
@@ -1043,7 +1196,7 @@ func (suite *IntegrationTestSuite) TestBalanceTrackingEvents() {
 
 	suite.app.AccountKeeper = authkeeper.NewAccountKeeper(
 		suite.app.AppCodec(), suite.app.GetKey(authtypes.StoreKey), suite.app.GetSubspace(authtypes.ModuleName),
-		authtypes.ProtoBaseAccount, maccPerms,
+		authtypes.ProtoBaseAccount, maccPerms, sdk.NewBech32AddressCodec(sdk.Bech32MainPrefix),
 	)
 
 	suite.app.BankKeeper = keeper.NewBaseKeeper(suite.app.AppCodec(), suite.app.GetKey(types.StoreKey),
@@ -1168,7 +1321,7 @@ func (suite *IntegrationTestSuite) TestMintCoinRestrictions() {
 
 	suite.app.AccountKeeper = authkeeper.NewAccountKeeper(
 		suite.app.AppCodec(), suite.app.GetKey(authtypes.StoreKey), suite.app.GetSubspace(authtypes.ModuleName),
-		authtypes.ProtoBaseAccount, maccPerms,
+		authtypes.ProtoBaseAccount, maccPerms, sdk.NewBech32AddressCodec(sdk.Bech32MainPrefix),
 	)
 	suite.app.AccountKeeper.SetModuleAccount(suite.ctx, multiPermAcc)
 
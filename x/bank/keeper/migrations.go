@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v043 "github.com/cosmos/cosmos-sdk/x/bank/legacy/v043"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -19,3 +20,27 @@ func NewMigrator(keeper BaseKeeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v043.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates from version 2 to 3, initializing the supply history
+// params to their defaults.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeySupplyHistoryInterval, types.DefaultSupplyHistoryInterval)
+	m.keeper.paramSpace.Set(ctx, types.KeySupplyHistoryMaxEntries, types.DefaultSupplyHistoryMaxEntries)
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4, initializing the multi-send limits
+// and the transfer caps params to their defaults, with no limits.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxMultiSendInputs, types.DefaultMaxMultiSendInputs)
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxMultiSendOutputs, types.DefaultMaxMultiSendOutputs)
+	m.keeper.paramSpace.Set(ctx, types.KeyTransferCaps, sdk.Coins{})
+	return nil
+}
+
+// Migrate4to5 migrates from version 4 to 5, initializing the account statements
+// retention param to its default, with the statements disabled.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyStatementRetentionBlocks, types.DefaultStatementRetentionBlocks)
+	return nil
+}
@@ -38,7 +38,7 @@ func queryBalance(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerie
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	address, err := sdk.AccAddressFromBech32(params.Address)
+	address, err := k.AddressCodec().StringToBytes(params.Address)
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +60,7 @@ func queryAllBalance(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQue
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	address, err := sdk.AccAddressFromBech32(params.Address)
+	address, err := k.AddressCodec().StringToBytes(params.Address)
 	if err != nil {
 		return nil, err
 	}
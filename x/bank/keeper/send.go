@@ -23,6 +23,8 @@ type SendKeeper interface {
 	IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool
 	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
 
+	GetBlockTransferVolume(ctx sdk.Context, denom string) sdk.Int
+
 	BlockedAddr(addr sdk.AccAddress) bool
 }
 
@@ -40,6 +42,10 @@ type BaseSendKeeper struct {
 
 	// list of addresses that are restricted from receiving transactions
 	blockedAddrs map[string]bool
+
+	// keeper of the addresses restricted from sending and receiving funds at
+	// all, if any
+	blocklistKeeper types.BlocklistKeeper
 }
 
 func NewBaseSendKeeper(
@@ -69,7 +75,10 @@ func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) {
 
 // InputOutputCoins performs multi-send functionality. It accepts a series of
 // inputs that correspond to a series of outputs. It returns an error if the
-// inputs and outputs don't lineup or if any single transfer of tokens fails.
+// inputs and outputs don't lineup, if they exceed the maximum numbers of inputs
+// and outputs of a multi-send, if one of their addresses is blocked, if the
+// transfer exceeds the transfer cap of one of the denoms in the current block
+// or if any single transfer of tokens fails.
 func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
 	// Safety check ensuring that when sending coins the keeper must maintain the
 	// Check supply invariant and validity of Coins.
@@ -77,8 +86,34 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		return err
 	}
 
+	if err := k.validateMultiSend(ctx, inputs, outputs); err != nil {
+		return err
+	}
+
+	if err := k.checkMultiSendBlocklist(ctx, inputs, outputs); err != nil {
+		return err
+	}
+
+	var amt sdk.Coins
+	for _, in := range inputs {
+		amt = amt.Add(in.Coins...)
+	}
+	if err := k.addTransferVolume(ctx, amt); err != nil {
+		return err
+	}
+
+	// the counterparty of each side of a multi-send is the account on the other
+	// side, if there is a single one
+	var inCounterparty, outCounterparty string
+	if len(inputs) == 1 {
+		inCounterparty = inputs[0].Address
+	}
+	if len(outputs) == 1 {
+		outCounterparty = outputs[0].Address
+	}
+
 	for _, in := range inputs {
-		inAddress, err := sdk.AccAddressFromBech32(in.Address)
+		inAddress, err := k.AddressCodec().StringToBytes(in.Address)
 		if err != nil {
 			return err
 		}
@@ -87,6 +122,7 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		if err != nil {
 			return err
 		}
+		k.recordStatement(ctx, inAddress, outCounterparty, nil, in.Coins)
 
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
@@ -97,7 +133,7 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 	}
 
 	for _, out := range outputs {
-		outAddress, err := sdk.AccAddressFromBech32(out.Address)
+		outAddress, err := k.AddressCodec().StringToBytes(out.Address)
 		if err != nil {
 			return err
 		}
@@ -105,6 +141,7 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		if err != nil {
 			return err
 		}
+		k.recordStatement(ctx, outAddress, inCounterparty, out.Coins, nil)
 
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
@@ -129,9 +166,21 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 }
 
 // SendCoins transfers amt coins from a sending account to a receiving account.
-// An error is returned upon failure.
+// An error is returned upon failure, if one of the accounts is blocked, or if
+// the transfer exceeds the transfer cap of one of the denoms in the current
+// block.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
-	err := k.subUnlockedCoins(ctx, fromAddr, amt)
+	err := k.checkBlocklist(ctx, fromAddr, toAddr)
+	if err != nil {
+		return err
+	}
+
+	err = k.addTransferVolume(ctx, amt)
+	if err != nil {
+		return err
+	}
+
+	err = k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
 	}
@@ -141,6 +190,9 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 		return err
 	}
 
+	k.recordStatement(ctx, fromAddr, toAddr.String(), nil, amt)
+	k.recordStatement(ctx, toAddr, fromAddr.String(), amt, nil)
+
 	// Create account if recipient does not exist.
 	//
 	// NOTE: This should ultimately be removed in favor a more flexible approach
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// recordStatement records a change of the balance of addr, exchanging coins
+// with the counterparty address if any, in the statement of the account if the
+// account statements are enabled by the StatementRetentionBlocks param. The
+// change is attributed to the Msg being executed, if any.
+func (k BaseSendKeeper) recordStatement(ctx sdk.Context, addr sdk.AccAddress, counterparty string, received, spent sdk.Coins) {
+	var retention uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyStatementRetentionBlocks, &retention)
+	if retention == 0 {
+		return
+	}
+
+	reason := sdk.MsgTypeURLFromContext(ctx)
+	if reason == "" {
+		reason = types.StatementReasonBlock
+		if len(ctx.TxBytes()) > 0 {
+			reason = types.StatementReasonTx
+		}
+	}
+
+	entry := types.StatementEntry{
+		Height:       ctx.BlockHeight(),
+		Time:         ctx.BlockTime(),
+		Received:     received,
+		Spent:        spent,
+		Reason:       reason,
+		Counterparty: counterparty,
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	indexKey := types.StatementIndexKey(entry.Height, addr)
+	var seq uint64
+	if bz := store.Get(indexKey); bz != nil {
+		seq = sdk.BigEndianToUint64(bz)
+	}
+
+	store.Set(types.AccountStatementKey(addr, entry.Height, seq), k.cdc.MustMarshal(&entry))
+	store.Set(indexKey, sdk.Uint64ToBigEndian(seq+1))
+}
+
+// PruneAccountStatements deletes the balance changes of the accounts recorded
+// more than StatementRetentionBlocks blocks before the current height. The
+// statements are kept as they are while they are disabled.
+func (k BaseKeeper) PruneAccountStatements(ctx sdk.Context) {
+	var retention uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyStatementRetentionBlocks, &retention)
+	cutoff := ctx.BlockHeight() - int64(retention)
+	if retention == 0 || cutoff <= 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, types.StatementIndexPrefix)
+	iterator := indexStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(cutoff+1)))
+	defer iterator.Close()
+
+	var indexKeys, keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		height, addr, err := types.SplitStatementIndexKey(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		count := sdk.BigEndianToUint64(iterator.Value())
+		for seq := uint64(0); seq < count; seq++ {
+			keys = append(keys, types.AccountStatementKey(addr, height, seq))
+		}
+		indexKeys = append(indexKeys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	for _, key := range indexKeys {
+		indexStore.Delete(key)
+	}
+}
+
+// GetPaginatedAccountStatement returns the recorded balance changes of addr,
+// oldest first, in the blocks whose time is within [start, end), with a given
+// pagination. A zero start or end time leaves the range unbounded on that side.
+func (k BaseKeeper) GetPaginatedAccountStatement(
+	ctx sdk.Context, addr sdk.AccAddress, start, end time.Time, pagination *query.PageRequest,
+) ([]types.StatementEntry, *query.PageResponse, error) {
+	statementStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateAccountStatementPrefix(addr))
+
+	var entries []types.StatementEntry
+	pageRes, err := query.FilteredPaginate(statementStore, pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var entry types.StatementEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return false, err
+		}
+
+		if (!start.IsZero() && entry.Time.Before(start)) || (!end.IsZero() && !entry.Time.Before(end)) {
+			return false, nil
+		}
+
+		if accumulate {
+			entries = append(entries, entry)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return entries, pageRes, nil
+}
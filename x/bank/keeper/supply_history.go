@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// RecordSupplyHistory records the total supply of every denom if the current
+// height is a multiple of the SupplyHistoryInterval param, and prunes the
+// records of these denoms older than SupplyHistoryMaxEntries intervals.
+func (k BaseKeeper) RecordSupplyHistory(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.SupplyHistoryInterval == 0 || ctx.BlockHeight()%int64(params.SupplyHistoryInterval) != 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	cutoff := ctx.BlockHeight() - int64(params.SupplyHistoryInterval*params.SupplyHistoryMaxEntries)
+
+	k.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		entry := types.SupplyHistoryEntry{
+			Height: ctx.BlockHeight(),
+			Time:   ctx.BlockTime(),
+			Amount: coin.Amount,
+		}
+		store.Set(types.SupplyHistoryKey(coin.Denom, entry.Height), k.cdc.MustMarshal(&entry))
+
+		if cutoff > 0 {
+			k.pruneSupplyHistory(ctx, coin.Denom, cutoff)
+		}
+
+		return false
+	})
+}
+
+// pruneSupplyHistory deletes the supply records of denom up to and including
+// the given height.
+func (k BaseKeeper) pruneSupplyHistory(ctx sdk.Context, denom string, height int64) {
+	historyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateSupplyHistoryPrefix(denom))
+	iterator := historyStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(height+1)))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		historyStore.Delete(key)
+	}
+}
+
+// GetPaginatedSupplyHistory returns the recorded supplies of denom, oldest
+// first, with a given pagination.
+func (k BaseKeeper) GetPaginatedSupplyHistory(ctx sdk.Context, denom string, pagination *query.PageRequest) ([]types.SupplyHistoryEntry, *query.PageResponse, error) {
+	historyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateSupplyHistoryPrefix(denom))
+
+	var entries []types.SupplyHistoryEntry
+	pageRes, err := query.Paginate(historyStore, pagination, func(_, value []byte) error {
+		var entry types.SupplyHistoryEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return entries, pageRes, nil
+}
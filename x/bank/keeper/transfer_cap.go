@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// validateMultiSend returns an error if the inputs or the outputs of a
+// multi-send exceed the MaxMultiSendInputs or MaxMultiSendOutputs params. Like
+// the TransferCaps param, they are unlimited if they are not set, e.g. before
+// the migration to version 4 of the module.
+func (k BaseSendKeeper) validateMultiSend(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
+	var maxInputs, maxOutputs uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxMultiSendInputs, &maxInputs)
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxMultiSendOutputs, &maxOutputs)
+
+	if maxInputs > 0 && uint64(len(inputs)) > maxInputs {
+		return sdkerrors.Wrapf(types.ErrTooManyInputs, "%d inputs, maximum %d", len(inputs), maxInputs)
+	}
+	if maxOutputs > 0 && uint64(len(outputs)) > maxOutputs {
+		return sdkerrors.Wrapf(types.ErrTooManyOutputs, "%d outputs, maximum %d", len(outputs), maxOutputs)
+	}
+
+	return nil
+}
+
+// addTransferVolume adds amt to the volumes transferred in the current block
+// of the denoms with a transfer cap, and returns an error if any of them
+// exceeds its cap.
+func (k BaseSendKeeper) addTransferVolume(ctx sdk.Context, amt sdk.Coins) error {
+	var caps sdk.Coins
+	k.paramSpace.GetIfExists(ctx, types.KeyTransferCaps, &caps)
+
+	for _, coin := range amt {
+		limit := caps.AmountOf(coin.Denom)
+		if limit.IsZero() {
+			continue
+		}
+
+		volume := k.GetBlockTransferVolume(ctx, coin.Denom).Add(coin.Amount)
+		if volume.GT(limit) {
+			return sdkerrors.Wrapf(
+				types.ErrTransferCapExceeded, "%s%s transferred in block %d, cap %s%s",
+				volume, coin.Denom, ctx.BlockHeight(), limit, coin.Denom,
+			)
+		}
+
+		entry := types.TransferVolume{Height: ctx.BlockHeight(), Amount: volume}
+		ctx.KVStore(k.storeKey).Set(types.TransferVolumeKey(coin.Denom), k.cdc.MustMarshal(&entry))
+	}
+
+	return nil
+}
+
+// GetBlockTransferVolume returns the amount of denom transferred between
+// accounts in the current block, which is only tracked for the denoms with a
+// transfer cap.
+func (k BaseSendKeeper) GetBlockTransferVolume(ctx sdk.Context, denom string) sdk.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.TransferVolumeKey(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var entry types.TransferVolume
+	k.cdc.MustUnmarshal(bz, &entry)
+	if entry.Height != ctx.BlockHeight() {
+		return sdk.ZeroInt()
+	}
+
+	return entry.Amount
+}
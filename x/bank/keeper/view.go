@@ -29,6 +29,8 @@ type ViewKeeper interface {
 
 	IterateAccountBalances(ctx sdk.Context, addr sdk.AccAddress, cb func(coin sdk.Coin) (stop bool))
 	IterateAllBalances(ctx sdk.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
+
+	AddressCodec() sdk.AddressCodec
 }
 
 // BaseViewKeeper implements a read only keeper implementation of ViewKeeper.
@@ -52,6 +54,11 @@ func (k BaseViewKeeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
 }
 
+// AddressCodec returns the codec of the account addresses.
+func (k BaseViewKeeper) AddressCodec() sdk.AddressCodec {
+	return k.ak.AddressCodec()
+}
+
 // HasBalance returns whether or not an account has at least amt balance.
 func (k BaseViewKeeper) HasBalance(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin) bool {
 	return k.GetBalance(ctx, addr, amt.Denom).IsGTE(amt)
@@ -72,10 +72,17 @@ func TestMigrateJSON(t *testing.T) {
 			]
 		}
 	],
+	"burned": [],
 	"denom_metadata": [],
 	"params": {
 		"default_send_enabled": false,
-		"send_enabled": []
+		"max_multi_send_inputs": "0",
+		"max_multi_send_outputs": "0",
+		"send_enabled": [],
+		"statement_retention_blocks": "0",
+		"supply_history_interval": "0",
+		"supply_history_max_entries": "0",
+		"transfer_caps": []
 	},
 	"supply": [
 		{
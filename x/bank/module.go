@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math/rand"
 	"time"
 
@@ -28,9 +29,11 @@ import (
 )
 
 var (
-	_ module.AppModule           = AppModule{}
-	_ module.AppModuleBasic      = AppModuleBasic{}
-	_ module.AppModuleSimulation = AppModule{}
+	_ module.AppModule                 = AppModule{}
+	_ module.AppModuleBasic            = AppModuleBasic{}
+	_ module.TableExportAppModuleBasic = AppModuleBasic{}
+	_ module.StreamingGenesisAppModule = AppModule{}
+	_ module.AppModuleSimulation       = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the bank module.
@@ -62,6 +65,16 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingCo
 	return data.Validate()
 }
 
+// ExportTables exports the bank state of the genesis as tables.
+func (AppModuleBasic) ExportTables(cdc codec.JSONCodec, bz json.RawMessage) ([]*sdk.Table, error) {
+	var data types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+
+	return data.Tables(), nil
+}
+
 // RegisterRESTRoutes registers the REST routes for the bank module.
 func (AppModuleBasic) RegisterRESTRoutes(clientCtx client.Context, rtr *mux.Router) {
 	rest.RegisterHandlers(clientCtx, rtr)
@@ -105,6 +118,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 
 	m := keeper.NewMigrator(am.keeper.(keeper.BaseKeeper))
 	cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2)
+	cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3)
+	cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4)
+	cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5)
 }
 
 // NewAppModule creates a new AppModule object
@@ -149,6 +165,17 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.
 	return []abci.ValidatorUpdate{}
 }
 
+// InitGenesisFromReader performs genesis initialization for the bank module
+// from a JSON genesis state read from r, streaming its balances. It returns no
+// validator updates.
+func (am AppModule) InitGenesisFromReader(ctx sdk.Context, cdc codec.JSONCodec, r io.Reader) ([]abci.ValidatorUpdate, error) {
+	if err := am.keeper.InitGenesisFromReader(ctx, cdc, r); err != nil {
+		return nil, err
+	}
+
+	return []abci.ValidatorUpdate{}, nil
+}
+
 // ExportGenesis returns the exported genesis state as raw bytes for the bank
 // module.
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
@@ -156,15 +183,24 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+// ExportGenesisToWriter writes the exported genesis state of the bank module
+// to w, streaming its balances.
+func (am AppModule) ExportGenesisToWriter(ctx sdk.Context, cdc codec.JSONCodec, w io.Writer) error {
+	return am.keeper.ExportGenesisToWriter(ctx, cdc, w)
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 5 }
 
 // BeginBlock performs a no-op.
 func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
-// EndBlock returns the end blocker for the bank module. It returns no validator
+// EndBlock returns the end blocker for the bank module, recording the supply
+// history and pruning the expired account statements. It returns no validator
 // updates.
-func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.RecordSupplyHistory(ctx)
+	am.keeper.PruneAccountStatements(ctx)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -9,16 +9,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	_ "github.com/regen-network/cosmos-proto"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -30,6 +34,26 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty" yaml:"send_enabled,omitempty"`
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty" yaml:"default_send_enabled,omitempty"`
+	// supply_history_interval is the number of blocks between two records of
+	// the total supply of each denom. Zero disables the supply history.
+	SupplyHistoryInterval uint64 `protobuf:"varint,3,opt,name=supply_history_interval,json=supplyHistoryInterval,proto3" json:"supply_history_interval,omitempty" yaml:"supply_history_interval"`
+	// supply_history_max_entries is the number of most recent records of the
+	// total supply kept per denom.
+	SupplyHistoryMaxEntries uint64 `protobuf:"varint,4,opt,name=supply_history_max_entries,json=supplyHistoryMaxEntries,proto3" json:"supply_history_max_entries,omitempty" yaml:"supply_history_max_entries"`
+	// max_multi_send_inputs is the maximum number of inputs of a MsgMultiSend.
+	// Zero means no maximum.
+	MaxMultiSendInputs uint64 `protobuf:"varint,5,opt,name=max_multi_send_inputs,json=maxMultiSendInputs,proto3" json:"max_multi_send_inputs,omitempty" yaml:"max_multi_send_inputs"`
+	// max_multi_send_outputs is the maximum number of outputs of a MsgMultiSend.
+	// Zero means no maximum.
+	MaxMultiSendOutputs uint64 `protobuf:"varint,6,opt,name=max_multi_send_outputs,json=maxMultiSendOutputs,proto3" json:"max_multi_send_outputs,omitempty" yaml:"max_multi_send_outputs"`
+	// transfer_caps are the maximum amounts of their denoms transferred between
+	// accounts per block, beyond which transfers fail, as a circuit breaker. The
+	// denoms without a cap are not capped.
+	TransferCaps github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=transfer_caps,json=transferCaps,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"transfer_caps" yaml:"transfer_caps"`
+	// statement_retention_blocks is the number of blocks the balance changes of
+	// each account are kept in its statement. Zero disables the account
+	// statements.
+	StatementRetentionBlocks uint64 `protobuf:"varint,8,opt,name=statement_retention_blocks,json=statementRetentionBlocks,proto3" json:"statement_retention_blocks,omitempty" yaml:"statement_retention_blocks"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -78,6 +102,48 @@ func (m *Params) GetDefaultSendEnabled() bool {
 	return false
 }
 
+func (m *Params) GetSupplyHistoryInterval() uint64 {
+	if m != nil {
+		return m.SupplyHistoryInterval
+	}
+	return 0
+}
+
+func (m *Params) GetSupplyHistoryMaxEntries() uint64 {
+	if m != nil {
+		return m.SupplyHistoryMaxEntries
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMultiSendInputs() uint64 {
+	if m != nil {
+		return m.MaxMultiSendInputs
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMultiSendOutputs() uint64 {
+	if m != nil {
+		return m.MaxMultiSendOutputs
+	}
+	return 0
+}
+
+func (m *Params) GetTransferCaps() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TransferCaps
+	}
+	return nil
+}
+
+func (m *Params) GetStatementRetentionBlocks() uint64 {
+	if m != nil {
+		return m.StatementRetentionBlocks
+	}
+	return 0
+}
+
 // SendEnabled maps coin denom to a send_enabled status (whether a denom is
 // sendable).
 type SendEnabled struct {
@@ -417,6 +483,209 @@ func (m *Metadata) GetSymbol() string {
 	return ""
 }
 
+// SupplyHistoryEntry records the total supply of a denom at a given height.
+type SupplyHistoryEntry struct {
+	// height is the height of the block at the end of which the supply was
+	// recorded.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the time of the block at the end of which the supply was recorded.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// amount is the total supply of the denom.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *SupplyHistoryEntry) Reset()         { *m = SupplyHistoryEntry{} }
+func (m *SupplyHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*SupplyHistoryEntry) ProtoMessage()    {}
+func (*SupplyHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{7}
+}
+func (m *SupplyHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyHistoryEntry.Merge(m, src)
+}
+func (m *SupplyHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyHistoryEntry proto.InternalMessageInfo
+
+func (m *SupplyHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SupplyHistoryEntry) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// TransferVolume records the amount of a denom transferred between accounts in
+// a block, checked against its transfer cap.
+type TransferVolume struct {
+	// height is the height of the block of the transfers.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// amount is the amount of the denom transferred in the block.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *TransferVolume) Reset()         { *m = TransferVolume{} }
+func (m *TransferVolume) String() string { return proto.CompactTextString(m) }
+func (*TransferVolume) ProtoMessage()    {}
+func (*TransferVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{8}
+}
+func (m *TransferVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferVolume.Merge(m, src)
+}
+func (m *TransferVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferVolume proto.InternalMessageInfo
+
+func (m *TransferVolume) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// StatementEntry records a change of the balance of an account in its
+// statement.
+type StatementEntry struct {
+	// height is the height of the block in which the balance changed.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the time of the block in which the balance changed.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// received are the coins added to the balance.
+	Received github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=received,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"received"`
+	// spent are the coins removed from the balance.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+	// reason is the type URL of the Msg which changed the balance, "tx" if it was
+	// changed by its transaction outside of its Msgs, e.g. to pay the fees, or
+	// "block" if it was changed at the beginning or the end of the block.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// counterparty is the address of the account the coins were received from or
+	// sent to, empty if they were minted, burned or exchanged with several
+	// accounts.
+	Counterparty string `protobuf:"bytes,6,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+}
+
+func (m *StatementEntry) Reset()         { *m = StatementEntry{} }
+func (m *StatementEntry) String() string { return proto.CompactTextString(m) }
+func (*StatementEntry) ProtoMessage()    {}
+func (*StatementEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{9}
+}
+func (m *StatementEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatementEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatementEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatementEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatementEntry.Merge(m, src)
+}
+func (m *StatementEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatementEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatementEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatementEntry proto.InternalMessageInfo
+
+func (m *StatementEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *StatementEntry) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *StatementEntry) GetReceived() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Received
+	}
+	return nil
+}
+
+func (m *StatementEntry) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+func (m *StatementEntry) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *StatementEntry) GetCounterparty() string {
+	if m != nil {
+		return m.Counterparty
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
@@ -425,49 +694,78 @@ func init() {
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
 	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
 	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*SupplyHistoryEntry)(nil), "cosmos.bank.v1beta1.SupplyHistoryEntry")
+	proto.RegisterType((*TransferVolume)(nil), "cosmos.bank.v1beta1.TransferVolume")
+	proto.RegisterType((*StatementEntry)(nil), "cosmos.bank.v1beta1.StatementEntry")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 592 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xf6, 0x35, 0x8d, 0x49, 0x2f, 0xb0, 0x1c, 0x15, 0x72, 0x23, 0x61, 0x1b, 0x4b, 0x48, 0x29,
-	0xa2, 0x4e, 0x0a, 0x0c, 0x28, 0x0b, 0x52, 0xca, 0x0f, 0x75, 0x40, 0x20, 0x57, 0x08, 0x09, 0x86,
-	0xe8, 0x9c, 0xbb, 0x06, 0xab, 0xf6, 0x9d, 0x95, 0x3b, 0x57, 0xf5, 0x7f, 0xc0, 0x04, 0x8c, 0x8c,
-	0x9d, 0x59, 0xe1, 0x7f, 0xa0, 0x63, 0x05, 0x0b, 0x53, 0x40, 0xc9, 0xc2, 0xdc, 0xbf, 0x00, 0xf9,
-	0xce, 0xf9, 0x51, 0x29, 0x20, 0x06, 0x06, 0xa6, 0xbc, 0xef, 0xbd, 0xef, 0x7d, 0xef, 0xe9, 0xbb,
-	0xe7, 0x40, 0xbb, 0xcf, 0x45, 0xc2, 0x45, 0x2b, 0xc4, 0xec, 0xa0, 0x75, 0xb8, 0x1d, 0x52, 0x89,
-	0xb7, 0x15, 0xf0, 0xd3, 0x21, 0x97, 0x1c, 0x5d, 0xd6, 0x75, 0x5f, 0xa5, 0xca, 0x7a, 0x63, 0x7d,
-	0xc0, 0x07, 0x5c, 0xd5, 0x5b, 0x45, 0xa4, 0xa9, 0x8d, 0x0d, 0x4d, 0xed, 0xe9, 0x42, 0xd9, 0xa7,
-	0x4b, 0xf3, 0x29, 0x82, 0xce, 0xa6, 0xf4, 0x79, 0xc4, 0x74, 0xdd, 0xfb, 0x0a, 0xa0, 0xf9, 0x14,
-	0x0f, 0x71, 0x22, 0xd0, 0x3e, 0xbc, 0x28, 0x28, 0x23, 0x3d, 0xca, 0x70, 0x18, 0x53, 0x62, 0x01,
-	0xb7, 0xd2, 0xac, 0xdf, 0x72, 0xfd, 0x25, 0x7b, 0xf8, 0x7b, 0x94, 0x91, 0x07, 0x9a, 0xd7, 0xbd,
-	0x76, 0x36, 0x72, 0xae, 0xe6, 0x38, 0x89, 0x3b, 0xde, 0x62, 0xff, 0x4d, 0x9e, 0x44, 0x92, 0x26,
-	0xa9, 0xcc, 0xbd, 0xa0, 0x2e, 0xe6, 0x7c, 0xf4, 0x12, 0xae, 0x13, 0xba, 0x8f, 0xb3, 0x58, 0xf6,
-	0xce, 0xcd, 0x5b, 0x71, 0x41, 0xb3, 0xd6, 0xdd, 0x3c, 0x1b, 0x39, 0xd7, 0xb5, 0xda, 0x32, 0xd6,
-	0xa2, 0x2a, 0x2a, 0x09, 0x0b, 0xcb, 0x74, 0x56, 0xdf, 0x1f, 0x3b, 0x86, 0xf7, 0x08, 0xd6, 0x17,
-	0x92, 0x68, 0x1d, 0x56, 0x09, 0x65, 0x3c, 0xb1, 0x80, 0x0b, 0x9a, 0x6b, 0x81, 0x06, 0xc8, 0x82,
-	0x17, 0xce, 0x8d, 0x0e, 0xa6, 0xb0, 0x53, 0x2b, 0x44, 0x7e, 0x1e, 0x3b, 0xc0, 0x7b, 0x03, 0x60,
-	0x75, 0x97, 0xa5, 0x99, 0x2c, 0xd8, 0x98, 0x90, 0x21, 0x15, 0xa2, 0x54, 0x99, 0x42, 0x84, 0x61,
-	0xb5, 0x30, 0x54, 0x58, 0x2b, 0xca, 0xb0, 0x8d, 0xb9, 0x61, 0x82, 0xce, 0x0c, 0xdb, 0xe1, 0x11,
-	0xeb, 0xb6, 0x4f, 0x46, 0x8e, 0xf1, 0xe1, 0xbb, 0xd3, 0x1c, 0x44, 0xf2, 0x55, 0x16, 0xfa, 0x7d,
-	0x9e, 0x94, 0xaf, 0x55, 0xfe, 0x6c, 0x09, 0x72, 0xd0, 0x92, 0x79, 0x4a, 0x85, 0x6a, 0x10, 0x81,
-	0x56, 0xee, 0xd4, 0x5e, 0xeb, 0x85, 0x0c, 0xef, 0x2d, 0x80, 0xe6, 0x93, 0x4c, 0xfe, 0x47, 0x1b,
-	0x7d, 0x04, 0xd0, 0xdc, 0xcb, 0xd2, 0x34, 0xce, 0x8b, 0xb9, 0x92, 0x4b, 0x1c, 0x97, 0xa7, 0xf3,
-	0x6f, 0xe7, 0x2a, 0xe5, 0xce, 0xc3, 0x72, 0x2e, 0xf8, 0xf2, 0x69, 0xeb, 0xee, 0x8d, 0x3f, 0x76,
-	0x1f, 0xe9, 0x4f, 0x2b, 0xa6, 0x03, 0xdc, 0xcf, 0x5b, 0x87, 0xed, 0x3b, 0x6d, 0x5f, 0xef, 0xb9,
-	0x6b, 0x01, 0xef, 0x39, 0x5c, 0xbb, 0x5f, 0x5c, 0xc1, 0x33, 0x16, 0xc9, 0xdf, 0xdc, 0x47, 0x03,
-	0xd6, 0xe8, 0x51, 0xca, 0x19, 0x65, 0x52, 0x1d, 0xc8, 0xa5, 0x60, 0x86, 0x95, 0xf7, 0x71, 0x84,
-	0x05, 0x15, 0x56, 0xc5, 0xad, 0x28, 0xef, 0x35, 0xf4, 0x3e, 0x03, 0x58, 0x7b, 0x4c, 0x25, 0x26,
-	0x58, 0x62, 0xe4, 0xc2, 0x3a, 0xa1, 0xa2, 0x3f, 0x8c, 0x52, 0x19, 0x71, 0x56, 0xca, 0x2f, 0xa6,
-	0xd0, 0xbd, 0x82, 0xc1, 0x78, 0xd2, 0xcb, 0x58, 0x24, 0xa7, 0x0f, 0x66, 0x2f, 0xfd, 0xe6, 0x66,
-	0xfb, 0x06, 0x90, 0x4c, 0x43, 0x81, 0x10, 0x5c, 0x2d, 0xec, 0xb5, 0x2a, 0x4a, 0x5b, 0xc5, 0xc5,
-	0x76, 0x24, 0x12, 0x69, 0x8c, 0x73, 0x6b, 0x55, 0x5f, 0x46, 0x09, 0x0b, 0x36, 0xc3, 0x09, 0xb5,
-	0xaa, 0x9a, 0x5d, 0xc4, 0xe8, 0x0a, 0x34, 0x45, 0x9e, 0x84, 0x3c, 0xb6, 0x4c, 0x95, 0x2d, 0x51,
-	0x77, 0xe7, 0x64, 0x6c, 0x83, 0xd3, 0xb1, 0x0d, 0x7e, 0x8c, 0x6d, 0xf0, 0x6e, 0x62, 0x1b, 0xa7,
-	0x13, 0xdb, 0xf8, 0x36, 0xb1, 0x8d, 0x17, 0x9b, 0x7f, 0xe3, 0xbb, 0x7a, 0xbc, 0xd0, 0x54, 0x7f,
-	0x33, 0xb7, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xcc, 0x03, 0xbf, 0xe9, 0xee, 0x04, 0x00, 0x00,
+	// 993 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x41, 0x8f, 0xdb, 0x44,
+	0x14, 0xce, 0x6c, 0xb2, 0x69, 0x32, 0x69, 0x7b, 0x98, 0x6e, 0x5b, 0x37, 0x82, 0x38, 0xb5, 0x54,
+	0x94, 0x22, 0xea, 0x6c, 0x0b, 0x87, 0x2a, 0x17, 0xa4, 0x2c, 0x2d, 0xdd, 0xc3, 0x0a, 0x34, 0x29,
+	0x45, 0x2a, 0x07, 0x6b, 0x62, 0xcf, 0x66, 0xad, 0xb5, 0x67, 0x2c, 0xcf, 0x78, 0x15, 0xff, 0x83,
+	0x9e, 0xa0, 0x47, 0x8e, 0x3d, 0x73, 0x40, 0x48, 0xf0, 0x1f, 0xe8, 0xb1, 0xe2, 0x84, 0x38, 0xa4,
+	0x68, 0xf7, 0x82, 0x38, 0xe6, 0x17, 0xa0, 0x99, 0xb1, 0xb3, 0x49, 0x49, 0xa1, 0x08, 0x56, 0xe2,
+	0x64, 0xbf, 0x79, 0xdf, 0xfb, 0xde, 0x9b, 0x37, 0xdf, 0xbc, 0x81, 0x1d, 0x9f, 0x8b, 0x98, 0x8b,
+	0xfe, 0x98, 0xb0, 0xc3, 0xfe, 0xd1, 0xed, 0x31, 0x95, 0xe4, 0xb6, 0x36, 0xdc, 0x24, 0xe5, 0x92,
+	0xa3, 0x4b, 0xc6, 0xef, 0xea, 0xa5, 0xc2, 0xdf, 0xde, 0x9a, 0xf0, 0x09, 0xd7, 0xfe, 0xbe, 0xfa,
+	0x33, 0xd0, 0xf6, 0x35, 0x03, 0xf5, 0x8c, 0xa3, 0x88, 0x33, 0xae, 0xd3, 0x2c, 0x82, 0x2e, 0xb2,
+	0xf8, 0x3c, 0x64, 0x85, 0xdf, 0x9e, 0x70, 0x3e, 0x89, 0x68, 0x5f, 0x5b, 0xe3, 0x6c, 0xbf, 0x2f,
+	0xc3, 0x98, 0x0a, 0x49, 0xe2, 0xc4, 0x00, 0x9c, 0xef, 0xea, 0xb0, 0xfe, 0x29, 0x49, 0x49, 0x2c,
+	0xd0, 0x3e, 0x3c, 0x2f, 0x28, 0x0b, 0x3c, 0xca, 0xc8, 0x38, 0xa2, 0x81, 0x05, 0xba, 0xd5, 0x5e,
+	0xeb, 0x4e, 0xd7, 0x5d, 0x53, 0xa8, 0x3b, 0xa2, 0x2c, 0xb8, 0x67, 0x70, 0xc3, 0xeb, 0xf3, 0x99,
+	0xfd, 0x76, 0x4e, 0xe2, 0x68, 0xe0, 0x2c, 0xc7, 0xbf, 0xc7, 0xe3, 0x50, 0xd2, 0x38, 0x91, 0xb9,
+	0x83, 0x5b, 0xe2, 0x14, 0x8f, 0xbe, 0x80, 0x5b, 0x01, 0xdd, 0x27, 0x59, 0x24, 0xbd, 0x95, 0x7c,
+	0x1b, 0x5d, 0xd0, 0x6b, 0x0c, 0x6f, 0xce, 0x67, 0xf6, 0x0d, 0xc3, 0xb6, 0x0e, 0xb5, 0xcc, 0x8a,
+	0x0a, 0xc0, 0x52, 0x31, 0xe8, 0x31, 0xbc, 0x2a, 0xb2, 0x24, 0x89, 0x72, 0xef, 0x20, 0x14, 0x92,
+	0xa7, 0xb9, 0x17, 0x32, 0x49, 0xd3, 0x23, 0x12, 0x59, 0xd5, 0x2e, 0xe8, 0xd5, 0x86, 0xce, 0x7c,
+	0x66, 0x77, 0x8a, 0x6a, 0xd7, 0x03, 0x1d, 0x7c, 0xd9, 0x78, 0x1e, 0x18, 0xc7, 0x6e, 0xb1, 0x8e,
+	0xc6, 0xb0, 0xfd, 0x4a, 0x48, 0x4c, 0xa6, 0x1e, 0x65, 0x32, 0x0d, 0xa9, 0xb0, 0x6a, 0x9a, 0xfe,
+	0xc6, 0x7c, 0x66, 0x5f, 0x5f, 0x4b, 0xbf, 0x84, 0x75, 0xf0, 0xd5, 0x95, 0x0c, 0x7b, 0x64, 0x7a,
+	0xcf, 0x78, 0xd0, 0x08, 0x5e, 0x56, 0xc0, 0x38, 0x8b, 0x64, 0x68, 0x36, 0x1e, 0xb2, 0x24, 0x93,
+	0xc2, 0xda, 0xd4, 0xf4, 0xdd, 0xf9, 0xcc, 0x7e, 0xcb, 0xd0, 0xaf, 0x85, 0x39, 0x18, 0xc5, 0x64,
+	0xba, 0xa7, 0x96, 0x55, 0x57, 0x76, 0xf5, 0x22, 0x7a, 0x04, 0xaf, 0xbc, 0x82, 0xe6, 0x99, 0xd4,
+	0xac, 0x75, 0xcd, 0xba, 0x74, 0x82, 0xeb, 0x71, 0x0e, 0xbe, 0xb4, 0x4c, 0xfb, 0x89, 0x59, 0x45,
+	0x4f, 0x00, 0xbc, 0x20, 0x53, 0xc2, 0xc4, 0x3e, 0x4d, 0x3d, 0x9f, 0x24, 0xc2, 0x3a, 0xa7, 0x35,
+	0x73, 0xed, 0x54, 0x33, 0x82, 0x2e, 0x34, 0xb3, 0xc3, 0x43, 0x36, 0x7c, 0xf0, 0x7c, 0x66, 0x57,
+	0xe6, 0x33, 0x7b, 0xcb, 0xa4, 0x5b, 0x89, 0x76, 0xbe, 0x79, 0x69, 0xf7, 0x26, 0xa1, 0x3c, 0xc8,
+	0xc6, 0xae, 0xcf, 0xe3, 0x42, 0xe9, 0xc5, 0xe7, 0x96, 0x08, 0x0e, 0xfb, 0x32, 0x4f, 0xa8, 0xd0,
+	0x44, 0x02, 0x9f, 0x2f, 0x63, 0x77, 0x48, 0x22, 0x90, 0x0f, 0xdb, 0x42, 0x12, 0x49, 0x63, 0xca,
+	0xa4, 0x97, 0x52, 0x49, 0x99, 0x0c, 0x39, 0xf3, 0xc6, 0x11, 0xf7, 0x0f, 0x85, 0xd5, 0xf8, 0xd3,
+	0xd9, 0xbc, 0x16, 0xeb, 0x60, 0x6b, 0xe1, 0xc4, 0xa5, 0x6f, 0xa8, 0x5d, 0x83, 0xda, 0xd7, 0xcf,
+	0xec, 0x8a, 0xf3, 0x31, 0x6c, 0x2d, 0x2b, 0x6e, 0x0b, 0x6e, 0x06, 0x94, 0xf1, 0xd8, 0x02, 0x5d,
+	0xd0, 0x6b, 0x62, 0x63, 0x20, 0x0b, 0x9e, 0x5b, 0xd1, 0x35, 0x2e, 0xcd, 0x41, 0x43, 0x91, 0xfc,
+	0xf6, 0xcc, 0x06, 0xce, 0x97, 0x00, 0x6e, 0xea, 0x13, 0x52, 0x68, 0x12, 0x04, 0x29, 0x15, 0xa2,
+	0x60, 0x29, 0x4d, 0x44, 0xe0, 0xa6, 0xba, 0xce, 0xc2, 0xda, 0xf8, 0xbb, 0xce, 0x6e, 0xab, 0xce,
+	0xfe, 0xa3, 0x0e, 0x1a, 0xe6, 0x41, 0xe3, 0x89, 0x29, 0xa8, 0xe2, 0x7c, 0x05, 0x60, 0xdd, 0x9c,
+	0xed, 0xff, 0xa5, 0xa2, 0xef, 0x01, 0xac, 0x8f, 0xf4, 0x55, 0x51, 0x79, 0x25, 0x97, 0x24, 0xb2,
+	0xc0, 0x19, 0xe4, 0xd5, 0xcc, 0x83, 0xfb, 0x45, 0x5e, 0xf0, 0xd3, 0x0f, 0xb7, 0xee, 0xbe, 0xfb,
+	0x97, 0xd1, 0x53, 0x33, 0xd8, 0x23, 0x3a, 0x21, 0x7e, 0xde, 0x3f, 0xda, 0xfe, 0x60, 0xdb, 0x35,
+	0x75, 0xee, 0x5a, 0xc0, 0xf9, 0x1c, 0x36, 0x3f, 0x52, 0x2a, 0xf8, 0x8c, 0x85, 0xf2, 0x35, 0xfa,
+	0x68, 0xc3, 0x06, 0x9d, 0x26, 0x9c, 0x51, 0x26, 0xb5, 0x40, 0x2e, 0xe0, 0x85, 0xad, 0x7b, 0x1f,
+	0x85, 0x44, 0x50, 0x61, 0x55, 0xbb, 0x55, 0xdd, 0x7b, 0x63, 0x3a, 0x3f, 0x02, 0xd8, 0xd8, 0xa3,
+	0x92, 0x04, 0x44, 0x12, 0xd4, 0x85, 0xad, 0x80, 0x0a, 0x3f, 0x0d, 0x13, 0x25, 0xd1, 0x82, 0x7e,
+	0x79, 0x09, 0x7d, 0xa8, 0x10, 0x8c, 0xc7, 0x5e, 0xc6, 0x42, 0x59, 0x1e, 0x58, 0x67, 0xed, 0x40,
+	0x5f, 0xd4, 0x8b, 0x61, 0x50, 0xfe, 0x0a, 0x84, 0x60, 0x4d, 0xb5, 0x57, 0x8f, 0xce, 0x26, 0xd6,
+	0xff, 0xaa, 0xba, 0x20, 0x14, 0x49, 0x44, 0x72, 0x3d, 0xf2, 0x9a, 0xb8, 0x34, 0x15, 0x9a, 0x91,
+	0x98, 0xea, 0x51, 0xd5, 0xc4, 0xfa, 0x1f, 0x5d, 0x81, 0x75, 0x91, 0xc7, 0x63, 0x1e, 0xe9, 0x51,
+	0xd3, 0xc4, 0x85, 0xe5, 0x7c, 0x0b, 0x20, 0x1a, 0x2d, 0xcf, 0x40, 0x35, 0x00, 0x73, 0x05, 0x3f,
+	0xa0, 0xe1, 0xe4, 0x40, 0xea, 0xed, 0x54, 0x71, 0x61, 0xa1, 0xbb, 0xb0, 0xa6, 0x5e, 0x2e, 0xdd,
+	0xaa, 0xd6, 0x9d, 0xb6, 0x6b, 0x9e, 0x35, 0xb7, 0x7c, 0xd6, 0xdc, 0x87, 0xe5, 0xb3, 0x36, 0x6c,
+	0xa8, 0xc3, 0x7f, 0xfa, 0xd2, 0x06, 0x58, 0x47, 0xa0, 0xfb, 0xb0, 0x4e, 0x62, 0x9e, 0x31, 0x69,
+	0x36, 0x31, 0x74, 0x95, 0xff, 0x97, 0x99, 0xfd, 0xce, 0x1b, 0x88, 0x63, 0x97, 0x49, 0x5c, 0x44,
+	0x3b, 0x09, 0xbc, 0xf8, 0xb0, 0x18, 0x38, 0x8f, 0x78, 0x94, 0x99, 0xad, 0xad, 0xad, 0xf5, 0x34,
+	0xe3, 0xc6, 0xbf, 0xca, 0xf8, 0xfb, 0x06, 0xbc, 0x38, 0x2a, 0x47, 0xd1, 0x59, 0xb5, 0x67, 0x02,
+	0x1b, 0x29, 0xf5, 0x69, 0x78, 0x44, 0x03, 0xab, 0xfa, 0xdf, 0x5f, 0xac, 0x05, 0xb9, 0xba, 0xbe,
+	0x22, 0x51, 0x6a, 0xaf, 0x9d, 0xc1, 0xf5, 0xd5, 0xcc, 0xaa, 0x3b, 0x29, 0x25, 0x82, 0xb3, 0x42,
+	0x81, 0x85, 0x85, 0x1c, 0x78, 0xde, 0x57, 0x1d, 0xa5, 0x69, 0x42, 0x52, 0x99, 0x17, 0x4a, 0x5c,
+	0x59, 0x1b, 0xee, 0x3c, 0x3f, 0xee, 0x80, 0x17, 0xc7, 0x1d, 0xf0, 0xeb, 0x71, 0x07, 0x3c, 0x3d,
+	0xe9, 0x54, 0x5e, 0x9c, 0x74, 0x2a, 0x3f, 0x9f, 0x74, 0x2a, 0x8f, 0x6f, 0xbe, 0xc9, 0x1c, 0xd0,
+	0xd5, 0x8c, 0xeb, 0xba, 0xe1, 0xef, 0xff, 0x31, 0x00, 0x7d, 0x2e, 0x41, 0xbd, 0xfc, 0x09, 0x00,
+	0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -546,6 +844,45 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StatementRetentionBlocks != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.StatementRetentionBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.TransferCaps) > 0 {
+		for iNdEx := len(m.TransferCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.MaxMultiSendOutputs != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.MaxMultiSendOutputs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxMultiSendInputs != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.MaxMultiSendInputs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SupplyHistoryMaxEntries != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.SupplyHistoryMaxEntries))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SupplyHistoryInterval != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.SupplyHistoryInterval))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.DefaultSendEnabled {
 		i--
 		if m.DefaultSendEnabled {
@@ -854,6 +1191,168 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SupplyHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintBank(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatementEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatementEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatementEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Counterparty) > 0 {
+		i -= len(m.Counterparty)
+		copy(dAtA[i:], m.Counterparty)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Counterparty)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Received) > 0 {
+		for iNdEx := len(m.Received) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Received[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintBank(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBank(v)
 	base := offset
@@ -880,12 +1379,33 @@ func (m *Params) Size() (n int) {
 	if m.DefaultSendEnabled {
 		n += 2
 	}
-	return n
-}
-
-func (m *SendEnabled) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.SupplyHistoryInterval != 0 {
+		n += 1 + sovBank(uint64(m.SupplyHistoryInterval))
+	}
+	if m.SupplyHistoryMaxEntries != 0 {
+		n += 1 + sovBank(uint64(m.SupplyHistoryMaxEntries))
+	}
+	if m.MaxMultiSendInputs != 0 {
+		n += 1 + sovBank(uint64(m.MaxMultiSendInputs))
+	}
+	if m.MaxMultiSendOutputs != 0 {
+		n += 1 + sovBank(uint64(m.MaxMultiSendOutputs))
+	}
+	if len(m.TransferCaps) > 0 {
+		for _, e := range m.TransferCaps {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if m.StatementRetentionBlocks != 0 {
+		n += 1 + sovBank(uint64(m.StatementRetentionBlocks))
+	}
+	return n
+}
+
+func (m *SendEnabled) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1009,6 +1529,70 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
+func (m *SupplyHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBank(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovBank(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovBank(uint64(l))
+	return n
+}
+
+func (m *TransferVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBank(uint64(m.Height))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovBank(uint64(l))
+	return n
+}
+
+func (m *StatementEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBank(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovBank(uint64(l))
+	if len(m.Received) > 0 {
+		for _, e := range m.Received {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Counterparty)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	return n
+}
+
 func sovBank(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1098,6 +1682,135 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DefaultSendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyHistoryInterval", wireType)
+			}
+			m.SupplyHistoryInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SupplyHistoryInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyHistoryMaxEntries", wireType)
+			}
+			m.SupplyHistoryMaxEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SupplyHistoryMaxEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMultiSendInputs", wireType)
+			}
+			m.MaxMultiSendInputs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMultiSendInputs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMultiSendOutputs", wireType)
+			}
+			m.MaxMultiSendOutputs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMultiSendOutputs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferCaps = append(m.TransferCaps, types.Coin{})
+			if err := m.TransferCaps[len(m.TransferCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatementRetentionBlocks", wireType)
+			}
+			m.StatementRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatementRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
@@ -1914,6 +2627,479 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SupplyHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatementEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatementEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatementEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Received = append(m.Received, types.Coin{})
+			if err := m.Received[len(m.Received)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counterparty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBank(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
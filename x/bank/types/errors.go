@@ -12,4 +12,7 @@ var (
 	ErrSendDisabled          = sdkerrors.Register(ModuleName, 5, "send transactions are disabled")
 	ErrDenomMetadataNotFound = sdkerrors.Register(ModuleName, 6, "client denom metadata not found")
 	ErrInvalidKey            = sdkerrors.Register(ModuleName, 7, "invalid key")
+	ErrTooManyInputs         = sdkerrors.Register(ModuleName, 8, "too many inputs")
+	ErrTooManyOutputs        = sdkerrors.Register(ModuleName, 9, "too many outputs")
+	ErrTransferCapExceeded   = sdkerrors.Register(ModuleName, 10, "block transfer cap exceeded")
 )
@@ -25,4 +25,19 @@ type AccountKeeper interface {
 	GetModuleAccountAndPermissions(ctx sdk.Context, moduleName string) (types.ModuleAccountI, []string)
 	GetModuleAccount(ctx sdk.Context, moduleName string) types.ModuleAccountI
 	SetModuleAccount(ctx sdk.Context, macc types.ModuleAccountI)
+
+	AddressCodec() sdk.AddressCodec
+}
+
+// BankHooks event hooks for coins burned by the bank keeper (noalias)
+type BankHooks interface {
+	// AfterCoinsBurned is called after coins are burned from the balance of
+	// the burner, an account or a module account.
+	AfterCoinsBurned(ctx sdk.Context, burner sdk.AccAddress, amount sdk.Coins)
+}
+
+// BlocklistKeeper defines the expected keeper of the addresses blocked from
+// sending and receiving funds.
+type BlocklistKeeper interface {
+	IsBlocked(ctx sdk.Context, addr sdk.AccAddress) bool
 }
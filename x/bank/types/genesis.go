@@ -46,6 +46,10 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	if err := gs.Burned.Validate(); err != nil {
+		return fmt.Errorf("invalid burned coins: %w", err)
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
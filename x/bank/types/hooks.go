@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// combine multiple bank hooks, all hook functions are run in array sequence
+type MultiBankHooks []BankHooks
+
+func NewMultiBankHooks(hooks ...BankHooks) MultiBankHooks {
+	return hooks
+}
+
+func (h MultiBankHooks) AfterCoinsBurned(ctx sdk.Context, burner sdk.AccAddress, amount sdk.Coins) {
+	for i := range h {
+		h[i].AfterCoinsBurned(ctx, burner, amount)
+	}
+}
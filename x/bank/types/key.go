@@ -23,9 +23,14 @@ const (
 var (
 	// BalancesPrefix is the prefix for the account balances store. We use a byte
 	// (instead of `[]byte("balances")` to save some disk space).
-	BalancesPrefix      = []byte{0x02}
-	SupplyKey           = []byte{0x00}
-	DenomMetadataPrefix = []byte{0x1}
+	BalancesPrefix       = []byte{0x02}
+	SupplyKey            = []byte{0x00}
+	DenomMetadataPrefix  = []byte{0x1}
+	SupplyHistoryPrefix  = []byte{0x03}
+	BurnedPrefix         = []byte{0x04}
+	TransferVolumePrefix = []byte{0x05}
+	StatementPrefix      = []byte{0x06}
+	StatementIndexPrefix = []byte{0x07}
 )
 
 // DenomMetadataKey returns the denomination metadata key.
@@ -34,6 +39,60 @@ func DenomMetadataKey(denom string) []byte {
 	return append(DenomMetadataPrefix, d...)
 }
 
+// CreateSupplyHistoryPrefix creates the prefix for the supply history of a
+// denom.
+func CreateSupplyHistoryPrefix(denom string) []byte {
+	return append(append([]byte{}, SupplyHistoryPrefix...), address.MustLengthPrefix([]byte(denom))...)
+}
+
+// SupplyHistoryKey returns the key of the supply of a denom recorded at the
+// given height.
+func SupplyHistoryKey(denom string, height int64) []byte {
+	return append(CreateSupplyHistoryPrefix(denom), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// TransferVolumeKey returns the key of the transfer volume of a denom in the
+// current block.
+func TransferVolumeKey(denom string) []byte {
+	return append(append([]byte{}, TransferVolumePrefix...), []byte(denom)...)
+}
+
+// CreateAccountStatementPrefix creates the prefix for the statement of an
+// account.
+func CreateAccountStatementPrefix(addr []byte) []byte {
+	return append(append([]byte{}, StatementPrefix...), address.MustLengthPrefix(addr)...)
+}
+
+// AccountStatementKey returns the key of the balance change of an account with
+// the given sequence among its balance changes at the given height.
+func AccountStatementKey(addr []byte, height int64, seq uint64) []byte {
+	key := append(CreateAccountStatementPrefix(addr), sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(key, sdk.Uint64ToBigEndian(seq)...)
+}
+
+// StatementIndexKey returns the key of the number of balance changes of an
+// account at the given height, by which the statements are pruned.
+func StatementIndexKey(height int64, addr []byte) []byte {
+	key := append(append([]byte{}, StatementIndexPrefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(key, address.MustLengthPrefix(addr)...)
+}
+
+// SplitStatementIndexKey returns the height and the account address of a
+// statement index key. The key must not contain the prefix StatementIndexPrefix
+// as the prefix store iterator discards the actual prefix.
+//
+// If invalid key is passed, SplitStatementIndexKey returns ErrInvalidKey.
+func SplitStatementIndexKey(key []byte) (int64, sdk.AccAddress, error) {
+	if len(key) < 9 {
+		return 0, nil, ErrInvalidKey
+	}
+	addr, err := AddressFromBalancesStore(key[8:])
+	if err != nil {
+		return 0, nil, err
+	}
+	return int64(sdk.BigEndianToUint64(key[:8])), addr, nil
+}
+
 // AddressFromBalancesStore returns an account address from a balances prefix
 // store. The key must not contain the prefix BalancesPrefix as the prefix store
 // iterator discards the actual prefix.
@@ -12,6 +12,17 @@ import (
 const (
 	// DefaultSendEnabled enabled
 	DefaultSendEnabled = true
+	// DefaultSupplyHistoryInterval records the total supply every 100 blocks
+	DefaultSupplyHistoryInterval uint64 = 100
+	// DefaultSupplyHistoryMaxEntries keeps the 1000 most recent records of the
+	// total supply of each denom
+	DefaultSupplyHistoryMaxEntries uint64 = 1000
+	// DefaultMaxMultiSendInputs sets no maximum number of inputs per multi-send
+	DefaultMaxMultiSendInputs uint64 = 0
+	// DefaultMaxMultiSendOutputs sets no maximum number of outputs per multi-send
+	DefaultMaxMultiSendOutputs uint64 = 0
+	// DefaultStatementRetentionBlocks disables the account statements
+	DefaultStatementRetentionBlocks uint64 = 0
 )
 
 var (
@@ -19,6 +30,18 @@ var (
 	KeySendEnabled = []byte("SendEnabled")
 	// KeyDefaultSendEnabled is store's key for the DefaultSendEnabled option
 	KeyDefaultSendEnabled = []byte("DefaultSendEnabled")
+	// KeySupplyHistoryInterval is store's key for the SupplyHistoryInterval option
+	KeySupplyHistoryInterval = []byte("SupplyHistoryInterval")
+	// KeySupplyHistoryMaxEntries is store's key for the SupplyHistoryMaxEntries option
+	KeySupplyHistoryMaxEntries = []byte("SupplyHistoryMaxEntries")
+	// KeyMaxMultiSendInputs is store's key for the MaxMultiSendInputs option
+	KeyMaxMultiSendInputs = []byte("MaxMultiSendInputs")
+	// KeyMaxMultiSendOutputs is store's key for the MaxMultiSendOutputs option
+	KeyMaxMultiSendOutputs = []byte("MaxMultiSendOutputs")
+	// KeyTransferCaps is store's key for the TransferCaps option
+	KeyTransferCaps = []byte("TransferCaps")
+	// KeyStatementRetentionBlocks is store's key for the StatementRetentionBlocks option
+	KeyStatementRetentionBlocks = []byte("StatementRetentionBlocks")
 )
 
 // ParamKeyTable for bank module.
@@ -26,11 +49,19 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-// NewParams creates a new parameter configuration for the bank module
+// NewParams creates a new parameter configuration for the bank module, with
+// the default supply history configuration, multi-send limits, transfer caps
+// and account statements retention.
 func NewParams(defaultSendEnabled bool, sendEnabledParams SendEnabledParams) Params {
 	return Params{
-		SendEnabled:        sendEnabledParams,
-		DefaultSendEnabled: defaultSendEnabled,
+		SendEnabled:              sendEnabledParams,
+		DefaultSendEnabled:       defaultSendEnabled,
+		SupplyHistoryInterval:    DefaultSupplyHistoryInterval,
+		SupplyHistoryMaxEntries:  DefaultSupplyHistoryMaxEntries,
+		MaxMultiSendInputs:       DefaultMaxMultiSendInputs,
+		MaxMultiSendOutputs:      DefaultMaxMultiSendOutputs,
+		TransferCaps:             sdk.Coins{},
+		StatementRetentionBlocks: DefaultStatementRetentionBlocks,
 	}
 }
 
@@ -39,7 +70,13 @@ func DefaultParams() Params {
 	return Params{
 		SendEnabled: SendEnabledParams{},
 		// The default send enabled value allows send transfers for all coin denoms
-		DefaultSendEnabled: true,
+		DefaultSendEnabled:       true,
+		SupplyHistoryInterval:    DefaultSupplyHistoryInterval,
+		SupplyHistoryMaxEntries:  DefaultSupplyHistoryMaxEntries,
+		MaxMultiSendInputs:       DefaultMaxMultiSendInputs,
+		MaxMultiSendOutputs:      DefaultMaxMultiSendOutputs,
+		TransferCaps:             sdk.Coins{},
+		StatementRetentionBlocks: DefaultStatementRetentionBlocks,
 	}
 }
 
@@ -48,7 +85,13 @@ func (p Params) Validate() error {
 	if err := validateSendEnabledParams(p.SendEnabled); err != nil {
 		return err
 	}
-	return validateIsBool(p.DefaultSendEnabled)
+	if err := validateIsBool(p.DefaultSendEnabled); err != nil {
+		return err
+	}
+	if p.SupplyHistoryInterval > 0 && p.SupplyHistoryMaxEntries == 0 {
+		return fmt.Errorf("supply history max entries must be positive when the supply history is enabled")
+	}
+	return validateTransferCaps(p.TransferCaps)
 }
 
 // String implements the Stringer interface.
@@ -77,7 +120,8 @@ func (p Params) SetSendEnabledParam(denom string, sendEnabled bool) Params {
 		}
 	}
 	sendParams = append(sendParams, NewSendEnabled(denom, sendEnabled))
-	return NewParams(p.DefaultSendEnabled, sendParams)
+	p.SendEnabled = sendParams
+	return p
 }
 
 // ParamSetPairs implements params.ParamSet
@@ -85,6 +129,12 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeySendEnabled, &p.SendEnabled, validateSendEnabledParams),
 		paramtypes.NewParamSetPair(KeyDefaultSendEnabled, &p.DefaultSendEnabled, validateIsBool),
+		paramtypes.NewParamSetPair(KeySupplyHistoryInterval, &p.SupplyHistoryInterval, validateUint64),
+		paramtypes.NewParamSetPair(KeySupplyHistoryMaxEntries, &p.SupplyHistoryMaxEntries, validateUint64),
+		paramtypes.NewParamSetPair(KeyMaxMultiSendInputs, &p.MaxMultiSendInputs, validateUint64),
+		paramtypes.NewParamSetPair(KeyMaxMultiSendOutputs, &p.MaxMultiSendOutputs, validateUint64),
+		paramtypes.NewParamSetPair(KeyTransferCaps, &p.TransferCaps, validateTransferCaps),
+		paramtypes.NewParamSetPair(KeyStatementRetentionBlocks, &p.StatementRetentionBlocks, validateUint64),
 	}
 }
 
@@ -140,3 +190,22 @@ func validateIsBool(i interface{}) error {
 	}
 	return nil
 }
+
+func validateUint64(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateTransferCaps(i interface{}) error {
+	caps, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if err := caps.Validate(); err != nil {
+		return fmt.Errorf("invalid transfer caps: %w", err)
+	}
+	return nil
+}
@@ -97,6 +97,12 @@ func Test_validateParams(t *testing.T) {
 - denom: foodenom2
   enabled: false
 default_send_enabled: true
+supply_history_interval: 100
+supply_history_max_entries: 1000
+max_multi_send_inputs: 0
+max_multi_send_outputs: 0
+transfer_caps: []
+statement_retention_blocks: 0
 `
 	require.Equal(t, paramYaml, params.String())
 
@@ -107,6 +113,12 @@ default_send_enabled: true
   enabled: false
 - denom: foodenom2
   enabled: false
+supply_history_interval: 100
+supply_history_max_entries: 1000
+max_multi_send_inputs: 0
+max_multi_send_outputs: 0
+transfer_caps: []
+statement_retention_blocks: 0
 `
 	require.Equal(t, paramYaml, params.String())
 
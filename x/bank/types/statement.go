@@ -0,0 +1,11 @@
+package types
+
+const (
+	// StatementReasonTx is the reason of the balance changes made by a
+	// transaction outside of its Msgs, e.g. by its AnteHandler to pay the fees.
+	StatementReasonTx = "tx"
+
+	// StatementReasonBlock is the reason of the balance changes made at the
+	// beginning or the end of a block.
+	StatementReasonBlock = "block"
+)
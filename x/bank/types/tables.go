@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Tables returns the balances and supply of the genesis state as tables.
+func (gs GenesisState) Tables() []*sdk.Table {
+	balances := sdk.NewTable("balances",
+		sdk.TableColumn{Name: "address", Type: sdk.ColumnTypeString},
+		sdk.TableColumn{Name: "denom", Type: sdk.ColumnTypeString},
+		sdk.TableColumn{Name: "amount", Type: sdk.ColumnTypeString},
+	)
+	for _, balance := range gs.Balances {
+		for _, coin := range balance.Coins {
+			balances.AddRow(balance.Address, coin.Denom, coin.Amount.String())
+		}
+	}
+
+	supply := sdk.NewTable("supply",
+		sdk.TableColumn{Name: "denom", Type: sdk.ColumnTypeString},
+		sdk.TableColumn{Name: "amount", Type: sdk.ColumnTypeString},
+	)
+	for _, coin := range gs.Supply {
+		supply.AddRow(coin.Denom, coin.Amount.String())
+	}
+
+	return []*sdk.Table{balances, supply}
+}
@@ -0,0 +1,31 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestGenesisStateTables(t *testing.T) {
+	gs := types.GenesisState{
+		Balances: []types.Balance{
+			{Address: "cosmos1a", Coins: sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 20))},
+			{Address: "cosmos1b", Coins: sdk.NewCoins(sdk.NewInt64Coin("atom", 5))},
+		},
+		Supply: sdk.NewCoins(sdk.NewInt64Coin("atom", 15), sdk.NewInt64Coin("stake", 20)),
+	}
+
+	tables := gs.Tables()
+	require.Len(t, tables, 2)
+	require.Equal(t, "balances", tables[0].Name)
+	require.Equal(t, [][]interface{}{
+		{"cosmos1a", "atom", "10"},
+		{"cosmos1a", "stake", "20"},
+		{"cosmos1b", "atom", "5"},
+	}, tables[0].Rows)
+	require.Equal(t, "supply", tables[1].Name)
+	require.Equal(t, [][]interface{}{{"atom", "15"}, {"stake", "20"}}, tables[1].Rows)
+}
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/batch"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	batchTxCmd := &cobra.Command{
+		Use:                        batch.ModuleName,
+		Short:                      "Batch transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	batchTxCmd.AddCommand(
+		NewCmdBatch(),
+	)
+
+	return batchTxCmd
+}
+
+// NewCmdBatch returns a CLI command handler for creating a MsgBatch
+// transaction out of the messages of one or more unsigned transactions.
+func NewCmdBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [tx_json_file]... --from [key]",
+		Short: "Execute the messages of the given transactions atomically",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Wrap the messages of the given unsigned transactions into a single batch
+which is executed atomically: either all messages succeed, or none of them is applied.
+The transaction must be signed by the signers of all messages, e.g. by generating it
+and signing it in turn with each key:
+
+Example:
+ $ %s tx bank send <alice> <bob> 10stake --generate-only > alice.json
+ $ %s tx bank send <bob> <alice> 10atom --generate-only > bob.json
+ $ %s tx %s exec alice.json bob.json --from alice --generate-only > batch.json
+ $ %s tx sign batch.json --from alice --chain-id <chain-id> > batch-signed.json
+ $ %s tx sign batch-signed.json --from bob --chain-id <chain-id> > batch-signed-all.json
+ $ %s tx broadcast batch-signed-all.json
+`,
+				version.AppName, version.AppName, version.AppName, batch.ModuleName,
+				version.AppName, version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var msgs []sdk.Msg
+			for _, file := range args {
+				theTx, err := authclient.ReadTxFromFile(clientCtx, file)
+				if err != nil {
+					return err
+				}
+				msgs = append(msgs, theTx.GetMsgs()...)
+			}
+
+			msg, err := batch.NewMsgBatch(msgs)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
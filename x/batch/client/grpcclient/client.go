@@ -0,0 +1,33 @@
+// Code generated by typedgen. DO NOT EDIT.
+
+// Package grpcclient implements the typed clients of the batch module.
+package grpcclient
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/typed"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/batch"
+)
+
+// MsgClient is the typed client of the batch Msg service, broadcasting a
+// transaction of each message.
+type MsgClient struct {
+	broadcaster typed.Broadcaster
+}
+
+// NewMsgClient returns a MsgClient broadcasting the transactions with the
+// broadcaster.
+func NewMsgClient(broadcaster typed.Broadcaster) *MsgClient {
+	return &MsgClient{broadcaster: broadcaster}
+}
+
+// Batch broadcasts a transaction of the batch Msg/Batch message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) Batch(ctx context.Context, msg *batch.MsgBatch) (*batch.MsgBatchResponse, *sdk.TxResponse, error) {
+	res := &batch.MsgBatchResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
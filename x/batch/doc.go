@@ -0,0 +1,10 @@
+/*
+Package batch provides a wrapper message executing a list of messages, possibly
+from multiple signers, atomically.
+
+A MsgBatch requires the signatures of all signers of its messages. The messages
+are executed in order, and the state changes of the batch are only committed if
+every message succeeds. This allows accounts to coordinate operations, such as
+over-the-counter swaps, without trusting each other or an escrow contract.
+*/
+package batch
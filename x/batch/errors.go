@@ -0,0 +1,11 @@
+package batch
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/batch module sentinel errors
+var (
+	ErrEmptyBatch  = sdkerrors.Register(ModuleName, 2, "batch contains no messages")
+	ErrNestedBatch = sdkerrors.Register(ModuleName, 3, "batch cannot contain a batch")
+)
@@ -0,0 +1,9 @@
+package batch
+
+// batch module events
+const (
+	EventTypeBatch = "batch"
+
+	AttributeKeyMsgCount = "msg_count"
+	AttributeKeySigners  = "signers"
+)
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/batch"
+)
+
+// Keeper executes batches of messages through the app's Msg service router.
+// It holds no state of its own.
+type Keeper struct {
+	router *baseapp.MsgServiceRouter
+}
+
+// NewKeeper constructs a batch Keeper
+func NewKeeper(router *baseapp.MsgServiceRouter) Keeper {
+	return Keeper{router: router}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", batch.ModuleName))
+}
+
+// ExecuteBatch executes the given messages in order on a cached context, and
+// only commits their state changes and events if all of them succeed. The
+// signers of the messages must already have been authenticated, which the ante
+// handler does for the signers returned by MsgBatch.GetSigners.
+func (k Keeper) ExecuteBatch(ctx sdk.Context, msgs []sdk.Msg) ([][]byte, error) {
+	cacheCtx, write := ctx.CacheContext()
+
+	results := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		if _, ok := msg.(*batch.MsgBatch); ok {
+			return nil, sdkerrors.Wrapf(batch.ErrNestedBatch, "message %d", i)
+		}
+
+		handler := k.router.Handler(msg)
+		if handler == nil {
+			return nil, sdkerrors.ErrUnknownRequest.Wrapf("unrecognized message route: %s", sdk.MsgTypeURL(msg))
+		}
+
+		msgResp, err := handler(cacheCtx, msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to execute message %d; message %v", i, msg)
+		}
+		results[i] = msgResp.Data
+
+		// emit the events from the executed message on the cached context, so
+		// they are discarded along with its state changes if the batch fails
+		events := msgResp.Events
+		sdkEvents := make([]sdk.Event, 0, len(events))
+		for i := 0; i < len(events); i++ {
+			sdkEvents = append(sdkEvents, sdk.Event(events[i]))
+		}
+		cacheCtx.EventManager().EmitEvents(sdkEvents)
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return results, nil
+}
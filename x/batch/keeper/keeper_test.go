@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/batch"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	app   *simapp.SimApp
+	ctx   sdk.Context
+	addrs []sdk.AccAddress
+}
+
+func (s *TestSuite) SetupTest() {
+	s.app = simapp.Setup(false)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{})
+	s.addrs = simapp.AddTestAddrsIncremental(s.app, s.ctx, 2, sdk.NewInt(1000))
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+}
+
+func (s *TestSuite) send(from, to sdk.AccAddress, amount int64) *banktypes.MsgSend {
+	denom := s.app.StakingKeeper.BondDenom(s.ctx)
+	return banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin(denom, amount)))
+}
+
+func (s *TestSuite) balance(addr sdk.AccAddress) int64 {
+	return s.app.BankKeeper.GetBalance(s.ctx, addr, s.app.StakingKeeper.BondDenom(s.ctx)).Amount.Int64()
+}
+
+func (s *TestSuite) TestBatch() {
+	alice, bob := s.addrs[0], s.addrs[1]
+
+	msg, err := batch.NewMsgBatch([]sdk.Msg{s.send(alice, bob, 100), s.send(bob, alice, 300)})
+	s.Require().NoError(err)
+	s.Require().Equal([]sdk.AccAddress{alice, bob}, msg.GetSigners())
+
+	res, err := s.app.BatchKeeper.Batch(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+	s.Require().Len(res.Results, 2)
+	s.Require().Equal(int64(1200), s.balance(alice))
+	s.Require().Equal(int64(800), s.balance(bob))
+
+	var found bool
+	for _, ev := range s.ctx.EventManager().Events() {
+		if ev.Type == batch.EventTypeBatch {
+			found = true
+		}
+	}
+	s.Require().True(found)
+}
+
+func (s *TestSuite) TestBatchAtomic() {
+	alice, bob := s.addrs[0], s.addrs[1]
+
+	// the second message overspends, so the first one must be reverted too
+	msg, err := batch.NewMsgBatch([]sdk.Msg{s.send(alice, bob, 100), s.send(bob, alice, 5000)})
+	s.Require().NoError(err)
+
+	_, err = s.app.BatchKeeper.Batch(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	s.Require().Equal(int64(1000), s.balance(alice))
+	s.Require().Equal(int64(1000), s.balance(bob))
+	s.Require().Empty(s.ctx.EventManager().Events())
+}
+
+func (s *TestSuite) TestBatchNested() {
+	alice, bob := s.addrs[0], s.addrs[1]
+
+	inner, err := batch.NewMsgBatch([]sdk.Msg{s.send(alice, bob, 100)})
+	s.Require().NoError(err)
+
+	_, err = s.app.BatchKeeper.ExecuteBatch(s.ctx, []sdk.Msg{inner})
+	s.Require().ErrorIs(err, batch.ErrNestedBatch)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
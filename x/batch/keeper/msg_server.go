@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/batch"
+)
+
+var _ batch.MsgServer = Keeper{}
+
+// Batch implements the MsgServer.Batch method.
+func (k Keeper) Batch(goCtx context.Context, msg *batch.MsgBatch) (*batch.MsgBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := k.ExecuteBatch(ctx, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	signers := msg.GetSigners()
+	signerStrs := make([]string, len(signers))
+	for i, signer := range signers {
+		signerStrs[i] = signer.String()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			batch.EventTypeBatch,
+			sdk.NewAttribute(batch.AttributeKeyMsgCount, fmt.Sprintf("%d", len(msgs))),
+			sdk.NewAttribute(batch.AttributeKeySigners, strings.Join(signerStrs, ",")),
+		),
+	)
+
+	return &batch.MsgBatchResponse{Results: results}, nil
+}
@@ -0,0 +1,9 @@
+package batch
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "batch"
+
+	// RouterKey is the message route for batch
+	RouterKey = ModuleName
+)
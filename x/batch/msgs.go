@@ -0,0 +1,124 @@
+package batch
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+)
+
+var (
+	_ sdk.Msg = &MsgBatch{}
+
+	// For amino support.
+	_ legacytx.LegacyMsg = &MsgBatch{}
+
+	_ cdctypes.UnpackInterfacesMessage = &MsgBatch{}
+)
+
+// NewMsgBatch creates a new MsgBatch
+func NewMsgBatch(msgs []sdk.Msg) (*MsgBatch, error) {
+	msgsAny := make([]*cdctypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := cdctypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+
+		msgsAny[i] = any
+	}
+
+	return &MsgBatch{Msgs: msgsAny}, nil
+}
+
+// GetMessages returns the cache values from the MsgBatch.Msgs if present.
+func (msg MsgBatch) GetMessages() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(msg.Msgs))
+	for i, msgAny := range msg.Msgs {
+		m, ok := msgAny.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "messages contains %T which is not a sdk.Msg", msgAny)
+		}
+		msgs[i] = m
+	}
+
+	return msgs, nil
+}
+
+// GetSigners implements Msg. It returns the unique signers of all messages of
+// the batch, in order of appearance, so that all of them must sign the tx.
+func (msg MsgBatch) GetSigners() []sdk.AccAddress {
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		panic(err)
+	}
+
+	var signers []sdk.AccAddress
+	seen := make(map[string]bool)
+	for _, m := range msgs {
+		for _, signer := range m.GetSigners() {
+			if !seen[signer.String()] {
+				seen[signer.String()] = true
+				signers = append(signers, signer)
+			}
+		}
+	}
+
+	return signers
+}
+
+// ValidateBasic implements Msg
+func (msg MsgBatch) ValidateBasic() error {
+	if len(msg.Msgs) == 0 {
+		return ErrEmptyBatch
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+
+	for i, m := range msgs {
+		if _, ok := m.(*MsgBatch); ok {
+			return sdkerrors.Wrapf(ErrNestedBatch, "message %d", i)
+		}
+
+		if len(m.GetSigners()) == 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrNoSignatures, "message %d has no signers", i)
+		}
+
+		if err := m.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "message %d", i)
+		}
+	}
+
+	return nil
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgBatch) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgBatch) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgBatch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgBatch) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, x := range msg.Msgs {
+		var m sdk.Msg
+		if err := unpacker.UnpackAny(x, &m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package batch_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/batch"
+)
+
+var (
+	alice = sdk.AccAddress("________alice_______")
+	bob   = sdk.AccAddress("_________bob________")
+)
+
+func newSend(from, to sdk.AccAddress, amount int64) *banktypes.MsgSend {
+	return banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("steak", amount)))
+}
+
+func TestMsgBatchValidateBasic(t *testing.T) {
+	nested, err := batch.NewMsgBatch([]sdk.Msg{newSend(alice, bob, 1)})
+	require.NoError(t, err)
+
+	tests := []struct {
+		title  string
+		msgs   []sdk.Msg
+		expErr error
+	}{
+		{"empty batch", []sdk.Msg{}, batch.ErrEmptyBatch},
+		{"nested batch", []sdk.Msg{newSend(alice, bob, 1), nested}, batch.ErrNestedBatch},
+		{"invalid inner msg", []sdk.Msg{newSend(alice, bob, 0)}, sdkerrors.ErrInvalidCoins},
+		{"valid", []sdk.Msg{newSend(alice, bob, 1), newSend(bob, alice, 2)}, nil},
+	}
+	for _, tc := range tests {
+		msg, err := batch.NewMsgBatch(tc.msgs)
+		require.NoError(t, err, tc.title)
+
+		err = msg.ValidateBasic()
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.title)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.title)
+		}
+	}
+}
+
+func TestMsgBatchGetSigners(t *testing.T) {
+	msg, err := batch.NewMsgBatch([]sdk.Msg{
+		newSend(bob, alice, 1),
+		newSend(alice, bob, 1),
+		newSend(bob, alice, 2),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []sdk.AccAddress{bob, alice}, msg.GetSigners())
+}
@@ -0,0 +1,23 @@
+package blocktime
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/blocktime/keeper"
+	"github.com/cosmos/cosmos-sdk/x/blocktime/types"
+)
+
+// BeginBlocker records the time of the current block and updates the average
+// block time.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	avg := k.RecordBlockTime(ctx)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBlockTime,
+		sdk.NewAttribute(types.AttributeKeyAverageBlockTime, avg.String()),
+	))
+}
@@ -0,0 +1,148 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/blocktime/types"
+)
+
+// GetQueryCmd returns the cli query commands for the blocktime module.
+func GetQueryCmd() *cobra.Command {
+	blockTimeQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the blocktime module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	blockTimeQueryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryEstimateTime(),
+		GetCmdQueryEstimateHeight(),
+	)
+
+	return blockTimeQueryCmd
+}
+
+// GetCmdQueryParams implements a command to return the current blocktime
+// parameters.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current blocktime parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryEstimateTime implements a command to estimate the time of the
+// block at a height.
+func GetCmdQueryEstimateTime() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-time [height]",
+		Short: "Estimate the time of the block at a height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Estimate the time of the block at a height from the time of the most recent
+block and the average block time.
+
+Example:
+$ %s query %s estimate-time 1000000
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[0], err)
+			}
+
+			res, err := queryClient.EstimateTimeAtHeight(cmd.Context(), &types.QueryEstimateTimeAtHeightRequest{Height: height})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryEstimateHeight implements a command to estimate the height of the
+// most recent block at a time.
+func GetCmdQueryEstimateHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-height [time]",
+		Short: "Estimate the height of the most recent block at a time",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Estimate the height of the most recent block at an RFC 3339 time from the time
+of the most recent block and the average block time.
+
+Example:
+$ %s query %s estimate-height 2030-01-01T00:00:00Z
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			t, err := time.Parse(time.RFC3339, args[0])
+			if err != nil {
+				return fmt.Errorf("invalid time %s: %w", args[0], err)
+			}
+
+			res, err := queryClient.EstimateHeightAtTime(cmd.Context(), &types.QueryEstimateHeightAtTimeRequest{Time: t})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
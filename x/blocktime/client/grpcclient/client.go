@@ -0,0 +1,62 @@
+// Code generated by typedgen. DO NOT EDIT.
+
+// Package grpcclient implements the typed clients of the blocktime module.
+package grpcclient
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/typed"
+	"github.com/cosmos/cosmos-sdk/x/blocktime/types"
+)
+
+// QueryClient is the typed client of the blocktime Query service, retrying
+// the queries failing with a transient error.
+type QueryClient struct {
+	client types.QueryClient
+	retry  typed.RetryConfig
+}
+
+// NewQueryClient returns a QueryClient querying through the connection.
+func NewQueryClient(conn gogogrpc.ClientConn, retry typed.RetryConfig) *QueryClient {
+	return &QueryClient{
+		client: types.NewQueryClient(conn),
+		retry:  retry,
+	}
+}
+
+// EstimateHeightAtTime calls the blocktime Query/EstimateHeightAtTime method.
+func (c *QueryClient) EstimateHeightAtTime(ctx context.Context, req *types.QueryEstimateHeightAtTimeRequest, opts ...grpc.CallOption) (*types.QueryEstimateHeightAtTimeResponse, error) {
+	var res *types.QueryEstimateHeightAtTimeResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.EstimateHeightAtTime(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// EstimateTimeAtHeight calls the blocktime Query/EstimateTimeAtHeight method.
+func (c *QueryClient) EstimateTimeAtHeight(ctx context.Context, req *types.QueryEstimateTimeAtHeightRequest, opts ...grpc.CallOption) (*types.QueryEstimateTimeAtHeightResponse, error) {
+	var res *types.QueryEstimateTimeAtHeightResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.EstimateTimeAtHeight(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// Params calls the blocktime Query/Params method.
+func (c *QueryClient) Params(ctx context.Context, req *types.QueryParamsRequest, opts ...grpc.CallOption) (*types.QueryParamsResponse, error) {
+	var res *types.QueryParamsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Params(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
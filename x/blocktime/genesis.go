@@ -0,0 +1,18 @@
+package blocktime
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/blocktime/keeper"
+	"github.com/cosmos/cosmos-sdk/x/blocktime/types"
+)
+
+// InitGenesis new blocktime genesis
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+	keeper.SetBlockTimeInfo(ctx, types.BlockTimeInfo{AverageBlockTime: data.AverageBlockTime})
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(keeper.GetParams(ctx), keeper.GetBlockTimeInfo(ctx).AverageBlockTime)
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/blocktime/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns params of the blocktime module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// EstimateTimeAtHeight estimates the time of the block at the given height.
+func (k Keeper) EstimateTimeAtHeight(c context.Context, req *types.QueryEstimateTimeAtHeightRequest) (*types.QueryEstimateTimeAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	t, avg, err := k.EstimateBlockTime(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEstimateTimeAtHeightResponse{Time: t, AverageBlockTime: avg}, nil
+}
+
+// EstimateHeightAtTime estimates the height of the most recent block at the
+// given time.
+func (k Keeper) EstimateHeightAtTime(c context.Context, req *types.QueryEstimateHeightAtTimeRequest) (*types.QueryEstimateHeightAtTimeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	height, avg, err := k.EstimateBlockHeight(ctx, req.Time)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEstimateHeightAtTimeResponse{Height: height, AverageBlockTime: avg}, nil
+}
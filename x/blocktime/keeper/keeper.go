@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/blocktime/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper of the blocktime store
+type Keeper struct {
+	cdc        codec.BinaryCodec
+	storeKey   sdk.StoreKey
+	paramSpace paramtypes.Subspace
+}
+
+// NewKeeper creates a new blocktime Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   key,
+		paramSpace: paramSpace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetParams returns the total set of blocktime parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of blocktime parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetBlockTimeInfo returns the height and time of the most recent block with
+// the average block time. The height is zero until a block has been recorded.
+func (k Keeper) GetBlockTimeInfo(ctx sdk.Context) (info types.BlockTimeInfo) {
+	bz := ctx.KVStore(k.storeKey).Get(types.BlockTimeInfoKey)
+	if bz == nil {
+		return info
+	}
+
+	k.cdc.MustUnmarshal(bz, &info)
+	return info
+}
+
+// SetBlockTimeInfo sets the height and time of the most recent block with the
+// average block time.
+func (k Keeper) SetBlockTimeInfo(ctx sdk.Context, info types.BlockTimeInfo) {
+	ctx.KVStore(k.storeKey).Set(types.BlockTimeInfoKey, k.cdc.MustMarshal(&info))
+}
+
+// GetAverageBlockTime returns the average block time, the initial block time
+// of the params if it is not known yet.
+func (k Keeper) GetAverageBlockTime(ctx sdk.Context) time.Duration {
+	if avg := k.GetBlockTimeInfo(ctx).AverageBlockTime; avg > 0 {
+		return avg
+	}
+
+	return k.GetParams(ctx).InitialBlockTime
+}
+
+// RecordBlockTime records the height and time of the current block, and
+// updates the average block time with the time of the blocks since the most
+// recent recorded one as an exponential moving average:
+//
+//	average + (blockTime - average) / AveragingWindow
+//
+// The blocks whose time is not after the recorded one, e.g. the first block of
+// a chain after its genesis time, are recorded without updating the average.
+// It returns the average block time.
+func (k Keeper) RecordBlockTime(ctx sdk.Context) time.Duration {
+	info := k.GetBlockTimeInfo(ctx)
+	avg := k.GetAverageBlockTime(ctx)
+
+	if info.Height > 0 && ctx.BlockHeight() > info.Height && ctx.BlockTime().After(info.Time) {
+		blockTime := ctx.BlockTime().Sub(info.Time) / time.Duration(ctx.BlockHeight()-info.Height)
+		avg += (blockTime - avg) / time.Duration(k.GetParams(ctx).AveragingWindow)
+	}
+
+	k.SetBlockTimeInfo(ctx, types.BlockTimeInfo{
+		Height:           ctx.BlockHeight(),
+		Time:             ctx.BlockTime(),
+		AverageBlockTime: avg,
+	})
+
+	return avg
+}
+
+// EstimateBlockTime estimates the time of the block at the given height from
+// the time of the most recent block and the average block time, which it
+// returns with it.
+func (k Keeper) EstimateBlockTime(ctx sdk.Context, height int64) (time.Time, time.Duration, error) {
+	if height <= 0 {
+		return time.Time{}, 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidHeight, "height must be positive: %d", height)
+	}
+
+	info := k.GetBlockTimeInfo(ctx)
+	if info.Height == 0 {
+		return time.Time{}, 0, sdkerrors.Wrap(sdkerrors.ErrNotFound, "no block time recorded")
+	}
+
+	avg := k.GetAverageBlockTime(ctx)
+	return info.Time.Add(time.Duration(height-info.Height) * avg), avg, nil
+}
+
+// EstimateBlockHeight estimates the height of the most recent block at the
+// given time from the time of the most recent block and the average block
+// time, which it returns with it. The heights before the first block are
+// estimated as 1.
+func (k Keeper) EstimateBlockHeight(ctx sdk.Context, t time.Time) (int64, time.Duration, error) {
+	info := k.GetBlockTimeInfo(ctx)
+	if info.Height == 0 {
+		return 0, 0, sdkerrors.Wrap(sdkerrors.ErrNotFound, "no block time recorded")
+	}
+
+	avg := k.GetAverageBlockTime(ctx)
+	d := t.Sub(info.Time)
+	blocks := int64(d / avg)
+	if d < 0 && d%avg != 0 {
+		// round down the blocks before the most recent one, as after it
+		blocks--
+	}
+
+	height := info.Height + blocks
+	if height < 1 {
+		height = 1
+	}
+
+	return height, avg, nil
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/blocktime/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = simapp.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: time.Unix(1000, 0).UTC()})
+	suite.app.BlockTimeKeeper.SetParams(suite.ctx, types.NewParams(2, 5*time.Second))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.app.BlockTimeKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func (suite *KeeperTestSuite) TestRecordBlockTime() {
+	k := suite.app.BlockTimeKeeper
+	suite.Require().Equal(5*time.Second, k.GetAverageBlockTime(suite.ctx))
+
+	// the first block is recorded without updating the average
+	suite.Require().Equal(5*time.Second, k.RecordBlockTime(suite.ctx))
+	suite.Require().Equal(types.BlockTimeInfo{
+		Height: 10, Time: time.Unix(1000, 0).UTC(), AverageBlockTime: 5 * time.Second,
+	}, k.GetBlockTimeInfo(suite.ctx))
+
+	ctx := suite.ctx.WithBlockHeight(11).WithBlockTime(time.Unix(1015, 0).UTC())
+	suite.Require().Equal(10*time.Second, k.RecordBlockTime(ctx))
+
+	// the time since the recorded block is averaged over the skipped heights
+	ctx = suite.ctx.WithBlockHeight(13).WithBlockTime(time.Unix(1045, 0).UTC())
+	suite.Require().Equal(12500*time.Millisecond, k.RecordBlockTime(ctx))
+
+	// a block not after the recorded one does not update the average
+	ctx = suite.ctx.WithBlockHeight(14).WithBlockTime(time.Unix(1045, 0).UTC())
+	suite.Require().Equal(12500*time.Millisecond, k.RecordBlockTime(ctx))
+	suite.Require().Equal(int64(14), k.GetBlockTimeInfo(ctx).Height)
+}
+
+func (suite *KeeperTestSuite) TestEstimations() {
+	k := suite.app.BlockTimeKeeper
+
+	_, err := suite.queryClient.EstimateTimeAtHeight(context.Background(), &types.QueryEstimateTimeAtHeightRequest{Height: 20})
+	suite.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+	_, err = suite.queryClient.EstimateHeightAtTime(context.Background(), &types.QueryEstimateHeightAtTimeRequest{Time: time.Unix(2000, 0)})
+	suite.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+	k.SetBlockTimeInfo(suite.ctx, types.BlockTimeInfo{
+		Height: 10, Time: time.Unix(1000, 0).UTC(), AverageBlockTime: 10 * time.Second,
+	})
+
+	timeRes, err := suite.queryClient.EstimateTimeAtHeight(context.Background(), &types.QueryEstimateTimeAtHeightRequest{Height: 20})
+	suite.Require().NoError(err)
+	suite.Require().Equal(time.Unix(1100, 0).UTC(), timeRes.Time)
+	suite.Require().Equal(10*time.Second, timeRes.AverageBlockTime)
+
+	_, err = suite.queryClient.EstimateTimeAtHeight(context.Background(), &types.QueryEstimateTimeAtHeightRequest{Height: 0})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidHeight)
+
+	testCases := []struct {
+		name      string
+		time      time.Time
+		expHeight int64
+	}{
+		{"recorded block", time.Unix(1000, 0), 10},
+		{"after the recorded block", time.Unix(1025, 0), 12},
+		{"before the recorded block", time.Unix(995, 0), 9},
+		{"before the first block", time.Unix(0, 0), 1},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := suite.queryClient.EstimateHeightAtTime(context.Background(), &types.QueryEstimateHeightAtTimeRequest{Time: tc.time})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expHeight, res.Height)
+			suite.Require().Equal(10*time.Second, res.AverageBlockTime)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	suite.Require().NoError(types.ValidateGenesis(*types.DefaultGenesisState()))
+
+	genesis := types.DefaultGenesisState()
+	genesis.AverageBlockTime = -time.Second
+	suite.Require().EqualError(types.ValidateGenesis(*genesis), "average block time must be non-negative: -1s")
+
+	genesis = types.DefaultGenesisState()
+	genesis.Params.AveragingWindow = 0
+	suite.Require().EqualError(types.ValidateGenesis(*genesis), "averaging window must be positive")
+	genesis.Params = types.NewParams(types.DefaultAveragingWindow, 0)
+	suite.Require().EqualError(types.ValidateGenesis(*genesis), "initial block time must be positive: 0s")
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
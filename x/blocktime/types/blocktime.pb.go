@@ -0,0 +1,660 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/blocktime/v1beta1/blocktime.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the blocktime module.
+type Params struct {
+	// averaging_window is the number of blocks the average block time is
+	// averaged over: each new block time is weighted by 1/averaging_window.
+	AveragingWindow uint64 `protobuf:"varint,1,opt,name=averaging_window,json=averagingWindow,proto3" json:"averaging_window,omitempty" yaml:"averaging_window"`
+	// initial_block_time is the average block time until the time of a block
+	// has been recorded.
+	InitialBlockTime time.Duration `protobuf:"bytes,2,opt,name=initial_block_time,json=initialBlockTime,proto3,stdduration" json:"initial_block_time" yaml:"initial_block_time"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e665baca8e7d2bcd, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetAveragingWindow() uint64 {
+	if m != nil {
+		return m.AveragingWindow
+	}
+	return 0
+}
+
+func (m *Params) GetInitialBlockTime() time.Duration {
+	if m != nil {
+		return m.InitialBlockTime
+	}
+	return 0
+}
+
+// BlockTimeInfo is the height and time of the most recent block, with the
+// average block time up to it.
+type BlockTimeInfo struct {
+	Height           int64         `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Time             time.Time     `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	AverageBlockTime time.Duration `protobuf:"bytes,3,opt,name=average_block_time,json=averageBlockTime,proto3,stdduration" json:"average_block_time" yaml:"average_block_time"`
+}
+
+func (m *BlockTimeInfo) Reset()         { *m = BlockTimeInfo{} }
+func (m *BlockTimeInfo) String() string { return proto.CompactTextString(m) }
+func (*BlockTimeInfo) ProtoMessage()    {}
+func (*BlockTimeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e665baca8e7d2bcd, []int{1}
+}
+func (m *BlockTimeInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockTimeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockTimeInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockTimeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockTimeInfo.Merge(m, src)
+}
+func (m *BlockTimeInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockTimeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockTimeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockTimeInfo proto.InternalMessageInfo
+
+func (m *BlockTimeInfo) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockTimeInfo) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *BlockTimeInfo) GetAverageBlockTime() time.Duration {
+	if m != nil {
+		return m.AverageBlockTime
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmos.blocktime.v1beta1.Params")
+	proto.RegisterType((*BlockTimeInfo)(nil), "cosmos.blocktime.v1beta1.BlockTimeInfo")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/blocktime/v1beta1/blocktime.proto", fileDescriptor_e665baca8e7d2bcd)
+}
+
+var fileDescriptor_e665baca8e7d2bcd = []byte{
+	// 379 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x3f, 0x6b, 0xfa, 0x40,
+	0x1c, 0xc6, 0x73, 0x3f, 0x45, 0x24, 0x3f, 0x4a, 0x25, 0x94, 0x56, 0x2d, 0x24, 0x12, 0x28, 0xb8,
+	0x34, 0xc1, 0x76, 0x29, 0x8e, 0xa1, 0x94, 0x76, 0x2b, 0x22, 0x14, 0xba, 0xc8, 0x45, 0xcf, 0xf3,
+	0x30, 0xc9, 0x49, 0x72, 0x6a, 0x7d, 0x17, 0x8e, 0x8e, 0xbe, 0x1c, 0xe9, 0x64, 0xb7, 0x4e, 0xb6,
+	0xe8, 0xd2, 0xd9, 0x57, 0x50, 0xee, 0x8f, 0x7f, 0xd0, 0xa9, 0x53, 0x72, 0xcf, 0xf3, 0xe4, 0xfb,
+	0x7d, 0x3e, 0xe1, 0xf4, 0x72, 0x93, 0x26, 0x21, 0x4d, 0x5c, 0x3f, 0xa0, 0xcd, 0x2e, 0x23, 0x21,
+	0x72, 0x07, 0x15, 0x1f, 0x31, 0x58, 0xd9, 0x29, 0x4e, 0x2f, 0xa6, 0x8c, 0x1a, 0x79, 0x99, 0x74,
+	0x76, 0xba, 0x4a, 0x16, 0xcf, 0x30, 0xc5, 0x54, 0x84, 0x5c, 0xfe, 0x26, 0xf3, 0x45, 0x13, 0x53,
+	0x8a, 0x03, 0xe4, 0x8a, 0x93, 0xdf, 0x6f, 0xbb, 0xad, 0x7e, 0x0c, 0x19, 0xa1, 0x91, 0xf2, 0xad,
+	0x43, 0x9f, 0xcf, 0x4c, 0x18, 0x0c, 0x7b, 0x32, 0x60, 0xbf, 0x03, 0x3d, 0xf3, 0x0c, 0x63, 0x18,
+	0x26, 0xc6, 0x83, 0x9e, 0x83, 0x03, 0x14, 0x43, 0x4c, 0x22, 0xdc, 0x18, 0x92, 0xa8, 0x45, 0x87,
+	0x79, 0x50, 0x02, 0xe5, 0xb4, 0x77, 0xb9, 0x5e, 0x58, 0x17, 0x23, 0x18, 0x06, 0x55, 0xfb, 0x30,
+	0x61, 0xd7, 0x4e, 0xb7, 0xd2, 0x8b, 0x50, 0x8c, 0x48, 0x37, 0x48, 0x44, 0x18, 0x81, 0x41, 0x43,
+	0x60, 0x34, 0xf8, 0xce, 0xfc, 0xbf, 0x12, 0x28, 0xff, 0xbf, 0x29, 0x38, 0xb2, 0x90, 0xb3, 0x29,
+	0xe4, 0xdc, 0xab, 0xc2, 0xde, 0xd5, 0x6c, 0x61, 0x69, 0xeb, 0x85, 0x55, 0x90, 0x8b, 0x8e, 0x47,
+	0xd8, 0x93, 0x2f, 0x0b, 0xd4, 0x72, 0xca, 0xf0, 0xb8, 0x5e, 0x27, 0x21, 0xaa, 0x66, 0x27, 0x53,
+	0x4b, 0xfb, 0x99, 0x5a, 0xc0, 0xfe, 0x00, 0xfa, 0xc9, 0x56, 0x7f, 0x8a, 0xda, 0xd4, 0x38, 0xd7,
+	0x33, 0x1d, 0x44, 0x70, 0x87, 0x09, 0x92, 0x54, 0x4d, 0x9d, 0x8c, 0x3b, 0x3d, 0xbd, 0xd7, 0xaa,
+	0x78, 0xd4, 0xaa, 0xbe, 0xf9, 0x4d, 0x5e, 0x96, 0xd7, 0x1a, 0xf3, 0xcd, 0xe2, 0x0b, 0x4e, 0x27,
+	0x81, 0xd1, 0x3e, 0x5d, 0xea, 0x8f, 0x74, 0xc7, 0x23, 0x14, 0x9d, 0x32, 0xb6, 0x14, 0xde, 0xe3,
+	0x6c, 0x69, 0x82, 0xf9, 0xd2, 0x04, 0xdf, 0x4b, 0x13, 0x8c, 0x57, 0xa6, 0x36, 0x5f, 0x99, 0xda,
+	0xe7, 0xca, 0xd4, 0x5e, 0x1d, 0x4c, 0x58, 0xa7, 0xef, 0x3b, 0x4d, 0x1a, 0xba, 0xea, 0x82, 0xc9,
+	0xc7, 0x75, 0xd2, 0xea, 0xba, 0x6f, 0x7b, 0xb7, 0x8d, 0x8d, 0x7a, 0x28, 0xf1, 0x33, 0xa2, 0xd5,
+	0xed, 0xef, 0x00, 0x28, 0xc4, 0x25, 0x82, 0x8e, 0x02, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.AveragingWindow != that1.AveragingWindow {
+		return false
+	}
+	if this.InitialBlockTime != that1.InitialBlockTime {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.InitialBlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.InitialBlockTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintBlocktime(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.AveragingWindow != 0 {
+		i = encodeVarintBlocktime(dAtA, i, uint64(m.AveragingWindow))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockTimeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockTimeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockTimeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AverageBlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AverageBlockTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintBlocktime(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintBlocktime(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintBlocktime(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBlocktime(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBlocktime(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AveragingWindow != 0 {
+		n += 1 + sovBlocktime(uint64(m.AveragingWindow))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.InitialBlockTime)
+	n += 1 + l + sovBlocktime(uint64(l))
+	return n
+}
+
+func (m *BlockTimeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBlocktime(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovBlocktime(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.AverageBlockTime)
+	n += 1 + l + sovBlocktime(uint64(l))
+	return n
+}
+
+func sovBlocktime(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBlocktime(x uint64) (n int) {
+	return sovBlocktime(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlocktime
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AveragingWindow", wireType)
+			}
+			m.AveragingWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocktime
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AveragingWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocktime
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlocktime
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocktime
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.InitialBlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlocktime(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlocktime
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockTimeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlocktime
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockTimeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockTimeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocktime
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocktime
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlocktime
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocktime
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocktime
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlocktime
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocktime
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.AverageBlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlocktime(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlocktime
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBlocktime(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBlocktime
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlocktime
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlocktime
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBlocktime
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBlocktime
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBlocktime
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBlocktime        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBlocktime          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBlocktime = fmt.Errorf("proto: unexpected end of group")
+)
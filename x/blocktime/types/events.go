@@ -0,0 +1,8 @@
+package types
+
+// blocktime module event types
+const (
+	EventTypeBlockTime = "block_time"
+
+	AttributeKeyAverageBlockTime = "average_block_time"
+)
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, averageBlockTime time.Duration) *GenesisState {
+	return &GenesisState{
+		Params:           params,
+		AverageBlockTime: averageBlockTime,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), 0)
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if data.AverageBlockTime < 0 {
+		return fmt.Errorf("average block time must be non-negative: %s", data.AverageBlockTime)
+	}
+
+	return nil
+}
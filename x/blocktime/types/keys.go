@@ -0,0 +1,20 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "blocktime"
+
+	// StoreKey is the default store key for blocktime
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the blocktime store.
+	QuerierRoute = StoreKey
+)
+
+// Keys for blocktime store
+// Items are stored with the following key: values
+//
+// - 0x01: BlockTimeInfo
+var (
+	BlockTimeInfoKey = []byte{0x01}
+)
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	yaml "gopkg.in/yaml.v2"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Default parameter values
+const (
+	DefaultAveragingWindow  uint64        = 100
+	DefaultInitialBlockTime time.Duration = 5 * time.Second
+)
+
+// Parameter store keys
+var (
+	KeyAveragingWindow  = []byte("AveragingWindow")
+	KeyInitialBlockTime = []byte("InitialBlockTime")
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// ParamKeyTable for blocktime module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(averagingWindow uint64, initialBlockTime time.Duration) Params {
+	return Params{
+		AveragingWindow:  averagingWindow,
+		InitialBlockTime: initialBlockTime,
+	}
+}
+
+// DefaultParams returns default blocktime module parameters
+func DefaultParams() Params {
+	return NewParams(DefaultAveragingWindow, DefaultInitialBlockTime)
+}
+
+// Validate validates the params
+func (p Params) Validate() error {
+	if err := validateAveragingWindow(p.AveragingWindow); err != nil {
+		return err
+	}
+
+	return validateInitialBlockTime(p.InitialBlockTime)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyAveragingWindow, &p.AveragingWindow, validateAveragingWindow),
+		paramtypes.NewParamSetPair(KeyInitialBlockTime, &p.InitialBlockTime, validateInitialBlockTime),
+	}
+}
+
+func validateAveragingWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("averaging window must be positive")
+	}
+
+	return nil
+}
+
+func validateInitialBlockTime(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("initial block time must be positive: %s", v)
+	}
+
+	return nil
+}
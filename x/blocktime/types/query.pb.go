@@ -0,0 +1,1397 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/blocktime/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3078377ad7941d00, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3078377ad7941d00, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryEstimateTimeAtHeightRequest is the request type for the
+// Query/EstimateTimeAtHeight RPC method.
+type QueryEstimateTimeAtHeightRequest struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryEstimateTimeAtHeightRequest) Reset()         { *m = QueryEstimateTimeAtHeightRequest{} }
+func (m *QueryEstimateTimeAtHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateTimeAtHeightRequest) ProtoMessage()    {}
+func (*QueryEstimateTimeAtHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3078377ad7941d00, []int{2}
+}
+func (m *QueryEstimateTimeAtHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateTimeAtHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateTimeAtHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateTimeAtHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateTimeAtHeightRequest.Merge(m, src)
+}
+func (m *QueryEstimateTimeAtHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateTimeAtHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateTimeAtHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateTimeAtHeightRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateTimeAtHeightRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryEstimateTimeAtHeightResponse is the response type for the
+// Query/EstimateTimeAtHeight RPC method.
+type QueryEstimateTimeAtHeightResponse struct {
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// average_block_time is the average block time the time is estimated from.
+	AverageBlockTime time.Duration `protobuf:"bytes,2,opt,name=average_block_time,json=averageBlockTime,proto3,stdduration" json:"average_block_time"`
+}
+
+func (m *QueryEstimateTimeAtHeightResponse) Reset()         { *m = QueryEstimateTimeAtHeightResponse{} }
+func (m *QueryEstimateTimeAtHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateTimeAtHeightResponse) ProtoMessage()    {}
+func (*QueryEstimateTimeAtHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3078377ad7941d00, []int{3}
+}
+func (m *QueryEstimateTimeAtHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateTimeAtHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateTimeAtHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateTimeAtHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateTimeAtHeightResponse.Merge(m, src)
+}
+func (m *QueryEstimateTimeAtHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateTimeAtHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateTimeAtHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateTimeAtHeightResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateTimeAtHeightResponse) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *QueryEstimateTimeAtHeightResponse) GetAverageBlockTime() time.Duration {
+	if m != nil {
+		return m.AverageBlockTime
+	}
+	return 0
+}
+
+// QueryEstimateHeightAtTimeRequest is the request type for the
+// Query/EstimateHeightAtTime RPC method.
+type QueryEstimateHeightAtTimeRequest struct {
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *QueryEstimateHeightAtTimeRequest) Reset()         { *m = QueryEstimateHeightAtTimeRequest{} }
+func (m *QueryEstimateHeightAtTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateHeightAtTimeRequest) ProtoMessage()    {}
+func (*QueryEstimateHeightAtTimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3078377ad7941d00, []int{4}
+}
+func (m *QueryEstimateHeightAtTimeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateHeightAtTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateHeightAtTimeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateHeightAtTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateHeightAtTimeRequest.Merge(m, src)
+}
+func (m *QueryEstimateHeightAtTimeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateHeightAtTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateHeightAtTimeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateHeightAtTimeRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateHeightAtTimeRequest) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// QueryEstimateHeightAtTimeResponse is the response type for the
+// Query/EstimateHeightAtTime RPC method.
+type QueryEstimateHeightAtTimeResponse struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// average_block_time is the average block time the height is estimated from.
+	AverageBlockTime time.Duration `protobuf:"bytes,2,opt,name=average_block_time,json=averageBlockTime,proto3,stdduration" json:"average_block_time"`
+}
+
+func (m *QueryEstimateHeightAtTimeResponse) Reset()         { *m = QueryEstimateHeightAtTimeResponse{} }
+func (m *QueryEstimateHeightAtTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateHeightAtTimeResponse) ProtoMessage()    {}
+func (*QueryEstimateHeightAtTimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3078377ad7941d00, []int{5}
+}
+func (m *QueryEstimateHeightAtTimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateHeightAtTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateHeightAtTimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateHeightAtTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateHeightAtTimeResponse.Merge(m, src)
+}
+func (m *QueryEstimateHeightAtTimeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateHeightAtTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateHeightAtTimeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateHeightAtTimeResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateHeightAtTimeResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryEstimateHeightAtTimeResponse) GetAverageBlockTime() time.Duration {
+	if m != nil {
+		return m.AverageBlockTime
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.blocktime.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.blocktime.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryEstimateTimeAtHeightRequest)(nil), "cosmos.blocktime.v1beta1.QueryEstimateTimeAtHeightRequest")
+	proto.RegisterType((*QueryEstimateTimeAtHeightResponse)(nil), "cosmos.blocktime.v1beta1.QueryEstimateTimeAtHeightResponse")
+	proto.RegisterType((*QueryEstimateHeightAtTimeRequest)(nil), "cosmos.blocktime.v1beta1.QueryEstimateHeightAtTimeRequest")
+	proto.RegisterType((*QueryEstimateHeightAtTimeResponse)(nil), "cosmos.blocktime.v1beta1.QueryEstimateHeightAtTimeResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/blocktime/v1beta1/query.proto", fileDescriptor_3078377ad7941d00)
+}
+
+var fileDescriptor_3078377ad7941d00 = []byte{
+	// 525 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0x41, 0x6b, 0x13, 0x41,
+	0x18, 0xcd, 0xd4, 0x18, 0x64, 0xbc, 0xc8, 0x18, 0xa4, 0x2e, 0xb2, 0x89, 0x8b, 0x87, 0x88, 0x76,
+	0xc6, 0xc4, 0x4b, 0x69, 0x41, 0x68, 0x50, 0xe8, 0xd1, 0x86, 0x7a, 0x11, 0xa1, 0xcc, 0xa6, 0xe3,
+	0x66, 0x69, 0x37, 0xb3, 0xdd, 0x99, 0x2d, 0x16, 0xf1, 0xe2, 0x0f, 0xd0, 0x82, 0x17, 0x7f, 0x83,
+	0x27, 0x7f, 0x83, 0xa7, 0x7a, 0x2b, 0x78, 0xf1, 0xa4, 0x92, 0xf8, 0x43, 0x64, 0xbe, 0x99, 0xd5,
+	0x34, 0xed, 0xd6, 0x10, 0xe8, 0x29, 0xd9, 0x99, 0xf7, 0xde, 0xf7, 0xde, 0x37, 0x0f, 0xdf, 0xe9,
+	0x4b, 0x95, 0x48, 0xc5, 0xc2, 0x5d, 0xd9, 0xdf, 0xd1, 0x71, 0x22, 0xd8, 0x7e, 0x3b, 0x14, 0x9a,
+	0xb7, 0xd9, 0x5e, 0x2e, 0xb2, 0x03, 0x9a, 0x66, 0x52, 0x4b, 0xb2, 0x68, 0x51, 0xf4, 0x2f, 0x8a,
+	0x3a, 0x94, 0x57, 0x8f, 0x64, 0x24, 0x01, 0xc4, 0xcc, 0x3f, 0x8b, 0xf7, 0x6e, 0x45, 0x52, 0x46,
+	0xbb, 0x82, 0xf1, 0x34, 0x66, 0x7c, 0x38, 0x94, 0x9a, 0xeb, 0x58, 0x0e, 0x95, 0xbb, 0xf5, 0xdd,
+	0x2d, 0x7c, 0x85, 0xf9, 0x4b, 0xb6, 0x9d, 0x67, 0x00, 0x70, 0xf7, 0x8d, 0xe9, 0x7b, 0x33, 0x51,
+	0x69, 0x9e, 0xa4, 0x0e, 0xd0, 0x2a, 0x35, 0xfd, 0xcf, 0x20, 0x20, 0x83, 0x3a, 0x26, 0x1b, 0x26,
+	0xc7, 0x53, 0x9e, 0xf1, 0x44, 0xf5, 0xc4, 0x5e, 0x2e, 0x94, 0x0e, 0x9e, 0xe1, 0xeb, 0x27, 0x4e,
+	0x55, 0x2a, 0x87, 0x4a, 0x90, 0x47, 0xb8, 0x96, 0xc2, 0xc9, 0x22, 0x6a, 0xa2, 0xd6, 0xd5, 0x4e,
+	0x93, 0x96, 0xc5, 0xa6, 0x96, 0xd9, 0xad, 0x1e, 0xfd, 0x68, 0x54, 0x7a, 0x8e, 0x15, 0xac, 0xe0,
+	0x26, 0xc8, 0x3e, 0x51, 0x3a, 0x4e, 0xb8, 0x16, 0x9b, 0x71, 0x22, 0xd6, 0xf4, 0xba, 0x88, 0xa3,
+	0x81, 0x76, 0xa3, 0xc9, 0x0d, 0x5c, 0x1b, 0xc0, 0x01, 0xcc, 0xb8, 0xd4, 0x73, 0x5f, 0xc1, 0x67,
+	0x84, 0x6f, 0x9f, 0x43, 0x76, 0x0e, 0x97, 0x71, 0xd5, 0xd8, 0x70, 0xfe, 0x3c, 0x6a, 0x17, 0x45,
+	0x8b, 0x45, 0xd1, 0xcd, 0x62, 0x51, 0xdd, 0x2b, 0xc6, 0xd9, 0xe1, 0xcf, 0x06, 0xea, 0x01, 0x83,
+	0x6c, 0x60, 0xc2, 0xf7, 0x45, 0xc6, 0x23, 0xb1, 0x05, 0x69, 0xb6, 0x40, 0x67, 0x01, 0x74, 0x6e,
+	0x9e, 0xd2, 0x79, 0xec, 0x1e, 0xc4, 0xca, 0x7c, 0x34, 0x32, 0xd7, 0x1c, 0xbd, 0x6b, 0xd8, 0x66,
+	0x4e, 0xf0, 0x62, 0x2a, 0xae, 0xf5, 0xba, 0xa6, 0xcd, 0x65, 0x11, 0x77, 0x6e, 0xc3, 0xc1, 0xbb,
+	0xe9, 0x85, 0x9c, 0x94, 0x77, 0x0b, 0x29, 0x59, 0xe7, 0x05, 0xc4, 0xed, 0x7c, 0xaa, 0xe2, 0xcb,
+	0x60, 0x88, 0xbc, 0x47, 0xb8, 0x66, 0x0b, 0x40, 0xee, 0x97, 0x57, 0xe4, 0x74, 0xef, 0xbc, 0xa5,
+	0x19, 0xd1, 0x36, 0x5c, 0xd0, 0x7a, 0xfb, 0xed, 0xf7, 0x87, 0x85, 0x80, 0x34, 0x59, 0x69, 0xdf,
+	0x6d, 0xf3, 0xc8, 0x57, 0x84, 0xeb, 0x67, 0x15, 0x87, 0xac, 0xfc, 0x67, 0xe2, 0x39, 0x55, 0xf5,
+	0x56, 0xe7, 0xe2, 0x3a, 0xef, 0xcb, 0xe0, 0xbd, 0x43, 0x1e, 0x94, 0x7b, 0x17, 0x8e, 0x0f, 0x6f,
+	0xc3, 0x5e, 0xdb, 0x97, 0x7b, 0x43, 0xbe, 0x4c, 0x64, 0x99, 0x7c, 0xf3, 0x99, 0xb3, 0x9c, 0xd1,
+	0x43, 0x6f, 0x75, 0x2e, 0xae, 0xcb, 0xd2, 0x86, 0x2c, 0xf7, 0xc8, 0xdd, 0x19, 0xb2, 0xd8, 0x14,
+	0xdd, 0xf5, 0xa3, 0x91, 0x8f, 0x8e, 0x47, 0x3e, 0xfa, 0x35, 0xf2, 0xd1, 0xe1, 0xd8, 0xaf, 0x1c,
+	0x8f, 0xfd, 0xca, 0xf7, 0xb1, 0x5f, 0x79, 0x4e, 0xa3, 0x58, 0x0f, 0xf2, 0x90, 0xf6, 0x65, 0x52,
+	0xc8, 0xd9, 0x9f, 0x25, 0xb5, 0xbd, 0xc3, 0x5e, 0x4d, 0x68, 0xeb, 0x83, 0x54, 0xa8, 0xb0, 0x06,
+	0x2d, 0x7d, 0xf8, 0x67, 0x00, 0xa0, 0xc5, 0x5b, 0x1d, 0xa9, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the parameters of the blocktime module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// EstimateTimeAtHeight estimates the time of the block at the given height
+	// from the average block time.
+	EstimateTimeAtHeight(ctx context.Context, in *QueryEstimateTimeAtHeightRequest, opts ...grpc.CallOption) (*QueryEstimateTimeAtHeightResponse, error)
+	// EstimateHeightAtTime estimates the height of the most recent block at the
+	// given time from the average block time.
+	EstimateHeightAtTime(ctx context.Context, in *QueryEstimateHeightAtTimeRequest, opts ...grpc.CallOption) (*QueryEstimateHeightAtTimeResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.blocktime.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateTimeAtHeight(ctx context.Context, in *QueryEstimateTimeAtHeightRequest, opts ...grpc.CallOption) (*QueryEstimateTimeAtHeightResponse, error) {
+	out := new(QueryEstimateTimeAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.blocktime.v1beta1.Query/EstimateTimeAtHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateHeightAtTime(ctx context.Context, in *QueryEstimateHeightAtTimeRequest, opts ...grpc.CallOption) (*QueryEstimateHeightAtTimeResponse, error) {
+	out := new(QueryEstimateHeightAtTimeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.blocktime.v1beta1.Query/EstimateHeightAtTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the parameters of the blocktime module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// EstimateTimeAtHeight estimates the time of the block at the given height
+	// from the average block time.
+	EstimateTimeAtHeight(context.Context, *QueryEstimateTimeAtHeightRequest) (*QueryEstimateTimeAtHeightResponse, error)
+	// EstimateHeightAtTime estimates the height of the most recent block at the
+	// given time from the average block time.
+	EstimateHeightAtTime(context.Context, *QueryEstimateHeightAtTimeRequest) (*QueryEstimateHeightAtTimeResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) EstimateTimeAtHeight(ctx context.Context, req *QueryEstimateTimeAtHeightRequest) (*QueryEstimateTimeAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateTimeAtHeight not implemented")
+}
+func (*UnimplementedQueryServer) EstimateHeightAtTime(ctx context.Context, req *QueryEstimateHeightAtTimeRequest) (*QueryEstimateHeightAtTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateHeightAtTime not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.blocktime.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateTimeAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateTimeAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateTimeAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.blocktime.v1beta1.Query/EstimateTimeAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateTimeAtHeight(ctx, req.(*QueryEstimateTimeAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateHeightAtTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateHeightAtTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateHeightAtTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.blocktime.v1beta1.Query/EstimateHeightAtTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateHeightAtTime(ctx, req.(*QueryEstimateHeightAtTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.blocktime.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "EstimateTimeAtHeight",
+			Handler:    _Query_EstimateTimeAtHeight_Handler,
+		},
+		{
+			MethodName: "EstimateHeightAtTime",
+			Handler:    _Query_EstimateHeightAtTime_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/blocktime/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateTimeAtHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateTimeAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateTimeAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateTimeAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateTimeAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateTimeAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AverageBlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AverageBlockTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateHeightAtTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateHeightAtTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateHeightAtTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateHeightAtTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateHeightAtTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateHeightAtTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AverageBlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AverageBlockTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimateTimeAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryEstimateTimeAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.AverageBlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimateHeightAtTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimateHeightAtTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.AverageBlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateTimeAtHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateTimeAtHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateTimeAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateTimeAtHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateTimeAtHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateTimeAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.AverageBlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateHeightAtTimeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateHeightAtTimeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateHeightAtTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateHeightAtTimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateHeightAtTimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateHeightAtTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.AverageBlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -2,15 +2,21 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
+	"github.com/armon/go-metrics"
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
+var _ sdk.SeverityInvariantRegistry = (*Keeper)(nil)
+
 // Keeper - crisis keeper
 type Keeper struct {
 	routes         []types.InvarRoute
@@ -53,6 +59,16 @@ func (k *Keeper) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
 	k.routes = append(k.routes, invarRoute)
 }
 
+// RegisterRouteWithSeverity register the route of an invariant with the
+// severity of its breaking, and the remediation executed by an approved
+// InvariantRemediationProposal if its severity is auto-fix
+func (k *Keeper) RegisterRouteWithSeverity(
+	moduleName, route string, invar sdk.Invariant, severity sdk.InvariantSeverity, remediation sdk.InvariantRemediation,
+) {
+	invarRoute := types.NewInvarRouteWithSeverity(moduleName, route, invar, severity, remediation)
+	k.routes = append(k.routes, invarRoute)
+}
+
 // Routes - return the keeper's invariant routes
 func (k Keeper) Routes() []types.InvarRoute {
 	return k.routes
@@ -67,8 +83,9 @@ func (k Keeper) Invariants() []sdk.Invariant {
 	return invars
 }
 
-// AssertInvariants asserts all registered invariants. If any invariant fails,
-// the method panics.
+// AssertInvariants asserts all registered invariants. If any invariant with
+// the halt severity fails, the method panics, while the other broken
+// invariants are only reported.
 func (k Keeper) AssertInvariants(ctx sdk.Context) {
 	logger := k.Logger(ctx)
 
@@ -77,7 +94,12 @@ func (k Keeper) AssertInvariants(ctx sdk.Context) {
 	n := len(invarRoutes)
 	for i, ir := range invarRoutes {
 		logger.Info("asserting crisis invariants", "inv", fmt.Sprint(i, "/", n), "name", ir.FullRoute())
-		if res, stop := ir.Invar(ctx); stop {
+		if res, stop := k.runInvariant(ctx, ir); stop {
+			if ir.Severity != sdk.InvariantSeverityHalt {
+				k.reportBrokenInvariant(ctx, ir, res)
+				continue
+			}
+
 			// TODO: Include app name as part of context to allow for this to be
 			// variable.
 			panic(fmt.Errorf("invariant broken: %s\n"+
@@ -90,6 +112,78 @@ func (k Keeper) AssertInvariants(ctx sdk.Context) {
 	logger.Info("asserted all invariants", "duration", diff, "height", ctx.BlockHeight())
 }
 
+// runInvariant runs the invariant, recording the duration and the result of
+// the run in the telemetry.
+func (k Keeper) runInvariant(ctx sdk.Context, ir types.InvarRoute) (string, bool) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), "invariant", ir.FullRoute())
+
+	res, stop := ir.Invar(ctx)
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, "invariant", "runs"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel("route", ir.FullRoute()),
+			telemetry.NewLabel("severity", ir.Severity.String()),
+			telemetry.NewLabel("broken", strconv.FormatBool(stop)),
+		},
+	)
+
+	return res, stop
+}
+
+// reportBrokenInvariant reports a broken invariant which does not halt the
+// chain in the logs and the events.
+func (k Keeper) reportBrokenInvariant(ctx sdk.Context, ir types.InvarRoute, res string) {
+	k.Logger(ctx).Error("invariant broken", "name", ir.FullRoute(), "severity", ir.Severity, "msg", res)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInvariantBroken,
+			sdk.NewAttribute(types.AttributeKeyRoute, ir.FullRoute()),
+			sdk.NewAttribute(types.AttributeKeySeverity, ir.Severity.String()),
+		),
+	)
+}
+
+// RemediateInvariant executes the remediation of a broken invariant with the
+// auto-fix severity, as approved by governance. It returns an error if the
+// invariant is not broken, or if it is still broken after the remediation.
+func (k Keeper) RemediateInvariant(ctx sdk.Context, moduleName, route string) error {
+	fullRoute := moduleName + "/" + route
+
+	for _, ir := range k.Routes() {
+		if ir.FullRoute() != fullRoute {
+			continue
+		}
+
+		if ir.Severity != sdk.InvariantSeverityAutoFix || ir.Remediation == nil {
+			return sdkerrors.Wrapf(types.ErrNoRemediation, "invariant %s", fullRoute)
+		}
+		if _, stop := k.runInvariant(ctx, ir); !stop {
+			return sdkerrors.Wrapf(types.ErrInvariantNotBroken, "invariant %s", fullRoute)
+		}
+
+		if err := ir.Remediation(ctx); err != nil {
+			return sdkerrors.Wrapf(err, "failed to remediate invariant %s", fullRoute)
+		}
+		if res, stop := k.runInvariant(ctx, ir); stop {
+			return sdkerrors.Wrapf(types.ErrInvariantBroken, "invariant %s after remediation: %s", fullRoute, res)
+		}
+
+		k.Logger(ctx).Info("remediated invariant", "name", fullRoute)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeInvariantRemediated,
+				sdk.NewAttribute(types.AttributeKeyRoute, fullRoute),
+			),
+		)
+
+		return nil
+	}
+
+	return sdkerrors.Wrapf(types.ErrUnknownInvariant, "invariant %s", fullRoute)
+}
+
 // InvCheckPeriod returns the invariant checks period.
 func (k Keeper) InvCheckPeriod() uint { return k.invCheckPeriod }
 
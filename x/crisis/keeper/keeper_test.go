@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 )
 
 func TestLogger(t *testing.T) {
@@ -44,3 +45,48 @@ func TestAssertInvariants(t *testing.T) {
 	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "", true })
 	require.Panics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
 }
+
+func TestAssertInvariantsSeverity(t *testing.T) {
+	app := simapp.Setup(false)
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1}})
+
+	ctx := app.NewContext(true, tmproto.Header{})
+
+	app.CrisisKeeper.RegisterRouteWithSeverity("testModule", "testRoute1",
+		func(sdk.Context) (string, bool) { return "", true }, sdk.InvariantSeverityAlert, nil)
+	require.NotPanics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
+	require.Equal(t, types.EventTypeInvariantBroken, ctx.EventManager().Events()[0].Type)
+
+	app.CrisisKeeper.RegisterRouteWithSeverity("testModule", "testRoute2",
+		func(sdk.Context) (string, bool) { return "", true }, sdk.InvariantSeverityHalt, nil)
+	require.Panics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
+}
+
+func TestRemediateInvariant(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(true, tmproto.Header{})
+
+	broken := true
+	invar := func(sdk.Context) (string, bool) { return "broken", broken }
+	fixed := true
+	remediation := func(sdk.Context) error {
+		broken = !fixed
+		return nil
+	}
+	app.CrisisKeeper.RegisterRouteWithSeverity("testModule", "alert", invar, sdk.InvariantSeverityAlert, remediation)
+	app.CrisisKeeper.RegisterRouteWithSeverity("testModule", "noRemediation", invar, sdk.InvariantSeverityAutoFix, nil)
+	app.CrisisKeeper.RegisterRouteWithSeverity("testModule", "autoFix", invar, sdk.InvariantSeverityAutoFix, remediation)
+
+	require.ErrorIs(t, app.CrisisKeeper.RemediateInvariant(ctx, "testModule", "unknown"), types.ErrUnknownInvariant)
+	require.ErrorIs(t, app.CrisisKeeper.RemediateInvariant(ctx, "testModule", "alert"), types.ErrNoRemediation)
+	require.ErrorIs(t, app.CrisisKeeper.RemediateInvariant(ctx, "testModule", "noRemediation"), types.ErrNoRemediation)
+
+	fixed = false
+	require.ErrorIs(t, app.CrisisKeeper.RemediateInvariant(ctx, "testModule", "autoFix"), types.ErrInvariantBroken)
+
+	fixed = true
+	require.NoError(t, app.CrisisKeeper.RemediateInvariant(ctx, "testModule", "autoFix"))
+	require.False(t, broken)
+	require.ErrorIs(t, app.CrisisKeeper.RemediateInvariant(ctx, "testModule", "autoFix"), types.ErrInvariantNotBroken)
+}
@@ -29,9 +29,11 @@ func (k Keeper) VerifyInvariant(goCtx context.Context, msg *types.MsgVerifyInvar
 
 	var res string
 	var stop bool
-	for _, invarRoute := range k.Routes() {
-		if invarRoute.FullRoute() == msgFullRoute {
-			res, stop = invarRoute.Invar(cacheCtx)
+	var invarRoute types.InvarRoute
+	for _, ir := range k.Routes() {
+		if ir.FullRoute() == msgFullRoute {
+			invarRoute = ir
+			res, stop = k.runInvariant(cacheCtx, ir)
 			found = true
 
 			break
@@ -43,11 +45,16 @@ func (k Keeper) VerifyInvariant(goCtx context.Context, msg *types.MsgVerifyInvar
 	}
 
 	if stop {
-		// Currently, because the chain halts here, this transaction will never be included in the
-		// blockchain thus the constant fee will have never been deducted. Thus no refund is required.
+		if invarRoute.Severity == sdk.InvariantSeverityHalt {
+			// Currently, because the chain halts here, this transaction will never be included in the
+			// blockchain thus the constant fee will have never been deducted. Thus no refund is required.
 
-		// TODO replace with circuit breaker
-		panic(res)
+			// TODO replace with circuit breaker
+			panic(res)
+		}
+
+		// the invariants which do not halt the chain are only reported
+		k.reportBrokenInvariant(ctx, invarRoute, res)
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
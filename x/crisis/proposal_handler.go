@@ -0,0 +1,30 @@
+package crisis
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/crisis/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/crisis/client/rest"
+	"github.com/cosmos/cosmos-sdk/x/crisis/keeper"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// ProposalHandler is the invariant remediation proposal client handler.
+var ProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitInvariantRemediationProposal, rest.ProposalRESTHandler)
+
+// NewInvariantRemediationProposalHandler creates a new governance Handler for
+// an InvariantRemediationProposal. It takes a reference to the keeper, as the
+// invariants are registered after the governance router is created.
+func NewInvariantRemediationProposalHandler(k *keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.InvariantRemediationProposal:
+			return k.RemediateInvariant(ctx, c.InvariantModuleName, c.InvariantRoute)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized crisis proposal content type: %T", c)
+		}
+	}
+}
@@ -6,6 +6,9 @@ import (
 
 // x/crisis module sentinel errors
 var (
-	ErrNoSender         = sdkerrors.Register(ModuleName, 2, "sender address is empty")
-	ErrUnknownInvariant = sdkerrors.Register(ModuleName, 3, "unknown invariant")
+	ErrNoSender           = sdkerrors.Register(ModuleName, 2, "sender address is empty")
+	ErrUnknownInvariant   = sdkerrors.Register(ModuleName, 3, "unknown invariant")
+	ErrNoRemediation      = sdkerrors.Register(ModuleName, 4, "invariant has no remediation")
+	ErrInvariantNotBroken = sdkerrors.Register(ModuleName, 5, "invariant not broken")
+	ErrInvariantBroken    = sdkerrors.Register(ModuleName, 6, "invariant broken")
 )
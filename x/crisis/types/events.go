@@ -2,8 +2,11 @@ package types
 
 // crisis module event types
 const (
-	EventTypeInvariant = "invariant"
+	EventTypeInvariant           = "invariant"
+	EventTypeInvariantBroken     = "invariant_broken"
+	EventTypeInvariantRemediated = "invariant_remediated"
 
 	AttributeValueCrisis = ModuleName
 	AttributeKeyRoute    = "route"
+	AttributeKeySeverity = "severity"
 )
@@ -3,4 +3,7 @@ package types
 const (
 	// module name
 	ModuleName = "crisis"
+
+	// RouterKey defines the routing key for the crisis proposals
+	RouterKey = ModuleName
 )
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeInvariantRemediation defines the type for an InvariantRemediationProposal
+	ProposalTypeInvariantRemediation = "InvariantRemediation"
+)
+
+// Assert InvariantRemediationProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = &InvariantRemediationProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeInvariantRemediation)
+	govtypes.RegisterProposalTypeCodec(&InvariantRemediationProposal{}, "cosmos-sdk/InvariantRemediationProposal")
+}
+
+// NewInvariantRemediationProposal creates a new invariant remediation proposal.
+func NewInvariantRemediationProposal(title, description, invariantModuleName, invariantRoute string) *InvariantRemediationProposal {
+	return &InvariantRemediationProposal{title, description, invariantModuleName, invariantRoute}
+}
+
+// GetTitle returns the title of an invariant remediation proposal.
+func (p *InvariantRemediationProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an invariant remediation proposal.
+func (p *InvariantRemediationProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an invariant remediation proposal.
+func (p *InvariantRemediationProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an invariant remediation proposal.
+func (p *InvariantRemediationProposal) ProposalType() string {
+	return ProposalTypeInvariantRemediation
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *InvariantRemediationProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(p.InvariantModuleName) == "" || strings.TrimSpace(p.InvariantRoute) == "" {
+		return sdkerrors.Wrap(ErrUnknownInvariant, "invariant module name and route cannot be blank")
+	}
+
+	return nil
+}
+
+// FullInvariantRoute - get the proposal full invariant route
+func (p *InvariantRemediationProposal) FullInvariantRoute() string {
+	return p.InvariantModuleName + "/" + p.InvariantRoute
+}
+
+// String implements the Stringer interface.
+func (p InvariantRemediationProposal) String() string {
+	return fmt.Sprintf(`Invariant Remediation Proposal:
+  Title:       %s
+  Description: %s
+  Invariant:   %s/%s
+`, p.Title, p.Description, p.InvariantModuleName, p.InvariantRoute)
+}
@@ -0,0 +1,453 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/crisis/v1beta1/proposal.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InvariantRemediationProposal defines a proposal to execute the remediation
+// of a broken invariant registered with the auto-fix severity.
+type InvariantRemediationProposal struct {
+	Title               string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description         string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	InvariantModuleName string `protobuf:"bytes,3,opt,name=invariant_module_name,json=invariantModuleName,proto3" json:"invariant_module_name,omitempty" yaml:"invariant_module_name"`
+	InvariantRoute      string `protobuf:"bytes,4,opt,name=invariant_route,json=invariantRoute,proto3" json:"invariant_route,omitempty" yaml:"invariant_route"`
+}
+
+func (m *InvariantRemediationProposal) Reset()      { *m = InvariantRemediationProposal{} }
+func (*InvariantRemediationProposal) ProtoMessage() {}
+func (*InvariantRemediationProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_041521c10a8d86ca, []int{0}
+}
+func (m *InvariantRemediationProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantRemediationProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantRemediationProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantRemediationProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantRemediationProposal.Merge(m, src)
+}
+func (m *InvariantRemediationProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantRemediationProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantRemediationProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantRemediationProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*InvariantRemediationProposal)(nil), "cosmos.crisis.v1beta1.InvariantRemediationProposal")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/crisis/v1beta1/proposal.proto", fileDescriptor_041521c10a8d86ca)
+}
+
+var fileDescriptor_041521c10a8d86ca = []byte{
+	// 305 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0xd1, 0x3f, 0x4b, 0x03, 0x31,
+	0x18, 0x06, 0xf0, 0x8b, 0xff, 0xd0, 0x08, 0x0a, 0x67, 0x2b, 0x47, 0x29, 0xb9, 0x72, 0x38, 0x08,
+	0xe2, 0x85, 0xe2, 0xd6, 0xb1, 0xe2, 0xe0, 0xa0, 0xc8, 0xe1, 0xe4, 0x52, 0xd2, 0xbb, 0x50, 0x83,
+	0x97, 0x7b, 0x8f, 0x24, 0x2d, 0xf6, 0x1b, 0x38, 0x3a, 0x3a, 0xf6, 0xe3, 0x38, 0x76, 0x74, 0x2a,
+	0xd2, 0x7e, 0x83, 0xae, 0x2e, 0x72, 0xb9, 0x5e, 0x15, 0x71, 0x4a, 0xf2, 0xe4, 0xc7, 0x33, 0xbc,
+	0x2f, 0x3e, 0x89, 0x41, 0x4b, 0xd0, 0x34, 0x56, 0x42, 0x0b, 0x4d, 0x47, 0xed, 0x3e, 0x37, 0xac,
+	0x4d, 0x73, 0x05, 0x39, 0x68, 0x96, 0x86, 0xb9, 0x02, 0x03, 0x6e, 0xbd, 0x54, 0x61, 0xa9, 0xc2,
+	0x95, 0x6a, 0xd4, 0x06, 0x30, 0x00, 0x2b, 0x68, 0x71, 0x2b, 0x71, 0xf0, 0x85, 0x70, 0xf3, 0x3a,
+	0x1b, 0x31, 0x25, 0x58, 0x66, 0x22, 0x2e, 0x79, 0x22, 0x98, 0x11, 0x90, 0xdd, 0xad, 0x3a, 0xdd,
+	0x1a, 0xde, 0x36, 0xc2, 0xa4, 0xdc, 0x43, 0x2d, 0x74, 0xba, 0x17, 0x95, 0x0f, 0xb7, 0x85, 0xf7,
+	0x13, 0xae, 0x63, 0x25, 0xf2, 0x02, 0x7b, 0x1b, 0xf6, 0xef, 0x77, 0xe4, 0xde, 0xe3, 0xba, 0xa8,
+	0x7a, 0x7b, 0x12, 0x92, 0x61, 0xca, 0x7b, 0x19, 0x93, 0xdc, 0xdb, 0x2c, 0x6c, 0xb7, 0xb5, 0x9c,
+	0xf9, 0xcd, 0x31, 0x93, 0x69, 0x27, 0xf8, 0x97, 0x05, 0xd1, 0xd1, 0x3a, 0xbf, 0xb1, 0xf1, 0x2d,
+	0x93, 0xdc, 0xbd, 0xc4, 0x87, 0x3f, 0x5c, 0xc1, 0xd0, 0x70, 0x6f, 0xcb, 0xf6, 0x35, 0x96, 0x33,
+	0xff, 0xf8, 0x6f, 0x9f, 0x05, 0x41, 0x74, 0xb0, 0x4e, 0xa2, 0x22, 0xe8, 0xec, 0xbe, 0x4c, 0x7c,
+	0xe7, 0x6d, 0xe2, 0x3b, 0xdd, 0xab, 0xf7, 0x39, 0x41, 0xd3, 0x39, 0x41, 0x9f, 0x73, 0x82, 0x5e,
+	0x17, 0xc4, 0x99, 0x2e, 0x88, 0xf3, 0xb1, 0x20, 0xce, 0xc3, 0xd9, 0x40, 0x98, 0xc7, 0x61, 0x3f,
+	0x8c, 0x41, 0xd2, 0x6a, 0xea, 0xf6, 0x38, 0xd7, 0xc9, 0x13, 0x7d, 0xae, 0x56, 0x60, 0xc6, 0x39,
+	0xd7, 0xfd, 0x1d, 0x3b, 0xcb, 0x8b, 0xef, 0x01, 0x00, 0x0b, 0x76, 0xe1, 0xe8, 0xa0, 0x01, 0x00,
+	0x00,
+}
+
+func (m *InvariantRemediationProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantRemediationProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantRemediationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InvariantRoute) > 0 {
+		i -= len(m.InvariantRoute)
+		copy(dAtA[i:], m.InvariantRoute)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.InvariantRoute)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.InvariantModuleName) > 0 {
+		i -= len(m.InvariantModuleName)
+		copy(dAtA[i:], m.InvariantModuleName)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.InvariantModuleName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InvariantRemediationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.InvariantModuleName)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.InvariantRoute)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func sovProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposal(x uint64) (n int) {
+	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InvariantRemediationProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvariantRemediationProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvariantRemediationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvariantModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvariantModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvariantRoute", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvariantRoute = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProposal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProposal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProposal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProposal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProposal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProposal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProposal = fmt.Errorf("proto: unexpected end of group")
+)
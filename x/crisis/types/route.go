@@ -6,9 +6,11 @@ import (
 
 // invariant route
 type InvarRoute struct {
-	ModuleName string
-	Route      string
-	Invar      sdk.Invariant
+	ModuleName  string
+	Route       string
+	Invar       sdk.Invariant
+	Severity    sdk.InvariantSeverity
+	Remediation sdk.InvariantRemediation
 }
 
 // NewInvarRoute - create an InvarRoute object
@@ -20,6 +22,18 @@ func NewInvarRoute(moduleName, route string, invar sdk.Invariant) InvarRoute {
 	}
 }
 
+// NewInvarRouteWithSeverity - create an InvarRoute object with a severity and a
+// remediation, which may be nil
+func NewInvarRouteWithSeverity(
+	moduleName, route string, invar sdk.Invariant, severity sdk.InvariantSeverity, remediation sdk.InvariantRemediation,
+) InvarRoute {
+	invarRoute := NewInvarRoute(moduleName, route, invar)
+	invarRoute.Severity = severity
+	invarRoute.Remediation = remediation
+
+	return invarRoute
+}
+
 // get the full invariance route
 func (i InvarRoute) FullRoute() string {
 	return i.ModuleName + "/" + i.Route
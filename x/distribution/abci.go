@@ -32,6 +32,11 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 		k.AllocateTokens(ctx, sumPreviousPrecommitPower, previousTotalPower, previousProposer, req.LastCommitInfo.GetVotes())
 	}
 
+	// sweep the truncation dust to the community pool
+	if interval := k.GetDustSweepInterval(ctx); interval > 0 && uint64(ctx.BlockHeight())%interval == 0 {
+		k.SweepDust(ctx)
+	}
+
 	// record the proposer for when we payout on the next block
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
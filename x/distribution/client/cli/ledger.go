@@ -0,0 +1,323 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// DelegationLedgerEntry is a delegation to the validator of a ledger.
+type DelegationLedgerEntry struct {
+	DelegatorAddress string  `json:"delegator_address" yaml:"delegator_address"`
+	Shares           sdk.Dec `json:"shares" yaml:"shares"`
+	// StartingInfo is the point from which the rewards of the delegation
+	// accrue.
+	StartingInfo types.DelegatorStartingInfo `json:"starting_info" yaml:"starting_info"`
+}
+
+// Equal returns true if the entries are the same delegation.
+func (e DelegationLedgerEntry) Equal(other DelegationLedgerEntry) bool {
+	return e.DelegatorAddress == other.DelegatorAddress &&
+		e.Shares.Equal(other.Shares) &&
+		e.StartingInfo.PreviousPeriod == other.StartingInfo.PreviousPeriod &&
+		e.StartingInfo.Stake.Equal(other.StartingInfo.Stake) &&
+		e.StartingInfo.Height == other.StartingInfo.Height
+}
+
+// DelegationLedger is the full delegation ledger of a validator at a height,
+// e.g. for new chains bootstrapping their distribution from an existing one.
+type DelegationLedger struct {
+	Height           int64  `json:"height" yaml:"height"`
+	ValidatorAddress string `json:"validator_address" yaml:"validator_address"`
+	// DelegatorShares are the total shares of the delegations to the validator.
+	DelegatorShares sdk.Dec `json:"delegator_shares" yaml:"delegator_shares"`
+	// Entries are the delegations to the validator, sorted by delegator
+	// address.
+	Entries []DelegationLedgerEntry `json:"entries" yaml:"entries"`
+}
+
+// NewDelegationLedger returns the delegation ledger of the validator from its
+// delegations and their starting infos. It returns an error unless each
+// delegation has a starting info.
+func NewDelegationLedger(
+	height int64,
+	validator stakingtypes.Validator,
+	delegations []stakingtypes.Delegation,
+	startingInfos []types.DelegatorStartingInfoRecord,
+) (DelegationLedger, error) {
+	ledger := DelegationLedger{
+		Height:           height,
+		ValidatorAddress: validator.OperatorAddress,
+		DelegatorShares:  validator.DelegatorShares,
+		Entries:          make([]DelegationLedgerEntry, 0, len(delegations)),
+	}
+
+	infos := make(map[string]types.DelegatorStartingInfo, len(startingInfos))
+	for _, record := range startingInfos {
+		infos[record.DelegatorAddress] = record.StartingInfo
+	}
+
+	for _, delegation := range delegations {
+		info, ok := infos[delegation.DelegatorAddress]
+		if !ok {
+			return ledger, fmt.Errorf("no starting info for the delegation of %s to %s", delegation.DelegatorAddress, validator.OperatorAddress)
+		}
+
+		ledger.Entries = append(ledger.Entries, DelegationLedgerEntry{
+			DelegatorAddress: delegation.DelegatorAddress,
+			Shares:           delegation.Shares,
+			StartingInfo:     info,
+		})
+	}
+	if len(infos) != len(ledger.Entries) {
+		return ledger, fmt.Errorf("%d starting infos for %d delegations to %s", len(infos), len(ledger.Entries), validator.OperatorAddress)
+	}
+
+	sort.Slice(ledger.Entries, func(i, j int) bool {
+		return ledger.Entries[i].DelegatorAddress < ledger.Entries[j].DelegatorAddress
+	})
+
+	return ledger, nil
+}
+
+// DelegationLedgerMismatch is a delegation differing between a ledger and the
+// state.
+type DelegationLedgerMismatch struct {
+	Expected DelegationLedgerEntry `json:"expected" yaml:"expected"`
+	Actual   DelegationLedgerEntry `json:"actual" yaml:"actual"`
+}
+
+// DelegationLedgerReport is the verification of a delegation ledger against
+// the state at its height.
+type DelegationLedgerReport struct {
+	Height           int64  `json:"height" yaml:"height"`
+	ValidatorAddress string `json:"validator_address" yaml:"validator_address"`
+	// ExpectedDelegatorShares are the total shares of the ledger, and
+	// ActualDelegatorShares the ones of the state.
+	ExpectedDelegatorShares sdk.Dec `json:"expected_delegator_shares" yaml:"expected_delegator_shares"`
+	ActualDelegatorShares   sdk.Dec `json:"actual_delegator_shares" yaml:"actual_delegator_shares"`
+	// Missing are the delegations of the ledger not in the state, and
+	// Unexpected the delegations of the state not in the ledger.
+	Missing    []DelegationLedgerEntry    `json:"missing" yaml:"missing"`
+	Unexpected []DelegationLedgerEntry    `json:"unexpected" yaml:"unexpected"`
+	Mismatched []DelegationLedgerMismatch `json:"mismatched" yaml:"mismatched"`
+}
+
+// Verified returns true if the ledger matches the state.
+func (r DelegationLedgerReport) Verified() bool {
+	return r.ExpectedDelegatorShares.Equal(r.ActualDelegatorShares) &&
+		len(r.Missing) == 0 && len(r.Unexpected) == 0 && len(r.Mismatched) == 0
+}
+
+// VerifyDelegationLedger verifies the ledger against the actual ledger built
+// from the state at its height.
+func VerifyDelegationLedger(ledger, actual DelegationLedger) DelegationLedgerReport {
+	report := DelegationLedgerReport{
+		Height:                  ledger.Height,
+		ValidatorAddress:        ledger.ValidatorAddress,
+		ExpectedDelegatorShares: ledger.DelegatorShares,
+		ActualDelegatorShares:   actual.DelegatorShares,
+	}
+
+	actualEntries := make(map[string]DelegationLedgerEntry, len(actual.Entries))
+	for _, entry := range actual.Entries {
+		actualEntries[entry.DelegatorAddress] = entry
+	}
+
+	for _, entry := range ledger.Entries {
+		actualEntry, ok := actualEntries[entry.DelegatorAddress]
+		switch {
+		case !ok:
+			report.Missing = append(report.Missing, entry)
+		case !entry.Equal(actualEntry):
+			report.Mismatched = append(report.Mismatched, DelegationLedgerMismatch{Expected: entry, Actual: actualEntry})
+		}
+		delete(actualEntries, entry.DelegatorAddress)
+	}
+
+	for _, entry := range actual.Entries {
+		if _, ok := actualEntries[entry.DelegatorAddress]; ok {
+			report.Unexpected = append(report.Unexpected, entry)
+		}
+	}
+
+	return report
+}
+
+// GetCmdQueryExportDelegationLedger implements the query export delegation
+// ledger command.
+func GetCmdQueryExportDelegationLedger() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-delegation-ledger [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Export the delegation ledger of a validator at a height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Export the full delegation ledger of a validator at a height, i.e. its
+delegators with their shares and the starting infos from which their rewards
+accrue, as JSON. The ledger can be verified against the state of its height
+with the verify-delegation-ledger command.
+
+Example:
+$ %s query distribution export-delegation-ledger %s1lwjmdnks33xwnmfayc64ycprww49n33mtm92ne --height=100 > ledger.json
+`,
+				version.AppName, sdk.GetConfig().GetBech32ValidatorAddrPrefix(),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			height := clientCtx.Height
+			if height == 0 {
+				node, err := clientCtx.GetNode()
+				if err != nil {
+					return err
+				}
+				status, err := node.Status(cmd.Context())
+				if err != nil {
+					return err
+				}
+				height = status.SyncInfo.LatestBlockHeight
+			}
+
+			ledger, err := queryDelegationLedger(cmd, clientCtx, height, valAddr)
+			if err != nil {
+				return err
+			}
+
+			// the ledger is a file format, thus always printed as JSON
+			return clientCtx.WithOutputFormat("json").PrintObjectLegacy(ledger)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryVerifyDelegationLedger implements the query verify delegation
+// ledger command.
+func GetCmdQueryVerifyDelegationLedger() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-delegation-ledger [ledger-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Verify a delegation ledger against the state at its height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Verify a delegation ledger exported by the export-delegation-ledger command
+against the state at its height, and report the delegations which are missing
+from the state, unexpected or differ from it. The command fails unless the
+ledger matches the state.
+
+The queried node must keep the state of the height of the ledger.
+
+Example:
+$ %s query distribution verify-delegation-ledger ledger.json
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var ledger DelegationLedger
+			if err := clientCtx.LegacyAmino.UnmarshalJSON(bz, &ledger); err != nil {
+				return fmt.Errorf("failed to parse the delegation ledger: %w", err)
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(ledger.ValidatorAddress)
+			if err != nil {
+				return err
+			}
+
+			actual, err := queryDelegationLedger(cmd, clientCtx, ledger.Height, valAddr)
+			if err != nil {
+				return err
+			}
+
+			report := VerifyDelegationLedger(ledger, actual)
+			if err := clientCtx.PrintObjectLegacy(report); err != nil {
+				return err
+			}
+			if !report.Verified() {
+				return fmt.Errorf("the delegation ledger does not match the state at height %d", ledger.Height)
+			}
+
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// queryDelegationLedger queries the delegation ledger of a validator at a
+// height.
+func queryDelegationLedger(cmd *cobra.Command, clientCtx client.Context, height int64, valAddr sdk.ValAddress) (DelegationLedger, error) {
+	clientCtx = clientCtx.WithHeight(height)
+	ctx := cmd.Context()
+	queryClient := types.NewQueryClient(clientCtx)
+	stakingQueryClient := stakingtypes.NewQueryClient(clientCtx)
+
+	validator, err := stakingQueryClient.Validator(ctx, &stakingtypes.QueryValidatorRequest{ValidatorAddr: valAddr.String()})
+	if err != nil {
+		return DelegationLedger{}, err
+	}
+
+	var delegations []stakingtypes.Delegation
+	for pageReq := (&query.PageRequest{}); pageReq != nil; {
+		res, err := stakingQueryClient.ValidatorDelegations(ctx, &stakingtypes.QueryValidatorDelegationsRequest{
+			ValidatorAddr: valAddr.String(),
+			Pagination:    pageReq,
+		})
+		if err != nil {
+			return DelegationLedger{}, err
+		}
+
+		for _, delegation := range res.DelegationResponses {
+			delegations = append(delegations, delegation.Delegation)
+		}
+
+		pageReq = nextPageRequest(res.Pagination)
+	}
+
+	var startingInfos []types.DelegatorStartingInfoRecord
+	for pageReq := (&query.PageRequest{}); pageReq != nil; {
+		res, err := queryClient.ValidatorDelegatorStartingInfos(ctx, &types.QueryValidatorDelegatorStartingInfosRequest{
+			ValidatorAddress: valAddr.String(),
+			Pagination:       pageReq,
+		})
+		if err != nil {
+			return DelegationLedger{}, err
+		}
+		startingInfos = append(startingInfos, res.StartingInfos...)
+
+		pageReq = nextPageRequest(res.Pagination)
+	}
+
+	return NewDelegationLedger(height, validator.Validator, delegations, startingInfos)
+}
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestDelegationLedger(t *testing.T) {
+	valAddr := sdk.ValAddress("validator")
+	delAddrs := []sdk.AccAddress{sdk.AccAddress("delegator1"), sdk.AccAddress("delegator2"), sdk.AccAddress("delegator3")}
+	validator := stakingtypes.Validator{OperatorAddress: valAddr.String(), DelegatorShares: sdk.NewDec(300)}
+
+	delegation := func(delAddr sdk.AccAddress, shares int64) stakingtypes.Delegation {
+		return stakingtypes.NewDelegation(delAddr, valAddr, sdk.NewDec(shares))
+	}
+	startingInfo := func(delAddr sdk.AccAddress, period uint64, stake int64) types.DelegatorStartingInfoRecord {
+		return types.DelegatorStartingInfoRecord{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: valAddr.String(),
+			StartingInfo:     types.NewDelegatorStartingInfo(period, sdk.NewDec(stake), 10),
+		}
+	}
+
+	// each delegation must have a starting info
+	_, err := NewDelegationLedger(100, validator,
+		[]stakingtypes.Delegation{delegation(delAddrs[0], 100)},
+		nil,
+	)
+	require.Error(t, err)
+	_, err = NewDelegationLedger(100, validator,
+		[]stakingtypes.Delegation{delegation(delAddrs[0], 100)},
+		[]types.DelegatorStartingInfoRecord{startingInfo(delAddrs[0], 1, 100), startingInfo(delAddrs[1], 1, 100)},
+	)
+	require.Error(t, err)
+
+	ledger, err := NewDelegationLedger(100, validator,
+		[]stakingtypes.Delegation{delegation(delAddrs[1], 200), delegation(delAddrs[0], 100)},
+		[]types.DelegatorStartingInfoRecord{startingInfo(delAddrs[0], 1, 100), startingInfo(delAddrs[1], 2, 200)},
+	)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), ledger.Height)
+	require.Equal(t, valAddr.String(), ledger.ValidatorAddress)
+	require.Len(t, ledger.Entries, 2)
+	require.Equal(t, delAddrs[0].String(), ledger.Entries[0].DelegatorAddress)
+	require.Equal(t, uint64(2), ledger.Entries[1].StartingInfo.PreviousPeriod)
+
+	// the ledger survives its JSON round trip
+	cdc := codec.NewLegacyAmino()
+	bz, err := cdc.MarshalJSON(ledger)
+	require.NoError(t, err)
+	var decoded DelegationLedger
+	require.NoError(t, cdc.UnmarshalJSON(bz, &decoded))
+	require.True(t, VerifyDelegationLedger(decoded, ledger).Verified())
+
+	// the second delegation accrued its rewards from another period, the first
+	// one is gone, and a third one was created
+	validator.DelegatorShares = sdk.NewDec(500)
+	actual, err := NewDelegationLedger(100, validator,
+		[]stakingtypes.Delegation{delegation(delAddrs[1], 200), delegation(delAddrs[2], 300)},
+		[]types.DelegatorStartingInfoRecord{startingInfo(delAddrs[1], 3, 200), startingInfo(delAddrs[2], 3, 300)},
+	)
+	require.NoError(t, err)
+
+	report := VerifyDelegationLedger(ledger, actual)
+	require.False(t, report.Verified())
+	require.True(t, report.ExpectedDelegatorShares.Equal(sdk.NewDec(300)))
+	require.True(t, report.ActualDelegatorShares.Equal(sdk.NewDec(500)))
+	require.Equal(t, []DelegationLedgerEntry{ledger.Entries[0]}, report.Missing)
+	require.Equal(t, []DelegationLedgerEntry{actual.Entries[1]}, report.Unexpected)
+	require.Equal(t, []DelegationLedgerMismatch{{Expected: ledger.Entries[1], Actual: actual.Entries[0]}}, report.Mismatched)
+}
@@ -12,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingcli "github.com/cosmos/cosmos-sdk/x/staking/client/cli"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -30,7 +31,13 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryValidatorCommission(),
 		GetCmdQueryValidatorSlashes(),
 		GetCmdQueryDelegatorRewards(),
+		GetCmdQueryDelegatorRealizedRewards(),
 		GetCmdQueryCommunityPool(),
+		GetCmdQueryBurnedFees(),
+		GetCmdQueryDust(),
+		GetCmdQueryReconcile(),
+		GetCmdQueryExportDelegationLedger(),
+		GetCmdQueryVerifyDelegationLedger(),
 	)
 
 	return distQueryCmd
@@ -68,9 +75,10 @@ func GetCmdQueryValidatorOutstandingRewards() *cobra.Command {
 	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
 
 	cmd := &cobra.Command{
-		Use:   "validator-outstanding-rewards [validator]",
-		Args:  cobra.ExactArgs(1),
-		Short: "Query distribution outstanding (un-withdrawn) rewards for a validator and all their delegations",
+		Use:               "validator-outstanding-rewards [validator]",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: stakingcli.CompleteValidatorArgs(0),
+		Short:             "Query distribution outstanding (un-withdrawn) rewards for a validator and all their delegations",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query distribution outstanding (un-withdrawn) rewards for a validator and all their delegations.
 
@@ -113,9 +121,10 @@ func GetCmdQueryValidatorCommission() *cobra.Command {
 	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
 
 	cmd := &cobra.Command{
-		Use:   "commission [validator]",
-		Args:  cobra.ExactArgs(1),
-		Short: "Query distribution validator commission",
+		Use:               "commission [validator]",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: stakingcli.CompleteValidatorArgs(0),
+		Short:             "Query distribution validator commission",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query validator commission rewards from delegators to that validator.
 
@@ -158,9 +167,10 @@ func GetCmdQueryValidatorSlashes() *cobra.Command {
 	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
 
 	cmd := &cobra.Command{
-		Use:   "slashes [validator] [start-height] [end-height]",
-		Args:  cobra.ExactArgs(3),
-		Short: "Query distribution validator slashes",
+		Use:               "slashes [validator] [start-height] [end-height]",
+		Args:              cobra.ExactArgs(3),
+		ValidArgsFunction: stakingcli.CompleteValidatorArgs(0),
+		Short:             "Query distribution validator slashes",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query all slashes of a validator for a given block range.
 
@@ -225,9 +235,10 @@ func GetCmdQueryDelegatorRewards() *cobra.Command {
 	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
 
 	cmd := &cobra.Command{
-		Use:   "rewards [delegator-addr] [validator-addr]",
-		Args:  cobra.RangeArgs(1, 2),
-		Short: "Query all distribution delegator rewards or rewards from a particular validator",
+		Use:               "rewards [delegator-addr] [validator-addr]",
+		Args:              cobra.RangeArgs(1, 2),
+		ValidArgsFunction: stakingcli.CompleteValidatorArgs(1),
+		Short:             "Query all distribution delegator rewards or rewards from a particular validator",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query all rewards earned by a delegator, optionally restrict to rewards from a single validator.
 
@@ -285,6 +296,53 @@ $ %s query distribution rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1ggh
 	return cmd
 }
 
+// GetCmdQueryDelegatorRealizedRewards returns the command for fetching the
+// rewards withdrawn by a delegator.
+func GetCmdQueryDelegatorRealizedRewards() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "realized-rewards [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the rewards withdrawn by a delegator from each validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the cumulative rewards withdrawn by a delegator from each validator,
+including the validators the delegator no longer delegates to.
+
+Example:
+$ %s query distribution realized-rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorRealizedRewards(
+				cmd.Context(),
+				&types.QueryDelegatorRealizedRewardsRequest{DelegatorAddress: delegatorAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryCommunityPool returns the command for fetching community pool info.
 func GetCmdQueryCommunityPool() *cobra.Command {
 	cmd := &cobra.Command{
@@ -319,3 +377,75 @@ $ %s query distribution community-pool
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryBurnedFees returns the command for fetching the cumulative amount
+// of collected fees burned.
+func GetCmdQueryBurnedFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burned-fees",
+		Args:  cobra.NoArgs,
+		Short: "Query the amount of collected fees burned",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the cumulative amount of collected fees burned according to the fee burn ratio.
+
+Example:
+$ %s query distribution burned-fees
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BurnedFees(cmd.Context(), &types.QueryBurnedFeesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDust returns the command for fetching the truncation dust
+// accumulated since the last sweep.
+func GetCmdQueryDust() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dust",
+		Args:  cobra.NoArgs,
+		Short: "Query the truncation dust accumulated since the last sweep",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the truncation dust of the distribution operations accumulated since its last sweep to the community pool.
+
+Example:
+$ %s query distribution dust
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Dust(cmd.Context(), &types.QueryDustRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,370 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// FlagStartHeight defines the first height of the reconciled height range.
+const FlagStartHeight = "start-height"
+
+// ReconcileFlows are the token flows of the mint and distribution modules
+// aggregated from the events of the blocks of a height range.
+type ReconcileFlows struct {
+	// Minted and Burned are the coins minted and burned by any module.
+	Minted sdk.Coins `json:"minted" yaml:"minted"`
+	Burned sdk.Coins `json:"burned" yaml:"burned"`
+	// FeesBurned are the collected fees burned according to the fee burn ratio.
+	FeesBurned sdk.Coins `json:"fees_burned" yaml:"fees_burned"`
+	// FeesDistributed are the collected fees sent to the distribution module.
+	FeesDistributed sdk.Coins `json:"fees_distributed" yaml:"fees_distributed"`
+	// RewardsAllocated are the rewards, commission included, allocated to
+	// the validators.
+	RewardsAllocated sdk.DecCoins `json:"rewards_allocated" yaml:"rewards_allocated"`
+	RewardsWithdrawn sdk.Coins    `json:"rewards_withdrawn" yaml:"rewards_withdrawn"`
+	// DustSwept is the truncation dust swept to the community pool.
+	DustSwept           sdk.DecCoins `json:"dust_swept" yaml:"dust_swept"`
+	CommissionWithdrawn sdk.Coins    `json:"commission_withdrawn" yaml:"commission_withdrawn"`
+	// Deposits are the coins sent to the distribution module other than the
+	// collected fees, e.g. community pool funding.
+	Deposits sdk.Coins `json:"deposits" yaml:"deposits"`
+	// Payouts are all the coins sent from the distribution module, i.e. the
+	// withdrawals and the community pool spending.
+	Payouts sdk.Coins `json:"payouts" yaml:"payouts"`
+}
+
+// AddEvents aggregates the flows of the given block events.
+func (f *ReconcileFlows) AddEvents(events []abci.Event) error {
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+	distribution := authtypes.NewModuleAddress(types.ModuleName).String()
+
+	for _, event := range events {
+		attrs := make(map[string]string, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+
+		amount := attrs[sdk.AttributeKeyAmount]
+		switch event.Type {
+		case types.EventTypeRewards, types.EventTypeSweepDust:
+			decCoins, err := sdk.ParseDecCoins(amount)
+			if err != nil {
+				return err
+			}
+			if event.Type == types.EventTypeRewards {
+				f.RewardsAllocated = f.RewardsAllocated.Add(decCoins...)
+			} else {
+				f.DustSwept = f.DustSwept.Add(decCoins...)
+			}
+			continue
+		case banktypes.EventTypeCoinMint, banktypes.EventTypeCoinBurn, types.EventTypeBurnFees,
+			types.EventTypeWithdrawRewards, types.EventTypeWithdrawCommission, banktypes.EventTypeTransfer:
+		default:
+			continue
+		}
+
+		coins, err := sdk.ParseCoinsNormalized(amount)
+		if err != nil {
+			return err
+		}
+
+		switch event.Type {
+		case banktypes.EventTypeCoinMint:
+			f.Minted = f.Minted.Add(coins...)
+		case banktypes.EventTypeCoinBurn:
+			f.Burned = f.Burned.Add(coins...)
+		case types.EventTypeBurnFees:
+			f.FeesBurned = f.FeesBurned.Add(coins...)
+		case types.EventTypeWithdrawRewards:
+			f.RewardsWithdrawn = f.RewardsWithdrawn.Add(coins...)
+		case types.EventTypeWithdrawCommission:
+			f.CommissionWithdrawn = f.CommissionWithdrawn.Add(coins...)
+		case banktypes.EventTypeTransfer:
+			sender, recipient := attrs[banktypes.AttributeKeySender], attrs[banktypes.AttributeKeyRecipient]
+			switch {
+			case sender == distribution && recipient == distribution:
+			case sender == distribution:
+				f.Payouts = f.Payouts.Add(coins...)
+			case recipient == distribution && sender == feeCollector:
+				f.FeesDistributed = f.FeesDistributed.Add(coins...)
+			case recipient == distribution:
+				f.Deposits = f.Deposits.Add(coins...)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ReconcileState is the state of the mint and distribution modules at a
+// height.
+type ReconcileState struct {
+	Height             int64        `json:"height" yaml:"height"`
+	CommunityPool      sdk.DecCoins `json:"community_pool" yaml:"community_pool"`
+	BurnedFees         sdk.DecCoins `json:"burned_fees" yaml:"burned_fees"`
+	OutstandingRewards sdk.DecCoins `json:"outstanding_rewards" yaml:"outstanding_rewards"`
+	Dust               sdk.DecCoins `json:"dust" yaml:"dust"`
+	ModuleBalance      sdk.DecCoins `json:"module_balance" yaml:"module_balance"`
+	Supply             sdk.DecCoins `json:"supply" yaml:"supply"`
+}
+
+// ReconcileReport is the reconciliation of the state of the mint and
+// distribution modules over a height range.
+type ReconcileReport struct {
+	StartHeight int64          `json:"start_height" yaml:"start_height"`
+	EndHeight   int64          `json:"end_height" yaml:"end_height"`
+	Flows       ReconcileFlows `json:"flows" yaml:"flows"`
+	// Expected is the state expected at the end height from the state before
+	// the start height and the flows, Actual the state at the end height.
+	Expected ReconcileState `json:"expected" yaml:"expected"`
+	Actual   ReconcileState `json:"actual" yaml:"actual"`
+	// Discrepancy is the actual minus the expected state, negative amounts
+	// being missing from the actual state.
+	Discrepancy ReconcileState `json:"discrepancy" yaml:"discrepancy"`
+}
+
+// NewReconcileReport reconciles the state at the end of a height range with
+// the state before its start and the flows over the range.
+//
+// The truncation dust of the allocations and withdrawals is not evented, and
+// shows as a positive dust discrepancy matched by negative community pool and
+// outstanding rewards ones.
+func NewReconcileReport(initial, actual ReconcileState, flows ReconcileFlows) ReconcileReport {
+	dec := sdk.NewDecCoinsFromCoins
+	withdrawn := dec(flows.RewardsWithdrawn.Add(flows.CommissionWithdrawn...)...)
+
+	spent, _ := dec(flows.Payouts...).SafeSub(withdrawn)
+	communityPool, _ := initial.CommunityPool.
+		Add(dec(flows.FeesDistributed...)...).
+		Add(dec(flows.Deposits...)...).
+		Add(flows.DustSwept...).
+		SafeSub(flows.RewardsAllocated.Add(spent...))
+	outstanding, _ := initial.OutstandingRewards.Add(flows.RewardsAllocated...).SafeSub(withdrawn)
+	dust, _ := initial.Dust.SafeSub(flows.DustSwept)
+	balance, _ := initial.ModuleBalance.
+		Add(dec(flows.FeesDistributed...)...).
+		Add(dec(flows.Deposits...)...).
+		SafeSub(dec(flows.Payouts...))
+	supply, _ := initial.Supply.Add(dec(flows.Minted...)...).SafeSub(dec(flows.Burned...))
+
+	expected := ReconcileState{
+		Height:             actual.Height,
+		CommunityPool:      communityPool,
+		BurnedFees:         initial.BurnedFees.Add(dec(flows.FeesBurned...)...),
+		OutstandingRewards: outstanding,
+		Dust:               dust,
+		ModuleBalance:      balance,
+		Supply:             supply,
+	}
+
+	diff := func(a, b sdk.DecCoins) sdk.DecCoins {
+		d, _ := a.SafeSub(b)
+		return d
+	}
+
+	return ReconcileReport{
+		StartHeight: initial.Height + 1,
+		EndHeight:   actual.Height,
+		Flows:       flows,
+		Expected:    expected,
+		Actual:      actual,
+		Discrepancy: ReconcileState{
+			Height:             actual.Height,
+			CommunityPool:      diff(actual.CommunityPool, expected.CommunityPool),
+			BurnedFees:         diff(actual.BurnedFees, expected.BurnedFees),
+			OutstandingRewards: diff(actual.OutstandingRewards, expected.OutstandingRewards),
+			Dust:               diff(actual.Dust, expected.Dust),
+			ModuleBalance:      diff(actual.ModuleBalance, expected.ModuleBalance),
+			Supply:             diff(actual.Supply, expected.Supply),
+		},
+	}
+}
+
+// GetCmdQueryReconcile implements the query reconcile command.
+func GetCmdQueryReconcile() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reconcile",
+		Args:  cobra.NoArgs,
+		Short: "Reconcile the mint and distribution state over a height range",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Recompute the expected community pool, burned fees, validator outstanding
+rewards, truncation dust, distribution module balance and supply at a height
+from the state before a start height and the events of the blocks in between,
+and report the discrepancies with the actual state.
+
+The queried node must keep the state and the block results of the whole range.
+
+Example:
+$ %s query distribution reconcile --start-height=100 --height=200
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+
+			endHeight := clientCtx.Height
+			if endHeight == 0 {
+				status, err := node.Status(cmd.Context())
+				if err != nil {
+					return err
+				}
+				endHeight = status.SyncInfo.LatestBlockHeight
+			}
+
+			startHeight, err := cmd.Flags().GetInt64(FlagStartHeight)
+			if err != nil {
+				return err
+			}
+			if startHeight == 0 {
+				startHeight = endHeight
+			}
+			if startHeight < 2 || startHeight > endHeight {
+				return fmt.Errorf("invalid start height %d, must be between 2 and %d", startHeight, endHeight)
+			}
+
+			var flows ReconcileFlows
+			for height := startHeight; height <= endHeight; height++ {
+				h := height
+				res, err := node.BlockResults(cmd.Context(), &h)
+				if err != nil {
+					return err
+				}
+
+				if err := flows.AddEvents(res.BeginBlockEvents); err != nil {
+					return err
+				}
+				for _, tx := range res.TxsResults {
+					if tx.Code != abci.CodeTypeOK {
+						continue
+					}
+					if err := flows.AddEvents(tx.Events); err != nil {
+						return err
+					}
+				}
+				if err := flows.AddEvents(res.EndBlockEvents); err != nil {
+					return err
+				}
+			}
+
+			initial, err := queryReconcileState(cmd, clientCtx, startHeight-1)
+			if err != nil {
+				return err
+			}
+
+			actual, err := queryReconcileState(cmd, clientCtx, endHeight)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(NewReconcileReport(initial, actual, flows))
+		},
+	}
+
+	cmd.Flags().Int64(FlagStartHeight, 0, "First height of the reconciled range, the end height by default")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// queryReconcileState queries the state of the mint and distribution modules
+// at a height.
+func queryReconcileState(cmd *cobra.Command, clientCtx client.Context, height int64) (ReconcileState, error) {
+	clientCtx = clientCtx.WithHeight(height)
+	ctx := cmd.Context()
+	queryClient := types.NewQueryClient(clientCtx)
+	bankQueryClient := banktypes.NewQueryClient(clientCtx)
+	stakingQueryClient := stakingtypes.NewQueryClient(clientCtx)
+
+	state := ReconcileState{Height: height}
+
+	pool, err := queryClient.CommunityPool(ctx, &types.QueryCommunityPoolRequest{})
+	if err != nil {
+		return state, err
+	}
+	state.CommunityPool = pool.Pool
+
+	dust, err := queryClient.Dust(ctx, &types.QueryDustRequest{})
+	if err != nil {
+		return state, err
+	}
+	state.Dust = dust.Dust
+
+	burned, err := queryClient.BurnedFees(ctx, &types.QueryBurnedFeesRequest{})
+	if err != nil {
+		return state, err
+	}
+	state.BurnedFees = sdk.NewDecCoinsFromCoins(burned.BurnedFees...)
+
+	for pageReq := (&query.PageRequest{}); pageReq != nil; {
+		validators, err := stakingQueryClient.Validators(ctx, &stakingtypes.QueryValidatorsRequest{Pagination: pageReq})
+		if err != nil {
+			return state, err
+		}
+
+		for _, validator := range validators.Validators {
+			outstanding, err := queryClient.ValidatorOutstandingRewards(
+				ctx,
+				&types.QueryValidatorOutstandingRewardsRequest{ValidatorAddress: validator.OperatorAddress},
+			)
+			if err != nil {
+				return state, err
+			}
+			state.OutstandingRewards = state.OutstandingRewards.Add(outstanding.Rewards.Rewards...)
+		}
+
+		pageReq = nextPageRequest(validators.Pagination)
+	}
+
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	for pageReq := (&query.PageRequest{}); pageReq != nil; {
+		balances, err := bankQueryClient.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{Address: moduleAddr.String(), Pagination: pageReq})
+		if err != nil {
+			return state, err
+		}
+		state.ModuleBalance = state.ModuleBalance.Add(sdk.NewDecCoinsFromCoins(balances.Balances...)...)
+
+		pageReq = nextPageRequest(balances.Pagination)
+	}
+
+	for pageReq := (&query.PageRequest{}); pageReq != nil; {
+		supply, err := bankQueryClient.TotalSupply(ctx, &banktypes.QueryTotalSupplyRequest{Pagination: pageReq})
+		if err != nil {
+			return state, err
+		}
+		state.Supply = state.Supply.Add(sdk.NewDecCoinsFromCoins(supply.Supply...)...)
+
+		pageReq = nextPageRequest(supply.Pagination)
+	}
+
+	return state, nil
+}
+
+// nextPageRequest returns the request of the page following the given
+// response, or nil if it is the last one.
+func nextPageRequest(res *query.PageResponse) *query.PageRequest {
+	if res == nil || len(res.NextKey) == 0 {
+		return nil
+	}
+	return &query.PageRequest{Key: res.NextKey}
+}
@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func newEvent(ty string, attrs ...string) abci.Event {
+	event := sdk.NewEvent(ty)
+	for i := 0; i < len(attrs); i += 2 {
+		event = event.AppendAttributes(sdk.NewAttribute(attrs[i], attrs[i+1]))
+	}
+	return abci.Event(event)
+}
+
+func TestReconcileReport(t *testing.T) {
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+	distribution := authtypes.NewModuleAddress(types.ModuleName).String()
+	minter := authtypes.NewModuleAddress("mint").String()
+	account := sdk.AccAddress("account").String()
+
+	var flows ReconcileFlows
+	require.NoError(t, flows.AddEvents([]abci.Event{
+		newEvent(banktypes.EventTypeCoinMint, banktypes.AttributeKeyMinter, minter, sdk.AttributeKeyAmount, "100stake"),
+		newEvent(banktypes.EventTypeCoinBurn, banktypes.AttributeKeyBurner, feeCollector, sdk.AttributeKeyAmount, "10stake"),
+		newEvent(types.EventTypeBurnFees, sdk.AttributeKeyAmount, "10stake"),
+		newEvent(banktypes.EventTypeTransfer,
+			banktypes.AttributeKeyRecipient, feeCollector, banktypes.AttributeKeySender, minter, sdk.AttributeKeyAmount, "100stake"),
+		newEvent(banktypes.EventTypeTransfer,
+			banktypes.AttributeKeyRecipient, distribution, banktypes.AttributeKeySender, feeCollector, sdk.AttributeKeyAmount, "90stake"),
+		newEvent(types.EventTypeProposerReward, sdk.AttributeKeyAmount, "4.5stake", types.AttributeKeyValidator, "val"),
+		newEvent(types.EventTypeCommission, sdk.AttributeKeyAmount, "0.5stake", types.AttributeKeyValidator, "val"),
+		newEvent(types.EventTypeRewards, sdk.AttributeKeyAmount, "80.5stake", types.AttributeKeyValidator, "val"),
+		newEvent(types.EventTypeSweepDust, sdk.AttributeKeyAmount, "1stake"),
+	}))
+	require.NoError(t, flows.AddEvents([]abci.Event{
+		newEvent(banktypes.EventTypeTransfer,
+			banktypes.AttributeKeyRecipient, account, banktypes.AttributeKeySender, distribution, sdk.AttributeKeyAmount, "40stake"),
+		newEvent(types.EventTypeWithdrawRewards, sdk.AttributeKeyAmount, "40stake", types.AttributeKeyValidator, "val"),
+		newEvent(banktypes.EventTypeTransfer,
+			banktypes.AttributeKeyRecipient, distribution, banktypes.AttributeKeySender, account, sdk.AttributeKeyAmount, "5stake"),
+		newEvent(types.EventTypeWithdrawCommission, sdk.AttributeKeyAmount, ""),
+	}))
+
+	require.Equal(t, ReconcileFlows{
+		Minted:           sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		Burned:           sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		FeesBurned:       sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		FeesDistributed:  sdk.NewCoins(sdk.NewInt64Coin("stake", 90)),
+		RewardsAllocated: sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.MustNewDecFromStr("80.5"))),
+		RewardsWithdrawn: sdk.NewCoins(sdk.NewInt64Coin("stake", 40)),
+		DustSwept:        sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 1)),
+		Deposits:         sdk.NewCoins(sdk.NewInt64Coin("stake", 5)),
+		Payouts:          sdk.NewCoins(sdk.NewInt64Coin("stake", 40)),
+	}, flows)
+
+	decCoins := func(amount string) sdk.DecCoins {
+		coins, err := sdk.ParseDecCoins(amount)
+		require.NoError(t, err)
+		return coins
+	}
+	initial := ReconcileState{
+		Height:             9,
+		CommunityPool:      decCoins("10stake"),
+		OutstandingRewards: decCoins("20stake"),
+		Dust:               decCoins("1stake"),
+		ModuleBalance:      decCoins("30stake"),
+		Supply:             decCoins("1000stake"),
+	}
+	// the withdrawal truncated 0.25stake from the outstanding rewards to the
+	// dust
+	actual := ReconcileState{
+		Height:             10,
+		CommunityPool:      decCoins("25.5stake"),
+		BurnedFees:         decCoins("10stake"),
+		OutstandingRewards: decCoins("60.25stake"),
+		Dust:               decCoins("0.25stake"),
+		ModuleBalance:      decCoins("85stake"),
+		Supply:             decCoins("1090stake"),
+	}
+
+	report := NewReconcileReport(initial, actual, flows)
+	require.Equal(t, int64(10), report.StartHeight)
+	require.Equal(t, int64(10), report.EndHeight)
+	require.Equal(t, ReconcileState{
+		Height:             10,
+		CommunityPool:      decCoins("25.5stake"),
+		BurnedFees:         decCoins("10stake"),
+		OutstandingRewards: decCoins("60.5stake"),
+		ModuleBalance:      decCoins("85stake"),
+		Supply:             decCoins("1090stake"),
+	}, report.Expected)
+	require.Equal(t, ReconcileState{
+		Height:             10,
+		OutstandingRewards: sdk.DecCoins{sdk.DecCoin{Denom: "stake", Amount: sdk.MustNewDecFromStr("-0.25")}},
+		Dust:               decCoins("0.25stake"),
+	}, report.Discrepancy)
+}
@@ -0,0 +1,262 @@
+// Code generated by typedgen. DO NOT EDIT.
+
+// Package grpcclient implements the typed clients of the distribution module.
+package grpcclient
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/typed"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// QueryClient is the typed client of the distribution Query service, retrying
+// the queries failing with a transient error.
+type QueryClient struct {
+	client types.QueryClient
+	retry  typed.RetryConfig
+}
+
+// NewQueryClient returns a QueryClient querying through the connection.
+func NewQueryClient(conn gogogrpc.ClientConn, retry typed.RetryConfig) *QueryClient {
+	return &QueryClient{
+		client: types.NewQueryClient(conn),
+		retry:  retry,
+	}
+}
+
+// BurnedFees calls the distribution Query/BurnedFees method.
+func (c *QueryClient) BurnedFees(ctx context.Context, req *types.QueryBurnedFeesRequest, opts ...grpc.CallOption) (*types.QueryBurnedFeesResponse, error) {
+	var res *types.QueryBurnedFeesResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.BurnedFees(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// CommunityPool calls the distribution Query/CommunityPool method.
+func (c *QueryClient) CommunityPool(ctx context.Context, req *types.QueryCommunityPoolRequest, opts ...grpc.CallOption) (*types.QueryCommunityPoolResponse, error) {
+	var res *types.QueryCommunityPoolResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.CommunityPool(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// DelegationRewards calls the distribution Query/DelegationRewards method.
+func (c *QueryClient) DelegationRewards(ctx context.Context, req *types.QueryDelegationRewardsRequest, opts ...grpc.CallOption) (*types.QueryDelegationRewardsResponse, error) {
+	var res *types.QueryDelegationRewardsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.DelegationRewards(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// DelegationTotalRewards calls the distribution Query/DelegationTotalRewards method.
+func (c *QueryClient) DelegationTotalRewards(ctx context.Context, req *types.QueryDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*types.QueryDelegationTotalRewardsResponse, error) {
+	var res *types.QueryDelegationTotalRewardsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.DelegationTotalRewards(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// DelegatorRealizedRewards calls the distribution Query/DelegatorRealizedRewards method.
+func (c *QueryClient) DelegatorRealizedRewards(ctx context.Context, req *types.QueryDelegatorRealizedRewardsRequest, opts ...grpc.CallOption) (*types.QueryDelegatorRealizedRewardsResponse, error) {
+	var res *types.QueryDelegatorRealizedRewardsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.DelegatorRealizedRewards(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// DelegatorValidators calls the distribution Query/DelegatorValidators method.
+func (c *QueryClient) DelegatorValidators(ctx context.Context, req *types.QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*types.QueryDelegatorValidatorsResponse, error) {
+	var res *types.QueryDelegatorValidatorsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.DelegatorValidators(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// DelegatorWithdrawAddress calls the distribution Query/DelegatorWithdrawAddress method.
+func (c *QueryClient) DelegatorWithdrawAddress(ctx context.Context, req *types.QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*types.QueryDelegatorWithdrawAddressResponse, error) {
+	var res *types.QueryDelegatorWithdrawAddressResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.DelegatorWithdrawAddress(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// Dust calls the distribution Query/Dust method.
+func (c *QueryClient) Dust(ctx context.Context, req *types.QueryDustRequest, opts ...grpc.CallOption) (*types.QueryDustResponse, error) {
+	var res *types.QueryDustResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Dust(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// Params calls the distribution Query/Params method.
+func (c *QueryClient) Params(ctx context.Context, req *types.QueryParamsRequest, opts ...grpc.CallOption) (*types.QueryParamsResponse, error) {
+	var res *types.QueryParamsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Params(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// ValidatorCommission calls the distribution Query/ValidatorCommission method.
+func (c *QueryClient) ValidatorCommission(ctx context.Context, req *types.QueryValidatorCommissionRequest, opts ...grpc.CallOption) (*types.QueryValidatorCommissionResponse, error) {
+	var res *types.QueryValidatorCommissionResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.ValidatorCommission(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// ValidatorDelegatorStartingInfos calls the distribution Query/ValidatorDelegatorStartingInfos method.
+func (c *QueryClient) ValidatorDelegatorStartingInfos(ctx context.Context, req *types.QueryValidatorDelegatorStartingInfosRequest, opts ...grpc.CallOption) (*types.QueryValidatorDelegatorStartingInfosResponse, error) {
+	var res *types.QueryValidatorDelegatorStartingInfosResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.ValidatorDelegatorStartingInfos(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateValidatorDelegatorStartingInfos calls the distribution Query/ValidatorDelegatorStartingInfos method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateValidatorDelegatorStartingInfos(ctx context.Context, req *types.QueryValidatorDelegatorStartingInfosRequest, fn func(*types.QueryValidatorDelegatorStartingInfosResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq types.QueryValidatorDelegatorStartingInfosRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.ValidatorDelegatorStartingInfos(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// ValidatorOutstandingRewards calls the distribution Query/ValidatorOutstandingRewards method.
+func (c *QueryClient) ValidatorOutstandingRewards(ctx context.Context, req *types.QueryValidatorOutstandingRewardsRequest, opts ...grpc.CallOption) (*types.QueryValidatorOutstandingRewardsResponse, error) {
+	var res *types.QueryValidatorOutstandingRewardsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.ValidatorOutstandingRewards(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// ValidatorSlashes calls the distribution Query/ValidatorSlashes method.
+func (c *QueryClient) ValidatorSlashes(ctx context.Context, req *types.QueryValidatorSlashesRequest, opts ...grpc.CallOption) (*types.QueryValidatorSlashesResponse, error) {
+	var res *types.QueryValidatorSlashesResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.ValidatorSlashes(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateValidatorSlashes calls the distribution Query/ValidatorSlashes method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateValidatorSlashes(ctx context.Context, req *types.QueryValidatorSlashesRequest, fn func(*types.QueryValidatorSlashesResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq types.QueryValidatorSlashesRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.ValidatorSlashes(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// MsgClient is the typed client of the distribution Msg service, broadcasting a
+// transaction of each message.
+type MsgClient struct {
+	broadcaster typed.Broadcaster
+}
+
+// NewMsgClient returns a MsgClient broadcasting the transactions with the
+// broadcaster.
+func NewMsgClient(broadcaster typed.Broadcaster) *MsgClient {
+	return &MsgClient{broadcaster: broadcaster}
+}
+
+// FundCommunityPool broadcasts a transaction of the distribution Msg/FundCommunityPool message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) FundCommunityPool(ctx context.Context, msg *types.MsgFundCommunityPool) (*types.MsgFundCommunityPoolResponse, *sdk.TxResponse, error) {
+	res := &types.MsgFundCommunityPoolResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
+
+// SetWithdrawAddress broadcasts a transaction of the distribution Msg/SetWithdrawAddress message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) SetWithdrawAddress(ctx context.Context, msg *types.MsgSetWithdrawAddress) (*types.MsgSetWithdrawAddressResponse, *sdk.TxResponse, error) {
+	res := &types.MsgSetWithdrawAddressResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
+
+// WithdrawDelegatorReward broadcasts a transaction of the distribution Msg/WithdrawDelegatorReward message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) WithdrawDelegatorReward(ctx context.Context, msg *types.MsgWithdrawDelegatorReward) (*types.MsgWithdrawDelegatorRewardResponse, *sdk.TxResponse, error) {
+	res := &types.MsgWithdrawDelegatorRewardResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
+
+// WithdrawValidatorCommission broadcasts a transaction of the distribution Msg/WithdrawValidatorCommission message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) WithdrawValidatorCommission(ctx context.Context, msg *types.MsgWithdrawValidatorCommission) (*types.MsgWithdrawValidatorCommissionResponse, *sdk.TxResponse, error) {
+	res := &types.MsgWithdrawValidatorCommissionResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -73,7 +74,7 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"community_tax":"0.020000000000000000","base_proposer_reward":"0.010000000000000000","bonus_proposer_reward":"0.040000000000000000","withdraw_addr_enabled":true}`,
+			`{"community_tax":"0.020000000000000000","base_proposer_reward":"0.010000000000000000","bonus_proposer_reward":"0.040000000000000000","withdraw_addr_enabled":true,"fee_burn_ratio":"0.000000000000000000","dust_sweep_interval":"100"}`,
 		},
 		{
 			"text output",
@@ -81,6 +82,8 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 			`base_proposer_reward: "0.010000000000000000"
 bonus_proposer_reward: "0.040000000000000000"
 community_tax: "0.020000000000000000"
+dust_sweep_interval: "100"
+fee_burn_ratio: "0.000000000000000000"
 withdraw_addr_enabled: true`,
 		},
 	}
@@ -444,6 +447,116 @@ func (s *IntegrationTestSuite) TestGetCmdQueryCommunityPool() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQueryReconcile() {
+	val := s.network.Validators[0]
+
+	_, err := s.network.WaitForHeight(5)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			"invalid start height",
+			[]string{fmt.Sprintf("--%s=5", cli.FlagStartHeight), fmt.Sprintf("--%s=4", flags.FlagHeight)},
+			true,
+		},
+		{
+			"height range",
+			[]string{
+				fmt.Sprintf("--%s=2", cli.FlagStartHeight), fmt.Sprintf("--%s=4", flags.FlagHeight),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryReconcile()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var report cli.ReconcileReport
+			s.Require().NoError(clientCtx.LegacyAmino.UnmarshalJSON(out.Bytes(), &report))
+			s.Require().Equal(int64(2), report.StartHeight)
+			s.Require().Equal(int64(4), report.EndHeight)
+			s.Require().False(report.Flows.Minted.IsZero())
+			s.Require().False(report.Flows.RewardsAllocated.IsZero())
+			s.Require().Equal(report.Actual, report.Expected)
+			s.Require().True(report.Discrepancy.CommunityPool.IsZero())
+			s.Require().True(report.Discrepancy.OutstandingRewards.IsZero())
+			s.Require().True(report.Discrepancy.ModuleBalance.IsZero())
+			s.Require().True(report.Discrepancy.Supply.IsZero())
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetCmdQueryDelegatorRealizedRewards() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	_, err := s.network.WaitForHeight(2)
+	s.Require().NoError(err)
+
+	// nothing is realized before rewards are withdrawn
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryDelegatorRealizedRewards(), []string{
+		val.Address.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(`{"rewards":[],"total":[]}`, strings.TrimSpace(out.String()))
+
+	bz, err := MsgWithdrawDelegatorRewardExec(clientCtx, sdk.ValAddress(val.Address),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	)
+	s.Require().NoError(err)
+	var txResp sdk.TxResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bz, &txResp), string(bz))
+	s.Require().Equal(uint32(0), txResp.Code, txResp.RawLog)
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{"invalid delegator address", []string{"foo", fmt.Sprintf("--%s=json", tmcli.OutputFlag)}, true},
+		{"json output", []string{val.Address.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryDelegatorRealizedRewards(), tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			var res types.QueryDelegatorRealizedRewardsResponse
+			s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().Len(res.Rewards, 1)
+			s.Require().Equal(sdk.ValAddress(val.Address).String(), res.Rewards[0].ValidatorAddress)
+			s.Require().True(res.Total.IsAllPositive())
+			s.Require().Equal(res.Rewards[0].Rewards, res.Total)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewWithdrawRewardsCmd() {
 	val := s.network.Validators[0]
 
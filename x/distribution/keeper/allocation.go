@@ -25,6 +25,28 @@ func (k Keeper) AllocateTokens(
 	// (and distributed to the previous proposer)
 	feeCollector := k.authKeeper.GetModuleAccount(ctx, k.feeCollectorName)
 	feesCollectedInt := k.bankKeeper.GetAllBalances(ctx, feeCollector.GetAddress())
+
+	// burn the share of the collected fees set by the fee burn ratio
+	feesBurned, _ := sdk.NewDecCoinsFromCoins(feesCollectedInt...).MulDecTruncate(k.GetFeeBurnRatio(ctx)).TruncateDecimal()
+	if !feesBurned.IsZero() {
+		if err := k.bankKeeper.BurnCoinsFromAccount(ctx, feeCollector.GetAddress(), feesBurned); err != nil {
+			panic(err)
+		}
+
+		feePool := k.GetFeePool(ctx)
+		feePool.BurnedFees = feePool.BurnedFees.Add(feesBurned...)
+		k.SetFeePool(ctx, feePool)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBurnFees,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, feesBurned.String()),
+			),
+		)
+
+		feesCollectedInt = feesCollectedInt.Sub(feesBurned)
+	}
+
 	feesCollected := sdk.NewDecCoinsFromCoins(feesCollectedInt...)
 
 	// transfer collected fees to the distribution module account
@@ -96,8 +118,18 @@ func (k Keeper) AllocateTokens(
 		remaining = remaining.Sub(reward)
 	}
 
-	// allocate community funding
-	feePool.CommunityPool = feePool.CommunityPool.Add(remaining...)
+	// allocate community funding, and collect the truncation dust of the
+	// allocations, i.e. what remains beyond the community tax
+	communityFunding := feesCollected.MulDecTruncate(communityTax)
+	if proposerValidator == nil {
+		communityFunding = communityFunding.Add(proposerReward...)
+	}
+	dust, hasNeg := remaining.SafeSub(communityFunding)
+	if hasNeg {
+		communityFunding, dust = remaining, nil
+	}
+	feePool.CommunityPool = feePool.CommunityPool.Add(communityFunding...)
+	feePool.Dust = feePool.Dust.Add(dust...)
 	k.SetFeePool(ctx, feePool)
 }
 
@@ -10,6 +10,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -186,4 +188,72 @@ func TestAllocateTokensTruncation(t *testing.T) {
 	require.True(t, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddrs[0]).Rewards.IsValid())
 	require.True(t, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddrs[1]).Rewards.IsValid())
 	require.True(t, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddrs[2]).Rewards.IsValid())
+
+	// the community pool gets the community tax, the truncation dust being
+	// collected apart
+	feePool := app.DistrKeeper.GetFeePool(ctx)
+	communityTax := sdk.NewDecCoinsFromCoins(fees...).MulDecTruncate(app.DistrKeeper.GetCommunityTax(ctx))
+	require.Equal(t, communityTax, feePool.CommunityPool)
+	require.False(t, feePool.Dust.IsZero())
+
+	total := feePool.CommunityPool.Add(feePool.Dust...)
+	for _, valAddr := range valAddrs {
+		total = total.Add(app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddr).Rewards...)
+	}
+	require.Equal(t, sdk.NewDecCoinsFromCoins(fees...), total)
+
+	_, broken := keeper.ModuleAccountInvariant(app.DistrKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestAllocateTokensBurnsFees(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.FeeBurnRatio = sdk.NewDecWithPrec(25, 2)
+	app.DistrKeeper.SetParams(ctx, params)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1234))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// create validator with 0% commission
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0))
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(201)))
+	feeCollector := app.AccountKeeper.GetModuleAccount(ctx, types.FeeCollectorName)
+	require.NoError(t, simapp.FundModuleAccount(app.BankKeeper, ctx, feeCollector.GetName(), fees))
+	supplyBefore := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+
+	votes := []abci.VoteInfo{
+		{
+			Validator:       abci.Validator{Address: valConsPk1.Address(), Power: 100},
+			SignedLastBlock: true,
+		},
+	}
+	app.DistrKeeper.AllocateTokens(ctx, 100, 100, valConsAddr1, votes)
+
+	// 25% of 201 truncated is burned, the remaining 151 are distributed
+	burned := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50)))
+	require.Equal(t, burned, app.DistrKeeper.GetFeePool(ctx).BurnedFees)
+	require.Equal(t, supplyBefore.Sub(burned[0]), app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, feeCollector.GetAddress()).IsZero())
+
+	// 2% of 151 go to the community pool, the rest to the validator
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecWithPrec(302, 2)}}, app.DistrKeeper.GetFeePool(ctx).CommunityPool)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecWithPrec(14798, 2)}}, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddrs[0]).Rewards)
+
+	var found bool
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type == "burn_fees" {
+			found = true
+		}
+	}
+	require.True(t, found, "burn_fees event should be emitted")
+
+	res, err := app.DistrKeeper.BurnedFees(sdk.WrapSDKContext(ctx), &disttypes.QueryBurnedFeesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, burned, res.BurnedFees)
 }
@@ -161,7 +161,7 @@ func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val stakingtypes.Vali
 		)
 	}
 
-	// truncate coins, return remainder to community pool
+	// truncate coins, collect remainder as dust
 	coins, remainder := rewards.TruncateDecimal()
 
 	// add coins to user account
@@ -171,13 +171,16 @@ func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val stakingtypes.Vali
 		if err != nil {
 			return nil, err
 		}
+
+		// track the rewards realized by the delegator for reporting purposes
+		k.AddDelegatorRealizedRewards(ctx, del.GetDelegatorAddr(), del.GetValidatorAddr(), coins)
 	}
 
-	// update the outstanding rewards and the community pool only if the
-	// transaction was successful
+	// update the outstanding rewards and the dust only if the transaction was
+	// successful
 	k.SetValidatorOutstandingRewards(ctx, del.GetValidatorAddr(), types.ValidatorOutstandingRewards{Rewards: outstanding.Sub(rewards)})
 	feePool := k.GetFeePool(ctx)
-	feePool.CommunityPool = feePool.CommunityPool.Add(remainder...)
+	feePool.Dust = feePool.Dust.Add(remainder...)
 	k.SetFeePool(ctx, feePool)
 
 	// decrement reference count of starting period
@@ -327,6 +327,12 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 		app.BankKeeper.GetAllBalances(ctx, sdk.AccAddress(valAddrs[0])),
 	)
 
+	// the withdrawn rewards are realized
+	require.Equal(t,
+		sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))},
+		app.DistrKeeper.GetDelegatorRealizedRewards(ctx, sdk.AccAddress(valAddrs[0]), valAddrs[0]).Rewards,
+	)
+
 	// withdraw commission
 	_, err = app.DistrKeeper.WithdrawValidatorCommission(ctx, valAddrs[0])
 	require.Nil(t, err)
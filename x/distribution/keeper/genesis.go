@@ -15,11 +15,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 	k.SetParams(ctx, data.Params)
 
 	for _, dwi := range data.DelegatorWithdrawInfos {
-		delegatorAddress, err := sdk.AccAddressFromBech32(dwi.DelegatorAddress)
+		delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(dwi.DelegatorAddress)
 		if err != nil {
 			panic(err)
 		}
-		withdrawAddress, err := sdk.AccAddressFromBech32(dwi.WithdrawAddress)
+		withdrawAddress, err := k.authKeeper.AddressCodec().StringToBytes(dwi.WithdrawAddress)
 		if err != nil {
 			panic(err)
 		}
@@ -72,7 +72,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
-		delegatorAddress, err := sdk.AccAddressFromBech32(del.DelegatorAddress)
+		delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(del.DelegatorAddress)
 		if err != nil {
 			panic(err)
 		}
@@ -85,6 +85,17 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 		k.SetValidatorSlashEvent(ctx, valAddr, evt.Height, evt.Period, evt.ValidatorSlashEvent)
 	}
+	for _, rec := range data.DelegatorRealizedRewards {
+		delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(rec.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		valAddr, err := sdk.ValAddressFromBech32(rec.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetDelegatorRealizedRewards(ctx, delegatorAddress, valAddr, rec.RealizedRewards)
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -190,5 +201,17 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	realized := make([]types.DelegatorRealizedRewardsRecord, 0)
+	k.IterateAllDelegatorRealizedRewards(ctx,
+		func(del sdk.AccAddress, val sdk.ValAddress, rewards types.DelegatorRealizedRewards) (stop bool) {
+			realized = append(realized, types.DelegatorRealizedRewardsRecord{
+				DelegatorAddress: del.String(),
+				ValidatorAddress: val.String(),
+				RealizedRewards:  rewards,
+			})
+			return false
+		},
+	)
+
+	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, realized)
 }
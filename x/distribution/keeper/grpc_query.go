@@ -141,7 +141,7 @@ func (k Keeper) DelegationRewards(c context.Context, req *types.QueryDelegationR
 		return nil, sdkerrors.Wrap(types.ErrNoValidatorExists, req.ValidatorAddress)
 	}
 
-	delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	delAdr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -171,7 +171,7 @@ func (k Keeper) DelegationTotalRewards(c context.Context, req *types.QueryDelega
 	total := sdk.DecCoins{}
 	var delRewards []types.DelegationDelegatorReward
 
-	delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	delAdr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -193,6 +193,41 @@ func (k Keeper) DelegationTotalRewards(c context.Context, req *types.QueryDelega
 	return &types.QueryDelegationTotalRewardsResponse{Rewards: delRewards, Total: total}, nil
 }
 
+// DelegatorRealizedRewards queries the rewards withdrawn by a delegator from each validator
+func (k Keeper) DelegatorRealizedRewards(c context.Context, req *types.QueryDelegatorRealizedRewardsRequest) (*types.QueryDelegatorRealizedRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	total := sdk.Coins{}
+	var realizedRewards []types.DelegationDelegatorRealizedReward
+
+	delAdr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	k.IterateDelegatorRealizedRewards(
+		ctx, delAdr,
+		func(valAddr sdk.ValAddress, rewards types.DelegatorRealizedRewards) (stop bool) {
+			realizedRewards = append(realizedRewards, types.DelegationDelegatorRealizedReward{
+				ValidatorAddress: valAddr.String(),
+				Rewards:          rewards.Rewards,
+			})
+			total = total.Add(rewards.Rewards...)
+			return false
+		},
+	)
+
+	return &types.QueryDelegatorRealizedRewardsResponse{Rewards: realizedRewards, Total: total}, nil
+}
+
 // DelegatorValidators queries the validators list of a delegator
 func (k Keeper) DelegatorValidators(c context.Context, req *types.QueryDelegatorValidatorsRequest) (*types.QueryDelegatorValidatorsResponse, error) {
 	if req == nil {
@@ -204,7 +239,7 @@ func (k Keeper) DelegatorValidators(c context.Context, req *types.QueryDelegator
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	delAdr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -230,7 +265,7 @@ func (k Keeper) DelegatorWithdrawAddress(c context.Context, req *types.QueryDele
 	if req.DelegatorAddress == "" {
 		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
 	}
-	delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	delAdr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -248,3 +283,61 @@ func (k Keeper) CommunityPool(c context.Context, req *types.QueryCommunityPoolRe
 
 	return &types.QueryCommunityPoolResponse{Pool: pool}, nil
 }
+
+// BurnedFees queries the cumulative amount of collected fees burned
+func (k Keeper) BurnedFees(c context.Context, req *types.QueryBurnedFeesRequest) (*types.QueryBurnedFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	feePool := k.GetFeePool(ctx)
+
+	return &types.QueryBurnedFeesResponse{BurnedFees: feePool.BurnedFees}, nil
+}
+
+// Dust queries the truncation dust accumulated since the last sweep
+func (k Keeper) Dust(c context.Context, req *types.QueryDustRequest) (*types.QueryDustResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	feePool := k.GetFeePool(ctx)
+
+	return &types.QueryDustResponse{Dust: feePool.Dust}, nil
+}
+
+// ValidatorDelegatorStartingInfos queries the starting infos of the delegations to a validator
+func (k Keeper) ValidatorDelegatorStartingInfos(c context.Context, req *types.QueryValidatorDelegatorStartingInfosRequest) (*types.QueryValidatorDelegatorStartingInfosResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	startingInfosStore := prefix.NewStore(store, types.GetDelegatorStartingInfoPrefix(valAddr))
+
+	var startingInfos []types.DelegatorStartingInfoRecord
+	pageRes, err := query.Paginate(startingInfosStore, req.Pagination, func(key []byte, value []byte) error {
+		var info types.DelegatorStartingInfo
+		if err := k.cdc.Unmarshal(value, &info); err != nil {
+			return err
+		}
+
+		// the keys of the prefix store are the length prefixed delegator addresses
+		delAddr := sdk.AccAddress(key[1:])
+		startingInfos = append(startingInfos, types.DelegatorStartingInfoRecord{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: req.ValidatorAddress,
+			StartingInfo:     info,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorDelegatorStartingInfosResponse{StartingInfos: startingInfos, Pagination: pageRes}, nil
+}
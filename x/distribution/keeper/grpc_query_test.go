@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"bytes"
 	gocontext "context"
 	"fmt"
 	"testing"
@@ -74,6 +75,7 @@ func (suite *KeeperTestSuite) TestGRPCParams() {
 					BaseProposerReward:  sdk.NewDecWithPrec(2, 1),
 					BonusProposerReward: sdk.NewDecWithPrec(1, 1),
 					WithdrawAddrEnabled: true,
+					FeeBurnRatio:        sdk.NewDecWithPrec(1, 1),
 				}
 
 				app.DistrKeeper.SetParams(ctx, params)
@@ -598,6 +600,168 @@ func (suite *KeeperTestSuite) TestGRPCDelegatorWithdrawAddress() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCDelegatorRealizedRewards() {
+	app, ctx, queryClient, addrs, valAddrs := suite.app, suite.ctx, suite.queryClient, suite.addrs, suite.valAddrs
+
+	app.DistrKeeper.AddDelegatorRealizedRewards(ctx, addrs[0], valAddrs[0], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)))
+	app.DistrKeeper.AddDelegatorRealizedRewards(ctx, addrs[0], valAddrs[0], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2)))
+	app.DistrKeeper.AddDelegatorRealizedRewards(ctx, addrs[0], valAddrs[1], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3)))
+
+	var (
+		req    *types.QueryDelegatorRealizedRewardsRequest
+		expRes *types.QueryDelegatorRealizedRewardsResponse
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryDelegatorRealizedRewardsRequest{}
+			},
+			false,
+		},
+		{
+			"invalid delegator address",
+			func() {
+				req = &types.QueryDelegatorRealizedRewardsRequest{DelegatorAddress: "invalid"}
+			},
+			false,
+		},
+		{
+			"no realized rewards",
+			func() {
+				req = &types.QueryDelegatorRealizedRewardsRequest{DelegatorAddress: addrs[1].String()}
+				expRes = &types.QueryDelegatorRealizedRewardsResponse{}
+			},
+			true,
+		},
+		{
+			"valid request",
+			func() {
+				req = &types.QueryDelegatorRealizedRewardsRequest{DelegatorAddress: addrs[0].String()}
+				expRes = &types.QueryDelegatorRealizedRewardsResponse{
+					Rewards: []types.DelegationDelegatorRealizedReward{
+						{ValidatorAddress: valAddrs[0].String(), Rewards: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 7))},
+						{ValidatorAddress: valAddrs[1].String(), Rewards: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3))},
+					},
+					Total: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+				}
+			},
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			realizedRewards, err := queryClient.DelegatorRealizedRewards(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(expRes.Total.IsEqual(realizedRewards.Total))
+				suite.Require().ElementsMatch(expRes.Rewards, realizedRewards.Rewards)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(realizedRewards)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCValidatorDelegatorStartingInfos() {
+	app, ctx, queryClient, addrs, valAddrs := suite.app, suite.ctx, suite.queryClient, suite.addrs, suite.valAddrs
+
+	info := func(period uint64, stake int64) types.DelegatorStartingInfo {
+		return types.NewDelegatorStartingInfo(period, sdk.NewDec(stake), 10)
+	}
+	app.DistrKeeper.SetDelegatorStartingInfo(ctx, valAddrs[0], addrs[0], info(1, 100))
+	app.DistrKeeper.SetDelegatorStartingInfo(ctx, valAddrs[0], addrs[1], info(2, 200))
+	app.DistrKeeper.SetDelegatorStartingInfo(ctx, valAddrs[1], addrs[0], info(3, 300))
+
+	record := func(delAddr sdk.AccAddress, info types.DelegatorStartingInfo) types.DelegatorStartingInfoRecord {
+		return types.DelegatorStartingInfoRecord{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: valAddrs[0].String(),
+			StartingInfo:     info,
+		}
+	}
+
+	var (
+		req    *types.QueryValidatorDelegatorStartingInfosRequest
+		expRes *types.QueryValidatorDelegatorStartingInfosResponse
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryValidatorDelegatorStartingInfosRequest{}
+			},
+			false,
+		},
+		{
+			"invalid validator address",
+			func() {
+				req = &types.QueryValidatorDelegatorStartingInfosRequest{ValidatorAddress: "invalid"}
+			},
+			false,
+		},
+		{
+			"all starting infos of the validator",
+			func() {
+				req = &types.QueryValidatorDelegatorStartingInfosRequest{ValidatorAddress: valAddrs[0].String()}
+				expRes = &types.QueryValidatorDelegatorStartingInfosResponse{
+					StartingInfos: []types.DelegatorStartingInfoRecord{record(addrs[0], info(1, 100)), record(addrs[1], info(2, 200))},
+				}
+			},
+			true,
+		},
+		{
+			"paginated request",
+			func() {
+				req = &types.QueryValidatorDelegatorStartingInfosRequest{
+					ValidatorAddress: valAddrs[0].String(),
+					Pagination:       &query.PageRequest{Limit: 1},
+				}
+				// the starting infos are sorted by delegator address
+				first := record(addrs[0], info(1, 100))
+				if bytes.Compare(addrs[1], addrs[0]) < 0 {
+					first = record(addrs[1], info(2, 200))
+				}
+				expRes = &types.QueryValidatorDelegatorStartingInfosResponse{
+					StartingInfos: []types.DelegatorStartingInfoRecord{first},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			startingInfos, err := queryClient.ValidatorDelegatorStartingInfos(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				suite.Require().ElementsMatch(expRes.StartingInfos, startingInfos.StartingInfos)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(startingInfos)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestGRPCCommunityPool() {
 	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
 
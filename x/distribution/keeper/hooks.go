@@ -36,9 +36,9 @@ func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr
 		// split into integral & remainder
 		coins, remainder := commission.TruncateDecimal()
 
-		// remainder to community pool
+		// remainder to dust
 		feePool := h.k.GetFeePool(ctx)
-		feePool.CommunityPool = feePool.CommunityPool.Add(remainder...)
+		feePool.Dust = feePool.Dust.Add(remainder...)
 		h.k.SetFeePool(ctx, feePool)
 
 		// add to validator account
@@ -52,11 +52,11 @@ func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr
 		}
 	}
 
-	// Add outstanding to community pool
+	// Add outstanding to dust
 	// The validator is removed only after it has no more delegations.
-	// This operation sends only the remaining dust to the community pool.
+	// This operation collects only the remaining dust.
 	feePool := h.k.GetFeePool(ctx)
-	feePool.CommunityPool = feePool.CommunityPool.Add(outstanding...)
+	feePool.Dust = feePool.Dust.Add(outstanding...)
 	h.k.SetFeePool(ctx, feePool)
 
 	// delete outstanding
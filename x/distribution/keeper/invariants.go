@@ -18,6 +18,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		ReferenceCountInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "module-account",
 		ModuleAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "nonnegative-dust",
+		NonNegativeDustInvariant(k))
 }
 
 // AllInvariants runs all invariants of the distribution module
@@ -35,6 +37,10 @@ func AllInvariants(k Keeper) sdk.Invariant {
 		if stop {
 			return res, stop
 		}
+		res, stop = NonNegativeDustInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
 		return ModuleAccountInvariant(k)(ctx)
 	}
 }
@@ -133,8 +139,20 @@ func ReferenceCountInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
+// NonNegativeDustInvariant checks that the truncation dust is never negative
+func NonNegativeDustInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		dust := k.GetFeePool(ctx).Dust
+		broken := dust.IsAnyNegative()
+
+		return sdk.FormatInvariant(types.ModuleName, "nonnegative dust",
+			fmt.Sprintf("\tdust: %v\n", dust)), broken
+	}
+}
+
 // ModuleAccountInvariant checks that the coins held by the distr ModuleAccount
-// is consistent with the sum of validator outstanding rewards
+// is consistent with the sum of validator outstanding rewards, the community
+// pool and the truncation dust
 func ModuleAccountInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 
@@ -144,8 +162,8 @@ func ModuleAccountInvariant(k Keeper) sdk.Invariant {
 			return false
 		})
 
-		communityPool := k.GetFeePoolCommunityCoins(ctx)
-		expectedInt, _ := expectedCoins.Add(communityPool...).TruncateDecimal()
+		feePool := k.GetFeePool(ctx)
+		expectedInt, _ := expectedCoins.Add(feePool.CommunityPool...).Add(feePool.Dust...).TruncateDecimal()
 
 		macc := k.GetDistributionAccount(ctx)
 		balances := k.bankKeeper.GetAllBalances(ctx, macc.GetAddress())
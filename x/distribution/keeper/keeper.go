@@ -173,3 +173,23 @@ func (k Keeper) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.
 
 	return nil
 }
+
+// SweepDust moves the truncation dust accumulated since the last sweep to the
+// community pool.
+func (k Keeper) SweepDust(ctx sdk.Context) {
+	feePool := k.GetFeePool(ctx)
+	if feePool.Dust.IsZero() {
+		return
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSweepDust,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, feePool.Dust.String()),
+		),
+	)
+
+	feePool.CommunityPool = feePool.CommunityPool.Add(feePool.Dust...)
+	feePool.Dust = sdk.DecCoins{}
+	k.SetFeePool(ctx, feePool)
+}
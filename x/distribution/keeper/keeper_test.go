@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
@@ -125,3 +126,32 @@ func TestFundCommunityPool(t *testing.T) {
 	assert.Equal(t, initPool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(amount...)...), app.DistrKeeper.GetFeePool(ctx).CommunityPool)
 	assert.Empty(t, app.BankKeeper.GetAllBalances(ctx, addr[0]))
 }
+
+func TestSweepDust(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	dust := sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(5, 1)))
+	feePool := app.DistrKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 1))
+	feePool.Dust = dust
+	app.DistrKeeper.SetFeePool(ctx, feePool)
+
+	res, err := app.DistrKeeper.Dust(sdk.WrapSDKContext(ctx), &types.QueryDustRequest{})
+	require.NoError(t, err)
+	require.Equal(t, dust, res.Dust)
+
+	app.DistrKeeper.SweepDust(ctx)
+
+	feePool = app.DistrKeeper.GetFeePool(ctx)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(15, 1))), feePool.CommunityPool)
+	require.True(t, feePool.Dust.IsZero())
+
+	_, broken := keeper.NonNegativeDustInvariant(app.DistrKeeper)(ctx)
+	require.False(t, broken)
+
+	feePool.Dust = sdk.DecCoins{{Denom: "stake", Amount: sdk.NewDec(-1)}}
+	app.DistrKeeper.SetFeePool(ctx, feePool)
+	_, broken = keeper.NonNegativeDustInvariant(app.DistrKeeper)(ctx)
+	require.True(t, broken)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v043 "github.com/cosmos/cosmos-sdk/x/distribution/legacy/v043"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -19,3 +20,17 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v043.MigrateStore(ctx, m.keeper.storeKey)
 }
+
+// Migrate2to3 migrates from version 2 to 3. It sets the fee burn ratio param
+// to zero, i.e. collected fees keep being fully distributed.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyFeeBurnRatio, sdk.ZeroDec())
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4. It sets the dust sweep interval
+// param to its default value.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyDustSweepInterval, types.DefaultDustSweepInterval)
+	return nil
+}
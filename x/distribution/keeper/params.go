@@ -41,3 +41,15 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) (enabled bool) {
 	k.paramSpace.Get(ctx, types.ParamStoreKeyWithdrawAddrEnabled, &enabled)
 	return enabled
 }
+
+// GetFeeBurnRatio returns the current distribution fee burn ratio.
+func (k Keeper) GetFeeBurnRatio(ctx sdk.Context) (ratio sdk.Dec) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyFeeBurnRatio, &ratio)
+	return ratio
+}
+
+// GetDustSweepInterval returns the current distribution dust sweep interval.
+func (k Keeper) GetDustSweepInterval(ctx sdk.Context) (interval uint64) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyDustSweepInterval, &interval)
+	return interval
+}
@@ -12,7 +12,7 @@ func HandleCommunityPoolSpendProposal(ctx sdk.Context, k Keeper, p *types.Commun
 		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", p.Recipient)
 	}
 
-	recipient, addrErr := sdk.AccAddressFromBech32(p.Recipient)
+	recipient, addrErr := k.authKeeper.AddressCodec().StringToBytes(p.Recipient)
 	if addrErr != nil {
 		return addrErr
 	}
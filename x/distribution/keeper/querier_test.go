@@ -131,6 +131,7 @@ func TestQueries(t *testing.T) {
 		BaseProposerReward:  sdk.NewDecWithPrec(2, 1),
 		BonusProposerReward: sdk.NewDecWithPrec(1, 1),
 		WithdrawAddrEnabled: true,
+		FeeBurnRatio:        sdk.NewDecWithPrec(1, 1),
 	}
 
 	app.DistrKeeper.SetParams(ctx, params)
@@ -124,6 +124,61 @@ func (k Keeper) IterateDelegatorStartingInfos(ctx sdk.Context, handler func(val
 	}
 }
 
+// get the rewards withdrawn by a delegator from a validator
+func (k Keeper) GetDelegatorRealizedRewards(ctx sdk.Context, del sdk.AccAddress, val sdk.ValAddress) (rewards types.DelegatorRealizedRewards) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetDelegatorRealizedRewardsKey(del, val))
+	if b == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(b, &rewards)
+	return
+}
+
+// set the rewards withdrawn by a delegator from a validator
+func (k Keeper) SetDelegatorRealizedRewards(ctx sdk.Context, del sdk.AccAddress, val sdk.ValAddress, rewards types.DelegatorRealizedRewards) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&rewards)
+	store.Set(types.GetDelegatorRealizedRewardsKey(del, val), b)
+}
+
+// add to the rewards withdrawn by a delegator from a validator
+func (k Keeper) AddDelegatorRealizedRewards(ctx sdk.Context, del sdk.AccAddress, val sdk.ValAddress, coins sdk.Coins) {
+	rewards := k.GetDelegatorRealizedRewards(ctx, del, val)
+	rewards.Rewards = rewards.Rewards.Add(coins...)
+	k.SetDelegatorRealizedRewards(ctx, del, val, rewards)
+}
+
+// iterate over the realized rewards of a delegator
+func (k Keeper) IterateDelegatorRealizedRewards(ctx sdk.Context, del sdk.AccAddress, handler func(val sdk.ValAddress, rewards types.DelegatorRealizedRewards) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetDelegatorRealizedRewardsPrefix(del))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var rewards types.DelegatorRealizedRewards
+		k.cdc.MustUnmarshal(iter.Value(), &rewards)
+		_, val := types.GetDelegatorRealizedRewardsAddresses(iter.Key())
+		if handler(val, rewards) {
+			break
+		}
+	}
+}
+
+// iterate over the realized rewards of all delegators
+func (k Keeper) IterateAllDelegatorRealizedRewards(ctx sdk.Context, handler func(del sdk.AccAddress, val sdk.ValAddress, rewards types.DelegatorRealizedRewards) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DelegatorRealizedRewardsPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var rewards types.DelegatorRealizedRewards
+		k.cdc.MustUnmarshal(iter.Value(), &rewards)
+		del, val := types.GetDelegatorRealizedRewardsAddresses(iter.Key())
+		if handler(del, val, rewards) {
+			break
+		}
+	}
+}
+
 // get historical rewards for a particular period
 func (k Keeper) GetValidatorHistoricalRewards(ctx sdk.Context, val sdk.ValAddress, period uint64) (rewards types.ValidatorHistoricalRewards) {
 	store := ctx.KVStore(k.storeKey)
@@ -63,6 +63,12 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &eventB)
 			return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+		case bytes.Equal(kvA.Key[:1], types.DelegatorRealizedRewardsPrefix):
+			var rewardsA, rewardsB types.DelegatorRealizedRewards
+			cdc.MustUnmarshal(kvA.Value, &rewardsA)
+			cdc.MustUnmarshal(kvB.Value, &rewardsB)
+			return fmt.Sprintf("%v\n%v", rewardsA, rewardsB)
+
 		default:
 			panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 		}
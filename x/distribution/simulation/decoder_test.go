@@ -34,6 +34,7 @@ func TestDecodeDistributionStore(t *testing.T) {
 	historicalRewards := types.NewValidatorHistoricalRewards(decCoins, 100)
 	currentRewards := types.NewValidatorCurrentRewards(decCoins, 5)
 	slashEvent := types.NewValidatorSlashEvent(10, sdk.OneDec())
+	realizedRewards := types.DelegatorRealizedRewards{Rewards: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))}
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -46,6 +47,7 @@ func TestDecodeDistributionStore(t *testing.T) {
 			{Key: types.GetValidatorCurrentRewardsKey(valAddr1), Value: cdc.MustMarshal(&currentRewards)},
 			{Key: types.GetValidatorAccumulatedCommissionKey(valAddr1), Value: cdc.MustMarshal(&commission)},
 			{Key: types.GetValidatorSlashEventKeyPrefix(valAddr1, 13), Value: cdc.MustMarshal(&slashEvent)},
+			{Key: types.GetDelegatorRealizedRewardsKey(delAddr1, valAddr1), Value: cdc.MustMarshal(&realizedRewards)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -63,6 +65,7 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"ValidatorCurrentRewards", fmt.Sprintf("%v\n%v", currentRewards, currentRewards)},
 		{"ValidatorAccumulatedCommission", fmt.Sprintf("%v\n%v", commission, commission)},
 		{"ValidatorSlashEvent", fmt.Sprintf("%v\n%v", slashEvent, slashEvent)},
+		{"DelegatorRealizedRewards", fmt.Sprintf("%v\n%v", realizedRewards, realizedRewards)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
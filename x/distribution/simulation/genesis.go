@@ -18,6 +18,8 @@ const (
 	BaseProposerReward  = "base_proposer_reward"
 	BonusProposerReward = "bonus_proposer_reward"
 	WithdrawEnabled     = "withdraw_enabled"
+	FeeBurnRatio        = "fee_burn_ratio"
+	DustSweepInterval   = "dust_sweep_interval"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -40,6 +42,16 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenFeeBurnRatio randomized FeeBurnRatio
+func GenFeeBurnRatio(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(50)), 2)
+}
+
+// GenDustSweepInterval randomized DustSweepInterval
+func GenDustSweepInterval(r *rand.Rand) uint64 {
+	return uint64(r.Intn(200))
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax sdk.Dec
@@ -66,6 +78,18 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) },
 	)
 
+	var feeBurnRatio sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, FeeBurnRatio, &feeBurnRatio, simState.Rand,
+		func(r *rand.Rand) { feeBurnRatio = GenFeeBurnRatio(r) },
+	)
+
+	var dustSweepInterval uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DustSweepInterval, &dustSweepInterval, simState.Rand,
+		func(r *rand.Rand) { dustSweepInterval = GenDustSweepInterval(r) },
+	)
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
@@ -73,6 +97,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 			BaseProposerReward:  baseProposerReward,
 			BonusProposerReward: bonusProposerReward,
 			WithdrawAddrEnabled: withdrawEnabled,
+			FeeBurnRatio:        feeBurnRatio,
+			DustSweepInterval:   dustSweepInterval,
 		},
 	}
 
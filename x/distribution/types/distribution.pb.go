@@ -31,6 +31,12 @@ type Params struct {
 	BaseProposerReward  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_proposer_reward,json=baseProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_proposer_reward" yaml:"base_proposer_reward"`
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward" yaml:"bonus_proposer_reward"`
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty" yaml:"withdraw_addr_enabled"`
+	// fee_burn_ratio is the ratio of the collected fees burned before their
+	// distribution.
+	FeeBurnRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=fee_burn_ratio,json=feeBurnRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_burn_ratio" yaml:"fee_burn_ratio"`
+	// dust_sweep_interval is the number of blocks between the sweeps of the
+	// truncation dust to the community pool, zero disabling the sweeps.
+	DustSweepInterval uint64 `protobuf:"varint,6,opt,name=dust_sweep_interval,json=dustSweepInterval,proto3" json:"dust_sweep_interval,omitempty" yaml:"dust_sweep_interval"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -72,6 +78,13 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetDustSweepInterval() uint64 {
+	if m != nil {
+		return m.DustSweepInterval
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -79,11 +92,12 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 // The reference count indicates the number of objects
 // which might need to reference this historical entry at any point.
 // ReferenceCount =
-//    number of outstanding delegations which ended the associated period (and
-//    might need to read that record)
-//  + number of slashes which ended the associated period (and might need to
-//  read that record)
-//  + one per validator for the zeroeth period, set on initialization
+//
+//	  number of outstanding delegations which ended the associated period (and
+//	  might need to read that record)
+//	+ number of slashes which ended the associated period (and might need to
+//	read that record)
+//	+ one per validator for the zeroeth period, set on initialization
 type ValidatorHistoricalRewards struct {
 	CumulativeRewardRatio github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_reward_ratio,json=cumulativeRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_reward_ratio" yaml:"cumulative_reward_ratio"`
 	ReferenceCount        uint32                                      `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty" yaml:"reference_count"`
@@ -379,6 +393,11 @@ func (m *ValidatorSlashEvents) GetValidatorSlashEvents() []ValidatorSlashEvent {
 // FeePool is the global fee pool for distribution.
 type FeePool struct {
 	CommunityPool github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=community_pool,json=communityPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"community_pool" yaml:"community_pool"`
+	// burned_fees is the cumulative amount of collected fees burned.
+	BurnedFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=burned_fees,json=burnedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned_fees" yaml:"burned_fees"`
+	// dust is the truncation dust accumulated by the distribution operations,
+	// periodically swept to the community pool.
+	Dust github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=dust,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"dust" yaml:"dust"`
 }
 
 func (m *FeePool) Reset()         { *m = FeePool{} }
@@ -421,6 +440,20 @@ func (m *FeePool) GetCommunityPool() github_com_cosmos_cosmos_sdk_types.DecCoins
 	return nil
 }
 
+func (m *FeePool) GetBurnedFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BurnedFees
+	}
+	return nil
+}
+
+func (m *FeePool) GetDust() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Dust
+	}
+	return nil
+}
+
 // CommunityPoolSpendProposal details a proposal for use of community funds,
 // together with how many coins are proposed to be spent, and to which
 // recipient account.
@@ -522,6 +555,92 @@ func (m *DelegatorStartingInfo) GetHeight() uint64 {
 	return 0
 }
 
+// DelegatorRealizedRewards represents the cumulative rewards withdrawn by a
+// delegator from a validator, kept after the delegation is removed.
+type DelegatorRealizedRewards struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *DelegatorRealizedRewards) Reset()         { *m = DelegatorRealizedRewards{} }
+func (m *DelegatorRealizedRewards) String() string { return proto.CompactTextString(m) }
+func (*DelegatorRealizedRewards) ProtoMessage()    {}
+func (*DelegatorRealizedRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{10}
+}
+func (m *DelegatorRealizedRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorRealizedRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorRealizedRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorRealizedRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorRealizedRewards.Merge(m, src)
+}
+func (m *DelegatorRealizedRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorRealizedRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorRealizedRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorRealizedRewards proto.InternalMessageInfo
+
+func (m *DelegatorRealizedRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+// DelegationDelegatorRealizedReward represents the realized rewards of a
+// delegator from a validator.
+type DelegationDelegatorRealizedReward struct {
+	ValidatorAddress string                                   `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	Rewards          github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *DelegationDelegatorRealizedReward) Reset()         { *m = DelegationDelegatorRealizedReward{} }
+func (m *DelegationDelegatorRealizedReward) String() string { return proto.CompactTextString(m) }
+func (*DelegationDelegatorRealizedReward) ProtoMessage()    {}
+func (*DelegationDelegatorRealizedReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{11}
+}
+func (m *DelegationDelegatorRealizedReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationDelegatorRealizedReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationDelegatorRealizedReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegationDelegatorRealizedReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationDelegatorRealizedReward.Merge(m, src)
+}
+func (m *DelegationDelegatorRealizedReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationDelegatorRealizedReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationDelegatorRealizedReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationDelegatorRealizedReward proto.InternalMessageInfo
+
 // DelegationDelegatorReward represents the properties
 // of a delegator's delegation reward.
 type DelegationDelegatorReward struct {
@@ -533,7 +652,7 @@ func (m *DelegationDelegatorReward) Reset()         { *m = DelegationDelegatorRe
 func (m *DelegationDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*DelegationDelegatorReward) ProtoMessage()    {}
 func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{10}
+	return fileDescriptor_cd78a31ea281a992, []int{12}
 }
 func (m *DelegationDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -576,7 +695,7 @@ func (m *CommunityPoolSpendProposalWithDeposit) Reset()         { *m = Community
 func (m *CommunityPoolSpendProposalWithDeposit) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolSpendProposalWithDeposit) ProtoMessage()    {}
 func (*CommunityPoolSpendProposalWithDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{11}
+	return fileDescriptor_cd78a31ea281a992, []int{13}
 }
 func (m *CommunityPoolSpendProposalWithDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -616,6 +735,8 @@ func init() {
 	proto.RegisterType((*FeePool)(nil), "cosmos.distribution.v1beta1.FeePool")
 	proto.RegisterType((*CommunityPoolSpendProposal)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposal")
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
+	proto.RegisterType((*DelegatorRealizedRewards)(nil), "cosmos.distribution.v1beta1.DelegatorRealizedRewards")
+	proto.RegisterType((*DelegationDelegatorRealizedReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorRealizedReward")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
 }
@@ -625,76 +746,86 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1102 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x24, 0x8e, 0x93, 0x4e, 0xf3, 0xab, 0x13, 0x27, 0x71, 0x93, 0xe0, 0x8d, 0x46, 0x6a,
-	0x15, 0x04, 0x75, 0x9a, 0xf6, 0x82, 0x72, 0x40, 0x8a, 0x9d, 0x44, 0x14, 0x01, 0x8d, 0xb6, 0x01,
-	0x24, 0x2e, 0xd6, 0x78, 0x77, 0x62, 0x8f, 0x62, 0xef, 0x2c, 0x33, 0x63, 0x27, 0x39, 0x20, 0x24,
-	0x4e, 0x5c, 0x10, 0x20, 0x2e, 0x1c, 0x00, 0xe5, 0xc8, 0xaf, 0x3f, 0xa4, 0xc7, 0xde, 0x40, 0x20,
-	0x2d, 0x28, 0x11, 0x12, 0xe2, 0xe8, 0x1b, 0x37, 0xb4, 0x3b, 0xb3, 0xbb, 0xb6, 0x6b, 0xaa, 0xb8,
-	0x52, 0x4f, 0xf6, 0x7e, 0xf3, 0xe6, 0xbd, 0xef, 0xbd, 0xf7, 0xed, 0x7b, 0x0b, 0x4b, 0x0e, 0x97,
-	0x2d, 0x2e, 0x37, 0x5d, 0x26, 0x95, 0x60, 0xb5, 0xb6, 0x62, 0xdc, 0xdb, 0xec, 0x6c, 0xd5, 0xa8,
-	0x22, 0x5b, 0x7d, 0x60, 0xc9, 0x17, 0x5c, 0x71, 0xb4, 0xaa, 0xed, 0x4b, 0x7d, 0x47, 0xc6, 0x7e,
-	0x25, 0x5f, 0xe7, 0x75, 0x1e, 0xd9, 0x6d, 0x86, 0xff, 0xf4, 0x95, 0x95, 0xa2, 0x09, 0x51, 0x23,
-	0x92, 0x26, 0xae, 0x1d, 0xce, 0x8c, 0x4b, 0xfc, 0xcb, 0x38, 0xcc, 0x1d, 0x10, 0x41, 0x5a, 0x12,
-	0x1d, 0xc3, 0x19, 0x87, 0xb7, 0x5a, 0x6d, 0x8f, 0xa9, 0xb3, 0xaa, 0x22, 0xa7, 0x05, 0xb0, 0x0e,
-	0x36, 0xae, 0x95, 0xf7, 0x1f, 0x07, 0x56, 0xe6, 0xb7, 0xc0, 0xba, 0x5d, 0x67, 0xaa, 0xd1, 0xae,
-	0x95, 0x1c, 0xde, 0xda, 0x34, 0x4e, 0xf5, 0xcf, 0x1d, 0xe9, 0x1e, 0x6f, 0xaa, 0x33, 0x9f, 0xca,
-	0xd2, 0x2e, 0x75, 0xba, 0x81, 0x95, 0x3f, 0x23, 0xad, 0xe6, 0x36, 0xee, 0x73, 0x86, 0xed, 0xe9,
-	0xe4, 0xf9, 0x90, 0x9c, 0xa2, 0x8f, 0x61, 0x3e, 0xa4, 0x54, 0xf5, 0x05, 0xf7, 0xb9, 0xa4, 0xa2,
-	0x2a, 0xe8, 0x09, 0x11, 0x6e, 0x61, 0x2c, 0x8a, 0xf9, 0xf6, 0xc8, 0x31, 0x57, 0x75, 0xcc, 0x61,
-	0x3e, 0xb1, 0x8d, 0x42, 0xf8, 0xc0, 0xa0, 0x76, 0x04, 0xa2, 0x4f, 0x00, 0x5c, 0xac, 0x71, 0xaf,
-	0x2d, 0x9f, 0xa2, 0x30, 0x1e, 0x51, 0x78, 0x67, 0x64, 0x0a, 0x6b, 0x86, 0xc2, 0x30, 0xa7, 0xd8,
-	0x5e, 0x88, 0xf0, 0x01, 0x12, 0x87, 0x70, 0xf1, 0x84, 0xa9, 0x86, 0x2b, 0xc8, 0x49, 0x95, 0xb8,
-	0xae, 0xa8, 0x52, 0x8f, 0xd4, 0x9a, 0xd4, 0x2d, 0x64, 0xd7, 0xc1, 0xc6, 0x54, 0x79, 0x3d, 0xf5,
-	0x3a, 0xd4, 0x0c, 0xdb, 0x0b, 0x31, 0xbe, 0xe3, 0xba, 0x62, 0x4f, 0xa3, 0xdb, 0xd9, 0xaf, 0xcf,
-	0xad, 0x0c, 0xfe, 0x7c, 0x0c, 0xae, 0xbc, 0x47, 0x9a, 0xcc, 0x25, 0x8a, 0x8b, 0x37, 0x98, 0x54,
-	0x5c, 0x30, 0x87, 0x34, 0x75, 0x64, 0x89, 0x7e, 0x02, 0x70, 0xd9, 0x69, 0xb7, 0xda, 0x4d, 0xa2,
-	0x58, 0x87, 0x1a, 0x9a, 0x55, 0x41, 0x14, 0xe3, 0x05, 0xb0, 0x3e, 0xbe, 0x71, 0xfd, 0xde, 0x9a,
-	0x91, 0x67, 0x29, 0xac, 0x5e, 0x2c, 0xb3, 0x30, 0xd7, 0x0a, 0x67, 0x5e, 0xf9, 0xdd, 0xb0, 0x3e,
-	0xdd, 0xc0, 0x2a, 0x9a, 0x66, 0x0f, 0x77, 0x85, 0x7f, 0xfc, 0xc3, 0x7a, 0xe5, 0x6a, 0x15, 0x0c,
-	0xbd, 0x4a, 0x7b, 0x31, 0x75, 0xa4, 0x99, 0xda, 0xa1, 0x1b, 0x54, 0x81, 0x73, 0x82, 0x1e, 0x51,
-	0x41, 0x3d, 0x87, 0x56, 0x1d, 0xde, 0xf6, 0x54, 0xa4, 0x94, 0x99, 0xf2, 0x4a, 0x37, 0xb0, 0x96,
-	0x34, 0x85, 0x01, 0x03, 0x6c, 0xcf, 0x26, 0x48, 0x25, 0x02, 0xbe, 0x03, 0x70, 0x39, 0xa9, 0x48,
-	0xa5, 0x2d, 0x04, 0xf5, 0x54, 0x5c, 0x8e, 0x63, 0x38, 0xa9, 0x79, 0xcb, 0x2b, 0x65, 0x7f, 0x3f,
-	0xcc, 0x7e, 0xd4, 0xdc, 0xe2, 0x08, 0x68, 0x09, 0xe6, 0x7c, 0x2a, 0x18, 0xd7, 0x72, 0xcf, 0xda,
-	0xe6, 0x09, 0x7f, 0x05, 0x60, 0x31, 0x21, 0xb8, 0xe3, 0x98, 0x52, 0x50, 0xb7, 0xc2, 0x5b, 0x2d,
-	0x26, 0x25, 0xe3, 0x1e, 0xfa, 0x10, 0x42, 0x27, 0x79, 0x7a, 0x71, 0x54, 0x7b, 0x82, 0xe0, 0x6f,
-	0x00, 0x5c, 0x4d, 0x58, 0x3d, 0x6c, 0x2b, 0xa9, 0x88, 0xe7, 0x32, 0xaf, 0x1e, 0x97, 0xee, 0xa3,
-	0xd1, 0x4a, 0xb7, 0x67, 0x84, 0x33, 0x1b, 0x77, 0x2d, 0xba, 0x8a, 0x9f, 0xb7, 0x98, 0xf8, 0x07,
-	0x00, 0x17, 0x12, 0x7a, 0x8f, 0x9a, 0x44, 0x36, 0xf6, 0x3a, 0xd4, 0x53, 0x68, 0x1f, 0xce, 0x77,
-	0x62, 0xb8, 0x6a, 0xca, 0x1d, 0x4e, 0xb4, 0x6c, 0x79, 0xb5, 0x1b, 0x58, 0xcb, 0x3a, 0xfa, 0xa0,
-	0x05, 0xb6, 0xe7, 0x12, 0xe8, 0x20, 0x42, 0xd0, 0x9b, 0x70, 0xea, 0x48, 0x10, 0x27, 0x9c, 0xb5,
-	0x66, 0x3a, 0x95, 0x46, 0x1b, 0x0d, 0x76, 0x72, 0x1f, 0xff, 0x0c, 0x60, 0x7e, 0x08, 0x57, 0x89,
-	0x3e, 0x03, 0x70, 0x29, 0xe5, 0x22, 0xc3, 0x93, 0x2a, 0x8d, 0x8e, 0x4c, 0x4d, 0xef, 0x96, 0x9e,
-	0x31, 0xfb, 0x4b, 0x43, 0x7c, 0x96, 0x6f, 0x99, 0x3a, 0xbf, 0x34, 0x98, 0x69, 0xaf, 0x77, 0x6c,
-	0xe7, 0x3b, 0x43, 0xf8, 0x98, 0x11, 0xf2, 0x2d, 0x80, 0x93, 0xfb, 0x94, 0x1e, 0x70, 0xde, 0x44,
-	0x5f, 0x02, 0x38, 0x9b, 0x4e, 0x74, 0x9f, 0xf3, 0xe6, 0x95, 0xba, 0xfd, 0x96, 0x61, 0xb1, 0x38,
-	0xb8, 0x13, 0x42, 0x0f, 0x23, 0x37, 0x3d, 0x5d, 0x50, 0x21, 0x27, 0xfc, 0x17, 0x80, 0x2b, 0x95,
-	0x5e, 0xe4, 0x91, 0x4f, 0x3d, 0x57, 0xcf, 0x58, 0xd2, 0x44, 0x79, 0x38, 0xa1, 0x98, 0x6a, 0x52,
-	0xbd, 0xc8, 0x6c, 0xfd, 0x80, 0xd6, 0xe1, 0x75, 0x97, 0x4a, 0x47, 0x30, 0x3f, 0x6d, 0xa9, 0xdd,
-	0x0b, 0xa1, 0x35, 0x78, 0x4d, 0x50, 0x87, 0xf9, 0x8c, 0x7a, 0x4a, 0x6f, 0x03, 0x3b, 0x05, 0x90,
-	0x03, 0x73, 0xa4, 0x15, 0x4d, 0xa0, 0x6c, 0x94, 0xff, 0xcd, 0xa1, 0xf9, 0x47, 0xc9, 0xdf, 0x35,
-	0xaf, 0xde, 0xc6, 0x15, 0x72, 0xd4, 0x09, 0x1a, 0xd7, 0xdb, 0xd3, 0x9f, 0x9e, 0x5b, 0x99, 0xb0,
-	0x07, 0x7f, 0x87, 0x7d, 0xf8, 0x17, 0xc0, 0xc5, 0x5d, 0xda, 0xa4, 0xf5, 0xa8, 0x4d, 0x8a, 0x08,
-	0xc5, 0xbc, 0xfa, 0x03, 0xef, 0x28, 0x9a, 0x8b, 0xbe, 0xa0, 0x1d, 0xc6, 0xc3, 0x95, 0xd3, 0xab,
-	0xf1, 0x9e, 0xb9, 0x38, 0x60, 0x80, 0xed, 0xd9, 0x18, 0x31, 0x0a, 0x3f, 0x84, 0x13, 0x52, 0x91,
-	0x63, 0x6a, 0xe4, 0xfd, 0xfa, 0xc8, 0x9b, 0x6f, 0x5a, 0x07, 0x8a, 0x9c, 0x60, 0x5b, 0x3b, 0x43,
-	0x7b, 0x30, 0xd7, 0xa0, 0xac, 0xde, 0xd0, 0x25, 0xcc, 0x96, 0xef, 0xfc, 0x13, 0x58, 0x73, 0x8e,
-	0xa0, 0xe1, 0x3c, 0xf7, 0xaa, 0xfa, 0x28, 0x25, 0x39, 0x70, 0x80, 0x6d, 0x73, 0x19, 0xff, 0x0e,
-	0xe0, 0x4d, 0x93, 0x3b, 0xe3, 0x5e, 0x52, 0x05, 0xb3, 0x40, 0x1f, 0xc0, 0x1b, 0xa9, 0xb0, 0xc3,
-	0xd5, 0x48, 0xa5, 0x34, 0xdf, 0x2d, 0x6b, 0xdd, 0xc0, 0x2a, 0x0c, 0x6a, 0xdf, 0x98, 0x60, 0x3b,
-	0x9d, 0x0d, 0x3b, 0x1a, 0x42, 0x0c, 0xe6, 0x92, 0x6f, 0x90, 0x17, 0x34, 0x55, 0x4d, 0x80, 0xed,
-	0x29, 0xd3, 0x5d, 0x80, 0xcf, 0xc7, 0xe0, 0xad, 0xff, 0x57, 0xf0, 0xfb, 0x4c, 0x35, 0x76, 0xa9,
-	0xcf, 0x25, 0x53, 0xe8, 0x76, 0x9f, 0x98, 0xcb, 0xf3, 0x69, 0xd9, 0x23, 0x18, 0xc7, 0xf2, 0x7e,
-	0x6d, 0x88, 0xbc, 0xcb, 0x4b, 0xdd, 0xc0, 0x42, 0xda, 0xba, 0xe7, 0x10, 0xf7, 0xcb, 0xfe, 0xde,
-	0x53, 0xb2, 0x2f, 0xe7, 0xbb, 0x81, 0x35, 0x1f, 0xcf, 0x69, 0x73, 0x84, 0x7b, 0x5f, 0x86, 0x97,
-	0x7b, 0x5e, 0x86, 0xf0, 0xc2, 0x8d, 0x6e, 0x60, 0xcd, 0xe8, 0x0b, 0x1a, 0xc7, 0xb1, 0xa4, 0xd1,
-	0xab, 0x70, 0xd2, 0xd5, 0xb9, 0x14, 0x26, 0x22, 0x5b, 0x94, 0x2e, 0x01, 0x73, 0x80, 0xed, 0xd8,
-	0x24, 0x2d, 0x51, 0xf9, 0xe1, 0xf7, 0x17, 0x45, 0xf0, 0xf8, 0xa2, 0x08, 0x9e, 0x5c, 0x14, 0xc1,
-	0x9f, 0x17, 0x45, 0xf0, 0xc5, 0x65, 0x31, 0xf3, 0xe4, 0xb2, 0x98, 0xf9, 0xf5, 0xb2, 0x98, 0xf9,
-	0x60, 0xeb, 0x99, 0xf5, 0x3f, 0xed, 0xff, 0xb4, 0x8e, 0xda, 0x51, 0xcb, 0x45, 0x5f, 0xbe, 0xf7,
-	0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x45, 0x1d, 0xb8, 0x42, 0x7e, 0x0b, 0x00, 0x00,
+	// 1264 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1c, 0x35,
+	0x14, 0x8e, 0x93, 0xcd, 0xb6, 0x75, 0xd2, 0xb4, 0x75, 0x36, 0xe9, 0x36, 0x09, 0x3b, 0xc1, 0x52,
+	0xab, 0x20, 0xe8, 0xa6, 0x3f, 0x2e, 0x28, 0x07, 0xa4, 0x4e, 0x9a, 0x40, 0x11, 0xb4, 0xd1, 0xb4,
+	0x80, 0xc4, 0x65, 0xe4, 0x9d, 0x71, 0x36, 0x56, 0x66, 0xc7, 0x83, 0xed, 0xd9, 0xb4, 0x48, 0x08,
+	0xd1, 0x13, 0x17, 0x04, 0x08, 0x09, 0xf5, 0x80, 0x50, 0x8f, 0xfc, 0xfa, 0x43, 0x7a, 0xec, 0x11,
+	0x81, 0xb4, 0x45, 0xa9, 0x90, 0x10, 0xc7, 0xbd, 0x71, 0x43, 0x33, 0xf6, 0xcc, 0xec, 0x6e, 0xb7,
+	0x65, 0x17, 0xb5, 0xa7, 0x64, 0x3e, 0xdb, 0xef, 0x7d, 0xef, 0xb3, 0xfd, 0x3d, 0x2f, 0xac, 0x7b,
+	0x5c, 0xb6, 0xb8, 0x5c, 0xf7, 0x99, 0x54, 0x82, 0x35, 0x62, 0xc5, 0x78, 0xb8, 0xde, 0xbe, 0xd8,
+	0xa0, 0x8a, 0x5c, 0xec, 0x03, 0xeb, 0x91, 0xe0, 0x8a, 0xa3, 0x65, 0x3d, 0xbf, 0xde, 0x37, 0x64,
+	0xe6, 0x2f, 0x55, 0x9a, 0xbc, 0xc9, 0xd3, 0x79, 0xeb, 0xc9, 0x7f, 0x7a, 0xc9, 0x52, 0xcd, 0xa4,
+	0x68, 0x10, 0x49, 0xf3, 0xd0, 0x1e, 0x67, 0x26, 0x24, 0xfe, 0x76, 0x1a, 0x96, 0x77, 0x88, 0x20,
+	0x2d, 0x89, 0xf6, 0xe1, 0x71, 0x8f, 0xb7, 0x5a, 0x71, 0xc8, 0xd4, 0x1d, 0x57, 0x91, 0xdb, 0x55,
+	0xb0, 0x0a, 0xd6, 0x8e, 0xd9, 0xdb, 0x0f, 0x3a, 0xd6, 0xc4, 0x6f, 0x1d, 0xeb, 0x5c, 0x93, 0xa9,
+	0xbd, 0xb8, 0x51, 0xf7, 0x78, 0x6b, 0xdd, 0x04, 0xd5, 0x7f, 0xce, 0x4b, 0x7f, 0x7f, 0x5d, 0xdd,
+	0x89, 0xa8, 0xac, 0x5f, 0xa5, 0x5e, 0xb7, 0x63, 0x55, 0xee, 0x90, 0x56, 0xb0, 0x81, 0xfb, 0x82,
+	0x61, 0x67, 0x36, 0xff, 0xbe, 0x45, 0x6e, 0xa3, 0x4f, 0x61, 0x25, 0xa1, 0xe4, 0x46, 0x82, 0x47,
+	0x5c, 0x52, 0xe1, 0x0a, 0x7a, 0x40, 0x84, 0x5f, 0x9d, 0x4c, 0x73, 0xbe, 0x3b, 0x76, 0xce, 0x65,
+	0x9d, 0x73, 0x58, 0x4c, 0xec, 0xa0, 0x04, 0xde, 0x31, 0xa8, 0x93, 0x82, 0xe8, 0x2e, 0x80, 0x0b,
+	0x0d, 0x1e, 0xc6, 0xf2, 0x09, 0x0a, 0x53, 0x29, 0x85, 0xeb, 0x63, 0x53, 0x58, 0x31, 0x14, 0x86,
+	0x05, 0xc5, 0xce, 0x7c, 0x8a, 0x0f, 0x90, 0xb8, 0x05, 0x17, 0x0e, 0x98, 0xda, 0xf3, 0x05, 0x39,
+	0x70, 0x89, 0xef, 0x0b, 0x97, 0x86, 0xa4, 0x11, 0x50, 0xbf, 0x5a, 0x5a, 0x05, 0x6b, 0x47, 0xed,
+	0xd5, 0x22, 0xea, 0xd0, 0x69, 0xd8, 0x99, 0xcf, 0xf0, 0x2b, 0xbe, 0x2f, 0xb6, 0x34, 0x8a, 0x5a,
+	0x70, 0x6e, 0x97, 0x52, 0xb7, 0x11, 0x8b, 0xd0, 0x15, 0x44, 0x31, 0x5e, 0x9d, 0x4e, 0x4b, 0x7a,
+	0x73, 0xec, 0x92, 0x16, 0x74, 0xf2, 0xfe, 0x68, 0xd8, 0x99, 0xdd, 0xa5, 0xd4, 0x8e, 0x45, 0xe8,
+	0x24, 0x9f, 0xe8, 0x3a, 0x9c, 0xf7, 0x63, 0xa9, 0x5c, 0x79, 0x40, 0x69, 0xe4, 0xb2, 0x50, 0x51,
+	0xd1, 0x26, 0x41, 0xb5, 0xbc, 0x0a, 0xd6, 0x4a, 0x76, 0xad, 0xdb, 0xb1, 0x96, 0x74, 0x94, 0x21,
+	0x93, 0xb0, 0x73, 0x2a, 0x41, 0x6f, 0x26, 0xe0, 0x35, 0x83, 0x6d, 0x94, 0xee, 0xdd, 0xb7, 0x26,
+	0xf0, 0x97, 0x93, 0x70, 0xe9, 0x7d, 0x12, 0x30, 0x9f, 0x28, 0x2e, 0xde, 0x62, 0x52, 0x71, 0xc1,
+	0x3c, 0x12, 0x68, 0xe1, 0x24, 0xfa, 0x19, 0xc0, 0xd3, 0x5e, 0xdc, 0x8a, 0x03, 0xa2, 0x58, 0x9b,
+	0x1a, 0x95, 0x4d, 0xb5, 0x60, 0x75, 0x6a, 0x6d, 0xe6, 0xd2, 0x8a, 0xb9, 0x5d, 0xf5, 0x64, 0xf3,
+	0xb3, 0x5b, 0x92, 0xd4, 0xb5, 0xc9, 0x59, 0x68, 0xbf, 0x97, 0x68, 0xd1, 0xed, 0x58, 0x35, 0x73,
+	0x56, 0x87, 0x87, 0xc2, 0x3f, 0x3d, 0xb2, 0x5e, 0x1d, 0x4d, 0xad, 0x24, 0xaa, 0x74, 0x16, 0x8a,
+	0x40, 0x9a, 0xa9, 0x96, 0x68, 0x13, 0x9e, 0x10, 0x74, 0x97, 0x0a, 0x1a, 0x7a, 0xd4, 0xf5, 0x78,
+	0x1c, 0xaa, 0xf4, 0xa0, 0x1f, 0xb7, 0x97, 0xba, 0x1d, 0x6b, 0x51, 0x53, 0x18, 0x98, 0x80, 0x9d,
+	0xb9, 0x1c, 0xd9, 0x4c, 0x81, 0xef, 0x01, 0x3c, 0x9d, 0x2b, 0xb2, 0x19, 0x0b, 0x41, 0x43, 0x95,
+	0xc9, 0xb1, 0x0f, 0x8f, 0x68, 0xde, 0x72, 0xa4, 0xea, 0x2f, 0x27, 0xd5, 0x8f, 0x5b, 0x5b, 0x96,
+	0x01, 0x2d, 0xc2, 0x72, 0x44, 0x05, 0xe3, 0xfa, 0xb6, 0x96, 0x1c, 0xf3, 0x85, 0xbf, 0x01, 0xb0,
+	0x96, 0x13, 0xbc, 0xe2, 0x19, 0x29, 0xa8, 0xbf, 0xc9, 0x5b, 0x2d, 0x26, 0x25, 0xe3, 0x21, 0xfa,
+	0x08, 0x42, 0x2f, 0xff, 0x7a, 0x71, 0x54, 0x7b, 0x92, 0xe0, 0xef, 0x00, 0x5c, 0xce, 0x59, 0xdd,
+	0x88, 0x95, 0x54, 0x24, 0xf4, 0x59, 0xd8, 0xcc, 0xa4, 0xfb, 0x64, 0x3c, 0xe9, 0xb6, 0xcc, 0xc1,
+	0x99, 0xcb, 0x76, 0x2d, 0x5d, 0x8a, 0xff, 0xaf, 0x98, 0xf8, 0x47, 0x00, 0xe7, 0x73, 0x7a, 0x37,
+	0x03, 0x22, 0xf7, 0xb6, 0xda, 0x34, 0x54, 0x68, 0x1b, 0x9e, 0x6c, 0x67, 0xb0, 0x6b, 0xe4, 0x06,
+	0xe9, 0x95, 0x5a, 0xee, 0x76, 0xac, 0xd3, 0x3a, 0xfb, 0xe0, 0x0c, 0xec, 0x9c, 0xc8, 0xa1, 0x9d,
+	0x14, 0x41, 0x6f, 0xc3, 0xa3, 0xbb, 0x82, 0x78, 0x49, 0xab, 0x30, 0xe6, 0x5a, 0x1f, 0xcf, 0x06,
+	0x9c, 0x7c, 0x3d, 0xfe, 0x05, 0xc0, 0xca, 0x10, 0xae, 0x12, 0x7d, 0x01, 0xe0, 0x62, 0xc1, 0x45,
+	0x26, 0x23, 0x2e, 0x4d, 0x87, 0x8c, 0xa6, 0x17, 0xea, 0xcf, 0x68, 0x5d, 0xf5, 0x21, 0x31, 0xed,
+	0xb3, 0x46, 0xe7, 0x97, 0x06, 0x2b, 0xed, 0x8d, 0x8e, 0x9d, 0x4a, 0x7b, 0x08, 0x1f, 0x63, 0x21,
+	0xf7, 0xa6, 0xe0, 0x91, 0x6d, 0x4a, 0x77, 0x38, 0x0f, 0xd0, 0xd7, 0x00, 0xce, 0x15, 0x0d, 0x29,
+	0xe2, 0x3c, 0x18, 0x69, 0xb7, 0xdf, 0x31, 0x2c, 0x16, 0x06, 0x5b, 0x5a, 0x12, 0x61, 0xec, 0x4d,
+	0x2f, 0xfa, 0x6b, 0xca, 0xe9, 0x2e, 0x80, 0x33, 0x89, 0xad, 0x52, 0xdf, 0xdd, 0xa5, 0x54, 0x56,
+	0x27, 0x53, 0x42, 0x67, 0x86, 0x12, 0x4a, 0xd9, 0x6c, 0x1b, 0x36, 0xc8, 0x74, 0x9a, 0x62, 0x6d,
+	0x42, 0x65, 0x6d, 0x04, 0x2a, 0xe6, 0x7a, 0xe8, 0x95, 0xdb, 0x94, 0x4a, 0xa4, 0x60, 0x29, 0xb1,
+	0xe0, 0xea, 0xd4, 0x08, 0x6a, 0xd8, 0x26, 0xff, 0x4c, 0x61, 0xe8, 0x63, 0x6b, 0x90, 0x66, 0xc3,
+	0x7f, 0x02, 0xb8, 0xb4, 0xd9, 0x2b, 0xc6, 0xcd, 0x88, 0x86, 0xbe, 0xee, 0x8e, 0x24, 0x40, 0x15,
+	0x38, 0xad, 0x98, 0x0a, 0xa8, 0x7e, 0x82, 0x38, 0xfa, 0x03, 0xad, 0xc2, 0x19, 0x9f, 0x4a, 0x4f,
+	0xb0, 0xa8, 0x38, 0xcd, 0x4e, 0x2f, 0x84, 0x56, 0xe0, 0x31, 0x41, 0x3d, 0x16, 0x31, 0x1a, 0x2a,
+	0xdd, 0xc7, 0x9d, 0x02, 0x40, 0x1e, 0x2c, 0x93, 0x56, 0x6a, 0xbe, 0xa5, 0xff, 0x52, 0xfa, 0x82,
+	0x71, 0x9d, 0xd1, 0x35, 0x35, 0xa1, 0x37, 0x66, 0x3f, 0xbf, 0x6f, 0x4d, 0x24, 0xc7, 0xef, 0xaf,
+	0xe4, 0x08, 0xfe, 0x03, 0xe0, 0xc2, 0x55, 0x1a, 0xd0, 0x66, 0x7a, 0x42, 0x15, 0x11, 0x8a, 0x85,
+	0xcd, 0x6b, 0xe1, 0x6e, 0xda, 0x12, 0x22, 0x41, 0xdb, 0x8c, 0x27, 0x8f, 0x85, 0xde, 0xeb, 0xdd,
+	0xd3, 0x12, 0x06, 0x26, 0x60, 0x67, 0x2e, 0x43, 0xcc, 0xe5, 0xbe, 0x05, 0xa7, 0xa5, 0x22, 0xfb,
+	0xd4, 0xdc, 0xec, 0x37, 0xc6, 0x6e, 0xf0, 0xb3, 0x3a, 0x51, 0x1a, 0x04, 0x3b, 0x3a, 0x18, 0xda,
+	0x82, 0xe5, 0x3d, 0xca, 0x9a, 0x7b, 0x5a, 0xc2, 0x92, 0x7d, 0xfe, 0xef, 0x8e, 0x75, 0xc2, 0x13,
+	0x34, 0x69, 0x65, 0xa1, 0xab, 0x87, 0x0a, 0x92, 0x03, 0x03, 0xd8, 0x31, 0x8b, 0xf1, 0x67, 0x00,
+	0x56, 0xf3, 0xda, 0x1d, 0x4a, 0x02, 0xf6, 0x31, 0xf5, 0x33, 0xd7, 0xa5, 0x83, 0xae, 0xfb, 0x5c,
+	0x37, 0x23, 0x77, 0xd7, 0x47, 0x00, 0xbe, 0x6c, 0x38, 0x30, 0x1e, 0x3e, 0x85, 0x0d, 0xba, 0x06,
+	0x4f, 0x15, 0xfe, 0x92, 0x3c, 0xb0, 0xa8, 0x94, 0xe6, 0xf5, 0xbb, 0xd2, 0xed, 0x58, 0xd5, 0x41,
+	0x0b, 0x32, 0x53, 0xb0, 0x53, 0x58, 0xf4, 0x15, 0x0d, 0xf5, 0xd6, 0x35, 0xf9, 0xe2, 0xea, 0xda,
+	0x38, 0x6a, 0x4e, 0x19, 0xc0, 0xbf, 0x03, 0x78, 0x66, 0x68, 0x85, 0xcf, 0xbb, 0x32, 0x06, 0xcb,
+	0xf9, 0x1b, 0xfd, 0x05, 0xb5, 0x6d, 0x93, 0xa0, 0xa7, 0xba, 0xfb, 0x93, 0xf0, 0xec, 0xd3, 0x7d,
+	0xe2, 0x03, 0xa6, 0xf6, 0xae, 0xd2, 0x88, 0x4b, 0xa6, 0xd0, 0xb9, 0x3e, 0xcb, 0xb0, 0x4f, 0x16,
+	0x87, 0x3b, 0x85, 0x71, 0x66, 0x22, 0xaf, 0x0f, 0x31, 0x11, 0x7b, 0xb1, 0x30, 0xd5, 0x9e, 0x41,
+	0xdc, 0x6f, 0x2e, 0x97, 0x9e, 0x30, 0x17, 0xbb, 0xd2, 0xed, 0x58, 0x27, 0xb3, 0x87, 0x80, 0x19,
+	0xc2, 0xbd, 0x96, 0xf3, 0x4a, 0x8f, 0xe5, 0x24, 0x0b, 0x4e, 0x75, 0x3b, 0xd6, 0x71, 0xbd, 0x40,
+	0xe3, 0x38, 0x33, 0x0e, 0xf4, 0x1a, 0x3c, 0xe2, 0xeb, 0x5a, 0xcc, 0x73, 0x1d, 0x15, 0xaf, 0x0c,
+	0x33, 0x80, 0x9d, 0x6c, 0x4a, 0x21, 0x91, 0x7d, 0xe3, 0x87, 0xc3, 0x1a, 0x78, 0x70, 0x58, 0x03,
+	0x0f, 0x0f, 0x6b, 0xe0, 0x8f, 0xc3, 0x1a, 0xf8, 0xea, 0x71, 0x6d, 0xe2, 0xe1, 0xe3, 0xda, 0xc4,
+	0xaf, 0x8f, 0x6b, 0x13, 0x1f, 0x5e, 0x7c, 0xa6, 0xfe, 0xb7, 0xfb, 0x7f, 0x7a, 0xa6, 0xdb, 0xd1,
+	0x28, 0xa7, 0xbf, 0x0c, 0x2f, 0xff, 0x3b, 0x00, 0xf3, 0xf1, 0x54, 0x37, 0x9e, 0x0e, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -728,6 +859,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if !this.FeeBurnRatio.Equal(that1.FeeBurnRatio) {
+		return false
+	}
+	if this.DustSweepInterval != that1.DustSweepInterval {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -935,6 +1072,22 @@ func (this *FeePool) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.BurnedFees) != len(that1.BurnedFees) {
+		return false
+	}
+	for i := range this.BurnedFees {
+		if !this.BurnedFees[i].Equal(&that1.BurnedFees[i]) {
+			return false
+		}
+	}
+	if len(this.Dust) != len(that1.Dust) {
+		return false
+	}
+	for i := range this.Dust {
+		if !this.Dust[i].Equal(&that1.Dust[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *DelegatorStartingInfo) Equal(that interface{}) bool {
@@ -967,6 +1120,67 @@ func (this *DelegatorStartingInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DelegatorRealizedRewards) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegatorRealizedRewards)
+	if !ok {
+		that2, ok := that.(DelegatorRealizedRewards)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Rewards) != len(that1.Rewards) {
+		return false
+	}
+	for i := range this.Rewards {
+		if !this.Rewards[i].Equal(&that1.Rewards[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DelegationDelegatorRealizedReward) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegationDelegatorRealizedReward)
+	if !ok {
+		that2, ok := that.(DelegationDelegatorRealizedReward)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if len(this.Rewards) != len(that1.Rewards) {
+		return false
+	}
+	for i := range this.Rewards {
+		if !this.Rewards[i].Equal(&that1.Rewards[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *DelegationDelegatorReward) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1055,6 +1269,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DustSweepInterval != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.DustSweepInterval))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.FeeBurnRatio.Size()
+		i -= size
+		if _, err := m.FeeBurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1351,6 +1580,34 @@ func (m *FeePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Dust) > 0 {
+		for iNdEx := len(m.Dust) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dust[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BurnedFees) > 0 {
+		for iNdEx := len(m.BurnedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.CommunityPool) > 0 {
 		for iNdEx := len(m.CommunityPool) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1469,6 +1726,87 @@ func (m *DelegatorStartingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegatorRealizedRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorRealizedRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorRealizedRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationDelegatorRealizedReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationDelegatorRealizedReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationDelegatorRealizedReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DelegationDelegatorReward) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1597,6 +1935,11 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	l = m.FeeBurnRatio.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.DustSweepInterval != 0 {
+		n += 1 + sovDistribution(uint64(m.DustSweepInterval))
+	}
 	return n
 }
 
@@ -1707,6 +2050,18 @@ func (m *FeePool) Size() (n int) {
 			n += 1 + l + sovDistribution(uint64(l))
 		}
 	}
+	if len(m.BurnedFees) > 0 {
+		for _, e := range m.BurnedFees {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.Dust) > 0 {
+		for _, e := range m.Dust {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1754,16 +2109,50 @@ func (m *DelegatorStartingInfo) Size() (n int) {
 	return n
 }
 
-func (m *DelegationDelegatorReward) Size() (n int) {
+func (m *DelegatorRealizedRewards) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
-	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegationDelegatorRealizedReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegationDelegatorReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
 	if len(m.Reward) > 0 {
 		for _, e := range m.Reward {
 			l = e.Size()
@@ -1959,6 +2348,59 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeBurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustSweepInterval", wireType)
+			}
+			m.DustSweepInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DustSweepInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2604,6 +3046,74 @@ func (m *FeePool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnedFees = append(m.BurnedFees, types.Coin{})
+			if err := m.BurnedFees[len(m.BurnedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dust = append(m.Dust, types.DecCoin{})
+			if err := m.Dust[len(m.Dust)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2927,6 +3437,206 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DelegatorRealizedRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorRealizedRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorRealizedRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationDelegatorRealizedReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationDelegatorRealizedReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationDelegatorRealizedReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
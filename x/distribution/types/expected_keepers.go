@@ -15,6 +15,8 @@ type AccountKeeper interface {
 
 	// TODO remove with genesis 2-phases refactor https://github.com/cosmos/cosmos-sdk/issues/2862
 	SetModuleAccount(sdk.Context, types.ModuleAccountI)
+
+	AddressCodec() sdk.AddressCodec
 }
 
 // BankKeeper defines the expected interface needed to retrieve account balances.
@@ -27,6 +29,7 @@ type BankKeeper interface {
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule string, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	BurnCoinsFromAccount(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error
 }
 
 // StakingKeeper expected staking keeper (noalias)
@@ -10,6 +10,8 @@ import (
 func InitialFeePool() FeePool {
 	return FeePool{
 		CommunityPool: sdk.DecCoins{},
+		BurnedFees:    sdk.Coins{},
+		Dust:          sdk.DecCoins{},
 	}
 }
 
@@ -20,5 +22,13 @@ func (f FeePool) ValidateGenesis() error {
 			f.CommunityPool)
 	}
 
+	if err := f.BurnedFees.Validate(); err != nil {
+		return fmt.Errorf("invalid BurnedFees in distribution fee pool: %w", err)
+	}
+
+	if f.Dust.IsAnyNegative() {
+		return fmt.Errorf("negative Dust in distribution fee pool, is %v", f.Dust)
+	}
+
 	return nil
 }
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -9,6 +11,7 @@ func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
+	realized []DelegatorRealizedRewardsRecord,
 ) *GenesisState {
 
 	return &GenesisState{
@@ -22,6 +25,7 @@ func NewGenesisState(
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		DelegatorRealizedRewards:        realized,
 	}
 }
 
@@ -38,6 +42,7 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		DelegatorRealizedRewards:        []DelegatorRealizedRewardsRecord{},
 	}
 }
 
@@ -46,5 +51,11 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	for _, record := range gs.DelegatorRealizedRewards {
+		if err := record.RealizedRewards.Rewards.Validate(); err != nil {
+			return fmt.Errorf("invalid realized rewards of delegator %s from validator %s: %w",
+				record.DelegatorAddress, record.ValidatorAddress, err)
+		}
+	}
 	return gs.FeePool.ValidateGenesis()
 }
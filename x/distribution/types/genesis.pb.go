@@ -324,6 +324,49 @@ func (m *ValidatorSlashEventRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ValidatorSlashEventRecord proto.InternalMessageInfo
 
+// DelegatorRealizedRewardsRecord is used for import / export via genesis json.
+type DelegatorRealizedRewardsRecord struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// realized_rewards defines the rewards withdrawn by the delegator from the validator.
+	RealizedRewards DelegatorRealizedRewards `protobuf:"bytes,3,opt,name=realized_rewards,json=realizedRewards,proto3" json:"realized_rewards" yaml:"realized_rewards"`
+}
+
+func (m *DelegatorRealizedRewardsRecord) Reset()         { *m = DelegatorRealizedRewardsRecord{} }
+func (m *DelegatorRealizedRewardsRecord) String() string { return proto.CompactTextString(m) }
+func (*DelegatorRealizedRewardsRecord) ProtoMessage()    {}
+func (*DelegatorRealizedRewardsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{7}
+}
+func (m *DelegatorRealizedRewardsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorRealizedRewardsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorRealizedRewardsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorRealizedRewardsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorRealizedRewardsRecord.Merge(m, src)
+}
+func (m *DelegatorRealizedRewardsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorRealizedRewardsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorRealizedRewardsRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorRealizedRewardsRecord proto.InternalMessageInfo
+
 // GenesisState defines the distribution module's genesis state.
 type GenesisState struct {
 	// params defines all the paramaters of the module.
@@ -346,13 +389,15 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos" yaml:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events" yaml:"validator_slash_events"`
+	// delegator_realized_rewards defines the realized rewards of all delegators at genesis.
+	DelegatorRealizedRewards []DelegatorRealizedRewardsRecord `protobuf:"bytes,11,rep,name=delegator_realized_rewards,json=delegatorRealizedRewards,proto3" json:"delegator_realized_rewards" yaml:"delegator_realized_rewards"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{7}
+	return fileDescriptor_76eed0f9489db580, []int{8}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -389,6 +434,7 @@ func init() {
 	proto.RegisterType((*ValidatorCurrentRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorCurrentRewardsRecord")
 	proto.RegisterType((*DelegatorStartingInfoRecord)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfoRecord")
 	proto.RegisterType((*ValidatorSlashEventRecord)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEventRecord")
+	proto.RegisterType((*DelegatorRealizedRewardsRecord)(nil), "cosmos.distribution.v1beta1.DelegatorRealizedRewardsRecord")
 	proto.RegisterType((*GenesisState)(nil), "cosmos.distribution.v1beta1.GenesisState")
 }
 
@@ -397,71 +443,77 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 1024 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x1c, 0xf5, 0x3a, 0x25, 0x49, 0x27, 0x29, 0x0d, 0xdb, 0x7c, 0xb8, 0x4e, 0xea, 0x4d, 0xa7, 0x45,
-	0x04, 0x55, 0xac, 0x9b, 0x80, 0x00, 0x05, 0x81, 0x94, 0x4d, 0x29, 0xf4, 0xd4, 0x30, 0x91, 0x00,
-	0x71, 0xb1, 0xd6, 0xbb, 0x63, 0x7b, 0x84, 0xbd, 0x63, 0xcd, 0x8c, 0x1d, 0xc2, 0x3f, 0x00, 0x47,
-	0x24, 0xc4, 0xa9, 0x1c, 0x72, 0x44, 0x88, 0x63, 0xef, 0x5c, 0x7b, 0xec, 0x91, 0x03, 0x0a, 0x28,
-	0xb9, 0x70, 0xce, 0x81, 0x03, 0x27, 0xb4, 0x33, 0xb3, 0x5f, 0xf6, 0xda, 0x38, 0xa1, 0x39, 0x25,
-	0x1e, 0xff, 0xf6, 0xbd, 0xf7, 0x7b, 0xf3, 0xfb, 0x58, 0x83, 0xd7, 0x3d, 0xca, 0x3b, 0x94, 0x57,
-	0x7d, 0xc2, 0x05, 0x23, 0xf5, 0x9e, 0x20, 0x34, 0xa8, 0xf6, 0x37, 0xeb, 0x58, 0xb8, 0x9b, 0xd5,
-	0x26, 0x0e, 0x30, 0x27, 0xdc, 0xee, 0x32, 0x2a, 0xa8, 0xb9, 0xaa, 0x42, 0xed, 0x74, 0xa8, 0xad,
-	0x43, 0xcb, 0x8b, 0x4d, 0xda, 0xa4, 0x32, 0xae, 0x1a, 0xfe, 0xa7, 0x1e, 0x29, 0x57, 0x34, 0x7a,
-	0xdd, 0xe5, 0x38, 0x46, 0xf5, 0x28, 0x09, 0xf4, 0xf7, 0xf6, 0x38, 0xf6, 0x0c, 0x8f, 0x8c, 0x87,
-	0x4f, 0x0d, 0xb0, 0xf4, 0x00, 0xb7, 0x71, 0xd3, 0x15, 0x94, 0x7d, 0x46, 0x44, 0xcb, 0x67, 0xee,
-	0xc1, 0xa3, 0xa0, 0x41, 0xcd, 0x47, 0xe0, 0x15, 0x3f, 0xfa, 0xa2, 0xe6, 0xfa, 0x3e, 0xc3, 0x9c,
-	0x97, 0x8c, 0x75, 0x63, 0xe3, 0xaa, 0xb3, 0x76, 0x76, 0x6c, 0x95, 0x0e, 0xdd, 0x4e, 0x7b, 0x1b,
-	0x0e, 0x85, 0x40, 0xb4, 0x10, 0x9f, 0xed, 0xa8, 0x23, 0xf3, 0x21, 0x58, 0x38, 0xd0, 0xd0, 0x31,
-	0x52, 0x51, 0x22, 0xad, 0x9e, 0x1d, 0x5b, 0x2b, 0x0a, 0x69, 0x30, 0x02, 0xa2, 0xeb, 0xd1, 0x91,
-	0xc6, 0xd9, 0x9e, 0xfd, 0xf6, 0xc8, 0x2a, 0xfc, 0x75, 0x64, 0x15, 0xe0, 0x93, 0x22, 0xb8, 0xfd,
-	0xa9, 0xdb, 0x26, 0x7e, 0x48, 0xf3, 0xb8, 0x27, 0xb8, 0x70, 0x03, 0x9f, 0x04, 0x4d, 0x84, 0x0f,
-	0x5c, 0xe6, 0x73, 0x84, 0x3d, 0xca, 0xfc, 0x30, 0x85, 0x7e, 0x14, 0x34, 0x3a, 0x85, 0xa1, 0x10,
-	0x88, 0x16, 0xe2, 0xb3, 0x28, 0x85, 0x23, 0x03, 0xdc, 0xa0, 0x09, 0x4f, 0x8d, 0x29, 0xa2, 0x52,
-	0x71, 0x7d, 0x6a, 0x63, 0x6e, 0x6b, 0x4d, 0xdb, 0x6e, 0x87, 0xd7, 0x12, 0xdd, 0xa0, 0xfd, 0x00,
-	0x7b, 0xbb, 0x94, 0x04, 0xce, 0x27, 0xcf, 0x8e, 0xad, 0xc2, 0xd9, 0xb1, 0x55, 0x56, 0x7c, 0x39,
-	0x30, 0xf0, 0xe7, 0x3f, 0xac, 0x7b, 0x4d, 0x22, 0x5a, 0xbd, 0xba, 0xed, 0xd1, 0x4e, 0x55, 0x5f,
-	0xa2, 0xfa, 0xf3, 0x06, 0xf7, 0xbf, 0xac, 0x8a, 0xc3, 0x2e, 0xe6, 0x11, 0x22, 0x47, 0x26, 0x1d,
-	0xca, 0x39, 0xe5, 0xce, 0xdf, 0x06, 0xb8, 0x1b, 0xbb, 0xb3, 0xe3, 0x79, 0xbd, 0x4e, 0xaf, 0xed,
-	0x0a, 0xec, 0xef, 0xd2, 0x4e, 0x87, 0x70, 0x4e, 0x68, 0xf0, 0xe2, 0x0d, 0x3a, 0x04, 0x73, 0x6e,
-	0xc2, 0x24, 0xaf, 0x77, 0x6e, 0xeb, 0x3d, 0x7b, 0x4c, 0x85, 0xdb, 0xe3, 0x25, 0x3a, 0x65, 0x6d,
-	0x9b, 0xa9, 0x54, 0xa4, 0xd0, 0x21, 0x4a, 0x73, 0xa5, 0x12, 0xff, 0xc7, 0x00, 0xeb, 0x31, 0xea,
-	0xc7, 0x84, 0x0b, 0xca, 0x88, 0xe7, 0xb6, 0x2f, 0xad, 0x2a, 0x96, 0xc1, 0x74, 0x17, 0x33, 0x42,
-	0x55, 0xbe, 0x57, 0x90, 0xfe, 0x64, 0x12, 0x30, 0x13, 0x15, 0xc8, 0x94, 0x34, 0xe2, 0x9d, 0xc9,
-	0x8c, 0x18, 0x92, 0xec, 0x2c, 0x6b, 0x13, 0x5e, 0x56, 0xaa, 0xa2, 0x7a, 0x41, 0x11, 0x7e, 0x2a,
-	0xf9, 0xdf, 0x0d, 0x70, 0x2b, 0x46, 0xda, 0xed, 0x31, 0x86, 0x03, 0x71, 0x69, 0x99, 0x37, 0x92,
-	0x0c, 0xd5, 0x55, 0xbf, 0x35, 0x59, 0x86, 0x59, 0x5d, 0xe7, 0x49, 0xef, 0x69, 0x11, 0xac, 0xc6,
-	0x93, 0x6a, 0x5f, 0xb8, 0x4c, 0x90, 0xa0, 0x19, 0x4e, 0xaa, 0x24, 0xb9, 0x17, 0x35, 0xaf, 0x72,
-	0x7d, 0x2a, 0x5e, 0xc8, 0xa7, 0x1e, 0xb8, 0xc6, 0xb5, 0xd6, 0x1a, 0x09, 0x1a, 0x54, 0xd7, 0xc3,
-	0xd6, 0x58, 0xb7, 0x72, 0xd3, 0x74, 0xd6, 0xb4, 0x57, 0x8b, 0x8a, 0x3e, 0x03, 0x0b, 0xd1, 0x3c,
-	0x4f, 0xc5, 0xa6, 0x6c, 0xfb, 0xb1, 0x08, 0x6e, 0xc6, 0xee, 0xef, 0xb7, 0x5d, 0xde, 0xfa, 0xb0,
-	0x2f, 0x2f, 0xe0, 0x12, 0x7a, 0xa1, 0x85, 0x49, 0xb3, 0x25, 0xa2, 0x5e, 0x50, 0x9f, 0x52, 0x3d,
-	0x32, 0x95, 0xe9, 0x91, 0xaf, 0xc1, 0x52, 0x82, 0xcb, 0x43, 0x61, 0x35, 0x1c, 0x2a, 0x2b, 0x5d,
-	0x91, 0x0e, 0xdd, 0x9f, 0xac, 0x9e, 0x92, 0x8c, 0x9c, 0x45, 0xed, 0xcf, 0xbc, 0x12, 0x2d, 0xc1,
-	0x20, 0xba, 0xd1, 0x1f, 0x0e, 0x4d, 0xd9, 0xf3, 0xcd, 0x1c, 0x98, 0xff, 0x48, 0x2d, 0xe5, 0x7d,
-	0xe1, 0x0a, 0x6c, 0x22, 0x30, 0xdd, 0x75, 0x99, 0xdb, 0x51, 0x36, 0xcc, 0x6d, 0xdd, 0x19, 0xab,
-	0x63, 0x4f, 0x86, 0x3a, 0x4b, 0x9a, 0xfa, 0x9a, 0xa2, 0x56, 0x00, 0x10, 0x69, 0x24, 0xf3, 0x73,
-	0x30, 0xdb, 0xc0, 0xb8, 0xd6, 0xa5, 0xb4, 0xad, 0xbb, 0xe5, 0xee, 0x58, 0xd4, 0x87, 0x18, 0xef,
-	0x51, 0xda, 0x76, 0x56, 0x34, 0xec, 0x75, 0x05, 0x1b, 0x61, 0x40, 0x34, 0xd3, 0x50, 0x11, 0xe6,
-	0x0f, 0x06, 0x28, 0x25, 0x25, 0x1d, 0xaf, 0xd0, 0xb0, 0x24, 0xc2, 0xd1, 0x33, 0x35, 0x79, 0xa9,
-	0xa5, 0x77, 0xbf, 0xf3, 0x9a, 0x26, 0xb6, 0x06, 0x9b, 0x26, 0xcb, 0x00, 0xd1, 0xb2, 0x9f, 0xf7,
-	0xbc, 0xec, 0xa0, 0x2e, 0xc3, 0x7d, 0x42, 0x7b, 0xbc, 0xd6, 0x65, 0xb4, 0x4b, 0x39, 0x66, 0xf2,
-	0x62, 0x33, 0x75, 0x35, 0x14, 0x02, 0xd1, 0x42, 0x74, 0xb6, 0xa7, 0x8f, 0xcc, 0xef, 0x47, 0x6c,
-	0xde, 0x97, 0x64, 0x76, 0x1f, 0x4c, 0x56, 0x26, 0xa3, 0x5e, 0x11, 0x1c, 0xf8, 0xdf, 0xbb, 0x39,
-	0x6f, 0xd9, 0x9a, 0xbf, 0x1a, 0xe0, 0x76, 0xaa, 0x2d, 0x92, 0x6d, 0x54, 0xf3, 0xe2, 0x0d, 0xc6,
-	0x4b, 0xd3, 0x52, 0xe3, 0xce, 0xff, 0xd8, 0x82, 0x5a, 0xe6, 0x7d, 0x2d, 0x73, 0x63, 0xa8, 0x21,
-	0xf3, 0x99, 0x21, 0xb2, 0xfa, 0x63, 0x71, 0xb9, 0xf9, 0x8b, 0x01, 0xd6, 0x12, 0x9c, 0x56, 0xbc,
-	0x79, 0x62, 0x83, 0x67, 0xa4, 0xf8, 0xf7, 0x2f, 0xb8, 0xb9, 0xb4, 0xf0, 0x7b, 0x5a, 0xf8, 0x9d,
-	0x41, 0xe1, 0xc3, 0x84, 0x10, 0x95, 0xfb, 0x23, 0xe1, 0xc2, 0x17, 0xb0, 0x9b, 0xc9, 0xd3, 0x9e,
-	0x5a, 0x23, 0xb1, 0xd6, 0x59, 0xa9, 0x75, 0xfb, 0x22, 0x3b, 0x48, 0x0b, 0xdd, 0xd0, 0x42, 0xd7,
-	0x07, 0x85, 0x0e, 0x50, 0x41, 0xb4, 0xd2, 0xcf, 0x07, 0x32, 0x9f, 0x64, 0x9a, 0x31, 0x33, 0x9f,
-	0x79, 0xe9, 0xaa, 0x54, 0xf8, 0xee, 0xf9, 0xe7, 0xbe, 0xd6, 0x37, 0xb2, 0x25, 0xb3, 0x3c, 0xe9,
-	0x96, 0x4c, 0xa3, 0xf0, 0xb0, 0x8f, 0x96, 0x73, 0x07, 0x2e, 0x2f, 0x01, 0xa9, 0xed, 0xed, 0xf3,
-	0x4e, 0x5c, 0xad, 0xec, 0x55, 0xad, 0xec, 0xd6, 0xa0, 0x73, 0x69, 0x0e, 0x88, 0x16, 0x73, 0x06,
-	0x71, 0x6a, 0xbf, 0x3b, 0x8f, 0x7f, 0x3a, 0xa9, 0x18, 0xcf, 0x4e, 0x2a, 0xc6, 0xf3, 0x93, 0x8a,
-	0xf1, 0xe7, 0x49, 0xc5, 0xf8, 0xee, 0xb4, 0x52, 0x78, 0x7e, 0x5a, 0x29, 0xfc, 0x76, 0x5a, 0x29,
-	0x7c, 0xb1, 0x39, 0xf6, 0xed, 0xf8, 0xab, 0xec, 0xef, 0x1d, 0xf9, 0xb2, 0x5c, 0x9f, 0x96, 0xbf,
-	0x70, 0xde, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0xa8, 0xee, 0xfe, 0x4a, 0x91, 0x0d, 0x00, 0x00,
+	// 1108 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x3d, 0x6c, 0x23, 0x45,
+	0x14, 0xf6, 0xda, 0x21, 0xc9, 0x8d, 0x73, 0xc4, 0xec, 0xe5, 0x67, 0xcf, 0xc9, 0x79, 0x9d, 0xb9,
+	0x43, 0xe4, 0x74, 0xc2, 0xbe, 0x84, 0x5f, 0x05, 0x81, 0x94, 0xcd, 0x71, 0x70, 0xd5, 0x85, 0x89,
+	0x04, 0x88, 0xc6, 0x5a, 0xef, 0x8e, 0xed, 0x11, 0xf6, 0x8e, 0x35, 0xb3, 0x76, 0xc8, 0x75, 0x74,
+	0x94, 0x48, 0x88, 0xea, 0x28, 0x52, 0x50, 0x20, 0x84, 0xa8, 0xae, 0xa7, 0xa1, 0xb8, 0xf2, 0x4a,
+	0x0a, 0x14, 0x50, 0xd2, 0x50, 0xa7, 0xa0, 0xa0, 0x42, 0xbb, 0x33, 0xfb, 0xe7, 0xb5, 0x7d, 0x4e,
+	0x48, 0x24, 0xaa, 0xc4, 0xb3, 0x6f, 0xbe, 0xf7, 0xbd, 0x6f, 0xde, 0x9b, 0xf7, 0x06, 0xdc, 0xb6,
+	0x28, 0xef, 0x50, 0x5e, 0xb5, 0x09, 0x77, 0x19, 0xa9, 0xf7, 0x5c, 0x42, 0x9d, 0x6a, 0x7f, 0xa3,
+	0x8e, 0x5d, 0x73, 0xa3, 0xda, 0xc4, 0x0e, 0xe6, 0x84, 0x57, 0xba, 0x8c, 0xba, 0x54, 0x5d, 0x11,
+	0xa6, 0x95, 0xb8, 0x69, 0x45, 0x9a, 0x16, 0x17, 0x9a, 0xb4, 0x49, 0x7d, 0xbb, 0xaa, 0xf7, 0x9f,
+	0xd8, 0x52, 0x2c, 0x49, 0xf4, 0xba, 0xc9, 0x71, 0x88, 0x6a, 0x51, 0xe2, 0xc8, 0xef, 0x95, 0x71,
+	0xde, 0x13, 0x7e, 0x7c, 0x7b, 0xf8, 0x44, 0x01, 0x8b, 0xf7, 0x70, 0x1b, 0x37, 0x4d, 0x97, 0xb2,
+	0x4f, 0x88, 0xdb, 0xb2, 0x99, 0xb9, 0xff, 0xc0, 0x69, 0x50, 0xf5, 0x01, 0x78, 0xc9, 0x0e, 0x3e,
+	0xd4, 0x4c, 0xdb, 0x66, 0x98, 0x73, 0x4d, 0x29, 0x2b, 0xeb, 0x57, 0x8c, 0xd5, 0xd3, 0x23, 0x5d,
+	0x3b, 0x30, 0x3b, 0xed, 0x2d, 0x98, 0x32, 0x81, 0xa8, 0x10, 0xae, 0x6d, 0x8b, 0x25, 0xf5, 0x3e,
+	0x28, 0xec, 0x4b, 0xe8, 0x10, 0x29, 0xeb, 0x23, 0xad, 0x9c, 0x1e, 0xe9, 0xcb, 0x02, 0x69, 0xd0,
+	0x02, 0xa2, 0xf9, 0x60, 0x49, 0xe2, 0x6c, 0xcd, 0x7e, 0x75, 0xa8, 0x67, 0xfe, 0x3a, 0xd4, 0x33,
+	0xf0, 0x71, 0x16, 0xac, 0x7d, 0x6c, 0xb6, 0x89, 0xed, 0xb9, 0x79, 0xd8, 0x73, 0xb9, 0x6b, 0x3a,
+	0x36, 0x71, 0x9a, 0x08, 0xef, 0x9b, 0xcc, 0xe6, 0x08, 0x5b, 0x94, 0xd9, 0x5e, 0x08, 0xfd, 0xc0,
+	0x68, 0x74, 0x08, 0x29, 0x13, 0x88, 0x0a, 0xe1, 0x5a, 0x10, 0xc2, 0xa1, 0x02, 0xae, 0xd1, 0xc8,
+	0x4f, 0x8d, 0x09, 0x47, 0x5a, 0xb6, 0x9c, 0x5b, 0xcf, 0x6f, 0xae, 0x4a, 0xd9, 0x2b, 0xde, 0xb1,
+	0x04, 0x27, 0x58, 0xb9, 0x87, 0xad, 0x1d, 0x4a, 0x1c, 0xe3, 0xa3, 0xa7, 0x47, 0x7a, 0xe6, 0xf4,
+	0x48, 0x2f, 0x0a, 0x7f, 0x43, 0x60, 0xe0, 0x8f, 0x7f, 0xe8, 0x77, 0x9a, 0xc4, 0x6d, 0xf5, 0xea,
+	0x15, 0x8b, 0x76, 0xaa, 0xf2, 0x10, 0xc5, 0x9f, 0x57, 0xb9, 0xfd, 0x79, 0xd5, 0x3d, 0xe8, 0x62,
+	0x1e, 0x20, 0x72, 0xa4, 0xd2, 0x54, 0xcc, 0x31, 0x75, 0xfe, 0x56, 0xc0, 0xad, 0x50, 0x9d, 0x6d,
+	0xcb, 0xea, 0x75, 0x7a, 0x6d, 0xd3, 0xc5, 0xf6, 0x0e, 0xed, 0x74, 0x08, 0xe7, 0x84, 0x3a, 0x17,
+	0x2f, 0xd0, 0x01, 0xc8, 0x9b, 0x91, 0x27, 0xff, 0x78, 0xf3, 0x9b, 0xef, 0x54, 0xc6, 0x64, 0x78,
+	0x65, 0x3c, 0x45, 0xa3, 0x28, 0x65, 0x53, 0x05, 0x8b, 0x18, 0x3a, 0x44, 0x71, 0x5f, 0xb1, 0xc0,
+	0xff, 0x51, 0x40, 0x39, 0x44, 0xfd, 0x90, 0x70, 0x97, 0x32, 0x62, 0x99, 0xed, 0x4b, 0xcb, 0x8a,
+	0x25, 0x30, 0xdd, 0xc5, 0x8c, 0x50, 0x11, 0xef, 0x14, 0x92, 0xbf, 0x54, 0x02, 0x66, 0x82, 0x04,
+	0xc9, 0xf9, 0x42, 0xbc, 0x35, 0x99, 0x10, 0x29, 0xca, 0xc6, 0x92, 0x14, 0xe1, 0x45, 0xc1, 0x2a,
+	0xc8, 0x17, 0x14, 0xe0, 0xc7, 0x82, 0xff, 0x5d, 0x01, 0x37, 0x42, 0xa4, 0x9d, 0x1e, 0x63, 0xd8,
+	0x71, 0x2f, 0x2d, 0xf2, 0x46, 0x14, 0xa1, 0x38, 0xea, 0xd7, 0x27, 0x8b, 0x30, 0xc9, 0xeb, 0x2c,
+	0xe1, 0x3d, 0xc9, 0x82, 0x95, 0xf0, 0xa6, 0xda, 0x73, 0x4d, 0xe6, 0x12, 0xa7, 0xe9, 0xdd, 0x54,
+	0x51, 0x70, 0x17, 0x75, 0x5f, 0x0d, 0xd5, 0x29, 0x7b, 0x2e, 0x9d, 0x7a, 0xe0, 0x2a, 0x97, 0x5c,
+	0x6b, 0xc4, 0x69, 0x50, 0x99, 0x0f, 0x9b, 0x63, 0xd5, 0x1a, 0x1a, 0xa6, 0xb1, 0x2a, 0xb5, 0x5a,
+	0x10, 0xee, 0x13, 0xb0, 0x10, 0xcd, 0xf1, 0x98, 0x6d, 0x4c, 0xb6, 0xef, 0xb2, 0xe0, 0x7a, 0xa8,
+	0xfe, 0x5e, 0xdb, 0xe4, 0xad, 0xf7, 0xfb, 0xfe, 0x01, 0x5c, 0x42, 0x2d, 0xb4, 0x30, 0x69, 0xb6,
+	0xdc, 0xa0, 0x16, 0xc4, 0xaf, 0x58, 0x8d, 0xe4, 0x12, 0x35, 0xf2, 0x08, 0x2c, 0x46, 0xb8, 0xdc,
+	0x23, 0x56, 0xc3, 0x1e, 0x33, 0x6d, 0xca, 0x57, 0xe8, 0xee, 0x64, 0xf9, 0x14, 0x45, 0x64, 0x2c,
+	0x48, 0x7d, 0xe6, 0x04, 0x69, 0x1f, 0x0c, 0xa2, 0x6b, 0xfd, 0xb4, 0x69, 0x4c, 0x9e, 0x5f, 0xb3,
+	0xa0, 0x14, 0xca, 0x8d, 0xb0, 0xd9, 0x26, 0x8f, 0xb0, 0x9d, 0xaa, 0x9a, 0xff, 0x61, 0x62, 0x7d,
+	0xa9, 0x80, 0x02, 0x93, 0x7c, 0x6b, 0xc9, 0xcb, 0xe6, 0x8d, 0xc9, 0x92, 0x6b, 0x20, 0x5a, 0x43,
+	0x97, 0xfa, 0x2d, 0x07, 0xb5, 0x98, 0x04, 0x87, 0x68, 0x9e, 0x25, 0x77, 0xc4, 0x64, 0xfc, 0x79,
+	0x0e, 0xcc, 0x7d, 0x20, 0x66, 0x9b, 0x3d, 0xd7, 0x74, 0xb1, 0x8a, 0xc0, 0x74, 0xd7, 0x64, 0x66,
+	0x47, 0x28, 0x95, 0xdf, 0xbc, 0x39, 0x96, 0xd3, 0xae, 0x6f, 0x6a, 0x2c, 0x4a, 0x06, 0x57, 0x05,
+	0x03, 0x01, 0x00, 0x91, 0x44, 0x52, 0x3f, 0x05, 0xb3, 0x0d, 0x8c, 0x6b, 0x5d, 0x4a, 0xdb, 0xf2,
+	0xd2, 0xb9, 0x35, 0x16, 0xf5, 0x3e, 0xc6, 0xbb, 0x94, 0xb6, 0x8d, 0x65, 0x09, 0x3b, 0x2f, 0x60,
+	0x03, 0x0c, 0x88, 0x66, 0x1a, 0xc2, 0x42, 0xfd, 0x56, 0x01, 0x5a, 0x74, 0x80, 0xe1, 0x24, 0xe2,
+	0x55, 0x96, 0x27, 0x6a, 0x6e, 0xf2, 0x8a, 0x8d, 0x8f, 0x50, 0xc6, 0x2b, 0xd2, 0xb1, 0x3e, 0x98,
+	0x22, 0x49, 0x0f, 0x10, 0x2d, 0xd9, 0xc3, 0xf6, 0xfb, 0xf9, 0xd2, 0x65, 0xb8, 0x4f, 0x68, 0x8f,
+	0xd7, 0xba, 0x8c, 0x76, 0x29, 0xc7, 0x4c, 0x9b, 0x1a, 0xcc, 0x97, 0x94, 0x09, 0x44, 0x85, 0x60,
+	0x6d, 0x57, 0x2e, 0xa9, 0xdf, 0x8c, 0x18, 0x60, 0x5e, 0xf0, 0xa3, 0x7b, 0x6f, 0xb2, 0x6a, 0x1b,
+	0x35, 0x69, 0x19, 0xf0, 0xf9, 0x23, 0xce, 0xb0, 0x99, 0x45, 0xfd, 0x45, 0x01, 0x6b, 0xb1, 0x74,
+	0x8f, 0x9a, 0x7a, 0xcd, 0x0a, 0x07, 0x01, 0xae, 0x4d, 0xfb, 0x1c, 0xb7, 0xff, 0xc3, 0x30, 0x21,
+	0x69, 0xde, 0x95, 0x34, 0xd7, 0x53, 0x85, 0x36, 0xdc, 0x33, 0x44, 0x7a, 0x7f, 0x2c, 0x2e, 0x57,
+	0x7f, 0x52, 0xc0, 0x6a, 0x84, 0xd3, 0x0a, 0x1b, 0x78, 0x28, 0xf0, 0x8c, 0x4f, 0xfe, 0xdd, 0x73,
+	0x0e, 0x00, 0x92, 0xf8, 0x1d, 0x49, 0xfc, 0xe6, 0x20, 0xf1, 0xb4, 0x43, 0x88, 0x8a, 0xfd, 0x91,
+	0x70, 0xde, 0x1c, 0x7b, 0x3d, 0xda, 0x6d, 0x89, 0x6e, 0x1c, 0x72, 0x9d, 0xf5, 0xb9, 0x6e, 0x9d,
+	0xa7, 0x95, 0x4b, 0xa2, 0xeb, 0x92, 0x68, 0x79, 0x90, 0xe8, 0x80, 0x2b, 0x88, 0x96, 0xfb, 0xc3,
+	0x81, 0xd4, 0xc7, 0x89, 0x62, 0x4c, 0xb4, 0x39, 0xae, 0x5d, 0xf1, 0x19, 0xbe, 0x7d, 0xf6, 0xf6,
+	0x29, 0xf9, 0x8d, 0x2c, 0xc9, 0xa4, 0x9f, 0x78, 0x49, 0xc6, 0x51, 0xb8, 0x57, 0x47, 0x4b, 0x43,
+	0xfb, 0x16, 0xd7, 0x80, 0xcf, 0xed, 0xcd, 0xb3, 0x36, 0x2e, 0xc9, 0xec, 0x65, 0xc9, 0xec, 0xc6,
+	0xa0, 0x72, 0x71, 0x1f, 0x10, 0x2d, 0x0c, 0xe9, 0x67, 0x5c, 0xfd, 0x5e, 0x01, 0xc5, 0x28, 0x96,
+	0x54, 0x5f, 0xc8, 0x97, 0x73, 0xcf, 0x9d, 0xc6, 0xc7, 0x77, 0x41, 0xe3, 0xb6, 0xa4, 0xb7, 0x36,
+	0x28, 0x5c, 0xba, 0x4f, 0x68, 0xf6, 0x08, 0xa8, 0xa8, 0x61, 0x18, 0x0f, 0x7f, 0x38, 0x2e, 0x29,
+	0x4f, 0x8f, 0x4b, 0xca, 0xb3, 0xe3, 0x92, 0xf2, 0xe7, 0x71, 0x49, 0xf9, 0xfa, 0xa4, 0x94, 0x79,
+	0x76, 0x52, 0xca, 0xfc, 0x76, 0x52, 0xca, 0x7c, 0xb6, 0x31, 0xf6, 0x2d, 0xf4, 0x45, 0xf2, 0x75,
+	0xeb, 0x3f, 0x8d, 0xea, 0xd3, 0xfe, 0x7b, 0xf6, 0xb5, 0x7f, 0x07, 0x00, 0x85, 0x7e, 0x3e, 0xef,
+	0x7f, 0x0f, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -767,6 +819,53 @@ func (m *ValidatorSlashEventRecord) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegatorRealizedRewardsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorRealizedRewardsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorRealizedRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RealizedRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -787,6 +886,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DelegatorRealizedRewards) > 0 {
+		for iNdEx := len(m.DelegatorRealizedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorRealizedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1050,6 +1163,25 @@ func (m *ValidatorSlashEventRecord) Size() (n int) {
 	return n
 }
 
+func (m *DelegatorRealizedRewardsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.RealizedRewards.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1106,6 +1238,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DelegatorRealizedRewards) > 0 {
+		for _, e := range m.DelegatorRealizedRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2009,6 +2147,153 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DelegatorRealizedRewardsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorRealizedRewardsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorRealizedRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RealizedRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2374,6 +2659,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorRealizedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorRealizedRewards = append(m.DelegatorRealizedRewards, DelegatorRealizedRewardsRecord{})
+			if err := m.DelegatorRealizedRewards[len(m.DelegatorRealizedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
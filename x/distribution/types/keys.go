@@ -41,6 +41,8 @@ const (
 // - 0x07<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorCurrentCommission
 //
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: DelegatorRealizedRewards
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -52,6 +54,7 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+	DelegatorRealizedRewardsPrefix       = []byte{0x09} // key for delegator realized rewards
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -97,6 +100,21 @@ func GetDelegatorStartingInfoAddresses(key []byte) (valAddr sdk.ValAddress, delA
 	return
 }
 
+// GetDelegatorRealizedRewardsAddresses creates the addresses from a delegator realized rewards key.
+func GetDelegatorRealizedRewardsAddresses(key []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	// key is in the format:
+	// 0x09<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>
+	delAddrLen := int(key[1])
+	delAddr = sdk.AccAddress(key[2 : 2+delAddrLen])
+	valAddrLen := int(key[2+delAddrLen])
+	valAddr = sdk.ValAddress(key[3+delAddrLen:])
+	if len(valAddr.Bytes()) != valAddrLen {
+		panic("unexpected key length")
+	}
+
+	return
+}
+
 // GetValidatorHistoricalRewardsAddressPeriod creates the address & period from a validator's historical rewards key.
 func GetValidatorHistoricalRewardsAddressPeriod(key []byte) (valAddr sdk.ValAddress, period uint64) {
 	// key is in the format:
@@ -161,9 +179,24 @@ func GetDelegatorWithdrawAddrKey(delAddr sdk.AccAddress) []byte {
 	return append(DelegatorWithdrawAddrPrefix, address.MustLengthPrefix(delAddr.Bytes())...)
 }
 
+// GetDelegatorStartingInfoPrefix creates the prefix key for the starting infos of a validator's delegators.
+func GetDelegatorStartingInfoPrefix(v sdk.ValAddress) []byte {
+	return append(DelegatorStartingInfoPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
 // GetDelegatorStartingInfoKey creates the key for a delegator's starting info.
 func GetDelegatorStartingInfoKey(v sdk.ValAddress, d sdk.AccAddress) []byte {
-	return append(append(DelegatorStartingInfoPrefix, address.MustLengthPrefix(v.Bytes())...), address.MustLengthPrefix(d.Bytes())...)
+	return append(GetDelegatorStartingInfoPrefix(v), address.MustLengthPrefix(d.Bytes())...)
+}
+
+// GetDelegatorRealizedRewardsPrefix creates the prefix key for a delegator's realized rewards.
+func GetDelegatorRealizedRewardsPrefix(d sdk.AccAddress) []byte {
+	return append(DelegatorRealizedRewardsPrefix, address.MustLengthPrefix(d.Bytes())...)
+}
+
+// GetDelegatorRealizedRewardsKey creates the key for a delegator's realized rewards from a validator.
+func GetDelegatorRealizedRewardsKey(d sdk.AccAddress, v sdk.ValAddress) []byte {
+	return append(GetDelegatorRealizedRewardsPrefix(d), address.MustLengthPrefix(v.Bytes())...)
 }
 
 // GetValidatorHistoricalRewardsPrefix creates the prefix key for a validator's historical rewards.
@@ -15,8 +15,14 @@ var (
 	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
 	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+	ParamStoreKeyFeeBurnRatio        = []byte("feeburnratio")
+	ParamStoreKeyDustSweepInterval   = []byte("dustsweepinterval")
 )
 
+// DefaultDustSweepInterval is the default number of blocks between the sweeps
+// of the truncation dust to the community pool.
+const DefaultDustSweepInterval uint64 = 100
+
 // ParamKeyTable returns the parameter key table.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -29,6 +35,8 @@ func DefaultParams() Params {
 		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
 		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
 		WithdrawAddrEnabled: true,
+		FeeBurnRatio:        sdk.ZeroDec(),
+		DustSweepInterval:   DefaultDustSweepInterval,
 	}
 }
 
@@ -44,6 +52,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyBaseProposerReward, &p.BaseProposerReward, validateBaseProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyBonusProposerReward, &p.BonusProposerReward, validateBonusProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyWithdrawAddrEnabled, &p.WithdrawAddrEnabled, validateWithdrawAddrEnabled),
+		paramtypes.NewParamSetPair(ParamStoreKeyFeeBurnRatio, &p.FeeBurnRatio, validateFeeBurnRatio),
+		paramtypes.NewParamSetPair(ParamStoreKeyDustSweepInterval, &p.DustSweepInterval, validateDustSweepInterval),
 	}
 }
 
@@ -64,6 +74,11 @@ func (p Params) ValidateBasic() error {
 			"bonus proposer reward should be positive: %s", p.BonusProposerReward,
 		)
 	}
+	if p.FeeBurnRatio.IsNegative() || p.FeeBurnRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf(
+			"fee burn ratio should be non-negative and less than one: %s", p.FeeBurnRatio,
+		)
+	}
 	if v := p.BaseProposerReward.Add(p.BonusProposerReward).Add(p.CommunityTax); v.GT(sdk.OneDec()) {
 		return fmt.Errorf(
 			"sum of base, bonus proposer rewards, and community tax cannot be greater than one: %s", v,
@@ -138,3 +153,31 @@ func validateWithdrawAddrEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateFeeBurnRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("fee burn ratio must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("fee burn ratio must be positive: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("fee burn ratio too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateDustSweepInterval(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
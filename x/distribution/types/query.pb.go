@@ -593,6 +593,102 @@ func (m *QueryDelegationTotalRewardsResponse) GetTotal() github_com_cosmos_cosmo
 	return nil
 }
 
+// QueryDelegatorRealizedRewardsRequest is the request type for the
+// Query/DelegatorRealizedRewards RPC method.
+type QueryDelegatorRealizedRewardsRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryDelegatorRealizedRewardsRequest) Reset()         { *m = QueryDelegatorRealizedRewardsRequest{} }
+func (m *QueryDelegatorRealizedRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorRealizedRewardsRequest) ProtoMessage()    {}
+func (*QueryDelegatorRealizedRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{12}
+}
+func (m *QueryDelegatorRealizedRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorRealizedRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorRealizedRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorRealizedRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorRealizedRewardsRequest.Merge(m, src)
+}
+func (m *QueryDelegatorRealizedRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorRealizedRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorRealizedRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorRealizedRewardsRequest proto.InternalMessageInfo
+
+// QueryDelegatorRealizedRewardsResponse is the response type for the
+// Query/DelegatorRealizedRewards RPC method.
+type QueryDelegatorRealizedRewardsResponse struct {
+	// rewards defines the rewards withdrawn by a delegator from each validator.
+	Rewards []DelegationDelegatorRealizedReward `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards"`
+	// total defines the sum of all the realized rewards.
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+}
+
+func (m *QueryDelegatorRealizedRewardsResponse) Reset()         { *m = QueryDelegatorRealizedRewardsResponse{} }
+func (m *QueryDelegatorRealizedRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorRealizedRewardsResponse) ProtoMessage()    {}
+func (*QueryDelegatorRealizedRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{13}
+}
+func (m *QueryDelegatorRealizedRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorRealizedRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorRealizedRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorRealizedRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorRealizedRewardsResponse.Merge(m, src)
+}
+func (m *QueryDelegatorRealizedRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorRealizedRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorRealizedRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorRealizedRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorRealizedRewardsResponse) GetRewards() []DelegationDelegatorRealizedReward {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *QueryDelegatorRealizedRewardsResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
 // QueryDelegatorValidatorsRequest is the request type for the
 // Query/DelegatorValidators RPC method.
 type QueryDelegatorValidatorsRequest struct {
@@ -604,7 +700,7 @@ func (m *QueryDelegatorValidatorsRequest) Reset()         { *m = QueryDelegatorV
 func (m *QueryDelegatorValidatorsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorValidatorsRequest) ProtoMessage()    {}
 func (*QueryDelegatorValidatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{12}
+	return fileDescriptor_5efd02cbc06efdc9, []int{14}
 }
 func (m *QueryDelegatorValidatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -644,7 +740,7 @@ func (m *QueryDelegatorValidatorsResponse) Reset()         { *m = QueryDelegator
 func (m *QueryDelegatorValidatorsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorValidatorsResponse) ProtoMessage()    {}
 func (*QueryDelegatorValidatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{13}
+	return fileDescriptor_5efd02cbc06efdc9, []int{15}
 }
 func (m *QueryDelegatorValidatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -684,7 +780,7 @@ func (m *QueryDelegatorWithdrawAddressRequest) Reset()         { *m = QueryDeleg
 func (m *QueryDelegatorWithdrawAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorWithdrawAddressRequest) ProtoMessage()    {}
 func (*QueryDelegatorWithdrawAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{14}
+	return fileDescriptor_5efd02cbc06efdc9, []int{16}
 }
 func (m *QueryDelegatorWithdrawAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -724,7 +820,7 @@ func (m *QueryDelegatorWithdrawAddressResponse) Reset()         { *m = QueryDele
 func (m *QueryDelegatorWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorWithdrawAddressResponse) ProtoMessage()    {}
 func (*QueryDelegatorWithdrawAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{15}
+	return fileDescriptor_5efd02cbc06efdc9, []int{17}
 }
 func (m *QueryDelegatorWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -762,7 +858,7 @@ func (m *QueryCommunityPoolRequest) Reset()         { *m = QueryCommunityPoolReq
 func (m *QueryCommunityPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolRequest) ProtoMessage()    {}
 func (*QueryCommunityPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{16}
+	return fileDescriptor_5efd02cbc06efdc9, []int{18}
 }
 func (m *QueryCommunityPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -802,7 +898,7 @@ func (m *QueryCommunityPoolResponse) Reset()         { *m = QueryCommunityPoolRe
 func (m *QueryCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolResponse) ProtoMessage()    {}
 func (*QueryCommunityPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{17}
+	return fileDescriptor_5efd02cbc06efdc9, []int{19}
 }
 func (m *QueryCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -838,6 +934,295 @@ func (m *QueryCommunityPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+// QueryBurnedFeesRequest is the request type for the Query/BurnedFees RPC
+// method.
+type QueryBurnedFeesRequest struct {
+}
+
+func (m *QueryBurnedFeesRequest) Reset()         { *m = QueryBurnedFeesRequest{} }
+func (m *QueryBurnedFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedFeesRequest) ProtoMessage()    {}
+func (*QueryBurnedFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
+}
+func (m *QueryBurnedFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedFeesRequest.Merge(m, src)
+}
+func (m *QueryBurnedFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedFeesRequest proto.InternalMessageInfo
+
+// QueryBurnedFeesResponse is the response type for the Query/BurnedFees RPC
+// method.
+type QueryBurnedFeesResponse struct {
+	// burned_fees defines the cumulative amount of collected fees burned.
+	BurnedFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=burned_fees,json=burnedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned_fees"`
+}
+
+func (m *QueryBurnedFeesResponse) Reset()         { *m = QueryBurnedFeesResponse{} }
+func (m *QueryBurnedFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedFeesResponse) ProtoMessage()    {}
+func (*QueryBurnedFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
+}
+func (m *QueryBurnedFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedFeesResponse.Merge(m, src)
+}
+func (m *QueryBurnedFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedFeesResponse proto.InternalMessageInfo
+
+func (m *QueryBurnedFeesResponse) GetBurnedFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BurnedFees
+	}
+	return nil
+}
+
+// QueryDustRequest is the request type for the Query/Dust RPC method.
+type QueryDustRequest struct {
+}
+
+func (m *QueryDustRequest) Reset()         { *m = QueryDustRequest{} }
+func (m *QueryDustRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDustRequest) ProtoMessage()    {}
+func (*QueryDustRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{22}
+}
+func (m *QueryDustRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDustRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDustRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDustRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDustRequest.Merge(m, src)
+}
+func (m *QueryDustRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDustRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDustRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDustRequest proto.InternalMessageInfo
+
+// QueryDustResponse is the response type for the Query/Dust RPC method.
+type QueryDustResponse struct {
+	// dust defines the truncation dust accumulated since the last sweep.
+	Dust github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=dust,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"dust"`
+}
+
+func (m *QueryDustResponse) Reset()         { *m = QueryDustResponse{} }
+func (m *QueryDustResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDustResponse) ProtoMessage()    {}
+func (*QueryDustResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{23}
+}
+func (m *QueryDustResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDustResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDustResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDustResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDustResponse.Merge(m, src)
+}
+func (m *QueryDustResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDustResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDustResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDustResponse proto.InternalMessageInfo
+
+func (m *QueryDustResponse) GetDust() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Dust
+	}
+	return nil
+}
+
+// QueryValidatorDelegatorStartingInfosRequest is the request type for the
+// Query/ValidatorDelegatorStartingInfos RPC method.
+type QueryValidatorDelegatorStartingInfosRequest struct {
+	// validator_address defines the validator address to query for.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorDelegatorStartingInfosRequest) Reset() {
+	*m = QueryValidatorDelegatorStartingInfosRequest{}
+}
+func (m *QueryValidatorDelegatorStartingInfosRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryValidatorDelegatorStartingInfosRequest) ProtoMessage() {}
+func (*QueryValidatorDelegatorStartingInfosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{24}
+}
+func (m *QueryValidatorDelegatorStartingInfosRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorDelegatorStartingInfosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorDelegatorStartingInfosRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorDelegatorStartingInfosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorDelegatorStartingInfosRequest.Merge(m, src)
+}
+func (m *QueryValidatorDelegatorStartingInfosRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorDelegatorStartingInfosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorDelegatorStartingInfosRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorDelegatorStartingInfosRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorDelegatorStartingInfosRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *QueryValidatorDelegatorStartingInfosRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryValidatorDelegatorStartingInfosResponse is the response type for the
+// Query/ValidatorDelegatorStartingInfos RPC method.
+type QueryValidatorDelegatorStartingInfosResponse struct {
+	// starting_infos defines the starting infos of the delegations to the
+	// validator.
+	StartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,1,rep,name=starting_infos,json=startingInfos,proto3" json:"starting_infos"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorDelegatorStartingInfosResponse) Reset() {
+	*m = QueryValidatorDelegatorStartingInfosResponse{}
+}
+func (m *QueryValidatorDelegatorStartingInfosResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryValidatorDelegatorStartingInfosResponse) ProtoMessage() {}
+func (*QueryValidatorDelegatorStartingInfosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{25}
+}
+func (m *QueryValidatorDelegatorStartingInfosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorDelegatorStartingInfosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorDelegatorStartingInfosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorDelegatorStartingInfosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorDelegatorStartingInfosResponse.Merge(m, src)
+}
+func (m *QueryValidatorDelegatorStartingInfosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorDelegatorStartingInfosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorDelegatorStartingInfosResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorDelegatorStartingInfosResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorDelegatorStartingInfosResponse) GetStartingInfos() []DelegatorStartingInfoRecord {
+	if m != nil {
+		return m.StartingInfos
+	}
+	return nil
+}
+
+func (m *QueryValidatorDelegatorStartingInfosResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.distribution.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.distribution.v1beta1.QueryParamsResponse")
@@ -851,12 +1236,20 @@ func init() {
 	proto.RegisterType((*QueryDelegationRewardsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegationRewardsResponse")
 	proto.RegisterType((*QueryDelegationTotalRewardsRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegationTotalRewardsRequest")
 	proto.RegisterType((*QueryDelegationTotalRewardsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegationTotalRewardsResponse")
+	proto.RegisterType((*QueryDelegatorRealizedRewardsRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorRealizedRewardsRequest")
+	proto.RegisterType((*QueryDelegatorRealizedRewardsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorRealizedRewardsResponse")
 	proto.RegisterType((*QueryDelegatorValidatorsRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorValidatorsRequest")
 	proto.RegisterType((*QueryDelegatorValidatorsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorValidatorsResponse")
 	proto.RegisterType((*QueryDelegatorWithdrawAddressRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressRequest")
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
+	proto.RegisterType((*QueryBurnedFeesRequest)(nil), "cosmos.distribution.v1beta1.QueryBurnedFeesRequest")
+	proto.RegisterType((*QueryBurnedFeesResponse)(nil), "cosmos.distribution.v1beta1.QueryBurnedFeesResponse")
+	proto.RegisterType((*QueryDustRequest)(nil), "cosmos.distribution.v1beta1.QueryDustRequest")
+	proto.RegisterType((*QueryDustResponse)(nil), "cosmos.distribution.v1beta1.QueryDustResponse")
+	proto.RegisterType((*QueryValidatorDelegatorStartingInfosRequest)(nil), "cosmos.distribution.v1beta1.QueryValidatorDelegatorStartingInfosRequest")
+	proto.RegisterType((*QueryValidatorDelegatorStartingInfosResponse)(nil), "cosmos.distribution.v1beta1.QueryValidatorDelegatorStartingInfosResponse")
 }
 
 func init() {
@@ -864,76 +1257,95 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1101 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x3d, 0x6e, 0xda, 0xd2, 0x57, 0x4a, 0xd2, 0x69, 0x85, 0xcc, 0x26, 0xd8, 0xd1, 0x86,
-	0x92, 0x40, 0x54, 0x6f, 0x93, 0x48, 0x05, 0x5a, 0x10, 0xe4, 0x57, 0xa9, 0xd4, 0x2a, 0x4d, 0x4d,
-	0x95, 0x84, 0x5f, 0x8a, 0x26, 0xde, 0xd1, 0x7a, 0x55, 0x7b, 0xc7, 0xdd, 0x19, 0x27, 0x44, 0x55,
-	0x2f, 0x04, 0x24, 0x2e, 0x48, 0x48, 0x5c, 0x7a, 0xcc, 0x99, 0x3b, 0x17, 0xfe, 0x82, 0x1e, 0x2b,
-	0x21, 0xa1, 0x9e, 0x00, 0x25, 0x08, 0x55, 0x42, 0x9c, 0xb9, 0x22, 0xcf, 0xcc, 0xda, 0xbb, 0xf6,
-	0x7a, 0xfd, 0x4b, 0x3d, 0xc5, 0x7a, 0x3b, 0xef, 0x3b, 0xef, 0xf3, 0x66, 0xde, 0xbc, 0xa7, 0xc0,
-	0x74, 0x91, 0xf1, 0x0a, 0xe3, 0x96, 0xed, 0x72, 0xe1, 0xbb, 0x3b, 0x35, 0xe1, 0x32, 0xcf, 0xda,
-	0x9d, 0xdb, 0xa1, 0x82, 0xcc, 0x59, 0x0f, 0x6a, 0xd4, 0xdf, 0xcf, 0x57, 0x7d, 0x26, 0x18, 0x1e,
-	0x57, 0x0b, 0xf3, 0xe1, 0x85, 0x79, 0xbd, 0xd0, 0x78, 0x5b, 0xab, 0xec, 0x10, 0x4e, 0x95, 0x57,
-	0x43, 0xa3, 0x4a, 0x1c, 0xd7, 0x23, 0x72, 0xb5, 0x14, 0x32, 0x2e, 0x3a, 0xcc, 0x61, 0xf2, 0xa7,
-	0x55, 0xff, 0xa5, 0xad, 0x13, 0x0e, 0x63, 0x4e, 0x99, 0x5a, 0xa4, 0xea, 0x5a, 0xc4, 0xf3, 0x98,
-	0x90, 0x2e, 0x5c, 0x7f, 0xcd, 0x86, 0xf5, 0x03, 0xe5, 0x22, 0x73, 0x03, 0xcd, 0x7c, 0x12, 0x45,
-	0x24, 0x62, 0xb9, 0xde, 0xbc, 0x08, 0xf8, 0x6e, 0x3d, 0xca, 0x75, 0xe2, 0x93, 0x0a, 0x2f, 0xd0,
-	0x07, 0x35, 0xca, 0x85, 0xb9, 0x05, 0x17, 0x22, 0x56, 0x5e, 0x65, 0x1e, 0xa7, 0x78, 0x11, 0x4e,
-	0x55, 0xa5, 0x25, 0x83, 0x26, 0xd1, 0xcc, 0xd9, 0xf9, 0xa9, 0x7c, 0x42, 0x2a, 0xf2, 0xca, 0x79,
-	0x69, 0xe4, 0xc9, 0xef, 0xb9, 0x54, 0x41, 0x3b, 0x9a, 0x1b, 0x30, 0x2d, 0x95, 0x37, 0x48, 0xd9,
-	0xb5, 0x89, 0x60, 0xfe, 0x9d, 0x9a, 0xe0, 0x82, 0x78, 0xb6, 0xeb, 0x39, 0x05, 0xba, 0x47, 0x7c,
-	0x3b, 0x08, 0x02, 0xcf, 0xc2, 0xf9, 0xdd, 0x60, 0xd5, 0x36, 0xb1, 0x6d, 0x9f, 0x72, 0xb5, 0xf1,
-	0x99, 0xc2, 0x58, 0xe3, 0xc3, 0xa2, 0xb2, 0x9b, 0xdf, 0x20, 0x98, 0xe9, 0x2e, 0xac, 0x39, 0xb6,
-	0xe0, 0xb4, 0xaf, 0x4c, 0x1a, 0xe4, 0xdd, 0x44, 0x90, 0x04, 0x49, 0x4d, 0x17, 0xc8, 0x99, 0x6b,
-	0x90, 0x8b, 0x46, 0xb1, 0xcc, 0x2a, 0x15, 0x97, 0x73, 0x97, 0x79, 0x03, 0x61, 0x7d, 0x8b, 0x60,
-	0xb2, 0xb3, 0xa0, 0xc6, 0x21, 0x00, 0xc5, 0x86, 0x55, 0x13, 0x5d, 0xef, 0x8d, 0x68, 0xb1, 0x58,
-	0xac, 0x55, 0x6a, 0x65, 0x22, 0xa8, 0xdd, 0x14, 0xd6, 0x50, 0x21, 0x51, 0xf3, 0x1f, 0x04, 0x13,
-	0xd1, 0x38, 0x3e, 0x29, 0x13, 0x5e, 0xa2, 0x03, 0x1d, 0x16, 0x9e, 0x86, 0x51, 0x2e, 0x88, 0x2f,
-	0x5c, 0xcf, 0xd9, 0x2e, 0x51, 0xd7, 0x29, 0x89, 0x4c, 0x7a, 0x12, 0xcd, 0x8c, 0x14, 0x5e, 0x09,
-	0xcc, 0x37, 0xa5, 0x15, 0x4f, 0xc1, 0x39, 0x2a, 0xd3, 0x1d, 0x2c, 0x3b, 0x21, 0x97, 0xbd, 0xac,
-	0x8c, 0x7a, 0xd1, 0x0d, 0x80, 0x66, 0x69, 0x65, 0x46, 0x24, 0xfe, 0x9b, 0x01, 0x7e, 0xbd, 0x4e,
-	0xf2, 0xaa, 0x7a, 0x9b, 0xf7, 0xd2, 0xa1, 0x3a, 0xec, 0x42, 0xc8, 0xf3, 0xda, 0x4b, 0xdf, 0x1d,
-	0xe6, 0x52, 0x8f, 0x0f, 0x73, 0xc8, 0xfc, 0x05, 0xc1, 0xeb, 0x1d, 0x68, 0x75, 0xca, 0xd7, 0xe1,
-	0x34, 0x57, 0xa6, 0x0c, 0x9a, 0x3c, 0x31, 0x73, 0x76, 0xfe, 0x4a, 0x6f, 0xf9, 0x96, 0x3a, 0xab,
-	0xbb, 0xd4, 0x13, 0xc1, 0xcd, 0xd1, 0x32, 0xf8, 0xe3, 0x08, 0x45, 0x5a, 0x52, 0x4c, 0x77, 0xa5,
-	0x50, 0xe1, 0x84, 0x31, 0xcc, 0x83, 0x20, 0xf8, 0x15, 0x5a, 0xa6, 0x8e, 0xb4, 0xb5, 0x17, 0x96,
-	0xad, 0xbe, 0xb5, 0x9f, 0x55, 0xe3, 0x43, 0x70, 0x56, 0xb1, 0x07, 0x9b, 0x8e, 0x3f, 0x58, 0x95,
-	0xc2, 0xe7, 0x87, 0xb9, 0x94, 0xf9, 0x3d, 0x82, 0x6c, 0xa7, 0x28, 0x74, 0x0e, 0xef, 0x87, 0xab,
-	0xb0, 0x9e, 0xc3, 0x89, 0x08, 0x6e, 0x00, 0xba, 0x42, 0x8b, 0xcb, 0xcc, 0xf5, 0x96, 0x16, 0xea,
-	0xf9, 0xfa, 0xe9, 0x8f, 0xdc, 0xac, 0xe3, 0x8a, 0x52, 0x6d, 0x27, 0x5f, 0x64, 0x15, 0x4b, 0x3f,
-	0x76, 0xea, 0xcf, 0x65, 0x6e, 0xdf, 0xb7, 0xc4, 0x7e, 0x95, 0xf2, 0xc0, 0x87, 0x37, 0x0b, 0xf3,
-	0x73, 0x30, 0x5b, 0xc2, 0xb9, 0xc7, 0x04, 0x29, 0x0f, 0x91, 0x99, 0x10, 0xec, 0xdf, 0x08, 0xa6,
-	0x12, 0xd5, 0x35, 0xf1, 0x46, 0x2b, 0xf1, 0xd5, 0xc4, 0x5b, 0xd3, 0x54, 0x5b, 0x09, 0xf6, 0x56,
-	0x8a, 0x2d, 0xaf, 0x0e, 0x76, 0xe0, 0xa4, 0xa8, 0xef, 0x97, 0x49, 0xbf, 0xa8, 0x3c, 0x2a, 0x7d,
-	0x73, 0x4b, 0x3f, 0x6f, 0x8d, 0x78, 0x1a, 0x17, 0x7b, 0xd8, 0x14, 0xde, 0xd6, 0xef, 0x5c, 0xac,
-	0xb2, 0x4e, 0x5f, 0x16, 0xa0, 0x71, 0xe3, 0x54, 0x06, 0xcf, 0x14, 0x42, 0x96, 0x90, 0xda, 0x97,
-	0xf0, 0x46, 0x54, 0x6d, 0xd3, 0x15, 0x25, 0xdb, 0x27, 0x7b, 0x7a, 0xe3, 0x21, 0x83, 0xfd, 0x02,
-	0x2e, 0x75, 0x91, 0xd7, 0x11, 0xbf, 0x05, 0x63, 0x7b, 0xfa, 0x53, 0x8b, 0xfc, 0xe8, 0x5e, 0xd4,
-	0x25, 0xa4, 0x3e, 0x0e, 0xaf, 0x49, 0xf5, 0xfa, 0x83, 0x5c, 0xf3, 0x5c, 0xb1, 0xbf, 0xce, 0x58,
-	0x39, 0xe8, 0xcc, 0x07, 0x08, 0x8c, 0xb8, 0xaf, 0x7a, 0x43, 0x0a, 0x23, 0x55, 0xc6, 0xca, 0x2f,
-	0xae, 0xa0, 0xa4, 0xfc, 0xfc, 0xb3, 0x51, 0x38, 0x29, 0xa3, 0xc0, 0x8f, 0x11, 0x9c, 0x52, 0x8d,
-	0x1e, 0x5b, 0x89, 0x97, 0xb9, 0x7d, 0xca, 0x30, 0xae, 0xf4, 0xee, 0xa0, 0xf0, 0xcc, 0xd9, 0xaf,
-	0x7f, 0xfd, 0xeb, 0xc7, 0xf4, 0x25, 0x3c, 0x65, 0x25, 0x8d, 0x39, 0x6a, 0xd4, 0xc0, 0x07, 0x69,
-	0x18, 0x4f, 0x68, 0xdd, 0x78, 0xa5, 0xfb, 0xf6, 0xdd, 0xa7, 0x14, 0x63, 0x75, 0x48, 0x15, 0x4d,
-	0xb6, 0x29, 0xc9, 0xee, 0xe2, 0x3b, 0x89, 0x64, 0xcd, 0xcb, 0x6e, 0x3d, 0x6c, 0x7b, 0x95, 0x1f,
-	0x59, 0xac, 0xa9, 0xbf, 0x1d, 0xbc, 0x0d, 0x47, 0x08, 0x2e, 0xc4, 0x0c, 0x0f, 0xf8, 0xfd, 0x3e,
-	0xe2, 0x6e, 0x1b, 0x62, 0x8c, 0x0f, 0x06, 0xf4, 0xd6, 0xb4, 0x6b, 0x92, 0xf6, 0x26, 0xbe, 0x31,
-	0x0c, 0x6d, 0x73, 0x3c, 0xc1, 0xbf, 0x21, 0x18, 0x6b, 0xed, 0xd5, 0xf8, 0xbd, 0x3e, 0x62, 0x8c,
-	0x4e, 0x33, 0xc6, 0xb5, 0x41, 0x5c, 0x35, 0xdb, 0x2d, 0xc9, 0xb6, 0x8a, 0x97, 0x87, 0x61, 0x0b,
-	0xa6, 0x82, 0x7f, 0x11, 0x9c, 0x6f, 0xeb, 0xa0, 0xb8, 0x87, 0xf0, 0x3a, 0x35, 0x7f, 0xe3, 0xfa,
-	0x40, 0xbe, 0x9a, 0x6d, 0x5b, 0xb2, 0x7d, 0x8a, 0x37, 0x13, 0xd9, 0x1a, 0x2f, 0x27, 0xb7, 0x1e,
-	0xb6, 0x3d, 0xaf, 0x8f, 0x2c, 0x7d, 0x33, 0xe3, 0xb8, 0xf1, 0x73, 0x04, 0xaf, 0xc6, 0x37, 0x51,
-	0xfc, 0x61, 0x3f, 0x81, 0xc7, 0x34, 0x77, 0xe3, 0xa3, 0xc1, 0x05, 0xfa, 0x3a, 0xda, 0xde, 0xf0,
-	0x65, 0x61, 0xc6, 0x74, 0xbb, 0x5e, 0x0a, 0xb3, 0x73, 0xfb, 0xed, 0xa5, 0x30, 0x13, 0x5a, 0x6c,
-	0x8f, 0x85, 0xd9, 0x85, 0xb0, 0x79, 0xb7, 0xf1, 0x7f, 0x08, 0x32, 0x9d, 0xba, 0x24, 0x5e, 0xec,
-	0x23, 0xd6, 0xf8, 0x06, 0x6e, 0x2c, 0x0d, 0x23, 0xa1, 0x99, 0xef, 0x49, 0xe6, 0x35, 0x7c, 0x7b,
-	0x18, 0xe6, 0xd6, 0x36, 0x8f, 0x7f, 0x46, 0x70, 0x2e, 0xd2, 0xa3, 0xf1, 0xd5, 0xee, 0xb1, 0xc6,
-	0xb5, 0x7c, 0xe3, 0x9d, 0xbe, 0xfd, 0x34, 0xd8, 0x82, 0x04, 0xbb, 0x8c, 0x67, 0x13, 0xc1, 0x8a,
-	0x81, 0xef, 0x76, 0xbd, 0xb5, 0x2f, 0xdd, 0x7a, 0x72, 0x94, 0x45, 0x4f, 0x8f, 0xb2, 0xe8, 0xcf,
-	0xa3, 0x2c, 0xfa, 0xe1, 0x38, 0x9b, 0x7a, 0x7a, 0x9c, 0x4d, 0x3d, 0x3b, 0xce, 0xa6, 0x3e, 0x9b,
-	0x4b, 0x9c, 0x13, 0xbe, 0x8a, 0xaa, 0xcb, 0xb1, 0x61, 0xe7, 0x94, 0xfc, 0x27, 0xc3, 0xc2, 0xff,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x68, 0x0b, 0x91, 0xae, 0x5c, 0x11, 0x00, 0x00,
+	// 1397 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdf, 0x8f, 0x13, 0xd5,
+	0x1f, 0xed, 0x2d, 0x0b, 0x7c, 0xf9, 0xf0, 0x45, 0x96, 0x0b, 0xc1, 0x32, 0x60, 0xbb, 0xce, 0x8a,
+	0x5b, 0x58, 0xe9, 0x2c, 0xac, 0x41, 0x04, 0x51, 0xb7, 0x2c, 0x2b, 0x04, 0xc2, 0x8f, 0x42, 0x16,
+	0x54, 0xb4, 0x99, 0x76, 0x2e, 0xb3, 0x13, 0xda, 0xb9, 0xa5, 0x77, 0xca, 0xba, 0x10, 0x5e, 0x5c,
+	0x8d, 0xbe, 0x98, 0x90, 0xe8, 0x03, 0x8f, 0x1b, 0x9f, 0xd4, 0x77, 0x5f, 0xfc, 0x0b, 0x78, 0x24,
+	0x31, 0x31, 0x3e, 0xa1, 0xd9, 0x35, 0x86, 0x68, 0x7c, 0xf6, 0xd5, 0xcc, 0x9d, 0x3b, 0xbf, 0xda,
+	0xe9, 0x74, 0xda, 0xee, 0x3e, 0x6d, 0x73, 0xe7, 0x7e, 0xce, 0x3d, 0xe7, 0xdc, 0x5f, 0xe7, 0x2e,
+	0x4c, 0x54, 0x29, 0xab, 0x53, 0xa6, 0x68, 0x06, 0xb3, 0x9a, 0x46, 0xa5, 0x65, 0x19, 0xd4, 0x54,
+	0xee, 0x1d, 0xad, 0x10, 0x4b, 0x3d, 0xaa, 0xdc, 0x6d, 0x91, 0xe6, 0x52, 0xa1, 0xd1, 0xa4, 0x16,
+	0xc5, 0xfb, 0x9d, 0x8e, 0x85, 0x60, 0xc7, 0x82, 0xe8, 0x28, 0x1d, 0x16, 0x28, 0x15, 0x95, 0x11,
+	0xa7, 0xca, 0xc3, 0x68, 0xa8, 0xba, 0x61, 0xaa, 0xbc, 0x37, 0x07, 0x92, 0xf6, 0xe8, 0x54, 0xa7,
+	0xfc, 0xa7, 0x62, 0xff, 0x12, 0xad, 0x07, 0x74, 0x4a, 0xf5, 0x1a, 0x51, 0xd4, 0x86, 0xa1, 0xa8,
+	0xa6, 0x49, 0x2d, 0x5e, 0xc2, 0xc4, 0xd7, 0x6c, 0x10, 0xdf, 0x45, 0xae, 0x52, 0xc3, 0xc5, 0x2c,
+	0xc4, 0xa9, 0x08, 0x31, 0x76, 0xfa, 0x1f, 0x8a, 0xeb, 0xaf, 0x13, 0x93, 0x30, 0x43, 0x0c, 0x2d,
+	0xef, 0x01, 0x7c, 0xd5, 0x16, 0x74, 0x45, 0x6d, 0xaa, 0x75, 0x56, 0x22, 0x77, 0x5b, 0x84, 0x59,
+	0xf2, 0x4d, 0xd8, 0x1d, 0x6a, 0x65, 0x0d, 0x6a, 0x32, 0x82, 0x67, 0x60, 0x4b, 0x83, 0xb7, 0x64,
+	0xd0, 0x18, 0xca, 0x6f, 0x3f, 0x36, 0x5e, 0x88, 0x71, 0xad, 0xe0, 0x14, 0x17, 0x47, 0x9e, 0x3c,
+	0xcb, 0xa5, 0x4a, 0xa2, 0x50, 0x9e, 0x87, 0x09, 0x8e, 0x3c, 0xaf, 0xd6, 0x0c, 0x4d, 0xb5, 0x68,
+	0xf3, 0x72, 0xcb, 0x62, 0x96, 0x6a, 0x6a, 0x86, 0xa9, 0x97, 0xc8, 0xa2, 0xda, 0xd4, 0x5c, 0x12,
+	0x78, 0x12, 0x76, 0xdd, 0x73, 0x7b, 0x95, 0x55, 0x4d, 0x6b, 0x12, 0xe6, 0x0c, 0xbc, 0xad, 0x34,
+	0xea, 0x7d, 0x98, 0x71, 0xda, 0xe5, 0xcf, 0x10, 0xe4, 0x7b, 0x03, 0x0b, 0x1d, 0x37, 0x61, 0x6b,
+	0xd3, 0x69, 0x12, 0x42, 0x4e, 0xc4, 0x0a, 0x89, 0x81, 0x14, 0xea, 0x5c, 0x38, 0xf9, 0x12, 0xe4,
+	0xc2, 0x2c, 0xce, 0xd0, 0x7a, 0xdd, 0x60, 0xcc, 0xa0, 0xe6, 0x40, 0xb2, 0x3e, 0x47, 0x30, 0xd6,
+	0x1d, 0x50, 0xc8, 0x51, 0x01, 0xaa, 0x5e, 0xab, 0x50, 0x74, 0x2a, 0x99, 0xa2, 0x99, 0x6a, 0xb5,
+	0x55, 0x6f, 0xd5, 0x54, 0x8b, 0x68, 0x3e, 0xb0, 0x10, 0x15, 0x00, 0x95, 0xff, 0x46, 0x70, 0x20,
+	0xcc, 0xe3, 0x5a, 0x4d, 0x65, 0x0b, 0x64, 0xa0, 0xc9, 0xc2, 0x13, 0xb0, 0x93, 0x59, 0x6a, 0xd3,
+	0x32, 0x4c, 0xbd, 0xbc, 0x40, 0x0c, 0x7d, 0xc1, 0xca, 0xa4, 0xc7, 0x50, 0x7e, 0xa4, 0xf4, 0x82,
+	0xdb, 0x7c, 0x8e, 0xb7, 0xe2, 0x71, 0xd8, 0x41, 0x4c, 0x2d, 0xd0, 0x6d, 0x13, 0xef, 0xf6, 0x7f,
+	0xa7, 0x51, 0x74, 0x9a, 0x03, 0xf0, 0x77, 0x61, 0x66, 0x84, 0xcb, 0x7f, 0xd5, 0x95, 0x6f, 0x6f,
+	0xa9, 0x82, 0xb3, 0xd1, 0xfd, 0x75, 0xa9, 0x13, 0x41, 0xbb, 0x14, 0xa8, 0x3c, 0xf9, 0xbf, 0x2f,
+	0x57, 0x72, 0xa9, 0xc7, 0x2b, 0x39, 0x24, 0xff, 0x84, 0xe0, 0xa5, 0x2e, 0x6a, 0x85, 0xe5, 0x57,
+	0x60, 0x2b, 0x73, 0x9a, 0x32, 0x68, 0x6c, 0x53, 0x7e, 0xfb, 0xb1, 0xa9, 0x64, 0x7e, 0x73, 0x9c,
+	0xb3, 0xf7, 0x88, 0x69, 0xb9, 0x2b, 0x47, 0xc0, 0xe0, 0xf7, 0x42, 0x2a, 0xd2, 0x5c, 0xc5, 0x44,
+	0x4f, 0x15, 0x0e, 0x9d, 0xa0, 0x0c, 0x79, 0xd9, 0x25, 0x3f, 0x4b, 0x6a, 0x44, 0xe7, 0x6d, 0x9d,
+	0x1b, 0x4b, 0x73, 0xbe, 0x75, 0xce, 0x95, 0xf7, 0xc1, 0x9d, 0xab, 0xc8, 0x89, 0x4d, 0x47, 0x4f,
+	0xac, 0x63, 0xe1, 0xf3, 0x95, 0x5c, 0x4a, 0xfe, 0x0a, 0x41, 0xb6, 0x1b, 0x0b, 0xe1, 0xe1, 0x9d,
+	0xe0, 0x2e, 0xb4, 0x3d, 0x3c, 0x10, 0x92, 0xeb, 0x0a, 0x9d, 0x25, 0xd5, 0x33, 0xd4, 0x30, 0x8b,
+	0xd3, 0xb6, 0x5f, 0x3f, 0xfc, 0x96, 0x9b, 0xd4, 0x0d, 0x6b, 0xa1, 0x55, 0x29, 0x54, 0x69, 0x5d,
+	0x11, 0xe7, 0x9c, 0xf3, 0xe7, 0x08, 0xd3, 0xee, 0x28, 0xd6, 0x52, 0x83, 0x30, 0xb7, 0x86, 0xf9,
+	0x1b, 0xf3, 0x43, 0x90, 0xdb, 0xe8, 0x5c, 0xa7, 0x96, 0x5a, 0x1b, 0xc2, 0x99, 0x80, 0xd8, 0x3f,
+	0x11, 0x8c, 0xc7, 0xa2, 0x0b, 0xc5, 0xf3, 0xed, 0x8a, 0x8f, 0xc7, 0xae, 0x1a, 0x1f, 0x6d, 0xd6,
+	0x1d, 0xdb, 0x41, 0x6c, 0x3b, 0x75, 0xb0, 0x0e, 0x9b, 0x2d, 0x7b, 0xbc, 0x4c, 0x7a, 0xa3, 0x7c,
+	0x74, 0xf0, 0xe5, 0x8f, 0xe0, 0x95, 0xa0, 0x4e, 0x9b, 0x8f, 0x5a, 0x33, 0xee, 0x13, 0x6d, 0x7d,
+	0x7c, 0xfc, 0x0b, 0xc1, 0xc1, 0x1e, 0xf8, 0xc2, 0xc9, 0x8f, 0xdb, 0x9d, 0x7c, 0xbb, 0x7f, 0x27,
+	0x83, 0xc8, 0xed, 0x8e, 0xaa, 0x61, 0x47, 0xf7, 0x45, 0x3a, 0xca, 0xed, 0x9c, 0x12, 0x76, 0xe6,
+	0x13, 0xd8, 0x19, 0xf2, 0xf2, 0xa6, 0xb8, 0x2a, 0x3c, 0x46, 0xde, 0x21, 0x31, 0xac, 0x8d, 0x17,
+	0x61, 0xac, 0x3b, 0xb2, 0x30, 0x30, 0x0b, 0xe0, 0xed, 0x5e, 0xc7, 0xc3, 0x6d, 0xa5, 0x40, 0x4b,
+	0x00, 0xad, 0x63, 0xce, 0x6f, 0x18, 0xd6, 0x82, 0xd6, 0x54, 0x17, 0xc5, 0xc0, 0x43, 0x92, 0xbd,
+	0x05, 0x07, 0x7b, 0xc0, 0x0b, 0xc6, 0x87, 0x60, 0x74, 0x51, 0x7c, 0x6a, 0x83, 0xdf, 0xb9, 0x18,
+	0x2e, 0x09, 0xa0, 0xef, 0x87, 0x7d, 0x1c, 0xdd, 0xbe, 0xdc, 0x5a, 0xa6, 0x61, 0x2d, 0x5d, 0xa1,
+	0xb4, 0xe6, 0xa6, 0x9c, 0x65, 0x04, 0x52, 0xd4, 0x57, 0x31, 0x20, 0x81, 0x91, 0x06, 0xa5, 0xb5,
+	0x8d, 0x3b, 0x9c, 0x38, 0xbc, 0x9c, 0x81, 0xbd, 0x9c, 0x44, 0xb1, 0xd5, 0x34, 0x89, 0x36, 0x47,
+	0xbc, 0x3b, 0x55, 0xfe, 0x02, 0xc1, 0x8b, 0x1d, 0x9f, 0x04, 0xb9, 0x1a, 0x6c, 0xaf, 0xf0, 0xd6,
+	0xf2, 0x6d, 0xe2, 0x5d, 0x42, 0xeb, 0xba, 0x4c, 0xa1, 0xe2, 0x8d, 0x2a, 0x63, 0x18, 0x75, 0x26,
+	0xa9, 0xc5, 0x2c, 0x97, 0xdd, 0x7d, 0xd8, 0x15, 0x68, 0xf3, 0x3d, 0xd3, 0x5a, 0xcc, 0xda, 0x40,
+	0xcf, 0x6c, 0x78, 0xf9, 0x5b, 0x04, 0x93, 0xe1, 0x0b, 0xda, 0x5b, 0x3e, 0xd7, 0x44, 0x82, 0x38,
+	0x6f, 0xde, 0xa6, 0x83, 0xa5, 0x93, 0xb9, 0x88, 0x9b, 0x78, 0x80, 0x3c, 0x21, 0x3f, 0x43, 0xf0,
+	0x5a, 0x32, 0x92, 0x9e, 0x79, 0x5e, 0xfe, 0x29, 0x1b, 0xf6, 0x17, 0x61, 0xe3, 0x89, 0x24, 0x67,
+	0x5b, 0x18, 0xb4, 0x44, 0xaa, 0xd4, 0x3b, 0xd5, 0x76, 0xb0, 0xe0, 0x70, 0xeb, 0x96, 0x34, 0x8e,
+	0x7d, 0xb7, 0x17, 0x36, 0x73, 0x81, 0xf8, 0x31, 0x82, 0x2d, 0x4e, 0xdc, 0xc7, 0x4a, 0x2c, 0xd9,
+	0xce, 0xb7, 0x86, 0x34, 0x95, 0xbc, 0xc0, 0xe1, 0x20, 0x4f, 0x7e, 0xfa, 0xf3, 0x1f, 0x5f, 0xa7,
+	0x0f, 0xe2, 0x71, 0x25, 0xee, 0x9d, 0xe3, 0x3c, 0x38, 0xf0, 0x72, 0x1a, 0xf6, 0xc7, 0x04, 0x78,
+	0x3c, 0xdb, 0x7b, 0xf8, 0xde, 0x6f, 0x15, 0xe9, 0xec, 0x90, 0x28, 0x42, 0xd9, 0x0d, 0xae, 0xec,
+	0x2a, 0xbe, 0x1c, 0xab, 0xcc, 0x3f, 0xa6, 0x95, 0x07, 0x1d, 0xcb, 0xfa, 0xa1, 0x42, 0x7d, 0xfc,
+	0xb2, 0x7b, 0x9f, 0xad, 0x22, 0xd8, 0x1d, 0xf1, 0x84, 0xc0, 0x6f, 0xf5, 0xc1, 0xbb, 0xe3, 0x29,
+	0x23, 0x9d, 0x1e, 0xb0, 0x5a, 0xa8, 0xbd, 0xc4, 0xd5, 0x9e, 0xc3, 0x73, 0xc3, 0xa8, 0xf5, 0x1f,
+	0x29, 0xf8, 0x17, 0x04, 0xa3, 0xed, 0x89, 0x1d, 0xbf, 0xd9, 0x07, 0xc7, 0xf0, 0x9b, 0x46, 0x3a,
+	0x39, 0x48, 0xa9, 0xd0, 0x76, 0x81, 0x6b, 0x3b, 0x8b, 0xcf, 0x0c, 0xa3, 0xcd, 0x7d, 0x1b, 0xfc,
+	0x83, 0x60, 0x57, 0x47, 0x8e, 0xc6, 0x09, 0xe8, 0x75, 0x7b, 0x02, 0x48, 0xa7, 0x06, 0xaa, 0x15,
+	0xda, 0xca, 0x5c, 0xdb, 0xfb, 0xf8, 0x46, 0xac, 0x36, 0xef, 0xce, 0x67, 0xca, 0x83, 0x8e, 0x60,
+	0xf0, 0x50, 0x11, 0x2b, 0x33, 0x4a, 0x37, 0x7e, 0x8e, 0x60, 0x6f, 0x74, 0x94, 0xc6, 0xef, 0xf4,
+	0x43, 0x3c, 0x22, 0xe2, 0x4b, 0xef, 0x0e, 0x0e, 0xd0, 0xd7, 0xd4, 0x26, 0x93, 0x8f, 0xff, 0x45,
+	0x90, 0xe9, 0x96, 0x76, 0xf1, 0x4c, 0x62, 0xae, 0xdd, 0x92, 0xb8, 0x54, 0x1c, 0x06, 0x42, 0x08,
+	0xbe, 0xce, 0x05, 0x5f, 0xc2, 0x17, 0x87, 0x13, 0xec, 0x80, 0x87, 0x8e, 0xa4, 0x88, 0x84, 0x9a,
+	0xe4, 0x48, 0xea, 0x1e, 0x99, 0xa5, 0xd3, 0x03, 0x56, 0xf7, 0x75, 0x24, 0xf5, 0x90, 0xea, 0xef,
+	0xea, 0xf0, 0xf4, 0xb6, 0x25, 0xdb, 0xbe, 0xa6, 0x37, 0x3a, 0x74, 0x4b, 0xc5, 0x61, 0x20, 0xd6,
+	0x73, 0x7a, 0xdb, 0xa3, 0x39, 0xfe, 0x11, 0xc1, 0x8e, 0x50, 0xae, 0xc6, 0xc7, 0x7b, 0x73, 0x8d,
+	0x8a, 0xe9, 0xd2, 0x1b, 0x7d, 0xd7, 0x09, 0x61, 0xd3, 0x5c, 0xd8, 0x11, 0x3c, 0x19, 0x2b, 0xac,
+	0xea, 0xd6, 0x96, 0xed, 0x38, 0x8e, 0xbf, 0x47, 0x00, 0x7e, 0xde, 0xc6, 0xd3, 0xbd, 0x07, 0xef,
+	0x08, 0xee, 0xd2, 0xeb, 0xfd, 0x15, 0x09, 0xba, 0x53, 0x9c, 0xee, 0x61, 0x9c, 0x8f, 0xa5, 0x1b,
+	0x48, 0xfd, 0xf8, 0x11, 0x82, 0x11, 0x3b, 0x7e, 0xe3, 0x23, 0x09, 0x96, 0x81, 0x1f, 0xdd, 0xa5,
+	0x42, 0xd2, 0xee, 0x82, 0xd9, 0x21, 0xce, 0x6c, 0x1c, 0xbf, 0x1c, 0xbf, 0x42, 0x6c, 0x26, 0xdf,
+	0xa4, 0x21, 0xd7, 0x23, 0xef, 0xe2, 0x73, 0x7d, 0xdc, 0xab, 0xb1, 0xb9, 0x5e, 0x3a, 0xbf, 0x0e,
+	0x48, 0x42, 0xe3, 0x2d, 0xae, 0x71, 0x1e, 0x5f, 0x1f, 0xe6, 0xc2, 0xf6, 0x37, 0x46, 0x38, 0xc8,
+	0x17, 0x2f, 0x3c, 0x59, 0xcd, 0xa2, 0xa7, 0xab, 0x59, 0xf4, 0xfb, 0x6a, 0x16, 0x3d, 0x5a, 0xcb,
+	0xa6, 0x9e, 0xae, 0x65, 0x53, 0xbf, 0xae, 0x65, 0x53, 0x1f, 0x1c, 0x8d, 0x7d, 0xfd, 0x7c, 0x12,
+	0xa6, 0xc1, 0x1f, 0x43, 0x95, 0x2d, 0xfc, 0x5f, 0xf7, 0xd3, 0xff, 0x0d, 0x00, 0xab, 0x68, 0x4e,
+	0xbb, 0xdd, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -961,12 +1373,23 @@ type QueryClient interface {
 	// DelegationTotalRewards queries the total rewards accrued by a each
 	// validator.
 	DelegationTotalRewards(ctx context.Context, in *QueryDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationTotalRewardsResponse, error)
+	// DelegatorRealizedRewards queries the cumulative rewards withdrawn by a
+	// delegator from each validator.
+	DelegatorRealizedRewards(ctx context.Context, in *QueryDelegatorRealizedRewardsRequest, opts ...grpc.CallOption) (*QueryDelegatorRealizedRewardsResponse, error)
 	// DelegatorValidators queries the validators of a delegator.
 	DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// BurnedFees queries the cumulative amount of collected fees burned.
+	BurnedFees(ctx context.Context, in *QueryBurnedFeesRequest, opts ...grpc.CallOption) (*QueryBurnedFeesResponse, error)
+	// Dust queries the truncation dust accumulated since the last sweep.
+	Dust(ctx context.Context, in *QueryDustRequest, opts ...grpc.CallOption) (*QueryDustResponse, error)
+	// ValidatorDelegatorStartingInfos queries the starting infos of the
+	// delegations to a validator, i.e. the points from which their rewards
+	// accrue.
+	ValidatorDelegatorStartingInfos(ctx context.Context, in *QueryValidatorDelegatorStartingInfosRequest, opts ...grpc.CallOption) (*QueryValidatorDelegatorStartingInfosResponse, error)
 }
 
 type queryClient struct {
@@ -1031,6 +1454,15 @@ func (c *queryClient) DelegationTotalRewards(ctx context.Context, in *QueryDeleg
 	return out, nil
 }
 
+func (c *queryClient) DelegatorRealizedRewards(ctx context.Context, in *QueryDelegatorRealizedRewardsRequest, opts ...grpc.CallOption) (*QueryDelegatorRealizedRewardsResponse, error) {
+	out := new(QueryDelegatorRealizedRewardsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegatorRealizedRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error) {
 	out := new(QueryDelegatorValidatorsResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegatorValidators", in, out, opts...)
@@ -1058,27 +1490,65 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries params of the distribution module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ValidatorOutstandingRewards queries rewards of a validator address.
-	ValidatorOutstandingRewards(context.Context, *QueryValidatorOutstandingRewardsRequest) (*QueryValidatorOutstandingRewardsResponse, error)
-	// ValidatorCommission queries accumulated commission for a validator.
-	ValidatorCommission(context.Context, *QueryValidatorCommissionRequest) (*QueryValidatorCommissionResponse, error)
-	// ValidatorSlashes queries slash events of a validator.
-	ValidatorSlashes(context.Context, *QueryValidatorSlashesRequest) (*QueryValidatorSlashesResponse, error)
-	// DelegationRewards queries the total rewards accrued by a delegation.
+func (c *queryClient) BurnedFees(ctx context.Context, in *QueryBurnedFeesRequest, opts ...grpc.CallOption) (*QueryBurnedFeesResponse, error) {
+	out := new(QueryBurnedFeesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/BurnedFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Dust(ctx context.Context, in *QueryDustRequest, opts ...grpc.CallOption) (*QueryDustResponse, error) {
+	out := new(QueryDustResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/Dust", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorDelegatorStartingInfos(ctx context.Context, in *QueryValidatorDelegatorStartingInfosRequest, opts ...grpc.CallOption) (*QueryValidatorDelegatorStartingInfosResponse, error) {
+	out := new(QueryValidatorDelegatorStartingInfosResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/ValidatorDelegatorStartingInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries params of the distribution module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ValidatorOutstandingRewards queries rewards of a validator address.
+	ValidatorOutstandingRewards(context.Context, *QueryValidatorOutstandingRewardsRequest) (*QueryValidatorOutstandingRewardsResponse, error)
+	// ValidatorCommission queries accumulated commission for a validator.
+	ValidatorCommission(context.Context, *QueryValidatorCommissionRequest) (*QueryValidatorCommissionResponse, error)
+	// ValidatorSlashes queries slash events of a validator.
+	ValidatorSlashes(context.Context, *QueryValidatorSlashesRequest) (*QueryValidatorSlashesResponse, error)
+	// DelegationRewards queries the total rewards accrued by a delegation.
 	DelegationRewards(context.Context, *QueryDelegationRewardsRequest) (*QueryDelegationRewardsResponse, error)
 	// DelegationTotalRewards queries the total rewards accrued by a each
 	// validator.
 	DelegationTotalRewards(context.Context, *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error)
+	// DelegatorRealizedRewards queries the cumulative rewards withdrawn by a
+	// delegator from each validator.
+	DelegatorRealizedRewards(context.Context, *QueryDelegatorRealizedRewardsRequest) (*QueryDelegatorRealizedRewardsResponse, error)
 	// DelegatorValidators queries the validators of a delegator.
 	DelegatorValidators(context.Context, *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// BurnedFees queries the cumulative amount of collected fees burned.
+	BurnedFees(context.Context, *QueryBurnedFeesRequest) (*QueryBurnedFeesResponse, error)
+	// Dust queries the truncation dust accumulated since the last sweep.
+	Dust(context.Context, *QueryDustRequest) (*QueryDustResponse, error)
+	// ValidatorDelegatorStartingInfos queries the starting infos of the
+	// delegations to a validator, i.e. the points from which their rewards
+	// accrue.
+	ValidatorDelegatorStartingInfos(context.Context, *QueryValidatorDelegatorStartingInfosRequest) (*QueryValidatorDelegatorStartingInfosResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1103,6 +1573,9 @@ func (*UnimplementedQueryServer) DelegationRewards(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) DelegationTotalRewards(ctx context.Context, req *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegationTotalRewards not implemented")
 }
+func (*UnimplementedQueryServer) DelegatorRealizedRewards(ctx context.Context, req *QueryDelegatorRealizedRewardsRequest) (*QueryDelegatorRealizedRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorRealizedRewards not implemented")
+}
 func (*UnimplementedQueryServer) DelegatorValidators(ctx context.Context, req *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorValidators not implemented")
 }
@@ -1112,6 +1585,15 @@ func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, r
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (*UnimplementedQueryServer) BurnedFees(ctx context.Context, req *QueryBurnedFeesRequest) (*QueryBurnedFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnedFees not implemented")
+}
+func (*UnimplementedQueryServer) Dust(ctx context.Context, req *QueryDustRequest) (*QueryDustResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Dust not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorDelegatorStartingInfos(ctx context.Context, req *QueryValidatorDelegatorStartingInfosRequest) (*QueryValidatorDelegatorStartingInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorDelegatorStartingInfos not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1225,6 +1707,24 @@ func _Query_DelegationTotalRewards_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorRealizedRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorRealizedRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorRealizedRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegatorRealizedRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorRealizedRewards(ctx, req.(*QueryDelegatorRealizedRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_DelegatorValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryDelegatorValidatorsRequest)
 	if err := dec(in); err != nil {
@@ -1279,6 +1779,60 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BurnedFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnedFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnedFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/BurnedFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnedFees(ctx, req.(*QueryBurnedFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Dust_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDustRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Dust(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/Dust",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Dust(ctx, req.(*QueryDustRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorDelegatorStartingInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorDelegatorStartingInfosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorDelegatorStartingInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/ValidatorDelegatorStartingInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorDelegatorStartingInfos(ctx, req.(*QueryValidatorDelegatorStartingInfosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1307,6 +1861,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegationTotalRewards",
 			Handler:    _Query_DelegationTotalRewards_Handler,
 		},
+		{
+			MethodName: "DelegatorRealizedRewards",
+			Handler:    _Query_DelegatorRealizedRewards_Handler,
+		},
 		{
 			MethodName: "DelegatorValidators",
 			Handler:    _Query_DelegatorValidators_Handler,
@@ -1319,6 +1877,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "BurnedFees",
+			Handler:    _Query_BurnedFees_Handler,
+		},
+		{
+			MethodName: "Dust",
+			Handler:    _Query_Dust_Handler,
+		},
+		{
+			MethodName: "ValidatorDelegatorStartingInfos",
+			Handler:    _Query_ValidatorDelegatorStartingInfos_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
@@ -1762,6 +2332,87 @@ func (m *QueryDelegationTotalRewardsResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDelegatorRealizedRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorRealizedRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorRealizedRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorRealizedRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorRealizedRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorRealizedRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryDelegatorValidatorsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1944,59 +2595,270 @@ func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryBurnedFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryBurnedFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBurnedFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorOutstandingRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBurnedFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.BurnedFees) > 0 {
+		for iNdEx := len(m.BurnedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorOutstandingRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDustRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDustRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDustRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Rewards.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDustResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDustResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDustResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Dust) > 0 {
+		for iNdEx := len(m.Dust) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dust[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorDelegatorStartingInfosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorDelegatorStartingInfosRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorDelegatorStartingInfosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorDelegatorStartingInfosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorDelegatorStartingInfosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorDelegatorStartingInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StartingInfos) > 0 {
+		for iNdEx := len(m.StartingInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StartingInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorOutstandingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorOutstandingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
 }
 
 func (m *QueryValidatorCommissionRequest) Size() (n int) {
@@ -2131,6 +2993,40 @@ func (m *QueryDelegationTotalRewardsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDelegatorRealizedRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorRealizedRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryDelegatorValidatorsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2209,13 +3105,560 @@ func (m *QueryCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryBurnedFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryBurnedFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BurnedFees) > 0 {
+		for _, e := range m.BurnedFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDustRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDustResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Dust) > 0 {
+		for _, e := range m.Dust {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorDelegatorStartingInfosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorDelegatorStartingInfosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StartingInfos) > 0 {
+		for _, e := range m.StartingInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorCommissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorCommissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorCommissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorCommissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2238,12 +3681,118 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorSlashesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorSlashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingHeight", wireType)
+			}
+			m.StartingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartingHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndingHeight", wireType)
+			}
+			m.EndingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndingHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2265,7 +3814,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2288,15 +3837,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorSlashesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorSlashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2323,7 +3872,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Slashes = append(m.Slashes, ValidatorSlashEvent{})
+			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2348,7 +3934,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2371,13 +3957,45 @@ func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
@@ -2430,7 +4048,7 @@ func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2453,10 +4071,10 @@ func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2488,7 +4106,8 @@ func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2513,7 +4132,7 @@ func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryValidatorCommissionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2536,15 +4155,15 @@ func (m *QueryValidatorCommissionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorCommissionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationTotalRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorCommissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationTotalRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2572,7 +4191,7 @@ func (m *QueryValidatorCommissionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2595,7 +4214,7 @@ func (m *QueryValidatorCommissionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2614,19 +4233,53 @@ func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorCommissionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationTotalRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationTotalRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, DelegationDelegatorReward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2653,7 +4306,8 @@ func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Total = append(m.Total, types.DecCoin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2678,7 +4332,7 @@ func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorRealizedRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2701,15 +4355,15 @@ func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorSlashesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorRealizedRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorSlashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorRealizedRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2737,81 +4391,7 @@ func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingHeight", wireType)
-			}
-			m.StartingHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartingHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndingHeight", wireType)
-			}
-			m.EndingHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndingHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2834,7 +4414,7 @@ func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorRealizedRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2857,15 +4437,15 @@ func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorSlashesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorRealizedRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorSlashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorRealizedRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2892,14 +4472,14 @@ func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Slashes = append(m.Slashes, ValidatorSlashEvent{})
-			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, DelegationDelegatorRealizedReward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2926,10 +4506,8 @@ func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2954,7 +4532,7 @@ func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorValidatorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2977,10 +4555,10 @@ func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorValidatorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3015,9 +4593,59 @@ func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3045,7 +4673,7 @@ func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3068,7 +4696,7 @@ func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3091,17 +4719,17 @@ func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3111,25 +4739,23 @@ func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types.DecCoin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3152,7 +4778,7 @@ func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3175,15 +4801,15 @@ func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationTotalRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationTotalRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3211,7 +4837,7 @@ func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3234,7 +4860,7 @@ func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3257,49 +4883,65 @@ func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationTotalRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCommunityPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationTotalRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCommunityPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, DelegationDelegatorReward{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommunityPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3326,8 +4968,8 @@ func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Total = append(m.Total, types.DecCoin{})
-			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pool = append(m.Pool, types.DecCoin{})
+			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3352,7 +4994,7 @@ func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnedFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3375,44 +5017,12 @@ func (m *QueryDelegatorValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnedFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnedFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3434,7 +5044,7 @@ func (m *QueryDelegatorValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnedFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3457,17 +5067,17 @@ func (m *QueryDelegatorValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnedFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnedFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedFees", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3477,23 +5087,25 @@ func (m *QueryDelegatorValidatorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			m.BurnedFees = append(m.BurnedFees, types.Coin{})
+			if err := m.BurnedFees[len(m.BurnedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3516,7 +5128,7 @@ func (m *QueryDelegatorValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDustRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3539,44 +5151,12 @@ func (m *QueryDelegatorWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDustRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDustRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3598,7 +5178,7 @@ func (m *QueryDelegatorWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDustResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3621,17 +5201,17 @@ func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDustResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDustResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3641,23 +5221,25 @@ func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			m.Dust = append(m.Dust, types.DecCoin{})
+			if err := m.Dust[len(m.Dust)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3680,7 +5262,7 @@ func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorDelegatorStartingInfosRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3703,12 +5285,80 @@ func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCommunityPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorDelegatorStartingInfosRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCommunityPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorDelegatorStartingInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3730,7 +5380,7 @@ func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorDelegatorStartingInfosResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3753,15 +5403,15 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCommunityPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorDelegatorStartingInfosResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorDelegatorStartingInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingInfos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3788,8 +5438,44 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pool = append(m.Pool, types.DecCoin{})
-			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.StartingInfos = append(m.StartingInfos, DelegatorStartingInfoRecord{})
+			if err := m.StartingInfos[len(m.StartingInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type (
+	// decodedEvidence is the human readable form of submitted evidence. Only
+	// equivocation evidence is decoded, other evidence types only report their
+	// hash and type.
+	decodedEvidence struct {
+		Hash         string               `json:"hash" yaml:"hash"`
+		Type         string               `json:"type" yaml:"type"`
+		Equivocation *decodedEquivocation `json:"equivocation,omitempty" yaml:"equivocation,omitempty"`
+	}
+
+	// decodedEquivocation describes an equivocation along with the validator
+	// which committed it and the estimated slash of its current delegations.
+	decodedEquivocation struct {
+		Height             int64                `json:"height" yaml:"height"`
+		DistributionHeight int64                `json:"distribution_height" yaml:"distribution_height"`
+		Time               time.Time            `json:"time" yaml:"time"`
+		Power              int64                `json:"power" yaml:"power"`
+		ConsensusAddress   string               `json:"consensus_address" yaml:"consensus_address"`
+		Validator          *evidenceValidator   `json:"validator,omitempty" yaml:"validator,omitempty"`
+		SlashFraction      sdk.Dec              `json:"slash_fraction" yaml:"slash_fraction"`
+		Delegations        []affectedDelegation `json:"delegations" yaml:"delegations"`
+	}
+
+	evidenceValidator struct {
+		OperatorAddress string  `json:"operator_address" yaml:"operator_address"`
+		Moniker         string  `json:"moniker" yaml:"moniker"`
+		Status          string  `json:"status" yaml:"status"`
+		Jailed          bool    `json:"jailed" yaml:"jailed"`
+		Tombstoned      bool    `json:"tombstoned" yaml:"tombstoned"`
+		Tokens          sdk.Int `json:"tokens" yaml:"tokens"`
+	}
+
+	// affectedDelegation is a current delegation to the validator along with
+	// the amount an equivocation slash would burn from it.
+	affectedDelegation struct {
+		DelegatorAddress string   `json:"delegator_address" yaml:"delegator_address"`
+		Balance          sdk.Coin `json:"balance" yaml:"balance"`
+		Slashed          sdk.Int  `json:"slashed" yaml:"slashed"`
+	}
+
+	decodedEvidenceList struct {
+		Evidence   []decodedEvidence   `json:"evidence" yaml:"evidence"`
+		Pagination *query.PageResponse `json:"pagination" yaml:"pagination"`
+	}
+)
+
+// evidenceTypeMatches returns true if the evidence type URL, or its message
+// name, case-insensitively matches the filter, e.g. "equivocation".
+func evidenceTypeMatches(typeURL, filter string) bool {
+	name := typeURL[strings.LastIndex(typeURL, ".")+1:]
+	return strings.EqualFold(typeURL, filter) || strings.EqualFold(name, filter)
+}
+
+// decodeEvidence decodes the evidence, querying the validator, delegations and
+// slashing parameters of equivocations.
+func decodeEvidence(ctx context.Context, clientCtx client.Context, any *codectypes.Any) (decodedEvidence, error) {
+	var evi exported.Evidence
+	if err := clientCtx.InterfaceRegistry.UnpackAny(any, &evi); err != nil {
+		return decodedEvidence{}, err
+	}
+
+	decoded := decodedEvidence{Hash: evi.Hash().String(), Type: any.TypeUrl}
+
+	equivocation, ok := evi.(*types.Equivocation)
+	if !ok {
+		return decoded, nil
+	}
+
+	slashingParams, err := slashingtypes.NewQueryClient(clientCtx).Params(ctx, &slashingtypes.QueryParamsRequest{})
+	if err != nil {
+		return decodedEvidence{}, err
+	}
+
+	consAddr := equivocation.GetConsensusAddress()
+	decoded.Equivocation = &decodedEquivocation{
+		Height:             equivocation.Height,
+		DistributionHeight: equivocation.Height - sdk.ValidatorUpdateDelay,
+		Time:               equivocation.Time,
+		Power:              equivocation.Power,
+		ConsensusAddress:   equivocation.ConsensusAddress,
+		SlashFraction:      slashingParams.Params.SlashFractionDoubleSign,
+		Delegations:        []affectedDelegation{},
+	}
+
+	validator, found, err := queryValidatorByConsAddr(ctx, clientCtx, consAddr)
+	if err != nil || !found {
+		return decoded, err
+	}
+
+	signingInfo, err := slashingtypes.NewQueryClient(clientCtx).SigningInfo(ctx, &slashingtypes.QuerySigningInfoRequest{
+		ConsAddress: consAddr.String(),
+	})
+	tombstoned := err == nil && signingInfo.ValSigningInfo.Tombstoned
+
+	decoded.Equivocation.Validator = &evidenceValidator{
+		OperatorAddress: validator.OperatorAddress,
+		Moniker:         validator.GetMoniker(),
+		Status:          validator.Status.String(),
+		Jailed:          validator.Jailed,
+		Tombstoned:      tombstoned,
+		Tokens:          validator.Tokens,
+	}
+
+	stakingClient := stakingtypes.NewQueryClient(clientCtx)
+	pageReq := &query.PageRequest{}
+	for {
+		res, err := stakingClient.ValidatorDelegations(ctx, &stakingtypes.QueryValidatorDelegationsRequest{
+			ValidatorAddr: validator.OperatorAddress,
+			Pagination:    pageReq,
+		})
+		if err != nil {
+			return decodedEvidence{}, err
+		}
+
+		for _, del := range res.DelegationResponses {
+			decoded.Equivocation.Delegations = append(decoded.Equivocation.Delegations, affectedDelegation{
+				DelegatorAddress: del.Delegation.DelegatorAddress,
+				Balance:          del.Balance,
+				Slashed:          decoded.Equivocation.SlashFraction.MulInt(del.Balance.Amount).TruncateInt(),
+			})
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return decoded, nil
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+}
+
+// queryValidatorByConsAddr pages through all the validators to find the one
+// with the given consensus address.
+func queryValidatorByConsAddr(ctx context.Context, clientCtx client.Context, consAddr sdk.ConsAddress) (stakingtypes.Validator, bool, error) {
+	stakingClient := stakingtypes.NewQueryClient(clientCtx)
+	pageReq := &query.PageRequest{}
+	for {
+		res, err := stakingClient.Validators(ctx, &stakingtypes.QueryValidatorsRequest{Pagination: pageReq})
+		if err != nil {
+			return stakingtypes.Validator{}, false, err
+		}
+
+		for _, validator := range res.Validators {
+			addr, err := validator.GetConsAddr()
+			if err == nil && addr.Equals(consAddr) {
+				return validator, true, nil
+			}
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return stakingtypes.Validator{}, false, nil
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+}
@@ -15,6 +15,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 )
 
+const (
+	flagDecode = "decode"
+	flagType   = "type"
+)
+
 // GetQueryCmd returns the CLI command with all evidence module query commands
 // mounted.
 func GetQueryCmd() *cobra.Command {
@@ -26,19 +31,28 @@ func GetQueryCmd() *cobra.Command {
 
 Example:
 $ %s query %s DF0C23E8634E480F84B9D5674A7CDC9816466DEC28A3358F73260F68D28D7660
+$ %s query %s DF0C23E8634E480F84B9D5674A7CDC9816466DEC28A3358F73260F68D28D7660 --decode
 $ %s query %s --page=2 --limit=50
+$ %s query %s --type=equivocation --decode
+
+The --decode flag prints equivocation evidence along with the validator that
+committed it and its current delegations, with the amount a double sign slash
+burns from each of them. The --type flag filters the listed evidence by type
+URL or message name, e.g. "equivocation" or "/cosmos.evidence.v1beta1.Equivocation".
 `,
 				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
 			),
 		),
 		Args:                       cobra.MaximumNArgs(1),
-		DisableFlagParsing:         true,
 		SuggestionsMinimumDistance: 2,
 		RunE:                       QueryEvidenceCmd(),
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "evidence")
+	cmd.Flags().Bool(flagDecode, false, "Decode equivocations along with the affected validator and delegations")
+	cmd.Flags().String(flagType, "", "Only list evidence of the given type URL or message name")
 
 	return cmd
 }
@@ -51,20 +65,28 @@ func QueryEvidenceCmd() func(*cobra.Command, []string) error {
 		if err != nil {
 			return err
 		}
+		decode, err := cmd.Flags().GetBool(flagDecode)
+		if err != nil {
+			return err
+		}
 		if len(args) > 0 {
-			return queryEvidence(clientCtx, args[0])
+			return queryEvidence(clientCtx, args[0], decode)
 		}
 
 		pageReq, err := client.ReadPageRequest(cmd.Flags())
 		if err != nil {
 			return err
 		}
+		evidenceType, err := cmd.Flags().GetString(flagType)
+		if err != nil {
+			return err
+		}
 
-		return queryAllEvidence(clientCtx, pageReq)
+		return queryAllEvidence(clientCtx, pageReq, evidenceType, decode)
 	}
 }
 
-func queryEvidence(clientCtx client.Context, hash string) error {
+func queryEvidence(clientCtx client.Context, hash string, decode bool) error {
 	decodedHash, err := hex.DecodeString(hash)
 	if err != nil {
 		return fmt.Errorf("invalid evidence hash: %w", err)
@@ -79,10 +101,19 @@ func queryEvidence(clientCtx client.Context, hash string) error {
 		return err
 	}
 
-	return clientCtx.PrintProto(res.Evidence)
+	if !decode {
+		return clientCtx.PrintProto(res.Evidence)
+	}
+
+	decoded, err := decodeEvidence(context.Background(), clientCtx, res.Evidence)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintObjectLegacy(decoded)
 }
 
-func queryAllEvidence(clientCtx client.Context, pageReq *query.PageRequest) error {
+func queryAllEvidence(clientCtx client.Context, pageReq *query.PageRequest, evidenceType string, decode bool) error {
 	queryClient := types.NewQueryClient(clientCtx)
 
 	params := &types.QueryAllEvidenceRequest{
@@ -94,5 +125,29 @@ func queryAllEvidence(clientCtx client.Context, pageReq *query.PageRequest) erro
 		return err
 	}
 
-	return clientCtx.PrintProto(res)
+	// the filter applies to the queried page only
+	if evidenceType != "" {
+		filtered := res.Evidence[:0]
+		for _, evi := range res.Evidence {
+			if evidenceTypeMatches(evi.TypeUrl, evidenceType) {
+				filtered = append(filtered, evi)
+			}
+		}
+		res.Evidence = filtered
+	}
+
+	if !decode {
+		return clientCtx.PrintProto(res)
+	}
+
+	decoded := decodedEvidenceList{Evidence: []decodedEvidence{}, Pagination: res.Pagination}
+	for _, evi := range res.Evidence {
+		d, err := decodeEvidence(context.Background(), clientCtx, evi)
+		if err != nil {
+			return err
+		}
+		decoded.Evidence = append(decoded.Evidence, d)
+	}
+
+	return clientCtx.PrintObjectLegacy(decoded)
 }
@@ -0,0 +1,94 @@
+// Code generated by typedgen. DO NOT EDIT.
+
+// Package grpcclient implements the typed clients of the evidence module.
+package grpcclient
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/typed"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+)
+
+// QueryClient is the typed client of the evidence Query service, retrying
+// the queries failing with a transient error.
+type QueryClient struct {
+	client types.QueryClient
+	retry  typed.RetryConfig
+}
+
+// NewQueryClient returns a QueryClient querying through the connection.
+func NewQueryClient(conn gogogrpc.ClientConn, retry typed.RetryConfig) *QueryClient {
+	return &QueryClient{
+		client: types.NewQueryClient(conn),
+		retry:  retry,
+	}
+}
+
+// AllEvidence calls the evidence Query/AllEvidence method.
+func (c *QueryClient) AllEvidence(ctx context.Context, req *types.QueryAllEvidenceRequest, opts ...grpc.CallOption) (*types.QueryAllEvidenceResponse, error) {
+	var res *types.QueryAllEvidenceResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.AllEvidence(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateAllEvidence calls the evidence Query/AllEvidence method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateAllEvidence(ctx context.Context, req *types.QueryAllEvidenceRequest, fn func(*types.QueryAllEvidenceResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq types.QueryAllEvidenceRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.AllEvidence(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// Evidence calls the evidence Query/Evidence method.
+func (c *QueryClient) Evidence(ctx context.Context, req *types.QueryEvidenceRequest, opts ...grpc.CallOption) (*types.QueryEvidenceResponse, error) {
+	var res *types.QueryEvidenceResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Evidence(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// MsgClient is the typed client of the evidence Msg service, broadcasting a
+// transaction of each message.
+type MsgClient struct {
+	broadcaster typed.Broadcaster
+}
+
+// NewMsgClient returns a MsgClient broadcasting the transactions with the
+// broadcaster.
+func NewMsgClient(broadcaster typed.Broadcaster) *MsgClient {
+	return &MsgClient{broadcaster: broadcaster}
+}
+
+// SubmitEvidence broadcasts a transaction of the evidence Msg/SubmitEvidence message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) SubmitEvidence(ctx context.Context, msg *types.MsgSubmitEvidence) (*types.MsgSubmitEvidenceResponse, *sdk.TxResponse, error) {
+	res := &types.MsgSubmitEvidenceResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
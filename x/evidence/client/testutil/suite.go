@@ -1,20 +1,27 @@
 package testutil
 
 import (
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 )
 
 type IntegrationTestSuite struct {
 	suite.Suite
 
-	cfg     network.Config
-	network *network.Network
+	cfg          network.Config
+	network      *network.Network
+	equivocation *types.Equivocation
 }
 
 func NewIntegrationTestSuite(cfg network.Config) *IntegrationTestSuite {
@@ -24,9 +31,20 @@ func NewIntegrationTestSuite(cfg network.Config) *IntegrationTestSuite {
 func (s *IntegrationTestSuite) SetupSuite() {
 	s.T().Log("setting up integration test suite")
 
+	// seed genesis with the equivocation of an unknown validator
+	s.equivocation = &types.Equivocation{
+		Height:           10,
+		Time:             time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		Power:            100,
+		ConsensusAddress: sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+	}
+	bz, err := s.cfg.Codec.MarshalJSON(types.NewGenesisState([]exported.Evidence{s.equivocation}))
+	s.Require().NoError(err)
+	s.cfg.GenesisState[types.ModuleName] = bz
+
 	s.network = network.New(s.T(), s.cfg)
 
-	_, err := s.network.WaitForHeight(1)
+	_, err = s.network.WaitForHeight(1)
 	s.Require().NoError(err)
 }
 
@@ -37,6 +55,7 @@ func (s *IntegrationTestSuite) TearDownSuite() {
 
 func (s *IntegrationTestSuite) TestGetQueryCmd() {
 	val := s.network.Validators[0]
+	hash := s.equivocation.Hash().String()
 
 	testCases := map[string]struct {
 		args           []string
@@ -48,9 +67,34 @@ func (s *IntegrationTestSuite) TestGetQueryCmd() {
 			"evidence DF0C23E8634E480F84B9D5674A7CDC9816466DEC28A3358F73260F68D28D7660 not found",
 			true,
 		},
+		"existing evidence": {
+			[]string{hash},
+			fmt.Sprintf("consensus_address: %s", s.equivocation.ConsensusAddress),
+			false,
+		},
+		"decoded evidence": {
+			[]string{hash, "--decode"},
+			fmt.Sprintf("equivocation:\n  consensus_address: %s\n  delegations: []\n  distribution_height: \"9\"", s.equivocation.ConsensusAddress),
+			false,
+		},
+		"decoded evidence slash fraction": {
+			[]string{hash, "--decode"},
+			"slash_fraction: \"0.050000000000000000\"",
+			false,
+		},
 		"all evidence (default pagination)": {
 			[]string{},
-			"evidence: []\npagination:\n  next_key: null\n  total: \"0\"",
+			fmt.Sprintf("evidence:\n- '@type': /cosmos.evidence.v1beta1.Equivocation\n  consensus_address: %s", s.equivocation.ConsensusAddress),
+			false,
+		},
+		"all evidence of matching type": {
+			[]string{"--type=equivocation", "--decode"},
+			fmt.Sprintf("hash: %s", hash),
+			false,
+		},
+		"all evidence of other type": {
+			[]string{"--type=/cosmos.evidence.v1beta1.Other"},
+			"evidence: []",
 			false,
 		},
 	}
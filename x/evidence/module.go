@@ -19,6 +19,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	eviclient "github.com/cosmos/cosmos-sdk/x/evidence/client"
 	"github.com/cosmos/cosmos-sdk/x/evidence/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/evidence/client/rest"
@@ -31,6 +32,8 @@ var (
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
+
+	_ module.BlockerOrderAppModule = AppModule{}
 )
 
 // ----------------------------------------------------------------------------
@@ -189,6 +192,14 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 	return []abci.ValidatorUpdate{}
 }
 
+// BlockerOrderConstraints implements module.BlockerOrderAppModule. The
+// evidence begin blocker slashes after the distribution one, like slashing.
+func (AppModule) BlockerOrderConstraints() module.BlockerOrderConstraints {
+	return module.BlockerOrderConstraints{
+		BeginBlockAfter: []string{distrtypes.ModuleName},
+	}
+}
+
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the evidence module.
@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/feeconversion/types"
+)
+
+// GetQueryCmd returns the cli query commands for the feeconversion module.
+func GetQueryCmd() *cobra.Command {
+	feeConversionQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the feeconversion module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feeConversionQueryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryConversionRate(),
+	)
+
+	return feeConversionQueryCmd
+}
+
+// GetCmdQueryParams implements a command to return the current feeconversion
+// parameters.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current feeconversion parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryConversionRate implements a command to return the conversion rate
+// of an accepted fee denom.
+func GetCmdQueryConversionRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conversion-rate [denom]",
+		Short: "Query the rate the fees paid in a denom are converted at",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the rate the fees paid in an accepted fee denom are converted to the fee
+denom at, from the rate oracle of the chain or the params.
+
+Example:
+$ %s query %s conversion-rate ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ConversionRate(cmd.Context(), &types.QueryConversionRateRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
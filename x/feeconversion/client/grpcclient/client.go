@@ -0,0 +1,51 @@
+// Code generated by typedgen. DO NOT EDIT.
+
+// Package grpcclient implements the typed clients of the feeconversion module.
+package grpcclient
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/typed"
+	"github.com/cosmos/cosmos-sdk/x/feeconversion/types"
+)
+
+// QueryClient is the typed client of the feeconversion Query service, retrying
+// the queries failing with a transient error.
+type QueryClient struct {
+	client types.QueryClient
+	retry  typed.RetryConfig
+}
+
+// NewQueryClient returns a QueryClient querying through the connection.
+func NewQueryClient(conn gogogrpc.ClientConn, retry typed.RetryConfig) *QueryClient {
+	return &QueryClient{
+		client: types.NewQueryClient(conn),
+		retry:  retry,
+	}
+}
+
+// ConversionRate calls the feeconversion Query/ConversionRate method.
+func (c *QueryClient) ConversionRate(ctx context.Context, req *types.QueryConversionRateRequest, opts ...grpc.CallOption) (*types.QueryConversionRateResponse, error) {
+	var res *types.QueryConversionRateResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.ConversionRate(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// Params calls the feeconversion Query/Params method.
+func (c *QueryClient) Params(ctx context.Context, req *types.QueryParamsRequest, opts ...grpc.CallOption) (*types.QueryParamsResponse, error) {
+	var res *types.QueryParamsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Params(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feeconversion/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns params of the feeconversion module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// ConversionRate returns the conversion rate of an accepted fee denom.
+func (k Keeper) ConversionRate(c context.Context, req *types.QueryConversionRateRequest) (*types.QueryConversionRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rate, ok := k.GetConversionRate(ctx, req.Denom)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "fees cannot be paid in %s", req.Denom)
+	}
+
+	return &types.QueryConversionRateResponse{FeeDenom: k.GetFeeDenom(ctx), Rate: rate}, nil
+}
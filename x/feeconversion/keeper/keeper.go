@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feeconversion/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper of the feeconversion module. It provides the accepted fee denoms and
+// their conversion rates to the fee converter of the fee market, e.g. with
+// feemarket Keeper.SetFeeConversionKeeper.
+type Keeper struct {
+	paramSpace paramtypes.Subspace
+	rateOracle types.RateOracle
+}
+
+// NewKeeper creates a new feeconversion Keeper instance
+func NewKeeper(paramSpace paramtypes.Subspace) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		paramSpace: paramSpace,
+	}
+}
+
+// SetRateOracle sets the provider of the conversion rates of the accepted fee
+// denoms, which defaults to the rates of the params only.
+func (k *Keeper) SetRateOracle(oracle types.RateOracle) *Keeper {
+	k.rateOracle = oracle
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetParams returns the total set of feeconversion parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of feeconversion parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetFeeDenom returns the denom the accepted fee denoms convert to, empty if
+// the params are not initialized.
+func (k Keeper) GetFeeDenom(ctx sdk.Context) (denom string) {
+	k.paramSpace.GetIfExists(ctx, types.KeyFeeDenom, &denom)
+	return denom
+}
+
+// GetConversionRate returns the amount of fee denom one unit of the accepted
+// fee denom converts to, from the rate oracle if it has a positive rate for
+// it, else from the params, and false if the fees cannot be paid in the denom.
+func (k Keeper) GetConversionRate(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	var params types.Params
+	k.paramSpace.GetIfExists(ctx, types.KeyAcceptedFeeDenoms, &params.AcceptedFeeDenoms)
+	rate, ok := params.AcceptedFeeDenomRate(denom)
+	if !ok {
+		return sdk.Dec{}, false
+	}
+
+	if k.rateOracle != nil {
+		if oracleRate, ok := k.rateOracle.GetConversionRate(ctx, denom, k.GetFeeDenom(ctx)); ok && !oracleRate.IsNil() && oracleRate.IsPositive() {
+			return oracleRate, true
+		}
+	}
+
+	return rate, true
+}
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feeconversion/types"
+)
+
+// mockRateOracle returns its rates of the accepted fee denoms.
+type mockRateOracle map[string]sdk.Dec
+
+func (o mockRateOracle) GetConversionRate(_ sdk.Context, denom, _ string) (sdk.Dec, bool) {
+	rate, ok := o[denom]
+	return rate, ok
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = simapp.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	suite.app.FeeConversionKeeper.SetParams(suite.ctx, types.NewParams(sdk.DefaultBondDenom, []types.ConversionRate{
+		types.NewConversionRate("atom", sdk.NewDecWithPrec(25, 1)),
+		types.NewConversionRate("usd", sdk.NewDecWithPrec(5, 1)),
+	}))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.app.FeeConversionKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func (suite *KeeperTestSuite) TestGetConversionRate() {
+	k := suite.app.FeeConversionKeeper
+
+	rate, ok := k.GetConversionRate(suite.ctx, "atom")
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.NewDecWithPrec(25, 1), rate)
+	_, ok = k.GetConversionRate(suite.ctx, "other")
+	suite.Require().False(ok)
+
+	// the positive rates of the oracle take precedence over the params
+	k.SetRateOracle(mockRateOracle{"atom": sdk.NewDec(3), "usd": sdk.ZeroDec(), "other": sdk.NewDec(1)})
+	rate, ok = k.GetConversionRate(suite.ctx, "atom")
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.NewDec(3), rate)
+	rate, ok = k.GetConversionRate(suite.ctx, "usd")
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 1), rate)
+	_, ok = k.GetConversionRate(suite.ctx, "other")
+	suite.Require().False(ok)
+
+	suite.Require().Equal(sdk.DefaultBondDenom, k.GetFeeDenom(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestQueries() {
+	res, err := suite.queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.app.FeeConversionKeeper.GetParams(suite.ctx), res.Params)
+
+	rateRes, err := suite.queryClient.ConversionRate(context.Background(), &types.QueryConversionRateRequest{Denom: "usd"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.DefaultBondDenom, rateRes.FeeDenom)
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 1), rateRes.Rate)
+
+	_, err = suite.queryClient.ConversionRate(context.Background(), &types.QueryConversionRateRequest{Denom: "other"})
+	suite.Require().Error(err)
+	_, err = suite.queryClient.ConversionRate(context.Background(), &types.QueryConversionRateRequest{Denom: "!"})
+	suite.Require().Error(err)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RateOracle defines the expected provider of the conversion rates of the fee
+// denoms, e.g. from the prices of an oracle module or the reserves of a pool.
+type RateOracle interface {
+	// GetConversionRate returns the amount of feeDenom one unit of denom
+	// converts to, and false if it has no rate for the denom.
+	GetConversionRate(ctx sdk.Context, denom, feeDenom string) (sdk.Dec, bool)
+}
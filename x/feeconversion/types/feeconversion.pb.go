@@ -0,0 +1,643 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/feeconversion/v1beta1/feeconversion.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the feeconversion module.
+type Params struct {
+	// fee_denom is the denom the fees paid in the accepted fee denoms are
+	// converted to. The fee market only uses the conversion rates if it is its
+	// fee denom too.
+	FeeDenom string `protobuf:"bytes,1,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty" yaml:"fee_denom"`
+	// accepted_fee_denoms are the denoms, other than fee_denom, the fees can be
+	// paid in, sorted by denom, with their conversion rates. The rates are used
+	// when the rate oracle of the keeper, if any, has no rate for their denom.
+	AcceptedFeeDenoms []ConversionRate `protobuf:"bytes,2,rep,name=accepted_fee_denoms,json=acceptedFeeDenoms,proto3" json:"accepted_fee_denoms" yaml:"accepted_fee_denoms"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5b8f40cf0c2973c, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetFeeDenom() string {
+	if m != nil {
+		return m.FeeDenom
+	}
+	return ""
+}
+
+func (m *Params) GetAcceptedFeeDenoms() []ConversionRate {
+	if m != nil {
+		return m.AcceptedFeeDenoms
+	}
+	return nil
+}
+
+// ConversionRate is an accepted fee denom with its rate, the amount of fee
+// denom one unit of it converts to.
+type ConversionRate struct {
+	Denom string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Rate  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+}
+
+func (m *ConversionRate) Reset()         { *m = ConversionRate{} }
+func (m *ConversionRate) String() string { return proto.CompactTextString(m) }
+func (*ConversionRate) ProtoMessage()    {}
+func (*ConversionRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5b8f40cf0c2973c, []int{1}
+}
+func (m *ConversionRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConversionRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionRate.Merge(m, src)
+}
+func (m *ConversionRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionRate proto.InternalMessageInfo
+
+func (m *ConversionRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmos.feeconversion.v1beta1.Params")
+	proto.RegisterType((*ConversionRate)(nil), "cosmos.feeconversion.v1beta1.ConversionRate")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/feeconversion/v1beta1/feeconversion.proto", fileDescriptor_d5b8f40cf0c2973c)
+}
+
+var fileDescriptor_d5b8f40cf0c2973c = []byte{
+	// 323 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0xbd, 0x4e, 0x32, 0x41,
+	0x14, 0xdd, 0xe1, 0xe3, 0x23, 0x32, 0x26, 0x46, 0x57, 0x0a, 0x42, 0xcc, 0x0c, 0x99, 0xc2, 0x50,
+	0xe8, 0xac, 0x60, 0x47, 0xb9, 0x12, 0x2b, 0x0b, 0xb3, 0xa5, 0x0d, 0x19, 0x86, 0x0b, 0x12, 0x5d,
+	0x66, 0xb3, 0x33, 0x12, 0xe9, 0x7c, 0x04, 0x4b, 0x4b, 0x1e, 0xc6, 0x82, 0x92, 0xd2, 0x58, 0x6c,
+	0x0c, 0x34, 0xd6, 0x3c, 0x81, 0x61, 0x77, 0xdd, 0xb8, 0xc6, 0x50, 0xcd, 0x9d, 0x73, 0xef, 0x39,
+	0xf7, 0xe7, 0xe0, 0x33, 0xa9, 0xb4, 0xaf, 0xb4, 0x33, 0x00, 0x90, 0x6a, 0x3c, 0x81, 0x50, 0x8f,
+	0xd4, 0xd8, 0x99, 0x34, 0x7b, 0x60, 0x44, 0x33, 0x8f, 0xf2, 0x20, 0x54, 0x46, 0xd9, 0x47, 0x09,
+	0x83, 0xe7, 0x73, 0x29, 0xa3, 0x56, 0x19, 0xaa, 0xa1, 0x8a, 0x0b, 0x9d, 0x4d, 0x94, 0x70, 0xd8,
+	0x2b, 0xc2, 0xa5, 0x6b, 0x11, 0x0a, 0x5f, 0xdb, 0x4d, 0x5c, 0x1e, 0x00, 0x74, 0xfb, 0x30, 0x56,
+	0x7e, 0x15, 0xd5, 0x51, 0xa3, 0xec, 0x56, 0xd6, 0x11, 0xdd, 0x9f, 0x0a, 0xff, 0xbe, 0xcd, 0xb2,
+	0x14, 0xf3, 0x76, 0x06, 0x00, 0x9d, 0x4d, 0x68, 0x3f, 0x21, 0x7c, 0x28, 0xa4, 0x84, 0xc0, 0x40,
+	0xbf, 0x9b, 0x55, 0xe8, 0x6a, 0xa1, 0xfe, 0xaf, 0xb1, 0xdb, 0x3a, 0xe1, 0xdb, 0x06, 0xe2, 0x17,
+	0x19, 0xe4, 0x09, 0x03, 0x2e, 0x9b, 0x47, 0xd4, 0x5a, 0x47, 0xb4, 0x96, 0xf4, 0xfb, 0x43, 0x96,
+	0x79, 0x07, 0xdf, 0xe8, 0x65, 0x3a, 0x81, 0x6e, 0x17, 0x5f, 0x66, 0xd4, 0x62, 0x01, 0xde, 0xcb,
+	0xcb, 0xd9, 0x15, 0xfc, 0xff, 0xc7, 0x26, 0x5e, 0xf2, 0xb1, 0x5d, 0x5c, 0x0c, 0x85, 0x81, 0x6a,
+	0x21, 0x5e, 0x8f, 0x6f, 0x5a, 0xbe, 0x47, 0xf4, 0x78, 0x38, 0x32, 0xb7, 0x0f, 0x3d, 0x2e, 0x95,
+	0xef, 0xa4, 0x57, 0x4f, 0x9e, 0x53, 0xdd, 0xbf, 0x73, 0xcc, 0x34, 0x00, 0xcd, 0x3b, 0x20, 0xbd,
+	0x98, 0xdb, 0x2e, 0x7e, 0xce, 0x28, 0x72, 0xaf, 0xe6, 0x4b, 0x82, 0x16, 0x4b, 0x82, 0x3e, 0x96,
+	0x04, 0x3d, 0xaf, 0x88, 0xb5, 0x58, 0x11, 0xeb, 0x6d, 0x45, 0xac, 0x9b, 0xd6, 0x56, 0xb5, 0xc7,
+	0x5f, 0x86, 0xc6, 0xea, 0xbd, 0x52, 0xec, 0xc6, 0xf9, 0xd7, 0x00, 0x23, 0x73, 0xed, 0xe5, 0xf5,
+	0x01, 0x00, 0x00,
+}
+
+func (this *ConversionRate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConversionRate)
+	if !ok {
+		that2, ok := that.(ConversionRate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Rate.Equal(that1.Rate) {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AcceptedFeeDenoms) > 0 {
+		for iNdEx := len(m.AcceptedFeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AcceptedFeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeconversion(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FeeDenom) > 0 {
+		i -= len(m.FeeDenom)
+		copy(dAtA[i:], m.FeeDenom)
+		i = encodeVarintFeeconversion(dAtA, i, uint64(len(m.FeeDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConversionRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeconversion(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeeconversion(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeeconversion(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeeconversion(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeeDenom)
+	if l > 0 {
+		n += 1 + l + sovFeeconversion(uint64(l))
+	}
+	if len(m.AcceptedFeeDenoms) > 0 {
+		for _, e := range m.AcceptedFeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovFeeconversion(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConversionRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeeconversion(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovFeeconversion(uint64(l))
+	return n
+}
+
+func sovFeeconversion(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeeconversion(x uint64) (n int) {
+	return sovFeeconversion(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeconversion
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeconversion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeconversion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeconversion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedFeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeconversion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeconversion
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeconversion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedFeeDenoms = append(m.AcceptedFeeDenoms, ConversionRate{})
+			if err := m.AcceptedFeeDenoms[len(m.AcceptedFeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeconversion(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeconversion
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConversionRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeconversion
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeconversion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeconversion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeconversion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeconversion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeconversion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeconversion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeconversion(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeconversion
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeeconversion(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeeconversion
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeconversion
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeconversion
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeeconversion
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeeconversion
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeeconversion
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeeconversion        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeeconversion          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeeconversion = fmt.Errorf("proto: unexpected end of group")
+)
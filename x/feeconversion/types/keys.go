@@ -0,0 +1,9 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feeconversion"
+
+	// QuerierRoute is the querier route for the feeconversion module.
+	QuerierRoute = ModuleName
+)
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// DefaultFeeDenom is the default fee denom. The default params accept no other
+// fee denoms.
+var DefaultFeeDenom = sdk.DefaultBondDenom
+
+// Parameter store keys
+var (
+	KeyFeeDenom          = []byte("FeeDenom")
+	KeyAcceptedFeeDenoms = []byte("AcceptedFeeDenoms")
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(feeDenom string, acceptedFeeDenoms []ConversionRate) Params {
+	return Params{
+		FeeDenom:          feeDenom,
+		AcceptedFeeDenoms: acceptedFeeDenoms,
+	}
+}
+
+// NewConversionRate creates a new ConversionRate object
+func NewConversionRate(denom string, rate sdk.Dec) ConversionRate {
+	return ConversionRate{
+		Denom: denom,
+		Rate:  rate,
+	}
+}
+
+// DefaultParams returns default parameters
+func DefaultParams() Params {
+	return NewParams(DefaultFeeDenom, nil)
+}
+
+// AcceptedFeeDenomRate returns the rate of the accepted fee denom in the
+// params, and false if the fees cannot be paid in the denom.
+func (p Params) AcceptedFeeDenomRate(denom string) (sdk.Dec, bool) {
+	for _, cr := range p.AcceptedFeeDenoms {
+		if cr.Denom == denom {
+			return cr.Rate, true
+		}
+	}
+
+	return sdk.Dec{}, false
+}
+
+// Validate validates the params
+func (p Params) Validate() error {
+	if err := validateFeeDenom(p.FeeDenom); err != nil {
+		return err
+	}
+	if err := validateAcceptedFeeDenoms(p.AcceptedFeeDenoms); err != nil {
+		return err
+	}
+
+	for _, cr := range p.AcceptedFeeDenoms {
+		if cr.Denom == p.FeeDenom {
+			return fmt.Errorf("accepted fee denom %s is the fee denom", cr.Denom)
+		}
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyFeeDenom, &p.FeeDenom, validateFeeDenom),
+		paramtypes.NewParamSetPair(KeyAcceptedFeeDenoms, &p.AcceptedFeeDenoms, validateAcceptedFeeDenoms),
+	}
+}
+
+func validateFeeDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := sdk.ValidateDenom(v); err != nil {
+		return fmt.Errorf("invalid fee denom: %w", err)
+	}
+
+	return nil
+}
+
+func validateAcceptedFeeDenoms(i interface{}) error {
+	v, ok := i.([]ConversionRate)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for i, cr := range v {
+		if err := sdk.ValidateDenom(cr.Denom); err != nil {
+			return fmt.Errorf("invalid accepted fee denom: %w", err)
+		}
+		if i > 0 && cr.Denom <= v[i-1].Denom {
+			return fmt.Errorf("accepted fee denoms must be sorted by unique denom: %s", cr.Denom)
+		}
+		if cr.Rate.IsNil() || !cr.Rate.IsPositive() {
+			return fmt.Errorf("accepted fee denom %s rate must be positive: %s", cr.Denom, cr.Rate)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+// Code generated by typedgen. DO NOT EDIT.
+
+// Package grpcclient implements the typed clients of the feegrant module.
+package grpcclient
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/typed"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+// QueryClient is the typed client of the feegrant Query service, retrying
+// the queries failing with a transient error.
+type QueryClient struct {
+	client feegrant.QueryClient
+	retry  typed.RetryConfig
+}
+
+// NewQueryClient returns a QueryClient querying through the connection.
+func NewQueryClient(conn gogogrpc.ClientConn, retry typed.RetryConfig) *QueryClient {
+	return &QueryClient{
+		client: feegrant.NewQueryClient(conn),
+		retry:  retry,
+	}
+}
+
+// Allowance calls the feegrant Query/Allowance method.
+func (c *QueryClient) Allowance(ctx context.Context, req *feegrant.QueryAllowanceRequest, opts ...grpc.CallOption) (*feegrant.QueryAllowanceResponse, error) {
+	var res *feegrant.QueryAllowanceResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Allowance(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// Allowances calls the feegrant Query/Allowances method.
+func (c *QueryClient) Allowances(ctx context.Context, req *feegrant.QueryAllowancesRequest, opts ...grpc.CallOption) (*feegrant.QueryAllowancesResponse, error) {
+	var res *feegrant.QueryAllowancesResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Allowances(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateAllowances calls the feegrant Query/Allowances method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateAllowances(ctx context.Context, req *feegrant.QueryAllowancesRequest, fn func(*feegrant.QueryAllowancesResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq feegrant.QueryAllowancesRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.Allowances(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// MsgClient is the typed client of the feegrant Msg service, broadcasting a
+// transaction of each message.
+type MsgClient struct {
+	broadcaster typed.Broadcaster
+}
+
+// NewMsgClient returns a MsgClient broadcasting the transactions with the
+// broadcaster.
+func NewMsgClient(broadcaster typed.Broadcaster) *MsgClient {
+	return &MsgClient{broadcaster: broadcaster}
+}
+
+// GrantAllowance broadcasts a transaction of the feegrant Msg/GrantAllowance message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) GrantAllowance(ctx context.Context, msg *feegrant.MsgGrantAllowance) (*feegrant.MsgGrantAllowanceResponse, *sdk.TxResponse, error) {
+	res := &feegrant.MsgGrantAllowanceResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
+
+// RevokeAllowance broadcasts a transaction of the feegrant Msg/RevokeAllowance message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) RevokeAllowance(ctx context.Context, msg *feegrant.MsgRevokeAllowance) (*feegrant.MsgRevokeAllowanceResponse, *sdk.TxResponse, error) {
+	res := &feegrant.MsgRevokeAllowanceResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
@@ -13,6 +13,8 @@ type AccountKeeper interface {
 	NewAccountWithAddress(ctx sdk.Context, addr sdk.AccAddress) auth.AccountI
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) auth.AccountI
 	SetAccount(ctx sdk.Context, acc auth.AccountI)
+
+	AddressCodec() sdk.AddressCodec
 }
 
 // BankKeeper defines the expected supply Keeper (noalias)
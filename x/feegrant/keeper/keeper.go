@@ -192,11 +192,11 @@ func emitUseGrantEvent(ctx sdk.Context, granter, grantee string) {
 // InitGenesis will initialize the keeper from a *previously validated* GenesisState
 func (k Keeper) InitGenesis(ctx sdk.Context, data *feegrant.GenesisState) error {
 	for _, f := range data.Allowances {
-		granter, err := sdk.AccAddressFromBech32(f.Granter)
+		granter, err := k.authKeeper.AddressCodec().StringToBytes(f.Granter)
 		if err != nil {
 			return err
 		}
-		grantee, err := sdk.AccAddressFromBech32(f.Grantee)
+		grantee, err := k.authKeeper.AddressCodec().StringToBytes(f.Grantee)
 		if err != nil {
 			return err
 		}
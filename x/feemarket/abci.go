@@ -0,0 +1,30 @@
+package feemarket
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// EndBlocker sets the base fee of the next block from the gas used by the
+// current block, and prunes the base fees which are past the history window.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	var gasUsed uint64
+	if meter := ctx.BlockGasMeter(); meter != nil {
+		gasUsed = meter.GasConsumed()
+	}
+
+	baseFee := k.UpdateBaseFee(ctx, gasUsed)
+	k.PruneBaseFeeHistory(ctx)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBaseFee,
+		sdk.NewAttribute(types.AttributeKeyNextBaseFee, baseFee.String()),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, sdk.NewIntFromUint64(gasUsed).String()),
+	))
+}
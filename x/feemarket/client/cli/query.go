@@ -0,0 +1,172 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// GetQueryCmd returns the cli query commands for the feemarket module.
+func GetQueryCmd() *cobra.Command {
+	feeMarketQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the feemarket module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feeMarketQueryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryBaseFee(),
+		GetCmdQueryBaseFeeHistory(),
+		GetCmdQueryConversionRate(),
+	)
+
+	return feeMarketQueryCmd
+}
+
+// GetCmdQueryParams implements a command to return the current feemarket
+// parameters.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current feemarket parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBaseFee implements a command to return the base fee of the next
+// block.
+func GetCmdQueryBaseFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "base-fee",
+		Short: "Query the base fee gas price of the next block",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BaseFee(cmd.Context(), &types.QueryBaseFeeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.BaseFee)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBaseFeeHistory implements a command to return the base fees of
+// the most recent blocks.
+func GetCmdQueryBaseFeeHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "base-fee-history",
+		Short: "Query the base fees of the most recent blocks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the base fees of the most recent blocks and the gas they used, by
+ascending height. Only the number of blocks set by the history_blocks param are
+kept.
+
+Example:
+$ %s query %s base-fee-history --limit 10
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BaseFeeHistory(cmd.Context(), &types.QueryBaseFeeHistoryRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "base fee history")
+
+	return cmd
+}
+
+// GetCmdQueryConversionRate implements a command to return the conversion rate
+// of an alternative fee denom.
+func GetCmdQueryConversionRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conversion-rate [denom]",
+		Short: "Query the rate the fees paid in a denom are converted at",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the rate the fees paid in an alternative fee denom are converted to the
+fee denom at, from the fee conversion module of the chain or the params.
+
+Example:
+$ %s query %s conversion-rate ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ConversionRate(cmd.Context(), &types.QueryConversionRateRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,94 @@
+// Code generated by typedgen. DO NOT EDIT.
+
+// Package grpcclient implements the typed clients of the feemarket module.
+package grpcclient
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/typed"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// QueryClient is the typed client of the feemarket Query service, retrying
+// the queries failing with a transient error.
+type QueryClient struct {
+	client types.QueryClient
+	retry  typed.RetryConfig
+}
+
+// NewQueryClient returns a QueryClient querying through the connection.
+func NewQueryClient(conn gogogrpc.ClientConn, retry typed.RetryConfig) *QueryClient {
+	return &QueryClient{
+		client: types.NewQueryClient(conn),
+		retry:  retry,
+	}
+}
+
+// BaseFee calls the feemarket Query/BaseFee method.
+func (c *QueryClient) BaseFee(ctx context.Context, req *types.QueryBaseFeeRequest, opts ...grpc.CallOption) (*types.QueryBaseFeeResponse, error) {
+	var res *types.QueryBaseFeeResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.BaseFee(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// BaseFeeHistory calls the feemarket Query/BaseFeeHistory method.
+func (c *QueryClient) BaseFeeHistory(ctx context.Context, req *types.QueryBaseFeeHistoryRequest, opts ...grpc.CallOption) (*types.QueryBaseFeeHistoryResponse, error) {
+	var res *types.QueryBaseFeeHistoryResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.BaseFeeHistory(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateBaseFeeHistory calls the feemarket Query/BaseFeeHistory method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateBaseFeeHistory(ctx context.Context, req *types.QueryBaseFeeHistoryRequest, fn func(*types.QueryBaseFeeHistoryResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq types.QueryBaseFeeHistoryRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.BaseFeeHistory(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// ConversionRate calls the feemarket Query/ConversionRate method.
+func (c *QueryClient) ConversionRate(ctx context.Context, req *types.QueryConversionRateRequest, opts ...grpc.CallOption) (*types.QueryConversionRateResponse, error) {
+	var res *types.QueryConversionRateResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.ConversionRate(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// Params calls the feemarket Query/Params method.
+func (c *QueryClient) Params(ctx context.Context, req *types.QueryParamsRequest, opts ...grpc.CallOption) (*types.QueryParamsResponse, error) {
+	var res *types.QueryParamsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Params(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// ReserveFeeConverter converts the fees paid in the alternative fee denoms, the
+// ones accepted by the FeeConversionKeeper of the keeper, if any, and those of
+// the params, set by governance, by exchanging them for fee denom tokens of a
+// reserve module account at their conversion rates. The reserve keeps the
+// coins it exchanged, and the conversions fail once it runs out of fee denom
+// tokens.
+// It implements the FeeConverter of the ante handler.
+type ReserveFeeConverter struct {
+	k          Keeper
+	bankKeeper types.BankKeeper
+	reserve    string
+}
+
+// NewReserveFeeConverter returns a ReserveFeeConverter exchanging the fees with
+// the given module account, e.g. types.FeeReserveName.
+func NewReserveFeeConverter(k Keeper, ak types.AccountKeeper, bk types.BankKeeper, reserve string) ReserveFeeConverter {
+	if addr := ak.GetModuleAddress(reserve); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", reserve))
+	}
+
+	return ReserveFeeConverter{
+		k:          k,
+		bankKeeper: bk,
+		reserve:    reserve,
+	}
+}
+
+// ConvertedFee returns the fee denom coin the fee coin converts to at the
+// conversion rate of its denom, and false if it is not an alternative fee denom.
+func (c ReserveFeeConverter) ConvertedFee(ctx sdk.Context, fee sdk.Coin) (sdk.Coin, bool) {
+	rate, ok := c.k.GetConversionRate(ctx, fee.Denom)
+	if !ok {
+		return fee, false
+	}
+
+	return sdk.NewCoin(c.k.getFeeDenom(ctx), rate.MulInt(fee.Amount).TruncateInt()), true
+}
+
+// ConvertFee exchanges the fee coin of the payer, in an alternative fee denom,
+// for fee denom tokens of the reserve, and returns them.
+func (c ReserveFeeConverter) ConvertFee(ctx sdk.Context, payer sdk.AccAddress, fee sdk.Coin) (sdk.Coin, error) {
+	converted, ok := c.ConvertedFee(ctx, fee)
+	if !ok {
+		return fee, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "fees cannot be paid in %s", fee.Denom)
+	}
+	if !converted.IsPositive() {
+		return fee, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "%s converts to no %s fees", fee, converted.Denom)
+	}
+
+	if err := c.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, c.reserve, sdk.NewCoins(fee)); err != nil {
+		return fee, err
+	}
+	if err := c.bankKeeper.SendCoinsFromModuleToAccount(ctx, c.reserve, payer, sdk.NewCoins(converted)); err != nil {
+		return fee, sdkerrors.Wrapf(err, "failed to convert %s", fee)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConvertFee,
+			sdk.NewAttribute(types.AttributeKeyPayer, payer.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, fee.String()),
+			sdk.NewAttribute(types.AttributeKeyConvertedAmount, converted.String()),
+		),
+	)
+
+	return converted, nil
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns params of the feemarket module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// BaseFee returns the base fee of the next block.
+func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBaseFeeResponse{BaseFee: k.GetBaseFee(ctx)}, nil
+}
+
+// BaseFeeHistory returns the base fees of the most recent blocks.
+func (k Keeper) BaseFeeHistory(c context.Context, req *types.QueryBaseFeeHistoryRequest) (*types.QueryBaseFeeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BlockBaseFeeKeyPrefix)
+
+	var baseFees []types.BlockBaseFee
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var baseFee types.BlockBaseFee
+		k.cdc.MustUnmarshal(value, &baseFee)
+
+		baseFees = append(baseFees, baseFee)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBaseFeeHistoryResponse{BaseFees: baseFees, Pagination: pageRes}, nil
+}
+
+// ConversionRate returns the conversion rate of an alternative fee denom.
+func (k Keeper) ConversionRate(c context.Context, req *types.QueryConversionRateRequest) (*types.QueryConversionRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rate, ok := k.GetConversionRate(ctx, req.Denom)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "fees cannot be paid in %s", req.Denom)
+	}
+
+	return &types.QueryConversionRateResponse{FeeDenom: k.GetParams(ctx).FeeDenom, Rate: rate}, nil
+}
@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	cdc                 codec.BinaryCodec
+	storeKey            sdk.StoreKey
+	paramSpace          paramtypes.Subspace
+	feeConversionKeeper types.FeeConversionKeeper
+}
+
+// NewKeeper creates a new feemarket Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   key,
+		paramSpace: paramSpace,
+	}
+}
+
+// SetFeeConversionKeeper sets the provider of the accepted fee denoms and
+// their conversion rates, e.g. the x/feeconversion keeper, which defaults to
+// the alternative fee denoms of the params only. It must be set before the
+// keeper is given to a ReserveFeeConverter.
+func (k *Keeper) SetFeeConversionKeeper(fck types.FeeConversionKeeper) *Keeper {
+	k.feeConversionKeeper = fck
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetParams returns the total set of feemarket parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of feemarket parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetConversionRate returns the amount of fee denom one unit of the alternative
+// fee denom converts to, from the FeeConversionKeeper if it accepts the denom
+// and converts to the fee denom, else from the alternative fee denoms of the
+// params, and false if the fees cannot be paid in the denom.
+func (k Keeper) GetConversionRate(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	if k.feeConversionKeeper != nil && k.feeConversionKeeper.GetFeeDenom(ctx) == k.getFeeDenom(ctx) {
+		if rate, ok := k.feeConversionKeeper.GetConversionRate(ctx, denom); ok {
+			return rate, true
+		}
+	}
+
+	var params types.Params
+	k.paramSpace.GetIfExists(ctx, types.KeyAlternativeFeeDenoms, &params.AlternativeFeeDenoms)
+	return params.AlternativeFeeDenomRate(denom)
+}
+
+// GetBaseFeeAmount returns the base fee gas price of the current block, zero
+// if it is not set.
+func (k Keeper) GetBaseFeeAmount(ctx sdk.Context) sdk.Dec {
+	bz := ctx.KVStore(k.storeKey).Get(types.BaseFeeKey)
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var baseFee sdk.Dec
+	if err := baseFee.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return baseFee
+}
+
+// SetBaseFeeAmount sets the base fee gas price of the current block.
+func (k Keeper) SetBaseFeeAmount(ctx sdk.Context, baseFee sdk.Dec) {
+	bz, err := baseFee.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.BaseFeeKey, bz)
+}
+
+// GetBaseFee returns the base fee gas price the txs of the current block must
+// pay at least, in the fee denom. The genesis txs are delivered before the
+// params are initialized, in which case the base fee is zero. It implements
+// the FeeMarketKeeper of the ante handler.
+func (k Keeper) GetBaseFee(ctx sdk.Context) sdk.DecCoin {
+	denom := k.getFeeDenom(ctx)
+	if denom == "" {
+		return sdk.DecCoin{}
+	}
+
+	return sdk.NewDecCoinFromDec(denom, k.GetBaseFeeAmount(ctx))
+}
+
+// getFeeDenom returns the fee denom param, empty if the params are not
+// initialized.
+func (k Keeper) getFeeDenom(ctx sdk.Context) (denom string) {
+	k.paramSpace.GetIfExists(ctx, types.KeyFeeDenom, &denom)
+	return denom
+}
+
+// GetBlockBaseFee returns the base fee of the block at the given height, if it
+// is still in the history.
+func (k Keeper) GetBlockBaseFee(ctx sdk.Context, height int64) (blockBaseFee types.BlockBaseFee, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.BlockBaseFeeKey(height))
+	if bz == nil {
+		return blockBaseFee, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &blockBaseFee)
+	return blockBaseFee, true
+}
+
+// SetBlockBaseFee records the base fee of a block in the history.
+func (k Keeper) SetBlockBaseFee(ctx sdk.Context, blockBaseFee types.BlockBaseFee) {
+	ctx.KVStore(k.storeKey).Set(types.BlockBaseFeeKey(blockBaseFee.Height), k.cdc.MustMarshal(&blockBaseFee))
+}
+
+// PruneBaseFeeHistory deletes the base fees of the blocks more than
+// HistoryBlocks blocks before the current block.
+func (k Keeper) PruneBaseFeeHistory(ctx sdk.Context) {
+	cutoff := ctx.BlockHeight() - int64(k.GetParams(ctx).HistoryBlocks)
+	if cutoff < 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.BlockBaseFeeKeyPrefix, types.BlockBaseFeeKey(cutoff+1))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// UpdateBaseFee records the base fee of the current block and the gas it used
+// in the history, and sets the base fee of the next block as in EIP-1559:
+//
+//	baseFee * (1 + (gasUsed - targetGas) / targetGas / BaseFeeChangeDenominator)
+//
+// where the target gas of the blocks is their maximum gas divided by
+// ElasticityMultiplier, so that the base fee changes by up to
+// 1/BaseFeeChangeDenominator of its value with the default multiplier. The base
+// fee does not change if the gas of the blocks is unlimited, and never falls
+// below MinBaseFee. It returns the base fee of the next block.
+func (k Keeper) UpdateBaseFee(ctx sdk.Context, gasUsed uint64) sdk.Dec {
+	params := k.GetParams(ctx)
+	baseFee := k.GetBaseFeeAmount(ctx)
+
+	if params.HistoryBlocks > 0 {
+		k.SetBlockBaseFee(ctx, types.BlockBaseFee{
+			Height:  ctx.BlockHeight(),
+			BaseFee: baseFee,
+			GasUsed: gasUsed,
+		})
+	}
+
+	next := baseFee
+	if cp := ctx.ConsensusParams(); cp != nil && cp.Block != nil && cp.Block.MaxGas > 0 {
+		target := sdk.NewInt(cp.Block.MaxGas).QuoRaw(int64(params.ElasticityMultiplier))
+		if target.IsPositive() {
+			delta := sdk.NewIntFromUint64(gasUsed).Sub(target)
+			next = baseFee.Add(baseFee.MulInt(delta).QuoInt(target).QuoInt64(int64(params.BaseFeeChangeDenominator)))
+		}
+	}
+	if next.LT(params.MinBaseFee) {
+		next = params.MinBaseFee
+	}
+
+	k.SetBaseFeeAmount(ctx, next)
+	return next
+}
@@ -0,0 +1,190 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	feeconversiontypes "github.com/cosmos/cosmos-sdk/x/feeconversion/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = simapp.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{Height: 10}).
+		WithConsensusParams(&abci.ConsensusParams{Block: &abci.BlockParams{MaxGas: 1000000}})
+	params := types.DefaultParams()
+	params.HistoryBlocks = 3
+	suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+	suite.app.FeeMarketKeeper.SetBaseFeeAmount(suite.ctx, sdk.NewDec(100))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.app.FeeMarketKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func (suite *KeeperTestSuite) TestUpdateBaseFee() {
+	k := suite.app.FeeMarketKeeper
+
+	testCases := []struct {
+		name       string
+		maxGas     int64
+		minBaseFee sdk.Dec
+		gasUsed    uint64
+		expBaseFee sdk.Dec
+	}{
+		{"full block", 1000000, sdk.ZeroDec(), 1000000, sdk.NewDecWithPrec(1125, 1)},
+		{"target gas", 1000000, sdk.ZeroDec(), 500000, sdk.NewDec(100)},
+		{"empty block", 1000000, sdk.ZeroDec(), 0, sdk.NewDecWithPrec(875, 1)},
+		{"below min base fee", 1000000, sdk.NewDec(95), 0, sdk.NewDec(95)},
+		{"unlimited gas", -1, sdk.ZeroDec(), 0, sdk.NewDec(100)},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithConsensusParams(&abci.ConsensusParams{Block: &abci.BlockParams{MaxGas: tc.maxGas}})
+			params := k.GetParams(ctx)
+			params.MinBaseFee = tc.minBaseFee
+			k.SetParams(ctx, params)
+
+			suite.Require().Equal(tc.expBaseFee, k.UpdateBaseFee(ctx, tc.gasUsed))
+			suite.Require().Equal(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, tc.expBaseFee), k.GetBaseFee(ctx))
+
+			// the base fee of the current block is recorded in the history
+			blockBaseFee, found := k.GetBlockBaseFee(ctx, 10)
+			suite.Require().True(found)
+			suite.Require().Equal(types.BlockBaseFee{Height: 10, BaseFee: sdk.NewDec(100), GasUsed: tc.gasUsed}, blockBaseFee)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestBaseFeeHistory() {
+	k := suite.app.FeeMarketKeeper
+
+	for height := int64(10); height < 15; height++ {
+		ctx := suite.ctx.WithBlockHeight(height)
+		k.UpdateBaseFee(ctx, 1000000)
+		k.PruneBaseFeeHistory(ctx)
+	}
+
+	// only the base fees of the last HistoryBlocks blocks are kept
+	_, found := k.GetBlockBaseFee(suite.ctx, 11)
+	suite.Require().False(found)
+
+	res, err := suite.queryClient.BaseFeeHistory(context.Background(), &types.QueryBaseFeeHistoryRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+	suite.Require().Equal([]types.BlockBaseFee{
+		{Height: 12, BaseFee: sdk.MustNewDecFromStr("126.5625"), GasUsed: 1000000},
+		{Height: 13, BaseFee: sdk.MustNewDecFromStr("142.3828125"), GasUsed: 1000000},
+	}, res.BaseFees)
+
+	baseFeeRes, err := suite.queryClient.BaseFee(context.Background(), &types.QueryBaseFeeRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(k.GetBaseFee(suite.ctx), baseFeeRes.BaseFee)
+	suite.Require().Equal(sdk.DefaultBondDenom, baseFeeRes.BaseFee.Denom)
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	suite.Require().NoError(types.ValidateGenesis(*types.DefaultGenesisState()))
+
+	genesis := types.DefaultGenesisState()
+	genesis.Params.MinBaseFee = sdk.NewDec(10)
+	suite.Require().EqualError(types.ValidateGenesis(*genesis), "base fee 0.000000000000000000 is below the min base fee 10.000000000000000000")
+
+	genesis = types.DefaultGenesisState()
+	genesis.Params.AlternativeFeeDenoms = []types.FeeDenomRate{
+		types.NewFeeDenomRate("uatom", sdk.NewDec(2)), types.NewFeeDenomRate("uosmo", sdk.NewDecWithPrec(5, 1)),
+	}
+	suite.Require().NoError(types.ValidateGenesis(*genesis))
+	rate, ok := genesis.Params.AlternativeFeeDenomRate("uosmo")
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 1), rate)
+	_, ok = genesis.Params.AlternativeFeeDenomRate(genesis.Params.FeeDenom)
+	suite.Require().False(ok)
+
+	genesis.Params.AlternativeFeeDenoms[1].Denom = "uatom"
+	suite.Require().EqualError(types.ValidateGenesis(*genesis), "alternative fee denoms must be sorted by unique denom: uatom")
+	genesis.Params.AlternativeFeeDenoms = []types.FeeDenomRate{types.NewFeeDenomRate("uatom", sdk.ZeroDec())}
+	suite.Require().EqualError(types.ValidateGenesis(*genesis), "alternative fee denom uatom rate must be positive: 0.000000000000000000")
+	genesis.Params.AlternativeFeeDenoms = []types.FeeDenomRate{types.NewFeeDenomRate(genesis.Params.FeeDenom, sdk.OneDec())}
+	suite.Require().EqualError(types.ValidateGenesis(*genesis), "alternative fee denom stake is the fee denom")
+}
+
+func (suite *KeeperTestSuite) TestConversionRate() {
+	params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	params.AlternativeFeeDenoms = []types.FeeDenomRate{
+		types.NewFeeDenomRate("atom", sdk.NewDecWithPrec(25, 1)), types.NewFeeDenomRate("usd", sdk.NewDecWithPrec(5, 1)),
+	}
+	suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+
+	k := suite.app.FeeMarketKeeper
+	rate, ok := k.GetConversionRate(suite.ctx, "atom")
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.NewDecWithPrec(25, 1), rate)
+	_, ok = k.GetConversionRate(suite.ctx, "other")
+	suite.Require().False(ok)
+
+	res, err := suite.queryClient.ConversionRate(context.Background(), &types.QueryConversionRateRequest{Denom: "usd"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.DefaultBondDenom, res.FeeDenom)
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 1), res.Rate)
+	_, err = suite.queryClient.ConversionRate(context.Background(), &types.QueryConversionRateRequest{Denom: "other"})
+	suite.Require().Error(err)
+	_, err = suite.queryClient.ConversionRate(context.Background(), &types.QueryConversionRateRequest{Denom: "!"})
+	suite.Require().Error(err)
+
+	// the denoms accepted by x/feeconversion are accepted too, at its rates,
+	// and the converter converts the fees at them
+	suite.app.FeeConversionKeeper.SetParams(suite.ctx, feeconversiontypes.NewParams(sdk.DefaultBondDenom, []feeconversiontypes.ConversionRate{
+		feeconversiontypes.NewConversionRate("atom", sdk.NewDec(3)), feeconversiontypes.NewConversionRate("other", sdk.NewDec(1)),
+	}))
+	rate, ok = k.GetConversionRate(suite.ctx, "atom")
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.NewDec(3), rate)
+	rate, ok = k.GetConversionRate(suite.ctx, "usd")
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 1), rate)
+	rate, ok = k.GetConversionRate(suite.ctx, "other")
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.NewDec(1), rate)
+
+	converter := keeper.NewReserveFeeConverter(k, suite.app.AccountKeeper, suite.app.BankKeeper, types.FeeReserveName)
+	converted, ok := converter.ConvertedFee(suite.ctx, sdk.NewInt64Coin("atom", 5))
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 15), converted)
+	_, ok = converter.ConvertedFee(suite.ctx, sdk.NewInt64Coin("unknown", 5))
+	suite.Require().False(ok)
+
+	// the rates of x/feeconversion to another denom are not used
+	suite.app.FeeConversionKeeper.SetParams(suite.ctx, feeconversiontypes.NewParams("uatom", []feeconversiontypes.ConversionRate{
+		feeconversiontypes.NewConversionRate("atom", sdk.NewDec(3)), feeconversiontypes.NewConversionRate("other", sdk.NewDec(1)),
+	}))
+	rate, ok = k.GetConversionRate(suite.ctx, "atom")
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.NewDecWithPrec(25, 1), rate)
+	_, ok = k.GetConversionRate(suite.ctx, "other")
+	suite.Require().False(ok)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,12 @@
+package types
+
+// feemarket module event types
+const (
+	EventTypeBaseFee    = "base_fee"
+	EventTypeConvertFee = "convert_fee"
+
+	AttributeKeyNextBaseFee     = "next_base_fee"
+	AttributeKeyGasUsed         = "gas_used"
+	AttributeKeyPayer           = "payer"
+	AttributeKeyConvertedAmount = "converted_amount"
+)
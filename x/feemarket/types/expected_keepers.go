@@ -0,0 +1,27 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountKeeper defines the expected account keeper (noalias)
+type AccountKeeper interface {
+	GetModuleAddress(name string) sdk.AccAddress
+}
+
+// BankKeeper defines the expected bank keeper exchanging the fees paid in the
+// alternative fee denoms (noalias)
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// FeeConversionKeeper defines the expected keeper of the accepted fee denoms
+// and their conversion rates, e.g. the x/feeconversion keeper.
+type FeeConversionKeeper interface {
+	// GetFeeDenom returns the denom the accepted fee denoms convert to.
+	GetFeeDenom(ctx sdk.Context) string
+	// GetConversionRate returns the amount of fee denom one unit of denom
+	// converts to, and false if the fees cannot be paid in the denom.
+	GetConversionRate(ctx sdk.Context, denom string) (sdk.Dec, bool)
+}
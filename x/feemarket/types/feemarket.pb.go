@@ -0,0 +1,1095 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/feemarket/v1beta1/feemarket.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the feemarket module.
+type Params struct {
+	// fee_denom is the denom of the base fee.
+	FeeDenom string `protobuf:"bytes,1,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty" yaml:"fee_denom"`
+	// base_fee_change_denominator bounds the change of the base fee between two
+	// blocks, to 1/base_fee_change_denominator of its value.
+	BaseFeeChangeDenominator uint32 `protobuf:"varint,2,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty" yaml:"base_fee_change_denominator"`
+	// elasticity_multiplier is the ratio of the maximum gas of the blocks to
+	// their target gas, at which the base fee does not change.
+	ElasticityMultiplier uint32 `protobuf:"varint,3,opt,name=elasticity_multiplier,json=elasticityMultiplier,proto3" json:"elasticity_multiplier,omitempty" yaml:"elasticity_multiplier"`
+	// min_base_fee is the lower bound of the base fee.
+	MinBaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_base_fee" yaml:"min_base_fee"`
+	// history_blocks is the number of most recent blocks whose base fees are
+	// kept in state.
+	HistoryBlocks uint64 `protobuf:"varint,5,opt,name=history_blocks,json=historyBlocks,proto3" json:"history_blocks,omitempty" yaml:"history_blocks"`
+	// alternative_fee_denoms are the denoms, other than fee_denom, the fees can
+	// be paid in, sorted by denom. Their fees are converted to fee_denom at their
+	// rates.
+	AlternativeFeeDenoms []FeeDenomRate `protobuf:"bytes,6,rep,name=alternative_fee_denoms,json=alternativeFeeDenoms,proto3" json:"alternative_fee_denoms" yaml:"alternative_fee_denoms"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3047acb548fa7c8, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetFeeDenom() string {
+	if m != nil {
+		return m.FeeDenom
+	}
+	return ""
+}
+
+func (m *Params) GetBaseFeeChangeDenominator() uint32 {
+	if m != nil {
+		return m.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (m *Params) GetElasticityMultiplier() uint32 {
+	if m != nil {
+		return m.ElasticityMultiplier
+	}
+	return 0
+}
+
+func (m *Params) GetHistoryBlocks() uint64 {
+	if m != nil {
+		return m.HistoryBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetAlternativeFeeDenoms() []FeeDenomRate {
+	if m != nil {
+		return m.AlternativeFeeDenoms
+	}
+	return nil
+}
+
+// FeeDenomRate is an alternative fee denom with its rate, the amount of fee
+// denom one unit of it converts to.
+type FeeDenomRate struct {
+	Denom string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Rate  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+}
+
+func (m *FeeDenomRate) Reset()         { *m = FeeDenomRate{} }
+func (m *FeeDenomRate) String() string { return proto.CompactTextString(m) }
+func (*FeeDenomRate) ProtoMessage()    {}
+func (*FeeDenomRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3047acb548fa7c8, []int{1}
+}
+func (m *FeeDenomRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenomRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenomRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenomRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenomRate.Merge(m, src)
+}
+func (m *FeeDenomRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenomRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenomRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenomRate proto.InternalMessageInfo
+
+func (m *FeeDenomRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// BlockBaseFee is the base fee paid by the txs of a block, and the gas they
+// used.
+type BlockBaseFee struct {
+	Height  int64                                  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	BaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee" yaml:"base_fee"`
+	GasUsed uint64                                 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty" yaml:"gas_used"`
+}
+
+func (m *BlockBaseFee) Reset()         { *m = BlockBaseFee{} }
+func (m *BlockBaseFee) String() string { return proto.CompactTextString(m) }
+func (*BlockBaseFee) ProtoMessage()    {}
+func (*BlockBaseFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3047acb548fa7c8, []int{2}
+}
+func (m *BlockBaseFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockBaseFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockBaseFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockBaseFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockBaseFee.Merge(m, src)
+}
+func (m *BlockBaseFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockBaseFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockBaseFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockBaseFee proto.InternalMessageInfo
+
+func (m *BlockBaseFee) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockBaseFee) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmos.feemarket.v1beta1.Params")
+	proto.RegisterType((*FeeDenomRate)(nil), "cosmos.feemarket.v1beta1.FeeDenomRate")
+	proto.RegisterType((*BlockBaseFee)(nil), "cosmos.feemarket.v1beta1.BlockBaseFee")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/feemarket/v1beta1/feemarket.proto", fileDescriptor_f3047acb548fa7c8)
+}
+
+var fileDescriptor_f3047acb548fa7c8 = []byte{
+	// 544 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0x89, 0x9b, 0x26, 0x4b, 0x0a, 0xc8, 0x4d, 0x2b, 0xf3, 0x67, 0x47, 0x2b, 0x11, 0xe5,
+	0x82, 0xa3, 0xc0, 0x2d, 0x27, 0x30, 0xa1, 0xe2, 0x82, 0x84, 0x56, 0xea, 0x05, 0x21, 0x59, 0x1b,
+	0x67, 0xe2, 0x58, 0xb1, 0xbd, 0x91, 0x77, 0x53, 0x91, 0x33, 0x2f, 0xc0, 0x91, 0x63, 0x1f, 0xa2,
+	0x0f, 0xd1, 0x63, 0x8f, 0x88, 0x83, 0x85, 0x92, 0x0b, 0x67, 0x3f, 0x01, 0xca, 0xae, 0xf3, 0x53,
+	0xa9, 0xad, 0xd4, 0x93, 0xbd, 0xf3, 0x7d, 0x3b, 0xdf, 0xcc, 0x37, 0xb3, 0xa8, 0xed, 0x33, 0x1e,
+	0x33, 0xde, 0x19, 0x01, 0xc4, 0x34, 0x9d, 0x80, 0xe8, 0x9c, 0x75, 0x07, 0x20, 0x68, 0x77, 0x1b,
+	0x71, 0xa6, 0x29, 0x13, 0xcc, 0x30, 0x15, 0xd3, 0xd9, 0xc6, 0x0b, 0xe6, 0xb3, 0x46, 0xc0, 0x02,
+	0x26, 0x49, 0x9d, 0xd5, 0x9f, 0xe2, 0xe3, 0x0b, 0x1d, 0x55, 0xbe, 0xd0, 0x94, 0xc6, 0xdc, 0xe8,
+	0xa2, 0xda, 0x08, 0xc0, 0x1b, 0x42, 0xc2, 0x62, 0x53, 0x6b, 0x6a, 0xed, 0x9a, 0xdb, 0xc8, 0x33,
+	0xfb, 0xc9, 0x9c, 0xc6, 0x51, 0x0f, 0x6f, 0x20, 0x4c, 0xaa, 0x23, 0x80, 0xfe, 0xea, 0xd7, 0x00,
+	0xf4, 0x7c, 0x40, 0x39, 0x78, 0x2b, 0xd0, 0x1f, 0xd3, 0x24, 0x28, 0x38, 0x61, 0x42, 0x05, 0x4b,
+	0xcd, 0x07, 0x4d, 0xad, 0x7d, 0xe0, 0xb6, 0xf2, 0xcc, 0xc6, 0x2a, 0xc9, 0x1d, 0x64, 0x4c, 0xcc,
+	0x15, 0x7a, 0x02, 0xf0, 0x41, 0x62, 0xfd, 0x2d, 0x64, 0x9c, 0xa2, 0x23, 0x88, 0x28, 0x17, 0xa1,
+	0x1f, 0x8a, 0xb9, 0x17, 0xcf, 0x22, 0x11, 0x4e, 0xa3, 0x10, 0x52, 0xb3, 0x2c, 0x05, 0x9a, 0x79,
+	0x66, 0xbf, 0x50, 0x02, 0x37, 0xd2, 0x30, 0x69, 0x6c, 0xe3, 0x9f, 0x37, 0x61, 0x23, 0x40, 0xf5,
+	0x38, 0x4c, 0xbc, 0x75, 0x51, 0xa6, 0x2e, 0x7b, 0xfe, 0x78, 0x99, 0xd9, 0xa5, 0x3f, 0x99, 0xdd,
+	0x0a, 0x42, 0x31, 0x9e, 0x0d, 0x1c, 0x9f, 0xc5, 0x9d, 0xc2, 0x7e, 0xf5, 0x79, 0xcd, 0x87, 0x93,
+	0x8e, 0x98, 0x4f, 0x81, 0x3b, 0x7d, 0xf0, 0xf3, 0xcc, 0x3e, 0x54, 0xda, 0xbb, 0xb9, 0x30, 0x41,
+	0x71, 0x98, 0xb8, 0xaa, 0x21, 0xe3, 0x1d, 0x7a, 0x34, 0x0e, 0xb9, 0x60, 0xe9, 0xdc, 0x1b, 0x44,
+	0xcc, 0x9f, 0x70, 0x73, 0xaf, 0xa9, 0xb5, 0x75, 0xf7, 0x69, 0x9e, 0xd9, 0x47, 0xea, 0xf2, 0x75,
+	0x1c, 0x93, 0x83, 0x22, 0xe0, 0xca, 0xb3, 0xf1, 0x43, 0x43, 0xc7, 0x34, 0x12, 0x90, 0x26, 0x54,
+	0x84, 0x67, 0xca, 0x43, 0x69, 0x1e, 0x37, 0x2b, 0xcd, 0x72, 0xfb, 0xe1, 0x9b, 0x96, 0x73, 0xdb,
+	0xe0, 0x9d, 0x93, 0x62, 0x5a, 0x84, 0x0a, 0x70, 0x5f, 0xad, 0xba, 0xcb, 0x33, 0xfb, 0xa5, 0x92,
+	0xbd, 0x39, 0x27, 0x26, 0x8d, 0x1d, 0x60, 0x7d, 0x9f, 0xf7, 0xaa, 0xbf, 0xce, 0xed, 0xd2, 0xbf,
+	0x73, 0x5b, 0xc3, 0x09, 0xaa, 0xef, 0xa6, 0x35, 0x1a, 0x68, 0x6f, 0x67, 0x6f, 0x88, 0x3a, 0x18,
+	0x2e, 0xd2, 0x53, 0x2a, 0x40, 0xee, 0x41, 0xcd, 0x75, 0xee, 0x67, 0x2c, 0x91, 0x77, 0x7b, 0xba,
+	0xd4, 0xbb, 0xd0, 0x50, 0x5d, 0x5a, 0xb1, 0xb6, 0xf4, 0x18, 0x55, 0xc6, 0x10, 0x06, 0x63, 0x21,
+	0x15, 0xcb, 0xa4, 0x38, 0x19, 0xdf, 0x50, 0x75, 0x33, 0x4f, 0x25, 0xfb, 0xfe, 0xde, 0xf3, 0x7c,
+	0x7c, 0x7d, 0x59, 0x31, 0xd9, 0x2f, 0x36, 0xd3, 0x70, 0x50, 0x35, 0xa0, 0xdc, 0x9b, 0x71, 0x18,
+	0xca, 0xdd, 0xd3, 0xdd, 0xc3, 0x2d, 0x7f, 0x8d, 0x60, 0xb2, 0x1f, 0x50, 0x7e, 0xca, 0x61, 0xe8,
+	0x7e, 0xba, 0x5c, 0x58, 0xda, 0xd5, 0xc2, 0xd2, 0xfe, 0x2e, 0x2c, 0xed, 0xe7, 0xd2, 0x2a, 0x5d,
+	0x2d, 0xad, 0xd2, 0xef, 0xa5, 0x55, 0xfa, 0xea, 0xdc, 0x59, 0xcd, 0xf7, 0x9d, 0x97, 0x2e, 0x2b,
+	0x1b, 0x54, 0xe4, 0x73, 0x7d, 0xfb, 0x7f, 0x00, 0x99, 0x4d, 0xa5, 0x6e, 0x0a, 0x04, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FeeDenom != that1.FeeDenom {
+		return false
+	}
+	if this.BaseFeeChangeDenominator != that1.BaseFeeChangeDenominator {
+		return false
+	}
+	if this.ElasticityMultiplier != that1.ElasticityMultiplier {
+		return false
+	}
+	if !this.MinBaseFee.Equal(that1.MinBaseFee) {
+		return false
+	}
+	if this.HistoryBlocks != that1.HistoryBlocks {
+		return false
+	}
+	if len(this.AlternativeFeeDenoms) != len(that1.AlternativeFeeDenoms) {
+		return false
+	}
+	for i := range this.AlternativeFeeDenoms {
+		if !this.AlternativeFeeDenoms[i].Equal(&that1.AlternativeFeeDenoms[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *FeeDenomRate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeDenomRate)
+	if !ok {
+		that2, ok := that.(FeeDenomRate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Rate.Equal(that1.Rate) {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AlternativeFeeDenoms) > 0 {
+		for iNdEx := len(m.AlternativeFeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AlternativeFeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.HistoryBlocks != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.HistoryBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ElasticityMultiplier != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.ElasticityMultiplier))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FeeDenom) > 0 {
+		i -= len(m.FeeDenom)
+		copy(dAtA[i:], m.FeeDenom)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.FeeDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDenomRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenomRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenomRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockBaseFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockBaseFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockBaseFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeemarket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeeDenom)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeChangeDenominator))
+	}
+	if m.ElasticityMultiplier != 0 {
+		n += 1 + sovFeemarket(uint64(m.ElasticityMultiplier))
+	}
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.HistoryBlocks != 0 {
+		n += 1 + sovFeemarket(uint64(m.HistoryBlocks))
+	}
+	if len(m.AlternativeFeeDenoms) > 0 {
+		for _, e := range m.AlternativeFeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeDenomRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func (m *BlockBaseFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeemarket(uint64(m.Height))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.GasUsed != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func sovFeemarket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeemarket(x uint64) (n int) {
+	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElasticityMultiplier", wireType)
+			}
+			m.ElasticityMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElasticityMultiplier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryBlocks", wireType)
+			}
+			m.HistoryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlternativeFeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AlternativeFeeDenoms = append(m.AlternativeFeeDenoms, FeeDenomRate{})
+			if err := m.AlternativeFeeDenoms[len(m.AlternativeFeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenomRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenomRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenomRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockBaseFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockBaseFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockBaseFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeemarket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeemarket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeemarket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeemarket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeemarket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeemarket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeemarket = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feemarket"
+
+	// StoreKey is the default store key for feemarket
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the feemarket store.
+	QuerierRoute = StoreKey
+
+	// FeeReserveName is the name of the module account exchanging the fees paid
+	// in the alternative fee denoms for fee denom tokens.
+	FeeReserveName = "fee_reserve"
+)
+
+// Keys for feemarket store
+// Items are stored with the following key: values
+//
+// - 0x01: base fee sdk.Dec
+//
+// - 0x02<height_Bytes>: BlockBaseFee
+var (
+	BaseFeeKey            = []byte{0x01}
+	BlockBaseFeeKeyPrefix = []byte{0x02}
+)
+
+// BlockBaseFeeKey returns the key of the base fee of the block at the given
+// height.
+func BlockBaseFeeKey(height int64) []byte {
+	return append(append([]byte{}, BlockBaseFeeKeyPrefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
@@ -0,0 +1,225 @@
+package types
+
+import (
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Default parameter values, as in EIP-1559. The default base fee and min base
+// fee are zero, which disables the fee market until either is set.
+const (
+	DefaultBaseFeeChangeDenominator uint32 = 8
+	DefaultElasticityMultiplier     uint32 = 2
+	DefaultHistoryBlocks            uint64 = 100
+)
+
+var (
+	DefaultFeeDenom   = sdk.DefaultBondDenom
+	DefaultMinBaseFee = sdk.ZeroDec()
+	DefaultBaseFee    = sdk.ZeroDec()
+)
+
+// Parameter store keys
+var (
+	KeyFeeDenom                 = []byte("FeeDenom")
+	KeyBaseFeeChangeDenominator = []byte("BaseFeeChangeDenominator")
+	KeyElasticityMultiplier     = []byte("ElasticityMultiplier")
+	KeyMinBaseFee               = []byte("MinBaseFee")
+	KeyHistoryBlocks            = []byte("HistoryBlocks")
+	KeyAlternativeFeeDenoms     = []byte("AlternativeFeeDenoms")
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// ParamKeyTable for feemarket module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(
+	feeDenom string, baseFeeChangeDenominator, elasticityMultiplier uint32, minBaseFee sdk.Dec, historyBlocks uint64,
+	alternativeFeeDenoms []FeeDenomRate,
+) Params {
+	return Params{
+		FeeDenom:                 feeDenom,
+		BaseFeeChangeDenominator: baseFeeChangeDenominator,
+		ElasticityMultiplier:     elasticityMultiplier,
+		MinBaseFee:               minBaseFee,
+		HistoryBlocks:            historyBlocks,
+		AlternativeFeeDenoms:     alternativeFeeDenoms,
+	}
+}
+
+// NewFeeDenomRate creates a new FeeDenomRate object
+func NewFeeDenomRate(denom string, rate sdk.Dec) FeeDenomRate {
+	return FeeDenomRate{
+		Denom: denom,
+		Rate:  rate,
+	}
+}
+
+// DefaultParams returns default feemarket module parameters
+func DefaultParams() Params {
+	return Params{
+		FeeDenom:                 DefaultFeeDenom,
+		BaseFeeChangeDenominator: DefaultBaseFeeChangeDenominator,
+		ElasticityMultiplier:     DefaultElasticityMultiplier,
+		MinBaseFee:               DefaultMinBaseFee,
+		HistoryBlocks:            DefaultHistoryBlocks,
+		AlternativeFeeDenoms:     DefaultAlternativeFeeDenoms(),
+	}
+}
+
+// DefaultAlternativeFeeDenoms returns the default alternative fee denoms, which
+// are empty: the fees can only be paid in the fee denom.
+func DefaultAlternativeFeeDenoms() []FeeDenomRate {
+	return nil
+}
+
+// AlternativeFeeDenomRate returns the rate of the alternative fee denom, and
+// false if the fees cannot be paid in the denom.
+func (p Params) AlternativeFeeDenomRate(denom string) (sdk.Dec, bool) {
+	for _, dr := range p.AlternativeFeeDenoms {
+		if dr.Denom == denom {
+			return dr.Rate, true
+		}
+	}
+
+	return sdk.Dec{}, false
+}
+
+// Validate validates the params
+func (p Params) Validate() error {
+	if err := validateFeeDenom(p.FeeDenom); err != nil {
+		return err
+	}
+	if err := validateBaseFeeChangeDenominator(p.BaseFeeChangeDenominator); err != nil {
+		return err
+	}
+	if err := validateElasticityMultiplier(p.ElasticityMultiplier); err != nil {
+		return err
+	}
+	if err := validateMinBaseFee(p.MinBaseFee); err != nil {
+		return err
+	}
+
+	if err := validateHistoryBlocks(p.HistoryBlocks); err != nil {
+		return err
+	}
+	if err := validateAlternativeFeeDenoms(p.AlternativeFeeDenoms); err != nil {
+		return err
+	}
+
+	for _, dr := range p.AlternativeFeeDenoms {
+		if dr.Denom == p.FeeDenom {
+			return fmt.Errorf("alternative fee denom %s is the fee denom", dr.Denom)
+		}
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyFeeDenom, &p.FeeDenom, validateFeeDenom),
+		paramtypes.NewParamSetPair(KeyBaseFeeChangeDenominator, &p.BaseFeeChangeDenominator, validateBaseFeeChangeDenominator),
+		paramtypes.NewParamSetPair(KeyElasticityMultiplier, &p.ElasticityMultiplier, validateElasticityMultiplier),
+		paramtypes.NewParamSetPair(KeyMinBaseFee, &p.MinBaseFee, validateMinBaseFee),
+		paramtypes.NewParamSetPair(KeyHistoryBlocks, &p.HistoryBlocks, validateHistoryBlocks),
+		paramtypes.NewParamSetPair(KeyAlternativeFeeDenoms, &p.AlternativeFeeDenoms, validateAlternativeFeeDenoms),
+	}
+}
+
+func validateFeeDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := sdk.ValidateDenom(v); err != nil {
+		return fmt.Errorf("invalid fee denom: %w", err)
+	}
+
+	return nil
+}
+
+func validateBaseFeeChangeDenominator(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("base fee change denominator must be positive")
+	}
+
+	return nil
+}
+
+func validateElasticityMultiplier(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("elasticity multiplier must be positive")
+	}
+
+	return nil
+}
+
+func validateMinBaseFee(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("min base fee must be non-negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateHistoryBlocks(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateAlternativeFeeDenoms(i interface{}) error {
+	v, ok := i.([]FeeDenomRate)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for i, dr := range v {
+		if err := sdk.ValidateDenom(dr.Denom); err != nil {
+			return fmt.Errorf("invalid alternative fee denom: %w", err)
+		}
+		if i > 0 && dr.Denom <= v[i-1].Denom {
+			return fmt.Errorf("alternative fee denoms must be sorted by unique denom: %s", dr.Denom)
+		}
+		if dr.Rate.IsNil() || !dr.Rate.IsPositive() {
+			return fmt.Errorf("alternative fee denom %s rate must be positive: %s", dr.Denom, dr.Rate)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/genesishash/types"
+)
+
+// NewGenesisCmd returns the genesis commands, to verify a genesis file
+// against the genesis hash recorded on chain.
+func NewGenesisCmd() *cobra.Command {
+	genesisCmd := &cobra.Command{
+		Use:                        "genesis",
+		Short:                      "Genesis file subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	genesisCmd.AddCommand(
+		GetCmdVerifyGenesis(),
+	)
+
+	return genesisCmd
+}
+
+// GetCmdVerifyGenesis implements a command to verify a genesis file against
+// the genesis hash recorded on chain.
+func GetCmdVerifyGenesis() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify [file]",
+		Short: "Verify a genesis file against the genesis hash recorded on chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Verify that a genesis file, e.g. a downloaded one, is the genesis the chain
+was initialized from: its chain id, initial height and the sha256 hash of its
+app state must match the ones recorded on chain at InitChain, as queried from
+the node. The validators and consensus params of the genesis are not verified.
+
+Example:
+$ %s genesis verify genesis.json --node https://rpc.example.com:443
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			genDoc, err := tmtypes.GenesisDocFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GenesisHash(cmd.Context(), &types.QueryGenesisHashRequest{})
+			if err != nil {
+				return err
+			}
+
+			genesisHash := types.NewGenesisHash(genDoc.ChainID, genDoc.InitialHeight, genDoc.AppState)
+			if err := genesisHash.Verify(res.GenesisHash); err != nil {
+				return fmt.Errorf("genesis file %s does not match the genesis of the chain: %w", args[0], err)
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("Genesis file %s matches the genesis of chain %s (app state hash %X)\n", args[0], genDoc.ChainID, genesisHash.AppStateHash))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/x/genesishash/types"
+)
+
+// GetQueryCmd returns the cli query commands for the genesishash module.
+func GetQueryCmd() *cobra.Command {
+	genesisHashQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the genesishash module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	genesisHashQueryCmd.AddCommand(
+		GetCmdQueryGenesisHash(),
+	)
+
+	return genesisHashQueryCmd
+}
+
+// GetCmdQueryGenesisHash implements a command to return the hash of the
+// genesis the chain was initialized from.
+func GetCmdQueryGenesisHash() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "genesis-hash",
+		Short: "Query the hash of the genesis the chain was initialized from",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GenesisHash(cmd.Context(), &types.QueryGenesisHashRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.GenesisHash)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
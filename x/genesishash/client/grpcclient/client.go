@@ -0,0 +1,40 @@
+// Code generated by typedgen. DO NOT EDIT.
+
+// Package grpcclient implements the typed clients of the genesishash module.
+package grpcclient
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/typed"
+	"github.com/cosmos/cosmos-sdk/x/genesishash/types"
+)
+
+// QueryClient is the typed client of the genesishash Query service, retrying
+// the queries failing with a transient error.
+type QueryClient struct {
+	client types.QueryClient
+	retry  typed.RetryConfig
+}
+
+// NewQueryClient returns a QueryClient querying through the connection.
+func NewQueryClient(conn gogogrpc.ClientConn, retry typed.RetryConfig) *QueryClient {
+	return &QueryClient{
+		client: types.NewQueryClient(conn),
+		retry:  retry,
+	}
+}
+
+// GenesisHash calls the genesishash Query/GenesisHash method.
+func (c *QueryClient) GenesisHash(ctx context.Context, req *types.QueryGenesisHashRequest, opts ...grpc.CallOption) (*types.QueryGenesisHashResponse, error) {
+	var res *types.QueryGenesisHashResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.GenesisHash(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
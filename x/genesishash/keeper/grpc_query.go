@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genesishash/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// GenesisHash returns the hash of the genesis the chain was initialized from.
+func (k Keeper) GenesisHash(c context.Context, _ *types.QueryGenesisHashRequest) (*types.QueryGenesisHashResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	genesisHash, found := k.GetGenesisHash(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "no genesis hash recorded")
+	}
+
+	return &types.QueryGenesisHashResponse{GenesisHash: genesisHash}, nil
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genesishash/types"
+)
+
+// Keeper of the genesishash store
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey sdk.StoreKey
+}
+
+// NewKeeper creates a new genesishash Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: key,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetGenesisHash returns the hash of the genesis the chain was initialized
+// from, if it was recorded.
+func (k Keeper) GetGenesisHash(ctx sdk.Context) (genesisHash types.GenesisHash, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GenesisHashKey)
+	if bz == nil {
+		return genesisHash, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &genesisHash)
+	return genesisHash, true
+}
+
+// SetGenesisHash sets the hash of the genesis the chain was initialized from.
+func (k Keeper) SetGenesisHash(ctx sdk.Context, genesisHash types.GenesisHash) {
+	ctx.KVStore(k.storeKey).Set(types.GenesisHashKey, k.cdc.MustMarshal(&genesisHash))
+}
+
+// RecordGenesisHash records the hash of the genesis of the InitChain request.
+// It must be called by the InitChainer of the app, which receives the whole
+// app state of the genesis, unlike the InitGenesis of the modules.
+func (k Keeper) RecordGenesisHash(ctx sdk.Context, req abci.RequestInitChain) {
+	k.SetGenesisHash(ctx, types.NewGenesisHash(req.ChainId, req.InitialHeight, req.AppStateBytes))
+}
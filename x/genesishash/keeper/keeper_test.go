@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genesishash/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = simapp.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.app.GenesisHashKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func (suite *KeeperTestSuite) TestRecordGenesisHash() {
+	// the genesis hash is recorded by the InitChainer of the app
+	_, found := suite.app.GenesisHashKeeper.GetGenesisHash(suite.ctx)
+	suite.Require().True(found)
+
+	appState := []byte(`{"bank":{}}`)
+	suite.app.GenesisHashKeeper.RecordGenesisHash(suite.ctx, abci.RequestInitChain{
+		ChainId: "test-chain", InitialHeight: 5, AppStateBytes: appState,
+	})
+
+	res, err := suite.queryClient.GenesisHash(context.Background(), &types.QueryGenesisHashRequest{})
+	suite.Require().NoError(err)
+	hash := sha256.Sum256(appState)
+	suite.Require().Equal(types.GenesisHash{ChainId: "test-chain", InitialHeight: 5, AppStateHash: hash[:]}, res.GenesisHash)
+
+	suite.Require().NoError(types.NewGenesisHash("test-chain", 5, appState).Verify(res.GenesisHash))
+	suite.Require().EqualError(
+		types.NewGenesisHash("other-chain", 5, appState).Verify(res.GenesisHash),
+		"chain id other-chain does not match the expected test-chain",
+	)
+	suite.Require().EqualError(
+		types.NewGenesisHash("test-chain", 1, appState).Verify(res.GenesisHash),
+		"initial height 1 does not match the expected 5",
+	)
+	suite.Require().Error(types.NewGenesisHash("test-chain", 5, []byte(`{"bank": {}}`)).Verify(res.GenesisHash))
+}
+
+func (suite *KeeperTestSuite) TestGenesisHashNotFound() {
+	ctx, _ := suite.ctx.CacheContext()
+	ctx.KVStore(suite.app.GetKey(types.StoreKey)).Delete(types.GenesisHashKey)
+
+	_, err := suite.app.GenesisHashKeeper.GenesisHash(sdk.WrapSDKContext(ctx), &types.QueryGenesisHashRequest{})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+)
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(_ GenesisState) error {
+	return nil
+}
+
+// NewGenesisHash returns the GenesisHash of a genesis with the given chain id,
+// initial height and app state.
+func NewGenesisHash(chainID string, initialHeight int64, appState []byte) GenesisHash {
+	hash := sha256.Sum256(appState)
+
+	return GenesisHash{
+		ChainId:       chainID,
+		InitialHeight: initialHeight,
+		AppStateHash:  hash[:],
+	}
+}
+
+// Verify returns an error if the genesis hash does not match the expected one,
+// e.g. the one recorded on chain.
+func (h GenesisHash) Verify(expected GenesisHash) error {
+	if h.ChainId != expected.ChainId {
+		return fmt.Errorf("chain id %s does not match the expected %s", h.ChainId, expected.ChainId)
+	}
+	if h.InitialHeight != expected.InitialHeight {
+		return fmt.Errorf("initial height %d does not match the expected %d", h.InitialHeight, expected.InitialHeight)
+	}
+	if !bytes.Equal(h.AppStateHash, expected.AppStateHash) {
+		return fmt.Errorf("app state hash %X does not match the expected %X", h.AppStateHash, expected.AppStateHash)
+	}
+
+	return nil
+}
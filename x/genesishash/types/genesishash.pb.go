@@ -0,0 +1,413 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/genesishash/v1beta1/genesishash.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisHash is the hash of the genesis the chain was initialized from, with
+// its chain id and initial height.
+type GenesisHash struct {
+	ChainId       string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	InitialHeight int64  `protobuf:"varint,2,opt,name=initial_height,json=initialHeight,proto3" json:"initial_height,omitempty"`
+	// app_state_hash is the sha256 hash of the app_state of the genesis file, as
+	// passed to InitChain.
+	AppStateHash []byte `protobuf:"bytes,3,opt,name=app_state_hash,json=appStateHash,proto3" json:"app_state_hash,omitempty"`
+}
+
+func (m *GenesisHash) Reset()         { *m = GenesisHash{} }
+func (m *GenesisHash) String() string { return proto.CompactTextString(m) }
+func (*GenesisHash) ProtoMessage()    {}
+func (*GenesisHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d80885b4dbcd65f, []int{0}
+}
+func (m *GenesisHash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisHash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisHash.Merge(m, src)
+}
+func (m *GenesisHash) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisHash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisHash proto.InternalMessageInfo
+
+func (m *GenesisHash) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *GenesisHash) GetInitialHeight() int64 {
+	if m != nil {
+		return m.InitialHeight
+	}
+	return 0
+}
+
+func (m *GenesisHash) GetAppStateHash() []byte {
+	if m != nil {
+		return m.AppStateHash
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisHash)(nil), "cosmos.genesishash.v1beta1.GenesisHash")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/genesishash/v1beta1/genesishash.proto", fileDescriptor_5d80885b4dbcd65f)
+}
+
+var fileDescriptor_5d80885b4dbcd65f = []byte{
+	// 226 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x49, 0xce, 0x2f, 0xce,
+	0xcd, 0x2f, 0xd6, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xce, 0x48, 0x2c, 0xce, 0xd0, 0x2f,
+	0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0x44, 0x16, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92,
+	0x82, 0xa8, 0xd6, 0x43, 0x96, 0x81, 0xaa, 0x56, 0x2a, 0xe5, 0xe2, 0x76, 0x87, 0x08, 0x7b, 0x24,
+	0x16, 0x67, 0x08, 0x49, 0x72, 0x71, 0x24, 0x67, 0x24, 0x66, 0xe6, 0xc5, 0x67, 0xa6, 0x48, 0x30,
+	0x2a, 0x30, 0x6a, 0x70, 0x06, 0xb1, 0x83, 0xf9, 0x9e, 0x29, 0x42, 0xaa, 0x5c, 0x7c, 0x99, 0x79,
+	0x99, 0x25, 0x99, 0x89, 0x39, 0xf1, 0x19, 0xa9, 0x99, 0xe9, 0x19, 0x25, 0x12, 0x4c, 0x0a, 0x8c,
+	0x1a, 0xcc, 0x41, 0xbc, 0x50, 0x51, 0x0f, 0xb0, 0xa0, 0x90, 0x0a, 0x17, 0x5f, 0x62, 0x41, 0x41,
+	0x7c, 0x71, 0x49, 0x62, 0x49, 0x6a, 0x3c, 0xc8, 0x2a, 0x09, 0x66, 0x05, 0x46, 0x0d, 0x9e, 0x20,
+	0x9e, 0xc4, 0x82, 0x82, 0x60, 0x90, 0x20, 0xc8, 0x1e, 0x27, 0xaf, 0x13, 0x8f, 0xe4, 0x18, 0x2f,
+	0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18,
+	0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x48, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf,
+	0xd5, 0x87, 0xfa, 0x12, 0x42, 0xe9, 0x16, 0xa7, 0x64, 0xeb, 0x57, 0xa0, 0x78, 0xb9, 0xa4, 0xb2,
+	0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x4b, 0x63, 0xc0, 0x00, 0xd2, 0x3e, 0xec, 0x22, 0x15, 0x01,
+	0x00, 0x00,
+}
+
+func (m *GenesisHash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisHash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisHash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppStateHash) > 0 {
+		i -= len(m.AppStateHash)
+		copy(dAtA[i:], m.AppStateHash)
+		i = encodeVarintGenesishash(dAtA, i, uint64(len(m.AppStateHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.InitialHeight != 0 {
+		i = encodeVarintGenesishash(dAtA, i, uint64(m.InitialHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesishash(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesishash(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesishash(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisHash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesishash(uint64(l))
+	}
+	if m.InitialHeight != 0 {
+		n += 1 + sovGenesishash(uint64(m.InitialHeight))
+	}
+	l = len(m.AppStateHash)
+	if l > 0 {
+		n += 1 + l + sovGenesishash(uint64(l))
+	}
+	return n
+}
+
+func sovGenesishash(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesishash(x uint64) (n int) {
+	return sovGenesishash(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisHash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesishash
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisHash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisHash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesishash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesishash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesishash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialHeight", wireType)
+			}
+			m.InitialHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesishash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppStateHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesishash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesishash
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesishash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppStateHash = append(m.AppStateHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppStateHash == nil {
+				m.AppStateHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesishash(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesishash
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesishash(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesishash
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesishash
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesishash
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesishash
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesishash
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesishash
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesishash        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesishash          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesishash = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,20 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "genesishash"
+
+	// StoreKey is the default store key for genesishash
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the genesishash store.
+	QuerierRoute = StoreKey
+)
+
+// Keys for genesishash store
+// Items are stored with the following key: values
+//
+// - 0x01: GenesisHash
+var (
+	GenesisHashKey = []byte{0x01}
+)
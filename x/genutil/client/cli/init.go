@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/cosmos/go-bip39"
 	"github.com/pkg/errors"
@@ -20,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/server"
+	srvconfig "github.com/cosmos/cosmos-sdk/server/config"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
@@ -31,6 +33,9 @@ const (
 
 	// FlagSeed defines a flag to initialize the private validator key from a specific seed.
 	FlagRecover = "recover"
+
+	// FlagPreset defines a flag to apply the configuration preset of a node role.
+	FlagPreset = "preset"
 )
 
 type printInfo struct {
@@ -68,8 +73,13 @@ func InitCmd(mbm module.BasicManager, defaultNodeHome string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "init [moniker]",
 		Short: "Initialize private validator, p2p, genesis, and application configuration files",
-		Long:  `Initialize validators's and node's configuration files.`,
-		Args:  cobra.ExactArgs(1),
+		Long: `Initialize validators's and node's configuration files.
+
+With --preset, the opinionated settings of config.toml and app.toml of a node
+role are applied: pruning, indexing, peer exchange and exposure of the RPC, API
+and gRPC servers. The role is recorded in app.toml, against which the config
+doctor command checks the configuration.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 			cdc := clientCtx.Codec
@@ -78,6 +88,15 @@ func InitCmd(mbm module.BasicManager, defaultNodeHome string) *cobra.Command {
 			config := serverCtx.Config
 			config.SetRoot(clientCtx.HomeDir)
 
+			var preset *srvconfig.Preset
+			if presetName, _ := cmd.Flags().GetString(FlagPreset); presetName != "" {
+				p, err := srvconfig.GetPreset(presetName)
+				if err != nil {
+					return err
+				}
+				preset = &p
+			}
+
 			chainID, _ := cmd.Flags().GetString(flags.FlagChainID)
 			if chainID == "" {
 				chainID = fmt.Sprintf("test-chain-%v", tmrand.Str(6))
@@ -140,6 +159,12 @@ func InitCmd(mbm module.BasicManager, defaultNodeHome string) *cobra.Command {
 
 			toPrint := newPrintInfo(config.Moniker, chainID, nodeID, "", appState)
 
+			if preset != nil {
+				if err := preset.Apply(config, filepath.Join(config.RootDir, "config", "app.toml")); err != nil {
+					return err
+				}
+			}
+
 			cfg.WriteConfigFile(filepath.Join(config.RootDir, "config", "config.toml"), config)
 			return displayInfo(toPrint)
 		},
@@ -149,6 +174,7 @@ func InitCmd(mbm module.BasicManager, defaultNodeHome string) *cobra.Command {
 	cmd.Flags().BoolP(FlagOverwrite, "o", false, "overwrite the genesis.json file")
 	cmd.Flags().Bool(FlagRecover, false, "provide seed phrase to recover existing key instead of creating")
 	cmd.Flags().String(flags.FlagChainID, "", "genesis file chain-id, if left blank will be randomly created")
+	cmd.Flags().String(FlagPreset, "", fmt.Sprintf("apply the configuration preset of a node role (%s)", strings.Join(srvconfig.PresetNames(), "|")))
 
 	return cmd
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -20,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/server"
+	srvconfig "github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/server/mock"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -117,6 +119,48 @@ func TestInitRecover(t *testing.T) {
 	require.NoError(t, cmd.ExecuteContext(ctx))
 }
 
+func TestInitPreset(t *testing.T) {
+	home := t.TempDir()
+	logger := log.NewNopLogger()
+	cfg, err := genutiltest.CreateDefaultTendermintConfig(home)
+	require.NoError(t, err)
+	appConfigPath := filepath.Join(home, "config", "app.toml")
+	srvconfig.WriteConfigFile(appConfigPath, srvconfig.DefaultConfig())
+
+	serverCtx := server.NewContext(viper.New(), cfg, logger)
+	interfaceRegistry := types.NewInterfaceRegistry()
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+	clientCtx := client.Context{}.
+		WithCodec(marshaler).
+		WithLegacyAmino(makeCodec()).
+		WithHomeDir(home)
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+	ctx = context.WithValue(ctx, server.ServerContextKey, serverCtx)
+
+	cmd := genutilcli.InitCmd(testMbm, home)
+	cmd.SetArgs([]string{"appnode-test", fmt.Sprintf("--%s=%s", genutilcli.FlagPreset, srvconfig.RoleValidator)})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	v := viper.New()
+	v.SetConfigFile(filepath.Join(home, "config", "config.toml"))
+	require.NoError(t, v.ReadInConfig())
+	require.False(t, v.GetBool("p2p.pex"))
+	require.Equal(t, "tcp://127.0.0.1:26657", v.GetString("rpc.laddr"))
+	require.Equal(t, "null", v.GetString("tx_index.indexer"))
+
+	v = viper.New()
+	v.SetConfigFile(appConfigPath)
+	require.NoError(t, v.ReadInConfig())
+	require.Equal(t, srvconfig.RoleValidator, v.GetString("node-role"))
+	require.False(t, v.GetBool("grpc-web.enable"))
+
+	cmd = genutilcli.InitCmd(testMbm, home)
+	cmd.SetArgs([]string{"appnode-test", "--overwrite", fmt.Sprintf("--%s=unknown", genutilcli.FlagPreset)})
+	require.Error(t, cmd.ExecuteContext(ctx))
+}
+
 func TestEmptyState(t *testing.T) {
 	home := t.TempDir()
 	logger := log.NewNopLogger()
@@ -144,7 +188,7 @@ func TestEmptyState(t *testing.T) {
 	r, w, _ := os.Pipe()
 	os.Stdout = w
 
-	cmd = server.ExportCmd(nil, home)
+	cmd = server.ExportCmd(nil, nil, home)
 	cmd.SetArgs([]string{fmt.Sprintf("--%s=%s", cli.HomeFlag, home)})
 	require.NoError(t, cmd.ExecuteContext(ctx))
 
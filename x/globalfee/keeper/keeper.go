@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/globalfee/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper of the globalfee params. The chain-wide minimum gas prices are
+// params, set by governance through parameter change proposals.
+type Keeper struct {
+	paramSpace paramtypes.Subspace
+}
+
+// NewKeeper creates a new globalfee Keeper instance
+func NewKeeper(paramSpace paramtypes.Subspace) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		paramSpace: paramSpace,
+	}
+}
+
+// GetParams returns the total set of globalfee parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of globalfee parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetMinimumGasPrices returns the chain-wide minimum gas prices. It returns no
+// gas prices before the params are initialized, e.g. for the genesis txs.
+func (k Keeper) GetMinimumGasPrices(ctx sdk.Context) (minGasPrices sdk.DecCoins) {
+	k.paramSpace.GetIfExists(ctx, types.KeyMinimumGasPrices, &minGasPrices)
+	return minGasPrices
+}
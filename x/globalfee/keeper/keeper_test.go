@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/globalfee/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = simapp.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.app.GlobalFeeKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func (suite *KeeperTestSuite) TestParams() {
+	// no chain-wide minimum gas prices by default
+	suite.Require().Empty(suite.app.GlobalFeeKeeper.GetMinimumGasPrices(suite.ctx))
+
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(25, 3)))
+	suite.app.GlobalFeeKeeper.SetParams(suite.ctx, types.NewParams(minGasPrices))
+	suite.Require().Equal(minGasPrices, suite.app.GlobalFeeKeeper.GetMinimumGasPrices(suite.ctx))
+
+	res, err := suite.queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewParams(minGasPrices), res.Params)
+}
+
+func (suite *KeeperTestSuite) TestValidateGenesis() {
+	suite.Require().NoError(types.ValidateGenesis(*types.DefaultGenesisState()))
+
+	valid := sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 3)), sdk.NewDecCoinFromDec("stake", sdk.OneDec()))
+	suite.Require().NoError(types.ValidateGenesis(*types.NewGenesisState(types.NewParams(valid))))
+
+	unsorted := sdk.DecCoins{sdk.NewDecCoinFromDec("stake", sdk.OneDec()), sdk.NewDecCoinFromDec("atom", sdk.OneDec())}
+	suite.Require().Error(types.ValidateGenesis(*types.NewGenesisState(types.NewParams(unsorted))))
+
+	zero := sdk.DecCoins{sdk.NewDecCoinFromDec("stake", sdk.ZeroDec())}
+	suite.Require().Error(types.ValidateGenesis(*types.NewGenesisState(types.NewParams(zero))))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,9 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "globalfee"
+
+	// QuerierRoute is the querier route for the globalfee module.
+	QuerierRoute = ModuleName
+)
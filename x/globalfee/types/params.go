@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyMinimumGasPrices = []byte("MinimumGasPrices")
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// ParamKeyTable for globalfee module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(minimumGasPrices sdk.DecCoins) Params {
+	return Params{
+		MinimumGasPrices: minimumGasPrices,
+	}
+}
+
+// DefaultParams returns default globalfee module parameters, without chain-wide
+// minimum gas prices.
+func DefaultParams() Params {
+	return Params{
+		MinimumGasPrices: sdk.DecCoins{},
+	}
+}
+
+// Validate validates the params
+func (p Params) Validate() error {
+	return validateMinimumGasPrices(p.MinimumGasPrices)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMinimumGasPrices, &p.MinimumGasPrices, validateMinimumGasPrices),
+	}
+}
+
+func validateMinimumGasPrices(i interface{}) error {
+	v, ok := i.(sdk.DecCoins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid minimum gas prices: %w", err)
+	}
+
+	return nil
+}
@@ -91,6 +91,7 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 
 		keeper.SetProposal(ctx, proposal)
 		keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+		keeper.DeleteTallySnapshot(ctx, proposal.ProposalId)
 
 		// when proposal become active
 		keeper.AfterProposalVotingPeriodEnded(ctx, proposal.ProposalId)
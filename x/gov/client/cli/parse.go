@@ -21,6 +21,8 @@ func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
 		proposal.Description, _ = fs.GetString(FlagDescription)
 		proposal.Type = govutils.NormalizeProposalType(proposalType)
 		proposal.Deposit, _ = fs.GetString(FlagDeposit)
+		proposal.Metadata, _ = fs.GetString(FlagMetadata)
+		proposal.MetadataHash, _ = fs.GetString(FlagMetadataHash)
 		return proposal, nil
 	}
 
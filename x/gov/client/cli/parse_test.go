@@ -14,7 +14,9 @@ func TestParseSubmitProposalFlags(t *testing.T) {
   "title": "Test Proposal",
   "description": "My awesome proposal",
   "type": "Text",
-  "deposit": "1000test"
+  "deposit": "1000test",
+  "metadata": "ipfs://QmcwnCamp7UWgVfmB7nMwTsgdWJL8x8wNSXHtHkAmbBaxK",
+  "metadata_hash": "7f83b1657ff1fc53b92dc18148a1d65dfc2d4b1fa3d677284addd200126d9069"
 }
 `)
 
@@ -39,6 +41,8 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	require.Equal(t, "My awesome proposal", proposal1.Description)
 	require.Equal(t, "Text", proposal1.Type)
 	require.Equal(t, "1000test", proposal1.Deposit)
+	require.Equal(t, "ipfs://QmcwnCamp7UWgVfmB7nMwTsgdWJL8x8wNSXHtHkAmbBaxK", proposal1.Metadata)
+	require.Equal(t, "7f83b1657ff1fc53b92dc18148a1d65dfc2d4b1fa3d677284addd200126d9069", proposal1.MetadataHash)
 
 	// flags that can't be used with --proposal
 	for _, incompatibleFlag := range ProposalFlags {
@@ -54,6 +58,8 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	fs.Set(FlagDescription, proposal1.Description)
 	fs.Set(FlagProposalType, proposal1.Type)
 	fs.Set(FlagDeposit, proposal1.Deposit)
+	fs.Set(FlagMetadata, proposal1.Metadata)
+	fs.Set(FlagMetadataHash, proposal1.MetadataHash)
 	proposal2, err := parseSubmitProposalFlags(fs)
 
 	require.Nil(t, err, "unexpected error")
@@ -61,6 +67,8 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	require.Equal(t, proposal1.Description, proposal2.Description)
 	require.Equal(t, proposal1.Type, proposal2.Type)
 	require.Equal(t, proposal1.Deposit, proposal2.Deposit)
+	require.Equal(t, proposal1.Metadata, proposal2.Metadata)
+	require.Equal(t, proposal1.MetadataHash, proposal2.MetadataHash)
 
 	err = okJSON.Close()
 	require.Nil(t, err, "unexpected error")
@@ -15,6 +15,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
+const (
+	flagFetchMetadata = "fetch-metadata"
+	flagIPFSGateway   = "ipfs-gateway"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	// Group gov queries under a subcommand
@@ -45,17 +50,24 @@ func GetQueryCmd() *cobra.Command {
 // GetCmdQueryProposal implements the query proposal command.
 func GetCmdQueryProposal() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "proposal [proposal-id]",
-		Args:  cobra.ExactArgs(1),
-		Short: "Query details of a single proposal",
+		Use:     "proposal [proposal-id]",
+		Aliases: []string{"show-proposal"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query details of a single proposal",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query details for a proposal. You can find the
 proposal-id by running "%s query gov proposals".
 
+With --fetch-metadata, the document the metadata of the proposal points to, an
+http(s):// URL or an ipfs:// URI fetched from --ipfs-gateway, is fetched and
+checked against the metadata hash of the proposal. The command fails if the
+document does not match the hash.
+
 Example:
 $ %s query gov proposal 1
+$ %s query gov show-proposal 1 --fetch-metadata
 `,
-				version.AppName, version.AppName,
+				version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -80,15 +92,48 @@ $ %s query gov proposal 1
 				return err
 			}
 
+			if fetch, _ := cmd.Flags().GetBool(flagFetchMetadata); fetch {
+				if err := verifyProposalMetadata(cmd, res.Proposal); err != nil {
+					return err
+				}
+			}
+
 			return clientCtx.PrintProto(&res.Proposal)
 		},
 	}
 
+	cmd.Flags().Bool(flagFetchMetadata, false, "Fetch the metadata document of the proposal and check it against its metadata hash")
+	cmd.Flags().String(flagIPFSGateway, gcutils.DefaultIPFSGateway, "The IPFS gateway fetching the ipfs:// metadata documents")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
 
+// verifyProposalMetadata fetches the metadata document of the proposal and
+// checks it against the metadata hash of the proposal.
+func verifyProposalMetadata(cmd *cobra.Command, proposal types.Proposal) error {
+	if proposal.Metadata == "" {
+		return fmt.Errorf("proposal %d has no metadata", proposal.ProposalId)
+	}
+
+	ipfsGateway, _ := cmd.Flags().GetString(flagIPFSGateway)
+	document, err := gcutils.FetchProposalMetadata(cmd.Context(), proposal.Metadata, ipfsGateway)
+	if err != nil {
+		return err
+	}
+	if err := proposal.VerifyMetadata(document); err != nil {
+		return err
+	}
+
+	if len(proposal.MetadataHash) == 0 {
+		cmd.PrintErrf("metadata %s fetched, the proposal has no metadata hash to check it against\n", proposal.Metadata)
+	} else {
+		cmd.PrintErrf("metadata %s matches the metadata hash %X\n", proposal.Metadata, proposal.MetadataHash)
+	}
+
+	return nil
+}
+
 // GetCmdQueryProposals implements a query proposals command. Command to Get a
 // Proposal Information.
 func GetCmdQueryProposals() *cobra.Command {
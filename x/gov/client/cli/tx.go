@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"strings"
@@ -22,6 +23,8 @@ const (
 	FlagDescription  = "description"
 	FlagProposalType = "type"
 	FlagDeposit      = "deposit"
+	FlagMetadata     = "metadata"
+	FlagMetadataHash = "metadata-hash"
 	flagVoter        = "voter"
 	flagDepositor    = "depositor"
 	flagStatus       = "status"
@@ -29,10 +32,12 @@ const (
 )
 
 type proposal struct {
-	Title       string
-	Description string
-	Type        string
-	Deposit     string
+	Title        string
+	Description  string
+	Type         string
+	Deposit      string
+	Metadata     string
+	MetadataHash string `json:"metadata_hash"`
 }
 
 // ProposalFlags defines the core required fields of a proposal. It is used to
@@ -43,6 +48,8 @@ var ProposalFlags = []string{
 	FlagDescription,
 	FlagProposalType,
 	FlagDeposit,
+	FlagMetadata,
+	FlagMetadataHash,
 }
 
 // NewTxCmd returns the transaction commands for this module
@@ -75,6 +82,24 @@ func NewTxCmd(propCmds []*cobra.Command) *cobra.Command {
 	return govTxCmd
 }
 
+// NewCmdDraftProposal returns the command generating drafts of proposals, with
+// one child command per proposal type supporting drafts. Drafts are printed as
+// proposal files to be edited and passed to the respective submit-proposal
+// command.
+func NewCmdDraftProposal(draftCmds []*cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "draft-proposal",
+		Short:                      "Generate a draft proposal file from the current state of the chain",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(draftCmds...)
+
+	return cmd
+}
+
 // NewCmdSubmitProposal implements submitting a proposal transaction command.
 func NewCmdSubmitProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -84,6 +109,12 @@ func NewCmdSubmitProposal() *cobra.Command {
 			fmt.Sprintf(`Submit a proposal along with an initial deposit.
 Proposal title, description, type and deposit can be given directly or through a proposal JSON file.
 
+The proposal may be anchored with --metadata to an off-chain document
+describing it, e.g. an IPFS URI, of at most %d bytes. The optional
+--metadata-hash is the hex SHA-256 hash of the document, which commits to its
+content: 'query gov proposal --fetch-metadata' fetches the document and checks
+it against the hash.
+
 Example:
 $ %s tx gov submit-proposal --proposal="path/to/proposal.json" --from mykey
 
@@ -93,14 +124,16 @@ Where proposal.json contains:
   "title": "Test Proposal",
   "description": "My awesome proposal",
   "type": "Text",
-  "deposit": "10test"
+  "deposit": "10test",
+  "metadata": "ipfs://QmcwnCamp7UWgVfmB7nMwTsgdWJL8x8wNSXHtHkAmbBaxK",
+  "metadata_hash": "7f83b1657ff1fc53b92dc18148a1d65dfc2d4b1fa3d677284addd200126d9069"
 }
 
 Which is equivalent to:
 
-$ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --from mykey
+$ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --metadata="ipfs://QmcwnCamp7UWgVfmB7nMwTsgdWJL8x8wNSXHtHkAmbBaxK" --metadata-hash="7f83b1657ff1fc53b92dc18148a1d65dfc2d4b1fa3d677284addd200126d9069" --from mykey
 `,
-				version.AppName, version.AppName,
+				types.MaxMetadataLen, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -126,6 +159,12 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 				return fmt.Errorf("invalid message: %w", err)
 			}
 
+			metadataHash, err := hex.DecodeString(proposal.MetadataHash)
+			if err != nil {
+				return fmt.Errorf("invalid metadata hash: %w", err)
+			}
+			msg.SetMetadata(proposal.Metadata, metadataHash)
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -134,6 +173,8 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 	cmd.Flags().String(FlagDescription, "", "The proposal description")
 	cmd.Flags().String(FlagProposalType, "", "The proposal Type")
 	cmd.Flags().String(FlagDeposit, "", "The proposal deposit")
+	cmd.Flags().String(FlagMetadata, "", "The off-chain metadata of the proposal, e.g. the URI of a document")
+	cmd.Flags().String(FlagMetadataHash, "", "The hex SHA-256 hash of the metadata document")
 	cmd.Flags().String(FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -0,0 +1,227 @@
+// Code generated by typedgen. DO NOT EDIT.
+
+// Package grpcclient implements the typed clients of the gov module.
+package grpcclient
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/typed"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// QueryClient is the typed client of the gov Query service, retrying
+// the queries failing with a transient error.
+type QueryClient struct {
+	client types.QueryClient
+	retry  typed.RetryConfig
+}
+
+// NewQueryClient returns a QueryClient querying through the connection.
+func NewQueryClient(conn gogogrpc.ClientConn, retry typed.RetryConfig) *QueryClient {
+	return &QueryClient{
+		client: types.NewQueryClient(conn),
+		retry:  retry,
+	}
+}
+
+// Deposit calls the gov Query/Deposit method.
+func (c *QueryClient) Deposit(ctx context.Context, req *types.QueryDepositRequest, opts ...grpc.CallOption) (*types.QueryDepositResponse, error) {
+	var res *types.QueryDepositResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Deposit(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// Deposits calls the gov Query/Deposits method.
+func (c *QueryClient) Deposits(ctx context.Context, req *types.QueryDepositsRequest, opts ...grpc.CallOption) (*types.QueryDepositsResponse, error) {
+	var res *types.QueryDepositsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Deposits(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateDeposits calls the gov Query/Deposits method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateDeposits(ctx context.Context, req *types.QueryDepositsRequest, fn func(*types.QueryDepositsResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq types.QueryDepositsRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.Deposits(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// Params calls the gov Query/Params method.
+func (c *QueryClient) Params(ctx context.Context, req *types.QueryParamsRequest, opts ...grpc.CallOption) (*types.QueryParamsResponse, error) {
+	var res *types.QueryParamsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Params(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// Proposal calls the gov Query/Proposal method.
+func (c *QueryClient) Proposal(ctx context.Context, req *types.QueryProposalRequest, opts ...grpc.CallOption) (*types.QueryProposalResponse, error) {
+	var res *types.QueryProposalResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Proposal(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// Proposals calls the gov Query/Proposals method.
+func (c *QueryClient) Proposals(ctx context.Context, req *types.QueryProposalsRequest, opts ...grpc.CallOption) (*types.QueryProposalsResponse, error) {
+	var res *types.QueryProposalsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Proposals(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateProposals calls the gov Query/Proposals method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateProposals(ctx context.Context, req *types.QueryProposalsRequest, fn func(*types.QueryProposalsResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq types.QueryProposalsRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.Proposals(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// TallyResult calls the gov Query/TallyResult method.
+func (c *QueryClient) TallyResult(ctx context.Context, req *types.QueryTallyResultRequest, opts ...grpc.CallOption) (*types.QueryTallyResultResponse, error) {
+	var res *types.QueryTallyResultResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.TallyResult(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// Vote calls the gov Query/Vote method.
+func (c *QueryClient) Vote(ctx context.Context, req *types.QueryVoteRequest, opts ...grpc.CallOption) (*types.QueryVoteResponse, error) {
+	var res *types.QueryVoteResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Vote(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// Votes calls the gov Query/Votes method.
+func (c *QueryClient) Votes(ctx context.Context, req *types.QueryVotesRequest, opts ...grpc.CallOption) (*types.QueryVotesResponse, error) {
+	var res *types.QueryVotesResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Votes(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateVotes calls the gov Query/Votes method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateVotes(ctx context.Context, req *types.QueryVotesRequest, fn func(*types.QueryVotesResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq types.QueryVotesRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.Votes(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// MsgClient is the typed client of the gov Msg service, broadcasting a
+// transaction of each message.
+type MsgClient struct {
+	broadcaster typed.Broadcaster
+}
+
+// NewMsgClient returns a MsgClient broadcasting the transactions with the
+// broadcaster.
+func NewMsgClient(broadcaster typed.Broadcaster) *MsgClient {
+	return &MsgClient{broadcaster: broadcaster}
+}
+
+// Deposit broadcasts a transaction of the gov Msg/Deposit message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) Deposit(ctx context.Context, msg *types.MsgDeposit) (*types.MsgDepositResponse, *sdk.TxResponse, error) {
+	res := &types.MsgDepositResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
+
+// SubmitProposal broadcasts a transaction of the gov Msg/SubmitProposal message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) SubmitProposal(ctx context.Context, msg *types.MsgSubmitProposal) (*types.MsgSubmitProposalResponse, *sdk.TxResponse, error) {
+	res := &types.MsgSubmitProposalResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
+
+// Vote broadcasts a transaction of the gov Msg/Vote message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) Vote(ctx context.Context, msg *types.MsgVote) (*types.MsgVoteResponse, *sdk.TxResponse, error) {
+	res := &types.MsgVoteResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
+
+// VoteWeighted broadcasts a transaction of the gov Msg/VoteWeighted message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) VoteWeighted(ctx context.Context, msg *types.MsgVoteWeighted) (*types.MsgVoteWeightedResponse, *sdk.TxResponse, error) {
+	res := &types.MsgVoteWeightedResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
@@ -17,6 +17,10 @@ type CLIHandlerFn func() *cobra.Command
 type ProposalHandler struct {
 	CLIHandler  CLIHandlerFn
 	RESTHandler RESTHandlerFn
+
+	// DraftCLIHandler optionally creates the command generating a draft of the
+	// proposal, mounted under the draft-proposal command.
+	DraftCLIHandler CLIHandlerFn
 }
 
 // NewProposalHandler creates a new ProposalHandler object
@@ -26,3 +30,10 @@ func NewProposalHandler(cliHandler CLIHandlerFn, restHandler RESTHandlerFn) Prop
 		RESTHandler: restHandler,
 	}
 }
+
+// WithDraftCLIHandler returns a copy of the ProposalHandler with the given draft
+// command handler.
+func (h ProposalHandler) WithDraftCLIHandler(draftHandler CLIHandlerFn) ProposalHandler {
+	h.DraftCLIHandler = draftHandler
+	return h
+}
@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultIPFSGateway is the default gateway fetching the ipfs:// metadata
+	// of the proposals.
+	DefaultIPFSGateway = "https://ipfs.io/ipfs/"
+
+	// maxMetadataDocumentSize bounds the size of the fetched metadata
+	// documents.
+	maxMetadataDocumentSize = 10 << 20
+
+	metadataFetchTimeout = 30 * time.Second
+)
+
+// FetchProposalMetadata fetches the document the metadata of a proposal points
+// to. The metadata must be an http(s):// URL, or an ipfs:// URI which is
+// fetched from the given IPFS gateway.
+func FetchProposalMetadata(ctx context.Context, metadata, ipfsGateway string) ([]byte, error) {
+	u, err := url.Parse(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("invalid metadata URI %q: %w", metadata, err)
+	}
+
+	switch u.Scheme {
+	case "http", "https":
+	case "ipfs":
+		metadata = strings.TrimSuffix(ipfsGateway, "/") + "/" + strings.TrimPrefix(metadata, "ipfs://")
+	default:
+		return nil, fmt.Errorf("cannot fetch metadata %q: unsupported URI scheme %q", metadata, u.Scheme)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, metadataFetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, metadata, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch metadata: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch metadata %s: %s", metadata, res.Status)
+	}
+
+	document, err := ioutil.ReadAll(io.LimitReader(res.Body, maxMetadataDocumentSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch metadata %s: %w", metadata, err)
+	}
+	if len(document) > maxMetadataDocumentSize {
+		return nil, fmt.Errorf("metadata %s is larger than %d bytes", metadata, maxMetadataDocumentSize)
+	}
+
+	return document, nil
+}
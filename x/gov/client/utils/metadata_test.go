@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchProposalMetadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/proposal.json", "/ipfs/QmcwnCamp7UWgVfmB7nMwTsgdWJL8x8wNSXHtHkAmbBaxK":
+			w.Write([]byte(`{"title":"Test Proposal"}`)) // nolint:errcheck
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	testCases := []struct {
+		name     string
+		metadata string
+		expPass  bool
+	}{
+		{"http url", server.URL + "/proposal.json", true},
+		{"ipfs uri", "ipfs://QmcwnCamp7UWgVfmB7nMwTsgdWJL8x8wNSXHtHkAmbBaxK", true},
+		{"missing document", server.URL + "/missing.json", false},
+		{"unsupported scheme", "ftp://example.com/proposal.json", false},
+		{"not a uri", "a proposal about things", false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			document, err := FetchProposalMetadata(context.Background(), tc.metadata, server.URL+"/ipfs/")
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, `{"title":"Test Proposal"}`, string(document))
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -52,6 +52,9 @@ func TestImportExportQueues(t *testing.T) {
 	require.True(t, proposal1.Status == types.StatusDepositPeriod)
 	require.True(t, proposal2.Status == types.StatusVotingPeriod)
 
+	snapshot, found := app.GovKeeper.GetTallySnapshot(ctx, proposalID2)
+	require.True(t, found)
+
 	authGenState := auth.ExportGenesis(ctx, app.AccountKeeper)
 	bankGenState := app.BankKeeper.ExportGenesis(ctx)
 
@@ -98,6 +101,10 @@ func TestImportExportQueues(t *testing.T) {
 	require.True(t, proposal1.Status == types.StatusDepositPeriod)
 	require.True(t, proposal2.Status == types.StatusVotingPeriod)
 
+	snapshot2, found := app2.GovKeeper.GetTallySnapshot(ctx2, proposalID2)
+	require.True(t, found)
+	require.Equal(t, snapshot, snapshot2)
+
 	macc := app2.GovKeeper.GetGovernanceAccount(ctx2)
 	require.Equal(t, app2.GovKeeper.GetDepositParams(ctx2).MinDeposit, app2.BankKeeper.GetAllBalances(ctx2, macc.GetAddress()))
 
@@ -110,6 +117,9 @@ func TestImportExportQueues(t *testing.T) {
 	proposal2, ok = app2.GovKeeper.GetProposal(ctx2, proposalID2)
 	require.True(t, ok)
 	require.True(t, proposal2.Status == types.StatusRejected)
+
+	_, found = app2.GovKeeper.GetTallySnapshot(ctx2, proposalID2)
+	require.False(t, found)
 }
 
 func TestImportExportQueues_ErrorUnconsistentState(t *testing.T) {
@@ -25,7 +25,7 @@ func (keeper Keeper) GetDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 func (keeper Keeper) SetDeposit(ctx sdk.Context, deposit types.Deposit) {
 	store := ctx.KVStore(keeper.storeKey)
 	bz := keeper.cdc.MustMarshal(&deposit)
-	depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
+	depositor, err := keeper.authKeeper.AddressCodec().StringToBytes(deposit.Depositor)
 	if err != nil {
 		panic(err)
 	}
@@ -63,7 +63,7 @@ func (keeper Keeper) DeleteDeposits(ctx sdk.Context, proposalID uint64) {
 			panic(err)
 		}
 
-		depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
+		depositor, err := keeper.authKeeper.AddressCodec().StringToBytes(deposit.Depositor)
 		if err != nil {
 			panic(err)
 		}
@@ -171,7 +171,7 @@ func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
 
 	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
-		depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
+		depositor, err := keeper.authKeeper.AddressCodec().StringToBytes(deposit.Depositor)
 		if err != nil {
 			panic(err)
 		}
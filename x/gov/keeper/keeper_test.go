@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -87,6 +88,34 @@ func TestProposalQueues(t *testing.T) {
 	activeIterator.Close()
 }
 
+func TestMigrate2to3(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	tp := TestProposal
+	depositProposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	votingProposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+
+	// proposals activated before the migration have no snapshot
+	app.GovKeeper.ActivateVotingPeriod(ctx, votingProposal)
+	app.GovKeeper.DeleteTallySnapshot(ctx, votingProposal.ProposalId)
+
+	m := keeper.NewMigrator(app.GovKeeper)
+	require.NoError(t, m.Migrate2to3(ctx))
+
+	_, found := app.GovKeeper.GetTallySnapshot(ctx, depositProposal.ProposalId)
+	require.False(t, found)
+	snapshot, found := app.GovKeeper.GetTallySnapshot(ctx, votingProposal.ProposalId)
+	require.True(t, found)
+	require.Equal(t, app.StakingKeeper.TotalBondedTokens(ctx), snapshot.TotalBondedTokens)
+
+	// the snapshot is deleted with the proposal
+	app.GovKeeper.DeleteProposal(ctx, votingProposal.ProposalId)
+	require.Empty(t, app.GovKeeper.GetTallySnapshots(ctx))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v043 "github.com/cosmos/cosmos-sdk/x/gov/legacy/v043"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -19,3 +20,20 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v043.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates from version 2 to 3, snapshotting the current bonded
+// stake for the proposals in voting period, which are then tallied against it.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.IterateProposals(ctx, func(proposal types.Proposal) bool {
+		if proposal.Status != types.StatusVotingPeriod {
+			return false
+		}
+		if _, found := m.keeper.GetTallySnapshot(ctx, proposal.ProposalId); !found {
+			m.keeper.SetTallySnapshot(ctx, m.keeper.NewTallySnapshot(ctx, proposal.ProposalId))
+		}
+
+		return false
+	})
+
+	return nil
+}
@@ -11,6 +11,16 @@ import (
 
 // SubmitProposal create new proposal given a content
 func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (types.Proposal, error) {
+	return keeper.SubmitProposalWithMetadata(ctx, content, "", nil)
+}
+
+// SubmitProposalWithMetadata create new proposal given a content, its
+// off-chain metadata and the optional hash of the document it points to
+func (keeper Keeper) SubmitProposalWithMetadata(ctx sdk.Context, content types.Content, metadata string, metadataHash []byte) (types.Proposal, error) {
+	if err := types.ValidateProposalMetadata(metadata, metadataHash); err != nil {
+		return types.Proposal{}, err
+	}
+
 	if !keeper.router.HasRoute(content.ProposalRoute()) {
 		return types.Proposal{}, sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
 	}
@@ -36,6 +46,8 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	if err != nil {
 		return types.Proposal{}, err
 	}
+	proposal.Metadata = metadata
+	proposal.MetadataHash = metadataHash
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
@@ -87,6 +99,7 @@ func (keeper Keeper) DeleteProposal(ctx sdk.Context, proposalID uint64) {
 	}
 	keeper.RemoveFromInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
 	keeper.RemoveFromActiveProposalQueue(ctx, proposalID, proposal.VotingEndTime)
+	keeper.DeleteTallySnapshot(ctx, proposalID)
 	store.Delete(types.ProposalKey(proposalID))
 }
 
@@ -189,6 +202,7 @@ func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal types.Propos
 	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
+	keeper.SetTallySnapshot(ctx, keeper.NewTallySnapshot(ctx, proposal.ProposalId))
 
 	keeper.RemoveFromInactiveProposalQueue(ctx, proposal.ProposalId, proposal.DepositEndTime)
 	keeper.InsertActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"strings"
@@ -8,6 +9,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func (suite *KeeperTestSuite) TestGetSetProposal() {
@@ -61,6 +64,13 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 		{&types.TextProposal{Title: "title", Description: strings.Repeat("1234567890", 1000)}, nil},
 		// error only when invalid route
 		{&invalidProposalRoute{}, types.ErrNoProposalHandlerExists},
+		// param changes are validated against the registered subspaces at submission
+		{paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+			paramproposal.NewParamChange(stakingtypes.ModuleName, "Unknown", "1"),
+		}), types.ErrInvalidProposalContent},
+		{paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+			paramproposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "0"),
+		}), types.ErrInvalidProposalContent},
 	}
 
 	for i, tc := range testCases {
@@ -69,6 +79,24 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSubmitProposalWithMetadata() {
+	metadata := "ipfs://QmcwnCamp7UWgVfmB7nMwTsgdWJL8x8wNSXHtHkAmbBaxK"
+	hash := sha256.Sum256([]byte("document"))
+
+	proposal, err := suite.app.GovKeeper.SubmitProposalWithMetadata(suite.ctx, TestProposal, metadata, hash[:])
+	suite.Require().NoError(err)
+
+	gotProposal, ok := suite.app.GovKeeper.GetProposal(suite.ctx, proposal.ProposalId)
+	suite.Require().True(ok)
+	suite.Require().Equal(metadata, gotProposal.Metadata)
+	suite.Require().Equal(hash[:], gotProposal.MetadataHash)
+
+	_, err = suite.app.GovKeeper.SubmitProposalWithMetadata(suite.ctx, TestProposal, strings.Repeat("#", types.MaxMetadataLen+1), nil)
+	suite.Require().ErrorIs(err, types.ErrInvalidProposalMetadata)
+	_, err = suite.app.GovKeeper.SubmitProposalWithMetadata(suite.ctx, TestProposal, "", hash[:])
+	suite.Require().ErrorIs(err, types.ErrInvalidProposalMetadata)
+}
+
 func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 	proposalID := uint64(1)
 	status := []types.ProposalStatus{types.StatusDepositPeriod, types.StatusVotingPeriod}
@@ -20,22 +20,32 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 	totalVotingPower := sdk.ZeroDec()
 	currValidators := make(map[string]types.ValidatorGovInfo)
 
-	// fetch all the bonded validators, insert them into currValidators
-	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
-		currValidators[validator.GetOperator().String()] = types.NewValidatorGovInfo(
-			validator.GetOperator(),
-			validator.GetBondedTokens(),
-			validator.GetDelegatorShares(),
+	// the proposal is tallied against the bonded stake at the start of its
+	// voting period, or the current one if it has no snapshot
+	snapshot, found := keeper.GetTallySnapshot(ctx, proposal.ProposalId)
+	if !found {
+		snapshot = keeper.NewTallySnapshot(ctx, proposal.ProposalId)
+	}
+
+	// insert the snapshotted validators into currValidators
+	for _, val := range snapshot.Validators {
+		valAddr, err := sdk.ValAddressFromBech32(val.OperatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		currValidators[val.OperatorAddress] = types.NewValidatorGovInfo(
+			valAddr,
+			val.BondedTokens,
+			val.DelegatorShares,
 			sdk.ZeroDec(),
 			types.WeightedVoteOptions{},
 		)
-
-		return false
-	})
+	}
 
 	keeper.IterateVotes(ctx, proposal.ProposalId, func(vote types.Vote) bool {
 		// if validator, just record it in the map
-		voter, err := sdk.AccAddressFromBech32(vote.Voter)
+		voter, err := keeper.authKeeper.AddressCodec().StringToBytes(vote.Voter)
 
 		if err != nil {
 			panic(err)
@@ -80,7 +90,12 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 			continue
 		}
 
+		// the delegations made during the voting period may exceed the
+		// snapshotted shares
 		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
+		if sharesAfterDeductions.IsNegative() {
+			sharesAfterDeductions = sdk.ZeroDec()
+		}
 		votingPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
 
 		for _, option := range val.Vote {
@@ -95,12 +110,12 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
 	// If there is no staked coins, the proposal fails
-	if keeper.sk.TotalBondedTokens(ctx).IsZero() {
+	if snapshot.TotalBondedTokens.IsZero() {
 		return false, false, tallyResults
 	}
 
 	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVotingPower.Quo(keeper.sk.TotalBondedTokens(ctx).ToDec())
+	percentVoting := totalVotingPower.Quo(snapshot.TotalBondedTokens.ToDec())
 	if percentVoting.LT(tallyParams.Quorum) {
 		return false, true, tallyResults
 	}
@@ -123,3 +138,74 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 	// If more than 1/2 of non-abstaining voters vote No, proposal fails
 	return false, false, tallyResults
 }
+
+// NewTallySnapshot returns the tally snapshot of the proposal from the current
+// bonded stake.
+func (keeper Keeper) NewTallySnapshot(ctx sdk.Context, proposalID uint64) types.TallySnapshot {
+	var validators []types.ValidatorSnapshot
+	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
+		validators = append(validators, types.NewValidatorSnapshot(
+			validator.GetOperator(),
+			validator.GetBondedTokens(),
+			validator.GetDelegatorShares(),
+		))
+
+		return false
+	})
+
+	return types.NewTallySnapshot(proposalID, keeper.sk.TotalBondedTokens(ctx), validators)
+}
+
+// GetTallySnapshot gets the tally snapshot of a proposal from store.
+func (keeper Keeper) GetTallySnapshot(ctx sdk.Context, proposalID uint64) (snapshot types.TallySnapshot, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.TallySnapshotKey(proposalID))
+	if bz == nil {
+		return snapshot, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &snapshot)
+	return snapshot, true
+}
+
+// SetTallySnapshot sets a tally snapshot to the gov store.
+func (keeper Keeper) SetTallySnapshot(ctx sdk.Context, snapshot types.TallySnapshot) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&snapshot)
+	store.Set(types.TallySnapshotKey(snapshot.ProposalId), bz)
+}
+
+// DeleteTallySnapshot deletes the tally snapshot of a proposal from the gov
+// store.
+func (keeper Keeper) DeleteTallySnapshot(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.TallySnapshotKey(proposalID))
+}
+
+// GetTallySnapshots returns all the tally snapshots from the gov store.
+func (keeper Keeper) GetTallySnapshots(ctx sdk.Context) (snapshots []types.TallySnapshot) {
+	keeper.IterateTallySnapshots(ctx, func(snapshot types.TallySnapshot) bool {
+		snapshots = append(snapshots, snapshot)
+		return false
+	})
+
+	return
+}
+
+// IterateTallySnapshots iterates over all the tally snapshots and performs a
+// callback function.
+func (keeper Keeper) IterateTallySnapshots(ctx sdk.Context, cb func(snapshot types.TallySnapshot) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TallySnapshotsKeyPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.TallySnapshot
+		keeper.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+
+		if cb(snapshot) {
+			break
+		}
+	}
+}
@@ -473,3 +473,105 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 
 	require.True(t, tallyResults.Equals(expectedTallyResult))
 }
+
+func TestTallySnapshot(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs, valAddrs := createValidators(t, ctx, app, []int64{5, 5, 5})
+
+	tp := TestProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalId
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	snapshot, found := app.GovKeeper.GetTallySnapshot(ctx, proposalID)
+	require.True(t, found)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 15), snapshot.TotalBondedTokens)
+	require.Len(t, snapshot.Validators, 3)
+
+	// a large delegation to the validator voting no during the voting period
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 30)
+	val3, found := app.StakingKeeper.GetValidator(ctx, valAddrs[2])
+	require.True(t, found)
+	_, err = app.StakingKeeper.Delegate(ctx, addrs[3], delTokens, stakingtypes.Unbonded, val3, true)
+	require.NoError(t, err)
+	_ = staking.EndBlocker(ctx, app.StakingKeeper)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], types.NewNonSplitVoteOption(types.OptionNo)))
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+
+	// the proposal is tallied against the snapshot
+	cacheCtx, _ := ctx.CacheContext()
+	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(cacheCtx, proposal)
+	require.True(t, passes)
+	require.False(t, burnDeposits)
+	expectedTallyResult := types.NewTallyResult(
+		app.StakingKeeper.TokensFromConsensusPower(ctx, 10),
+		sdk.ZeroInt(),
+		app.StakingKeeper.TokensFromConsensusPower(ctx, 5),
+		sdk.ZeroInt(),
+	)
+	require.True(t, tallyResults.Equals(expectedTallyResult))
+
+	// without a snapshot, the proposal is tallied against the current stake
+	app.GovKeeper.DeleteTallySnapshot(ctx, proposalID)
+	passes, burnDeposits, tallyResults = app.GovKeeper.Tally(ctx, proposal)
+	require.False(t, passes)
+	require.False(t, burnDeposits)
+	expectedTallyResult = types.NewTallyResult(
+		app.StakingKeeper.TokensFromConsensusPower(ctx, 10),
+		sdk.ZeroInt(),
+		app.StakingKeeper.TokensFromConsensusPower(ctx, 35),
+		sdk.ZeroInt(),
+	)
+	require.True(t, tallyResults.Equals(expectedTallyResult))
+}
+
+func TestTallySnapshotDelegatorUnbonded(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs, valAddrs := createValidators(t, ctx, app, []int64{5, 5, 5})
+
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 30)
+	val1, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	_, err := app.StakingKeeper.Delegate(ctx, addrs[3], delTokens, stakingtypes.Unbonded, val1, true)
+	require.NoError(t, err)
+	_ = staking.EndBlocker(ctx, app.StakingKeeper)
+
+	tp := TestProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalId
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	// the delegator unbonds most of the stake during the voting period
+	_, err = app.StakingKeeper.Undelegate(ctx, addrs[3], valAddrs[0], delTokens.ToDec())
+	require.NoError(t, err)
+	_ = staking.EndBlocker(ctx, app.StakingKeeper)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], types.NewNonSplitVoteOption(types.OptionYes)))
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+
+	// the quorum is not reached against the snapshot
+	cacheCtx, _ := ctx.CacheContext()
+	passes, burnDeposits, _ := app.GovKeeper.Tally(cacheCtx, proposal)
+	require.False(t, passes)
+	require.True(t, burnDeposits)
+
+	// while it is against the current stake
+	app.GovKeeper.DeleteTallySnapshot(ctx, proposalID)
+	passes, burnDeposits, _ = app.GovKeeper.Tally(ctx, proposal)
+	require.True(t, passes)
+	require.False(t, burnDeposits)
+}
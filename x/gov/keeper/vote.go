@@ -84,7 +84,7 @@ func (keeper Keeper) SetVote(ctx sdk.Context, vote types.Vote) {
 
 	store := ctx.KVStore(keeper.storeKey)
 	bz := keeper.cdc.MustMarshal(&vote)
-	addr, err := sdk.AccAddressFromBech32(vote.Voter)
+	addr, err := keeper.authKeeper.AddressCodec().StringToBytes(vote.Voter)
 	if err != nil {
 		panic(err)
 	}
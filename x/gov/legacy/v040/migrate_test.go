@@ -114,6 +114,8 @@ func TestMigrate(t *testing.T) {
 				"no_with_veto": "0",
 				"yes": "0"
 			},
+			"metadata": "",
+			"metadata_hash": null,
 			"proposal_id": "0",
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
@@ -141,6 +143,8 @@ func TestMigrate(t *testing.T) {
 				"no_with_veto": "0",
 				"yes": "0"
 			},
+			"metadata": "",
+			"metadata_hash": null,
 			"proposal_id": "0",
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
@@ -161,6 +165,8 @@ func TestMigrate(t *testing.T) {
 				"no_with_veto": "0",
 				"yes": "0"
 			},
+			"metadata": "",
+			"metadata_hash": null,
 			"proposal_id": "0",
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
@@ -188,6 +194,8 @@ func TestMigrate(t *testing.T) {
 				"no_with_veto": "0",
 				"yes": "0"
 			},
+			"metadata": "",
+			"metadata_hash": null,
 			"proposal_id": "0",
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
@@ -215,6 +223,8 @@ func TestMigrate(t *testing.T) {
 				"no_with_veto": "0",
 				"yes": "0"
 			},
+			"metadata": "",
+			"metadata_hash": null,
 			"proposal_id": "0",
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
@@ -229,6 +239,7 @@ func TestMigrate(t *testing.T) {
 		"threshold": "0",
 		"veto_threshold": "0"
 	},
+	"tally_snapshots": [],
 	"votes": [],
 	"voting_params": {
 		"voting_period": "0s"
@@ -90,11 +90,20 @@ func (a AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux
 // GetTxCmd returns the root tx command for the gov module.
 func (a AppModuleBasic) GetTxCmd() *cobra.Command {
 	proposalCLIHandlers := make([]*cobra.Command, 0, len(a.proposalHandlers))
+	var draftCLIHandlers []*cobra.Command
 	for _, proposalHandler := range a.proposalHandlers {
 		proposalCLIHandlers = append(proposalCLIHandlers, proposalHandler.CLIHandler())
+		if proposalHandler.DraftCLIHandler != nil {
+			draftCLIHandlers = append(draftCLIHandlers, proposalHandler.DraftCLIHandler())
+		}
 	}
 
-	return cli.NewTxCmd(proposalCLIHandlers)
+	cmd := cli.NewTxCmd(proposalCLIHandlers)
+	if len(draftCLIHandlers) > 0 {
+		cmd.AddCommand(cli.NewCmdDraftProposal(draftCLIHandlers))
+	}
+
+	return cmd
 }
 
 // GetQueryCmd returns the root query command for the gov module.
@@ -161,6 +170,11 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(err)
 	}
+
+	err = cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3)
+	if err != nil {
+		panic(err)
+	}
 }
 
 // InitGenesis performs genesis initialization for the gov module. It returns
@@ -180,7 +194,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
 
 // BeginBlock performs a no-op.
 func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
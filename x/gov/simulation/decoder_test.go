@@ -36,6 +36,9 @@ func TestDecodeStore(t *testing.T) {
 	binary.LittleEndian.PutUint64(proposalIDBz, 1)
 	deposit := types.NewDeposit(1, delAddr1, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())))
 	vote := types.NewVote(1, delAddr1, types.NewNonSplitVoteOption(types.OptionYes))
+	snapshot := types.NewTallySnapshot(1, sdk.OneInt(), []types.ValidatorSnapshot{
+		types.NewValidatorSnapshot(sdk.ValAddress(delAddr1), sdk.OneInt(), sdk.OneDec()),
+	})
 
 	proposalBzA, err := cdc.Marshal(&proposalA)
 	require.NoError(t, err)
@@ -72,6 +75,12 @@ func TestDecodeStore(t *testing.T) {
 			kv.Pair{Key: types.VoteKey(1, delAddr1), Value: cdc.MustMarshal(&vote)},
 			fmt.Sprintf("%v\n%v", vote, vote), false,
 		},
+		{
+			"tally snapshots",
+			kv.Pair{Key: types.TallySnapshotKey(1), Value: cdc.MustMarshal(&snapshot)},
+			kv.Pair{Key: types.TallySnapshotKey(1), Value: cdc.MustMarshal(&snapshot)},
+			fmt.Sprintf("%v\n%v", snapshot, snapshot), false,
+		},
 		{
 			"other",
 			kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
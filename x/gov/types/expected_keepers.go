@@ -35,6 +35,8 @@ type AccountKeeper interface {
 
 	// TODO remove with genesis 2-phases refactor https://github.com/cosmos/cosmos-sdk/issues/2862
 	SetModuleAccount(sdk.Context, types.ModuleAccountI)
+
+	AddressCodec() sdk.AddressCodec
 }
 
 // BankKeeper defines the expected interface needed to retrieve account balances.
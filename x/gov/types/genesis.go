@@ -34,7 +34,22 @@ func (data GenesisState) Equal(other GenesisState) bool {
 		data.Proposals.Equal(other.Proposals) &&
 		data.DepositParams.Equal(other.DepositParams) &&
 		data.TallyParams.Equal(other.TallyParams) &&
-		data.VotingParams.Equal(other.VotingParams)
+		data.VotingParams.Equal(other.VotingParams) &&
+		tallySnapshotsEqual(data.TallySnapshots, other.TallySnapshots)
+}
+
+func tallySnapshotsEqual(snapshots, other []TallySnapshot) bool {
+	if len(snapshots) != len(other) {
+		return false
+	}
+
+	for i, snapshot := range snapshots {
+		if snapshot.String() != other[i].String() {
+			return false
+		}
+	}
+
+	return true
 }
 
 // Empty returns true if a GenesisState is empty
@@ -61,6 +76,18 @@ func ValidateGenesis(data *GenesisState) error {
 			data.DepositParams.MinDeposit.String())
 	}
 
+	snapshots := make(map[uint64]bool, len(data.TallySnapshots))
+	for _, snapshot := range data.TallySnapshots {
+		if snapshots[snapshot.ProposalId] {
+			return fmt.Errorf("duplicate tally snapshot of proposal %d", snapshot.ProposalId)
+		}
+		snapshots[snapshot.ProposalId] = true
+
+		if err := snapshot.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestEqualProposalID(t *testing.T) {
@@ -20,3 +22,42 @@ func TestEqualProposalID(t *testing.T) {
 	require.Equal(t, state1, state2)
 	require.True(t, state1.Equal(state2))
 }
+
+func TestValidateGenesisTallySnapshots(t *testing.T) {
+	valAddr := sdk.ValAddress([]byte("validator"))
+	snapshot := NewTallySnapshot(1, sdk.NewInt(10), []ValidatorSnapshot{
+		NewValidatorSnapshot(valAddr, sdk.NewInt(10), sdk.NewDec(10)),
+	})
+
+	testCases := []struct {
+		name      string
+		snapshots []TallySnapshot
+		expErr    bool
+	}{
+		{"valid snapshot", []TallySnapshot{snapshot}, false},
+		{"duplicate snapshot", []TallySnapshot{snapshot, snapshot}, true},
+		{"negative total bonded tokens", []TallySnapshot{NewTallySnapshot(1, sdk.NewInt(-1), nil)}, true},
+		{"duplicate validator", []TallySnapshot{NewTallySnapshot(1, sdk.NewInt(10), append(snapshot.Validators, snapshot.Validators...))}, true},
+		{"invalid validator", []TallySnapshot{NewTallySnapshot(1, sdk.NewInt(10), []ValidatorSnapshot{{
+			OperatorAddress: "invalid", BondedTokens: sdk.NewInt(10), DelegatorShares: sdk.NewDec(10),
+		}})}, true},
+		{"zero delegator shares", []TallySnapshot{NewTallySnapshot(1, sdk.NewInt(10), []ValidatorSnapshot{
+			NewValidatorSnapshot(valAddr, sdk.NewInt(10), sdk.ZeroDec()),
+		})}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			genState := DefaultGenesisState()
+			genState.TallySnapshots = tc.snapshots
+
+			err := ValidateGenesis(genState)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
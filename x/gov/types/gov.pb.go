@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -251,6 +252,12 @@ type Proposal struct {
 	TotalDeposit     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_deposit" yaml:"total_deposit"`
 	VotingStartTime  time.Time                                `protobuf:"bytes,8,opt,name=voting_start_time,json=votingStartTime,proto3,stdtime" json:"voting_start_time" yaml:"voting_start_time"`
 	VotingEndTime    time.Time                                `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time" yaml:"voting_end_time"`
+	// metadata is an off-chain anchor of the proposal, e.g. the URI of an IPFS
+	// document describing it, of at most MaxMetadataLen bytes.
+	Metadata string `protobuf:"bytes,10,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// metadata_hash is the optional SHA-256 hash of the document the metadata
+	// points to, committing to its content.
+	MetadataHash []byte `protobuf:"bytes,11,opt,name=metadata_hash,json=metadataHash,proto3" json:"metadata_hash,omitempty" yaml:"metadata_hash"`
 }
 
 func (m *Proposal) Reset()      { *m = Proposal{} }
@@ -370,6 +377,90 @@ func (m *Vote) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Vote proto.InternalMessageInfo
 
+// TallySnapshot defines the bonded stake a proposal is tallied against. It is
+// taken when the voting period of the proposal starts, so that the tally is
+// not distorted by stake (un)bonding during the voting period.
+type TallySnapshot struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
+	// total_bonded_tokens is the total bonded stake the quorum is computed
+	// against.
+	TotalBondedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total_bonded_tokens,json=totalBondedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_bonded_tokens" yaml:"total_bonded_tokens"`
+	// validators are the bonded validators at the start of the voting period.
+	Validators []ValidatorSnapshot `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators"`
+}
+
+func (m *TallySnapshot) Reset()      { *m = TallySnapshot{} }
+func (*TallySnapshot) ProtoMessage() {}
+func (*TallySnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82113c1a9a4b7c, []int{6}
+}
+func (m *TallySnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TallySnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TallySnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TallySnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TallySnapshot.Merge(m, src)
+}
+func (m *TallySnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *TallySnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_TallySnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TallySnapshot proto.InternalMessageInfo
+
+// ValidatorSnapshot defines the voting power of a bonded validator in a
+// TallySnapshot.
+type ValidatorSnapshot struct {
+	OperatorAddress string                                 `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty" yaml:"operator_address"`
+	BondedTokens    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=bonded_tokens,json=bondedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bonded_tokens" yaml:"bonded_tokens"`
+	DelegatorShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=delegator_shares,json=delegatorShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"delegator_shares" yaml:"delegator_shares"`
+}
+
+func (m *ValidatorSnapshot) Reset()      { *m = ValidatorSnapshot{} }
+func (*ValidatorSnapshot) ProtoMessage() {}
+func (*ValidatorSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82113c1a9a4b7c, []int{7}
+}
+func (m *ValidatorSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSnapshot.Merge(m, src)
+}
+func (m *ValidatorSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSnapshot proto.InternalMessageInfo
+
 // DepositParams defines the params for deposits on governance proposals.
 type DepositParams struct {
 	//  Minimum deposit for a proposal to enter voting period.
@@ -382,7 +473,7 @@ type DepositParams struct {
 func (m *DepositParams) Reset()      { *m = DepositParams{} }
 func (*DepositParams) ProtoMessage() {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{6}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{8}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -420,7 +511,7 @@ type VotingParams struct {
 func (m *VotingParams) Reset()      { *m = VotingParams{} }
 func (*VotingParams) ProtoMessage() {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{7}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{9}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -464,7 +555,7 @@ type TallyParams struct {
 func (m *TallyParams) Reset()      { *m = TallyParams{} }
 func (*TallyParams) ProtoMessage() {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{8}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{10}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -502,6 +593,8 @@ func init() {
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1beta1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.gov.v1beta1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos.gov.v1beta1.Vote")
+	proto.RegisterType((*TallySnapshot)(nil), "cosmos.gov.v1beta1.TallySnapshot")
+	proto.RegisterType((*ValidatorSnapshot)(nil), "cosmos.gov.v1beta1.ValidatorSnapshot")
 	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1beta1.DepositParams")
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1beta1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1beta1.TallyParams")
@@ -510,98 +603,111 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/gov.proto", fileDescriptor_6e82113c1a9a4b7c) }
 
 var fileDescriptor_6e82113c1a9a4b7c = []byte{
-	// 1454 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x51, 0x68, 0xdb, 0x56,
-	0x17, 0xb6, 0x6c, 0xc7, 0x89, 0xaf, 0x9d, 0x44, 0xbd, 0x49, 0x13, 0xc7, 0x7f, 0x7f, 0xc9, 0xbf,
-	0xfe, 0x9f, 0x12, 0x4a, 0xeb, 0xb4, 0xf9, 0xc7, 0xc6, 0x52, 0xd8, 0x66, 0xc5, 0xca, 0xea, 0x51,
-	0x6c, 0x23, 0xab, 0x0e, 0xed, 0x1e, 0x84, 0x62, 0xdf, 0x3a, 0xda, 0x2c, 0x5d, 0xcf, 0xba, 0x4e,
-	0x13, 0xf6, 0xb2, 0xc7, 0xe2, 0xc1, 0xe8, 0x63, 0x61, 0x18, 0x0a, 0x63, 0x2f, 0x7b, 0xde, 0xf3,
-	0x9e, 0xc3, 0x18, 0xac, 0xec, 0xa9, 0x6c, 0xe0, 0xae, 0x09, 0x8c, 0x92, 0xc7, 0x3c, 0xec, 0x79,
-	0x48, 0xf7, 0x2a, 0x96, 0xed, 0xb0, 0xd4, 0x7b, 0x8a, 0x74, 0xee, 0xf9, 0xbe, 0x73, 0xce, 0xe7,
-	0x73, 0xce, 0x55, 0xc0, 0x95, 0x1a, 0x76, 0x2c, 0xec, 0xac, 0x35, 0xf0, 0xde, 0xda, 0xde, 0xad,
-	0x1d, 0x44, 0x8c, 0x5b, 0xee, 0x73, 0xb6, 0xd5, 0xc6, 0x04, 0x43, 0x48, 0x4f, 0xb3, 0xae, 0x85,
-	0x9d, 0xa6, 0x05, 0x86, 0xd8, 0x31, 0x1c, 0x74, 0x06, 0xa9, 0x61, 0xd3, 0xa6, 0x98, 0xf4, 0x62,
-	0x03, 0x37, 0xb0, 0xf7, 0xb8, 0xe6, 0x3e, 0x31, 0xeb, 0x0a, 0x45, 0xe9, 0xf4, 0x80, 0xd1, 0xd2,
-	0x23, 0xb1, 0x81, 0x71, 0xa3, 0x89, 0xd6, 0xbc, 0xb7, 0x9d, 0xce, 0xc3, 0x35, 0x62, 0x5a, 0xc8,
-	0x21, 0x86, 0xd5, 0xf2, 0xb1, 0xa3, 0x0e, 0x86, 0x7d, 0xc0, 0x8e, 0x84, 0xd1, 0xa3, 0x7a, 0xa7,
-	0x6d, 0x10, 0x13, 0xb3, 0x64, 0xa4, 0x6f, 0x39, 0x00, 0xb7, 0x91, 0xd9, 0xd8, 0x25, 0xa8, 0x5e,
-	0xc5, 0x04, 0x95, 0x5a, 0xee, 0x21, 0x7c, 0x1b, 0xc4, 0xb0, 0xf7, 0x94, 0xe2, 0x32, 0xdc, 0xea,
-	0xdc, 0xba, 0x90, 0x1d, 0x2f, 0x34, 0x3b, 0xf0, 0x57, 0x99, 0x37, 0xdc, 0x06, 0xb1, 0x47, 0x1e,
-	0x5b, 0x2a, 0x9c, 0xe1, 0x56, 0xe3, 0xf2, 0xfb, 0x87, 0x7d, 0x31, 0xf4, 0x6b, 0x5f, 0xbc, 0xda,
-	0x30, 0xc9, 0x6e, 0x67, 0x27, 0x5b, 0xc3, 0x16, 0xab, 0x8d, 0xfd, 0xb9, 0xe1, 0xd4, 0x3f, 0x5d,
-	0x23, 0x07, 0x2d, 0xe4, 0x64, 0xf3, 0xa8, 0x76, 0xda, 0x17, 0x67, 0x0f, 0x0c, 0xab, 0xb9, 0x21,
-	0x51, 0x16, 0x49, 0x65, 0x74, 0xd2, 0x36, 0x48, 0x6a, 0x68, 0x9f, 0x94, 0xdb, 0xb8, 0x85, 0x1d,
-	0xa3, 0x09, 0x17, 0xc1, 0x14, 0x31, 0x49, 0x13, 0x79, 0xf9, 0xc5, 0x55, 0xfa, 0x02, 0x33, 0x20,
-	0x51, 0x47, 0x4e, 0xad, 0x6d, 0xd2, 0xdc, 0xbd, 0x1c, 0xd4, 0xa0, 0x69, 0x63, 0xfe, 0xf5, 0x33,
-	0x91, 0xfb, 0xe5, 0xfb, 0x1b, 0xd3, 0x9b, 0xd8, 0x26, 0xc8, 0x26, 0xd2, 0xcf, 0x1c, 0x98, 0xce,
-	0xa3, 0x16, 0x76, 0x4c, 0x02, 0xdf, 0x01, 0x89, 0x16, 0x0b, 0xa0, 0x9b, 0x75, 0x8f, 0x3a, 0x2a,
-	0x2f, 0x9d, 0xf6, 0x45, 0x48, 0x93, 0x0a, 0x1c, 0x4a, 0x2a, 0xf0, 0xdf, 0x0a, 0x75, 0x78, 0x05,
-	0xc4, 0xeb, 0x94, 0x03, 0xb7, 0x59, 0xd4, 0x81, 0x01, 0xd6, 0x40, 0xcc, 0xb0, 0x70, 0xc7, 0x26,
-	0xa9, 0x48, 0x26, 0xb2, 0x9a, 0x58, 0x5f, 0xf1, 0xc5, 0x74, 0x3b, 0xe4, 0x4c, 0xcd, 0x4d, 0x6c,
-	0xda, 0xf2, 0x4d, 0x57, 0xaf, 0xef, 0x5e, 0x8a, 0xab, 0x6f, 0xa0, 0x97, 0x0b, 0x70, 0x54, 0x46,
-	0xbd, 0x31, 0xf3, 0xf8, 0x99, 0x18, 0x7a, 0xfd, 0x4c, 0x0c, 0x49, 0x7f, 0xc6, 0xc0, 0xcc, 0x99,
-	0x4e, 0x6f, 0x9d, 0x57, 0xd2, 0xc2, 0x49, 0x5f, 0x0c, 0x9b, 0xf5, 0xd3, 0xbe, 0x18, 0xa7, 0x85,
-	0x8d, 0xd6, 0x73, 0x1b, 0x4c, 0xd7, 0xa8, 0x3e, 0x5e, 0x35, 0x89, 0xf5, 0xc5, 0x2c, 0xed, 0xa3,
-	0xac, 0xdf, 0x47, 0xd9, 0x9c, 0x7d, 0x20, 0x27, 0x7e, 0x1c, 0x08, 0xa9, 0xfa, 0x08, 0x58, 0x05,
-	0x31, 0x87, 0x18, 0xa4, 0xe3, 0xa4, 0x22, 0x5e, 0xef, 0x48, 0xe7, 0xf5, 0x8e, 0x9f, 0x60, 0xc5,
-	0xf3, 0x94, 0xd3, 0xa7, 0x7d, 0x71, 0x69, 0x44, 0x64, 0x4a, 0x22, 0xa9, 0x8c, 0x0d, 0xb6, 0x00,
-	0x7c, 0x68, 0xda, 0x46, 0x53, 0x27, 0x46, 0xb3, 0x79, 0xa0, 0xb7, 0x91, 0xd3, 0x69, 0x92, 0x54,
-	0xd4, 0xcb, 0x4f, 0x3c, 0x2f, 0x86, 0xe6, 0xfa, 0xa9, 0x9e, 0x9b, 0xfc, 0x1f, 0x57, 0xd8, 0xd3,
-	0xbe, 0xb8, 0x42, 0x83, 0x8c, 0x13, 0x49, 0x2a, 0xef, 0x19, 0x03, 0x20, 0xf8, 0x31, 0x48, 0x38,
-	0x9d, 0x1d, 0xcb, 0x24, 0xba, 0x3b, 0x71, 0xa9, 0x29, 0x2f, 0x54, 0x7a, 0x4c, 0x0a, 0xcd, 0x1f,
-	0x47, 0x59, 0x60, 0x51, 0x58, 0xbf, 0x04, 0xc0, 0xd2, 0x93, 0x97, 0x22, 0xa7, 0x02, 0x6a, 0x71,
-	0x01, 0xd0, 0x04, 0x3c, 0x6b, 0x11, 0x1d, 0xd9, 0x75, 0x1a, 0x21, 0x76, 0x61, 0x84, 0xff, 0xb2,
-	0x08, 0xcb, 0x34, 0xc2, 0x28, 0x03, 0x0d, 0x33, 0xc7, 0xcc, 0x8a, 0x5d, 0xf7, 0x42, 0x3d, 0xe6,
-	0xc0, 0x2c, 0xc1, 0xc4, 0x68, 0xea, 0xec, 0x20, 0x35, 0x7d, 0x51, 0x23, 0xde, 0x61, 0x71, 0x16,
-	0x69, 0x9c, 0x21, 0xb4, 0x34, 0x51, 0x83, 0x26, 0x3d, 0xac, 0x3f, 0x62, 0x4d, 0x70, 0x69, 0x0f,
-	0x13, 0xd3, 0x6e, 0xb8, 0x3f, 0x6f, 0x9b, 0x09, 0x3b, 0x73, 0x61, 0xd9, 0xff, 0x63, 0xe9, 0xa4,
-	0x68, 0x3a, 0x63, 0x14, 0xb4, 0xee, 0x79, 0x6a, 0xaf, 0xb8, 0x66, 0xaf, 0xf0, 0x87, 0x80, 0x99,
-	0x06, 0x12, 0xc7, 0x2f, 0x8c, 0x25, 0xb1, 0x58, 0x4b, 0x43, 0xb1, 0x86, 0x15, 0x9e, 0xa5, 0x56,
-	0x26, 0xf0, 0x46, 0xd4, 0xdd, 0x2a, 0xd2, 0x61, 0x18, 0x24, 0x82, 0xed, 0xf3, 0x01, 0x88, 0x1c,
-	0x20, 0x87, 0x6e, 0x28, 0x39, 0x3b, 0xc1, 0x26, 0x2c, 0xd8, 0x44, 0x75, 0xa1, 0xf0, 0x0e, 0x98,
-	0x36, 0x76, 0x1c, 0x62, 0x98, 0x6c, 0x97, 0x4d, 0xcc, 0xe2, 0xc3, 0xe1, 0x7b, 0x20, 0x6c, 0x63,
-	0x6f, 0x20, 0x27, 0x27, 0x09, 0xdb, 0x18, 0x36, 0x40, 0xd2, 0xc6, 0xfa, 0x23, 0x93, 0xec, 0xea,
-	0x7b, 0x88, 0x60, 0x6f, 0xec, 0xe2, 0xb2, 0x32, 0x19, 0xd3, 0x69, 0x5f, 0x5c, 0xa0, 0xa2, 0x06,
-	0xb9, 0x24, 0x15, 0xd8, 0x78, 0xdb, 0x24, 0xbb, 0x55, 0x44, 0x30, 0x93, 0xf2, 0x98, 0x03, 0x51,
-	0xf7, 0x7a, 0xf9, 0xe7, 0x2b, 0x79, 0x11, 0x4c, 0xed, 0x61, 0x82, 0xfc, 0x75, 0x4c, 0x5f, 0xe0,
-	0xc6, 0xd9, 0xbd, 0x16, 0x79, 0x93, 0x7b, 0x4d, 0x0e, 0xa7, 0xb8, 0xb3, 0xbb, 0x6d, 0x0b, 0x4c,
-	0xd3, 0x27, 0x27, 0x15, 0xf5, 0xc6, 0xe7, 0xea, 0x79, 0xe0, 0xf1, 0xcb, 0x54, 0x8e, 0xba, 0x2a,
-	0xa9, 0x3e, 0x78, 0x63, 0xe6, 0xa9, 0xbf, 0xa9, 0x7f, 0x08, 0x83, 0x59, 0x36, 0x18, 0x65, 0xa3,
-	0x6d, 0x58, 0x0e, 0xfc, 0x9a, 0x03, 0x09, 0xcb, 0xb4, 0xcf, 0xe6, 0x94, 0xbb, 0x68, 0x4e, 0x75,
-	0x97, 0xfb, 0xa4, 0x2f, 0x5e, 0x0e, 0xa0, 0xae, 0x63, 0xcb, 0x24, 0xc8, 0x6a, 0x91, 0x83, 0x81,
-	0x4e, 0x81, 0xe3, 0xc9, 0xc6, 0x17, 0x58, 0xa6, 0xed, 0x0f, 0xef, 0x57, 0x1c, 0x80, 0x96, 0xb1,
-	0xef, 0x13, 0xe9, 0x2d, 0xd4, 0x36, 0x71, 0x9d, 0x5d, 0x11, 0x2b, 0x63, 0x23, 0x95, 0x67, 0x9f,
-	0x1a, 0xb4, 0x4d, 0x4e, 0xfa, 0xe2, 0x95, 0x71, 0xf0, 0x50, 0xae, 0x6c, 0x39, 0x8f, 0x7b, 0x49,
-	0x4f, 0xdd, 0xa1, 0xe3, 0x2d, 0x63, 0xdf, 0x97, 0x8b, 0x9a, 0xbf, 0xe4, 0x40, 0xb2, 0xea, 0x4d,
-	0x22, 0xd3, 0xef, 0x73, 0xc0, 0x26, 0xd3, 0xcf, 0x8d, 0xbb, 0x28, 0xb7, 0xdb, 0x2c, 0xb7, 0xe5,
-	0x21, 0xdc, 0x50, 0x5a, 0x8b, 0x43, 0x8b, 0x20, 0x98, 0x51, 0x92, 0xda, 0x58, 0x36, 0xbf, 0xf9,
-	0xf3, 0xcf, 0x92, 0x79, 0x00, 0x62, 0x9f, 0x75, 0x70, 0xbb, 0x63, 0x79, 0x59, 0x24, 0x65, 0x79,
-	0xb2, 0x8f, 0xa1, 0x93, 0xbe, 0xc8, 0x53, 0xfc, 0x20, 0x1b, 0x95, 0x31, 0xc2, 0x1a, 0x88, 0x93,
-	0xdd, 0x36, 0x72, 0x76, 0x71, 0x93, 0xfe, 0x00, 0xc9, 0x89, 0x86, 0x91, 0xd2, 0x2f, 0x9c, 0x51,
-	0x04, 0x22, 0x0c, 0x78, 0x61, 0x97, 0x03, 0x73, 0xee, 0x84, 0xea, 0x83, 0x50, 0x11, 0x2f, 0x54,
-	0x6d, 0xe2, 0x50, 0xa9, 0x61, 0x9e, 0x21, 0x7d, 0x2f, 0x33, 0x7d, 0x87, 0x3c, 0x24, 0x75, 0xd6,
-	0x35, 0x68, 0xfe, 0xfb, 0xb5, 0x3f, 0x38, 0x00, 0x02, 0x5f, 0xa8, 0xd7, 0xc1, 0x72, 0xb5, 0xa4,
-	0x29, 0x7a, 0xa9, 0xac, 0x15, 0x4a, 0x45, 0xfd, 0x5e, 0xb1, 0x52, 0x56, 0x36, 0x0b, 0x5b, 0x05,
-	0x25, 0xcf, 0x87, 0xd2, 0xf3, 0xdd, 0x5e, 0x26, 0x41, 0x1d, 0x15, 0x37, 0x08, 0x94, 0xc0, 0x7c,
-	0xd0, 0xfb, 0xbe, 0x52, 0xe1, 0xb9, 0xf4, 0x6c, 0xb7, 0x97, 0x89, 0x53, 0xaf, 0xfb, 0xc8, 0x81,
-	0xd7, 0xc0, 0x42, 0xd0, 0x27, 0x27, 0x57, 0xb4, 0x5c, 0xa1, 0xc8, 0x87, 0xd3, 0x97, 0xba, 0xbd,
-	0xcc, 0x2c, 0xf5, 0xcb, 0xb1, 0x75, 0x9a, 0x01, 0x73, 0x41, 0xdf, 0x62, 0x89, 0x8f, 0xa4, 0x93,
-	0xdd, 0x5e, 0x66, 0x86, 0xba, 0x15, 0x31, 0x5c, 0x07, 0xa9, 0x61, 0x0f, 0x7d, 0xbb, 0xa0, 0xdd,
-	0xd1, 0xab, 0x8a, 0x56, 0xe2, 0xa3, 0xe9, 0xc5, 0x6e, 0x2f, 0xc3, 0xfb, 0xbe, 0xfe, 0xee, 0x4b,
-	0x47, 0x1f, 0x7f, 0x23, 0x84, 0xae, 0xfd, 0x14, 0x06, 0x73, 0xc3, 0x9f, 0x47, 0x30, 0x0b, 0xfe,
-	0x55, 0x56, 0x4b, 0xe5, 0x52, 0x25, 0x77, 0x57, 0xaf, 0x68, 0x39, 0xed, 0x5e, 0x65, 0xa4, 0x60,
-	0xaf, 0x14, 0xea, 0x5c, 0x34, 0x9b, 0xf0, 0x36, 0x10, 0x46, 0xfd, 0xf3, 0x4a, 0xb9, 0x54, 0x29,
-	0x68, 0x7a, 0x59, 0x51, 0x0b, 0xa5, 0x3c, 0xcf, 0xa5, 0x97, 0xbb, 0xbd, 0xcc, 0x02, 0x85, 0x0c,
-	0x0d, 0x15, 0x7c, 0x17, 0xfc, 0x7b, 0x14, 0x5c, 0x2d, 0x69, 0x85, 0xe2, 0x87, 0x3e, 0x36, 0x9c,
-	0x5e, 0xea, 0xf6, 0x32, 0x90, 0x62, 0xab, 0x81, 0x09, 0x80, 0xd7, 0xc1, 0xd2, 0x28, 0xb4, 0x9c,
-	0xab, 0x54, 0x94, 0x3c, 0x1f, 0x49, 0xf3, 0xdd, 0x5e, 0x26, 0x49, 0x31, 0x65, 0xc3, 0x71, 0x50,
-	0x1d, 0xde, 0x04, 0xa9, 0x51, 0x6f, 0x55, 0xf9, 0x48, 0xd9, 0xd4, 0x94, 0x3c, 0x1f, 0x4d, 0xc3,
-	0x6e, 0x2f, 0x33, 0x47, 0xfd, 0x55, 0xf4, 0x09, 0xaa, 0x11, 0x74, 0x2e, 0xff, 0x56, 0xae, 0x70,
-	0x57, 0xc9, 0xf3, 0x53, 0x41, 0xfe, 0x2d, 0xc3, 0x6c, 0xa2, 0x3a, 0x95, 0x53, 0x2e, 0x1e, 0xbe,
-	0x12, 0x42, 0x2f, 0x5e, 0x09, 0xa1, 0x2f, 0x8e, 0x84, 0xd0, 0xe1, 0x91, 0xc0, 0x3d, 0x3f, 0x12,
-	0xb8, 0xdf, 0x8f, 0x04, 0xee, 0xc9, 0xb1, 0x10, 0x7a, 0x7e, 0x2c, 0x84, 0x5e, 0x1c, 0x0b, 0xa1,
-	0x07, 0x7f, 0xbf, 0x10, 0xf7, 0xbd, 0x7f, 0xff, 0xbc, 0x7e, 0xde, 0x89, 0x79, 0x3b, 0xe4, 0xff,
-	0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x14, 0xb4, 0xf2, 0xfe, 0x19, 0x0e, 0x00, 0x00,
+	// 1663 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcf, 0x6f, 0xdb, 0xc8,
+	0x15, 0x16, 0x25, 0xc5, 0xb6, 0x46, 0x92, 0xcd, 0x8c, 0xbd, 0x0e, 0xc3, 0x4d, 0x45, 0x95, 0x6d,
+	0x17, 0x46, 0x90, 0x95, 0x77, 0xd3, 0xa2, 0x45, 0x1d, 0xf4, 0x87, 0x18, 0xd1, 0x8d, 0xda, 0x40,
+	0x12, 0x28, 0xad, 0x8c, 0xdd, 0x1e, 0x08, 0x4a, 0x9c, 0x48, 0x6c, 0x44, 0x8e, 0x2a, 0x8e, 0xbc,
+	0x31, 0xda, 0x43, 0x8f, 0x81, 0x0a, 0x14, 0x7b, 0xdc, 0xa2, 0x10, 0x10, 0xa0, 0xe8, 0xa5, 0xe7,
+	0x9e, 0x7b, 0xea, 0x21, 0x28, 0x0a, 0x74, 0xdb, 0xd3, 0xa2, 0x05, 0xb4, 0xdd, 0x18, 0x28, 0x16,
+	0x3e, 0xfa, 0x2f, 0x28, 0x38, 0x33, 0x94, 0x48, 0xc9, 0xa8, 0x57, 0xbb, 0xa7, 0xcc, 0xbc, 0x79,
+	0xdf, 0xf7, 0xde, 0x7c, 0x7a, 0xf3, 0x1e, 0x63, 0x70, 0xa7, 0x8b, 0x7d, 0x17, 0xfb, 0x87, 0x3d,
+	0x7c, 0x7a, 0x78, 0xfa, 0x76, 0x07, 0x11, 0xeb, 0xed, 0x60, 0x5d, 0x1a, 0x8e, 0x30, 0xc1, 0x10,
+	0xb2, 0xd3, 0x52, 0x60, 0xe1, 0xa7, 0x72, 0x81, 0x23, 0x3a, 0x96, 0x8f, 0xe6, 0x90, 0x2e, 0x76,
+	0x3c, 0x86, 0x91, 0xf7, 0x7a, 0xb8, 0x87, 0xe9, 0xf2, 0x30, 0x58, 0x71, 0xeb, 0x6d, 0x86, 0x32,
+	0xd9, 0x01, 0xa7, 0x65, 0x47, 0x4a, 0x0f, 0xe3, 0xde, 0x00, 0x1d, 0xd2, 0x5d, 0x67, 0xfc, 0xe4,
+	0x90, 0x38, 0x2e, 0xf2, 0x89, 0xe5, 0x0e, 0x43, 0xec, 0xb2, 0x83, 0xe5, 0x9d, 0xf1, 0xa3, 0xc2,
+	0xf2, 0x91, 0x3d, 0x1e, 0x59, 0xc4, 0xc1, 0x3c, 0x19, 0xf5, 0x0f, 0x02, 0x80, 0x27, 0xc8, 0xe9,
+	0xf5, 0x09, 0xb2, 0xdb, 0x98, 0xa0, 0xfa, 0x30, 0x38, 0x84, 0xdf, 0x06, 0x1b, 0x98, 0xae, 0x24,
+	0xa1, 0x28, 0x1c, 0x6c, 0xdf, 0x2f, 0x94, 0x56, 0x2f, 0x5a, 0x5a, 0xf8, 0x1b, 0xdc, 0x1b, 0x9e,
+	0x80, 0x8d, 0xf7, 0x29, 0x9b, 0x94, 0x2c, 0x0a, 0x07, 0x19, 0xed, 0x07, 0x2f, 0x67, 0x4a, 0xe2,
+	0x5f, 0x33, 0xe5, 0x8d, 0x9e, 0x43, 0xfa, 0xe3, 0x4e, 0xa9, 0x8b, 0x5d, 0x7e, 0x37, 0xfe, 0xcf,
+	0x9b, 0xbe, 0xfd, 0xf4, 0x90, 0x9c, 0x0d, 0x91, 0x5f, 0xaa, 0xa0, 0xee, 0xe5, 0x4c, 0xc9, 0x9f,
+	0x59, 0xee, 0xe0, 0x48, 0x65, 0x2c, 0xaa, 0xc1, 0xe9, 0xd4, 0x13, 0x90, 0x6b, 0xa1, 0x67, 0xa4,
+	0x31, 0xc2, 0x43, 0xec, 0x5b, 0x03, 0xb8, 0x07, 0x6e, 0x10, 0x87, 0x0c, 0x10, 0xcd, 0x2f, 0x63,
+	0xb0, 0x0d, 0x2c, 0x82, 0xac, 0x8d, 0xfc, 0xee, 0xc8, 0x61, 0xb9, 0xd3, 0x1c, 0x8c, 0xa8, 0xe9,
+	0x68, 0xe7, 0xb3, 0x17, 0x8a, 0xf0, 0xcf, 0x3f, 0xbd, 0xb9, 0xf9, 0x10, 0x7b, 0x04, 0x79, 0x44,
+	0xfd, 0xbb, 0x00, 0x36, 0x2b, 0x68, 0x88, 0x7d, 0x87, 0xc0, 0xef, 0x80, 0xec, 0x90, 0x07, 0x30,
+	0x1d, 0x9b, 0x52, 0xa7, 0xb5, 0xfd, 0xcb, 0x99, 0x02, 0x59, 0x52, 0x91, 0x43, 0xd5, 0x00, 0xe1,
+	0xae, 0x6a, 0xc3, 0x3b, 0x20, 0x63, 0x33, 0x0e, 0x3c, 0xe2, 0x51, 0x17, 0x06, 0xd8, 0x05, 0x1b,
+	0x96, 0x8b, 0xc7, 0x1e, 0x91, 0x52, 0xc5, 0xd4, 0x41, 0xf6, 0xfe, 0xed, 0x50, 0xcc, 0xa0, 0x42,
+	0xe6, 0x6a, 0x3e, 0xc4, 0x8e, 0xa7, 0xbd, 0x15, 0xe8, 0xf5, 0xc7, 0x4f, 0x94, 0x83, 0xcf, 0xa1,
+	0x57, 0x00, 0xf0, 0x0d, 0x4e, 0x7d, 0xb4, 0xf5, 0xfc, 0x85, 0x92, 0xf8, 0xec, 0x85, 0x92, 0x50,
+	0xff, 0xb1, 0x09, 0xb6, 0xe6, 0x3a, 0x7d, 0xeb, 0xaa, 0x2b, 0xed, 0x5e, 0xcc, 0x94, 0xa4, 0x63,
+	0x5f, 0xce, 0x94, 0x0c, 0xbb, 0xd8, 0xf2, 0x7d, 0x1e, 0x80, 0xcd, 0x2e, 0xd3, 0x87, 0xde, 0x26,
+	0x7b, 0x7f, 0xaf, 0xc4, 0xea, 0xa8, 0x14, 0xd6, 0x51, 0xa9, 0xec, 0x9d, 0x69, 0xd9, 0xbf, 0x2e,
+	0x84, 0x34, 0x42, 0x04, 0x6c, 0x83, 0x0d, 0x9f, 0x58, 0x64, 0xec, 0x4b, 0x29, 0x5a, 0x3b, 0xea,
+	0x55, 0xb5, 0x13, 0x26, 0xd8, 0xa4, 0x9e, 0x9a, 0x7c, 0x39, 0x53, 0xf6, 0x97, 0x44, 0x66, 0x24,
+	0xaa, 0xc1, 0xd9, 0xe0, 0x10, 0xc0, 0x27, 0x8e, 0x67, 0x0d, 0x4c, 0x62, 0x0d, 0x06, 0x67, 0xe6,
+	0x08, 0xf9, 0xe3, 0x01, 0x91, 0xd2, 0x34, 0x3f, 0xe5, 0xaa, 0x18, 0xad, 0xc0, 0xcf, 0xa0, 0x6e,
+	0xda, 0x57, 0x03, 0x61, 0x2f, 0x67, 0xca, 0x6d, 0x16, 0x64, 0x95, 0x48, 0x35, 0x44, 0x6a, 0x8c,
+	0x80, 0xe0, 0x4f, 0x41, 0xd6, 0x1f, 0x77, 0x5c, 0x87, 0x98, 0xc1, 0x8b, 0x93, 0x6e, 0xd0, 0x50,
+	0xf2, 0x8a, 0x14, 0xad, 0xf0, 0x39, 0x6a, 0x05, 0x1e, 0x85, 0xd7, 0x4b, 0x04, 0xac, 0x7e, 0xf0,
+	0x89, 0x22, 0x18, 0x80, 0x59, 0x02, 0x00, 0x74, 0x80, 0xc8, 0x4b, 0xc4, 0x44, 0x9e, 0xcd, 0x22,
+	0x6c, 0x5c, 0x1b, 0xe1, 0x6b, 0x3c, 0xc2, 0x2d, 0x16, 0x61, 0x99, 0x81, 0x85, 0xd9, 0xe6, 0x66,
+	0xdd, 0xb3, 0x69, 0xa8, 0xe7, 0x02, 0xc8, 0x13, 0x4c, 0xac, 0x81, 0xc9, 0x0f, 0xa4, 0xcd, 0xeb,
+	0x0a, 0xf1, 0x11, 0x8f, 0xb3, 0xc7, 0xe2, 0xc4, 0xd0, 0xea, 0x5a, 0x05, 0x9a, 0xa3, 0xd8, 0xf0,
+	0x89, 0x0d, 0xc0, 0xcd, 0x53, 0x4c, 0x1c, 0xaf, 0x17, 0xfc, 0xbc, 0x23, 0x2e, 0xec, 0xd6, 0xb5,
+	0xd7, 0xfe, 0x3a, 0x4f, 0x47, 0x62, 0xe9, 0xac, 0x50, 0xb0, 0x7b, 0xef, 0x30, 0x7b, 0x33, 0x30,
+	0xd3, 0x8b, 0x3f, 0x01, 0xdc, 0xb4, 0x90, 0x38, 0x73, 0x6d, 0x2c, 0x95, 0xc7, 0xda, 0x8f, 0xc5,
+	0x8a, 0x2b, 0x9c, 0x67, 0xd6, 0x50, 0x60, 0x19, 0x6c, 0xb9, 0x88, 0x58, 0xb6, 0x45, 0x2c, 0x09,
+	0xd0, 0xe7, 0x3f, 0xdf, 0xc3, 0xef, 0x81, 0x7c, 0xb8, 0x36, 0xfb, 0x96, 0xdf, 0x97, 0xb2, 0x45,
+	0xe1, 0x20, 0xa7, 0x49, 0x0b, 0x71, 0x63, 0xc7, 0xaa, 0x91, 0x0b, 0xf7, 0x8f, 0x2c, 0xbf, 0x7f,
+	0x94, 0x0e, 0x1a, 0x96, 0xfa, 0x32, 0x09, 0xb2, 0xd1, 0xca, 0xfc, 0x21, 0x48, 0x9d, 0x21, 0x9f,
+	0x35, 0x3f, 0xad, 0xb4, 0x46, 0x93, 0xad, 0x7a, 0xc4, 0x08, 0xa0, 0xf0, 0x11, 0xd8, 0xb4, 0x3a,
+	0x3e, 0xb1, 0x1c, 0xde, 0x26, 0xd7, 0x66, 0x09, 0xe1, 0xf0, 0xfb, 0x20, 0xe9, 0x61, 0x29, 0xf5,
+	0x85, 0x48, 0x92, 0x1e, 0x86, 0x3d, 0x90, 0xf3, 0xb0, 0xf9, 0xbe, 0x43, 0xfa, 0xe6, 0x29, 0x22,
+	0x98, 0xbe, 0xe8, 0x8c, 0xa6, 0xaf, 0xc7, 0x74, 0x39, 0x53, 0x76, 0x99, 0x9a, 0x51, 0x2e, 0xd5,
+	0x00, 0x1e, 0x3e, 0x71, 0x48, 0xbf, 0x8d, 0x08, 0xe6, 0x52, 0x9e, 0x0b, 0x20, 0x1d, 0x4c, 0xae,
+	0x2f, 0xde, 0xed, 0xf7, 0xc0, 0x8d, 0x53, 0x4c, 0x50, 0xd8, 0xe9, 0xd9, 0x06, 0x1e, 0xcd, 0x47,
+	0x66, 0xea, 0xf3, 0x8c, 0x4c, 0x2d, 0x29, 0x09, 0xf3, 0xb1, 0x79, 0x0c, 0x36, 0xd9, 0xca, 0x97,
+	0xd2, 0xf4, 0x65, 0xbe, 0x71, 0x15, 0x78, 0x75, 0x4e, 0x6b, 0xe9, 0x40, 0x25, 0x23, 0x04, 0x1f,
+	0x6d, 0x7d, 0x18, 0x0e, 0x81, 0xdf, 0x26, 0x41, 0x9e, 0x16, 0x4c, 0xd3, 0xb3, 0x86, 0x7e, 0x1f,
+	0x7f, 0x89, 0xe1, 0xf6, 0x4b, 0xb0, 0xcb, 0x9e, 0x7f, 0x07, 0x7b, 0x36, 0xb2, 0x4d, 0x82, 0x9f,
+	0x22, 0xcf, 0xe7, 0x55, 0xf3, 0x78, 0xed, 0x9f, 0x49, 0x8e, 0x76, 0x94, 0x18, 0xa5, 0x6a, 0xdc,
+	0xa4, 0x56, 0x8d, 0x1a, 0x5b, 0xd4, 0x06, 0x7f, 0x02, 0xc0, 0xa9, 0x35, 0x70, 0x6c, 0x8b, 0xe0,
+	0x91, 0xcf, 0x07, 0xe8, 0x37, 0xae, 0x94, 0x36, 0xf4, 0x0a, 0x6f, 0xcc, 0xc5, 0x89, 0xc0, 0x8f,
+	0xd2, 0x81, 0x3e, 0xea, 0x5f, 0x92, 0xe0, 0xe6, 0x8a, 0x37, 0x3c, 0x06, 0x22, 0x1e, 0xa2, 0x51,
+	0x60, 0x33, 0x2d, 0xdb, 0x1e, 0x21, 0x3f, 0x7c, 0x5f, 0xaf, 0x2f, 0xfa, 0xed, 0xb2, 0x87, 0x6a,
+	0xec, 0x84, 0xa6, 0x32, 0xb3, 0xc0, 0xa7, 0x20, 0x7f, 0x95, 0x50, 0xc7, 0x6b, 0x0b, 0xc5, 0xbb,
+	0xc3, 0x92, 0x44, 0xb9, 0x4e, 0x54, 0x1d, 0x12, 0x0c, 0x91, 0x01, 0xea, 0xd1, 0x9c, 0xfc, 0xbe,
+	0x35, 0x42, 0x3e, 0x7f, 0x89, 0xd5, 0xb5, 0xbf, 0xbc, 0xe6, 0x23, 0x25, 0xce, 0xa7, 0x1a, 0x3b,
+	0x73, 0x53, 0x93, 0x5a, 0xb8, 0x8c, 0x7f, 0x4e, 0x82, 0x3c, 0x6f, 0xeb, 0x0d, 0x6b, 0x64, 0xb9,
+	0x3e, 0xfc, 0x9d, 0x00, 0xb2, 0xae, 0xe3, 0xcd, 0xa7, 0x8c, 0x70, 0xdd, 0x94, 0x31, 0x83, 0x24,
+	0x2f, 0x66, 0xca, 0x6b, 0x11, 0xd4, 0x3d, 0xec, 0x3a, 0x04, 0xb9, 0x43, 0x72, 0xb6, 0xa8, 0xcd,
+	0xc8, 0xf1, 0x7a, 0xc3, 0x07, 0xb8, 0x8e, 0x17, 0x8e, 0x9e, 0xdf, 0x08, 0x00, 0xba, 0xd6, 0xb3,
+	0x90, 0xc8, 0x1c, 0xa2, 0x91, 0x83, 0x6d, 0xfe, 0x81, 0x73, 0x7b, 0x65, 0x20, 0x54, 0xf8, 0x87,
+	0x32, 0xeb, 0x44, 0x17, 0x33, 0xe5, 0xce, 0x2a, 0x38, 0x96, 0x2b, 0xff, 0xb4, 0x58, 0xf5, 0x52,
+	0x3f, 0x0c, 0x46, 0x86, 0xe8, 0x5a, 0xcf, 0x42, 0xb9, 0x98, 0xf9, 0xd7, 0x02, 0xc8, 0xb5, 0xe9,
+	0x1c, 0xe1, 0xfa, 0xfd, 0x02, 0xf0, 0xb9, 0x12, 0xe6, 0x26, 0x5c, 0x97, 0xdb, 0x03, 0x9e, 0xdb,
+	0xad, 0x18, 0x2e, 0x96, 0xd6, 0x5e, 0x6c, 0x8c, 0x45, 0x33, 0xca, 0x31, 0x1b, 0xcf, 0xe6, 0xdf,
+	0xe1, 0x88, 0xe1, 0xc9, 0xbc, 0x07, 0x36, 0x7e, 0x3e, 0xc6, 0xa3, 0xb1, 0x4b, 0xb3, 0xc8, 0x69,
+	0xda, 0x7a, 0x05, 0x75, 0x31, 0x53, 0x44, 0x86, 0x5f, 0x64, 0x63, 0x70, 0x46, 0xd8, 0x05, 0x19,
+	0xd2, 0x1f, 0x21, 0xbf, 0x8f, 0x07, 0xec, 0x07, 0xc8, 0x69, 0xfa, 0xda, 0xf4, 0xbb, 0x73, 0x8a,
+	0x48, 0x84, 0x05, 0x2f, 0x9c, 0x08, 0x60, 0x3b, 0x18, 0x02, 0xe6, 0x22, 0x54, 0x8a, 0x86, 0xea,
+	0xae, 0x1d, 0x4a, 0x8a, 0xf3, 0xc4, 0xf4, 0x7d, 0x8d, 0xeb, 0x1b, 0xf3, 0x50, 0x8d, 0x7c, 0x60,
+	0x68, 0x85, 0xfb, 0xbb, 0xff, 0x15, 0x00, 0x88, 0xfc, 0xff, 0xea, 0x1e, 0xb8, 0xd5, 0xae, 0xb7,
+	0x74, 0xb3, 0xde, 0x68, 0x55, 0xeb, 0x35, 0xf3, 0x9d, 0x5a, 0xb3, 0xa1, 0x3f, 0xac, 0x1e, 0x57,
+	0xf5, 0x8a, 0x98, 0x90, 0x77, 0x26, 0xd3, 0x62, 0x96, 0x39, 0xea, 0x41, 0x10, 0xa8, 0x82, 0x9d,
+	0xa8, 0xf7, 0xbb, 0x7a, 0x53, 0x14, 0xe4, 0xfc, 0x64, 0x5a, 0xcc, 0x30, 0xaf, 0x77, 0x91, 0x0f,
+	0xef, 0x82, 0xdd, 0xa8, 0x4f, 0x59, 0x6b, 0xb6, 0xca, 0xd5, 0x9a, 0x98, 0x94, 0x6f, 0x4e, 0xa6,
+	0xc5, 0x3c, 0xf3, 0x2b, 0xf3, 0x89, 0x5d, 0x04, 0xdb, 0x51, 0xdf, 0x5a, 0x5d, 0x4c, 0xc9, 0xb9,
+	0xc9, 0xb4, 0xb8, 0xc5, 0xdc, 0x6a, 0x18, 0xde, 0x07, 0x52, 0xdc, 0xc3, 0x3c, 0xa9, 0xb6, 0x1e,
+	0x99, 0x6d, 0xbd, 0x55, 0x17, 0xd3, 0xf2, 0xde, 0x64, 0x5a, 0x14, 0x43, 0xdf, 0x70, 0xbc, 0xca,
+	0xe9, 0xe7, 0xbf, 0x2f, 0x24, 0xee, 0xfe, 0x2d, 0x09, 0xb6, 0xe3, 0x1f, 0xf7, 0xb0, 0x04, 0x5e,
+	0x6f, 0x18, 0xf5, 0x46, 0xbd, 0x59, 0x7e, 0x6c, 0x36, 0x5b, 0xe5, 0xd6, 0x3b, 0xcd, 0xa5, 0x0b,
+	0xd3, 0xab, 0x30, 0xe7, 0x9a, 0x33, 0x80, 0x0f, 0x40, 0x61, 0xd9, 0xbf, 0xa2, 0x37, 0xea, 0xcd,
+	0x6a, 0xcb, 0x6c, 0xe8, 0x46, 0xb5, 0x5e, 0x11, 0x05, 0xf9, 0xd6, 0x64, 0x5a, 0xdc, 0x65, 0x90,
+	0xd8, 0xa3, 0x82, 0xdf, 0x05, 0x5f, 0x59, 0x06, 0xb7, 0xeb, 0xad, 0x6a, 0xed, 0x47, 0x21, 0x36,
+	0x29, 0xef, 0x4f, 0xa6, 0x45, 0xc8, 0xb0, 0xed, 0xc8, 0x0b, 0x80, 0xf7, 0xc0, 0xfe, 0x32, 0xb4,
+	0x51, 0x6e, 0x36, 0xf5, 0x8a, 0x98, 0x92, 0xc5, 0xc9, 0xb4, 0x98, 0x63, 0x98, 0x86, 0xe5, 0xfb,
+	0xc8, 0x86, 0x6f, 0x01, 0x69, 0xd9, 0xdb, 0xd0, 0x7f, 0xac, 0x3f, 0x6c, 0xe9, 0x15, 0x31, 0x2d,
+	0xc3, 0xc9, 0xb4, 0xb8, 0xcd, 0xfc, 0x0d, 0xf4, 0x33, 0xd4, 0x25, 0xe8, 0x4a, 0xfe, 0xe3, 0x72,
+	0xf5, 0xb1, 0x5e, 0x11, 0x6f, 0x44, 0xf9, 0x8f, 0x2d, 0x67, 0x80, 0x6c, 0x26, 0xa7, 0x56, 0x7b,
+	0xf9, 0x69, 0x21, 0xf1, 0xf1, 0xa7, 0x85, 0xc4, 0xaf, 0x5e, 0x15, 0x12, 0x2f, 0x5f, 0x15, 0x84,
+	0x8f, 0x5e, 0x15, 0x84, 0xff, 0xbc, 0x2a, 0x08, 0x1f, 0x9c, 0x17, 0x12, 0x1f, 0x9d, 0x17, 0x12,
+	0x1f, 0x9f, 0x17, 0x12, 0xef, 0xfd, 0xff, 0x86, 0xf8, 0x8c, 0xfe, 0xf1, 0x82, 0xd6, 0x73, 0x67,
+	0x83, 0xf6, 0x90, 0x6f, 0xfe, 0x6f, 0x00, 0x20, 0x8c, 0xef, 0x08, 0xd7, 0x10, 0x00, 0x00,
 }
 
 func (this *TextProposal) Equal(that interface{}) bool {
@@ -682,6 +788,12 @@ func (this *Proposal) Equal(that interface{}) bool {
 	if !this.VotingEndTime.Equal(that1.VotingEndTime) {
 		return false
 	}
+	if this.Metadata != that1.Metadata {
+		return false
+	}
+	if !bytes.Equal(this.MetadataHash, that1.MetadataHash) {
+		return false
+	}
 	return true
 }
 func (this *TallyResult) Equal(that interface{}) bool {
@@ -861,6 +973,20 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MetadataHash) > 0 {
+		i -= len(m.MetadataHash)
+		copy(dAtA[i:], m.MetadataHash)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MetadataHash)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x52
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
 	if err1 != nil {
 		return 0, err1
@@ -1059,6 +1185,108 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TallySnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallySnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallySnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.TotalBondedTokens.Size()
+		i -= size
+		if _, err := m.TotalBondedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DelegatorShares.Size()
+		i -= size
+		if _, err := m.DelegatorShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BondedTokens.Size()
+		i -= size
+		if _, err := m.BondedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DepositParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1284,6 +1512,14 @@ func (m *Proposal) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
 	n += 1 + l + sovGov(uint64(l))
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.MetadataHash)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1329,6 +1565,43 @@ func (m *Vote) Size() (n int) {
 	return n
 }
 
+func (m *TallySnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = m.TotalBondedTokens.Size()
+	n += 1 + l + sovGov(uint64(l))
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.BondedTokens.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.DelegatorShares.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
 func (m *DepositParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2032,6 +2305,72 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataHash = append(m.MetadataHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.MetadataHash == nil {
+				m.MetadataHash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2393,6 +2732,293 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TallySnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallySnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallySnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBondedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, ValidatorSnapshot{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DepositParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
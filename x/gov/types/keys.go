@@ -37,6 +37,8 @@ const (
 // - 0x10<proposalID_Bytes><depositorAddrLen (1 Byte)><depositorAddr_Bytes>: Deposit
 //
 // - 0x20<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: Voter
+//
+// - 0x30<proposalID_Bytes>: TallySnapshot
 var (
 	ProposalsKeyPrefix          = []byte{0x00}
 	ActiveProposalQueuePrefix   = []byte{0x01}
@@ -46,6 +48,8 @@ var (
 	DepositsKeyPrefix = []byte{0x10}
 
 	VotesKeyPrefix = []byte{0x20}
+
+	TallySnapshotsKeyPrefix = []byte{0x30}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -107,6 +111,11 @@ func VoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
 }
 
+// TallySnapshotKey gets the tally snapshot of a specific proposal from the store
+func TallySnapshotKey(proposalID uint64) []byte {
+	return append(TallySnapshotsKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
@@ -62,6 +62,13 @@ func (m *MsgSubmitProposal) SetProposer(address fmt.Stringer) {
 	m.Proposer = address.String()
 }
 
+// SetMetadata sets the off-chain metadata of the proposal and the optional
+// SHA-256 hash of the document it points to.
+func (m *MsgSubmitProposal) SetMetadata(metadata string, metadataHash []byte) {
+	m.Metadata = metadata
+	m.MetadataHash = metadataHash
+}
+
 func (m *MsgSubmitProposal) SetContent(content Content) error {
 	msg, ok := content.(proto.Message)
 	if !ok {
@@ -104,7 +111,7 @@ func (m MsgSubmitProposal) ValidateBasic() error {
 		return err
 	}
 
-	return nil
+	return ValidateProposalMetadata(m.Metadata, m.MetadataHash)
 }
 
 // GetSignBytes implements Msg
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"strings"
 	"testing"
 
@@ -68,6 +69,40 @@ func TestMsgDepositGetSignBytes(t *testing.T) {
 	require.Equal(t, expected, string(res))
 }
 
+func TestMsgSubmitProposalMetadata(t *testing.T) {
+	hash := sha256.Sum256([]byte("document"))
+	tests := []struct {
+		name         string
+		metadata     string
+		metadataHash []byte
+		expectPass   bool
+	}{
+		{"no metadata", "", nil, true},
+		{"metadata without hash", "ipfs://QmcwnCamp7UWgVfmB7nMwTsgdWJL8x8wNSXHtHkAmbBaxK", nil, true},
+		{"metadata with hash", "ipfs://QmcwnCamp7UWgVfmB7nMwTsgdWJL8x8wNSXHtHkAmbBaxK", hash[:], true},
+		{"metadata of max length", strings.Repeat("#", MaxMetadataLen), nil, true},
+		{"too long metadata", strings.Repeat("#", MaxMetadataLen+1), nil, false},
+		{"hash without metadata", "", hash[:], false},
+		{"hash of wrong length", "ipfs://QmcwnCamp7UWgVfmB7nMwTsgdWJL8x8wNSXHtHkAmbBaxK", hash[:16], false},
+	}
+
+	for _, tc := range tests {
+		msg, err := NewMsgSubmitProposal(
+			ContentFromProposalType("Test Proposal", "the purpose of this proposal is to test", ProposalTypeText),
+			coinsPos,
+			addrs[0],
+		)
+		require.NoError(t, err)
+		msg.SetMetadata(tc.metadata, tc.metadataHash)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), tc.name)
+		} else {
+			require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidProposalMetadata, tc.name)
+		}
+	}
+}
+
 // test ValidateBasic for MsgDeposit
 func TestMsgDeposit(t *testing.T) {
 	tests := []struct {
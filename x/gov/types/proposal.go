@@ -1,6 +1,8 @@
 package types
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"fmt"
 	"strings"
 	"time"
@@ -13,8 +15,14 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// DefaultStartingProposalID is 1
-const DefaultStartingProposalID uint64 = 1
+const (
+	// DefaultStartingProposalID is 1
+	DefaultStartingProposalID uint64 = 1
+
+	// MaxMetadataLen is the maximum length of the metadata of a proposal, which
+	// anchors a document stored off-chain rather than holding it.
+	MaxMetadataLen = 255
+)
 
 // NewProposal creates a new Proposal instance
 func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Time) (Proposal, error) {
@@ -56,6 +64,42 @@ func (p Proposal) GetContent() Content {
 	return content
 }
 
+// ValidateProposalMetadata validates the metadata of a proposal and the
+// optional SHA-256 hash of the document it points to.
+func ValidateProposalMetadata(metadata string, metadataHash []byte) error {
+	if len(metadata) > MaxMetadataLen {
+		return sdkerrors.Wrapf(ErrInvalidProposalMetadata, "metadata is longer than %d bytes", MaxMetadataLen)
+	}
+
+	if len(metadataHash) == 0 {
+		return nil
+	}
+	if metadata == "" {
+		return sdkerrors.Wrap(ErrInvalidProposalMetadata, "metadata hash without metadata")
+	}
+	if len(metadataHash) != sha256.Size {
+		return sdkerrors.Wrapf(ErrInvalidProposalMetadata, "metadata hash must be a %d bytes SHA-256 hash", sha256.Size)
+	}
+
+	return nil
+}
+
+// VerifyMetadata returns an error unless the document matches the metadata
+// hash of the proposal. Documents of proposals without metadata hash are not
+// verified.
+func (p Proposal) VerifyMetadata(document []byte) error {
+	if len(p.MetadataHash) == 0 {
+		return nil
+	}
+
+	hash := sha256.Sum256(document)
+	if !bytes.Equal(hash[:], p.MetadataHash) {
+		return sdkerrors.Wrapf(ErrInvalidProposalMetadata, "document hash %X does not match the metadata hash %X", hash[:], p.MetadataHash)
+	}
+
+	return nil
+}
+
 func (p Proposal) ProposalType() string {
 	content := p.GetContent()
 	if content == nil {
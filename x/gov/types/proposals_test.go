@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"fmt"
 	"testing"
 
@@ -22,3 +23,15 @@ func TestProposalStatus_Format(t *testing.T) {
 		require.Equal(t, tt.expectedStringOutput, got)
 	}
 }
+
+func TestProposalVerifyMetadata(t *testing.T) {
+	document := []byte(`{"title":"Test Proposal"}`)
+	hash := sha256.Sum256(document)
+
+	proposal := Proposal{Metadata: "ipfs://QmcwnCamp7UWgVfmB7nMwTsgdWJL8x8wNSXHtHkAmbBaxK"}
+	require.NoError(t, proposal.VerifyMetadata(document))
+
+	proposal.MetadataHash = hash[:]
+	require.NoError(t, proposal.VerifyMetadata(document))
+	require.ErrorIs(t, proposal.VerifyMetadata([]byte(`{"title":"Other Proposal"}`)), ErrInvalidProposalMetadata)
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	yaml "gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -66,3 +68,58 @@ func (tr TallyResult) String() string {
 	out, _ := yaml.Marshal(tr)
 	return string(out)
 }
+
+// NewTallySnapshot creates a new TallySnapshot instance
+func NewTallySnapshot(proposalID uint64, totalBondedTokens sdk.Int, validators []ValidatorSnapshot) TallySnapshot {
+	return TallySnapshot{
+		ProposalId:        proposalID,
+		TotalBondedTokens: totalBondedTokens,
+		Validators:        validators,
+	}
+}
+
+// String implements stringer interface
+func (ts TallySnapshot) String() string {
+	out, _ := yaml.Marshal(ts)
+	return string(out)
+}
+
+// Validate performs a basic validation of the tally snapshot.
+func (ts TallySnapshot) Validate() error {
+	if ts.TotalBondedTokens.IsNil() || ts.TotalBondedTokens.IsNegative() {
+		return fmt.Errorf("invalid total bonded tokens of the tally snapshot of proposal %d", ts.ProposalId)
+	}
+
+	seen := make(map[string]bool, len(ts.Validators))
+	for _, val := range ts.Validators {
+		if _, err := sdk.ValAddressFromBech32(val.OperatorAddress); err != nil {
+			return fmt.Errorf("invalid validator of the tally snapshot of proposal %d: %w", ts.ProposalId, err)
+		}
+		if seen[val.OperatorAddress] {
+			return fmt.Errorf("duplicate validator %s in the tally snapshot of proposal %d", val.OperatorAddress, ts.ProposalId)
+		}
+		seen[val.OperatorAddress] = true
+
+		if val.BondedTokens.IsNil() || val.BondedTokens.IsNegative() ||
+			val.DelegatorShares.IsNil() || !val.DelegatorShares.IsPositive() {
+			return fmt.Errorf("invalid voting power of validator %s in the tally snapshot of proposal %d", val.OperatorAddress, ts.ProposalId)
+		}
+	}
+
+	return nil
+}
+
+// NewValidatorSnapshot creates a new ValidatorSnapshot instance
+func NewValidatorSnapshot(operator sdk.ValAddress, bondedTokens sdk.Int, delegatorShares sdk.Dec) ValidatorSnapshot {
+	return ValidatorSnapshot{
+		OperatorAddress: operator.String(),
+		BondedTokens:    bondedTokens,
+		DelegatorShares: delegatorShares,
+	}
+}
+
+// String implements stringer interface
+func (vs ValidatorSnapshot) String() string {
+	out, _ := yaml.Marshal(vs)
+	return string(out)
+}
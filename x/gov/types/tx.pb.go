@@ -38,6 +38,12 @@ type MsgSubmitProposal struct {
 	Content        *types.Any                               `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
 	InitialDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=initial_deposit,json=initialDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"initial_deposit" yaml:"initial_deposit"`
 	Proposer       string                                   `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// metadata is an off-chain anchor of the proposal, e.g. the URI of an IPFS
+	// document describing it, of at most MaxMetadataLen bytes.
+	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// metadata_hash is the optional SHA-256 hash of the document the metadata
+	// points to, committing to its content.
+	MetadataHash []byte `protobuf:"bytes,5,opt,name=metadata_hash,json=metadataHash,proto3" json:"metadata_hash,omitempty" yaml:"metadata_hash"`
 }
 
 func (m *MsgSubmitProposal) Reset()      { *m = MsgSubmitProposal{} }
@@ -363,49 +369,51 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/tx.proto", fileDescriptor_3c053992595e3dce) }
 
 var fileDescriptor_3c053992595e3dce = []byte{
-	// 659 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x3f, 0x6f, 0xd3, 0x5e,
-	0x14, 0xb5, 0x93, 0xfe, 0x9a, 0x5f, 0x5f, 0x50, 0x4b, 0x9f, 0xa2, 0x92, 0xb8, 0x95, 0x1d, 0x19,
-	0xb5, 0x8a, 0x84, 0x6a, 0xd3, 0x20, 0x81, 0x54, 0x26, 0x52, 0x54, 0x01, 0x52, 0x04, 0x18, 0x09,
-	0x24, 0x96, 0xe2, 0x24, 0xae, 0x6b, 0x91, 0xf8, 0x5a, 0x79, 0x2f, 0x51, 0xb3, 0x31, 0x32, 0x01,
-	0x23, 0x63, 0x67, 0x36, 0x24, 0x26, 0x3e, 0x41, 0xc5, 0xd4, 0x91, 0x01, 0x05, 0xd4, 0x2e, 0x80,
-	0x98, 0xfa, 0x09, 0x90, 0xdf, 0x1f, 0xb7, 0x34, 0x6e, 0x54, 0x50, 0xa7, 0xe4, 0xdd, 0x73, 0xcf,
-	0xf1, 0x3d, 0xf7, 0xdd, 0x6b, 0xa3, 0xf9, 0x26, 0x90, 0x0e, 0x10, 0xdb, 0x87, 0xbe, 0xdd, 0x5f,
-	0x69, 0x78, 0xd4, 0x5d, 0xb1, 0xe9, 0xb6, 0x15, 0x75, 0x81, 0x02, 0xc6, 0x1c, 0xb4, 0x7c, 0xe8,
-	0x5b, 0x02, 0xd4, 0x74, 0x41, 0x68, 0xb8, 0xc4, 0x4b, 0x18, 0x4d, 0x08, 0x42, 0xce, 0xd1, 0x16,
-	0x52, 0x04, 0x63, 0x3e, 0x47, 0x4b, 0x1c, 0xdd, 0x60, 0x27, 0x5b, 0xc8, 0x73, 0xa8, 0xe0, 0x83,
-	0x0f, 0x3c, 0x1e, 0xff, 0x93, 0x04, 0x1f, 0xc0, 0x6f, 0x7b, 0x36, 0x3b, 0x35, 0x7a, 0x9b, 0xb6,
-	0x1b, 0x0e, 0x38, 0x64, 0xbe, 0xce, 0xa0, 0xd9, 0x3a, 0xf1, 0x1f, 0xf5, 0x1a, 0x9d, 0x80, 0x3e,
-	0xe8, 0x42, 0x04, 0xc4, 0x6d, 0xe3, 0x9b, 0x28, 0xd7, 0x84, 0x90, 0x7a, 0x21, 0x2d, 0xaa, 0x65,
-	0xb5, 0x92, 0xaf, 0x16, 0x2c, 0x2e, 0x61, 0x49, 0x09, 0xeb, 0x56, 0x38, 0xa8, 0xe5, 0x3f, 0x7d,
-	0x58, 0xce, 0xad, 0xf1, 0x44, 0x47, 0x32, 0xf0, 0x2b, 0x15, 0xcd, 0x04, 0x61, 0x40, 0x03, 0xb7,
-	0xbd, 0xd1, 0xf2, 0x22, 0x20, 0x01, 0x2d, 0x66, 0xca, 0xd9, 0x4a, 0xbe, 0x5a, 0xb2, 0x44, 0xb1,
-	0xb1, 0x6f, 0xd9, 0x0c, 0x6b, 0x0d, 0x82, 0xb0, 0x76, 0x6f, 0x77, 0x68, 0x28, 0x87, 0x43, 0x63,
-	0x6e, 0xe0, 0x76, 0xda, 0xab, 0xe6, 0x09, 0xbe, 0xf9, 0xee, 0xab, 0x51, 0xf1, 0x03, 0xba, 0xd5,
-	0x6b, 0x58, 0x4d, 0xe8, 0x08, 0xcf, 0xe2, 0x67, 0x99, 0xb4, 0x9e, 0xdb, 0x74, 0x10, 0x79, 0x84,
-	0x49, 0x11, 0x67, 0x5a, 0xb0, 0x6f, 0x73, 0x32, 0xd6, 0xd0, 0xff, 0x11, 0x73, 0xe6, 0x75, 0x8b,
-	0xd9, 0xb2, 0x5a, 0x99, 0x72, 0x92, 0xf3, 0xea, 0xc5, 0x97, 0x3b, 0x86, 0xf2, 0x76, 0xc7, 0x50,
-	0xbe, 0xef, 0x18, 0xca, 0x8b, 0x2f, 0x65, 0xc5, 0x6c, 0xa2, 0xd2, 0x48, 0x43, 0x1c, 0x8f, 0x44,
-	0x10, 0x12, 0x0f, 0xaf, 0xa3, 0x7c, 0x24, 0x62, 0x1b, 0x41, 0x8b, 0x35, 0x67, 0xa2, 0xb6, 0xf8,
-	0x73, 0x68, 0x1c, 0x0f, 0x1f, 0x0e, 0x0d, 0xcc, 0x6d, 0x1c, 0x0b, 0x9a, 0x0e, 0x92, 0xa7, 0xbb,
-	0x2d, 0xf3, 0xbd, 0x8a, 0x72, 0x75, 0xe2, 0x3f, 0x06, 0x7a, 0x6e, 0x9a, 0xb8, 0x80, 0xfe, 0xeb,
-	0x03, 0xf5, 0xba, 0xc5, 0x0c, 0xf3, 0xc8, 0x0f, 0xf8, 0x3a, 0x9a, 0x84, 0x88, 0x06, 0x10, 0x32,
-	0xeb, 0xd3, 0x55, 0xdd, 0x1a, 0x9d, 0x47, 0x2b, 0xae, 0xe3, 0x3e, 0xcb, 0x72, 0x44, 0x76, 0x4a,
-	0x63, 0x66, 0xd1, 0x8c, 0x28, 0x59, 0xb6, 0xc3, 0xfc, 0xa8, 0x26, 0xb1, 0x27, 0x5e, 0xe0, 0x6f,
-	0x51, 0xaf, 0x85, 0x6f, 0xa4, 0xd9, 0x99, 0xfb, 0xe7, 0xfa, 0xd7, 0x51, 0x8e, 0x57, 0x44, 0x8a,
-	0x59, 0x36, 0x44, 0x4b, 0x69, 0x06, 0xe4, 0xd3, 0x8f, 0x8c, 0xd4, 0x26, 0xe2, 0x89, 0x72, 0x24,
-	0x39, 0xc5, 0x4f, 0x09, 0x5d, 0x3a, 0x51, 0x7b, 0xe2, 0xeb, 0x87, 0x8a, 0x50, 0x9d, 0xf8, 0x72,
-	0x80, 0xce, 0xeb, 0x86, 0x16, 0xd0, 0x94, 0x18, 0x68, 0x90, 0x2e, 0x8f, 0x02, 0xb8, 0x89, 0x26,
-	0xdd, 0x0e, 0xf4, 0x42, 0x2a, 0x8c, 0x8e, 0xd9, 0x96, 0xab, 0xb1, 0xb7, 0xbf, 0xda, 0x09, 0x21,
-	0x9d, 0xd2, 0x86, 0x02, 0xc2, 0x47, 0x56, 0x65, 0x07, 0xaa, 0xbf, 0x32, 0x28, 0x5b, 0x27, 0x3e,
-	0xde, 0x44, 0xd3, 0x27, 0xde, 0x0d, 0x8b, 0x69, 0xfd, 0x1f, 0xd9, 0x18, 0x6d, 0xf9, 0x4c, 0x69,
-	0xc9, 0x62, 0xdd, 0x41, 0x13, 0x6c, 0x19, 0xe6, 0x4f, 0xa1, 0xc5, 0xa0, 0x76, 0x79, 0x0c, 0x98,
-	0x28, 0x3d, 0x43, 0x17, 0xfe, 0x98, 0xc7, 0x71, 0x24, 0x99, 0xa4, 0x5d, 0x39, 0x43, 0x52, 0xf2,
-	0x84, 0x87, 0x28, 0x27, 0x27, 0x43, 0x3f, 0x85, 0x27, 0x70, 0x6d, 0x69, 0x3c, 0x2e, 0x25, 0x6b,
-	0xb5, 0xdd, 0x7d, 0x5d, 0xdd, 0xdb, 0xd7, 0xd5, 0x6f, 0xfb, 0xba, 0xfa, 0xe6, 0x40, 0x57, 0xf6,
-	0x0e, 0x74, 0xe5, 0xf3, 0x81, 0xae, 0x3c, 0x1d, 0x7f, 0xc5, 0xdb, 0xec, 0x13, 0xc1, 0x2e, 0xba,
-	0x31, 0xc9, 0xde, 0xcd, 0xd7, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0x96, 0x26, 0x77, 0x3f, 0x8e,
-	0x06, 0x00, 0x00,
+	// 699 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xb6, 0x93, 0xb4, 0xa1, 0x97, 0xd2, 0xd2, 0x53, 0x54, 0x9c, 0xb4, 0xb2, 0x23, 0xa3, 0x56,
+	0x91, 0x50, 0x6d, 0x1a, 0x24, 0x90, 0x8a, 0x18, 0x70, 0x51, 0x55, 0x90, 0x22, 0xc0, 0x48, 0x20,
+	0xb1, 0x04, 0x27, 0x71, 0x1d, 0x8b, 0xc4, 0x67, 0xe5, 0x2e, 0x51, 0xb3, 0x31, 0x32, 0x21, 0x46,
+	0xc6, 0xce, 0x6c, 0x48, 0x4c, 0xfc, 0x05, 0x15, 0x53, 0x47, 0x06, 0x14, 0x50, 0xbb, 0x40, 0xc5,
+	0xd4, 0xbf, 0x00, 0xf9, 0x7e, 0xb8, 0xbf, 0xdc, 0x50, 0x50, 0xa7, 0xf8, 0xbd, 0xef, 0x7d, 0xef,
+	0xde, 0xf7, 0xee, 0xbd, 0x0b, 0x98, 0x6b, 0x20, 0xdc, 0x41, 0xd8, 0xf4, 0x50, 0xdf, 0xec, 0x2f,
+	0xd7, 0x5d, 0xe2, 0x2c, 0x9b, 0x64, 0xd3, 0x08, 0xbb, 0x88, 0x20, 0x08, 0x19, 0x68, 0x78, 0xa8,
+	0x6f, 0x70, 0xb0, 0xa8, 0x72, 0x42, 0xdd, 0xc1, 0x6e, 0xcc, 0x68, 0x20, 0x3f, 0x60, 0x9c, 0xe2,
+	0x7c, 0x42, 0xc2, 0x88, 0xcf, 0xd0, 0x02, 0x43, 0x6b, 0xd4, 0x32, 0x79, 0x7a, 0x06, 0xe5, 0x3d,
+	0xe4, 0x21, 0xe6, 0x8f, 0xbe, 0x04, 0xc1, 0x43, 0xc8, 0x6b, 0xbb, 0x26, 0xb5, 0xea, 0xbd, 0x0d,
+	0xd3, 0x09, 0x06, 0x0c, 0xd2, 0xf7, 0x53, 0x60, 0xa6, 0x8a, 0xbd, 0xa7, 0xbd, 0x7a, 0xc7, 0x27,
+	0x8f, 0xbb, 0x28, 0x44, 0xd8, 0x69, 0xc3, 0x3b, 0x20, 0xdb, 0x40, 0x01, 0x71, 0x03, 0xa2, 0xc8,
+	0x25, 0xb9, 0x9c, 0xab, 0xe4, 0x0d, 0x96, 0xc2, 0x10, 0x29, 0x8c, 0x7b, 0xc1, 0xc0, 0xca, 0x7d,
+	0xf9, 0xb4, 0x94, 0x5d, 0x65, 0x81, 0xb6, 0x60, 0xc0, 0xb7, 0x32, 0x98, 0xf6, 0x03, 0x9f, 0xf8,
+	0x4e, 0xbb, 0xd6, 0x74, 0x43, 0x84, 0x7d, 0xa2, 0xa4, 0x4a, 0xe9, 0x72, 0xae, 0x52, 0x30, 0x78,
+	0xb1, 0x91, 0x6e, 0xd1, 0x0c, 0x63, 0x15, 0xf9, 0x81, 0xf5, 0x70, 0x7b, 0xa8, 0x49, 0x07, 0x43,
+	0x6d, 0x76, 0xe0, 0x74, 0xda, 0x2b, 0xfa, 0x09, 0xbe, 0xfe, 0xe1, 0xbb, 0x56, 0xf6, 0x7c, 0xd2,
+	0xea, 0xd5, 0x8d, 0x06, 0xea, 0x70, 0xcd, 0xfc, 0x67, 0x09, 0x37, 0x5f, 0x99, 0x64, 0x10, 0xba,
+	0x98, 0xa6, 0xc2, 0xf6, 0x14, 0x67, 0xdf, 0x67, 0x64, 0x58, 0x04, 0x97, 0x42, 0xaa, 0xcc, 0xed,
+	0x2a, 0xe9, 0x92, 0x5c, 0x9e, 0xb0, 0x63, 0x3b, 0xc2, 0x3a, 0x2e, 0x71, 0x9a, 0x0e, 0x71, 0x94,
+	0x0c, 0xc3, 0x84, 0x0d, 0xef, 0x82, 0xcb, 0xe2, 0xbb, 0xd6, 0x72, 0x70, 0x4b, 0x19, 0x2b, 0xc9,
+	0xe5, 0x49, 0x4b, 0x39, 0x18, 0x6a, 0x79, 0x56, 0xe6, 0x31, 0x58, 0xb7, 0x27, 0x85, 0xbd, 0xee,
+	0xe0, 0xd6, 0xca, 0x95, 0x37, 0x5b, 0x9a, 0xf4, 0x7e, 0x4b, 0x93, 0x7e, 0x6e, 0x69, 0xd2, 0xeb,
+	0x6f, 0x25, 0x49, 0x6f, 0x80, 0xc2, 0xa9, 0x5e, 0xdb, 0x2e, 0x0e, 0x51, 0x80, 0x5d, 0xb8, 0x06,
+	0x72, 0x21, 0xf7, 0xd5, 0xfc, 0x26, 0xed, 0x7b, 0xc6, 0x5a, 0xd8, 0x1f, 0x6a, 0x47, 0xdd, 0x07,
+	0x43, 0x0d, 0xb2, 0xa3, 0x8f, 0x38, 0x75, 0x1b, 0x08, 0xeb, 0x41, 0x53, 0xff, 0x28, 0x83, 0x6c,
+	0x15, 0x7b, 0xcf, 0x10, 0xb9, 0xb0, 0x9c, 0x30, 0x0f, 0xc6, 0xfa, 0x88, 0xb8, 0x5d, 0x25, 0x45,
+	0x5b, 0xc4, 0x0c, 0x78, 0x0b, 0x8c, 0xa3, 0x90, 0xf8, 0x28, 0xa0, 0x5d, 0x9d, 0xaa, 0xa8, 0xc6,
+	0xe9, 0x51, 0x37, 0xa2, 0x3a, 0x1e, 0xd1, 0x28, 0x9b, 0x47, 0x27, 0x34, 0x66, 0x06, 0x4c, 0xf3,
+	0x92, 0x45, 0x3b, 0xf4, 0xcf, 0x72, 0xec, 0x7b, 0xee, 0xfa, 0x5e, 0x8b, 0xb8, 0x4d, 0x78, 0x3b,
+	0x49, 0xce, 0xec, 0x7f, 0xd7, 0xbf, 0x06, 0xb2, 0xac, 0x22, 0xac, 0xa4, 0xe9, 0x7c, 0x2e, 0x26,
+	0x09, 0x10, 0xa7, 0x1f, 0x0a, 0xb1, 0x32, 0xd1, 0xb0, 0xda, 0x82, 0x9c, 0xa0, 0xa7, 0x00, 0xae,
+	0x9e, 0xa8, 0x3d, 0xd6, 0xf5, 0x4b, 0x06, 0xa0, 0x8a, 0x3d, 0x31, 0x9b, 0x17, 0x75, 0x43, 0xf3,
+	0x60, 0x82, 0xef, 0x0a, 0x12, 0x2a, 0x0f, 0x1d, 0xb0, 0x01, 0xc6, 0x9d, 0x0e, 0xea, 0x05, 0x44,
+	0x49, 0xff, 0x6d, 0x11, 0x6f, 0x44, 0xda, 0xfe, 0x69, 0xdd, 0x78, 0xea, 0x84, 0x36, 0xe4, 0x01,
+	0x3c, 0x94, 0x2a, 0x3a, 0x50, 0xf9, 0x9d, 0x02, 0xe9, 0x2a, 0xf6, 0xe0, 0x06, 0x98, 0x3a, 0xf1,
+	0xec, 0x2c, 0x24, 0xf5, 0xff, 0xd4, 0xc6, 0x14, 0x97, 0xce, 0x15, 0x16, 0x2f, 0xd6, 0x3a, 0xc8,
+	0xd0, 0x65, 0x98, 0x3b, 0x83, 0x16, 0x81, 0xc5, 0x6b, 0x23, 0xc0, 0x38, 0xd3, 0x4b, 0x30, 0x79,
+	0x6c, 0x1e, 0x47, 0x91, 0x44, 0x50, 0xf1, 0xfa, 0x39, 0x82, 0xe2, 0x13, 0x9e, 0x80, 0xac, 0x98,
+	0x0c, 0xf5, 0x0c, 0x1e, 0xc7, 0x8b, 0x8b, 0xa3, 0x71, 0x91, 0xd2, 0xb2, 0xb6, 0x77, 0x55, 0x79,
+	0x67, 0x57, 0x95, 0x7f, 0xec, 0xaa, 0xf2, 0xbb, 0x3d, 0x55, 0xda, 0xd9, 0x53, 0xa5, 0xaf, 0x7b,
+	0xaa, 0xf4, 0x62, 0xf4, 0x15, 0x6f, 0xd2, 0x7f, 0x1f, 0x7a, 0xd1, 0xf5, 0x71, 0xfa, 0xec, 0xdf,
+	0xfc, 0x33, 0x00, 0xf4, 0x5a, 0x07, 0x52, 0xe9, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -628,6 +636,20 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MetadataHash) > 0 {
+		i -= len(m.MetadataHash)
+		copy(dAtA[i:], m.MetadataHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MetadataHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Proposer) > 0 {
 		i -= len(m.Proposer)
 		copy(dAtA[i:], m.Proposer)
@@ -930,6 +952,14 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MetadataHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1172,6 +1202,72 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Proposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataHash = append(m.MetadataHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.MetadataHash == nil {
+				m.MetadataHash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
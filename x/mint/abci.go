@@ -22,11 +22,12 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	bondedRatio := k.BondedRatio(ctx)
 	minter.Inflation = minter.NextInflationRate(params, bondedRatio)
 	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
-	k.SetMinter(ctx, minter)
 
-	// mint coins, update supply
+	// mint coins, update supply, and carry the truncated provisions over
 	mintedCoin := minter.BlockProvision(params)
 	mintedCoins := sdk.NewCoins(mintedCoin)
+	minter.ProvisionDust = minter.NextProvisionDust(params)
+	k.SetMinter(ctx, minter)
 
 	err := k.MintCoins(ctx, mintedCoins)
 	if err != nil {
@@ -0,0 +1,62 @@
+// Code generated by typedgen. DO NOT EDIT.
+
+// Package grpcclient implements the typed clients of the mint module.
+package grpcclient
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/typed"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// QueryClient is the typed client of the mint Query service, retrying
+// the queries failing with a transient error.
+type QueryClient struct {
+	client types.QueryClient
+	retry  typed.RetryConfig
+}
+
+// NewQueryClient returns a QueryClient querying through the connection.
+func NewQueryClient(conn gogogrpc.ClientConn, retry typed.RetryConfig) *QueryClient {
+	return &QueryClient{
+		client: types.NewQueryClient(conn),
+		retry:  retry,
+	}
+}
+
+// AnnualProvisions calls the mint Query/AnnualProvisions method.
+func (c *QueryClient) AnnualProvisions(ctx context.Context, req *types.QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*types.QueryAnnualProvisionsResponse, error) {
+	var res *types.QueryAnnualProvisionsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.AnnualProvisions(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// Inflation calls the mint Query/Inflation method.
+func (c *QueryClient) Inflation(ctx context.Context, req *types.QueryInflationRequest, opts ...grpc.CallOption) (*types.QueryInflationResponse, error) {
+	var res *types.QueryInflationResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Inflation(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// Params calls the mint Query/Params method.
+func (c *QueryClient) Params(ctx context.Context, req *types.QueryParamsRequest, opts ...grpc.CallOption) (*types.QueryParamsResponse, error) {
+	var res *types.QueryParamsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Params(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
@@ -30,6 +30,9 @@ type Minter struct {
 	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
 	// current annual expected provisions
 	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provisions" yaml:"annual_provisions"`
+	// fraction of the block provisions truncated from the minted coins, carried
+	// over to the next block
+	ProvisionDust github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=provision_dust,json=provisionDust,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"provision_dust" yaml:"provision_dust"`
 }
 
 func (m *Minter) Reset()         { *m = Minter{} }
@@ -135,35 +138,36 @@ func init() {
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 437 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
-	0x1c, 0xc6, 0x63, 0x28, 0x95, 0x6a, 0x98, 0x00, 0x6f, 0xa0, 0x68, 0x82, 0x64, 0xca, 0x01, 0x8d,
-	0x03, 0x89, 0x26, 0x6e, 0x3b, 0x66, 0x15, 0x07, 0xc4, 0x50, 0xe5, 0x1b, 0x5c, 0xa2, 0x7f, 0x52,
-	0x93, 0x59, 0x8d, 0xed, 0xca, 0xf6, 0x46, 0x7b, 0xe5, 0x09, 0x38, 0x72, 0xe4, 0x2d, 0x78, 0x85,
-	0xdd, 0xd8, 0x11, 0x71, 0xa8, 0x50, 0xfb, 0x06, 0x7b, 0x02, 0x14, 0xbb, 0x6a, 0xa1, 0x20, 0xa4,
-	0x4a, 0x9c, 0x92, 0xef, 0xfb, 0xff, 0xf3, 0xfd, 0xbe, 0x58, 0x32, 0x8e, 0x2a, 0x65, 0x84, 0x32,
-	0x99, 0xe0, 0xd2, 0x66, 0x17, 0x47, 0x25, 0xb3, 0x70, 0xe4, 0x44, 0x3a, 0xd6, 0xca, 0x2a, 0xb2,
-	0xeb, 0xe7, 0xa9, 0xb3, 0x96, 0xf3, 0xfd, 0xbd, 0x5a, 0xd5, 0xca, 0xcd, 0xb3, 0xf6, 0xcd, 0xaf,
-	0x26, 0x5f, 0x11, 0xee, 0x9e, 0x72, 0x69, 0x99, 0x26, 0xaf, 0x70, 0x8f, 0xcb, 0x77, 0x0d, 0x58,
-	0xae, 0x64, 0x88, 0x0e, 0xd0, 0x61, 0x2f, 0x4f, 0x2f, 0x67, 0x71, 0xf0, 0x7d, 0x16, 0x3f, 0xa9,
-	0xb9, 0x3d, 0x3b, 0x2f, 0xd3, 0x4a, 0x89, 0x6c, 0xc9, 0xf6, 0x8f, 0x67, 0x66, 0x38, 0xca, 0xec,
-	0x74, 0xcc, 0x4c, 0xda, 0x67, 0x15, 0x5d, 0x07, 0x90, 0xf7, 0xf8, 0x3e, 0x48, 0x79, 0x0e, 0x4d,
-	0x31, 0xd6, 0xea, 0x82, 0x1b, 0xae, 0xa4, 0x09, 0x6f, 0xb8, 0xd4, 0x97, 0xdb, 0xa5, 0x5e, 0xcf,
-	0xe2, 0x70, 0x0a, 0xa2, 0x39, 0x4e, 0xfe, 0x08, 0x4c, 0xe8, 0x3d, 0xef, 0x0d, 0xd6, 0xd6, 0x97,
-	0x0e, 0xee, 0x0e, 0x40, 0x83, 0x30, 0xe4, 0x31, 0xc6, 0xed, 0x11, 0x14, 0x43, 0x26, 0x95, 0xf0,
-	0xbf, 0x44, 0x7b, 0xad, 0xd3, 0x6f, 0x0d, 0xf2, 0x01, 0xe1, 0x07, 0xab, 0xc2, 0x85, 0x06, 0xcb,
-	0x8a, 0xea, 0x0c, 0x64, 0xcd, 0x96, 0x3d, 0x5f, 0x6f, 0xdd, 0xf3, 0x91, 0xef, 0xf9, 0xd7, 0xd0,
-	0x84, 0xee, 0xae, 0x7c, 0x0a, 0x96, 0x9d, 0x38, 0x97, 0x8c, 0xf0, 0xce, 0x7a, 0x5d, 0xc0, 0x24,
-	0xbc, 0xe9, 0xd8, 0x2f, 0xb6, 0x66, 0xef, 0x6d, 0xb2, 0x05, 0x4c, 0x12, 0x7a, 0x67, 0xa5, 0x4f,
-	0x61, 0xb2, 0x01, 0xe3, 0x32, 0xec, 0xfc, 0x37, 0x18, 0x97, 0xbf, 0xc1, 0xb8, 0x24, 0x0c, 0xdf,
-	0xae, 0x15, 0x34, 0x45, 0xa9, 0xe4, 0x90, 0x0d, 0xc3, 0x5b, 0x0e, 0xd5, 0xdf, 0x1a, 0x45, 0x3c,
-	0xea, 0x97, 0xa8, 0x84, 0xe2, 0x56, 0xe5, 0x4e, 0x90, 0x1c, 0xdf, 0x2d, 0x1b, 0x55, 0x8d, 0x4c,
-	0x31, 0x66, 0xba, 0x98, 0x32, 0xd0, 0x61, 0xf7, 0x00, 0x1d, 0x76, 0xf2, 0xfd, 0xeb, 0x59, 0xfc,
-	0xd0, 0x7f, 0xbc, 0xb1, 0x90, 0xd0, 0x1d, 0xef, 0x0c, 0x98, 0x7e, 0xc3, 0x40, 0x1f, 0x77, 0x3e,
-	0x7d, 0x8e, 0x83, 0xfc, 0xe4, 0x72, 0x1e, 0xa1, 0xab, 0x79, 0x84, 0x7e, 0xcc, 0x23, 0xf4, 0x71,
-	0x11, 0x05, 0x57, 0x8b, 0x28, 0xf8, 0xb6, 0x88, 0x82, 0xb7, 0x4f, 0xff, 0xd9, 0x76, 0xe2, 0x2f,
-	0xa2, 0x2b, 0x5d, 0x76, 0xdd, 0xbd, 0x7a, 0xfe, 0x33, 0x00, 0x00, 0xff, 0xff, 0x34, 0x52, 0xcf,
-	0x87, 0xa4, 0x03, 0x00, 0x00,
+	// 461 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0xed, 0x12, 0x22, 0xe5, 0x20, 0xfc, 0xb9, 0xb6, 0xc8, 0xaa, 0xc0, 0xae, 0x3c, 0xa0,
+	0x32, 0x60, 0xab, 0x62, 0xeb, 0xe8, 0x46, 0x20, 0x21, 0x8a, 0xa2, 0xdb, 0x60, 0xb1, 0xce, 0xf6,
+	0xe1, 0x9e, 0xe2, 0xbb, 0x8b, 0xee, 0x2e, 0x25, 0x59, 0xf9, 0x04, 0x8c, 0x8c, 0x7c, 0x0b, 0x46,
+	0xd6, 0x8e, 0x1d, 0x11, 0x43, 0x84, 0x92, 0x6f, 0xd0, 0x4f, 0x80, 0x7c, 0x67, 0xb9, 0x34, 0x20,
+	0x24, 0x4b, 0x9d, 0x92, 0xe7, 0x79, 0x5f, 0x3f, 0xbf, 0x57, 0x8f, 0x74, 0xc0, 0xcf, 0x85, 0x62,
+	0x42, 0xc5, 0x8c, 0x72, 0x1d, 0x9f, 0x1d, 0x66, 0x44, 0xe3, 0x43, 0x23, 0xa2, 0xa9, 0x14, 0x5a,
+	0xc0, 0x6d, 0x3b, 0x8f, 0x8c, 0xd5, 0xcc, 0xf7, 0x76, 0x4a, 0x51, 0x0a, 0x33, 0x8f, 0xeb, 0x7f,
+	0x76, 0x35, 0xfc, 0xbe, 0x05, 0xfa, 0x27, 0x94, 0x6b, 0x22, 0xe1, 0x1b, 0x30, 0xa0, 0xfc, 0x43,
+	0x85, 0x35, 0x15, 0xdc, 0x73, 0xf7, 0xdd, 0x83, 0x41, 0x12, 0x9d, 0x2f, 0x03, 0xe7, 0xe7, 0x32,
+	0x78, 0x5a, 0x52, 0x7d, 0x3a, 0xcb, 0xa2, 0x5c, 0xb0, 0xb8, 0x61, 0xdb, 0x9f, 0xe7, 0xaa, 0x98,
+	0xc4, 0x7a, 0x31, 0x25, 0x2a, 0x1a, 0x91, 0x1c, 0x5d, 0x05, 0xc0, 0x8f, 0xe0, 0x21, 0xe6, 0x7c,
+	0x86, 0xab, 0x74, 0x2a, 0xc5, 0x19, 0x55, 0x54, 0x70, 0xe5, 0x6d, 0x99, 0xd4, 0xd7, 0xdd, 0x52,
+	0x2f, 0x97, 0x81, 0xb7, 0xc0, 0xac, 0x3a, 0x0a, 0xff, 0x0a, 0x0c, 0xd1, 0x03, 0xeb, 0x8d, 0x5b,
+	0x0b, 0x72, 0x70, 0xaf, 0x5d, 0x48, 0x8b, 0x99, 0xd2, 0xde, 0x2d, 0x43, 0x7d, 0xd5, 0x99, 0xba,
+	0x6b, 0xa9, 0xd7, 0xd3, 0x42, 0x34, 0x6c, 0x8d, 0x51, 0xad, 0xbf, 0xf5, 0x40, 0x7f, 0x8c, 0x25,
+	0x66, 0x0a, 0x3e, 0x01, 0xa0, 0xae, 0x3c, 0x2d, 0x08, 0x17, 0xcc, 0x56, 0x88, 0x06, 0xb5, 0x33,
+	0xaa, 0x0d, 0xf8, 0xc9, 0x05, 0xbb, 0x6d, 0x41, 0xa9, 0xc4, 0x9a, 0xa4, 0xf9, 0x29, 0xe6, 0x25,
+	0x69, 0x7a, 0x79, 0xdb, 0xf9, 0xc2, 0xc7, 0xf6, 0xc2, 0x7f, 0x86, 0x86, 0x68, 0xbb, 0xf5, 0x11,
+	0xd6, 0xe4, 0xd8, 0xb8, 0x70, 0x02, 0x86, 0x57, 0xeb, 0x0c, 0xcf, 0x9b, 0x76, 0x5e, 0x76, 0x66,
+	0xef, 0x6c, 0xb2, 0x19, 0x9e, 0x87, 0xe8, 0x6e, 0xab, 0x4f, 0xf0, 0x7c, 0x03, 0x46, 0xb9, 0xd7,
+	0xbb, 0x31, 0x18, 0xe5, 0xd7, 0x60, 0x94, 0x43, 0x02, 0xee, 0x94, 0x02, 0x57, 0x69, 0x26, 0x78,
+	0x41, 0x0a, 0xef, 0xb6, 0x41, 0x8d, 0x3a, 0xa3, 0xa0, 0x45, 0xfd, 0x11, 0x15, 0x22, 0x50, 0xab,
+	0xc4, 0x08, 0x98, 0x80, 0xfb, 0x59, 0x25, 0xf2, 0x89, 0x4a, 0xa7, 0x44, 0xa6, 0x0b, 0x82, 0xa5,
+	0xd7, 0xdf, 0x77, 0x0f, 0x7a, 0xc9, 0xde, 0xe5, 0x32, 0x78, 0x64, 0x3f, 0xde, 0x58, 0x08, 0xd1,
+	0xd0, 0x3a, 0x63, 0x22, 0xdf, 0x11, 0x2c, 0x8f, 0x7a, 0x5f, 0xbe, 0x06, 0x4e, 0x72, 0x7c, 0xbe,
+	0xf2, 0xdd, 0x8b, 0x95, 0xef, 0xfe, 0x5a, 0xf9, 0xee, 0xe7, 0xb5, 0xef, 0x5c, 0xac, 0x7d, 0xe7,
+	0xc7, 0xda, 0x77, 0xde, 0x3f, 0xfb, 0xef, 0xb5, 0x73, 0xfb, 0xf0, 0xcd, 0xd1, 0x59, 0xdf, 0xbc,
+	0xe3, 0x17, 0xbf, 0x07, 0x00, 0x3f, 0x58, 0xfe, 0xc1, 0x14, 0x04, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -186,6 +190,16 @@ func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ProvisionDust.Size()
+		i -= size
+		if _, err := m.ProvisionDust.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size := m.AnnualProvisions.Size()
 		i -= size
@@ -305,6 +319,8 @@ func (m *Minter) Size() (n int) {
 	n += 1 + l + sovMint(uint64(l))
 	l = m.AnnualProvisions.Size()
 	n += 1 + l + sovMint(uint64(l))
+	l = m.ProvisionDust.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -435,6 +451,40 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisionDust", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProvisionDust.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
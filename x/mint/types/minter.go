@@ -12,6 +12,7 @@ func NewMinter(inflation, annualProvisions sdk.Dec) Minter {
 	return Minter{
 		Inflation:        inflation,
 		AnnualProvisions: annualProvisions,
+		ProvisionDust:    sdk.ZeroDec(),
 	}
 }
 
@@ -37,6 +38,10 @@ func ValidateMinter(minter Minter) error {
 		return fmt.Errorf("mint parameter Inflation should be positive, is %s",
 			minter.Inflation.String())
 	}
+	if !minter.ProvisionDust.IsNil() && (minter.ProvisionDust.IsNegative() || minter.ProvisionDust.GTE(sdk.OneDec())) {
+		return fmt.Errorf("mint provision dust should be positive and less than one, is %s",
+			minter.ProvisionDust.String())
+	}
 	return nil
 }
 
@@ -73,8 +78,23 @@ func (m Minter) NextAnnualProvisions(_ Params, totalSupply sdk.Int) sdk.Dec {
 }
 
 // BlockProvision returns the provisions for a block based on the annual
-// provisions rate.
+// provisions rate, including the provision dust carried over.
 func (m Minter) BlockProvision(params Params) sdk.Coin {
+	return sdk.NewCoin(params.MintDenom, m.blockProvisionAmount(params).TruncateInt())
+}
+
+// NextProvisionDust returns the fraction of the block provisions truncated
+// from the block provision coin, to be carried over to the next block.
+func (m Minter) NextProvisionDust(params Params) sdk.Dec {
+	provisionAmt := m.blockProvisionAmount(params)
+	return provisionAmt.Sub(provisionAmt.TruncateDec())
+}
+
+func (m Minter) blockProvisionAmount(params Params) sdk.Dec {
 	provisionAmt := m.AnnualProvisions.QuoInt(sdk.NewInt(int64(params.BlocksPerYear)))
-	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
+	// the provision dust is not set for minters stored before it was tracked
+	if !m.ProvisionDust.IsNil() {
+		provisionAmt = provisionAmt.Add(m.ProvisionDust)
+	}
+	return provisionAmt
 }
@@ -82,6 +82,32 @@ func TestBlockProvision(t *testing.T) {
 	}
 }
 
+func TestBlockProvisionDust(t *testing.T) {
+	minter := InitialMinter(sdk.NewDecWithPrec(1, 1))
+	params := DefaultParams()
+
+	// half a token per block
+	secondsPerYear := int64(60 * 60 * 8766)
+	minter.AnnualProvisions = sdk.NewDec(secondsPerYear / 10)
+
+	for _, exp := range []struct {
+		provisions int64
+		dust       sdk.Dec
+	}{
+		{0, sdk.NewDecWithPrec(5, 1)},
+		{1, sdk.ZeroDec()},
+		{0, sdk.NewDecWithPrec(5, 1)},
+	} {
+		require.True(t, sdk.NewInt64Coin(params.MintDenom, exp.provisions).IsEqual(minter.BlockProvision(params)))
+		minter.ProvisionDust = minter.NextProvisionDust(params)
+		require.True(t, exp.dust.Equal(minter.ProvisionDust), minter.ProvisionDust.String())
+	}
+
+	require.NoError(t, ValidateMinter(minter))
+	minter.ProvisionDust = sdk.OneDec()
+	require.Error(t, ValidateMinter(minter))
+}
+
 // Benchmarking :)
 // previously using sdk.Int operations:
 // BenchmarkBlockProvision-4 5000000 220 ns/op
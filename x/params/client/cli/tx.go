@@ -1,12 +1,14 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -15,6 +17,8 @@ import (
 	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 )
 
+const flagDeposit = "deposit"
+
 // NewSubmitParamChangeProposalTxCmd returns a CLI command handler for creating
 // a parameter change proposal governance transaction.
 func NewSubmitParamChangeProposalTxCmd() *cobra.Command {
@@ -27,13 +31,10 @@ func NewSubmitParamChangeProposalTxCmd() *cobra.Command {
 The proposal details must be supplied via a JSON file. For values that contains
 objects, only non-empty fields will be updated.
 
-IMPORTANT: Currently parameter changes are evaluated but not validated, so it is
-very important that any "value" change is valid (ie. correct type and within bounds)
-for its respective parameter, eg. "MaxValidators" should be an integer and not a decimal.
-
-Proper vetting of a parameter change proposal should prevent this from happening
-(no deposits should occur during the governance process), but it should be noted
-regardless.
+The proposal is rejected at submission if a subspace or key is not registered,
+or if a value is not valid for its respective parameter, eg. "MaxValidators"
+should be a positive integer and not a decimal. A proposal file with the current
+values of the parameters can be generated with "draft-proposal param-change".
 
 Example:
 $ %s tx gov submit-proposal param-change <path/to/proposal.json> --from=<key_or_address>
@@ -85,3 +86,133 @@ Where proposal.json contains:
 		},
 	}
 }
+
+// NewDraftParamChangeProposalCmd returns a CLI command handler for generating a
+// parameter change proposal file, filled with the parameters registered on the
+// node and their current values.
+func NewDraftParamChangeProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "param-change [subspace] [key]...",
+		Args:  cobra.ArbitraryArgs,
+		Short: "Generate a draft parameter change proposal file",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Generate a parameter change proposal file for the given parameter keys of a
+subspace, or all of its keys if none are given, filled with their current values.
+The types of the parameters are printed to stderr. Without arguments, the
+registered subspaces along with their keys and types are listed.
+
+Example:
+$ %s tx gov draft-proposal param-change staking MaxValidators --deposit=1000stake > proposal.json
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := paramproposal.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Subspaces(cmd.Context(), &paramproposal.QuerySubspacesRequest{})
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 0 {
+				return clientCtx.PrintProto(res)
+			}
+
+			subspace, err := findSubspace(res.Subspaces, args[0])
+			if err != nil {
+				return err
+			}
+
+			keys := subspace.Keys
+			if len(args) > 1 {
+				if keys, err = findParamKeys(subspace, args[1:]); err != nil {
+					return err
+				}
+			}
+
+			deposit, err := cmd.Flags().GetString(flagDeposit)
+			if err != nil {
+				return err
+			}
+
+			draft := paramscutils.ParamChangeProposalJSON{
+				Title:       fmt.Sprintf("%s parameter change", subspace.Subspace),
+				Description: "",
+				Changes:     make(paramscutils.ParamChangesJSON, len(keys)),
+				Deposit:     deposit,
+			}
+
+			for i, key := range keys {
+				paramRes, err := queryClient.Params(cmd.Context(), &paramproposal.QueryParamsRequest{
+					Subspace: subspace.Subspace,
+					Key:      key.Key,
+				})
+				if err != nil {
+					return err
+				}
+
+				value := json.RawMessage(paramRes.Param.Value)
+				if len(value) == 0 {
+					value = json.RawMessage("null")
+				}
+
+				draft.Changes[i] = paramscutils.NewParamChangeJSON(subspace.Subspace, key.Key, value)
+				cmd.PrintErrf("%s: %s\n", key.Key, key.Type)
+			}
+
+			bz, err := json.MarshalIndent(draft, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintBytes(bz)
+		},
+	}
+
+	cmd.Flags().String(flagDeposit, "", "The deposit of the proposal")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func findSubspace(subspaces []*paramproposal.Subspace, name string) (*paramproposal.Subspace, error) {
+	names := make([]string, len(subspaces))
+	for i, ss := range subspaces {
+		if ss.Subspace == name {
+			return ss, nil
+		}
+		names[i] = ss.Subspace
+	}
+
+	return nil, fmt.Errorf("unknown subspace %s, registered subspaces: %s", name, strings.Join(names, ", "))
+}
+
+func findParamKeys(subspace *paramproposal.Subspace, names []string) ([]paramproposal.ParamKey, error) {
+	registered := make(map[string]paramproposal.ParamKey, len(subspace.Keys))
+	for _, key := range subspace.Keys {
+		registered[key.Key] = key
+	}
+
+	keys := make([]paramproposal.ParamKey, len(names))
+	for i, name := range names {
+		key, ok := registered[name]
+		if !ok {
+			valid := make([]string, len(subspace.Keys))
+			for j, k := range subspace.Keys {
+				valid[j] = k.Key
+			}
+
+			return nil, fmt.Errorf(
+				"unknown key %s in subspace %s, registered keys: %s", name, subspace.Subspace, strings.Join(valid, ", "),
+			)
+		}
+		keys[i] = key
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,51 @@
+// Code generated by typedgen. DO NOT EDIT.
+
+// Package grpcclient implements the typed clients of the params module.
+package grpcclient
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/typed"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+// QueryClient is the typed client of the params Query service, retrying
+// the queries failing with a transient error.
+type QueryClient struct {
+	client proposal.QueryClient
+	retry  typed.RetryConfig
+}
+
+// NewQueryClient returns a QueryClient querying through the connection.
+func NewQueryClient(conn gogogrpc.ClientConn, retry typed.RetryConfig) *QueryClient {
+	return &QueryClient{
+		client: proposal.NewQueryClient(conn),
+		retry:  retry,
+	}
+}
+
+// Params calls the params Query/Params method.
+func (c *QueryClient) Params(ctx context.Context, req *proposal.QueryParamsRequest, opts ...grpc.CallOption) (*proposal.QueryParamsResponse, error) {
+	var res *proposal.QueryParamsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Params(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// Subspaces calls the params Query/Subspaces method.
+func (c *QueryClient) Subspaces(ctx context.Context, req *proposal.QuerySubspacesRequest, opts ...grpc.CallOption) (*proposal.QuerySubspacesResponse, error) {
+	var res *proposal.QuerySubspacesResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Subspaces(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
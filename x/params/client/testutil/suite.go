@@ -78,3 +78,65 @@ value: "100"`,
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestNewDraftParamChangeProposalCmd() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name        string
+		args        []string
+		expectErr   bool
+		expectedOut []string
+	}{
+		{
+			"list subspaces",
+			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false,
+			[]string{`"subspace":"staking"`, `{"key":"MaxValidators","type":"uint32"}`},
+		},
+		{
+			"unknown subspace",
+			[]string{"unknown"},
+			true,
+			nil,
+		},
+		{
+			"unknown key",
+			[]string{"staking", "Unknown"},
+			true,
+			nil,
+		},
+		{
+			"draft with current values",
+			[]string{"staking", "MaxValidators", "--deposit=10stake"},
+			false,
+			[]string{
+				"MaxValidators: uint32",
+				`"subspace": "staking"`,
+				`"key": "MaxValidators"`,
+				`"value": 100`,
+				`"deposit": "10stake"`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewDraftParamChangeProposalCmd()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			for _, expected := range tc.expectedOut {
+				s.Require().Contains(out.String(), expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// BlockerOrderParamsKeyTable returns an x/params module keyTable to be used in
+// the module manager's ParamStore. The KeyTable registers the begin and end
+// blocker orders, validated against the modules of the manager.
+func BlockerOrderParamsKeyTable(mm *module.Manager) types.KeyTable {
+	return types.NewKeyTable(
+		types.NewParamSetPair(
+			module.ParamStoreKeyOrderBeginBlockers, []string{}, mm.ValidateOrderBeginBlockers,
+		),
+		types.NewParamSetPair(
+			module.ParamStoreKeyOrderEndBlockers, []string{}, mm.ValidateOrderEndBlockers,
+		),
+	)
+}
@@ -34,3 +34,31 @@ func (k Keeper) Params(c context.Context, req *proposal.QueryParamsRequest) (*pr
 
 	return &proposal.QueryParamsResponse{Param: param}, nil
 }
+
+// Subspaces returns all the registered subspaces along with their parameter keys
+// and types.
+func (k Keeper) Subspaces(c context.Context, req *proposal.QuerySubspacesRequest) (*proposal.QuerySubspacesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	spaces := k.GetSubspaces()
+	resp := &proposal.QuerySubspacesResponse{
+		Subspaces: make([]*proposal.Subspace, len(spaces)),
+	}
+
+	for i, ss := range spaces {
+		keys := ss.RegisteredKeys()
+		resp.Subspaces[i] = &proposal.Subspace{
+			Subspace: ss.Name(),
+			Keys:     make([]proposal.ParamKey, len(keys)),
+		}
+
+		for j, key := range keys {
+			ty, _ := ss.KeyType([]byte(key))
+			resp.Subspaces[i].Keys[j] = proposal.ParamKey{Key: key, Type: ty.String()}
+		}
+	}
+
+	return resp, nil
+}
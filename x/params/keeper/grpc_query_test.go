@@ -84,3 +84,33 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQuerySubspaces() {
+	suite.SetupTest()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	suite.app.ParamsKeeper.Subspace("test").
+		WithKeyTable(types.NewKeyTable(
+			types.NewParamSetPair([]byte("key2"), paramJSON{}, validateNoOp),
+			types.NewParamSetPair([]byte("key1"), uint32(0), validateNoOp),
+		))
+
+	res, err := suite.queryClient.Subspaces(ctx, &proposal.QuerySubspacesRequest{})
+	suite.Require().NoError(err)
+
+	var found *proposal.Subspace
+	for i, ss := range res.Subspaces {
+		if i > 0 {
+			suite.Require().True(res.Subspaces[i-1].Subspace < ss.Subspace, "subspaces should be sorted")
+		}
+		if ss.Subspace == "test" {
+			found = ss
+		}
+	}
+
+	suite.Require().NotNil(found)
+	suite.Require().Equal([]proposal.ParamKey{
+		{Key: "key1", Type: "uint32"},
+		{Key: "key2", Type: "keeper_test.paramJSON"},
+	}, found.Keys)
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"sort"
+
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -59,3 +61,14 @@ func (k Keeper) GetSubspace(s string) (types.Subspace, bool) {
 	}
 	return *space, ok
 }
+
+// GetSubspaces returns all the registered subspaces, sorted by name.
+func (k Keeper) GetSubspaces() []types.Subspace {
+	spaces := make([]types.Subspace, 0, len(k.spaces))
+	for _, ss := range k.spaces {
+		spaces = append(spaces, *ss)
+	}
+	sort.Slice(spaces, func(i, j int) bool { return spaces[i].Name() < spaces[j].Name() })
+
+	return spaces
+}
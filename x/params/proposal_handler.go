@@ -30,6 +30,10 @@ func handleParameterChangeProposal(ctx sdk.Context, k keeper.Keeper, p *proposal
 			return sdkerrors.Wrap(proposal.ErrUnknownSubspace, c.Subspace)
 		}
 
+		if _, ok := ss.KeyType([]byte(c.Key)); !ok {
+			return sdkerrors.Wrapf(proposal.ErrUnknownKey, "subspace %s, key %s", c.Subspace, c.Key)
+		}
+
 		k.Logger(ctx).Info(
 			fmt.Sprintf("attempt to set new parameter value; key: %s, value: %s", c.Key, c.Value),
 		)
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"reflect"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -46,6 +47,28 @@ func (s Subspace) HasKeyTable() bool {
 	return len(s.table.m) > 0
 }
 
+// KeyType returns the type registered in the KeyTable for the given parameter
+// key, or false if the key has not been registered.
+func (s Subspace) KeyType(key []byte) (reflect.Type, bool) {
+	attr, ok := s.table.m[string(key)]
+	if !ok {
+		return nil, false
+	}
+
+	return attr.ty, true
+}
+
+// RegisteredKeys returns the sorted parameter keys registered in the KeyTable.
+func (s Subspace) RegisteredKeys() []string {
+	keys := make([]string, 0, len(s.table.m))
+	for k := range s.table.m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
 // WithKeyTable initializes KeyTable and returns modified Subspace
 func (s Subspace) WithKeyTable(table KeyTable) Subspace {
 	if table.m == nil {
@@ -133,6 +156,13 @@ func (s Subspace) GetRaw(ctx sdk.Context, key []byte) []byte {
 	return store.Get(key)
 }
 
+// DeleteRaw deletes the raw value bytes of a parameter by key, which need not
+// be registered, e.g. a legacy parameter replaced by a store migration.
+func (s Subspace) DeleteRaw(ctx sdk.Context, key []byte) {
+	store := s.kvStore(ctx)
+	store.Delete(key)
+}
+
 // Has returns if a parameter key exists or not in the Subspace's KVStore.
 func (s Subspace) Has(ctx sdk.Context, key []byte) bool {
 	store := s.kvStore(ctx)
@@ -92,6 +92,16 @@ func (suite *SubspaceTestSuite) TestGetRaw() {
 	})
 }
 
+func (suite *SubspaceTestSuite) TestDeleteRaw() {
+	t := time.Hour * 48
+
+	suite.Require().NotPanics(func() {
+		suite.ss.Set(suite.ctx, keyUnbondingTime, t)
+	})
+	suite.ss.DeleteRaw(suite.ctx, keyUnbondingTime)
+	suite.Require().False(suite.ss.Has(suite.ctx, keyUnbondingTime))
+}
+
 func (suite *SubspaceTestSuite) TestHas() {
 	t := time.Hour * 48
 
@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/precompile"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	precompileQueryCmd := &cobra.Command{
+		Use:                        precompile.ModuleName,
+		Short:                      "Querying commands for the precompile module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	precompileQueryCmd.AddCommand(
+		GetCmdQueryPrecompile(),
+		GetCmdQueryPrecompiles(),
+	)
+
+	return precompileQueryCmd
+}
+
+// GetCmdQueryPrecompile returns cmd to query the precompile at an address.
+func GetCmdQueryPrecompile() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "precompile [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the precompile at an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the precompile registered at an address.
+
+Example:
+$ %s query %s precompile [address]
+`, version.AppName, precompile.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := precompile.NewQueryClient(clientCtx)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Precompile(cmd.Context(), &precompile.QueryPrecompileRequest{Address: addr.String()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Precompile)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryPrecompiles returns cmd to query all the registered precompiles.
+func GetCmdQueryPrecompiles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "precompiles",
+		Args:  cobra.NoArgs,
+		Short: "Query all the precompiles",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the precompiles registered by the application.
+
+Example:
+$ %s query %s precompiles
+`, version.AppName, precompile.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := precompile.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Precompiles(cmd.Context(), &precompile.QueryPrecompilesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
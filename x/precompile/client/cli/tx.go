@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/precompile"
+)
+
+// flags for the precompile module
+const (
+	FlagFunds = "funds"
+	FlagHex   = "hex"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	precompileTxCmd := &cobra.Command{
+		Use:                        precompile.ModuleName,
+		Short:                      "Precompile transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	precompileTxCmd.AddCommand(
+		NewCmdExecutePrecompile(),
+	)
+
+	return precompileTxCmd
+}
+
+// NewCmdExecutePrecompile returns a CLI command handler for creating a MsgExecutePrecompile transaction.
+func NewCmdExecutePrecompile() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute [address] [input]",
+		Short: "Run the precompile at an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Run the precompile at an address with the given input, optionally sending it
+funds from the --from account beforehand. The input is given as a string, or in
+hex with --hex.
+
+Examples:
+$ %s tx %s execute cosmos1... '{"increment":{}}' --from mykey
+$ %s tx %s execute cosmos1... 0a0b0c --hex --funds 10stake --from mykey
+`, version.AppName, precompile.ModuleName, version.AppName, precompile.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			input := []byte(args[1])
+			if isHex, _ := cmd.Flags().GetBool(FlagHex); isHex {
+				if input, err = hex.DecodeString(args[1]); err != nil {
+					return fmt.Errorf("invalid hex input: %w", err)
+				}
+			}
+
+			fundsStr, err := cmd.Flags().GetString(FlagFunds)
+			if err != nil {
+				return err
+			}
+
+			funds, err := sdk.ParseCoinsNormalized(fundsStr)
+			if err != nil {
+				return err
+			}
+
+			msg := precompile.NewMsgExecutePrecompile(clientCtx.GetFromAddress(), addr, input, funds)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagFunds, "", "The coins to send to the precompile before running it")
+	cmd.Flags().Bool(FlagHex, false, "Decode the input from hex")
+
+	return cmd
+}
@@ -0,0 +1,73 @@
+// Code generated by typedgen. DO NOT EDIT.
+
+// Package grpcclient implements the typed clients of the precompile module.
+package grpcclient
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/typed"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/precompile"
+)
+
+// QueryClient is the typed client of the precompile Query service, retrying
+// the queries failing with a transient error.
+type QueryClient struct {
+	client precompile.QueryClient
+	retry  typed.RetryConfig
+}
+
+// NewQueryClient returns a QueryClient querying through the connection.
+func NewQueryClient(conn gogogrpc.ClientConn, retry typed.RetryConfig) *QueryClient {
+	return &QueryClient{
+		client: precompile.NewQueryClient(conn),
+		retry:  retry,
+	}
+}
+
+// Precompile calls the precompile Query/Precompile method.
+func (c *QueryClient) Precompile(ctx context.Context, req *precompile.QueryPrecompileRequest, opts ...grpc.CallOption) (*precompile.QueryPrecompileResponse, error) {
+	var res *precompile.QueryPrecompileResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Precompile(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// Precompiles calls the precompile Query/Precompiles method.
+func (c *QueryClient) Precompiles(ctx context.Context, req *precompile.QueryPrecompilesRequest, opts ...grpc.CallOption) (*precompile.QueryPrecompilesResponse, error) {
+	var res *precompile.QueryPrecompilesResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Precompiles(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// MsgClient is the typed client of the precompile Msg service, broadcasting a
+// transaction of each message.
+type MsgClient struct {
+	broadcaster typed.Broadcaster
+}
+
+// NewMsgClient returns a MsgClient broadcasting the transactions with the
+// broadcaster.
+func NewMsgClient(broadcaster typed.Broadcaster) *MsgClient {
+	return &MsgClient{broadcaster: broadcaster}
+}
+
+// ExecutePrecompile broadcasts a transaction of the precompile Msg/ExecutePrecompile message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) ExecutePrecompile(ctx context.Context, msg *precompile.MsgExecutePrecompile) (*precompile.MsgExecutePrecompileResponse, *sdk.TxResponse, error) {
+	res := &precompile.MsgExecutePrecompileResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
@@ -0,0 +1,14 @@
+/*
+Package precompile implements precompiles, native Go handlers registered by the
+application at deterministic addresses, giving chains a lightweight form of
+programmability without a virtual machine.
+
+A precompile is registered with the keeper when the application is built, and
+lives at the module address derived from its name. Any account can run it with
+MsgExecutePrecompile, optionally sending it funds beforehand. The precompile is
+charged the gas it requires for the input, then runs with access to a store
+under its own prefix only, metered with the default KV gas schedule unless the
+precompile defines its own. Precompile states are exported to and imported from
+genesis; the precompiles themselves are part of the application binary.
+*/
+package precompile
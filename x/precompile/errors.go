@@ -0,0 +1,13 @@
+package precompile
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/precompile module sentinel errors
+var (
+	// ErrPrecompileNotFound error if there is no precompile at an address
+	ErrPrecompileNotFound = sdkerrors.Register(ModuleName, 2, "precompile not found")
+	// ErrExecutionFailed error if a precompile returns an error
+	ErrExecutionFailed = sdkerrors.Register(ModuleName, 3, "precompile execution failed")
+)
@@ -0,0 +1,12 @@
+package precompile
+
+// precompile module events
+const (
+	EventTypeExecutePrecompile = "execute_precompile"
+
+	AttributeKeySender  = "sender"
+	AttributeKeyAddress = "address"
+	AttributeKeyName    = "name"
+
+	AttributeValueCategory = ModuleName
+)
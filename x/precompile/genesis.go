@@ -0,0 +1,47 @@
+package precompile
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates new GenesisState object
+func NewGenesisState(states []PrecompileState) *GenesisState {
+	return &GenesisState{
+		States: states,
+	}
+}
+
+// DefaultGenesisState returns default state for precompile module.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// ValidateGenesis ensures the precompile states in the genesis state have valid
+// and unique addresses and keys.
+func ValidateGenesis(data GenesisState) error {
+	seen := make(map[string]bool, len(data.States))
+	for _, s := range data.States {
+		if _, err := sdk.AccAddressFromBech32(s.Address); err != nil {
+			return fmt.Errorf("invalid precompile address %s: %w", s.Address, err)
+		}
+		if seen[s.Address] {
+			return fmt.Errorf("duplicate state of precompile %s", s.Address)
+		}
+		seen[s.Address] = true
+
+		keys := make(map[string]bool, len(s.Entries))
+		for _, e := range s.Entries {
+			if len(e.Key) == 0 {
+				return fmt.Errorf("empty state key of precompile %s", s.Address)
+			}
+			if keys[string(e.Key)] {
+				return fmt.Errorf("duplicate state key %X of precompile %s", e.Key, s.Address)
+			}
+			keys[string(e.Key)] = true
+		}
+	}
+
+	return nil
+}
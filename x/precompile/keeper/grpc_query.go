@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/precompile"
+)
+
+var _ precompile.QueryServer = Keeper{}
+
+// Precompile returns the precompile registered at an address.
+func (k Keeper) Precompile(_ context.Context, req *precompile.QueryPrecompileRequest) (*precompile.QueryPrecompileResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	p, found := k.GetPrecompile(addr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "precompile %s not found", addr)
+	}
+
+	return &precompile.QueryPrecompileResponse{Precompile: precompile.NewPrecompileInfo(p)}, nil
+}
+
+// Precompiles returns all the registered precompiles.
+func (k Keeper) Precompiles(_ context.Context, req *precompile.QueryPrecompilesRequest) (*precompile.QueryPrecompilesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var infos []precompile.PrecompileInfo
+	for _, p := range k.GetPrecompiles() {
+		infos = append(infos, precompile.NewPrecompileInfo(p))
+	}
+
+	return &precompile.QueryPrecompilesResponse{Precompiles: infos}, nil
+}
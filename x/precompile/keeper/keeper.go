@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/gaskv"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/precompile"
+)
+
+// Keeper runs the precompiles registered by the application and manages their
+// states.
+type Keeper struct {
+	cdc        codec.BinaryCodec
+	storeKey   sdk.StoreKey
+	bankKeeper precompile.BankKeeper
+
+	// precompiles are the registered precompiles by address
+	precompiles map[string]precompile.Precompile
+}
+
+// NewKeeper creates a precompile Keeper
+func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, bankKeeper precompile.BankKeeper) Keeper {
+	return Keeper{
+		cdc:         cdc,
+		storeKey:    storeKey,
+		bankKeeper:  bankKeeper,
+		precompiles: make(map[string]precompile.Precompile),
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", precompile.ModuleName))
+}
+
+// RegisterPrecompile registers a precompile at the address derived from its name
+// and returns that address. It panics if a precompile with the same name is
+// already registered. Precompiles must be registered when building the
+// application, by every node in the same way.
+func (k Keeper) RegisterPrecompile(p precompile.Precompile) sdk.AccAddress {
+	addr := precompile.Address(p.Name())
+	if _, found := k.precompiles[addr.String()]; found {
+		panic(fmt.Sprintf("precompile %s already registered", p.Name()))
+	}
+
+	k.precompiles[addr.String()] = p
+	return addr
+}
+
+// GetPrecompile returns the precompile registered at an address, if any.
+func (k Keeper) GetPrecompile(addr sdk.AccAddress) (p precompile.Precompile, found bool) {
+	p, found = k.precompiles[addr.String()]
+	return p, found
+}
+
+// GetPrecompiles returns the registered precompiles, sorted by address.
+func (k Keeper) GetPrecompiles() []precompile.Precompile {
+	addrs := make([]string, 0, len(k.precompiles))
+	for addr := range k.precompiles {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+
+	precompiles := make([]precompile.Precompile, len(addrs))
+	for i, addr := range addrs {
+		precompiles[i] = k.precompiles[addr]
+	}
+
+	return precompiles
+}
+
+// Execute sends the funds of the caller to the precompile at an address and
+// runs it with the given input, charging the gas it requires beforehand.
+func (k Keeper) Execute(ctx sdk.Context, caller, addr sdk.AccAddress, input []byte, funds sdk.Coins) ([]byte, error) {
+	p, found := k.GetPrecompile(addr)
+	if !found {
+		return nil, sdkerrors.Wrapf(precompile.ErrPrecompileNotFound, "address %s", addr)
+	}
+
+	ctx.GasMeter().ConsumeGas(p.RequiredGas(input), fmt.Sprintf("precompile %s", p.Name()))
+
+	if !funds.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, caller, addr, funds); err != nil {
+			return nil, err
+		}
+	}
+
+	env := precompile.Env{
+		Caller:  caller,
+		Address: addr,
+		Funds:   funds,
+		Store:   k.precompileStore(ctx, addr, p),
+	}
+	output, err := p.Run(ctx, env, input)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(precompile.ErrExecutionFailed, "%s: %s", p.Name(), err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			precompile.EventTypeExecutePrecompile,
+			sdk.NewAttribute(precompile.AttributeKeySender, caller.String()),
+			sdk.NewAttribute(precompile.AttributeKeyAddress, addr.String()),
+			sdk.NewAttribute(precompile.AttributeKeyName, p.Name()),
+		),
+	)
+
+	return output, nil
+}
+
+// precompileStore returns the store of the precompile at an address, metered
+// with the gas schedule of the precompile.
+func (k Keeper) precompileStore(ctx sdk.Context, addr sdk.AccAddress, p precompile.Precompile) sdk.KVStore {
+	store := gaskv.NewStore(ctx.MultiStore().GetKVStore(k.storeKey), ctx.GasMeter(), precompile.GetKVGasConfig(p))
+	return prefix.NewStore(store, precompile.StatePrefix(addr))
+}
+
+// InitGenesis will initialize the keeper from a *previously validated* GenesisState.
+// The precompiles must be registered before their states are imported.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *precompile.GenesisState) error {
+	for _, s := range data.States {
+		addr, err := sdk.AccAddressFromBech32(s.Address)
+		if err != nil {
+			return err
+		}
+
+		if _, found := k.GetPrecompile(addr); !found {
+			return sdkerrors.Wrapf(precompile.ErrPrecompileNotFound, "state of address %s", addr)
+		}
+
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), precompile.StatePrefix(addr))
+		for _, e := range s.Entries {
+			store.Set(e.Key, e.Value)
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis will dump the contents of the keeper into a serializable GenesisState.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *precompile.GenesisState {
+	var states []precompile.PrecompileState
+	for _, p := range k.GetPrecompiles() {
+		addr := precompile.Address(p.Name())
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), precompile.StatePrefix(addr))
+
+		iter := store.Iterator(nil, nil)
+		var entries []precompile.StateEntry
+		for ; iter.Valid(); iter.Next() {
+			entries = append(entries, precompile.StateEntry{Key: iter.Key(), Value: iter.Value()})
+		}
+		iter.Close()
+
+		if len(entries) > 0 {
+			states = append(states, precompile.PrecompileState{Address: addr.String(), Entries: entries})
+		}
+	}
+
+	return precompile.NewGenesisState(states)
+}
@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/precompile"
+	"github.com/cosmos/cosmos-sdk/x/precompile/keeper"
+)
+
+var counterKey = []byte("counter")
+
+// counter is a precompile adding its input, a big endian uint64, to a counter
+// and returning the new value.
+type counter struct {
+	name string
+}
+
+var _ precompile.Precompile = counter{}
+
+func (c counter) Name() string { return c.name }
+
+func (counter) RequiredGas(input []byte) uint64 { return 100 + uint64(len(input)) }
+
+func (counter) Run(_ sdk.Context, env precompile.Env, input []byte) ([]byte, error) {
+	if len(input) != 8 {
+		return nil, errors.New("input must be 8 bytes")
+	}
+
+	var value uint64
+	if bz := env.Store.Get(counterKey); bz != nil {
+		value = binary.BigEndian.Uint64(bz)
+	}
+	value += binary.BigEndian.Uint64(input)
+
+	bz := sdk.Uint64ToBigEndian(value)
+	env.Store.Set(counterKey, bz)
+	return bz, nil
+}
+
+// meteredCounter is a counter with its own store gas schedule.
+type meteredCounter struct {
+	counter
+}
+
+func (meteredCounter) KVGasConfig() storetypes.GasConfig {
+	return storetypes.GasConfig{WriteCostFlat: 10000}
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	sdkCtx      sdk.Context
+	ctx         context.Context
+	addrs       []sdk.AccAddress
+	keeper      keeper.Keeper
+	msgSrvr     precompile.MsgServer
+	queryClient precompile.QueryClient
+	counterAddr sdk.AccAddress
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	suite.app = app
+	suite.sdkCtx = ctx
+	suite.ctx = sdk.WrapSDKContext(ctx)
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(30000000))
+	suite.keeper = app.PrecompileKeeper
+	suite.msgSrvr = keeper.NewMsgServerImpl(suite.keeper)
+	suite.counterAddr = suite.keeper.RegisterPrecompile(counter{name: "counter"})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	precompile.RegisterQueryServer(queryHelper, app.PrecompileKeeper)
+	suite.queryClient = precompile.NewQueryClient(queryHelper)
+}
+
+func (suite *KeeperTestSuite) TestRegisterPrecompile() {
+	suite.Require().Equal(precompile.Address("counter"), suite.counterAddr)
+	suite.Require().Panics(func() { suite.keeper.RegisterPrecompile(counter{name: "counter"}) })
+
+	other := suite.keeper.RegisterPrecompile(counter{name: "other"})
+	suite.Require().NotEqual(suite.counterAddr, other)
+	suite.Require().Len(suite.keeper.GetPrecompiles(), 2)
+}
+
+func (suite *KeeperTestSuite) TestExecutePrecompile() {
+	sender := suite.addrs[0]
+	funds := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	msg := precompile.NewMsgExecutePrecompile(sender, suite.counterAddr, sdk.Uint64ToBigEndian(3), funds)
+	res, err := suite.msgSrvr.ExecutePrecompile(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Uint64ToBigEndian(3), res.Output)
+
+	res, err = suite.msgSrvr.ExecutePrecompile(suite.ctx, precompile.NewMsgExecutePrecompile(sender, suite.counterAddr, sdk.Uint64ToBigEndian(4), nil))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Uint64ToBigEndian(7), res.Output)
+
+	// the funds were sent to the precompile
+	suite.Require().Equal(funds, suite.app.BankKeeper.GetAllBalances(suite.sdkCtx, suite.counterAddr))
+
+	// the state is stored under the prefix of the precompile
+	store := suite.sdkCtx.KVStore(suite.app.GetKey(precompile.StoreKey))
+	suite.Require().Equal(sdk.Uint64ToBigEndian(7), store.Get(append(precompile.StatePrefix(suite.counterAddr), counterKey...)))
+
+	// unknown address
+	_, err = suite.msgSrvr.ExecutePrecompile(suite.ctx, precompile.NewMsgExecutePrecompile(sender, suite.addrs[1], nil, nil))
+	suite.Require().ErrorIs(err, precompile.ErrPrecompileNotFound)
+
+	// the precompile fails
+	_, err = suite.msgSrvr.ExecutePrecompile(suite.ctx, precompile.NewMsgExecutePrecompile(sender, suite.counterAddr, []byte{1}, nil))
+	suite.Require().ErrorIs(err, precompile.ErrExecutionFailed)
+}
+
+func (suite *KeeperTestSuite) TestExecutePrecompileGas() {
+	metered := suite.keeper.RegisterPrecompile(meteredCounter{counter{name: "metered"}})
+	input := sdk.Uint64ToBigEndian(1)
+
+	gasUsed := func(addr sdk.AccAddress) uint64 {
+		ctx := suite.sdkCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		_, err := suite.keeper.Execute(ctx, suite.addrs[0], addr, input, nil)
+		suite.Require().NoError(err)
+		return ctx.GasMeter().GasConsumed()
+	}
+
+	// the required gas is charged on top of the store accesses
+	defaultGas := gasUsed(suite.counterAddr)
+	suite.Require().Greater(defaultGas, uint64(108))
+
+	// the custom gas schedule only charges the writes
+	suite.Require().Equal(uint64(108+10000), gasUsed(metered))
+
+	// the required gas is charged before running
+	ctx := suite.sdkCtx.WithGasMeter(sdk.NewGasMeter(50))
+	suite.Require().Panics(func() {
+		_, _ = suite.keeper.Execute(ctx, suite.addrs[0], suite.counterAddr, input, nil)
+	})
+}
+
+func (suite *KeeperTestSuite) TestQueryPrecompiles() {
+	res, err := suite.queryClient.Precompile(suite.ctx, &precompile.QueryPrecompileRequest{Address: suite.counterAddr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(precompile.PrecompileInfo{Address: suite.counterAddr.String(), Name: "counter"}, res.Precompile)
+
+	_, err = suite.queryClient.Precompile(suite.ctx, &precompile.QueryPrecompileRequest{Address: suite.addrs[0].String()})
+	suite.Require().Error(err)
+
+	suite.keeper.RegisterPrecompile(counter{name: "other"})
+	all, err := suite.queryClient.Precompiles(suite.ctx, &precompile.QueryPrecompilesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(all.Precompiles, 2)
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	_, err := suite.keeper.Execute(suite.sdkCtx, suite.addrs[0], suite.counterAddr, sdk.Uint64ToBigEndian(5), nil)
+	suite.Require().NoError(err)
+
+	genesis := suite.keeper.ExportGenesis(suite.sdkCtx)
+	suite.Require().NoError(precompile.ValidateGenesis(*genesis))
+	suite.Require().Equal([]precompile.PrecompileState{{
+		Address: suite.counterAddr.String(),
+		Entries: []precompile.StateEntry{{Key: counterKey, Value: sdk.Uint64ToBigEndian(5)}},
+	}}, genesis.States)
+
+	suite.SetupTest()
+	suite.Require().NoError(suite.keeper.InitGenesis(suite.sdkCtx, genesis))
+	suite.Require().Equal(genesis, suite.keeper.ExportGenesis(suite.sdkCtx))
+
+	// the state of an unregistered precompile cannot be imported
+	genesis.States[0].Address = suite.addrs[0].String()
+	suite.Require().ErrorIs(suite.keeper.InitGenesis(suite.sdkCtx, genesis), precompile.ErrPrecompileNotFound)
+}
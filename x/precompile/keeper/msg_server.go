@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/precompile"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the precompile MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) precompile.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ precompile.MsgServer = msgServer{}
+
+// ExecutePrecompile runs the precompile at an address on behalf of the sender.
+func (k msgServer) ExecutePrecompile(goCtx context.Context, msg *precompile.MsgExecutePrecompile) (*precompile.MsgExecutePrecompileResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := k.Execute(ctx, sender, addr, msg.Input, msg.Funds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &precompile.MsgExecutePrecompileResponse{Output: output}, nil
+}
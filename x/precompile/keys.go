@@ -0,0 +1,31 @@
+package precompile
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "precompile"
+
+	// StoreKey is the store key string for precompile
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for precompile
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for precompile
+	QuerierRoute = ModuleName
+)
+
+var (
+	// StateKeyPrefix is the prefix of the precompile states in the kvstore
+	StateKeyPrefix = []byte{0x01}
+)
+
+// StatePrefix returns the prefix of the state of the precompile at the given
+// address. Each precompile only has access to the keys under its own prefix.
+func StatePrefix(addr sdk.AccAddress) []byte {
+	return append(StateKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
+}
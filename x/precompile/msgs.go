@@ -0,0 +1,62 @@
+package precompile
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+)
+
+var (
+	_ sdk.Msg            = &MsgExecutePrecompile{}
+	_ legacytx.LegacyMsg = &MsgExecutePrecompile{} // For amino support.
+)
+
+// NewMsgExecutePrecompile creates a new MsgExecutePrecompile.
+//nolint:interfacer
+func NewMsgExecutePrecompile(sender, addr sdk.AccAddress, input []byte, funds sdk.Coins) *MsgExecutePrecompile {
+	return &MsgExecutePrecompile{
+		Sender:  sender.String(),
+		Address: addr.String(),
+		Input:   input,
+		Funds:   funds,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgExecutePrecompile) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid precompile address: %s", err)
+	}
+	if !msg.Funds.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Funds.String())
+	}
+
+	return nil
+}
+
+// GetSigners returns the sender running the precompile.
+func (msg MsgExecutePrecompile) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgExecutePrecompile) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgExecutePrecompile) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgExecutePrecompile) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
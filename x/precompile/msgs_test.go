@@ -0,0 +1,45 @@
+package precompile_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/precompile"
+)
+
+func TestMsgExecutePrecompile(t *testing.T) {
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	addr := precompile.Address("counter")
+	funds := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	cases := map[string]struct {
+		sender sdk.AccAddress
+		addr   sdk.AccAddress
+		funds  sdk.Coins
+		valid  bool
+	}{
+		"valid":         {sender, addr, funds, true},
+		"no funds":      {sender, addr, nil, true},
+		"empty sender":  {nil, addr, funds, false},
+		"empty address": {sender, nil, funds, false},
+		"invalid funds": {sender, addr, sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.ZeroInt()}}, false},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			msg := precompile.NewMsgExecutePrecompile(tc.sender, tc.addr, []byte("input"), tc.funds)
+
+			if tc.valid {
+				require.NoError(t, msg.ValidateBasic())
+				require.Equal(t, []sdk.AccAddress{tc.sender}, msg.GetSigners())
+				require.NotPanics(t, func() { msg.GetSignBytes() })
+			} else {
+				require.Error(t, msg.ValidateBasic())
+			}
+		})
+	}
+}
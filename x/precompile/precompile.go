@@ -0,0 +1,64 @@
+package precompile
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// Precompile is a native handler run by MsgExecutePrecompile at the address
+// derived from its name.
+type Precompile interface {
+	// Name returns the unique name of the precompile, from which its address is
+	// derived. It must never change once the precompile has state.
+	Name() string
+
+	// RequiredGas returns the gas charged before running the precompile with
+	// the given input, on top of the gas of its store accesses.
+	RequiredGas(input []byte) uint64
+
+	// Run runs the precompile with the given input and returns its output. The
+	// state changes of a failed run are reverted along with the transaction.
+	Run(ctx sdk.Context, env Env, input []byte) ([]byte, error)
+}
+
+// GasConfigurer is implemented by precompiles metering the accesses to their
+// store with their own gas schedule instead of the default KV gas config.
+type GasConfigurer interface {
+	KVGasConfig() storetypes.GasConfig
+}
+
+// Env is the environment a precompile runs in.
+type Env struct {
+	// Caller is the sender of the MsgExecutePrecompile.
+	Caller sdk.AccAddress
+	// Address is the address of the precompile.
+	Address sdk.AccAddress
+	// Funds are the coins sent by the caller to the precompile address.
+	Funds sdk.Coins
+	// Store is the store of the precompile, isolated from the state of the
+	// other precompiles.
+	Store sdk.KVStore
+}
+
+// Address returns the address of the precompile with the given name.
+func Address(name string) sdk.AccAddress {
+	return address.Module(ModuleName, []byte(name))
+}
+
+// GetKVGasConfig returns the gas schedule of the store of the precompile.
+func GetKVGasConfig(p Precompile) storetypes.GasConfig {
+	if gc, ok := p.(GasConfigurer); ok {
+		return gc.KVGasConfig()
+	}
+
+	return storetypes.KVGasConfig()
+}
+
+// NewPrecompileInfo returns the description of a precompile.
+func NewPrecompileInfo(p Precompile) PrecompileInfo {
+	return PrecompileInfo{
+		Address: Address(p.Name()).String(),
+		Name:    p.Name(),
+	}
+}
@@ -0,0 +1,933 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/precompile/v1beta1/query.proto
+
+package precompile
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryPrecompileRequest is the request type for the Query/Precompile RPC method.
+type QueryPrecompileRequest struct {
+	// address is the address of the precompile.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryPrecompileRequest) Reset()         { *m = QueryPrecompileRequest{} }
+func (m *QueryPrecompileRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPrecompileRequest) ProtoMessage()    {}
+func (*QueryPrecompileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a42443a60448020e, []int{0}
+}
+func (m *QueryPrecompileRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPrecompileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPrecompileRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPrecompileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPrecompileRequest.Merge(m, src)
+}
+func (m *QueryPrecompileRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPrecompileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPrecompileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPrecompileRequest proto.InternalMessageInfo
+
+func (m *QueryPrecompileRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryPrecompileResponse is the response type for the Query/Precompile RPC method.
+type QueryPrecompileResponse struct {
+	Precompile PrecompileInfo `protobuf:"bytes,1,opt,name=precompile,proto3" json:"precompile"`
+}
+
+func (m *QueryPrecompileResponse) Reset()         { *m = QueryPrecompileResponse{} }
+func (m *QueryPrecompileResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPrecompileResponse) ProtoMessage()    {}
+func (*QueryPrecompileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a42443a60448020e, []int{1}
+}
+func (m *QueryPrecompileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPrecompileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPrecompileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPrecompileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPrecompileResponse.Merge(m, src)
+}
+func (m *QueryPrecompileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPrecompileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPrecompileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPrecompileResponse proto.InternalMessageInfo
+
+func (m *QueryPrecompileResponse) GetPrecompile() PrecompileInfo {
+	if m != nil {
+		return m.Precompile
+	}
+	return PrecompileInfo{}
+}
+
+// QueryPrecompilesRequest is the request type for the Query/Precompiles RPC method.
+type QueryPrecompilesRequest struct {
+}
+
+func (m *QueryPrecompilesRequest) Reset()         { *m = QueryPrecompilesRequest{} }
+func (m *QueryPrecompilesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPrecompilesRequest) ProtoMessage()    {}
+func (*QueryPrecompilesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a42443a60448020e, []int{2}
+}
+func (m *QueryPrecompilesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPrecompilesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPrecompilesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPrecompilesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPrecompilesRequest.Merge(m, src)
+}
+func (m *QueryPrecompilesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPrecompilesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPrecompilesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPrecompilesRequest proto.InternalMessageInfo
+
+// QueryPrecompilesResponse is the response type for the Query/Precompiles RPC method.
+type QueryPrecompilesResponse struct {
+	Precompiles []PrecompileInfo `protobuf:"bytes,1,rep,name=precompiles,proto3" json:"precompiles"`
+}
+
+func (m *QueryPrecompilesResponse) Reset()         { *m = QueryPrecompilesResponse{} }
+func (m *QueryPrecompilesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPrecompilesResponse) ProtoMessage()    {}
+func (*QueryPrecompilesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a42443a60448020e, []int{3}
+}
+func (m *QueryPrecompilesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPrecompilesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPrecompilesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPrecompilesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPrecompilesResponse.Merge(m, src)
+}
+func (m *QueryPrecompilesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPrecompilesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPrecompilesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPrecompilesResponse proto.InternalMessageInfo
+
+func (m *QueryPrecompilesResponse) GetPrecompiles() []PrecompileInfo {
+	if m != nil {
+		return m.Precompiles
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryPrecompileRequest)(nil), "cosmos.precompile.v1beta1.QueryPrecompileRequest")
+	proto.RegisterType((*QueryPrecompileResponse)(nil), "cosmos.precompile.v1beta1.QueryPrecompileResponse")
+	proto.RegisterType((*QueryPrecompilesRequest)(nil), "cosmos.precompile.v1beta1.QueryPrecompilesRequest")
+	proto.RegisterType((*QueryPrecompilesResponse)(nil), "cosmos.precompile.v1beta1.QueryPrecompilesResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/precompile/v1beta1/query.proto", fileDescriptor_a42443a60448020e)
+}
+
+var fileDescriptor_a42443a60448020e = []byte{
+	// 362 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xbf, 0x4e, 0xc2, 0x50,
+	0x14, 0xc6, 0x7b, 0xf1, 0x5f, 0x3c, 0x6c, 0x37, 0x46, 0xa1, 0x31, 0xd5, 0x34, 0xd1, 0x80, 0x89,
+	0xf7, 0x4a, 0x59, 0x9c, 0x71, 0x72, 0x52, 0x18, 0xdd, 0x0a, 0x5c, 0x6b, 0x95, 0xf6, 0x94, 0xde,
+	0x62, 0x34, 0xc6, 0xc5, 0x27, 0x30, 0xf1, 0x1d, 0xdc, 0x7c, 0x0f, 0x46, 0x12, 0x17, 0x27, 0x43,
+	0xc0, 0x07, 0x31, 0xb4, 0xc5, 0x36, 0x41, 0x0c, 0x75, 0x6a, 0x9b, 0xf3, 0x7d, 0xbf, 0xf3, 0x9d,
+	0x73, 0x0a, 0x7b, 0x2d, 0x94, 0x0e, 0x4a, 0xee, 0xf9, 0xa2, 0x85, 0x8e, 0x67, 0x77, 0x04, 0xbf,
+	0xad, 0x34, 0x45, 0x60, 0x56, 0x78, 0xb7, 0x27, 0xfc, 0x7b, 0xe6, 0xf9, 0x18, 0x20, 0x2d, 0x46,
+	0x32, 0x96, 0xc8, 0x58, 0x2c, 0x53, 0x37, 0x2c, 0xb4, 0x30, 0x54, 0xf1, 0xc9, 0x5b, 0x64, 0x50,
+	0x0f, 0xe6, 0x73, 0x53, 0x8c, 0x48, 0xbb, 0x6d, 0x21, 0x5a, 0x1d, 0xc1, 0x4d, 0xcf, 0xe6, 0xa6,
+	0xeb, 0x62, 0x60, 0x06, 0x36, 0xba, 0x32, 0xaa, 0xea, 0x06, 0x6c, 0xd6, 0x27, 0x49, 0xce, 0x7f,
+	0x6c, 0x0d, 0xd1, 0xed, 0x09, 0x19, 0xd0, 0x02, 0xac, 0x99, 0xed, 0xb6, 0x2f, 0xa4, 0x2c, 0x90,
+	0x5d, 0x52, 0x5a, 0x6f, 0x4c, 0x3f, 0xf5, 0x6b, 0xd8, 0x9a, 0xf1, 0x48, 0x0f, 0x5d, 0x29, 0xe8,
+	0x19, 0x40, 0x12, 0x20, 0xf4, 0xe5, 0x8d, 0x32, 0x9b, 0x3b, 0x1e, 0x4b, 0x10, 0xa7, 0xee, 0x25,
+	0xd6, 0x96, 0xfb, 0x9f, 0x3b, 0x4a, 0x23, 0x85, 0xd0, 0x8b, 0x33, 0xbd, 0x64, 0x1c, 0x50, 0x77,
+	0xa0, 0x30, 0x5b, 0x8a, 0x73, 0xd4, 0x21, 0x9f, 0x40, 0x26, 0x03, 0x2c, 0xfd, 0x27, 0x48, 0x9a,
+	0x61, 0x0c, 0x73, 0xb0, 0x12, 0xf6, 0xa3, 0x6f, 0x04, 0x20, 0xd1, 0xd3, 0xca, 0x1f, 0xd8, 0xdf,
+	0x77, 0xab, 0x1a, 0x59, 0x2c, 0xd1, 0x48, 0xfa, 0xf1, 0xd3, 0xfb, 0xd7, 0x4b, 0xce, 0xa0, 0x47,
+	0x7c, 0x91, 0xe3, 0x4b, 0xfe, 0x10, 0x9f, 0xeb, 0x91, 0xbe, 0x12, 0xc8, 0xa7, 0x96, 0x44, 0x33,
+	0x74, 0x9f, 0x2e, 0x5b, 0xad, 0x66, 0xf2, 0xc4, 0x91, 0x59, 0x18, 0xb9, 0x44, 0xf7, 0x17, 0x8b,
+	0x5c, 0x3b, 0xe9, 0x8f, 0x34, 0x32, 0x18, 0x69, 0x64, 0x38, 0xd2, 0xc8, 0xf3, 0x58, 0x53, 0x06,
+	0x63, 0x4d, 0xf9, 0x18, 0x6b, 0xca, 0x45, 0xd9, 0xb2, 0x83, 0xab, 0x5e, 0x93, 0xb5, 0xd0, 0x99,
+	0xb2, 0xa2, 0xc7, 0xa1, 0x6c, 0xdf, 0xf0, 0xbb, 0x14, 0xa5, 0xb9, 0x1a, 0xfe, 0xd8, 0xd5, 0xef,
+	0x01, 0x00, 0x2a, 0x9e, 0x05, 0x6d, 0x7c, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Precompile returns the precompile registered at an address.
+	Precompile(ctx context.Context, in *QueryPrecompileRequest, opts ...grpc.CallOption) (*QueryPrecompileResponse, error)
+	// Precompiles returns all the precompiles registered by the application.
+	Precompiles(ctx context.Context, in *QueryPrecompilesRequest, opts ...grpc.CallOption) (*QueryPrecompilesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Precompile(ctx context.Context, in *QueryPrecompileRequest, opts ...grpc.CallOption) (*QueryPrecompileResponse, error) {
+	out := new(QueryPrecompileResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.precompile.v1beta1.Query/Precompile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Precompiles(ctx context.Context, in *QueryPrecompilesRequest, opts ...grpc.CallOption) (*QueryPrecompilesResponse, error) {
+	out := new(QueryPrecompilesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.precompile.v1beta1.Query/Precompiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Precompile returns the precompile registered at an address.
+	Precompile(context.Context, *QueryPrecompileRequest) (*QueryPrecompileResponse, error)
+	// Precompiles returns all the precompiles registered by the application.
+	Precompiles(context.Context, *QueryPrecompilesRequest) (*QueryPrecompilesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Precompile(ctx context.Context, req *QueryPrecompileRequest) (*QueryPrecompileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Precompile not implemented")
+}
+func (*UnimplementedQueryServer) Precompiles(ctx context.Context, req *QueryPrecompilesRequest) (*QueryPrecompilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Precompiles not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Precompile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPrecompileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Precompile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.precompile.v1beta1.Query/Precompile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Precompile(ctx, req.(*QueryPrecompileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Precompiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPrecompilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Precompiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.precompile.v1beta1.Query/Precompiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Precompiles(ctx, req.(*QueryPrecompilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.precompile.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Precompile",
+			Handler:    _Query_Precompile_Handler,
+		},
+		{
+			MethodName: "Precompiles",
+			Handler:    _Query_Precompiles_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/precompile/v1beta1/query.proto",
+}
+
+func (m *QueryPrecompileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrecompileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrecompileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPrecompileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrecompileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrecompileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Precompile.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPrecompilesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrecompilesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrecompilesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPrecompilesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrecompilesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrecompilesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Precompiles) > 0 {
+		for iNdEx := len(m.Precompiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Precompiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPrecompileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPrecompileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Precompile.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPrecompilesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPrecompilesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Precompiles) > 0 {
+		for _, e := range m.Precompiles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPrecompileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPrecompileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPrecompileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPrecompileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPrecompileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPrecompileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precompile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Precompile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPrecompilesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPrecompilesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPrecompilesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPrecompilesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPrecompilesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPrecompilesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precompiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Precompiles = append(m.Precompiles, PrecompileInfo{})
+			if err := m.Precompiles[len(m.Precompiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
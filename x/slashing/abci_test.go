@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -99,3 +100,57 @@ func TestBeginBlocker(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, stakingtypes.Unbonding, validator.GetStatus())
 }
+
+func TestEndBlockerAutoUnjail(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(1000, 0)})
+
+	pks := simapp.CreateTestPubKeys(1)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	addr, pk := sdk.ValAddress(pks[0].Address()), pks[0]
+	consAddr := sdk.ConsAddress(pk.Address())
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(addr, pk, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// jail the validator for an hour
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	info.JailedUntil = ctx.BlockTime().Add(time.Hour)
+	app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, info)
+	app.StakingKeeper.Jail(ctx, consAddr)
+
+	h := slashing.NewHandler(app.SlashingKeeper)
+	_, err := h(ctx, types.NewMsgSetAutoUnjail(sdk.ValAddress(sdk.AccAddress("unknown_validator___")), true))
+	require.Error(t, err)
+
+	// opted out validators are not unjailed
+	ctx = ctx.WithBlockTime(info.JailedUntil)
+	slashing.EndBlocker(ctx, app.SlashingKeeper)
+	require.True(t, app.StakingKeeper.Validator(ctx, addr).IsJailed())
+
+	_, err = h(ctx, types.NewMsgSetAutoUnjail(addr, true))
+	require.NoError(t, err)
+	require.True(t, app.SlashingKeeper.IsAutoUnjail(ctx, addr))
+
+	// not unjailed until the jail period elapsed
+	slashing.EndBlocker(ctx.WithBlockTime(info.JailedUntil.Add(-time.Second)), app.SlashingKeeper)
+	require.True(t, app.StakingKeeper.Validator(ctx, addr).IsJailed())
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	slashing.EndBlocker(ctx, app.SlashingKeeper)
+	require.False(t, app.StakingKeeper.Validator(ctx, addr).IsJailed())
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, types.EventTypeAutoUnjail, ctx.EventManager().Events()[0].Type)
+
+	// tombstoned validators are never unjailed
+	app.StakingKeeper.Jail(ctx, consAddr)
+	app.SlashingKeeper.Tombstone(ctx, consAddr)
+	slashing.EndBlocker(ctx, app.SlashingKeeper)
+	require.True(t, app.StakingKeeper.Validator(ctx, addr).IsJailed())
+
+	_, err = h(ctx, types.NewMsgSetAutoUnjail(addr, false))
+	require.NoError(t, err)
+	require.False(t, app.SlashingKeeper.IsAutoUnjail(ctx, addr))
+}
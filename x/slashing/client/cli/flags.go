@@ -2,4 +2,6 @@ package cli
 
 const (
 	FlagAddressValidator = "validator"
+	FlagSortBy           = "sort-by"
+	FlagAscending        = "ascending"
 )
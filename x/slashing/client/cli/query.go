@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -27,6 +28,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQuerySigningInfo(),
 		GetCmdQueryParams(),
 		GetCmdQuerySigningInfos(),
+		GetCmdQueryLivenessStatus(),
+		GetCmdQueryValidatorScores(),
 	)
 
 	return slashingQueryCmd
@@ -71,6 +74,50 @@ $ <appd> query slashing signing-info '{"@type":"/cosmos.crypto.ed25519.PubKey","
 	return cmd
 }
 
+// GetCmdQueryLivenessStatus implements the command to query the liveness status
+// of a validator.
+func GetCmdQueryLivenessStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liveness-status [validator-conspub|validator-consaddr]",
+		Short: "Query a validator's liveness over the current signed blocks window",
+		Long: strings.TrimSpace(`Use a validators' consensus public key or address to find its uptime, missed
+blocks streak and number of further missed blocks getting it jailed:
+
+$ <appd> query slashing liveness-status '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"OauFcTKbN5Lx3fJL689cikXBqe+hcp6Y+x0rYUdR9Jk="}'
+$ <appd> query slashing liveness-status cosmosvalcons1...
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			consAddr, err := sdk.ConsAddressFromBech32(args[0])
+			if err != nil {
+				var pk cryptotypes.PubKey
+				if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pk); err != nil {
+					return err
+				}
+				consAddr = sdk.ConsAddress(pk.Address())
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			params := &types.QueryLivenessStatusRequest{ConsAddress: consAddr.String()}
+			res, err := queryClient.LivenessStatus(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.LivenessStatus)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQuerySigningInfos implements the command to query signing infos.
 func GetCmdQuerySigningInfos() *cobra.Command {
 	cmd := &cobra.Command{
@@ -109,6 +156,75 @@ $ <appd> query slashing signing-infos
 	return cmd
 }
 
+// GetCmdQueryValidatorScores implements the command to query the performance
+// scores of all validators.
+func GetCmdQueryValidatorScores() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-scores",
+		Short: "Query the performance scores of all validators, from the best to the worst",
+		Long: strings.TrimSpace(`Query the performance scores of all validators, made of their uptime,
+governance participation, commission stability and slash history:
+
+$ <appd> query slashing validator-scores
+$ <appd> query slashing validator-scores --sort-by=uptime --ascending
+`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sortBy, err := cmd.Flags().GetString(FlagSortBy)
+			if err != nil {
+				return err
+			}
+			sort, err := parseValidatorScoreSort(sortBy)
+			if err != nil {
+				return err
+			}
+
+			ascending, err := cmd.Flags().GetBool(FlagAscending)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryValidatorScoresRequest{SortBy: sort, Ascending: ascending, Pagination: pageReq}
+			res, err := queryClient.ValidatorScores(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagSortBy, "score", "Score component to sort by (score|uptime|governance-participation|commission-stability|slash-history)")
+	cmd.Flags().Bool(FlagAscending, false, "Sort from the lowest to the highest score")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validator scores")
+
+	return cmd
+}
+
+// parseValidatorScoreSort parses a score component, e.g. "uptime", into its
+// sort order.
+func parseValidatorScoreSort(sortBy string) (types.ValidatorScoreSort, error) {
+	name := "VALIDATOR_SCORE_SORT_" + strings.ToUpper(strings.ReplaceAll(sortBy, "-", "_"))
+	sort, ok := types.ValidatorScoreSort_value[name]
+	if !ok {
+		return 0, fmt.Errorf("invalid sort order %s", sortBy)
+	}
+
+	return types.ValidatorScoreSort(sort), nil
+}
+
 // GetCmdQueryParams implements a command to fetch slashing parameters.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
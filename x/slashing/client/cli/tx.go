@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -20,7 +22,10 @@ func NewTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	slashingTxCmd.AddCommand(NewUnjailTxCmd())
+	slashingTxCmd.AddCommand(
+		NewUnjailTxCmd(),
+		NewSetAutoUnjailTxCmd(),
+	)
 	return slashingTxCmd
 }
 
@@ -50,3 +55,36 @@ $ <appd> tx slashing unjail --from mykey
 
 	return cmd
 }
+
+func NewSetAutoUnjailTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auto-unjail [true|false]",
+		Args:  cobra.ExactArgs(1),
+		Short: "opt in or out of unjailing validator automatically after downtime",
+		Long: `opt in or out of unjailing a validator automatically once its downtime jail
+period elapsed, provided it is otherwise eligible to be unjailed:
+
+$ <appd> tx slashing auto-unjail true --from mykey
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			valAddr := clientCtx.GetFromAddress()
+
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoUnjail(sdk.ValAddress(valAddr), enabled)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
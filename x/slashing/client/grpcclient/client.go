@@ -0,0 +1,156 @@
+// Code generated by typedgen. DO NOT EDIT.
+
+// Package grpcclient implements the typed clients of the slashing module.
+package grpcclient
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/typed"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// QueryClient is the typed client of the slashing Query service, retrying
+// the queries failing with a transient error.
+type QueryClient struct {
+	client types.QueryClient
+	retry  typed.RetryConfig
+}
+
+// NewQueryClient returns a QueryClient querying through the connection.
+func NewQueryClient(conn gogogrpc.ClientConn, retry typed.RetryConfig) *QueryClient {
+	return &QueryClient{
+		client: types.NewQueryClient(conn),
+		retry:  retry,
+	}
+}
+
+// LivenessStatus calls the slashing Query/LivenessStatus method.
+func (c *QueryClient) LivenessStatus(ctx context.Context, req *types.QueryLivenessStatusRequest, opts ...grpc.CallOption) (*types.QueryLivenessStatusResponse, error) {
+	var res *types.QueryLivenessStatusResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.LivenessStatus(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// Params calls the slashing Query/Params method.
+func (c *QueryClient) Params(ctx context.Context, req *types.QueryParamsRequest, opts ...grpc.CallOption) (*types.QueryParamsResponse, error) {
+	var res *types.QueryParamsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Params(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// SigningInfo calls the slashing Query/SigningInfo method.
+func (c *QueryClient) SigningInfo(ctx context.Context, req *types.QuerySigningInfoRequest, opts ...grpc.CallOption) (*types.QuerySigningInfoResponse, error) {
+	var res *types.QuerySigningInfoResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.SigningInfo(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// SigningInfos calls the slashing Query/SigningInfos method.
+func (c *QueryClient) SigningInfos(ctx context.Context, req *types.QuerySigningInfosRequest, opts ...grpc.CallOption) (*types.QuerySigningInfosResponse, error) {
+	var res *types.QuerySigningInfosResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.SigningInfos(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateSigningInfos calls the slashing Query/SigningInfos method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateSigningInfos(ctx context.Context, req *types.QuerySigningInfosRequest, fn func(*types.QuerySigningInfosResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq types.QuerySigningInfosRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.SigningInfos(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// ValidatorScores calls the slashing Query/ValidatorScores method.
+func (c *QueryClient) ValidatorScores(ctx context.Context, req *types.QueryValidatorScoresRequest, opts ...grpc.CallOption) (*types.QueryValidatorScoresResponse, error) {
+	var res *types.QueryValidatorScoresResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.ValidatorScores(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateValidatorScores calls the slashing Query/ValidatorScores method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateValidatorScores(ctx context.Context, req *types.QueryValidatorScoresRequest, fn func(*types.QueryValidatorScoresResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq types.QueryValidatorScoresRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.ValidatorScores(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// MsgClient is the typed client of the slashing Msg service, broadcasting a
+// transaction of each message.
+type MsgClient struct {
+	broadcaster typed.Broadcaster
+}
+
+// NewMsgClient returns a MsgClient broadcasting the transactions with the
+// broadcaster.
+func NewMsgClient(broadcaster typed.Broadcaster) *MsgClient {
+	return &MsgClient{broadcaster: broadcaster}
+}
+
+// SetAutoUnjail broadcasts a transaction of the slashing Msg/SetAutoUnjail message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) SetAutoUnjail(ctx context.Context, msg *types.MsgSetAutoUnjail) (*types.MsgSetAutoUnjailResponse, *sdk.TxResponse, error) {
+	res := &types.MsgSetAutoUnjailResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
+
+// Unjail broadcasts a transaction of the slashing Msg/Unjail message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) Unjail(ctx context.Context, msg *types.MsgUnjail) (*types.MsgUnjailResponse, *sdk.TxResponse, error) {
+	res := &types.MsgUnjailResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
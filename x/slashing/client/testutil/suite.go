@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
 
 type IntegrationTestSuite struct {
@@ -102,6 +103,50 @@ tombstoned: false`, sdk.ConsAddress(val.PubKey.Address())),
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQueryValidatorScores() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{"invalid sort", []string{fmt.Sprintf("--%s=foo", cli.FlagSortBy)}, true},
+		{"default sort", []string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)}, false},
+		{
+			"uptime ascending",
+			[]string{
+				fmt.Sprintf("--%s=uptime", cli.FlagSortBy),
+				fmt.Sprintf("--%s", cli.FlagAscending),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryValidatorScores()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			var res types.QueryValidatorScoresResponse
+			s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+			s.Require().Len(res.Scores, 1)
+			s.Require().Equal(val.ValAddress.String(), res.Scores[0].OperatorAddress)
+			s.Require().Equal(sdk.ConsAddress(val.PubKey.Address()).String(), res.Scores[0].ConsAddress)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 	val := s.network.Validators[0]
 
@@ -140,6 +185,58 @@ slash_fraction_downtime: "0.010000000000000000"`,
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewSetAutoUnjailTxCmd() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+		respType     proto.Message
+	}{
+		{
+			"invalid flag value",
+			[]string{
+				"foo",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			},
+			true, 0, nil,
+		},
+		{
+			"valid transaction",
+			[]string{
+				"true",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, 0, &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewSetAutoUnjailTxCmd()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewUnjailTxCmd() {
 	val := s.network.Validators[0]
 	testCases := []struct {
@@ -39,6 +39,30 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, stakingKeeper types.Stak
 		}
 	}
 
+	for _, perf := range data.ValidatorPerformances {
+		address, err := sdk.ConsAddressFromBech32(perf.Address)
+		if err != nil {
+			panic(err)
+		}
+		keeper.SetValidatorPerformance(ctx, address, perf)
+	}
+
+	for _, vote := range data.ProposalVotes {
+		address, err := sdk.ConsAddressFromBech32(vote.Address)
+		if err != nil {
+			panic(err)
+		}
+		keeper.SetValidatorProposalVote(ctx, vote.ProposalId, address)
+	}
+
+	for _, bechAddr := range data.AutoUnjailValidators {
+		valAddr, err := sdk.ValAddressFromBech32(bechAddr)
+		if err != nil {
+			panic(err)
+		}
+		keeper.SetAutoUnjail(ctx, valAddr, true)
+	}
+
 	keeper.SetParams(ctx, data.Params)
 }
 
@@ -66,5 +90,26 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data *types.GenesisSt
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	performances := make([]types.ValidatorPerformance, 0)
+	keeper.IterateValidatorPerformances(ctx, func(_ sdk.ConsAddress, perf types.ValidatorPerformance) (stop bool) {
+		performances = append(performances, perf)
+		return false
+	})
+
+	proposalVotes := make([]types.ValidatorProposalVote, 0)
+	keeper.IterateValidatorProposalVotes(ctx, func(proposalID uint64, address sdk.ConsAddress) (stop bool) {
+		proposalVotes = append(proposalVotes, types.ValidatorProposalVote{
+			ProposalId: proposalID,
+			Address:    address.String(),
+		})
+		return false
+	})
+
+	autoUnjailValidators := make([]string, 0)
+	keeper.IterateAutoUnjailValidators(ctx, func(valAddr sdk.ValAddress) (stop bool) {
+		autoUnjailValidators = append(autoUnjailValidators, valAddr.String())
+		return false
+	})
+
+	return types.NewGenesisState(params, signingInfos, missedBlocks, performances, proposalVotes, autoUnjailValidators)
 }
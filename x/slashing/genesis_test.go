@@ -29,11 +29,20 @@ func TestExportAndInitGenesis(t *testing.T) {
 
 	app.SlashingKeeper.SetValidatorSigningInfo(ctx, sdk.ConsAddress(addrDels[0]), info1)
 	app.SlashingKeeper.SetValidatorSigningInfo(ctx, sdk.ConsAddress(addrDels[1]), info2)
+
+	perf := types.NewValidatorPerformance(sdk.ConsAddress(addrDels[0]), sdk.NewDecWithPrec(1, 1))
+	perf.SignedBlocks, perf.MissedBlocks = 10, 2
+	app.SlashingKeeper.SetValidatorPerformance(ctx, sdk.ConsAddress(addrDels[0]), perf)
+	app.SlashingKeeper.SetValidatorProposalVote(ctx, 3, sdk.ConsAddress(addrDels[1]))
+	app.SlashingKeeper.SetAutoUnjail(ctx, sdk.ValAddress(addrDels[0]), true)
 	genesisState := slashing.ExportGenesis(ctx, app.SlashingKeeper)
 
 	require.Equal(t, genesisState.Params, testslashing.TestParams())
 	require.Len(t, genesisState.SigningInfos, 2)
 	require.Equal(t, genesisState.SigningInfos[0].ValidatorSigningInfo, info1)
+	require.Equal(t, []types.ValidatorPerformance{perf}, genesisState.ValidatorPerformances)
+	require.Equal(t, []types.ValidatorProposalVote{{ProposalId: 3, Address: sdk.ConsAddress(addrDels[1]).String()}}, genesisState.ProposalVotes)
+	require.Equal(t, []string{sdk.ValAddress(addrDels[0]).String()}, genesisState.AutoUnjailValidators)
 
 	// Tombstone validators after genesis shouldn't effect genesis state
 	app.SlashingKeeper.Tombstone(ctx, sdk.ConsAddress(addrDels[0]))
@@ -44,6 +53,8 @@ func TestExportAndInitGenesis(t *testing.T) {
 
 	newInfo1, ok := app.SlashingKeeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(addrDels[0]))
 	require.NotEqual(t, info1, newInfo1)
+	app.SlashingKeeper.SetAutoUnjail(ctx, sdk.ValAddress(addrDels[0]), false)
+
 	// Initialise genesis with genesis state before tombstone
 	slashing.InitGenesis(ctx, app.SlashingKeeper, app.StakingKeeper, genesisState)
 
@@ -56,4 +67,52 @@ func TestExportAndInitGenesis(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, info1, newInfo1)
 	require.Equal(t, info2, newInfo2)
+
+	newPerf, ok := app.SlashingKeeper.GetValidatorPerformance(ctx, sdk.ConsAddress(addrDels[0]))
+	require.True(t, ok)
+	require.Equal(t, perf, newPerf)
+	require.True(t, app.SlashingKeeper.IsAutoUnjail(ctx, sdk.ValAddress(addrDels[0])))
+}
+
+func TestValidateGenesisPerformances(t *testing.T) {
+	perf := types.NewValidatorPerformance(sdk.ConsAddress("addr1_______________"), sdk.ZeroDec())
+
+	testCases := []struct {
+		name     string
+		malleate func(*types.GenesisState)
+		expErr   bool
+	}{
+		{"valid", func(gs *types.GenesisState) {}, false},
+		{"duplicate", func(gs *types.GenesisState) {
+			gs.ValidatorPerformances = append(gs.ValidatorPerformances, perf)
+		}, true},
+		{"negative counter", func(gs *types.GenesisState) {
+			gs.ValidatorPerformances[0].MissedBlocks = -1
+		}, true},
+		{"more voted than eligible", func(gs *types.GenesisState) {
+			gs.ValidatorPerformances[0].VotedProposals = 1
+		}, true},
+		{"invalid vote address", func(gs *types.GenesisState) {
+			gs.ProposalVotes = []types.ValidatorProposalVote{{ProposalId: 1, Address: "invalid"}}
+		}, true},
+		{"invalid auto unjail validator", func(gs *types.GenesisState) {
+			gs.AutoUnjailValidators = []string{"invalid"}
+		}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.DefaultGenesisState()
+			gs.ValidatorPerformances = []types.ValidatorPerformance{perf}
+			tc.malleate(gs)
+
+			err := types.ValidateGenesis(*gs)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
 }
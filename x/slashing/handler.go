@@ -19,6 +19,10 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.Unjail(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgSetAutoUnjail:
+			res, err := msgServer.SetAutoUnjail(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// SetAutoUnjail sets whether a validator is unjailed automatically once its
+// downtime jail period elapsed.
+func (k Keeper) SetAutoUnjail(ctx sdk.Context, valAddr sdk.ValAddress, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	if enabled {
+		store.Set(types.AutoUnjailKey(valAddr), []byte{})
+	} else {
+		store.Delete(types.AutoUnjailKey(valAddr))
+	}
+}
+
+// IsAutoUnjail returns whether a validator is unjailed automatically.
+func (k Keeper) IsAutoUnjail(ctx sdk.Context, valAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.AutoUnjailKey(valAddr))
+}
+
+// IterateAutoUnjailValidators iterates over the validators unjailed
+// automatically.
+func (k Keeper) IterateAutoUnjailValidators(ctx sdk.Context, handler func(valAddr sdk.ValAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AutoUnjailKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// Remove prefix and address length.
+		if handler(sdk.ValAddress(iter.Key()[2:])) {
+			break
+		}
+	}
+}
+
+// AutoUnjail unjails the jailed validators opted in auto unjail which are
+// eligible to be unjailed, i.e. whose downtime jail period elapsed and which
+// are neither tombstoned nor below their minimum self-delegation.
+func (k Keeper) AutoUnjail(ctx sdk.Context) {
+	var jailed []sdk.ValAddress
+	k.IterateAutoUnjailValidators(ctx, func(valAddr sdk.ValAddress) bool {
+		if validator := k.sk.Validator(ctx, valAddr); validator != nil && validator.IsJailed() {
+			jailed = append(jailed, valAddr)
+		}
+		return false
+	})
+
+	for _, valAddr := range jailed {
+		if err := k.Unjail(ctx, valAddr); err != nil {
+			continue
+		}
+
+		k.Logger(ctx).Info("validator unjailed automatically", "validator", valAddr.String())
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAutoUnjail,
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			),
+		)
+	}
+}
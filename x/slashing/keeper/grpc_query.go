@@ -48,6 +48,31 @@ func (k Keeper) SigningInfo(c context.Context, req *types.QuerySigningInfoReques
 	return &types.QuerySigningInfoResponse{ValSigningInfo: signingInfo}, nil
 }
 
+// LivenessStatus returns the liveness status of a validator over the current
+// signed blocks window.
+func (k Keeper) LivenessStatus(c context.Context, req *types.QueryLivenessStatusRequest) (*types.QueryLivenessStatusResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	livenessStatus, found := k.GetLivenessStatus(ctx, consAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", req.ConsAddress)
+	}
+
+	return &types.QueryLivenessStatusResponse{LivenessStatus: livenessStatus}, nil
+}
+
 func (k Keeper) SigningInfos(c context.Context, req *types.QuerySigningInfosRequest) (*types.QuerySigningInfosResponse, error) {
 	if req == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "empty request")
@@ -72,3 +97,48 @@ func (k Keeper) SigningInfos(c context.Context, req *types.QuerySigningInfosRequ
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+// ValidatorScores returns the sorted performance scores of all validators,
+// paginated by offset.
+func (k Keeper) ValidatorScores(c context.Context, req *types.QueryValidatorScoresRequest) (*types.QueryValidatorScoresResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	var (
+		offset, limit uint64
+		countTotal    bool
+	)
+	if req.Pagination != nil {
+		if len(req.Pagination.Key) > 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "page keys are not supported, use an offset")
+		}
+		offset, limit, countTotal = req.Pagination.Offset, req.Pagination.Limit, req.Pagination.CountTotal
+	}
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = true
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	scores, err := k.GetValidatorScores(ctx, req.SortBy, req.Ascending)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pageRes := &query.PageResponse{}
+	if countTotal {
+		pageRes.Total = uint64(len(scores))
+	}
+
+	total := uint64(len(scores))
+	if offset > total {
+		offset = total
+	}
+	end := offset + limit
+	if end > total {
+		end = total
+	}
+
+	return &types.QueryValidatorScoresResponse{Scores: scores[offset:end], Pagination: pageRes}, nil
+}
@@ -16,6 +16,8 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/testslashing"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 )
 
 type SlashingTestSuite struct {
@@ -105,6 +107,83 @@ func (suite *SlashingTestSuite) TestGRPCSigningInfos() {
 	suite.Equal(uint64(2), infoResp.Pagination.Total)
 }
 
+func (suite *SlashingTestSuite) TestGRPCLivenessStatus() {
+	queryClient, keeper, ctx := suite.queryClient, suite.app.SlashingKeeper, suite.ctx
+
+	_, err := queryClient.LivenessStatus(gocontext.Background(), &types.QueryLivenessStatusRequest{ConsAddress: ""})
+	suite.Error(err)
+
+	consAddr := sdk.ConsAddress(suite.addrDels[0])
+
+	// 5 blocks in the window, the last 2 missed
+	keeper.SetValidatorSigningInfo(ctx, consAddr, types.NewValidatorSigningInfo(consAddr, 0, 5, time.Unix(0, 0), false, 2))
+	keeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 3, true)
+	keeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 4, true)
+
+	res, err := queryClient.LivenessStatus(gocontext.Background(), &types.QueryLivenessStatusRequest{ConsAddress: consAddr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.LivenessStatus{
+		Address:               consAddr.String(),
+		BlocksInWindow:        5,
+		MissedBlocksCounter:   2,
+		Uptime:                sdk.NewDecWithPrec(6, 1),
+		MissedBlocksStreak:    2,
+		MaxMissedBlocks:       500,
+		MissedBlocksUntilJail: 499,
+	}, res.LivenessStatus)
+
+	// the streak wraps around the start of the bit array
+	keeper.SetValidatorSigningInfo(ctx, consAddr, types.NewValidatorSigningInfo(consAddr, 0, 1002, time.Unix(0, 0), false, 501))
+	keeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 0, true)
+	keeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 1, true)
+	keeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 999, true)
+
+	status, found := keeper.GetLivenessStatus(ctx, consAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(1000), status.BlocksInWindow)
+	suite.Require().Equal(int64(3), status.MissedBlocksStreak)
+	suite.Require().Equal(int64(0), status.MissedBlocksUntilJail)
+	suite.Require().Equal(sdk.NewDecWithPrec(499, 3), status.Uptime)
+}
+
+func (suite *SlashingTestSuite) TestGRPCValidatorScores() {
+	queryClient, app, ctx := suite.queryClient, suite.app, suite.ctx
+
+	valAddrs := simapp.ConvertAddrsToValAddrs(suite.addrDels)
+	pks := simapp.CreateTestPubKeys(2)
+	tstaking := teststaking.NewHelper(suite.T(), ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], pks[0], 100, true)
+	tstaking.CreateValidatorWithValPower(valAddrs[1], pks[1], 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// the first validator misses a block
+	app.SlashingKeeper.HandleValidatorSignature(ctx, pks[0].Address(), 100, false)
+	app.SlashingKeeper.HandleValidatorSignature(ctx, pks[1].Address(), 100, true)
+
+	_, err := queryClient.ValidatorScores(gocontext.Background(),
+		&types.QueryValidatorScoresRequest{Pagination: &query.PageRequest{Key: []byte("key")}})
+	suite.Require().Error(err)
+
+	res, err := queryClient.ValidatorScores(gocontext.Background(), &types.QueryValidatorScoresRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Scores, 2)
+	suite.Require().Equal(valAddrs[1].String(), res.Scores[0].OperatorAddress)
+	suite.Require().Equal(sdk.OneDec(), res.Scores[0].Score)
+	suite.Require().Equal(valAddrs[0].String(), res.Scores[1].OperatorAddress)
+	suite.Require().Equal(sdk.NewDecWithPrec(6, 1), res.Scores[1].Score)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	res, err = queryClient.ValidatorScores(gocontext.Background(), &types.QueryValidatorScoresRequest{
+		SortBy:     types.ValidatorScoreSort_VALIDATOR_SCORE_SORT_UPTIME,
+		Ascending:  true,
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Scores, 1)
+	suite.Require().Equal(valAddrs[1].String(), res.Scores[0].OperatorAddress)
+	suite.Require().Equal(uint64(0), res.Pagination.Total)
+}
+
 func TestSlashingTestSuite(t *testing.T) {
 	suite.Run(t, new(SlashingTestSuite))
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
 
@@ -25,7 +26,8 @@ func (k Keeper) AfterValidatorBonded(ctx sdk.Context, address sdk.ConsAddress, _
 	}
 }
 
-// AfterValidatorCreated adds the address-pubkey relation when a validator is created.
+// AfterValidatorCreated adds the address-pubkey relation and the performance
+// when a validator is created.
 func (k Keeper) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) error {
 	validator := k.sk.Validator(ctx, valAddr)
 	consPk, err := validator.ConsPubKey()
@@ -34,12 +36,47 @@ func (k Keeper) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) e
 	}
 	k.AddPubkey(ctx, consPk)
 
+	consAddr := sdk.ConsAddress(consPk.Address())
+	k.SetValidatorPerformance(ctx, consAddr, types.NewValidatorPerformance(consAddr, validator.GetCommission()))
+
 	return nil
 }
 
-// AfterValidatorRemoved deletes the address-pubkey relation when a validator is removed,
+// AfterValidatorRemoved deletes the address-pubkey relation and the performance
+// when a validator is removed,
 func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, address sdk.ConsAddress) {
 	k.deleteAddrPubkeyRelation(ctx, crypto.Address(address))
+	k.deleteValidatorPerformance(ctx, address)
+}
+
+// BeforeValidatorModified records the commission change of the previous update
+// of the validator, if any, before it is modified.
+func (k Keeper) BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) {
+	validator := k.sk.Validator(ctx, valAddr)
+	k.updateValidatorPerformance(ctx, validator, func(perf *types.ValidatorPerformance) {
+		syncCommission(perf, validator)
+	})
+}
+
+// BeforeValidatorSlashed records the slash of a validator.
+func (k Keeper) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress) {
+	k.updateValidatorPerformance(ctx, k.sk.Validator(ctx, valAddr), func(perf *types.ValidatorPerformance) {
+		perf.Slashes++
+	})
+}
+
+// AfterProposalVote records the vote of a validator on a proposal.
+func (k Keeper) AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
+	validator := k.sk.Validator(ctx, sdk.ValAddress(voterAddr))
+	if validator == nil {
+		return
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return
+	}
+	k.SetValidatorProposalVote(ctx, proposalID, consAddr)
 }
 
 // Hooks wrapper struct for slashing keeper
@@ -47,7 +84,10 @@ type Hooks struct {
 	k Keeper
 }
 
-var _ types.StakingHooks = Hooks{}
+var (
+	_ types.StakingHooks = Hooks{}
+	_ govtypes.GovHooks  = Hooks{}
+)
 
 // Return the wrapper struct
 func (k Keeper) Hooks() Hooks {
@@ -60,8 +100,9 @@ func (h Hooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, v
 }
 
 // Implements sdk.ValidatorHooks
-func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, _ sdk.ValAddress) {
+func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
 	h.k.AfterValidatorRemoved(ctx, consAddr)
+	h.k.SetAutoUnjail(ctx, valAddr, false)
 }
 
 // Implements sdk.ValidatorHooks
@@ -69,10 +110,31 @@ func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) {
 	h.k.AfterValidatorCreated(ctx, valAddr)
 }
 
+// Implements sdk.ValidatorHooks
+func (h Hooks) BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) {
+	h.k.BeforeValidatorModified(ctx, valAddr)
+}
+
+// Implements sdk.ValidatorHooks
+func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, _ sdk.Dec) {
+	h.k.BeforeValidatorSlashed(ctx, valAddr)
+}
+
+// Implements govtypes.GovHooks
+func (h Hooks) AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
+	h.k.AfterProposalVote(ctx, proposalID, voterAddr)
+}
+
+// Implements govtypes.GovHooks
+func (h Hooks) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64) {
+	h.k.tallyProposalParticipation(ctx, proposalID)
+}
+
 func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress)  {}
-func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress)                          {}
 func (h Hooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
 func (h Hooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) {}
 func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
 func (h Hooks) AfterDelegationModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
-func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec)                {}
+func (h Hooks) AfterProposalSubmission(_ sdk.Context, _ uint64)                                  {}
+func (h Hooks) AfterProposalDeposit(_ sdk.Context, _ uint64, _ sdk.AccAddress)                   {}
+func (h Hooks) AfterProposalFailedMinDeposit(_ sdk.Context, _ uint64)                            {}
@@ -48,6 +48,8 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 		// Array value at this index has not changed, no need to update counter
 	}
 
+	k.recordSignature(ctx, consAddr, signed)
+
 	minSignedPerWindow := k.MinSignedPerWindow(ctx)
 
 	if missed {
@@ -17,6 +17,9 @@ type Keeper struct {
 	cdc        codec.BinaryCodec
 	sk         types.StakingKeeper
 	paramspace types.ParamSubspace
+
+	// validators whose liveness is reported as metrics, see EmitLivenessMetrics
+	livenessMetrics []sdk.ConsAddress
 }
 
 // NewKeeper creates a slashing keeper
@@ -34,6 +37,14 @@ func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, sk types.StakingKeeper,
 	}
 }
 
+// WithLivenessMetrics returns a copy of the keeper reporting the liveness of the
+// given validators as metrics on every block, typically the validators operated
+// with the node.
+func (k Keeper) WithLivenessMetrics(consAddrs ...sdk.ConsAddress) Keeper {
+	k.livenessMetrics = consAddrs
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
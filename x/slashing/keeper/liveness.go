@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// GetLivenessStatus returns the liveness status of a validator over the current
+// signed blocks window, or false if the validator has no signing info.
+func (k Keeper) GetLivenessStatus(ctx sdk.Context, consAddr sdk.ConsAddress) (types.LivenessStatus, bool) {
+	signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		return types.LivenessStatus{}, false
+	}
+
+	window := k.SignedBlocksWindow(ctx)
+	blocksInWindow := signInfo.IndexOffset
+	if blocksInWindow > window {
+		blocksInWindow = window
+	}
+
+	uptime := sdk.OneDec()
+	if blocksInWindow > 0 {
+		uptime = sdk.NewDec(blocksInWindow - signInfo.MissedBlocksCounter).QuoInt64(blocksInWindow)
+	}
+
+	// walk the bit array back from the latest block until a signed block
+	var streak int64
+	for ; streak < blocksInWindow; streak++ {
+		index := (signInfo.IndexOffset - 1 - streak) % window
+		if !k.GetValidatorMissedBlockBitArray(ctx, consAddr, index) {
+			break
+		}
+	}
+
+	maxMissed := window - k.MinSignedPerWindow(ctx)
+	untilJail := maxMissed - signInfo.MissedBlocksCounter + 1
+	if untilJail < 0 {
+		untilJail = 0
+	}
+
+	validator := k.sk.ValidatorByConsAddr(ctx, consAddr)
+
+	return types.LivenessStatus{
+		Address:               consAddr.String(),
+		BlocksInWindow:        blocksInWindow,
+		MissedBlocksCounter:   signInfo.MissedBlocksCounter,
+		Uptime:                uptime,
+		MissedBlocksStreak:    streak,
+		MaxMissedBlocks:       maxMissed,
+		MissedBlocksUntilJail: untilJail,
+		Jailed:                validator != nil && validator.IsJailed(),
+		Tombstoned:            signInfo.Tombstoned,
+	}, true
+}
+
+// EmitLivenessMetrics reports the liveness status of the validators set with
+// WithLivenessMetrics as gauges labeled with their consensus address.
+func (k Keeper) EmitLivenessMetrics(ctx sdk.Context) {
+	for _, consAddr := range k.livenessMetrics {
+		status, found := k.GetLivenessStatus(ctx, consAddr)
+		if !found {
+			continue
+		}
+
+		labels := []metrics.Label{telemetry.NewLabel(types.MetricLabelValidator, status.Address)}
+		uptime, _ := status.Uptime.Float64()
+
+		telemetry.SetGaugeWithLabels([]string{types.ModuleName, types.MetricKeyUptime}, float32(uptime), labels)
+		telemetry.SetGaugeWithLabels([]string{types.ModuleName, types.MetricKeyMissedBlocks}, float32(status.MissedBlocksCounter), labels)
+		telemetry.SetGaugeWithLabels([]string{types.ModuleName, types.MetricKeyMissedBlocksStreak}, float32(status.MissedBlocksStreak), labels)
+		telemetry.SetGaugeWithLabels([]string{types.ModuleName, types.MetricKeyMissedBlocksUntilJail}, float32(status.MissedBlocksUntilJail), labels)
+	}
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
@@ -44,3 +45,35 @@ func (k msgServer) Unjail(goCtx context.Context, msg *types.MsgUnjail) (*types.M
 
 	return &types.MsgUnjailResponse{}, nil
 }
+
+// SetAutoUnjail implements MsgServer.SetAutoUnjail method.
+// Validators opt in or out of being unjailed automatically in EndBlock once
+// their downtime jail period elapsed.
+func (k msgServer) SetAutoUnjail(goCtx context.Context, msg *types.MsgSetAutoUnjail) (*types.MsgSetAutoUnjailResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, valErr := sdk.ValAddressFromBech32(msg.ValidatorAddr)
+	if valErr != nil {
+		return nil, valErr
+	}
+	if k.sk.Validator(ctx, valAddr) == nil {
+		return nil, types.ErrNoValidatorForAddress
+	}
+
+	k.Keeper.SetAutoUnjail(ctx, valAddr, msg.Enabled)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAutoUnjail,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddr),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(msg.Enabled)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ValidatorAddr),
+		),
+	})
+
+	return &types.MsgSetAutoUnjailResponse{}, nil
+}
@@ -0,0 +1,205 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetValidatorPerformance returns the ValidatorPerformance for a specific
+// validator ConsAddress
+func (k Keeper) GetValidatorPerformance(ctx sdk.Context, address sdk.ConsAddress) (perf types.ValidatorPerformance, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ValidatorPerformanceKey(address))
+	if bz == nil {
+		return perf, false
+	}
+	k.cdc.MustUnmarshal(bz, &perf)
+	return perf, true
+}
+
+// SetValidatorPerformance sets the validator performance to a consensus address key
+func (k Keeper) SetValidatorPerformance(ctx sdk.Context, address sdk.ConsAddress, perf types.ValidatorPerformance) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&perf)
+	store.Set(types.ValidatorPerformanceKey(address), bz)
+}
+
+// deleteValidatorPerformance deletes the performance of a removed validator
+func (k Keeper) deleteValidatorPerformance(ctx sdk.Context, address sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ValidatorPerformanceKey(address))
+}
+
+// IterateValidatorPerformances iterates over the stored ValidatorPerformance
+func (k Keeper) IterateValidatorPerformances(ctx sdk.Context,
+	handler func(address sdk.ConsAddress, perf types.ValidatorPerformance) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorPerformanceKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// Remove prefix and address length.
+		address := sdk.ConsAddress(iter.Key()[2:])
+		var perf types.ValidatorPerformance
+		k.cdc.MustUnmarshal(iter.Value(), &perf)
+		if handler(address, perf) {
+			break
+		}
+	}
+}
+
+// getOrInitValidatorPerformance returns the performance of a validator, or a
+// new one if the validator has none yet, e.g. when created before the
+// performance was tracked.
+func (k Keeper) getOrInitValidatorPerformance(ctx sdk.Context, validator stakingtypes.ValidatorI) (sdk.ConsAddress, types.ValidatorPerformance, error) {
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, types.ValidatorPerformance{}, err
+	}
+
+	perf, found := k.GetValidatorPerformance(ctx, consAddr)
+	if !found {
+		perf = types.NewValidatorPerformance(consAddr, validator.GetCommission())
+	}
+
+	return consAddr, perf, nil
+}
+
+// updateValidatorPerformance applies the update to the performance of a
+// validator, if it exists.
+func (k Keeper) updateValidatorPerformance(ctx sdk.Context, validator stakingtypes.ValidatorI, update func(*types.ValidatorPerformance)) {
+	if validator == nil {
+		return
+	}
+
+	consAddr, perf, err := k.getOrInitValidatorPerformance(ctx, validator)
+	if err != nil {
+		return
+	}
+
+	update(&perf)
+	k.SetValidatorPerformance(ctx, consAddr, perf)
+}
+
+// recordSignature counts a signed or missed block in the performance of a
+// validator.
+func (k Keeper) recordSignature(ctx sdk.Context, consAddr sdk.ConsAddress, signed bool) {
+	perf, found := k.GetValidatorPerformance(ctx, consAddr)
+	if !found {
+		validator := k.sk.ValidatorByConsAddr(ctx, consAddr)
+		if validator == nil {
+			return
+		}
+		perf = types.NewValidatorPerformance(consAddr, validator.GetCommission())
+	}
+
+	if signed {
+		perf.SignedBlocks++
+	} else {
+		perf.MissedBlocks++
+	}
+	k.SetValidatorPerformance(ctx, consAddr, perf)
+}
+
+// syncCommission counts a commission change if the commission rate of the
+// validator differs from the last one recorded in its performance.
+func syncCommission(perf *types.ValidatorPerformance, validator stakingtypes.ValidatorI) {
+	if rate := validator.GetCommission(); !rate.Equal(perf.CommissionRate) {
+		perf.CommissionChanges++
+		perf.CommissionRate = rate
+	}
+}
+
+// SetValidatorProposalVote records that a validator voted on a proposal in its
+// voting period.
+func (k Keeper) SetValidatorProposalVote(ctx sdk.Context, proposalID uint64, consAddr sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ValidatorProposalVoteKey(proposalID, consAddr), []byte{})
+}
+
+// IterateValidatorProposalVotes iterates over the validator votes on the
+// proposals in their voting period.
+func (k Keeper) IterateValidatorProposalVotes(ctx sdk.Context,
+	handler func(proposalID uint64, consAddr sdk.ConsAddress) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorProposalVoteKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if handler(types.SplitValidatorProposalVoteKey(iter.Key())) {
+			break
+		}
+	}
+}
+
+// tallyProposalParticipation updates the governance participation of the
+// bonded validators once the voting period of a proposal ended, and deletes
+// the votes recorded for the proposal.
+func (k Keeper) tallyProposalParticipation(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	k.sk.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingtypes.ValidatorI) bool {
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return false
+		}
+
+		voted := store.Has(types.ValidatorProposalVoteKey(proposalID, consAddr))
+		k.updateValidatorPerformance(ctx, validator, func(perf *types.ValidatorPerformance) {
+			perf.EligibleProposals++
+			if voted {
+				perf.VotedProposals++
+			}
+		})
+		return false
+	})
+
+	var keys [][]byte
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorProposalVotePrefixKey(proposalID))
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetValidatorScores returns the scores of all validators, sorted by the given
+// score component from the highest to the lowest, or the other way around if
+// ascending. Validators with equal values are sorted by operator address.
+func (k Keeper) GetValidatorScores(ctx sdk.Context, sortBy types.ValidatorScoreSort, ascending bool) ([]types.ValidatorScore, error) {
+	var (
+		scores []types.ValidatorScore
+		err    error
+	)
+	k.sk.IterateValidators(ctx, func(_ int64, validator stakingtypes.ValidatorI) bool {
+		var perf types.ValidatorPerformance
+		if _, perf, err = k.getOrInitValidatorPerformance(ctx, validator); err != nil {
+			return true
+		}
+
+		// commission changes are only recorded on the next validator update
+		syncCommission(&perf, validator)
+
+		scores = append(scores, types.NewValidatorScore(validator.GetOperator(), validator.GetMoniker(), perf))
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(scores, func(i, j int) bool {
+		a, b := scores[i].SortValue(sortBy), scores[j].SortValue(sortBy)
+		if !a.Equal(b) {
+			return a.GT(b) != ascending
+		}
+		return scores[i].OperatorAddress < scores[j].OperatorAddress
+	})
+
+	return scores, nil
+}
@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+)
+
+func TestValidatorPerformance(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(2)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(valAddrs[0], pks[0], 100, true)
+	tstaking.CreateValidatorWithValPower(valAddrs[1], pks[1], 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	consAddr1, consAddr2 := sdk.ConsAddress(pks[0].Address()), sdk.ConsAddress(pks[1].Address())
+	perf, found := app.SlashingKeeper.GetValidatorPerformance(ctx, consAddr1)
+	require.True(t, found)
+	require.Equal(t, types.NewValidatorPerformance(consAddr1, sdk.ZeroDec()), perf)
+
+	// the first validator signs all blocks, the second one misses 2 out of 4
+	for i, signed := range []bool{true, false, true, false} {
+		ctx = ctx.WithBlockHeight(int64(i + 1))
+		app.SlashingKeeper.HandleValidatorSignature(ctx, pks[0].Address(), 100, true)
+		app.SlashingKeeper.HandleValidatorSignature(ctx, pks[1].Address(), 100, signed)
+	}
+
+	// only the first validator votes on the proposal
+	hooks := app.SlashingKeeper.Hooks()
+	hooks.AfterProposalVote(ctx, 1, sdk.AccAddress(valAddrs[0]))
+	hooks.AfterProposalVote(ctx, 1, addrDels[0])
+	hooks.AfterProposalVotingPeriodEnded(ctx, 1)
+
+	// the second validator changes its commission twice and gets slashed
+	for _, rate := range []sdk.Dec{sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1)} {
+		validator, _ := app.StakingKeeper.GetValidator(ctx, valAddrs[1])
+		hooks.BeforeValidatorModified(ctx, valAddrs[1])
+		validator.Commission.Rate = rate
+		app.StakingKeeper.SetValidator(ctx, validator)
+	}
+	app.StakingKeeper.Slash(ctx, consAddr2, ctx.BlockHeight(), 100, sdk.NewDecWithPrec(1, 2))
+
+	perf, _ = app.SlashingKeeper.GetValidatorPerformance(ctx, consAddr1)
+	require.Equal(t, types.ValidatorPerformance{
+		Address:           consAddr1.String(),
+		SignedBlocks:      4,
+		EligibleProposals: 1,
+		VotedProposals:    1,
+		CommissionRate:    sdk.ZeroDec(),
+	}, perf)
+
+	perf, _ = app.SlashingKeeper.GetValidatorPerformance(ctx, consAddr2)
+	require.Equal(t, types.ValidatorPerformance{
+		Address:           consAddr2.String(),
+		SignedBlocks:      2,
+		MissedBlocks:      2,
+		EligibleProposals: 1,
+		CommissionChanges: 2,
+		CommissionRate:    sdk.NewDecWithPrec(2, 1),
+		Slashes:           1,
+	}, perf)
+
+	// the votes of ended proposals are deleted
+	app.SlashingKeeper.IterateValidatorProposalVotes(ctx, func(uint64, sdk.ConsAddress) bool {
+		t.Fatal("unexpected proposal vote")
+		return true
+	})
+
+	scores, err := app.SlashingKeeper.GetValidatorScores(ctx, types.ValidatorScoreSort_VALIDATOR_SCORE_SORT_UNSPECIFIED, false)
+	require.NoError(t, err)
+	require.Len(t, scores, 2)
+
+	// 0.4 * 1 + 0.2 * 1 + 0.2 * 1 + 0.2 * 1
+	require.Equal(t, valAddrs[0].String(), scores[0].OperatorAddress)
+	require.Equal(t, sdk.OneDec(), scores[0].Score)
+
+	// 0.4 * 0.5 + 0.2 * 0 + 0.2 * 1/3 + 0.2 * 1/2
+	last := scores[1]
+	require.Equal(t, valAddrs[1].String(), last.OperatorAddress)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), last.Uptime)
+	require.Equal(t, sdk.ZeroDec(), last.GovernanceParticipation)
+	require.Equal(t, sdk.OneDec().QuoInt64(3), last.CommissionStability)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), last.SlashHistory)
+	require.Equal(t, sdk.MustNewDecFromStr("0.366666666666666667"), last.Score)
+
+	scores, err = app.SlashingKeeper.GetValidatorScores(ctx, types.ValidatorScoreSort_VALIDATOR_SCORE_SORT_UPTIME, true)
+	require.NoError(t, err)
+	require.Equal(t, valAddrs[1].String(), scores[0].OperatorAddress)
+
+	// removed validators have no performance
+	hooks.AfterValidatorRemoved(ctx, consAddr2, valAddrs[1])
+	_, found = app.SlashingKeeper.GetValidatorPerformance(ctx, consAddr2)
+	require.False(t, found)
+}
@@ -69,6 +69,7 @@ func TestMigrate(t *testing.T) {
 	// cosmosvalcons10e4c5p6qk0sycy9u6u43t7csmlx9fyadr9yxph
 	// (in alphabetic order, basically).
 	expected := `{
+  "auto_unjail_validators": [],
   "missed_blocks": [
     {
       "address": "cosmosvalcons104cjmxkrg8y8lmrp25de02e4zf00zle4mzs685",
@@ -100,6 +101,7 @@ func TestMigrate(t *testing.T) {
     "slash_fraction_double_sign": "0.050000000000000000",
     "slash_fraction_downtime": "0.010000000000000000"
   },
+  "proposal_votes": [],
   "signing_infos": [
     {
       "address": "cosmosvalcons104cjmxkrg8y8lmrp25de02e4zf00zle4mzs685",
@@ -123,7 +125,8 @@ func TestMigrate(t *testing.T) {
         "tombstoned": false
       }
     }
-  ]
+  ],
+  "validator_performances": []
 }`
 
 	bz, err := clientCtx.Codec.MarshalJSON(migrated)
@@ -19,18 +19,27 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/slashing/client/rest"
 	"github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	"github.com/cosmos/cosmos-sdk/x/slashing/simulation"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 var (
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
+
+	_ module.BlockerOrderAppModule = AppModule{}
+)
+
+// Module init related flags
+const (
+	FlagLivenessMetrics = "x-slashing-liveness-metrics"
 )
 
 // AppModuleBasic defines the basic application module used by the slashing module.
@@ -91,6 +100,11 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 	return cli.GetQueryCmd()
 }
 
+// AddModuleInitFlags implements servertypes.ModuleInitFlags interface.
+func AddModuleInitFlags(startCmd *cobra.Command) {
+	startCmd.Flags().StringSlice(FlagLivenessMetrics, nil, "Consensus addresses of the validators whose x/slashing liveness is reported as metrics, e.g. the validator operated with the node")
+}
+
 // AppModule implements an application module for the slashing module.
 type AppModule struct {
 	AppModuleBasic
@@ -170,10 +184,23 @@ func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 
 // EndBlock returns the end blocker for the slashing module. It returns no validator
 // updates.
-func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	EndBlocker(ctx, am.keeper)
 	return []abci.ValidatorUpdate{}
 }
 
+// BlockerOrderConstraints implements module.BlockerOrderAppModule. The
+// slashing begin blocker runs after the distribution one so that there is
+// nothing left over in the validator fee pool, so as to keep the
+// CanWithdrawInvariant invariant, and its end blocker auto unjails validators
+// before staking updates the validator set.
+func (AppModule) BlockerOrderConstraints() module.BlockerOrderConstraints {
+	return module.BlockerOrderConstraints{
+		BeginBlockAfter: []string{distrtypes.ModuleName},
+		EndBlockBefore:  []string{stakingtypes.ModuleName},
+	}
+}
+
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the slashing module.
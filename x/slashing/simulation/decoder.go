@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
@@ -39,6 +40,20 @@ func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
 			}
 			return fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", pubKeyA, pubKeyB)
 
+		case bytes.Equal(kvA.Key[:1], types.ValidatorPerformanceKeyPrefix):
+			var perfA, perfB types.ValidatorPerformance
+			cdc.MustUnmarshal(kvA.Value, &perfA)
+			cdc.MustUnmarshal(kvB.Value, &perfB)
+			return fmt.Sprintf("%v\n%v", perfA, perfB)
+
+		case bytes.Equal(kvA.Key[:1], types.ValidatorProposalVoteKeyPrefix):
+			proposalIDA, addrA := types.SplitValidatorProposalVoteKey(kvA.Key)
+			proposalIDB, addrB := types.SplitValidatorProposalVoteKey(kvB.Key)
+			return fmt.Sprintf("proposalA: %d %s\nproposalB: %d %s", proposalIDA, addrA, proposalIDB, addrB)
+
+		case bytes.Equal(kvA.Key[:1], types.AutoUnjailKeyPrefix):
+			return fmt.Sprintf("validatorA: %s\nvalidatorB: %s", sdk.ValAddress(kvA.Key[2:]), sdk.ValAddress(kvB.Key[2:]))
+
 		default:
 			panic(fmt.Sprintf("invalid slashing key prefix %X", kvA.Key[:1]))
 		}
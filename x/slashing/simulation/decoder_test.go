@@ -30,6 +30,7 @@ func TestDecodeStore(t *testing.T) {
 
 	info := types.NewValidatorSigningInfo(consAddr1, 0, 1, time.Now().UTC(), false, 0)
 	missed := gogotypes.BoolValue{Value: true}
+	perf := types.NewValidatorPerformance(consAddr1, sdk.NewDecWithPrec(1, 1))
 	bz, err := cdc.MarshalInterface(delPk1)
 	require.NoError(t, err)
 
@@ -38,6 +39,9 @@ func TestDecodeStore(t *testing.T) {
 			{Key: types.ValidatorSigningInfoKey(consAddr1), Value: cdc.MustMarshal(&info)},
 			{Key: types.ValidatorMissedBlockBitArrayKey(consAddr1, 6), Value: cdc.MustMarshal(&missed)},
 			{Key: types.AddrPubkeyRelationKey(delAddr1), Value: bz},
+			{Key: types.ValidatorPerformanceKey(consAddr1), Value: cdc.MustMarshal(&perf)},
+			{Key: types.ValidatorProposalVoteKey(1, consAddr1), Value: []byte{}},
+			{Key: types.AutoUnjailKey(valAddr1), Value: []byte{}},
 			{Key: []byte{0x99}, Value: []byte{0x99}}, // This test should panic
 		},
 	}
@@ -50,6 +54,9 @@ func TestDecodeStore(t *testing.T) {
 		{"ValidatorSigningInfo", fmt.Sprintf("%v\n%v", info, info), false},
 		{"ValidatorMissedBlockBitArray", fmt.Sprintf("missedA: %v\nmissedB: %v", missed.Value, missed.Value), false},
 		{"AddrPubkeyRelation", fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", delPk1, delPk1), false},
+		{"ValidatorPerformance", fmt.Sprintf("%v\n%v", perf, perf), false},
+		{"ValidatorProposalVote", fmt.Sprintf("proposalA: 1 %s\nproposalB: 1 %s", consAddr1, consAddr1), false},
+		{"AutoUnjail", fmt.Sprintf("validatorA: %s\nvalidatorB: %s", valAddr1, valAddr1), false},
 		{"other", "", true},
 	}
 	for i, tt := range tests {
@@ -2,8 +2,9 @@ package types
 
 // Slashing module event types
 const (
-	EventTypeSlash    = "slash"
-	EventTypeLiveness = "liveness"
+	EventTypeSlash      = "slash"
+	EventTypeLiveness   = "liveness"
+	EventTypeAutoUnjail = "auto_unjail"
 
 	AttributeKeyAddress      = "address"
 	AttributeKeyHeight       = "height"
@@ -11,6 +12,8 @@ const (
 	AttributeKeyReason       = "reason"
 	AttributeKeyJailed       = "jailed"
 	AttributeKeyMissedBlocks = "missed_blocks"
+	AttributeKeyValidator    = "validator"
+	AttributeKeyEnabled      = "enabled"
 
 	AttributeValueDoubleSign       = "double_sign"
 	AttributeValueMissingSignature = "missing_signature"
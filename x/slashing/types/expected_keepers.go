@@ -38,6 +38,10 @@ type StakingKeeper interface {
 	IterateValidators(sdk.Context,
 		func(index int64, validator stakingtypes.ValidatorI) (stop bool))
 
+	// iterate through bonded validators by power, execute func for each validator
+	IterateBondedValidatorsByPower(sdk.Context,
+		func(index int64, validator stakingtypes.ValidatorI) (stop bool))
+
 	Validator(sdk.Context, sdk.ValAddress) stakingtypes.ValidatorI            // get a particular validator by operator address
 	ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingtypes.ValidatorI // get a particular validator by consensus address
 
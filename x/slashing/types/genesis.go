@@ -10,12 +10,17 @@ import (
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(
 	params Params, signingInfos []SigningInfo, missedBlocks []ValidatorMissedBlocks,
+	performances []ValidatorPerformance, proposalVotes []ValidatorProposalVote,
+	autoUnjailValidators []string,
 ) *GenesisState {
 
 	return &GenesisState{
-		Params:       params,
-		SigningInfos: signingInfos,
-		MissedBlocks: missedBlocks,
+		Params:                params,
+		SigningInfos:          signingInfos,
+		MissedBlocks:          missedBlocks,
+		ValidatorPerformances: performances,
+		ProposalVotes:         proposalVotes,
+		AutoUnjailValidators:  autoUnjailValidators,
 	}
 }
 
@@ -30,9 +35,12 @@ func NewMissedBlock(index int64, missed bool) MissedBlock {
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:       DefaultParams(),
-		SigningInfos: []SigningInfo{},
-		MissedBlocks: []ValidatorMissedBlocks{},
+		Params:                DefaultParams(),
+		SigningInfos:          []SigningInfo{},
+		MissedBlocks:          []ValidatorMissedBlocks{},
+		ValidatorPerformances: []ValidatorPerformance{},
+		ProposalVotes:         []ValidatorProposalVote{},
+		AutoUnjailValidators:  []string{},
 	}
 }
 
@@ -63,5 +71,29 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	performances := make(map[string]bool, len(data.ValidatorPerformances))
+	for _, perf := range data.ValidatorPerformances {
+		if performances[perf.Address] {
+			return fmt.Errorf("duplicate validator performance for %s", perf.Address)
+		}
+		performances[perf.Address] = true
+
+		if err := perf.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, vote := range data.ProposalVotes {
+		if _, err := sdk.ConsAddressFromBech32(vote.Address); err != nil {
+			return fmt.Errorf("invalid proposal vote of %s: %w", vote.Address, err)
+		}
+	}
+
+	for _, valAddr := range data.AutoUnjailValidators {
+		if _, err := sdk.ValAddressFromBech32(valAddr); err != nil {
+			return fmt.Errorf("invalid auto unjail validator %s: %w", valAddr, err)
+		}
+	}
+
 	return nil
 }
@@ -33,6 +33,15 @@ type GenesisState struct {
 	// missed_blocks represents a map between validator addresses and their
 	// missed blocks.
 	MissedBlocks []ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks" yaml:"missed_blocks"`
+	// validator_performances represents the performance records of the
+	// validators.
+	ValidatorPerformances []ValidatorPerformance `protobuf:"bytes,4,rep,name=validator_performances,json=validatorPerformances,proto3" json:"validator_performances" yaml:"validator_performances"`
+	// proposal_votes represents the votes of validators on proposals still in
+	// their voting period.
+	ProposalVotes []ValidatorProposalVote `protobuf:"bytes,5,rep,name=proposal_votes,json=proposalVotes,proto3" json:"proposal_votes" yaml:"proposal_votes"`
+	// auto_unjail_validators represents the operator addresses of the validators
+	// unjailed automatically once their downtime jail period elapsed.
+	AutoUnjailValidators []string `protobuf:"bytes,6,rep,name=auto_unjail_validators,json=autoUnjailValidators,proto3" json:"auto_unjail_validators,omitempty" yaml:"auto_unjail_validators"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -89,6 +98,27 @@ func (m *GenesisState) GetMissedBlocks() []ValidatorMissedBlocks {
 	return nil
 }
 
+func (m *GenesisState) GetValidatorPerformances() []ValidatorPerformance {
+	if m != nil {
+		return m.ValidatorPerformances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProposalVotes() []ValidatorProposalVote {
+	if m != nil {
+		return m.ProposalVotes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoUnjailValidators() []string {
+	if m != nil {
+		return m.AutoUnjailValidators
+	}
+	return nil
+}
+
 // SigningInfo stores validator signing info of corresponding address.
 type SigningInfo struct {
 	// address is the validator address.
@@ -255,11 +285,67 @@ func (m *MissedBlock) GetMissed() bool {
 	return false
 }
 
+// ValidatorProposalVote records that a validator voted on a proposal still in
+// its voting period.
+type ValidatorProposalVote struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
+	// address is the consensus address of the validator.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *ValidatorProposalVote) Reset()         { *m = ValidatorProposalVote{} }
+func (m *ValidatorProposalVote) String() string { return proto.CompactTextString(m) }
+func (*ValidatorProposalVote) ProtoMessage()    {}
+func (*ValidatorProposalVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1923b9188b635394, []int{4}
+}
+func (m *ValidatorProposalVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorProposalVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorProposalVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorProposalVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorProposalVote.Merge(m, src)
+}
+func (m *ValidatorProposalVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorProposalVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorProposalVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorProposalVote proto.InternalMessageInfo
+
+func (m *ValidatorProposalVote) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ValidatorProposalVote) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.slashing.v1beta1.GenesisState")
 	proto.RegisterType((*SigningInfo)(nil), "cosmos.slashing.v1beta1.SigningInfo")
 	proto.RegisterType((*ValidatorMissedBlocks)(nil), "cosmos.slashing.v1beta1.ValidatorMissedBlocks")
 	proto.RegisterType((*MissedBlock)(nil), "cosmos.slashing.v1beta1.MissedBlock")
+	proto.RegisterType((*ValidatorProposalVote)(nil), "cosmos.slashing.v1beta1.ValidatorProposalVote")
 }
 
 func init() {
@@ -267,34 +353,43 @@ func init() {
 }
 
 var fileDescriptor_1923b9188b635394 = []byte{
-	// 424 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3d, 0x8e, 0xd3, 0x40,
-	0x14, 0xf6, 0x24, 0x10, 0x60, 0x9c, 0x6d, 0x46, 0x66, 0xb1, 0x56, 0xe0, 0xac, 0x2c, 0x16, 0x6d,
-	0x13, 0x5b, 0xbb, 0x74, 0x20, 0x1a, 0x37, 0x2b, 0x0a, 0x24, 0xe4, 0x95, 0x28, 0x68, 0xa2, 0x71,
-	0x3c, 0x3b, 0x19, 0xc5, 0xf6, 0x04, 0x3f, 0x13, 0x25, 0x57, 0xa0, 0xa2, 0xe6, 0x06, 0xf4, 0x1c,
-	0x22, 0x65, 0x4a, 0xaa, 0x08, 0x25, 0x37, 0xe0, 0x04, 0x28, 0x33, 0x0e, 0x71, 0xa2, 0x98, 0x88,
-	0xca, 0x7e, 0xd2, 0xf7, 0xf7, 0xbe, 0xd1, 0xc3, 0x17, 0x7d, 0x09, 0xa9, 0x04, 0x1f, 0x12, 0x0a,
-	0x03, 0x91, 0x71, 0x7f, 0x7c, 0x15, 0xb1, 0x82, 0x5e, 0xf9, 0x9c, 0x65, 0x0c, 0x04, 0x78, 0xa3,
-	0x5c, 0x16, 0x92, 0x3c, 0xd1, 0x30, 0x6f, 0x03, 0xf3, 0x4a, 0xd8, 0x99, 0xc5, 0x25, 0x97, 0x0a,
-	0xe3, 0xaf, 0xff, 0x34, 0xfc, 0xec, 0x45, 0x9d, 0xea, 0x5f, 0xbe, 0xc2, 0xb9, 0xdf, 0x1b, 0xb8,
-	0x7d, 0xa3, 0x8d, 0x6e, 0x0b, 0x5a, 0x30, 0xf2, 0x06, 0xb7, 0x46, 0x34, 0xa7, 0x29, 0xd8, 0xe8,
-	0x1c, 0x5d, 0x9a, 0xd7, 0x1d, 0xaf, 0xc6, 0xd8, 0x7b, 0xaf, 0x60, 0xc1, 0xbd, 0xd9, 0xa2, 0x63,
-	0x84, 0x25, 0x89, 0x70, 0x7c, 0x02, 0x82, 0x67, 0x22, 0xe3, 0x3d, 0x91, 0xdd, 0x49, 0xb0, 0x1b,
-	0xe7, 0xcd, 0x4b, 0xf3, 0xfa, 0x79, 0xad, 0xca, 0xad, 0x46, 0xbf, 0xcd, 0xee, 0x64, 0xf0, 0x74,
-	0x2d, 0xf5, 0x7b, 0xd1, 0xb1, 0xa6, 0x34, 0x4d, 0x5e, 0xb9, 0x3b, 0x42, 0x6e, 0xd8, 0x86, 0x2d,
-	0x14, 0xc8, 0x27, 0x7c, 0x92, 0x0a, 0x00, 0x16, 0xf7, 0xa2, 0x44, 0xf6, 0x87, 0x60, 0x37, 0x95,
-	0x91, 0x57, 0x6b, 0xf4, 0x81, 0x26, 0x22, 0xa6, 0x85, 0xcc, 0xdf, 0x29, 0x5a, 0xa0, 0x58, 0xfb,
-	0x96, 0x3b, 0x92, 0x6e, 0xd8, 0x4e, 0x2b, 0x58, 0xf7, 0x07, 0xc2, 0x66, 0x25, 0x2e, 0xb1, 0xf1,
-	0x03, 0x1a, 0xc7, 0x39, 0x03, 0xdd, 0xd5, 0xa3, 0x70, 0x33, 0x92, 0x2f, 0x08, 0x9f, 0x8e, 0x37,
-	0x7e, 0xbd, 0xea, 0x1e, 0x76, 0x43, 0xb5, 0xda, 0x3d, 0x1e, 0xb3, 0x5a, 0xcc, 0x45, 0x99, 0xf2,
-	0x99, 0x4e, 0x79, 0x58, 0xda, 0x0d, 0xad, 0xf1, 0x01, 0xb2, 0xfb, 0x0d, 0xe1, 0xc7, 0x07, 0x97,
-	0xff, 0xc7, 0x02, 0x7c, 0xbf, 0xdd, 0x63, 0xcf, 0x58, 0xd1, 0xfd, 0xaf, 0x4e, 0x5f, 0x63, 0xb3,
-	0x42, 0x25, 0x16, 0xbe, 0x2f, 0xb2, 0x98, 0x4d, 0x54, 0x9e, 0x66, 0xa8, 0x07, 0x72, 0x8a, 0x5b,
-	0x9a, 0xa4, 0xda, 0x7b, 0x18, 0x96, 0x53, 0x70, 0x33, 0x5b, 0x3a, 0x68, 0xbe, 0x74, 0xd0, 0xaf,
-	0xa5, 0x83, 0xbe, 0xae, 0x1c, 0x63, 0xbe, 0x72, 0x8c, 0x9f, 0x2b, 0xc7, 0xf8, 0xd8, 0xe5, 0xa2,
-	0x18, 0x7c, 0x8e, 0xbc, 0xbe, 0x4c, 0xfd, 0xf2, 0x12, 0xf4, 0xa7, 0x0b, 0xf1, 0xd0, 0x9f, 0x6c,
-	0xcf, 0xa2, 0x98, 0x8e, 0x18, 0x44, 0x2d, 0x75, 0x0c, 0x2f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0x03, 0x3c, 0xa8, 0xf6, 0x8c, 0x03, 0x00, 0x00,
+	// 570 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x3f, 0x6f, 0xda, 0x4c,
+	0x18, 0xc7, 0x21, 0xe1, 0x7d, 0x73, 0x40, 0x87, 0x13, 0x50, 0x2b, 0x6a, 0x0c, 0x3d, 0x35, 0x15,
+	0x0b, 0xb6, 0x92, 0x0e, 0x95, 0x5a, 0x75, 0xf1, 0x12, 0x65, 0xa8, 0x84, 0x1c, 0x95, 0x4a, 0x5d,
+	0xac, 0x03, 0x1f, 0xce, 0x25, 0xb6, 0xcf, 0xf5, 0x63, 0x50, 0xf2, 0x05, 0x3a, 0xb4, 0x4b, 0xe7,
+	0x7e, 0x8e, 0x7e, 0x88, 0x8c, 0x19, 0x3b, 0xa1, 0x0a, 0xbe, 0x41, 0x3e, 0x41, 0xc5, 0xd9, 0x80,
+	0x41, 0xa6, 0x49, 0x27, 0xfb, 0x39, 0xff, 0xfe, 0x3c, 0xf7, 0xdc, 0xcf, 0x87, 0x8e, 0x06, 0x02,
+	0x7c, 0x01, 0x06, 0x78, 0x14, 0x2e, 0x78, 0xe0, 0x1a, 0xe3, 0xe3, 0x3e, 0x8b, 0xe9, 0xb1, 0xe1,
+	0xb2, 0x80, 0x01, 0x07, 0x3d, 0x8c, 0x44, 0x2c, 0xf0, 0xd3, 0x04, 0xa6, 0x2f, 0x60, 0x7a, 0x0a,
+	0x3b, 0xa8, 0xb9, 0xc2, 0x15, 0x12, 0x63, 0xcc, 0xdf, 0x12, 0xf8, 0xc1, 0xcb, 0x6d, 0xaa, 0x4b,
+	0xbe, 0xc4, 0x91, 0x2f, 0x7b, 0xa8, 0x72, 0x9a, 0x18, 0x9d, 0xc7, 0x34, 0x66, 0xf8, 0x1d, 0x2a,
+	0x85, 0x34, 0xa2, 0x3e, 0xa8, 0x4a, 0x4b, 0x69, 0x97, 0x4f, 0x9a, 0xfa, 0x16, 0x63, 0xbd, 0x2b,
+	0x61, 0xe6, 0xee, 0xed, 0xa4, 0x59, 0xb0, 0x52, 0x12, 0x76, 0x51, 0x15, 0xb8, 0x1b, 0xf0, 0xc0,
+	0xb5, 0x79, 0x30, 0x14, 0xa0, 0xee, 0xb4, 0x8a, 0xed, 0xf2, 0xc9, 0x8b, 0xad, 0x2a, 0xe7, 0x09,
+	0xfa, 0x2c, 0x18, 0x0a, 0xf3, 0xd9, 0x5c, 0xea, 0x7e, 0xd2, 0xac, 0xdd, 0x50, 0xdf, 0x7b, 0x43,
+	0xd6, 0x84, 0x88, 0x55, 0x81, 0x15, 0x14, 0xf0, 0x67, 0x54, 0xf5, 0x39, 0x00, 0x73, 0xec, 0xbe,
+	0x27, 0x06, 0x57, 0xa0, 0x16, 0xa5, 0x91, 0xbe, 0xd5, 0xa8, 0x47, 0x3d, 0xee, 0xd0, 0x58, 0x44,
+	0xef, 0x25, 0xcd, 0x94, 0xac, 0x4d, 0xcb, 0x35, 0x49, 0x62, 0x55, 0xfc, 0x0c, 0x16, 0x7f, 0x53,
+	0x50, 0x63, 0xbc, 0x50, 0xb1, 0x43, 0x16, 0x0d, 0x45, 0xe4, 0xd3, 0x60, 0xc0, 0x40, 0xdd, 0x95,
+	0xe6, 0x9d, 0x87, 0xcd, 0xbb, 0x2b, 0x96, 0x79, 0x94, 0x7a, 0x1f, 0x26, 0xde, 0xf9, 0xd2, 0xc4,
+	0xaa, 0x8f, 0x73, 0xc8, 0x80, 0x63, 0xf4, 0x24, 0x8c, 0x44, 0x28, 0x80, 0x7a, 0xf6, 0x58, 0xc4,
+	0x0c, 0xd4, 0xbd, 0xc7, 0x4e, 0xa0, 0x9b, 0xf2, 0x7a, 0x22, 0x66, 0xe6, 0x61, 0xda, 0x45, 0x3d,
+	0xe9, 0x62, 0x5d, 0x93, 0x58, 0xd5, 0x30, 0x03, 0x06, 0xfc, 0x11, 0x35, 0xe8, 0x28, 0x16, 0xf6,
+	0x28, 0xb8, 0xa4, 0xdc, 0xb3, 0x97, 0xad, 0x81, 0x5a, 0x6a, 0x15, 0xdb, 0xfb, 0xe6, 0xf3, 0xd5,
+	0x7e, 0xf2, 0x71, 0xc4, 0xaa, 0xcd, 0x3f, 0x7c, 0x90, 0xeb, 0xbd, 0xd5, 0xf2, 0x4f, 0x05, 0x95,
+	0x33, 0x59, 0xc0, 0x2a, 0xfa, 0x8f, 0x3a, 0x4e, 0xc4, 0x20, 0x09, 0xe2, 0xbe, 0xb5, 0x28, 0xf1,
+	0xd7, 0xb5, 0x63, 0xc8, 0x86, 0x44, 0xdd, 0x69, 0x29, 0x8f, 0x3b, 0x86, 0x6c, 0xea, 0xb6, 0x1e,
+	0x43, 0x56, 0x9a, 0x58, 0xb5, 0x71, 0x0e, 0x99, 0xfc, 0x50, 0x50, 0x3d, 0x37, 0x59, 0x7f, 0xd9,
+	0x80, 0xbb, 0x19, 0xdd, 0x87, 0xfe, 0x91, 0x8c, 0xee, 0xbf, 0x04, 0x96, 0xbc, 0x45, 0xe5, 0x0c,
+	0x15, 0xd7, 0xd0, 0x1e, 0x0f, 0x1c, 0x76, 0x2d, 0xfb, 0x29, 0x5a, 0x49, 0x81, 0x1b, 0xa8, 0x94,
+	0x90, 0xe4, 0xf4, 0xfe, 0xb7, 0xd2, 0x8a, 0x5c, 0xa2, 0x7a, 0x6e, 0x60, 0xf0, 0x6b, 0x54, 0x5e,
+	0x86, 0x84, 0x3b, 0x52, 0x6c, 0xd7, 0x6c, 0xdc, 0x4f, 0x9a, 0x78, 0x23, 0x41, 0xdc, 0x21, 0x16,
+	0x5a, 0x54, 0x67, 0x4e, 0x76, 0x22, 0x3b, 0x6b, 0x13, 0x31, 0x4f, 0x6f, 0xa7, 0x9a, 0x72, 0x37,
+	0xd5, 0x94, 0xdf, 0x53, 0x4d, 0xf9, 0x3e, 0xd3, 0x0a, 0x77, 0x33, 0xad, 0xf0, 0x6b, 0xa6, 0x15,
+	0x3e, 0x75, 0x5c, 0x1e, 0x5f, 0x8c, 0xfa, 0xfa, 0x40, 0xf8, 0x46, 0x7a, 0xa5, 0x25, 0x8f, 0x0e,
+	0x38, 0x57, 0xc6, 0xf5, 0xea, 0x7e, 0x8b, 0x6f, 0x42, 0x06, 0xfd, 0x92, 0xbc, 0xd5, 0x5e, 0xfd,
+	0x19, 0x00, 0x5d, 0x66, 0x2e, 0x6d, 0x55, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -317,6 +412,43 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoUnjailValidators) > 0 {
+		for iNdEx := len(m.AutoUnjailValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoUnjailValidators[iNdEx])
+			copy(dAtA[i:], m.AutoUnjailValidators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoUnjailValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ProposalVotes) > 0 {
+		for iNdEx := len(m.ProposalVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposalVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ValidatorPerformances) > 0 {
+		for iNdEx := len(m.ValidatorPerformances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorPerformances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.MissedBlocks) > 0 {
 		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -480,6 +612,41 @@ func (m *MissedBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorProposalVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorProposalVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorProposalVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -511,6 +678,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ValidatorPerformances) > 0 {
+		for _, e := range m.ValidatorPerformances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProposalVotes) > 0 {
+		for _, e := range m.ProposalVotes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoUnjailValidators) > 0 {
+		for _, s := range m.AutoUnjailValidators {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -563,6 +748,22 @@ func (m *MissedBlock) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorProposalVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGenesis(uint64(m.ProposalId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -699,6 +900,106 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPerformances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorPerformances = append(m.ValidatorPerformances, ValidatorPerformance{})
+			if err := m.ValidatorPerformances[len(m.ValidatorPerformances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalVotes = append(m.ProposalVotes, ValidatorProposalVote{})
+			if err := m.ProposalVotes[len(m.ProposalVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoUnjailValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoUnjailValidators = append(m.AutoUnjailValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1040,6 +1341,107 @@ func (m *MissedBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorProposalVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorProposalVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorProposalVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
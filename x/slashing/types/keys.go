@@ -29,10 +29,19 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><period_Bytes>: bool
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<consAddrLen (1 Byte)><consAddress_Bytes>: ValidatorPerformance
+//
+// - 0x05<proposalID_Bytes><consAddrLen (1 Byte)><consAddress_Bytes>: []byte{}
+//
+// - 0x06<valAddrLen (1 Byte)><valAddress_Bytes>: []byte{}
 var (
 	ValidatorSigningInfoKeyPrefix         = []byte{0x01} // Prefix for signing info
 	ValidatorMissedBlockBitArrayKeyPrefix = []byte{0x02} // Prefix for missed block bit array
 	AddrPubkeyRelationKeyPrefix           = []byte{0x03} // Prefix for address-pubkey relation
+	ValidatorPerformanceKeyPrefix         = []byte{0x04} // Prefix for validator performance
+	ValidatorProposalVoteKeyPrefix        = []byte{0x05} // Prefix for validator votes on proposals in voting period
+	AutoUnjailKeyPrefix                   = []byte{0x06} // Prefix for validators opted in auto unjail
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -65,3 +74,32 @@ func ValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
 func AddrPubkeyRelationKey(addr []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address.MustLengthPrefix(addr)...)
 }
+
+// ValidatorPerformanceKey - stored by *Consensus* address (not operator address)
+func ValidatorPerformanceKey(v sdk.ConsAddress) []byte {
+	return append(ValidatorPerformanceKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// ValidatorProposalVotePrefixKey gets the prefix of the validator votes on a proposal
+func ValidatorProposalVotePrefixKey(proposalID uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, proposalID)
+
+	return append(ValidatorProposalVoteKeyPrefix, b...)
+}
+
+// ValidatorProposalVoteKey - stored by proposal ID and *Consensus* address
+func ValidatorProposalVoteKey(proposalID uint64, v sdk.ConsAddress) []byte {
+	return append(ValidatorProposalVotePrefixKey(proposalID), address.MustLengthPrefix(v.Bytes())...)
+}
+
+// SplitValidatorProposalVoteKey - extract the proposal ID and address from a validator proposal vote key
+func SplitValidatorProposalVoteKey(key []byte) (uint64, sdk.ConsAddress) {
+	// Remove prefix, proposal ID and address length.
+	return binary.BigEndian.Uint64(key[1:9]), sdk.ConsAddress(key[10:])
+}
+
+// AutoUnjailKey - stored by *Operator* address
+func AutoUnjailKey(v sdk.ValAddress) []byte {
+	return append(AutoUnjailKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
@@ -0,0 +1,12 @@
+package types
+
+// Liveness metric keys, prefixed with the module name, and label, see
+// Keeper.EmitLivenessMetrics.
+const (
+	MetricKeyUptime                = "liveness_uptime"
+	MetricKeyMissedBlocks          = "liveness_missed_blocks"
+	MetricKeyMissedBlocksStreak    = "liveness_missed_blocks_streak"
+	MetricKeyMissedBlocksUntilJail = "liveness_missed_blocks_until_jail"
+
+	MetricLabelValidator = "validator"
+)
@@ -6,11 +6,15 @@ import (
 
 // slashing message types
 const (
-	TypeMsgUnjail = "unjail"
+	TypeMsgUnjail        = "unjail"
+	TypeMsgSetAutoUnjail = "set_auto_unjail"
 )
 
 // verify interface at compile time
-var _ sdk.Msg = &MsgUnjail{}
+var (
+	_ sdk.Msg = &MsgUnjail{}
+	_ sdk.Msg = &MsgSetAutoUnjail{}
+)
 
 // NewMsgUnjail creates a new MsgUnjail instance
 //nolint:interfacer
@@ -44,3 +48,37 @@ func (msg MsgUnjail) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgSetAutoUnjail creates a new MsgSetAutoUnjail instance
+//nolint:interfacer
+func NewMsgSetAutoUnjail(validatorAddr sdk.ValAddress, enabled bool) *MsgSetAutoUnjail {
+	return &MsgSetAutoUnjail{
+		ValidatorAddr: validatorAddr.String(),
+		Enabled:       enabled,
+	}
+}
+
+func (msg MsgSetAutoUnjail) Route() string { return RouterKey }
+func (msg MsgSetAutoUnjail) Type() string  { return TypeMsgSetAutoUnjail }
+func (msg MsgSetAutoUnjail) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddr)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{valAddr.Bytes()}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgSetAutoUnjail) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgSetAutoUnjail) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddr); err != nil {
+		return ErrBadValidatorAddr
+	}
+
+	return nil
+}
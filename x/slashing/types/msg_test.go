@@ -18,3 +18,19 @@ func TestMsgUnjailGetSignBytes(t *testing.T) {
 		string(bytes),
 	)
 }
+
+func TestMsgSetAutoUnjailGetSignBytes(t *testing.T) {
+	addr := sdk.AccAddress("abcd")
+	msg := NewMsgSetAutoUnjail(sdk.ValAddress(addr), true)
+	bytes := msg.GetSignBytes()
+	require.Equal(
+		t,
+		`{"type":"cosmos-sdk/MsgSetAutoUnjail","value":{"address":"cosmosvaloper1v93xxeqhg9nn6","enabled":true}}`,
+		string(bytes),
+	)
+}
+
+func TestMsgSetAutoUnjailValidateBasic(t *testing.T) {
+	require.NoError(t, NewMsgSetAutoUnjail(sdk.ValAddress("abcd"), false).ValidateBasic())
+	require.Error(t, (&MsgSetAutoUnjail{ValidatorAddr: "invalid"}).ValidateBasic())
+}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Weights of the components of the validator score, summing up to 1.
+var (
+	UptimeScoreWeight                  = sdk.NewDecWithPrec(4, 1)
+	GovernanceParticipationScoreWeight = sdk.NewDecWithPrec(2, 1)
+	CommissionStabilityScoreWeight     = sdk.NewDecWithPrec(2, 1)
+	SlashHistoryScoreWeight            = sdk.NewDecWithPrec(2, 1)
+)
+
+// NewValidatorPerformance creates a new, empty, ValidatorPerformance instance
+func NewValidatorPerformance(consAddr sdk.ConsAddress, commissionRate sdk.Dec) ValidatorPerformance {
+	return ValidatorPerformance{
+		Address:        consAddr.String(),
+		CommissionRate: commissionRate,
+	}
+}
+
+// Validate performs a basic validation of the validator performance.
+func (p ValidatorPerformance) Validate() error {
+	if _, err := sdk.ConsAddressFromBech32(p.Address); err != nil {
+		return err
+	}
+
+	if p.SignedBlocks < 0 || p.MissedBlocks < 0 || p.EligibleProposals < 0 ||
+		p.VotedProposals < 0 || p.CommissionChanges < 0 || p.Slashes < 0 {
+		return fmt.Errorf("negative counter for %s", p.Address)
+	}
+
+	if p.VotedProposals > p.EligibleProposals {
+		return fmt.Errorf("more voted than eligible proposals for %s", p.Address)
+	}
+
+	if p.CommissionRate.IsNil() || p.CommissionRate.IsNegative() {
+		return fmt.Errorf("invalid commission rate for %s", p.Address)
+	}
+
+	return nil
+}
+
+// NewValidatorScore computes the score of a validator from its performance.
+//
+// The uptime is the ratio of the signed blocks and the participation the ratio
+// of the voted proposals, both 1 if empty, while the commission stability and
+// slash history are 1/(1+n) for n commission changes and slashes respectively.
+func NewValidatorScore(operator sdk.ValAddress, moniker string, perf ValidatorPerformance) ValidatorScore {
+	uptime := sdk.OneDec()
+	if blocks := perf.SignedBlocks + perf.MissedBlocks; blocks > 0 {
+		uptime = sdk.NewDec(perf.SignedBlocks).QuoInt64(blocks)
+	}
+
+	participation := sdk.OneDec()
+	if perf.EligibleProposals > 0 {
+		participation = sdk.NewDec(perf.VotedProposals).QuoInt64(perf.EligibleProposals)
+	}
+
+	commissionStability := sdk.OneDec().QuoInt64(1 + perf.CommissionChanges)
+	slashHistory := sdk.OneDec().QuoInt64(1 + perf.Slashes)
+
+	score := uptime.Mul(UptimeScoreWeight).
+		Add(participation.Mul(GovernanceParticipationScoreWeight)).
+		Add(commissionStability.Mul(CommissionStabilityScoreWeight)).
+		Add(slashHistory.Mul(SlashHistoryScoreWeight))
+
+	return ValidatorScore{
+		OperatorAddress:         operator.String(),
+		ConsAddress:             perf.Address,
+		Moniker:                 moniker,
+		Score:                   score,
+		Uptime:                  uptime,
+		GovernanceParticipation: participation,
+		CommissionStability:     commissionStability,
+		SlashHistory:            slashHistory,
+		Performance:             perf,
+	}
+}
+
+// SortValue returns the value of the score sorted by, the composite score by
+// default.
+func (s ValidatorScore) SortValue(sortBy ValidatorScoreSort) sdk.Dec {
+	switch sortBy {
+	case ValidatorScoreSort_VALIDATOR_SCORE_SORT_UPTIME:
+		return s.Uptime
+	case ValidatorScoreSort_VALIDATOR_SCORE_SORT_GOVERNANCE_PARTICIPATION:
+		return s.GovernanceParticipation
+	case ValidatorScoreSort_VALIDATOR_SCORE_SORT_COMMISSION_STABILITY:
+		return s.CommissionStability
+	case ValidatorScoreSort_VALIDATOR_SCORE_SORT_SLASH_HISTORY:
+		return s.SlashHistory
+	default:
+		return s.Score
+	}
+}
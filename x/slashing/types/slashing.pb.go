@@ -29,6 +29,50 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ValidatorScoreSort defines the ordering of validator scores.
+type ValidatorScoreSort int32
+
+const (
+	// VALIDATOR_SCORE_SORT_UNSPECIFIED sorts by composite score.
+	ValidatorScoreSort_VALIDATOR_SCORE_SORT_UNSPECIFIED ValidatorScoreSort = 0
+	// VALIDATOR_SCORE_SORT_SCORE sorts by composite score.
+	ValidatorScoreSort_VALIDATOR_SCORE_SORT_SCORE ValidatorScoreSort = 1
+	// VALIDATOR_SCORE_SORT_UPTIME sorts by uptime.
+	ValidatorScoreSort_VALIDATOR_SCORE_SORT_UPTIME ValidatorScoreSort = 2
+	// VALIDATOR_SCORE_SORT_GOVERNANCE_PARTICIPATION sorts by governance participation.
+	ValidatorScoreSort_VALIDATOR_SCORE_SORT_GOVERNANCE_PARTICIPATION ValidatorScoreSort = 3
+	// VALIDATOR_SCORE_SORT_COMMISSION_STABILITY sorts by commission stability.
+	ValidatorScoreSort_VALIDATOR_SCORE_SORT_COMMISSION_STABILITY ValidatorScoreSort = 4
+	// VALIDATOR_SCORE_SORT_SLASH_HISTORY sorts by slash history.
+	ValidatorScoreSort_VALIDATOR_SCORE_SORT_SLASH_HISTORY ValidatorScoreSort = 5
+)
+
+var ValidatorScoreSort_name = map[int32]string{
+	0: "VALIDATOR_SCORE_SORT_UNSPECIFIED",
+	1: "VALIDATOR_SCORE_SORT_SCORE",
+	2: "VALIDATOR_SCORE_SORT_UPTIME",
+	3: "VALIDATOR_SCORE_SORT_GOVERNANCE_PARTICIPATION",
+	4: "VALIDATOR_SCORE_SORT_COMMISSION_STABILITY",
+	5: "VALIDATOR_SCORE_SORT_SLASH_HISTORY",
+}
+
+var ValidatorScoreSort_value = map[string]int32{
+	"VALIDATOR_SCORE_SORT_UNSPECIFIED":              0,
+	"VALIDATOR_SCORE_SORT_SCORE":                    1,
+	"VALIDATOR_SCORE_SORT_UPTIME":                   2,
+	"VALIDATOR_SCORE_SORT_GOVERNANCE_PARTICIPATION": 3,
+	"VALIDATOR_SCORE_SORT_COMMISSION_STABILITY":     4,
+	"VALIDATOR_SCORE_SORT_SLASH_HISTORY":            5,
+}
+
+func (x ValidatorScoreSort) String() string {
+	return proto.EnumName(ValidatorScoreSort_name, int32(x))
+}
+
+func (ValidatorScoreSort) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{0}
+}
+
 // ValidatorSigningInfo defines a validator's signing info for monitoring their
 // liveness activity.
 type ValidatorSigningInfo struct {
@@ -123,6 +167,293 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+// LivenessStatus defines the liveness of a validator over the current signed
+// blocks window, for operators to monitor how close a validator is to being
+// jailed for downtime.
+type LivenessStatus struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Number of blocks the validator should have signed in the current window,
+	// up to the signed blocks window.
+	BlocksInWindow      int64 `protobuf:"varint,2,opt,name=blocks_in_window,json=blocksInWindow,proto3" json:"blocks_in_window,omitempty" yaml:"blocks_in_window"`
+	MissedBlocksCounter int64 `protobuf:"varint,3,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty" yaml:"missed_blocks_counter"`
+	// Ratio of the blocks signed over the blocks in the window, 1 if empty.
+	Uptime github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=uptime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"uptime"`
+	// Number of consecutive blocks missed up to the latest block.
+	MissedBlocksStreak int64 `protobuf:"varint,5,opt,name=missed_blocks_streak,json=missedBlocksStreak,proto3" json:"missed_blocks_streak,omitempty" yaml:"missed_blocks_streak"`
+	// Maximum number of missed blocks in the window before being jailed.
+	MaxMissedBlocks int64 `protobuf:"varint,6,opt,name=max_missed_blocks,json=maxMissedBlocks,proto3" json:"max_missed_blocks,omitempty" yaml:"max_missed_blocks"`
+	// Minimum number of further missed blocks getting the validator jailed.
+	MissedBlocksUntilJail int64 `protobuf:"varint,7,opt,name=missed_blocks_until_jail,json=missedBlocksUntilJail,proto3" json:"missed_blocks_until_jail,omitempty" yaml:"missed_blocks_until_jail"`
+	Jailed                bool  `protobuf:"varint,8,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	Tombstoned            bool  `protobuf:"varint,9,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
+}
+
+func (m *LivenessStatus) Reset()         { *m = LivenessStatus{} }
+func (m *LivenessStatus) String() string { return proto.CompactTextString(m) }
+func (*LivenessStatus) ProtoMessage()    {}
+func (*LivenessStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{1}
+}
+func (m *LivenessStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LivenessStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LivenessStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LivenessStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LivenessStatus.Merge(m, src)
+}
+func (m *LivenessStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *LivenessStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_LivenessStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LivenessStatus proto.InternalMessageInfo
+
+func (m *LivenessStatus) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *LivenessStatus) GetBlocksInWindow() int64 {
+	if m != nil {
+		return m.BlocksInWindow
+	}
+	return 0
+}
+
+func (m *LivenessStatus) GetMissedBlocksCounter() int64 {
+	if m != nil {
+		return m.MissedBlocksCounter
+	}
+	return 0
+}
+
+func (m *LivenessStatus) GetMissedBlocksStreak() int64 {
+	if m != nil {
+		return m.MissedBlocksStreak
+	}
+	return 0
+}
+
+func (m *LivenessStatus) GetMaxMissedBlocks() int64 {
+	if m != nil {
+		return m.MaxMissedBlocks
+	}
+	return 0
+}
+
+func (m *LivenessStatus) GetMissedBlocksUntilJail() int64 {
+	if m != nil {
+		return m.MissedBlocksUntilJail
+	}
+	return 0
+}
+
+func (m *LivenessStatus) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *LivenessStatus) GetTombstoned() bool {
+	if m != nil {
+		return m.Tombstoned
+	}
+	return false
+}
+
+// ValidatorPerformance defines the performance record of a validator, updated
+// incrementally as the validator signs blocks, votes on proposals, changes its
+// commission and gets slashed.
+type ValidatorPerformance struct {
+	// address is the consensus address of the validator.
+	Address      string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	SignedBlocks int64  `protobuf:"varint,2,opt,name=signed_blocks,json=signedBlocks,proto3" json:"signed_blocks,omitempty" yaml:"signed_blocks"`
+	MissedBlocks int64  `protobuf:"varint,3,opt,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks,omitempty" yaml:"missed_blocks"`
+	// Number of proposals which ended their voting period while the validator
+	// was bonded.
+	EligibleProposals int64 `protobuf:"varint,4,opt,name=eligible_proposals,json=eligibleProposals,proto3" json:"eligible_proposals,omitempty" yaml:"eligible_proposals"`
+	// Number of the eligible proposals the validator voted on.
+	VotedProposals    int64 `protobuf:"varint,5,opt,name=voted_proposals,json=votedProposals,proto3" json:"voted_proposals,omitempty" yaml:"voted_proposals"`
+	CommissionChanges int64 `protobuf:"varint,6,opt,name=commission_changes,json=commissionChanges,proto3" json:"commission_changes,omitempty" yaml:"commission_changes"`
+	// Last commission rate of the validator, used to detect commission changes.
+	CommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate" yaml:"commission_rate"`
+	Slashes        int64                                  `protobuf:"varint,8,opt,name=slashes,proto3" json:"slashes,omitempty"`
+}
+
+func (m *ValidatorPerformance) Reset()         { *m = ValidatorPerformance{} }
+func (m *ValidatorPerformance) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformance) ProtoMessage()    {}
+func (*ValidatorPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
+}
+func (m *ValidatorPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformance.Merge(m, src)
+}
+func (m *ValidatorPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPerformance proto.InternalMessageInfo
+
+func (m *ValidatorPerformance) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorPerformance) GetSignedBlocks() int64 {
+	if m != nil {
+		return m.SignedBlocks
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetMissedBlocks() int64 {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetEligibleProposals() int64 {
+	if m != nil {
+		return m.EligibleProposals
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetVotedProposals() int64 {
+	if m != nil {
+		return m.VotedProposals
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetCommissionChanges() int64 {
+	if m != nil {
+		return m.CommissionChanges
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetSlashes() int64 {
+	if m != nil {
+		return m.Slashes
+	}
+	return 0
+}
+
+// ValidatorScore defines the composite performance score of a validator, the
+// weighted sum of its uptime, governance participation, commission stability
+// and slash history components, each ranging from 0 to 1.
+type ValidatorScore struct {
+	OperatorAddress         string                                 `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty" yaml:"operator_address"`
+	ConsAddress             string                                 `protobuf:"bytes,2,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty" yaml:"cons_address"`
+	Moniker                 string                                 `protobuf:"bytes,3,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	Score                   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=score,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"score"`
+	Uptime                  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=uptime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"uptime"`
+	GovernanceParticipation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=governance_participation,json=governanceParticipation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"governance_participation" yaml:"governance_participation"`
+	CommissionStability     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=commission_stability,json=commissionStability,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_stability" yaml:"commission_stability"`
+	SlashHistory            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=slash_history,json=slashHistory,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_history" yaml:"slash_history"`
+	Performance             ValidatorPerformance                   `protobuf:"bytes,9,opt,name=performance,proto3" json:"performance"`
+}
+
+func (m *ValidatorScore) Reset()         { *m = ValidatorScore{} }
+func (m *ValidatorScore) String() string { return proto.CompactTextString(m) }
+func (*ValidatorScore) ProtoMessage()    {}
+func (*ValidatorScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{3}
+}
+func (m *ValidatorScore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorScore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorScore.Merge(m, src)
+}
+func (m *ValidatorScore) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorScore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorScore proto.InternalMessageInfo
+
+func (m *ValidatorScore) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorScore) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+func (m *ValidatorScore) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
+	}
+	return ""
+}
+
+func (m *ValidatorScore) GetPerformance() ValidatorPerformance {
+	if m != nil {
+		return m.Performance
+	}
+	return ValidatorPerformance{}
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	SignedBlocksWindow      int64                                  `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty" yaml:"signed_blocks_window"`
@@ -136,7 +467,7 @@ func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1078e5d96a74cc52, []int{1}
+	return fileDescriptor_1078e5d96a74cc52, []int{4}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -180,7 +511,11 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 }
 
 func init() {
+	proto.RegisterEnum("cosmos.slashing.v1beta1.ValidatorScoreSort", ValidatorScoreSort_name, ValidatorScoreSort_value)
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
+	proto.RegisterType((*LivenessStatus)(nil), "cosmos.slashing.v1beta1.LivenessStatus")
+	proto.RegisterType((*ValidatorPerformance)(nil), "cosmos.slashing.v1beta1.ValidatorPerformance")
+	proto.RegisterType((*ValidatorScore)(nil), "cosmos.slashing.v1beta1.ValidatorScore")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
 }
 
@@ -189,47 +524,92 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 636 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbd, 0x6f, 0xd3, 0x4e,
-	0x18, 0xce, 0xfd, 0xd2, 0x5f, 0x29, 0x97, 0x4c, 0x6e, 0x4a, 0x4c, 0x00, 0x3b, 0x78, 0xa8, 0xc2,
-	0x50, 0x5b, 0x2d, 0x5b, 0x47, 0x53, 0x21, 0x3e, 0x24, 0x28, 0x6e, 0x01, 0x89, 0x01, 0xeb, 0x9c,
-	0xbb, 0x38, 0x47, 0xed, 0xbb, 0xc8, 0x77, 0xa1, 0x2d, 0x1b, 0x5b, 0xc7, 0x8e, 0x1d, 0x3b, 0xf2,
-	0xa7, 0x74, 0xec, 0x88, 0x18, 0x02, 0x4a, 0x17, 0xe6, 0x6e, 0x6c, 0xc8, 0x77, 0x76, 0x1b, 0xa5,
-	0x29, 0x52, 0xa7, 0xe4, 0x7d, 0xde, 0xe7, 0x7d, 0xee, 0x79, 0x3f, 0x12, 0xb8, 0xdc, 0xe5, 0x22,
-	0xe5, 0xc2, 0x13, 0x09, 0x12, 0x7d, 0xca, 0x62, 0xef, 0xf3, 0x6a, 0x44, 0x24, 0x5a, 0xbd, 0x00,
-	0xdc, 0x41, 0xc6, 0x25, 0x37, 0x9a, 0x9a, 0xe7, 0x5e, 0xc0, 0x05, 0xaf, 0xd5, 0x88, 0x79, 0xcc,
-	0x15, 0xc7, 0xcb, 0xbf, 0x69, 0x7a, 0xcb, 0x8a, 0x39, 0x8f, 0x13, 0xe2, 0xa9, 0x28, 0x1a, 0xf6,
-	0x3c, 0x3c, 0xcc, 0x90, 0xa4, 0x9c, 0x15, 0x79, 0x7b, 0x3a, 0x2f, 0x69, 0x4a, 0x84, 0x44, 0xe9,
-	0x40, 0x13, 0x9c, 0x83, 0x2a, 0x6c, 0xbc, 0x43, 0x09, 0xc5, 0x48, 0xf2, 0x6c, 0x8b, 0xc6, 0x8c,
-	0xb2, 0xf8, 0x39, 0xeb, 0x71, 0xc3, 0x84, 0xb7, 0x10, 0xc6, 0x19, 0x11, 0xc2, 0x04, 0x6d, 0xd0,
-	0xb9, 0x1d, 0x94, 0xa1, 0xb1, 0x0e, 0xeb, 0x42, 0xa2, 0x4c, 0x86, 0x7d, 0x42, 0xe3, 0xbe, 0x34,
-	0xff, 0x6b, 0x83, 0x4e, 0xd5, 0x6f, 0x9e, 0x8f, 0xec, 0xc5, 0x7d, 0x94, 0x26, 0xeb, 0xce, 0x64,
-	0xd6, 0x09, 0x6a, 0x2a, 0x7c, 0xa6, 0xa2, 0xbc, 0x96, 0x32, 0x4c, 0xf6, 0x42, 0xde, 0xeb, 0x09,
-	0x22, 0xcd, 0xea, 0x74, 0xed, 0x64, 0xd6, 0x09, 0x6a, 0x2a, 0x7c, 0xad, 0x22, 0xe3, 0x23, 0xac,
-	0x7f, 0x42, 0x34, 0x21, 0x38, 0x1c, 0x32, 0x49, 0x13, 0x73, 0xae, 0x0d, 0x3a, 0xb5, 0xb5, 0x96,
-	0xab, 0x5b, 0x74, 0xcb, 0x16, 0xdd, 0xed, 0xb2, 0x45, 0xdf, 0x3e, 0x19, 0xd9, 0x95, 0x4b, 0xed,
-	0xc9, 0x6a, 0xe7, 0xf0, 0xa7, 0x0d, 0x82, 0x9a, 0x86, 0xde, 0xe6, 0x88, 0x61, 0x41, 0x28, 0x79,
-	0x1a, 0x09, 0xc9, 0x19, 0xc1, 0xe6, 0xff, 0x6d, 0xd0, 0x59, 0x08, 0x26, 0x10, 0x63, 0x1b, 0x2e,
-	0xa5, 0x54, 0x08, 0x82, 0xc3, 0x28, 0xe1, 0xdd, 0x1d, 0x11, 0x76, 0xf9, 0x90, 0x49, 0x92, 0x99,
-	0xf3, 0xaa, 0x89, 0xf6, 0xf9, 0xc8, 0xbe, 0xaf, 0x1f, 0x9a, 0x49, 0x73, 0x82, 0x45, 0x8d, 0xfb,
-	0x0a, 0x7e, 0xa2, 0xd1, 0xf5, 0x85, 0xa3, 0x63, 0xbb, 0xf2, 0xfb, 0xd8, 0x06, 0xce, 0x9f, 0x39,
-	0x38, 0xbf, 0x89, 0x32, 0x94, 0x0a, 0xe3, 0x0d, 0x6c, 0x08, 0x1a, 0xb3, 0x4b, 0x8d, 0x5d, 0xca,
-	0x30, 0xdf, 0x55, 0x9b, 0xa8, 0xfa, 0xf6, 0xf9, 0xc8, 0xbe, 0x57, 0x8c, 0x7a, 0x06, 0xcb, 0x09,
-	0x0c, 0x0d, 0xeb, 0x87, 0xde, 0x2b, 0xd0, 0xf8, 0x0a, 0x72, 0xfb, 0x2c, 0x2c, 0x2a, 0x06, 0x24,
-	0x2b, 0x45, 0xf3, 0xfd, 0xd5, 0xfd, 0x57, 0xf9, 0xac, 0x7e, 0x8c, 0xec, 0xe5, 0x98, 0xca, 0xfe,
-	0x30, 0x72, 0xbb, 0x3c, 0xf5, 0x8a, 0x9b, 0xd5, 0x1f, 0x2b, 0x02, 0xef, 0x78, 0x72, 0x7f, 0x40,
-	0x84, 0xbb, 0x41, 0xba, 0x93, 0xcd, 0xce, 0x10, 0x75, 0x02, 0x23, 0xa5, 0x6c, 0x4b, 0xc1, 0x9b,
-	0x24, 0x2b, 0x3c, 0x7c, 0x81, 0x77, 0x30, 0xdf, 0x65, 0xf9, 0x0d, 0x86, 0xf9, 0xe4, 0xc3, 0xf2,
-	0x5a, 0xd5, 0x1d, 0xd4, 0xd6, 0xee, 0x5e, 0xd9, 0xe5, 0x46, 0x41, 0xf0, 0x1f, 0x15, 0xab, 0x7c,
-	0xa0, 0x1f, 0x9d, 0x2d, 0xe3, 0x1c, 0xe5, 0x4b, 0x6d, 0x94, 0xc9, 0x17, 0x88, 0x26, 0xa5, 0x80,
-	0x71, 0x08, 0x60, 0x4b, 0xfd, 0xa8, 0xc2, 0x5e, 0x86, 0xba, 0x39, 0x14, 0x62, 0x3e, 0x8c, 0x12,
-	0xa2, 0xcc, 0xab, 0x63, 0xaa, 0xfb, 0x5b, 0x37, 0x1e, 0xc2, 0xc3, 0x62, 0x0f, 0xd7, 0x2a, 0x3b,
-	0x41, 0x53, 0x25, 0x9f, 0x16, 0xb9, 0x0d, 0x95, 0xca, 0x27, 0x63, 0x1c, 0x00, 0xd8, 0xbc, 0x52,
-	0xa8, 0xad, 0xab, 0xf3, 0xab, 0xfb, 0x9b, 0x37, 0xf6, 0x63, 0x5d, 0xe3, 0x47, 0xcb, 0x3a, 0xc1,
-	0xd2, 0x94, 0x19, 0x8d, 0xfb, 0x2f, 0xbf, 0x8d, 0x2d, 0x70, 0x32, 0xb6, 0xc0, 0xe9, 0xd8, 0x02,
-	0xbf, 0xc6, 0x16, 0x38, 0x3c, 0xb3, 0x2a, 0xa7, 0x67, 0x56, 0xe5, 0xfb, 0x99, 0x55, 0xf9, 0xb0,
-	0xf2, 0xcf, 0xe7, 0xf7, 0x2e, 0xff, 0xd4, 0x94, 0x93, 0x68, 0x5e, 0xad, 0xef, 0xf1, 0xdf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x7b, 0x78, 0xfd, 0x40, 0xf4, 0x04, 0x00, 0x00,
+	// 1345 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xbf, 0x6f, 0x1b, 0xc7,
+	0x12, 0xd6, 0x89, 0x94, 0x6c, 0x2e, 0x69, 0x89, 0x5e, 0xcb, 0xd6, 0x59, 0xb2, 0x79, 0x7a, 0xfb,
+	0x1e, 0x0c, 0xf9, 0x01, 0x22, 0x61, 0xbf, 0x4e, 0x80, 0x0b, 0x1e, 0x45, 0x3d, 0x5d, 0x22, 0x89,
+	0xf4, 0x1e, 0xed, 0xc0, 0x41, 0x90, 0xc3, 0x91, 0x5c, 0x51, 0x1b, 0x91, 0xb7, 0xcc, 0xed, 0x52,
+	0x3f, 0x52, 0x25, 0x45, 0x00, 0x17, 0x29, 0x8c, 0x54, 0x2e, 0x8d, 0x54, 0xf9, 0x3b, 0x52, 0xb9,
+	0x74, 0x19, 0xa4, 0x60, 0x02, 0xb9, 0x49, 0xcd, 0x2e, 0x5d, 0x70, 0x7b, 0x77, 0xe2, 0xf1, 0x74,
+	0x32, 0xc0, 0xb8, 0x12, 0xe7, 0x9b, 0xd9, 0xef, 0x66, 0x77, 0xbe, 0x9d, 0x59, 0x81, 0x07, 0x2d,
+	0xc6, 0x7b, 0x8c, 0x97, 0x78, 0xd7, 0xe6, 0x87, 0xd4, 0xe9, 0x94, 0x8e, 0x1f, 0x35, 0x89, 0xb0,
+	0x1f, 0x5d, 0x00, 0xc5, 0xbe, 0xcb, 0x04, 0x83, 0xcb, 0x7e, 0x5c, 0xf1, 0x02, 0x0e, 0xe2, 0x56,
+	0x96, 0x3a, 0xac, 0xc3, 0x64, 0x4c, 0xc9, 0xfb, 0xe5, 0x87, 0xaf, 0x14, 0x3a, 0x8c, 0x75, 0xba,
+	0xa4, 0x24, 0xad, 0xe6, 0xe0, 0xa0, 0xd4, 0x1e, 0xb8, 0xb6, 0xa0, 0xcc, 0x09, 0xfc, 0x5a, 0xdc,
+	0x2f, 0x68, 0x8f, 0x70, 0x61, 0xf7, 0xfa, 0x7e, 0x00, 0x7a, 0x99, 0x02, 0x4b, 0xcf, 0xed, 0x2e,
+	0x6d, 0xdb, 0x82, 0xb9, 0x26, 0xed, 0x38, 0xd4, 0xe9, 0x18, 0xce, 0x01, 0x83, 0x2a, 0xb8, 0x66,
+	0xb7, 0xdb, 0x2e, 0xe1, 0x5c, 0x55, 0xd6, 0x94, 0xf5, 0x0c, 0x0e, 0x4d, 0xb8, 0x09, 0x72, 0x5c,
+	0xd8, 0xae, 0xb0, 0x0e, 0x09, 0xed, 0x1c, 0x0a, 0x75, 0x76, 0x4d, 0x59, 0x4f, 0xe9, 0xcb, 0xa3,
+	0xa1, 0x76, 0xeb, 0xcc, 0xee, 0x75, 0x37, 0x51, 0xd4, 0x8b, 0x70, 0x56, 0x9a, 0x3b, 0xd2, 0xf2,
+	0xd6, 0x52, 0xa7, 0x4d, 0x4e, 0x2d, 0x76, 0x70, 0xc0, 0x89, 0x50, 0x53, 0xf1, 0xb5, 0x51, 0x2f,
+	0xc2, 0x59, 0x69, 0xd6, 0xa4, 0x05, 0xbf, 0x04, 0xb9, 0xaf, 0x6c, 0xda, 0x25, 0x6d, 0x6b, 0xe0,
+	0x08, 0xda, 0x55, 0xd3, 0x6b, 0xca, 0x7a, 0xf6, 0xf1, 0x4a, 0xd1, 0xdf, 0x62, 0x31, 0xdc, 0x62,
+	0xb1, 0x11, 0x6e, 0x51, 0xd7, 0xde, 0x0e, 0xb5, 0x99, 0x31, 0x77, 0x74, 0x35, 0x7a, 0xf5, 0xbb,
+	0xa6, 0xe0, 0xac, 0x0f, 0x3d, 0xf3, 0x10, 0x58, 0x00, 0x40, 0xb0, 0x5e, 0x93, 0x0b, 0xe6, 0x90,
+	0xb6, 0x3a, 0xb7, 0xa6, 0xac, 0x5f, 0xc7, 0x11, 0x04, 0x36, 0xc0, 0xed, 0x1e, 0xe5, 0x9c, 0xb4,
+	0xad, 0x66, 0x97, 0xb5, 0x8e, 0xb8, 0xd5, 0x62, 0x03, 0x47, 0x10, 0x57, 0x9d, 0x97, 0x9b, 0x58,
+	0x1b, 0x0d, 0xb5, 0x7b, 0xfe, 0x87, 0x12, 0xc3, 0x10, 0xbe, 0xe5, 0xe3, 0xba, 0x84, 0x2b, 0x3e,
+	0xba, 0x79, 0xfd, 0xf5, 0x1b, 0x6d, 0xe6, 0xcf, 0x37, 0x9a, 0x82, 0x7e, 0x49, 0x83, 0x85, 0x5d,
+	0x7a, 0x4c, 0x1c, 0xc2, 0xb9, 0x29, 0x6c, 0x31, 0xe0, 0x1f, 0x28, 0x42, 0x15, 0xe4, 0x03, 0x7a,
+	0xea, 0x58, 0x27, 0xd4, 0x69, 0xb3, 0x93, 0xa0, 0x10, 0xab, 0xa3, 0xa1, 0xb6, 0xec, 0xe7, 0x11,
+	0x8f, 0x40, 0x78, 0xc1, 0x87, 0x0c, 0xe7, 0x33, 0x09, 0x5c, 0xbd, 0xa7, 0xd4, 0x47, 0xec, 0x09,
+	0x6e, 0x83, 0xf9, 0x41, 0xdf, 0x53, 0x9a, 0xac, 0x51, 0x46, 0x2f, 0x7a, 0x75, 0xf8, 0x6d, 0xa8,
+	0x3d, 0xe8, 0x50, 0x71, 0x38, 0x68, 0x16, 0x5b, 0xac, 0x57, 0x0a, 0xee, 0x83, 0xff, 0x67, 0x83,
+	0xb7, 0x8f, 0x4a, 0xe2, 0xac, 0x4f, 0x78, 0x71, 0x8b, 0xb4, 0x70, 0xb0, 0x1a, 0x3e, 0x05, 0x4b,
+	0x93, 0x9f, 0xe5, 0xc2, 0x25, 0xf6, 0x91, 0xac, 0x4d, 0x4a, 0xd7, 0x46, 0x43, 0x6d, 0x35, 0x29,
+	0x39, 0x3f, 0x0a, 0x61, 0x18, 0xcd, 0xcd, 0x94, 0x20, 0xdc, 0x01, 0x37, 0x7b, 0xf6, 0xa9, 0x35,
+	0xb1, 0x20, 0x28, 0xe0, 0xbd, 0xd1, 0x50, 0x53, 0x03, 0xbe, 0x78, 0x08, 0xc2, 0x8b, 0x3d, 0xfb,
+	0x74, 0x2f, 0xc2, 0x07, 0xbf, 0x00, 0xea, 0xe4, 0x67, 0xa5, 0xae, 0x2c, 0x4f, 0x51, 0xea, 0x35,
+	0x49, 0xf8, 0xef, 0xd1, 0x50, 0xd3, 0x92, 0x12, 0x1c, 0x47, 0x22, 0x7c, 0x3b, 0x9a, 0xa4, 0x14,
+	0xe2, 0x27, 0x36, 0xed, 0xc2, 0x3b, 0x60, 0xde, 0xd7, 0xa6, 0x7a, 0x5d, 0x0a, 0x31, 0xb0, 0x62,
+	0x22, 0xcd, 0xc4, 0x45, 0x8a, 0x7e, 0x4a, 0x47, 0xee, 0x73, 0x9d, 0xb8, 0x07, 0xcc, 0xed, 0xd9,
+	0x4e, 0x8b, 0x7c, 0x40, 0x4a, 0x4f, 0xc0, 0x0d, 0x4e, 0x3b, 0xce, 0xf8, 0x38, 0x7c, 0x1d, 0xa9,
+	0xa3, 0xa1, 0xb6, 0x14, 0x5c, 0xe8, 0xa8, 0x1b, 0xe1, 0x9c, 0x6f, 0x07, 0xe7, 0xf0, 0x04, 0xdc,
+	0x98, 0x3c, 0xcd, 0x54, 0x7c, 0x79, 0xec, 0x24, 0x73, 0xd1, 0x1d, 0xc3, 0x5d, 0x00, 0x49, 0x97,
+	0x76, 0x68, 0xb3, 0x4b, 0xac, 0xbe, 0xcb, 0xfa, 0x8c, 0xdb, 0x5d, 0x2e, 0x75, 0x93, 0xd2, 0xef,
+	0x8f, 0x86, 0xda, 0x5d, 0x9f, 0xe3, 0x72, 0x0c, 0xc2, 0x37, 0x43, 0xb0, 0x1e, 0x62, 0xb0, 0x02,
+	0x16, 0x8f, 0x99, 0x20, 0xed, 0x08, 0x95, 0x2f, 0x96, 0x95, 0xd1, 0x50, 0xbb, 0xe3, 0x53, 0xc5,
+	0x02, 0x10, 0x5e, 0x90, 0xc8, 0x98, 0x64, 0x17, 0xc0, 0x16, 0xeb, 0x79, 0x59, 0x52, 0xe6, 0x58,
+	0xad, 0x43, 0xdb, 0xe9, 0x90, 0x50, 0x24, 0x91, 0x94, 0x2e, 0xc7, 0x20, 0x7c, 0x73, 0x0c, 0x56,
+	0x7c, 0x0c, 0x7e, 0x0d, 0x16, 0x23, 0x91, 0xae, 0x2d, 0x88, 0x94, 0x47, 0x46, 0xdf, 0x99, 0xee,
+	0x56, 0x8c, 0x37, 0x10, 0xa3, 0x43, 0x78, 0x61, 0x8c, 0x60, 0x5b, 0xc8, 0x5a, 0xcb, 0xf9, 0x41,
+	0xb8, 0x54, 0x4f, 0x0a, 0x87, 0xe6, 0x66, 0x5a, 0x76, 0x9a, 0x1f, 0xe7, 0xc1, 0xc2, 0xb8, 0xe9,
+	0xb7, 0x98, 0x4b, 0xe0, 0x36, 0xc8, 0xb3, 0x3e, 0x71, 0x3d, 0xc0, 0x9a, 0xd0, 0x49, 0xb4, 0x9f,
+	0xc4, 0x23, 0x10, 0x5e, 0x0c, 0xa1, 0xf2, 0x78, 0x38, 0xb4, 0x98, 0xc3, 0x2f, 0x38, 0x66, 0x25,
+	0x47, 0xa4, 0xc1, 0x47, 0xbd, 0x08, 0x67, 0x3d, 0x33, 0x5c, 0xab, 0x82, 0x6b, 0x3d, 0xe6, 0xd0,
+	0xa3, 0xa0, 0xfd, 0x64, 0x70, 0x68, 0xc2, 0x2d, 0x30, 0xc7, 0xbd, 0x34, 0xff, 0x61, 0x3f, 0xf1,
+	0x17, 0x47, 0xda, 0xd2, 0xdc, 0x47, 0xb5, 0xa5, 0x1f, 0x14, 0xa0, 0x76, 0xd8, 0x31, 0x71, 0x1d,
+	0xef, 0x66, 0x59, 0x7d, 0xdb, 0x15, 0xb4, 0x45, 0xfb, 0x72, 0xee, 0x4a, 0x99, 0x64, 0xf4, 0xa7,
+	0x53, 0xd7, 0x36, 0x68, 0x14, 0x57, 0xf1, 0x22, 0xbc, 0x3c, 0x76, 0xd5, 0xa3, 0x1e, 0xf8, 0xad,
+	0x02, 0x96, 0x22, 0x92, 0xe0, 0xc2, 0x6e, 0xd2, 0x2e, 0x15, 0x67, 0x81, 0xcc, 0xf6, 0xa6, 0x4e,
+	0x65, 0xf5, 0x92, 0xcc, 0x2e, 0x38, 0x11, 0xbe, 0x35, 0x86, 0xcd, 0x10, 0x85, 0x47, 0xe0, 0x86,
+	0x54, 0x98, 0x75, 0x48, 0xb9, 0x60, 0xee, 0x99, 0x94, 0x5d, 0x46, 0xdf, 0x9e, 0xfa, 0xd3, 0x61,
+	0xc3, 0x89, 0x92, 0x79, 0x0d, 0xc7, 0xb3, 0x77, 0x7c, 0x13, 0x3e, 0x03, 0xd9, 0xfe, 0xb8, 0xb1,
+	0xc9, 0x1e, 0x98, 0x7d, 0xbc, 0x51, 0xbc, 0xe2, 0xe1, 0x54, 0x4c, 0xea, 0x86, 0x7a, 0xda, 0xcb,
+	0x0c, 0x47, 0x79, 0xd0, 0x5f, 0x69, 0x30, 0x5f, 0xb7, 0x5d, 0xbb, 0xc7, 0xbd, 0xb9, 0x33, 0xd1,
+	0xf2, 0xc2, 0x01, 0xab, 0xc4, 0xe7, 0x4e, 0x52, 0x14, 0xc2, 0x30, 0xda, 0x1f, 0x83, 0x41, 0xfb,
+	0x9d, 0xe2, 0x4d, 0x5a, 0xc7, 0x0a, 0x56, 0xf4, 0x89, 0x1b, 0x9d, 0xda, 0x39, 0x7d, 0x7f, 0xea,
+	0xa3, 0xba, 0x98, 0xcb, 0x09, 0xa4, 0x72, 0xf6, 0x39, 0xa6, 0x84, 0xeb, 0xc4, 0x0d, 0x72, 0xf8,
+	0x06, 0xdc, 0x69, 0xb3, 0x13, 0xc7, 0xd3, 0xb0, 0x1c, 0x3e, 0x56, 0xf8, 0x58, 0x94, 0xd7, 0x2d,
+	0xfb, 0xf8, 0xee, 0xa5, 0xa7, 0xd4, 0x56, 0x10, 0xa0, 0x3f, 0x0c, 0x5e, 0x52, 0xf7, 0xfd, 0x8f,
+	0x26, 0xd3, 0xa0, 0xd7, 0xde, 0x9b, 0x6a, 0x29, 0x74, 0x7a, 0x73, 0x2c, 0x24, 0x80, 0xaf, 0x14,
+	0xb0, 0xe2, 0x57, 0xf5, 0xc0, 0xb5, 0x5b, 0x1e, 0x64, 0xb5, 0xd9, 0xc0, 0x6b, 0xe8, 0x5e, 0xf2,
+	0xf2, 0x5e, 0xe7, 0x74, 0x73, 0xea, 0x43, 0xf8, 0x57, 0x54, 0x2f, 0x49, 0xcc, 0x08, 0x2f, 0x4b,
+	0xe7, 0x76, 0xe0, 0xdb, 0x92, 0x2e, 0xef, 0x64, 0xe0, 0x4b, 0x05, 0x2c, 0x5f, 0x5a, 0x78, 0xe2,
+	0x5c, 0x34, 0x88, 0x9c, 0x5e, 0x9f, 0x3a, 0x9f, 0xc2, 0x15, 0xf9, 0xf8, 0xb4, 0x08, 0xdf, 0x8e,
+	0x25, 0xe3, 0xe3, 0xff, 0xfd, 0x7e, 0x16, 0xc0, 0xc9, 0x86, 0x6c, 0x32, 0x57, 0xc0, 0xff, 0x80,
+	0xb5, 0xe7, 0xe5, 0x5d, 0x63, 0xab, 0xdc, 0xa8, 0x61, 0xcb, 0xac, 0xd4, 0x70, 0xd5, 0x32, 0x6b,
+	0xb8, 0x61, 0x3d, 0xdb, 0x37, 0xeb, 0xd5, 0x8a, 0xb1, 0x6d, 0x54, 0xb7, 0xf2, 0x33, 0xb0, 0x00,
+	0x56, 0x12, 0xa3, 0xe4, 0xcf, 0xbc, 0x02, 0x35, 0xb0, 0x9a, 0xcc, 0x52, 0x6f, 0x18, 0x7b, 0xd5,
+	0xfc, 0x2c, 0x7c, 0x04, 0x36, 0x12, 0x03, 0xfe, 0x5f, 0x7b, 0x5e, 0xc5, 0xfb, 0xe5, 0xfd, 0x4a,
+	0xd5, 0xaa, 0x97, 0x71, 0xc3, 0xa8, 0x18, 0xf5, 0x72, 0xc3, 0xa8, 0xed, 0xe7, 0x53, 0x70, 0x03,
+	0x3c, 0x4c, 0x5c, 0x52, 0xa9, 0xed, 0xed, 0x19, 0xa6, 0x69, 0xd4, 0xf6, 0x2d, 0xb3, 0x51, 0xd6,
+	0x8d, 0x5d, 0xa3, 0xf1, 0x22, 0x9f, 0x86, 0x0f, 0x00, 0x4a, 0x4e, 0x71, 0xb7, 0x6c, 0xee, 0x58,
+	0x3b, 0x86, 0xd9, 0xa8, 0xe1, 0x17, 0xf9, 0x39, 0xfd, 0xd3, 0x9f, 0xcf, 0x0b, 0xca, 0xdb, 0xf3,
+	0x82, 0xf2, 0xee, 0xbc, 0xa0, 0xfc, 0x71, 0x5e, 0x50, 0x5e, 0xbd, 0x2f, 0xcc, 0xbc, 0x7b, 0x5f,
+	0x98, 0xf9, 0xf5, 0x7d, 0x61, 0xe6, 0xf3, 0x8d, 0x0f, 0x96, 0xe1, 0x74, 0xfc, 0xbf, 0x95, 0xac,
+	0x48, 0x73, 0x5e, 0xca, 0xf8, 0x7f, 0x7f, 0x0f, 0x00, 0x2d, 0xa9, 0x88, 0x07, 0x7b, 0x0d, 0x00,
+	0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -271,14 +651,14 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *Params) Equal(that interface{}) bool {
+func (this *LivenessStatus) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Params)
+	that1, ok := that.(*LivenessStatus)
 	if !ok {
-		that2, ok := that.(Params)
+		that2, ok := that.(LivenessStatus)
 		if ok {
 			that1 = &that2
 		} else {
@@ -290,79 +670,220 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.SignedBlocksWindow != that1.SignedBlocksWindow {
+	if this.Address != that1.Address {
 		return false
 	}
-	if !this.MinSignedPerWindow.Equal(that1.MinSignedPerWindow) {
+	if this.BlocksInWindow != that1.BlocksInWindow {
 		return false
 	}
-	if this.DowntimeJailDuration != that1.DowntimeJailDuration {
+	if this.MissedBlocksCounter != that1.MissedBlocksCounter {
 		return false
 	}
-	if !this.SlashFractionDoubleSign.Equal(that1.SlashFractionDoubleSign) {
+	if !this.Uptime.Equal(that1.Uptime) {
 		return false
 	}
-	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
+	if this.MissedBlocksStreak != that1.MissedBlocksStreak {
+		return false
+	}
+	if this.MaxMissedBlocks != that1.MaxMissedBlocks {
+		return false
+	}
+	if this.MissedBlocksUntilJail != that1.MissedBlocksUntilJail {
+		return false
+	}
+	if this.Jailed != that1.Jailed {
+		return false
+	}
+	if this.Tombstoned != that1.Tombstoned {
 		return false
 	}
 	return true
 }
-func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ValidatorPerformance) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ValidatorSigningInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MissedBlocksCounter != 0 {
-		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.Tombstoned {
-		i--
-		if m.Tombstoned {
-			dAtA[i] = 1
+	that1, ok := that.(*ValidatorPerformance)
+	if !ok {
+		that2, ok := that.(ValidatorPerformance)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x28
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil):])
-	if err1 != nil {
-		return 0, err1
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i -= n1
-	i = encodeVarintSlashing(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x22
-	if m.IndexOffset != 0 {
-		i = encodeVarintSlashing(dAtA, i, uint64(m.IndexOffset))
-		i--
-		dAtA[i] = 0x18
+	if this.Address != that1.Address {
+		return false
 	}
-	if m.StartHeight != 0 {
-		i = encodeVarintSlashing(dAtA, i, uint64(m.StartHeight))
-		i--
-		dAtA[i] = 0x10
+	if this.SignedBlocks != that1.SignedBlocks {
+		return false
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
+	if this.MissedBlocks != that1.MissedBlocks {
+		return false
+	}
+	if this.EligibleProposals != that1.EligibleProposals {
+		return false
+	}
+	if this.VotedProposals != that1.VotedProposals {
+		return false
+	}
+	if this.CommissionChanges != that1.CommissionChanges {
+		return false
+	}
+	if !this.CommissionRate.Equal(that1.CommissionRate) {
+		return false
+	}
+	if this.Slashes != that1.Slashes {
+		return false
+	}
+	return true
+}
+func (this *ValidatorScore) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorScore)
+	if !ok {
+		that2, ok := that.(ValidatorScore)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OperatorAddress != that1.OperatorAddress {
+		return false
+	}
+	if this.ConsAddress != that1.ConsAddress {
+		return false
+	}
+	if this.Moniker != that1.Moniker {
+		return false
+	}
+	if !this.Score.Equal(that1.Score) {
+		return false
+	}
+	if !this.Uptime.Equal(that1.Uptime) {
+		return false
+	}
+	if !this.GovernanceParticipation.Equal(that1.GovernanceParticipation) {
+		return false
+	}
+	if !this.CommissionStability.Equal(that1.CommissionStability) {
+		return false
+	}
+	if !this.SlashHistory.Equal(that1.SlashHistory) {
+		return false
+	}
+	if !this.Performance.Equal(&that1.Performance) {
+		return false
+	}
+	return true
+}
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SignedBlocksWindow != that1.SignedBlocksWindow {
+		return false
+	}
+	if !this.MinSignedPerWindow.Equal(that1.MinSignedPerWindow) {
+		return false
+	}
+	if this.DowntimeJailDuration != that1.DowntimeJailDuration {
+		return false
+	}
+	if !this.SlashFractionDoubleSign.Equal(that1.SlashFractionDoubleSign) {
+		return false
+	}
+	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
+		return false
+	}
+	return true
+}
+func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSigningInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSlashing(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.IndexOffset != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
 		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
@@ -370,7 +891,7 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
+func (m *LivenessStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -380,127 +901,1161 @@ func (m *Params) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+func (m *LivenessStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LivenessStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.SlashFractionDowntime.Size()
-		i -= size
-		if _, err := m.SlashFractionDowntime.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i = encodeVarintSlashing(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x48
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.SlashFractionDoubleSign.Size()
-		i -= size
-		if _, err := m.SlashFractionDoubleSign.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i = encodeVarintSlashing(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x40
 	}
-	i--
-	dAtA[i] = 0x22
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	if m.MissedBlocksUntilJail != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksUntilJail))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxMissedBlocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MaxMissedBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MissedBlocksStreak != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksStreak))
+		i--
+		dAtA[i] = 0x28
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x1a
 	{
-		size := m.MinSignedPerWindow.Size()
+		size := m.Uptime.Size()
 		i -= size
-		if _, err := m.MinSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Uptime.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintSlashing(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if m.SignedBlocksWindow != 0 {
-		i = encodeVarintSlashing(dAtA, i, uint64(m.SignedBlocksWindow))
+	dAtA[i] = 0x22
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x18
+	}
+	if m.BlocksInWindow != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.BlocksInWindow))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSlashing(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ValidatorPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ValidatorSigningInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ValidatorPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovSlashing(uint64(l))
+	if m.Slashes != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Slashes))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.StartHeight != 0 {
-		n += 1 + sovSlashing(uint64(m.StartHeight))
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
 	}
-	if m.IndexOffset != 0 {
-		n += 1 + sovSlashing(uint64(m.IndexOffset))
+	i--
+	dAtA[i] = 0x3a
+	if m.CommissionChanges != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.CommissionChanges))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil)
-	n += 1 + l + sovSlashing(uint64(l))
-	if m.Tombstoned {
-		n += 2
+	if m.VotedProposals != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.VotedProposals))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.MissedBlocksCounter != 0 {
-		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
+	if m.EligibleProposals != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.EligibleProposals))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MissedBlocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SignedBlocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.SignedBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorScore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorScore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Performance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.SlashHistory.Size()
+		i -= size
+		if _, err := m.SlashHistory.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.CommissionStability.Size()
+		i -= size
+		if _, err := m.CommissionStability.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.GovernanceParticipation.Size()
+		i -= size
+		if _, err := m.GovernanceParticipation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Uptime.Size()
+		i -= size
+		if _, err := m.Uptime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Score.Size()
+		i -= size
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlashFractionDowntime.Size()
+		i -= size
+		if _, err := m.SlashFractionDowntime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SlashFractionDoubleSign.Size()
+		i -= size
+		if _, err := m.SlashFractionDoubleSign.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinSignedPerWindow.Size()
+		i -= size
+		if _, err := m.MinSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSlashing(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.StartHeight))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovSlashing(uint64(m.IndexOffset))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.Tombstoned {
+		n += 2
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
+	}
+	return n
+}
+
+func (m *LivenessStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.BlocksInWindow != 0 {
+		n += 1 + sovSlashing(uint64(m.BlocksInWindow))
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
+	}
+	l = m.Uptime.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.MissedBlocksStreak != 0 {
+		n += 1 + sovSlashing(uint64(m.MissedBlocksStreak))
+	}
+	if m.MaxMissedBlocks != 0 {
+		n += 1 + sovSlashing(uint64(m.MaxMissedBlocks))
+	}
+	if m.MissedBlocksUntilJail != 0 {
+		n += 1 + sovSlashing(uint64(m.MissedBlocksUntilJail))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.Tombstoned {
+		n += 2
+	}
+	return n
+}
+
+func (m *ValidatorPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.SignedBlocks != 0 {
+		n += 1 + sovSlashing(uint64(m.SignedBlocks))
+	}
+	if m.MissedBlocks != 0 {
+		n += 1 + sovSlashing(uint64(m.MissedBlocks))
+	}
+	if m.EligibleProposals != 0 {
+		n += 1 + sovSlashing(uint64(m.EligibleProposals))
+	}
+	if m.VotedProposals != 0 {
+		n += 1 + sovSlashing(uint64(m.VotedProposals))
+	}
+	if m.CommissionChanges != 0 {
+		n += 1 + sovSlashing(uint64(m.CommissionChanges))
+	}
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.Slashes != 0 {
+		n += 1 + sovSlashing(uint64(m.Slashes))
+	}
+	return n
+}
+
+func (m *ValidatorScore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = m.Score.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.Uptime.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.GovernanceParticipation.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.CommissionStability.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashHistory.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.Performance.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovSlashing(uint64(m.SignedBlocksWindow))
+	}
+	l = m.MinSignedPerWindow.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionDoubleSign.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionDowntime.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func sovSlashing(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSlashing(x uint64) (n int) {
+	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LivenessStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LivenessStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LivenessStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksInWindow", wireType)
+			}
+			m.BlocksInWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksInWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Uptime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksStreak", wireType)
+			}
+			m.MissedBlocksStreak = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksStreak |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedBlocks", wireType)
+			}
+			m.MaxMissedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMissedBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksUntilJail", wireType)
+			}
+			m.MissedBlocksUntilJail = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksUntilJail |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPerformance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocks", wireType)
+			}
+			m.SignedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
+			}
+			m.MissedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EligibleProposals", wireType)
+			}
+			m.EligibleProposals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EligibleProposals |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotedProposals", wireType)
+			}
+			m.VotedProposals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotedProposals |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionChanges", wireType)
+			}
+			m.CommissionChanges = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommissionChanges |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
+			}
+			m.Slashes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slashes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SignedBlocksWindow != 0 {
-		n += 1 + sovSlashing(uint64(m.SignedBlocksWindow))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.MinSignedPerWindow.Size()
-	n += 1 + l + sovSlashing(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration)
-	n += 1 + l + sovSlashing(uint64(l))
-	l = m.SlashFractionDoubleSign.Size()
-	n += 1 + l + sovSlashing(uint64(l))
-	l = m.SlashFractionDowntime.Size()
-	n += 1 + l + sovSlashing(uint64(l))
-	return n
-}
-
-func sovSlashing(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozSlashing(x uint64) (n int) {
-	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
+func (m *ValidatorScore) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -523,15 +2078,15 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorScore: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorScore: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -559,13 +2114,13 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
 			}
-			m.StartHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -575,16 +2130,29 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
 			}
-			m.IndexOffset = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -594,16 +2162,29 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IndexOffset |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -613,30 +2194,31 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSlashing
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSlashing
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -646,17 +2228,31 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Tombstoned = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Uptime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernanceParticipation", wireType)
 			}
-			m.MissedBlocksCounter = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -666,11 +2262,127 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GovernanceParticipation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionStability", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionStability.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashHistory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Performance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Performance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -103,32 +103,116 @@ func (m *MsgUnjailResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnjailResponse proto.InternalMessageInfo
 
+// MsgSetAutoUnjail defines the Msg/SetAutoUnjail request type
+type MsgSetAutoUnjail struct {
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"address" yaml:"address"`
+	// enabled defines whether the validator is unjailed automatically.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetAutoUnjail) Reset()         { *m = MsgSetAutoUnjail{} }
+func (m *MsgSetAutoUnjail) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoUnjail) ProtoMessage()    {}
+func (*MsgSetAutoUnjail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{2}
+}
+func (m *MsgSetAutoUnjail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoUnjail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoUnjail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoUnjail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoUnjail.Merge(m, src)
+}
+func (m *MsgSetAutoUnjail) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoUnjail) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoUnjail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoUnjail proto.InternalMessageInfo
+
+// MsgSetAutoUnjailResponse defines the Msg/SetAutoUnjail response type
+type MsgSetAutoUnjailResponse struct {
+}
+
+func (m *MsgSetAutoUnjailResponse) Reset()         { *m = MsgSetAutoUnjailResponse{} }
+func (m *MsgSetAutoUnjailResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoUnjailResponse) ProtoMessage()    {}
+func (*MsgSetAutoUnjailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{3}
+}
+func (m *MsgSetAutoUnjailResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoUnjailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoUnjailResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoUnjailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoUnjailResponse.Merge(m, src)
+}
+func (m *MsgSetAutoUnjailResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoUnjailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoUnjailResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoUnjailResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUnjail)(nil), "cosmos.slashing.v1beta1.MsgUnjail")
 	proto.RegisterType((*MsgUnjailResponse)(nil), "cosmos.slashing.v1beta1.MsgUnjailResponse")
+	proto.RegisterType((*MsgSetAutoUnjail)(nil), "cosmos.slashing.v1beta1.MsgSetAutoUnjail")
+	proto.RegisterType((*MsgSetAutoUnjailResponse)(nil), "cosmos.slashing.v1beta1.MsgSetAutoUnjailResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/slashing/v1beta1/tx.proto", fileDescriptor_3c5611c0c4a59d9d) }
 
 var fileDescriptor_3c5611c0c4a59d9d = []byte{
-	// 269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0xce, 0x49, 0x2c, 0xce, 0xc8, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd1, 0x07,
-	0xb1, 0x20, 0xca, 0x95, 0xa2, 0xb9, 0x38, 0x7d, 0x8b, 0xd3, 0x43, 0xf3, 0xb2, 0x12, 0x33, 0x73,
-	0x84, 0x5c, 0xb8, 0xf8, 0xca, 0x12, 0x73, 0x32, 0x53, 0x12, 0x4b, 0xf2, 0x8b, 0xe2, 0x13, 0x53,
-	0x52, 0x8a, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38, 0x9d, 0x64, 0x5f, 0xdd, 0x93, 0x67, 0x07, 0xf1,
-	0x53, 0x8b, 0x8b, 0x3f, 0xdd, 0x93, 0xe7, 0xab, 0x4c, 0xcc, 0xcd, 0xb1, 0x52, 0x82, 0x0a, 0x28,
-	0x05, 0xf1, 0xc2, 0x35, 0x39, 0xa6, 0xa4, 0x14, 0x59, 0x71, 0x74, 0x2c, 0x90, 0x67, 0x98, 0xb1,
-	0x40, 0x9e, 0x51, 0x49, 0x98, 0x4b, 0x10, 0x6e, 0x78, 0x50, 0x6a, 0x71, 0x41, 0x7e, 0x5e, 0x71,
-	0xaa, 0x51, 0x3c, 0x17, 0xb3, 0x6f, 0x71, 0xba, 0x50, 0x04, 0x17, 0x1b, 0xd4, 0x56, 0x25, 0x3d,
-	0x1c, 0x4e, 0xd6, 0x83, 0x6b, 0x96, 0xd2, 0x22, 0xac, 0x06, 0x66, 0x81, 0x93, 0xf7, 0x8a, 0x47,
-	0x72, 0x8c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84,
-	0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x9b, 0x9e, 0x59,
-	0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x0d, 0x4c, 0x08, 0xa5, 0x5b, 0x9c, 0x92,
-	0xad, 0x5f, 0x81, 0x08, 0xd9, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0x30, 0x19, 0x03,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x1b, 0xa7, 0xdc, 0xcf, 0x79, 0x01, 0x00, 0x00,
+	// 342 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x3f, 0x4b, 0xc3, 0x40,
+	0x18, 0xc6, 0x73, 0x0a, 0xfd, 0x73, 0xd0, 0xa2, 0x51, 0x30, 0x04, 0x4c, 0x4a, 0xa6, 0x2a, 0x34,
+	0xa1, 0xba, 0x75, 0x6b, 0x71, 0x93, 0x2e, 0x15, 0x41, 0x74, 0x90, 0x4b, 0xef, 0xb8, 0x46, 0x93,
+	0x5c, 0xc9, 0x7b, 0x2d, 0x2d, 0xf8, 0x01, 0x1c, 0x1d, 0x1d, 0x3b, 0xfa, 0x51, 0x9c, 0xa4, 0xa3,
+	0x53, 0x91, 0x74, 0x73, 0xf4, 0x13, 0x48, 0xdb, 0xa4, 0x5a, 0xc1, 0x3f, 0x83, 0xd3, 0xdd, 0x7b,
+	0xfc, 0xde, 0x7b, 0x9e, 0xf7, 0xe5, 0xc1, 0xa5, 0xb6, 0x80, 0x40, 0x80, 0x03, 0x3e, 0x81, 0x8e,
+	0x17, 0x72, 0xa7, 0x5f, 0x75, 0x99, 0x24, 0x55, 0x47, 0x0e, 0xec, 0x6e, 0x24, 0xa4, 0x50, 0x77,
+	0x16, 0x84, 0x9d, 0x12, 0x76, 0x42, 0xe8, 0xdb, 0x5c, 0x70, 0x31, 0x67, 0x9c, 0xd9, 0x6d, 0x81,
+	0x5b, 0x17, 0x38, 0xdf, 0x04, 0x7e, 0x1a, 0x5e, 0x11, 0xcf, 0x57, 0x8f, 0x70, 0xb1, 0x4f, 0x7c,
+	0x8f, 0x12, 0x29, 0xa2, 0x4b, 0x42, 0x69, 0xa4, 0xa1, 0x12, 0x2a, 0xe7, 0x1b, 0xbb, 0xaf, 0x13,
+	0x33, 0x3b, 0xab, 0x19, 0xc0, 0xdb, 0xc4, 0x2c, 0x0e, 0x49, 0xe0, 0xd7, 0xac, 0xe4, 0xc1, 0x6a,
+	0x15, 0x96, 0x4d, 0x75, 0x4a, 0xa3, 0x5a, 0xee, 0x76, 0x64, 0x2a, 0xf7, 0x23, 0x13, 0x59, 0x5b,
+	0x78, 0x73, 0xf9, 0x79, 0x8b, 0x41, 0x57, 0x84, 0xc0, 0xac, 0x1b, 0xbc, 0xd1, 0x04, 0x7e, 0xc2,
+	0x64, 0xbd, 0x27, 0xc5, 0x7f, 0x0a, 0xab, 0x1a, 0xce, 0xb2, 0x90, 0xb8, 0x3e, 0xa3, 0xda, 0x5a,
+	0x09, 0x95, 0x73, 0xad, 0xb4, 0xfc, 0x64, 0x49, 0xc7, 0xda, 0x57, 0xf5, 0xd4, 0xd9, 0xc1, 0x13,
+	0xc2, 0xeb, 0x4d, 0xe0, 0xea, 0x19, 0xce, 0x24, 0xbe, 0x2c, 0xfb, 0x9b, 0x6d, 0xda, 0xcb, 0xb9,
+	0xf4, 0xfd, 0xdf, 0x99, 0x54, 0x41, 0x0d, 0x70, 0x61, 0x75, 0xf0, 0xbd, 0x9f, 0x9a, 0x57, 0x50,
+	0xbd, 0xfa, 0x67, 0x34, 0x95, 0x6b, 0x1c, 0x3f, 0xc4, 0x06, 0x7a, 0x8c, 0x0d, 0x34, 0x8e, 0x0d,
+	0xf4, 0x12, 0x1b, 0xe8, 0x6e, 0x6a, 0x28, 0xe3, 0xa9, 0xa1, 0x3c, 0x4f, 0x0d, 0xe5, 0xbc, 0xc2,
+	0x3d, 0xd9, 0xe9, 0xb9, 0x76, 0x5b, 0x04, 0x4e, 0x12, 0xab, 0xc5, 0x51, 0x01, 0x7a, 0xed, 0x0c,
+	0x3e, 0x32, 0x26, 0x87, 0x5d, 0x06, 0x6e, 0x66, 0x1e, 0x98, 0xc3, 0xf7, 0x01, 0x00, 0x9a, 0xc8,
+	0xec, 0x01, 0x83, 0x02, 0x00, 0x00,
 }
 
 func (this *MsgUnjail) Equal(that interface{}) bool {
@@ -176,6 +260,54 @@ func (this *MsgUnjailResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgSetAutoUnjail) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetAutoUnjail)
+	if !ok {
+		that2, ok := that.(MsgSetAutoUnjail)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddr != that1.ValidatorAddr {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	return true
+}
+func (this *MsgSetAutoUnjailResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetAutoUnjailResponse)
+	if !ok {
+		that2, ok := that.(MsgSetAutoUnjailResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -193,6 +325,9 @@ type MsgClient interface {
 	// them into the bonded validator set, so they can begin receiving provisions
 	// and rewards again.
 	Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.CallOption) (*MsgUnjailResponse, error)
+	// SetAutoUnjail defines a method for a validator to opt in or out of being
+	// unjailed automatically once its downtime jail period elapsed.
+	SetAutoUnjail(ctx context.Context, in *MsgSetAutoUnjail, opts ...grpc.CallOption) (*MsgSetAutoUnjailResponse, error)
 }
 
 type msgClient struct {
@@ -212,12 +347,24 @@ func (c *msgClient) Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) SetAutoUnjail(ctx context.Context, in *MsgSetAutoUnjail, opts ...grpc.CallOption) (*MsgSetAutoUnjailResponse, error) {
+	out := new(MsgSetAutoUnjailResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Msg/SetAutoUnjail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Unjail defines a method for unjailing a jailed validator, thus returning
 	// them into the bonded validator set, so they can begin receiving provisions
 	// and rewards again.
 	Unjail(context.Context, *MsgUnjail) (*MsgUnjailResponse, error)
+	// SetAutoUnjail defines a method for a validator to opt in or out of being
+	// unjailed automatically once its downtime jail period elapsed.
+	SetAutoUnjail(context.Context, *MsgSetAutoUnjail) (*MsgSetAutoUnjailResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -227,6 +374,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) Unjail(ctx context.Context, req *MsgUnjail) (*MsgUnjailResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Unjail not implemented")
 }
+func (*UnimplementedMsgServer) SetAutoUnjail(ctx context.Context, req *MsgSetAutoUnjail) (*MsgSetAutoUnjailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoUnjail not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -250,6 +400,24 @@ func _Msg_Unjail_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAutoUnjail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoUnjail)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoUnjail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Msg/SetAutoUnjail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoUnjail(ctx, req.(*MsgSetAutoUnjail))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -258,6 +426,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Unjail",
 			Handler:    _Msg_Unjail_Handler,
 		},
+		{
+			MethodName: "SetAutoUnjail",
+			Handler:    _Msg_SetAutoUnjail_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/tx.proto",
@@ -316,6 +488,69 @@ func (m *MsgUnjailResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetAutoUnjail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoUnjail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoUnjail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoUnjailResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoUnjailResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoUnjailResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -349,6 +584,31 @@ func (m *MsgUnjailResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetAutoUnjail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoUnjailResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -487,6 +747,158 @@ func (m *MsgUnjailResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetAutoUnjail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoUnjail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoUnjail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoUnjailResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoUnjailResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoUnjailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
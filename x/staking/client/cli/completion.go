@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// CompleteValidatorArgs returns a cobra completion function completing the
+// arguments at the given positions with the operator addresses of the
+// validators, queried from the node and cached for client.CompletionCacheTTL.
+func CompleteValidatorArgs(positions ...int) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		for _, position := range positions {
+			if position == len(args) {
+				return completeValidators(cmd, toComplete), cobra.ShellCompDirectiveNoFileComp
+			}
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+func completeValidators(cmd *cobra.Command, toComplete string) []string {
+	clientCtx, err := client.GetCompletionContext(cmd)
+	if err != nil || clientCtx.Client == nil {
+		return nil
+	}
+
+	addrs, err := client.CachedCompletions(clientCtx, "validators", func() ([]string, error) {
+		queryClient := types.NewQueryClient(clientCtx)
+
+		var (
+			addrs   []string
+			pageReq = &query.PageRequest{}
+		)
+		for {
+			res, err := queryClient.Validators(cmd.Context(), &types.QueryValidatorsRequest{Pagination: pageReq})
+			if err != nil {
+				return nil, err
+			}
+
+			for _, validator := range res.Validators {
+				addrs = append(addrs, validator.OperatorAddress)
+			}
+
+			if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+				return addrs, nil
+			}
+			pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+		}
+	})
+	if err != nil {
+		return nil
+	}
+
+	return client.FilterCompletions(addrs, toComplete)
+}
@@ -20,6 +20,7 @@ const (
 	FlagWebsite         = "website"
 	FlagSecurityContact = "security-contact"
 	FlagDetails         = "details"
+	FlagPeers           = "peers"
 
 	FlagCommissionRate          = "commission-rate"
 	FlagCommissionMaxRate       = "commission-max-rate"
@@ -27,6 +28,9 @@ const (
 
 	FlagMinSelfDelegation = "min-self-delegation"
 
+	FlagStatus        = "status"
+	FlagMonikerPrefix = "moniker-prefix"
+
 	FlagGenesisFormat = "genesis-format"
 	FlagNodeID        = "node-id"
 	FlagIP            = "ip"
@@ -87,6 +91,7 @@ func flagSetDescriptionEdit() *flag.FlagSet {
 	fs.String(FlagWebsite, types.DoNotModifyDesc, "The validator's (optional) website")
 	fs.String(FlagSecurityContact, types.DoNotModifyDesc, "The validator's (optional) security contact email")
 	fs.String(FlagDetails, types.DoNotModifyDesc, "The validator's (optional) details")
+	fs.String(FlagPeers, types.DoNotModifyDesc, "The validator's (optional) comma-separated public P2P endpoints, e.g. of its sentries (node_id@host:port)")
 
 	return fs
 }
@@ -107,6 +112,7 @@ func flagSetDescriptionCreate() *flag.FlagSet {
 	fs.String(FlagWebsite, "", "The validator's (optional) website")
 	fs.String(FlagSecurityContact, "", "The validator's (optional) security contact email")
 	fs.String(FlagDetails, "", "The validator's (optional) details")
+	fs.String(FlagPeers, "", "The validator's (optional) comma-separated public P2P endpoints, e.g. of its sentries (node_id@host:port)")
 
 	return fs
 }
@@ -0,0 +1,228 @@
+package cli
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	tmcfg "github.com/tendermint/tendermint/config"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	srvconfig "github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+const (
+	FlagRole          = "role"
+	FlagPrivatePeers  = "private-peers"
+	FlagMaxValidators = "max-validators"
+	FlagWrite         = "write"
+)
+
+// PeersConfig is the P2P configuration of a node generated from the public
+// endpoints registered by the validators in their descriptions.
+type PeersConfig struct {
+	PersistentPeers      []string
+	PrivatePeerIDs       []string
+	UnconditionalPeerIDs []string
+}
+
+// BuildPeersConfig returns the P2P configuration of a node of the given role,
+// given the bonded validators and the operator address of the validator of the
+// node, if any:
+//
+// - a validator only connects to the endpoints registered by its validator,
+// i.e. to its sentries;
+// - a sentry connects to the endpoints of the other validators, of the
+// maxValidators ones with the most tokens if positive, and to the private
+// peers, i.e. to the nodes of its validator, whose IDs it does not gossip;
+// - any other node connects to the endpoints of the validators, of the
+// maxValidators ones with the most tokens if positive.
+func BuildPeersConfig(validators []types.Validator, role, operator string, privatePeers []string, maxValidators int) (PeersConfig, error) {
+	var (
+		cfg  PeersConfig
+		seen = make(map[string]bool)
+	)
+	addPeer := func(peer string, unconditional bool) {
+		id := peer[:strings.Index(peer, "@")]
+		if seen[id] {
+			return
+		}
+		seen[id] = true
+		cfg.PersistentPeers = append(cfg.PersistentPeers, peer)
+		if unconditional {
+			cfg.UnconditionalPeerIDs = append(cfg.UnconditionalPeerIDs, id)
+		}
+	}
+
+	switch role {
+	case srvconfig.RoleValidator:
+		if operator == "" {
+			return cfg, fmt.Errorf("the configuration of a validator requires its operator address")
+		}
+		for _, val := range validators {
+			if val.OperatorAddress != operator {
+				continue
+			}
+			peers, err := types.ParsePeers(val.Description.Peers)
+			if err != nil {
+				return cfg, fmt.Errorf("invalid peers of validator %s: %w", operator, err)
+			}
+			for _, peer := range peers {
+				addPeer(peer, true)
+			}
+		}
+		if len(cfg.PersistentPeers) == 0 {
+			return cfg, fmt.Errorf("validator %s is not bonded or has no registered peers", operator)
+		}
+
+		return cfg, nil
+
+	case srvconfig.RoleSentry:
+		if len(privatePeers) == 0 {
+			return cfg, fmt.Errorf("the configuration of a sentry requires the private peers of its validator")
+		}
+		for _, peer := range privatePeers {
+			addPeer(peer, true)
+			cfg.PrivatePeerIDs = append(cfg.PrivatePeerIDs, peer[:strings.Index(peer, "@")])
+		}
+
+	case "":
+
+	default:
+		return cfg, fmt.Errorf("unsupported role %q, expected %s, %s or none", role, srvconfig.RoleValidator, srvconfig.RoleSentry)
+	}
+
+	validators = append([]types.Validator(nil), validators...)
+	sort.SliceStable(validators, func(i, j int) bool {
+		return validators[i].Tokens.GT(validators[j].Tokens)
+	})
+
+	n := 0
+	for _, val := range validators {
+		if val.OperatorAddress == operator || val.Description.Peers == "" {
+			continue
+		}
+		if maxValidators > 0 && n == maxValidators {
+			break
+		}
+		// the peers are validated when registered, but not the ones of the
+		// validators imported from a genesis file
+		peers, err := types.ParsePeers(val.Description.Peers)
+		if err != nil {
+			continue
+		}
+		for _, peer := range peers {
+			addPeer(peer, false)
+		}
+		n++
+	}
+
+	return cfg, nil
+}
+
+// GetCmdQueryPeersConfig implements the command generating the P2P
+// configuration of a node from the public endpoints of the validators.
+func GetCmdQueryPeersConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "peers-config",
+		Short: "Generate the persistent peers of a node from the public endpoints of the bonded validators",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Generate the P2P configuration of a node, i.e. its persistent_peers,
+private_peer_ids and unconditional_peer_ids, from the public P2P endpoints
+registered by the bonded validators in the peers field of their descriptions:
+
+- with --role validator, the node connects to the endpoints registered by its
+validator, given by --validator, i.e. to its sentries;
+- with --role sentry, the node connects to the endpoints of the other validators
+and to the nodes of its validator, given by --private-peers, whose IDs are kept
+private;
+- otherwise, the node connects to the endpoints of the validators.
+
+The configuration is printed in the config.toml format, or written to the
+config.toml of the node home with --write.
+
+Example:
+$ %s query staking peers-config --max-validators 20
+$ %s query staking peers-config --role sentry --validator cosmosvaloper1... --private-peers <node-id>@10.0.0.2:26656 --write
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			role, _ := cmd.Flags().GetString(FlagRole)
+			operator, _ := cmd.Flags().GetString(FlagAddressValidator)
+			maxValidators, _ := cmd.Flags().GetInt(FlagMaxValidators)
+			privatePeersStr, _ := cmd.Flags().GetString(FlagPrivatePeers)
+			privatePeers, err := types.ParsePeers(privatePeersStr)
+			if err != nil {
+				return err
+			}
+
+			var (
+				validators []types.Validator
+				pageReq    = &query.PageRequest{Limit: 200}
+			)
+			for {
+				res, err := queryClient.Validators(cmd.Context(), &types.QueryValidatorsRequest{
+					Status:     types.BondStatusBonded,
+					Pagination: pageReq,
+				})
+				if err != nil {
+					return err
+				}
+				validators = append(validators, res.Validators...)
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: pageReq.Limit}
+			}
+
+			cfg, err := BuildPeersConfig(validators, role, operator, privatePeers, maxValidators)
+			if err != nil {
+				return err
+			}
+
+			if write, _ := cmd.Flags().GetBool(FlagWrite); write {
+				tmConfig := server.GetServerContextFromCmd(cmd).Config
+				tmConfig.P2P.PersistentPeers = strings.Join(cfg.PersistentPeers, ",")
+				tmConfig.P2P.PrivatePeerIDs = strings.Join(cfg.PrivatePeerIDs, ",")
+				tmConfig.P2P.UnconditionalPeerIDs = strings.Join(cfg.UnconditionalPeerIDs, ",")
+				configPath := filepath.Join(tmConfig.RootDir, "config", "config.toml")
+				tmcfg.WriteConfigFile(configPath, tmConfig)
+
+				fmt.Fprintf(cmd.OutOrStdout(), "Wrote %d persistent peers to %s\n", len(cfg.PersistentPeers), configPath)
+				return nil
+			}
+
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "persistent_peers = %q\n", strings.Join(cfg.PersistentPeers, ","))
+			fmt.Fprintf(out, "private_peer_ids = %q\n", strings.Join(cfg.PrivatePeerIDs, ","))
+			fmt.Fprintf(out, "unconditional_peer_ids = %q\n", strings.Join(cfg.UnconditionalPeerIDs, ","))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagRole, "", fmt.Sprintf("The role of the node (%s|%s), none for a full node", srvconfig.RoleValidator, srvconfig.RoleSentry))
+	cmd.Flags().String(FlagAddressValidator, "", "The operator address of the validator of the node, whose endpoints are excluded from the ones of the other validators")
+	cmd.Flags().String(FlagPrivatePeers, "", "The comma-separated P2P addresses of the nodes of the validator of a sentry (node_id@host:port)")
+	cmd.Flags().Int(FlagMaxValidators, 0, "The maximum number of validators, with the most tokens, to connect to, all if 0")
+	cmd.Flags().Bool(FlagWrite, false, "Write the configuration to the config.toml of the node home")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
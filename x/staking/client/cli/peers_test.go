@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	srvconfig "github.com/cosmos/cosmos-sdk/server/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestBuildPeersConfig(t *testing.T) {
+	const (
+		id1 = "1111111111111111111111111111111111111111"
+		id2 = "2222222222222222222222222222222222222222"
+		id3 = "3333333333333333333333333333333333333333"
+		id4 = "4444444444444444444444444444444444444444"
+		idV = "5555555555555555555555555555555555555555"
+	)
+	newValidator := func(operator string, tokens int64, peers string) types.Validator {
+		return types.Validator{
+			OperatorAddress: operator,
+			Tokens:          sdk.NewInt(tokens),
+			Description:     types.Description{Peers: peers},
+		}
+	}
+	validators := []types.Validator{
+		newValidator("val1", 10, id1+"@1.1.1.1:26656"),
+		newValidator("val2", 30, id2+"@2.2.2.2:26656,"+id3+"@3.3.3.3:26656"),
+		newValidator("val3", 20, ""),
+		newValidator("val4", 40, id4+"@4.4.4.4:26656"),
+		newValidator("invalid", 50, "invalid"),
+		// the same sentry registered twice is connected to once
+		newValidator("val5", 5, id1+"@1.1.1.1:26656"),
+	}
+	privatePeer := idV + "@10.0.0.2:26656"
+
+	testCases := []struct {
+		name          string
+		role          string
+		operator      string
+		privatePeers  []string
+		maxValidators int
+		expected      PeersConfig
+		expErr        bool
+	}{
+		{
+			name: "full node",
+			expected: PeersConfig{PersistentPeers: []string{
+				id4 + "@4.4.4.4:26656", id2 + "@2.2.2.2:26656", id3 + "@3.3.3.3:26656", id1 + "@1.1.1.1:26656",
+			}},
+		},
+		{
+			name:          "full node with max validators",
+			maxValidators: 2,
+			expected: PeersConfig{PersistentPeers: []string{
+				id4 + "@4.4.4.4:26656", id2 + "@2.2.2.2:26656", id3 + "@3.3.3.3:26656",
+			}},
+		},
+		{
+			name:     "validator",
+			role:     srvconfig.RoleValidator,
+			operator: "val2",
+			expected: PeersConfig{
+				PersistentPeers:      []string{id2 + "@2.2.2.2:26656", id3 + "@3.3.3.3:26656"},
+				UnconditionalPeerIDs: []string{id2, id3},
+			},
+		},
+		{name: "validator without operator", role: srvconfig.RoleValidator, expErr: true},
+		{name: "validator without peers", role: srvconfig.RoleValidator, operator: "val3", expErr: true},
+		{name: "unbonded validator", role: srvconfig.RoleValidator, operator: "unknown", expErr: true},
+		{
+			name:          "sentry",
+			role:          srvconfig.RoleSentry,
+			operator:      "val2",
+			privatePeers:  []string{privatePeer},
+			maxValidators: 2,
+			expected: PeersConfig{
+				PersistentPeers:      []string{privatePeer, id4 + "@4.4.4.4:26656", id1 + "@1.1.1.1:26656"},
+				PrivatePeerIDs:       []string{idV},
+				UnconditionalPeerIDs: []string{idV},
+			},
+		},
+		{name: "sentry without private peers", role: srvconfig.RoleSentry, operator: "val2", expErr: true},
+		{name: "unknown role", role: srvconfig.RoleSeed, expErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg, err := BuildPeersConfig(validators, tc.role, tc.operator, tc.privatePeers, tc.maxValidators)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, cfg)
+		})
+	}
+
+	// the validators are not reordered
+	require.Equal(t, "val1", validators[0].OperatorAddress)
+}
@@ -39,6 +39,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryHistoricalInfo(),
 		GetCmdQueryParams(),
 		GetCmdQueryPool(),
+		GetCmdQueryPeersConfig(),
 	)
 
 	return stakingQueryCmd
@@ -60,7 +61,8 @@ $ %s query staking validator %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 				version.AppName, bech32PrefixValAddr,
 			),
 		),
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: CompleteValidatorArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -99,8 +101,9 @@ func GetCmdQueryValidators() *cobra.Command {
 
 Example:
 $ %s query staking validators
+$ %s query staking validators --status=BOND_STATUS_BONDED --moniker-prefix=cosmos
 `,
-				version.AppName,
+				version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -114,9 +117,21 @@ $ %s query staking validators
 				return err
 			}
 
+			valStatus, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+
+			monikerPrefix, err := cmd.Flags().GetString(FlagMonikerPrefix)
+			if err != nil {
+				return err
+			}
+
 			result, err := queryClient.Validators(cmd.Context(), &types.QueryValidatorsRequest{
-				// Leaving status empty on purpose to query all validators.
-				Pagination: pageReq,
+				// An empty status queries the validators of all statuses.
+				Status:        valStatus,
+				MonikerPrefix: monikerPrefix,
+				Pagination:    pageReq,
 			})
 			if err != nil {
 				return err
@@ -126,6 +141,8 @@ $ %s query staking validators
 		},
 	}
 
+	cmd.Flags().String(FlagStatus, "", "Only query the validators with the given status, e.g. BOND_STATUS_BONDED")
+	cmd.Flags().String(FlagMonikerPrefix, "", "Only query the validators whose moniker starts with the given prefix")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "validators")
 
@@ -148,7 +165,8 @@ $ %s query staking unbonding-delegations-from %s1gghjut3ccd8ay0zduzj64hwre2fxs9l
 				version.AppName, bech32PrefixValAddr,
 			),
 		),
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: CompleteValidatorArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -203,7 +221,8 @@ $ %s query staking redelegations-from %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 				version.AppName, bech32PrefixValAddr,
 			),
 		),
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: CompleteValidatorArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -258,7 +277,8 @@ $ %s query staking delegation %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1gghju
 				version.AppName, bech32PrefixAccAddr, bech32PrefixValAddr,
 			),
 		),
-		Args: cobra.ExactArgs(2),
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: CompleteValidatorArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -367,7 +387,8 @@ $ %s query staking delegations-to %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 				version.AppName, bech32PrefixValAddr,
 			),
 		),
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: CompleteValidatorArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -423,7 +444,8 @@ $ %s query staking unbonding-delegation %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9
 				version.AppName, bech32PrefixAccAddr, bech32PrefixValAddr,
 			),
 		),
-		Args: cobra.ExactArgs(2),
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: CompleteValidatorArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -533,7 +555,8 @@ $ %s query staking redelegation %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1l2r
 				version.AppName, bech32PrefixAccAddr, bech32PrefixValAddr, bech32PrefixValAddr,
 			),
 		),
-		Args: cobra.ExactArgs(3),
+		Args:              cobra.ExactArgs(3),
+		ValidArgsFunction: CompleteValidatorArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -104,6 +104,7 @@ func NewEditValidatorCmd() *cobra.Command {
 			security, _ := cmd.Flags().GetString(FlagSecurityContact)
 			details, _ := cmd.Flags().GetString(FlagDetails)
 			description := types.NewDescription(moniker, identity, website, security, details)
+			description.Peers, _ = cmd.Flags().GetString(FlagPeers)
 
 			var newRate *sdk.Dec
 
@@ -147,9 +148,10 @@ func NewDelegateCmd() *cobra.Command {
 	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
 
 	cmd := &cobra.Command{
-		Use:   "delegate [validator-addr] [amount]",
-		Args:  cobra.ExactArgs(2),
-		Short: "Delegate liquid tokens to a validator",
+		Use:               "delegate [validator-addr] [amount]",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: CompleteValidatorArgs(0),
+		Short:             "Delegate liquid tokens to a validator",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Delegate an amount of liquid coins to a validator from your wallet.
 
@@ -190,9 +192,10 @@ func NewRedelegateCmd() *cobra.Command {
 	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
 
 	cmd := &cobra.Command{
-		Use:   "redelegate [src-validator-addr] [dst-validator-addr] [amount]",
-		Short: "Redelegate illiquid tokens from one validator to another",
-		Args:  cobra.ExactArgs(3),
+		Use:               "redelegate [src-validator-addr] [dst-validator-addr] [amount]",
+		Short:             "Redelegate illiquid tokens from one validator to another",
+		Args:              cobra.ExactArgs(3),
+		ValidArgsFunction: CompleteValidatorArgs(0, 1),
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Redelegate an amount of illiquid staking tokens from one validator to another.
 
@@ -238,9 +241,10 @@ func NewUnbondCmd() *cobra.Command {
 	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
 
 	cmd := &cobra.Command{
-		Use:   "unbond [validator-addr] [amount]",
-		Short: "Unbond shares from a validator",
-		Args:  cobra.ExactArgs(2),
+		Use:               "unbond [validator-addr] [amount]",
+		Short:             "Unbond shares from a validator",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: CompleteValidatorArgs(0),
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Unbond an amount of bonded shares from a validator.
 
@@ -307,6 +311,7 @@ func newBuildCreateValidatorMsg(clientCtx client.Context, txf tx.Factory, fs *fl
 		security,
 		details,
 	)
+	description.Peers, _ = fs.GetString(FlagPeers)
 
 	// get the initial validator commission parameters
 	rateStr, _ := fs.GetString(FlagCommissionRate)
@@ -360,6 +365,7 @@ func CreateValidatorMsgFlagSet(ipDefault string) (fs *flag.FlagSet, defaultsDesc
 	fsCreateValidator.String(FlagSecurityContact, "", "The validator's (optional) security contact email")
 	fsCreateValidator.String(FlagDetails, "", "The validator's (optional) details")
 	fsCreateValidator.String(FlagIdentity, "", "The (optional) identity signature (ex. UPort or Keybase)")
+	fsCreateValidator.String(FlagPeers, "", "The validator's (optional) comma-separated public P2P endpoints, e.g. of its sentries (node_id@host:port)")
 	fsCreateValidator.AddFlagSet(FlagSetCommissionCreate())
 	fsCreateValidator.AddFlagSet(FlagSetMinSelfDelegation())
 	fsCreateValidator.AddFlagSet(FlagSetAmount())
@@ -397,6 +403,7 @@ type TxCreateValidatorConfig struct {
 	SecurityContact string
 	Details         string
 	Identity        string
+	Peers           string
 }
 
 func PrepareConfigForTxCreateValidator(flagSet *flag.FlagSet, moniker, nodeID, chainID string, valPubKey cryptotypes.PubKey) (TxCreateValidatorConfig, error) {
@@ -436,6 +443,11 @@ func PrepareConfigForTxCreateValidator(flagSet *flag.FlagSet, moniker, nodeID, c
 	}
 	c.Identity = identity
 
+	c.Peers, err = flagSet.GetString(FlagPeers)
+	if err != nil {
+		return c, err
+	}
+
 	c.Amount, err = flagSet.GetString(FlagAmount)
 	if err != nil {
 		return c, err
@@ -510,6 +522,7 @@ func BuildCreateValidatorMsg(clientCtx client.Context, config TxCreateValidatorC
 		config.SecurityContact,
 		config.Details,
 	)
+	description.Peers = config.Peers
 
 	// get the initial validator commission parameters
 	rateStr := config.CommissionRate
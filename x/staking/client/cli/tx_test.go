@@ -17,6 +17,7 @@ func TestPrepareConfigForTxCreateValidator(t *testing.T) {
 	privKey := ed25519.GenPrivKey()
 	valPubKey := privKey.PubKey()
 	moniker := "DefaultMoniker"
+	peers := "d5ba1c5bae9d9bd2bd5c9e1c4b1ba7d5c9ed0a8f@sentry.example.com:26656"
 	mkTxValCfg := func(amount, commission, commissionMax, commissionMaxChange, minSelfDelegation string) TxCreateValidatorConfig {
 		return TxCreateValidatorConfig{
 			IP:                      ip,
@@ -75,6 +76,17 @@ func TestPrepareConfigForTxCreateValidator(t *testing.T) {
 			},
 			expectedCfg: mkTxValCfg(defaultAmount, "0.1", "0.2", "0.01", "0.33"),
 		},
+		{
+			name: "Custom peers",
+			fsModify: func(fs *pflag.FlagSet) {
+				fs.Set(FlagPeers, peers)
+			},
+			expectedCfg: func() TxCreateValidatorConfig {
+				cfg := mkTxValCfg(defaultAmount, "0.1", "0.2", "0.01", "1")
+				cfg.Peers = peers
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tc := range tests {
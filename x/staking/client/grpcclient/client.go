@@ -0,0 +1,382 @@
+// Code generated by typedgen. DO NOT EDIT.
+
+// Package grpcclient implements the typed clients of the staking module.
+package grpcclient
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/typed"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// QueryClient is the typed client of the staking Query service, retrying
+// the queries failing with a transient error.
+type QueryClient struct {
+	client types.QueryClient
+	retry  typed.RetryConfig
+}
+
+// NewQueryClient returns a QueryClient querying through the connection.
+func NewQueryClient(conn gogogrpc.ClientConn, retry typed.RetryConfig) *QueryClient {
+	return &QueryClient{
+		client: types.NewQueryClient(conn),
+		retry:  retry,
+	}
+}
+
+// Delegation calls the staking Query/Delegation method.
+func (c *QueryClient) Delegation(ctx context.Context, req *types.QueryDelegationRequest, opts ...grpc.CallOption) (*types.QueryDelegationResponse, error) {
+	var res *types.QueryDelegationResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Delegation(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// DelegatorDelegations calls the staking Query/DelegatorDelegations method.
+func (c *QueryClient) DelegatorDelegations(ctx context.Context, req *types.QueryDelegatorDelegationsRequest, opts ...grpc.CallOption) (*types.QueryDelegatorDelegationsResponse, error) {
+	var res *types.QueryDelegatorDelegationsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.DelegatorDelegations(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateDelegatorDelegations calls the staking Query/DelegatorDelegations method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateDelegatorDelegations(ctx context.Context, req *types.QueryDelegatorDelegationsRequest, fn func(*types.QueryDelegatorDelegationsResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq types.QueryDelegatorDelegationsRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.DelegatorDelegations(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// DelegatorUnbondingDelegations calls the staking Query/DelegatorUnbondingDelegations method.
+func (c *QueryClient) DelegatorUnbondingDelegations(ctx context.Context, req *types.QueryDelegatorUnbondingDelegationsRequest, opts ...grpc.CallOption) (*types.QueryDelegatorUnbondingDelegationsResponse, error) {
+	var res *types.QueryDelegatorUnbondingDelegationsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.DelegatorUnbondingDelegations(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateDelegatorUnbondingDelegations calls the staking Query/DelegatorUnbondingDelegations method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateDelegatorUnbondingDelegations(ctx context.Context, req *types.QueryDelegatorUnbondingDelegationsRequest, fn func(*types.QueryDelegatorUnbondingDelegationsResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq types.QueryDelegatorUnbondingDelegationsRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.DelegatorUnbondingDelegations(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// DelegatorValidator calls the staking Query/DelegatorValidator method.
+func (c *QueryClient) DelegatorValidator(ctx context.Context, req *types.QueryDelegatorValidatorRequest, opts ...grpc.CallOption) (*types.QueryDelegatorValidatorResponse, error) {
+	var res *types.QueryDelegatorValidatorResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.DelegatorValidator(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// DelegatorValidators calls the staking Query/DelegatorValidators method.
+func (c *QueryClient) DelegatorValidators(ctx context.Context, req *types.QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*types.QueryDelegatorValidatorsResponse, error) {
+	var res *types.QueryDelegatorValidatorsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.DelegatorValidators(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateDelegatorValidators calls the staking Query/DelegatorValidators method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateDelegatorValidators(ctx context.Context, req *types.QueryDelegatorValidatorsRequest, fn func(*types.QueryDelegatorValidatorsResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq types.QueryDelegatorValidatorsRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.DelegatorValidators(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// HistoricalInfo calls the staking Query/HistoricalInfo method.
+func (c *QueryClient) HistoricalInfo(ctx context.Context, req *types.QueryHistoricalInfoRequest, opts ...grpc.CallOption) (*types.QueryHistoricalInfoResponse, error) {
+	var res *types.QueryHistoricalInfoResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.HistoricalInfo(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// Params calls the staking Query/Params method.
+func (c *QueryClient) Params(ctx context.Context, req *types.QueryParamsRequest, opts ...grpc.CallOption) (*types.QueryParamsResponse, error) {
+	var res *types.QueryParamsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Params(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// Pool calls the staking Query/Pool method.
+func (c *QueryClient) Pool(ctx context.Context, req *types.QueryPoolRequest, opts ...grpc.CallOption) (*types.QueryPoolResponse, error) {
+	var res *types.QueryPoolResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Pool(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// Redelegations calls the staking Query/Redelegations method.
+func (c *QueryClient) Redelegations(ctx context.Context, req *types.QueryRedelegationsRequest, opts ...grpc.CallOption) (*types.QueryRedelegationsResponse, error) {
+	var res *types.QueryRedelegationsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Redelegations(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateRedelegations calls the staking Query/Redelegations method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateRedelegations(ctx context.Context, req *types.QueryRedelegationsRequest, fn func(*types.QueryRedelegationsResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq types.QueryRedelegationsRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.Redelegations(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// UnbondingDelegation calls the staking Query/UnbondingDelegation method.
+func (c *QueryClient) UnbondingDelegation(ctx context.Context, req *types.QueryUnbondingDelegationRequest, opts ...grpc.CallOption) (*types.QueryUnbondingDelegationResponse, error) {
+	var res *types.QueryUnbondingDelegationResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.UnbondingDelegation(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// Validator calls the staking Query/Validator method.
+func (c *QueryClient) Validator(ctx context.Context, req *types.QueryValidatorRequest, opts ...grpc.CallOption) (*types.QueryValidatorResponse, error) {
+	var res *types.QueryValidatorResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Validator(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// ValidatorDelegations calls the staking Query/ValidatorDelegations method.
+func (c *QueryClient) ValidatorDelegations(ctx context.Context, req *types.QueryValidatorDelegationsRequest, opts ...grpc.CallOption) (*types.QueryValidatorDelegationsResponse, error) {
+	var res *types.QueryValidatorDelegationsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.ValidatorDelegations(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateValidatorDelegations calls the staking Query/ValidatorDelegations method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateValidatorDelegations(ctx context.Context, req *types.QueryValidatorDelegationsRequest, fn func(*types.QueryValidatorDelegationsResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq types.QueryValidatorDelegationsRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.ValidatorDelegations(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// ValidatorUnbondingDelegations calls the staking Query/ValidatorUnbondingDelegations method.
+func (c *QueryClient) ValidatorUnbondingDelegations(ctx context.Context, req *types.QueryValidatorUnbondingDelegationsRequest, opts ...grpc.CallOption) (*types.QueryValidatorUnbondingDelegationsResponse, error) {
+	var res *types.QueryValidatorUnbondingDelegationsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.ValidatorUnbondingDelegations(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateValidatorUnbondingDelegations calls the staking Query/ValidatorUnbondingDelegations method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateValidatorUnbondingDelegations(ctx context.Context, req *types.QueryValidatorUnbondingDelegationsRequest, fn func(*types.QueryValidatorUnbondingDelegationsResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq types.QueryValidatorUnbondingDelegationsRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.ValidatorUnbondingDelegations(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// Validators calls the staking Query/Validators method.
+func (c *QueryClient) Validators(ctx context.Context, req *types.QueryValidatorsRequest, opts ...grpc.CallOption) (*types.QueryValidatorsResponse, error) {
+	var res *types.QueryValidatorsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.Validators(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// IterateValidators calls the staking Query/Validators method for each
+// page starting at the page request of req, and calls fn with each response
+// until the last page or until fn returns false.
+func (c *QueryClient) IterateValidators(ctx context.Context, req *types.QueryValidatorsRequest, fn func(*types.QueryValidatorsResponse) bool, opts ...grpc.CallOption) error {
+	var pageReq types.QueryValidatorsRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	return typed.Paginate(ctx, pageReq.Pagination, func(ctx context.Context, pagination *query.PageRequest) (*query.PageResponse, bool, error) {
+		pageReq.Pagination = pagination
+		res, err := c.Validators(ctx, &pageReq, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return res.Pagination, fn(res), nil
+	})
+}
+
+// MsgClient is the typed client of the staking Msg service, broadcasting a
+// transaction of each message.
+type MsgClient struct {
+	broadcaster typed.Broadcaster
+}
+
+// NewMsgClient returns a MsgClient broadcasting the transactions with the
+// broadcaster.
+func NewMsgClient(broadcaster typed.Broadcaster) *MsgClient {
+	return &MsgClient{broadcaster: broadcaster}
+}
+
+// BeginRedelegate broadcasts a transaction of the staking Msg/BeginRedelegate message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) BeginRedelegate(ctx context.Context, msg *types.MsgBeginRedelegate) (*types.MsgBeginRedelegateResponse, *sdk.TxResponse, error) {
+	res := &types.MsgBeginRedelegateResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
+
+// CreateValidator broadcasts a transaction of the staking Msg/CreateValidator message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) CreateValidator(ctx context.Context, msg *types.MsgCreateValidator) (*types.MsgCreateValidatorResponse, *sdk.TxResponse, error) {
+	res := &types.MsgCreateValidatorResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
+
+// Delegate broadcasts a transaction of the staking Msg/Delegate message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) Delegate(ctx context.Context, msg *types.MsgDelegate) (*types.MsgDelegateResponse, *sdk.TxResponse, error) {
+	res := &types.MsgDelegateResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
+
+// EditValidator broadcasts a transaction of the staking Msg/EditValidator message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) EditValidator(ctx context.Context, msg *types.MsgEditValidator) (*types.MsgEditValidatorResponse, *sdk.TxResponse, error) {
+	res := &types.MsgEditValidatorResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
+
+// Undelegate broadcasts a transaction of the staking Msg/Undelegate message.
+// Its response is only set if the transaction is broadcast in block mode.
+func (c *MsgClient) Undelegate(ctx context.Context, msg *types.MsgUndelegate) (*types.MsgUndelegateResponse, *sdk.TxResponse, error) {
+	res := &types.MsgUndelegateResponse{}
+	txRes, err := typed.BroadcastMsg(ctx, c.broadcaster, msg, res)
+
+	return res, txRes, err
+}
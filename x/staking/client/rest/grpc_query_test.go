@@ -55,8 +55,8 @@ func (s *IntegrationTestSuite) SetupSuite() {
 		val.ValAddress,
 		val2.ValAddress,
 		unbond,
-		fmt.Sprintf("--%s=%d", flags.FlagGas, 254000),
-	) // expected gas is 202987
+		fmt.Sprintf("--%s=%d", flags.FlagGas, 350000),
+	) // expected gas is 301017
 
 	s.Require().NoError(err)
 	_, err = s.network.WaitForHeight(1)
@@ -225,6 +225,7 @@ func (s *IntegrationTestSuite) TestQueryValidatorDelegationsGRPC() {
 				s.Require().Error(err)
 			} else {
 				s.Require().NoError(err)
+				s.clearDelegationTimestamps(tc.respType)
 				s.Require().Equal(tc.expectedResp.String(), tc.respType.String())
 			}
 		})
@@ -348,6 +349,7 @@ func (s *IntegrationTestSuite) TestQueryDelegationGRPC() {
 				s.Require().Error(err)
 			} else {
 				s.Require().NoError(err)
+				s.clearDelegationTimestamps(tc.respType)
 				s.Require().Equal(tc.expectedResp.String(), tc.respType.String())
 			}
 		})
@@ -487,6 +489,7 @@ func (s *IntegrationTestSuite) TestQueryDelegatorDelegationsGRPC() {
 				s.Require().Error(err)
 			} else {
 				s.Require().NoError(err)
+				s.clearDelegationTimestamps(tc.respType)
 				s.Require().Equal(tc.expectedResp.String(), tc.respType.String())
 			}
 		})
@@ -825,3 +828,29 @@ func (s *IntegrationTestSuite) TestQueryPoolGRPC() {
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
+
+// clearDelegationTimestamps checks that the timestamps of the delegations of
+// a response are set, and clears them as they depend on the block times of the
+// network.
+func (s *IntegrationTestSuite) clearDelegationTimestamps(resp proto.Message) {
+	var resps []*types.DelegationResponse
+	switch resp := resp.(type) {
+	case *types.QueryDelegationResponse:
+		resps = append(resps, resp.DelegationResponse)
+	case *types.QueryDelegatorDelegationsResponse:
+		for i := range resp.DelegationResponses {
+			resps = append(resps, &resp.DelegationResponses[i])
+		}
+	case *types.QueryValidatorDelegationsResponse:
+		for i := range resp.DelegationResponses {
+			resps = append(resps, &resp.DelegationResponses[i])
+		}
+	}
+
+	for _, res := range resps {
+		s.Require().NotNil(res.Timestamps)
+		s.Require().False(res.Timestamps.CreatedAt.IsZero())
+		s.Require().False(res.Timestamps.UpdatedAt.Before(res.Timestamps.CreatedAt))
+		res.Timestamps = nil
+	}
+}
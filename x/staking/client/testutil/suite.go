@@ -19,6 +19,7 @@ import (
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
 	"github.com/cosmos/cosmos-sdk/x/staking/client/cli"
@@ -61,7 +62,7 @@ func (s *IntegrationTestSuite) SetupSuite() {
 		val.ValAddress,
 		val2.ValAddress,
 		unbond,
-		fmt.Sprintf("--%s=%d", flags.FlagGas, 300000),
+		fmt.Sprintf("--%s=%d", flags.FlagGas, 350000),
 	)
 	s.Require().NoError(err)
 	_, err = s.network.WaitForHeight(1)
@@ -362,6 +363,7 @@ func (s *IntegrationTestSuite) TestGetCmdQueryDelegation() {
 			} else {
 				s.Require().NoError(err)
 				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+				s.clearDelegationTimestamps(tc.respType)
 				s.Require().Equal(tc.expected.String(), tc.respType.String())
 			}
 		})
@@ -418,6 +420,7 @@ func (s *IntegrationTestSuite) TestGetCmdQueryDelegations() {
 			} else {
 				s.Require().NoError(err)
 				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+				s.clearDelegationTimestamps(tc.respType)
 				s.Require().Equal(tc.expected.String(), tc.respType.String())
 			}
 		})
@@ -474,6 +477,7 @@ func (s *IntegrationTestSuite) TestGetCmdQueryValidatorDelegations() {
 			} else {
 				s.Require().NoError(err)
 				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+				s.clearDelegationTimestamps(tc.respType)
 				s.Require().Equal(tc.expected.String(), tc.respType.String())
 			}
 		})
@@ -1012,6 +1016,28 @@ func (s *IntegrationTestSuite) TestNewEditValidatorCmd() {
 			},
 			false, 0, &sdk.TxResponse{},
 		},
+		{
+			"edit validator peers",
+			[]string{
+				fmt.Sprintf("--peers=%s", "4444444444444444444444444444444444444444@4.4.4.4:26656"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, 0, &sdk.TxResponse{},
+		},
+		{
+			"edit validator with invalid peers",
+			[]string{
+				fmt.Sprintf("--peers=%s", "invalid"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, sdkerrors.ErrInvalidRequest.ABCICode(), &sdk.TxResponse{},
+		},
 		{
 			"with all edit flags",
 			[]string{
@@ -1255,6 +1281,7 @@ func (s *IntegrationTestSuite) TestNewUnbondCmd() {
 				val.ValAddress.String(),
 				sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(150)).String(),
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=%d", flags.FlagGas, 300000),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
@@ -1353,3 +1380,29 @@ func (s *IntegrationTestSuite) TestBlockResults() {
 		s.network.WaitForNextBlock()
 	}
 }
+
+// clearDelegationTimestamps checks that the timestamps of the delegations of
+// a response are set, and clears them as they depend on the block times of the
+// network.
+func (s *IntegrationTestSuite) clearDelegationTimestamps(resp proto.Message) {
+	var resps []*types.DelegationResponse
+	switch resp := resp.(type) {
+	case *types.DelegationResponse:
+		resps = append(resps, resp)
+	case *types.QueryDelegatorDelegationsResponse:
+		for i := range resp.DelegationResponses {
+			resps = append(resps, &resp.DelegationResponses[i])
+		}
+	case *types.QueryValidatorDelegationsResponse:
+		for i := range resp.DelegationResponses {
+			resps = append(resps, &resp.DelegationResponses[i])
+		}
+	}
+
+	for _, res := range resps {
+		s.Require().NotNil(res.Timestamps)
+		s.Require().False(res.Timestamps.CreatedAt.IsZero())
+		s.Require().False(res.Timestamps.UpdatedAt.Before(res.Timestamps.CreatedAt))
+		res.Timestamps = nil
+	}
+}
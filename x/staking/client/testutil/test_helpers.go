@@ -42,6 +42,7 @@ func MsgUnbondExec(clientCtx client.Context, from fmt.Stringer, valAddress,
 		valAddress.String(),
 		amount.String(),
 		fmt.Sprintf("--%s=%s", flags.FlagFrom, from.String()),
+		fmt.Sprintf("--%s=%d", flags.FlagGas, 300000),
 	}
 
 	args = append(args, commonArgs...)
@@ -1,14 +1,18 @@
 package staking
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmtypes "github.com/tendermint/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -22,83 +26,191 @@ func InitGenesis(
 	ctx sdk.Context, keeper keeper.Keeper, accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper, data *types.GenesisState,
 ) (res []abci.ValidatorUpdate) {
-	bondedTokens := sdk.ZeroInt()
-	notBondedTokens := sdk.ZeroInt()
+	return newGenesisInitializer(ctx, keeper).init(accountKeeper, bankKeeper, data)
+}
+
+// InitGenesisFromReader is InitGenesis with a JSON genesis state read from r,
+// without holding its validators, delegations, unbonding delegations and
+// redelegations in memory.
+func InitGenesisFromReader(
+	ctx sdk.Context, keeper keeper.Keeper, accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper, cdc codec.JSONCodec, r io.Reader,
+) ([]abci.ValidatorUpdate, error) {
+	gi := newGenesisInitializer(ctx, keeper)
+
+	var data types.GenesisState
+	err := module.ReadGenesis(cdc, r, &data, map[string]func(json.RawMessage) error{
+		"validators": func(bz json.RawMessage) error {
+			var validator types.Validator
+			if err := cdc.UnmarshalJSON(bz, &validator); err != nil {
+				return err
+			}
+
+			gi.initValidator(validator)
+			return nil
+		},
+		"delegations": func(bz json.RawMessage) error {
+			var delegation types.Delegation
+			if err := cdc.UnmarshalJSON(bz, &delegation); err != nil {
+				return err
+			}
 
+			gi.keeper.SetDelegation(gi.ctx, delegation)
+			return nil
+		},
+		"unbonding_delegations": func(bz json.RawMessage) error {
+			var ubd types.UnbondingDelegation
+			if err := cdc.UnmarshalJSON(bz, &ubd); err != nil {
+				return err
+			}
+
+			gi.initUnbondingDelegation(ubd)
+			return nil
+		},
+		"redelegations": func(bz json.RawMessage) error {
+			var red types.Redelegation
+			if err := cdc.UnmarshalJSON(bz, &red); err != nil {
+				return err
+			}
+
+			gi.initRedelegation(red)
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return gi.init(accountKeeper, bankKeeper, &data), nil
+}
+
+// genesisInitializer sets the parts of a genesis state one at a time, so that
+// they can be streamed, and tallies the tokens of the bonded and not bonded
+// pools.
+type genesisInitializer struct {
+	ctx             sdk.Context
+	keeper          keeper.Keeper
+	bondedTokens    sdk.Int
+	notBondedTokens sdk.Int
+}
+
+func newGenesisInitializer(ctx sdk.Context, keeper keeper.Keeper) *genesisInitializer {
 	// We need to pretend to be "n blocks before genesis", where "n" is the
 	// validator update delay, so that e.g. slashing periods are correctly
 	// initialized for the validator set e.g. with a one-block offset - the
 	// first TM block is at height 1, so state updates applied from
 	// genesis.json are in block 0.
-	ctx = ctx.WithBlockHeight(1 - sdk.ValidatorUpdateDelay)
+	return &genesisInitializer{
+		ctx:             ctx.WithBlockHeight(1 - sdk.ValidatorUpdateDelay),
+		keeper:          keeper,
+		bondedTokens:    sdk.ZeroInt(),
+		notBondedTokens: sdk.ZeroInt(),
+	}
+}
+
+func (gi *genesisInitializer) initValidator(validator types.Validator) {
+	gi.keeper.SetValidator(gi.ctx, validator)
+
+	// Manually set indices for the first time
+	gi.keeper.SetValidatorByConsAddr(gi.ctx, validator)
+	gi.keeper.SetValidatorByPowerIndex(gi.ctx, validator)
+
+	// update timeslice if necessary
+	if validator.IsUnbonding() {
+		gi.keeper.InsertUnbondingValidatorQueue(gi.ctx, validator)
+	}
+
+	switch validator.GetStatus() {
+	case types.Bonded:
+		gi.bondedTokens = gi.bondedTokens.Add(validator.GetTokens())
+	case types.Unbonding, types.Unbonded:
+		gi.notBondedTokens = gi.notBondedTokens.Add(validator.GetTokens())
+	default:
+		panic("invalid validator status")
+	}
+}
+
+func (gi *genesisInitializer) initUnbondingDelegation(ubd types.UnbondingDelegation) {
+	gi.keeper.SetUnbondingDelegation(gi.ctx, ubd)
+
+	for _, entry := range ubd.Entries {
+		gi.keeper.InsertUBDQueue(gi.ctx, ubd, entry.CompletionTime)
+		gi.notBondedTokens = gi.notBondedTokens.Add(entry.Balance)
+	}
+}
+
+func (gi *genesisInitializer) initRedelegation(red types.Redelegation) {
+	gi.keeper.SetRedelegation(gi.ctx, red)
+
+	for _, entry := range red.Entries {
+		gi.keeper.InsertRedelegationQueue(gi.ctx, red, entry.CompletionTime)
+	}
+}
+
+// init sets the rest of the genesis state, checks the balances of the pools,
+// and returns the validator set.
+func (gi *genesisInitializer) init(
+	accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, data *types.GenesisState,
+) (res []abci.ValidatorUpdate) {
+	ctx, keeper := gi.ctx, gi.keeper
 
 	keeper.SetParams(ctx, data.Params)
 	keeper.SetLastTotalPower(ctx, data.LastTotalPower)
 
 	for _, validator := range data.Validators {
-		keeper.SetValidator(ctx, validator)
+		gi.initValidator(validator)
+	}
 
-		// Manually set indices for the first time
-		keeper.SetValidatorByConsAddr(ctx, validator)
-		keeper.SetValidatorByPowerIndex(ctx, validator)
+	for _, delegation := range data.Delegations {
+		keeper.SetDelegation(ctx, delegation)
+	}
 
-		// Call the creation hook if not exported
-		if !data.Exported {
+	// Call the creation hooks if not exported, once all the validators and
+	// delegations are set as whether the genesis state is exported may only
+	// be known after reading them
+	if !data.Exported {
+		keeper.IterateValidators(ctx, func(_ int64, validator types.ValidatorI) (stop bool) {
 			keeper.AfterValidatorCreated(ctx, validator.GetOperator())
-		}
+			return false
+		})
 
-		// update timeslice if necessary
-		if validator.IsUnbonding() {
-			keeper.InsertUnbondingValidatorQueue(ctx, validator)
-		}
+		keeper.IterateAllDelegations(ctx, func(delegation types.Delegation) (stop bool) {
+			delegatorAddress, err := sdk.AccAddressFromBech32(delegation.DelegatorAddress)
+			if err != nil {
+				panic(err)
+			}
 
-		switch validator.GetStatus() {
-		case types.Bonded:
-			bondedTokens = bondedTokens.Add(validator.GetTokens())
-		case types.Unbonding, types.Unbonded:
-			notBondedTokens = notBondedTokens.Add(validator.GetTokens())
-		default:
-			panic("invalid validator status")
-		}
+			keeper.BeforeDelegationCreated(ctx, delegatorAddress, delegation.GetValidatorAddr())
+			keeper.AfterDelegationModified(ctx, delegatorAddress, delegation.GetValidatorAddr())
+			return false
+		})
 	}
 
-	for _, delegation := range data.Delegations {
-		delegatorAddress, err := sdk.AccAddressFromBech32(delegation.DelegatorAddress)
+	// restore the timestamps overwritten when setting the delegations
+	for _, record := range data.DelegationTimestamps {
+		delegatorAddress, err := sdk.AccAddressFromBech32(record.DelegatorAddress)
 		if err != nil {
 			panic(err)
 		}
 
-		// Call the before-creation hook if not exported
-		if !data.Exported {
-			keeper.BeforeDelegationCreated(ctx, delegatorAddress, delegation.GetValidatorAddr())
+		validatorAddress, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+		if err != nil {
+			panic(err)
 		}
 
-		keeper.SetDelegation(ctx, delegation)
-		// Call the after-modification hook if not exported
-		if !data.Exported {
-			keeper.AfterDelegationModified(ctx, delegatorAddress, delegation.GetValidatorAddr())
-		}
+		keeper.SetDelegationTimestamps(ctx, delegatorAddress, validatorAddress, record.Timestamps)
 	}
 
 	for _, ubd := range data.UnbondingDelegations {
-		keeper.SetUnbondingDelegation(ctx, ubd)
-
-		for _, entry := range ubd.Entries {
-			keeper.InsertUBDQueue(ctx, ubd, entry.CompletionTime)
-			notBondedTokens = notBondedTokens.Add(entry.Balance)
-		}
+		gi.initUnbondingDelegation(ubd)
 	}
 
 	for _, red := range data.Redelegations {
-		keeper.SetRedelegation(ctx, red)
-
-		for _, entry := range red.Entries {
-			keeper.InsertRedelegationQueue(ctx, red, entry.CompletionTime)
-		}
+		gi.initRedelegation(red)
 	}
 
-	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
-	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
+	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, gi.bondedTokens))
+	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, gi.notBondedTokens))
 
 	// check if the unbonded and bonded pools accounts exists
 	bondedPool := keeper.GetBondedPool(ctx)
@@ -174,6 +286,23 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		return false
 	})
 
+	delegations := keeper.GetAllDelegations(ctx)
+
+	var delegationTimestamps []types.DelegationTimestampsRecord
+
+	for _, delegation := range delegations {
+		timestamps, found := keeper.GetDelegationTimestamps(ctx, delegation.GetDelegatorAddr(), delegation.GetValidatorAddr())
+		if !found {
+			continue
+		}
+
+		delegationTimestamps = append(delegationTimestamps, types.DelegationTimestampsRecord{
+			DelegatorAddress: delegation.DelegatorAddress,
+			ValidatorAddress: delegation.ValidatorAddress,
+			Timestamps:       timestamps,
+		})
+	}
+
 	var lastValidatorPowers []types.LastValidatorPower
 
 	keeper.IterateLastValidatorPowers(ctx, func(addr sdk.ValAddress, power int64) (stop bool) {
@@ -186,13 +315,81 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		LastTotalPower:       keeper.GetLastTotalPower(ctx),
 		LastValidatorPowers:  lastValidatorPowers,
 		Validators:           keeper.GetAllValidators(ctx),
-		Delegations:          keeper.GetAllDelegations(ctx),
+		Delegations:          delegations,
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
 		Exported:             true,
+		DelegationTimestamps: delegationTimestamps,
 	}
 }
 
+// ExportGenesisToWriter writes the JSON of the GenesisState returned by
+// ExportGenesis to w, iterating the store without holding the validators and
+// bonds in memory.
+func ExportGenesisToWriter(ctx sdk.Context, keeper keeper.Keeper, cdc codec.JSONCodec, w io.Writer) error {
+	params := keeper.GetParams(ctx)
+
+	gw := module.NewGenesisWriter(cdc, w)
+	gw.WriteMessageField("params", &params)
+	gw.WriteField("last_total_power", keeper.GetLastTotalPower(ctx))
+
+	gw.BeginArray("last_validator_powers")
+	keeper.IterateLastValidatorPowers(ctx, func(addr sdk.ValAddress, power int64) (stop bool) {
+		gw.WriteItem(&types.LastValidatorPower{Address: addr.String(), Power: power})
+		return false
+	})
+	gw.EndArray()
+
+	gw.BeginArray("validators")
+	keeper.IterateValidators(ctx, func(_ int64, validator types.ValidatorI) (stop bool) {
+		val := validator.(types.Validator)
+		gw.WriteItem(&val)
+		return false
+	})
+	gw.EndArray()
+
+	gw.BeginArray("delegations")
+	keeper.IterateAllDelegations(ctx, func(delegation types.Delegation) (stop bool) {
+		gw.WriteItem(&delegation)
+		return false
+	})
+	gw.EndArray()
+
+	gw.BeginArray("unbonding_delegations")
+	keeper.IterateUnbondingDelegations(ctx, func(_ int64, ubd types.UnbondingDelegation) (stop bool) {
+		gw.WriteItem(&ubd)
+		return false
+	})
+	gw.EndArray()
+
+	gw.BeginArray("redelegations")
+	keeper.IterateRedelegations(ctx, func(_ int64, red types.Redelegation) (stop bool) {
+		gw.WriteItem(&red)
+		return false
+	})
+	gw.EndArray()
+
+	gw.WriteField("exported", true)
+
+	gw.BeginArray("delegation_timestamps")
+	keeper.IterateAllDelegations(ctx, func(delegation types.Delegation) (stop bool) {
+		timestamps, found := keeper.GetDelegationTimestamps(ctx, delegation.GetDelegatorAddr(), delegation.GetValidatorAddr())
+		if !found {
+			return false
+		}
+
+		gw.WriteItem(&types.DelegationTimestampsRecord{
+			DelegatorAddress: delegation.DelegatorAddress,
+			ValidatorAddress: delegation.ValidatorAddress,
+			Timestamps:       timestamps,
+		})
+		return false
+	})
+	gw.EndArray()
+
+	return gw.Close()
+}
+
 // WriteValidators returns a slice of bonded genesis validators.
 func WriteValidators(ctx sdk.Context, keeper keeper.Keeper) (vals []tmtypes.GenesisValidator, err error) {
 	keeper.IterateLastValidators(ctx, func(_ int64, validator types.ValidatorI) (stop bool) {
@@ -225,9 +422,39 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateDelegationTimestamps(data.Delegations, data.DelegationTimestamps); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+func validateGenesisStateDelegationTimestamps(delegations []types.Delegation, records []types.DelegationTimestampsRecord) error {
+	delegationMap := make(map[string]bool, len(delegations))
+	for _, delegation := range delegations {
+		delegationMap[delegation.DelegatorAddress+"/"+delegation.ValidatorAddress] = true
+	}
+
+	recordMap := make(map[string]bool, len(records))
+	for _, record := range records {
+		key := record.DelegatorAddress + "/" + record.ValidatorAddress
+		if !delegationMap[key] {
+			return fmt.Errorf("delegation timestamps for unknown delegation: delegator %s, validator %s", record.DelegatorAddress, record.ValidatorAddress)
+		}
+
+		if recordMap[key] {
+			return fmt.Errorf("duplicate delegation timestamps in genesis state: delegator %s, validator %s", record.DelegatorAddress, record.ValidatorAddress)
+		}
+		recordMap[key] = true
+
+		if record.Timestamps.UpdatedAt.Before(record.Timestamps.CreatedAt) {
+			return fmt.Errorf("delegation timestamps updated before being created: delegator %s, validator %s", record.DelegatorAddress, record.ValidatorAddress)
+		}
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
@@ -1,9 +1,11 @@
 package staking_test
 
 import (
+	"bytes"
 	"fmt"
 	"log"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -73,12 +75,24 @@ func TestInitGenesis(t *testing.T) {
 			),
 		),
 	)
+	// delegate to the first validator, recording when the delegation was made
+	delegations = append(delegations, types.NewDelegation(addrs[0], sdk.ValAddress(addrs[0]), valTokens.ToDec()))
+
 	genesisState := types.NewGenesisState(params, validators, delegations)
+	genesisState.DelegationTimestamps = []types.DelegationTimestampsRecord{{
+		DelegatorAddress: addrs[0].String(),
+		ValidatorAddress: sdk.ValAddress(addrs[0]).String(),
+		Timestamps: types.DelegationTimestamps{
+			CreatedAt: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}}
 	vals := staking.InitGenesis(ctx, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, genesisState)
 
 	actualGenesis := staking.ExportGenesis(ctx, app.StakingKeeper)
 	require.Equal(t, genesisState.Params, actualGenesis.Params)
 	require.Equal(t, genesisState.Delegations, actualGenesis.Delegations)
+	require.Equal(t, genesisState.DelegationTimestamps, actualGenesis.DelegationTimestamps)
 	require.EqualValues(t, app.StakingKeeper.GetAllValidators(ctx), actualGenesis.Validators)
 
 	// Ensure validators have addresses.
@@ -105,6 +119,31 @@ func TestInitGenesis(t *testing.T) {
 	require.Equal(t, abcivals, vals)
 }
 
+func TestExportGenesisToWriter(t *testing.T) {
+	_, app, ctx := getBaseSimappWithCustomKeeper()
+	addrs, _ := generateAddresses(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	cdc := app.AppCodec()
+
+	// a validator with a delegation, and an unbonding delegation
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(sdk.ValAddress(addrs[0]), PKs[0], 10, true)
+	tstaking.DelegateWithPower(addrs[1], sdk.ValAddress(addrs[0]), 2)
+	tstaking.Undelegate(addrs[1], sdk.ValAddress(addrs[0]), app.StakingKeeper.TokensFromConsensusPower(ctx, 1), true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	var buf bytes.Buffer
+	require.NoError(t, staking.ExportGenesisToWriter(ctx, app.StakingKeeper, cdc, &buf))
+	expected := cdc.MustMarshalJSON(staking.ExportGenesis(ctx, app.StakingKeeper))
+	require.Equal(t, string(expected), buf.String())
+
+	// the streamed genesis state initializes the same state
+	cacheCtx, _ := ctx.CacheContext()
+	vals, err := staking.InitGenesisFromReader(cacheCtx, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, cdc, bytes.NewReader(expected))
+	require.NoError(t, err)
+	require.Len(t, vals, 1)
+	require.Equal(t, staking.ExportGenesis(ctx, app.StakingKeeper), staking.ExportGenesis(cacheCtx, app.StakingKeeper))
+}
+
 func TestInitGenesis_PoolsBalanceMismatch(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.NewContext(false, tmproto.Header{})
@@ -207,6 +246,17 @@ func TestValidateGenesis(t *testing.T) {
 	genValidators1[0].Tokens = sdk.OneInt()
 	genValidators1[0].DelegatorShares = sdk.OneDec()
 
+	delAddr := sdk.AccAddress(pk.Address())
+	genDelegation := types.NewDelegation(delAddr, sdk.ValAddress(pk.Address()), sdk.OneDec())
+	genTimestamps := types.DelegationTimestampsRecord{
+		DelegatorAddress: genDelegation.DelegatorAddress,
+		ValidatorAddress: genDelegation.ValidatorAddress,
+		Timestamps: types.DelegationTimestamps{
+			CreatedAt: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
 	tests := []struct {
 		name    string
 		mutate  func(*types.GenesisState)
@@ -227,6 +277,24 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = types.Bonded
 		}, true},
+		// validate genesis delegation timestamps
+		{"delegation timestamps", func(data *types.GenesisState) {
+			data.Delegations = []types.Delegation{genDelegation}
+			data.DelegationTimestamps = []types.DelegationTimestampsRecord{genTimestamps}
+		}, false},
+		{"delegation timestamps for unknown delegation", func(data *types.GenesisState) {
+			data.DelegationTimestamps = []types.DelegationTimestampsRecord{genTimestamps}
+		}, true},
+		{"duplicate delegation timestamps", func(data *types.GenesisState) {
+			data.Delegations = []types.Delegation{genDelegation}
+			data.DelegationTimestamps = []types.DelegationTimestampsRecord{genTimestamps, genTimestamps}
+		}, true},
+		{"delegation updated before being created", func(data *types.GenesisState) {
+			data.Delegations = []types.Delegation{genDelegation}
+			record := genTimestamps
+			record.Timestamps.UpdatedAt = record.Timestamps.CreatedAt.Add(-time.Second)
+			data.DelegationTimestamps = []types.DelegationTimestampsRecord{record}
+		}, true},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	_ types.BondDenomProvider = DefaultBondDenomProvider{}
+	_ types.BondDenomProvider = ReserveBondDenomProvider{}
+)
+
+// DefaultBondDenomProvider is the default BondDenomProvider of the keeper,
+// which only accepts the bond denom param for delegations.
+type DefaultBondDenomProvider struct {
+	k Keeper
+}
+
+// NewDefaultBondDenomProvider returns a DefaultBondDenomProvider.
+func NewDefaultBondDenomProvider(k Keeper) DefaultBondDenomProvider {
+	return DefaultBondDenomProvider{k: k}
+}
+
+// BondDenom implements types.BondDenomProvider.
+func (p DefaultBondDenomProvider) BondDenom(ctx sdk.Context) string {
+	return p.k.bondDenomParam(ctx)
+}
+
+// BondDenomWeight implements types.BondDenomProvider.
+func (p DefaultBondDenomProvider) BondDenomWeight(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	if denom != p.BondDenom(ctx) {
+		return sdk.Dec{}, false
+	}
+
+	return sdk.OneDec(), true
+}
+
+// ConvertToBondDenom implements types.BondDenomProvider.
+func (p DefaultBondDenomProvider) ConvertToBondDenom(ctx sdk.Context, _ sdk.AccAddress, coin sdk.Coin) (sdk.Coin, error) {
+	return coin, sdkerrors.Wrapf(
+		sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", coin.Denom, p.BondDenom(ctx),
+	)
+}
+
+// ReserveBondDenomProvider is a BondDenomProvider which also accepts the
+// denoms of the BondDenomWeights param, set by governance, for delegations.
+// Their coins are converted by exchanging them for bond denom tokens of a
+// reserve module account at their weights, so that the staking tokens stay
+// backed by bond denom tokens. The reserve keeps the coins it exchanged, and
+// the conversions fail once it runs out of bond denom tokens.
+type ReserveBondDenomProvider struct {
+	DefaultBondDenomProvider
+
+	reserve string
+}
+
+// NewReserveBondDenomProvider returns a ReserveBondDenomProvider exchanging the
+// coins with the given module account, e.g. types.BondDenomReserveName.
+func NewReserveBondDenomProvider(k Keeper, reserve string) ReserveBondDenomProvider {
+	if addr := k.authKeeper.GetModuleAddress(reserve); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", reserve))
+	}
+
+	return ReserveBondDenomProvider{
+		DefaultBondDenomProvider: NewDefaultBondDenomProvider(k),
+		reserve:                  reserve,
+	}
+}
+
+// BondDenomWeight implements types.BondDenomProvider.
+func (p ReserveBondDenomProvider) BondDenomWeight(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	if weight, ok := p.DefaultBondDenomProvider.BondDenomWeight(ctx, denom); ok {
+		return weight, true
+	}
+
+	for _, dw := range p.k.BondDenomWeights(ctx) {
+		if dw.Denom == denom {
+			return dw.Weight, true
+		}
+	}
+
+	return sdk.Dec{}, false
+}
+
+// ConvertToBondDenom implements types.BondDenomProvider.
+func (p ReserveBondDenomProvider) ConvertToBondDenom(ctx sdk.Context, delAddr sdk.AccAddress, coin sdk.Coin) (sdk.Coin, error) {
+	weight, ok := p.BondDenomWeight(ctx, coin.Denom)
+	if !ok {
+		return p.DefaultBondDenomProvider.ConvertToBondDenom(ctx, delAddr, coin)
+	}
+
+	converted := sdk.NewCoin(p.BondDenom(ctx), weight.MulInt(coin.Amount).TruncateInt())
+	if !converted.IsPositive() {
+		return coin, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s converts to no %s tokens", coin, converted.Denom)
+	}
+
+	if err := p.k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, p.reserve, sdk.NewCoins(coin)); err != nil {
+		return coin, err
+	}
+	if err := p.k.bankKeeper.SendCoinsFromModuleToAccount(ctx, p.reserve, delAddr, sdk.NewCoins(converted)); err != nil {
+		return coin, sdkerrors.Wrapf(err, "failed to convert %s", coin)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConvertBondDenom,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coin.String()),
+			sdk.NewAttribute(types.AttributeKeyConvertedAmount, converted.String()),
+		),
+	)
+
+	return converted, nil
+}
+
+// convertToBondDenom converts the coin delegated by the delegator to bond
+// denom tokens with the BondDenomProvider, unless it is in the bond denom.
+func (k Keeper) convertToBondDenom(ctx sdk.Context, delAddr sdk.AccAddress, coin sdk.Coin) (sdk.Coin, error) {
+	if coin.Denom == k.BondDenom(ctx) {
+		return coin, nil
+	}
+
+	return k.bondDenomProvider.ConvertToBondDenom(ctx, delAddr, coin)
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestReserveBondDenomProvider(t *testing.T) {
+	_, app, ctx := createTestInput()
+	addrDels, addrVals := generateAddresses(app, ctx, 1)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	validator := teststaking.NewValidator(t, addrVals[0], PKs[0])
+	app.StakingKeeper.SetValidator(ctx, validator)
+	app.StakingKeeper.SetNewValidatorByPowerIndex(ctx, validator)
+
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, addrDels[0], sdk.NewCoins(sdk.NewInt64Coin("ustk", 1000))))
+	// the failed delegations are reverted as in a tx
+	delegate := func() error {
+		cacheCtx, write := ctx.CacheContext()
+		_, err := keeper.NewMsgServerImpl(app.StakingKeeper).Delegate(sdk.WrapSDKContext(cacheCtx), types.NewMsgDelegate(
+			addrDels[0], addrVals[0], sdk.NewInt64Coin("ustk", 100),
+		))
+		if err == nil {
+			write()
+		}
+		return err
+	}
+
+	// the default provider only accepts the bond denom
+	_, ok := keeper.NewDefaultBondDenomProvider(app.StakingKeeper).BondDenomWeight(ctx, "ustk")
+	require.False(t, ok)
+	require.ErrorIs(t, delegate(), sdkerrors.ErrInvalidRequest)
+
+	provider := keeper.NewReserveBondDenomProvider(app.StakingKeeper, types.BondDenomReserveName)
+	app.StakingKeeper.SetBondDenomProvider(provider)
+	params := app.StakingKeeper.GetParams(ctx)
+	params.BondDenomWeights = []types.DenomWeight{types.NewDenomWeight("ustk", sdk.NewDecWithPrec(5, 1))}
+	app.StakingKeeper.SetParams(ctx, params)
+
+	weight, ok := provider.BondDenomWeight(ctx, "ustk")
+	require.True(t, ok)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), weight)
+	weight, ok = provider.BondDenomWeight(ctx, bondDenom)
+	require.True(t, ok)
+	require.Equal(t, sdk.OneDec(), weight)
+	_, ok = provider.BondDenomWeight(ctx, "other")
+	require.False(t, ok)
+
+	// the conversions fail while the reserve has no bond denom tokens
+	require.Error(t, delegate())
+
+	reserve := app.AccountKeeper.GetModuleAddress(types.BondDenomReserveName)
+	require.NoError(t, simapp.FundModuleAccount(app.BankKeeper, ctx, types.BondDenomReserveName, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 80))))
+	require.NoError(t, delegate())
+
+	// the coins are converted at their weight, and delegated
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	validator, _ = app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.Equal(t, sdk.NewInt(50), validator.TokensFromShares(delegation.Shares).TruncateInt())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 30), sdk.NewInt64Coin("ustk", 100)), app.BankKeeper.GetAllBalances(ctx, reserve))
+	require.Equal(t, sdk.NewInt(900), app.BankKeeper.GetBalance(ctx, addrDels[0], "ustk").Amount)
+
+	// the reserve runs out of bond denom tokens
+	require.Error(t, delegate())
+}
+
+func TestMigrate3to4(t *testing.T) {
+	_, app, ctx := createTestInput()
+	params := app.StakingKeeper.GetParams(ctx)
+	params.BondDenomWeights = []types.DenomWeight{types.NewDenomWeight("ustk", sdk.OneDec())}
+	app.StakingKeeper.SetParams(ctx, params)
+
+	m := keeper.NewMigrator(app.StakingKeeper)
+	require.NoError(t, m.Migrate3to4(ctx))
+	require.Empty(t, app.StakingKeeper.BondDenomWeights(ctx))
+	require.NoError(t, app.StakingKeeper.GetParams(ctx).Validate())
+}
@@ -92,7 +92,7 @@ func (k Keeper) GetDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddres
 
 // SetDelegation sets a delegation.
 func (k Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) {
-	delegatorAddress, err := sdk.AccAddressFromBech32(delegation.DelegatorAddress)
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(delegation.DelegatorAddress)
 	if err != nil {
 		panic(err)
 	}
@@ -100,11 +100,19 @@ func (k Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) {
 	store := ctx.KVStore(k.storeKey)
 	b := types.MustMarshalDelegation(k.cdc, delegation)
 	store.Set(types.GetDelegationKey(delegatorAddress, delegation.GetValidatorAddr()), b)
+
+	// record the creation and modification time of the delegation
+	timestamps, found := k.GetDelegationTimestamps(ctx, delegatorAddress, delegation.GetValidatorAddr())
+	if !found {
+		timestamps.CreatedAt = ctx.BlockTime()
+	}
+	timestamps.UpdatedAt = ctx.BlockTime()
+	k.SetDelegationTimestamps(ctx, delegatorAddress, delegation.GetValidatorAddr(), timestamps)
 }
 
 // RemoveDelegation removes a delegation.
 func (k Keeper) RemoveDelegation(ctx sdk.Context, delegation types.Delegation) {
-	delegatorAddress, err := sdk.AccAddressFromBech32(delegation.DelegatorAddress)
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(delegation.DelegatorAddress)
 	if err != nil {
 		panic(err)
 	}
@@ -112,6 +120,30 @@ func (k Keeper) RemoveDelegation(ctx sdk.Context, delegation types.Delegation) {
 	k.BeforeDelegationRemoved(ctx, delegatorAddress, delegation.GetValidatorAddr())
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetDelegationKey(delegatorAddress, delegation.GetValidatorAddr()))
+	store.Delete(types.GetDelegationTimestampsKey(delegatorAddress, delegation.GetValidatorAddr()))
+}
+
+// GetDelegationTimestamps returns the timestamps of a delegation. Delegations
+// last modified before timestamps were recorded have none.
+func (k Keeper) GetDelegationTimestamps(ctx sdk.Context,
+	delAddr sdk.AccAddress, valAddr sdk.ValAddress) (timestamps types.DelegationTimestamps, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetDelegationTimestampsKey(delAddr, valAddr))
+	if value == nil {
+		return timestamps, false
+	}
+
+	k.cdc.MustUnmarshal(value, &timestamps)
+
+	return timestamps, true
+}
+
+// SetDelegationTimestamps sets the timestamps of a delegation.
+func (k Keeper) SetDelegationTimestamps(ctx sdk.Context,
+	delAddr sdk.AccAddress, valAddr sdk.ValAddress, timestamps types.DelegationTimestamps) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDelegationTimestampsKey(delAddr, valAddr), k.cdc.MustMarshal(&timestamps))
 }
 
 // GetUnbondingDelegations returns a given amount of all the delegator unbonding-delegations.
@@ -277,7 +309,7 @@ func (k Keeper) HasMaxUnbondingDelegationEntries(ctx sdk.Context,
 
 // SetUnbondingDelegation sets the unbonding delegation and associated index.
 func (k Keeper) SetUnbondingDelegation(ctx sdk.Context, ubd types.UnbondingDelegation) {
-	delegatorAddress, err := sdk.AccAddressFromBech32(ubd.DelegatorAddress)
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(ubd.DelegatorAddress)
 	if err != nil {
 		panic(err)
 	}
@@ -294,7 +326,7 @@ func (k Keeper) SetUnbondingDelegation(ctx sdk.Context, ubd types.UnbondingDeleg
 
 // RemoveUnbondingDelegation removes the unbonding delegation object and associated index.
 func (k Keeper) RemoveUnbondingDelegation(ctx sdk.Context, ubd types.UnbondingDelegation) {
-	delegatorAddress, err := sdk.AccAddressFromBech32(ubd.DelegatorAddress)
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(ubd.DelegatorAddress)
 	if err != nil {
 		panic(err)
 	}
@@ -478,7 +510,7 @@ func (k Keeper) HasMaxRedelegationEntries(ctx sdk.Context,
 
 // SetRedelegation set a redelegation and associated index.
 func (k Keeper) SetRedelegation(ctx sdk.Context, red types.Redelegation) {
-	delegatorAddress, err := sdk.AccAddressFromBech32(red.DelegatorAddress)
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(red.DelegatorAddress)
 	if err != nil {
 		panic(err)
 	}
@@ -537,7 +569,7 @@ func (k Keeper) IterateRedelegations(ctx sdk.Context, fn func(index int64, red t
 
 // RemoveRedelegation removes a redelegation object and associated index.
 func (k Keeper) RemoveRedelegation(ctx sdk.Context, red types.Redelegation) {
-	delegatorAddress, err := sdk.AccAddressFromBech32(red.DelegatorAddress)
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(red.DelegatorAddress)
 	if err != nil {
 		panic(err)
 	}
@@ -638,8 +670,8 @@ func (k Keeper) Delegate(
 ) (newShares sdk.Dec, err error) {
 	// In some situations, the exchange rate becomes invalid, e.g. if
 	// Validator loses all tokens due to slashing. In this case,
-	// make all future delegations invalid.
-	if validator.InvalidExRate() {
+	// make all future delegations invalid until the validator is reset.
+	if validator.BelowMinExchangeRate() {
 		return sdk.ZeroDec(), types.ErrDelegatorShareExRateInvalid
 	}
 
@@ -656,7 +688,7 @@ func (k Keeper) Delegate(
 		k.BeforeDelegationCreated(ctx, delAddr, validator.GetOperator())
 	}
 
-	delegatorAddress, err := sdk.AccAddressFromBech32(delegation.DelegatorAddress)
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(delegation.DelegatorAddress)
 	if err != nil {
 		panic(err)
 	}
@@ -741,7 +773,7 @@ func (k Keeper) Unbond(
 	// subtract shares from delegation
 	delegation.Shares = delegation.Shares.Sub(shares)
 
-	delegatorAddress, err := sdk.AccAddressFromBech32(delegation.DelegatorAddress)
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(delegation.DelegatorAddress)
 	if err != nil {
 		return amount, err
 	}
@@ -848,11 +880,11 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 		return nil, types.ErrNoUnbondingDelegation
 	}
 
-	bondDenom := k.GetParams(ctx).BondDenom
+	bondDenom := k.BondDenom(ctx)
 	balances := sdk.NewCoins()
 	ctxTime := ctx.BlockHeader().Time
 
-	delegatorAddress, err := sdk.AccAddressFromBech32(ubd.DelegatorAddress)
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(ubd.DelegatorAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -957,7 +989,7 @@ func (k Keeper) CompleteRedelegation(
 		return nil, types.ErrNoRedelegation
 	}
 
-	bondDenom := k.GetParams(ctx).BondDenom
+	bondDenom := k.BondDenom(ctx)
 	balances := sdk.NewCoins()
 	ctxTime := ctx.BlockHeader().Time
 
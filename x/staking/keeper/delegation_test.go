@@ -135,6 +135,48 @@ func TestDelegation(t *testing.T) {
 	require.Equal(t, 0, len(resBonds))
 }
 
+func TestDelegationTimestamps(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+
+	createdAt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(createdAt)
+
+	// no timestamps before the delegation is set
+	_, found := app.StakingKeeper.GetDelegationTimestamps(ctx, addrDels[0], valAddrs[0])
+	require.False(t, found)
+
+	bond := types.NewDelegation(addrDels[0], valAddrs[0], sdk.NewDec(9))
+	app.StakingKeeper.SetDelegation(ctx, bond)
+	timestamps, found := app.StakingKeeper.GetDelegationTimestamps(ctx, addrDels[0], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, types.DelegationTimestamps{CreatedAt: createdAt, UpdatedAt: createdAt}, timestamps)
+
+	// modifying the delegation only changes its update time
+	updatedAt := createdAt.Add(time.Hour)
+	ctx = ctx.WithBlockTime(updatedAt)
+	bond.Shares = sdk.NewDec(99)
+	app.StakingKeeper.SetDelegation(ctx, bond)
+	timestamps, found = app.StakingKeeper.GetDelegationTimestamps(ctx, addrDels[0], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, types.DelegationTimestamps{CreatedAt: createdAt, UpdatedAt: updatedAt}, timestamps)
+
+	// the timestamps are returned along with the delegation
+	validator := teststaking.NewValidator(t, valAddrs[0], PKs[0])
+	validator, _ = validator.AddTokensFromDel(sdk.NewInt(99))
+	app.StakingKeeper.SetValidator(ctx, validator)
+	res, err := keeper.DelegationToDelegationResponse(ctx, app.StakingKeeper, bond)
+	require.NoError(t, err)
+	require.Equal(t, &timestamps, res.Timestamps)
+
+	// removing the delegation removes its timestamps
+	app.StakingKeeper.RemoveDelegation(ctx, bond)
+	_, found = app.StakingKeeper.GetDelegationTimestamps(ctx, addrDels[0], valAddrs[0])
+	require.False(t, found)
+}
+
 // tests Get/Set/Remove UnbondingDelegation
 func TestUnbondingDelegation(t *testing.T) {
 	_, app, ctx := createTestInput()
@@ -20,7 +20,8 @@ type Querier struct {
 
 var _ types.QueryServer = Querier{}
 
-// Validators queries all validators that match the given status
+// Validators queries all validators that match the given status and moniker
+// prefix
 func (k Querier) Validators(c context.Context, req *types.QueryValidatorsRequest) (*types.QueryValidatorsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -37,21 +38,14 @@ func (k Querier) Validators(c context.Context, req *types.QueryValidatorsRequest
 	store := ctx.KVStore(k.storeKey)
 	valStore := prefix.NewStore(store, types.ValidatorsKey)
 
-	pageRes, err := query.FilteredPaginate(valStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+	pageRes, err := query.PaginateWithFilter(valStore, req.Pagination, k.validatorsFilter(req), func(key []byte, value []byte) error {
 		val, err := types.UnmarshalValidator(k.cdc, value)
 		if err != nil {
-			return false, err
-		}
-
-		if req.Status != "" && !strings.EqualFold(val.GetStatus().String(), req.Status) {
-			return false, nil
-		}
-
-		if accumulate {
-			validators = append(validators, val)
+			return err
 		}
 
-		return true, nil
+		validators = append(validators, val)
+		return nil
 	})
 
 	if err != nil {
@@ -61,6 +55,27 @@ func (k Querier) Validators(c context.Context, req *types.QueryValidatorsRequest
 	return &types.QueryValidatorsResponse{Validators: validators, Pagination: pageRes}, nil
 }
 
+// validatorsFilter returns the filter of the validators matching the status
+// and the moniker prefix of the request, or nil if the request has neither.
+func (k Querier) validatorsFilter(req *types.QueryValidatorsRequest) query.Filter {
+	if req.Status == "" && req.MonikerPrefix == "" {
+		return nil
+	}
+
+	return func(_ []byte, value []byte) (bool, error) {
+		val, err := types.UnmarshalValidator(k.cdc, value)
+		if err != nil {
+			return false, err
+		}
+
+		if req.Status != "" && !strings.EqualFold(val.GetStatus().String(), req.Status) {
+			return false, nil
+		}
+
+		return strings.HasPrefix(val.GetMoniker(), req.MonikerPrefix), nil
+	}
+}
+
 // Validator queries validator info for given validator address
 func (k Querier) Validator(c context.Context, req *types.QueryValidatorRequest) (*types.QueryValidatorResponse, error) {
 	if req == nil {
@@ -188,7 +203,7 @@ func (k Querier) Delegation(c context.Context, req *types.QueryDelegationRequest
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -229,7 +244,7 @@ func (k Querier) UnbondingDelegation(c context.Context, req *types.QueryUnbondin
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -262,7 +277,7 @@ func (k Querier) DelegatorDelegations(c context.Context, req *types.QueryDelegat
 	var delegations types.Delegations
 	ctx := sdk.UnwrapSDKContext(c)
 
-	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -304,7 +319,7 @@ func (k Querier) DelegatorValidator(c context.Context, req *types.QueryDelegator
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -335,7 +350,7 @@ func (k Querier) DelegatorUnbondingDelegations(c context.Context, req *types.Que
 	ctx := sdk.UnwrapSDKContext(c)
 
 	store := ctx.KVStore(k.storeKey)
-	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -419,7 +434,7 @@ func (k Querier) DelegatorValidators(c context.Context, req *types.QueryDelegato
 	ctx := sdk.UnwrapSDKContext(c)
 
 	store := ctx.KVStore(k.storeKey)
-	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -472,7 +487,7 @@ func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.
 
 func queryRedelegation(ctx sdk.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 
-	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -522,7 +537,7 @@ func queryRedelegationsFromSrcValidator(store sdk.KVStore, k Querier, req *types
 }
 
 func queryAllRedelegations(store sdk.KVStore, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, res *query.PageResponse, err error) {
-	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
 	if err != nil {
 		return nil, nil, err
 	}
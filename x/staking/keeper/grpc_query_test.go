@@ -3,6 +3,7 @@ package keeper_test
 import (
 	gocontext "context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -86,6 +87,65 @@ func (suite *KeeperTestSuite) TestGRPCQueryValidators() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryValidatorsMonikerPrefix() {
+	app, ctx, queryClient, vals := suite.app, suite.ctx, suite.queryClient, suite.vals
+	for i, moniker := range []string{"alpha", "alphabet"} {
+		val := vals[i]
+		val.Description.Moniker = moniker
+		app.StakingKeeper.SetValidator(ctx, val)
+	}
+
+	testCases := []struct {
+		msg     string
+		req     *types.QueryValidatorsRequest
+		numVals int
+		total   uint64
+		hasNext bool
+	}{
+		{
+			"matching validators",
+			&types.QueryValidatorsRequest{MonikerPrefix: "alpha"},
+			2,
+			2,
+			false,
+		},
+		{
+			"paginated matching validators",
+			&types.QueryValidatorsRequest{MonikerPrefix: "alpha", Pagination: &query.PageRequest{Limit: 1, CountTotal: true}},
+			1,
+			2,
+			true,
+		},
+		{
+			"matching validators with status",
+			&types.QueryValidatorsRequest{Status: vals[1].Status.String(), MonikerPrefix: "alphabet"},
+			1,
+			1,
+			false,
+		},
+		{
+			"no matching validator",
+			&types.QueryValidatorsRequest{MonikerPrefix: "gamma"},
+			0,
+			0,
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			valsResp, err := queryClient.Validators(gocontext.Background(), tc.req)
+			suite.Require().NoError(err)
+			suite.Equal(tc.numVals, len(valsResp.Validators))
+			suite.Equal(tc.total, valsResp.Pagination.Total)
+			suite.Equal(tc.hasNext, valsResp.Pagination.NextKey != nil)
+			for _, val := range valsResp.Validators {
+				suite.True(strings.HasPrefix(val.GetMoniker(), tc.req.MonikerPrefix))
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestGRPCQueryValidator() {
 	app, ctx, queryClient, vals := suite.app, suite.ctx, suite.queryClient, suite.vals
 	validator, found := app.StakingKeeper.GetValidator(ctx, vals[0].GetOperator())
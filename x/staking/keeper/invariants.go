@@ -18,6 +18,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		PositiveDelegationInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "delegator-shares",
 		DelegatorSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "exchange-rate",
+		ExchangeRateInvariant(k))
 }
 
 // AllInvariants runs all invariants of the staking module.
@@ -38,7 +40,12 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			return res, stop
 		}
 
-		return DelegatorSharesInvariant(k)(ctx)
+		res, stop = DelegatorSharesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return ExchangeRateInvariant(k)(ctx)
 	}
 }
 
@@ -186,3 +193,25 @@ func DelegatorSharesInvariant(k Keeper) sdk.Invariant {
 		return sdk.FormatInvariant(types.ModuleName, "delegator shares", msg), broken
 	}
 }
+
+// ExchangeRateInvariant checks that the exchange rate of all the validators is
+// at least MinExchangeRate, unless they are queued to reset.
+func ExchangeRateInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		validators := k.GetAllValidators(ctx)
+		for _, validator := range validators {
+			if validator.BelowMinExchangeRate() && !k.IsValidatorQueuedForReset(ctx, validator.GetOperator()) {
+				broken = true
+				msg += fmt.Sprintf("validator %s has an exchange rate of %v below the minimum %v\n",
+					validator.GetOperator(), validator.ExchangeRate(), types.MinExchangeRate)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "exchange rate", msg), broken
+	}
+}
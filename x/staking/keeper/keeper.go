@@ -25,6 +25,8 @@ type Keeper struct {
 	bankKeeper types.BankKeeper
 	hooks      types.StakingHooks
 	paramstore paramtypes.Subspace
+
+	bondDenomProvider types.BondDenomProvider
 }
 
 // NewKeeper creates a new staking Keeper instance
@@ -46,7 +48,7 @@ func NewKeeper(
 		panic(fmt.Sprintf("%s module account has not been set", types.NotBondedPoolName))
 	}
 
-	return Keeper{
+	k := Keeper{
 		storeKey:   key,
 		cdc:        cdc,
 		authKeeper: ak,
@@ -54,6 +56,9 @@ func NewKeeper(
 		paramstore: ps,
 		hooks:      nil,
 	}
+	k.bondDenomProvider = NewDefaultBondDenomProvider(k)
+
+	return k
 }
 
 // Logger returns a module-specific logger.
@@ -72,6 +77,13 @@ func (k *Keeper) SetHooks(sh types.StakingHooks) *Keeper {
 	return k
 }
 
+// SetBondDenomProvider sets the provider of the denoms accepted for
+// delegations, which defaults to the bond denom param only.
+func (k *Keeper) SetBondDenomProvider(provider types.BondDenomProvider) *Keeper {
+	k.bondDenomProvider = provider
+	return k
+}
+
 // Load the last total validator power.
 func (k Keeper) GetLastTotalPower(ctx sdk.Context) sdk.Int {
 	store := ctx.KVStore(k.storeKey)
@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v043 "github.com/cosmos/cosmos-sdk/x/staking/legacy/v043"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -19,3 +20,23 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v043.MigrateStore(ctx, m.keeper.storeKey)
 }
+
+// Migrate2to3 migrates from version 2 to 3, queueing the validators whose
+// exchange rate is below MinExchangeRate, e.g. after a full slash, to reset at
+// the end of the upgrade block.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	for _, validator := range m.keeper.GetAllValidators(ctx) {
+		if validator.BelowMinExchangeRate() {
+			m.keeper.InsertValidatorResetQueue(ctx, validator.GetOperator())
+		}
+	}
+
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4, setting the new BondDenomWeights
+// param without weights, so that only the bond denom is accepted.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.keeper.paramstore.Set(ctx, types.KeyBondDenomWeights, types.DefaultParams().BondDenomWeights)
+	return nil
+}
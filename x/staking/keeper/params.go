@@ -33,8 +33,19 @@ func (k Keeper) HistoricalEntries(ctx sdk.Context) (res uint32) {
 	return
 }
 
-// BondDenom - Bondable coin denomination
-func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
+// BondDenom - Bondable coin denomination, provided by the BondDenomProvider
+func (k Keeper) BondDenom(ctx sdk.Context) string {
+	return k.bondDenomProvider.BondDenom(ctx)
+}
+
+// BondDenomWeights - Weights of the other denoms accepted for delegations
+func (k Keeper) BondDenomWeights(ctx sdk.Context) (res []types.DenomWeight) {
+	k.paramstore.GetIfExists(ctx, types.KeyBondDenomWeights, &res)
+	return
+}
+
+// bondDenomParam returns the bond denom param
+func (k Keeper) bondDenomParam(ctx sdk.Context) (res string) {
 	k.paramstore.Get(ctx, types.KeyBondDenom, &res)
 	return
 }
@@ -49,13 +60,16 @@ func (k Keeper) PowerReduction(ctx sdk.Context) sdk.Int {
 
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(
+	params := types.NewParams(
 		k.UnbondingTime(ctx),
 		k.MaxValidators(ctx),
 		k.MaxEntries(ctx),
 		k.HistoricalEntries(ctx),
-		k.BondDenom(ctx),
+		k.bondDenomParam(ctx),
 	)
+	params.BondDenomWeights = k.BondDenomWeights(ctx)
+
+	return params
 }
 
 // set the params
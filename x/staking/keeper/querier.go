@@ -259,7 +259,7 @@ func queryDelegatorValidator(ctx sdk.Context, req abci.RequestQuery, k Keeper, l
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	delAddr, err := sdk.AccAddressFromBech32(params.DelegatorAddr)
+	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(params.DelegatorAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -290,7 +290,7 @@ func queryDelegation(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQue
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	delAddr, err := sdk.AccAddressFromBech32(params.DelegatorAddr)
+	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(params.DelegatorAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -326,7 +326,7 @@ func queryUnbondingDelegation(ctx sdk.Context, req abci.RequestQuery, k Keeper,
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	delAddr, err := sdk.AccAddressFromBech32(params.DelegatorAddr)
+	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(params.DelegatorAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -452,17 +452,22 @@ func DelegationToDelegationResponse(ctx sdk.Context, k Keeper, del types.Delegat
 		return types.DelegationResponse{}, types.ErrNoValidatorFound
 	}
 
-	delegatorAddress, err := sdk.AccAddressFromBech32(del.DelegatorAddress)
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(del.DelegatorAddress)
 	if err != nil {
 		return types.DelegationResponse{}, err
 	}
 
-	return types.NewDelegationResp(
+	res := types.NewDelegationResp(
 		delegatorAddress,
 		del.GetValidatorAddr(),
 		del.Shares,
 		sdk.NewCoin(k.BondDenom(ctx), val.TokensFromShares(del.Shares).TruncateInt()),
-	), nil
+	)
+	if timestamps, found := k.GetDelegationTimestamps(ctx, delegatorAddress, del.GetValidatorAddr()); found {
+		res.Timestamps = &timestamps
+	}
+
+	return res, nil
 }
 
 func DelegationsToDelegationResponses(
@@ -497,7 +502,7 @@ func RedelegationsToRedelegationResponses(
 			panic(err)
 		}
 
-		delegatorAddress, err := sdk.AccAddressFromBech32(redel.DelegatorAddress)
+		delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(redel.DelegatorAddress)
 		if err != nil {
 			panic(err)
 		}
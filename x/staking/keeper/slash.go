@@ -140,6 +140,13 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		"slash_factor", slashFactor.String(),
 		"burned", tokensToBurn,
 	)
+
+	// the shares of the validator are worth too few tokens after the slash,
+	// thus it is reset by the end blocker
+	if validator.BelowMinExchangeRate() {
+		k.InsertValidatorResetQueue(ctx, operatorAddress)
+		logger.Info("validator queued for reset", "validator", operatorAddress.String())
+	}
 }
 
 // jail a validator
@@ -250,7 +257,7 @@ func (k Keeper) SlashRedelegation(ctx sdk.Context, srcValidator types.Validator,
 			panic(err)
 		}
 
-		delegatorAddress, err := sdk.AccAddressFromBech32(redelegation.DelegatorAddress)
+		delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(redelegation.DelegatorAddress)
 		if err != nil {
 			panic(err)
 		}
@@ -297,3 +304,90 @@ func (k Keeper) SlashRedelegation(ctx sdk.Context, srcValidator types.Validator,
 
 	return totalSlashAmount
 }
+
+// InsertValidatorResetQueue queues a validator to reset at the end of the
+// block.
+func (k Keeper) InsertValidatorResetQueue(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorResetQueueKey(valAddr), []byte{})
+}
+
+// IsValidatorQueuedForReset returns true if the validator is queued to reset.
+func (k Keeper) IsValidatorQueuedForReset(ctx sdk.Context, valAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetValidatorResetQueueKey(valAddr))
+}
+
+// ResetQueuedValidators resets the validators of the reset queue, and empties
+// it.
+func (k Keeper) ResetQueuedValidators(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorResetQueueKey)
+
+	var valAddrs []sdk.ValAddress
+	for ; iterator.Valid(); iterator.Next() {
+		valAddrs = append(valAddrs, sdk.ValAddress(types.AddressFromValidatorsKey(iterator.Key())))
+	}
+	iterator.Close()
+
+	for _, valAddr := range valAddrs {
+		store.Delete(types.GetValidatorResetQueueKey(valAddr))
+
+		if err := k.ResetValidator(ctx, valAddr); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ResetValidator resets a validator whose exchange rate fell below
+// MinExchangeRate, so that its exchange rate restarts at one. To protect the
+// delegators, each delegation to the validator is unbonded, and the tokens its
+// shares are still worth are returned to the delegator as for an undelegation,
+// regardless of the maximum number of unbonding entries. The validator is
+// removed if it is unbonded. It is a no-op unless the validator is below
+// MinExchangeRate.
+func (k Keeper) ResetValidator(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found || !validator.BelowMinExchangeRate() {
+		return nil
+	}
+
+	completionTime := ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
+	for _, delegation := range k.GetValidatorDelegations(ctx, valAddr) {
+		delAddr, err := k.authKeeper.AddressCodec().StringToBytes(delegation.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+
+		validator, found = k.GetValidator(ctx, valAddr)
+		if !found {
+			return types.ErrNoValidatorFound
+		}
+
+		returnAmount, err := k.Unbond(ctx, delAddr, valAddr, delegation.Shares)
+		if err != nil {
+			return err
+		}
+		if !returnAmount.IsPositive() {
+			continue
+		}
+
+		// transfer the validator tokens to the not bonded pool
+		if validator.IsBonded() {
+			k.bondedTokensToNotBonded(ctx, returnAmount)
+		}
+
+		ubd := k.SetUnbondingDelegationEntry(ctx, delAddr, valAddr, ctx.BlockHeight(), completionTime, returnAmount)
+		k.InsertUBDQueue(ctx, ubd, completionTime)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeResetValidator,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		),
+	)
+	k.Logger(ctx).Info("validator reset", "validator", valAddr.String())
+
+	return nil
+}
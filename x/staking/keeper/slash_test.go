@@ -604,3 +604,85 @@ func TestSlashBoth(t *testing.T) {
 	// power not decreased, all stake was bonded since
 	require.Equal(t, int64(10), validator.GetConsensusPower(app.StakingKeeper.PowerReduction(ctx)))
 }
+
+// tests the reset of a validator slashed below the minimum exchange rate
+func TestSlashResetValidator(t *testing.T) {
+	_, app, ctx := createTestInput()
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	addrVals := simapp.ConvertAddrsToValAddrs(addrDels)
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+
+	validator := teststaking.NewValidator(t, addrVals[0], PKs[0])
+	app.StakingKeeper.SetValidator(ctx, validator)
+	app.StakingKeeper.SetValidatorByConsAddr(ctx, validator)
+	app.StakingKeeper.SetNewValidatorByPowerIndex(ctx, validator)
+
+	_, err := app.StakingKeeper.Delegate(ctx, addrDels[0], app.StakingKeeper.TokensFromConsensusPower(ctx, 10), types.Unbonded, validator, true)
+	require.NoError(t, err)
+	validator, _ = app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	_, err = app.StakingKeeper.Delegate(ctx, addrDels[1], app.StakingKeeper.TokensFromConsensusPower(ctx, 30), types.Unbonded, validator, true)
+	require.NoError(t, err)
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, -1)
+
+	validator, _ = app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, validator.IsBonded())
+
+	// slash nearly all the tokens of the validator
+	fraction := sdk.OneDec().Sub(sdk.NewDecWithPrec(1, 12))
+	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), 40, fraction)
+
+	validator, _ = app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	remainingTokens := validator.Tokens
+	require.True(t, remainingTokens.IsPositive())
+	require.True(t, validator.BelowMinExchangeRate())
+	require.True(t, app.StakingKeeper.IsValidatorQueuedForReset(ctx, addrVals[0]))
+
+	// the validator does not accept delegations until it is reset
+	_, err = app.StakingKeeper.Delegate(ctx, addrDels[1], app.StakingKeeper.TokensFromConsensusPower(ctx, 1), types.Unbonded, validator, true)
+	require.ErrorIs(t, err, types.ErrDelegatorShareExRateInvalid)
+
+	_, broken := keeper.ExchangeRateInvariant(app.StakingKeeper)(ctx)
+	require.False(t, broken)
+
+	// the validator is reset by the end blocker
+	app.StakingKeeper.BlockValidatorUpdates(ctx)
+	require.False(t, app.StakingKeeper.IsValidatorQueuedForReset(ctx, addrVals[0]))
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.True(t, validator.IsJailed())
+	require.True(t, validator.Tokens.IsZero())
+	require.True(t, validator.DelegatorShares.IsZero())
+	require.Equal(t, sdk.OneDec(), validator.ExchangeRate())
+	require.Empty(t, app.StakingKeeper.GetValidatorDelegations(ctx, addrVals[0]))
+
+	// the delegators keep the remaining tokens
+	unbonding := sdk.ZeroInt()
+	for _, ubd := range app.StakingKeeper.GetUnbondingDelegationsFromValidator(ctx, addrVals[0]) {
+		for _, entry := range ubd.Entries {
+			unbonding = unbonding.Add(entry.Balance)
+		}
+	}
+	require.Equal(t, remainingTokens, unbonding)
+
+	_, broken = keeper.AllInvariants(app.StakingKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestMigrate2to3(t *testing.T) {
+	_, app, ctx := createTestInput()
+	_, addrVals := generateAddresses(app, ctx, 2)
+
+	// a validator fully slashed before the exchange rate guard
+	slashed := teststaking.NewValidator(t, addrVals[0], PKs[0])
+	slashed.DelegatorShares = sdk.NewDec(100)
+	app.StakingKeeper.SetValidator(ctx, slashed)
+	validator := teststaking.NewValidator(t, addrVals[1], PKs[1])
+	validator, _ = validator.AddTokensFromDel(sdk.NewInt(100))
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	m := keeper.NewMigrator(app.StakingKeeper)
+	require.NoError(t, m.Migrate2to3(ctx))
+	require.True(t, app.StakingKeeper.IsValidatorQueuedForReset(ctx, addrVals[0]))
+	require.False(t, app.StakingKeeper.IsValidatorQueuedForReset(ctx, addrVals[1]))
+}
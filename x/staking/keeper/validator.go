@@ -194,6 +194,28 @@ func (k Keeper) GetAllValidators(ctx sdk.Context) (validators []types.Validator)
 	return validators
 }
 
+// ValidateAddressPrefix checks that the stored validators are encoded with the
+// configured bech32 validator prefix, i.e. that the node is configured for the
+// network of its state. Only the first validator is checked.
+func (k Keeper) ValidateAddressPrefix(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorsKey)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return nil
+	}
+
+	validator, err := types.UnmarshalValidator(k.cdc, iterator.Value())
+	if err != nil {
+		return err
+	}
+
+	_, err = sdk.ValAddressFromBech32(validator.OperatorAddress)
+	return err
+}
+
 // return a given amount of all the validators
 func (k Keeper) GetValidators(ctx sdk.Context, maxRetrieve uint32) (validators []types.Validator) {
 	store := ctx.KVStore(k.storeKey)
@@ -13,6 +13,7 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -106,6 +107,28 @@ func TestSetValidator(t *testing.T) {
 	require.Equal(t, 1, len(allVals))
 }
 
+func TestValidateAddressPrefix(t *testing.T) {
+	_, app, ctx := createTestInput()
+	require.NoError(t, app.StakingKeeper.ValidateAddressPrefix(ctx))
+
+	app.StakingKeeper.SetValidator(ctx, teststaking.NewValidator(t, sdk.ValAddress(PKs[0].Address()), PKs[0]))
+	require.NoError(t, app.StakingKeeper.ValidateAddressPrefix(ctx))
+
+	// encode the first stored validator with another prefix
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorsKey)
+	require.True(t, iterator.Valid())
+	key := iterator.Key()
+	validator := types.MustUnmarshalValidator(app.AppCodec(), iterator.Value())
+	iterator.Close()
+
+	other, err := bech32.ConvertAndEncode("othervaloper", validator.GetOperator())
+	require.NoError(t, err)
+	validator.OperatorAddress = other
+	store.Set(key, types.MustMarshalValidator(app.AppCodec(), &validator))
+	require.Error(t, app.StakingKeeper.ValidateAddressPrefix(ctx))
+}
+
 func TestUpdateValidatorByPowerIndex(t *testing.T) {
 	app, ctx, _, _ := bootstrapValidatorTest(t, 0, 100)
 	_, addrVals := generateAddresses(app, ctx, 1)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math/rand"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -26,9 +27,11 @@ import (
 )
 
 var (
-	_ module.AppModule           = AppModule{}
-	_ module.AppModuleBasic      = AppModuleBasic{}
-	_ module.AppModuleSimulation = AppModule{}
+	_ module.AppModule                 = AppModule{}
+	_ module.AppModuleBasic            = AppModuleBasic{}
+	_ module.TableExportAppModuleBasic = AppModuleBasic{}
+	_ module.AppModuleSimulation       = AppModule{}
+	_ module.StreamingGenesisAppModule = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the staking module.
@@ -69,6 +72,16 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncod
 	return ValidateGenesis(&data)
 }
 
+// ExportTables exports the staking state of the genesis as tables.
+func (AppModuleBasic) ExportTables(cdc codec.JSONCodec, bz json.RawMessage) ([]*sdk.Table, error) {
+	var data types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+
+	return data.Tables(), nil
+}
+
 // RegisterRESTRoutes registers the REST routes for the staking module.
 func (AppModuleBasic) RegisterRESTRoutes(clientCtx client.Context, rtr *mux.Router) {
 	rest.RegisterHandlers(clientCtx, rtr)
@@ -141,6 +154,8 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 
 	m := keeper.NewMigrator(am.keeper)
 	cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2)
+	cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3)
+	cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4)
 }
 
 // InitGenesis performs genesis initialization for the staking module. It returns
@@ -153,6 +168,12 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.
 	return InitGenesis(ctx, am.keeper, am.accountKeeper, am.bankKeeper, &genesisState)
 }
 
+// InitGenesisFromReader performs genesis initialization for the staking module
+// from a JSON genesis state read from r, streaming its validators and bonds.
+func (am AppModule) InitGenesisFromReader(ctx sdk.Context, cdc codec.JSONCodec, r io.Reader) ([]abci.ValidatorUpdate, error) {
+	return InitGenesisFromReader(ctx, am.keeper, am.accountKeeper, am.bankKeeper, cdc, r)
+}
+
 // ExportGenesis returns the exported genesis state as raw bytes for the staking
 // module.
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
@@ -160,8 +181,14 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+// ExportGenesisToWriter writes the exported genesis state of the staking
+// module to w, streaming its validators and bonds.
+func (am AppModule) ExportGenesisToWriter(ctx sdk.Context, cdc codec.JSONCodec, w io.Writer) error {
+	return ExportGenesisToWriter(ctx, am.keeper, cdc, w)
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 4 }
 
 // BeginBlock returns the begin blocker for the staking module.
 func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
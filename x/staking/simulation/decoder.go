@@ -41,6 +41,13 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &delegationB)
 
 			return fmt.Sprintf("%v\n%v", delegationA, delegationB)
+		case bytes.Equal(kvA.Key[:1], types.DelegationTimestampsKey):
+			var timestampsA, timestampsB types.DelegationTimestamps
+
+			cdc.MustUnmarshal(kvA.Value, &timestampsA)
+			cdc.MustUnmarshal(kvB.Value, &timestampsB)
+
+			return fmt.Sprintf("%v\n%v", timestampsA, timestampsB)
 		case bytes.Equal(kvA.Key[:1], types.UnbondingDelegationKey),
 			bytes.Equal(kvA.Key[:1], types.UnbondingDelegationByValIndexKey):
 			var ubdA, ubdB types.UnbondingDelegation
@@ -0,0 +1,24 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BondDenomProvider provides the denoms accepted for delegations. The staking
+// tokens are accounted in the bond denom, and the coins of the other accepted
+// denoms, e.g. the liquid staking tokens of another chain, are converted to
+// bond denom tokens at their weights when they are delegated.
+type BondDenomProvider interface {
+	// BondDenom returns the denom the staking tokens are accounted in.
+	BondDenom(ctx sdk.Context) string
+
+	// BondDenomWeight returns the weight of the denom, i.e. the bond denom
+	// tokens one unit of it converts to, and false if the denom is not accepted
+	// for delegations. The weight of the bond denom is one.
+	BondDenomWeight(ctx sdk.Context, denom string) (sdk.Dec, bool)
+
+	// ConvertToBondDenom converts the coin of the delegator, in an accepted
+	// denom other than the bond denom, to bond denom tokens of the delegator at
+	// its weight, and returns them.
+	ConvertToBondDenom(ctx sdk.Context, delAddr sdk.AccAddress, coin sdk.Coin) (sdk.Coin, error)
+}
@@ -9,6 +9,8 @@ const (
 	EventTypeDelegate             = "delegate"
 	EventTypeUnbond               = "unbond"
 	EventTypeRedelegate           = "redelegate"
+	EventTypeResetValidator       = "reset_validator"
+	EventTypeConvertBondDenom     = "convert_bond_denom"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -18,5 +20,6 @@ const (
 	AttributeKeyDelegator         = "delegator"
 	AttributeKeyCompletionTime    = "completion_time"
 	AttributeKeyNewShares         = "new_shares"
+	AttributeKeyConvertedAmount   = "converted_amount"
 	AttributeValueCategory        = ModuleName
 )
@@ -43,6 +43,8 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// delegation_timestamps defines the timestamps of the delegations active at genesis.
+	DelegationTimestamps []DelegationTimestampsRecord `protobuf:"bytes,9,rep,name=delegation_timestamps,json=delegationTimestamps,proto3" json:"delegation_timestamps" yaml:"delegation_timestamps"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -127,6 +129,13 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetDelegationTimestamps() []DelegationTimestampsRecord {
+	if m != nil {
+		return m.DelegationTimestamps
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -168,9 +177,74 @@ func (m *LastValidatorPower) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LastValidatorPower proto.InternalMessageInfo
 
+// DelegationTimestampsRecord is used for import / export via genesis json.
+type DelegationTimestampsRecord struct {
+	// delegator_address is the bech32-encoded address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	// validator_address is the bech32-encoded address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// timestamps are the timestamps of the delegation.
+	Timestamps DelegationTimestamps `protobuf:"bytes,3,opt,name=timestamps,proto3" json:"timestamps"`
+}
+
+func (m *DelegationTimestampsRecord) Reset()         { *m = DelegationTimestampsRecord{} }
+func (m *DelegationTimestampsRecord) String() string { return proto.CompactTextString(m) }
+func (*DelegationTimestampsRecord) ProtoMessage()    {}
+func (*DelegationTimestampsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b3dec8894f2831b, []int{2}
+}
+func (m *DelegationTimestampsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationTimestampsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationTimestampsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegationTimestampsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationTimestampsRecord.Merge(m, src)
+}
+func (m *DelegationTimestampsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationTimestampsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationTimestampsRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationTimestampsRecord proto.InternalMessageInfo
+
+func (m *DelegationTimestampsRecord) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *DelegationTimestampsRecord) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *DelegationTimestampsRecord) GetTimestamps() DelegationTimestamps {
+	if m != nil {
+		return m.Timestamps
+	}
+	return DelegationTimestamps{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.staking.v1beta1.GenesisState")
 	proto.RegisterType((*LastValidatorPower)(nil), "cosmos.staking.v1beta1.LastValidatorPower")
+	proto.RegisterType((*DelegationTimestampsRecord)(nil), "cosmos.staking.v1beta1.DelegationTimestampsRecord")
 }
 
 func init() {
@@ -178,38 +252,45 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 493 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0xc7, 0x7d, 0xa4, 0x49, 0xc3, 0xa5, 0x20, 0x74, 0xa4, 0x60, 0x45, 0xc8, 0x0e, 0x56, 0x84,
-	0x22, 0x5e, 0x6c, 0xb5, 0x6c, 0x15, 0x53, 0x84, 0xa8, 0x8a, 0x10, 0x8a, 0x8e, 0x97, 0x81, 0x25,
-	0xba, 0xd4, 0x27, 0x63, 0xd5, 0xf1, 0x59, 0x7e, 0x2e, 0xa5, 0xdd, 0x11, 0x62, 0xe4, 0x23, 0xf4,
-	0xe3, 0x74, 0xec, 0xc0, 0x80, 0x18, 0x2c, 0x94, 0x2c, 0xcc, 0xfd, 0x04, 0xc8, 0xe7, 0x17, 0x4c,
-	0x52, 0x33, 0x25, 0x77, 0xfa, 0xfd, 0x7f, 0x7f, 0xfb, 0xfc, 0x1c, 0x1e, 0x1c, 0x0a, 0x98, 0x09,
-	0x70, 0x40, 0xb2, 0x23, 0x3f, 0xf4, 0x9c, 0xe3, 0x9d, 0x29, 0x97, 0x6c, 0xc7, 0xf1, 0x78, 0xc8,
-	0xc1, 0x07, 0x3b, 0x8a, 0x85, 0x14, 0xe4, 0x4e, 0x46, 0xd9, 0x39, 0x65, 0xe7, 0x54, 0xaf, 0xeb,
-	0x09, 0x4f, 0x28, 0xc4, 0x49, 0xff, 0x65, 0x74, 0xaf, 0xce, 0x59, 0xa4, 0x15, 0x65, 0x7d, 0x6f,
-	0xe2, 0xad, 0xfd, 0xac, 0xe5, 0x8d, 0x64, 0x92, 0x93, 0x67, 0xb8, 0x15, 0xb1, 0x98, 0xcd, 0x40,
-	0x47, 0x7d, 0x34, 0xec, 0xec, 0x1a, 0xf6, 0xd5, 0xad, 0xf6, 0x58, 0x51, 0xa3, 0x8d, 0xf3, 0xc4,
-	0xd4, 0x68, 0x9e, 0x21, 0x80, 0x6f, 0x05, 0x0c, 0xe4, 0x44, 0x0a, 0xc9, 0x82, 0x49, 0x24, 0x3e,
-	0xf1, 0x58, 0xbf, 0xd6, 0x47, 0xc3, 0xad, 0xd1, 0x41, 0xca, 0xfd, 0x4c, 0xcc, 0x07, 0x9e, 0x2f,
-	0x3f, 0xce, 0xa7, 0xf6, 0xa1, 0x98, 0x39, 0xf9, 0x13, 0x66, 0x3f, 0x4f, 0xc0, 0x3d, 0x72, 0xe4,
-	0x69, 0xc4, 0xc1, 0x3e, 0x08, 0xe5, 0x65, 0x62, 0xde, 0x3d, 0x65, 0xb3, 0x60, 0xcf, 0x5a, 0xf5,
-	0x59, 0xf4, 0x66, 0xba, 0xf5, 0x36, 0xdd, 0x19, 0xa7, 0x1b, 0xe4, 0x33, 0xc2, 0xdb, 0x8a, 0x3a,
-	0x66, 0x81, 0xef, 0x32, 0x29, 0xe2, 0x8c, 0x04, 0xbd, 0xd1, 0x6f, 0x0c, 0x3b, 0xbb, 0x0f, 0xeb,
-	0x5e, 0xe1, 0x15, 0x03, 0xf9, 0xbe, 0xc8, 0x28, 0xd7, 0x68, 0x90, 0x3e, 0xe6, 0x65, 0x62, 0xde,
-	0xab, 0x94, 0xaf, 0x6a, 0x2d, 0x7a, 0x3b, 0x58, 0x4b, 0x02, 0xd9, 0xc7, 0xb8, 0x24, 0x41, 0xdf,
-	0x50, 0xd5, 0xf7, 0xeb, 0xaa, 0xcb, 0x70, 0x7e, 0x80, 0x95, 0x28, 0x79, 0x89, 0x3b, 0x2e, 0x0f,
-	0xb8, 0xc7, 0xa4, 0x2f, 0x42, 0xd0, 0x9b, 0xca, 0x64, 0xd5, 0x99, 0x9e, 0x97, 0x68, 0xae, 0xaa,
-	0x86, 0xc9, 0x17, 0x84, 0xb7, 0xe7, 0xe1, 0x54, 0x84, 0xae, 0x1f, 0x7a, 0x93, 0xaa, 0xb6, 0xa5,
-	0xb4, 0x8f, 0xea, 0xb4, 0xef, 0x8a, 0x50, 0xc5, 0xbf, 0x72, 0x38, 0x57, 0x7a, 0x2d, 0xda, 0x9d,
-	0xaf, 0x47, 0x81, 0x8c, 0xf1, 0x8d, 0x98, 0x57, 0xfb, 0x37, 0x55, 0xff, 0xa0, 0xae, 0x9f, 0x56,
-	0xe0, 0xfc, 0xc5, 0xfe, 0x15, 0x90, 0x1e, 0x6e, 0xf3, 0x93, 0x48, 0xc4, 0x92, 0xbb, 0x7a, 0xbb,
-	0x8f, 0x86, 0x6d, 0x5a, 0xae, 0xad, 0xd7, 0x98, 0xac, 0x7f, 0x5c, 0xa2, 0xe3, 0x4d, 0xe6, 0xba,
-	0x31, 0x87, 0x6c, 0xb8, 0xaf, 0xd3, 0x62, 0x49, 0xba, 0xb8, 0xf9, 0x77, 0x58, 0x1b, 0x34, 0x5b,
-	0xec, 0xb5, 0xbf, 0x9e, 0x99, 0xda, 0xef, 0x33, 0x53, 0x1b, 0xbd, 0x38, 0x5f, 0x18, 0xe8, 0x62,
-	0x61, 0xa0, 0x5f, 0x0b, 0x03, 0x7d, 0x5b, 0x1a, 0xda, 0xc5, 0xd2, 0xd0, 0x7e, 0x2c, 0x0d, 0xed,
-	0xc3, 0xe3, 0xff, 0xce, 0xf3, 0x49, 0x79, 0xfd, 0xd4, 0x64, 0x4f, 0x5b, 0xea, 0xd6, 0x3d, 0xfd,
-	0x13, 0x00, 0x00, 0xff, 0xff, 0xff, 0x85, 0xad, 0xc8, 0xf1, 0x03, 0x00, 0x00,
+	// 598 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x18, 0xc7, 0x93, 0x75, 0xeb, 0x3a, 0x6f, 0xbf, 0x9f, 0x36, 0xd3, 0x41, 0x54, 0x4d, 0x49, 0x89,
+	0x26, 0x54, 0xc1, 0x48, 0xb4, 0x71, 0x9b, 0xb8, 0x50, 0x21, 0xa6, 0x22, 0x84, 0x2a, 0x33, 0x38,
+	0x70, 0xa9, 0xdc, 0xc6, 0x0a, 0xd1, 0x92, 0x38, 0x8a, 0xdd, 0xb1, 0xdd, 0xd1, 0xc4, 0x05, 0x89,
+	0x97, 0xb0, 0x97, 0xb3, 0xe3, 0x8e, 0x88, 0x43, 0x85, 0x5a, 0x0e, 0x9c, 0xfb, 0x0a, 0x50, 0x9c,
+	0x3f, 0x4d, 0xd3, 0x06, 0xc4, 0xa9, 0xf5, 0xa3, 0xef, 0xf7, 0xf3, 0x7d, 0xec, 0xd8, 0x0f, 0xd8,
+	0x1f, 0x50, 0xe6, 0x51, 0x66, 0x32, 0x8e, 0xcf, 0x1c, 0xdf, 0x36, 0xcf, 0x0f, 0xfb, 0x84, 0xe3,
+	0x43, 0xd3, 0x26, 0x3e, 0x61, 0x0e, 0x33, 0x82, 0x90, 0x72, 0x0a, 0xef, 0xc6, 0x2a, 0x23, 0x51,
+	0x19, 0x89, 0xaa, 0x51, 0xb7, 0xa9, 0x4d, 0x85, 0xc4, 0x8c, 0xfe, 0xc5, 0xea, 0x46, 0x19, 0x33,
+	0x75, 0x0b, 0x95, 0xfe, 0xb3, 0x0a, 0xb6, 0x4e, 0xe2, 0x94, 0x37, 0x1c, 0x73, 0x02, 0x9f, 0x82,
+	0x6a, 0x80, 0x43, 0xec, 0x31, 0x45, 0x6e, 0xca, 0xad, 0xcd, 0x23, 0xd5, 0x58, 0x9e, 0x6a, 0x74,
+	0x85, 0xaa, 0xbd, 0x7a, 0x33, 0xd2, 0x24, 0x94, 0x78, 0x20, 0x03, 0xdb, 0x2e, 0x66, 0xbc, 0xc7,
+	0x29, 0xc7, 0x6e, 0x2f, 0xa0, 0x1f, 0x49, 0xa8, 0xac, 0x34, 0xe5, 0xd6, 0x56, 0xbb, 0x13, 0xe9,
+	0xbe, 0x8f, 0xb4, 0x07, 0xb6, 0xc3, 0x3f, 0x0c, 0xfb, 0xc6, 0x80, 0x7a, 0x66, 0xd2, 0x61, 0xfc,
+	0xf3, 0x98, 0x59, 0x67, 0x26, 0xbf, 0x0c, 0x08, 0x33, 0x3a, 0x3e, 0x9f, 0x8e, 0xb4, 0x7b, 0x97,
+	0xd8, 0x73, 0x8f, 0xf5, 0x22, 0x4f, 0x47, 0xff, 0x47, 0xa5, 0xd3, 0xa8, 0xd2, 0x8d, 0x0a, 0xf0,
+	0x93, 0x0c, 0x76, 0x85, 0xea, 0x1c, 0xbb, 0x8e, 0x85, 0x39, 0x0d, 0x63, 0x25, 0x53, 0x2a, 0xcd,
+	0x4a, 0x6b, 0xf3, 0xe8, 0x61, 0xd9, 0x16, 0x5e, 0x61, 0xc6, 0xdf, 0xa5, 0x1e, 0xc1, 0x6a, 0xef,
+	0x47, 0x6d, 0x4e, 0x47, 0xda, 0x5e, 0x2e, 0xbc, 0x88, 0xd5, 0xd1, 0x1d, 0x77, 0xc1, 0xc9, 0xe0,
+	0x09, 0x00, 0x99, 0x92, 0x29, 0xab, 0x22, 0xfa, 0x7e, 0x59, 0x74, 0x66, 0x4e, 0x0e, 0x30, 0x67,
+	0x85, 0x2f, 0xc1, 0xa6, 0x45, 0x5c, 0x62, 0x63, 0xee, 0x50, 0x9f, 0x29, 0x6b, 0x82, 0xa4, 0x97,
+	0x91, 0x9e, 0x67, 0xd2, 0x04, 0x95, 0x37, 0xc3, 0x2b, 0x19, 0xec, 0x0e, 0xfd, 0x3e, 0xf5, 0x2d,
+	0xc7, 0xb7, 0x7b, 0x79, 0x6c, 0x55, 0x60, 0x1f, 0x95, 0x61, 0xdf, 0xa6, 0xa6, 0x1c, 0xbf, 0x70,
+	0x38, 0x4b, 0xb9, 0x3a, 0xaa, 0x0f, 0x17, 0xad, 0x0c, 0x76, 0xc1, 0x7f, 0x21, 0xc9, 0xe7, 0xaf,
+	0x8b, 0xfc, 0xfd, 0xb2, 0x7c, 0x44, 0xac, 0xe2, 0xc6, 0xe6, 0x01, 0xb0, 0x01, 0x6a, 0xe4, 0x22,
+	0xa0, 0x21, 0x27, 0x96, 0x52, 0x6b, 0xca, 0xad, 0x1a, 0xca, 0xd6, 0xf0, 0x8b, 0x0c, 0x76, 0x67,
+	0xda, 0x1e, 0x77, 0x3c, 0xc2, 0x38, 0xf6, 0x02, 0xa6, 0x6c, 0x88, 0xd8, 0xa3, 0xbf, 0x9f, 0xe6,
+	0x69, 0xe6, 0x41, 0x64, 0x40, 0x43, 0xab, 0xb8, 0xfb, 0xa5, 0x78, 0x1d, 0xd5, 0xad, 0x25, 0x04,
+	0xfd, 0x35, 0x80, 0x8b, 0x97, 0x0d, 0x2a, 0x60, 0x1d, 0x5b, 0x56, 0x48, 0x58, 0xfc, 0xd8, 0x36,
+	0x50, 0xba, 0x84, 0x75, 0xb0, 0x36, 0x7b, 0x3c, 0x15, 0x14, 0x2f, 0x8e, 0x6b, 0x9f, 0xaf, 0x35,
+	0xe9, 0xd7, 0xb5, 0x26, 0xe9, 0x57, 0x2b, 0xa0, 0x51, 0xde, 0x2a, 0xec, 0x80, 0x9d, 0xa4, 0x0d,
+	0x1a, 0xf6, 0xe6, 0x22, 0xda, 0x7b, 0xd3, 0x91, 0xa6, 0xcc, 0xed, 0x60, 0x26, 0xd1, 0xd1, 0x76,
+	0x56, 0x7b, 0x96, 0x74, 0xd2, 0x01, 0x3b, 0xb3, 0xfb, 0x9f, 0xa2, 0x56, 0x8a, 0xa8, 0x05, 0x89,
+	0x8e, 0xb6, 0xb3, 0x5a, 0x8a, 0x42, 0x00, 0xe4, 0x3e, 0x44, 0x45, 0x8c, 0x97, 0x83, 0x7f, 0xf9,
+	0x10, 0xe9, 0x5b, 0x99, 0x51, 0xda, 0x2f, 0x6e, 0xc6, 0xaa, 0x7c, 0x3b, 0x56, 0xe5, 0x1f, 0x63,
+	0x55, 0xfe, 0x3a, 0x51, 0xa5, 0xdb, 0x89, 0x2a, 0x7d, 0x9b, 0xa8, 0xd2, 0xfb, 0x83, 0x3f, 0x0e,
+	0x9a, 0x8b, 0x6c, 0x2e, 0x8a, 0x91, 0xd3, 0xaf, 0x8a, 0x71, 0xf8, 0xe4, 0xf7, 0x00, 0x5e, 0x7e,
+	0xb1, 0x81, 0x8a, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -232,6 +313,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DelegationTimestamps) > 0 {
+		for iNdEx := len(m.DelegationTimestamps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationTimestamps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -370,6 +465,53 @@ func (m *LastValidatorPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegationTimestampsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationTimestampsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationTimestampsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Timestamps.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -424,6 +566,12 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.DelegationTimestamps) > 0 {
+		for _, e := range m.DelegationTimestamps {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -443,6 +591,25 @@ func (m *LastValidatorPower) Size() (n int) {
 	return n
 }
 
+func (m *DelegationTimestampsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Timestamps.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -734,6 +901,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationTimestamps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationTimestamps = append(m.DelegationTimestamps, DelegationTimestampsRecord{})
+			if err := m.DelegationTimestamps[len(m.DelegationTimestamps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -856,6 +1057,153 @@ func (m *LastValidatorPower) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DelegationTimestampsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationTimestampsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationTimestampsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timestamps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -41,10 +41,12 @@ var (
 	RedelegationKey                  = []byte{0x34} // key for a redelegation
 	RedelegationByValSrcIndexKey     = []byte{0x35} // prefix for each key for an redelegation, by source validator operator
 	RedelegationByValDstIndexKey     = []byte{0x36} // prefix for each key for an redelegation, by destination validator operator
+	DelegationTimestampsKey          = []byte{0x37} // key for the timestamps of a delegation
 
-	UnbondingQueueKey    = []byte{0x41} // prefix for the timestamps in unbonding queue
-	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
-	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
+	UnbondingQueueKey      = []byte{0x41} // prefix for the timestamps in unbonding queue
+	RedelegationQueueKey   = []byte{0x42} // prefix for the timestamps in redelegations queue
+	ValidatorQueueKey      = []byte{0x43} // prefix for the timestamps in validator queue
+	ValidatorResetQueueKey = []byte{0x44} // prefix for the validators to reset
 
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
 )
@@ -177,6 +179,13 @@ func GetDelegationKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
 	return append(GetDelegationsKey(delAddr), address.MustLengthPrefix(valAddr)...)
 }
 
+// GetDelegationTimestampsKey creates the key for the timestamps of a delegator
+// bond with a validator
+// VALUE: staking/DelegationTimestamps
+func GetDelegationTimestampsKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(append(DelegationTimestampsKey, address.MustLengthPrefix(delAddr)...), address.MustLengthPrefix(valAddr)...)
+}
+
 // GetDelegationsKey creates the prefix for a delegator for all validators
 func GetDelegationsKey(delAddr sdk.AccAddress) []byte {
 	return append(DelegationKey, address.MustLengthPrefix(delAddr)...)
@@ -329,6 +338,11 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 	return append(GetREDsToValDstIndexKey(valDstAddr), address.MustLengthPrefix(delAddr)...)
 }
 
+// GetValidatorResetQueueKey creates the key for a validator to reset.
+func GetValidatorResetQueueKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorResetQueueKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
 // GetHistoricalInfoKey returns a key prefix for indexing HistoricalInfo objects.
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
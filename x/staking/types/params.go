@@ -39,6 +39,7 @@ var (
 	KeyBondDenom         = []byte("BondDenom")
 	KeyHistoricalEntries = []byte("HistoricalEntries")
 	KeyPowerReduction    = []byte("PowerReduction")
+	KeyBondDenomWeights  = []byte("BondDenomWeights")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -59,6 +60,14 @@ func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historica
 	}
 }
 
+// NewDenomWeight creates a new DenomWeight instance
+func NewDenomWeight(denom string, weight sdk.Dec) DenomWeight {
+	return DenomWeight{
+		Denom:  denom,
+		Weight: weight,
+	}
+}
+
 // Implements params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
@@ -67,6 +76,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyMaxEntries, &p.MaxEntries, validateMaxEntries),
 		paramtypes.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
 		paramtypes.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
+		paramtypes.NewParamSetPair(KeyBondDenomWeights, &p.BondDenomWeights, validateBondDenomWeights),
 	}
 }
 
@@ -125,6 +135,16 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateBondDenomWeights(p.BondDenomWeights); err != nil {
+		return err
+	}
+
+	for _, dw := range p.BondDenomWeights {
+		if dw.Denom == p.BondDenom {
+			return fmt.Errorf("bond denom %s cannot have a weight", dw.Denom)
+		}
+	}
+
 	return nil
 }
 
@@ -193,6 +213,30 @@ func validateBondDenom(i interface{}) error {
 	return nil
 }
 
+func validateBondDenomWeights(i interface{}) error {
+	v, ok := i.([]DenomWeight)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, dw := range v {
+		if err := sdk.ValidateDenom(dw.Denom); err != nil {
+			return err
+		}
+		if seen[dw.Denom] {
+			return fmt.Errorf("duplicate weight of denom %s", dw.Denom)
+		}
+		seen[dw.Denom] = true
+
+		if dw.Weight.IsNil() || !dw.Weight.IsPositive() {
+			return fmt.Errorf("weight of denom %s must be positive: %s", dw.Denom, dw.Weight)
+		}
+	}
+
+	return nil
+}
+
 func ValidatePowerReduction(i interface{}) error {
 	v, ok := i.(sdk.Int)
 	if !ok {
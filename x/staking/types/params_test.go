@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -21,3 +22,31 @@ func TestParamsEqual(t *testing.T) {
 	ok = p1.Equal(p2)
 	require.False(t, ok)
 }
+
+func TestValidateBondDenomWeights(t *testing.T) {
+	testCases := []struct {
+		name    string
+		weights []types.DenomWeight
+		expErr  bool
+	}{
+		{"no weights", nil, false},
+		{"valid weights", []types.DenomWeight{types.NewDenomWeight("ustk", sdk.NewDecWithPrec(5, 1)), types.NewDenomWeight("uatom", sdk.OneDec())}, false},
+		{"invalid denom", []types.DenomWeight{types.NewDenomWeight("1", sdk.OneDec())}, true},
+		{"duplicate denom", []types.DenomWeight{types.NewDenomWeight("ustk", sdk.OneDec()), types.NewDenomWeight("ustk", sdk.OneDec())}, true},
+		{"zero weight", []types.DenomWeight{types.NewDenomWeight("ustk", sdk.ZeroDec())}, true},
+		{"bond denom weight", []types.DenomWeight{types.NewDenomWeight(sdk.DefaultBondDenom, sdk.OneDec())}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.BondDenomWeights = tc.weights
+			if tc.expErr {
+				require.Error(t, params.Validate())
+			} else {
+				require.NoError(t, params.Validate())
+			}
+		})
+	}
+}
@@ -9,9 +9,13 @@ import (
 // - NotBondedPool -> "not_bonded_tokens_pool"
 //
 // - BondedPool -> "bonded_tokens_pool"
+//
+// - BondDenomReserve -> "bond_denom_reserve", the reserve of bond denom tokens
+// which the other denoms accepted for delegations are converted with
 const (
-	NotBondedPoolName = "not_bonded_tokens_pool"
-	BondedPoolName    = "bonded_tokens_pool"
+	NotBondedPoolName    = "not_bonded_tokens_pool"
+	BondedPoolName       = "bonded_tokens_pool"
+	BondDenomReserveName = "bond_denom_reserve"
 )
 
 // NewPool creates a new Pool instance used for queries
@@ -36,6 +36,9 @@ type QueryValidatorsRequest struct {
 	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// moniker_prefix enables to query for validators whose moniker starts with
+	// the given prefix.
+	MonikerPrefix string `protobuf:"bytes,3,opt,name=moniker_prefix,json=monikerPrefix,proto3" json:"moniker_prefix,omitempty"`
 }
 
 func (m *QueryValidatorsRequest) Reset()         { *m = QueryValidatorsRequest{} }
@@ -85,6 +88,13 @@ func (m *QueryValidatorsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryValidatorsRequest) GetMonikerPrefix() string {
+	if m != nil {
+		return m.MonikerPrefix
+	}
+	return ""
+}
+
 // QueryValidatorsResponse is response type for the Query/Validators RPC method
 type QueryValidatorsResponse struct {
 	// validators contains all the queried validators.
@@ -1425,89 +1435,91 @@ func init() {
 }
 
 var fileDescriptor_f270127f442bbcd8 = []byte{
-	// 1303 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcf, 0x4f, 0x1c, 0x75,
-	0x14, 0xdf, 0x2f, 0x20, 0x91, 0xd7, 0xb4, 0xa9, 0xdf, 0x05, 0xc4, 0x29, 0xee, 0xd2, 0x09, 0x22,
-	0xa5, 0x74, 0x46, 0xa0, 0x52, 0xac, 0x4d, 0x15, 0xac, 0x54, 0xd2, 0x83, 0xb0, 0x46, 0xfc, 0x75,
-	0x20, 0xb3, 0x3b, 0xd3, 0xd9, 0x49, 0x97, 0x99, 0xed, 0xcc, 0x40, 0x40, 0xc2, 0x41, 0x4f, 0x7a,
-	0xd3, 0x78, 0x52, 0x2f, 0x3d, 0x98, 0x98, 0xe8, 0x51, 0xff, 0x01, 0x4f, 0xd6, 0x1b, 0x46, 0x0f,
-	0x7a, 0xa9, 0x06, 0x3c, 0x34, 0x9e, 0xbc, 0x19, 0x6f, 0x66, 0xbf, 0xf3, 0x66, 0x76, 0x86, 0xf9,
-	0xb9, 0xcb, 0x12, 0xd2, 0x13, 0xbb, 0xdf, 0x7d, 0x3f, 0x3e, 0x9f, 0xf7, 0xbe, 0xef, 0x7d, 0xdf,
-	0x0b, 0xc0, 0x57, 0x0c, 0x6b, 0xdd, 0xb0, 0x44, 0xcb, 0x96, 0xee, 0x68, 0xba, 0x2a, 0x6e, 0x4e,
-	0x95, 0x15, 0x5b, 0x9a, 0x12, 0xef, 0x6e, 0x28, 0xe6, 0xb6, 0x50, 0x37, 0x0d, 0xdb, 0xa0, 0x83,
-	0x8e, 0x8c, 0x80, 0x32, 0x02, 0xca, 0x70, 0x13, 0xa8, 0x5b, 0x96, 0x2c, 0xc5, 0x51, 0xf0, 0xd4,
-	0xeb, 0x92, 0xaa, 0xe9, 0x92, 0xad, 0x19, 0xba, 0x63, 0x83, 0xeb, 0x57, 0x0d, 0xd5, 0x60, 0x1f,
-	0xc5, 0xc6, 0x27, 0x3c, 0x1d, 0x56, 0x0d, 0x43, 0xad, 0x29, 0xa2, 0x54, 0xd7, 0x44, 0x49, 0xd7,
-	0x0d, 0x9b, 0xa9, 0x58, 0xf8, 0xeb, 0x68, 0x0c, 0x36, 0x17, 0x07, 0x93, 0xe2, 0xb7, 0x60, 0x70,
-	0xa5, 0xe1, 0x7b, 0x55, 0xaa, 0x69, 0xb2, 0x64, 0x1b, 0xa6, 0x55, 0x52, 0xee, 0x6e, 0x28, 0x96,
-	0x4d, 0x07, 0xa1, 0xd7, 0xb2, 0x25, 0x7b, 0xc3, 0x1a, 0x22, 0x23, 0x64, 0xbc, 0xaf, 0x84, 0xdf,
-	0xe8, 0x22, 0x40, 0x13, 0xdf, 0x50, 0xd7, 0x08, 0x19, 0x3f, 0x35, 0x3d, 0x26, 0x20, 0xc9, 0x06,
-	0x19, 0xc1, 0x61, 0x8f, 0xfe, 0x84, 0x65, 0x49, 0x55, 0xd0, 0x66, 0xc9, 0xa7, 0xc9, 0x7f, 0x4b,
-	0xe0, 0xc9, 0x90, 0x6b, 0xab, 0x6e, 0xe8, 0x96, 0x42, 0x6f, 0x02, 0x6c, 0x7a, 0xa7, 0x43, 0x64,
-	0xa4, 0x7b, 0xfc, 0xd4, 0xf4, 0x79, 0x21, 0x3a, 0x90, 0x82, 0xa7, 0xbf, 0xd0, 0x73, 0xff, 0x41,
-	0x31, 0x57, 0xf2, 0xa9, 0x36, 0x0c, 0x85, 0xc0, 0x3e, 0x9b, 0x0a, 0xd6, 0x41, 0x11, 0x40, 0x7b,
-	0x1d, 0x06, 0x82, 0x60, 0xdd, 0x30, 0x3d, 0x03, 0x67, 0x3c, 0x7f, 0x6b, 0x92, 0x2c, 0x9b, 0x18,
-	0xae, 0xd3, 0xde, 0xe9, 0xbc, 0x2c, 0x9b, 0xfc, 0xda, 0xe1, 0x38, 0x7b, 0x5c, 0x5f, 0x85, 0x3e,
-	0x4f, 0x94, 0xe9, 0xb6, 0x40, 0xb5, 0xa9, 0xc9, 0x7f, 0x4a, 0x60, 0x24, 0xe8, 0xe1, 0x86, 0x52,
-	0x53, 0x54, 0xe7, 0x4a, 0xb4, 0x06, 0xb6, 0x63, 0x29, 0x7e, 0x48, 0xe0, 0x7c, 0x02, 0x26, 0x0c,
-	0xc0, 0xfb, 0xd0, 0x2f, 0x7b, 0xc7, 0x6b, 0x26, 0x1e, 0xbb, 0x69, 0x9f, 0x88, 0x8b, 0x45, 0xd3,
-	0x94, 0x6b, 0x69, 0xe1, 0x5c, 0x23, 0x28, 0xdf, 0xfc, 0x51, 0xcc, 0x87, 0x7f, 0xb3, 0x4a, 0x79,
-	0x39, 0x7c, 0xd8, 0xb9, 0xfb, 0xf1, 0x05, 0x81, 0x0b, 0x41, 0xaa, 0x6f, 0xea, 0x65, 0x43, 0x97,
-	0x35, 0x5d, 0x3d, 0xf9, 0x3c, 0xfc, 0x4e, 0x60, 0x22, 0x0b, 0x38, 0x4c, 0x48, 0x19, 0xf2, 0x1b,
-	0xee, 0xef, 0xa1, 0x7c, 0x5c, 0x8c, 0xcb, 0x47, 0x84, 0x49, 0xbc, 0xa5, 0xd4, 0xb3, 0x76, 0x0c,
-	0x81, 0xaf, 0x63, 0x61, 0xf9, 0x53, 0xee, 0x05, 0x19, 0x53, 0x7e, 0x28, 0xc8, 0xde, 0x29, 0x0b,
-	0x72, 0x38, 0x17, 0x5d, 0x11, 0xb9, 0xb8, 0xfa, 0xf8, 0x47, 0xf7, 0x8a, 0xb9, 0x87, 0xf7, 0x8a,
-	0x39, 0x7e, 0x13, 0xfb, 0x56, 0xf8, 0x92, 0xd1, 0xf7, 0x20, 0x1f, 0x71, 0x95, 0xb1, 0xaa, 0x5b,
-	0xb8, 0xc9, 0x25, 0x1a, 0xbe, 0xac, 0xfc, 0x36, 0x14, 0x99, 0xdf, 0x88, 0x40, 0x1f, 0x37, 0xe5,
-	0x75, 0xec, 0x2d, 0x91, 0xae, 0x91, 0xfb, 0x12, 0xf4, 0x3a, 0x79, 0x46, 0xba, 0x6d, 0x5c, 0x14,
-	0x34, 0xc0, 0x7f, 0xe9, 0xf6, 0xb2, 0x1b, 0x2e, 0xec, 0xe8, 0x1a, 0xca, 0xc2, 0xb5, 0x43, 0x35,
-	0xe4, 0x0b, 0xc6, 0xcf, 0x6e, 0x57, 0x8b, 0x46, 0x87, 0xe1, 0xa8, 0x74, 0xac, 0xab, 0x39, 0xb1,
-	0x39, 0xde, 0xf6, 0xf5, 0x95, 0xdb, 0xbe, 0x3c, 0x4e, 0x29, 0xed, 0xeb, 0x64, 0x42, 0xef, 0x35,
-	0xb2, 0x14, 0x98, 0x8f, 0x62, 0x23, 0xfb, 0x87, 0xc0, 0x53, 0x8c, 0x5b, 0x49, 0x91, 0xdb, 0x0e,
-	0xf9, 0x24, 0x50, 0xcb, 0xac, 0xac, 0x45, 0x56, 0xf7, 0x59, 0xcb, 0xac, 0xac, 0x06, 0xde, 0x97,
-	0x49, 0xa0, 0xb2, 0x65, 0x1f, 0x96, 0xee, 0x76, 0xa4, 0x65, 0xcb, 0x5e, 0x4d, 0x78, 0x8d, 0x7a,
-	0x3a, 0x90, 0xce, 0x3d, 0x02, 0x5c, 0x14, 0x65, 0x4c, 0x9f, 0x06, 0x83, 0xa6, 0x92, 0x50, 0x44,
-	0x93, 0x71, 0x19, 0xf4, 0x9b, 0x3b, 0x54, 0x46, 0x03, 0xa6, 0x72, 0xdc, 0x73, 0x40, 0x31, 0x78,
-	0x43, 0xc3, 0x93, 0xf5, 0x89, 0x95, 0xcf, 0xf7, 0xa1, 0xbe, 0xfa, 0x48, 0xcc, 0xde, 0x5b, 0x50,
-	0x88, 0x41, 0x7d, 0xdc, 0xef, 0x5e, 0x35, 0x36, 0x99, 0x9d, 0x1e, 0xdf, 0x2f, 0x63, 0x25, 0xbc,
-	0xa6, 0x59, 0xb6, 0x61, 0x6a, 0x15, 0xa9, 0xb6, 0xa4, 0xdf, 0x36, 0x7c, 0xbb, 0x58, 0x55, 0xd1,
-	0xd4, 0xaa, 0xcd, 0x3c, 0x74, 0x97, 0xf0, 0x1b, 0xff, 0x0e, 0x9c, 0x8b, 0xd4, 0x42, 0x6c, 0x57,
-	0xa1, 0xa7, 0xaa, 0x59, 0x36, 0xc2, 0x1a, 0x8b, 0x83, 0x75, 0x48, 0x9b, 0xe9, 0xf0, 0x14, 0xce,
-	0x32, 0xd3, 0xcb, 0x86, 0x51, 0x43, 0x18, 0xfc, 0x2d, 0x78, 0xc2, 0x77, 0x86, 0x4e, 0x66, 0xa1,
-	0xa7, 0x6e, 0x18, 0x35, 0x74, 0x32, 0x1c, 0xe7, 0xa4, 0xa1, 0x83, 0xb4, 0x99, 0x3c, 0xdf, 0x0f,
-	0xd4, 0x31, 0x26, 0x99, 0xd2, 0xba, 0x5b, 0x1b, 0xfc, 0x1b, 0x90, 0x0f, 0x9c, 0xa2, 0x93, 0x6b,
-	0xd0, 0x5b, 0x67, 0x27, 0xe8, 0xa6, 0x10, 0xeb, 0x86, 0x49, 0xb9, 0xf3, 0x84, 0xa3, 0x33, 0xfd,
-	0xf7, 0x00, 0x3c, 0xc6, 0xac, 0xd2, 0xcf, 0x09, 0x40, 0xf3, 0xce, 0x53, 0x21, 0xce, 0x4c, 0xf4,
-	0x4e, 0xcc, 0x89, 0x99, 0xe5, 0x71, 0x66, 0x9b, 0xf8, 0xf0, 0x97, 0xbf, 0x3e, 0xeb, 0x1a, 0xa5,
-	0xbc, 0x18, 0xb3, 0x8d, 0xfb, 0xea, 0xe5, 0x6b, 0x02, 0x7d, 0x9e, 0x09, 0x7a, 0x29, 0x9b, 0x2b,
-	0x17, 0x99, 0x90, 0x55, 0x1c, 0x81, 0xbd, 0xc8, 0x80, 0x3d, 0x4f, 0x67, 0xd2, 0x81, 0x89, 0x3b,
-	0xc1, 0xa2, 0xd9, 0xa5, 0xbf, 0x12, 0xe8, 0x8f, 0x5a, 0xe9, 0xe8, 0x5c, 0x36, 0x14, 0xe1, 0x91,
-	0x82, 0x7b, 0xa1, 0x0d, 0x4d, 0xa4, 0x72, 0x93, 0x51, 0x99, 0xa7, 0x2f, 0xb5, 0x41, 0x45, 0xf4,
-	0xbd, 0x3b, 0xf4, 0x3f, 0x02, 0x4f, 0x27, 0x6e, 0x48, 0x74, 0x3e, 0x1b, 0xca, 0x84, 0xd9, 0x89,
-	0x5b, 0x38, 0x8a, 0x09, 0x64, 0xbc, 0xc2, 0x18, 0xdf, 0xa2, 0x4b, 0xed, 0x30, 0x6e, 0x4e, 0x44,
-	0x7e, 0xee, 0x3f, 0x12, 0x80, 0xa6, 0xab, 0x94, 0xc2, 0x08, 0x2d, 0x1e, 0x29, 0x85, 0x11, 0x1e,
-	0x6a, 0xf9, 0xb7, 0x19, 0x85, 0x12, 0x5d, 0x3e, 0x62, 0xd2, 0xc4, 0x9d, 0x60, 0xe3, 0xdf, 0xa5,
-	0xff, 0x12, 0xc8, 0x47, 0x44, 0x8f, 0x5e, 0x49, 0x84, 0x18, 0xbf, 0x54, 0x71, 0x73, 0xad, 0x2b,
-	0x22, 0xc9, 0x75, 0x46, 0x52, 0xa5, 0x4a, 0xa7, 0x49, 0x46, 0x26, 0x91, 0xfe, 0x44, 0xa0, 0x3f,
-	0x6a, 0x27, 0x49, 0x29, 0xcb, 0x84, 0x25, 0x2b, 0xa5, 0x2c, 0x93, 0x16, 0x20, 0xfe, 0x1a, 0x23,
-	0x3f, 0x4b, 0x2f, 0xc7, 0x91, 0x4f, 0xcc, 0x62, 0xa3, 0x16, 0x13, 0x87, 0xfc, 0x94, 0x5a, 0xcc,
-	0xb2, 0xc7, 0xa4, 0xd4, 0x62, 0xa6, 0x1d, 0x23, 0xbd, 0x16, 0x3d, 0x66, 0x19, 0xd3, 0x68, 0xd1,
-	0x1f, 0x08, 0x9c, 0x0e, 0x4c, 0xc4, 0x74, 0x2a, 0x11, 0x68, 0xd4, 0xc2, 0xc0, 0x4d, 0xb7, 0xa2,
-	0x82, 0x5c, 0x96, 0x18, 0x97, 0x57, 0xe8, 0x7c, 0x3b, 0x5c, 0xcc, 0x00, 0xe2, 0x3d, 0x02, 0xf9,
-	0x88, 0x29, 0x33, 0xa5, 0x0a, 0xe3, 0x87, 0x66, 0x6e, 0xae, 0x75, 0x45, 0x64, 0xb5, 0xc8, 0x58,
-	0xbd, 0x4c, 0xaf, 0xb7, 0xc3, 0xca, 0xf7, 0x3e, 0x3f, 0x20, 0x40, 0xc3, 0x7e, 0xe8, 0x6c, 0x8b,
-	0xc0, 0x5c, 0x42, 0x57, 0x5a, 0xd6, 0x43, 0x3e, 0x6f, 0x31, 0x3e, 0x2b, 0xf4, 0xf5, 0xa3, 0xf1,
-	0x09, 0x3f, 0xeb, 0xdf, 0x11, 0x38, 0x13, 0x9c, 0x05, 0x69, 0xf2, 0x2d, 0x8a, 0x1c, 0x56, 0xb9,
-	0x99, 0x96, 0x74, 0x90, 0xd4, 0x1c, 0x23, 0x35, 0x4d, 0x9f, 0x8b, 0x23, 0x55, 0xf5, 0xf4, 0xd6,
-	0x34, 0xfd, 0xb6, 0x21, 0xee, 0x38, 0x23, 0xf0, 0x2e, 0xfd, 0x80, 0x40, 0x4f, 0x63, 0xb8, 0xa4,
-	0xe3, 0x89, 0x7e, 0x7d, 0x73, 0x2c, 0x77, 0x21, 0x83, 0x24, 0xe2, 0x1a, 0x65, 0xb8, 0x0a, 0x74,
-	0x38, 0x0e, 0x57, 0x63, 0x96, 0xa5, 0x1f, 0x13, 0xe8, 0x75, 0x26, 0x4f, 0x3a, 0x91, 0x6c, 0xdb,
-	0x3f, 0xec, 0x72, 0x17, 0x33, 0xc9, 0x22, 0x92, 0x31, 0x86, 0x64, 0x84, 0x16, 0x62, 0x91, 0x38,
-	0xa3, 0xef, 0xe2, 0xfd, 0xfd, 0x02, 0xd9, 0xdb, 0x2f, 0x90, 0x3f, 0xf7, 0x0b, 0xe4, 0x93, 0x83,
-	0x42, 0x6e, 0xef, 0xa0, 0x90, 0xfb, 0xed, 0xa0, 0x90, 0x7b, 0x77, 0x52, 0xd5, 0xec, 0xea, 0x46,
-	0x59, 0xa8, 0x18, 0xeb, 0xae, 0x0d, 0xe7, 0xcf, 0x25, 0x4b, 0xbe, 0x23, 0x6e, 0x79, 0x06, 0xed,
-	0xed, 0xba, 0x62, 0x95, 0x7b, 0xd9, 0x3f, 0x88, 0x66, 0xfe, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x4b,
-	0x6e, 0xeb, 0x7f, 0xe4, 0x1a, 0x00, 0x00,
+	// 1331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcb, 0x6f, 0x1b, 0x55,
+	0x17, 0xf7, 0x6d, 0xf3, 0x45, 0x5f, 0x4f, 0xd5, 0x2a, 0x5c, 0x27, 0x69, 0x98, 0x06, 0xdb, 0x1d,
+	0xa5, 0x25, 0x4d, 0x53, 0x0f, 0x49, 0x4a, 0x1a, 0x4a, 0x55, 0x48, 0x28, 0x29, 0x51, 0x17, 0x24,
+	0x46, 0x84, 0xd7, 0xc2, 0x1a, 0x7b, 0x26, 0xe3, 0x51, 0xec, 0x19, 0x77, 0x66, 0x12, 0x25, 0x44,
+	0x59, 0xc0, 0x0a, 0x76, 0x20, 0x56, 0xc0, 0x82, 0x2e, 0x90, 0x90, 0x60, 0x09, 0xff, 0x00, 0x2b,
+	0xca, 0x2e, 0x08, 0x16, 0xb0, 0x29, 0x28, 0x61, 0x51, 0xb1, 0x62, 0x87, 0xd8, 0x21, 0xdf, 0x39,
+	0x33, 0x9e, 0xf1, 0x3c, 0x3c, 0x76, 0x1c, 0x45, 0x5d, 0xc5, 0xbe, 0x3e, 0x8f, 0xdf, 0xef, 0x3c,
+	0xee, 0x3d, 0x47, 0x01, 0xbe, 0xac, 0x9b, 0x35, 0xdd, 0x14, 0x4c, 0x4b, 0x5c, 0x57, 0x35, 0x45,
+	0xd8, 0x9c, 0x2a, 0xc9, 0x96, 0x38, 0x25, 0xdc, 0xdb, 0x90, 0x8d, 0xed, 0x7c, 0xdd, 0xd0, 0x2d,
+	0x9d, 0x0e, 0xdb, 0x32, 0x79, 0x94, 0xc9, 0xa3, 0x0c, 0x37, 0x81, 0xba, 0x25, 0xd1, 0x94, 0x6d,
+	0x05, 0x57, 0xbd, 0x2e, 0x2a, 0xaa, 0x26, 0x5a, 0xaa, 0xae, 0xd9, 0x36, 0xb8, 0x41, 0x45, 0x57,
+	0x74, 0xf6, 0x51, 0x68, 0x7c, 0xc2, 0xd3, 0x51, 0x45, 0xd7, 0x95, 0xaa, 0x2c, 0x88, 0x75, 0x55,
+	0x10, 0x35, 0x4d, 0xb7, 0x98, 0x8a, 0x89, 0xbf, 0x8e, 0x45, 0x60, 0x73, 0x70, 0x30, 0x29, 0xfe,
+	0x0b, 0x02, 0xc3, 0x2b, 0x0d, 0xe7, 0xab, 0x62, 0x55, 0x95, 0x44, 0x4b, 0x37, 0xcc, 0x82, 0x7c,
+	0x6f, 0x43, 0x36, 0x2d, 0x3a, 0x0c, 0xfd, 0xa6, 0x25, 0x5a, 0x1b, 0xe6, 0x08, 0xc9, 0x91, 0xf1,
+	0x53, 0x05, 0xfc, 0x46, 0x17, 0x01, 0x9a, 0x00, 0x47, 0x4e, 0xe4, 0xc8, 0xf8, 0xe9, 0xe9, 0x4b,
+	0x79, 0x64, 0xd9, 0x60, 0x93, 0xb7, 0xe9, 0xa3, 0xc3, 0xfc, 0xb2, 0xa8, 0xc8, 0x68, 0xb3, 0xe0,
+	0xd1, 0xa4, 0x17, 0xe1, 0x6c, 0x4d, 0xd7, 0xd4, 0x75, 0xd9, 0x28, 0xd6, 0x0d, 0x79, 0x4d, 0xdd,
+	0x1a, 0x39, 0xc9, 0xfc, 0x9c, 0xc1, 0xd3, 0x65, 0x76, 0xc8, 0x7f, 0x43, 0xe0, 0x5c, 0x00, 0xa1,
+	0x59, 0xd7, 0x35, 0x53, 0xa6, 0x77, 0x00, 0x36, 0xdd, 0xd3, 0x11, 0x92, 0x3b, 0x39, 0x7e, 0x7a,
+	0xfa, 0x42, 0x3e, 0x3c, 0xe0, 0x79, 0x57, 0x7f, 0xa1, 0xef, 0xc1, 0xc3, 0x6c, 0xaa, 0xe0, 0x51,
+	0x6d, 0x18, 0x0a, 0x70, 0x7a, 0xba, 0x2d, 0x27, 0x1b, 0x85, 0x97, 0x14, 0x7f, 0x0b, 0x86, 0xfc,
+	0x60, 0x9d, 0x68, 0x5e, 0x84, 0xb3, 0xae, 0xbf, 0xa2, 0x28, 0x49, 0x06, 0x46, 0xf5, 0x8c, 0x7b,
+	0x3a, 0x2f, 0x49, 0x06, 0x5f, 0x6c, 0x4d, 0x87, 0xcb, 0xf5, 0x65, 0x38, 0xe5, 0x8a, 0x32, 0xdd,
+	0x0e, 0xa8, 0x36, 0x35, 0xf9, 0x8f, 0x09, 0xe4, 0xfc, 0x1e, 0x6e, 0xcb, 0x55, 0x59, 0xb1, 0x4b,
+	0xa7, 0x33, 0xb0, 0xbd, 0xaa, 0x04, 0xfe, 0x11, 0x81, 0x0b, 0x31, 0x98, 0x30, 0x00, 0xef, 0xc2,
+	0xa0, 0xe4, 0x1e, 0x17, 0x0d, 0x3c, 0x76, 0xd2, 0x3e, 0x11, 0x15, 0x8b, 0xa6, 0x29, 0xc7, 0xd2,
+	0xc2, 0xf9, 0x46, 0x50, 0xbe, 0xfe, 0x3d, 0x9b, 0x0e, 0xfe, 0x66, 0x16, 0xd2, 0x52, 0xf0, 0xb0,
+	0x77, 0xf5, 0xf1, 0x19, 0x81, 0xcb, 0x7e, 0xaa, 0xaf, 0x6b, 0x25, 0x5d, 0x93, 0x54, 0x4d, 0x39,
+	0xfe, 0x3c, 0xfc, 0x46, 0x60, 0x22, 0x09, 0x38, 0x4c, 0x48, 0x09, 0xd2, 0x1b, 0xce, 0xef, 0x81,
+	0x7c, 0x5c, 0x89, 0xca, 0x47, 0x88, 0x49, 0xac, 0x52, 0xea, 0x5a, 0x3b, 0x82, 0xc0, 0xd7, 0xb1,
+	0xb1, 0xbc, 0x29, 0x77, 0x83, 0x8c, 0x29, 0x6f, 0x09, 0xb2, 0x7b, 0xca, 0x82, 0x1c, 0xcc, 0xc5,
+	0x89, 0x90, 0x5c, 0xdc, 0xf8, 0xff, 0x07, 0xf7, 0xb3, 0xa9, 0x47, 0xf7, 0xb3, 0x29, 0x7e, 0x13,
+	0xce, 0x05, 0x3c, 0x62, 0xe4, 0xde, 0x81, 0x74, 0x48, 0x29, 0x63, 0x57, 0x77, 0x50, 0xc9, 0x05,
+	0x1a, 0x2c, 0x56, 0x7e, 0x1b, 0xb2, 0xcc, 0x6f, 0x48, 0xa0, 0x8f, 0x9a, 0x72, 0x0d, 0x72, 0xd1,
+	0xae, 0x91, 0xfb, 0x12, 0xf4, 0xdb, 0x79, 0x46, 0xba, 0x5d, 0x14, 0x0a, 0x1a, 0xe0, 0x3f, 0x77,
+	0xee, 0xb2, 0xdb, 0x0e, 0xec, 0xf0, 0x1e, 0x4a, 0xc2, 0xb5, 0x47, 0x3d, 0xe4, 0x09, 0xc6, 0x4f,
+	0xce, 0xad, 0x16, 0x8e, 0x0e, 0xc3, 0x51, 0xee, 0xd9, 0xad, 0x66, 0xc7, 0xe6, 0x68, 0xaf, 0xaf,
+	0x2f, 0x9d, 0xeb, 0xcb, 0xe5, 0xd4, 0xe6, 0xfa, 0x3a, 0x9e, 0xd0, 0xbb, 0x17, 0x59, 0x1b, 0x98,
+	0x8f, 0xe3, 0x45, 0xf6, 0x37, 0x81, 0x27, 0x19, 0xb7, 0x82, 0x2c, 0x75, 0x1d, 0xf2, 0x49, 0xa0,
+	0xa6, 0x51, 0x2e, 0x86, 0x76, 0xf7, 0x80, 0x69, 0x94, 0x57, 0x7d, 0xef, 0xcb, 0x24, 0x50, 0xc9,
+	0xb4, 0x5a, 0xa5, 0xed, 0x69, 0x6d, 0x40, 0x32, 0xad, 0xd5, 0x98, 0xd7, 0xa8, 0xaf, 0x07, 0xe9,
+	0xdc, 0x23, 0xc0, 0x85, 0x51, 0xc6, 0xf4, 0xa9, 0x30, 0x6c, 0xc8, 0x31, 0x4d, 0x34, 0x19, 0x95,
+	0x41, 0xaf, 0xb9, 0x96, 0x36, 0x1a, 0x32, 0xe4, 0xa3, 0x9e, 0x03, 0xb2, 0xfe, 0x0a, 0x0d, 0x0e,
+	0xe0, 0xc7, 0xd6, 0x3e, 0xdf, 0x05, 0xee, 0xd5, 0xc7, 0x62, 0xf6, 0xde, 0x82, 0x4c, 0x04, 0xea,
+	0xa3, 0x7e, 0xf7, 0x2a, 0x91, 0xc9, 0xec, 0xf5, 0xf8, 0x7e, 0x0d, 0x3b, 0xe1, 0x15, 0xd5, 0xb4,
+	0x74, 0x43, 0x2d, 0x8b, 0xd5, 0x25, 0x6d, 0x4d, 0xf7, 0xac, 0x6c, 0x15, 0x59, 0x55, 0x2a, 0x16,
+	0xf3, 0x70, 0xb2, 0x80, 0xdf, 0xf8, 0xb7, 0xe0, 0x7c, 0xa8, 0x16, 0x62, 0xbb, 0x01, 0x7d, 0x15,
+	0xd5, 0xb4, 0x46, 0x88, 0xbf, 0x76, 0x5a, 0x61, 0xb5, 0x68, 0x33, 0x1d, 0x9e, 0xc2, 0x00, 0x33,
+	0xbd, 0xac, 0xeb, 0x55, 0x84, 0xc1, 0xdf, 0x85, 0x27, 0x3c, 0x67, 0xe8, 0x64, 0x16, 0xfa, 0xea,
+	0xba, 0x5e, 0x45, 0x27, 0xa3, 0x51, 0x4e, 0x1a, 0x3a, 0x48, 0x9b, 0xc9, 0xf3, 0x83, 0x40, 0x6d,
+	0x63, 0xa2, 0x21, 0xd6, 0x9c, 0xde, 0xe0, 0x5f, 0x83, 0xb4, 0xef, 0x14, 0x9d, 0xdc, 0x84, 0xfe,
+	0x3a, 0x3b, 0x41, 0x37, 0x99, 0x48, 0x37, 0x4c, 0xca, 0x99, 0x27, 0x6c, 0x9d, 0xe9, 0xbf, 0x86,
+	0xe0, 0x7f, 0xcc, 0x2a, 0xfd, 0x94, 0x00, 0x34, 0x6b, 0x9e, 0xe6, 0xa3, 0xcc, 0x84, 0xaf, 0xce,
+	0x9c, 0x90, 0x58, 0x1e, 0x67, 0xb6, 0x89, 0xf7, 0x7f, 0xfe, 0xf3, 0x93, 0x13, 0x63, 0x94, 0x17,
+	0x22, 0xb6, 0x76, 0x4f, 0xbf, 0x7c, 0x45, 0xe0, 0x94, 0x6b, 0x82, 0x5e, 0x4d, 0xe6, 0xca, 0x41,
+	0x96, 0x4f, 0x2a, 0x8e, 0xc0, 0x9e, 0x67, 0xc0, 0x9e, 0xa5, 0x33, 0xed, 0x81, 0x09, 0x3b, 0xfe,
+	0xa6, 0xd9, 0xa5, 0xbf, 0x10, 0x18, 0x0c, 0x5b, 0xe9, 0xe8, 0x5c, 0x32, 0x14, 0xc1, 0x91, 0x82,
+	0x7b, 0xae, 0x0b, 0x4d, 0xa4, 0x72, 0x87, 0x51, 0x99, 0xa7, 0x2f, 0x74, 0x41, 0x45, 0xf0, 0xbc,
+	0x3b, 0xf4, 0x5f, 0x02, 0x4f, 0xc5, 0x6e, 0x48, 0x74, 0x3e, 0x19, 0xca, 0x98, 0xd9, 0x89, 0x5b,
+	0x38, 0x8c, 0x09, 0x64, 0xbc, 0xc2, 0x18, 0xdf, 0xa5, 0x4b, 0xdd, 0x30, 0x6e, 0x4e, 0x44, 0x5e,
+	0xee, 0x3f, 0x10, 0x80, 0xa6, 0xab, 0x36, 0x8d, 0x11, 0x58, 0x3c, 0x38, 0x21, 0xb1, 0x3c, 0x52,
+	0x78, 0x93, 0x51, 0x28, 0xd0, 0xe5, 0x43, 0x26, 0x4d, 0xd8, 0xf1, 0x5f, 0xfc, 0xbb, 0xf4, 0x1f,
+	0x02, 0xe9, 0x90, 0xe8, 0xd1, 0xeb, 0xb1, 0x10, 0xa3, 0x97, 0x2a, 0x6e, 0xae, 0x73, 0x45, 0x24,
+	0x59, 0x63, 0x24, 0x15, 0x2a, 0xf7, 0x9a, 0x64, 0x68, 0x12, 0xe9, 0x8f, 0x04, 0x06, 0xc3, 0x76,
+	0x92, 0x36, 0x6d, 0x19, 0xb3, 0x64, 0xb5, 0x69, 0xcb, 0xb8, 0x05, 0x88, 0xbf, 0xc9, 0xc8, 0xcf,
+	0xd2, 0x6b, 0x51, 0xe4, 0x63, 0xb3, 0xd8, 0xe8, 0xc5, 0xd8, 0x21, 0xbf, 0x4d, 0x2f, 0x26, 0xd9,
+	0x63, 0xda, 0xf4, 0x62, 0xa2, 0x1d, 0xa3, 0x7d, 0x2f, 0xba, 0xcc, 0x12, 0xa6, 0xd1, 0xa4, 0xdf,
+	0x13, 0x38, 0xe3, 0x9b, 0x88, 0xe9, 0x54, 0x2c, 0xd0, 0xb0, 0x85, 0x81, 0x9b, 0xee, 0x44, 0x05,
+	0xb9, 0x2c, 0x31, 0x2e, 0x2f, 0xd1, 0xf9, 0x6e, 0xb8, 0x18, 0x3e, 0xc4, 0x7b, 0x04, 0xd2, 0x21,
+	0x53, 0x66, 0x9b, 0x2e, 0x8c, 0x1e, 0x9a, 0xb9, 0xb9, 0xce, 0x15, 0x91, 0xd5, 0x22, 0x63, 0xf5,
+	0x22, 0xbd, 0xd5, 0x0d, 0x2b, 0xcf, 0xfb, 0xfc, 0x90, 0x00, 0x0d, 0xfa, 0xa1, 0xb3, 0x1d, 0x02,
+	0x73, 0x08, 0x5d, 0xef, 0x58, 0x0f, 0xf9, 0xbc, 0xc1, 0xf8, 0xac, 0xd0, 0x57, 0x0f, 0xc7, 0x27,
+	0xf8, 0xac, 0x7f, 0x4b, 0xe0, 0xac, 0x7f, 0x16, 0xa4, 0xf1, 0x55, 0x14, 0x3a, 0xac, 0x72, 0x33,
+	0x1d, 0xe9, 0x20, 0xa9, 0x39, 0x46, 0x6a, 0x9a, 0x3e, 0x13, 0x45, 0xaa, 0xe2, 0xea, 0x15, 0x55,
+	0x6d, 0x4d, 0x17, 0x76, 0xec, 0x11, 0x78, 0x97, 0xbe, 0x47, 0xa0, 0xaf, 0x31, 0x5c, 0xd2, 0xf1,
+	0x58, 0xbf, 0x9e, 0x39, 0x96, 0xbb, 0x9c, 0x40, 0x12, 0x71, 0x8d, 0x31, 0x5c, 0x19, 0x3a, 0x1a,
+	0x85, 0xab, 0x31, 0xcb, 0xd2, 0x0f, 0x09, 0xf4, 0xdb, 0x93, 0x27, 0x9d, 0x88, 0xb7, 0xed, 0x1d,
+	0x76, 0xb9, 0x2b, 0x89, 0x64, 0x11, 0xc9, 0x25, 0x86, 0x24, 0x47, 0x33, 0x91, 0x48, 0xec, 0xd1,
+	0x77, 0xf1, 0xc1, 0x7e, 0x86, 0xec, 0xed, 0x67, 0xc8, 0x1f, 0xfb, 0x19, 0xf2, 0xd1, 0x41, 0x26,
+	0xb5, 0x77, 0x90, 0x49, 0xfd, 0x7a, 0x90, 0x49, 0xbd, 0x3d, 0xa9, 0xa8, 0x56, 0x65, 0xa3, 0x94,
+	0x2f, 0xeb, 0x35, 0xc7, 0x86, 0xfd, 0xe7, 0xaa, 0x29, 0xad, 0x0b, 0x5b, 0xae, 0x41, 0x6b, 0xbb,
+	0x2e, 0x9b, 0xa5, 0x7e, 0xf6, 0x8f, 0xa4, 0x99, 0xff, 0x06, 0x00, 0xce, 0x80, 0x83, 0x91, 0x0c,
+	0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2114,6 +2126,13 @@ func (m *QueryValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.MonikerPrefix) > 0 {
+		i -= len(m.MonikerPrefix)
+		copy(dAtA[i:], m.MonikerPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MonikerPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -3220,6 +3239,10 @@ func (m *QueryValidatorsRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.MonikerPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3744,6 +3767,38 @@ func (m *QueryValidatorsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonikerPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MonikerPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
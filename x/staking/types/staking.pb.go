@@ -234,6 +234,10 @@ type Description struct {
 	SecurityContact string `protobuf:"bytes,4,opt,name=security_contact,json=securityContact,proto3" json:"security_contact,omitempty" yaml:"security_contact"`
 	// details define other optional details.
 	Details string `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+	// peers defines the optional comma-separated public P2P endpoints, in the
+	// node_id@host:port form, through which the validator is reachable, e.g. the
+	// ones of its sentries.
+	Peers string `protobuf:"bytes,6,opt,name=peers,proto3" json:"peers,omitempty"`
 }
 
 func (m *Description) Reset()      { *m = Description{} }
@@ -303,6 +307,13 @@ func (m *Description) GetDetails() string {
 	return ""
 }
 
+func (m *Description) GetPeers() string {
+	if m != nil {
+		return m.Peers
+	}
+	return ""
+}
+
 // Validator defines a validator, together with the total amount of the
 // Validator's bond shares and their exchange rate to coins. Slashing results in
 // a decrease in the exchange rate, allowing correct calculation of future
@@ -628,6 +639,62 @@ func (m *Delegation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Delegation proto.InternalMessageInfo
 
+// DelegationTimestamps records when a delegation was created and when its
+// shares were last modified.
+type DelegationTimestamps struct {
+	// created_at is the block time at which the delegation was created.
+	CreatedAt time.Time `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at" yaml:"created_at"`
+	// updated_at is the block time at which the delegation shares were last modified.
+	UpdatedAt time.Time `protobuf:"bytes,2,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at" yaml:"updated_at"`
+}
+
+func (m *DelegationTimestamps) Reset()         { *m = DelegationTimestamps{} }
+func (m *DelegationTimestamps) String() string { return proto.CompactTextString(m) }
+func (*DelegationTimestamps) ProtoMessage()    {}
+func (*DelegationTimestamps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{11}
+}
+func (m *DelegationTimestamps) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationTimestamps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationTimestamps.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegationTimestamps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationTimestamps.Merge(m, src)
+}
+func (m *DelegationTimestamps) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationTimestamps) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationTimestamps.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationTimestamps proto.InternalMessageInfo
+
+func (m *DelegationTimestamps) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+func (m *DelegationTimestamps) GetUpdatedAt() time.Time {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return time.Time{}
+}
+
 // UnbondingDelegation stores all of a single delegator's unbonding bonds
 // for a single validator in an time-ordered list.
 type UnbondingDelegation struct {
@@ -642,7 +709,7 @@ type UnbondingDelegation struct {
 func (m *UnbondingDelegation) Reset()      { *m = UnbondingDelegation{} }
 func (*UnbondingDelegation) ProtoMessage() {}
 func (*UnbondingDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{11}
+	return fileDescriptor_64c30c6cf92913c9, []int{12}
 }
 func (m *UnbondingDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -686,7 +753,7 @@ type UnbondingDelegationEntry struct {
 func (m *UnbondingDelegationEntry) Reset()      { *m = UnbondingDelegationEntry{} }
 func (*UnbondingDelegationEntry) ProtoMessage() {}
 func (*UnbondingDelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{12}
+	return fileDescriptor_64c30c6cf92913c9, []int{13}
 }
 func (m *UnbondingDelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -744,7 +811,7 @@ type RedelegationEntry struct {
 func (m *RedelegationEntry) Reset()      { *m = RedelegationEntry{} }
 func (*RedelegationEntry) ProtoMessage() {}
 func (*RedelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{13}
+	return fileDescriptor_64c30c6cf92913c9, []int{14}
 }
 func (m *RedelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -803,7 +870,7 @@ type Redelegation struct {
 func (m *Redelegation) Reset()      { *m = Redelegation{} }
 func (*Redelegation) ProtoMessage() {}
 func (*Redelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{14}
+	return fileDescriptor_64c30c6cf92913c9, []int{15}
 }
 func (m *Redelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -844,12 +911,15 @@ type Params struct {
 	HistoricalEntries uint32 `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty" yaml:"historical_entries"`
 	// bond_denom defines the bondable coin denomination.
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty" yaml:"bond_denom"`
+	// bond_denom_weights are the weights of the other denoms accepted for
+	// delegations, which are converted to bond_denom tokens at their weights.
+	BondDenomWeights []DenomWeight `protobuf:"bytes,6,rep,name=bond_denom_weights,json=bondDenomWeights,proto3" json:"bond_denom_weights" yaml:"bond_denom_weights"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{15}
+	return fileDescriptor_64c30c6cf92913c9, []int{16}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -913,17 +983,74 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetBondDenomWeights() []DenomWeight {
+	if m != nil {
+		return m.BondDenomWeights
+	}
+	return nil
+}
+
+// DenomWeight is the weight of a denom accepted for delegations, i.e. the
+// bond denom tokens one unit of the denom converts to.
+type DenomWeight struct {
+	Denom  string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *DenomWeight) Reset()         { *m = DenomWeight{} }
+func (m *DenomWeight) String() string { return proto.CompactTextString(m) }
+func (*DenomWeight) ProtoMessage()    {}
+func (*DenomWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{17}
+}
+func (m *DenomWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomWeight.Merge(m, src)
+}
+func (m *DenomWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomWeight proto.InternalMessageInfo
+
+func (m *DenomWeight) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
 	Delegation Delegation  `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation"`
 	Balance    types2.Coin `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance"`
+	// timestamps records when the delegation was created and last modified. It is
+	// unset for delegations last modified before timestamps were recorded.
+	Timestamps *DelegationTimestamps `protobuf:"bytes,3,opt,name=timestamps,proto3" json:"timestamps,omitempty" yaml:"timestamps,omitempty"`
 }
 
 func (m *DelegationResponse) Reset()      { *m = DelegationResponse{} }
 func (*DelegationResponse) ProtoMessage() {}
 func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{16}
+	return fileDescriptor_64c30c6cf92913c9, []int{18}
 }
 func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -966,6 +1093,13 @@ func (m *DelegationResponse) GetBalance() types2.Coin {
 	return types2.Coin{}
 }
 
+func (m *DelegationResponse) GetTimestamps() *DelegationTimestamps {
+	if m != nil {
+		return m.Timestamps
+	}
+	return nil
+}
+
 // RedelegationEntryResponse is equivalent to a RedelegationEntry except that it
 // contains a balance in addition to shares which is more suitable for client
 // responses.
@@ -978,7 +1112,7 @@ func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResp
 func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntryResponse) ProtoMessage()    {}
 func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{17}
+	return fileDescriptor_64c30c6cf92913c9, []int{19}
 }
 func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1026,7 +1160,7 @@ func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
 func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationResponse) ProtoMessage()    {}
 func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{18}
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
 }
 func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1080,7 +1214,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{19}
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1122,11 +1256,13 @@ func init() {
 	proto.RegisterType((*DVVTriplet)(nil), "cosmos.staking.v1beta1.DVVTriplet")
 	proto.RegisterType((*DVVTriplets)(nil), "cosmos.staking.v1beta1.DVVTriplets")
 	proto.RegisterType((*Delegation)(nil), "cosmos.staking.v1beta1.Delegation")
+	proto.RegisterType((*DelegationTimestamps)(nil), "cosmos.staking.v1beta1.DelegationTimestamps")
 	proto.RegisterType((*UnbondingDelegation)(nil), "cosmos.staking.v1beta1.UnbondingDelegation")
 	proto.RegisterType((*UnbondingDelegationEntry)(nil), "cosmos.staking.v1beta1.UnbondingDelegationEntry")
 	proto.RegisterType((*RedelegationEntry)(nil), "cosmos.staking.v1beta1.RedelegationEntry")
 	proto.RegisterType((*Redelegation)(nil), "cosmos.staking.v1beta1.Redelegation")
 	proto.RegisterType((*Params)(nil), "cosmos.staking.v1beta1.Params")
+	proto.RegisterType((*DenomWeight)(nil), "cosmos.staking.v1beta1.DenomWeight")
 	proto.RegisterType((*DelegationResponse)(nil), "cosmos.staking.v1beta1.DelegationResponse")
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
@@ -1138,120 +1274,130 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1796 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4d, 0x6c, 0x23, 0x49,
-	0x15, 0x76, 0x3b, 0x5e, 0xc7, 0x7e, 0x4e, 0xe2, 0xa4, 0x26, 0x33, 0xeb, 0x98, 0xc1, 0xed, 0x6d,
-	0x56, 0x4b, 0x40, 0xbb, 0x0e, 0x93, 0x45, 0x8b, 0xc8, 0x05, 0xc6, 0x71, 0x86, 0x58, 0xbb, 0x0c,
-	0xa1, 0x93, 0x09, 0x12, 0xac, 0xb0, 0xca, 0xdd, 0x15, 0xa7, 0x89, 0xbb, 0xdb, 0x74, 0x95, 0x87,
-	0x58, 0xda, 0x03, 0xc7, 0x65, 0x10, 0x62, 0xb9, 0xed, 0x65, 0xa4, 0x91, 0xf6, 0xba, 0x12, 0x17,
-	0xc4, 0x95, 0xeb, 0x02, 0x97, 0xe1, 0x86, 0x10, 0x32, 0x68, 0xe6, 0x82, 0x38, 0x21, 0x1f, 0x10,
-	0x37, 0x50, 0xfd, 0xf4, 0x4f, 0xda, 0xf1, 0xcc, 0x78, 0xb4, 0x87, 0x91, 0xd8, 0x4b, 0xe2, 0x7a,
-	0xf5, 0xde, 0xf7, 0xea, 0xfd, 0xd6, 0xab, 0x86, 0x57, 0x2d, 0x9f, 0xba, 0x3e, 0xdd, 0xa2, 0x0c,
-	0x9f, 0x39, 0x5e, 0x6f, 0xeb, 0xee, 0x8d, 0x2e, 0x61, 0xf8, 0x46, 0xb8, 0x6e, 0x0c, 0x02, 0x9f,
-	0xf9, 0xe8, 0x9a, 0xe4, 0x6a, 0x84, 0x54, 0xc5, 0x55, 0x5d, 0xef, 0xf9, 0x3d, 0x5f, 0xb0, 0x6c,
-	0xf1, 0x5f, 0x92, 0xbb, 0xba, 0xd1, 0xf3, 0xfd, 0x5e, 0x9f, 0x6c, 0x89, 0x55, 0x77, 0x78, 0xb2,
-	0x85, 0xbd, 0x91, 0xda, 0xaa, 0xa5, 0xb7, 0xec, 0x61, 0x80, 0x99, 0xe3, 0x7b, 0x6a, 0x5f, 0x4f,
-	0xef, 0x33, 0xc7, 0x25, 0x94, 0x61, 0x77, 0x10, 0x62, 0xcb, 0x93, 0x74, 0xa4, 0x52, 0x75, 0x2c,
-	0x85, 0xad, 0x4c, 0xe9, 0x62, 0x4a, 0x22, 0x3b, 0x2c, 0xdf, 0x09, 0xb1, 0xaf, 0x33, 0xe2, 0xd9,
-	0x24, 0x70, 0x1d, 0x8f, 0x6d, 0xb1, 0xd1, 0x80, 0x50, 0xf9, 0x57, 0xee, 0x1a, 0x3f, 0xd3, 0x60,
-	0x65, 0xdf, 0xa1, 0xcc, 0x0f, 0x1c, 0x0b, 0xf7, 0xdb, 0xde, 0x89, 0x8f, 0xde, 0x82, 0xfc, 0x29,
-	0xc1, 0x36, 0x09, 0x2a, 0x5a, 0x5d, 0xdb, 0x2c, 0x6d, 0x57, 0x1a, 0x31, 0x42, 0x43, 0xca, 0xee,
-	0x8b, 0xfd, 0x66, 0xee, 0x93, 0xb1, 0x9e, 0x31, 0x15, 0x37, 0xfa, 0x06, 0xe4, 0xef, 0xe2, 0x3e,
-	0x25, 0xac, 0x92, 0xad, 0x2f, 0x6c, 0x96, 0xb6, 0x5f, 0x69, 0x5c, 0xee, 0xbe, 0xc6, 0x31, 0xee,
-	0x3b, 0x36, 0x66, 0x7e, 0x04, 0x20, 0xc5, 0x8c, 0x5f, 0x67, 0xa1, 0xbc, 0xeb, 0xbb, 0xae, 0x43,
-	0xa9, 0xe3, 0x7b, 0x26, 0x66, 0x84, 0xa2, 0x26, 0xe4, 0x02, 0xcc, 0x88, 0x38, 0x4a, 0xb1, 0xd9,
-	0xe0, 0xfc, 0x7f, 0x19, 0xeb, 0xaf, 0xf5, 0x1c, 0x76, 0x3a, 0xec, 0x36, 0x2c, 0xdf, 0x55, 0xce,
-	0x50, 0xff, 0xde, 0xa0, 0xf6, 0x99, 0xb2, 0xaf, 0x45, 0x2c, 0x53, 0xc8, 0xa2, 0x77, 0xa1, 0xe0,
-	0xe2, 0xf3, 0x8e, 0xc0, 0xc9, 0x0a, 0x9c, 0x9b, 0xf3, 0xe1, 0x4c, 0xc6, 0x7a, 0x79, 0x84, 0xdd,
-	0xfe, 0x8e, 0x11, 0xe2, 0x18, 0xe6, 0xa2, 0x8b, 0xcf, 0xf9, 0x11, 0xd1, 0x00, 0xca, 0x9c, 0x6a,
-	0x9d, 0x62, 0xaf, 0x47, 0xa4, 0x92, 0x05, 0xa1, 0x64, 0x7f, 0x6e, 0x25, 0xd7, 0x62, 0x25, 0x09,
-	0x38, 0xc3, 0x5c, 0x76, 0xf1, 0xf9, 0xae, 0x20, 0x70, 0x8d, 0x3b, 0x85, 0x0f, 0x1f, 0xe8, 0x99,
-	0x7f, 0x3c, 0xd0, 0x35, 0xe3, 0x4f, 0x1a, 0x40, 0xec, 0x31, 0xf4, 0x2e, 0xac, 0x5a, 0xd1, 0x4a,
-	0xc8, 0x52, 0x15, 0xc3, 0x2f, 0xce, 0x8a, 0x45, 0xca, 0xdf, 0xcd, 0x02, 0x3f, 0xf4, 0xc3, 0xb1,
-	0xae, 0x99, 0x65, 0x2b, 0x15, 0x8a, 0x1f, 0x40, 0x69, 0x38, 0xb0, 0x31, 0x23, 0x1d, 0x9e, 0x9d,
-	0xc2, 0x93, 0xa5, 0xed, 0x6a, 0x43, 0xa6, 0x6e, 0x23, 0x4c, 0xdd, 0xc6, 0x51, 0x98, 0xba, 0xcd,
-	0x1a, 0xc7, 0x9a, 0x8c, 0x75, 0x24, 0xcd, 0x4a, 0x08, 0x1b, 0x1f, 0xfc, 0x4d, 0xd7, 0x4c, 0x90,
-	0x14, 0x2e, 0x90, 0xb0, 0xe9, 0xf7, 0x1a, 0x94, 0x5a, 0x84, 0x5a, 0x81, 0x33, 0xe0, 0x15, 0x82,
-	0x2a, 0xb0, 0xe8, 0xfa, 0x9e, 0x73, 0xa6, 0xf2, 0xb1, 0x68, 0x86, 0x4b, 0x54, 0x85, 0x82, 0x63,
-	0x13, 0x8f, 0x39, 0x6c, 0x24, 0xe3, 0x6a, 0x46, 0x6b, 0x2e, 0xf5, 0x13, 0xd2, 0xa5, 0x4e, 0x18,
-	0x0d, 0x33, 0x5c, 0xa2, 0x5b, 0xb0, 0x4a, 0x89, 0x35, 0x0c, 0x1c, 0x36, 0xea, 0x58, 0xbe, 0xc7,
-	0xb0, 0xc5, 0x2a, 0x39, 0x11, 0xb0, 0xcf, 0x4d, 0xc6, 0xfa, 0xcb, 0xf2, 0xac, 0x69, 0x0e, 0xc3,
-	0x2c, 0x87, 0xa4, 0x5d, 0x49, 0xe1, 0x1a, 0x6c, 0xc2, 0xb0, 0xd3, 0xa7, 0x95, 0x97, 0xa4, 0x06,
-	0xb5, 0x4c, 0xd8, 0xf2, 0xf1, 0x22, 0x14, 0xa3, 0x6c, 0xe7, 0x9a, 0xfd, 0x01, 0x09, 0xf8, 0xef,
-	0x0e, 0xb6, 0xed, 0x80, 0x50, 0xaa, 0xf2, 0x3a, 0xa1, 0x39, 0xcd, 0x61, 0x98, 0xe5, 0x90, 0x74,
-	0x53, 0x52, 0x10, 0xe3, 0x61, 0xf6, 0x28, 0xf1, 0xe8, 0x90, 0x76, 0x06, 0xc3, 0xee, 0x19, 0x19,
-	0xa9, 0x68, 0xac, 0x4f, 0x45, 0xe3, 0xa6, 0x37, 0x6a, 0xbe, 0x19, 0xa3, 0xa7, 0xe5, 0x8c, 0x3f,
-	0xfc, 0xe6, 0x8d, 0x75, 0x95, 0x1a, 0x56, 0x30, 0x1a, 0x30, 0xbf, 0x71, 0x30, 0xec, 0xbe, 0x4d,
-	0x46, 0x3c, 0xfc, 0x8a, 0xf5, 0x40, 0x70, 0xa2, 0x6b, 0x90, 0xff, 0x11, 0x76, 0xfa, 0xc4, 0x16,
-	0x0e, 0x2d, 0x98, 0x6a, 0x85, 0x76, 0x20, 0x4f, 0x19, 0x66, 0x43, 0x2a, 0xbc, 0xb8, 0xb2, 0x6d,
-	0xcc, 0x4a, 0xb5, 0xa6, 0xef, 0xd9, 0x87, 0x82, 0xd3, 0x54, 0x12, 0xe8, 0x16, 0xe4, 0x99, 0x7f,
-	0x46, 0x3c, 0xe5, 0xc2, 0xb9, 0xea, 0xbb, 0xed, 0x31, 0x53, 0x49, 0x73, 0x8f, 0xd8, 0xa4, 0x4f,
-	0x7a, 0xc2, 0x71, 0xf4, 0x14, 0x07, 0x84, 0x56, 0xf2, 0x02, 0xb1, 0x3d, 0x77, 0x11, 0x2a, 0x4f,
-	0xa5, 0xf1, 0x0c, 0xb3, 0x1c, 0x91, 0x0e, 0x05, 0x05, 0xbd, 0x0d, 0x25, 0x3b, 0x4e, 0xd4, 0xca,
-	0xa2, 0x08, 0xc1, 0x17, 0x66, 0x99, 0x9f, 0xc8, 0x69, 0xd5, 0xf7, 0x92, 0xd2, 0x3c, 0x39, 0x86,
-	0x5e, 0xd7, 0xf7, 0x6c, 0xc7, 0xeb, 0x75, 0x4e, 0x89, 0xd3, 0x3b, 0x65, 0x95, 0x42, 0x5d, 0xdb,
-	0x5c, 0x48, 0x26, 0x47, 0x9a, 0xc3, 0x30, 0xcb, 0x11, 0x69, 0x5f, 0x50, 0x90, 0x0d, 0x2b, 0x31,
-	0x97, 0x28, 0xd4, 0xe2, 0x53, 0x0b, 0xf5, 0x15, 0x55, 0xa8, 0x57, 0xd3, 0x5a, 0xe2, 0x5a, 0x5d,
-	0x8e, 0x88, 0x5c, 0x0c, 0xed, 0x03, 0xc4, 0xed, 0xa1, 0x02, 0x42, 0x83, 0xf1, 0xf4, 0x1e, 0xa3,
-	0x0c, 0x4f, 0xc8, 0xa2, 0xf7, 0xe0, 0x8a, 0xeb, 0x78, 0x1d, 0x4a, 0xfa, 0x27, 0x1d, 0xe5, 0x60,
-	0x0e, 0x59, 0x12, 0xd1, 0x7b, 0x67, 0xbe, 0x7c, 0x98, 0x8c, 0xf5, 0xaa, 0x6a, 0xa1, 0xd3, 0x90,
-	0x86, 0xb9, 0xe6, 0x3a, 0xde, 0x21, 0xe9, 0x9f, 0xb4, 0x22, 0xda, 0xce, 0xd2, 0xfb, 0x0f, 0xf4,
-	0x8c, 0x2a, 0xd7, 0x8c, 0xf1, 0x16, 0x2c, 0x1d, 0xe3, 0xbe, 0x2a, 0x33, 0x42, 0xd1, 0x75, 0x28,
-	0xe2, 0x70, 0x51, 0xd1, 0xea, 0x0b, 0x9b, 0x45, 0x33, 0x26, 0xc8, 0x32, 0xff, 0xe9, 0x5f, 0xeb,
-	0x9a, 0xf1, 0xb1, 0x06, 0xf9, 0xd6, 0xf1, 0x01, 0x76, 0x02, 0xd4, 0x86, 0xb5, 0x38, 0x73, 0x2e,
-	0x16, 0xf9, 0xf5, 0xc9, 0x58, 0xaf, 0xa4, 0x93, 0x2b, 0xaa, 0xf2, 0x38, 0x81, 0xc3, 0x32, 0x6f,
-	0xc3, 0xda, 0xdd, 0xb0, 0x77, 0x44, 0x50, 0xd9, 0x34, 0xd4, 0x14, 0x8b, 0x61, 0xae, 0x46, 0x34,
-	0x05, 0x95, 0x32, 0x73, 0x0f, 0x16, 0xe5, 0x69, 0x29, 0xda, 0x81, 0x97, 0x06, 0xfc, 0x87, 0xb0,
-	0xae, 0xb4, 0x5d, 0x9b, 0x99, 0xbc, 0x82, 0x5f, 0x85, 0x4f, 0x8a, 0x18, 0xbf, 0xca, 0x02, 0xb4,
-	0x8e, 0x8f, 0x8f, 0x02, 0x67, 0xd0, 0x27, 0xec, 0xd3, 0xb4, 0xfc, 0x08, 0xae, 0xc6, 0x66, 0xd1,
-	0xc0, 0x4a, 0x59, 0x5f, 0x9f, 0x8c, 0xf5, 0xeb, 0x69, 0xeb, 0x13, 0x6c, 0x86, 0x79, 0x25, 0xa2,
-	0x1f, 0x06, 0xd6, 0xa5, 0xa8, 0x36, 0x65, 0x11, 0xea, 0xc2, 0x6c, 0xd4, 0x04, 0x5b, 0x12, 0xb5,
-	0x45, 0xd9, 0xe5, 0xae, 0x3d, 0x84, 0x52, 0xec, 0x12, 0x8a, 0x5a, 0x50, 0x60, 0xea, 0xb7, 0xf2,
-	0xb0, 0x31, 0xdb, 0xc3, 0xa1, 0x98, 0xf2, 0x72, 0x24, 0x69, 0xfc, 0x47, 0x03, 0x88, 0x73, 0xf6,
-	0xc5, 0x4c, 0x31, 0xde, 0xca, 0x55, 0xe3, 0x5d, 0x78, 0xae, 0x51, 0x4d, 0x49, 0xa7, 0xfc, 0xf9,
-	0xf3, 0x2c, 0x5c, 0xb9, 0x13, 0x76, 0x9e, 0x17, 0xde, 0x07, 0x07, 0xb0, 0x48, 0x3c, 0x16, 0x38,
-	0xc2, 0x09, 0x3c, 0xda, 0x5f, 0x99, 0x15, 0xed, 0x4b, 0x6c, 0xda, 0xf3, 0x58, 0x30, 0x52, 0xb1,
-	0x0f, 0x61, 0x52, 0xde, 0xf8, 0xe5, 0x02, 0x54, 0x66, 0x49, 0xa2, 0x5d, 0x28, 0x5b, 0x01, 0x11,
-	0x84, 0xf0, 0xfe, 0xd0, 0xc4, 0xfd, 0x51, 0x8d, 0x27, 0xcb, 0x14, 0x83, 0x61, 0xae, 0x84, 0x14,
-	0x75, 0x7b, 0xf4, 0x80, 0x8f, 0x7d, 0x3c, 0xed, 0x38, 0xd7, 0x33, 0xce, 0x79, 0x86, 0xba, 0x3e,
-	0x42, 0x25, 0x17, 0x01, 0xe4, 0xfd, 0xb1, 0x12, 0x53, 0xc5, 0x05, 0xf2, 0x63, 0x28, 0x3b, 0x9e,
-	0xc3, 0x1c, 0xdc, 0xef, 0x74, 0x71, 0x1f, 0x7b, 0xd6, 0xf3, 0x4c, 0xcd, 0xb2, 0xe5, 0x2b, 0xb5,
-	0x29, 0x38, 0xc3, 0x5c, 0x51, 0x94, 0xa6, 0x24, 0xa0, 0x7d, 0x58, 0x0c, 0x55, 0xe5, 0x9e, 0x6b,
-	0xda, 0x08, 0xc5, 0x13, 0x03, 0xde, 0x2f, 0x16, 0x60, 0xcd, 0x24, 0xf6, 0x67, 0xa1, 0x98, 0x2f,
-	0x14, 0xdf, 0x06, 0x90, 0xe5, 0xce, 0x1b, 0xec, 0x73, 0x44, 0x83, 0x37, 0x8c, 0xa2, 0x44, 0x68,
-	0x51, 0x96, 0x88, 0xc7, 0x38, 0x0b, 0x4b, 0xc9, 0x78, 0xfc, 0x9f, 0xde, 0x4a, 0xa8, 0x1d, 0x77,
-	0xa2, 0x9c, 0xe8, 0x44, 0x5f, 0x9a, 0xd5, 0x89, 0xa6, 0xb2, 0xf7, 0xc9, 0x2d, 0xe8, 0xdf, 0x59,
-	0xc8, 0x1f, 0xe0, 0x00, 0xbb, 0x14, 0x59, 0x53, 0x93, 0xa6, 0x7c, 0x6b, 0x6e, 0x4c, 0xe5, 0x67,
-	0x4b, 0x7d, 0xed, 0x78, 0xca, 0xa0, 0xf9, 0xe1, 0x25, 0x83, 0xe6, 0x37, 0x61, 0x85, 0x3f, 0x87,
-	0x23, 0x1b, 0xa5, 0xb7, 0x97, 0x9b, 0x1b, 0x31, 0xca, 0xc5, 0x7d, 0xf9, 0x5a, 0x8e, 0x1e, 0x5d,
-	0x14, 0x7d, 0x0d, 0x4a, 0x9c, 0x23, 0x6e, 0xcc, 0x5c, 0xfc, 0x5a, 0xfc, 0x2c, 0x4d, 0x6c, 0x1a,
-	0x26, 0xb8, 0xf8, 0x7c, 0x4f, 0x2e, 0xd0, 0x3b, 0x80, 0x4e, 0xa3, 0x2f, 0x23, 0x9d, 0xd8, 0x9d,
-	0x5c, 0xfe, 0xf3, 0x93, 0xb1, 0xbe, 0x21, 0xe5, 0xa7, 0x79, 0x0c, 0x73, 0x2d, 0x26, 0x86, 0x68,
-	0x5f, 0x05, 0xe0, 0x76, 0x75, 0x6c, 0xe2, 0xf9, 0xae, 0x7a, 0xee, 0x5c, 0x9d, 0x8c, 0xf5, 0x35,
-	0x89, 0x12, 0xef, 0x19, 0x66, 0x91, 0x2f, 0x5a, 0xfc, 0x77, 0x22, 0xb3, 0x3f, 0xd2, 0x00, 0xc5,
-	0x2d, 0xdf, 0x24, 0x74, 0xc0, 0xdf, 0x67, 0x7c, 0x10, 0x4f, 0x4c, 0xcd, 0xda, 0x93, 0x07, 0xf1,
-	0x58, 0x3e, 0x1c, 0xc4, 0x13, 0x95, 0xf2, 0xf5, 0xb8, 0x3d, 0x66, 0x55, 0x1c, 0x15, 0x4c, 0x17,
-	0x53, 0x92, 0x18, 0xe6, 0x9d, 0x50, 0x7a, 0xaa, 0x1f, 0x66, 0x8c, 0x3f, 0x6a, 0xb0, 0x31, 0x95,
-	0x51, 0xd1, 0x61, 0x7f, 0x08, 0x28, 0x48, 0x6c, 0x0a, 0x7f, 0x8d, 0xd4, 0xa1, 0xe7, 0x4e, 0xd0,
-	0xb5, 0x60, 0xaa, 0xef, 0x7e, 0x7a, 0x1d, 0x3e, 0x27, 0x7c, 0xfe, 0x3b, 0x0d, 0xd6, 0x93, 0xea,
-	0x23, 0x43, 0x6e, 0xc3, 0x52, 0x52, 0xbb, 0x32, 0xe1, 0xd5, 0x67, 0x31, 0x41, 0x9d, 0xfe, 0x82,
-	0x3c, 0xfa, 0x6e, 0x5c, 0xae, 0xf2, 0xdb, 0xd9, 0x8d, 0x67, 0xf6, 0x46, 0x78, 0xa6, 0x74, 0xd9,
-	0xe6, 0x44, 0x3c, 0xfe, 0xab, 0x41, 0xee, 0xc0, 0xf7, 0xfb, 0xc8, 0x87, 0x35, 0xcf, 0x67, 0x1d,
-	0x9e, 0x59, 0xc4, 0xee, 0xa8, 0x47, 0xb7, 0xec, 0x83, 0xbb, 0xf3, 0x39, 0xe9, 0x9f, 0x63, 0x7d,
-	0x1a, 0xca, 0x2c, 0x7b, 0x3e, 0x6b, 0x0a, 0xca, 0x91, 0x7c, 0x92, 0xbf, 0x07, 0xcb, 0x17, 0x95,
-	0xc9, 0x2e, 0xf9, 0xbd, 0xb9, 0x95, 0x5d, 0x84, 0x99, 0x8c, 0xf5, 0xf5, 0xb8, 0x62, 0x22, 0xb2,
-	0x61, 0x2e, 0x75, 0x13, 0xda, 0x77, 0x0a, 0x3c, 0x7e, 0xff, 0x7a, 0xa0, 0x6b, 0x5f, 0xfe, 0xad,
-	0x06, 0x10, 0x7f, 0x79, 0x40, 0xaf, 0xc3, 0xcb, 0xcd, 0xef, 0xdc, 0x6e, 0x75, 0x0e, 0x8f, 0x6e,
-	0x1e, 0xdd, 0x39, 0xec, 0xdc, 0xb9, 0x7d, 0x78, 0xb0, 0xb7, 0xdb, 0xbe, 0xd5, 0xde, 0x6b, 0xad,
-	0x66, 0xaa, 0xe5, 0x7b, 0xf7, 0xeb, 0xa5, 0x3b, 0x1e, 0x1d, 0x10, 0xcb, 0x39, 0x71, 0x88, 0x8d,
-	0x5e, 0x83, 0xf5, 0x8b, 0xdc, 0x7c, 0xb5, 0xd7, 0x5a, 0xd5, 0xaa, 0x4b, 0xf7, 0xee, 0xd7, 0x0b,
-	0x72, 0x16, 0x23, 0x36, 0xda, 0x84, 0xab, 0xd3, 0x7c, 0xed, 0xdb, 0xdf, 0x5a, 0xcd, 0x56, 0x97,
-	0xef, 0xdd, 0xaf, 0x17, 0xa3, 0xa1, 0x0d, 0x19, 0x80, 0x92, 0x9c, 0x0a, 0x6f, 0xa1, 0x0a, 0xf7,
-	0xee, 0xd7, 0xf3, 0xd2, 0x81, 0xd5, 0xdc, 0xfb, 0x1f, 0xd5, 0x32, 0xcd, 0x5b, 0x9f, 0x3c, 0xaa,
-	0x69, 0x0f, 0x1f, 0xd5, 0xb4, 0xbf, 0x3f, 0xaa, 0x69, 0x1f, 0x3c, 0xae, 0x65, 0x1e, 0x3e, 0xae,
-	0x65, 0xfe, 0xfc, 0xb8, 0x96, 0xf9, 0xfe, 0xeb, 0x4f, 0xf4, 0xdd, 0x79, 0xf4, 0x51, 0x5b, 0x78,
-	0xb1, 0x9b, 0x17, 0x6d, 0xf8, 0xcd, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x48, 0x4c, 0x86,
-	0xf3, 0x16, 0x00, 0x00,
+	// 1954 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x6c, 0x23, 0x57,
+	0x19, 0xf7, 0xd8, 0x5e, 0x27, 0xfe, 0x9c, 0x8d, 0x93, 0xb7, 0xd9, 0xad, 0xe3, 0x6e, 0x3d, 0xee,
+	0x50, 0x95, 0x80, 0xb6, 0x0e, 0x9b, 0xa2, 0x22, 0x72, 0x81, 0x38, 0xce, 0x92, 0xa8, 0x65, 0x09,
+	0x93, 0x6c, 0x8a, 0xa0, 0xc2, 0x7a, 0x9e, 0x79, 0x71, 0x86, 0x78, 0x66, 0xdc, 0x79, 0xcf, 0xdb,
+	0x58, 0xea, 0x81, 0x63, 0x59, 0x84, 0x28, 0x9c, 0x7a, 0x59, 0x69, 0x25, 0xae, 0x95, 0xb8, 0x20,
+	0xae, 0x5c, 0xab, 0x72, 0x59, 0x6e, 0x08, 0x21, 0x17, 0xed, 0x5e, 0x10, 0xe2, 0x80, 0x72, 0xe2,
+	0x06, 0x7a, 0x7f, 0xe6, 0x4f, 0xc6, 0xf1, 0x66, 0x1d, 0xf5, 0x50, 0x09, 0x2e, 0xc9, 0xbc, 0xef,
+	0x7d, 0xdf, 0xef, 0x7b, 0xdf, 0xdf, 0xf7, 0xc7, 0xf0, 0x8a, 0xe5, 0x53, 0xd7, 0xa7, 0xab, 0x94,
+	0xe1, 0x63, 0xc7, 0xeb, 0xae, 0xde, 0xbf, 0xdd, 0x21, 0x0c, 0xdf, 0x0e, 0xc7, 0x8d, 0x7e, 0xe0,
+	0x33, 0x1f, 0xdd, 0x90, 0x5c, 0x8d, 0x90, 0xaa, 0xb8, 0xaa, 0x4b, 0x5d, 0xbf, 0xeb, 0x0b, 0x96,
+	0x55, 0xfe, 0x25, 0xb9, 0xab, 0xcb, 0x5d, 0xdf, 0xef, 0xf6, 0xc8, 0xaa, 0x18, 0x75, 0x06, 0x87,
+	0xab, 0xd8, 0x1b, 0xaa, 0xa9, 0x5a, 0x7a, 0xca, 0x1e, 0x04, 0x98, 0x39, 0xbe, 0xa7, 0xe6, 0xf5,
+	0xf4, 0x3c, 0x73, 0x5c, 0x42, 0x19, 0x76, 0xfb, 0x21, 0xb6, 0x5c, 0x49, 0x5b, 0x2a, 0x55, 0xcb,
+	0x52, 0xd8, 0xca, 0x94, 0x0e, 0xa6, 0x24, 0xb2, 0xc3, 0xf2, 0x9d, 0x10, 0xfb, 0x26, 0x23, 0x9e,
+	0x4d, 0x02, 0xd7, 0xf1, 0xd8, 0x2a, 0x1b, 0xf6, 0x09, 0x95, 0x7f, 0xe5, 0xac, 0xf1, 0x33, 0x0d,
+	0xe6, 0xb7, 0x1d, 0xca, 0xfc, 0xc0, 0xb1, 0x70, 0x6f, 0xc7, 0x3b, 0xf4, 0xd1, 0x1b, 0x50, 0x38,
+	0x22, 0xd8, 0x26, 0x41, 0x45, 0xab, 0x6b, 0x2b, 0xa5, 0xb5, 0x4a, 0x23, 0x46, 0x68, 0x48, 0xd9,
+	0x6d, 0x31, 0xdf, 0xcc, 0x7f, 0x32, 0xd2, 0x33, 0xa6, 0xe2, 0x46, 0xdf, 0x82, 0xc2, 0x7d, 0xdc,
+	0xa3, 0x84, 0x55, 0xb2, 0xf5, 0xdc, 0x4a, 0x69, 0xed, 0xe5, 0xc6, 0xf9, 0xee, 0x6b, 0x1c, 0xe0,
+	0x9e, 0x63, 0x63, 0xe6, 0x47, 0x00, 0x52, 0xcc, 0xf8, 0x6d, 0x16, 0xca, 0x9b, 0xbe, 0xeb, 0x3a,
+	0x94, 0x3a, 0xbe, 0x67, 0x62, 0x46, 0x28, 0x6a, 0x42, 0x3e, 0xc0, 0x8c, 0x88, 0xa5, 0x14, 0x9b,
+	0x0d, 0xce, 0xff, 0x97, 0x91, 0xfe, 0x6a, 0xd7, 0x61, 0x47, 0x83, 0x4e, 0xc3, 0xf2, 0x5d, 0xe5,
+	0x0c, 0xf5, 0xef, 0x35, 0x6a, 0x1f, 0x2b, 0xfb, 0x5a, 0xc4, 0x32, 0x85, 0x2c, 0x7a, 0x07, 0x66,
+	0x5d, 0x7c, 0xd2, 0x16, 0x38, 0x59, 0x81, 0xb3, 0x31, 0x1d, 0xce, 0xe9, 0x48, 0x2f, 0x0f, 0xb1,
+	0xdb, 0x5b, 0x37, 0x42, 0x1c, 0xc3, 0x9c, 0x71, 0xf1, 0x09, 0x5f, 0x22, 0xea, 0x43, 0x99, 0x53,
+	0xad, 0x23, 0xec, 0x75, 0x89, 0x54, 0x92, 0x13, 0x4a, 0xb6, 0xa7, 0x56, 0x72, 0x23, 0x56, 0x92,
+	0x80, 0x33, 0xcc, 0xab, 0x2e, 0x3e, 0xd9, 0x14, 0x04, 0xae, 0x71, 0x7d, 0xf6, 0xa3, 0x47, 0x7a,
+	0xe6, 0xef, 0x8f, 0x74, 0xcd, 0xf8, 0x93, 0x06, 0x10, 0x7b, 0x0c, 0xbd, 0x03, 0x0b, 0x56, 0x34,
+	0x12, 0xb2, 0x54, 0xc5, 0xf0, 0xcb, 0x93, 0x62, 0x91, 0xf2, 0x77, 0x73, 0x96, 0x2f, 0xfa, 0xf1,
+	0x48, 0xd7, 0xcc, 0xb2, 0x95, 0x0a, 0xc5, 0x8f, 0xa0, 0x34, 0xe8, 0xdb, 0x98, 0x91, 0x36, 0xcf,
+	0x4e, 0xe1, 0xc9, 0xd2, 0x5a, 0xb5, 0x21, 0x53, 0xb7, 0x11, 0xa6, 0x6e, 0x63, 0x3f, 0x4c, 0xdd,
+	0x66, 0x8d, 0x63, 0x9d, 0x8e, 0x74, 0x24, 0xcd, 0x4a, 0x08, 0x1b, 0x1f, 0x7e, 0xa6, 0x6b, 0x26,
+	0x48, 0x0a, 0x17, 0x48, 0xd8, 0xf4, 0x99, 0x06, 0xa5, 0x16, 0xa1, 0x56, 0xe0, 0xf4, 0x79, 0x85,
+	0xa0, 0x0a, 0xcc, 0xb8, 0xbe, 0xe7, 0x1c, 0xab, 0x7c, 0x2c, 0x9a, 0xe1, 0x10, 0x55, 0x61, 0xd6,
+	0xb1, 0x89, 0xc7, 0x1c, 0x36, 0x94, 0x71, 0x35, 0xa3, 0x31, 0x97, 0x7a, 0x8f, 0x74, 0xa8, 0x13,
+	0x46, 0xc3, 0x0c, 0x87, 0xe8, 0x0e, 0x2c, 0x50, 0x62, 0x0d, 0x02, 0x87, 0x0d, 0xdb, 0x96, 0xef,
+	0x31, 0x6c, 0xb1, 0x4a, 0x5e, 0x04, 0xec, 0xc5, 0xd3, 0x91, 0xfe, 0x82, 0x5c, 0x6b, 0x9a, 0xc3,
+	0x30, 0xcb, 0x21, 0x69, 0x53, 0x52, 0xb8, 0x06, 0x9b, 0x30, 0xec, 0xf4, 0x68, 0xe5, 0x8a, 0xd4,
+	0xa0, 0x86, 0x68, 0x09, 0xae, 0xf4, 0x09, 0x09, 0x68, 0xa5, 0x20, 0xe8, 0x72, 0x90, 0xb0, 0xf0,
+	0xe3, 0x19, 0x28, 0x46, 0x35, 0xc0, 0xd7, 0xe3, 0xf7, 0x49, 0xc0, 0xbf, 0xdb, 0xd8, 0xb6, 0x03,
+	0x42, 0x69, 0x45, 0x4b, 0xaf, 0x27, 0xcd, 0x61, 0x98, 0xe5, 0x90, 0xb4, 0x21, 0x29, 0x88, 0xf1,
+	0xe0, 0x7b, 0x94, 0x78, 0x74, 0x40, 0xdb, 0xfd, 0x41, 0xe7, 0x98, 0x0c, 0x55, 0x8c, 0x96, 0xc6,
+	0x62, 0xb4, 0xe1, 0x0d, 0x9b, 0xaf, 0xc7, 0xe8, 0x69, 0x39, 0xe3, 0xd3, 0xdf, 0xbd, 0xb6, 0xa4,
+	0x12, 0xc6, 0x0a, 0x86, 0x7d, 0xe6, 0x37, 0x76, 0x07, 0x9d, 0x37, 0xc9, 0xd0, 0x2c, 0x47, 0xac,
+	0xbb, 0x82, 0x13, 0xdd, 0x80, 0xc2, 0x4f, 0xb0, 0xd3, 0x23, 0xb6, 0x70, 0xf3, 0xac, 0xa9, 0x46,
+	0x68, 0x1d, 0x0a, 0x94, 0x61, 0x36, 0xa0, 0xc2, 0xb7, 0xf3, 0x6b, 0xc6, 0xa4, 0x04, 0x6c, 0xfa,
+	0x9e, 0xbd, 0x27, 0x38, 0x4d, 0x25, 0x81, 0xee, 0x40, 0x81, 0xf9, 0xc7, 0xc4, 0x53, 0x8e, 0x9d,
+	0xaa, 0xea, 0x77, 0x3c, 0x66, 0x2a, 0x69, 0xee, 0x11, 0x9b, 0xf4, 0x48, 0x57, 0x38, 0x8e, 0x1e,
+	0xe1, 0x80, 0xa8, 0x90, 0x34, 0x77, 0xa6, 0x2e, 0x4d, 0xe5, 0xa9, 0x34, 0x9e, 0x61, 0x96, 0x23,
+	0xd2, 0x9e, 0xa0, 0xa0, 0x37, 0xa1, 0x64, 0xc7, 0xe9, 0x5b, 0x99, 0x11, 0x21, 0xf8, 0xd2, 0x24,
+	0xf3, 0x13, 0x99, 0xae, 0xba, 0x61, 0x52, 0x9a, 0x27, 0xc7, 0xc0, 0xeb, 0xf8, 0x9e, 0xed, 0x78,
+	0xdd, 0xf6, 0x11, 0x71, 0xba, 0x47, 0xac, 0x32, 0x5b, 0xd7, 0x56, 0x72, 0xc9, 0xe4, 0x48, 0x73,
+	0x18, 0x66, 0x39, 0x22, 0x6d, 0x0b, 0x0a, 0xb2, 0x61, 0x3e, 0xe6, 0x12, 0xe5, 0x5b, 0xbc, 0xb0,
+	0x7c, 0x5f, 0x56, 0xe5, 0x7b, 0x3d, 0xad, 0x25, 0xae, 0xe0, 0xab, 0x11, 0x91, 0x8b, 0xa1, 0x6d,
+	0x80, 0xb8, 0x69, 0x54, 0x40, 0x68, 0x30, 0x2e, 0xee, 0x3c, 0xca, 0xf0, 0x84, 0x2c, 0x7a, 0x1f,
+	0xae, 0xb9, 0x8e, 0xd7, 0xa6, 0xa4, 0x77, 0xd8, 0x56, 0x0e, 0xe6, 0x90, 0x25, 0x11, 0xbd, 0xb7,
+	0xa6, 0xcb, 0x87, 0xd3, 0x91, 0x5e, 0x55, 0x8d, 0x75, 0x1c, 0xd2, 0x30, 0x17, 0x5d, 0xc7, 0xdb,
+	0x23, 0xbd, 0xc3, 0x56, 0x44, 0x5b, 0x9f, 0xfb, 0xe0, 0x91, 0x9e, 0x51, 0xe5, 0x9a, 0x31, 0xde,
+	0x80, 0xb9, 0x03, 0xdc, 0x53, 0x65, 0x46, 0x28, 0xba, 0x09, 0x45, 0x1c, 0x0e, 0x2a, 0x5a, 0x3d,
+	0xb7, 0x52, 0x34, 0x63, 0x82, 0x2c, 0xf3, 0x9f, 0xfe, 0xb5, 0xae, 0x19, 0x1f, 0x6b, 0x50, 0x68,
+	0x1d, 0xec, 0x62, 0x27, 0x40, 0x3b, 0xb0, 0x18, 0x67, 0xce, 0xd9, 0x22, 0xbf, 0x79, 0x3a, 0xd2,
+	0x2b, 0xe9, 0xe4, 0x8a, 0xaa, 0x3c, 0x4e, 0xe0, 0xb0, 0xcc, 0x77, 0x60, 0xf1, 0x7e, 0xd8, 0x3b,
+	0x22, 0xa8, 0x6c, 0x1a, 0x6a, 0x8c, 0xc5, 0x30, 0x17, 0x22, 0x9a, 0x82, 0x4a, 0x99, 0xb9, 0x05,
+	0x33, 0x72, 0xb5, 0x14, 0xad, 0xc3, 0x95, 0x3e, 0xff, 0x10, 0xd6, 0x95, 0xd6, 0x6a, 0x13, 0x93,
+	0x57, 0xf0, 0xab, 0xf0, 0x49, 0x11, 0xe3, 0x57, 0x59, 0x80, 0xd6, 0xc1, 0xc1, 0x7e, 0xe0, 0xf4,
+	0x7b, 0x84, 0x7d, 0x9e, 0x96, 0xef, 0xc3, 0xf5, 0xd8, 0x2c, 0x1a, 0x58, 0x29, 0xeb, 0xeb, 0xa7,
+	0x23, 0xfd, 0x66, 0xda, 0xfa, 0x04, 0x9b, 0x61, 0x5e, 0x8b, 0xe8, 0x7b, 0x81, 0x75, 0x2e, 0xaa,
+	0x4d, 0x59, 0x84, 0x9a, 0x9b, 0x8c, 0x9a, 0x60, 0x4b, 0xa2, 0xb6, 0x28, 0x3b, 0xdf, 0xb5, 0x7b,
+	0x50, 0x8a, 0x5d, 0x42, 0x51, 0x0b, 0x66, 0x99, 0xfa, 0x56, 0x1e, 0x36, 0x26, 0x7b, 0x38, 0x14,
+	0x53, 0x5e, 0x8e, 0x24, 0x8d, 0x7f, 0x6b, 0x00, 0x71, 0xce, 0x7e, 0x31, 0x53, 0x8c, 0xb7, 0x72,
+	0xd5, 0x78, 0x73, 0x97, 0x3a, 0xc0, 0x29, 0xe9, 0x94, 0x3f, 0x3f, 0xd5, 0x60, 0x29, 0x36, 0x3d,
+	0xea, 0x58, 0x14, 0xfd, 0x00, 0xc0, 0x0a, 0x08, 0x66, 0xc4, 0x6e, 0x63, 0x56, 0xd1, 0x2e, 0x6c,
+	0x71, 0x2f, 0xa9, 0x16, 0xb7, 0xa8, 0xf6, 0xc1, 0x48, 0x56, 0xb6, 0xb7, 0xa2, 0x22, 0x6c, 0x30,
+	0x8e, 0x2c, 0x4f, 0x2b, 0x02, 0x39, 0x3b, 0x2d, 0x72, 0x2c, 0xab, 0x90, 0x15, 0x61, 0x83, 0x19,
+	0x3f, 0xcf, 0xc2, 0xb5, 0x7b, 0x61, 0x1b, 0xfd, 0xc2, 0x07, 0x74, 0x17, 0x66, 0x88, 0xc7, 0x02,
+	0x47, 0x44, 0x94, 0xa7, 0xee, 0xd7, 0x26, 0xa5, 0xee, 0x39, 0x36, 0x6d, 0x79, 0x2c, 0x18, 0xaa,
+	0x44, 0x0e, 0x61, 0x52, 0xa1, 0xfd, 0x65, 0x0e, 0x2a, 0x93, 0x24, 0xd1, 0x26, 0x94, 0x45, 0x44,
+	0xf8, 0xe9, 0x56, 0x6d, 0x86, 0x9a, 0xd8, 0x0c, 0xab, 0xf1, 0xe1, 0x39, 0xc5, 0x60, 0x98, 0xf3,
+	0x21, 0x45, 0x6d, 0x85, 0x5d, 0xe0, 0x27, 0x5b, 0x5e, 0x43, 0x9c, 0xeb, 0x39, 0x8f, 0xb2, 0x86,
+	0x0a, 0x67, 0xa8, 0xe4, 0x2c, 0x80, 0x8c, 0xe9, 0x7c, 0x4c, 0x15, 0xbb, 0xe1, 0xbb, 0x50, 0x76,
+	0x3c, 0x87, 0x39, 0xb8, 0xd7, 0xee, 0xe0, 0x1e, 0xf6, 0xac, 0xcb, 0x5c, 0x0c, 0xe4, 0xfe, 0xa5,
+	0xd4, 0xa6, 0xe0, 0x0c, 0x73, 0x5e, 0x51, 0x9a, 0x92, 0x80, 0xb6, 0x61, 0x26, 0x54, 0x95, 0xbf,
+	0xd4, 0xd1, 0x29, 0x14, 0x4f, 0x9c, 0x56, 0x7f, 0x91, 0x83, 0x45, 0x93, 0xd8, 0xff, 0x0f, 0xc5,
+	0x74, 0xa1, 0xf8, 0x2e, 0x80, 0xec, 0x5d, 0x7c, 0xb7, 0xa8, 0xe4, 0x2f, 0xd5, 0xfd, 0x8a, 0x12,
+	0xa1, 0x45, 0x59, 0x22, 0x1e, 0xa3, 0x2c, 0xcc, 0x25, 0xe3, 0xf1, 0x3f, 0xba, 0xc5, 0xa2, 0x9d,
+	0xb8, 0x13, 0xe5, 0x45, 0x27, 0xfa, 0xca, 0xa4, 0x4e, 0x34, 0x96, 0xbd, 0xcf, 0x6e, 0x41, 0xff,
+	0xcc, 0x41, 0x61, 0x17, 0x07, 0xd8, 0xa5, 0xc8, 0x1a, 0x3b, 0x36, 0xcb, 0x3d, 0x65, 0x79, 0x2c,
+	0x3f, 0x5b, 0xea, 0x41, 0xe7, 0x82, 0x53, 0xf3, 0x47, 0xe7, 0x9c, 0x9a, 0xbf, 0x0d, 0xf3, 0xfc,
+	0xc6, 0x1f, 0xd9, 0x28, 0xbd, 0x7d, 0xb5, 0xb9, 0x1c, 0xa3, 0x9c, 0x9d, 0x97, 0x0f, 0x02, 0xd1,
+	0x0d, 0x92, 0xa2, 0x6f, 0x40, 0x89, 0x73, 0xc4, 0x8d, 0x99, 0x8b, 0xdf, 0x88, 0x6f, 0xde, 0x89,
+	0x49, 0xc3, 0x04, 0x17, 0x9f, 0x6c, 0xc9, 0x01, 0x7a, 0x0b, 0xd0, 0x51, 0xf4, 0xf8, 0xd3, 0x8e,
+	0xdd, 0xc9, 0xe5, 0x5f, 0x3a, 0x1d, 0xe9, 0xcb, 0x52, 0x7e, 0x9c, 0xc7, 0x30, 0x17, 0x63, 0x62,
+	0x88, 0xf6, 0x75, 0x00, 0x6e, 0x57, 0xdb, 0x26, 0x9e, 0xef, 0xaa, 0xbb, 0xdb, 0xf5, 0x78, 0x0f,
+	0x8c, 0xe7, 0x0c, 0xb3, 0xc8, 0x07, 0x2d, 0xfe, 0x8d, 0x18, 0xa0, 0x78, 0xa6, 0xfd, 0x9e, 0xe8,
+	0x0c, 0xfc, 0x9e, 0x96, 0x7b, 0xf6, 0xb5, 0xc9, 0xf3, 0xdd, 0xb7, 0x05, 0x6f, 0xe4, 0xf1, 0xe5,
+	0xb4, 0x9a, 0x10, 0xcc, 0x30, 0x17, 0x22, 0x75, 0x52, 0x26, 0x79, 0x1b, 0x7f, 0x17, 0x4a, 0x89,
+	0x19, 0x7e, 0x79, 0x97, 0xeb, 0x97, 0x8f, 0x0d, 0x72, 0xc0, 0xcf, 0x31, 0x12, 0xac, 0x92, 0xbd,
+	0x54, 0x25, 0x2b, 0xe9, 0xf5, 0xbc, 0x50, 0xf9, 0xeb, 0x2c, 0xa0, 0x78, 0x6f, 0x33, 0x09, 0xed,
+	0xfb, 0x1e, 0x15, 0xd7, 0xa7, 0xc4, 0x5d, 0x47, 0x7b, 0xf6, 0xf5, 0x29, 0x96, 0x0f, 0xaf, 0x4f,
+	0xb1, 0x2c, 0xfa, 0x66, 0xbc, 0x0f, 0x64, 0x55, 0xc2, 0x2a, 0x98, 0x0e, 0xa6, 0x24, 0x71, 0x05,
+	0x73, 0x42, 0xe9, 0x90, 0x1f, 0xb9, 0x00, 0xd1, 0xe3, 0xa3, 0x4c, 0xa5, 0xd2, 0xda, 0xad, 0x8b,
+	0x17, 0x11, 0x1f, 0xc2, 0x9a, 0xfa, 0xe9, 0x48, 0x7f, 0x51, 0xc6, 0x22, 0x46, 0xba, 0xe5, 0xbb,
+	0x0e, 0x23, 0x6e, 0x9f, 0x0d, 0x0d, 0x33, 0xa1, 0x20, 0x8a, 0x43, 0xc6, 0xf8, 0xa3, 0x06, 0xcb,
+	0x63, 0x95, 0x1a, 0xf9, 0xe6, 0xc7, 0x80, 0x82, 0xc4, 0xa4, 0xc8, 0xc3, 0xa1, 0xf2, 0xd1, 0xd4,
+	0x85, 0xbf, 0x18, 0xa4, 0x27, 0x3e, 0xc7, 0x9d, 0x53, 0x86, 0xf8, 0x0f, 0x1a, 0x2c, 0x25, 0xd5,
+	0x47, 0x86, 0xdc, 0x85, 0xb9, 0xa4, 0x76, 0x65, 0xc2, 0x2b, 0xcf, 0x63, 0x82, 0x5a, 0xfd, 0x19,
+	0x79, 0xf4, 0xfd, 0xb8, 0x0d, 0xca, 0x67, 0xd7, 0xdb, 0xcf, 0xed, 0x8d, 0x70, 0x4d, 0xe9, 0x76,
+	0x98, 0x17, 0xf1, 0xf8, 0x8f, 0x06, 0xf9, 0x5d, 0xdf, 0xef, 0x21, 0x1f, 0x16, 0x3d, 0x9f, 0xb5,
+	0x79, 0x09, 0x11, 0xbb, 0xad, 0x5e, 0x66, 0xe4, 0xfe, 0xb2, 0x39, 0x9d, 0x93, 0xfe, 0x31, 0xd2,
+	0xc7, 0xa1, 0xcc, 0xb2, 0xe7, 0xb3, 0xa6, 0xa0, 0xec, 0x0b, 0x02, 0x7a, 0x1f, 0xae, 0x9e, 0x55,
+	0x26, 0x6b, 0xee, 0xed, 0xa9, 0x95, 0x9d, 0x85, 0x39, 0x1d, 0xe9, 0x4b, 0x71, 0x8b, 0x88, 0xc8,
+	0x86, 0x39, 0xd7, 0x49, 0x68, 0x5f, 0x9f, 0xe5, 0xf1, 0xfb, 0xd7, 0x23, 0x5d, 0xfb, 0xea, 0xef,
+	0x35, 0x80, 0xf8, 0x79, 0x0a, 0xdd, 0x82, 0x17, 0x9a, 0xdf, 0xbb, 0xdb, 0x6a, 0xef, 0xed, 0x6f,
+	0xec, 0xdf, 0xdb, 0x6b, 0xdf, 0xbb, 0xbb, 0xb7, 0xbb, 0xb5, 0xb9, 0x73, 0x67, 0x67, 0xab, 0xb5,
+	0x90, 0xa9, 0x96, 0x1f, 0x3c, 0xac, 0x97, 0xee, 0x79, 0xb4, 0x4f, 0x2c, 0xe7, 0xd0, 0x21, 0x36,
+	0x7a, 0x15, 0x96, 0xce, 0x72, 0xf3, 0xd1, 0x56, 0x6b, 0x41, 0xab, 0xce, 0x3d, 0x78, 0x58, 0x9f,
+	0x95, 0x67, 0x5c, 0x62, 0xa3, 0x15, 0xb8, 0x3e, 0xce, 0xb7, 0x73, 0xf7, 0x3b, 0x0b, 0xd9, 0xea,
+	0xd5, 0x07, 0x0f, 0xeb, 0xc5, 0xe8, 0x30, 0x8c, 0x0c, 0x40, 0x49, 0x4e, 0x85, 0x97, 0xab, 0xc2,
+	0x83, 0x87, 0xf5, 0x82, 0x74, 0x60, 0x35, 0xff, 0xc1, 0x6f, 0x6a, 0x99, 0xe6, 0x9d, 0x4f, 0x9e,
+	0xd4, 0xb4, 0xc7, 0x4f, 0x6a, 0xda, 0xdf, 0x9e, 0xd4, 0xb4, 0x0f, 0x9f, 0xd6, 0x32, 0x8f, 0x9f,
+	0xd6, 0x32, 0x7f, 0x7e, 0x5a, 0xcb, 0xfc, 0xf0, 0xd6, 0x33, 0x7d, 0x77, 0x12, 0xfd, 0x1e, 0x22,
+	0xbc, 0xd8, 0x29, 0x88, 0xed, 0xed, 0xf5, 0xff, 0x0e, 0x00, 0x5a, 0xd0, 0x16, 0x1d, 0x2e, 0x19,
+	0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1260,622 +1406,485 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 9834 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x5c, 0xd7,
-		0x75, 0x18, 0xde, 0x7e, 0x00, 0xbb, 0x07, 0x0b, 0x60, 0x71, 0x01, 0x92, 0xcb, 0x25, 0x09, 0x40,
-		0x4f, 0x5f, 0x14, 0x25, 0x81, 0x12, 0x25, 0x52, 0xd2, 0x32, 0xb6, 0xbc, 0x8b, 0x5d, 0x82, 0x10,
-		0xf1, 0xa5, 0x07, 0x80, 0x92, 0x65, 0xa7, 0x3b, 0x0f, 0xbb, 0x17, 0x8b, 0x27, 0xec, 0xbe, 0xf7,
-		0xf4, 0xde, 0x5b, 0x12, 0xa0, 0xed, 0x8e, 0xfc, 0x51, 0xd7, 0x66, 0x26, 0x8d, 0x5d, 0x77, 0x1a,
-		0x5b, 0x36, 0x5d, 0x39, 0x4e, 0xeb, 0xd4, 0x71, 0x1b, 0x3b, 0x76, 0xdd, 0xa6, 0xed, 0x4c, 0xed,
-		0xce, 0xa4, 0xb1, 0xdd, 0x26, 0x63, 0xb7, 0x99, 0x36, 0xcd, 0xa4, 0x74, 0x2a, 0x7b, 0x52, 0xd5,
-		0x75, 0x1b, 0x87, 0x71, 0xdb, 0x74, 0x3c, 0x9d, 0x76, 0xee, 0xd7, 0xfb, 0xda, 0x4f, 0x40, 0xa4,
-		0xed, 0x34, 0xfd, 0x85, 0xbd, 0xe7, 0x9e, 0x73, 0xee, 0x39, 0xe7, 0x9e, 0x7b, 0xee, 0xb9, 0x5f,
-		0x0f, 0xf0, 0x85, 0xf3, 0x30, 0x53, 0x33, 0x8c, 0x5a, 0x1d, 0x9f, 0x36, 0x2d, 0xc3, 0x31, 0x36,
-		0x9b, 0x5b, 0xa7, 0xab, 0xd8, 0xae, 0x58, 0x9a, 0xe9, 0x18, 0xd6, 0x2c, 0x85, 0xa1, 0x31, 0x86,
-		0x31, 0x2b, 0x30, 0xe4, 0x25, 0x18, 0xbf, 0xa0, 0xd5, 0x71, 0xd1, 0x45, 0x5c, 0xc3, 0x0e, 0x7a,
-		0x12, 0x62, 0x5b, 0x5a, 0x1d, 0x67, 0xa4, 0x99, 0xe8, 0xc9, 0xe1, 0x33, 0xf7, 0xcc, 0x86, 0x88,
-		0x66, 0x83, 0x14, 0xab, 0x04, 0xac, 0x50, 0x0a, 0xf9, 0xbb, 0x31, 0x98, 0x68, 0x53, 0x8b, 0x10,
-		0xc4, 0x74, 0xb5, 0x41, 0x38, 0x4a, 0x27, 0x93, 0x0a, 0xfd, 0x8d, 0x32, 0x30, 0x64, 0xaa, 0x95,
-		0x1d, 0xb5, 0x86, 0x33, 0x11, 0x0a, 0x16, 0x45, 0x34, 0x05, 0x50, 0xc5, 0x26, 0xd6, 0xab, 0x58,
-		0xaf, 0xec, 0x65, 0xa2, 0x33, 0xd1, 0x93, 0x49, 0xc5, 0x07, 0x41, 0x0f, 0xc2, 0xb8, 0xd9, 0xdc,
-		0xac, 0x6b, 0x95, 0xb2, 0x0f, 0x0d, 0x66, 0xa2, 0x27, 0xe3, 0x4a, 0x9a, 0x55, 0x14, 0x3d, 0xe4,
-		0xfb, 0x61, 0xec, 0x2a, 0x56, 0x77, 0xfc, 0xa8, 0xc3, 0x14, 0x75, 0x94, 0x80, 0x7d, 0x88, 0x73,
-		0x90, 0x6a, 0x60, 0xdb, 0x56, 0x6b, 0xb8, 0xec, 0xec, 0x99, 0x38, 0x13, 0xa3, 0xda, 0xcf, 0xb4,
-		0x68, 0x1f, 0xd6, 0x7c, 0x98, 0x53, 0xad, 0xef, 0x99, 0x18, 0xe5, 0x21, 0x89, 0xf5, 0x66, 0x83,
-		0x71, 0x88, 0x77, 0xb0, 0x5f, 0x49, 0x6f, 0x36, 0xc2, 0x5c, 0x12, 0x84, 0x8c, 0xb3, 0x18, 0xb2,
-		0xb1, 0x75, 0x45, 0xab, 0xe0, 0xcc, 0x20, 0x65, 0x70, 0x7f, 0x0b, 0x83, 0x35, 0x56, 0x1f, 0xe6,
-		0x21, 0xe8, 0xd0, 0x1c, 0x24, 0xf1, 0xae, 0x83, 0x75, 0x5b, 0x33, 0xf4, 0xcc, 0x10, 0x65, 0x72,
-		0x6f, 0x9b, 0x5e, 0xc4, 0xf5, 0x6a, 0x98, 0x85, 0x47, 0x87, 0xce, 0xc1, 0x90, 0x61, 0x3a, 0x9a,
-		0xa1, 0xdb, 0x99, 0xc4, 0x8c, 0x74, 0x72, 0xf8, 0xcc, 0xf1, 0xb6, 0x8e, 0xb0, 0xc2, 0x70, 0x14,
-		0x81, 0x8c, 0x16, 0x20, 0x6d, 0x1b, 0x4d, 0xab, 0x82, 0xcb, 0x15, 0xa3, 0x8a, 0xcb, 0x9a, 0xbe,
-		0x65, 0x64, 0x92, 0x94, 0xc1, 0x74, 0xab, 0x22, 0x14, 0x71, 0xce, 0xa8, 0xe2, 0x05, 0x7d, 0xcb,
-		0x50, 0x46, 0xed, 0x40, 0x19, 0x1d, 0x86, 0x41, 0x7b, 0x4f, 0x77, 0xd4, 0xdd, 0x4c, 0x8a, 0x7a,
-		0x08, 0x2f, 0xc9, 0xbf, 0x31, 0x08, 0x63, 0xfd, 0xb8, 0xd8, 0x79, 0x88, 0x6f, 0x11, 0x2d, 0x33,
-		0x91, 0xfd, 0xd8, 0x80, 0xd1, 0x04, 0x8d, 0x38, 0x78, 0x40, 0x23, 0xe6, 0x61, 0x58, 0xc7, 0xb6,
-		0x83, 0xab, 0xcc, 0x23, 0xa2, 0x7d, 0xfa, 0x14, 0x30, 0xa2, 0x56, 0x97, 0x8a, 0x1d, 0xc8, 0xa5,
-		0x9e, 0x87, 0x31, 0x57, 0xa4, 0xb2, 0xa5, 0xea, 0x35, 0xe1, 0x9b, 0xa7, 0x7b, 0x49, 0x32, 0x5b,
-		0x12, 0x74, 0x0a, 0x21, 0x53, 0x46, 0x71, 0xa0, 0x8c, 0x8a, 0x00, 0x86, 0x8e, 0x8d, 0xad, 0x72,
-		0x15, 0x57, 0xea, 0x99, 0x44, 0x07, 0x2b, 0xad, 0x10, 0x94, 0x16, 0x2b, 0x19, 0x0c, 0x5a, 0xa9,
-		0xa3, 0xa7, 0x3c, 0x57, 0x1b, 0xea, 0xe0, 0x29, 0x4b, 0x6c, 0x90, 0xb5, 0x78, 0xdb, 0x06, 0x8c,
-		0x5a, 0x98, 0xf8, 0x3d, 0xae, 0x72, 0xcd, 0x92, 0x54, 0x88, 0xd9, 0x9e, 0x9a, 0x29, 0x9c, 0x8c,
-		0x29, 0x36, 0x62, 0xf9, 0x8b, 0xe8, 0x6e, 0x70, 0x01, 0x65, 0xea, 0x56, 0x40, 0xa3, 0x50, 0x4a,
-		0x00, 0x97, 0xd5, 0x06, 0xce, 0x5e, 0x83, 0xd1, 0xa0, 0x79, 0xd0, 0x24, 0xc4, 0x6d, 0x47, 0xb5,
-		0x1c, 0xea, 0x85, 0x71, 0x85, 0x15, 0x50, 0x1a, 0xa2, 0x58, 0xaf, 0xd2, 0x28, 0x17, 0x57, 0xc8,
-		0x4f, 0xf4, 0x16, 0x4f, 0xe1, 0x28, 0x55, 0xf8, 0xbe, 0xd6, 0x1e, 0x0d, 0x70, 0x0e, 0xeb, 0x9d,
-		0x7d, 0x02, 0x46, 0x02, 0x0a, 0xf4, 0xdb, 0xb4, 0xfc, 0x4e, 0x38, 0xd4, 0x96, 0x35, 0x7a, 0x1e,
-		0x26, 0x9b, 0xba, 0xa6, 0x3b, 0xd8, 0x32, 0x2d, 0x4c, 0x3c, 0x96, 0x35, 0x95, 0xf9, 0x4f, 0x43,
-		0x1d, 0x7c, 0x6e, 0xc3, 0x8f, 0xcd, 0xb8, 0x28, 0x13, 0xcd, 0x56, 0xe0, 0xa9, 0x64, 0xe2, 0xf5,
-		0xa1, 0xf4, 0xcb, 0x2f, 0xbf, 0xfc, 0x72, 0x44, 0xfe, 0xea, 0x20, 0x4c, 0xb6, 0x1b, 0x33, 0x6d,
-		0x87, 0xef, 0x61, 0x18, 0xd4, 0x9b, 0x8d, 0x4d, 0x6c, 0x51, 0x23, 0xc5, 0x15, 0x5e, 0x42, 0x79,
-		0x88, 0xd7, 0xd5, 0x4d, 0x5c, 0xcf, 0xc4, 0x66, 0xa4, 0x93, 0xa3, 0x67, 0x1e, 0xec, 0x6b, 0x54,
-		0xce, 0x2e, 0x12, 0x12, 0x85, 0x51, 0xa2, 0x37, 0x43, 0x8c, 0x87, 0x68, 0xc2, 0xe1, 0x54, 0x7f,
-		0x1c, 0xc8, 0x58, 0x52, 0x28, 0x1d, 0x3a, 0x06, 0x49, 0xf2, 0x97, 0xf9, 0xc6, 0x20, 0x95, 0x39,
-		0x41, 0x00, 0xc4, 0x2f, 0x50, 0x16, 0x12, 0x74, 0x98, 0x54, 0xb1, 0x98, 0xda, 0xdc, 0x32, 0x71,
-		0xac, 0x2a, 0xde, 0x52, 0x9b, 0x75, 0xa7, 0x7c, 0x45, 0xad, 0x37, 0x31, 0x75, 0xf8, 0xa4, 0x92,
-		0xe2, 0xc0, 0xcb, 0x04, 0x86, 0xa6, 0x61, 0x98, 0x8d, 0x2a, 0x4d, 0xaf, 0xe2, 0x5d, 0x1a, 0x3d,
-		0xe3, 0x0a, 0x1b, 0x68, 0x0b, 0x04, 0x42, 0x9a, 0x7f, 0xd1, 0x36, 0x74, 0xe1, 0x9a, 0xb4, 0x09,
-		0x02, 0xa0, 0xcd, 0x3f, 0x11, 0x0e, 0xdc, 0x27, 0xda, 0xab, 0xd7, 0x32, 0x96, 0xee, 0x87, 0x31,
-		0x8a, 0xf1, 0x18, 0xef, 0x7a, 0xb5, 0x9e, 0x19, 0x9f, 0x91, 0x4e, 0x26, 0x94, 0x51, 0x06, 0x5e,
-		0xe1, 0x50, 0xf9, 0xcb, 0x11, 0x88, 0xd1, 0xc0, 0x32, 0x06, 0xc3, 0xeb, 0x6f, 0x5d, 0x2d, 0x95,
-		0x8b, 0x2b, 0x1b, 0x85, 0xc5, 0x52, 0x5a, 0x42, 0xa3, 0x00, 0x14, 0x70, 0x61, 0x71, 0x25, 0xbf,
-		0x9e, 0x8e, 0xb8, 0xe5, 0x85, 0xe5, 0xf5, 0x73, 0x8f, 0xa7, 0xa3, 0x2e, 0xc1, 0x06, 0x03, 0xc4,
-		0xfc, 0x08, 0x8f, 0x9d, 0x49, 0xc7, 0x51, 0x1a, 0x52, 0x8c, 0xc1, 0xc2, 0xf3, 0xa5, 0xe2, 0xb9,
-		0xc7, 0xd3, 0x83, 0x41, 0xc8, 0x63, 0x67, 0xd2, 0x43, 0x68, 0x04, 0x92, 0x14, 0x52, 0x58, 0x59,
-		0x59, 0x4c, 0x27, 0x5c, 0x9e, 0x6b, 0xeb, 0xca, 0xc2, 0xf2, 0x7c, 0x3a, 0xe9, 0xf2, 0x9c, 0x57,
-		0x56, 0x36, 0x56, 0xd3, 0xe0, 0x72, 0x58, 0x2a, 0xad, 0xad, 0xe5, 0xe7, 0x4b, 0xe9, 0x61, 0x17,
-		0xa3, 0xf0, 0xd6, 0xf5, 0xd2, 0x5a, 0x3a, 0x15, 0x10, 0xeb, 0xb1, 0x33, 0xe9, 0x11, 0xb7, 0x89,
-		0xd2, 0xf2, 0xc6, 0x52, 0x7a, 0x14, 0x8d, 0xc3, 0x08, 0x6b, 0x42, 0x08, 0x31, 0x16, 0x02, 0x9d,
-		0x7b, 0x3c, 0x9d, 0xf6, 0x04, 0x61, 0x5c, 0xc6, 0x03, 0x80, 0x73, 0x8f, 0xa7, 0x91, 0x3c, 0x07,
-		0x71, 0xea, 0x86, 0x08, 0xc1, 0xe8, 0x62, 0xbe, 0x50, 0x5a, 0x2c, 0xaf, 0xac, 0xae, 0x2f, 0xac,
-		0x2c, 0xe7, 0x17, 0xd3, 0x92, 0x07, 0x53, 0x4a, 0xcf, 0x6e, 0x2c, 0x28, 0xa5, 0x62, 0x3a, 0xe2,
-		0x87, 0xad, 0x96, 0xf2, 0xeb, 0xa5, 0x62, 0x3a, 0x2a, 0x57, 0x60, 0xb2, 0x5d, 0x40, 0x6d, 0x3b,
-		0x84, 0x7c, 0xbe, 0x10, 0xe9, 0xe0, 0x0b, 0x94, 0x57, 0xd8, 0x17, 0xe4, 0xef, 0x44, 0x60, 0xa2,
-		0xcd, 0xa4, 0xd2, 0xb6, 0x91, 0xa7, 0x21, 0xce, 0x7c, 0x99, 0x4d, 0xb3, 0x0f, 0xb4, 0x9d, 0x9d,
-		0xa8, 0x67, 0xb7, 0x4c, 0xb5, 0x94, 0xce, 0x9f, 0x6a, 0x44, 0x3b, 0xa4, 0x1a, 0x84, 0x45, 0x8b,
-		0xc3, 0xfe, 0x6c, 0x4b, 0xf0, 0x67, 0xf3, 0xe3, 0xb9, 0x7e, 0xe6, 0x47, 0x0a, 0xdb, 0xdf, 0x24,
-		0x10, 0x6f, 0x33, 0x09, 0x9c, 0x87, 0xf1, 0x16, 0x46, 0x7d, 0x07, 0xe3, 0xf7, 0x4a, 0x90, 0xe9,
-		0x64, 0x9c, 0x1e, 0x21, 0x31, 0x12, 0x08, 0x89, 0xe7, 0xc3, 0x16, 0xbc, 0xab, 0x73, 0x27, 0xb4,
-		0xf4, 0xf5, 0x67, 0x24, 0x38, 0xdc, 0x3e, 0xa5, 0x6c, 0x2b, 0xc3, 0x9b, 0x61, 0xb0, 0x81, 0x9d,
-		0x6d, 0x43, 0xa4, 0x55, 0xf7, 0xb5, 0x99, 0xac, 0x49, 0x75, 0xb8, 0xb3, 0x39, 0x95, 0x7f, 0xb6,
-		0x8f, 0x76, 0xca, 0x0b, 0x99, 0x34, 0x2d, 0x92, 0x7e, 0x30, 0x02, 0x87, 0xda, 0x32, 0x6f, 0x2b,
-		0xe8, 0x09, 0x00, 0x4d, 0x37, 0x9b, 0x0e, 0x4b, 0x9d, 0x58, 0x24, 0x4e, 0x52, 0x08, 0x0d, 0x5e,
-		0x24, 0xca, 0x36, 0x1d, 0xb7, 0x3e, 0x4a, 0xeb, 0x81, 0x81, 0x28, 0xc2, 0x93, 0x9e, 0xa0, 0x31,
-		0x2a, 0xe8, 0x54, 0x07, 0x4d, 0x5b, 0x1c, 0xf3, 0x11, 0x48, 0x57, 0xea, 0x1a, 0xd6, 0x9d, 0xb2,
-		0xed, 0x58, 0x58, 0x6d, 0x68, 0x7a, 0x8d, 0x4e, 0x35, 0x89, 0x5c, 0x7c, 0x4b, 0xad, 0xdb, 0x58,
-		0x19, 0x63, 0xd5, 0x6b, 0xa2, 0x96, 0x50, 0x50, 0x07, 0xb2, 0x7c, 0x14, 0x83, 0x01, 0x0a, 0x56,
-		0xed, 0x52, 0xc8, 0x1f, 0x4e, 0xc2, 0xb0, 0x2f, 0x01, 0x47, 0x77, 0x41, 0xea, 0x45, 0xf5, 0x8a,
-		0x5a, 0x16, 0x8b, 0x2a, 0x66, 0x89, 0x61, 0x02, 0x5b, 0xe5, 0x0b, 0xab, 0x47, 0x60, 0x92, 0xa2,
-		0x18, 0x4d, 0x07, 0x5b, 0xe5, 0x4a, 0x5d, 0xb5, 0x6d, 0x6a, 0xb4, 0x04, 0x45, 0x45, 0xa4, 0x6e,
-		0x85, 0x54, 0xcd, 0x89, 0x1a, 0x74, 0x16, 0x26, 0x28, 0x45, 0xa3, 0x59, 0x77, 0x34, 0xb3, 0x8e,
-		0xcb, 0x64, 0x99, 0x67, 0xd3, 0x29, 0xc7, 0x95, 0x6c, 0x9c, 0x60, 0x2c, 0x71, 0x04, 0x22, 0x91,
-		0x8d, 0x8a, 0x70, 0x82, 0x92, 0xd5, 0xb0, 0x8e, 0x2d, 0xd5, 0xc1, 0x65, 0xfc, 0x52, 0x53, 0xad,
-		0xdb, 0x65, 0x55, 0xaf, 0x96, 0xb7, 0x55, 0x7b, 0x3b, 0x33, 0x49, 0x18, 0x14, 0x22, 0x19, 0x49,
-		0x39, 0x4a, 0x10, 0xe7, 0x39, 0x5e, 0x89, 0xa2, 0xe5, 0xf5, 0xea, 0x45, 0xd5, 0xde, 0x46, 0x39,
-		0x38, 0x4c, 0xb9, 0xd8, 0x8e, 0xa5, 0xe9, 0xb5, 0x72, 0x65, 0x1b, 0x57, 0x76, 0xca, 0x4d, 0x67,
-		0xeb, 0xc9, 0xcc, 0x31, 0x7f, 0xfb, 0x54, 0xc2, 0x35, 0x8a, 0x33, 0x47, 0x50, 0x36, 0x9c, 0xad,
-		0x27, 0xd1, 0x1a, 0xa4, 0x48, 0x67, 0x34, 0xb4, 0x6b, 0xb8, 0xbc, 0x65, 0x58, 0x74, 0x0e, 0x1d,
-		0x6d, 0x13, 0x9a, 0x7c, 0x16, 0x9c, 0x5d, 0xe1, 0x04, 0x4b, 0x46, 0x15, 0xe7, 0xe2, 0x6b, 0xab,
-		0xa5, 0x52, 0x51, 0x19, 0x16, 0x5c, 0x2e, 0x18, 0x16, 0x71, 0xa8, 0x9a, 0xe1, 0x1a, 0x78, 0x98,
-		0x39, 0x54, 0xcd, 0x10, 0xe6, 0x3d, 0x0b, 0x13, 0x95, 0x0a, 0xd3, 0x59, 0xab, 0x94, 0xf9, 0x62,
-		0xcc, 0xce, 0xa4, 0x03, 0xc6, 0xaa, 0x54, 0xe6, 0x19, 0x02, 0xf7, 0x71, 0x1b, 0x3d, 0x05, 0x87,
-		0x3c, 0x63, 0xf9, 0x09, 0xc7, 0x5b, 0xb4, 0x0c, 0x93, 0x9e, 0x85, 0x09, 0x73, 0xaf, 0x95, 0x10,
-		0x05, 0x5a, 0x34, 0xf7, 0xc2, 0x64, 0x4f, 0xc0, 0xa4, 0xb9, 0x6d, 0xb6, 0xd2, 0x9d, 0xf2, 0xd3,
-		0x21, 0x73, 0xdb, 0x0c, 0x13, 0xde, 0x4b, 0x57, 0xe6, 0x16, 0xae, 0xa8, 0x0e, 0xae, 0x66, 0x8e,
-		0xf8, 0xd1, 0x7d, 0x15, 0x68, 0x16, 0xd2, 0x95, 0x4a, 0x19, 0xeb, 0xea, 0x66, 0x1d, 0x97, 0x55,
-		0x0b, 0xeb, 0xaa, 0x9d, 0x99, 0xa6, 0xc8, 0x31, 0xc7, 0x6a, 0x62, 0x65, 0xb4, 0x52, 0x29, 0xd1,
-		0xca, 0x3c, 0xad, 0x43, 0xa7, 0x60, 0xdc, 0xd8, 0x7c, 0xb1, 0xc2, 0x3c, 0xb2, 0x6c, 0x5a, 0x78,
-		0x4b, 0xdb, 0xcd, 0xdc, 0x43, 0xcd, 0x3b, 0x46, 0x2a, 0xa8, 0x3f, 0xae, 0x52, 0x30, 0x7a, 0x00,
-		0xd2, 0x15, 0x7b, 0x5b, 0xb5, 0x4c, 0x1a, 0x92, 0x6d, 0x53, 0xad, 0xe0, 0xcc, 0xbd, 0x0c, 0x95,
-		0xc1, 0x97, 0x05, 0x98, 0x8c, 0x08, 0xfb, 0xaa, 0xb6, 0xe5, 0x08, 0x8e, 0xf7, 0xb3, 0x11, 0x41,
-		0x61, 0x9c, 0xdb, 0x49, 0x48, 0x13, 0x4b, 0x04, 0x1a, 0x3e, 0x49, 0xd1, 0x46, 0xcd, 0x6d, 0xd3,
-		0xdf, 0xee, 0xdd, 0x30, 0x42, 0x30, 0xbd, 0x46, 0x1f, 0x60, 0x89, 0x9b, 0xb9, 0xed, 0x6b, 0xf1,
-		0x71, 0x38, 0x4c, 0x90, 0x1a, 0xd8, 0x51, 0xab, 0xaa, 0xa3, 0xfa, 0xb0, 0x1f, 0xa2, 0xd8, 0xc4,
-		0xec, 0x4b, 0xbc, 0x32, 0x20, 0xa7, 0xd5, 0xdc, 0xdc, 0x73, 0x1d, 0xeb, 0x61, 0x26, 0x27, 0x81,
-		0x09, 0xd7, 0xba, 0x63, 0xc9, 0xb9, 0x9c, 0x83, 0x94, 0xdf, 0xef, 0x51, 0x12, 0x98, 0xe7, 0xa7,
-		0x25, 0x92, 0x04, 0xcd, 0xad, 0x14, 0x49, 0xfa, 0xf2, 0x42, 0x29, 0x1d, 0x21, 0x69, 0xd4, 0xe2,
-		0xc2, 0x7a, 0xa9, 0xac, 0x6c, 0x2c, 0xaf, 0x2f, 0x2c, 0x95, 0xd2, 0x51, 0x5f, 0x62, 0xff, 0x4c,
-		0x2c, 0x71, 0x5f, 0xfa, 0x7e, 0xf9, 0x5b, 0x11, 0x18, 0x0d, 0xae, 0xd4, 0xd0, 0xcf, 0xc0, 0x11,
-		0xb1, 0xad, 0x62, 0x63, 0xa7, 0x7c, 0x55, 0xb3, 0xe8, 0x80, 0x6c, 0xa8, 0x6c, 0x72, 0x74, 0xfd,
-		0x67, 0x92, 0x63, 0xad, 0x61, 0xe7, 0x39, 0xcd, 0x22, 0xc3, 0xad, 0xa1, 0x3a, 0x68, 0x11, 0xa6,
-		0x75, 0xa3, 0x6c, 0x3b, 0xaa, 0x5e, 0x55, 0xad, 0x6a, 0xd9, 0xdb, 0xd0, 0x2a, 0xab, 0x95, 0x0a,
-		0xb6, 0x6d, 0x83, 0x4d, 0x84, 0x2e, 0x97, 0xe3, 0xba, 0xb1, 0xc6, 0x91, 0xbd, 0x19, 0x22, 0xcf,
-		0x51, 0x43, 0xee, 0x1b, 0xed, 0xe4, 0xbe, 0xc7, 0x20, 0xd9, 0x50, 0xcd, 0x32, 0xd6, 0x1d, 0x6b,
-		0x8f, 0xe6, 0xe7, 0x09, 0x25, 0xd1, 0x50, 0xcd, 0x12, 0x29, 0xff, 0x58, 0x96, 0x49, 0xcf, 0xc4,
-		0x12, 0x89, 0x74, 0xf2, 0x99, 0x58, 0x22, 0x99, 0x06, 0xf9, 0xb5, 0x28, 0xa4, 0xfc, 0xf9, 0x3a,
-		0x59, 0xfe, 0x54, 0xe8, 0x8c, 0x25, 0xd1, 0x98, 0x76, 0x77, 0xd7, 0xec, 0x7e, 0x76, 0x8e, 0x4c,
-		0x65, 0xb9, 0x41, 0x96, 0x1c, 0x2b, 0x8c, 0x92, 0xa4, 0x11, 0xc4, 0xd9, 0x30, 0x4b, 0x46, 0x12,
-		0x0a, 0x2f, 0xa1, 0x79, 0x18, 0x7c, 0xd1, 0xa6, 0xbc, 0x07, 0x29, 0xef, 0x7b, 0xba, 0xf3, 0x7e,
-		0x66, 0x8d, 0x32, 0x4f, 0x3e, 0xb3, 0x56, 0x5e, 0x5e, 0x51, 0x96, 0xf2, 0x8b, 0x0a, 0x27, 0x47,
-		0x47, 0x21, 0x56, 0x57, 0xaf, 0xed, 0x05, 0x27, 0x3d, 0x0a, 0xea, 0xb7, 0x13, 0x8e, 0x42, 0xec,
-		0x2a, 0x56, 0x77, 0x82, 0x53, 0x0d, 0x05, 0xdd, 0xc1, 0xc1, 0x70, 0x1a, 0xe2, 0xd4, 0x5e, 0x08,
-		0x80, 0x5b, 0x2c, 0x3d, 0x80, 0x12, 0x10, 0x9b, 0x5b, 0x51, 0xc8, 0x80, 0x48, 0x43, 0x8a, 0x41,
-		0xcb, 0xab, 0x0b, 0xa5, 0xb9, 0x52, 0x3a, 0x22, 0x9f, 0x85, 0x41, 0x66, 0x04, 0x32, 0x58, 0x5c,
-		0x33, 0xa4, 0x07, 0x78, 0x91, 0xf3, 0x90, 0x44, 0xed, 0xc6, 0x52, 0xa1, 0xa4, 0xa4, 0x23, 0xc1,
-		0xae, 0x8e, 0xa5, 0xe3, 0xb2, 0x0d, 0x29, 0x7f, 0x1e, 0xfe, 0xe3, 0x59, 0x8c, 0x7f, 0x45, 0x82,
-		0x61, 0x5f, 0x5e, 0x4d, 0x12, 0x22, 0xb5, 0x5e, 0x37, 0xae, 0x96, 0xd5, 0xba, 0xa6, 0xda, 0xdc,
-		0x35, 0x80, 0x82, 0xf2, 0x04, 0xd2, 0x6f, 0xd7, 0xfd, 0x98, 0x86, 0x48, 0x3c, 0x3d, 0x28, 0x7f,
-		0x52, 0x82, 0x74, 0x38, 0xb1, 0x0d, 0x89, 0x29, 0xfd, 0x24, 0xc5, 0x94, 0x3f, 0x21, 0xc1, 0x68,
-		0x30, 0x9b, 0x0d, 0x89, 0x77, 0xd7, 0x4f, 0x54, 0xbc, 0x3f, 0x8c, 0xc0, 0x48, 0x20, 0x87, 0xed,
-		0x57, 0xba, 0x97, 0x60, 0x5c, 0xab, 0xe2, 0x86, 0x69, 0x38, 0x58, 0xaf, 0xec, 0x95, 0xeb, 0xf8,
-		0x0a, 0xae, 0x67, 0x64, 0x1a, 0x34, 0x4e, 0x77, 0xcf, 0x92, 0x67, 0x17, 0x3c, 0xba, 0x45, 0x42,
-		0x96, 0x9b, 0x58, 0x28, 0x96, 0x96, 0x56, 0x57, 0xd6, 0x4b, 0xcb, 0x73, 0x6f, 0x2d, 0x6f, 0x2c,
-		0x5f, 0x5a, 0x5e, 0x79, 0x6e, 0x59, 0x49, 0x6b, 0x21, 0xb4, 0x3b, 0x38, 0xec, 0x57, 0x21, 0x1d,
-		0x16, 0x0a, 0x1d, 0x81, 0x76, 0x62, 0xa5, 0x07, 0xd0, 0x04, 0x8c, 0x2d, 0xaf, 0x94, 0xd7, 0x16,
-		0x8a, 0xa5, 0x72, 0xe9, 0xc2, 0x85, 0xd2, 0xdc, 0xfa, 0x1a, 0xdb, 0xf7, 0x70, 0xb1, 0xd7, 0x03,
-		0x03, 0x5c, 0x7e, 0x25, 0x0a, 0x13, 0x6d, 0x24, 0x41, 0x79, 0xbe, 0x62, 0x61, 0x8b, 0xa8, 0x87,
-		0xfb, 0x91, 0x7e, 0x96, 0xe4, 0x0c, 0xab, 0xaa, 0xe5, 0xf0, 0x05, 0xce, 0x03, 0x40, 0xac, 0xa4,
-		0x3b, 0xda, 0x96, 0x86, 0x2d, 0xbe, 0x9f, 0xc4, 0x96, 0x31, 0x63, 0x1e, 0x9c, 0x6d, 0x29, 0x3d,
-		0x04, 0xc8, 0x34, 0x6c, 0xcd, 0xd1, 0xae, 0xe0, 0xb2, 0xa6, 0x8b, 0xcd, 0x27, 0xb2, 0xac, 0x89,
-		0x29, 0x69, 0x51, 0xb3, 0xa0, 0x3b, 0x2e, 0xb6, 0x8e, 0x6b, 0x6a, 0x08, 0x9b, 0x04, 0xf3, 0xa8,
-		0x92, 0x16, 0x35, 0x2e, 0xf6, 0x5d, 0x90, 0xaa, 0x1a, 0x4d, 0x92, 0xeb, 0x31, 0x3c, 0x32, 0x77,
-		0x48, 0xca, 0x30, 0x83, 0xb9, 0x28, 0x3c, 0x8b, 0xf7, 0x76, 0xbd, 0x52, 0xca, 0x30, 0x83, 0x31,
-		0x94, 0xfb, 0x61, 0x4c, 0xad, 0xd5, 0x2c, 0xc2, 0x5c, 0x30, 0x62, 0xeb, 0x92, 0x51, 0x17, 0x4c,
-		0x11, 0xb3, 0xcf, 0x40, 0x42, 0xd8, 0x81, 0x4c, 0xd5, 0xc4, 0x12, 0x65, 0x93, 0x2d, 0xb6, 0x23,
-		0x27, 0x93, 0x4a, 0x42, 0x17, 0x95, 0x77, 0x41, 0x4a, 0xb3, 0xcb, 0xde, 0x26, 0x7e, 0x64, 0x26,
-		0x72, 0x32, 0xa1, 0x0c, 0x6b, 0xb6, 0xbb, 0x01, 0x2a, 0x7f, 0x26, 0x02, 0xa3, 0xc1, 0x43, 0x08,
-		0x54, 0x84, 0x44, 0xdd, 0xa8, 0xa8, 0xd4, 0xb5, 0xd8, 0x09, 0xd8, 0xc9, 0x1e, 0xe7, 0x16, 0xb3,
-		0x8b, 0x1c, 0x5f, 0x71, 0x29, 0xb3, 0xbf, 0x23, 0x41, 0x42, 0x80, 0xd1, 0x61, 0x88, 0x99, 0xaa,
-		0xb3, 0x4d, 0xd9, 0xc5, 0x0b, 0x91, 0xb4, 0xa4, 0xd0, 0x32, 0x81, 0xdb, 0xa6, 0xaa, 0x53, 0x17,
-		0xe0, 0x70, 0x52, 0x26, 0xfd, 0x5a, 0xc7, 0x6a, 0x95, 0x2e, 0x7a, 0x8c, 0x46, 0x03, 0xeb, 0x8e,
-		0x2d, 0xfa, 0x95, 0xc3, 0xe7, 0x38, 0x18, 0x3d, 0x08, 0xe3, 0x8e, 0xa5, 0x6a, 0xf5, 0x00, 0x6e,
-		0x8c, 0xe2, 0xa6, 0x45, 0x85, 0x8b, 0x9c, 0x83, 0xa3, 0x82, 0x6f, 0x15, 0x3b, 0x6a, 0x65, 0x1b,
-		0x57, 0x3d, 0xa2, 0x41, 0xba, 0xb9, 0x71, 0x84, 0x23, 0x14, 0x79, 0xbd, 0xa0, 0x95, 0xbf, 0x25,
-		0xc1, 0xb8, 0x58, 0xa6, 0x55, 0x5d, 0x63, 0x2d, 0x01, 0xa8, 0xba, 0x6e, 0x38, 0x7e, 0x73, 0xb5,
-		0xba, 0x72, 0x0b, 0xdd, 0x6c, 0xde, 0x25, 0x52, 0x7c, 0x0c, 0xb2, 0x0d, 0x00, 0xaf, 0xa6, 0xa3,
-		0xd9, 0xa6, 0x61, 0x98, 0x9f, 0x30, 0xd1, 0x63, 0x4a, 0xb6, 0xb0, 0x07, 0x06, 0x22, 0xeb, 0x39,
-		0x34, 0x09, 0xf1, 0x4d, 0x5c, 0xd3, 0x74, 0xbe, 0x6f, 0xcc, 0x0a, 0x62, 0xfb, 0x25, 0xe6, 0x6e,
-		0xbf, 0x14, 0xfe, 0x32, 0x4c, 0x54, 0x8c, 0x46, 0x58, 0xdc, 0x42, 0x3a, 0xb4, 0xb9, 0x60, 0x5f,
-		0x94, 0x5e, 0x78, 0x98, 0x23, 0xd5, 0x8c, 0xba, 0xaa, 0xd7, 0x66, 0x0d, 0xab, 0xe6, 0x1d, 0xb3,
-		0x92, 0x8c, 0xc7, 0xf6, 0x1d, 0xb6, 0x9a, 0x9b, 0x7f, 0x26, 0x49, 0xbf, 0x14, 0x89, 0xce, 0xaf,
-		0x16, 0x3e, 0x1b, 0xc9, 0xce, 0x33, 0xc2, 0x55, 0x61, 0x0c, 0x05, 0x6f, 0xd5, 0x71, 0x85, 0x28,
-		0x08, 0xdf, 0x7b, 0x10, 0x26, 0x6b, 0x46, 0xcd, 0xa0, 0x9c, 0x4e, 0x93, 0x5f, 0xfc, 0x9c, 0x36,
-		0xe9, 0x42, 0xb3, 0x3d, 0x0f, 0x75, 0x73, 0xcb, 0x30, 0xc1, 0x91, 0xcb, 0xf4, 0xa0, 0x88, 0x2d,
-		0x63, 0x50, 0xd7, 0x3d, 0xb4, 0xcc, 0x17, 0xbe, 0x4b, 0xa7, 0x6f, 0x65, 0x9c, 0x93, 0x92, 0x3a,
-		0xb6, 0xd2, 0xc9, 0x29, 0x70, 0x28, 0xc0, 0x8f, 0x0d, 0x52, 0x6c, 0xf5, 0xe0, 0xf8, 0x9b, 0x9c,
-		0xe3, 0x84, 0x8f, 0xe3, 0x1a, 0x27, 0xcd, 0xcd, 0xc1, 0xc8, 0x7e, 0x78, 0xfd, 0x0b, 0xce, 0x2b,
-		0x85, 0xfd, 0x4c, 0xe6, 0x61, 0x8c, 0x32, 0xa9, 0x34, 0x6d, 0xc7, 0x68, 0xd0, 0x08, 0xd8, 0x9d,
-		0xcd, 0x6f, 0x7d, 0x97, 0x8d, 0x9a, 0x51, 0x42, 0x36, 0xe7, 0x52, 0xe5, 0x72, 0x40, 0xcf, 0xc6,
-		0xaa, 0xb8, 0x52, 0xef, 0xc1, 0xe1, 0x6b, 0x5c, 0x10, 0x17, 0x3f, 0x77, 0x19, 0x26, 0xc9, 0x6f,
-		0x1a, 0xa0, 0xfc, 0x92, 0xf4, 0xde, 0x70, 0xcb, 0x7c, 0xeb, 0xbd, 0x6c, 0x60, 0x4e, 0xb8, 0x0c,
-		0x7c, 0x32, 0xf9, 0x7a, 0xb1, 0x86, 0x1d, 0x07, 0x5b, 0x76, 0x59, 0xad, 0xb7, 0x13, 0xcf, 0xb7,
-		0x63, 0x91, 0xf9, 0xd8, 0xf7, 0x83, 0xbd, 0x38, 0xcf, 0x28, 0xf3, 0xf5, 0x7a, 0x6e, 0x03, 0x8e,
-		0xb4, 0xf1, 0x8a, 0x3e, 0x78, 0xbe, 0xc2, 0x79, 0x4e, 0xb6, 0x78, 0x06, 0x61, 0xbb, 0x0a, 0x02,
-		0xee, 0xf6, 0x65, 0x1f, 0x3c, 0x3f, 0xce, 0x79, 0x22, 0x4e, 0x2b, 0xba, 0x94, 0x70, 0x7c, 0x06,
-		0xc6, 0xaf, 0x60, 0x6b, 0xd3, 0xb0, 0xf9, 0x2e, 0x51, 0x1f, 0xec, 0x3e, 0xc1, 0xd9, 0x8d, 0x71,
-		0x42, 0xba, 0x6d, 0x44, 0x78, 0x3d, 0x05, 0x89, 0x2d, 0xb5, 0x82, 0xfb, 0x60, 0x71, 0x83, 0xb3,
-		0x18, 0x22, 0xf8, 0x84, 0x34, 0x0f, 0xa9, 0x9a, 0xc1, 0xe7, 0xa8, 0xde, 0xe4, 0x9f, 0xe4, 0xe4,
-		0xc3, 0x82, 0x86, 0xb3, 0x30, 0x0d, 0xb3, 0x59, 0x27, 0x13, 0x58, 0x6f, 0x16, 0x7f, 0x4b, 0xb0,
-		0x10, 0x34, 0x9c, 0xc5, 0x3e, 0xcc, 0xfa, 0xaa, 0x60, 0x61, 0xfb, 0xec, 0xf9, 0x34, 0x0c, 0x1b,
-		0x7a, 0x7d, 0xcf, 0xd0, 0xfb, 0x11, 0xe2, 0x53, 0x9c, 0x03, 0x70, 0x12, 0xc2, 0xe0, 0x3c, 0x24,
-		0xfb, 0xed, 0x88, 0xbf, 0xfd, 0x7d, 0x31, 0x3c, 0x44, 0x0f, 0xcc, 0xc3, 0x98, 0x08, 0x50, 0x9a,
-		0xa1, 0xf7, 0xc1, 0xe2, 0xef, 0x70, 0x16, 0xa3, 0x3e, 0x32, 0xae, 0x86, 0x83, 0x6d, 0xa7, 0x86,
-		0xfb, 0x61, 0xf2, 0x19, 0xa1, 0x06, 0x27, 0xe1, 0xa6, 0xdc, 0xc4, 0x7a, 0x65, 0xbb, 0x3f, 0x0e,
-		0xbf, 0x22, 0x4c, 0x29, 0x68, 0x08, 0x8b, 0x39, 0x18, 0x69, 0xa8, 0x96, 0xbd, 0xad, 0xd6, 0xfb,
-		0xea, 0x8e, 0xbf, 0xcb, 0x79, 0xa4, 0x5c, 0x22, 0x6e, 0x91, 0xa6, 0xbe, 0x1f, 0x36, 0x9f, 0x15,
-		0x16, 0xf1, 0x91, 0xf1, 0xa1, 0x67, 0x3b, 0x74, 0x4b, 0x6d, 0x3f, 0xdc, 0x7e, 0x55, 0x0c, 0x3d,
-		0x46, 0xbb, 0xe4, 0xe7, 0x78, 0x1e, 0x92, 0xb6, 0x76, 0xad, 0x2f, 0x36, 0x9f, 0x13, 0x3d, 0x4d,
-		0x09, 0x08, 0xf1, 0x5b, 0xe1, 0x68, 0xdb, 0x69, 0xa2, 0x0f, 0x66, 0x7f, 0x8f, 0x33, 0x3b, 0xdc,
-		0x66, 0xaa, 0xe0, 0x21, 0x61, 0xbf, 0x2c, 0xff, 0xbe, 0x08, 0x09, 0x38, 0xc4, 0x6b, 0x95, 0xac,
-		0x1a, 0x6c, 0x75, 0x6b, 0x7f, 0x56, 0xfb, 0x35, 0x61, 0x35, 0x46, 0x1b, 0xb0, 0xda, 0x3a, 0x1c,
-		0xe6, 0x1c, 0xf7, 0xd7, 0xaf, 0x9f, 0x17, 0x81, 0x95, 0x51, 0x6f, 0x04, 0x7b, 0xf7, 0x6d, 0x90,
-		0x75, 0xcd, 0x29, 0xd2, 0x53, 0xbb, 0xdc, 0x50, 0xcd, 0x3e, 0x38, 0x7f, 0x81, 0x73, 0x16, 0x11,
-		0xdf, 0xcd, 0x6f, 0xed, 0x25, 0xd5, 0x24, 0xcc, 0x9f, 0x87, 0x8c, 0x60, 0xde, 0xd4, 0x2d, 0x5c,
-		0x31, 0x6a, 0xba, 0x76, 0x0d, 0x57, 0xfb, 0x60, 0xfd, 0xeb, 0xa1, 0xae, 0xda, 0xf0, 0x91, 0x13,
-		0xce, 0x0b, 0x90, 0x76, 0x73, 0x95, 0xb2, 0xd6, 0x30, 0x0d, 0xcb, 0xe9, 0xc1, 0xf1, 0x8b, 0xa2,
-		0xa7, 0x5c, 0xba, 0x05, 0x4a, 0x96, 0x2b, 0x01, 0x3b, 0x67, 0xee, 0xd7, 0x25, 0xbf, 0xc4, 0x19,
-		0x8d, 0x78, 0x54, 0x3c, 0x70, 0x54, 0x8c, 0x86, 0xa9, 0x5a, 0xfd, 0xc4, 0xbf, 0x7f, 0x20, 0x02,
-		0x07, 0x27, 0xe1, 0x81, 0x83, 0x64, 0x74, 0x64, 0xb6, 0xef, 0x83, 0xc3, 0x97, 0x45, 0xe0, 0x10,
-		0x34, 0x9c, 0x85, 0x48, 0x18, 0xfa, 0x60, 0xf1, 0x0f, 0x05, 0x0b, 0x41, 0x43, 0x58, 0x3c, 0xeb,
-		0x4d, 0xb4, 0x16, 0xae, 0x69, 0xb6, 0x63, 0xb1, 0xa4, 0xb8, 0x3b, 0xab, 0x7f, 0xf4, 0xfd, 0x60,
-		0x12, 0xa6, 0xf8, 0x48, 0x49, 0x24, 0xe2, 0x9b, 0xac, 0x74, 0xcd, 0xd4, 0x5b, 0xb0, 0xdf, 0x10,
-		0x91, 0xc8, 0x47, 0x46, 0x64, 0xf3, 0x65, 0x88, 0xc4, 0xec, 0x15, 0xb2, 0x52, 0xe8, 0x83, 0xdd,
-		0x3f, 0x0e, 0x09, 0xb7, 0x26, 0x68, 0x09, 0x4f, 0x5f, 0xfe, 0xd3, 0xd4, 0x77, 0xf0, 0x5e, 0x5f,
-		0xde, 0xf9, 0x4f, 0x42, 0xf9, 0xcf, 0x06, 0xa3, 0x64, 0x31, 0x64, 0x2c, 0x94, 0x4f, 0xa1, 0x5e,
-		0xb7, 0x8a, 0x32, 0xef, 0xfe, 0x21, 0xd7, 0x37, 0x98, 0x4e, 0xe5, 0x16, 0x89, 0x93, 0x07, 0x93,
-		0x9e, 0xde, 0xcc, 0xde, 0xfb, 0x43, 0xd7, 0xcf, 0x03, 0x39, 0x4f, 0xee, 0x02, 0x8c, 0x04, 0x12,
-		0x9e, 0xde, 0xac, 0xde, 0xc7, 0x59, 0xa5, 0xfc, 0xf9, 0x4e, 0xee, 0x2c, 0xc4, 0x48, 0xf2, 0xd2,
-		0x9b, 0xfc, 0xaf, 0x70, 0x72, 0x8a, 0x9e, 0x7b, 0x13, 0x24, 0x44, 0xd2, 0xd2, 0x9b, 0xf4, 0xfd,
-		0x9c, 0xd4, 0x25, 0x21, 0xe4, 0x22, 0x61, 0xe9, 0x4d, 0xfe, 0x57, 0x05, 0xb9, 0x20, 0x21, 0xe4,
-		0xfd, 0x9b, 0xf0, 0x2b, 0x3f, 0x17, 0xe3, 0x93, 0x8e, 0xb0, 0xdd, 0x79, 0x18, 0xe2, 0x99, 0x4a,
-		0x6f, 0xea, 0x0f, 0xf2, 0xc6, 0x05, 0x45, 0xee, 0x09, 0x88, 0xf7, 0x69, 0xf0, 0x9f, 0xe7, 0xa4,
-		0x0c, 0x3f, 0x37, 0x07, 0xc3, 0xbe, 0xec, 0xa4, 0x37, 0xf9, 0x5f, 0xe3, 0xe4, 0x7e, 0x2a, 0x22,
-		0x3a, 0xcf, 0x4e, 0x7a, 0x33, 0xf8, 0x05, 0x21, 0x3a, 0xa7, 0x20, 0x66, 0x13, 0x89, 0x49, 0x6f,
-		0xea, 0x0f, 0x09, 0xab, 0x0b, 0x92, 0xdc, 0xd3, 0x90, 0x74, 0x27, 0x9b, 0xde, 0xf4, 0x1f, 0xe6,
-		0xf4, 0x1e, 0x0d, 0xb1, 0x80, 0x6f, 0xb2, 0xeb, 0xcd, 0xe2, 0xaf, 0x0b, 0x0b, 0xf8, 0xa8, 0xc8,
-		0x30, 0x0a, 0x27, 0x30, 0xbd, 0x39, 0x7d, 0x44, 0x0c, 0xa3, 0x50, 0xfe, 0x42, 0x7a, 0x93, 0xc6,
-		0xfc, 0xde, 0x2c, 0xfe, 0x86, 0xe8, 0x4d, 0x8a, 0x4f, 0xc4, 0x08, 0x67, 0x04, 0xbd, 0x79, 0xfc,
-		0xa2, 0x10, 0x23, 0x94, 0x10, 0xe4, 0x56, 0x01, 0xb5, 0x66, 0x03, 0xbd, 0xf9, 0x7d, 0x94, 0xf3,
-		0x1b, 0x6f, 0x49, 0x06, 0x72, 0xcf, 0xc1, 0xe1, 0xf6, 0x99, 0x40, 0x6f, 0xae, 0x1f, 0xfb, 0x61,
-		0x68, 0xed, 0xe6, 0x4f, 0x04, 0x72, 0xeb, 0xde, 0x94, 0xe2, 0xcf, 0x02, 0x7a, 0xb3, 0x7d, 0xe5,
-		0x87, 0xc1, 0xc0, 0xed, 0x4f, 0x02, 0x72, 0x79, 0x00, 0x6f, 0x02, 0xee, 0xcd, 0xeb, 0x13, 0x9c,
-		0x97, 0x8f, 0x88, 0x0c, 0x0d, 0x3e, 0xff, 0xf6, 0xa6, 0xbf, 0x21, 0x86, 0x06, 0xa7, 0x20, 0x43,
-		0x43, 0x4c, 0xbd, 0xbd, 0xa9, 0x3f, 0x29, 0x86, 0x86, 0x20, 0x21, 0x9e, 0xed, 0x9b, 0xdd, 0x7a,
-		0x73, 0xf8, 0x94, 0xf0, 0x6c, 0x1f, 0x55, 0x6e, 0x19, 0xc6, 0x5b, 0x26, 0xc4, 0xde, 0xac, 0x7e,
-		0x89, 0xb3, 0x4a, 0x87, 0xe7, 0x43, 0xff, 0xe4, 0xc5, 0x27, 0xc3, 0xde, 0xdc, 0x3e, 0x1d, 0x9a,
-		0xbc, 0xf8, 0x5c, 0x98, 0x3b, 0x0f, 0x09, 0xbd, 0x59, 0xaf, 0x93, 0xc1, 0x83, 0xba, 0xdf, 0x04,
-		0xcc, 0xfc, 0xe7, 0x1f, 0x71, 0xeb, 0x08, 0x82, 0xdc, 0x59, 0x88, 0xe3, 0xc6, 0x26, 0xae, 0xf6,
-		0xa2, 0xfc, 0xde, 0x8f, 0x44, 0xc0, 0x24, 0xd8, 0xb9, 0xa7, 0x01, 0xd8, 0xd6, 0x08, 0x3d, 0x0c,
-		0xec, 0x41, 0xfb, 0x5f, 0x7e, 0xc4, 0xaf, 0xde, 0x78, 0x24, 0x1e, 0x03, 0x76, 0x91, 0xa7, 0x3b,
-		0x83, 0xef, 0x07, 0x19, 0xd0, 0x1e, 0x79, 0x0a, 0x86, 0x5e, 0xb4, 0x0d, 0xdd, 0x51, 0x6b, 0xbd,
-		0xa8, 0xff, 0x2b, 0xa7, 0x16, 0xf8, 0xc4, 0x60, 0x0d, 0xc3, 0xc2, 0x8e, 0x5a, 0xb3, 0x7b, 0xd1,
-		0xfe, 0x37, 0x4e, 0xeb, 0x12, 0x10, 0xe2, 0x8a, 0x6a, 0x3b, 0xfd, 0xe8, 0xfd, 0xc7, 0x82, 0x58,
-		0x10, 0x10, 0xa1, 0xc9, 0xef, 0x1d, 0xbc, 0xd7, 0x8b, 0xf6, 0x07, 0x42, 0x68, 0x8e, 0x9f, 0x7b,
-		0x13, 0x24, 0xc9, 0x4f, 0x76, 0x9f, 0xae, 0x07, 0xf1, 0x9f, 0x70, 0x62, 0x8f, 0x82, 0xb4, 0x6c,
-		0x3b, 0x55, 0x47, 0xeb, 0x6d, 0xec, 0x5b, 0xbc, 0xa7, 0x05, 0x7e, 0x2e, 0x0f, 0xc3, 0xb6, 0x53,
-		0xad, 0x36, 0x79, 0x7e, 0xda, 0x83, 0xfc, 0x4f, 0x7f, 0xe4, 0x6e, 0x59, 0xb8, 0x34, 0xa4, 0xb7,
-		0xaf, 0xee, 0x38, 0xa6, 0x41, 0x0f, 0x3c, 0x7a, 0x71, 0xf8, 0x21, 0xe7, 0xe0, 0x23, 0xc9, 0xcd,
-		0x41, 0x8a, 0xe8, 0x62, 0x61, 0x13, 0xd3, 0xd3, 0xa9, 0x1e, 0x2c, 0xfe, 0x3b, 0x37, 0x40, 0x80,
-		0xa8, 0xf0, 0xb3, 0x5f, 0x7b, 0x6d, 0x4a, 0xfa, 0xe6, 0x6b, 0x53, 0xd2, 0x1f, 0xbe, 0x36, 0x25,
-		0x7d, 0xe8, 0x3b, 0x53, 0x03, 0xdf, 0xfc, 0xce, 0xd4, 0xc0, 0xef, 0x7d, 0x67, 0x6a, 0xa0, 0xfd,
-		0x2e, 0x31, 0xcc, 0x1b, 0xf3, 0x06, 0xdb, 0x1f, 0x7e, 0x41, 0xae, 0x69, 0xce, 0x76, 0x73, 0x73,
-		0xb6, 0x62, 0x34, 0xe8, 0x36, 0xae, 0xb7, 0x5b, 0xeb, 0x2e, 0x72, 0xe0, 0x3d, 0x51, 0x38, 0x5a,
-		0x31, 0xec, 0x86, 0x61, 0x97, 0xd9, 0x7e, 0x2f, 0x2b, 0xf0, 0x1d, 0xdf, 0x94, 0xbf, 0xaa, 0x8f,
-		0x4d, 0xdf, 0x8b, 0x30, 0x4a, 0x55, 0xa7, 0xdb, 0x5d, 0xd4, 0xdb, 0x7a, 0x06, 0x88, 0xaf, 0xff,
-		0xdb, 0x38, 0xd5, 0x7a, 0xc4, 0x25, 0xa4, 0xa7, 0xf7, 0xeb, 0x30, 0xa9, 0x35, 0xcc, 0x3a, 0xa6,
-		0xdb, 0xfc, 0x65, 0xb7, 0xae, 0x37, 0xbf, 0x6f, 0x70, 0x7e, 0x13, 0x1e, 0xf9, 0x82, 0xa0, 0xce,
-		0x2d, 0xc2, 0xb8, 0x5a, 0xa9, 0x60, 0x33, 0xc0, 0xb2, 0x47, 0xb7, 0x08, 0x01, 0xd3, 0x9c, 0xd2,
-		0xe5, 0x56, 0x78, 0xba, 0x53, 0xd7, 0xbc, 0x70, 0xaf, 0xcf, 0xf2, 0x16, 0xae, 0x61, 0xfd, 0x61,
-		0x1d, 0x3b, 0x57, 0x0d, 0x6b, 0x87, 0x9b, 0xf7, 0x61, 0xd6, 0xd4, 0x20, 0xbb, 0xc1, 0x0c, 0xef,
-		0x8b, 0xc2, 0x14, 0xab, 0x38, 0xbd, 0xa9, 0xda, 0xf8, 0xf4, 0x95, 0x47, 0x37, 0xb1, 0xa3, 0x3e,
-		0x7a, 0xba, 0x62, 0x68, 0x3a, 0xef, 0x89, 0x09, 0xde, 0x2f, 0xa4, 0x7e, 0x96, 0xd7, 0x67, 0xdb,
-		0x6e, 0xd3, 0xcb, 0xf3, 0x10, 0x9b, 0x33, 0x34, 0x1d, 0x4d, 0x42, 0xbc, 0x8a, 0x75, 0xa3, 0xc1,
-		0xef, 0xdc, 0xb1, 0x02, 0xba, 0x1b, 0x06, 0xd5, 0x86, 0xd1, 0xd4, 0x1d, 0x76, 0x42, 0x51, 0x18,
-		0xfe, 0xda, 0xcd, 0xe9, 0x81, 0xdf, 0xbf, 0x39, 0x1d, 0x5d, 0xd0, 0x1d, 0x85, 0x57, 0xe5, 0x62,
-		0xaf, 0xbf, 0x3a, 0x2d, 0xc9, 0xcf, 0xc0, 0x50, 0x11, 0x57, 0x0e, 0xc2, 0xab, 0x88, 0x2b, 0x21,
-		0x5e, 0x0f, 0x40, 0x62, 0x41, 0x77, 0xd8, 0xad, 0xc8, 0x13, 0x10, 0xd5, 0x74, 0x76, 0xd1, 0x26,
-		0xd4, 0x3e, 0x81, 0x13, 0xd4, 0x22, 0xae, 0xb8, 0xa8, 0x55, 0x5c, 0x09, 0xa3, 0x12, 0xf6, 0x04,
-		0x5e, 0x28, 0xfe, 0xde, 0x7f, 0x9c, 0x1a, 0x78, 0xf9, 0xb5, 0xa9, 0x81, 0x8e, 0x3d, 0xe1, 0x1f,
-		0x03, 0xdc, 0xc4, 0xbc, 0x0b, 0xec, 0xea, 0x0e, 0x3b, 0x23, 0x71, 0xbb, 0xe1, 0xb7, 0x07, 0x41,
-		0xe6, 0x38, 0xb6, 0xa3, 0xee, 0x68, 0x7a, 0xcd, 0xed, 0x09, 0xb5, 0xe9, 0x6c, 0x5f, 0xe3, 0x5d,
-		0x71, 0x98, 0x77, 0x05, 0xc7, 0xe9, 0xde, 0x1b, 0xd9, 0xce, 0xa3, 0x2b, 0xdb, 0xa3, 0xcf, 0xe5,
-		0x7f, 0x15, 0x05, 0xb4, 0xe6, 0xa8, 0x3b, 0x38, 0xdf, 0x74, 0xb6, 0x0d, 0x4b, 0xbb, 0xc6, 0x62,
-		0x19, 0x06, 0x68, 0xa8, 0xbb, 0x65, 0xc7, 0xd8, 0xc1, 0xba, 0x4d, 0x4d, 0x33, 0x7c, 0xe6, 0xe8,
-		0x6c, 0x1b, 0xff, 0x98, 0x25, 0x5d, 0x57, 0x78, 0xf0, 0xb3, 0xdf, 0x9e, 0xbe, 0xbf, 0xb7, 0x15,
-		0x28, 0x32, 0x49, 0xae, 0x77, 0xd7, 0x29, 0x63, 0x74, 0x19, 0xd8, 0x25, 0x8b, 0x72, 0x5d, 0xb3,
-		0x1d, 0x7e, 0x4f, 0xfb, 0xec, 0x6c, 0x7b, 0xdd, 0x67, 0x5b, 0xc5, 0x9c, 0xbd, 0xac, 0xd6, 0xb5,
-		0xaa, 0xea, 0x18, 0x96, 0x7d, 0x71, 0x40, 0x49, 0x52, 0x56, 0x8b, 0x9a, 0xed, 0xa0, 0x75, 0x48,
-		0x56, 0xb1, 0xbe, 0xc7, 0xd8, 0x46, 0xdf, 0x18, 0xdb, 0x04, 0xe1, 0x44, 0xb9, 0x3e, 0x0f, 0x48,
-		0xf5, 0xe3, 0x89, 0x87, 0x49, 0xec, 0x7e, 0x65, 0x07, 0xf6, 0x01, 0xce, 0xf4, 0x1d, 0xc5, 0xb8,
-		0x1a, 0x06, 0x65, 0xef, 0x03, 0xf0, 0xda, 0x44, 0x19, 0x18, 0x52, 0xab, 0x55, 0x0b, 0xdb, 0x36,
-		0x3d, 0x00, 0x4c, 0x2a, 0xa2, 0x98, 0x1b, 0xff, 0xd7, 0x5f, 0x7a, 0x78, 0x24, 0xc0, 0xb1, 0x90,
-		0x02, 0xb8, 0xe2, 0x92, 0x9e, 0xfa, 0xa4, 0x04, 0xe3, 0x2d, 0x2d, 0x22, 0x19, 0xa6, 0xf2, 0x1b,
-		0xeb, 0x17, 0x57, 0x94, 0x85, 0x17, 0xf2, 0xeb, 0x0b, 0x2b, 0xcb, 0x65, 0x76, 0xe5, 0x7f, 0x79,
-		0x6d, 0xb5, 0x34, 0xb7, 0x70, 0x61, 0xa1, 0x54, 0x4c, 0x0f, 0xa0, 0x69, 0x38, 0xd6, 0x06, 0xa7,
-		0x58, 0x5a, 0x2c, 0xcd, 0xe7, 0xd7, 0x4b, 0x69, 0x09, 0xdd, 0x05, 0x27, 0xda, 0x32, 0x71, 0x51,
-		0x22, 0x1d, 0x50, 0x94, 0x92, 0x8b, 0x12, 0x2d, 0x5c, 0xe8, 0x38, 0x8a, 0x1e, 0xea, 0xea, 0x3f,
-		0xbb, 0xee, 0x70, 0x09, 0x8e, 0xa7, 0x77, 0x47, 0xe0, 0x68, 0x78, 0xca, 0x50, 0xf5, 0xbd, 0x0e,
-		0xaf, 0x3e, 0x3b, 0x44, 0xb3, 0x8b, 0x10, 0xcd, 0xeb, 0x7b, 0xe8, 0x28, 0xcb, 0xa7, 0xcb, 0x4d,
-		0xab, 0xce, 0x63, 0xd0, 0x10, 0x29, 0x6f, 0x58, 0x75, 0x12, 0x9b, 0xc4, 0x45, 0x7f, 0xe9, 0x64,
-		0x8a, 0xdf, 0xde, 0xcf, 0xa5, 0x3f, 0xfa, 0xea, 0xf4, 0xc0, 0xe7, 0x5f, 0x9d, 0x1e, 0xf8, 0xc1,
-		0xa7, 0xa6, 0x07, 0x5e, 0xfe, 0x83, 0x99, 0x81, 0xc2, 0x4e, 0x58, 0xbd, 0xaf, 0xf4, 0x9c, 0x4d,
-		0x13, 0x79, 0x7d, 0x8f, 0x06, 0xa2, 0x55, 0xe9, 0x85, 0x38, 0x55, 0x4e, 0x1c, 0xa0, 0x4e, 0x85,
-		0x0f, 0x50, 0x9f, 0xc3, 0xf5, 0xfa, 0x25, 0xdd, 0xb8, 0x4a, 0x7b, 0xd5, 0xb3, 0xc1, 0x47, 0x22,
-		0x30, 0xd5, 0x32, 0x6d, 0xf2, 0x0c, 0xa3, 0xd3, 0xf3, 0xd7, 0x1c, 0x24, 0x8a, 0x22, 0x71, 0xc9,
-		0xc0, 0x90, 0x8d, 0x2b, 0x86, 0x5e, 0x65, 0x23, 0x3d, 0xaa, 0x88, 0x22, 0x51, 0x5b, 0x57, 0x75,
-		0xc3, 0xe6, 0x77, 0xee, 0x59, 0xa1, 0xf0, 0x71, 0x69, 0x7f, 0xf9, 0xc2, 0x88, 0x68, 0x49, 0xa8,
-		0xf9, 0x68, 0xcf, 0x23, 0xe5, 0x1d, 0xa2, 0xa5, 0xab, 0x44, 0xe0, 0x58, 0xb9, 0x5f, 0xab, 0xfc,
-		0x62, 0x04, 0xa6, 0xc3, 0x56, 0x21, 0x69, 0x9b, 0xed, 0xa8, 0x0d, 0xb3, 0x93, 0x59, 0xce, 0x43,
-		0x72, 0x5d, 0xe0, 0xec, 0xdb, 0x2e, 0x37, 0xf6, 0x69, 0x97, 0x51, 0xb7, 0x29, 0x61, 0x98, 0x33,
-		0x7d, 0x1a, 0xc6, 0xd5, 0xe3, 0x40, 0x96, 0xf9, 0x6c, 0x0c, 0x4e, 0xd0, 0x47, 0x59, 0x56, 0x43,
-		0xd3, 0x9d, 0xd3, 0x15, 0x6b, 0xcf, 0x74, 0x68, 0xe2, 0x66, 0x6c, 0x71, 0xbb, 0x8c, 0x7b, 0xd5,
-		0xb3, 0xac, 0xba, 0xc3, 0xc8, 0xd9, 0x82, 0xf8, 0x2a, 0xa1, 0x23, 0x16, 0x71, 0x0c, 0x47, 0xad,
-		0x73, 0x4b, 0xb1, 0x02, 0x81, 0xb2, 0x87, 0x5c, 0x11, 0x06, 0xd5, 0xc4, 0x1b, 0xae, 0x3a, 0x56,
-		0xb7, 0xd8, 0x7d, 0xf8, 0x28, 0x1d, 0x50, 0x09, 0x02, 0xa0, 0x57, 0xdf, 0x27, 0x21, 0xae, 0x36,
-		0xd9, 0x55, 0x8e, 0x28, 0x19, 0x69, 0xb4, 0x20, 0x5f, 0x82, 0x21, 0x7e, 0xa0, 0x8c, 0xd2, 0x10,
-		0xdd, 0xc1, 0x7b, 0xb4, 0x9d, 0x94, 0x42, 0x7e, 0xa2, 0x59, 0x88, 0x53, 0xe1, 0xf9, 0x04, 0x92,
-		0x99, 0x6d, 0x91, 0x7e, 0x96, 0x0a, 0xa9, 0x30, 0x34, 0xf9, 0x19, 0x48, 0x14, 0x8d, 0x86, 0xa6,
-		0x1b, 0x41, 0x6e, 0x49, 0xc6, 0x8d, 0xca, 0x6c, 0x36, 0x79, 0xbe, 0xa1, 0xb0, 0x02, 0x3a, 0x0c,
-		0x83, 0xec, 0x7d, 0x04, 0xbf, 0x8e, 0xc2, 0x4b, 0xf2, 0x1c, 0x0c, 0x51, 0xde, 0x2b, 0x26, 0x42,
-		0xfc, 0x65, 0x1d, 0x7f, 0x88, 0x41, 0x53, 0x53, 0xce, 0x3e, 0xe2, 0x09, 0x8b, 0x20, 0x56, 0x55,
-		0x1d, 0x95, 0xeb, 0x4d, 0x7f, 0xcb, 0x6f, 0x86, 0x04, 0x67, 0x62, 0xa3, 0x33, 0x10, 0x35, 0x4c,
-		0x9b, 0x5f, 0x28, 0xc9, 0x76, 0x52, 0x65, 0xc5, 0x2c, 0xc4, 0x48, 0xa6, 0xa2, 0x10, 0xe4, 0x82,
-		0xd2, 0x31, 0xa8, 0x3e, 0xe9, 0x0b, 0xaa, 0xbe, 0x2e, 0xf7, 0xfd, 0x64, 0x5d, 0xda, 0xe2, 0x0e,
-		0xae, 0xb3, 0x7c, 0x2a, 0x02, 0x53, 0xbe, 0xda, 0x2b, 0xd8, 0xb2, 0x35, 0x43, 0xe7, 0xf3, 0x39,
-		0xf3, 0x16, 0xe4, 0x13, 0x92, 0xd7, 0x77, 0x70, 0x97, 0x37, 0x41, 0x34, 0x6f, 0x9a, 0x28, 0x0b,
-		0x09, 0x5a, 0xae, 0x18, 0xcc, 0x5f, 0x62, 0x8a, 0x5b, 0x26, 0x75, 0xb6, 0xb1, 0xe5, 0x5c, 0x55,
-		0x2d, 0xf7, 0x09, 0xa1, 0x28, 0xcb, 0x4f, 0x41, 0x72, 0xce, 0xd0, 0x6d, 0xac, 0xdb, 0x4d, 0x3a,
-		0x06, 0x37, 0xeb, 0x46, 0x65, 0x87, 0x73, 0x60, 0x05, 0x62, 0x70, 0xd5, 0x34, 0x29, 0x65, 0x4c,
-		0x21, 0x3f, 0x59, 0x6e, 0x58, 0x58, 0xeb, 0x68, 0xa2, 0xa7, 0xf6, 0x6f, 0x22, 0xae, 0xa4, 0x6b,
-		0xa3, 0xff, 0x2d, 0xc1, 0xf1, 0xd6, 0x01, 0xb5, 0x83, 0xf7, 0xec, 0xfd, 0x8e, 0xa7, 0xe7, 0x21,
-		0xb9, 0x4a, 0xdf, 0xf1, 0x5f, 0xc2, 0x7b, 0x28, 0x0b, 0x43, 0xb8, 0x7a, 0xe6, 0xec, 0xd9, 0x47,
-		0x9f, 0x62, 0xde, 0x7e, 0x71, 0x40, 0x11, 0x00, 0x34, 0x05, 0x49, 0x1b, 0x57, 0xcc, 0x33, 0x67,
-		0xcf, 0xed, 0x3c, 0xca, 0xdc, 0x8b, 0x64, 0x40, 0x2e, 0x28, 0x97, 0x20, 0x5a, 0xbf, 0xfe, 0xa9,
-		0x69, 0xa9, 0x10, 0x87, 0xa8, 0xdd, 0x6c, 0xdc, 0x51, 0x1f, 0x79, 0x25, 0x0e, 0x33, 0x7e, 0x4a,
-		0x1a, 0xa9, 0xdc, 0xac, 0x84, 0xdb, 0x20, 0xed, 0xb3, 0x01, 0xc5, 0xe8, 0x90, 0xcc, 0x76, 0xb5,
-		0xa4, 0xfc, 0xeb, 0x12, 0xa4, 0xdc, 0x54, 0x69, 0x0d, 0x3b, 0xe8, 0xbc, 0x3f, 0xff, 0xe1, 0xc3,
-		0xe6, 0xd8, 0x6c, 0xb8, 0x2d, 0x2f, 0xa5, 0x53, 0x7c, 0xe8, 0xe8, 0x09, 0xea, 0x88, 0xa6, 0x61,
-		0xf3, 0x67, 0x65, 0x3d, 0x48, 0x5d, 0x64, 0xf4, 0x10, 0x20, 0x1a, 0xe1, 0xca, 0x57, 0x0c, 0x47,
-		0xd3, 0x6b, 0x65, 0xd3, 0xb8, 0xca, 0x1f, 0xeb, 0x46, 0x95, 0x34, 0xad, 0xb9, 0x4c, 0x2b, 0x56,
-		0x09, 0x9c, 0x08, 0x9d, 0x74, 0xb9, 0x04, 0xd3, 0x3b, 0x12, 0x04, 0x44, 0x11, 0x9d, 0x87, 0x21,
-		0xb3, 0xb9, 0x59, 0x16, 0x11, 0x63, 0xf8, 0xcc, 0xf1, 0x76, 0xe3, 0x5f, 0xf8, 0x07, 0x8f, 0x00,
-		0x83, 0x66, 0x73, 0x93, 0x78, 0xcb, 0x5d, 0x90, 0x6a, 0x23, 0xcc, 0xf0, 0x15, 0x4f, 0x0e, 0xfa,
-		0xf9, 0x08, 0xae, 0x41, 0xd9, 0xb4, 0x34, 0xc3, 0xd2, 0x9c, 0x3d, 0x9a, 0xbf, 0x46, 0x95, 0xb4,
-		0xa8, 0x58, 0xe5, 0x70, 0x79, 0x07, 0xc6, 0xd6, 0xe8, 0xfa, 0xd6, 0x93, 0xfc, 0xac, 0x27, 0x9f,
-		0xd4, 0x5b, 0xbe, 0x8e, 0x92, 0x45, 0x5a, 0x24, 0x2b, 0x3c, 0xdb, 0xd1, 0x3b, 0x9f, 0xd8, 0xbf,
-		0x77, 0x06, 0x33, 0xc4, 0x3f, 0x3e, 0x1a, 0x18, 0x9c, 0xcc, 0x39, 0xfd, 0xe1, 0xab, 0x5f, 0xc7,
-		0xec, 0x95, 0x4d, 0x64, 0xbb, 0x4f, 0xaa, 0xd9, 0x1e, 0x61, 0x34, 0xdb, 0x73, 0x08, 0xc9, 0x4f,
-		0xc1, 0xc8, 0xaa, 0x6a, 0x39, 0x6b, 0xd8, 0xb9, 0x88, 0xd5, 0x2a, 0xb6, 0x82, 0xb3, 0xee, 0x88,
-		0x98, 0x75, 0x11, 0xc4, 0xe8, 0xd4, 0xca, 0x66, 0x1d, 0xfa, 0x5b, 0xde, 0x86, 0x18, 0xbd, 0x19,
-		0xea, 0xce, 0xc8, 0x9c, 0x82, 0xcd, 0xc8, 0x24, 0x96, 0xee, 0x39, 0xd8, 0x16, 0xe9, 0x2d, 0x2d,
-		0xa0, 0xc7, 0xc5, 0xbc, 0x1a, 0xed, 0x3e, 0xaf, 0x72, 0x47, 0xe4, 0xb3, 0x6b, 0x1d, 0x86, 0x0a,
-		0x24, 0x14, 0x2f, 0x14, 0x5d, 0x41, 0x24, 0x4f, 0x10, 0xb4, 0x04, 0x63, 0xa6, 0x6a, 0x39, 0xf4,
-		0x49, 0xcc, 0x36, 0xd5, 0x82, 0xfb, 0xfa, 0x74, 0xeb, 0xc8, 0x0b, 0x28, 0xcb, 0x5b, 0x19, 0x31,
-		0xfd, 0x40, 0xf9, 0x8f, 0x62, 0x30, 0xc8, 0x8d, 0xf1, 0x26, 0x18, 0xe2, 0x66, 0xe5, 0xde, 0x79,
-		0x62, 0xb6, 0x75, 0x62, 0x9a, 0x75, 0x27, 0x10, 0xce, 0x4f, 0xd0, 0xa0, 0xfb, 0x20, 0x51, 0xd9,
-		0x56, 0x35, 0xbd, 0xac, 0x55, 0xc5, 0x56, 0xc3, 0x6b, 0x37, 0xa7, 0x87, 0xe6, 0x08, 0x6c, 0xa1,
-		0xa8, 0x0c, 0xd1, 0xca, 0x85, 0x2a, 0xc9, 0x04, 0xb6, 0xb1, 0x56, 0xdb, 0x76, 0xf8, 0x08, 0xe3,
-		0x25, 0xf4, 0x24, 0xc4, 0x88, 0x43, 0xf0, 0x07, 0x93, 0xd9, 0x96, 0x0d, 0x1f, 0x37, 0xd9, 0x2b,
-		0x24, 0x48, 0xc3, 0x1f, 0xfa, 0xf6, 0xb4, 0xa4, 0x50, 0x0a, 0x34, 0x07, 0x23, 0x75, 0xd5, 0x76,
-		0xca, 0x74, 0x06, 0x23, 0xcd, 0xc7, 0xf9, 0x7a, 0xbb, 0xc5, 0x20, 0xdc, 0xb0, 0x5c, 0xf4, 0x61,
-		0x42, 0xc5, 0x40, 0x55, 0x74, 0x12, 0xd2, 0x94, 0x49, 0xc5, 0x68, 0x34, 0x34, 0x87, 0xe5, 0x56,
-		0x83, 0xd4, 0xee, 0xa3, 0x04, 0x3e, 0x47, 0xc1, 0x34, 0xc3, 0x3a, 0x06, 0x49, 0xfa, 0x44, 0x8b,
-		0xa2, 0xb0, 0xeb, 0xc8, 0x09, 0x02, 0xa0, 0x95, 0xf7, 0xc3, 0x98, 0x17, 0x1f, 0x19, 0x4a, 0x82,
-		0x71, 0xf1, 0xc0, 0x14, 0xf1, 0x11, 0x98, 0xd4, 0xf1, 0x2e, 0xbd, 0x20, 0x1d, 0xc0, 0x4e, 0x52,
-		0x6c, 0x44, 0xea, 0x2e, 0x07, 0x29, 0xee, 0x85, 0xd1, 0x8a, 0x30, 0x3e, 0xc3, 0x05, 0x8a, 0x3b,
-		0xe2, 0x42, 0x29, 0xda, 0x51, 0x48, 0xa8, 0xa6, 0xc9, 0x10, 0x86, 0x79, 0x7c, 0x34, 0x4d, 0x5a,
-		0x75, 0x0a, 0xc6, 0xa9, 0x8e, 0x16, 0xb6, 0x9b, 0x75, 0x87, 0x33, 0x49, 0x51, 0x9c, 0x31, 0x52,
-		0xa1, 0x30, 0x38, 0xc5, 0xbd, 0x1b, 0x46, 0xf0, 0x15, 0xad, 0x8a, 0xf5, 0x0a, 0x66, 0x78, 0x23,
-		0x14, 0x2f, 0x25, 0x80, 0x14, 0xe9, 0x01, 0x70, 0xe3, 0x5e, 0x59, 0xc4, 0xe4, 0x51, 0xc6, 0x4f,
-		0xc0, 0xf3, 0x0c, 0x2c, 0x67, 0x20, 0x56, 0x54, 0x1d, 0x95, 0x24, 0x18, 0xce, 0x2e, 0x9b, 0x68,
-		0x52, 0x0a, 0xf9, 0x29, 0xbf, 0x1e, 0x81, 0xd8, 0x65, 0xc3, 0xc1, 0xe8, 0x31, 0x5f, 0x02, 0x38,
-		0xda, 0xce, 0x9f, 0xd7, 0xb4, 0x9a, 0x8e, 0xab, 0x4b, 0x76, 0xcd, 0xf7, 0x3d, 0x05, 0xcf, 0x9d,
-		0x22, 0x01, 0x77, 0x9a, 0x84, 0xb8, 0x65, 0x34, 0xf5, 0xaa, 0xb8, 0xc9, 0x4b, 0x0b, 0xa8, 0x04,
-		0x09, 0xd7, 0x4b, 0x62, 0xbd, 0xbc, 0x64, 0x8c, 0x78, 0x09, 0xf1, 0x61, 0x0e, 0x50, 0x86, 0x36,
-		0xb9, 0xb3, 0x14, 0x20, 0xe9, 0x06, 0x2f, 0xee, 0x6d, 0xfd, 0x39, 0xac, 0x47, 0x46, 0x26, 0x13,
-		0xb7, 0xef, 0x5d, 0xe3, 0x31, 0x8f, 0x4b, 0xbb, 0x15, 0xdc, 0x7a, 0x01, 0xb7, 0xe2, 0xdf, 0x76,
-		0x18, 0xa2, 0x7a, 0x79, 0x6e, 0xc5, 0xbe, 0xef, 0x70, 0x1c, 0x92, 0xb6, 0x56, 0xd3, 0x55, 0xa7,
-		0x69, 0x61, 0xee, 0x79, 0x1e, 0x40, 0xfe, 0x8a, 0x04, 0x83, 0xcc, 0x93, 0x7d, 0x76, 0x93, 0xda,
-		0xdb, 0x2d, 0xd2, 0xc9, 0x6e, 0xd1, 0x83, 0xdb, 0x2d, 0x0f, 0xe0, 0x0a, 0x63, 0xf3, 0x27, 0xf7,
-		0x6d, 0x32, 0x06, 0x26, 0xe2, 0x9a, 0x56, 0xe3, 0x03, 0xd5, 0x47, 0x24, 0xff, 0x07, 0x89, 0x24,
-		0xb1, 0xbc, 0x1e, 0xe5, 0x61, 0x44, 0xc8, 0x55, 0xde, 0xaa, 0xab, 0x35, 0xee, 0x3b, 0x27, 0x3a,
-		0x0a, 0x77, 0xa1, 0xae, 0xd6, 0x94, 0x61, 0x2e, 0x0f, 0x29, 0xb4, 0xef, 0x87, 0x48, 0x87, 0x7e,
-		0x08, 0x74, 0x7c, 0xf4, 0x60, 0x1d, 0x1f, 0xe8, 0xa2, 0x58, 0xb8, 0x8b, 0xbe, 0x18, 0xa1, 0x8b,
-		0x19, 0xd3, 0xb0, 0xd5, 0xfa, 0x8f, 0x63, 0x44, 0x1c, 0x83, 0xa4, 0x69, 0xd4, 0xcb, 0xac, 0x86,
-		0xdd, 0x70, 0x4f, 0x98, 0x46, 0x5d, 0x69, 0xe9, 0xf6, 0xf8, 0x6d, 0x1a, 0x2e, 0x83, 0xb7, 0xc1,
-		0x6a, 0x43, 0x61, 0xab, 0x59, 0x90, 0x62, 0xa6, 0xe0, 0x73, 0xd9, 0x23, 0xc4, 0x06, 0x74, 0x72,
-		0x94, 0x5a, 0xe7, 0x5e, 0x26, 0x36, 0xc3, 0x54, 0x38, 0x1e, 0xa1, 0x60, 0xa1, 0xbf, 0xdd, 0x2a,
-		0xd8, 0xef, 0x96, 0x0a, 0xc7, 0x93, 0xff, 0xa6, 0x04, 0xb0, 0x48, 0x2c, 0x4b, 0xf5, 0x25, 0xb3,
-		0x90, 0x4d, 0x45, 0x28, 0x07, 0x5a, 0x9e, 0xea, 0xd4, 0x69, 0xbc, 0xfd, 0x94, 0xed, 0x97, 0x7b,
-		0x0e, 0x46, 0x3c, 0x67, 0xb4, 0xb1, 0x10, 0x66, 0xaa, 0x4b, 0x56, 0xbd, 0x86, 0x1d, 0x25, 0x75,
-		0xc5, 0x57, 0x92, 0xff, 0xb9, 0x04, 0x49, 0x2a, 0xd3, 0x12, 0x76, 0xd4, 0x40, 0x1f, 0x4a, 0x07,
-		0xef, 0xc3, 0x13, 0x00, 0x8c, 0x8d, 0xad, 0x5d, 0xc3, 0xdc, 0xb3, 0x92, 0x14, 0xb2, 0xa6, 0x5d,
-		0xc3, 0xe8, 0x9c, 0x6b, 0xf0, 0x68, 0x77, 0x83, 0x8b, 0xac, 0x9b, 0x9b, 0xfd, 0x08, 0x0c, 0xd1,
-		0x4f, 0x54, 0xed, 0xda, 0x3c, 0x91, 0x1e, 0xd4, 0x9b, 0x8d, 0xf5, 0x5d, 0x5b, 0x7e, 0x11, 0x86,
-		0xd6, 0x77, 0xd9, 0xde, 0xc8, 0x31, 0x48, 0x5a, 0x86, 0xc1, 0xe7, 0x64, 0x96, 0x0b, 0x25, 0x08,
-		0x80, 0x4e, 0x41, 0x62, 0x3f, 0x20, 0xe2, 0xed, 0x07, 0x78, 0x1b, 0x1a, 0xd1, 0xbe, 0x36, 0x34,
-		0x4e, 0xfd, 0x3b, 0x09, 0x86, 0x7d, 0xf1, 0x01, 0x3d, 0x0a, 0x87, 0x0a, 0x8b, 0x2b, 0x73, 0x97,
-		0xca, 0x0b, 0xc5, 0xf2, 0x85, 0xc5, 0xfc, 0xbc, 0xf7, 0x86, 0x2b, 0x7b, 0xf8, 0xfa, 0x8d, 0x19,
-		0xe4, 0xc3, 0xdd, 0xd0, 0xe9, 0x8e, 0x12, 0x3a, 0x0d, 0x93, 0x41, 0x92, 0x7c, 0x61, 0xad, 0xb4,
-		0xbc, 0x9e, 0x96, 0xb2, 0x87, 0xae, 0xdf, 0x98, 0x19, 0xf7, 0x51, 0xe4, 0x37, 0x6d, 0xac, 0x3b,
-		0xad, 0x04, 0x73, 0x2b, 0x4b, 0x4b, 0x0b, 0xeb, 0xe9, 0x48, 0x0b, 0x01, 0x0f, 0xd8, 0x0f, 0xc0,
-		0x78, 0x90, 0x60, 0x79, 0x61, 0x31, 0x1d, 0xcd, 0xa2, 0xeb, 0x37, 0x66, 0x46, 0x7d, 0xd8, 0xcb,
-		0x5a, 0x3d, 0x9b, 0xf8, 0xc0, 0xa7, 0xa7, 0x06, 0x7e, 0xe5, 0x97, 0xa7, 0x24, 0xa2, 0xd9, 0x48,
-		0x20, 0x46, 0xa0, 0x87, 0xe0, 0xc8, 0xda, 0xc2, 0xfc, 0x72, 0xa9, 0x58, 0x5e, 0x5a, 0x9b, 0x17,
-		0x7b, 0xd0, 0x42, 0xbb, 0xb1, 0xeb, 0x37, 0x66, 0x86, 0xb9, 0x4a, 0x9d, 0xb0, 0x57, 0x95, 0xd2,
-		0xe5, 0x95, 0xf5, 0x52, 0x5a, 0x62, 0xd8, 0xab, 0x16, 0xbe, 0x62, 0x38, 0xec, 0x1b, 0x76, 0x8f,
-		0xc0, 0xd1, 0x36, 0xd8, 0xae, 0x62, 0xe3, 0xd7, 0x6f, 0xcc, 0x8c, 0xac, 0x5a, 0x98, 0x8d, 0x1f,
-		0x4a, 0x31, 0x0b, 0x99, 0x56, 0x8a, 0x95, 0xd5, 0x95, 0xb5, 0xfc, 0x62, 0x7a, 0x26, 0x9b, 0xbe,
-		0x7e, 0x63, 0x26, 0x25, 0x82, 0x21, 0xdd, 0xe8, 0x77, 0x35, 0xbb, 0x93, 0x2b, 0x9e, 0x3f, 0x7d,
-		0x18, 0xee, 0xe9, 0x70, 0xc6, 0x24, 0x4e, 0x27, 0x0e, 0x74, 0xca, 0xd4, 0x71, 0x9f, 0x3d, 0xdb,
-		0x63, 0xfb, 0xb9, 0xf7, 0xd2, 0xe9, 0xe0, 0x27, 0x58, 0xd9, 0xae, 0x8b, 0x3b, 0xf9, 0x83, 0x12,
-		0x8c, 0x5e, 0xd4, 0x6c, 0xc7, 0xb0, 0xb4, 0x8a, 0x5a, 0xa7, 0x2f, 0xb7, 0xce, 0xf5, 0x1b, 0x5b,
-		0x43, 0x43, 0xfd, 0x69, 0x18, 0xbc, 0xa2, 0xd6, 0x59, 0x50, 0x8b, 0xd2, 0x0f, 0xcd, 0x74, 0x38,
-		0xf2, 0x71, 0x43, 0x9b, 0x60, 0xc0, 0xc8, 0xe4, 0x5f, 0x8b, 0xc0, 0x18, 0x1d, 0x0c, 0x36, 0xfb,
-		0x04, 0x19, 0x59, 0x63, 0x15, 0x20, 0x66, 0xa9, 0x0e, 0xdf, 0x34, 0x2c, 0xcc, 0xf2, 0xd3, 0xc7,
-		0xfb, 0xfa, 0x38, 0x4b, 0x2b, 0xe2, 0x8a, 0x42, 0x69, 0xd1, 0xdb, 0x21, 0xd1, 0x50, 0x77, 0xcb,
-		0x94, 0x0f, 0x5b, 0xb9, 0xe4, 0xf7, 0xc7, 0xe7, 0xd6, 0xcd, 0xe9, 0xb1, 0x3d, 0xb5, 0x51, 0xcf,
-		0xc9, 0x82, 0x8f, 0xac, 0x0c, 0x35, 0xd4, 0x5d, 0x22, 0x22, 0x32, 0x61, 0x8c, 0x40, 0x2b, 0xdb,
-		0xaa, 0x5e, 0xc3, 0xac, 0x11, 0xba, 0x05, 0x5a, 0xb8, 0xb8, 0xef, 0x46, 0x0e, 0x7b, 0x8d, 0xf8,
-		0xd8, 0xc9, 0xca, 0x48, 0x43, 0xdd, 0x9d, 0xa3, 0x00, 0xd2, 0x62, 0x2e, 0xf1, 0xd1, 0x57, 0xa7,
-		0x07, 0xe8, 0x89, 0xee, 0xb7, 0x24, 0x00, 0xcf, 0x62, 0xe8, 0xed, 0x90, 0xae, 0xb8, 0x25, 0x4a,
-		0x2b, 0xce, 0x26, 0xef, 0xef, 0xd4, 0x17, 0x21, 0x7b, 0xb3, 0xb9, 0xf9, 0x9b, 0x37, 0xa7, 0x25,
-		0x65, 0xac, 0x12, 0xea, 0x8a, 0xb7, 0xc1, 0x70, 0xd3, 0xac, 0xaa, 0x0e, 0x2e, 0xd3, 0x75, 0x5c,
-		0xa4, 0xe7, 0x3c, 0x3f, 0x45, 0x78, 0xdd, 0xba, 0x39, 0x8d, 0x98, 0x5a, 0x3e, 0x62, 0x99, 0xce,
-		0xfe, 0xc0, 0x20, 0x84, 0xc0, 0xa7, 0xd3, 0xd7, 0x25, 0x18, 0x2e, 0xfa, 0xee, 0x54, 0x66, 0x60,
-		0xa8, 0x61, 0xe8, 0xda, 0x0e, 0xf7, 0xc7, 0xa4, 0x22, 0x8a, 0x28, 0x0b, 0x09, 0xf6, 0x98, 0xd5,
-		0xd9, 0x13, 0x5b, 0xa1, 0xa2, 0x4c, 0xa8, 0xae, 0xe2, 0x4d, 0x5b, 0x13, 0xbd, 0xa1, 0x88, 0x22,
-		0xba, 0x00, 0x69, 0x1b, 0x57, 0x9a, 0x96, 0xe6, 0xec, 0x95, 0x2b, 0x86, 0xee, 0xa8, 0x15, 0x87,
-		0x3d, 0x8b, 0x2c, 0x1c, 0xbb, 0x75, 0x73, 0xfa, 0x08, 0x93, 0x35, 0x8c, 0x21, 0x2b, 0x63, 0x02,
-		0x34, 0xc7, 0x20, 0xa4, 0x85, 0x2a, 0x76, 0x54, 0xad, 0x6e, 0x67, 0xd8, 0xe5, 0x04, 0x51, 0xf4,
-		0xe9, 0xf2, 0xb9, 0x21, 0xff, 0xc6, 0xd6, 0x05, 0x48, 0x1b, 0x26, 0xb6, 0x02, 0x89, 0xa8, 0x14,
-		0x6e, 0x39, 0x8c, 0x21, 0x2b, 0x63, 0x02, 0x24, 0x92, 0x54, 0x87, 0x74, 0xb3, 0x58, 0x28, 0x9a,
-		0xcd, 0x4d, 0x6f, 0x3f, 0x6c, 0xb2, 0xa5, 0x37, 0xf2, 0xfa, 0x5e, 0xe1, 0x31, 0x8f, 0x7b, 0x98,
-		0x4e, 0xfe, 0xc6, 0x97, 0x1e, 0x9e, 0xe4, 0xae, 0xe1, 0xed, 0x4f, 0x5d, 0xc2, 0x7b, 0xa4, 0xfb,
-		0x39, 0xea, 0x2a, 0xc5, 0x24, 0x69, 0xe7, 0x8b, 0xaa, 0x56, 0x17, 0xcf, 0xfb, 0x15, 0x5e, 0x42,
-		0x39, 0x18, 0xb4, 0x1d, 0xd5, 0x69, 0xda, 0xfc, 0xa4, 0x57, 0xee, 0xe4, 0x6a, 0x05, 0x43, 0xaf,
-		0xae, 0x51, 0x4c, 0x85, 0x53, 0xa0, 0x0b, 0x30, 0xc8, 0x8f, 0xd0, 0xe3, 0xfb, 0x1e, 0xdf, 0xf4,
-		0xae, 0x04, 0xa3, 0x26, 0x16, 0xa9, 0xe2, 0x3a, 0xae, 0xb1, 0xb4, 0x6a, 0x5b, 0x25, 0xab, 0x0f,
-		0xfa, 0xed, 0xbd, 0xc2, 0xc2, 0xbe, 0x07, 0x21, 0xb7, 0x54, 0x98, 0x9f, 0xac, 0x8c, 0xb9, 0xa0,
-		0x35, 0x0a, 0x41, 0x97, 0x02, 0x97, 0x7f, 0xf9, 0x07, 0x2a, 0xef, 0xee, 0xa4, 0xbe, 0xcf, 0xa7,
-		0xc5, 0xfe, 0x84, 0xff, 0xea, 0xf0, 0x05, 0x48, 0x37, 0xf5, 0x4d, 0x43, 0xa7, 0x6f, 0x70, 0x79,
-		0x7e, 0x4f, 0xd6, 0x77, 0x51, 0xbf, 0x73, 0x84, 0x31, 0x64, 0x65, 0xcc, 0x05, 0x5d, 0x64, 0xab,
-		0x80, 0x2a, 0x8c, 0x7a, 0x58, 0x74, 0xa0, 0x26, 0x7b, 0x0e, 0xd4, 0xbb, 0xf8, 0x40, 0x3d, 0x14,
-		0x6e, 0xc5, 0x1b, 0xab, 0x23, 0x2e, 0x90, 0x90, 0xa1, 0x8b, 0x00, 0x5e, 0x78, 0xa0, 0xfb, 0x14,
-		0xc3, 0x9d, 0x3b, 0xde, 0x8b, 0x31, 0x62, 0xbd, 0xe7, 0xd1, 0xa2, 0x77, 0xc2, 0x44, 0x43, 0xd3,
-		0xcb, 0x36, 0xae, 0x6f, 0x95, 0xb9, 0x81, 0x09, 0x4b, 0xfa, 0x09, 0xa5, 0xc2, 0xe2, 0xfe, 0xfc,
-		0xe1, 0xd6, 0xcd, 0xe9, 0x2c, 0x0f, 0xa1, 0xad, 0x2c, 0x65, 0x65, 0xbc, 0xa1, 0xe9, 0x6b, 0xb8,
-		0xbe, 0x55, 0x74, 0x61, 0xb9, 0xd4, 0x07, 0x5e, 0x9d, 0x1e, 0xe0, 0xc3, 0x75, 0x40, 0x3e, 0x47,
-		0xf7, 0xce, 0xf9, 0x30, 0xc3, 0x36, 0x59, 0x93, 0xa8, 0xa2, 0xc0, 0xaf, 0x1a, 0x78, 0x00, 0x36,
-		0xcc, 0x5f, 0xfe, 0x83, 0x19, 0x49, 0xfe, 0x9c, 0x04, 0x83, 0xc5, 0xcb, 0xab, 0xaa, 0x66, 0xa1,
-		0x05, 0x18, 0xf7, 0x3c, 0x27, 0x38, 0xc8, 0x8f, 0xdf, 0xba, 0x39, 0x9d, 0x09, 0x3b, 0x97, 0x3b,
-		0xca, 0x3d, 0x07, 0x16, 0xc3, 0x7c, 0xa1, 0xd3, 0xc2, 0x35, 0xc0, 0xaa, 0x05, 0x45, 0x6e, 0x5d,
-		0xd6, 0x86, 0xd4, 0x2c, 0xc1, 0x10, 0x93, 0xd6, 0x46, 0x39, 0x88, 0x9b, 0xe4, 0x07, 0x3f, 0x18,
-		0x98, 0xea, 0xe8, 0xbc, 0x14, 0xdf, 0xdd, 0xc8, 0x24, 0x24, 0xf2, 0x87, 0x23, 0x00, 0xc5, 0xcb,
-		0x97, 0xd7, 0x2d, 0xcd, 0xac, 0x63, 0xe7, 0x76, 0x6a, 0xbe, 0x0e, 0x87, 0x7c, 0xab, 0x24, 0xab,
-		0x12, 0xd2, 0x7e, 0xe6, 0xd6, 0xcd, 0xe9, 0xe3, 0x61, 0xed, 0x7d, 0x68, 0xb2, 0x32, 0xe1, 0xad,
-		0x97, 0xac, 0x4a, 0x5b, 0xae, 0x55, 0xdb, 0x71, 0xb9, 0x46, 0x3b, 0x73, 0xf5, 0xa1, 0xf9, 0xb9,
-		0x16, 0x6d, 0xa7, 0xbd, 0x69, 0xd7, 0x60, 0xd8, 0x33, 0x89, 0x8d, 0x8a, 0x90, 0x70, 0xf8, 0x6f,
-		0x6e, 0x61, 0xb9, 0xb3, 0x85, 0x05, 0x19, 0xb7, 0xb2, 0x4b, 0x29, 0xff, 0x99, 0x04, 0xe0, 0xf9,
-		0xec, 0x4f, 0xa7, 0x8b, 0x91, 0x50, 0xce, 0x03, 0x6f, 0xf4, 0x40, 0xa9, 0x1a, 0xa7, 0x0e, 0xd9,
-		0xf3, 0xe7, 0x22, 0x30, 0xb1, 0x21, 0x22, 0xcf, 0x4f, 0xbd, 0x0d, 0x56, 0x61, 0x08, 0xeb, 0x8e,
-		0xa5, 0x51, 0x23, 0x90, 0xde, 0x7e, 0xa4, 0x53, 0x6f, 0xb7, 0xd1, 0x89, 0x7e, 0x44, 0x4a, 0x6c,
-		0xba, 0x73, 0x36, 0x21, 0x6b, 0xfc, 0x42, 0x14, 0x32, 0x9d, 0x28, 0xd1, 0x1c, 0x8c, 0x55, 0x2c,
-		0xcc, 0x2e, 0x5e, 0xf9, 0x77, 0xfe, 0x0a, 0x59, 0x2f, 0xb3, 0x0c, 0x21, 0xc8, 0xca, 0xa8, 0x80,
-		0xf0, 0xd9, 0xa3, 0x06, 0x24, 0xed, 0x23, 0x6e, 0x47, 0xef, 0x6f, 0xf5, 0x97, 0xe7, 0xc9, 0x7c,
-		0xfa, 0x10, 0x8d, 0x04, 0x19, 0xb0, 0xf9, 0x63, 0xd4, 0x83, 0xd2, 0x09, 0xe4, 0x25, 0x18, 0xd3,
-		0x74, 0xcd, 0xd1, 0xd4, 0x7a, 0x79, 0x53, 0xad, 0xab, 0x7a, 0xe5, 0x20, 0x59, 0x33, 0x0b, 0xf9,
-		0xbc, 0xd9, 0x10, 0x3b, 0x59, 0x19, 0xe5, 0x90, 0x02, 0x03, 0xa0, 0x8b, 0x30, 0x24, 0x9a, 0x8a,
-		0x1d, 0x28, 0xdb, 0x10, 0xe4, 0xbe, 0x04, 0xef, 0xe7, 0xa3, 0x30, 0xae, 0xe0, 0xea, 0xff, 0xef,
-		0x8a, 0xfd, 0x75, 0xc5, 0x12, 0x00, 0x1b, 0xee, 0x24, 0xc0, 0x1e, 0xa0, 0x37, 0x48, 0xc0, 0x48,
-		0x32, 0x0e, 0x45, 0xdb, 0xf1, 0xf5, 0xc7, 0xcd, 0x08, 0xa4, 0xfc, 0xfd, 0xf1, 0x17, 0x74, 0x56,
-		0x42, 0x0b, 0x5e, 0x24, 0x8a, 0xf1, 0x4f, 0xef, 0x76, 0x88, 0x44, 0x2d, 0xde, 0xdb, 0x3d, 0x04,
-		0xfd, 0x8f, 0x08, 0x0c, 0xae, 0xaa, 0x96, 0xda, 0xb0, 0x51, 0xa5, 0x25, 0xd3, 0x14, 0xdb, 0x8f,
-		0x2d, 0x1f, 0x58, 0xe7, 0xbb, 0x1d, 0x3d, 0x12, 0xcd, 0x8f, 0xb6, 0x49, 0x34, 0xdf, 0x02, 0xa3,
-		0x64, 0x39, 0xec, 0xbb, 0xc2, 0x40, 0xac, 0x3d, 0x52, 0x38, 0xea, 0x71, 0x09, 0xd6, 0xb3, 0xd5,
-		0xf2, 0x65, 0xff, 0x1d, 0x86, 0x61, 0x82, 0xe1, 0x05, 0x66, 0x42, 0x7e, 0xd8, 0x5b, 0x96, 0xfa,
-		0x2a, 0x65, 0x05, 0x1a, 0xea, 0x6e, 0x89, 0x15, 0xd0, 0x22, 0xa0, 0x6d, 0x77, 0x67, 0xa4, 0xec,
-		0x99, 0x93, 0xd0, 0x9f, 0xb8, 0x75, 0x73, 0xfa, 0x28, 0xa3, 0x6f, 0xc5, 0x91, 0x95, 0x71, 0x0f,
-		0x28, 0xb8, 0x3d, 0x0e, 0x40, 0xf4, 0x2a, 0xb3, 0x2b, 0xdc, 0x6c, 0xb9, 0x73, 0xe8, 0xd6, 0xcd,
-		0xe9, 0x71, 0xc6, 0xc5, 0xab, 0x93, 0x95, 0x24, 0x29, 0x14, 0xc9, 0x6f, 0x9f, 0x67, 0x7f, 0x5a,
-		0x02, 0xe4, 0x85, 0x7c, 0x05, 0xdb, 0x26, 0x59, 0x9f, 0x91, 0x44, 0xdc, 0x97, 0x35, 0x4b, 0xdd,
-		0x13, 0x71, 0x8f, 0x5e, 0x24, 0xe2, 0xbe, 0x91, 0xf2, 0x94, 0x17, 0x1e, 0x23, 0xbd, 0xee, 0x33,
-		0x73, 0x17, 0x09, 0xc7, 0xc3, 0x01, 0xf9, 0x5f, 0x4a, 0x70, 0xb4, 0xc5, 0xa3, 0x5c, 0x61, 0xff,
-		0x12, 0x20, 0xcb, 0x57, 0xc9, 0xbf, 0xa3, 0xc8, 0x84, 0xde, 0xb7, 0x83, 0x8e, 0x5b, 0x2d, 0x71,
-		0xf7, 0xf6, 0x45, 0x78, 0x76, 0x61, 0xfe, 0x9f, 0x49, 0x30, 0xe9, 0x6f, 0xde, 0x55, 0x64, 0x19,
-		0x52, 0xfe, 0xd6, 0xb9, 0x0a, 0xf7, 0xf4, 0xa3, 0x02, 0x97, 0x3e, 0x40, 0x8f, 0x9e, 0xf5, 0x86,
-		0x2b, 0xdb, 0x3b, 0x7b, 0xb4, 0x6f, 0x6b, 0x08, 0x99, 0xc2, 0xc3, 0x36, 0x46, 0xfb, 0xe3, 0xff,
-		0x48, 0x10, 0x5b, 0x35, 0x8c, 0x3a, 0x32, 0x60, 0x5c, 0x37, 0x9c, 0x32, 0xf1, 0x2c, 0x5c, 0xf5,
-		0xdf, 0x5b, 0x4f, 0x16, 0xe6, 0xf6, 0x67, 0xa4, 0xef, 0xdd, 0x9c, 0x6e, 0x65, 0xa5, 0x8c, 0xe9,
-		0x86, 0x53, 0xa0, 0x10, 0x7e, 0x75, 0xfd, 0x9d, 0x30, 0x12, 0x6c, 0x8c, 0x45, 0xc9, 0xe7, 0xf6,
-		0xdd, 0x58, 0x90, 0xcd, 0xad, 0x9b, 0xd3, 0x93, 0xde, 0x88, 0x71, 0xc1, 0xb2, 0x92, 0xda, 0xf4,
-		0xb5, 0xce, 0xae, 0x77, 0xfd, 0xe0, 0xd5, 0x69, 0xe9, 0xd4, 0x97, 0x25, 0x00, 0x6f, 0xe7, 0x01,
-		0x3d, 0x04, 0x47, 0x0a, 0x2b, 0xcb, 0xc5, 0xf2, 0xda, 0x7a, 0x7e, 0x7d, 0x63, 0x2d, 0x78, 0xc7,
-		0x5b, 0x6c, 0x8f, 0xdb, 0x26, 0xae, 0x68, 0x5b, 0x1a, 0xae, 0xa2, 0xfb, 0x60, 0x32, 0x88, 0x4d,
-		0x4a, 0xa5, 0x62, 0x5a, 0xca, 0xa6, 0xae, 0xdf, 0x98, 0x49, 0xb0, 0x5c, 0x0c, 0x57, 0xd1, 0x49,
-		0x38, 0xd4, 0x8a, 0xb7, 0xb0, 0x3c, 0x9f, 0x8e, 0x64, 0x47, 0xae, 0xdf, 0x98, 0x49, 0xba, 0x49,
-		0x1b, 0x92, 0x01, 0xf9, 0x31, 0x39, 0xbf, 0x68, 0x16, 0xae, 0xdf, 0x98, 0x19, 0x64, 0x06, 0xcc,
-		0xc6, 0x3e, 0xf0, 0xe9, 0xa9, 0x81, 0xdb, 0x7e, 0x13, 0xfc, 0x4f, 0x86, 0x3a, 0xee, 0x7a, 0xd7,
-		0xb0, 0x8e, 0x6d, 0xcd, 0x3e, 0xd0, 0xae, 0x77, 0x5f, 0x3b, 0xe9, 0xf2, 0xef, 0xc6, 0x21, 0x35,
-		0xcf, 0x5a, 0x21, 0x1d, 0x81, 0xd1, 0xcf, 0xc0, 0xa0, 0x49, 0xa7, 0x11, 0xf7, 0x18, 0xad, 0x83,
-		0xc3, 0xb3, 0xc9, 0xc6, 0xbd, 0xcb, 0xc5, 0xa6, 0x1e, 0x9b, 0x5f, 0xe6, 0x60, 0x77, 0xcc, 0xbc,
-		0x5b, 0x53, 0xa9, 0x7d, 0xed, 0xf7, 0xb0, 0x9c, 0x85, 0x6f, 0xad, 0x84, 0xf9, 0xc9, 0xec, 0x5e,
-		0xc8, 0x3a, 0x81, 0xb0, 0xdb, 0x61, 0xef, 0x93, 0xe0, 0x10, 0xc5, 0xf2, 0x26, 0x62, 0x8a, 0x29,
-		0x92, 0xfd, 0x53, 0x9d, 0x54, 0x58, 0x54, 0x6d, 0xef, 0xae, 0x07, 0xbb, 0xcf, 0x75, 0x0f, 0x9f,
-		0x08, 0x8f, 0xfb, 0x1a, 0x0f, 0xb3, 0x95, 0x95, 0x89, 0x7a, 0x0b, 0xa5, 0x8d, 0xe6, 0x03, 0x17,
-		0xfa, 0x62, 0xfb, 0xdb, 0x6a, 0xf7, 0x5f, 0xee, 0x7b, 0x06, 0x86, 0xbd, 0x58, 0x62, 0xf3, 0xff,
-		0xfb, 0xd2, 0xff, 0xdc, 0xe1, 0x27, 0x46, 0xef, 0x97, 0xe0, 0x90, 0x37, 0x9b, 0xfb, 0xd9, 0xb2,
-		0xff, 0x8f, 0xf3, 0xe0, 0x3e, 0x16, 0x42, 0x61, 0xe3, 0xb4, 0xe5, 0x2b, 0x2b, 0x93, 0xcd, 0x56,
-		0x52, 0xb2, 0x04, 0x1b, 0xf1, 0x47, 0x56, 0x3b, 0x23, 0x3e, 0x01, 0xd9, 0x7f, 0x68, 0x0e, 0x32,
-		0x60, 0xff, 0xb3, 0xc3, 0x34, 0x2c, 0x07, 0x57, 0xe9, 0x86, 0x5c, 0x42, 0x71, 0xcb, 0xf2, 0x32,
-		0xa0, 0xd6, 0xce, 0x0d, 0x5f, 0x60, 0xf4, 0xde, 0xa7, 0xa0, 0x49, 0x88, 0xfb, 0xaf, 0xf8, 0xb1,
-		0x42, 0x2e, 0xf1, 0x01, 0x3e, 0x7d, 0xde, 0xf6, 0x31, 0xff, 0xed, 0x08, 0x9c, 0xf2, 0x1f, 0x0f,
-		0xbd, 0xd4, 0xc4, 0xd6, 0x9e, 0x3b, 0x44, 0x4d, 0xb5, 0xa6, 0xe9, 0xfe, 0x57, 0x10, 0x47, 0xfd,
-		0x13, 0x3e, 0xc5, 0x15, 0x76, 0x92, 0x3f, 0x20, 0xc1, 0xf0, 0xaa, 0x5a, 0xc3, 0x0a, 0x7e, 0xa9,
-		0x89, 0x6d, 0xa7, 0xcd, 0x2d, 0xf3, 0xc3, 0x30, 0x68, 0x6c, 0x6d, 0x89, 0x33, 0xed, 0x98, 0xc2,
-		0x4b, 0x44, 0xe7, 0xba, 0xd6, 0xd0, 0xd8, 0x75, 0xb0, 0x98, 0xc2, 0x0a, 0x68, 0x1a, 0x86, 0x2b,
-		0x46, 0x53, 0xe7, 0x43, 0x2e, 0x13, 0x13, 0xdf, 0x5a, 0x69, 0xea, 0x6c, 0xc8, 0x11, 0x23, 0x5a,
-		0xf8, 0x0a, 0xb6, 0x6c, 0xf6, 0x75, 0xc9, 0x84, 0x22, 0x8a, 0xf2, 0xd3, 0x90, 0x62, 0x92, 0xf0,
-		0xc9, 0xf8, 0x28, 0x24, 0xe8, 0x4d, 0x2b, 0x4f, 0x9e, 0x21, 0x52, 0xbe, 0xc4, 0xee, 0xaa, 0x33,
-		0xfe, 0x4c, 0x24, 0x56, 0x28, 0x14, 0x3a, 0x5a, 0xf9, 0x64, 0xef, 0xa8, 0xc1, 0x6c, 0xe8, 0x5a,
-		0xf8, 0x37, 0xe3, 0x70, 0x88, 0x1f, 0xde, 0xa9, 0xa6, 0x76, 0x7a, 0xdb, 0x71, 0xc4, 0xdb, 0x09,
-		0xe0, 0x59, 0xb0, 0x6a, 0x6a, 0xf2, 0x1e, 0xc4, 0x2e, 0x3a, 0x8e, 0x89, 0x4e, 0x41, 0xdc, 0x6a,
-		0xd6, 0xb1, 0xd8, 0x0c, 0x72, 0xb7, 0xeb, 0x55, 0x53, 0x9b, 0x25, 0x08, 0x4a, 0xb3, 0x8e, 0x15,
-		0x86, 0x82, 0x4a, 0x30, 0xbd, 0xd5, 0xac, 0xd7, 0xf7, 0xca, 0x55, 0x4c, 0xff, 0x5d, 0x96, 0xfb,
-		0x0f, 0x27, 0xf0, 0xae, 0xa9, 0x8a, 0xcf, 0x56, 0x12, 0xc3, 0x1c, 0xa7, 0x68, 0x45, 0x8a, 0x25,
-		0xfe, 0xd9, 0x44, 0x49, 0xe0, 0xc8, 0xbf, 0x1f, 0x81, 0x84, 0x60, 0x4d, 0x2f, 0x8f, 0xe3, 0x3a,
-		0xae, 0x38, 0x86, 0x38, 0x4c, 0x71, 0xcb, 0x08, 0x41, 0xb4, 0xc6, 0x3b, 0x2f, 0x79, 0x71, 0x40,
-		0x21, 0x05, 0x02, 0x73, 0xaf, 0xf4, 0x13, 0x98, 0xd9, 0x24, 0xfd, 0x19, 0x33, 0x0d, 0xb1, 0x6a,
-		0xbb, 0x38, 0xa0, 0xd0, 0x12, 0xca, 0xc0, 0x20, 0x19, 0x34, 0x0e, 0xeb, 0x2d, 0x02, 0xe7, 0x65,
-		0x74, 0x18, 0xe2, 0xa6, 0xea, 0x54, 0xd8, 0x6d, 0x3b, 0x52, 0xc1, 0x8a, 0xe8, 0x09, 0x18, 0x64,
-		0xaf, 0xb2, 0xc3, 0xff, 0x8b, 0x86, 0x18, 0x83, 0x7d, 0xfe, 0x8e, 0xc8, 0xbd, 0xaa, 0x3a, 0x0e,
-		0xb6, 0x74, 0xc2, 0x90, 0xa1, 0x23, 0x04, 0xb1, 0x4d, 0xa3, 0xba, 0xc7, 0xff, 0x3f, 0x0e, 0xfd,
-		0xcd, 0xff, 0x21, 0x07, 0xf5, 0x87, 0x32, 0xad, 0x64, 0xff, 0x16, 0x2c, 0x25, 0x80, 0x05, 0x82,
-		0x54, 0x82, 0x09, 0xb5, 0x5a, 0xd5, 0xd8, 0xbf, 0xaa, 0x29, 0x6f, 0x6a, 0x34, 0x78, 0xd8, 0xf4,
-		0x9f, 0xbe, 0x75, 0xea, 0x0b, 0xe4, 0x11, 0x14, 0x38, 0x7e, 0x21, 0x09, 0x43, 0x26, 0x13, 0x4a,
-		0x3e, 0x0f, 0xe3, 0x2d, 0x92, 0x12, 0xf9, 0x76, 0x34, 0xbd, 0x2a, 0xde, 0x39, 0x90, 0xdf, 0x04,
-		0x46, 0x3f, 0x58, 0xc9, 0x8e, 0xa9, 0xe8, 0xef, 0xc2, 0x7b, 0x3a, 0x3f, 0x87, 0x19, 0xf5, 0x3d,
-		0x87, 0x51, 0x4d, 0xad, 0x90, 0xa4, 0xfc, 0xf9, 0x23, 0x98, 0x7c, 0xeb, 0x23, 0x98, 0x1a, 0xd6,
-		0xc5, 0xc4, 0x4c, 0xaa, 0x54, 0x53, 0xb3, 0xa9, 0x3b, 0x7a, 0x1f, 0xd0, 0xb4, 0xcf, 0xfb, 0x7e,
-		0xd3, 0x37, 0x31, 0xb1, 0xf9, 0xfc, 0xea, 0x82, 0xeb, 0xc7, 0x5f, 0x8d, 0xc0, 0x71, 0x9f, 0x1f,
-		0xfb, 0x90, 0x5b, 0xdd, 0x39, 0xdb, 0xde, 0xe3, 0xfb, 0x78, 0x9b, 0x7c, 0x09, 0x62, 0x04, 0x1f,
-		0xf5, 0xf8, 0x77, 0x19, 0x99, 0xcf, 0x7f, 0xe3, 0x9f, 0xca, 0xc1, 0x03, 0xad, 0x40, 0xaf, 0x50,
-		0x26, 0x85, 0xf7, 0xf7, 0x6f, 0xbf, 0xb4, 0xf7, 0xed, 0x50, 0xfb, 0xf6, 0x99, 0x31, 0x6c, 0xc3,
-		0xef, 0x9e, 0xed, 0xf8, 0x76, 0x95, 0x05, 0xd3, 0xee, 0xf9, 0xd5, 0x3e, 0x22, 0x75, 0xa7, 0xa7,
-		0x01, 0xdd, 0x7a, 0xb0, 0xcf, 0x4c, 0x6d, 0x17, 0x0e, 0x3f, 0x4b, 0xda, 0xf6, 0x56, 0xd0, 0x22,
-		0xe4, 0x1f, 0x76, 0x0f, 0xfa, 0x24, 0xfe, 0x3f, 0xf7, 0xc4, 0x21, 0x1e, 0x78, 0xf2, 0xf1, 0xb5,
-		0xe3, 0x7d, 0xb3, 0x1d, 0xa7, 0x92, 0x59, 0xdf, 0x34, 0xa2, 0xf8, 0x28, 0xe5, 0x5f, 0x95, 0xe0,
-		0x48, 0x4b, 0xd3, 0x3c, 0xc6, 0xcf, 0xb7, 0x79, 0xc5, 0x70, 0xa0, 0xa4, 0x67, 0xbe, 0x8d, 0xb0,
-		0xf7, 0xf7, 0x14, 0x96, 0x49, 0x11, 0x90, 0xf6, 0xcd, 0x70, 0x28, 0x28, 0xac, 0x30, 0xd3, 0xbd,
-		0x30, 0x1a, 0xdc, 0x2c, 0xe6, 0xe6, 0x1a, 0x09, 0x6c, 0x17, 0xcb, 0xe5, 0xb0, 0x9d, 0x5d, 0x5d,
-		0x4b, 0x90, 0x74, 0x51, 0x79, 0x76, 0xdc, 0xb7, 0xaa, 0x1e, 0xa5, 0xfc, 0x61, 0x09, 0x66, 0x82,
-		0x2d, 0xf8, 0xf2, 0xa4, 0xfd, 0x09, 0x7b, 0xdb, 0xba, 0xf8, 0x75, 0x09, 0xee, 0xea, 0x22, 0x13,
-		0x37, 0xc0, 0x35, 0x98, 0xf4, 0x6d, 0x12, 0x88, 0x10, 0x2e, 0xba, 0xfd, 0x54, 0xef, 0x0c, 0xd5,
-		0x5d, 0x13, 0x1f, 0x23, 0x46, 0xf9, 0xec, 0xb7, 0xa7, 0x27, 0x5a, 0xeb, 0x6c, 0x65, 0xa2, 0x75,
-		0x61, 0x7f, 0x1b, 0xfd, 0xe3, 0x15, 0x09, 0x1e, 0x08, 0xaa, 0xda, 0x26, 0xd5, 0xfd, 0x49, 0xf5,
-		0xc3, 0xbf, 0x97, 0xe0, 0x54, 0x3f, 0xc2, 0xf1, 0x0e, 0xd9, 0x84, 0x09, 0x2f, 0x09, 0x0f, 0xf7,
-		0xc7, 0xbe, 0x52, 0x7b, 0xe6, 0xa5, 0xc8, 0xe5, 0x76, 0x07, 0x0c, 0x6f, 0xf2, 0x81, 0xe5, 0xef,
-		0x72, 0xd7, 0xc8, 0xc1, 0x8d, 0x5e, 0x61, 0xe4, 0xc0, 0x56, 0x6f, 0x9b, 0xbe, 0x88, 0xb4, 0xe9,
-		0x0b, 0x2f, 0x6b, 0x97, 0xaf, 0xf0, 0xb8, 0xd5, 0x66, 0x7b, 0xee, 0x6d, 0x30, 0xd1, 0xc6, 0x95,
-		0xf9, 0xa8, 0xde, 0x87, 0x27, 0x2b, 0xa8, 0xd5, 0x59, 0xe5, 0x3d, 0x98, 0xa6, 0xed, 0xb6, 0x31,
-		0xf4, 0x9d, 0x56, 0xb9, 0xc1, 0x63, 0x4b, 0xdb, 0xa6, 0xb9, 0xee, 0x0b, 0x30, 0xc8, 0xfa, 0x99,
-		0xab, 0x7b, 0x00, 0x47, 0xe1, 0x0c, 0xe4, 0x8f, 0x8b, 0x58, 0x56, 0x14, 0x62, 0xb7, 0x1f, 0x43,
-		0xfd, 0xe8, 0x7a, 0x9b, 0xc6, 0x90, 0xcf, 0x18, 0xdf, 0x12, 0x51, 0xad, 0xbd, 0x74, 0xdc, 0x1c,
-		0x95, 0xdb, 0x16, 0xd5, 0x98, 0x6d, 0xee, 0x6c, 0xf8, 0xfa, 0x65, 0x11, 0xbe, 0x5c, 0x9d, 0x7a,
-		0x84, 0xaf, 0x9f, 0x8c, 0xe9, 0xdd, 0x40, 0xd6, 0x43, 0xcc, 0x3f, 0x8f, 0x81, 0xec, 0x07, 0x12,
-		0x1c, 0xa5, 0xba, 0xf9, 0xf7, 0x28, 0xf6, 0x6b, 0xf2, 0x87, 0x00, 0xd9, 0x56, 0xa5, 0xdc, 0x76,
-		0x74, 0xa7, 0x6d, 0xab, 0x72, 0x39, 0x30, 0xbf, 0x3c, 0x04, 0xa8, 0x1a, 0xd8, 0x89, 0xa2, 0xd8,
-		0xec, 0x02, 0x5d, 0xba, 0xea, 0xdb, 0xe8, 0x68, 0xd3, 0x9d, 0xb1, 0xdb, 0xd0, 0x9d, 0xdf, 0x94,
-		0x20, 0xdb, 0x4e, 0x65, 0xde, 0x7d, 0x1a, 0x1c, 0x0e, 0x9c, 0x1f, 0x84, 0x7b, 0xf0, 0xa1, 0x7e,
-		0x76, 0x79, 0x42, 0xc3, 0xe8, 0x90, 0x85, 0xef, 0x74, 0x1e, 0x30, 0x1d, 0xf4, 0xd0, 0xd6, 0xcc,
-		0xfa, 0x27, 0x36, 0x7c, 0xbe, 0xd4, 0x12, 0x57, 0xff, 0x5c, 0xe4, 0xde, 0xbb, 0x30, 0xd5, 0x41,
-		0xea, 0x3b, 0x3d, 0xef, 0x6d, 0x77, 0xec, 0xcc, 0xdb, 0x9d, 0xbe, 0x3f, 0xce, 0x47, 0x42, 0xf0,
-		0x72, 0xb6, 0x6f, 0x2d, 0xd6, 0xee, 0x75, 0x97, 0xfc, 0x56, 0x38, 0xd6, 0x96, 0x8a, 0xcb, 0x96,
-		0x83, 0xd8, 0xb6, 0x66, 0x3b, 0x5c, 0xac, 0xfb, 0x3a, 0x89, 0x15, 0xa2, 0xa6, 0x34, 0x32, 0x82,
-		0x34, 0x65, 0xbd, 0x6a, 0x18, 0x75, 0x2e, 0x86, 0x7c, 0x09, 0xc6, 0x7d, 0x30, 0xde, 0xc8, 0x39,
-		0x88, 0x99, 0x06, 0xff, 0x72, 0xc1, 0xf0, 0x99, 0xe3, 0x1d, 0x37, 0xf6, 0x0d, 0xa3, 0xce, 0xd5,
-		0xa6, 0xf8, 0xf2, 0x24, 0x20, 0xc6, 0x8c, 0xee, 0xf1, 0x8b, 0x26, 0xd6, 0x60, 0x22, 0x00, 0xe5,
-		0x8d, 0xbc, 0xa1, 0xf3, 0x83, 0x33, 0xdf, 0x3b, 0x04, 0x71, 0xca, 0x15, 0x7d, 0x4c, 0x0a, 0x7c,
-		0x5a, 0x68, 0xb6, 0x13, 0x9b, 0xf6, 0x6b, 0xe2, 0xec, 0xe9, 0xbe, 0xf1, 0x79, 0xce, 0x76, 0xea,
-		0x3d, 0xff, 0xe6, 0xbb, 0x1f, 0x89, 0xdc, 0x83, 0xe4, 0xd3, 0x1d, 0x56, 0xe3, 0xbe, 0xf1, 0xf2,
-		0x99, 0xc0, 0xb3, 0xf8, 0x87, 0xfb, 0x6b, 0x4a, 0x48, 0x36, 0xdb, 0x2f, 0x3a, 0x17, 0xec, 0x3c,
-		0x15, 0xec, 0x2c, 0x7a, 0xac, 0xb7, 0x60, 0xa7, 0xdf, 0x11, 0x1c, 0x34, 0xef, 0x42, 0xbf, 0x2b,
-		0xc1, 0x64, 0xbb, 0x25, 0x1d, 0x7a, 0xb2, 0x3f, 0x29, 0x5a, 0x53, 0x8a, 0xec, 0x53, 0x07, 0xa0,
-		0xe4, 0xaa, 0xcc, 0x53, 0x55, 0xf2, 0xe8, 0xe9, 0x03, 0xa8, 0x72, 0xda, 0xbf, 0xf5, 0xff, 0xbf,
-		0x24, 0x38, 0xd1, 0x75, 0x85, 0x84, 0xf2, 0xfd, 0x49, 0xd9, 0x25, 0x77, 0xca, 0x16, 0xde, 0x08,
-		0x0b, 0xae, 0xf1, 0xb3, 0x54, 0xe3, 0x4b, 0x68, 0xe1, 0x20, 0x1a, 0xb7, 0x3d, 0x5f, 0x41, 0xbf,
-		0x15, 0xbc, 0x74, 0xd8, 0xdd, 0x9d, 0x5a, 0x16, 0x1e, 0x3d, 0x06, 0x46, 0x6b, 0x52, 0x2b, 0x3f,
-		0x4f, 0x55, 0x50, 0xd0, 0xea, 0x1b, 0xec, 0xb4, 0xd3, 0xef, 0x08, 0x06, 0xfe, 0x77, 0xa1, 0xff,
-		0x29, 0xb5, 0xbf, 0x43, 0xf8, 0x44, 0x57, 0x11, 0x3b, 0x2f, 0xaa, 0xb2, 0x4f, 0xee, 0x9f, 0x90,
-		0x2b, 0xd9, 0xa0, 0x4a, 0xd6, 0x10, 0xbe, 0xdd, 0x4a, 0xb6, 0xed, 0x44, 0xf4, 0x75, 0x09, 0x26,
-		0xdb, 0xad, 0x49, 0x7a, 0x0c, 0xcb, 0x2e, 0x8b, 0xac, 0x1e, 0xc3, 0xb2, 0xdb, 0x02, 0x48, 0xfe,
-		0x19, 0xaa, 0xfc, 0x39, 0xf4, 0x78, 0x27, 0xe5, 0xbb, 0xf6, 0x22, 0x19, 0x8b, 0x5d, 0x93, 0xfc,
-		0x1e, 0x63, 0xb1, 0x9f, 0x75, 0x4c, 0x8f, 0xb1, 0xd8, 0xd7, 0x1a, 0xa3, 0xf7, 0x58, 0x74, 0x35,
-		0xeb, 0xb3, 0x1b, 0x6d, 0xf4, 0x55, 0x09, 0x46, 0x02, 0x19, 0x31, 0x7a, 0xb4, 0xab, 0xa0, 0xed,
-		0x16, 0x0c, 0xd9, 0x33, 0xfb, 0x21, 0xe1, 0xba, 0x2c, 0x50, 0x5d, 0xe6, 0x50, 0xfe, 0x20, 0xba,
-		0x04, 0x8f, 0x51, 0xbf, 0x29, 0xc1, 0x44, 0x9b, 0x2c, 0xb3, 0xc7, 0x28, 0xec, 0x9c, 0x34, 0x67,
-		0x9f, 0xdc, 0x3f, 0x21, 0xd7, 0xea, 0x02, 0xd5, 0xea, 0x2d, 0xe8, 0xcd, 0x07, 0xd1, 0xca, 0x37,
-		0x3f, 0xdf, 0xf4, 0xae, 0x64, 0xf9, 0xda, 0x41, 0xe7, 0xf6, 0x29, 0x98, 0x50, 0xe8, 0x89, 0x7d,
-		0xd3, 0x71, 0x7d, 0x9e, 0xa3, 0xfa, 0x3c, 0x8b, 0x56, 0xde, 0x98, 0x3e, 0xad, 0xd3, 0xfa, 0x17,
-		0x5b, 0x1f, 0x07, 0x76, 0xf7, 0xa2, 0xb6, 0xc9, 0x6a, 0xf6, 0xb1, 0x7d, 0xd1, 0x70, 0xa5, 0x9e,
-		0xa4, 0x4a, 0x9d, 0x41, 0x8f, 0x74, 0x52, 0xca, 0x77, 0xef, 0x4e, 0xd3, 0xb7, 0x8c, 0xd3, 0xef,
-		0x60, 0x29, 0xf0, 0xbb, 0xd0, 0xbb, 0xc5, 0x9d, 0xa7, 0x93, 0x5d, 0xdb, 0xf5, 0xe5, 0xb1, 0xd9,
-		0x07, 0xfa, 0xc0, 0xe4, 0x72, 0xdd, 0x43, 0xe5, 0x9a, 0x42, 0xc7, 0x3b, 0xc9, 0x45, 0x72, 0x59,
-		0xf4, 0x41, 0xc9, 0xbd, 0x26, 0x79, 0xaa, 0x3b, 0x6f, 0x7f, 0xb2, 0x9b, 0x7d, 0xb0, 0x2f, 0x5c,
-		0x2e, 0xc9, 0x7d, 0x54, 0x92, 0x19, 0x34, 0xd5, 0x51, 0x12, 0x96, 0xfa, 0xde, 0xee, 0x4b, 0x05,
-		0xd7, 0x8f, 0xc0, 0x74, 0x87, 0x16, 0x9d, 0xdd, 0x1e, 0x67, 0x5c, 0x5d, 0xde, 0xc8, 0xf6, 0x7c,
-		0x03, 0x7b, 0xbb, 0xbf, 0xed, 0xda, 0xe7, 0x81, 0xd8, 0x6f, 0xc7, 0x00, 0x2d, 0xd9, 0xb5, 0x39,
-		0x0b, 0xb3, 0xff, 0x33, 0xc9, 0x47, 0x79, 0xe8, 0xf1, 0x97, 0xf4, 0x86, 0x1e, 0x7f, 0x2d, 0x05,
-		0x9e, 0x53, 0x45, 0xf6, 0xf7, 0x64, 0xb3, 0xef, 0x37, 0x55, 0xd1, 0x1f, 0xcb, 0x9b, 0xaa, 0xf6,
-		0x57, 0xae, 0x63, 0xb7, 0xef, 0x6d, 0x46, 0xfc, 0xa0, 0xef, 0x53, 0xf8, 0x53, 0xc9, 0xc1, 0x2e,
-		0x4f, 0x25, 0x33, 0x1d, 0xdf, 0x43, 0x72, 0x6a, 0x74, 0x56, 0x7c, 0xe9, 0x74, 0xa8, 0xbf, 0x4b,
-		0xb2, 0xfc, 0x53, 0xa8, 0xde, 0x16, 0xc2, 0x71, 0xc8, 0xb6, 0xba, 0x93, 0x3b, 0xa8, 0x3f, 0x12,
-		0x85, 0xf4, 0x92, 0x5d, 0x2b, 0x55, 0x35, 0xe7, 0x0e, 0xf9, 0xda, 0xd3, 0x9d, 0xdf, 0xbb, 0xa0,
-		0x5b, 0x37, 0xa7, 0x47, 0x99, 0x4d, 0xbb, 0x58, 0xb2, 0x01, 0x63, 0xa1, 0x57, 0xc6, 0xdc, 0xb3,
-		0x8a, 0x07, 0x79, 0xec, 0x1c, 0x62, 0x25, 0xd3, 0xe7, 0x09, 0x3e, 0xff, 0x46, 0xbb, 0xed, 0x9d,
-		0x99, 0x39, 0xd4, 0xc5, 0x3b, 0xf9, 0x38, 0xd0, 0xeb, 0xb3, 0x2c, 0x64, 0xc2, 0x9d, 0xe2, 0xf6,
-		0xd8, 0x1f, 0x49, 0x30, 0xbc, 0x64, 0x8b, 0x54, 0x10, 0xff, 0x94, 0x3e, 0x4d, 0x7a, 0xc2, 0xfd,
-		0x4c, 0x78, 0xb4, 0x3f, 0xbf, 0x15, 0x9f, 0x0e, 0xf7, 0x8c, 0x70, 0x08, 0x26, 0x7c, 0x7a, 0xba,
-		0xfa, 0xff, 0x4e, 0x84, 0xc6, 0xc7, 0x02, 0xae, 0x69, 0xba, 0x9b, 0x45, 0xe2, 0xbf, 0xa8, 0x0f,
-		0x2f, 0x3c, 0x3b, 0xc7, 0x0e, 0x6a, 0xe7, 0x1d, 0x1a, 0x20, 0x42, 0xf6, 0x74, 0x37, 0xbe, 0x96,
-		0x5a, 0x9f, 0x05, 0x49, 0xfb, 0xf8, 0xe2, 0x4e, 0xe8, 0xf1, 0x8f, 0xfc, 0xba, 0x04, 0x23, 0x4b,
-		0x76, 0x6d, 0x43, 0xaf, 0xfe, 0x3f, 0xef, 0xbf, 0x5b, 0x70, 0x28, 0xa0, 0xe9, 0x1d, 0x32, 0xe9,
-		0x99, 0x57, 0x62, 0x10, 0x5d, 0xb2, 0x6b, 0xe8, 0x25, 0x18, 0x0b, 0x27, 0x0d, 0x1d, 0x73, 0xc1,
-		0xd6, 0x19, 0xa1, 0xf3, 0x7a, 0xad, 0xf3, 0xec, 0x81, 0x76, 0x60, 0x24, 0x38, 0x73, 0x9c, 0xec,
-		0xc2, 0x24, 0x80, 0x99, 0x7d, 0xa4, 0x5f, 0x4c, 0xb7, 0xb1, 0xb7, 0x43, 0xc2, 0x0d, 0x7a, 0x77,
-		0x77, 0xa1, 0x16, 0x48, 0x9d, 0xb3, 0xdb, 0x36, 0x61, 0x85, 0x58, 0x2f, 0x1c, 0x52, 0xba, 0x59,
-		0x2f, 0x84, 0xdb, 0xd5, 0x7a, 0x9d, 0x86, 0xd6, 0x26, 0x80, 0x6f, 0x1c, 0xdc, 0xdb, 0x85, 0x83,
-		0x87, 0x96, 0x7d, 0xb8, 0x2f, 0x34, 0xf7, 0xd0, 0xe9, 0x36, 0x27, 0xe3, 0xff, 0x37, 0x00, 0x00,
-		0xff, 0xff, 0xb1, 0x48, 0x2a, 0x05, 0xdd, 0x97, 0x00, 0x00,
+		// 7641 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0x7b, 0x70, 0x24, 0xc7,
+		0x79, 0x1f, 0xf6, 0x01, 0x60, 0xf7, 0xc3, 0x02, 0x3b, 0x18, 0xe0, 0x8e, 0x7b, 0x38, 0x1e, 0x00,
+		0x0e, 0x5f, 0xc7, 0x13, 0x89, 0x23, 0x8f, 0xbc, 0x23, 0x6f, 0x2f, 0x12, 0xbd, 0x8b, 0xdd, 0xc3,
+		0xed, 0x11, 0x8f, 0xd5, 0x2c, 0x70, 0xa4, 0x68, 0x3b, 0x53, 0x83, 0xd9, 0xc6, 0x62, 0x88, 0xd9,
+		0x99, 0xd1, 0xcc, 0xec, 0xdd, 0x81, 0xa5, 0x3f, 0xe8, 0xc8, 0x49, 0xac, 0x73, 0x1c, 0x49, 0x8e,
+		0xcb, 0x91, 0x15, 0x9d, 0x42, 0xda, 0x49, 0xe4, 0x28, 0x8a, 0xdf, 0x51, 0xe2, 0xe4, 0x8f, 0x28,
+		0x49, 0x25, 0x51, 0x94, 0xaa, 0x94, 0xf4, 0x4f, 0xe2, 0x4a, 0x39, 0x67, 0x87, 0x52, 0x25, 0x8a,
+		0xc2, 0xc4, 0xca, 0x85, 0xa9, 0x72, 0x95, 0x2a, 0x95, 0x54, 0xbf, 0xe6, 0xb5, 0xbb, 0x98, 0xc5,
+		0x95, 0x28, 0xbb, 0x2a, 0xf9, 0x6b, 0xb7, 0xbf, 0xfe, 0x7e, 0xbf, 0xee, 0xfe, 0xfa, 0xeb, 0xee,
+		0xaf, 0xbb, 0x67, 0x06, 0xfe, 0xd9, 0x15, 0x58, 0xee, 0x58, 0x56, 0xc7, 0x40, 0xe7, 0x6d, 0xc7,
+		0xf2, 0xac, 0xdd, 0xde, 0xde, 0xf9, 0x36, 0x72, 0x35, 0x47, 0xb7, 0x3d, 0xcb, 0x59, 0x21, 0x32,
+		0xb1, 0x48, 0x35, 0x56, 0xb8, 0x86, 0xb4, 0x01, 0xb3, 0x57, 0x75, 0x03, 0xd5, 0x7c, 0xc5, 0x16,
+		0xf2, 0xc4, 0x97, 0x20, 0xbb, 0xa7, 0x1b, 0xa8, 0x94, 0x5a, 0xce, 0x9c, 0x9d, 0xba, 0xf0, 0xd8,
+		0x4a, 0x0c, 0xb4, 0x12, 0x45, 0x34, 0xb1, 0x58, 0x26, 0x08, 0xe9, 0x3b, 0x59, 0x98, 0x1b, 0x90,
+		0x2b, 0x8a, 0x90, 0x35, 0xd5, 0x2e, 0x66, 0x4c, 0x9d, 0xcd, 0xcb, 0xe4, 0xbf, 0x58, 0x82, 0x49,
+		0x5b, 0xd5, 0x0e, 0xd4, 0x0e, 0x2a, 0xa5, 0x89, 0x98, 0x27, 0xc5, 0x45, 0x80, 0x36, 0xb2, 0x91,
+		0xd9, 0x46, 0xa6, 0x76, 0x58, 0xca, 0x2c, 0x67, 0xce, 0xe6, 0xe5, 0x90, 0x44, 0xfc, 0x10, 0xcc,
+		0xda, 0xbd, 0x5d, 0x43, 0xd7, 0x94, 0x90, 0x1a, 0x2c, 0x67, 0xce, 0x8e, 0xcb, 0x02, 0xcd, 0xa8,
+		0x05, 0xca, 0x4f, 0x42, 0xf1, 0x16, 0x52, 0x0f, 0xc2, 0xaa, 0x53, 0x44, 0x75, 0x06, 0x8b, 0x43,
+		0x8a, 0xab, 0x50, 0xe8, 0x22, 0xd7, 0x55, 0x3b, 0x48, 0xf1, 0x0e, 0x6d, 0x54, 0xca, 0x92, 0xd6,
+		0x2f, 0xf7, 0xb5, 0x3e, 0xde, 0xf2, 0x29, 0x86, 0xda, 0x3e, 0xb4, 0x91, 0x58, 0x81, 0x3c, 0x32,
+		0x7b, 0x5d, 0xca, 0x30, 0x3e, 0xc4, 0x7e, 0x75, 0xb3, 0xd7, 0x8d, 0xb3, 0xe4, 0x30, 0x8c, 0x51,
+		0x4c, 0xba, 0xc8, 0xb9, 0xa9, 0x6b, 0xa8, 0x34, 0x41, 0x08, 0x9e, 0xec, 0x23, 0x68, 0xd1, 0xfc,
+		0x38, 0x07, 0xc7, 0x89, 0xab, 0x90, 0x47, 0xb7, 0x3d, 0x64, 0xba, 0xba, 0x65, 0x96, 0x26, 0x09,
+		0xc9, 0xe3, 0x03, 0x7a, 0x11, 0x19, 0xed, 0x38, 0x45, 0x80, 0x13, 0x2f, 0xc1, 0xa4, 0x65, 0x7b,
+		0xba, 0x65, 0xba, 0xa5, 0xdc, 0x72, 0xea, 0xec, 0xd4, 0x85, 0x87, 0x07, 0x3a, 0xc2, 0x16, 0xd5,
+		0x91, 0xb9, 0xb2, 0xd8, 0x00, 0xc1, 0xb5, 0x7a, 0x8e, 0x86, 0x14, 0xcd, 0x6a, 0x23, 0x45, 0x37,
+		0xf7, 0xac, 0x52, 0x9e, 0x10, 0x2c, 0xf5, 0x37, 0x84, 0x28, 0xae, 0x5a, 0x6d, 0xd4, 0x30, 0xf7,
+		0x2c, 0x79, 0xc6, 0x8d, 0xa4, 0xc5, 0x93, 0x30, 0xe1, 0x1e, 0x9a, 0x9e, 0x7a, 0xbb, 0x54, 0x20,
+		0x1e, 0xc2, 0x52, 0xd2, 0xef, 0x4e, 0x40, 0x71, 0x14, 0x17, 0xbb, 0x02, 0xe3, 0x7b, 0xb8, 0x95,
+		0xa5, 0xf4, 0x71, 0x6c, 0x40, 0x31, 0x51, 0x23, 0x4e, 0x3c, 0xa0, 0x11, 0x2b, 0x30, 0x65, 0x22,
+		0xd7, 0x43, 0x6d, 0xea, 0x11, 0x99, 0x11, 0x7d, 0x0a, 0x28, 0xa8, 0xdf, 0xa5, 0xb2, 0x0f, 0xe4,
+		0x52, 0xaf, 0x41, 0xd1, 0xaf, 0x92, 0xe2, 0xa8, 0x66, 0x87, 0xfb, 0xe6, 0xf9, 0xa4, 0x9a, 0xac,
+		0xd4, 0x39, 0x4e, 0xc6, 0x30, 0x79, 0x06, 0x45, 0xd2, 0x62, 0x0d, 0xc0, 0x32, 0x91, 0xb5, 0xa7,
+		0xb4, 0x91, 0x66, 0x94, 0x72, 0x43, 0xac, 0xb4, 0x85, 0x55, 0xfa, 0xac, 0x64, 0x51, 0xa9, 0x66,
+		0x88, 0x97, 0x03, 0x57, 0x9b, 0x1c, 0xe2, 0x29, 0x1b, 0x74, 0x90, 0xf5, 0x79, 0xdb, 0x0e, 0xcc,
+		0x38, 0x08, 0xfb, 0x3d, 0x6a, 0xb3, 0x96, 0xe5, 0x49, 0x25, 0x56, 0x12, 0x5b, 0x26, 0x33, 0x18,
+		0x6d, 0xd8, 0xb4, 0x13, 0x4e, 0x8a, 0x8f, 0x82, 0x2f, 0x50, 0x88, 0x5b, 0x01, 0x99, 0x85, 0x0a,
+		0x5c, 0xb8, 0xa9, 0x76, 0xd1, 0xc2, 0x9b, 0x30, 0x13, 0x35, 0x8f, 0x38, 0x0f, 0xe3, 0xae, 0xa7,
+		0x3a, 0x1e, 0xf1, 0xc2, 0x71, 0x99, 0x26, 0x44, 0x01, 0x32, 0xc8, 0x6c, 0x93, 0x59, 0x6e, 0x5c,
+		0xc6, 0x7f, 0xc5, 0x1f, 0x0b, 0x1a, 0x9c, 0x21, 0x0d, 0x7e, 0xa2, 0xbf, 0x47, 0x23, 0xcc, 0xf1,
+		0x76, 0x2f, 0xbc, 0x08, 0xd3, 0x91, 0x06, 0x8c, 0x5a, 0xb4, 0xf4, 0x09, 0x38, 0x31, 0x90, 0x5a,
+		0x7c, 0x0d, 0xe6, 0x7b, 0xa6, 0x6e, 0x7a, 0xc8, 0xb1, 0x1d, 0x84, 0x3d, 0x96, 0x16, 0x55, 0xfa,
+		0xcf, 0x93, 0x43, 0x7c, 0x6e, 0x27, 0xac, 0x4d, 0x59, 0xe4, 0xb9, 0x5e, 0xbf, 0xf0, 0x5c, 0x3e,
+		0xf7, 0xdd, 0x49, 0xe1, 0xad, 0xb7, 0xde, 0x7a, 0x2b, 0x2d, 0xfd, 0x93, 0x09, 0x98, 0x1f, 0x34,
+		0x66, 0x06, 0x0e, 0xdf, 0x93, 0x30, 0x61, 0xf6, 0xba, 0xbb, 0xc8, 0x21, 0x46, 0x1a, 0x97, 0x59,
+		0x4a, 0xac, 0xc0, 0xb8, 0xa1, 0xee, 0x22, 0xa3, 0x94, 0x5d, 0x4e, 0x9d, 0x9d, 0xb9, 0xf0, 0xa1,
+		0x91, 0x46, 0xe5, 0xca, 0x3a, 0x86, 0xc8, 0x14, 0x29, 0x7e, 0x04, 0xb2, 0x6c, 0x8a, 0xc6, 0x0c,
+		0xe7, 0x46, 0x63, 0xc0, 0x63, 0x49, 0x26, 0x38, 0xf1, 0x34, 0xe4, 0xf1, 0x2f, 0xf5, 0x8d, 0x09,
+		0x52, 0xe7, 0x1c, 0x16, 0x60, 0xbf, 0x10, 0x17, 0x20, 0x47, 0x86, 0x49, 0x1b, 0xf1, 0xa5, 0xcd,
+		0x4f, 0x63, 0xc7, 0x6a, 0xa3, 0x3d, 0xb5, 0x67, 0x78, 0xca, 0x4d, 0xd5, 0xe8, 0x21, 0xe2, 0xf0,
+		0x79, 0xb9, 0xc0, 0x84, 0x37, 0xb0, 0x4c, 0x5c, 0x82, 0x29, 0x3a, 0xaa, 0x74, 0xb3, 0x8d, 0x6e,
+		0x93, 0xd9, 0x73, 0x5c, 0xa6, 0x03, 0xad, 0x81, 0x25, 0xb8, 0xf8, 0x37, 0x5c, 0xcb, 0xe4, 0xae,
+		0x49, 0x8a, 0xc0, 0x02, 0x52, 0xfc, 0x8b, 0xf1, 0x89, 0xfb, 0xcc, 0xe0, 0xe6, 0xf5, 0x8d, 0xa5,
+		0x27, 0xa1, 0x48, 0x34, 0x9e, 0x67, 0x5d, 0xaf, 0x1a, 0xa5, 0xd9, 0xe5, 0xd4, 0xd9, 0x9c, 0x3c,
+		0x43, 0xc5, 0x5b, 0x4c, 0x2a, 0x7d, 0x35, 0x0d, 0x59, 0x32, 0xb1, 0x14, 0x61, 0x6a, 0xfb, 0x63,
+		0xcd, 0xba, 0x52, 0xdb, 0xda, 0xa9, 0xae, 0xd7, 0x85, 0x94, 0x38, 0x03, 0x40, 0x04, 0x57, 0xd7,
+		0xb7, 0x2a, 0xdb, 0x42, 0xda, 0x4f, 0x37, 0x36, 0xb7, 0x2f, 0xbd, 0x20, 0x64, 0x7c, 0xc0, 0x0e,
+		0x15, 0x64, 0xc3, 0x0a, 0xcf, 0x5f, 0x10, 0xc6, 0x45, 0x01, 0x0a, 0x94, 0xa0, 0xf1, 0x5a, 0xbd,
+		0x76, 0xe9, 0x05, 0x61, 0x22, 0x2a, 0x79, 0xfe, 0x82, 0x30, 0x29, 0x4e, 0x43, 0x9e, 0x48, 0xaa,
+		0x5b, 0x5b, 0xeb, 0x42, 0xce, 0xe7, 0x6c, 0x6d, 0xcb, 0x8d, 0xcd, 0x35, 0x21, 0xef, 0x73, 0xae,
+		0xc9, 0x5b, 0x3b, 0x4d, 0x01, 0x7c, 0x86, 0x8d, 0x7a, 0xab, 0x55, 0x59, 0xab, 0x0b, 0x53, 0xbe,
+		0x46, 0xf5, 0x63, 0xdb, 0xf5, 0x96, 0x50, 0x88, 0x54, 0xeb, 0xf9, 0x0b, 0xc2, 0xb4, 0x5f, 0x44,
+		0x7d, 0x73, 0x67, 0x43, 0x98, 0x11, 0x67, 0x61, 0x9a, 0x16, 0xc1, 0x2b, 0x51, 0x8c, 0x89, 0x2e,
+		0xbd, 0x20, 0x08, 0x41, 0x45, 0x28, 0xcb, 0x6c, 0x44, 0x70, 0xe9, 0x05, 0x41, 0x94, 0x56, 0x61,
+		0x9c, 0xb8, 0xa1, 0x28, 0xc2, 0xcc, 0x7a, 0xa5, 0x5a, 0x5f, 0x57, 0xb6, 0x9a, 0xdb, 0x8d, 0xad,
+		0xcd, 0xca, 0xba, 0x90, 0x0a, 0x64, 0x72, 0xfd, 0xa3, 0x3b, 0x0d, 0xb9, 0x5e, 0x13, 0xd2, 0x61,
+		0x59, 0xb3, 0x5e, 0xd9, 0xae, 0xd7, 0x84, 0x8c, 0xa4, 0xc1, 0xfc, 0xa0, 0x09, 0x75, 0xe0, 0x10,
+		0x0a, 0xf9, 0x42, 0x7a, 0x88, 0x2f, 0x10, 0xae, 0xb8, 0x2f, 0x48, 0xdf, 0x4e, 0xc3, 0xdc, 0x80,
+		0x45, 0x65, 0x60, 0x21, 0x2f, 0xc3, 0x38, 0xf5, 0x65, 0xba, 0xcc, 0x3e, 0x35, 0x70, 0x75, 0x22,
+		0x9e, 0xdd, 0xb7, 0xd4, 0x12, 0x5c, 0x38, 0xd4, 0xc8, 0x0c, 0x09, 0x35, 0x30, 0x45, 0x9f, 0xc3,
+		0xfe, 0x64, 0xdf, 0xe4, 0x4f, 0xd7, 0xc7, 0x4b, 0xa3, 0xac, 0x8f, 0x44, 0x76, 0xbc, 0x45, 0x60,
+		0x7c, 0xc0, 0x22, 0x70, 0x05, 0x66, 0xfb, 0x88, 0x46, 0x9e, 0x8c, 0x3f, 0x99, 0x82, 0xd2, 0x30,
+		0xe3, 0x24, 0x4c, 0x89, 0xe9, 0xc8, 0x94, 0x78, 0x25, 0x6e, 0xc1, 0x47, 0x86, 0x77, 0x42, 0x5f,
+		0x5f, 0x7f, 0x29, 0x05, 0x27, 0x07, 0x87, 0x94, 0x03, 0xeb, 0xf0, 0x11, 0x98, 0xe8, 0x22, 0x6f,
+		0xdf, 0xe2, 0x61, 0xd5, 0x13, 0x03, 0x16, 0x6b, 0x9c, 0x1d, 0xef, 0x6c, 0x86, 0x12, 0x2f, 0xc7,
+		0xeb, 0xba, 0x34, 0x2c, 0xc0, 0xed, 0xab, 0xe9, 0xa7, 0xd2, 0x70, 0x62, 0x20, 0xf9, 0xc0, 0x8a,
+		0x9e, 0x01, 0xd0, 0x4d, 0xbb, 0xe7, 0xd1, 0xd0, 0x89, 0xce, 0xc4, 0x79, 0x22, 0x21, 0x93, 0x17,
+		0x9e, 0x65, 0x7b, 0x9e, 0x9f, 0x9f, 0x21, 0xf9, 0x40, 0x45, 0x44, 0xe1, 0xa5, 0xa0, 0xa2, 0x59,
+		0x52, 0xd1, 0xc5, 0x21, 0x2d, 0xed, 0x73, 0xcc, 0x67, 0x41, 0xd0, 0x0c, 0x1d, 0x99, 0x9e, 0xe2,
+		0x7a, 0x0e, 0x52, 0xbb, 0xba, 0xd9, 0x21, 0x4b, 0x4d, 0xae, 0x3c, 0xbe, 0xa7, 0x1a, 0x2e, 0x92,
+		0x8b, 0x34, 0xbb, 0xc5, 0x73, 0x31, 0x82, 0x38, 0x90, 0x13, 0x42, 0x4c, 0x44, 0x10, 0x34, 0xdb,
+		0x47, 0x48, 0x9f, 0xcd, 0xc3, 0x54, 0x28, 0x00, 0x17, 0x1f, 0x81, 0xc2, 0x1b, 0xea, 0x4d, 0x55,
+		0xe1, 0x9b, 0x2a, 0x6a, 0x89, 0x29, 0x2c, 0x6b, 0x52, 0x91, 0xf8, 0x2c, 0xcc, 0x13, 0x15, 0xab,
+		0xe7, 0x21, 0x47, 0xd1, 0x0c, 0xd5, 0x75, 0x89, 0xd1, 0x72, 0x44, 0x55, 0xc4, 0x79, 0x5b, 0x38,
+		0x6b, 0x95, 0xe7, 0x88, 0x17, 0x61, 0x8e, 0x20, 0xba, 0x3d, 0xc3, 0xd3, 0x6d, 0x03, 0x29, 0x78,
+		0x9b, 0xe7, 0x96, 0x20, 0x5c, 0xb3, 0x59, 0xac, 0xb1, 0xc1, 0x14, 0x70, 0x8d, 0x5c, 0xb1, 0x06,
+		0x67, 0x08, 0xac, 0x83, 0x4c, 0xe4, 0xa8, 0x1e, 0x52, 0xd0, 0xc7, 0x7b, 0xaa, 0xe1, 0x2a, 0xaa,
+		0xd9, 0x56, 0xf6, 0x55, 0x77, 0xbf, 0x34, 0x8f, 0x09, 0xaa, 0xe9, 0x52, 0x4a, 0x3e, 0x85, 0x15,
+		0xd7, 0x98, 0x5e, 0x9d, 0xa8, 0x55, 0xcc, 0xf6, 0x35, 0xd5, 0xdd, 0x17, 0xcb, 0x70, 0x92, 0xb0,
+		0xb8, 0x9e, 0xa3, 0x9b, 0x1d, 0x45, 0xdb, 0x47, 0xda, 0x81, 0xd2, 0xf3, 0xf6, 0x5e, 0x2a, 0x9d,
+		0x0e, 0x97, 0x4f, 0x6a, 0xd8, 0x22, 0x3a, 0xab, 0x58, 0x65, 0xc7, 0xdb, 0x7b, 0x49, 0x6c, 0x41,
+		0x01, 0x77, 0x46, 0x57, 0x7f, 0x13, 0x29, 0x7b, 0x96, 0x43, 0xd6, 0xd0, 0x99, 0x01, 0x53, 0x53,
+		0xc8, 0x82, 0x2b, 0x5b, 0x0c, 0xb0, 0x61, 0xb5, 0x51, 0x79, 0xbc, 0xd5, 0xac, 0xd7, 0x6b, 0xf2,
+		0x14, 0x67, 0xb9, 0x6a, 0x39, 0xd8, 0xa1, 0x3a, 0x96, 0x6f, 0xe0, 0x29, 0xea, 0x50, 0x1d, 0x8b,
+		0x9b, 0xf7, 0x22, 0xcc, 0x69, 0x1a, 0x6d, 0xb3, 0xae, 0x29, 0x6c, 0x33, 0xe6, 0x96, 0x84, 0x88,
+		0xb1, 0x34, 0x6d, 0x8d, 0x2a, 0x30, 0x1f, 0x77, 0xc5, 0xcb, 0x70, 0x22, 0x30, 0x56, 0x18, 0x38,
+		0xdb, 0xd7, 0xca, 0x38, 0xf4, 0x22, 0xcc, 0xd9, 0x87, 0xfd, 0x40, 0x31, 0x52, 0xa2, 0x7d, 0x18,
+		0x87, 0xbd, 0x08, 0xf3, 0xf6, 0xbe, 0xdd, 0x8f, 0x3b, 0x17, 0xc6, 0x89, 0xf6, 0xbe, 0x1d, 0x07,
+		0x3e, 0x4e, 0x76, 0xe6, 0x0e, 0xd2, 0x54, 0x0f, 0xb5, 0x4b, 0x0f, 0x85, 0xd5, 0x43, 0x19, 0xe2,
+		0x0a, 0x08, 0x9a, 0xa6, 0x20, 0x53, 0xdd, 0x35, 0x90, 0xa2, 0x3a, 0xc8, 0x54, 0xdd, 0xd2, 0x12,
+		0x51, 0xce, 0x7a, 0x4e, 0x0f, 0xc9, 0x33, 0x9a, 0x56, 0x27, 0x99, 0x15, 0x92, 0x27, 0x9e, 0x83,
+		0x59, 0x6b, 0xf7, 0x0d, 0x8d, 0x7a, 0xa4, 0x62, 0x3b, 0x68, 0x4f, 0xbf, 0x5d, 0x7a, 0x8c, 0x98,
+		0xb7, 0x88, 0x33, 0x88, 0x3f, 0x36, 0x89, 0x58, 0x7c, 0x0a, 0x04, 0xcd, 0xdd, 0x57, 0x1d, 0x9b,
+		0x4c, 0xc9, 0xae, 0xad, 0x6a, 0xa8, 0xf4, 0x38, 0x55, 0xa5, 0xf2, 0x4d, 0x2e, 0xc6, 0x23, 0xc2,
+		0xbd, 0xa5, 0xef, 0x79, 0x9c, 0xf1, 0x49, 0x3a, 0x22, 0x88, 0x8c, 0xb1, 0x9d, 0x05, 0x01, 0x5b,
+		0x22, 0x52, 0xf0, 0x59, 0xa2, 0x36, 0x63, 0xef, 0xdb, 0xe1, 0x72, 0x1f, 0x85, 0x69, 0x7b, 0x3f,
+		0x5c, 0xe8, 0x53, 0x34, 0x70, 0xb3, 0xf7, 0x43, 0x25, 0xbe, 0x00, 0x27, 0xb1, 0x52, 0x17, 0x79,
+		0x6a, 0x5b, 0xf5, 0xd4, 0x90, 0xf6, 0xd3, 0x44, 0x1b, 0x9b, 0x7d, 0x83, 0x65, 0x46, 0xea, 0xe9,
+		0xf4, 0x76, 0x0f, 0x7d, 0xc7, 0x7a, 0x86, 0xd6, 0x13, 0xcb, 0xb8, 0x6b, 0x7d, 0x60, 0xc1, 0xb9,
+		0x54, 0x86, 0x42, 0xd8, 0xef, 0xc5, 0x3c, 0x50, 0xcf, 0x17, 0x52, 0x38, 0x08, 0x5a, 0xdd, 0xaa,
+		0xe1, 0xf0, 0xe5, 0xf5, 0xba, 0x90, 0xc6, 0x61, 0xd4, 0x7a, 0x63, 0xbb, 0xae, 0xc8, 0x3b, 0x9b,
+		0xdb, 0x8d, 0x8d, 0xba, 0x90, 0x09, 0x05, 0xf6, 0xd7, 0xb3, 0xb9, 0x27, 0x84, 0x27, 0xa5, 0x6f,
+		0xa5, 0x61, 0x26, 0xba, 0x53, 0x13, 0xff, 0x0c, 0x3c, 0xc4, 0x8f, 0x55, 0x5c, 0xe4, 0x29, 0xb7,
+		0x74, 0x87, 0x0c, 0xc8, 0xae, 0x4a, 0x17, 0x47, 0xdf, 0x7f, 0xe6, 0x99, 0x56, 0x0b, 0x79, 0xaf,
+		0xea, 0x0e, 0x1e, 0x6e, 0x5d, 0xd5, 0x13, 0xd7, 0x61, 0xc9, 0xb4, 0x14, 0xd7, 0x53, 0xcd, 0xb6,
+		0xea, 0xb4, 0x95, 0xe0, 0x40, 0x4b, 0x51, 0x35, 0x0d, 0xb9, 0xae, 0x45, 0x17, 0x42, 0x9f, 0xe5,
+		0x61, 0xd3, 0x6a, 0x31, 0xe5, 0x60, 0x85, 0xa8, 0x30, 0xd5, 0x98, 0xfb, 0x66, 0x86, 0xb9, 0xef,
+		0x69, 0xc8, 0x77, 0x55, 0x5b, 0x41, 0xa6, 0xe7, 0x1c, 0x92, 0xf8, 0x3c, 0x27, 0xe7, 0xba, 0xaa,
+		0x5d, 0xc7, 0xe9, 0x1f, 0xc9, 0x36, 0xe9, 0x7a, 0x36, 0x97, 0x13, 0xf2, 0xd7, 0xb3, 0xb9, 0xbc,
+		0x00, 0xd2, 0xbb, 0x19, 0x28, 0x84, 0xe3, 0x75, 0xbc, 0xfd, 0xd1, 0xc8, 0x8a, 0x95, 0x22, 0x73,
+		0xda, 0xa3, 0x47, 0x46, 0xf7, 0x2b, 0xab, 0x78, 0x29, 0x2b, 0x4f, 0xd0, 0xe0, 0x58, 0xa6, 0x48,
+		0x1c, 0x46, 0x60, 0x67, 0x43, 0x34, 0x18, 0xc9, 0xc9, 0x2c, 0x25, 0xae, 0xc1, 0xc4, 0x1b, 0x2e,
+		0xe1, 0x9e, 0x20, 0xdc, 0x8f, 0x1d, 0xcd, 0x7d, 0xbd, 0x45, 0xc8, 0xf3, 0xd7, 0x5b, 0xca, 0xe6,
+		0x96, 0xbc, 0x51, 0x59, 0x97, 0x19, 0x5c, 0x3c, 0x05, 0x59, 0x43, 0x7d, 0xf3, 0x30, 0xba, 0xe8,
+		0x11, 0xd1, 0xa8, 0x9d, 0x70, 0x0a, 0xb2, 0xf8, 0x80, 0x2e, 0xba, 0xd4, 0x10, 0xd1, 0x07, 0x38,
+		0x18, 0xce, 0xc3, 0x38, 0xb1, 0x97, 0x08, 0xc0, 0x2c, 0x26, 0x8c, 0x89, 0x39, 0xc8, 0xae, 0x6e,
+		0xc9, 0x78, 0x40, 0x08, 0x50, 0xa0, 0x52, 0xa5, 0xd9, 0xa8, 0xaf, 0xd6, 0x85, 0xb4, 0x74, 0x11,
+		0x26, 0xa8, 0x11, 0xf0, 0x60, 0xf1, 0xcd, 0x20, 0x8c, 0xb1, 0x24, 0xe3, 0x48, 0xf1, 0xdc, 0x9d,
+		0x8d, 0x6a, 0x5d, 0x16, 0xd2, 0xd1, 0xae, 0xce, 0x0a, 0xe3, 0x92, 0x0b, 0x85, 0x70, 0x1c, 0xfe,
+		0xa3, 0xd9, 0x8c, 0x7f, 0x2d, 0x05, 0x53, 0xa1, 0xb8, 0x1a, 0x07, 0x44, 0xaa, 0x61, 0x58, 0xb7,
+		0x14, 0xd5, 0xd0, 0x55, 0x97, 0xb9, 0x06, 0x10, 0x51, 0x05, 0x4b, 0x46, 0xed, 0xba, 0x1f, 0xd1,
+		0x10, 0x19, 0x17, 0x26, 0xa4, 0x2f, 0xa6, 0x40, 0x88, 0x07, 0xb6, 0xb1, 0x6a, 0xa6, 0xfe, 0x24,
+		0xab, 0x29, 0x7d, 0x21, 0x05, 0x33, 0xd1, 0x68, 0x36, 0x56, 0xbd, 0x47, 0xfe, 0x44, 0xab, 0xf7,
+		0x87, 0x69, 0x98, 0x8e, 0xc4, 0xb0, 0xa3, 0xd6, 0xee, 0xe3, 0x30, 0xab, 0xb7, 0x51, 0xd7, 0xb6,
+		0x3c, 0x7c, 0x78, 0xae, 0x18, 0xe8, 0x26, 0x32, 0x4a, 0x12, 0x99, 0x34, 0xce, 0x1f, 0x1d, 0x25,
+		0xaf, 0x34, 0x02, 0xdc, 0x3a, 0x86, 0x95, 0xe7, 0x1a, 0xb5, 0xfa, 0x46, 0x73, 0x6b, 0xbb, 0xbe,
+		0xb9, 0xfa, 0x31, 0x65, 0x67, 0xf3, 0x95, 0xcd, 0xad, 0x57, 0x37, 0x65, 0x41, 0x8f, 0xa9, 0x7d,
+		0x80, 0xc3, 0xbe, 0x09, 0x42, 0xbc, 0x52, 0xe2, 0x43, 0x30, 0xa8, 0x5a, 0xc2, 0x98, 0x38, 0x07,
+		0xc5, 0xcd, 0x2d, 0xa5, 0xd5, 0xa8, 0xd5, 0x95, 0xfa, 0xd5, 0xab, 0xf5, 0xd5, 0xed, 0x16, 0x3d,
+		0xf7, 0xf0, 0xb5, 0xb7, 0x23, 0x03, 0x5c, 0xfa, 0x7c, 0x06, 0xe6, 0x06, 0xd4, 0x44, 0xac, 0xb0,
+		0x1d, 0x0b, 0xdd, 0x44, 0x3d, 0x33, 0x4a, 0xed, 0x57, 0x70, 0xcc, 0xd0, 0x54, 0x1d, 0x8f, 0x6d,
+		0x70, 0x9e, 0x02, 0x6c, 0x25, 0xd3, 0xd3, 0xf7, 0x74, 0xe4, 0xb0, 0xf3, 0x24, 0xba, 0x8d, 0x29,
+		0x06, 0x72, 0x7a, 0xa4, 0xf4, 0x34, 0x88, 0xb6, 0xe5, 0xea, 0x9e, 0x7e, 0x13, 0x1f, 0xc9, 0xf3,
+		0xc3, 0x27, 0xbc, 0xad, 0xc9, 0xca, 0x02, 0xcf, 0x69, 0x98, 0x9e, 0xaf, 0x6d, 0xa2, 0x8e, 0x1a,
+		0xd3, 0xc6, 0x93, 0x79, 0x46, 0x16, 0x78, 0x8e, 0xaf, 0xfd, 0x08, 0x14, 0xda, 0x56, 0x0f, 0xc7,
+		0x7a, 0x54, 0x0f, 0xaf, 0x1d, 0x29, 0x79, 0x8a, 0xca, 0x7c, 0x15, 0x16, 0xc5, 0x07, 0xa7, 0x5e,
+		0x05, 0x79, 0x8a, 0xca, 0xa8, 0xca, 0x93, 0x50, 0x54, 0x3b, 0x1d, 0x07, 0x93, 0x73, 0x22, 0xba,
+		0x2f, 0x99, 0xf1, 0xc5, 0x44, 0x71, 0xe1, 0x3a, 0xe4, 0xb8, 0x1d, 0xf0, 0x52, 0x8d, 0x2d, 0xa1,
+		0xd8, 0x74, 0xb3, 0x9d, 0xc6, 0x07, 0x61, 0x26, 0xcf, 0x7c, 0x04, 0x0a, 0xba, 0xab, 0x04, 0x87,
+		0xf8, 0xe9, 0xe5, 0xf4, 0xd9, 0x9c, 0x3c, 0xa5, 0xbb, 0xfe, 0x01, 0xa8, 0xf4, 0xa5, 0x34, 0xcc,
+		0x44, 0x2f, 0x21, 0xc4, 0x1a, 0xe4, 0x0c, 0x4b, 0x53, 0x89, 0x6b, 0xd1, 0x1b, 0xb0, 0xb3, 0x09,
+		0xf7, 0x16, 0x2b, 0xeb, 0x4c, 0x5f, 0xf6, 0x91, 0x0b, 0xff, 0x26, 0x05, 0x39, 0x2e, 0x16, 0x4f,
+		0x42, 0xd6, 0x56, 0xbd, 0x7d, 0x42, 0x37, 0x5e, 0x4d, 0x0b, 0x29, 0x99, 0xa4, 0xb1, 0xdc, 0xb5,
+		0x55, 0xb3, 0x94, 0x0e, 0xe4, 0x38, 0x8d, 0xfb, 0xd5, 0x40, 0x6a, 0x9b, 0x6c, 0x7a, 0xac, 0x6e,
+		0x17, 0x99, 0x9e, 0xcb, 0xfb, 0x95, 0xc9, 0x57, 0x99, 0x18, 0xdf, 0x85, 0x79, 0x8e, 0xaa, 0x1b,
+		0x11, 0xdd, 0x2c, 0xd1, 0x15, 0x78, 0x86, 0xaf, 0x5c, 0x86, 0x53, 0x9c, 0xb7, 0x8d, 0x3c, 0x55,
+		0xdb, 0x47, 0xed, 0x00, 0x34, 0x41, 0x0e, 0x37, 0x1e, 0x62, 0x0a, 0x35, 0x96, 0xcf, 0xb1, 0xd2,
+		0xb7, 0x52, 0x30, 0xcb, 0xb7, 0x69, 0x6d, 0xdf, 0x58, 0x1b, 0x00, 0xaa, 0x69, 0x5a, 0x5e, 0xd8,
+		0x5c, 0xfd, 0xae, 0xdc, 0x87, 0x5b, 0xa9, 0xf8, 0x20, 0x39, 0x44, 0xb0, 0xd0, 0x05, 0x08, 0x72,
+		0x86, 0x9a, 0x6d, 0x09, 0xa6, 0xd8, 0x0d, 0x13, 0xb9, 0xa6, 0xa4, 0x1b, 0x7b, 0xa0, 0x22, 0xbc,
+		0x9f, 0xc3, 0xc7, 0x2f, 0xbb, 0xa8, 0xa3, 0x9b, 0xec, 0xdc, 0x98, 0x26, 0xf8, 0xf1, 0x4b, 0xd6,
+		0x3f, 0x7e, 0xa9, 0x7e, 0x3a, 0x05, 0x73, 0x9a, 0xd5, 0x8d, 0xd7, 0xb7, 0x2a, 0xc4, 0x4e, 0x17,
+		0xdc, 0x6b, 0xa9, 0xd7, 0x3f, 0xd2, 0xd1, 0xbd, 0xfd, 0xde, 0xee, 0x8a, 0x66, 0x75, 0xcf, 0x77,
+		0x2c, 0x43, 0x35, 0x3b, 0xc1, 0x3d, 0x2b, 0xf9, 0xa3, 0x3d, 0xd3, 0x41, 0xe6, 0x33, 0x1d, 0x2b,
+		0x74, 0xeb, 0x7a, 0x25, 0xf8, 0xfb, 0xc7, 0xa9, 0xd4, 0x2f, 0xa7, 0x33, 0x6b, 0xcd, 0xea, 0x97,
+		0xd3, 0x0b, 0x6b, 0xb4, 0xb8, 0x26, 0x37, 0x8f, 0x8c, 0xf6, 0x0c, 0xa4, 0xe1, 0x26, 0xc3, 0xf7,
+		0x3e, 0x04, 0xf3, 0x1d, 0xab, 0x63, 0x11, 0xc6, 0xf3, 0xf8, 0x1f, 0xbb, 0xb9, 0xcd, 0xfb, 0xd2,
+		0x85, 0xc4, 0x6b, 0xde, 0xf2, 0x26, 0xcc, 0x31, 0x65, 0x85, 0x5c, 0x1d, 0xd1, 0x8d, 0x8d, 0x78,
+		0xe4, 0xa9, 0x5a, 0xe9, 0x37, 0xbf, 0x43, 0x16, 0x74, 0x79, 0x96, 0x41, 0x71, 0x1e, 0xdd, 0xfb,
+		0x94, 0x65, 0x38, 0x11, 0xe1, 0xa3, 0xc3, 0x16, 0x39, 0x09, 0x8c, 0xff, 0x9c, 0x31, 0xce, 0x85,
+		0x18, 0x5b, 0x0c, 0x5a, 0x5e, 0x85, 0xe9, 0xe3, 0x70, 0xfd, 0x0b, 0xc6, 0x55, 0x40, 0x61, 0x92,
+		0x35, 0x28, 0x12, 0x12, 0xad, 0xe7, 0x7a, 0x56, 0x97, 0xcc, 0x89, 0x47, 0xd3, 0xfc, 0xcb, 0xef,
+		0xd0, 0x71, 0x34, 0x83, 0x61, 0xab, 0x3e, 0xaa, 0x5c, 0x06, 0x72, 0x5b, 0x86, 0x6f, 0xb1, 0x12,
+		0x18, 0xbe, 0xce, 0x2a, 0xe2, 0xeb, 0x97, 0x6f, 0xc0, 0x3c, 0xfe, 0x4f, 0xa6, 0xac, 0x70, 0x4d,
+		0x92, 0x8f, 0xe0, 0x4a, 0xdf, 0xfa, 0x24, 0x1d, 0xaa, 0x73, 0x3e, 0x41, 0xa8, 0x4e, 0xa1, 0x5e,
+		0xec, 0x20, 0xcf, 0x43, 0x8e, 0xab, 0xa8, 0xc6, 0xa0, 0xea, 0x85, 0xce, 0x30, 0x4a, 0xbf, 0xf4,
+		0x5e, 0xb4, 0x17, 0xd7, 0x28, 0xb2, 0x62, 0x18, 0xe5, 0x1d, 0x78, 0x68, 0x80, 0x57, 0x8c, 0xc0,
+		0xf9, 0x79, 0xc6, 0x39, 0xdf, 0xe7, 0x19, 0x98, 0xb6, 0x09, 0x5c, 0xee, 0xf7, 0xe5, 0x08, 0x9c,
+		0x7f, 0x8d, 0x71, 0x8a, 0x0c, 0xcb, 0xbb, 0x14, 0x33, 0x5e, 0x87, 0xd9, 0x9b, 0xc8, 0xd9, 0xb5,
+		0x5c, 0x76, 0x6e, 0x34, 0x02, 0xdd, 0x17, 0x18, 0x5d, 0x91, 0x01, 0xc9, 0x41, 0x12, 0xe6, 0xba,
+		0x0c, 0xb9, 0x3d, 0x55, 0x43, 0x23, 0x50, 0xdc, 0x65, 0x14, 0x93, 0x58, 0x1f, 0x43, 0x2b, 0x50,
+		0xe8, 0x58, 0x6c, 0xd5, 0x4a, 0x86, 0x7f, 0x91, 0xc1, 0xa7, 0x38, 0x86, 0x51, 0xd8, 0x96, 0xdd,
+		0x33, 0xf0, 0x92, 0x96, 0x4c, 0xf1, 0xd7, 0x39, 0x05, 0xc7, 0x30, 0x8a, 0x63, 0x98, 0xf5, 0x6d,
+		0x4e, 0xe1, 0x86, 0xec, 0xf9, 0x32, 0xbe, 0x4e, 0x32, 0x0e, 0x2d, 0x73, 0x94, 0x4a, 0xbc, 0xc3,
+		0x18, 0x80, 0x41, 0x30, 0xc1, 0x15, 0xc8, 0x8f, 0xda, 0x11, 0x7f, 0xf3, 0x3d, 0x3e, 0x3c, 0x78,
+		0x0f, 0xac, 0x41, 0x91, 0x4f, 0x50, 0xf8, 0xfa, 0x39, 0x99, 0xe2, 0x6f, 0x31, 0x8a, 0x99, 0x10,
+		0x8c, 0x35, 0xc3, 0x43, 0xae, 0xd7, 0x41, 0xa3, 0x90, 0x7c, 0x89, 0x37, 0x83, 0x41, 0x98, 0x29,
+		0x77, 0x91, 0xa9, 0xed, 0x8f, 0xc6, 0xf0, 0xab, 0xdc, 0x94, 0x1c, 0x83, 0x29, 0x56, 0x61, 0xba,
+		0xab, 0x3a, 0xee, 0xbe, 0x6a, 0x8c, 0xd4, 0x1d, 0x7f, 0x9b, 0x71, 0x14, 0x7c, 0x10, 0xb3, 0x48,
+		0xcf, 0x3c, 0x0e, 0xcd, 0x97, 0xb9, 0x45, 0x7a, 0x66, 0x84, 0xa8, 0x09, 0xf3, 0xae, 0x47, 0x0e,
+		0xd9, 0x8e, 0xc3, 0xf6, 0x77, 0xf8, 0xd0, 0xa3, 0xd8, 0x8d, 0x30, 0xe3, 0x15, 0xc8, 0xbb, 0xfa,
+		0x9b, 0x23, 0xd1, 0x7c, 0x85, 0xf7, 0x34, 0x01, 0x60, 0xf0, 0xc7, 0xe0, 0xd4, 0xc0, 0x65, 0x62,
+		0x04, 0xb2, 0xbf, 0xcb, 0xc8, 0x4e, 0x0e, 0x58, 0x2a, 0xd8, 0x94, 0x70, 0x5c, 0xca, 0x5f, 0xe3,
+		0x53, 0x02, 0x8a, 0x71, 0x35, 0xf1, 0x3e, 0xc2, 0x55, 0xf7, 0x8e, 0x67, 0xb5, 0x5f, 0xe7, 0x56,
+		0xa3, 0xd8, 0x88, 0xd5, 0xb6, 0xe1, 0x24, 0x63, 0x3c, 0x5e, 0xbf, 0xfe, 0x06, 0x9f, 0x58, 0x29,
+		0x7a, 0x27, 0xda, 0xbb, 0x3f, 0x0e, 0x0b, 0xbe, 0x39, 0x79, 0xc0, 0xea, 0x2a, 0xf8, 0x64, 0x2a,
+		0x99, 0xf9, 0x37, 0x19, 0x33, 0x9f, 0xf1, 0xfd, 0x88, 0xd7, 0xdd, 0x50, 0x6d, 0x4c, 0xfe, 0x1a,
+		0x94, 0x38, 0x79, 0xcf, 0x74, 0x90, 0x66, 0x75, 0x4c, 0xfd, 0x4d, 0xd4, 0x1e, 0x81, 0xfa, 0xb7,
+		0x62, 0x5d, 0xb5, 0x13, 0x82, 0x63, 0xe6, 0x06, 0x08, 0x7e, 0xac, 0xa2, 0xe8, 0x5d, 0xdb, 0x72,
+		0xbc, 0x04, 0xc6, 0xdf, 0xe6, 0x3d, 0xe5, 0xe3, 0x1a, 0x04, 0x56, 0xae, 0x03, 0xbd, 0x79, 0x1e,
+		0xd5, 0x25, 0x7f, 0x87, 0x11, 0x4d, 0x07, 0x28, 0x36, 0x71, 0x68, 0x56, 0xd7, 0x56, 0x9d, 0x51,
+		0xe6, 0xbf, 0xbf, 0xc7, 0x27, 0x0e, 0x06, 0x61, 0x13, 0x07, 0x3e, 0xd5, 0xc2, 0xab, 0xfd, 0x08,
+		0x0c, 0x5f, 0xe5, 0x13, 0x07, 0xc7, 0x30, 0x0a, 0x1e, 0x30, 0x8c, 0x40, 0xf1, 0xf7, 0x39, 0x05,
+		0xc7, 0x60, 0x8a, 0x8f, 0x06, 0x0b, 0xad, 0x83, 0x3a, 0xba, 0xeb, 0x39, 0x34, 0x4c, 0x3e, 0x9a,
+		0xea, 0x1f, 0xbc, 0x17, 0x0d, 0xc2, 0xe4, 0x10, 0x14, 0xcf, 0x44, 0xec, 0xd8, 0x95, 0xec, 0xa2,
+		0x92, 0x2b, 0xf6, 0xbb, 0x7c, 0x26, 0x0a, 0xc1, 0x70, 0xdd, 0x42, 0x11, 0x22, 0x36, 0xbb, 0x86,
+		0xf7, 0x0e, 0x23, 0xd0, 0xfd, 0xc3, 0x58, 0xe5, 0x5a, 0x1c, 0x8b, 0x39, 0x43, 0xf1, 0x4f, 0xcf,
+		0x3c, 0x40, 0x87, 0x23, 0x79, 0xe7, 0x3f, 0x8a, 0xc5, 0x3f, 0x3b, 0x14, 0x49, 0xe7, 0x90, 0x62,
+		0x2c, 0x9e, 0x12, 0x93, 0x9e, 0x33, 0x2a, 0xfd, 0xd4, 0xfb, 0xac, 0xbd, 0xd1, 0x70, 0xaa, 0xbc,
+		0x0e, 0x02, 0x93, 0x04, 0x01, 0x6c, 0x22, 0xd9, 0x27, 0xdf, 0xf7, 0xfd, 0x3c, 0x12, 0xf3, 0x94,
+		0xaf, 0xc2, 0x74, 0x24, 0xe0, 0x49, 0xa6, 0xfa, 0x69, 0x46, 0x55, 0x08, 0xc7, 0x3b, 0xe5, 0x8b,
+		0x90, 0xc5, 0xc1, 0x4b, 0x32, 0xfc, 0xcf, 0x33, 0x38, 0x51, 0x2f, 0x7f, 0x18, 0x72, 0x3c, 0x68,
+		0x49, 0x86, 0xfe, 0x05, 0x06, 0xf5, 0x21, 0x18, 0xce, 0x03, 0x96, 0x64, 0xf8, 0x5f, 0xe4, 0x70,
+		0x0e, 0xc1, 0xf0, 0xd1, 0x4d, 0xf8, 0xb5, 0x9f, 0xcd, 0x52, 0x38, 0x87, 0x94, 0xf1, 0xcd, 0x37,
+		0x8d, 0x54, 0x92, 0xd1, 0x9f, 0x62, 0x85, 0x73, 0x44, 0xf9, 0x45, 0x18, 0x1f, 0xd1, 0xe0, 0x3f,
+		0xc7, 0xa0, 0x54, 0xbf, 0xbc, 0x0a, 0x53, 0xa1, 0xe8, 0x24, 0x19, 0xfe, 0x97, 0x19, 0x3c, 0x8c,
+		0xc2, 0x55, 0x67, 0xd1, 0x49, 0x32, 0xc1, 0xa7, 0x79, 0xd5, 0x19, 0x02, 0x9b, 0x8d, 0x07, 0x26,
+		0xc9, 0xe8, 0xcf, 0x70, 0xab, 0x73, 0x48, 0xf9, 0x65, 0xc8, 0xfb, 0x8b, 0x4d, 0x32, 0xfe, 0xb3,
+		0x0c, 0x1f, 0x60, 0xb0, 0x05, 0x7a, 0xe6, 0x31, 0x28, 0x7e, 0x9e, 0x5b, 0x20, 0x84, 0xc2, 0xc3,
+		0x28, 0x1e, 0xc0, 0x24, 0x33, 0xfd, 0x15, 0x3e, 0x8c, 0x62, 0xf1, 0x0b, 0xee, 0x4d, 0x32, 0xe7,
+		0x27, 0x53, 0xfc, 0x02, 0xef, 0x4d, 0xa2, 0x8f, 0xab, 0x11, 0x8f, 0x08, 0x92, 0x39, 0xfe, 0x2a,
+		0xaf, 0x46, 0x2c, 0x20, 0x28, 0x37, 0x41, 0xec, 0x8f, 0x06, 0x92, 0xf9, 0x3e, 0xc7, 0xf8, 0x66,
+		0xfb, 0x82, 0x81, 0xf2, 0xab, 0x70, 0x72, 0x70, 0x24, 0x90, 0xcc, 0xfa, 0x4b, 0xef, 0xc7, 0xf6,
+		0x6e, 0xe1, 0x40, 0xa0, 0xbc, 0x0d, 0xf3, 0x83, 0xa2, 0x80, 0x64, 0xda, 0xcf, 0xbf, 0x1f, 0x9d,
+		0xb8, 0xc3, 0x41, 0x40, 0xb9, 0x02, 0x10, 0x2c, 0xc0, 0xc9, 0x5c, 0x5f, 0x60, 0x5c, 0x21, 0x10,
+		0x1e, 0x1a, 0x6c, 0xfd, 0x4d, 0xc6, 0xdf, 0xe5, 0x43, 0x83, 0x21, 0xf0, 0xd0, 0xe0, 0x4b, 0x6f,
+		0x32, 0xfa, 0x8b, 0x7c, 0x68, 0x70, 0x08, 0xf6, 0xec, 0xd0, 0xea, 0x96, 0xcc, 0xf0, 0x0e, 0xf7,
+		0xec, 0x10, 0xaa, 0xbc, 0x09, 0xb3, 0x7d, 0x0b, 0x62, 0x32, 0xd5, 0x2f, 0x33, 0x2a, 0x21, 0xbe,
+		0x1e, 0x86, 0x17, 0x2f, 0xb6, 0x18, 0x26, 0xb3, 0xfd, 0x4a, 0x6c, 0xf1, 0x62, 0x6b, 0x61, 0xf9,
+		0x0a, 0xe4, 0xcc, 0x9e, 0x61, 0xe0, 0xc1, 0x23, 0x1e, 0xfd, 0x6c, 0x60, 0xe9, 0xbf, 0xfc, 0x80,
+		0x59, 0x87, 0x03, 0xca, 0x17, 0x61, 0x1c, 0x75, 0x77, 0x51, 0x3b, 0x09, 0xf9, 0xbd, 0x1f, 0xf0,
+		0x09, 0x13, 0x6b, 0x97, 0x5f, 0x06, 0xa0, 0x47, 0x23, 0xe4, 0x7a, 0x30, 0x01, 0xfb, 0x5f, 0x7f,
+		0xc0, 0x1e, 0xc6, 0x09, 0x20, 0x01, 0x01, 0x7d, 0xb4, 0xe7, 0x68, 0x82, 0xf7, 0xa2, 0x04, 0xa4,
+		0x47, 0x2e, 0xc3, 0x24, 0x7e, 0x44, 0xd2, 0x53, 0x3b, 0x49, 0xe8, 0xff, 0xc6, 0xd0, 0x5c, 0x1f,
+		0x1b, 0xac, 0x6b, 0x39, 0xc8, 0x53, 0x3b, 0x6e, 0x12, 0xf6, 0xbf, 0x33, 0xac, 0x0f, 0xc0, 0x60,
+		0x4d, 0x75, 0xbd, 0x51, 0xda, 0xfd, 0x47, 0x1c, 0xcc, 0x01, 0xb8, 0xd2, 0xf8, 0xff, 0x01, 0x3a,
+		0x4c, 0xc2, 0x7e, 0x9f, 0x57, 0x9a, 0xe9, 0x97, 0x3f, 0x0c, 0x79, 0xfc, 0x97, 0x3e, 0x61, 0x97,
+		0x00, 0xfe, 0x1f, 0x0c, 0x1c, 0x20, 0x70, 0xc9, 0xae, 0xd7, 0xf6, 0xf4, 0x64, 0x63, 0xdf, 0x67,
+		0x3d, 0xcd, 0xf5, 0xcb, 0x15, 0x98, 0x72, 0xbd, 0x76, 0xbb, 0xc7, 0xe2, 0xd3, 0x04, 0xf8, 0xff,
+		0xfc, 0x81, 0x7f, 0x64, 0xe1, 0x63, 0x70, 0x6f, 0xdf, 0x3a, 0xf0, 0x6c, 0x8b, 0x5c, 0x81, 0x24,
+		0x31, 0xbc, 0xcf, 0x18, 0x42, 0x90, 0xf2, 0x2a, 0x14, 0x70, 0x5b, 0x1c, 0x64, 0x23, 0x72, 0x5f,
+		0x95, 0x40, 0xf1, 0xbf, 0x98, 0x01, 0x22, 0xa0, 0xea, 0x4f, 0x7e, 0xfd, 0xdd, 0xc5, 0xd4, 0x37,
+		0xdf, 0x5d, 0x4c, 0xfd, 0xe1, 0xbb, 0x8b, 0xa9, 0xcf, 0x7c, 0x7b, 0x71, 0xec, 0x9b, 0xdf, 0x5e,
+		0x1c, 0xfb, 0xbd, 0x6f, 0x2f, 0x8e, 0x0d, 0x3e, 0x36, 0x86, 0x35, 0x6b, 0xcd, 0xa2, 0x07, 0xc6,
+		0xaf, 0x4b, 0x91, 0xe3, 0xe2, 0x8e, 0x15, 0x9c, 0xd6, 0xfa, 0x9b, 0x1c, 0xf8, 0xa9, 0x34, 0x9c,
+		0xa2, 0x1c, 0x41, 0xae, 0x6a, 0x1e, 0x0e, 0x79, 0x57, 0x67, 0x61, 0xe0, 0xc1, 0xb0, 0x74, 0x0d,
+		0x32, 0x15, 0xf3, 0x50, 0x3c, 0x45, 0xe7, 0x3c, 0xa5, 0xe7, 0x18, 0xec, 0xc9, 0xaf, 0x49, 0x9c,
+		0xde, 0x71, 0x0c, 0x7c, 0x1a, 0xce, 0x1f, 0xcf, 0xc4, 0x97, 0x2e, 0x34, 0x51, 0x16, 0x3e, 0xf7,
+		0xf6, 0xd2, 0xd8, 0x6f, 0xbc, 0xbd, 0x34, 0xf6, 0xfd, 0x77, 0x96, 0xc6, 0xde, 0xfa, 0xfd, 0xe5,
+		0xb1, 0xea, 0x41, 0xbc, 0xb5, 0x5f, 0x4b, 0x6c, 0x71, 0xae, 0x62, 0x1e, 0x92, 0x06, 0x37, 0x53,
+		0xaf, 0x8f, 0xe3, 0xf2, 0x5c, 0x7e, 0xc8, 0xbd, 0x18, 0x3f, 0xe4, 0x7e, 0x15, 0x19, 0xc6, 0x2b,
+		0xa6, 0x75, 0xcb, 0xc4, 0xb7, 0xe5, 0xee, 0xee, 0x04, 0x7d, 0xa4, 0x18, 0xfe, 0x52, 0x1a, 0x16,
+		0xfb, 0xce, 0xb3, 0x99, 0x17, 0x0c, 0x7b, 0x69, 0xa9, 0x0c, 0xb9, 0x1a, 0x77, 0xae, 0x12, 0x7e,
+		0x5b, 0x46, 0xb3, 0xcc, 0xb6, 0x4b, 0x9a, 0x9d, 0x91, 0x79, 0x12, 0x37, 0xdb, 0x54, 0x4d, 0xcb,
+		0x65, 0x4f, 0x4a, 0xd2, 0x44, 0xf5, 0x17, 0x52, 0xc7, 0xeb, 0xd3, 0x69, 0x5e, 0x12, 0x6f, 0xe6,
+		0xb9, 0xa3, 0xee, 0x01, 0x88, 0x09, 0xfc, 0xfa, 0x87, 0xce, 0xfc, 0x47, 0x35, 0xc7, 0x67, 0xd2,
+		0xb0, 0x14, 0x37, 0x07, 0x1e, 0x53, 0xae, 0xa7, 0x76, 0xed, 0x61, 0xf6, 0xb8, 0x02, 0xf9, 0x6d,
+		0xae, 0x73, 0x6c, 0x83, 0xfc, 0xe2, 0x31, 0x0d, 0x32, 0xe3, 0x17, 0xc5, 0x2d, 0xf2, 0xa1, 0x64,
+		0x8b, 0xf8, 0x4d, 0x78, 0x00, 0x93, 0xfc, 0xb9, 0x0c, 0x9c, 0xd2, 0x2c, 0xb7, 0x6b, 0xb9, 0x0a,
+		0x75, 0x7e, 0x9a, 0x60, 0xc6, 0x28, 0x84, 0xb3, 0x46, 0xb8, 0x1a, 0xb9, 0x06, 0x33, 0x64, 0x82,
+		0x20, 0x87, 0xc2, 0x64, 0x4e, 0x4e, 0x5c, 0x46, 0xff, 0xd5, 0xbf, 0x1d, 0x27, 0x03, 0x6a, 0xda,
+		0x07, 0x92, 0xa7, 0x5e, 0xb6, 0x61, 0x5e, 0xef, 0xda, 0x06, 0x22, 0xd7, 0x63, 0x8a, 0x9f, 0x97,
+		0xcc, 0xf7, 0x0d, 0xc6, 0x37, 0x17, 0xc0, 0x1b, 0x1c, 0x5d, 0x5e, 0x87, 0x59, 0xfc, 0x64, 0x93,
+		0x1d, 0xa1, 0x4c, 0x98, 0xbc, 0x78, 0x05, 0x05, 0x86, 0xf4, 0xd9, 0xaa, 0x2f, 0x0f, 0xeb, 0xdb,
+		0xd7, 0x1f, 0x0f, 0x75, 0x9a, 0x83, 0xf0, 0xcd, 0x95, 0x89, 0xbc, 0x5b, 0x96, 0x73, 0xc0, 0xcc,
+		0xfb, 0x0c, 0x2d, 0x8a, 0x77, 0xc2, 0x4f, 0x67, 0x60, 0x91, 0x66, 0x9c, 0xdf, 0x55, 0x5d, 0x74,
+		0xfe, 0xe6, 0x73, 0xbb, 0xc8, 0x53, 0x9f, 0x3b, 0xaf, 0x59, 0x3a, 0x1f, 0xa6, 0x73, 0xac, 0x5f,
+		0x70, 0xfe, 0x0a, 0xcb, 0x1f, 0x32, 0x67, 0xad, 0x41, 0x76, 0xd5, 0xd2, 0x4d, 0xec, 0x91, 0x6d,
+		0x64, 0x5a, 0x5d, 0x36, 0x63, 0xd1, 0x84, 0xf8, 0x28, 0x4c, 0xa8, 0x5d, 0xab, 0x67, 0x7a, 0xf4,
+		0x66, 0xaf, 0x3a, 0xf5, 0xf5, 0x7b, 0x4b, 0x63, 0xff, 0xfe, 0xde, 0x52, 0xa6, 0x61, 0x7a, 0x32,
+		0xcb, 0x2a, 0x67, 0xbf, 0xfb, 0xf6, 0x52, 0x4a, 0xba, 0x0e, 0x93, 0x35, 0xa4, 0x3d, 0x08, 0x57,
+		0x0d, 0x69, 0x31, 0xae, 0xa7, 0x20, 0xd7, 0x30, 0x3d, 0xfa, 0x34, 0xf1, 0x19, 0xc8, 0xe8, 0x26,
+		0x7d, 0x40, 0x2d, 0x56, 0x3e, 0x96, 0x63, 0xd5, 0x1a, 0xd2, 0x7c, 0xd5, 0x36, 0xd2, 0x4a, 0xa9,
+		0x7e, 0x7a, 0x2c, 0xaf, 0xd6, 0x7e, 0xef, 0x3f, 0x2e, 0x8e, 0xbd, 0xf5, 0xee, 0xe2, 0xd8, 0xd0,
+		0x9e, 0x08, 0xaf, 0x14, 0xcc, 0xc4, 0xac, 0x0b, 0xdc, 0xf6, 0xc1, 0x79, 0x2f, 0x32, 0x16, 0xbe,
+		0x9c, 0x85, 0x33, 0xe4, 0x45, 0x12, 0xa7, 0xab, 0x9b, 0xde, 0x79, 0xcd, 0x39, 0xb4, 0x3d, 0xb2,
+		0xb4, 0x58, 0x7b, 0xac, 0x17, 0x66, 0x83, 0xec, 0x15, 0x9a, 0x3d, 0xa4, 0x0f, 0xf6, 0x60, 0xbc,
+		0x89, 0x71, 0xd8, 0x70, 0x9e, 0xe5, 0xa9, 0x06, 0x9b, 0x2e, 0x68, 0x02, 0x4b, 0xe9, 0xcb, 0x27,
+		0x69, 0x2a, 0xd5, 0xf9, 0x7b, 0x27, 0x06, 0x52, 0xf7, 0xe8, 0x33, 0xbc, 0x19, 0xb2, 0x9c, 0xe4,
+		0xb0, 0x80, 0x3c, 0xae, 0x3b, 0x0f, 0xe3, 0x6a, 0x8f, 0x5e, 0x3f, 0x67, 0xf0, 0x3a, 0x43, 0x12,
+		0xd2, 0x2b, 0x30, 0xc9, 0xae, 0xbc, 0xf0, 0x05, 0xec, 0x01, 0x3a, 0x24, 0xe5, 0x14, 0x64, 0xfc,
+		0x57, 0x5c, 0x81, 0x71, 0x52, 0x79, 0xf6, 0x72, 0x42, 0x69, 0xa5, 0xaf, 0xf6, 0x2b, 0xa4, 0x92,
+		0x32, 0x55, 0x93, 0xae, 0x43, 0xae, 0x66, 0x75, 0x75, 0xd3, 0x8a, 0xb2, 0xe5, 0x29, 0x1b, 0xa9,
+		0xb3, 0xdd, 0x63, 0x7d, 0x2d, 0xd3, 0x04, 0x7e, 0xd6, 0x8d, 0x3e, 0xd3, 0xcd, 0xae, 0xd0, 0x59,
+		0x4a, 0x5a, 0x85, 0x49, 0xc2, 0xbd, 0x65, 0xe3, 0x87, 0xc7, 0xfd, 0x07, 0xea, 0xf2, 0xec, 0x0d,
+		0x1f, 0x46, 0x9f, 0x0e, 0x2a, 0x2b, 0x42, 0xb6, 0xad, 0x7a, 0x2a, 0x6b, 0x37, 0xf9, 0x2f, 0x7d,
+		0x04, 0x72, 0x8c, 0xc4, 0x15, 0x2f, 0x40, 0xc6, 0xb2, 0x5d, 0x76, 0x09, 0xbe, 0x30, 0xac, 0x29,
+		0x5b, 0x76, 0x35, 0x8b, 0xbd, 0x44, 0xc6, 0xca, 0x55, 0x79, 0xa8, 0x5b, 0xbc, 0x14, 0x72, 0x8b,
+		0x50, 0x97, 0x87, 0xfe, 0xd2, 0x2e, 0xed, 0x73, 0x07, 0xdf, 0x59, 0xde, 0x49, 0xc3, 0x62, 0x28,
+		0xf7, 0x26, 0x72, 0xf0, 0xbe, 0x8f, 0x7a, 0x14, 0xf3, 0x16, 0x31, 0x54, 0x49, 0x96, 0x3f, 0xc4,
+		0x5d, 0x3e, 0x0c, 0x99, 0x8a, 0x6d, 0xe3, 0x57, 0x9b, 0x48, 0x5a, 0xb3, 0xa8, 0xbf, 0x64, 0x65,
+		0x3f, 0x8d, 0xf3, 0x5c, 0x6b, 0xcf, 0xbb, 0xa5, 0x3a, 0xfe, 0x6b, 0x4f, 0x3c, 0x2d, 0x5d, 0x86,
+		0xfc, 0xaa, 0x65, 0xba, 0xc8, 0x74, 0x7b, 0x64, 0x21, 0xda, 0x35, 0x2c, 0xed, 0x80, 0x31, 0xd0,
+		0x04, 0x36, 0xb8, 0x6a, 0xdb, 0x04, 0x99, 0x95, 0xf1, 0x5f, 0x3a, 0x2e, 0xab, 0xad, 0xa1, 0x26,
+		0xba, 0x7c, 0x7c, 0x13, 0xb1, 0x46, 0xfa, 0x36, 0xfa, 0xdf, 0x29, 0x78, 0xb8, 0x7f, 0x40, 0x1d,
+		0xa0, 0x43, 0xf7, 0xb8, 0xe3, 0xe9, 0x35, 0xc8, 0x37, 0xc9, 0xbb, 0xc7, 0xaf, 0xa0, 0x43, 0x71,
+		0x01, 0x26, 0x51, 0xfb, 0xc2, 0xc5, 0x8b, 0xcf, 0x5d, 0xa6, 0xde, 0x7e, 0x6d, 0x4c, 0xe6, 0x02,
+		0x71, 0x11, 0xf2, 0x2e, 0xd2, 0xec, 0x0b, 0x17, 0x2f, 0x1d, 0x3c, 0x47, 0xdd, 0xeb, 0xda, 0x98,
+		0x1c, 0x88, 0xca, 0x39, 0xdc, 0xea, 0xef, 0xbe, 0xb3, 0x94, 0xaa, 0x8e, 0x43, 0xc6, 0xed, 0x75,
+		0x3f, 0x50, 0x1f, 0xf9, 0xfc, 0x38, 0x2c, 0x87, 0x91, 0x64, 0xb5, 0xbe, 0xa9, 0x1a, 0x7a, 0x5b,
+		0x0d, 0xde, 0x1a, 0x17, 0x42, 0x36, 0x20, 0x1a, 0x83, 0x4d, 0xb0, 0x70, 0xa4, 0x25, 0xa5, 0xdf,
+		0x4a, 0x41, 0xe1, 0x06, 0x67, 0xc6, 0xaf, 0x99, 0x5f, 0x01, 0xf0, 0x4b, 0xe2, 0xc3, 0xe6, 0xf4,
+		0x4a, 0xbc, 0xac, 0x15, 0x1f, 0x23, 0x87, 0xd4, 0xc5, 0x17, 0x89, 0x23, 0xda, 0x96, 0xcb, 0x5e,
+		0x85, 0x49, 0x80, 0xfa, 0xca, 0xf8, 0xd1, 0x26, 0x32, 0xc3, 0x29, 0x37, 0x2d, 0x0f, 0xdf, 0xec,
+		0xda, 0xd6, 0x2d, 0xf6, 0x82, 0x61, 0x46, 0x16, 0x48, 0xce, 0x0d, 0x92, 0xd1, 0xc4, 0x72, 0x5c,
+		0xe9, 0xbc, 0xcf, 0x82, 0x63, 0x2b, 0xb5, 0xdd, 0x76, 0x90, 0xeb, 0xb2, 0x49, 0x8c, 0x27, 0xf1,
+		0xfb, 0x37, 0x76, 0x6f, 0x57, 0xe1, 0x33, 0x06, 0x7e, 0x83, 0x69, 0xc0, 0xf8, 0xe7, 0xfe, 0xc1,
+		0x66, 0x80, 0x09, 0xbb, 0xb7, 0x8b, 0xbd, 0xe5, 0x11, 0x28, 0x0c, 0xa8, 0xcc, 0xd4, 0xcd, 0xa0,
+		0x1e, 0xe4, 0x95, 0x77, 0xd6, 0x02, 0xc5, 0x76, 0x74, 0xcb, 0xd1, 0xbd, 0x43, 0xf2, 0x24, 0x4b,
+		0x46, 0x16, 0x78, 0x46, 0x93, 0xc9, 0xa5, 0x03, 0x28, 0xb6, 0x48, 0x6c, 0x11, 0xd4, 0xfc, 0x62,
+		0x50, 0xbf, 0x54, 0x72, 0xfd, 0x86, 0xd6, 0x2c, 0xdd, 0x57, 0xb3, 0xea, 0x47, 0x87, 0x7a, 0xe7,
+		0x8b, 0xc7, 0xf7, 0xce, 0xe8, 0x6a, 0xf7, 0x47, 0xa7, 0xe0, 0xe1, 0x78, 0x66, 0x64, 0xfa, 0x1a,
+		0xd5, 0x31, 0x93, 0x42, 0xea, 0x85, 0xa3, 0x17, 0xd5, 0x85, 0x84, 0x69, 0x74, 0x21, 0x71, 0x08,
+		0x49, 0x97, 0x61, 0x1a, 0x3f, 0x92, 0xd6, 0x42, 0xde, 0x35, 0xa4, 0xb6, 0x91, 0x13, 0x5d, 0x75,
+		0xa7, 0xf9, 0xaa, 0x2b, 0x42, 0x96, 0x2c, 0xad, 0x74, 0xd5, 0x21, 0xff, 0xa5, 0x7d, 0xc8, 0x62,
+		0x68, 0xb0, 0x22, 0x33, 0x04, 0x49, 0x60, 0xe9, 0xee, 0xa1, 0x87, 0x5c, 0xbe, 0xb9, 0x23, 0x09,
+		0xf1, 0x05, 0xbe, 0xae, 0x66, 0x8e, 0x5e, 0x57, 0x99, 0x23, 0xb2, 0xd5, 0xd5, 0x80, 0xc9, 0x2a,
+		0x9e, 0x8a, 0x1b, 0x35, 0xbf, 0x22, 0xa9, 0xa0, 0x22, 0xe2, 0x06, 0x14, 0x6d, 0xd5, 0xf1, 0xc8,
+		0x63, 0xfc, 0xfb, 0xa4, 0x15, 0xcc, 0xd7, 0x97, 0xfa, 0x47, 0x5e, 0xa4, 0xb1, 0xac, 0x94, 0x69,
+		0x3b, 0x2c, 0x94, 0xfe, 0x53, 0x16, 0x26, 0x98, 0x31, 0x3e, 0x0c, 0x93, 0xcc, 0xac, 0xcc, 0x3b,
+		0xcf, 0xac, 0xf4, 0x2f, 0x4c, 0x2b, 0xfe, 0x02, 0xc2, 0xf8, 0x38, 0x46, 0x7c, 0x02, 0x72, 0xda,
+		0xbe, 0xaa, 0x9b, 0x8a, 0xde, 0xe6, 0x61, 0xde, 0xbb, 0xf7, 0x96, 0x26, 0x57, 0xb1, 0xac, 0x51,
+		0x93, 0x27, 0x49, 0x66, 0xa3, 0x8d, 0x23, 0x81, 0x7d, 0xa4, 0x77, 0xf6, 0x3d, 0x36, 0xc2, 0x58,
+		0x0a, 0x7f, 0xef, 0x02, 0x3b, 0x04, 0x7b, 0xc9, 0x6b, 0xa1, 0x2f, 0xd8, 0xf6, 0x77, 0x3c, 0xd5,
+		0x1c, 0x2e, 0xf8, 0x33, 0x7f, 0xb0, 0x94, 0x92, 0x09, 0x42, 0x5c, 0x85, 0x69, 0x43, 0x75, 0x3d,
+		0x85, 0xac, 0x60, 0xb8, 0xf8, 0x71, 0x42, 0x71, 0xaa, 0xdf, 0x20, 0xcc, 0xb0, 0xac, 0xea, 0x53,
+		0x18, 0x45, 0x45, 0x6d, 0xfc, 0x0e, 0x0a, 0x21, 0xc1, 0x4f, 0xe2, 0xe9, 0x1e, 0x8d, 0xad, 0x26,
+		0x88, 0xdd, 0x67, 0xb0, 0x7c, 0x95, 0x88, 0x49, 0x84, 0x75, 0x1a, 0xf2, 0xe4, 0xb5, 0x12, 0xa2,
+		0x42, 0x1f, 0xa1, 0xcc, 0x61, 0x01, 0xc9, 0x7c, 0x12, 0x8a, 0xc1, 0xfc, 0x48, 0x55, 0x72, 0x94,
+		0x25, 0x10, 0x13, 0xc5, 0x67, 0x61, 0xde, 0x44, 0xb7, 0x3d, 0x25, 0x10, 0x53, 0xed, 0x3c, 0xd1,
+		0x16, 0x71, 0xde, 0x8d, 0x28, 0xe2, 0x71, 0x98, 0xd1, 0xb8, 0xf1, 0xa9, 0x2e, 0x10, 0xdd, 0x69,
+		0x5f, 0x4a, 0xd4, 0x4e, 0x41, 0x4e, 0xb5, 0x6d, 0xaa, 0x30, 0xc5, 0xe6, 0x47, 0xdb, 0x26, 0x59,
+		0xe7, 0x60, 0x96, 0xb4, 0xd1, 0x41, 0x6e, 0xcf, 0xf0, 0x18, 0x49, 0x81, 0xe8, 0x14, 0x71, 0x86,
+		0x4c, 0xe5, 0x44, 0xf7, 0x51, 0x98, 0x46, 0x37, 0xf5, 0x36, 0x32, 0x35, 0x44, 0xf5, 0xa6, 0x89,
+		0x5e, 0x81, 0x0b, 0x89, 0xd2, 0x53, 0xe0, 0xcf, 0x7b, 0x0a, 0x9f, 0x93, 0x67, 0x28, 0x1f, 0x97,
+		0x57, 0xa8, 0x58, 0x2a, 0x41, 0xb6, 0xa6, 0x7a, 0x2a, 0x0e, 0x30, 0xbc, 0xdb, 0x74, 0xa1, 0x29,
+		0xc8, 0xf8, 0xaf, 0xf4, 0xdd, 0x34, 0x64, 0x6f, 0x58, 0x1e, 0x12, 0x9f, 0x0f, 0x05, 0x80, 0x33,
+		0x83, 0xfc, 0xb9, 0xa5, 0x77, 0x4c, 0xd4, 0xde, 0x70, 0x3b, 0xa1, 0x77, 0xc0, 0x03, 0x77, 0x4a,
+		0x47, 0xdc, 0x69, 0x1e, 0xc6, 0x1d, 0xab, 0x67, 0xb6, 0xf9, 0xd3, 0x87, 0x24, 0x21, 0xd6, 0x21,
+		0xe7, 0x7b, 0x49, 0x36, 0xc9, 0x4b, 0x8a, 0xd8, 0x4b, 0xb0, 0x0f, 0x33, 0x81, 0x3c, 0xb9, 0xcb,
+		0x9c, 0xa5, 0x0a, 0x79, 0x7f, 0xf2, 0x2a, 0x8d, 0x1f, 0xc3, 0x61, 0x03, 0x18, 0x5e, 0x4c, 0xfc,
+		0xbe, 0xf7, 0x8d, 0x47, 0x3d, 0x4e, 0xf0, 0x33, 0x98, 0xf5, 0x22, 0x6e, 0xc5, 0xde, 0x47, 0x9f,
+		0x24, 0xed, 0x0a, 0xdc, 0x8a, 0xbe, 0x93, 0xfe, 0x30, 0x7e, 0x74, 0xa4, 0x63, 0xaa, 0x5e, 0xcf,
+		0x41, 0xcc, 0xf3, 0x02, 0x01, 0x7e, 0xd7, 0x60, 0x82, 0x7a, 0x72, 0xc8, 0x6e, 0xa9, 0xc1, 0x76,
+		0x4b, 0x0f, 0xb3, 0x5b, 0xe6, 0xc1, 0xed, 0x56, 0x01, 0xf0, 0x2b, 0xe3, 0xb2, 0xd7, 0x84, 0x07,
+		0x44, 0x0c, 0xb4, 0x8a, 0x2d, 0xbd, 0xc3, 0x06, 0x6a, 0x08, 0x24, 0xfd, 0x87, 0x14, 0xe4, 0xfd,
+		0x7c, 0xb1, 0x02, 0xd3, 0xbc, 0x5e, 0xca, 0x9e, 0xa1, 0x76, 0x98, 0xef, 0x9c, 0x19, 0x5a, 0xb9,
+		0xab, 0x86, 0xda, 0x91, 0xa7, 0x58, 0x7d, 0x70, 0x62, 0x70, 0x3f, 0xa4, 0x87, 0xf4, 0x43, 0xa4,
+		0xe3, 0x33, 0x0f, 0xd6, 0xf1, 0x91, 0x2e, 0xca, 0xc6, 0xbb, 0xe8, 0xb7, 0xd3, 0x64, 0x33, 0x63,
+		0x5b, 0xae, 0x6a, 0xfc, 0x28, 0x46, 0xc4, 0x69, 0xc8, 0xdb, 0x96, 0xa1, 0xd0, 0x1c, 0xfa, 0x54,
+		0x6e, 0xce, 0xb6, 0x0c, 0xb9, 0xaf, 0xdb, 0xc7, 0x7f, 0x48, 0xc3, 0x65, 0xe2, 0x87, 0x60, 0xb5,
+		0xc9, 0xb8, 0xd5, 0x1c, 0x28, 0x50, 0x53, 0xb0, 0xb5, 0xec, 0x59, 0x6c, 0x03, 0xfc, 0xaf, 0x94,
+		0xea, 0x5f, 0x7b, 0x69, 0xb5, 0xa9, 0xa6, 0x3c, 0xb1, 0xef, 0x23, 0xe8, 0xd4, 0x5f, 0x4a, 0x0f,
+		0x43, 0x50, 0xb7, 0x93, 0x99, 0x9e, 0xf4, 0x8b, 0x29, 0x80, 0x75, 0x6c, 0x59, 0xd2, 0x5e, 0xbc,
+		0x0a, 0xb9, 0xa4, 0x0a, 0x4a, 0xa4, 0xe4, 0xc5, 0x61, 0x9d, 0xc6, 0xca, 0x2f, 0xb8, 0xe1, 0x7a,
+		0xaf, 0xc2, 0x74, 0xe0, 0x8c, 0x2e, 0xe2, 0x95, 0x59, 0x3c, 0x22, 0xaa, 0x6e, 0x21, 0x4f, 0x2e,
+		0xdc, 0x0c, 0xa5, 0xa4, 0x7f, 0x9a, 0x82, 0x3c, 0xa9, 0x13, 0x7e, 0xc9, 0x31, 0xd2, 0x87, 0xa9,
+		0x07, 0xef, 0xc3, 0x33, 0x00, 0x94, 0x06, 0x5f, 0xa4, 0x31, 0xcf, 0xca, 0x13, 0x09, 0xbe, 0x1e,
+		0x13, 0x2f, 0xf9, 0x06, 0xcf, 0x1c, 0x6d, 0x70, 0x1e, 0x75, 0x33, 0xb3, 0x3f, 0x04, 0x93, 0xe4,
+		0xb3, 0x3a, 0xb7, 0x5d, 0x16, 0x48, 0xe3, 0x77, 0xe9, 0xb7, 0x6f, 0xbb, 0xd2, 0x1b, 0x30, 0xb9,
+		0x7d, 0x9b, 0x9e, 0x8d, 0x9c, 0x86, 0xbc, 0x63, 0x59, 0x6c, 0x4d, 0xa6, 0xb1, 0x50, 0x0e, 0x0b,
+		0xc8, 0x12, 0xc4, 0xcf, 0x03, 0xd2, 0xc1, 0x79, 0x40, 0x70, 0xa0, 0x91, 0x19, 0xe9, 0x40, 0xe3,
+		0xdc, 0xbf, 0x4b, 0xc1, 0x54, 0x68, 0x7e, 0x10, 0x9f, 0x83, 0x13, 0xd5, 0xf5, 0xad, 0xd5, 0x57,
+		0x94, 0x46, 0x4d, 0xb9, 0xba, 0x5e, 0x59, 0x0b, 0xde, 0x3b, 0x59, 0x38, 0x79, 0xe7, 0xee, 0xb2,
+		0x18, 0xd2, 0xdd, 0x31, 0x0f, 0xf0, 0x61, 0xa9, 0x78, 0x1e, 0xe6, 0xa3, 0x90, 0x4a, 0xb5, 0x85,
+		0x5f, 0x42, 0x49, 0x2d, 0x9c, 0xb8, 0x73, 0x77, 0x79, 0x36, 0x84, 0xa8, 0xec, 0xba, 0xc8, 0xf4,
+		0xfa, 0x01, 0xab, 0x5b, 0x1b, 0x1b, 0x8d, 0x6d, 0x21, 0xdd, 0x07, 0x60, 0x13, 0xf6, 0x53, 0x30,
+		0x1b, 0x05, 0x6c, 0x36, 0xd6, 0x85, 0xcc, 0x82, 0x78, 0xe7, 0xee, 0xf2, 0x4c, 0x48, 0x7b, 0x53,
+		0x37, 0x16, 0x72, 0x3f, 0xf3, 0x2b, 0x8b, 0x63, 0xbf, 0xfa, 0x37, 0x16, 0x53, 0xb8, 0x65, 0xd3,
+		0x91, 0x39, 0x42, 0x7c, 0x1a, 0x1e, 0x6a, 0x35, 0xd6, 0x36, 0xeb, 0x35, 0x65, 0xa3, 0xb5, 0xa6,
+		0xd0, 0xef, 0x6d, 0xf8, 0xad, 0x2b, 0xde, 0xb9, 0xbb, 0x3c, 0xc5, 0x9a, 0x34, 0x4c, 0xbb, 0x29,
+		0xd7, 0x6f, 0x6c, 0x6d, 0xd7, 0x85, 0x14, 0xd5, 0x6e, 0x3a, 0xe8, 0xa6, 0xe5, 0xd1, 0xef, 0x6e,
+		0x3d, 0x0b, 0xa7, 0x06, 0x68, 0xfb, 0x0d, 0x9b, 0xbd, 0x73, 0x77, 0x79, 0xba, 0x89, 0xaf, 0xa8,
+		0x71, 0x83, 0x08, 0x62, 0x05, 0x4a, 0xfd, 0x88, 0xad, 0xe6, 0x56, 0xab, 0xb2, 0x2e, 0x2c, 0x2f,
+		0x08, 0x77, 0xee, 0x2e, 0x17, 0xf8, 0x64, 0x88, 0xf5, 0x83, 0x96, 0x7d, 0x90, 0x3b, 0x9e, 0x5f,
+		0xbb, 0x00, 0x8f, 0xb1, 0x33, 0x40, 0xd7, 0x53, 0x0f, 0x74, 0xb3, 0xe3, 0x9f, 0xb4, 0xb2, 0x34,
+		0xdb, 0xf9, 0x9c, 0xa4, 0x5a, 0x2b, 0x5c, 0x7a, 0xe4, 0x79, 0xeb, 0xc2, 0xf0, 0x5b, 0xa6, 0x85,
+		0x84, 0xcb, 0x97, 0xe4, 0xad, 0xd3, 0xf0, 0xb3, 0xf9, 0x85, 0x84, 0x13, 0xe3, 0x85, 0x23, 0x37,
+		0x77, 0xd2, 0xa7, 0x52, 0x30, 0x73, 0x4d, 0x77, 0x3d, 0xcb, 0xd1, 0x35, 0xd5, 0x20, 0x6f, 0x9b,
+		0x5c, 0x1a, 0x75, 0x6e, 0x8d, 0x0d, 0xf5, 0x97, 0x61, 0xe2, 0xa6, 0x6a, 0xd0, 0x49, 0x2d, 0x43,
+		0x3e, 0x8e, 0x31, 0xd8, 0x7c, 0xc1, 0xd4, 0xc6, 0x09, 0x28, 0x4c, 0xfa, 0xf5, 0x34, 0x14, 0xc9,
+		0x60, 0x70, 0xe9, 0x67, 0x93, 0xf0, 0x1e, 0xab, 0x0a, 0x59, 0x47, 0xf5, 0xd8, 0xa1, 0x61, 0x75,
+		0x85, 0x9d, 0xfc, 0x3e, 0x91, 0x7c, 0x9a, 0xbb, 0x82, 0x0f, 0x87, 0x09, 0x56, 0xfc, 0x09, 0xc8,
+		0x75, 0xd5, 0xdb, 0x0a, 0xe1, 0xa1, 0x3b, 0x97, 0xca, 0xf1, 0x78, 0xee, 0xdf, 0x5b, 0x2a, 0x1e,
+		0xaa, 0x5d, 0xa3, 0x2c, 0x71, 0x1e, 0x49, 0x9e, 0xec, 0xaa, 0xb7, 0x71, 0x15, 0x45, 0x1b, 0x8a,
+		0x58, 0xaa, 0xed, 0xab, 0x66, 0x07, 0xd1, 0x42, 0xc8, 0x11, 0x68, 0xf5, 0xda, 0xb1, 0x0b, 0x39,
+		0x19, 0x14, 0x12, 0xa2, 0x93, 0xe4, 0xe9, 0xae, 0x7a, 0x7b, 0x95, 0x08, 0x70, 0x89, 0xe5, 0x1c,
+		0xbe, 0x54, 0x24, 0xa7, 0xe9, 0xdf, 0x4a, 0x01, 0x04, 0x16, 0x13, 0x7f, 0x02, 0x04, 0xcd, 0x4f,
+		0x11, 0xac, 0xcb, 0xfa, 0xf0, 0xc9, 0x61, 0x7d, 0x11, 0xb3, 0x37, 0x5d, 0x9b, 0xbf, 0x79, 0x6f,
+		0x29, 0x25, 0x17, 0xb5, 0x58, 0x57, 0xfc, 0x38, 0x4c, 0xf5, 0xec, 0xb6, 0xea, 0x21, 0x85, 0xec,
+		0xe3, 0xd2, 0x89, 0xeb, 0xfc, 0x22, 0xe6, 0xba, 0x7f, 0x6f, 0x49, 0xa4, 0xcd, 0x0a, 0x81, 0x25,
+		0xb2, 0xfa, 0x03, 0x95, 0x60, 0x40, 0xa8, 0x4d, 0x7f, 0x90, 0x82, 0xa9, 0x5a, 0xe8, 0xa9, 0xaf,
+		0x12, 0x4c, 0x76, 0x2d, 0x53, 0x3f, 0x60, 0xfe, 0x98, 0x97, 0x79, 0x12, 0x1f, 0x85, 0xd2, 0x17,
+		0xf0, 0xbc, 0x43, 0x7e, 0x14, 0xca, 0xd3, 0x18, 0x75, 0x0b, 0xed, 0xba, 0x3a, 0xef, 0x0d, 0x99,
+		0x27, 0xc5, 0xab, 0xf8, 0x1b, 0x20, 0x5a, 0x0f, 0x9f, 0xe1, 0x28, 0x9a, 0x65, 0x7a, 0xaa, 0xe6,
+		0xd1, 0x57, 0xb9, 0xaa, 0xa7, 0xef, 0xdf, 0x5b, 0x7a, 0x88, 0xd6, 0x35, 0xae, 0x21, 0xc9, 0x45,
+		0x2e, 0x5a, 0xa5, 0x12, 0x5c, 0x42, 0x1b, 0x79, 0xaa, 0x6e, 0xb8, 0x25, 0x7a, 0x31, 0xc4, 0x93,
+		0x38, 0x10, 0xb3, 0x11, 0x72, 0x5c, 0xf6, 0xc9, 0x2a, 0x9a, 0x08, 0xb5, 0xf0, 0x2b, 0x93, 0xe1,
+		0xe3, 0xae, 0xab, 0x20, 0x58, 0x36, 0x72, 0x22, 0xe1, 0x69, 0x2a, 0x5e, 0x9f, 0xb8, 0x86, 0x24,
+		0x17, 0xb9, 0x88, 0x87, 0xae, 0x1e, 0x08, 0xfe, 0x46, 0x51, 0xb1, 0x7b, 0xbb, 0xc1, 0x29, 0xd9,
+		0x7c, 0x5f, 0x1f, 0x55, 0xcc, 0xc3, 0xea, 0xf3, 0x01, 0x7b, 0x1c, 0x27, 0x7d, 0xe3, 0x77, 0x9e,
+		0x99, 0x67, 0x0e, 0x13, 0x9c, 0x5a, 0xe1, 0x23, 0xab, 0xa2, 0xaf, 0xda, 0x24, 0x9a, 0x38, 0x18,
+		0x7d, 0x43, 0xd5, 0x0d, 0xfe, 0xa2, 0xb2, 0xcc, 0x52, 0x62, 0x19, 0x26, 0x5c, 0x4f, 0xf5, 0x7a,
+		0x2e, 0xfb, 0x7c, 0x98, 0x34, 0xcc, 0x01, 0xab, 0x96, 0xd9, 0x6e, 0x11, 0x4d, 0x99, 0x21, 0xc4,
+		0xab, 0x30, 0xe1, 0x59, 0x07, 0xc8, 0x64, 0x86, 0x3d, 0xd6, 0xa8, 0x27, 0xb7, 0x57, 0x14, 0x8d,
+		0x2d, 0xd2, 0x46, 0x06, 0xea, 0xd0, 0x60, 0x6b, 0x5f, 0x75, 0x10, 0xeb, 0x92, 0x6a, 0xe3, 0xd8,
+		0x43, 0x93, 0x59, 0x2a, 0xce, 0x27, 0xc9, 0x45, 0x5f, 0xd4, 0x22, 0x12, 0xf1, 0x95, 0xc8, 0x43,
+		0x8b, 0xec, 0x53, 0x7b, 0x8f, 0x0e, 0x6b, 0x7e, 0xc8, 0xd3, 0xf9, 0xa9, 0x45, 0x08, 0x8d, 0x9d,
+		0xa3, 0x67, 0xee, 0x5a, 0x26, 0x79, 0x9b, 0x90, 0x45, 0xfd, 0x78, 0xd7, 0x97, 0x09, 0x3b, 0x47,
+		0x5c, 0x43, 0x92, 0x8b, 0xbe, 0xe8, 0x1a, 0x91, 0x88, 0x6d, 0x98, 0x09, 0xb4, 0xc8, 0xf0, 0xcd,
+		0x27, 0x0e, 0xdf, 0x47, 0xd8, 0xf0, 0x3d, 0x11, 0x2f, 0x25, 0x18, 0xc1, 0xd3, 0xbe, 0x10, 0xc3,
+		0xc4, 0x6b, 0x00, 0xc1, 0xa4, 0x41, 0x4e, 0x2f, 0xa6, 0x2e, 0x48, 0xc9, 0x33, 0x0f, 0xdf, 0x05,
+		0x06, 0x58, 0xf1, 0x13, 0x30, 0xd7, 0xd5, 0x4d, 0xc5, 0x45, 0xc6, 0x9e, 0xc2, 0x0c, 0x8c, 0x29,
+		0xc9, 0xc7, 0x60, 0xaa, 0xeb, 0xc7, 0xf3, 0x87, 0xfb, 0xf7, 0x96, 0x16, 0xd8, 0xc4, 0xda, 0x4f,
+		0x29, 0xc9, 0xb3, 0x5d, 0xdd, 0x6c, 0x21, 0x63, 0xaf, 0xe6, 0xcb, 0xca, 0x85, 0x9f, 0x79, 0x7b,
+		0x69, 0x8c, 0x0d, 0xd7, 0x31, 0xe9, 0x12, 0x39, 0x51, 0x67, 0xc3, 0x0c, 0xb9, 0x78, 0xa7, 0xa2,
+		0xf2, 0x04, 0x39, 0xe7, 0xc8, 0xcb, 0x81, 0x80, 0x0e, 0xf3, 0xb7, 0x7e, 0x7f, 0x39, 0x25, 0x7d,
+		0x25, 0x05, 0x13, 0xb5, 0x1b, 0x4d, 0x55, 0x77, 0xc4, 0x06, 0xcc, 0x06, 0x9e, 0x13, 0x1d, 0xe4,
+		0x0f, 0xdf, 0xbf, 0xb7, 0x54, 0x8a, 0x3b, 0x97, 0x3f, 0xca, 0x03, 0x07, 0xe6, 0xc3, 0xbc, 0x31,
+		0x6c, 0x3b, 0x1b, 0xa1, 0xea, 0x53, 0x91, 0xfa, 0x37, 0xbb, 0xb1, 0x66, 0xd6, 0x61, 0x92, 0xd6,
+		0x16, 0xbf, 0xc1, 0x3a, 0x6e, 0xe3, 0x3f, 0xec, 0xba, 0x60, 0x71, 0xa8, 0xf3, 0x12, 0x7d, 0xff,
+		0x78, 0x13, 0x43, 0xa4, 0xcf, 0xa6, 0x01, 0x6a, 0x37, 0x6e, 0x6c, 0x3b, 0xba, 0x6d, 0x20, 0xef,
+		0x87, 0xd9, 0xf2, 0x6d, 0x38, 0x11, 0x34, 0xcb, 0x75, 0xb4, 0x58, 0xeb, 0x97, 0xef, 0xdf, 0x5b,
+		0x7a, 0x38, 0xde, 0xfa, 0x90, 0x9a, 0x24, 0xcf, 0x05, 0xbb, 0x28, 0x47, 0x1b, 0xc8, 0xda, 0x76,
+		0x3d, 0x9f, 0x35, 0x33, 0x9c, 0x35, 0xa4, 0x16, 0x66, 0xad, 0xb9, 0xde, 0x60, 0xd3, 0xb6, 0x60,
+		0x2a, 0x30, 0x09, 0xfe, 0x6e, 0x53, 0xce, 0x63, 0xff, 0x99, 0x85, 0xa5, 0xe1, 0x16, 0xe6, 0x30,
+		0x66, 0x65, 0x1f, 0x29, 0xfd, 0x71, 0x0a, 0x20, 0xf0, 0xd9, 0x3f, 0x9d, 0x2e, 0x86, 0xa7, 0x72,
+		0x36, 0xf1, 0x66, 0x1e, 0x28, 0x80, 0x63, 0xe8, 0x98, 0x3d, 0xbf, 0x91, 0x82, 0xf9, 0xa0, 0xe9,
+		0xfe, 0x8c, 0x85, 0x3f, 0xe8, 0x01, 0x9a, 0x43, 0x9e, 0x2a, 0x53, 0xd8, 0x97, 0x6f, 0x8e, 0x9e,
+		0xe2, 0xce, 0xb0, 0x29, 0x6e, 0x96, 0xad, 0x83, 0x3e, 0x96, 0x4e, 0x6f, 0x79, 0x26, 0xa8, 0x78,
+		0x98, 0x99, 0x46, 0x2b, 0x84, 0x39, 0x7d, 0x5c, 0xe6, 0x00, 0xcb, 0x98, 0x99, 0xa0, 0xe2, 0x49,
+		0x3f, 0x9b, 0xc6, 0x5f, 0x2e, 0x60, 0xd3, 0xe8, 0x9f, 0xfa, 0x0e, 0x6d, 0xc2, 0x24, 0x32, 0x3d,
+		0x47, 0x27, 0x3d, 0x8a, 0x5d, 0xf7, 0xd9, 0x61, 0xae, 0x3b, 0xa0, 0x4d, 0xe4, 0xdb, 0x3e, 0xfc,
+		0x5e, 0x81, 0xd1, 0xc4, 0xba, 0xf6, 0xd3, 0x19, 0x28, 0x0d, 0x43, 0x8a, 0xab, 0x50, 0x24, 0x3d,
+		0x82, 0xa3, 0xdb, 0xf0, 0xe1, 0x66, 0x75, 0x21, 0x08, 0x9e, 0x63, 0x0a, 0x92, 0x3c, 0xc3, 0x25,
+		0x6c, 0x29, 0xec, 0x00, 0x8e, 0x6c, 0xf1, 0x18, 0xc2, 0x5a, 0x23, 0x86, 0xb2, 0x12, 0xeb, 0x4e,
+		0x5e, 0x48, 0x94, 0x80, 0xf6, 0xe9, 0x4c, 0x20, 0x25, 0xab, 0xe1, 0xc7, 0xa1, 0xa8, 0x9b, 0xba,
+		0xa7, 0xab, 0x86, 0xb2, 0xab, 0x1a, 0xaa, 0xa9, 0x3d, 0xc8, 0xc6, 0x80, 0xae, 0x5f, 0xac, 0xd8,
+		0x18, 0x9d, 0x24, 0xcf, 0x30, 0x49, 0x95, 0x0a, 0xc4, 0x6b, 0x30, 0xc9, 0x8b, 0xca, 0x3e, 0x50,
+		0xe8, 0xc4, 0xe1, 0xa1, 0x68, 0xf5, 0xe7, 0x32, 0x30, 0x2b, 0xa3, 0xf6, 0xff, 0xef, 0x8a, 0xe3,
+		0x75, 0xc5, 0x06, 0x00, 0x9d, 0xbb, 0xf0, 0x6a, 0x51, 0xca, 0x3e, 0xd0, 0xec, 0x97, 0xa7, 0x0c,
+		0x35, 0xd7, 0x0b, 0xf5, 0xc7, 0xbd, 0x34, 0x14, 0xc2, 0xfd, 0xf1, 0xff, 0xe8, 0x12, 0x2b, 0x36,
+		0x82, 0x99, 0x28, 0xcb, 0xbe, 0x88, 0x3a, 0x64, 0x26, 0xea, 0xf3, 0xde, 0xa3, 0xa7, 0xa0, 0xf7,
+		0x32, 0x30, 0xd1, 0x54, 0x1d, 0xb5, 0xeb, 0x8a, 0x5a, 0x5f, 0xd8, 0xcc, 0x4f, 0x58, 0xfb, 0xbe,
+		0x7b, 0xcd, 0x0e, 0x74, 0x12, 0xa2, 0xe6, 0xcf, 0x0d, 0x88, 0x9a, 0x7f, 0x0c, 0x66, 0xf0, 0x8e,
+		0x3f, 0xf4, 0x94, 0x06, 0xb6, 0xf6, 0x74, 0xf5, 0x54, 0xc0, 0x12, 0xcd, 0xa7, 0x07, 0x02, 0x37,
+		0xc2, 0x8f, 0x69, 0x4c, 0x61, 0x8d, 0x60, 0x62, 0xc6, 0xf0, 0x93, 0xc1, 0xce, 0x3b, 0x94, 0x29,
+		0xc9, 0xd0, 0x55, 0x6f, 0xd7, 0x69, 0x42, 0x5c, 0x07, 0x71, 0xdf, 0x3f, 0xfc, 0x51, 0x02, 0x73,
+		0x62, 0xfc, 0x99, 0xfb, 0xf7, 0x96, 0x4e, 0x51, 0x7c, 0xbf, 0x8e, 0x24, 0xcf, 0x06, 0x42, 0xce,
+		0xf6, 0x02, 0x00, 0x6e, 0x97, 0x42, 0x9f, 0x10, 0xa4, 0x7b, 0xb7, 0x13, 0xc1, 0x1a, 0x18, 0xe4,
+		0x49, 0x72, 0x1e, 0x27, 0x6a, 0xf8, 0xbf, 0xe8, 0x81, 0x18, 0xe4, 0x28, 0xb7, 0xc8, 0xcc, 0xe0,
+		0xb2, 0x4f, 0xc1, 0x1f, 0xb1, 0x6d, 0x32, 0xad, 0xee, 0xab, 0x44, 0xd7, 0xb7, 0xf8, 0xa9, 0x78,
+		0x31, 0x9c, 0x4c, 0x92, 0x05, 0xbf, 0x38, 0x8a, 0x09, 0xef, 0xc6, 0x3f, 0x0e, 0x53, 0xa1, 0x9c,
+		0x21, 0x4f, 0x38, 0x5e, 0x85, 0x89, 0x5b, 0xc1, 0x9d, 0xcb, 0x03, 0xc4, 0x31, 0x14, 0xcd, 0x1e,
+		0x82, 0xfc, 0xf9, 0x34, 0x88, 0xc1, 0xda, 0x26, 0x23, 0xd7, 0xc6, 0xbb, 0x6a, 0xbc, 0x7d, 0x0a,
+		0xed, 0x75, 0x52, 0x47, 0x6f, 0x9f, 0x02, 0x3c, 0xdf, 0x3e, 0x05, 0x58, 0xfc, 0xf1, 0x57, 0x3e,
+		0xcf, 0xa5, 0x99, 0xc3, 0x0e, 0x78, 0x6e, 0x74, 0x05, 0x3f, 0xd2, 0xc9, 0xc7, 0x02, 0xd3, 0x17,
+		0xbb, 0x00, 0xfe, 0xe1, 0x23, 0xff, 0x74, 0xec, 0xd3, 0xc9, 0x95, 0x08, 0x82, 0xb0, 0xea, 0xd2,
+		0xfd, 0x7b, 0x4b, 0xa7, 0x69, 0x5f, 0x04, 0x4c, 0x4f, 0x5b, 0x5d, 0xdd, 0x43, 0x5d, 0xdb, 0x3b,
+		0x94, 0xe4, 0x50, 0x01, 0x7e, 0x3f, 0x8c, 0x49, 0xff, 0x3a, 0x05, 0xa7, 0xfa, 0x46, 0xaa, 0x6f,
+		0x9b, 0x3f, 0x0b, 0xa2, 0x13, 0xca, 0x64, 0x9f, 0x0d, 0xa4, 0x36, 0x3a, 0xf6, 0xc0, 0x9f, 0x75,
+		0xe2, 0x19, 0x3f, 0xc4, 0x95, 0x93, 0x76, 0xf1, 0x3f, 0x4e, 0xc1, 0x7c, 0xb8, 0x78, 0xbf, 0x21,
+		0x9b, 0x50, 0x08, 0x97, 0xce, 0x9a, 0xf0, 0xd8, 0x28, 0x4d, 0x60, 0xb5, 0x8f, 0xe0, 0xc5, 0x8f,
+		0x06, 0xd3, 0x20, 0x3d, 0x76, 0x7d, 0x6e, 0x64, 0x6b, 0xf0, 0x3a, 0xc5, 0xa7, 0xc3, 0x2c, 0xe9,
+		0x8f, 0xff, 0x93, 0x82, 0x6c, 0xd3, 0xb2, 0x0c, 0xd1, 0x82, 0x59, 0xd3, 0xf2, 0x14, 0x3c, 0x84,
+		0x50, 0x5b, 0x61, 0x27, 0x33, 0x74, 0x7d, 0x59, 0x3d, 0x9e, 0x91, 0xbe, 0x77, 0x6f, 0xa9, 0x9f,
+		0x4a, 0x2e, 0x9a, 0x96, 0x57, 0x25, 0x92, 0x6d, 0x22, 0x10, 0x3f, 0x01, 0xd3, 0xd1, 0xc2, 0xe8,
+		0x98, 0x7b, 0xf5, 0xd8, 0x85, 0x45, 0x69, 0xee, 0xdf, 0x5b, 0x9a, 0x0f, 0xa6, 0x08, 0x5f, 0x2c,
+		0xc9, 0x85, 0xdd, 0x50, 0xe9, 0xf4, 0xc9, 0xc0, 0xef, 0xbf, 0xbd, 0x94, 0x3a, 0xf7, 0xd5, 0x14,
+		0x40, 0x70, 0x3c, 0x85, 0xef, 0x4a, 0xaa, 0x5b, 0x9b, 0x35, 0xa5, 0xb5, 0x5d, 0xd9, 0xde, 0x69,
+		0x29, 0x3b, 0x9b, 0xad, 0x66, 0x7d, 0xb5, 0x71, 0xb5, 0x51, 0xaf, 0x05, 0x37, 0x2b, 0xae, 0x8d,
+		0x34, 0x7d, 0x4f, 0x47, 0x6d, 0xf1, 0x09, 0x98, 0x8f, 0x6a, 0xe3, 0x14, 0xfe, 0xe0, 0xe7, 0x42,
+		0xe1, 0xce, 0xdd, 0xe5, 0x1c, 0x8d, 0x71, 0x11, 0x7e, 0x2e, 0xe5, 0x44, 0xbf, 0x1e, 0xfe, 0x9c,
+		0x61, 0x7a, 0x61, 0xfa, 0xce, 0xdd, 0xe5, 0xbc, 0x1f, 0x0c, 0x8b, 0x12, 0x88, 0x61, 0x4d, 0xc6,
+		0x97, 0x59, 0x80, 0x3b, 0x77, 0x97, 0x27, 0xa8, 0x01, 0x17, 0xb2, 0xf8, 0xfe, 0xa4, 0x7a, 0x75,
+		0xe8, 0xdd, 0xc9, 0xd3, 0x47, 0xda, 0xee, 0xb6, 0x7f, 0x1f, 0x12, 0xb9, 0x30, 0xf9, 0xbf, 0x03,
+		0x00, 0x45, 0x78, 0x39, 0xdd, 0xf6, 0x66, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -1982,6 +1991,9 @@ func (this *Description) Equal(that interface{}) bool {
 	if this.Details != that1.Details {
 		return false
 	}
+	if this.Peers != that1.Peers {
+		return false
+	}
 	return true
 }
 func (this *UnbondingDelegationEntry) Equal(that interface{}) bool {
@@ -2084,6 +2096,41 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.BondDenom != that1.BondDenom {
 		return false
 	}
+	if len(this.BondDenomWeights) != len(that1.BondDenomWeights) {
+		return false
+	}
+	for i := range this.BondDenomWeights {
+		if !this.BondDenomWeights[i].Equal(&that1.BondDenomWeights[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DenomWeight) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomWeight)
+	if !ok {
+		that2, ok := that.(DenomWeight)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2301,6 +2348,13 @@ func (m *Description) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Peers) > 0 {
+		i -= len(m.Peers)
+		copy(dAtA[i:], m.Peers)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Peers)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Details) > 0 {
 		i -= len(m.Details)
 		copy(dAtA[i:], m.Details)
@@ -2693,6 +2747,45 @@ func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegationTimestamps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationTimestamps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationTimestamps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintStaking(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x12
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintStaking(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *UnbondingDelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2784,12 +2877,12 @@ func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error
 	}
 	i--
 	dAtA[i] = 0x1a
-	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err8 != nil {
-		return 0, err8
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err10 != nil {
+		return 0, err10
 	}
-	i -= n8
-	i = encodeVarintStaking(dAtA, i, uint64(n8))
+	i -= n10
+	i = encodeVarintStaking(dAtA, i, uint64(n10))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -2840,12 +2933,12 @@ func (m *RedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err9 != nil {
-		return 0, err9
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n9
-	i = encodeVarintStaking(dAtA, i, uint64(n9))
+	i -= n11
+	i = encodeVarintStaking(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -2934,6 +3027,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BondDenomWeights) > 0 {
+		for iNdEx := len(m.BondDenomWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BondDenomWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.BondDenom) > 0 {
 		i -= len(m.BondDenom)
 		copy(dAtA[i:], m.BondDenom)
@@ -2956,17 +3063,57 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err10 != nil {
-		return 0, err10
+	n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err12 != nil {
+		return 0, err12
 	}
-	i -= n10
-	i = encodeVarintStaking(dAtA, i, uint64(n10))
+	i -= n12
+	i = encodeVarintStaking(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2987,6 +3134,18 @@ func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Timestamps != nil {
+		{
+			size, err := m.Timestamps.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3225,6 +3384,10 @@ func (m *Description) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
+	l = len(m.Peers)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
 	return n
 }
 
@@ -3368,6 +3531,19 @@ func (m *Delegation) Size() (n int) {
 	return n
 }
 
+func (m *DelegationTimestamps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
 func (m *UnbondingDelegation) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3475,6 +3651,27 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
+	if len(m.BondDenomWeights) > 0 {
+		for _, e := range m.BondDenomWeights {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -3488,6 +3685,10 @@ func (m *DelegationResponse) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.Balance.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if m.Timestamps != nil {
+		l = m.Timestamps.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
 	return n
 }
 
@@ -4132,9 +4333,41 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			}
 			m.Details = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peers = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
@@ -5188,6 +5421,122 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DelegationTimestamps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationTimestamps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationTimestamps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6007,6 +6356,156 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenomWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenomWeights = append(m.BondDenomWeights, DenomWeight{})
+			if err := m.BondDenomWeights[len(m.BondDenomWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6123,6 +6622,42 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamps == nil {
+				m.Timestamps = &DelegationTimestamps{}
+			}
+			if err := m.Timestamps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
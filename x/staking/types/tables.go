@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Tables returns the validators, delegations and unbonding delegations of the
+// genesis state as tables.
+func (gs GenesisState) Tables() []*sdk.Table {
+	validators := sdk.NewTable("validators",
+		sdk.TableColumn{Name: "operator_address", Type: sdk.ColumnTypeString},
+		sdk.TableColumn{Name: "moniker", Type: sdk.ColumnTypeString},
+		sdk.TableColumn{Name: "status", Type: sdk.ColumnTypeString},
+		sdk.TableColumn{Name: "jailed", Type: sdk.ColumnTypeBool},
+		sdk.TableColumn{Name: "tokens", Type: sdk.ColumnTypeString},
+		sdk.TableColumn{Name: "delegator_shares", Type: sdk.ColumnTypeString},
+		sdk.TableColumn{Name: "commission_rate", Type: sdk.ColumnTypeString},
+		sdk.TableColumn{Name: "min_self_delegation", Type: sdk.ColumnTypeString},
+	)
+	for _, v := range gs.Validators {
+		validators.AddRow(
+			v.OperatorAddress, v.Description.Moniker, v.Status.String(), v.Jailed, v.Tokens.String(),
+			v.DelegatorShares.String(), v.Commission.Rate.String(), v.MinSelfDelegation.String(),
+		)
+	}
+
+	delegations := sdk.NewTable("delegations",
+		sdk.TableColumn{Name: "delegator_address", Type: sdk.ColumnTypeString},
+		sdk.TableColumn{Name: "validator_address", Type: sdk.ColumnTypeString},
+		sdk.TableColumn{Name: "shares", Type: sdk.ColumnTypeString},
+	)
+	for _, d := range gs.Delegations {
+		delegations.AddRow(d.DelegatorAddress, d.ValidatorAddress, d.Shares.String())
+	}
+
+	unbondings := sdk.NewTable("unbonding_delegations",
+		sdk.TableColumn{Name: "delegator_address", Type: sdk.ColumnTypeString},
+		sdk.TableColumn{Name: "validator_address", Type: sdk.ColumnTypeString},
+		sdk.TableColumn{Name: "creation_height", Type: sdk.ColumnTypeInt64},
+		sdk.TableColumn{Name: "completion_time", Type: sdk.ColumnTypeInt64},
+		sdk.TableColumn{Name: "balance", Type: sdk.ColumnTypeString},
+	)
+	for _, ubd := range gs.UnbondingDelegations {
+		for _, entry := range ubd.Entries {
+			unbondings.AddRow(
+				ubd.DelegatorAddress, ubd.ValidatorAddress, entry.CreationHeight,
+				entry.CompletionTime.Unix(), entry.Balance.String(),
+			)
+		}
+	}
+
+	return []*sdk.Table{validators, delegations, unbondings}
+}
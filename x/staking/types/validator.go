@@ -2,8 +2,11 @@ package types
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
+	"net"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -26,6 +29,10 @@ const (
 	MaxWebsiteLength         = 140
 	MaxSecurityContactLength = 140
 	MaxDetailsLength         = 280
+	MaxPeersLength           = 1000
+
+	// peerIDLength is the length of the hex encoded P2P node IDs.
+	peerIDLength = 40
 )
 
 var (
@@ -35,6 +42,11 @@ var (
 	BondStatusBonded      = BondStatus_name[int32(Bonded)]
 )
 
+// MinExchangeRate is the minimum token worth of a validator share. The
+// delegations to a validator whose exchange rate falls below it, e.g. after it
+// was fully slashed, are rejected and the validator is reset.
+var MinExchangeRate = sdk.NewDecWithPrec(1, 6)
+
 var _ ValidatorI = Validator{}
 
 // NewValidator constructs a new Validator
@@ -219,13 +231,20 @@ func (d Description) UpdateDescription(d2 Description) (Description, error) {
 		d2.Details = d.Details
 	}
 
-	return NewDescription(
+	if d2.Peers == DoNotModifyDesc {
+		d2.Peers = d.Peers
+	}
+
+	description := NewDescription(
 		d2.Moniker,
 		d2.Identity,
 		d2.Website,
 		d2.SecurityContact,
 		d2.Details,
-	).EnsureLength()
+	)
+	description.Peers = d2.Peers
+
+	return description.EnsureLength()
 }
 
 // EnsureLength ensures the length of a validator's description.
@@ -250,9 +269,58 @@ func (d Description) EnsureLength() (Description, error) {
 		return d, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid details length; got: %d, max: %d", len(d.Details), MaxDetailsLength)
 	}
 
+	if len(d.Peers) > MaxPeersLength {
+		return d, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid peers length; got: %d, max: %d", len(d.Peers), MaxPeersLength)
+	}
+
+	if _, err := ParsePeers(d.Peers); err != nil {
+		return d, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
 	return d, nil
 }
 
+// ParsePeers parses the comma-separated P2P endpoints of a description, in the
+// node_id@host:port form of the persistent_peers of Tendermint. The hosts are
+// not resolved, so that the parsing is deterministic.
+func ParsePeers(peers string) ([]string, error) {
+	if strings.TrimSpace(peers) == "" {
+		return nil, nil
+	}
+
+	var (
+		parsed = make([]string, 0)
+		seen   = make(map[string]bool)
+	)
+	for _, peer := range strings.Split(peers, ",") {
+		peer = strings.TrimSpace(peer)
+
+		i := strings.Index(peer, "@")
+		if i < 0 {
+			return nil, fmt.Errorf("invalid peer %q: expected node_id@host:port", peer)
+		}
+		id, addr := peer[:i], peer[i+1:]
+		if _, err := hex.DecodeString(id); err != nil || len(id) != peerIDLength {
+			return nil, fmt.Errorf("invalid peer %q: the node ID must be %d hex characters", peer, peerIDLength)
+		}
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil || host == "" || strings.ContainsAny(host, " \t/@") {
+			return nil, fmt.Errorf("invalid peer %q: invalid address %q", peer, addr)
+		}
+		if n, err := strconv.ParseUint(port, 10, 16); err != nil || n == 0 {
+			return nil, fmt.Errorf("invalid peer %q: invalid port %q", peer, port)
+		}
+		if seen[peer] {
+			return nil, fmt.Errorf("duplicate peer %q", peer)
+		}
+
+		seen[peer] = true
+		parsed = append(parsed, peer)
+	}
+
+	return parsed, nil
+}
+
 // ABCIValidatorUpdate returns an abci.ValidatorUpdate from a staking validator type
 // with the full validator power
 func (v Validator) ABCIValidatorUpdate(r sdk.Int) abci.ValidatorUpdate {
@@ -300,6 +368,23 @@ func (v Validator) InvalidExRate() bool {
 	return v.Tokens.IsZero() && v.DelegatorShares.IsPositive()
 }
 
+// ExchangeRate returns the token worth of one share of the validator, or one
+// if it has no shares.
+func (v Validator) ExchangeRate() sdk.Dec {
+	if !v.DelegatorShares.IsPositive() {
+		return sdk.OneDec()
+	}
+
+	return v.Tokens.ToDec().Quo(v.DelegatorShares)
+}
+
+// BelowMinExchangeRate returns true if the exchange rate of the validator is
+// below MinExchangeRate, e.g. after it was fully slashed. Its shares are then
+// worth too few tokens to be issued precisely, and the validator must be reset.
+func (v Validator) BelowMinExchangeRate() bool {
+	return v.InvalidExRate() || v.ExchangeRate().LT(MinExchangeRate)
+}
+
 // calculate the token worth of provided shares
 func (v Validator) TokensFromShares(shares sdk.Dec) sdk.Dec {
 	return (shares.MulInt(v.Tokens)).Quo(v.DelegatorShares)
@@ -3,6 +3,7 @@ package types_test
 import (
 	"math/rand"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -56,6 +57,61 @@ func TestUpdateDescription(t *testing.T) {
 	require.Equal(t, d, d3)
 }
 
+func TestUpdateDescriptionPeers(t *testing.T) {
+	peers := "d5ba1c5bae9d9bd2bd5c9e1c4b1ba7d5c9ed0a8f@sentry.example.com:26656"
+	d1 := types.Description{Moniker: "validator", Peers: peers}
+
+	d, err := d1.UpdateDescription(types.Description{Moniker: types.DoNotModifyDesc, Peers: types.DoNotModifyDesc})
+	require.NoError(t, err)
+	require.Equal(t, peers, d.Peers)
+
+	d, err = d1.UpdateDescription(types.Description{Moniker: types.DoNotModifyDesc})
+	require.NoError(t, err)
+	require.Empty(t, d.Peers)
+
+	_, err = d1.UpdateDescription(types.Description{Moniker: types.DoNotModifyDesc, Peers: "sentry.example.com:26656"})
+	require.Error(t, err)
+	_, err = d1.UpdateDescription(types.Description{Moniker: types.DoNotModifyDesc, Peers: strings.Repeat(peers+",", 20)})
+	require.Error(t, err)
+}
+
+func TestParsePeers(t *testing.T) {
+	const id = "d5ba1c5bae9d9bd2bd5c9e1c4b1ba7d5c9ed0a8f"
+
+	testCases := []struct {
+		peers    string
+		expected []string
+		expErr   bool
+	}{
+		{"", nil, false},
+		{" ", nil, false},
+		{id + "@1.2.3.4:26656", []string{id + "@1.2.3.4:26656"}, false},
+		{
+			id + "@sentry.example.com:26656, 3f1c5bae9d9bd2bd5c9e1c4b1ba7d5c9ed0a8fd5@[2001:db8::1]:26656",
+			[]string{id + "@sentry.example.com:26656", "3f1c5bae9d9bd2bd5c9e1c4b1ba7d5c9ed0a8fd5@[2001:db8::1]:26656"},
+			false,
+		},
+		{"1.2.3.4:26656", nil, true},
+		{"abc@1.2.3.4:26656", nil, true},
+		{strings.Repeat("z", 40) + "@1.2.3.4:26656", nil, true},
+		{id + "@1.2.3.4", nil, true},
+		{id + "@:26656", nil, true},
+		{id + "@1.2.3.4:0", nil, true},
+		{id + "@1.2.3.4:65536", nil, true},
+		{id + "@1.2.3.4:26656,", nil, true},
+		{id + "@1.2.3.4:26656," + id + "@1.2.3.4:26656", nil, true},
+	}
+	for _, tc := range testCases {
+		peers, err := types.ParsePeers(tc.peers)
+		if tc.expErr {
+			require.Error(t, err, tc.peers)
+			continue
+		}
+		require.NoError(t, err, tc.peers)
+		require.Equal(t, tc.expected, peers, tc.peers)
+	}
+}
+
 func TestABCIValidatorUpdate(t *testing.T) {
 	validator := newValidator(t, valAddr1, pk1)
 	abciVal := validator.ABCIValidatorUpdate(sdk.DefaultPowerReduction)
@@ -155,6 +211,25 @@ func TestRemoveDelShares(t *testing.T) {
 	require.True(sdk.IntEq(t, sdk.NewInt(1286), tokens))
 }
 
+func TestBelowMinExchangeRate(t *testing.T) {
+	validator := newValidator(t, valAddr1, pk1)
+	require.Equal(t, sdk.OneDec(), validator.ExchangeRate())
+	require.False(t, validator.BelowMinExchangeRate())
+
+	validator, _ = validator.AddTokensFromDel(sdk.NewInt(1000000))
+	require.Equal(t, sdk.OneDec(), validator.ExchangeRate())
+	require.False(t, validator.BelowMinExchangeRate())
+
+	// one token left is exactly at the minimum exchange rate
+	validator = validator.RemoveTokens(sdk.NewInt(999999))
+	require.Equal(t, types.MinExchangeRate, validator.ExchangeRate())
+	require.False(t, validator.BelowMinExchangeRate())
+
+	validator = validator.RemoveTokens(sdk.OneInt())
+	require.True(t, validator.InvalidExRate())
+	require.True(t, validator.BelowMinExchangeRate())
+}
+
 func TestAddTokensFromDel(t *testing.T) {
 	validator := newValidator(t, valAddr1, pk1)
 
@@ -0,0 +1,19 @@
+package txresult
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/txresult/keeper"
+	"github.com/cosmos/cosmos-sdk/x/txresult/types"
+)
+
+// BeginBlocker prunes the tx results and the seen txs which are past the
+// retention window.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	k.PruneTxResults(ctx)
+	k.PruneSeenTxs(ctx)
+}
@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/txresult/types"
+)
+
+// GetQueryCmd returns the cli query commands for the txresult module.
+func GetQueryCmd() *cobra.Command {
+	txResultQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the txresult module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txResultQueryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryTxResult(),
+	)
+
+	return txResultQueryCmd
+}
+
+// GetCmdQueryParams implements a command to return the current txresult
+// parameters.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current txresult parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTxResult implements a command to return the result of a tx
+// recorded in state.
+func GetCmdQueryTxResult() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx-result [hash]",
+		Short: "Query the result of a tx recorded in state by its hash",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the result of a tx recorded in state by its hex encoded hash.
+Unlike the tx query, this does not require the node to index txs, but results
+are only kept for the number of blocks set by the retention_blocks param.
+
+Example:
+$ %s query %s tx-result <hash>
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TxResult(cmd.Context(), &types.QueryTxResultRequest{Hash: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.TxResult)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/txresult/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns params of the txresult module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// TxResult returns the recorded result of a tx by its hash.
+func (k Keeper) TxResult(c context.Context, req *types.QueryTxResultRequest) (*types.QueryTxResultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	hash, err := hex.DecodeString(req.Hash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx hash %s: %s", req.Hash, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	result, found := k.GetTxResult(ctx, hash)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no result recorded for tx %s", req.Hash)
+	}
+
+	return &types.QueryTxResultResponse{TxResult: &result}, nil
+}
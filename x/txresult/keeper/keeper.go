@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/txresult/types"
+)
+
+// Keeper of the txresult store
+type Keeper struct {
+	cdc        codec.BinaryCodec
+	storeKey   sdk.StoreKey
+	paramSpace paramtypes.Subspace
+}
+
+// NewKeeper creates a new txresult Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   key,
+		paramSpace: paramSpace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetParams returns the total set of txresult parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of txresult parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// retentionBlocks returns the RetentionBlocks param, or zero, which disables
+// recording, if it is not set. The genesis txs may be delivered before the
+// params are initialized.
+func (k Keeper) retentionBlocks(ctx sdk.Context) uint64 {
+	var retentionBlocks uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyRetentionBlocks, &retentionBlocks)
+	return retentionBlocks
+}
+
+// RecordTxResult stores the result of a delivered tx, unless recording is
+// disabled. It implements sdk.DeliverTxHook.
+func (k Keeper) RecordTxResult(ctx sdk.Context, txBytes []byte, res abci.ResponseDeliverTx) {
+	if k.retentionBlocks(ctx) == 0 {
+		return
+	}
+
+	hash := tmhash.Sum(txBytes)
+	result := types.TxResult{
+		Height:       ctx.BlockHeight(),
+		Txhash:       fmt.Sprintf("%X", hash),
+		Codespace:    res.Codespace,
+		Code:         res.Code,
+		GasWanted:    uint64(res.GasWanted),
+		GasUsed:      uint64(res.GasUsed),
+		EventsDigest: types.EventsDigest(res.Events),
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.TxResultKey(hash), k.cdc.MustMarshal(&result))
+	store.Set(types.HeightIndexKey(result.Height, hash), []byte{})
+}
+
+// GetTxResult returns the recorded result of the tx with the given hash.
+func (k Keeper) GetTxResult(ctx sdk.Context, txHash []byte) (result types.TxResult, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.TxResultKey(txHash))
+	if bz == nil {
+		return result, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &result)
+	return result, true
+}
+
+// PruneTxResults deletes the tx results recorded more than RetentionBlocks
+// blocks before the current block.
+func (k Keeper) PruneTxResults(ctx sdk.Context) {
+	cutoff := ctx.BlockHeight() - int64(k.retentionBlocks(ctx))
+	if cutoff < 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.HeightIndexKeyPrefix, types.HeightIndexPrefix(cutoff+1))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		height, hash := types.SplitHeightIndexKey(key)
+		store.Delete(key)
+
+		// the same tx may have been included again in a later block, in which
+		// case its latest result must be kept
+		if result, found := k.GetTxResult(ctx, hash); found && result.Height == height {
+			store.Delete(types.TxResultKey(hash))
+		}
+	}
+}
+
+// MarkTxSeen records the tx with the given hash as processed at the current
+// height, unless recording is disabled, so that its replays are rejected by
+// HasSeenTx until it is past the retention window.
+func (k Keeper) MarkTxSeen(ctx sdk.Context, txHash []byte) {
+	if k.retentionBlocks(ctx) == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SeenTxKey(txHash), sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+	store.Set(types.SeenHeightIndexKey(ctx.BlockHeight(), txHash), []byte{})
+}
+
+// MarkTxSeenUntil records the tx with the given hash as processed until the
+// given timeout, e.g. the timeout timestamp of an unordered tx, so that its
+// replays are rejected by HasSeenTx until it times out, whatever the retention
+// window.
+func (k Keeper) MarkTxSeenUntil(ctx sdk.Context, txHash []byte, timeout time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SeenTxKey(txHash), sdk.FormatTimeBytes(timeout))
+	store.Set(types.SeenTimeoutIndexKey(timeout, txHash), []byte{})
+}
+
+// HasSeenTx returns whether the tx with the given hash was processed within the
+// retention window, or before its timeout.
+func (k Keeper) HasSeenTx(ctx sdk.Context, txHash []byte) bool {
+	return ctx.KVStore(k.storeKey).Has(types.SeenTxKey(txHash))
+}
+
+// PruneSeenTxs deletes the seen txs recorded more than RetentionBlocks blocks
+// before the current block, and the ones which timed out before the current
+// block time.
+func (k Keeper) PruneSeenTxs(ctx sdk.Context) {
+	k.pruneSeenTxsByTimeout(ctx)
+
+	cutoff := ctx.BlockHeight() - int64(k.retentionBlocks(ctx))
+	if cutoff < 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.SeenHeightIndexKeyPrefix, types.SeenHeightIndexPrefix(cutoff+1))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		height, hash := types.SplitHeightIndexKey(key)
+		store.Delete(key)
+
+		// keep the tx if it was seen again at a later height, or until a timeout
+		if bz := store.Get(types.SeenTxKey(hash)); bytes.Equal(bz, sdk.Uint64ToBigEndian(uint64(height))) {
+			store.Delete(types.SeenTxKey(hash))
+		}
+	}
+}
+
+// pruneSeenTxsByTimeout deletes the seen txs which timed out before the current
+// block time, as the txs past their timeout are rejected anyway.
+func (k Keeper) pruneSeenTxsByTimeout(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.SeenTimeoutIndexKeyPrefix, types.SeenTimeoutIndexPrefix(ctx.BlockTime()))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		timeoutBz, hash := types.SplitSeenTimeoutIndexKey(key)
+		store.Delete(key)
+
+		// keep the tx if it was seen again since
+		if bz := store.Get(types.SeenTxKey(hash)); bytes.Equal(bz, timeoutBz) {
+			store.Delete(types.SeenTxKey(hash))
+		}
+	}
+}
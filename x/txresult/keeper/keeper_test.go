@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/txresult/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = simapp.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	suite.app.TxResultKeeper.SetParams(suite.ctx, types.NewParams(5))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.app.TxResultKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func (suite *KeeperTestSuite) record(height int64, txBytes []byte, res abci.ResponseDeliverTx) {
+	suite.app.TxResultKeeper.RecordTxResult(suite.ctx.WithBlockHeight(height), txBytes, res)
+}
+
+func (suite *KeeperTestSuite) TestRecordTxResult() {
+	txBytes := []byte("tx")
+	events := []abci.Event{{Type: "transfer", Attributes: []abci.EventAttribute{{Key: []byte("amount"), Value: []byte("10stake")}}}}
+	suite.record(10, txBytes, abci.ResponseDeliverTx{Code: 5, Codespace: "sdk", GasWanted: 200, GasUsed: 100, Events: events})
+
+	res, err := suite.queryClient.TxResult(context.Background(), &types.QueryTxResultRequest{Hash: fmt.Sprintf("%X", tmhash.Sum(txBytes))})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.TxResult{
+		Height:       10,
+		Txhash:       fmt.Sprintf("%X", tmhash.Sum(txBytes)),
+		Codespace:    "sdk",
+		Code:         5,
+		GasWanted:    200,
+		GasUsed:      100,
+		EventsDigest: types.EventsDigest(events),
+	}, *res.TxResult)
+
+	// the digest does not depend on whether events are indexed
+	events[0].Attributes[0].Index = true
+	suite.Require().Equal(res.TxResult.EventsDigest, types.EventsDigest(events))
+
+	_, err = suite.queryClient.TxResult(context.Background(), &types.QueryTxResultRequest{Hash: fmt.Sprintf("%X", tmhash.Sum([]byte("other")))})
+	suite.Require().Error(err)
+
+	_, err = suite.queryClient.TxResult(context.Background(), &types.QueryTxResultRequest{Hash: "not hex"})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestRecordingDisabled() {
+	suite.app.TxResultKeeper.SetParams(suite.ctx, types.NewParams(0))
+	suite.record(10, []byte("tx"), abci.ResponseDeliverTx{})
+
+	_, found := suite.app.TxResultKeeper.GetTxResult(suite.ctx, tmhash.Sum([]byte("tx")))
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestPruneTxResults() {
+	k := suite.app.TxResultKeeper
+	suite.record(3, []byte("tx3"), abci.ResponseDeliverTx{})
+	suite.record(5, []byte("tx5"), abci.ResponseDeliverTx{})
+	suite.record(6, []byte("tx6"), abci.ResponseDeliverTx{})
+	// tx3 is included again at height 7, so its latest result must survive
+	// the pruning of height 3
+	suite.record(7, []byte("tx3"), abci.ResponseDeliverTx{Code: 1})
+
+	// with a retention of 5 blocks, results up to height 5 are pruned at 10
+	k.PruneTxResults(suite.ctx.WithBlockHeight(10))
+
+	_, found := k.GetTxResult(suite.ctx, tmhash.Sum([]byte("tx5")))
+	suite.Require().False(found)
+
+	_, found = k.GetTxResult(suite.ctx, tmhash.Sum([]byte("tx6")))
+	suite.Require().True(found)
+
+	result, found := k.GetTxResult(suite.ctx, tmhash.Sum([]byte("tx3")))
+	suite.Require().True(found)
+	suite.Require().Equal(int64(7), result.Height)
+
+	k.PruneTxResults(suite.ctx.WithBlockHeight(12))
+	_, found = k.GetTxResult(suite.ctx, tmhash.Sum([]byte("tx3")))
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestSeenTxs() {
+	k := suite.app.TxResultKeeper
+	hash3, hash6 := tmhash.Sum([]byte("tx3")), tmhash.Sum([]byte("tx6"))
+	k.MarkTxSeen(suite.ctx.WithBlockHeight(3), hash3)
+	k.MarkTxSeen(suite.ctx.WithBlockHeight(6), hash6)
+	suite.Require().True(k.HasSeenTx(suite.ctx, hash3))
+	suite.Require().False(k.HasSeenTx(suite.ctx, tmhash.Sum([]byte("other"))))
+
+	// with a retention of 5 blocks, txs seen up to height 5 are pruned at 10
+	k.PruneSeenTxs(suite.ctx.WithBlockHeight(10))
+	suite.Require().False(k.HasSeenTx(suite.ctx, hash3))
+	suite.Require().True(k.HasSeenTx(suite.ctx, hash6))
+
+	// a tx seen again after being pruned is kept for its new height
+	k.MarkTxSeen(suite.ctx.WithBlockHeight(10), hash3)
+	k.PruneSeenTxs(suite.ctx.WithBlockHeight(11))
+	suite.Require().True(k.HasSeenTx(suite.ctx, hash3))
+	suite.Require().False(k.HasSeenTx(suite.ctx, hash6))
+
+	suite.app.TxResultKeeper.SetParams(suite.ctx, types.NewParams(0))
+	k.MarkTxSeen(suite.ctx, hash6)
+	suite.Require().False(k.HasSeenTx(suite.ctx, hash6))
+}
+
+func (suite *KeeperTestSuite) TestSeenTxsUntilTimeout() {
+	k := suite.app.TxResultKeeper
+	blockTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := suite.ctx.WithBlockTime(blockTime)
+	hash1, hash2 := tmhash.Sum([]byte("tx1")), tmhash.Sum([]byte("tx2"))
+	k.MarkTxSeenUntil(ctx, hash1, blockTime.Add(time.Minute))
+	k.MarkTxSeenUntil(ctx, hash2, blockTime.Add(time.Hour))
+
+	// the txs are kept whatever the retention window until they time out
+	k.PruneSeenTxs(ctx.WithBlockHeight(100).WithBlockTime(blockTime.Add(time.Minute)))
+	suite.Require().True(k.HasSeenTx(ctx, hash1))
+	k.PruneSeenTxs(ctx.WithBlockHeight(100).WithBlockTime(blockTime.Add(2 * time.Minute)))
+	suite.Require().False(k.HasSeenTx(ctx, hash1))
+	suite.Require().True(k.HasSeenTx(ctx, hash2))
+
+	// a tx seen again at a height is kept for its height
+	k.MarkTxSeen(ctx.WithBlockHeight(100), hash2)
+	k.PruneSeenTxs(ctx.WithBlockHeight(100).WithBlockTime(blockTime.Add(2 * time.Hour)))
+	suite.Require().True(k.HasSeenTx(ctx, hash2))
+	k.PruneSeenTxs(ctx.WithBlockHeight(106).WithBlockTime(blockTime.Add(2 * time.Hour)))
+	suite.Require().False(k.HasSeenTx(ctx, hash2))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// EventsDigest returns the SHA-256 digest of the types, attribute keys and
+// attribute values of the given events. Whether events are indexed is a node
+// local setting, so it is not part of the digest.
+func EventsDigest(events []abci.Event) []byte {
+	h := sha256.New()
+	writeLen := func(n int) {
+		var bz [8]byte
+		binary.BigEndian.PutUint64(bz[:], uint64(n))
+		h.Write(bz[:])
+	}
+	write := func(bz []byte) {
+		writeLen(len(bz))
+		h.Write(bz)
+	}
+
+	for _, event := range events {
+		write([]byte(event.Type))
+		writeLen(len(event.Attributes))
+		for _, attr := range event.Attributes {
+			write(attr.Key)
+			write(attr.Value)
+		}
+	}
+
+	return h.Sum(nil)
+}
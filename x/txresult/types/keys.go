@@ -0,0 +1,100 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "txresult"
+
+	// StoreKey is the default store key for txresult
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the txresult store.
+	QuerierRoute = StoreKey
+)
+
+// Keys for txresult store
+// Items are stored with the following key: values
+//
+// - 0x01<txHash_Bytes>: TxResult
+//
+// - 0x02<height_Bytes><txHash_Bytes>: []byte{}
+//
+// - 0x03<txHash_Bytes>: height_Bytes or timeout_Bytes
+//
+// - 0x04<height_Bytes><txHash_Bytes>: []byte{}
+//
+// - 0x05<timeout_Bytes><txHash_Bytes>: []byte{}
+var (
+	TxResultKeyPrefix         = []byte{0x01}
+	HeightIndexKeyPrefix      = []byte{0x02}
+	SeenTxKeyPrefix           = []byte{0x03}
+	SeenHeightIndexKeyPrefix  = []byte{0x04}
+	SeenTimeoutIndexKeyPrefix = []byte{0x05}
+)
+
+// TxResultKey returns the key of the result of the tx with the given hash.
+func TxResultKey(txHash []byte) []byte {
+	return append(append([]byte{}, TxResultKeyPrefix...), txHash...)
+}
+
+// HeightIndexPrefix returns the prefix of the index keys of the tx results
+// recorded at the given height.
+func HeightIndexPrefix(height int64) []byte {
+	return append(append([]byte{}, HeightIndexKeyPrefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// HeightIndexKey returns the index key of the result of the tx with the given
+// hash recorded at the given height.
+func HeightIndexKey(height int64, txHash []byte) []byte {
+	return append(HeightIndexPrefix(height), txHash...)
+}
+
+// SplitHeightIndexKey returns the height and tx hash of a height index key, or
+// of a seen height index key.
+func SplitHeightIndexKey(key []byte) (height int64, txHash []byte) {
+	key = key[len(HeightIndexKeyPrefix):]
+	return int64(sdk.BigEndianToUint64(key[:8])), key[8:]
+}
+
+// SeenTxKey returns the key of the tx with the given hash in the index of the
+// seen txs.
+func SeenTxKey(txHash []byte) []byte {
+	return append(append([]byte{}, SeenTxKeyPrefix...), txHash...)
+}
+
+// SeenHeightIndexPrefix returns the prefix of the index keys of the txs seen at
+// the given height.
+func SeenHeightIndexPrefix(height int64) []byte {
+	return append(append([]byte{}, SeenHeightIndexKeyPrefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// SeenHeightIndexKey returns the index key of the tx with the given hash seen
+// at the given height.
+func SeenHeightIndexKey(height int64, txHash []byte) []byte {
+	return append(SeenHeightIndexPrefix(height), txHash...)
+}
+
+// SeenTimeoutIndexPrefix returns the prefix of the index keys of the txs seen
+// until the given timeout.
+func SeenTimeoutIndexPrefix(timeout time.Time) []byte {
+	return append(append([]byte{}, SeenTimeoutIndexKeyPrefix...), sdk.FormatTimeBytes(timeout)...)
+}
+
+// SeenTimeoutIndexKey returns the index key of the tx with the given hash seen
+// until the given timeout.
+func SeenTimeoutIndexKey(timeout time.Time, txHash []byte) []byte {
+	return append(SeenTimeoutIndexPrefix(timeout), txHash...)
+}
+
+// SplitSeenTimeoutIndexKey returns the formatted timeout and the tx hash of a
+// seen timeout index key.
+func SplitSeenTimeoutIndexKey(key []byte) (timeoutBz, txHash []byte) {
+	key = key[len(SeenTimeoutIndexKeyPrefix):]
+	n := len(sdk.FormatTimeBytes(time.Time{}))
+	return key[:n], key[n:]
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// DefaultRetentionBlocks is the default number of blocks whose tx results are
+// kept in state, i.e. about a day assuming 5 second block times.
+const DefaultRetentionBlocks uint64 = 60 * 60 * 24 / 5
+
+// Parameter store keys
+var (
+	KeyRetentionBlocks = []byte("RetentionBlocks")
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// ParamKeyTable for txresult module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(retentionBlocks uint64) Params {
+	return Params{
+		RetentionBlocks: retentionBlocks,
+	}
+}
+
+// DefaultParams returns default txresult module parameters
+func DefaultParams() Params {
+	return Params{
+		RetentionBlocks: DefaultRetentionBlocks,
+	}
+}
+
+// Validate validates the params
+func (p Params) Validate() error {
+	return validateRetentionBlocks(p.RetentionBlocks)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyRetentionBlocks, &p.RetentionBlocks, validateRetentionBlocks),
+	}
+}
+
+func validateRetentionBlocks(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -0,0 +1,762 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/txresult/v1beta1/txresult.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the txresult module.
+type Params struct {
+	// retention_blocks is the number of most recent blocks whose tx results are
+	// kept in state. Zero disables recording tx results.
+	RetentionBlocks uint64 `protobuf:"varint,1,opt,name=retention_blocks,json=retentionBlocks,proto3" json:"retention_blocks,omitempty" yaml:"retention_blocks"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da5cbfd464f7ba3b, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetRetentionBlocks() uint64 {
+	if m != nil {
+		return m.RetentionBlocks
+	}
+	return 0
+}
+
+// TxResult is the execution result of a tx, as recorded in state.
+type TxResult struct {
+	// height is the height of the block including the tx.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// txhash is the hex encoded hash of the tx.
+	Txhash string `protobuf:"bytes,2,opt,name=txhash,proto3" json:"txhash,omitempty"`
+	// codespace is the namespace of the error code, if the tx failed.
+	Codespace string `protobuf:"bytes,3,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	// code is the response code of the tx, zero on success.
+	Code      uint32 `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+	GasWanted uint64 `protobuf:"varint,5,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty" yaml:"gas_wanted"`
+	GasUsed   uint64 `protobuf:"varint,6,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty" yaml:"gas_used"`
+	// events_digest is the SHA-256 digest of the types, attribute keys and
+	// attribute values of the events emitted by the tx.
+	EventsDigest []byte `protobuf:"bytes,7,opt,name=events_digest,json=eventsDigest,proto3" json:"events_digest,omitempty" yaml:"events_digest"`
+}
+
+func (m *TxResult) Reset()         { *m = TxResult{} }
+func (m *TxResult) String() string { return proto.CompactTextString(m) }
+func (*TxResult) ProtoMessage()    {}
+func (*TxResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da5cbfd464f7ba3b, []int{1}
+}
+func (m *TxResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxResult.Merge(m, src)
+}
+func (m *TxResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxResult proto.InternalMessageInfo
+
+func (m *TxResult) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TxResult) GetTxhash() string {
+	if m != nil {
+		return m.Txhash
+	}
+	return ""
+}
+
+func (m *TxResult) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
+	}
+	return ""
+}
+
+func (m *TxResult) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *TxResult) GetGasWanted() uint64 {
+	if m != nil {
+		return m.GasWanted
+	}
+	return 0
+}
+
+func (m *TxResult) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *TxResult) GetEventsDigest() []byte {
+	if m != nil {
+		return m.EventsDigest
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmos.txresult.v1beta1.Params")
+	proto.RegisterType((*TxResult)(nil), "cosmos.txresult.v1beta1.TxResult")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/txresult/v1beta1/txresult.proto", fileDescriptor_da5cbfd464f7ba3b)
+}
+
+var fileDescriptor_da5cbfd464f7ba3b = []byte{
+	// 383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xc1, 0x6b, 0xd4, 0x40,
+	0x14, 0xc6, 0x77, 0xda, 0x35, 0xdd, 0x1d, 0x5a, 0xaa, 0x63, 0xb5, 0x83, 0x4a, 0x12, 0x72, 0x90,
+	0x5c, 0x9a, 0x50, 0xf4, 0x54, 0xf0, 0x12, 0x44, 0xaf, 0x32, 0x28, 0x42, 0x2f, 0xcb, 0x24, 0x19,
+	0x26, 0xa1, 0x9b, 0xcc, 0x92, 0x37, 0x5b, 0xd3, 0xff, 0x42, 0x6f, 0x1e, 0xfb, 0xe7, 0x78, 0xdc,
+	0xa3, 0xa7, 0x20, 0xbb, 0x17, 0xcf, 0xf9, 0x0b, 0x24, 0x93, 0xb0, 0xc1, 0x9e, 0xe6, 0x7d, 0xbf,
+	0xef, 0x7b, 0xc3, 0x83, 0x0f, 0xbf, 0x4e, 0x14, 0x14, 0x0a, 0x42, 0x5d, 0x57, 0x02, 0xd6, 0x4b,
+	0x1d, 0xde, 0x5e, 0xc6, 0x42, 0xf3, 0xcb, 0x3d, 0x08, 0x56, 0x95, 0xd2, 0x8a, 0x9c, 0xf7, 0xb9,
+	0x60, 0x8f, 0x87, 0xdc, 0x8b, 0x33, 0xa9, 0xa4, 0x32, 0x99, 0xb0, 0x9b, 0xfa, 0xb8, 0x77, 0x8d,
+	0xad, 0x4f, 0xbc, 0xe2, 0x05, 0x90, 0x0f, 0xf8, 0x71, 0x25, 0xb4, 0x28, 0x75, 0xae, 0xca, 0x45,
+	0xbc, 0x54, 0xc9, 0x0d, 0x50, 0xe4, 0x22, 0x7f, 0x1a, 0xbd, 0x6c, 0x1b, 0xe7, 0xfc, 0x8e, 0x17,
+	0xcb, 0x2b, 0xef, 0x61, 0xc2, 0x63, 0xa7, 0x7b, 0x14, 0x19, 0x72, 0x35, 0xfb, 0x79, 0xef, 0x4c,
+	0xfe, 0xde, 0x3b, 0xc8, 0xfb, 0x71, 0x80, 0x67, 0x9f, 0x6b, 0x66, 0xce, 0x20, 0xcf, 0xb1, 0x95,
+	0x89, 0x5c, 0x66, 0xda, 0x7c, 0x7a, 0xc8, 0x06, 0xd5, 0x71, 0x5d, 0x67, 0x1c, 0x32, 0x7a, 0xe0,
+	0x22, 0x7f, 0xce, 0x06, 0x45, 0x5e, 0xe1, 0x79, 0xa2, 0x52, 0x01, 0x2b, 0x9e, 0x08, 0x7a, 0x68,
+	0xac, 0x11, 0x10, 0x82, 0xa7, 0x9d, 0xa0, 0x53, 0x17, 0xf9, 0x27, 0xcc, 0xcc, 0xe4, 0x2d, 0xc6,
+	0x92, 0xc3, 0xe2, 0x1b, 0x2f, 0xb5, 0x48, 0xe9, 0x23, 0x73, 0xfa, 0xb3, 0xb6, 0x71, 0x9e, 0xf4,
+	0xa7, 0x8f, 0x9e, 0xc7, 0xe6, 0x92, 0xc3, 0x57, 0x33, 0x93, 0x00, 0xcf, 0x3a, 0x67, 0x0d, 0x22,
+	0xa5, 0x96, 0xd9, 0x79, 0xda, 0x36, 0xce, 0xe9, 0xb8, 0xd3, 0x39, 0x1e, 0x3b, 0x92, 0x1c, 0xbe,
+	0x80, 0x48, 0xc9, 0x3b, 0x7c, 0x22, 0x6e, 0x45, 0xa9, 0x61, 0x91, 0xe6, 0x52, 0x80, 0xa6, 0x47,
+	0x2e, 0xf2, 0x8f, 0x23, 0xda, 0x36, 0xce, 0x59, 0xbf, 0xf4, 0x9f, 0xed, 0xb1, 0xe3, 0x5e, 0xbf,
+	0x37, 0x32, 0xfa, 0xf8, 0x6b, 0x6b, 0xa3, 0xcd, 0xd6, 0x46, 0x7f, 0xb6, 0x36, 0xfa, 0xbe, 0xb3,
+	0x27, 0x9b, 0x9d, 0x3d, 0xf9, 0xbd, 0xb3, 0x27, 0xd7, 0x17, 0x32, 0xd7, 0xd9, 0x3a, 0x0e, 0x12,
+	0x55, 0x84, 0x43, 0xd7, 0xfd, 0x73, 0x01, 0xe9, 0x4d, 0x58, 0x8f, 0xc5, 0xeb, 0xbb, 0x95, 0x80,
+	0xd8, 0x32, 0xfd, 0xbd, 0xf9, 0x37, 0x00, 0x86, 0x3a, 0x8d, 0x0e, 0x18, 0x02, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RetentionBlocks != that1.RetentionBlocks {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetentionBlocks != 0 {
+		i = encodeVarintTxresult(dAtA, i, uint64(m.RetentionBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EventsDigest) > 0 {
+		i -= len(m.EventsDigest)
+		copy(dAtA[i:], m.EventsDigest)
+		i = encodeVarintTxresult(dAtA, i, uint64(len(m.EventsDigest)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintTxresult(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.GasWanted != 0 {
+		i = encodeVarintTxresult(dAtA, i, uint64(m.GasWanted))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Code != 0 {
+		i = encodeVarintTxresult(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintTxresult(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Txhash) > 0 {
+		i -= len(m.Txhash)
+		copy(dAtA[i:], m.Txhash)
+		i = encodeVarintTxresult(dAtA, i, uint64(len(m.Txhash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintTxresult(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTxresult(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTxresult(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RetentionBlocks != 0 {
+		n += 1 + sovTxresult(uint64(m.RetentionBlocks))
+	}
+	return n
+}
+
+func (m *TxResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTxresult(uint64(m.Height))
+	}
+	l = len(m.Txhash)
+	if l > 0 {
+		n += 1 + l + sovTxresult(uint64(l))
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovTxresult(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovTxresult(uint64(m.Code))
+	}
+	if m.GasWanted != 0 {
+		n += 1 + sovTxresult(uint64(m.GasWanted))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovTxresult(uint64(m.GasUsed))
+	}
+	l = len(m.EventsDigest)
+	if l > 0 {
+		n += 1 + l + sovTxresult(uint64(l))
+	}
+	return n
+}
+
+func sovTxresult(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTxresult(x uint64) (n int) {
+	return sovTxresult(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxresult
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionBlocks", wireType)
+			}
+			m.RetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxresult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxresult(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxresult
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxresult
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxresult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txhash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxresult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxresult
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxresult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txhash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxresult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxresult
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxresult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxresult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
+			}
+			m.GasWanted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxresult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasWanted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxresult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventsDigest", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxresult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTxresult
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxresult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventsDigest = append(m.EventsDigest[:0], dAtA[iNdEx:postIndex]...)
+			if m.EventsDigest == nil {
+				m.EventsDigest = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxresult(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxresult
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTxresult(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTxresult
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTxresult
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTxresult
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTxresult
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTxresult
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTxresult
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTxresult        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTxresult          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTxresult = fmt.Errorf("proto: unexpected end of group")
+)
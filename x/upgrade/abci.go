@@ -40,6 +40,11 @@ func BeginBlocker(k keeper.Keeper, ctx sdk.Context, _ abci.RequestBeginBlock) {
 		}
 	}
 
+	if err := k.ActivateFeatureGates(ctx); err != nil {
+		ctx.Logger().Error(err.Error())
+		panic(err)
+	}
+
 	if !found {
 		return
 	}
@@ -15,6 +15,7 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/server/health"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -476,3 +477,54 @@ func TestBinaryVersion(t *testing.T) {
 		}
 	}
 }
+
+func TestHealthCheck(t *testing.T) {
+	s := setupTest(10, map[int64]bool{})
+	registry := health.NewRegistry()
+	s.module.(module.HealthCheckAppModule).RegisterHealthChecks(registry)
+
+	require.True(t, registry.Readiness(s.ctx).Healthy())
+
+	err := s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "test", Height: s.ctx.BlockHeight() + 2}})
+	require.NoError(t, err)
+	require.True(t, registry.Readiness(s.ctx).Healthy())
+
+	// the node halts at the next block without a handler for the upgrade
+	report := registry.Readiness(s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1))
+	require.False(t, report.Healthy())
+	require.Equal(t, `halting for upgrade "test" at height 12`, report.Checks[0].Error)
+
+	s.keeper.SetUpgradeHandler("test", func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) { return vm, nil })
+	require.True(t, registry.Readiness(s.ctx.WithBlockHeight(s.ctx.BlockHeight()+1)).Healthy())
+}
+
+func TestFeatureGateActivation(t *testing.T) {
+	s := setupTest(10, map[int64]bool{})
+	s.keeper.SetFeatureGate(types.FeatureGate{Name: "new-fee-logic", Height: 11})
+
+	newCtx := s.ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+	require.False(t, s.keeper.IsFeatureActive(s.ctx, "new-fee-logic"))
+	require.NotPanics(t, func() {
+		s.module.BeginBlock(newCtx, abci.RequestBeginBlock{Header: newCtx.BlockHeader()})
+	})
+	require.True(t, s.keeper.IsFeatureActive(newCtx, "new-fee-logic"))
+	require.Equal(t, sdk.Events{sdk.NewEvent(
+		types.EventTypeFeatureGateActivated,
+		sdk.NewAttribute(types.AttributeKeyFeatureGate, "new-fee-logic"),
+		sdk.NewAttribute(types.AttributeKeyHeight, "11"),
+	)}, newCtx.EventManager().Events())
+
+	// the activated gate is not activated again
+	newCtx = newCtx.WithBlockHeight(12).WithEventManager(sdk.NewEventManager())
+	require.NotPanics(t, func() {
+		s.module.BeginBlock(newCtx, abci.RequestBeginBlock{Header: newCtx.BlockHeader()})
+	})
+	require.Empty(t, newCtx.EventManager().Events())
+
+	// a binary registering a gate after its height halts
+	s.keeper.SetFeatureGate(types.FeatureGate{Name: "retroactive", Height: 11})
+	newCtx = newCtx.WithBlockHeight(13)
+	require.Panics(t, func() {
+		s.module.BeginBlock(newCtx, abci.RequestBeginBlock{Header: newCtx.BlockHeader()})
+	})
+}
@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// dryRunApp defines the BaseApp methods needed to run an upgrade handler
+// outside of a block.
+type dryRunApp interface {
+	LastBlockHeight() int64
+	NewUncachedContext(isCheckTx bool, header tmproto.Header) sdk.Context
+}
+
+// NewUpgradeCmd returns the root command for the node operator upgrade
+// commands, run against the node home rather than a running node.
+func NewUpgradeCmd(appCreator servertypes.AppCreator, upgradeKeeper func(servertypes.Application) keeper.Keeper, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   types.ModuleName,
+		Short: "Upgrade subcommands run against the node home",
+	}
+
+	cmd.AddCommand(NewDryRunCmd(appCreator, upgradeKeeper, defaultNodeHome))
+
+	return cmd
+}
+
+// NewDryRunCmd returns a command running the upgrade handler of a plan, and
+// the module migrations it runs, against a throwaway copy of the application
+// database of the node home, reporting the duration, the store writes and the
+// errors of every module migration.
+func NewDryRunCmd(appCreator servertypes.AppCreator, upgradeKeeper func(servertypes.Application) keeper.Keeper, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dry-run [plan-name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Rehearse an upgrade against a copy of the application state",
+		Long: `Rehearse an upgrade by running the upgrade handler registered by this binary for
+the given plan name, at the height following the last committed height, against
+a throwaway copy of the application database. The application database of the
+node home is left untouched. The node should be stopped while the database is
+copied.
+
+Stores added by the upgrade are only mounted if the application sets the
+upgrade store loader, e.g. from the upgrade-info.json file of the node home.
+
+The report lists the duration and store writes of the upgrade handler and of
+every module migration, and the error the upgrade failed with, if any, in which
+case the command exits with an error.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			tmpDir, err := ioutil.TempDir("", "upgrade-dry-run")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(tmpDir)
+
+			if err := server.CopyDir(filepath.Join(config.RootDir, "data", "application.db"), filepath.Join(tmpDir, "application.db")); err != nil {
+				return fmt.Errorf("failed to copy the application database: %w", err)
+			}
+
+			db, err := sdk.NewLevelDB("application", tmpDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			app := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+			baseApp, ok := app.(dryRunApp)
+			if !ok {
+				return fmt.Errorf("application %T does not support upgrade dry runs", app)
+			}
+
+			plan := types.Plan{Name: args[0], Height: baseApp.LastBlockHeight() + 1}
+			ctx := baseApp.NewUncachedContext(false, tmproto.Header{Height: plan.Height, Time: time.Now().UTC()})
+
+			report := upgradeKeeper(app).DryRunUpgrade(ctx, plan)
+
+			bz, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+
+			if report.Error != "" {
+				return fmt.Errorf("upgrade %s failed: %s", plan.Name, report.Error)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
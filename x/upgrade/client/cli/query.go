@@ -11,6 +11,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
+// FlagActiveOnly is the flag filtering out the feature gates not active yet.
+const FlagActiveOnly = "active-only"
+
 // GetQueryCmd returns the parent command for all x/upgrade CLi query commands.
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -22,6 +25,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCurrentPlanCmd(),
 		GetAppliedPlanCmd(),
 		GetModuleVersionsCmd(),
+		GetFeatureGatesCmd(),
 	)
 
 	return cmd
@@ -154,3 +158,36 @@ func GetModuleVersionsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetFeatureGatesCmd returns the feature gates registered by the application
+func GetFeatureGatesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feature-gates",
+		Short: "get the list of feature gates",
+		Long: "Gets the feature gates registered by the application, i.e. the behavioral changes\n" +
+			"activating at a given height without an upgrade plan, along with whether they\n" +
+			"are active at the queried height.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			activeOnly, _ := cmd.Flags().GetBool(FlagActiveOnly)
+
+			res, err := queryClient.FeatureGates(cmd.Context(), &types.QueryFeatureGatesRequest{ActiveOnly: activeOnly})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(FlagActiveOnly, false, "Only list the active feature gates")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
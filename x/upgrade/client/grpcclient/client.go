@@ -0,0 +1,84 @@
+// Code generated by typedgen. DO NOT EDIT.
+
+// Package grpcclient implements the typed clients of the upgrade module.
+package grpcclient
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/typed"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// QueryClient is the typed client of the upgrade Query service, retrying
+// the queries failing with a transient error.
+type QueryClient struct {
+	client types.QueryClient
+	retry  typed.RetryConfig
+}
+
+// NewQueryClient returns a QueryClient querying through the connection.
+func NewQueryClient(conn gogogrpc.ClientConn, retry typed.RetryConfig) *QueryClient {
+	return &QueryClient{
+		client: types.NewQueryClient(conn),
+		retry:  retry,
+	}
+}
+
+// AppliedPlan calls the upgrade Query/AppliedPlan method.
+func (c *QueryClient) AppliedPlan(ctx context.Context, req *types.QueryAppliedPlanRequest, opts ...grpc.CallOption) (*types.QueryAppliedPlanResponse, error) {
+	var res *types.QueryAppliedPlanResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.AppliedPlan(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// CurrentPlan calls the upgrade Query/CurrentPlan method.
+func (c *QueryClient) CurrentPlan(ctx context.Context, req *types.QueryCurrentPlanRequest, opts ...grpc.CallOption) (*types.QueryCurrentPlanResponse, error) {
+	var res *types.QueryCurrentPlanResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.CurrentPlan(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// FeatureGates calls the upgrade Query/FeatureGates method.
+func (c *QueryClient) FeatureGates(ctx context.Context, req *types.QueryFeatureGatesRequest, opts ...grpc.CallOption) (*types.QueryFeatureGatesResponse, error) {
+	var res *types.QueryFeatureGatesResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.FeatureGates(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// ModuleVersions calls the upgrade Query/ModuleVersions method.
+func (c *QueryClient) ModuleVersions(ctx context.Context, req *types.QueryModuleVersionsRequest, opts ...grpc.CallOption) (*types.QueryModuleVersionsResponse, error) {
+	var res *types.QueryModuleVersionsResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.ModuleVersions(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// UpgradedConsensusState calls the upgrade Query/UpgradedConsensusState method.
+func (c *QueryClient) UpgradedConsensusState(ctx context.Context, req *types.QueryUpgradedConsensusStateRequest, opts ...grpc.CallOption) (*types.QueryUpgradedConsensusStateResponse, error) {
+	var res *types.QueryUpgradedConsensusStateResponse
+	err := c.retry.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = c.client.UpgradedConsensusState(ctx, req, opts...)
+		return err
+	})
+
+	return res, err
+}
@@ -1,7 +1,15 @@
 package exported
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 // ProtocolVersionSetter defines the interface fulfilled by BaseApp
 // which allows setting it's appVersion field.
 type ProtocolVersionSetter interface {
 	SetProtocolVersion(uint64)
 }
+
+// FeatureGateKeeper defines the interface fulfilled by the upgrade keeper
+// which allows the modules to check whether a behavioral change is active.
+type FeatureGateKeeper interface {
+	IsFeatureActive(ctx sdk.Context, name string) bool
+}
@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// DryRunUpgrade runs the upgrade handler registered for the plan and reports
+// the duration and the number of store writes of the handler and of every
+// module migration it runs, along with the error or panic they failed with, if
+// any. The upgrade handler writes to the context stores, so the context must be
+// backed by a throwaway copy of the application state.
+func (k Keeper) DryRunUpgrade(ctx sdk.Context, plan types.Plan) types.DryRunReport {
+	report := types.DryRunReport{Plan: plan.Name, Height: plan.Height}
+
+	handler := k.upgradeHandlers[plan.Name]
+	if handler == nil {
+		report.Error = fmt.Sprintf("no upgrade handler registered for %s", plan.Name)
+		return report
+	}
+
+	recorder := &migrationRecorder{}
+	ctx = ctx.WithMultiStore(writeCountingMultiStore{MultiStore: ctx.MultiStore(), writes: &recorder.writes})
+	ctx = module.WithMigrationObserver(ctx, recorder)
+
+	start := time.Now()
+	err := runUpgradeHandler(ctx, handler, plan, k.GetModuleVersionMap(ctx))
+	report.Duration = time.Since(start)
+
+	if err != nil {
+		report.Error = err.Error()
+		// a module whose migrations panicked was never notified as done
+		if n := len(recorder.modules); n > 0 && !recorder.done {
+			recorder.modules[n-1].Duration = time.Since(recorder.start)
+			recorder.modules[n-1].Writes = recorder.writes - recorder.startWrites
+			recorder.modules[n-1].Error = report.Error
+		}
+	}
+
+	report.Writes = recorder.writes
+	report.Modules = recorder.modules
+
+	return report
+}
+
+func runUpgradeHandler(ctx sdk.Context, handler types.UpgradeHandler, plan types.Plan, fromVM module.VersionMap) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("upgrade handler panicked: %v", r)
+		}
+	}()
+
+	_, err = handler(ctx, plan, fromVM)
+	return err
+}
+
+// migrationRecorder is a module.MigrationObserver recording the migrations of
+// every module.
+type migrationRecorder struct {
+	modules     []types.ModuleMigrationReport
+	writes      uint64
+	start       time.Time
+	startWrites uint64
+	done        bool
+}
+
+var _ module.MigrationObserver = &migrationRecorder{}
+
+func (r *migrationRecorder) BeforeModuleMigration(moduleName string, fromVersion, toVersion uint64) {
+	r.modules = append(r.modules, types.ModuleMigrationReport{
+		Module:      moduleName,
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+	})
+	r.start = time.Now()
+	r.startWrites = r.writes
+	r.done = false
+}
+
+func (r *migrationRecorder) AfterModuleMigration(_ string, err error) {
+	m := &r.modules[len(r.modules)-1]
+	m.Duration = time.Since(r.start)
+	m.Writes = r.writes - r.startWrites
+	if err != nil {
+		m.Error = err.Error()
+	}
+	r.done = true
+}
+
+// writeCountingMultiStore counts the writes and deletes made to its stores,
+// including the ones of its branches.
+type writeCountingMultiStore struct {
+	sdk.MultiStore
+	writes *uint64
+}
+
+func (s writeCountingMultiStore) GetKVStore(key sdk.StoreKey) sdk.KVStore {
+	return writeCountingKVStore{KVStore: s.MultiStore.GetKVStore(key), writes: s.writes}
+}
+
+func (s writeCountingMultiStore) CacheMultiStore() sdk.CacheMultiStore {
+	return writeCountingCacheMultiStore{cacheMultiStore: s.MultiStore.CacheMultiStore(), writes: s.writes}
+}
+
+// cacheMultiStore allows embedding a sdk.CacheMultiStore while overriding its
+// CacheMultiStore method.
+type cacheMultiStore = sdk.CacheMultiStore
+
+type writeCountingCacheMultiStore struct {
+	cacheMultiStore
+	writes *uint64
+}
+
+func (s writeCountingCacheMultiStore) GetKVStore(key sdk.StoreKey) sdk.KVStore {
+	return writeCountingKVStore{KVStore: s.cacheMultiStore.GetKVStore(key), writes: s.writes}
+}
+
+func (s writeCountingCacheMultiStore) CacheMultiStore() sdk.CacheMultiStore {
+	return writeCountingCacheMultiStore{cacheMultiStore: s.cacheMultiStore.CacheMultiStore(), writes: s.writes}
+}
+
+type writeCountingKVStore struct {
+	sdk.KVStore
+	writes *uint64
+}
+
+func (s writeCountingKVStore) Set(key, value []byte) {
+	*s.writes++
+	s.KVStore.Set(key, value)
+}
+
+func (s writeCountingKVStore) Delete(key []byte) {
+	*s.writes++
+	s.KVStore.Delete(key)
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// SetFeatureGate registers a feature gate: the behavioral change of the given
+// name is active from the height of the gate, as checked by IsFeatureActive,
+// without an upgrade plan. The gates must be registered when the application
+// is built, before it starts, and their heights must not be changed once
+// reached, nor be set to a past height, to keep the state transitions of the
+// past blocks deterministic.
+func (k Keeper) SetFeatureGate(gate types.FeatureGate) {
+	if err := gate.ValidateBasic(); err != nil {
+		panic(fmt.Sprintf("invalid feature gate %s: %s", gate.Name, err))
+	}
+	if _, ok := k.featureGates[gate.Name]; ok {
+		panic(fmt.Sprintf("feature gate %s already registered", gate.Name))
+	}
+
+	k.featureGates[gate.Name] = gate
+}
+
+// GetFeatureGates returns the registered feature gates sorted by name.
+func (k Keeper) GetFeatureGates() []types.FeatureGate {
+	gates := make([]types.FeatureGate, 0, len(k.featureGates))
+	for _, gate := range k.featureGates {
+		gates = append(gates, gate)
+	}
+	sort.Slice(gates, func(i, j int) bool { return gates[i].Name < gates[j].Name })
+
+	return gates
+}
+
+// IsFeatureActive returns true if the feature gate of the given name is
+// registered and active at the height of the context. CheckTx runs on the last
+// committed height, so that a feature is only active in CheckTx once its
+// activation block is committed.
+func (k Keeper) IsFeatureActive(ctx sdk.Context, name string) bool {
+	gate, ok := k.featureGates[name]
+	return ok && gate.IsActiveAt(ctx.BlockHeight())
+}
+
+// GetFeatureGateActivationHeight returns the height at which the feature gate
+// of the given name was activated on chain, if it was.
+func (k Keeper) GetFeatureGateActivationHeight(ctx sdk.Context, name string) (int64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.FeatureGateKey(name))
+	if len(bz) == 0 {
+		return 0, false
+	}
+
+	return int64(binary.BigEndian.Uint64(bz)), true
+}
+
+func (k Keeper) setFeatureGateActivated(ctx sdk.Context, name string) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(ctx.BlockHeight()))
+	ctx.KVStore(k.storeKey).Set(types.FeatureGateKey(name), bz)
+}
+
+// InitFeatureGates records the feature gates whose height precedes the initial
+// height of the chain, e.g. of a chain restarted from an exported genesis, as
+// activated at the initial height.
+func (k Keeper) InitFeatureGates(ctx sdk.Context) {
+	for _, gate := range k.GetFeatureGates() {
+		if gate.Height < ctx.BlockHeight() {
+			k.setFeatureGateActivated(ctx, gate.Name)
+		}
+	}
+}
+
+// ActivateFeatureGates records the feature gates reaching their height at the
+// height of the context, emitting an event for each of them. It returns an
+// error if a gate of a past height was never activated, i.e. was registered
+// after its height, since the past blocks were then processed without the
+// feature by the nodes running the previous binary.
+func (k Keeper) ActivateFeatureGates(ctx sdk.Context) error {
+	for _, gate := range k.GetFeatureGates() {
+		switch {
+		case gate.Height == ctx.BlockHeight():
+			k.setFeatureGateActivated(ctx, gate.Name)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeFeatureGateActivated,
+				sdk.NewAttribute(types.AttributeKeyFeatureGate, gate.Name),
+				sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", gate.Height)),
+			))
+			k.Logger(ctx).Info("feature gate activated", "feature", gate.Name, "height", gate.Height)
+
+		case gate.Height < ctx.BlockHeight():
+			if _, ok := k.GetFeatureGateActivationHeight(ctx, gate.Name); !ok {
+				return fmt.Errorf("feature gate %s of height %d was registered after its height, it must be set to a future height", gate.Name, gate.Height)
+			}
+		}
+	}
+
+	return nil
+}
@@ -70,3 +70,19 @@ func (k Keeper) ModuleVersions(c context.Context, req *types.QueryModuleVersions
 		ModuleVersions: mv,
 	}, nil
 }
+
+// FeatureGates implements the Query/FeatureGates gRPC method
+func (k Keeper) FeatureGates(c context.Context, req *types.QueryFeatureGatesRequest) (*types.QueryFeatureGatesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	gates := []types.FeatureGateStatus{}
+	for _, gate := range k.GetFeatureGates() {
+		active := gate.IsActiveAt(ctx.BlockHeight())
+		if req.ActiveOnly && !active {
+			continue
+		}
+		gates = append(gates, types.FeatureGateStatus{FeatureGate: gate, Active: active})
+	}
+
+	return &types.QueryFeatureGatesResponse{FeatureGates: gates}, nil
+}
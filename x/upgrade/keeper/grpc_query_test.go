@@ -203,6 +203,26 @@ func (suite *UpgradeTestSuite) TestModuleVersions() {
 	}
 }
 
+func (suite *UpgradeTestSuite) TestFeatureGates() {
+	suite.app.UpgradeKeeper.SetFeatureGate(types.FeatureGate{Name: "future", Height: 20})
+	suite.app.UpgradeKeeper.SetFeatureGate(types.FeatureGate{Name: "active", Description: "new fee logic", Height: 1})
+	active := types.FeatureGateStatus{FeatureGate: types.FeatureGate{Name: "active", Description: "new fee logic", Height: 1}, Active: true}
+	future := types.FeatureGateStatus{FeatureGate: types.FeatureGate{Name: "future", Height: 20}}
+
+	ctx := suite.ctx.WithBlockHeight(10)
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.app.UpgradeKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	res, err := queryClient.FeatureGates(gocontext.Background(), &types.QueryFeatureGatesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.FeatureGateStatus{active, future}, res.FeatureGates)
+
+	res, err = queryClient.FeatureGates(gocontext.Background(), &types.QueryFeatureGatesRequest{ActiveOnly: true})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.FeatureGateStatus{active}, res.FeatureGates)
+}
+
 func TestUpgradeTestSuite(t *testing.T) {
 	suite.Run(t, new(UpgradeTestSuite))
 }
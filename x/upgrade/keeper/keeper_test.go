@@ -12,6 +12,7 @@ import (
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
@@ -271,6 +272,90 @@ func (s *KeeperTestSuite) TestLastCompletedUpgrade() {
 	require.Equal(int64(15), height)
 }
 
+func (s *KeeperTestSuite) TestDryRunUpgrade() {
+	keeper := s.app.UpgradeKeeper
+	storeKey := s.app.GetKey(types.StoreKey)
+	require := s.Require()
+
+	report := keeper.DryRunUpgrade(s.ctx, types.Plan{Name: "unknown", Height: 11})
+	require.Contains(report.Error, "no upgrade handler registered for unknown")
+
+	mm := module.NewManager(upgrade.NewAppModule(keeper))
+	cfg := module.NewConfigurator(s.app.AppCodec(), s.app.MsgServiceRouter(), s.app.GRPCQueryRouter())
+	keeper.SetUpgradeHandler("test", func(ctx sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		ctx.KVStore(storeKey).Set([]byte("a"), []byte("a"))
+		ctx.KVStore(storeKey).Delete([]byte("a"))
+
+		cacheCtx, write := ctx.CacheContext()
+		cacheCtx.KVStore(storeKey).Set([]byte("b"), []byte("b"))
+		write()
+
+		// upgrade is missing from the version map, so its InitGenesis is run
+		return mm.RunMigrations(ctx, cfg, module.VersionMap{})
+	})
+
+	report = keeper.DryRunUpgrade(s.ctx, types.Plan{Name: "test", Height: 11})
+	require.Empty(report.Error)
+	require.Equal("test", report.Plan)
+	require.Equal(int64(11), report.Height)
+	require.GreaterOrEqual(report.Writes, uint64(3))
+	require.Len(report.Modules, 1)
+	require.Equal(types.ModuleName, report.Modules[0].Module)
+	require.Equal(uint64(0), report.Modules[0].FromVersion)
+	require.Equal(upgrade.AppModule{}.ConsensusVersion(), report.Modules[0].ToVersion)
+	require.Equal(report.Writes-3, report.Modules[0].Writes)
+
+	keeper.SetUpgradeHandler("panic", func(ctx sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		panic("boom")
+	})
+
+	report = keeper.DryRunUpgrade(s.ctx, types.Plan{Name: "panic", Height: 11})
+	require.Equal("upgrade handler panicked: boom", report.Error)
+}
+
+func (s *KeeperTestSuite) TestFeatureGates() {
+	keeper := s.app.UpgradeKeeper
+	require := s.Require()
+
+	require.Empty(keeper.GetFeatureGates())
+	require.False(keeper.IsFeatureActive(s.ctx, "unknown"))
+
+	keeper.SetFeatureGate(types.FeatureGate{Name: "b", Description: "future", Height: 11})
+	keeper.SetFeatureGate(types.FeatureGate{Name: "a", Description: "current", Height: 10})
+	require.Equal([]types.FeatureGate{
+		{Name: "a", Description: "current", Height: 10},
+		{Name: "b", Description: "future", Height: 11},
+	}, keeper.GetFeatureGates())
+
+	require.Panics(func() { keeper.SetFeatureGate(types.FeatureGate{Name: "a", Height: 20}) })
+	require.Panics(func() { keeper.SetFeatureGate(types.FeatureGate{Name: "", Height: 20}) })
+	require.Panics(func() { keeper.SetFeatureGate(types.FeatureGate{Name: "c", Height: 0}) })
+
+	require.True(keeper.IsFeatureActive(s.ctx, "a"))
+	require.False(keeper.IsFeatureActive(s.ctx, "b"))
+	require.True(keeper.IsFeatureActive(s.ctx.WithBlockHeight(11), "b"))
+	require.False(keeper.IsFeatureActive(s.ctx.WithBlockHeight(9), "a"))
+
+	// the gates reaching their height are recorded as activated
+	require.NoError(keeper.ActivateFeatureGates(s.ctx))
+	height, ok := keeper.GetFeatureGateActivationHeight(s.ctx, "a")
+	require.True(ok)
+	require.Equal(int64(10), height)
+	_, ok = keeper.GetFeatureGateActivationHeight(s.ctx, "b")
+	require.False(ok)
+
+	// a gate registered after its height is rejected
+	keeper.SetFeatureGate(types.FeatureGate{Name: "late", Height: 5})
+	require.Error(keeper.ActivateFeatureGates(s.ctx.WithBlockHeight(11)))
+
+	// unless it precedes the initial height of the chain
+	keeper.InitFeatureGates(s.ctx.WithBlockHeight(11))
+	require.NoError(keeper.ActivateFeatureGates(s.ctx.WithBlockHeight(11)))
+	height, ok = keeper.GetFeatureGateActivationHeight(s.ctx, "late")
+	require.True(ok)
+	require.Equal(int64(11), height)
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -3,6 +3,7 @@ package upgrade
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -25,8 +26,9 @@ func init() {
 }
 
 var (
-	_ module.AppModule      = AppModule{}
-	_ module.AppModuleBasic = AppModuleBasic{}
+	_ module.AppModule            = AppModule{}
+	_ module.AppModuleBasic       = AppModuleBasic{}
+	_ module.HealthCheckAppModule = AppModule{}
 )
 
 // AppModuleBasic implements the sdk.AppModuleBasic interface
@@ -100,8 +102,11 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 }
 
-// InitGenesis is ignored, no sense in serializing future upgrades
-func (am AppModule) InitGenesis(_ sdk.Context, _ codec.JSONCodec, _ json.RawMessage) []abci.ValidatorUpdate {
+// InitGenesis records the feature gates preceding the initial height as
+// activated, the genesis is ignored otherwise, no sense in serializing future
+// upgrades
+func (am AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, _ json.RawMessage) []abci.ValidatorUpdate {
+	am.keeper.InitFeatureGates(ctx)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -130,6 +135,19 @@ func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 	BeginBlocker(am.keeper, ctx, req)
 }
 
+// RegisterHealthChecks registers the upgrade readiness check, failing when the
+// node is about to halt, or halted, for an upgrade it has no handler for.
+func (am AppModule) RegisterHealthChecks(registry sdk.HealthCheckRegistry) {
+	registry.RegisterReadinessCheck(types.ModuleName, func(ctx sdk.Context) error {
+		plan, found := am.keeper.GetUpgradePlan(ctx)
+		if found && plan.Height <= ctx.BlockHeight()+1 && !am.keeper.HasHandler(plan.Name) {
+			return fmt.Errorf("halting for upgrade %q at height %d", plan.Name, plan.Height)
+		}
+
+		return nil
+	})
+}
+
 // EndBlock does nothing
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	return []abci.ValidatorUpdate{}
@@ -0,0 +1,28 @@
+package types
+
+import "time"
+
+// DryRunReport reports on the execution of an upgrade handler against a copy of
+// the application state, see Keeper.DryRunUpgrade.
+type DryRunReport struct {
+	Plan     string        `json:"plan" yaml:"plan"`
+	Height   int64         `json:"height" yaml:"height"`
+	Duration time.Duration `json:"duration" yaml:"duration"`
+	// Writes is the number of store writes and deletes made by the upgrade
+	// handler, including those of the module migrations.
+	Writes  uint64                  `json:"writes" yaml:"writes"`
+	Modules []ModuleMigrationReport `json:"modules" yaml:"modules"`
+	Error   string                  `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// ModuleMigrationReport reports on the migrations of a single module run by
+// the upgrade handler through module.Manager.RunMigrations. A FromVersion of 0
+// means the module is new and its InitGenesis was run.
+type ModuleMigrationReport struct {
+	Module      string        `json:"module" yaml:"module"`
+	FromVersion uint64        `json:"from_version" yaml:"from_version"`
+	ToVersion   uint64        `json:"to_version" yaml:"to_version"`
+	Duration    time.Duration `json:"duration" yaml:"duration"`
+	Writes      uint64        `json:"writes" yaml:"writes"`
+	Error       string        `json:"error,omitempty" yaml:"error,omitempty"`
+}
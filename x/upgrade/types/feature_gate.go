@@ -0,0 +1,31 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// EventTypeFeatureGateActivated is the type of the event emitted at the
+	// activation height of a feature gate
+	EventTypeFeatureGateActivated = "feature_gate_activated"
+
+	AttributeKeyFeatureGate = "feature_gate"
+	AttributeKeyHeight      = "height"
+)
+
+// ValidateBasic does basic validation of a FeatureGate
+func (g FeatureGate) ValidateBasic() error {
+	if len(g.Name) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "name cannot be empty")
+	}
+	if g.Height <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "height must be greater than 0")
+	}
+
+	return nil
+}
+
+// IsActiveAt returns true if the feature is active at the given height
+func (g FeatureGate) IsActiveAt(height int64) bool {
+	return height >= g.Height
+}
@@ -28,6 +28,10 @@ const (
 	// ProtocolVersionByte is a prefix to look up Protocol Version
 	ProtocolVersionByte = 0x3
 
+	// FeatureGateByte is a prefix to look up the activation heights of the
+	// feature gates by name
+	FeatureGateByte = 0x4
+
 	// KeyUpgradedIBCState is the key under which upgraded ibc state is stored in the upgrade store
 	KeyUpgradedIBCState = "upgradedIBCState"
 
@@ -57,3 +61,9 @@ func UpgradedClientKey(height int64) []byte {
 func UpgradedConsStateKey(height int64) []byte {
 	return []byte(fmt.Sprintf("%s/%d/%s", KeyUpgradedIBCState, height, KeyUpgradedConsState))
 }
+
+// FeatureGateKey is the key under which the activation height of a feature
+// gate is saved
+func FeatureGateKey(name string) []byte {
+	return append([]byte{FeatureGateByte}, name...)
+}
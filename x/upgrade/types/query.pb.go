@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -407,6 +408,154 @@ func (m *QueryModuleVersionsResponse) GetModuleVersions() []*ModuleVersion {
 	return nil
 }
 
+// QueryFeatureGatesRequest is the request type for the Query/FeatureGates RPC
+// method.
+type QueryFeatureGatesRequest struct {
+	// active_only filters out the feature gates which are not active yet.
+	ActiveOnly bool `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+}
+
+func (m *QueryFeatureGatesRequest) Reset()         { *m = QueryFeatureGatesRequest{} }
+func (m *QueryFeatureGatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeatureGatesRequest) ProtoMessage()    {}
+func (*QueryFeatureGatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{8}
+}
+func (m *QueryFeatureGatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeatureGatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeatureGatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeatureGatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeatureGatesRequest.Merge(m, src)
+}
+func (m *QueryFeatureGatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeatureGatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeatureGatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeatureGatesRequest proto.InternalMessageInfo
+
+func (m *QueryFeatureGatesRequest) GetActiveOnly() bool {
+	if m != nil {
+		return m.ActiveOnly
+	}
+	return false
+}
+
+// QueryFeatureGatesResponse is the response type for the Query/FeatureGates RPC
+// method.
+type QueryFeatureGatesResponse struct {
+	// feature_gates are the feature gates sorted by name.
+	FeatureGates []FeatureGateStatus `protobuf:"bytes,1,rep,name=feature_gates,json=featureGates,proto3" json:"feature_gates"`
+}
+
+func (m *QueryFeatureGatesResponse) Reset()         { *m = QueryFeatureGatesResponse{} }
+func (m *QueryFeatureGatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeatureGatesResponse) ProtoMessage()    {}
+func (*QueryFeatureGatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{9}
+}
+func (m *QueryFeatureGatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeatureGatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeatureGatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeatureGatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeatureGatesResponse.Merge(m, src)
+}
+func (m *QueryFeatureGatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeatureGatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeatureGatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeatureGatesResponse proto.InternalMessageInfo
+
+func (m *QueryFeatureGatesResponse) GetFeatureGates() []FeatureGateStatus {
+	if m != nil {
+		return m.FeatureGates
+	}
+	return nil
+}
+
+// FeatureGateStatus is a feature gate along with whether it is active.
+type FeatureGateStatus struct {
+	FeatureGate FeatureGate `protobuf:"bytes,1,opt,name=feature_gate,json=featureGate,proto3" json:"feature_gate"`
+	// active tells whether the feature is active at the queried height.
+	Active bool `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (m *FeatureGateStatus) Reset()         { *m = FeatureGateStatus{} }
+func (m *FeatureGateStatus) String() string { return proto.CompactTextString(m) }
+func (*FeatureGateStatus) ProtoMessage()    {}
+func (*FeatureGateStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{10}
+}
+func (m *FeatureGateStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeatureGateStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeatureGateStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeatureGateStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeatureGateStatus.Merge(m, src)
+}
+func (m *FeatureGateStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeatureGateStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeatureGateStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeatureGateStatus proto.InternalMessageInfo
+
+func (m *FeatureGateStatus) GetFeatureGate() FeatureGate {
+	if m != nil {
+		return m.FeatureGate
+	}
+	return FeatureGate{}
+}
+
+func (m *FeatureGateStatus) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryCurrentPlanRequest)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanRequest")
 	proto.RegisterType((*QueryCurrentPlanResponse)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanResponse")
@@ -416,6 +565,9 @@ func init() {
 	proto.RegisterType((*QueryUpgradedConsensusStateResponse)(nil), "cosmos.upgrade.v1beta1.QueryUpgradedConsensusStateResponse")
 	proto.RegisterType((*QueryModuleVersionsRequest)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsRequest")
 	proto.RegisterType((*QueryModuleVersionsResponse)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsResponse")
+	proto.RegisterType((*QueryFeatureGatesRequest)(nil), "cosmos.upgrade.v1beta1.QueryFeatureGatesRequest")
+	proto.RegisterType((*QueryFeatureGatesResponse)(nil), "cosmos.upgrade.v1beta1.QueryFeatureGatesResponse")
+	proto.RegisterType((*FeatureGateStatus)(nil), "cosmos.upgrade.v1beta1.FeatureGateStatus")
 }
 
 func init() {
@@ -423,44 +575,53 @@ func init() {
 }
 
 var fileDescriptor_4a334d07ad8374f0 = []byte{
-	// 588 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x6f, 0xd3, 0x3c,
-	0x1c, 0xae, 0xbb, 0x6e, 0x7a, 0x5f, 0x17, 0x0d, 0xe4, 0x43, 0xc9, 0xc2, 0x14, 0x2a, 0x33, 0xa0,
-	0x88, 0x35, 0xde, 0xda, 0x0b, 0x1a, 0x02, 0x01, 0x93, 0x10, 0x43, 0x30, 0x41, 0x11, 0x1c, 0xb8,
-	0x54, 0x6e, 0x63, 0xda, 0x88, 0x24, 0xce, 0x62, 0x67, 0x62, 0x9a, 0x76, 0xe1, 0xc4, 0x11, 0x89,
-	0x3b, 0x37, 0x2e, 0x7c, 0x12, 0x8e, 0x93, 0xb8, 0x70, 0xd8, 0x01, 0xb5, 0x7c, 0x10, 0x14, 0xc7,
-	0x45, 0x29, 0x4d, 0x3a, 0xe0, 0xd4, 0xc6, 0x7e, 0xfe, 0xfd, 0xe2, 0xc7, 0x81, 0xb8, 0xcf, 0x85,
-	0xcf, 0x05, 0x89, 0xc3, 0x41, 0x44, 0x1d, 0x46, 0xf6, 0x37, 0x7b, 0x4c, 0xd2, 0x4d, 0xb2, 0x17,
-	0xb3, 0xe8, 0xc0, 0x0e, 0x23, 0x2e, 0x39, 0xaa, 0xa5, 0x18, 0x5b, 0x63, 0x6c, 0x8d, 0x31, 0x57,
-	0x06, 0x9c, 0x0f, 0x3c, 0x46, 0x14, 0xaa, 0x17, 0xbf, 0x22, 0x34, 0xd0, 0x14, 0x73, 0x55, 0x6f,
-	0xd1, 0xd0, 0x25, 0x34, 0x08, 0xb8, 0xa4, 0xd2, 0xe5, 0x81, 0xd0, 0xbb, 0x6b, 0x05, 0xa6, 0x13,
-	0x03, 0x85, 0xc2, 0x2b, 0xf0, 0xfc, 0xd3, 0x24, 0xc5, 0x76, 0x1c, 0x45, 0x2c, 0x90, 0x4f, 0x3c,
-	0x1a, 0x74, 0xd8, 0x5e, 0xcc, 0x84, 0xc4, 0x8f, 0xa0, 0x31, 0xbb, 0x25, 0x42, 0x1e, 0x08, 0x86,
-	0x36, 0x60, 0x25, 0xf4, 0x68, 0x60, 0x80, 0x3a, 0x68, 0x54, 0x5b, 0xab, 0x76, 0x7e, 0x78, 0x5b,
-	0x71, 0x14, 0x12, 0x37, 0xb5, 0xd1, 0xdd, 0x30, 0xf4, 0x5c, 0xe6, 0x64, 0x8c, 0x10, 0x82, 0x95,
-	0x80, 0xfa, 0x4c, 0x89, 0xfd, 0xdf, 0x51, 0xff, 0x71, 0x4b, 0x9b, 0x4f, 0xc1, 0xb5, 0x79, 0x0d,
-	0x2e, 0x0d, 0x99, 0x3b, 0x18, 0x4a, 0xc5, 0x58, 0xe8, 0xe8, 0x27, 0xbc, 0x03, 0xb1, 0xe2, 0x3c,
-	0x4f, 0x53, 0x38, 0xdb, 0x09, 0x3a, 0x10, 0xb1, 0x78, 0x26, 0xa9, 0x64, 0x13, 0xb7, 0x8b, 0xb0,
-	0xea, 0x51, 0x21, 0xbb, 0x53, 0x12, 0x30, 0x59, 0x7a, 0xa0, 0x56, 0xb6, 0xca, 0x06, 0xc0, 0x2e,
-	0xbc, 0x34, 0x57, 0x4a, 0x27, 0xb9, 0x01, 0x0d, 0x3d, 0xb2, 0xd3, 0xed, 0x4f, 0x20, 0x5d, 0x91,
-	0x60, 0x8c, 0x72, 0x1d, 0x34, 0xce, 0x74, 0x6a, 0x71, 0xae, 0x42, 0x62, 0xf2, 0xb0, 0xf2, 0x1f,
-	0x38, 0x57, 0xc6, 0xb7, 0xa0, 0xa9, 0xac, 0x1e, 0x73, 0x27, 0xf6, 0xd8, 0x0b, 0x16, 0x89, 0xe4,
-	0x10, 0x33, 0x69, 0x7d, 0xb5, 0xd1, 0xcd, 0xbc, 0x22, 0x98, 0x2e, 0xed, 0x26, 0x2f, 0xca, 0x87,
-	0x17, 0x72, 0xe9, 0x3a, 0xe1, 0x2e, 0x3c, 0xab, 0xf9, 0xfb, 0x7a, 0xcb, 0x00, 0xf5, 0x85, 0x46,
-	0xb5, 0x75, 0xb9, 0xe8, 0xcc, 0xa6, 0x84, 0x3a, 0xcb, 0xfe, 0x94, 0x6e, 0xeb, 0x64, 0x11, 0x2e,
-	0x2a, 0x3f, 0xf4, 0x11, 0xc0, 0x6a, 0xa6, 0x1a, 0x88, 0x14, 0x09, 0x16, 0xf4, 0xcb, 0xdc, 0xf8,
-	0x73, 0x42, 0x3a, 0x0c, 0x5e, 0x7f, 0xfb, 0xf5, 0xc7, 0x87, 0xf2, 0x15, 0xb4, 0x46, 0x0a, 0xba,
-	0xdd, 0x4f, 0x49, 0xdd, 0xa4, 0x71, 0xe8, 0x13, 0x80, 0xd5, 0x4c, 0x7d, 0x4e, 0x09, 0x38, 0xdb,
-	0xcb, 0x53, 0x02, 0xe6, 0x34, 0x13, 0xb7, 0x55, 0xc0, 0x26, 0xba, 0x5e, 0x14, 0x90, 0xa6, 0x24,
-	0x15, 0x90, 0x1c, 0x26, 0x47, 0x7a, 0x84, 0x4e, 0x00, 0xac, 0xe5, 0xf7, 0x0c, 0x6d, 0xcd, 0x4d,
-	0x30, 0xb7, 0xe7, 0xe6, 0xcd, 0x7f, 0xe2, 0xea, 0x41, 0x76, 0xd4, 0x20, 0x77, 0xd0, 0x6d, 0x32,
-	0xff, 0x2b, 0x32, 0x53, 0x7b, 0x72, 0x98, 0xb9, 0x5c, 0x47, 0xef, 0xca, 0x00, 0x7d, 0x06, 0x70,
-	0x79, 0xba, 0x9c, 0xa8, 0x35, 0x37, 0x5a, 0xee, 0x45, 0x30, 0xdb, 0x7f, 0xc5, 0xd1, 0x63, 0x10,
-	0x35, 0xc6, 0x35, 0x74, 0xb5, 0x68, 0x8c, 0xdf, 0xee, 0xc6, 0xbd, 0xfb, 0x5f, 0x46, 0x16, 0x38,
-	0x1e, 0x59, 0xe0, 0xfb, 0xc8, 0x02, 0xef, 0xc7, 0x56, 0xe9, 0x78, 0x6c, 0x95, 0xbe, 0x8d, 0xad,
-	0xd2, 0xcb, 0xf5, 0x81, 0x2b, 0x87, 0x71, 0xcf, 0xee, 0x73, 0x7f, 0x22, 0x96, 0xfe, 0x34, 0x85,
-	0xf3, 0x9a, 0xbc, 0xf9, 0xa5, 0x2c, 0x0f, 0x42, 0x26, 0x7a, 0x4b, 0xea, 0xeb, 0xda, 0xfe, 0x19,
-	0x00, 0x00, 0xff, 0xff, 0x80, 0xcd, 0x5d, 0xc6, 0xfa, 0x05, 0x00, 0x00,
+	// 734 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x4f, 0x13, 0x4d,
+	0x18, 0xee, 0x94, 0x7e, 0x7c, 0x7c, 0xd3, 0x7e, 0x7c, 0x9f, 0x13, 0x53, 0x97, 0x95, 0x14, 0xb2,
+	0x80, 0x40, 0xa4, 0x1d, 0x28, 0x17, 0x03, 0xd1, 0x28, 0x24, 0x28, 0x06, 0x51, 0xd7, 0x1f, 0x07,
+	0x2f, 0x9b, 0x69, 0x3b, 0x2c, 0x1b, 0xb7, 0x3b, 0xcb, 0xce, 0x2c, 0xb1, 0x21, 0x1c, 0xf4, 0xe4,
+	0xd1, 0xc4, 0xbb, 0x07, 0x13, 0x2f, 0xfe, 0x25, 0x1c, 0x49, 0xbc, 0x78, 0x30, 0xc6, 0x80, 0x7f,
+	0x88, 0xd9, 0xd9, 0xa9, 0xd9, 0xda, 0xdd, 0x0a, 0x9e, 0x3a, 0x3b, 0xef, 0xf3, 0xbc, 0xcf, 0xf3,
+	0xce, 0xcc, 0xfb, 0x16, 0x1a, 0x4d, 0xc6, 0xdb, 0x8c, 0xe3, 0xd0, 0xb7, 0x03, 0xd2, 0xa2, 0x78,
+	0x7f, 0xa9, 0x41, 0x05, 0x59, 0xc2, 0x7b, 0x21, 0x0d, 0x3a, 0x35, 0x3f, 0x60, 0x82, 0xa1, 0x72,
+	0x8c, 0xa9, 0x29, 0x4c, 0x4d, 0x61, 0xf4, 0x31, 0x9b, 0x31, 0xdb, 0xa5, 0x58, 0xa2, 0x1a, 0xe1,
+	0x0e, 0x26, 0x9e, 0xa2, 0xe8, 0xe3, 0x2a, 0x44, 0x7c, 0x07, 0x13, 0xcf, 0x63, 0x82, 0x08, 0x87,
+	0x79, 0x5c, 0x45, 0x2f, 0xda, 0xcc, 0x66, 0x72, 0x89, 0xa3, 0x95, 0xda, 0x9d, 0xce, 0xb0, 0xd2,
+	0x95, 0x95, 0x28, 0x63, 0x0c, 0x5e, 0x7a, 0x18, 0x79, 0x5b, 0x0f, 0x83, 0x80, 0x7a, 0xe2, 0x81,
+	0x4b, 0x3c, 0x93, 0xee, 0x85, 0x94, 0x0b, 0x63, 0x0b, 0x6a, 0xfd, 0x21, 0xee, 0x33, 0x8f, 0x53,
+	0xb4, 0x08, 0x0b, 0xbe, 0x4b, 0x3c, 0x0d, 0x4c, 0x82, 0xb9, 0x62, 0x7d, 0xbc, 0x96, 0x5e, 0x52,
+	0x4d, 0x72, 0x24, 0xd2, 0xa8, 0x2a, 0xa1, 0x5b, 0xbe, 0xef, 0x3a, 0xb4, 0x95, 0x10, 0x42, 0x08,
+	0x16, 0x3c, 0xd2, 0xa6, 0x32, 0xd9, 0x3f, 0xa6, 0x5c, 0x1b, 0x75, 0xa8, 0xf5, 0xc3, 0x95, 0x78,
+	0x19, 0x0e, 0xef, 0x52, 0xc7, 0xde, 0x15, 0x92, 0x31, 0x64, 0xaa, 0x2f, 0x63, 0x13, 0x1a, 0x92,
+	0xf3, 0x24, 0x76, 0xd1, 0x5a, 0x8f, 0xd0, 0x1e, 0x0f, 0xf9, 0x23, 0x41, 0x04, 0xed, 0xaa, 0x4d,
+	0xc0, 0xa2, 0x4b, 0xb8, 0xb0, 0x7a, 0x52, 0xc0, 0x68, 0xeb, 0x8e, 0xdc, 0x59, 0xc9, 0x6b, 0xc0,
+	0x70, 0xe0, 0xd4, 0xc0, 0x54, 0xca, 0xc9, 0x35, 0xa8, 0xa9, 0x92, 0x5b, 0x56, 0xb3, 0x0b, 0xb1,
+	0x78, 0x84, 0xd1, 0xf2, 0x93, 0x60, 0xae, 0x64, 0x96, 0xc3, 0xd4, 0x0c, 0x91, 0xc8, 0xdd, 0xc2,
+	0x08, 0xf8, 0x3f, 0x6f, 0x5c, 0x87, 0xba, 0x94, 0xba, 0xc7, 0x5a, 0xa1, 0x4b, 0x9f, 0xd2, 0x80,
+	0x47, 0x57, 0x9b, 0x70, 0xdb, 0x96, 0x01, 0x2b, 0x71, 0x44, 0x30, 0xde, 0xda, 0x8e, 0x0e, 0xaa,
+	0x0d, 0x2f, 0xa7, 0xd2, 0x95, 0xc3, 0x6d, 0xf8, 0x9f, 0xe2, 0xef, 0xab, 0x90, 0x06, 0x26, 0x87,
+	0xe6, 0x8a, 0xf5, 0x99, 0xac, 0x3b, 0xeb, 0x49, 0x64, 0x8e, 0xb6, 0x7b, 0xf2, 0x1a, 0xab, 0xea,
+	0x5e, 0x36, 0x28, 0x11, 0x61, 0x40, 0x6f, 0x13, 0x41, 0x93, 0x5e, 0x49, 0x53, 0x38, 0xfb, 0xd4,
+	0x62, 0x9e, 0xdb, 0x91, 0x5e, 0x47, 0x4c, 0x18, 0x6f, 0xdd, 0xf7, 0xdc, 0x8e, 0xb1, 0x07, 0xc7,
+	0x52, 0xc8, 0xca, 0xe9, 0x63, 0xf8, 0xef, 0x4e, 0xbc, 0x6f, 0xd9, 0x51, 0x40, 0xf9, 0x9c, 0xcf,
+	0xf2, 0x99, 0x48, 0x12, 0x1d, 0x69, 0xc8, 0xd7, 0x0a, 0x47, 0x5f, 0x27, 0x72, 0x66, 0x69, 0x27,
+	0x91, 0xdd, 0xe8, 0xc0, 0x0b, 0x7d, 0x40, 0xb4, 0x05, 0x4b, 0x49, 0x29, 0xf5, 0x8a, 0xa7, 0xce,
+	0xa0, 0xa4, 0x34, 0x8a, 0x09, 0x8d, 0xe8, 0x39, 0xc6, 0x35, 0xca, 0x2b, 0x1f, 0x31, 0xd5, 0x57,
+	0xfd, 0xe5, 0xdf, 0xf0, 0x2f, 0x59, 0x2e, 0x7a, 0x07, 0x60, 0x31, 0xd1, 0x45, 0x08, 0x67, 0x29,
+	0x65, 0xb4, 0xa2, 0xbe, 0x78, 0x76, 0x42, 0x7c, 0x9a, 0xc6, 0xc2, 0xab, 0x4f, 0xdf, 0xdf, 0xe6,
+	0xaf, 0xa0, 0x69, 0x9c, 0x31, 0x06, 0x9a, 0x31, 0xc9, 0x8a, 0x9a, 0x13, 0x7d, 0x00, 0xb0, 0x98,
+	0xe8, 0xb4, 0xdf, 0x18, 0xec, 0x6f, 0x61, 0x7d, 0xf1, 0xec, 0x04, 0x65, 0x70, 0x59, 0x1a, 0xac,
+	0xa2, 0xab, 0x59, 0x06, 0x49, 0x4c, 0x92, 0x06, 0xf1, 0x41, 0xf4, 0xfa, 0x0f, 0xd1, 0x17, 0x00,
+	0xcb, 0xe9, 0x2d, 0x89, 0x56, 0x06, 0x3a, 0x18, 0x38, 0x12, 0xf4, 0xd5, 0x3f, 0xe2, 0xaa, 0x42,
+	0x36, 0x65, 0x21, 0x37, 0xd1, 0x0d, 0x3c, 0x78, 0xe0, 0xf6, 0x4d, 0x08, 0x7c, 0x90, 0x98, 0x43,
+	0x87, 0xaf, 0xf3, 0x00, 0x7d, 0x04, 0x70, 0xb4, 0xb7, 0x8f, 0x51, 0x7d, 0xa0, 0xb5, 0xd4, 0x99,
+	0xa1, 0x2f, 0x9f, 0x8b, 0xa3, 0xca, 0xc0, 0xb2, 0x8c, 0x79, 0x34, 0x9b, 0x55, 0xc6, 0x2f, 0x63,
+	0x04, 0xbd, 0x07, 0xb0, 0x94, 0x6c, 0x64, 0x34, 0xf8, 0x0d, 0xa4, 0x0c, 0x0c, 0x7d, 0xe9, 0x1c,
+	0x0c, 0x65, 0xb3, 0x2a, 0x6d, 0xce, 0xa2, 0x99, 0x2c, 0x9b, 0x3d, 0x33, 0x64, 0x6d, 0xe3, 0xe8,
+	0xa4, 0x02, 0x8e, 0x4f, 0x2a, 0xe0, 0xdb, 0x49, 0x05, 0xbc, 0x39, 0xad, 0xe4, 0x8e, 0x4f, 0x2b,
+	0xb9, 0xcf, 0xa7, 0x95, 0xdc, 0xb3, 0x05, 0xdb, 0x11, 0xbb, 0x61, 0xa3, 0xd6, 0x64, 0xed, 0x6e,
+	0xaa, 0xf8, 0xa7, 0xca, 0x5b, 0xcf, 0xf1, 0x8b, 0x9f, 0x79, 0x45, 0xc7, 0xa7, 0xbc, 0x31, 0x2c,
+	0xff, 0x2d, 0x97, 0x7f, 0x0c, 0x00, 0x79, 0xa4, 0xfb, 0x8e, 0xe0, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -490,6 +651,9 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.43
 	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
+	// FeatureGates queries the feature gates registered by the application,
+	// along with whether they are active.
+	FeatureGates(ctx context.Context, in *QueryFeatureGatesRequest, opts ...grpc.CallOption) (*QueryFeatureGatesResponse, error)
 }
 
 type queryClient struct {
@@ -537,6 +701,15 @@ func (c *queryClient) ModuleVersions(ctx context.Context, in *QueryModuleVersion
 	return out, nil
 }
 
+func (c *queryClient) FeatureGates(ctx context.Context, in *QueryFeatureGatesRequest, opts ...grpc.CallOption) (*QueryFeatureGatesResponse, error) {
+	out := new(QueryFeatureGatesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/FeatureGates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CurrentPlan queries the current upgrade plan.
@@ -554,6 +727,9 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.43
 	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
+	// FeatureGates queries the feature gates registered by the application,
+	// along with whether they are active.
+	FeatureGates(context.Context, *QueryFeatureGatesRequest) (*QueryFeatureGatesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -572,6 +748,9 @@ func (*UnimplementedQueryServer) UpgradedConsensusState(ctx context.Context, req
 func (*UnimplementedQueryServer) ModuleVersions(ctx context.Context, req *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModuleVersions not implemented")
 }
+func (*UnimplementedQueryServer) FeatureGates(ctx context.Context, req *QueryFeatureGatesRequest) (*QueryFeatureGatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeatureGates not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -649,6 +828,24 @@ func _Query_ModuleVersions_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeatureGates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeatureGatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeatureGates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/FeatureGates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeatureGates(ctx, req.(*QueryFeatureGatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -669,6 +866,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ModuleVersions",
 			Handler:    _Query_ModuleVersions_Handler,
 		},
+		{
+			MethodName: "FeatureGates",
+			Handler:    _Query_FeatureGates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -915,6 +1116,119 @@ func (m *QueryModuleVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryFeatureGatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeatureGatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeatureGatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActiveOnly {
+		i--
+		if m.ActiveOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeatureGatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeatureGatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeatureGatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeatureGates) > 0 {
+		for iNdEx := len(m.FeatureGates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeatureGates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeatureGateStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeatureGateStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeatureGateStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.FeatureGate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1026,6 +1340,47 @@ func (m *QueryModuleVersionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFeatureGatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActiveOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryFeatureGatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeatureGates) > 0 {
+		for _, e := range m.FeatureGates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeatureGateStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeatureGate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Active {
+		n += 2
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1638,6 +1993,263 @@ func (m *QueryModuleVersionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFeatureGatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeatureGatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeatureGatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ActiveOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeatureGatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeatureGatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeatureGatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeatureGates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeatureGates = append(m.FeatureGates, FeatureGateStatus{})
+			if err := m.FeatureGates[len(m.FeatureGates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeatureGateStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeatureGateStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeatureGateStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeatureGate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeatureGate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
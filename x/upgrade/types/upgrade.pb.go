@@ -208,11 +208,56 @@ func (m *ModuleVersion) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
 
+// FeatureGate specifies a behavioral change of the application, activating at
+// a given height without an upgrade plan.
+type FeatureGate struct {
+	// name of the feature, by which the application checks whether it is active
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// description of the behavioral change
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// height from which the feature is active
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *FeatureGate) Reset()         { *m = FeatureGate{} }
+func (m *FeatureGate) String() string { return proto.CompactTextString(m) }
+func (*FeatureGate) ProtoMessage()    {}
+func (*FeatureGate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{4}
+}
+func (m *FeatureGate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeatureGate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeatureGate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeatureGate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeatureGate.Merge(m, src)
+}
+func (m *FeatureGate) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeatureGate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeatureGate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeatureGate proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Plan)(nil), "cosmos.upgrade.v1beta1.Plan")
 	proto.RegisterType((*SoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.SoftwareUpgradeProposal")
 	proto.RegisterType((*CancelSoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal")
 	proto.RegisterType((*ModuleVersion)(nil), "cosmos.upgrade.v1beta1.ModuleVersion")
+	proto.RegisterType((*FeatureGate)(nil), "cosmos.upgrade.v1beta1.FeatureGate")
 }
 
 func init() {
@@ -220,36 +265,37 @@ func init() {
 }
 
 var fileDescriptor_ccf2a7d4d7b48dca = []byte{
-	// 462 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0xf6, 0x51, 0xb7, 0xd0, 0x8b, 0x58, 0x8e, 0x50, 0x4c, 0x54, 0xec, 0xc8, 0x62, 0xc8, 0x00,
-	0x67, 0xb5, 0x48, 0x0c, 0xd9, 0x48, 0x07, 0x24, 0x24, 0xa4, 0xca, 0x05, 0x06, 0x96, 0xea, 0x62,
-	0x5f, 0x9c, 0x13, 0xe7, 0x3b, 0xcb, 0x77, 0x29, 0xe4, 0x5f, 0x54, 0x62, 0x61, 0xec, 0xcf, 0xc9,
-	0xd8, 0x91, 0x29, 0x40, 0xb2, 0x30, 0x33, 0x32, 0xa1, 0xbb, 0xb3, 0x51, 0x04, 0x19, 0x3b, 0xf9,
-	0xfd, 0x78, 0xde, 0xe7, 0x79, 0x3f, 0x7c, 0xf0, 0x71, 0x26, 0x55, 0x29, 0x55, 0x32, 0xab, 0x8a,
-	0x9a, 0xe4, 0x34, 0xb9, 0x38, 0x1a, 0x53, 0x4d, 0x8e, 0x5a, 0x1f, 0x57, 0xb5, 0xd4, 0x12, 0x1d,
-	0x38, 0x14, 0x6e, 0xa3, 0x0d, 0xaa, 0xf7, 0xb0, 0x90, 0xb2, 0xe0, 0x34, 0xb1, 0xa8, 0xf1, 0x6c,
-	0x92, 0x10, 0x31, 0x77, 0x25, 0xbd, 0x6e, 0x21, 0x0b, 0x69, 0xcd, 0xc4, 0x58, 0x4d, 0x34, 0xfa,
-	0xb7, 0x40, 0xb3, 0x92, 0x2a, 0x4d, 0xca, 0xca, 0x01, 0xe2, 0xdf, 0x00, 0xfa, 0xa7, 0x9c, 0x08,
-	0x84, 0xa0, 0x2f, 0x48, 0x49, 0x03, 0xd0, 0x07, 0x83, 0xfd, 0xd4, 0xda, 0x68, 0x08, 0x7d, 0x83,
-	0x0f, 0x6e, 0xf5, 0xc1, 0xa0, 0x73, 0xdc, 0xc3, 0x8e, 0x0c, 0xb7, 0x64, 0xf8, 0x4d, 0x4b, 0x36,
-	0x82, 0x8b, 0x65, 0xe4, 0x5d, 0x7e, 0x8b, 0x40, 0x00, 0x52, 0x5b, 0x83, 0x0e, 0xe0, 0xde, 0x94,
-	0xb2, 0x62, 0xaa, 0x83, 0x9d, 0x3e, 0x18, 0xec, 0xa4, 0x8d, 0x67, 0x74, 0x98, 0x98, 0xc8, 0xc0,
-	0x77, 0x3a, 0xc6, 0x46, 0x1c, 0xde, 0x6f, 0x26, 0xcd, 0xcf, 0x33, 0xce, 0xa8, 0xd0, 0xe7, 0x4a,
-	0x13, 0x4d, 0x83, 0x5d, 0x2b, 0xdc, 0xfd, 0x4f, 0xf8, 0x85, 0x98, 0x8f, 0xe2, 0x5f, 0xcb, 0xe8,
-	0x70, 0x4e, 0x4a, 0x3e, 0x8c, 0xb7, 0x16, 0xc7, 0x01, 0x48, 0xef, 0xb5, 0x99, 0x13, 0x9b, 0x38,
-	0x33, 0xf1, 0xe1, 0x9d, 0x2f, 0x57, 0x91, 0xf7, 0xf3, 0x2a, 0x02, 0xf1, 0x67, 0x00, 0x1f, 0x9c,
-	0xc9, 0x89, 0xfe, 0x48, 0x6a, 0xfa, 0xd6, 0x21, 0x4f, 0x6b, 0x59, 0x49, 0x45, 0x38, 0xea, 0xc2,
-	0x5d, 0xcd, 0x34, 0x6f, 0x17, 0xe2, 0x1c, 0xd4, 0x87, 0x9d, 0x9c, 0xaa, 0xac, 0x66, 0x95, 0x66,
-	0x52, 0xd8, 0xc5, 0xec, 0xa7, 0x9b, 0x21, 0xf4, 0x1c, 0xfa, 0x15, 0x27, 0xc2, 0x4e, 0xdd, 0x39,
-	0x3e, 0xc4, 0xdb, 0x2f, 0x89, 0xcd, 0xce, 0x47, 0xbe, 0xd9, 0x5a, 0x6a, 0xf1, 0x1b, 0x5d, 0x11,
-	0xf8, 0xe8, 0x84, 0x88, 0x8c, 0xf2, 0x1b, 0x6e, 0x6d, 0x43, 0xe2, 0x25, 0xbc, 0xfb, 0x5a, 0xe6,
-	0x33, 0x4e, 0xdf, 0xd1, 0x5a, 0x99, 0xae, 0xb7, 0x5d, 0x3f, 0x80, 0xb7, 0x2f, 0x5c, 0xda, 0x92,
-	0xf9, 0x69, 0xeb, 0x5a, 0x22, 0x60, 0x88, 0x46, 0xaf, 0x16, 0x3f, 0x42, 0x6f, 0xb1, 0x0a, 0xc1,
-	0xf5, 0x2a, 0x04, 0xdf, 0x57, 0x21, 0xb8, 0x5c, 0x87, 0xde, 0xf5, 0x3a, 0xf4, 0xbe, 0xae, 0x43,
-	0xef, 0xfd, 0x93, 0x82, 0xe9, 0xe9, 0x6c, 0x8c, 0x33, 0x59, 0x26, 0xcd, 0x7f, 0xef, 0x3e, 0x4f,
-	0x55, 0xfe, 0x21, 0xf9, 0xf4, 0xf7, 0x11, 0xe8, 0x79, 0x45, 0xd5, 0x78, 0xcf, 0x9e, 0xf7, 0xd9,
-	0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2b, 0x67, 0xe1, 0x07, 0x23, 0x03, 0x00, 0x00,
+	// 480 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
+	0x18, 0xf5, 0x51, 0xb7, 0xd0, 0x8b, 0x58, 0x4c, 0x28, 0x26, 0x2a, 0x76, 0x64, 0x31, 0x64, 0x00,
+	0x5b, 0x2d, 0x12, 0x43, 0x36, 0x52, 0x89, 0x4a, 0x48, 0x48, 0x95, 0x0b, 0x0c, 0x2c, 0xd5, 0xc5,
+	0xfe, 0xe2, 0x9c, 0x38, 0xdf, 0x59, 0xbe, 0x73, 0x21, 0xff, 0x45, 0x25, 0x16, 0xc6, 0xfe, 0x39,
+	0x19, 0x3b, 0x32, 0x05, 0x48, 0x16, 0x66, 0x46, 0x26, 0xe4, 0x3b, 0x1b, 0x22, 0x08, 0x62, 0x61,
+	0xba, 0xef, 0xc7, 0xfb, 0xde, 0xbb, 0x7b, 0x77, 0x87, 0xef, 0x27, 0x42, 0xe6, 0x42, 0x46, 0x55,
+	0x91, 0x95, 0x24, 0x85, 0xe8, 0xfc, 0x60, 0x0c, 0x8a, 0x1c, 0xb4, 0x79, 0x58, 0x94, 0x42, 0x09,
+	0x67, 0xcf, 0xa0, 0xc2, 0xb6, 0xda, 0xa0, 0x7a, 0x77, 0x33, 0x21, 0x32, 0x06, 0x91, 0x46, 0x8d,
+	0xab, 0x49, 0x44, 0xf8, 0xcc, 0x8c, 0xf4, 0xba, 0x99, 0xc8, 0x84, 0x0e, 0xa3, 0x3a, 0x6a, 0xaa,
+	0xfe, 0xef, 0x03, 0x8a, 0xe6, 0x20, 0x15, 0xc9, 0x0b, 0x03, 0x08, 0xbe, 0x23, 0x6c, 0x9f, 0x30,
+	0xc2, 0x1d, 0x07, 0xdb, 0x9c, 0xe4, 0xe0, 0xa2, 0x3e, 0x1a, 0xec, 0xc6, 0x3a, 0x76, 0x86, 0xd8,
+	0xae, 0xf1, 0xee, 0xb5, 0x3e, 0x1a, 0x74, 0x0e, 0x7b, 0xa1, 0x21, 0x0b, 0x5b, 0xb2, 0xf0, 0x45,
+	0x4b, 0x36, 0xc2, 0xf3, 0x85, 0x6f, 0x5d, 0x7c, 0xf2, 0x91, 0x8b, 0x62, 0x3d, 0xe3, 0xec, 0xe1,
+	0x9d, 0x29, 0xd0, 0x6c, 0xaa, 0xdc, 0xad, 0x3e, 0x1a, 0x6c, 0xc5, 0x4d, 0x56, 0xeb, 0x50, 0x3e,
+	0x11, 0xae, 0x6d, 0x74, 0xea, 0xd8, 0x61, 0xf8, 0x76, 0x73, 0xd2, 0xf4, 0x2c, 0x61, 0x14, 0xb8,
+	0x3a, 0x93, 0x8a, 0x28, 0x70, 0xb7, 0xb5, 0x70, 0xf7, 0x0f, 0xe1, 0x27, 0x7c, 0x36, 0x0a, 0xbe,
+	0x2d, 0xfc, 0xfd, 0x19, 0xc9, 0xd9, 0x30, 0xd8, 0x38, 0x1c, 0xb8, 0x28, 0xbe, 0xd5, 0x76, 0x8e,
+	0x74, 0xe3, 0xb4, 0xae, 0x0f, 0x6f, 0x7c, 0xb8, 0xf4, 0xad, 0xaf, 0x97, 0x3e, 0x0a, 0xde, 0x23,
+	0x7c, 0xe7, 0x54, 0x4c, 0xd4, 0x5b, 0x52, 0xc2, 0x4b, 0x83, 0x3c, 0x29, 0x45, 0x21, 0x24, 0x61,
+	0x4e, 0x17, 0x6f, 0x2b, 0xaa, 0x58, 0x6b, 0x88, 0x49, 0x9c, 0x3e, 0xee, 0xa4, 0x20, 0x93, 0x92,
+	0x16, 0x8a, 0x0a, 0xae, 0x8d, 0xd9, 0x8d, 0xd7, 0x4b, 0xce, 0x63, 0x6c, 0x17, 0x8c, 0x70, 0x7d,
+	0xea, 0xce, 0xe1, 0x7e, 0xb8, 0xf9, 0x26, 0xc3, 0xda, 0xf3, 0x91, 0x5d, 0xbb, 0x16, 0x6b, 0xfc,
+	0xda, 0xae, 0x08, 0xbe, 0x77, 0x44, 0x78, 0x02, 0xec, 0x3f, 0x6f, 0x6d, 0x4d, 0xe2, 0x18, 0xdf,
+	0x7c, 0x2e, 0xd2, 0x8a, 0xc1, 0x2b, 0x28, 0x25, 0x15, 0x9b, 0x6f, 0xdf, 0xc5, 0xd7, 0xcf, 0x4d,
+	0x5b, 0x93, 0xd9, 0x71, 0x9b, 0x6a, 0x22, 0xa4, 0x89, 0x00, 0x77, 0x9e, 0x02, 0x51, 0x55, 0x09,
+	0xc7, 0x44, 0xc1, 0x46, 0x9a, 0x7f, 0x5b, 0xf6, 0x97, 0xa7, 0xf2, 0x4b, 0x66, 0xf4, 0x6c, 0xfe,
+	0xc5, 0xb3, 0xe6, 0x4b, 0x0f, 0x5d, 0x2d, 0x3d, 0xf4, 0x79, 0xe9, 0xa1, 0x8b, 0x95, 0x67, 0x5d,
+	0xad, 0x3c, 0xeb, 0xe3, 0xca, 0xb3, 0x5e, 0x3f, 0xc8, 0xa8, 0x9a, 0x56, 0xe3, 0x30, 0x11, 0x79,
+	0xd4, 0x7c, 0x2f, 0xb3, 0x3c, 0x94, 0xe9, 0x9b, 0xe8, 0xdd, 0xcf, 0xbf, 0xa6, 0x66, 0x05, 0xc8,
+	0xf1, 0x8e, 0x7e, 0x45, 0x8f, 0x7e, 0x0c, 0x00, 0x2c, 0xa2, 0xa6, 0x43, 0x8a, 0x03, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -372,6 +418,36 @@ func (this *ModuleVersion) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *FeatureGate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeatureGate)
+	if !ok {
+		that2, ok := that.(FeatureGate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
 func (m *Plan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -553,6 +629,48 @@ func (m *ModuleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeatureGate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeatureGate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeatureGate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
 	offset -= sovUpgrade(v)
 	base := offset
@@ -642,6 +760,26 @@ func (m *ModuleVersion) Size() (n int) {
 	return n
 }
 
+func (m *FeatureGate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovUpgrade(uint64(m.Height))
+	}
+	return n
+}
+
 func sovUpgrade(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1212,6 +1350,139 @@ func (m *ModuleVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FeatureGate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeatureGate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeatureGate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipUpgrade(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0